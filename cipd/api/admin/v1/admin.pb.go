@@ -58,6 +58,9 @@ const (
 	MapperKind_FIND_MALFORMED_TAGS MapperKind = 2
 	// Exports all tags into a BigQuery table 'exported_tags'.
 	MapperKind_EXPORT_TAGS_TO_BQ MapperKind = 3
+	// Deletes instances that expired according to retention policies of their
+	// prefixes, see RetentionPolicy in repo.proto. Supports dry_run.
+	MapperKind_ENFORCE_RETENTION_POLICIES MapperKind = 4
 )
 
 // Enum value maps for MapperKind.
@@ -67,12 +70,14 @@ var (
 		1: "ENUMERATE_PACKAGES",
 		2: "FIND_MALFORMED_TAGS",
 		3: "EXPORT_TAGS_TO_BQ",
+		4: "ENFORCE_RETENTION_POLICIES",
 	}
 	MapperKind_value = map[string]int32{
-		"MAPPER_KIND_UNSPECIFIED": 0,
-		"ENUMERATE_PACKAGES":      1,
-		"FIND_MALFORMED_TAGS":     2,
-		"EXPORT_TAGS_TO_BQ":       3,
+		"MAPPER_KIND_UNSPECIFIED":    0,
+		"ENUMERATE_PACKAGES":         1,
+		"FIND_MALFORMED_TAGS":        2,
+		"EXPORT_TAGS_TO_BQ":          3,
+		"ENFORCE_RETENTION_POLICIES": 4,
 	}
 )
 
@@ -321,6 +326,54 @@ func (x *TagFixReport) GetFixed() []*TagFixReport_Tag {
 	return nil
 }
 
+// Result of running ENFORCE_RETENTION_POLICIES mapper job.
+type RetentionReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instances []*RetentionReport_Instance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+}
+
+func (x *RetentionReport) Reset() {
+	*x = RetentionReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionReport) ProtoMessage() {}
+
+func (x *RetentionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionReport.ProtoReflect.Descriptor instead.
+func (*RetentionReport) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RetentionReport) GetInstances() []*RetentionReport_Instance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
 type TagFixReport_Tag struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -335,7 +388,7 @@ type TagFixReport_Tag struct {
 func (x *TagFixReport_Tag) Reset() {
 	*x = TagFixReport_Tag{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_msgTypes[4]
+		mi := &file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -348,7 +401,7 @@ func (x *TagFixReport_Tag) String() string {
 func (*TagFixReport_Tag) ProtoMessage() {}
 
 func (x *TagFixReport_Tag) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_msgTypes[4]
+	mi := &file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,6 +445,77 @@ func (x *TagFixReport_Tag) GetFixedTag() string {
 	return ""
 }
 
+type RetentionReport_Instance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg      string `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Instance string `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`    // why the instance has expired, for humans
+	Deleted  bool   `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"` // false in dry run mode or if the instance was skipped
+}
+
+func (x *RetentionReport_Instance) Reset() {
+	*x = RetentionReport_Instance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionReport_Instance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionReport_Instance) ProtoMessage() {}
+
+func (x *RetentionReport_Instance) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionReport_Instance.ProtoReflect.Descriptor instead.
+func (*RetentionReport_Instance) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *RetentionReport_Instance) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *RetentionReport_Instance) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *RetentionReport_Instance) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RetentionReport_Instance) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
 var File_go_chromium_org_luci_cipd_api_admin_v1_admin_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_rawDesc = []byte{
@@ -430,29 +554,47 @@ var file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_rawDesc = []byte{
 	0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e,
 	0x54, 0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x67,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x78, 0x65, 0x64, 0x54, 0x61, 0x67,
-	0x2a, 0x71, 0x0a, 0x0a, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1b,
-	0x0a, 0x17, 0x4d, 0x41, 0x50, 0x50, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x45,
-	0x4e, 0x55, 0x4d, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45,
-	0x53, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x41, 0x4c, 0x46,
-	0x4f, 0x52, 0x4d, 0x45, 0x44, 0x5f, 0x54, 0x41, 0x47, 0x53, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11,
-	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x41, 0x47, 0x53, 0x5f, 0x54, 0x4f, 0x5f, 0x42,
-	0x51, 0x10, 0x03, 0x32, 0xc1, 0x01, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x29, 0x0a,
-	0x09, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x0f, 0x2e, 0x63, 0x69, 0x70,
-	0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x0b, 0x2e, 0x63, 0x69,
-	0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x08, 0x41, 0x62, 0x6f, 0x72,
-	0x74, 0x4a, 0x6f, 0x62, 0x12, 0x0b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x49,
-	0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
-	0x4a, 0x6f, 0x62, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0d, 0x46, 0x69, 0x78, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12, 0x0b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f,
-	0x62, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x54, 0x61, 0x67, 0x46, 0x69,
-	0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x6f, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f,
-	0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76,
-	0x31, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0xbb, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x1a, 0x6a, 0x0a, 0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6b, 0x67,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x2a, 0x91,
+	0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a,
+	0x17, 0x4d, 0x41, 0x50, 0x50, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e,
+	0x55, 0x4d, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x53,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x41, 0x4c, 0x46, 0x4f,
+	0x52, 0x4d, 0x45, 0x44, 0x5f, 0x54, 0x41, 0x47, 0x53, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x45,
+	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x41, 0x47, 0x53, 0x5f, 0x54, 0x4f, 0x5f, 0x42, 0x51,
+	0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x4e, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45,
+	0x54, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x49, 0x45, 0x53,
+	0x10, 0x04, 0x32, 0xfb, 0x01, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x29, 0x0a, 0x09,
+	0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64,
+	0x2e, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x0b, 0x2e, 0x63, 0x69, 0x70,
+	0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x08, 0x41, 0x62, 0x6f, 0x72, 0x74,
+	0x4a, 0x6f, 0x62, 0x12, 0x0b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x44,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4a,
+	0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a,
+	0x6f, 0x62, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0d, 0x46, 0x69, 0x78, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x64, 0x54, 0x61, 0x67, 0x73, 0x12, 0x0b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62,
+	0x49, 0x44, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x54, 0x61, 0x67, 0x46, 0x69, 0x78,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x38, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0b, 0x2e, 0x63,
+	0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x63, 0x69, 0x70, 0x64,
+	0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e,
+	0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -468,35 +610,40 @@ func file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_rawDescGZIP() []byt
 }
 
 var file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_goTypes = []interface{}{
-	(MapperKind)(0),          // 0: cipd.MapperKind
-	(*JobConfig)(nil),        // 1: cipd.JobConfig
-	(*JobID)(nil),            // 2: cipd.JobID
-	(*JobState)(nil),         // 3: cipd.JobState
-	(*TagFixReport)(nil),     // 4: cipd.TagFixReport
-	(*TagFixReport_Tag)(nil), // 5: cipd.TagFixReport.Tag
-	(*mapper.JobInfo)(nil),   // 6: appengine.mapper.messages.JobInfo
-	(*empty.Empty)(nil),      // 7: google.protobuf.Empty
+	(MapperKind)(0),                  // 0: cipd.MapperKind
+	(*JobConfig)(nil),                // 1: cipd.JobConfig
+	(*JobID)(nil),                    // 2: cipd.JobID
+	(*JobState)(nil),                 // 3: cipd.JobState
+	(*TagFixReport)(nil),             // 4: cipd.TagFixReport
+	(*RetentionReport)(nil),          // 5: cipd.RetentionReport
+	(*TagFixReport_Tag)(nil),         // 6: cipd.TagFixReport.Tag
+	(*RetentionReport_Instance)(nil), // 7: cipd.RetentionReport.Instance
+	(*mapper.JobInfo)(nil),           // 8: appengine.mapper.messages.JobInfo
+	(*empty.Empty)(nil),              // 9: google.protobuf.Empty
 }
 var file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_depIdxs = []int32{
-	0, // 0: cipd.JobConfig.kind:type_name -> cipd.MapperKind
-	1, // 1: cipd.JobState.config:type_name -> cipd.JobConfig
-	6, // 2: cipd.JobState.info:type_name -> appengine.mapper.messages.JobInfo
-	5, // 3: cipd.TagFixReport.fixed:type_name -> cipd.TagFixReport.Tag
-	1, // 4: cipd.Admin.LaunchJob:input_type -> cipd.JobConfig
-	2, // 5: cipd.Admin.AbortJob:input_type -> cipd.JobID
-	2, // 6: cipd.Admin.GetJobState:input_type -> cipd.JobID
-	2, // 7: cipd.Admin.FixMarkedTags:input_type -> cipd.JobID
-	2, // 8: cipd.Admin.LaunchJob:output_type -> cipd.JobID
-	7, // 9: cipd.Admin.AbortJob:output_type -> google.protobuf.Empty
-	3, // 10: cipd.Admin.GetJobState:output_type -> cipd.JobState
-	4, // 11: cipd.Admin.FixMarkedTags:output_type -> cipd.TagFixReport
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: cipd.JobConfig.kind:type_name -> cipd.MapperKind
+	1,  // 1: cipd.JobState.config:type_name -> cipd.JobConfig
+	8,  // 2: cipd.JobState.info:type_name -> appengine.mapper.messages.JobInfo
+	6,  // 3: cipd.TagFixReport.fixed:type_name -> cipd.TagFixReport.Tag
+	7,  // 4: cipd.RetentionReport.instances:type_name -> cipd.RetentionReport.Instance
+	1,  // 5: cipd.Admin.LaunchJob:input_type -> cipd.JobConfig
+	2,  // 6: cipd.Admin.AbortJob:input_type -> cipd.JobID
+	2,  // 7: cipd.Admin.GetJobState:input_type -> cipd.JobID
+	2,  // 8: cipd.Admin.FixMarkedTags:input_type -> cipd.JobID
+	2,  // 9: cipd.Admin.GetRetentionReport:input_type -> cipd.JobID
+	2,  // 10: cipd.Admin.LaunchJob:output_type -> cipd.JobID
+	9,  // 11: cipd.Admin.AbortJob:output_type -> google.protobuf.Empty
+	3,  // 12: cipd.Admin.GetJobState:output_type -> cipd.JobState
+	4,  // 13: cipd.Admin.FixMarkedTags:output_type -> cipd.TagFixReport
+	5,  // 14: cipd.Admin.GetRetentionReport:output_type -> cipd.RetentionReport
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_init() }
@@ -554,6 +701,18 @@ func file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TagFixReport_Tag); i {
 			case 0:
 				return &v.state
@@ -565,6 +724,18 @@ func file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_init() {
 				return nil
 			}
 		}
+		file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionReport_Instance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -572,7 +743,7 @@ func file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -607,6 +778,10 @@ type AdminClient interface {
 	GetJobState(ctx context.Context, in *JobID, opts ...grpc.CallOption) (*JobState, error)
 	// Fixes (right inside the handler) tags marked by the given mapper job.
 	FixMarkedTags(ctx context.Context, in *JobID, opts ...grpc.CallOption) (*TagFixReport, error)
+	// Returns instances visited by the given ENFORCE_RETENTION_POLICIES job.
+	//
+	// For dry run jobs it is the list of instances that would have been deleted.
+	GetRetentionReport(ctx context.Context, in *JobID, opts ...grpc.CallOption) (*RetentionReport, error)
 }
 type adminPRPCClient struct {
 	client *prpc.Client
@@ -652,6 +827,15 @@ func (c *adminPRPCClient) FixMarkedTags(ctx context.Context, in *JobID, opts ...
 	return out, nil
 }
 
+func (c *adminPRPCClient) GetRetentionReport(ctx context.Context, in *JobID, opts ...grpc.CallOption) (*RetentionReport, error) {
+	out := new(RetentionReport)
+	err := c.client.Call(ctx, "cipd.Admin", "GetRetentionReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type adminClient struct {
 	cc grpc.ClientConnInterface
 }
@@ -696,6 +880,15 @@ func (c *adminClient) FixMarkedTags(ctx context.Context, in *JobID, opts ...grpc
 	return out, nil
 }
 
+func (c *adminClient) GetRetentionReport(ctx context.Context, in *JobID, opts ...grpc.CallOption) (*RetentionReport, error) {
+	out := new(RetentionReport)
+	err := c.cc.Invoke(ctx, "/cipd.Admin/GetRetentionReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServer is the server API for Admin service.
 type AdminServer interface {
 	// Launches a mapping job that examines and/or fixes datastore entities.
@@ -706,6 +899,10 @@ type AdminServer interface {
 	GetJobState(context.Context, *JobID) (*JobState, error)
 	// Fixes (right inside the handler) tags marked by the given mapper job.
 	FixMarkedTags(context.Context, *JobID) (*TagFixReport, error)
+	// Returns instances visited by the given ENFORCE_RETENTION_POLICIES job.
+	//
+	// For dry run jobs it is the list of instances that would have been deleted.
+	GetRetentionReport(context.Context, *JobID) (*RetentionReport, error)
 }
 
 // UnimplementedAdminServer can be embedded to have forward compatible implementations.
@@ -724,6 +921,9 @@ func (*UnimplementedAdminServer) GetJobState(context.Context, *JobID) (*JobState
 func (*UnimplementedAdminServer) FixMarkedTags(context.Context, *JobID) (*TagFixReport, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FixMarkedTags not implemented")
 }
+func (*UnimplementedAdminServer) GetRetentionReport(context.Context, *JobID) (*RetentionReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetentionReport not implemented")
+}
 
 func RegisterAdminServer(s prpc.Registrar, srv AdminServer) {
 	s.RegisterService(&_Admin_serviceDesc, srv)
@@ -801,6 +1001,24 @@ func _Admin_FixMarkedTags_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_GetRetentionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetRetentionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cipd.Admin/GetRetentionReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetRetentionReport(ctx, req.(*JobID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Admin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cipd.Admin",
 	HandlerType: (*AdminServer)(nil),
@@ -821,6 +1039,10 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FixMarkedTags",
 			Handler:    _Admin_FixMarkedTags_Handler,
 		},
+		{
+			MethodName: "GetRetentionReport",
+			Handler:    _Admin_GetRetentionReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "go.chromium.org/luci/cipd/api/admin/v1/admin.proto",
@@ -92,3 +92,20 @@ func (s *DecoratedAdmin) FixMarkedTags(ctx context.Context, req *JobID) (rsp *Ta
 	}
 	return
 }
+
+func (s *DecoratedAdmin) GetRetentionReport(ctx context.Context, req *JobID) (rsp *RetentionReport, err error) {
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "GetRetentionReport", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		rsp, err = s.Service.GetRetentionReport(ctx, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "GetRetentionReport", rsp, err)
+	}
+	return
+}
@@ -12,468 +12,293 @@ func init() {
 			"cipd.Admin",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 123, 205, 111, 27, 201,
-			242, 24, 103, 134, 164, 232, 246, 218, 43, 143, 191, 100, 122, 45,
-			215, 227, 90, 22, 105, 83, 164, 36, 219, 90, 75, 222, 47, 74,
-			164, 164, 241, 147, 72, 45, 73, 173, 159, 189, 120, 176, 135, 156,
-			38, 217, 235, 225, 52, 223, 204, 80, 178, 214, 241, 59, 228, 148,
-			223, 37, 127, 65, 114, 201, 45, 1, 114, 75, 128, 228, 248, 11,
-			114, 204, 45, 64, 128, 252, 1, 239, 156, 67, 46, 1, 18, 36,
-			65, 117, 79, 15, 41, 127, 237, 71, 130, 223, 105, 5, 11, 158,
-			154, 174, 174, 170, 174, 174, 234, 250, 232, 17, 249, 119, 38, 185,
-			222, 231, 188, 239, 210, 242, 200, 231, 33, 239, 140, 123, 101, 58,
-			28, 133, 39, 37, 1, 154, 159, 202, 193, 146, 26, 204, 205, 144,
-			84, 13, 199, 55, 143, 200, 197, 46, 31, 150, 222, 26, 223, 36,
-			98, 244, 0, 193, 3, 237, 217, 98, 159, 133, 131, 113, 167, 212,
-			229, 195, 114, 159, 187, 182, 215, 159, 176, 25, 133, 39, 35, 26,
-			72, 110, 255, 67, 211, 254, 133, 110, 236, 28, 108, 254, 43, 125,
-			126, 71, 82, 60, 136, 240, 74, 79, 168, 235, 254, 209, 227, 199,
-			94, 27, 241, 31, 255, 239, 89, 146, 54, 147, 243, 137, 123, 179,
-			228, 63, 125, 66, 180, 79, 76, 99, 62, 97, 174, 254, 253, 39,
-			32, 38, 116, 185, 11, 155, 227, 94, 143, 250, 1, 44, 129, 36,
-			181, 24, 128, 99, 135, 54, 48, 47, 164, 126, 119, 96, 123, 125,
-			10, 61, 238, 15, 237, 144, 192, 22, 31, 157, 248, 172, 63, 8,
-			97, 117, 121, 249, 97, 52, 1, 44, 175, 91, 2, 168, 184, 46,
-			136, 177, 0, 124, 26, 80, 255, 136, 58, 37, 2, 131, 48, 28,
-			5, 27, 229, 178, 67, 143, 168, 203, 71, 212, 15, 148, 14, 112,
-			145, 163, 72, 136, 165, 142, 20, 162, 76, 8, 52, 169, 195, 130,
-			208, 103, 157, 113, 200, 184, 7, 182, 231, 192, 56, 160, 192, 60,
-			8, 248, 216, 239, 82, 241, 166, 195, 60, 219, 63, 17, 114, 5,
-			69, 56, 102, 225, 0, 184, 47, 254, 231, 227, 144, 192, 144, 59,
-			172, 199, 186, 54, 82, 40, 130, 237, 83, 24, 81, 127, 200, 194,
-			144, 58, 48, 242, 249, 17, 115, 168, 3, 225, 192, 14, 33, 28,
-			224, 234, 92, 151, 31, 51, 175, 15, 93, 238, 57, 12, 39, 5,
-			56, 137, 192, 144, 134, 27, 132, 0, 254, 220, 121, 75, 176, 0,
-			120, 79, 73, 212, 229, 14, 133, 225, 56, 8, 193, 167, 161, 205,
-			60, 65, 213, 238, 240, 35, 28, 138, 52, 70, 192, 227, 33, 235,
-			210, 34, 132, 3, 22, 128, 203, 130, 16, 41, 76, 115, 244, 156,
-			183, 196, 113, 88, 208, 117, 109, 54, 164, 126, 233, 67, 66, 48,
-			111, 90, 23, 74, 136, 145, 207, 157, 113, 151, 78, 228, 32, 19,
-			65, 254, 159, 228, 32, 16, 173, 206, 225, 221, 241, 144, 122, 161,
-			173, 54, 169, 204, 125, 224, 225, 128, 250, 48, 180, 67, 234, 51,
-			219, 13, 38, 170, 22, 27, 20, 14, 40, 129, 105, 233, 227, 69,
-			213, 41, 19, 51, 145, 176, 103, 15, 41, 10, 52, 109, 91, 30,
-			159, 140, 9, 189, 179, 48, 192, 21, 121, 146, 20, 247, 3, 24,
-			218, 39, 208, 161, 104, 41, 14, 132, 28, 168, 231, 112, 63, 160,
-			104, 20, 35, 159, 15, 121, 72, 65, 234, 36, 12, 192, 161, 62,
-			59, 162, 14, 244, 124, 62, 36, 82, 11, 1, 239, 133, 199, 104,
-			38, 145, 5, 65, 48, 162, 93, 180, 32, 24, 249, 12, 13, 203,
-			71, 219, 241, 164, 21, 5, 129, 144, 157, 64, 123, 215, 106, 65,
-			171, 177, 221, 126, 82, 105, 214, 192, 106, 193, 65, 179, 241, 189,
-			85, 173, 85, 97, 243, 41, 180, 119, 107, 176, 213, 56, 120, 218,
-			180, 118, 118, 219, 176, 219, 216, 171, 214, 154, 45, 168, 212, 171,
-			176, 213, 168, 183, 155, 214, 230, 97, 187, 209, 108, 17, 200, 85,
-			90, 96, 181, 114, 98, 164, 82, 127, 10, 181, 63, 29, 52, 107,
-			173, 22, 52, 154, 96, 237, 31, 236, 89, 181, 42, 60, 169, 52,
-			155, 149, 122, 219, 170, 181, 138, 96, 213, 183, 246, 14, 171, 86,
-			125, 167, 8, 155, 135, 109, 168, 55, 218, 4, 246, 172, 125, 171,
-			93, 171, 66, 187, 81, 20, 108, 223, 157, 7, 141, 109, 216, 175,
-			53, 183, 118, 43, 245, 118, 101, 211, 218, 179, 218, 79, 5, 195,
-			109, 171, 93, 71, 102, 219, 141, 38, 129, 10, 28, 84, 154, 109,
-			107, 235, 112, 175, 210, 132, 131, 195, 230, 65, 163, 85, 3, 92,
-			89, 213, 106, 109, 237, 85, 172, 253, 90, 181, 4, 86, 29, 234,
-			13, 168, 125, 95, 171, 183, 161, 181, 91, 217, 219, 59, 189, 80,
-			2, 141, 39, 245, 90, 19, 165, 159, 94, 38, 108, 214, 96, 207,
-			170, 108, 238, 213, 144, 149, 88, 103, 213, 106, 214, 182, 218, 184,
-			160, 201, 211, 150, 85, 173, 213, 219, 149, 189, 34, 129, 214, 65,
-			109, 203, 170, 236, 21, 161, 246, 167, 218, 254, 193, 94, 165, 249,
-			180, 24, 17, 109, 213, 190, 59, 172, 213, 219, 86, 101, 15, 170,
-			149, 253, 202, 78, 173, 5, 249, 159, 211, 202, 65, 179, 177, 117,
-			216, 172, 237, 163, 212, 141, 109, 104, 29, 110, 182, 218, 86, 251,
-			176, 93, 131, 157, 70, 163, 42, 148, 221, 170, 53, 191, 183, 182,
-			106, 173, 71, 176, 215, 104, 9, 133, 29, 182, 106, 69, 2, 213,
-			74, 187, 34, 88, 31, 52, 27, 219, 86, 187, 245, 8, 159, 55,
-			15, 91, 150, 80, 156, 85, 111, 215, 154, 205, 195, 131, 182, 213,
-			168, 23, 96, 183, 241, 164, 246, 125, 173, 9, 91, 149, 195, 86,
-			173, 42, 52, 220, 168, 227, 106, 209, 86, 106, 141, 230, 83, 36,
-			139, 122, 16, 59, 80, 132, 39, 187, 181, 246, 110, 173, 137, 74,
-			21, 218, 170, 160, 26, 90, 237, 166, 181, 213, 158, 70, 107, 52,
-			161, 221, 104, 182, 201, 212, 58, 161, 94, 219, 217, 179, 118, 106,
-			245, 173, 26, 14, 55, 144, 204, 19, 171, 85, 43, 64, 165, 105,
-			181, 16, 193, 18, 140, 225, 73, 229, 41, 52, 14, 197, 170, 113,
-			163, 14, 91, 53, 34, 159, 167, 76, 183, 40, 246, 19, 172, 109,
-			168, 84, 191, 183, 80, 242, 8, 251, 160, 209, 106, 89, 145, 185,
-			8, 181, 109, 237, 70, 58, 47, 17, 146, 33, 154, 110, 26, 144,
-			152, 195, 167, 140, 105, 228, 18, 143, 200, 25, 162, 103, 22, 228,
-			163, 124, 249, 121, 226, 107, 241, 242, 172, 124, 148, 47, 111, 37,
-			138, 226, 165, 38, 31, 229, 203, 133, 196, 93, 241, 50, 122, 148,
-			47, 111, 39, 114, 226, 37, 145, 143, 242, 229, 98, 226, 15, 226,
-			229, 45, 249, 40, 95, 230, 19, 55, 197, 203, 155, 242, 241, 127,
-			234, 68, 79, 38, 76, 227, 94, 98, 54, 251, 223, 116, 168, 64,
-			159, 122, 212, 103, 93, 16, 241, 19, 134, 52, 8, 236, 62, 149,
-			33, 224, 132, 143, 161, 107, 123, 224, 211, 37, 12, 52, 33, 7,
-			251, 136, 51, 7, 28, 218, 99, 158, 56, 254, 198, 35, 23, 131,
-			9, 117, 200, 233, 249, 226, 248, 61, 225, 99, 31, 42, 7, 86,
-			80, 130, 10, 132, 39, 35, 214, 181, 93, 160, 175, 236, 225, 200,
-			165, 192, 2, 164, 39, 226, 87, 8, 118, 32, 78, 49, 159, 254,
-			101, 76, 131, 144, 64, 116, 170, 249, 52, 24, 113, 15, 57, 159,
-			140, 196, 209, 103, 123, 72, 15, 131, 207, 128, 59, 37, 216, 230,
-			62, 48, 47, 8, 109, 175, 75, 85, 52, 194, 248, 202, 186, 20,
-			182, 57, 135, 215, 242, 21, 128, 63, 234, 194, 166, 237, 231, 223,
-			74, 50, 74, 34, 199, 40, 96, 108, 26, 251, 94, 0, 31, 24,
-			127, 36, 201, 188, 193, 131, 109, 64, 225, 113, 171, 81, 23, 145,
-			132, 6, 241, 49, 223, 227, 62, 188, 16, 216, 47, 112, 101, 82,
-			23, 2, 145, 119, 126, 164, 221, 16, 94, 188, 126, 243, 162, 68,
-			8, 33, 70, 50, 161, 153, 198, 189, 204, 185, 78, 90, 176, 185,
-			71, 254, 115, 153, 220, 124, 59, 117, 10, 217, 144, 6, 161, 61,
-			28, 125, 40, 125, 122, 68, 206, 180, 21, 142, 57, 71, 102, 2,
-			138, 113, 42, 152, 211, 64, 203, 27, 77, 5, 154, 151, 72, 202,
-			179, 61, 30, 204, 233, 160, 229, 83, 77, 9, 108, 254, 245, 253,
-			41, 215, 249, 152, 162, 74, 187, 238, 254, 124, 218, 21, 75, 250,
-			43, 82, 175, 255, 186, 68, 102, 204, 212, 124, 226, 239, 52, 237,
-			247, 220, 235, 247, 220, 235, 247, 220, 235, 247, 220, 235, 247, 220,
-			235, 247, 220, 235, 31, 48, 247, 138, 83, 34, 124, 84, 185, 215,
-			166, 74, 200, 240, 81, 229, 94, 113, 66, 182, 16, 39, 100, 183,
-			19, 101, 149, 144, 225, 163, 202, 189, 226, 132, 108, 49, 78, 200,
-			242, 147, 132, 12, 31, 255, 251, 117, 145, 123, 165, 254, 138, 145,
-			47, 251, 183, 235, 80, 129, 56, 228, 78, 50, 138, 0, 108, 24,
-			113, 230, 133, 226, 84, 99, 67, 140, 50, 14, 29, 81, 207, 161,
-			94, 40, 179, 160, 19, 249, 254, 39, 238, 137, 195, 196, 229, 93,
-			219, 37, 208, 181, 93, 234, 57, 182, 95, 4, 234, 225, 225, 239,
-			96, 94, 101, 67, 151, 143, 229, 188, 40, 41, 16, 71, 105, 207,
-			183, 187, 147, 128, 161, 6, 48, 30, 96, 134, 32, 96, 12, 152,
-			220, 149, 103, 162, 72, 124, 36, 33, 134, 145, 212, 181, 67, 118,
-			36, 51, 66, 15, 232, 136, 119, 7, 96, 135, 112, 216, 222, 130,
-			33, 115, 60, 113, 160, 115, 143, 192, 99, 219, 27, 99, 20, 88,
-			41, 194, 202, 250, 23, 203, 69, 117, 78, 143, 124, 238, 210, 81,
-			200, 186, 176, 227, 211, 62, 247, 153, 237, 197, 210, 195, 241, 128,
-			117, 7, 64, 95, 133, 20, 101, 18, 231, 243, 123, 176, 58, 118,
-			247, 229, 177, 237, 59, 34, 141, 60, 161, 182, 15, 220, 163, 120,
-			254, 97, 196, 31, 50, 111, 28, 82, 17, 46, 97, 109, 57, 94,
-			159, 203, 189, 126, 9, 246, 168, 61, 154, 44, 217, 167, 144, 11,
-			134, 212, 246, 169, 147, 131, 128, 203, 248, 235, 113, 112, 169, 61,
-			34, 17, 26, 132, 118, 71, 166, 172, 30, 165, 168, 215, 158, 72,
-			60, 67, 234, 143, 48, 180, 202, 120, 62, 14, 48, 40, 217, 240,
-			195, 234, 253, 165, 1, 102, 190, 46, 243, 168, 237, 19, 16, 212,
-			255, 156, 255, 120, 206, 129, 251, 89, 22, 152, 133, 82, 148, 103,
-			250, 34, 201, 97, 129, 8, 9, 176, 188, 188, 188, 178, 36, 254,
-			181, 151, 151, 55, 196, 191, 103, 184, 244, 245, 245, 245, 245, 165,
-			149, 213, 165, 123, 43, 237, 213, 123, 27, 15, 214, 55, 30, 172,
-			151, 214, 213, 207, 179, 18, 108, 158, 16, 220, 200, 208, 103, 221,
-			16, 5, 12, 163, 37, 10, 234, 69, 56, 166, 64, 189, 96, 236,
-			71, 25, 255, 49, 21, 9, 127, 151, 123, 71, 212, 15, 229, 254,
-			202, 152, 4, 63, 52, 183, 183, 8, 220, 187, 119, 111, 125, 178,
-			150, 227, 227, 227, 18, 163, 97, 175, 196, 253, 126, 217, 239, 117,
-			241, 23, 49, 74, 225, 171, 176, 128, 9, 27, 5, 228, 236, 245,
-			3, 92, 212, 231, 80, 147, 201, 127, 64, 136, 122, 132, 149, 13,
-			216, 226, 195, 209, 56, 164, 83, 190, 32, 24, 30, 52, 90, 214,
-			159, 224, 5, 106, 38, 95, 192, 228, 89, 196, 229, 9, 82, 156,
-			121, 70, 249, 249, 36, 103, 14, 104, 248, 60, 218, 224, 188, 152,
-			94, 63, 220, 219, 43, 20, 222, 139, 39, 236, 61, 191, 92, 120,
-			52, 37, 211, 234, 207, 201, 212, 167, 33, 82, 225, 61, 199, 62,
-			153, 146, 45, 8, 253, 113, 55, 20, 12, 142, 108, 23, 194, 163,
-			136, 227, 41, 244, 219, 225, 81, 17, 132, 64, 143, 126, 235, 146,
-			142, 74, 225, 17, 66, 31, 91, 145, 68, 26, 7, 180, 11, 119,
-			96, 101, 121, 249, 244, 10, 239, 125, 112, 133, 79, 152, 119, 111,
-			21, 94, 236, 208, 176, 117, 18, 132, 116, 136, 195, 149, 96, 155,
-			185, 180, 125, 122, 35, 182, 173, 189, 90, 219, 218, 175, 65, 47,
-			140, 196, 248, 208, 156, 219, 189, 80, 73, 122, 104, 213, 219, 107,
-			247, 33, 100, 221, 151, 1, 124, 5, 249, 124, 94, 190, 41, 244,
-			194, 146, 115, 188, 203, 250, 131, 170, 29, 138, 89, 5, 248, 242,
-			75, 184, 183, 90, 128, 127, 4, 98, 108, 143, 31, 171, 33, 165,
-			183, 114, 25, 42, 40, 175, 195, 143, 3, 65, 18, 157, 101, 101,
-			121, 121, 234, 12, 11, 74, 49, 130, 60, 165, 86, 214, 222, 117,
-			163, 152, 26, 78, 95, 89, 187, 127, 255, 254, 23, 247, 214, 150,
-			39, 199, 70, 135, 246, 184, 79, 225, 208, 99, 175, 20, 149, 245,
-			47, 150, 223, 166, 82, 250, 109, 155, 153, 151, 235, 135, 124, 94,
-			42, 165, 44, 54, 11, 127, 10, 176, 52, 45, 206, 207, 88, 48,
-			210, 65, 117, 41, 58, 11, 83, 116, 132, 1, 20, 78, 25, 192,
-			253, 15, 26, 192, 99, 251, 200, 134, 23, 114, 35, 75, 221, 177,
-			239, 83, 47, 68, 148, 125, 230, 186, 44, 152, 50, 0, 60, 77,
-			97, 40, 222, 194, 87, 240, 225, 9, 31, 49, 115, 248, 106, 242,
-			182, 228, 209, 227, 205, 49, 115, 29, 234, 231, 11, 184, 176, 86,
-			164, 161, 136, 133, 84, 76, 65, 149, 244, 0, 136, 83, 151, 107,
-			103, 94, 136, 43, 143, 48, 229, 210, 163, 101, 11, 13, 20, 74,
-			29, 164, 44, 100, 153, 232, 224, 193, 7, 117, 16, 173, 66, 69,
-			95, 56, 56, 9, 7, 50, 187, 62, 165, 254, 105, 241, 243, 133,
-			183, 247, 102, 135, 134, 91, 19, 109, 228, 11, 226, 4, 20, 61,
-			129, 125, 123, 52, 98, 94, 159, 16, 176, 60, 249, 70, 150, 178,
-			69, 17, 28, 167, 244, 116, 50, 18, 1, 224, 84, 56, 151, 7,
-			106, 20, 73, 137, 56, 150, 127, 213, 169, 44, 89, 97, 68, 183,
-			49, 152, 23, 37, 25, 249, 22, 153, 229, 94, 99, 52, 125, 179,
-			244, 122, 200, 189, 112, 240, 102, 233, 181, 99, 159, 188, 105, 191,
-			198, 144, 246, 102, 227, 245, 144, 121, 111, 54, 94, 7, 180, 251,
-			230, 135, 210, 107, 76, 34, 208, 144, 223, 252, 249, 89, 142, 192,
-			241, 128, 250, 20, 228, 108, 36, 100, 187, 199, 246, 73, 0, 244,
-			21, 230, 53, 88, 1, 201, 8, 217, 195, 216, 232, 176, 62, 11,
-			3, 12, 245, 46, 133, 136, 83, 17, 4, 171, 34, 1, 201, 172,
-			8, 130, 91, 81, 132, 32, 193, 82, 68, 235, 159, 168, 207, 151,
-			70, 182, 227, 200, 154, 42, 60, 230, 138, 26, 181, 187, 3, 153,
-			169, 168, 236, 6, 179, 162, 200, 209, 138, 81, 94, 129, 225, 173,
-			207, 97, 60, 18, 193, 83, 77, 205, 179, 18, 45, 69, 47, 87,
-			222, 159, 3, 21, 138, 68, 240, 231, 35, 73, 89, 114, 202, 61,
-			203, 65, 48, 238, 245, 216, 43, 204, 210, 68, 47, 76, 246, 178,
-			208, 14, 68, 126, 150, 207, 29, 182, 183, 114, 133, 71, 167, 222,
-			18, 153, 70, 253, 101, 204, 124, 234, 148, 160, 2, 178, 23, 36,
-			141, 33, 16, 133, 42, 251, 137, 250, 16, 12, 248, 216, 117, 148,
-			42, 199, 1, 21, 57, 86, 222, 14, 98, 110, 14, 116, 78, 8,
-			138, 81, 192, 13, 240, 176, 52, 244, 100, 160, 127, 215, 148, 80,
-			145, 246, 41, 86, 35, 219, 15, 38, 108, 58, 148, 128, 200, 116,
-			48, 238, 119, 187, 116, 20, 66, 135, 135, 3, 193, 19, 231, 202,
-			74, 90, 173, 33, 120, 71, 14, 76, 6, 121, 175, 23, 208, 80,
-			36, 49, 219, 220, 87, 237, 190, 34, 228, 86, 151, 87, 190, 192,
-			51, 115, 229, 65, 123, 121, 101, 227, 222, 242, 198, 202, 131, 210,
-			242, 202, 179, 92, 100, 221, 1, 8, 56, 62, 116, 71, 118, 16,
-			18, 16, 152, 130, 63, 247, 38, 217, 228, 131, 34, 32, 181, 82,
-			228, 64, 246, 145, 221, 234, 250, 108, 20, 22, 49, 7, 60, 149,
-			192, 216, 128, 65, 67, 53, 225, 68, 238, 131, 9, 149, 52, 118,
-			105, 143, 194, 252, 131, 208, 198, 172, 210, 33, 240, 67, 200, 173,
-			86, 163, 37, 156, 44, 95, 120, 79, 218, 86, 26, 242, 159, 152,
-			235, 218, 194, 187, 168, 183, 116, 216, 42, 59, 188, 27, 148, 159,
-			208, 78, 121, 34, 74, 185, 73, 123, 212, 167, 94, 151, 150, 119,
-			92, 222, 177, 221, 231, 13, 33, 67, 80, 70, 129, 202, 83, 76,
-			10, 36, 238, 103, 90, 234, 164, 41, 10, 63, 151, 34, 193, 11,
-			204, 163, 80, 233, 37, 245, 240, 66, 45, 8, 151, 218, 161, 106,
-			181, 212, 33, 239, 93, 34, 129, 31, 94, 4, 161, 223, 19, 83,
-			167, 86, 196, 187, 65, 105, 36, 79, 54, 92, 203, 106, 217, 101,
-			29, 223, 246, 79, 68, 50, 90, 26, 132, 67, 247, 115, 241, 164,
-			230, 22, 68, 35, 130, 196, 134, 172, 152, 4, 35, 218, 133, 197,
-			133, 167, 75, 11, 195, 165, 5, 167, 189, 176, 187, 177, 176, 191,
-			177, 208, 42, 45, 244, 158, 45, 150, 96, 143, 189, 164, 199, 44,
-			160, 34, 249, 71, 5, 77, 118, 105, 28, 80, 73, 237, 49, 119,
-			108, 97, 172, 139, 1, 252, 240, 194, 106, 53, 84, 168, 223, 150,
-			135, 149, 19, 129, 249, 194, 139, 63, 231, 101, 251, 46, 58, 231,
-			126, 228, 142, 220, 9, 124, 88, 18, 89, 180, 61, 98, 98, 67,
-			212, 91, 153, 91, 75, 89, 203, 239, 210, 22, 235, 84, 12, 22,
-			86, 171, 11, 171, 85, 2, 5, 84, 36, 239, 136, 182, 153, 29,
-			173, 51, 164, 62, 116, 237, 145, 112, 16, 222, 147, 125, 115, 91,
-			186, 154, 114, 179, 64, 30, 203, 177, 254, 69, 199, 87, 245, 124,
-			255, 154, 185, 64, 254, 185, 70, 146, 201, 132, 158, 48, 147, 255,
-			88, 211, 47, 101, 255, 169, 6, 205, 73, 217, 167, 76, 159, 247,
-			132, 197, 11, 21, 7, 204, 235, 78, 167, 30, 228, 253, 185, 7,
-			236, 143, 131, 16, 77, 225, 99, 181, 2, 121, 95, 177, 240, 12,
-			152, 215, 117, 199, 1, 59, 194, 234, 233, 28, 73, 161, 120, 41,
-			33, 223, 140, 2, 53, 4, 51, 159, 42, 208, 64, 208, 188, 72,
-			254, 38, 23, 163, 153, 201, 127, 162, 233, 102, 246, 191, 104, 80,
-			231, 222, 146, 71, 251, 178, 56, 60, 85, 98, 218, 170, 148, 194,
-			234, 234, 253, 37, 102, 61, 154, 24, 87, 93, 71, 182, 59, 166,
-			129, 108, 211, 77, 136, 137, 102, 98, 16, 50, 215, 133, 129, 125,
-			68, 193, 155, 230, 41, 72, 71, 19, 137, 44, 105, 100, 213, 218,
-			227, 62, 86, 139, 170, 164, 126, 91, 97, 81, 37, 85, 140, 126,
-			201, 123, 148, 162, 165, 196, 58, 149, 82, 52, 177, 236, 204, 57,
-			5, 26, 8, 206, 94, 136, 187, 250, 255, 231, 6, 185, 223, 231,
-			165, 238, 192, 231, 67, 54, 30, 10, 27, 117, 199, 93, 86, 182,
-			71, 35, 234, 245, 153, 71, 203, 67, 124, 244, 203, 234, 202, 36,
-			106, 245, 95, 139, 17, 74, 18, 161, 164, 16, 178, 63, 119, 77,
-			144, 251, 59, 131, 156, 105, 13, 108, 223, 177, 188, 30, 55, 47,
-			145, 20, 243, 28, 250, 74, 92, 10, 164, 154, 18, 48, 215, 72,
-			42, 8, 237, 144, 138, 43, 129, 243, 171, 80, 250, 32, 191, 82,
-			11, 241, 154, 18, 29, 169, 81, 223, 231, 254, 156, 1, 90, 254,
-			76, 83, 2, 230, 125, 50, 211, 245, 41, 158, 255, 115, 73, 208,
-			242, 103, 87, 179, 111, 95, 43, 148, 226, 32, 212, 84, 168, 56,
-			107, 60, 114, 196, 172, 212, 207, 207, 138, 80, 205, 34, 49, 104,
-			104, 207, 165, 127, 118, 6, 162, 153, 75, 196, 28, 249, 188, 43,
-			82, 143, 231, 212, 11, 89, 200, 104, 48, 55, 35, 238, 71, 46,
-			196, 35, 181, 104, 192, 92, 32, 231, 67, 30, 218, 238, 4, 53,
-			35, 80, 207, 137, 183, 49, 90, 158, 204, 42, 132, 231, 35, 234,
-			99, 246, 51, 119, 6, 180, 188, 222, 60, 175, 222, 31, 80, 191,
-			69, 187, 185, 127, 105, 144, 153, 199, 188, 35, 118, 226, 60, 209,
-			153, 19, 221, 205, 232, 204, 249, 205, 123, 48, 165, 109, 227, 55,
-			105, 251, 23, 236, 209, 91, 218, 254, 249, 253, 249, 136, 182, 211,
-			191, 92, 219, 51, 191, 84, 219, 153, 247, 105, 219, 252, 146, 164,
-			3, 52, 252, 96, 238, 18, 24, 249, 179, 171, 183, 62, 166, 82,
-			229, 33, 205, 104, 206, 157, 151, 36, 37, 244, 108, 94, 38, 23,
-			90, 237, 74, 187, 246, 252, 176, 46, 122, 189, 219, 86, 173, 58,
-			155, 48, 63, 33, 153, 86, 187, 210, 108, 91, 245, 157, 89, 205,
-			60, 75, 102, 154, 135, 245, 58, 2, 58, 14, 85, 54, 27, 114,
-			200, 192, 161, 214, 225, 214, 86, 173, 213, 154, 77, 154, 25, 146,
-			220, 174, 88, 123, 179, 41, 124, 45, 144, 106, 213, 217, 244, 230,
-			226, 179, 133, 95, 116, 48, 60, 254, 55, 151, 73, 218, 76, 158,
-			79, 84, 52, 242, 31, 147, 226, 150, 236, 124, 194, 92, 253, 247,
-			201, 83, 23, 94, 43, 15, 69, 30, 186, 119, 184, 101, 65, 101,
-			28, 14, 184, 47, 122, 45, 123, 172, 75, 61, 145, 116, 123, 78,
-			116, 135, 81, 25, 217, 93, 196, 148, 35, 69, 248, 158, 250, 1,
-			227, 30, 172, 150, 150, 33, 143, 8, 185, 104, 40, 135, 117, 230,
-			9, 31, 139, 235, 11, 143, 135, 34, 239, 148, 81, 13, 211, 117,
-			250, 74, 100, 134, 12, 179, 172, 225, 200, 101, 54, 70, 40, 117,
-			151, 162, 200, 151, 8, 60, 141, 40, 196, 97, 180, 203, 71, 39,
-			24, 11, 166, 208, 192, 14, 163, 162, 106, 58, 168, 219, 66, 82,
-			169, 25, 137, 23, 148, 247, 172, 173, 90, 189, 85, 91, 90, 45,
-			45, 19, 2, 135, 158, 75, 131, 73, 6, 45, 114, 207, 145, 184,
-			139, 198, 232, 236, 218, 199, 192, 125, 176, 251, 62, 149, 69, 2,
-			243, 196, 101, 9, 243, 250, 197, 248, 86, 101, 234, 214, 231, 148,
-			154, 148, 100, 44, 56, 133, 32, 238, 147, 226, 123, 145, 205, 74,
-			203, 106, 21, 9, 60, 177, 218, 187, 141, 195, 246, 169, 75, 13,
-			113, 31, 80, 181, 218, 86, 163, 46, 58, 246, 149, 250, 83, 248,
-			163, 85, 175, 22, 33, 186, 80, 138, 42, 34, 20, 145, 161, 2,
-			197, 149, 100, 139, 210, 83, 236, 123, 209, 237, 82, 124, 231, 227,
-			218, 94, 127, 108, 247, 41, 244, 249, 17, 245, 197, 245, 251, 228,
-			226, 71, 52, 117, 9, 184, 108, 200, 100, 63, 50, 120, 119, 69,
-			113, 119, 124, 54, 238, 79, 155, 137, 47, 85, 207, 59, 122, 52,
-			18, 166, 113, 41, 81, 32, 171, 68, 79, 37, 204, 228, 92, 2,
-			180, 236, 109, 16, 206, 33, 195, 248, 143, 188, 131, 130, 139, 206,
-			179, 184, 242, 2, 233, 67, 242, 114, 59, 133, 137, 206, 92, 138,
-			144, 179, 36, 153, 194, 60, 199, 184, 166, 207, 145, 79, 72, 42,
-			37, 210, 8, 227, 154, 126, 81, 65, 186, 105, 92, 187, 114, 53,
-			66, 212, 76, 35, 27, 35, 106, 2, 34, 10, 210, 77, 35, 27,
-			35, 234, 166, 113, 61, 70, 196, 105, 215, 245, 51, 10, 194, 177,
-			24, 209, 48, 141, 207, 98, 68, 67, 67, 72, 81, 52, 116, 211,
-			248, 44, 70, 76, 154, 198, 141, 24, 49, 169, 33, 164, 40, 38,
-			117, 211, 184, 17, 35, 166, 76, 99, 62, 70, 76, 105, 8, 165,
-			21, 164, 155, 198, 124, 140, 152, 54, 141, 155, 49, 98, 90, 67,
-			72, 81, 76, 235, 166, 113, 243, 202, 85, 242, 72, 92, 4, 36,
-			111, 37, 150, 181, 108, 25, 240, 44, 194, 116, 81, 92, 90, 118,
-			196, 77, 31, 151, 185, 95, 223, 165, 82, 191, 177, 242, 167, 62,
-			34, 184, 149, 185, 64, 138, 81, 62, 105, 44, 232, 23, 115, 55,
-			101, 113, 220, 177, 209, 241, 69, 180, 87, 238, 38, 104, 136, 245,
-			203, 244, 206, 88, 208, 103, 20, 164, 153, 198, 66, 230, 156, 130,
-			12, 211, 88, 184, 96, 226, 74, 68, 106, 103, 220, 150, 59, 134,
-			64, 26, 33, 53, 13, 55, 233, 118, 60, 77, 51, 76, 227, 246,
-			5, 147, 108, 137, 105, 186, 105, 44, 234, 23, 115, 107, 48, 24,
-			15, 197, 231, 35, 182, 35, 252, 82, 164, 12, 234, 19, 145, 162,
-			48, 241, 158, 205, 92, 234, 68, 54, 4, 220, 115, 79, 148, 148,
-			168, 239, 69, 61, 163, 32, 205, 52, 22, 207, 156, 87, 144, 97,
-			26, 139, 23, 76, 146, 19, 236, 12, 211, 40, 232, 249, 220, 101,
-			89, 0, 179, 16, 142, 237, 0, 162, 224, 167, 168, 25, 105, 68,
-			186, 174, 32, 205, 52, 10, 159, 125, 174, 32, 36, 112, 123, 49,
-			210, 101, 210, 52, 238, 232, 249, 220, 205, 83, 212, 162, 160, 8,
-			174, 29, 200, 174, 144, 162, 155, 76, 35, 186, 162, 139, 230, 115,
-			39, 166, 155, 52, 76, 227, 206, 237, 69, 178, 38, 232, 166, 76,
-			227, 174, 158, 87, 101, 58, 11, 161, 199, 60, 22, 12, 176, 140,
-			102, 61, 8, 125, 187, 251, 82, 248, 180, 207, 251, 120, 58, 20,
-			20, 135, 84, 26, 39, 42, 14, 104, 119, 119, 63, 187, 169, 32,
-			195, 52, 238, 222, 94, 36, 247, 5, 135, 180, 105, 44, 233, 55,
-			115, 139, 224, 141, 135, 29, 234, 227, 222, 199, 145, 23, 84, 188,
-			132, 112, 48, 14, 160, 103, 251, 138, 126, 58, 133, 211, 212, 182,
-			162, 185, 46, 101, 178, 10, 50, 76, 99, 233, 198, 60, 121, 40,
-			232, 207, 152, 70, 73, 191, 153, 187, 11, 34, 72, 79, 113, 137,
-			105, 115, 31, 150, 86, 128, 245, 96, 236, 189, 244, 248, 177, 167,
-			120, 204, 164, 112, 170, 226, 49, 163, 153, 70, 41, 115, 69, 65,
-			134, 105, 148, 110, 204, 71, 90, 202, 152, 70, 89, 191, 153, 43,
-			128, 31, 157, 56, 209, 10, 196, 209, 29, 243, 25, 81, 63, 42,
-			18, 20, 135, 76, 10, 39, 42, 14, 25, 205, 52, 202, 153, 57,
-			5, 25, 166, 81, 190, 49, 79, 110, 19, 220, 160, 228, 253, 68,
-			69, 203, 102, 223, 227, 116, 211, 254, 133, 230, 125, 63, 243, 41,
-			185, 69, 146, 73, 13, 253, 235, 129, 110, 230, 174, 194, 216, 99,
-			127, 25, 83, 113, 8, 50, 7, 197, 233, 49, 26, 105, 82, 19,
-			126, 245, 32, 146, 65, 19, 126, 245, 32, 19, 143, 25, 166, 241,
-			96, 246, 2, 89, 20, 244, 52, 211, 88, 211, 205, 92, 22, 240,
-			40, 183, 93, 23, 2, 117, 192, 162, 179, 254, 200, 59, 106, 26,
-			250, 220, 90, 76, 18, 133, 90, 139, 124, 78, 19, 62, 183, 54,
-			123, 65, 56, 129, 134, 62, 247, 240, 227, 78, 160, 233, 104, 33,
-			15, 35, 83, 210, 132, 75, 61, 140, 140, 85, 19, 46, 245, 48,
-			114, 2, 13, 129, 245, 95, 234, 4, 154, 112, 174, 245, 152, 46,
-			58, 215, 122, 76, 23, 157, 107, 61, 114, 2, 13, 157, 107, 227,
-			215, 59, 129, 38, 220, 108, 35, 230, 128, 110, 182, 17, 57, 129,
-			38, 220, 108, 35, 114, 2, 13, 221, 236, 203, 95, 235, 4, 154,
-			158, 18, 211, 148, 158, 209, 201, 190, 140, 156, 64, 19, 78, 246,
-			101, 228, 4, 26, 170, 240, 171, 223, 226, 4, 154, 112, 180, 175,
-			98, 30, 232, 104, 95, 69, 78, 160, 9, 71, 251, 42, 114, 2,
-			13, 29, 237, 235, 95, 239, 4, 154, 112, 179, 175, 99, 14, 232,
-			102, 95, 71, 78, 160, 9, 55, 251, 250, 198, 60, 201, 11, 14,
-			25, 211, 248, 86, 255, 67, 238, 250, 196, 240, 208, 10, 127, 228,
-			157, 69, 21, 207, 21, 205, 76, 18, 81, 99, 40, 109, 26, 223,
-			158, 189, 164, 32, 205, 52, 190, 189, 172, 118, 5, 221, 236, 219,
-			121, 136, 43, 224, 127, 118, 131, 172, 190, 55, 209, 237, 178, 145,
-			83, 182, 71, 172, 108, 59, 67, 230, 149, 143, 86, 228, 67, 84,
-			255, 38, 113, 56, 251, 177, 63, 38, 200, 254, 166, 194, 58, 231,
-			144, 51, 143, 121, 103, 139, 123, 61, 214, 55, 111, 145, 228, 75,
-			230, 201, 154, 236, 252, 234, 108, 9, 153, 150, 246, 197, 188, 63,
-			50, 207, 105, 138, 81, 115, 142, 204, 116, 249, 112, 72, 189, 80,
-			84, 106, 103, 154, 10, 52, 175, 146, 25, 199, 63, 121, 238, 143,
-			61, 81, 137, 101, 154, 105, 199, 63, 105, 142, 189, 220, 60, 73,
-			97, 213, 87, 53, 47, 147, 244, 143, 188, 243, 60, 174, 251, 82,
-			63, 242, 142, 229, 228, 94, 146, 204, 99, 222, 145, 213, 198, 34,
-			73, 119, 133, 56, 2, 229, 236, 234, 167, 82, 140, 88, 202, 102,
-			52, 108, 174, 145, 36, 243, 122, 92, 8, 113, 118, 53, 247, 145,
-			218, 38, 170, 56, 155, 2, 63, 247, 175, 53, 242, 73, 219, 238,
-			111, 179, 87, 77, 58, 226, 126, 104, 22, 73, 170, 199, 94, 81,
-			148, 9, 171, 164, 43, 146, 225, 52, 10, 2, 77, 137, 148, 229,
-			196, 104, 219, 125, 115, 150, 24, 163, 151, 82, 198, 51, 77, 124,
-			52, 179, 36, 163, 190, 187, 140, 20, 19, 195, 230, 13, 66, 58,
-			62, 127, 73, 189, 231, 161, 221, 143, 154, 5, 103, 228, 27, 36,
-			118, 157, 156, 17, 196, 197, 104, 82, 206, 21, 47, 218, 118, 255,
-			206, 95, 8, 153, 236, 129, 121, 157, 92, 221, 175, 28, 28, 212,
-			154, 207, 49, 125, 126, 171, 36, 187, 66, 204, 90, 253, 112, 191,
-			214, 196, 106, 237, 160, 178, 245, 199, 202, 78, 173, 53, 171, 153,
-			87, 201, 197, 109, 196, 222, 175, 236, 109, 55, 154, 251, 181, 234,
-			243, 118, 101, 167, 53, 171, 99, 105, 87, 251, 211, 65, 163, 217,
-			22, 47, 158, 183, 27, 207, 55, 191, 155, 53, 86, 255, 94, 35,
-			169, 10, 154, 159, 89, 32, 103, 246, 236, 177, 215, 29, 60, 230,
-			29, 243, 237, 173, 200, 158, 141, 95, 88, 85, 179, 76, 50, 149,
-			14, 247, 67, 196, 156, 30, 200, 94, 121, 167, 78, 22, 31, 133,
-			154, 119, 200, 217, 29, 26, 198, 27, 127, 106, 206, 249, 24, 144,
-			131, 203, 228, 220, 54, 123, 181, 111, 251, 47, 133, 82, 130, 211,
-			216, 230, 187, 91, 182, 89, 124, 118, 231, 151, 57, 218, 35, 123,
-			196, 30, 255, 175, 168, 172, 60, 252, 189, 172, 252, 189, 172, 252,
-			255, 90, 86, 158, 139, 203, 202, 202, 164, 172, 172, 76, 202, 202,
-			5, 241, 168, 153, 198, 229, 196, 215, 228, 63, 104, 68, 79, 39,
-			204, 228, 103, 137, 219, 90, 246, 223, 106, 32, 156, 16, 53, 35,
-			59, 185, 149, 3, 75, 126, 55, 217, 57, 129, 45, 235, 160, 26,
-			127, 216, 109, 79, 240, 184, 31, 76, 62, 197, 241, 48, 26, 83,
-			42, 42, 81, 168, 108, 237, 201, 45, 58, 141, 29, 181, 125, 209,
-			248, 212, 103, 153, 157, 19, 160, 158, 3, 93, 151, 81, 47, 12,
-			196, 165, 158, 79, 23, 3, 240, 120, 252, 137, 17, 17, 22, 105,
-			135, 172, 195, 92, 22, 158, 136, 111, 55, 89, 64, 163, 146, 55,
-			141, 137, 222, 103, 153, 115, 164, 77, 146, 105, 81, 138, 205, 235,
-			119, 179, 59, 32, 207, 18, 26, 128, 13, 67, 121, 223, 43, 210,
-			70, 209, 147, 166, 175, 236, 33, 243, 104, 160, 62, 81, 197, 3,
-			80, 126, 224, 28, 132, 220, 167, 113, 140, 47, 137, 184, 155, 150,
-			69, 218, 124, 250, 83, 5, 97, 185, 57, 123, 77, 65, 134, 105,
-			204, 223, 42, 144, 123, 130, 191, 102, 26, 160, 175, 101, 111, 131,
-			229, 177, 144, 137, 235, 72, 91, 36, 184, 190, 252, 118, 108, 90,
-			152, 152, 60, 38, 150, 144, 62, 175, 32, 221, 52, 224, 211, 75,
-			10, 50, 76, 3, 110, 222, 39, 37, 65, 94, 55, 141, 156, 94,
-			204, 254, 1, 154, 209, 55, 243, 147, 4, 226, 189, 148, 81, 158,
-			92, 250, 130, 130, 112, 186, 121, 85, 65, 134, 105, 228, 114, 119,
-			34, 197, 97, 225, 169, 175, 102, 119, 96, 91, 40, 35, 47, 79,
-			34, 230, 5, 204, 145, 38, 60, 176, 61, 199, 165, 126, 1, 66,
-			187, 31, 192, 80, 156, 143, 184, 125, 56, 214, 103, 71, 212, 3,
-			25, 12, 79, 241, 71, 107, 91, 72, 95, 84, 144, 110, 26, 11,
-			151, 148, 226, 48, 53, 93, 184, 181, 76, 22, 100, 175, 227, 78,
-			98, 85, 203, 94, 131, 214, 120, 132, 135, 42, 117, 166, 215, 51,
-			221, 222, 184, 147, 250, 116, 210, 222, 184, 171, 207, 79, 181, 55,
-			238, 234, 215, 166, 218, 27, 119, 63, 187, 65, 190, 87, 237, 141,
-			37, 253, 90, 214, 130, 234, 120, 56, 154, 124, 48, 140, 57, 215,
-			200, 238, 190, 20, 127, 48, 17, 114, 216, 169, 212, 192, 229, 253,
-			160, 40, 174, 241, 104, 16, 158, 18, 1, 122, 190, 61, 164, 199,
-			220, 127, 89, 34, 83, 157, 146, 37, 253, 210, 84, 167, 100, 233,
-			234, 28, 217, 83, 157, 146, 178, 158, 205, 126, 3, 219, 76, 124,
-			193, 214, 15, 164, 237, 57, 220, 91, 12, 97, 100, 7, 1, 124,
-			111, 187, 12, 83, 122, 43, 138, 221, 109, 187, 47, 238, 121, 81,
-			183, 226, 22, 123, 24, 115, 194, 37, 148, 245, 203, 83, 173, 150,
-			242, 220, 53, 178, 173, 90, 45, 43, 250, 92, 118, 29, 106, 175,
-			80, 115, 129, 88, 151, 96, 200, 60, 116, 65, 216, 100, 253, 239,
-			198, 212, 63, 137, 190, 163, 91, 164, 175, 164, 134, 49, 7, 8,
-			22, 99, 30, 184, 87, 43, 113, 131, 8, 247, 106, 229, 202, 85,
-			114, 79, 182, 74, 214, 18, 27, 90, 118, 17, 170, 180, 39, 28,
-			231, 24, 151, 114, 218, 179, 162, 75, 108, 135, 79, 181, 72, 214,
-			50, 23, 162, 86, 70, 194, 52, 190, 208, 85, 205, 153, 72, 35,
-			244, 201, 84, 7, 228, 139, 115, 23, 166, 58, 32, 95, 92, 186,
-			140, 246, 46, 59, 32, 15, 245, 203, 185, 63, 128, 237, 119, 88,
-			232, 219, 254, 201, 219, 77, 13, 249, 61, 134, 170, 67, 181, 20,
-			78, 200, 76, 53, 73, 30, 158, 153, 157, 106, 146, 60, 188, 120,
-			41, 18, 72, 199, 154, 233, 226, 84, 179, 99, 93, 182, 147, 162,
-			102, 199, 250, 204, 116, 179, 99, 253, 130, 73, 214, 100, 249, 250,
-			117, 226, 91, 45, 123, 7, 44, 85, 126, 10, 255, 86, 217, 215,
-			251, 28, 81, 149, 179, 95, 103, 206, 9, 214, 162, 156, 253, 38,
-			98, 45, 171, 214, 111, 78, 85, 173, 223, 100, 206, 79, 85, 173,
-			223, 92, 48, 201, 29, 162, 39, 117, 51, 89, 77, 88, 90, 118,
-			30, 170, 52, 180, 153, 27, 196, 85, 243, 187, 236, 112, 1, 213,
-			204, 44, 217, 39, 201, 164, 142, 236, 182, 245, 171, 217, 111, 161,
-			225, 179, 62, 195, 115, 26, 183, 75, 166, 182, 69, 60, 11, 187,
-			161, 123, 2, 118, 160, 234, 204, 96, 220, 137, 254, 42, 34, 228,
-			16, 167, 100, 210, 76, 144, 92, 26, 233, 157, 85, 144, 102, 26,
-			219, 159, 152, 10, 50, 76, 99, 251, 242, 21, 242, 72, 48, 214,
-			76, 99, 87, 95, 202, 150, 32, 250, 14, 231, 157, 50, 91, 126,
-			17, 225, 186, 167, 251, 152, 146, 20, 150, 222, 187, 250, 231, 10,
-			66, 90, 183, 242, 10, 50, 76, 99, 247, 110, 145, 148, 8, 86,
-			159, 201, 253, 196, 161, 150, 205, 65, 147, 6, 99, 87, 156, 178,
-			254, 216, 19, 145, 244, 84, 30, 23, 233, 6, 173, 124, 63, 131,
-			62, 155, 76, 26, 70, 194, 76, 214, 245, 150, 33, 232, 26, 6,
-			46, 166, 78, 206, 145, 115, 36, 141, 16, 106, 174, 145, 188, 72,
-			206, 147, 25, 9, 166, 16, 38, 19, 88, 51, 141, 198, 217, 243,
-			19, 216, 48, 141, 198, 5, 51, 158, 174, 153, 198, 65, 114, 46,
-			30, 70, 219, 60, 152, 154, 142, 107, 58, 56, 59, 33, 143, 171,
-			58, 184, 114, 53, 158, 174, 155, 198, 119, 201, 108, 60, 140, 54,
-			250, 221, 212, 116, 36, 255, 221, 217, 203, 19, 216, 48, 141, 239,
-			230, 174, 145, 124, 52, 221, 48, 141, 102, 242, 90, 238, 26, 38,
-			36, 185, 28, 22, 196, 209, 254, 58, 212, 165, 162, 43, 161, 102,
-			26, 41, 68, 157, 130, 53, 211, 104, 158, 189, 52, 129, 145, 212,
-			213, 57, 97, 189, 6, 42, 165, 45, 207, 89, 4, 146, 8, 17,
-			5, 165, 77, 163, 125, 246, 188, 130, 52, 211, 104, 203, 56, 134,
-			144, 97, 26, 237, 171, 115, 170, 60, 253, 191, 1, 0, 0, 255,
-			255, 17, 181, 187, 28, 178, 62, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 89, 205, 115, 27, 199,
+			114, 199, 204, 44, 64, 112, 40, 81, 228, 136, 31, 32, 36, 146,
+			109, 60, 241, 83, 20, 160, 71, 203, 44, 73, 150, 101, 129, 36,
+			200, 7, 153, 34, 105, 0, 178, 44, 199, 41, 214, 2, 59, 0,
+			70, 4, 118, 241, 118, 23, 162, 152, 67, 238, 239, 152, 67, 234,
+			221, 147, 83, 82, 149, 91, 82, 169, 84, 110, 169, 252, 1, 249,
+			111, 146, 170, 28, 146, 234, 217, 157, 37, 41, 203, 95, 74, 142,
+			143, 39, 252, 118, 122, 186, 103, 250, 107, 186, 155, 252, 159, 9,
+			191, 213, 241, 188, 78, 79, 150, 6, 190, 23, 122, 205, 97, 187,
+			36, 251, 131, 240, 188, 168, 161, 184, 17, 45, 22, 205, 98, 97,
+			132, 167, 43, 184, 190, 253, 150, 223, 108, 121, 253, 226, 123, 235,
+			219, 92, 175, 30, 35, 60, 38, 223, 173, 116, 84, 216, 29, 54,
+			139, 45, 175, 95, 234, 120, 61, 219, 237, 92, 136, 25, 132, 231,
+			3, 25, 68, 210, 254, 139, 144, 191, 161, 108, 255, 120, 251, 239,
+			233, 194, 126, 196, 241, 56, 230, 88, 124, 37, 123, 189, 175, 92,
+			239, 204, 109, 32, 125, 51, 163, 25, 124, 202, 255, 147, 240, 197,
+			247, 79, 30, 170, 190, 12, 66, 187, 63, 248, 177, 211, 127, 206,
+			71, 27, 134, 70, 228, 248, 72, 32, 91, 158, 235, 4, 57, 2,
+			100, 149, 213, 12, 20, 83, 60, 237, 218, 174, 23, 228, 40, 144,
+			213, 116, 45, 2, 219, 127, 249, 225, 27, 143, 39, 28, 205, 173,
+			239, 254, 252, 173, 147, 147, 126, 196, 205, 255, 103, 158, 63, 232,
+			120, 197, 86, 215, 247, 250, 106, 216, 47, 122, 126, 167, 212, 27,
+			182, 84, 201, 30, 12, 164, 219, 81, 174, 44, 245, 241, 167, 95,
+			234, 203, 32, 176, 59, 50, 136, 213, 49, 151, 16, 20, 35, 130,
+			162, 33, 200, 255, 156, 42, 11, 127, 96, 124, 180, 222, 181, 125,
+			167, 234, 182, 61, 84, 144, 114, 29, 249, 78, 43, 46, 93, 139,
+			128, 216, 226, 233, 32, 180, 67, 169, 213, 54, 190, 9, 197, 31,
+			149, 87, 172, 35, 93, 45, 34, 71, 110, 210, 247, 61, 63, 199,
+			128, 172, 142, 214, 34, 32, 30, 240, 145, 150, 47, 237, 80, 58,
+			57, 11, 200, 234, 216, 102, 254, 125, 213, 23, 19, 205, 215, 12,
+			41, 238, 26, 14, 28, 252, 153, 75, 255, 252, 174, 152, 84, 108,
+			112, 38, 67, 59, 151, 249, 217, 29, 72, 38, 238, 113, 49, 240,
+			189, 150, 12, 2, 233, 156, 72, 55, 84, 161, 146, 65, 110, 68,
+			251, 208, 100, 178, 82, 137, 23, 196, 18, 31, 15, 189, 208, 238,
+			93, 144, 102, 53, 233, 117, 253, 53, 33, 91, 229, 19, 134, 224,
+			100, 32, 253, 147, 64, 182, 114, 163, 64, 86, 105, 109, 220, 124,
+			63, 150, 126, 93, 182, 10, 127, 199, 248, 200, 115, 175, 169, 45,
+			49, 206, 169, 114, 98, 255, 165, 202, 249, 104, 27, 92, 210, 54,
+			251, 40, 109, 91, 191, 90, 219, 233, 255, 139, 182, 51, 191, 92,
+			219, 35, 191, 84, 219, 217, 15, 105, 91, 60, 225, 153, 0, 29,
+			63, 200, 77, 1, 91, 29, 219, 188, 243, 83, 42, 53, 17, 82,
+			139, 247, 172, 159, 242, 180, 214, 179, 152, 230, 147, 245, 70, 185,
+			81, 57, 121, 121, 88, 63, 174, 236, 84, 247, 170, 149, 221, 137,
+			148, 184, 198, 179, 245, 70, 185, 214, 168, 30, 238, 79, 16, 49,
+			198, 71, 106, 47, 15, 15, 17, 80, 92, 42, 111, 31, 69, 75,
+			12, 151, 234, 47, 119, 118, 42, 245, 250, 132, 37, 178, 220, 218,
+			43, 87, 15, 38, 210, 248, 89, 19, 85, 118, 39, 50, 219, 43,
+			223, 45, 253, 162, 196, 240, 252, 31, 167, 121, 70, 88, 227, 169,
+			50, 225, 255, 110, 113, 114, 77, 176, 241, 148, 216, 252, 23, 11,
+			118, 188, 193, 185, 175, 58, 221, 16, 54, 239, 255, 246, 33, 52,
+			186, 18, 14, 94, 238, 84, 161, 60, 12, 187, 158, 31, 20, 57,
+			135, 3, 213, 146, 110, 32, 29, 24, 186, 142, 244, 33, 236, 74,
+			40, 15, 236, 22, 82, 70, 43, 27, 240, 141, 244, 3, 229, 185,
+			176, 89, 188, 15, 171, 72, 80, 136, 151, 10, 107, 159, 115, 56,
+			247, 134, 208, 183, 207, 193, 245, 66, 24, 6, 18, 194, 174, 10,
+			160, 173, 122, 18, 228, 187, 150, 28, 132, 160, 92, 104, 121, 253,
+			65, 79, 217, 110, 75, 194, 153, 10, 187, 16, 94, 176, 47, 114,
+			120, 29, 115, 240, 154, 161, 173, 92, 176, 161, 229, 13, 206, 193,
+			107, 95, 38, 3, 59, 228, 28, 244, 95, 55, 12, 7, 143, 75,
+			165, 179, 179, 179, 162, 173, 79, 26, 165, 204, 136, 46, 40, 29,
+			84, 119, 42, 135, 245, 202, 189, 205, 226, 125, 206, 225, 165, 219,
+			147, 65, 0, 190, 252, 253, 80, 249, 210, 129, 230, 57, 216, 131,
+			65, 79, 181, 236, 102, 79, 66, 207, 62, 3, 207, 7, 187, 227,
+			75, 233, 64, 232, 225, 89, 207, 124, 21, 42, 183, 179, 1, 129,
+			215, 14, 207, 108, 95, 114, 112, 84, 16, 250, 170, 57, 12, 175,
+			168, 201, 156, 76, 5, 87, 8, 60, 23, 108, 23, 10, 229, 58,
+			84, 235, 5, 216, 46, 215, 171, 245, 13, 14, 175, 170, 141, 223,
+			29, 189, 108, 192, 171, 114, 173, 86, 62, 108, 84, 43, 117, 56,
+			170, 193, 206, 209, 225, 110, 181, 81, 61, 58, 172, 195, 209, 30,
+			148, 15, 95, 195, 87, 213, 195, 221, 13, 144, 42, 236, 74, 31,
+			228, 187, 129, 143, 167, 247, 124, 80, 168, 64, 233, 20, 57, 212,
+			165, 188, 34, 190, 237, 69, 86, 11, 6, 178, 165, 218, 170, 5,
+			248, 46, 15, 237, 142, 132, 142, 247, 86, 250, 174, 114, 59, 48,
+			144, 126, 95, 5, 104, 196, 0, 108, 215, 225, 208, 83, 125, 21,
+			218, 161, 254, 240, 131, 27, 21, 57, 207, 114, 66, 5, 155, 72,
+			21, 240, 87, 86, 48, 145, 122, 194, 71, 57, 205, 142, 37, 63,
+			89, 74, 176, 169, 212, 26, 223, 228, 52, 157, 18, 86, 46, 5,
+			36, 191, 12, 58, 56, 208, 116, 54, 188, 241, 154, 224, 249, 224,
+			185, 26, 171, 48, 128, 40, 134, 138, 156, 115, 206, 210, 41, 34,
+			88, 46, 205, 249, 24, 183, 210, 41, 154, 18, 108, 142, 230, 248,
+			53, 158, 70, 64, 16, 221, 52, 136, 10, 54, 55, 51, 27, 19,
+			18, 193, 242, 9, 33, 209, 136, 27, 68, 5, 203, 39, 132, 84,
+			176, 91, 9, 33, 37, 136, 70, 13, 194, 181, 132, 144, 9, 118,
+			59, 33, 100, 4, 145, 225, 200, 168, 96, 183, 19, 66, 75, 176,
+			249, 132, 208, 34, 136, 12, 71, 139, 10, 54, 159, 16, 166, 5,
+			91, 72, 8, 211, 4, 81, 198, 32, 42, 216, 66, 66, 152, 17,
+			108, 49, 33, 204, 16, 68, 134, 99, 134, 10, 182, 56, 51, 203,
+			63, 231, 212, 74, 9, 235, 78, 234, 62, 201, 151, 0, 115, 145,
+			223, 215, 118, 3, 187, 233, 13, 67, 8, 188, 190, 132, 64, 185,
+			157, 158, 140, 244, 155, 40, 63, 210, 179, 133, 122, 190, 147, 157,
+			228, 27, 220, 178, 180, 158, 151, 232, 205, 194, 34, 252, 133, 244,
+			189, 123, 77, 27, 3, 95, 191, 246, 38, 220, 52, 15, 125, 127,
+			164, 78, 11, 182, 68, 71, 12, 34, 130, 45, 101, 175, 27, 196,
+			4, 91, 154, 20, 120, 19, 43, 133, 250, 93, 166, 55, 227, 37,
+			146, 65, 100, 182, 161, 145, 150, 147, 109, 132, 9, 182, 60, 41,
+			248, 142, 222, 70, 5, 91, 161, 55, 11, 91, 208, 29, 246, 109,
+			23, 124, 105, 59, 58, 46, 117, 201, 0, 113, 30, 222, 128, 182,
+			231, 67, 219, 86, 61, 233, 196, 62, 4, 158, 219, 59, 55, 167,
+			164, 105, 228, 146, 53, 136, 8, 182, 50, 58, 110, 16, 19, 108,
+			101, 82, 240, 130, 22, 199, 4, 91, 163, 171, 133, 105, 56, 235,
+			74, 23, 84, 8, 103, 118, 0, 241, 227, 103, 184, 177, 12, 18,
+			221, 50, 136, 8, 182, 118, 251, 55, 6, 33, 131, 229, 149, 88,
+			151, 150, 96, 235, 116, 181, 176, 120, 133, 91, 252, 40, 66, 207,
+			14, 66, 192, 178, 208, 240, 181, 50, 72, 110, 248, 162, 251, 172,
+			39, 124, 45, 38, 216, 250, 242, 10, 223, 210, 124, 211, 130, 221,
+			165, 171, 133, 181, 132, 111, 91, 185, 42, 232, 202, 0, 86, 85,
+			27, 66, 223, 110, 157, 234, 152, 246, 189, 14, 102, 135, 53, 35,
+			33, 157, 193, 141, 70, 2, 250, 221, 221, 219, 139, 6, 49, 193,
+			238, 46, 175, 240, 7, 90, 66, 70, 176, 123, 116, 177, 176, 2,
+			238, 176, 223, 148, 62, 218, 62, 121, 121, 193, 188, 151, 16, 118,
+			135, 1, 180, 109, 223, 240, 207, 164, 113, 155, 49, 43, 186, 235,
+			189, 108, 222, 32, 38, 216, 189, 249, 5, 254, 80, 243, 31, 17,
+			172, 72, 23, 11, 119, 65, 63, 221, 151, 164, 36, 188, 61, 31,
+			238, 253, 22, 84, 27, 134, 238, 41, 54, 3, 70, 198, 72, 26,
+			183, 26, 25, 35, 68, 176, 98, 118, 198, 32, 38, 88, 113, 126,
+			33, 214, 82, 86, 176, 18, 93, 44, 172, 129, 31, 103, 156, 248,
+			6, 58, 117, 39, 114, 6, 210, 135, 168, 13, 48, 18, 178, 105,
+			220, 104, 36, 100, 137, 96, 165, 108, 46, 150, 144, 101, 130, 149,
+			230, 23, 248, 50, 167, 22, 17, 214, 131, 84, 153, 228, 243, 31,
+			8, 186, 203, 241, 133, 238, 253, 32, 123, 131, 223, 225, 150, 69,
+			48, 190, 62, 163, 162, 48, 11, 67, 87, 253, 126, 40, 145, 14,
+			148, 131, 199, 105, 43, 25, 107, 146, 232, 184, 250, 44, 62, 3,
+			209, 113, 245, 89, 54, 89, 99, 130, 125, 54, 49, 201, 87, 52,
+			63, 34, 216, 22, 21, 133, 60, 96, 42, 183, 123, 61, 8, 76,
+			130, 197, 96, 125, 227, 53, 205, 54, 140, 185, 173, 132, 37, 30,
+			106, 43, 142, 57, 162, 99, 110, 107, 98, 82, 7, 1, 161, 84,
+			176, 135, 63, 29, 4, 132, 210, 12, 18, 221, 50, 136, 8, 246,
+			48, 118, 86, 162, 67, 234, 97, 28, 4, 4, 193, 163, 95, 26,
+			4, 68, 7, 215, 163, 132, 47, 38, 219, 71, 9, 95, 134, 172,
+			226, 32, 32, 212, 18, 236, 241, 175, 15, 2, 162, 195, 236, 113,
+			34, 1, 195, 236, 113, 28, 4, 68, 135, 217, 227, 56, 8, 8,
+			102, 141, 39, 191, 54, 8, 8, 77, 235, 109, 70, 207, 24, 100,
+			79, 226, 32, 32, 58, 200, 158, 196, 65, 64, 80, 133, 95, 124,
+			76, 16, 16, 29, 104, 95, 36, 182, 196, 64, 251, 34, 14, 2,
+			162, 3, 237, 139, 56, 8, 8, 29, 17, 236, 233, 175, 15, 2,
+			162, 195, 236, 105, 34, 1, 195, 236, 105, 28, 4, 68, 135, 217,
+			211, 249, 5, 190, 170, 37, 100, 5, 123, 70, 63, 41, 220, 186,
+			112, 60, 244, 194, 55, 94, 115, 197, 188, 231, 134, 103, 214, 66,
+			210, 4, 101, 4, 123, 54, 54, 101, 16, 17, 236, 217, 180, 177,
+			10, 134, 217, 179, 5, 72, 58, 224, 255, 88, 230, 155, 31, 44,
+			116, 91, 106, 224, 148, 236, 129, 42, 217, 78, 95, 185, 165, 183,
+			191, 141, 126, 196, 253, 175, 133, 203, 249, 159, 154, 119, 228, 63,
+			170, 177, 46, 56, 124, 244, 185, 215, 220, 241, 220, 182, 234, 136,
+			59, 220, 58, 85, 110, 212, 147, 141, 111, 78, 20, 81, 104, 241,
+			5, 178, 240, 191, 82, 174, 83, 211, 171, 56, 124, 104, 121, 253,
+			190, 116, 67, 221, 45, 143, 214, 12, 20, 179, 124, 196, 241, 207,
+			79, 252, 161, 171, 59, 177, 108, 45, 227, 248, 231, 181, 161, 91,
+			88, 224, 105, 236, 250, 118, 197, 52, 207, 188, 241, 154, 39, 73,
+			223, 151, 126, 227, 53, 171, 78, 225, 148, 103, 159, 123, 77, 93,
+			80, 137, 21, 158, 105, 233, 227, 104, 146, 177, 205, 27, 209, 49,
+			146, 83, 214, 226, 101, 177, 197, 45, 229, 182, 61, 125, 136, 177,
+			205, 194, 79, 244, 54, 113, 199, 89, 211, 244, 133, 127, 32, 252,
+			90, 195, 238, 236, 169, 119, 53, 57, 240, 252, 80, 108, 240, 116,
+			91, 189, 147, 120, 38, 236, 146, 102, 34, 129, 151, 73, 138, 13,
+			187, 83, 139, 136, 242, 30, 103, 13, 187, 35, 38, 56, 27, 156,
+			70, 103, 28, 173, 225, 79, 145, 231, 89, 229, 6, 33, 86, 254,
+			177, 98, 18, 44, 230, 57, 111, 250, 222, 169, 116, 79, 66, 187,
+			19, 15, 11, 70, 163, 47, 200, 236, 22, 31, 213, 204, 245, 170,
+			165, 87, 179, 250, 67, 195, 238, 20, 254, 149, 240, 27, 53, 25,
+			98, 98, 245, 220, 248, 200, 79, 248, 168, 225, 29, 196, 199, 94,
+			136, 142, 253, 30, 101, 177, 26, 147, 213, 46, 54, 228, 223, 240,
+			172, 249, 252, 43, 239, 49, 195, 51, 190, 180, 3, 207, 141, 239,
+			16, 35, 244, 9, 71, 246, 164, 233, 166, 179, 53, 3, 215, 255,
+			138, 112, 126, 225, 66, 226, 22, 159, 125, 81, 62, 62, 174, 212,
+			78, 176, 250, 127, 175, 163, 156, 225, 162, 114, 248, 242, 69, 165,
+			86, 110, 84, 78, 142, 203, 59, 95, 149, 247, 43, 245, 9, 34,
+			102, 249, 205, 61, 164, 126, 81, 62, 216, 59, 170, 189, 168, 236,
+			158, 52, 202, 251, 245, 9, 138, 157, 105, 229, 219, 227, 163, 90,
+			67, 127, 56, 105, 28, 157, 108, 127, 61, 193, 196, 2, 207, 87,
+			14, 247, 142, 106, 59, 149, 147, 90, 165, 81, 57, 196, 174, 227,
+			228, 248, 232, 160, 186, 83, 173, 212, 39, 172, 205, 255, 38, 60,
+			93, 198, 232, 18, 107, 124, 244, 192, 30, 186, 173, 238, 115, 175,
+			41, 222, 247, 180, 252, 88, 242, 161, 186, 43, 74, 60, 91, 110,
+			122, 126, 136, 148, 151, 23, 242, 51, 63, 24, 3, 232, 65, 162,
+			88, 231, 99, 251, 50, 76, 252, 250, 202, 158, 241, 4, 68, 139,
+			247, 249, 245, 61, 245, 238, 133, 237, 159, 74, 167, 97, 119, 130,
+			171, 212, 226, 135, 30, 41, 30, 114, 177, 47, 195, 247, 253, 226,
+			202, 182, 233, 15, 122, 196, 246, 198, 119, 235, 191, 44, 3, 125,
+			110, 15, 212, 243, 63, 206, 71, 253, 182, 250, 83, 191, 253, 167,
+			126, 251, 255, 181, 223, 190, 158, 244, 219, 229, 139, 126, 187, 124,
+			209, 111, 47, 233, 159, 68, 176, 233, 212, 83, 254, 111, 132, 211,
+			76, 74, 88, 183, 83, 119, 73, 254, 159, 8, 232, 240, 69, 213,
+			217, 161, 122, 43, 161, 124, 92, 197, 129, 140, 182, 193, 78, 245,
+			120, 23, 2, 233, 191, 85, 45, 9, 246, 5, 157, 231, 7, 186,
+			187, 82, 110, 40, 125, 23, 203, 20, 41, 117, 139, 14, 229, 157,
+			131, 200, 68, 87, 169, 139, 240, 98, 24, 132, 122, 216, 211, 148,
+			9, 123, 233, 58, 208, 234, 41, 233, 134, 65, 17, 189, 222, 151,
+			43, 1, 184, 30, 52, 237, 214, 233, 25, 54, 148, 122, 2, 100,
+			135, 170, 169, 122, 42, 60, 199, 50, 165, 175, 2, 25, 207, 2,
+			50, 88, 1, 223, 206, 94, 231, 13, 110, 101, 116, 143, 186, 64,
+			239, 230, 247, 33, 202, 66, 50, 0, 27, 240, 161, 70, 197, 190,
+			241, 154, 16, 118, 237, 16, 228, 59, 187, 175, 92, 92, 115, 157,
+			18, 182, 135, 234, 157, 12, 192, 177, 67, 59, 8, 61, 95, 38,
+			197, 79, 81, 23, 36, 200, 21, 251, 240, 204, 13, 131, 176, 15,
+			159, 152, 51, 136, 9, 182, 112, 103, 141, 127, 170, 229, 19, 193,
+			128, 110, 229, 151, 161, 234, 170, 80, 217, 161, 22, 130, 149, 191,
+			31, 234, 250, 231, 242, 97, 18, 246, 88, 113, 67, 102, 220, 32,
+			42, 24, 220, 152, 50, 136, 9, 6, 139, 15, 120, 81, 179, 167,
+			130, 21, 232, 70, 254, 19, 168, 201, 112, 232, 187, 193, 165, 202,
+			234, 131, 156, 177, 183, 46, 100, 38, 13, 194, 237, 98, 214, 32,
+			38, 88, 161, 176, 30, 43, 14, 59, 114, 186, 153, 223, 135, 61,
+			173, 140, 213, 40, 19, 41, 55, 80, 78, 228, 194, 93, 219, 117,
+			122, 210, 95, 131, 208, 238, 4, 208, 215, 153, 21, 189, 3, 215,
+			58, 234, 173, 116, 245, 1, 164, 127, 69, 62, 86, 233, 75, 153,
+			155, 6, 81, 193, 150, 166, 140, 226, 176, 102, 95, 186, 115, 159,
+			255, 45, 209, 7, 208, 29, 241, 227, 252, 95, 147, 228, 110, 230,
+			145, 12, 224, 173, 10, 84, 248, 190, 184, 31, 127, 145, 226, 35,
+			192, 158, 231, 131, 227, 159, 131, 63, 116, 241, 83, 128, 93, 187,
+			194, 178, 92, 66, 79, 5, 218, 32, 23, 50, 180, 103, 156, 121,
+			195, 158, 3, 93, 251, 173, 132, 166, 148, 46, 196, 143, 110, 114,
+			31, 236, 9, 214, 51, 57, 131, 168, 96, 235, 115, 11, 6, 97,
+			35, 190, 246, 144, 47, 69, 67, 173, 82, 234, 49, 201, 207, 65,
+			125, 56, 192, 231, 69, 58, 151, 237, 115, 121, 142, 85, 74, 223,
+			136, 39, 58, 41, 193, 238, 211, 133, 120, 162, 131, 75, 247, 233,
+			156, 65, 84, 176, 251, 183, 231, 249, 55, 102, 142, 181, 73, 231,
+			242, 85, 216, 29, 246, 7, 224, 218, 125, 108, 138, 163, 226, 122,
+			96, 183, 78, 177, 68, 195, 224, 219, 47, 87, 160, 231, 117, 130,
+			13, 4, 161, 12, 194, 43, 71, 128, 182, 111, 247, 229, 153, 231,
+			159, 22, 147, 33, 152, 102, 60, 101, 16, 21, 108, 115, 54, 199,
+			15, 204, 72, 236, 1, 205, 231, 191, 132, 61, 229, 58, 145, 15,
+			104, 141, 57, 158, 187, 18, 194, 192, 14, 2, 248, 198, 238, 41,
+			28, 96, 152, 82, 168, 97, 119, 48, 194, 180, 175, 32, 181, 236,
+			39, 146, 240, 10, 15, 232, 180, 65, 84, 176, 7, 185, 57, 190,
+			103, 102, 106, 91, 52, 151, 127, 4, 149, 119, 168, 185, 64, 223,
+			75, 11, 84, 46, 166, 20, 216, 86, 157, 175, 135, 210, 63, 135,
+			80, 191, 11, 43, 82, 211, 69, 197, 94, 176, 146, 200, 64, 223,
+			219, 74, 38, 129, 56, 142, 219, 154, 153, 229, 127, 36, 102, 30,
+			247, 136, 126, 146, 255, 3, 129, 93, 109, 226, 203, 222, 22, 231,
+			136, 129, 158, 249, 218, 173, 150, 231, 59, 152, 62, 66, 15, 124,
+			243, 242, 195, 192, 235, 169, 22, 246, 71, 209, 203, 167, 124, 14,
+			3, 95, 98, 137, 25, 108, 64, 32, 37, 36, 69, 194, 49, 82,
+			158, 227, 99, 229, 203, 129, 23, 245, 22, 69, 227, 22, 1, 196,
+			245, 125, 114, 106, 244, 176, 71, 244, 182, 65, 84, 176, 71, 139,
+			192, 63, 141, 38, 121, 79, 83, 219, 36, 191, 2, 187, 178, 173,
+			211, 215, 25, 30, 244, 106, 126, 11, 186, 218, 131, 29, 239, 210,
+			4, 239, 105, 118, 50, 158, 180, 165, 4, 251, 146, 154, 145, 72,
+			42, 131, 232, 154, 65, 68, 176, 47, 175, 79, 26, 196, 4, 251,
+			114, 106, 26, 179, 14, 206, 221, 176, 57, 155, 46, 124, 2, 182,
+			223, 84, 161, 111, 251, 231, 239, 207, 220, 240, 69, 117, 59, 102,
+			76, 66, 210, 184, 193, 12, 213, 48, 187, 61, 27, 157, 48, 8,
+			251, 183, 155, 83, 241, 129, 168, 96, 229, 100, 244, 135, 93, 117,
+			153, 102, 12, 34, 130, 149, 71, 46, 207, 226, 202, 147, 130, 111,
+			69, 211, 149, 189, 212, 239, 72, 126, 29, 170, 102, 58, 162, 179,
+			172, 177, 224, 135, 210, 161, 153, 182, 236, 101, 175, 107, 209, 122,
+			218, 178, 31, 139, 142, 134, 42, 251, 73, 79, 139, 177, 183, 159,
+			29, 55, 136, 9, 182, 63, 41, 248, 58, 71, 115, 88, 7, 169,
+			175, 73, 126, 1, 118, 101, 104, 171, 94, 144, 12, 117, 126, 40,
+			14, 213, 118, 144, 157, 224, 47, 184, 101, 81, 20, 119, 72, 103,
+			243, 207, 224, 200, 87, 29, 133, 175, 37, 154, 43, 234, 188, 54,
+			240, 69, 106, 133, 189, 115, 176, 3, 51, 6, 9, 134, 205, 190,
+			10, 49, 233, 133, 94, 252, 152, 61, 55, 137, 21, 217, 101, 144,
+			223, 152, 65, 68, 176, 195, 107, 194, 32, 38, 216, 225, 244, 12,
+			255, 92, 11, 38, 130, 29, 211, 123, 249, 34, 236, 12, 125, 95,
+			186, 225, 15, 166, 64, 58, 70, 49, 198, 174, 140, 217, 35, 86,
+			56, 25, 58, 166, 191, 137, 25, 163, 2, 143, 239, 172, 26, 196,
+			4, 59, 190, 187, 193, 139, 28, 83, 159, 245, 50, 245, 231, 36,
+			95, 128, 154, 12, 134, 61, 157, 90, 253, 161, 171, 255, 127, 112,
+			165, 14, 143, 117, 131, 177, 249, 50, 139, 153, 198, 178, 24, 75,
+			9, 235, 27, 250, 103, 76, 243, 101, 12, 181, 255, 13, 191, 206,
+			175, 243, 12, 174, 161, 230, 94, 89, 55, 249, 56, 31, 137, 96,
+			26, 49, 191, 192, 68, 176, 87, 99, 227, 23, 152, 9, 246, 106,
+			82, 36, 219, 137, 96, 223, 90, 185, 100, 25, 125, 243, 219, 75,
+			219, 241, 78, 223, 142, 93, 176, 199, 121, 215, 183, 51, 179, 201,
+			118, 42, 216, 107, 43, 159, 44, 163, 143, 190, 190, 180, 29, 141,
+			252, 122, 108, 250, 2, 51, 193, 94, 231, 230, 248, 106, 188, 157,
+			9, 246, 157, 53, 87, 152, 195, 178, 176, 80, 192, 161, 101, 108,
+			223, 248, 101, 185, 224, 196, 210, 72, 122, 9, 19, 193, 190, 27,
+			155, 186, 192, 200, 106, 54, 167, 189, 151, 161, 82, 190, 143, 94,
+			7, 139, 209, 148, 133, 136, 27, 148, 17, 236, 251, 177, 113, 131,
+			136, 96, 223, 71, 213, 4, 82, 50, 193, 190, 159, 205, 241, 61,
+			78, 45, 75, 88, 118, 74, 145, 252, 227, 15, 216, 236, 39, 222,
+			214, 43, 175, 60, 250, 57, 102, 44, 59, 139, 85, 133, 101, 89,
+			104, 203, 38, 237, 68, 182, 180, 180, 45, 155, 60, 50, 134, 133,
+			198, 17, 172, 21, 219, 210, 138, 109, 217, 138, 239, 108, 197, 182,
+			108, 197, 182, 180, 98, 91, 182, 98, 91, 90, 145, 45, 157, 216,
+			150, 86, 108, 75, 231, 210, 118, 180, 165, 19, 219, 210, 138, 109,
+			233, 204, 204, 242, 71, 241, 118, 42, 152, 180, 102, 10, 235, 112,
+			214, 141, 234, 150, 36, 99, 116, 237, 192, 100, 252, 13, 93, 211,
+			234, 252, 22, 92, 176, 70, 187, 203, 75, 162, 208, 238, 114, 108,
+			242, 2, 51, 193, 228, 212, 52, 175, 196, 162, 152, 96, 109, 107,
+			186, 176, 5, 109, 187, 135, 109, 135, 155, 20, 34, 125, 207, 145,
+			232, 13, 170, 125, 245, 8, 248, 239, 132, 224, 84, 13, 6, 198,
+			41, 172, 216, 41, 218, 86, 246, 2, 19, 193, 218, 163, 19, 23,
+			24, 229, 196, 217, 212, 66, 237, 118, 105, 33, 82, 189, 118, 138,
+			110, 236, 20, 150, 206, 23, 221, 177, 155, 6, 17, 193, 186, 83,
+			243, 6, 49, 193, 186, 240, 73, 51, 51, 240, 189, 208, 251, 244,
+			127, 7, 0, 1, 150, 161, 32, 9, 36, 0, 0},
 	)
 }
 
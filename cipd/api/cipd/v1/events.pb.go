@@ -52,12 +52,14 @@ const (
 	EventKind_PACKAGE_HIDDEN   EventKind = 202
 	EventKind_PACKAGE_UNHIDDEN EventKind = 203
 	// Instance events: relate to a particular package instance.
-	EventKind_INSTANCE_CREATED      EventKind = 300
-	EventKind_INSTANCE_DELETED      EventKind = 301
-	EventKind_INSTANCE_REF_SET      EventKind = 302
-	EventKind_INSTANCE_REF_UNSET    EventKind = 303
-	EventKind_INSTANCE_TAG_ATTACHED EventKind = 304
-	EventKind_INSTANCE_TAG_DETACHED EventKind = 305
+	EventKind_INSTANCE_CREATED           EventKind = 300
+	EventKind_INSTANCE_DELETED           EventKind = 301
+	EventKind_INSTANCE_REF_SET           EventKind = 302
+	EventKind_INSTANCE_REF_UNSET         EventKind = 303
+	EventKind_INSTANCE_TAG_ATTACHED      EventKind = 304
+	EventKind_INSTANCE_TAG_DETACHED      EventKind = 305
+	EventKind_INSTANCE_METADATA_ATTACHED EventKind = 306
+	EventKind_INSTANCE_METADATA_DETACHED EventKind = 307
 )
 
 // Enum value maps for EventKind.
@@ -75,20 +77,24 @@ var (
 		303: "INSTANCE_REF_UNSET",
 		304: "INSTANCE_TAG_ATTACHED",
 		305: "INSTANCE_TAG_DETACHED",
+		306: "INSTANCE_METADATA_ATTACHED",
+		307: "INSTANCE_METADATA_DETACHED",
 	}
 	EventKind_value = map[string]int32{
-		"EVENT_KIND_UNSPECIFIED": 0,
-		"PREFIX_ACL_CHANGED":     100,
-		"PACKAGE_CREATED":        200,
-		"PACKAGE_DELETED":        201,
-		"PACKAGE_HIDDEN":         202,
-		"PACKAGE_UNHIDDEN":       203,
-		"INSTANCE_CREATED":       300,
-		"INSTANCE_DELETED":       301,
-		"INSTANCE_REF_SET":       302,
-		"INSTANCE_REF_UNSET":     303,
-		"INSTANCE_TAG_ATTACHED":  304,
-		"INSTANCE_TAG_DETACHED":  305,
+		"EVENT_KIND_UNSPECIFIED":     0,
+		"PREFIX_ACL_CHANGED":         100,
+		"PACKAGE_CREATED":            200,
+		"PACKAGE_DELETED":            201,
+		"PACKAGE_HIDDEN":             202,
+		"PACKAGE_UNHIDDEN":           203,
+		"INSTANCE_CREATED":           300,
+		"INSTANCE_DELETED":           301,
+		"INSTANCE_REF_SET":           302,
+		"INSTANCE_REF_UNSET":         303,
+		"INSTANCE_TAG_ATTACHED":      304,
+		"INSTANCE_TAG_DETACHED":      305,
+		"INSTANCE_METADATA_ATTACHED": 306,
+		"INSTANCE_METADATA_DETACHED": 307,
 	}
 )
 
@@ -143,6 +149,10 @@ type Event struct {
 	// An ACL diff for PREFIX_ACL_CHANGED.
 	GrantedRole []*PrefixMetadata_ACL `protobuf:"bytes,8,rep,name=granted_role,json=grantedRole,proto3" json:"granted_role,omitempty"`
 	RevokedRole []*PrefixMetadata_ACL `protobuf:"bytes,9,rep,name=revoked_role,json=revokedRole,proto3" json:"revoked_role,omitempty"`
+	// Metadata key, content type and fingerprint for INSTANCE_METADATA_*.
+	MdKey         string `protobuf:"bytes,10,opt,name=md_key,json=mdKey,proto3" json:"md_key,omitempty"`
+	MdContentType string `protobuf:"bytes,11,opt,name=md_content_type,json=mdContentType,proto3" json:"md_content_type,omitempty"`
+	MdFingerprint string `protobuf:"bytes,12,opt,name=md_fingerprint,json=mdFingerprint,proto3" json:"md_fingerprint,omitempty"`
 }
 
 func (x *Event) Reset() {
@@ -240,6 +250,27 @@ func (x *Event) GetRevokedRole() []*PrefixMetadata_ACL {
 	return nil
 }
 
+func (x *Event) GetMdKey() string {
+	if x != nil {
+		return x.MdKey
+	}
+	return ""
+}
+
+func (x *Event) GetMdContentType() string {
+	if x != nil {
+		return x.MdContentType
+	}
+	return ""
+}
+
+func (x *Event) GetMdFingerprint() string {
+	if x != nil {
+		return x.MdFingerprint
+	}
+	return ""
+}
+
 var File_go_chromium_org_luci_cipd_api_cipd_v1_events_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_cipd_api_cipd_v1_events_proto_rawDesc = []byte{
@@ -251,7 +282,7 @@ var file_go_chromium_org_luci_cipd_api_cipd_v1_events_proto_rawDesc = []byte{
 	0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x76,
 	0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x02,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x03,
 	0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x45, 0x76, 0x65,
 	0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03,
@@ -272,29 +303,39 @@ var file_go_chromium_org_luci_cipd_api_cipd_v1_events_proto_rawDesc = []byte{
 	0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x69,
 	0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
 	0x61, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x52, 0x6f,
-	0x6c, 0x65, 0x2a, 0xad, 0x02, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64,
-	0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
-	0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x5f, 0x41, 0x43, 0x4c, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
-	0x45, 0x44, 0x10, 0x64, 0x12, 0x14, 0x0a, 0x0f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f,
-	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0xc8, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x50, 0x41,
-	0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0xc9, 0x01,
-	0x12, 0x13, 0x0a, 0x0e, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x48, 0x49, 0x44, 0x44,
-	0x45, 0x4e, 0x10, 0xca, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45,
-	0x5f, 0x55, 0x4e, 0x48, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0xcb, 0x01, 0x12, 0x15, 0x0a, 0x10,
-	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44,
-	0x10, 0xac, 0x02, 0x12, 0x15, 0x0a, 0x10, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f,
-	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0xad, 0x02, 0x12, 0x15, 0x0a, 0x10, 0x49, 0x4e,
-	0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x46, 0x5f, 0x53, 0x45, 0x54, 0x10, 0xae,
-	0x02, 0x12, 0x17, 0x0a, 0x12, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45,
-	0x46, 0x5f, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0xaf, 0x02, 0x12, 0x1a, 0x0a, 0x15, 0x49, 0x4e,
-	0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x41, 0x47, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43,
-	0x48, 0x45, 0x44, 0x10, 0xb0, 0x02, 0x12, 0x1a, 0x0a, 0x15, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e,
-	0x43, 0x45, 0x5f, 0x54, 0x41, 0x47, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10,
-	0xb1, 0x02, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75,
-	0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6d, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x64, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6d, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x64, 0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x64, 0x46, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2a, 0xef, 0x02, 0x0a, 0x09, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x5f, 0x41, 0x43, 0x4c,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x64, 0x12, 0x14, 0x0a, 0x0f, 0x50, 0x41,
+	0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0xc8, 0x01,
+	0x12, 0x14, 0x0a, 0x0f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0xc9, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47,
+	0x45, 0x5f, 0x48, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0xca, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x50,
+	0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x48, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10,
+	0xcb, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0xac, 0x02, 0x12, 0x15, 0x0a, 0x10, 0x49, 0x4e, 0x53,
+	0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0xad, 0x02,
+	0x12, 0x15, 0x0a, 0x10, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x46,
+	0x5f, 0x53, 0x45, 0x54, 0x10, 0xae, 0x02, 0x12, 0x17, 0x0a, 0x12, 0x49, 0x4e, 0x53, 0x54, 0x41,
+	0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x46, 0x5f, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0xaf, 0x02,
+	0x12, 0x1a, 0x0a, 0x15, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x41, 0x47,
+	0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0xb0, 0x02, 0x12, 0x1a, 0x0a, 0x15,
+	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x41, 0x47, 0x5f, 0x44, 0x45, 0x54,
+	0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0xb1, 0x02, 0x12, 0x1f, 0x0a, 0x1a, 0x49, 0x4e, 0x53, 0x54,
+	0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x41, 0x54,
+	0x54, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0xb2, 0x02, 0x12, 0x1f, 0x0a, 0x1a, 0x49, 0x4e, 0x53,
+	0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x44,
+	0x45, 0x54, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0xb3, 0x02, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x6f,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75,
+	0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64,
+	0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
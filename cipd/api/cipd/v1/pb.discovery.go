@@ -12,1599 +12,1613 @@ func init() {
 			"cipd.Storage", "cipd.Repository",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 11, 116, 36, 215,
-			117, 24, 136, 250, 116, 15, 240, 128, 193, 0, 133, 193, 103, 122,
-			126, 143, 61, 26, 13, 48, 236, 105, 204, 143, 164, 102, 70, 252,
-			52, 128, 158, 153, 22, 65, 0, 106, 0, 164, 40, 46, 3, 22,
-			186, 95, 163, 75, 83, 93, 213, 170, 170, 6, 166, 201, 165, 206,
-			202, 148, 252, 145, 21, 71, 116, 116, 172, 143, 227, 163, 77, 20,
-			43, 27, 69, 214, 202, 178, 20, 219, 178, 44, 203, 180, 163, 40,
-			178, 19, 203, 178, 21, 91, 254, 200, 62, 222, 200, 107, 91, 94,
-			41, 138, 237, 68, 57, 182, 163, 61, 247, 190, 79, 85, 55, 26,
-			51, 36, 77, 218, 222, 13, 113, 40, 77, 223, 170, 87, 239, 115,
-			223, 125, 247, 243, 222, 125, 247, 146, 159, 123, 144, 204, 110, 249,
-			249, 74, 61, 240, 27, 78, 171, 145, 247, 131, 173, 89, 183, 85,
-			113, 102, 43, 78, 179, 58, 107, 55, 197, 143, 237, 115, 179, 21,
-			59, 204, 55, 3, 63, 242, 45, 19, 30, 101, 31, 33, 3, 203,
-			155, 111, 98, 149, 168, 204, 106, 214, 157, 100, 160, 110, 135, 245,
-			13, 219, 221, 242, 167, 52, 170, 77, 15, 159, 31, 206, 67, 177,
-			252, 117, 59, 172, 23, 220, 45, 191, 220, 95, 23, 191, 172, 163,
-			132, 212, 217, 205, 141, 170, 179, 197, 194, 104, 74, 167, 218, 244,
-			64, 121, 160, 206, 110, 46, 224, 131, 236, 13, 50, 118, 141, 69,
-			188, 238, 245, 242, 98, 153, 189, 185, 197, 194, 200, 58, 69, 210,
-			62, 62, 195, 250, 7, 207, 31, 224, 245, 171, 62, 148, 197, 107,
-			235, 78, 50, 90, 245, 119, 60, 215, 183, 171, 27, 53, 199, 101,
-			158, 221, 96, 162, 149, 17, 249, 226, 170, 120, 158, 61, 45, 71,
-			177, 94, 94, 132, 142, 133, 206, 150, 199, 170, 27, 173, 192, 197,
-			102, 6, 202, 3, 252, 201, 122, 224, 102, 223, 68, 172, 57, 182,
-			229, 120, 235, 77, 168, 226, 69, 244, 43, 129, 35, 253, 214, 56,
-			202, 182, 200, 216, 85, 199, 115, 194, 122, 103, 99, 121, 50, 214,
-			194, 7, 27, 126, 147, 5, 118, 228, 248, 222, 134, 83, 21, 93,
-			29, 229, 175, 150, 229, 155, 82, 213, 202, 19, 82, 243, 131, 10,
-			219, 128, 138, 177, 209, 30, 29, 28, 192, 34, 208, 137, 108, 145,
-			140, 205, 219, 94, 133, 185, 127, 163, 102, 179, 255, 94, 35, 7,
-			214, 59, 159, 90, 119, 144, 161, 30, 31, 15, 250, 137, 222, 30,
-			37, 68, 52, 3, 248, 23, 132, 193, 159, 172, 7, 174, 117, 154,
-			164, 195, 200, 142, 90, 225, 148, 129, 216, 179, 248, 64, 120, 67,
-			171, 248, 166, 44, 74, 36, 102, 197, 188, 245, 172, 156, 32, 251,
-			89, 16, 248, 193, 70, 131, 133, 161, 189, 197, 166, 82, 216, 236,
-			16, 62, 124, 136, 63, 59, 125, 133, 244, 203, 57, 178, 14, 145,
-			241, 235, 133, 213, 235, 27, 133, 197, 107, 203, 27, 235, 75, 171,
-			43, 197, 249, 210, 213, 82, 113, 97, 164, 207, 234, 39, 230, 234,
-			245, 194, 185, 17, 205, 34, 36, 189, 122, 189, 112, 254, 174, 187,
-			71, 244, 211, 45, 50, 148, 236, 162, 117, 148, 28, 90, 95, 89,
-			92, 46, 44, 108, 172, 174, 21, 214, 214, 87, 187, 42, 217, 79,
-			6, 248, 235, 210, 210, 181, 17, 13, 192, 135, 139, 229, 210, 213,
-			71, 1, 212, 1, 92, 89, 159, 91, 44, 173, 94, 47, 46, 140,
-			24, 214, 32, 217, 87, 44, 151, 151, 203, 197, 133, 17, 211, 26,
-			34, 253, 243, 133, 165, 249, 226, 98, 113, 97, 36, 117, 254, 251,
-			116, 178, 111, 53, 242, 3, 123, 139, 89, 151, 201, 80, 114, 73,
-			89, 135, 56, 54, 122, 44, 179, 76, 7, 162, 160, 236, 125, 100,
-			48, 65, 245, 214, 20, 127, 191, 123, 33, 100, 198, 147, 211, 17,
-			207, 251, 3, 100, 40, 73, 201, 178, 237, 30, 212, 125, 139, 26,
-			146, 68, 41, 107, 232, 65, 168, 123, 212, 48, 119, 231, 27, 103,
-			158, 23, 147, 187, 98, 55, 157, 215, 253, 243, 2, 217, 103, 165,
-			134, 251, 126, 79, 211, 200, 47, 154, 68, 27, 178, 140, 225, 62,
-			235, 252, 79, 155, 116, 222, 111, 182, 3, 103, 171, 30, 209, 243,
-			103, 207, 221, 67, 215, 234, 140, 46, 174, 207, 151, 104, 161, 21,
-			213, 253, 32, 204, 19, 66, 23, 157, 10, 243, 66, 86, 165, 45,
-			175, 202, 2, 26, 213, 25, 45, 52, 237, 10, 148, 228, 111, 114,
-			244, 97, 22, 132, 142, 239, 209, 243, 249, 179, 116, 26, 10, 100,
-			197, 171, 236, 204, 21, 66, 219, 126, 139, 54, 236, 54, 245, 252,
-			136, 182, 66, 70, 163, 186, 19, 82, 96, 99, 148, 221, 172, 176,
-			102, 68, 29, 143, 86, 252, 70, 211, 117, 96, 248, 116, 199, 137,
-			234, 216, 138, 168, 35, 79, 232, 163, 162, 6, 127, 51, 178, 29,
-			143, 218, 180, 226, 55, 219, 212, 175, 37, 139, 81, 59, 34, 132,
-			226, 95, 61, 138, 154, 151, 103, 103, 119, 118, 118, 242, 54, 246,
-			148, 163, 135, 151, 11, 103, 23, 75, 243, 197, 165, 213, 226, 153,
-			243, 249, 179, 132, 208, 117, 207, 101, 97, 72, 3, 246, 230, 150,
-			19, 176, 42, 221, 108, 83, 187, 217, 116, 157, 138, 189, 233, 50,
-			234, 218, 59, 212, 15, 168, 189, 21, 48, 86, 165, 145, 15, 125,
-			221, 9, 156, 200, 241, 182, 114, 52, 244, 107, 209, 142, 29, 48,
-			66, 171, 78, 24, 5, 206, 102, 43, 234, 64, 147, 236, 153, 19,
-			118, 20, 240, 61, 106, 123, 52, 91, 88, 165, 165, 213, 44, 157,
-			43, 172, 150, 86, 115, 132, 62, 82, 90, 187, 190, 188, 190, 70,
-			31, 41, 148, 203, 133, 165, 181, 82, 113, 149, 46, 151, 233, 252,
-			242, 210, 66, 105, 173, 180, 188, 180, 74, 151, 175, 210, 194, 210,
-			163, 244, 193, 210, 210, 66, 142, 50, 39, 170, 179, 128, 178, 155,
-			205, 0, 122, 239, 7, 212, 1, 4, 178, 106, 158, 208, 85, 198,
-			58, 154, 175, 249, 188, 59, 97, 147, 85, 156, 154, 83, 161, 174,
-			237, 109, 181, 236, 45, 70, 183, 252, 109, 22, 120, 142, 183, 69,
-			155, 44, 104, 56, 33, 76, 98, 72, 109, 175, 74, 168, 235, 52,
-			156, 8, 73, 45, 220, 61, 162, 60, 33, 253, 68, 211, 45, 99,
-			164, 111, 63, 252, 234, 183, 12, 171, 239, 1, 50, 64, 244, 254,
-			65, 254, 243, 191, 27, 68, 79, 247, 89, 230, 241, 190, 55, 106,
-			153, 63, 53, 104, 241, 102, 211, 15, 89, 72, 43, 190, 23, 49,
-			47, 58, 99, 87, 171, 208, 113, 196, 113, 200, 151, 52, 141, 234,
-			118, 132, 0, 11, 177, 177, 166, 93, 185, 1, 207, 129, 84, 144,
-			18, 11, 174, 75, 231, 75, 43, 11, 234, 141, 227, 133, 17, 208,
-			76, 72, 167, 237, 74, 212, 178, 93, 218, 172, 183, 67, 167, 98,
-			187, 252, 163, 25, 106, 7, 140, 6, 44, 116, 170, 48, 72, 199,
-			67, 218, 35, 178, 197, 28, 12, 181, 163, 169, 128, 53, 253, 208,
-			137, 252, 160, 77, 171, 118, 100, 211, 48, 10, 90, 149, 168, 5,
-			61, 122, 83, 43, 140, 104, 192, 106, 44, 96, 64, 165, 81, 157,
-			53, 232, 102, 155, 192, 15, 39, 160, 92, 242, 67, 39, 31, 242,
-			195, 72, 146, 166, 96, 86, 52, 100, 193, 182, 83, 97, 180, 176,
-			82, 2, 98, 104, 193, 106, 114, 188, 136, 5, 158, 237, 186, 109,
-			32, 57, 40, 189, 105, 87, 110, 48, 175, 202, 241, 95, 241, 131,
-			128, 133, 77, 223, 195, 158, 151, 87, 230, 105, 131, 69, 117, 191,
-			26, 226, 144, 10, 243, 139, 72, 72, 110, 27, 104, 210, 174, 54,
-			28, 47, 204, 241, 149, 195, 215, 20, 172, 70, 191, 70, 104, 146,
-			39, 113, 4, 59, 33, 181, 93, 215, 223, 225, 228, 188, 201, 120,
-			111, 170, 78, 192, 42, 17, 239, 75, 197, 117, 152, 23, 133, 240,
-			186, 230, 120, 182, 235, 60, 201, 8, 229, 50, 139, 42, 217, 22,
-			210, 48, 178, 131, 136, 47, 152, 68, 239, 243, 132, 16, 98, 164,
-			251, 52, 203, 56, 222, 127, 128, 188, 77, 35, 102, 186, 79, 239,
-			179, 140, 19, 250, 107, 51, 59, 116, 37, 240, 171, 45, 152, 48,
-			155, 114, 45, 132, 174, 151, 23, 121, 199, 42, 182, 167, 186, 3,
-			77, 179, 168, 82, 135, 133, 194, 165, 26, 160, 182, 204, 162, 86,
-			224, 133, 116, 105, 121, 109, 227, 234, 242, 250, 210, 2, 229, 162,
-			145, 86, 252, 42, 163, 14, 226, 60, 96, 167, 66, 234, 249, 52,
-			108, 85, 234, 241, 167, 67, 36, 5, 189, 208, 44, 227, 68, 218,
-			146, 144, 110, 25, 39, 198, 94, 45, 33, 195, 50, 78, 156, 187,
-			76, 62, 219, 143, 29, 214, 44, 227, 178, 254, 64, 230, 227, 253,
-			180, 228, 57, 145, 99, 71, 208, 101, 111, 23, 22, 160, 87, 203,
-			130, 28, 228, 75, 39, 164, 142, 248, 134, 19, 23, 199, 39, 60,
-			231, 51, 26, 58, 64, 245, 176, 42, 249, 23, 48, 193, 81, 157,
-			17, 209, 93, 24, 60, 124, 22, 177, 70, 211, 15, 236, 160, 77,
-			93, 191, 130, 173, 209, 233, 102, 224, 111, 59, 85, 86, 165, 219,
-			142, 77, 79, 197, 154, 197, 41, 186, 83, 119, 42, 117, 26, 214,
-			253, 150, 91, 165, 155, 48, 97, 128, 71, 59, 217, 107, 192, 180,
-			227, 209, 107, 190, 191, 229, 198, 180, 89, 102, 97, 171, 129, 235,
-			80, 80, 9, 106, 196, 21, 223, 157, 225, 132, 88, 67, 10, 18,
-			125, 148, 85, 33, 165, 217, 180, 98, 187, 46, 116, 55, 73, 101,
-			57, 209, 149, 29, 199, 117, 169, 107, 183, 188, 74, 29, 23, 9,
-			221, 102, 1, 112, 31, 91, 208, 38, 62, 227, 35, 62, 21, 82,
-			80, 230, 128, 49, 34, 159, 98, 193, 54, 11, 104, 232, 84, 129,
-			209, 208, 82, 45, 137, 197, 27, 158, 191, 195, 217, 3, 255, 36,
-			89, 15, 117, 34, 186, 99, 11, 210, 21, 61, 181, 221, 128, 217,
-			213, 118, 142, 58, 17, 65, 18, 19, 40, 132, 178, 136, 68, 209,
-			5, 90, 115, 152, 91, 205, 211, 146, 39, 169, 49, 140, 113, 4,
-			178, 39, 96, 77, 183, 77, 81, 86, 18, 106, 239, 216, 109, 193,
-			175, 98, 58, 147, 109, 81, 118, 211, 9, 163, 16, 22, 70, 192,
-			162, 22, 242, 215, 194, 98, 185, 88, 88, 120, 116, 163, 248, 134,
-			210, 234, 218, 170, 160, 91, 130, 132, 187, 123, 140, 85, 159, 133,
-			222, 41, 62, 214, 120, 168, 109, 22, 209, 233, 38, 11, 234, 118,
-			51, 20, 196, 30, 227, 111, 211, 175, 182, 41, 176, 182, 45, 230,
-			1, 109, 114, 49, 3, 69, 106, 110, 123, 6, 70, 79, 195, 8,
-			102, 4, 80, 224, 55, 153, 215, 139, 154, 145, 29, 226, 178, 6,
-			22, 25, 48, 187, 33, 167, 29, 152, 97, 158, 62, 82, 103, 158,
-			100, 9, 93, 4, 145, 228, 95, 59, 188, 29, 183, 210, 114, 237,
-			136, 17, 172, 53, 192, 165, 139, 197, 2, 22, 182, 92, 16, 160,
-			61, 102, 16, 185, 188, 71, 187, 148, 29, 241, 181, 228, 53, 78,
-			40, 120, 33, 74, 19, 219, 241, 96, 190, 111, 48, 248, 7, 102,
-			100, 43, 176, 189, 136, 8, 142, 166, 200, 160, 82, 1, 57, 25,
-			249, 116, 167, 238, 51, 32, 175, 166, 31, 134, 12, 254, 67, 86,
-			14, 194, 28, 145, 36, 23, 16, 133, 241, 71, 184, 132, 8, 48,
-			43, 86, 9, 88, 148, 71, 217, 218, 221, 59, 88, 201, 13, 63,
-			0, 113, 84, 243, 21, 179, 209, 128, 133, 164, 71, 37, 164, 91,
-			198, 101, 235, 85, 18, 50, 44, 227, 242, 236, 125, 228, 95, 167,
-			144, 217, 232, 150, 241, 160, 62, 151, 249, 63, 82, 98, 33, 73,
-			249, 199, 56, 243, 239, 158, 166, 156, 192, 7, 138, 180, 40, 164,
-			30, 219, 17, 4, 5, 232, 155, 23, 220, 27, 196, 4, 187, 217,
-			100, 149, 72, 240, 83, 172, 122, 239, 213, 47, 151, 61, 173, 57,
-			65, 24, 17, 186, 201, 106, 48, 38, 88, 226, 208, 80, 114, 137,
-			231, 233, 85, 219, 113, 91, 1, 131, 122, 171, 62, 160, 78, 204,
-			57, 87, 239, 36, 85, 144, 4, 105, 69, 62, 5, 218, 104, 53,
-			129, 5, 161, 130, 143, 125, 70, 234, 95, 131, 41, 69, 94, 2,
-			188, 179, 10, 124, 15, 148, 4, 36, 28, 7, 25, 103, 60, 16,
-			92, 155, 98, 136, 216, 232, 13, 198, 154, 180, 233, 99, 47, 9,
-			22, 71, 130, 106, 35, 39, 192, 9, 183, 157, 40, 214, 129, 56,
-			111, 137, 124, 206, 142, 218, 187, 56, 9, 239, 57, 171, 146, 91,
-			202, 29, 177, 252, 20, 59, 222, 181, 146, 228, 18, 70, 86, 0,
-			117, 20, 193, 248, 2, 9, 224, 218, 98, 66, 146, 173, 113, 117,
-			24, 23, 49, 204, 91, 197, 111, 52, 90, 30, 240, 74, 28, 114,
-			224, 183, 182, 234, 244, 20, 159, 227, 83, 132, 179, 170, 206, 14,
-			39, 169, 35, 116, 132, 68, 226, 149, 129, 218, 173, 196, 123, 71,
-			251, 192, 47, 64, 179, 0, 212, 231, 232, 102, 43, 162, 129, 141,
-			106, 101, 71, 239, 18, 163, 114, 162, 144, 185, 53, 69, 226, 32,
-			37, 31, 84, 242, 20, 201, 88, 201, 83, 221, 176, 140, 7, 207,
-			61, 64, 190, 109, 32, 137, 27, 150, 241, 168, 62, 151, 249, 19,
-			131, 38, 109, 28, 106, 111, 250, 65, 116, 107, 106, 71, 246, 24,
-			209, 134, 191, 205, 66, 152, 222, 200, 167, 210, 36, 228, 20, 4,
-			147, 129, 140, 31, 228, 46, 85, 134, 166, 120, 9, 52, 84, 113,
-			153, 13, 106, 108, 19, 120, 81, 59, 33, 88, 183, 236, 96, 211,
-			222, 98, 121, 53, 195, 208, 145, 134, 143, 138, 94, 5, 40, 144,
-			215, 209, 129, 233, 36, 81, 79, 239, 212, 237, 8, 185, 137, 19,
-			161, 144, 216, 100, 51, 208, 223, 5, 159, 129, 18, 18, 161, 216,
-			148, 172, 58, 198, 98, 168, 36, 69, 5, 145, 1, 204, 58, 160,
-			53, 219, 113, 65, 127, 87, 244, 118, 25, 204, 153, 23, 79, 115,
-			148, 210, 171, 133, 210, 98, 113, 97, 99, 165, 92, 84, 118, 132,
-			172, 102, 247, 220, 34, 250, 148, 37, 14, 61, 82, 86, 186, 176,
-			171, 56, 54, 166, 157, 60, 203, 11, 118, 194, 123, 190, 201, 96,
-			156, 82, 87, 172, 82, 207, 223, 153, 81, 68, 98, 104, 150, 241,
-			168, 34, 18, 67, 183, 140, 71, 21, 145, 24, 64, 22, 231, 30,
-			32, 223, 163, 19, 61, 213, 103, 153, 213, 190, 186, 150, 249, 151,
-			26, 93, 109, 53, 155, 62, 42, 151, 176, 52, 161, 114, 219, 221,
-			242, 3, 39, 170, 55, 132, 246, 44, 148, 206, 91, 152, 19, 220,
-			116, 141, 88, 96, 187, 212, 179, 27, 140, 115, 68, 7, 166, 62,
-			178, 189, 104, 215, 50, 145, 122, 103, 197, 247, 184, 214, 223, 197,
-			42, 9, 109, 218, 81, 61, 76, 232, 237, 121, 114, 126, 121, 246,
-			37, 253, 67, 221, 57, 5, 106, 106, 53, 181, 159, 12, 18, 51,
-			133, 170, 51, 211, 143, 0, 194, 82, 92, 131, 101, 250, 164, 132,
-			116, 203, 96, 153, 195, 162, 160, 102, 25, 53, 85, 80, 67, 40,
-			45, 33, 221, 50, 106, 170, 160, 110, 25, 91, 170, 32, 124, 182,
-			165, 247, 75, 8, 222, 101, 14, 147, 215, 17, 221, 236, 179, 76,
-			183, 207, 215, 50, 247, 209, 66, 210, 238, 241, 99, 157, 156, 27,
-			84, 183, 155, 5, 66, 12, 19, 250, 237, 246, 143, 146, 251, 137,
-			105, 226, 144, 26, 250, 161, 236, 121, 168, 73, 77, 44, 183, 186,
-			99, 214, 136, 204, 48, 242, 233, 22, 139, 16, 134, 57, 68, 154,
-			130, 10, 210, 80, 131, 130, 52, 203, 104, 12, 30, 148, 144, 97,
-			25, 141, 201, 41, 242, 0, 54, 165, 89, 134, 167, 31, 202, 94,
-			80, 85, 128, 74, 12, 214, 79, 128, 10, 94, 157, 221, 4, 77,
-			7, 173, 121, 150, 223, 162, 167, 236, 205, 74, 149, 213, 242, 249,
-			252, 41, 89, 187, 150, 130, 42, 250, 37, 4, 21, 14, 140, 73,
-			200, 176, 12, 111, 114, 138, 16, 162, 155, 154, 101, 6, 125, 79,
-			106, 56, 92, 40, 21, 244, 31, 38, 243, 196, 52, 53, 24, 110,
-			164, 79, 102, 238, 238, 198, 99, 66, 113, 77, 40, 127, 74, 129,
-			69, 3, 136, 47, 35, 168, 36, 13, 181, 12, 74, 72, 179, 140,
-			104, 200, 146, 144, 97, 25, 209, 248, 4, 249, 164, 134, 237, 105,
-			150, 209, 214, 143, 103, 254, 133, 6, 106, 101, 51, 96, 33, 3,
-			114, 231, 170, 151, 208, 163, 192, 20, 64, 233, 185, 41, 68, 162,
-			80, 233, 231, 197, 76, 46, 56, 33, 26, 194, 192, 24, 234, 204,
-			174, 178, 128, 8, 235, 209, 171, 184, 173, 170, 80, 80, 182, 156,
-			109, 84, 8, 249, 198, 115, 30, 217, 180, 125, 131, 133, 116, 51,
-			240, 119, 66, 22, 132, 52, 180, 183, 185, 66, 128, 242, 77, 237,
-			35, 16, 241, 41, 126, 38, 71, 8, 136, 110, 11, 68, 107, 136,
-			232, 246, 64, 70, 66, 134, 101, 180, 143, 30, 35, 131, 68, 55,
-			117, 43, 245, 116, 223, 119, 107, 28, 211, 48, 216, 167, 251, 71,
-			201, 39, 117, 98, 154, 186, 222, 103, 153, 111, 215, 244, 169, 204,
-			135, 116, 90, 144, 6, 230, 245, 181, 181, 149, 85, 110, 102, 250,
-			187, 149, 102, 96, 19, 87, 1, 211, 92, 155, 82, 76, 84, 236,
-			7, 81, 187, 21, 213, 153, 23, 9, 187, 37, 79, 139, 55, 155,
-			78, 0, 204, 164, 22, 129, 141, 226, 55, 96, 88, 98, 103, 5,
-			180, 134, 176, 238, 7, 17, 181, 27, 126, 203, 227, 219, 0, 142,
-			192, 204, 110, 45, 198, 118, 93, 192, 17, 206, 3, 40, 78, 160,
-			187, 52, 26, 172, 10, 150, 163, 219, 230, 138, 17, 227, 86, 27,
-			118, 105, 171, 101, 131, 106, 43, 54, 162, 234, 128, 90, 16, 103,
-			77, 59, 136, 28, 208, 181, 3, 197, 155, 226, 125, 139, 60, 93,
-			240, 65, 18, 17, 106, 71, 32, 247, 80, 126, 54, 237, 0, 155,
-			203, 19, 178, 159, 164, 0, 109, 41, 196, 91, 191, 4, 53, 0,
-			7, 198, 36, 104, 0, 56, 49, 73, 30, 39, 122, 74, 179, 210,
-			239, 212, 250, 222, 173, 105, 47, 61, 239, 27, 36, 70, 74, 211,
-			44, 243, 157, 90, 10, 212, 102, 51, 5, 75, 199, 252, 135, 154,
-			78, 161, 35, 41, 164, 122, 0, 15, 75, 80, 7, 240, 216, 113,
-			146, 199, 178, 154, 101, 254, 35, 77, 31, 201, 82, 101, 177, 0,
-			206, 185, 108, 82, 122, 150, 231, 239, 16, 241, 185, 198, 63, 24,
-			148, 160, 14, 224, 240, 1, 114, 15, 214, 166, 91, 230, 187, 160,
-			182, 153, 30, 118, 170, 170, 150, 155, 180, 157, 213, 66, 63, 222,
-			21, 87, 203, 43, 26, 62, 64, 94, 131, 213, 26, 150, 249, 44,
-			84, 123, 58, 185, 250, 235, 54, 84, 200, 60, 218, 108, 109, 186,
-			92, 170, 10, 206, 58, 95, 88, 149, 245, 26, 26, 126, 42, 235,
-			53, 116, 0, 135, 15, 0, 75, 77, 105, 186, 105, 153, 63, 8,
-			245, 158, 227, 123, 32, 116, 7, 254, 175, 102, 71, 182, 75, 25,
-			215, 60, 171, 173, 64, 218, 108, 66, 82, 35, 69, 203, 234, 129,
-			127, 253, 160, 166, 15, 72, 80, 7, 112, 248, 0, 185, 130, 213,
-			167, 44, 243, 31, 67, 245, 103, 186, 84, 25, 80, 186, 148, 14,
-			3, 230, 116, 82, 187, 147, 85, 167, 52, 252, 90, 129, 58, 128,
-			195, 7, 200, 16, 209, 77, 195, 74, 191, 71, 235, 251, 49, 77,
-			131, 217, 55, 97, 140, 239, 209, 250, 51, 228, 25, 147, 152, 166,
-			1, 211, 255, 65, 77, 159, 204, 252, 185, 241, 2, 121, 103, 179,
-			165, 132, 147, 218, 235, 115, 106, 104, 80, 123, 194, 222, 222, 219,
-			112, 207, 161, 82, 140, 203, 82, 24, 77, 200, 27, 247, 48, 225,
-			249, 238, 185, 189, 99, 183, 149, 33, 143, 219, 219, 160, 162, 131,
-			165, 234, 132, 33, 224, 125, 218, 241, 248, 158, 194, 243, 177, 243,
-			103, 114, 36, 89, 238, 161, 245, 213, 53, 26, 182, 154, 77, 183,
-			77, 213, 121, 27, 111, 34, 7, 163, 117, 133, 136, 148, 6, 56,
-			214, 7, 170, 41, 217, 173, 63, 37, 140, 220, 150, 216, 27, 150,
-			166, 58, 148, 235, 32, 116, 201, 130, 148, 45, 207, 245, 49, 37,
-			57, 98, 179, 220, 174, 220, 144, 179, 34, 250, 108, 135, 200, 152,
-			112, 19, 178, 219, 96, 158, 238, 220, 116, 153, 1, 109, 174, 99,
-			163, 18, 88, 163, 96, 78, 6, 72, 62, 160, 130, 65, 9, 106,
-			0, 14, 89, 18, 52, 0, 28, 159, 32, 223, 209, 144, 100, 52,
-			203, 252, 136, 166, 31, 202, 252, 103, 141, 22, 146, 218, 69, 228,
-			243, 179, 7, 159, 86, 89, 224, 108, 39, 150, 52, 106, 5, 98,
-			117, 112, 62, 17, 210, 157, 58, 243, 226, 165, 66, 122, 238, 53,
-			161, 134, 143, 187, 50, 138, 172, 150, 113, 227, 213, 118, 129, 214,
-			212, 24, 157, 80, 10, 97, 65, 32, 155, 126, 84, 143, 95, 131,
-			129, 114, 74, 205, 234, 41, 212, 69, 149, 208, 22, 165, 242, 137,
-			247, 72, 12, 13, 59, 170, 212, 73, 242, 59, 133, 46, 45, 141,
-			24, 80, 32, 34, 100, 240, 160, 4, 13, 0, 39, 167, 112, 241,
-			153, 86, 250, 99, 90, 223, 207, 138, 197, 7, 28, 224, 99, 90,
-			255, 97, 242, 122, 98, 154, 38, 172, 189, 143, 107, 250, 29, 153,
-			121, 192, 163, 83, 5, 25, 88, 115, 88, 0, 88, 232, 181, 117,
-			148, 220, 162, 73, 156, 164, 193, 82, 18, 93, 51, 81, 204, 124,
-			92, 138, 25, 19, 103, 242, 227, 218, 192, 17, 9, 26, 0, 30,
-			167, 228, 191, 234, 216, 1, 205, 50, 63, 173, 233, 135, 51, 255,
-			183, 142, 203, 149, 69, 57, 220, 239, 7, 193, 22, 170, 253, 139,
-			200, 167, 225, 13, 167, 25, 175, 158, 142, 93, 70, 192, 45, 238,
-			219, 219, 97, 216, 106, 48, 174, 122, 40, 89, 144, 224, 190, 177,
-			54, 19, 211, 61, 24, 68, 123, 238, 213, 203, 29, 125, 190, 193,
-			234, 55, 35, 167, 33, 24, 170, 88, 82, 33, 227, 84, 132, 91,
-			18, 81, 208, 10, 133, 153, 171, 90, 71, 1, 53, 13, 133, 217,
-			77, 187, 209, 116, 89, 142, 83, 157, 163, 246, 172, 64, 31, 64,
-			131, 27, 109, 202, 226, 77, 33, 219, 165, 222, 0, 132, 82, 101,
-			30, 136, 159, 86, 8, 184, 240, 147, 188, 39, 79, 11, 177, 192,
-			23, 218, 5, 223, 121, 11, 9, 26, 122, 197, 242, 67, 165, 213,
-			213, 210, 242, 210, 198, 66, 113, 169, 84, 92, 80, 179, 4, 4,
-			244, 105, 185, 222, 76, 36, 160, 79, 107, 67, 19, 18, 52, 0,
-			60, 148, 65, 2, 74, 89, 233, 207, 106, 125, 191, 32, 8, 8,
-			248, 252, 103, 37, 1, 165, 128, 128, 158, 123, 73, 9, 40, 133,
-			4, 244, 156, 36, 160, 20, 18, 208, 115, 146, 128, 82, 72, 64,
-			207, 1, 1, 65, 215, 210, 86, 250, 115, 26, 30, 120, 66, 215,
-			210, 154, 101, 126, 78, 235, 159, 36, 255, 12, 184, 68, 26, 250,
-			246, 75, 154, 158, 201, 252, 99, 228, 18, 126, 211, 126, 115, 139,
-			9, 67, 64, 232, 185, 178, 191, 33, 199, 233, 30, 187, 18, 54,
-			208, 34, 208, 64, 98, 83, 193, 198, 163, 83, 73, 13, 184, 51,
-			137, 52, 209, 193, 225, 96, 84, 116, 45, 96, 208, 20, 40, 103,
-			97, 188, 199, 200, 7, 155, 198, 193, 254, 146, 28, 108, 26, 7,
-			251, 75, 218, 192, 184, 4, 13, 0, 167, 14, 145, 223, 230, 35,
-			210, 44, 243, 75, 160, 249, 254, 178, 38, 78, 84, 20, 39, 78,
-			112, 251, 23, 176, 251, 79, 35, 159, 36, 55, 119, 119, 41, 205,
-			75, 126, 151, 110, 204, 15, 56, 196, 41, 106, 188, 1, 143, 116,
-			46, 182, 155, 160, 107, 114, 167, 213, 118, 67, 95, 244, 141, 116,
-			238, 183, 238, 70, 133, 150, 194, 209, 73, 84, 104, 56, 88, 161,
-			159, 166, 145, 36, 191, 4, 250, 233, 44, 98, 66, 183, 204, 47,
-			195, 220, 222, 65, 185, 119, 194, 94, 27, 101, 170, 118, 61, 141,
-			95, 12, 75, 80, 3, 240, 128, 68, 52, 168, 108, 95, 6, 68,
-			127, 150, 35, 218, 176, 204, 223, 6, 157, 228, 199, 53, 122, 213,
-			15, 18, 27, 38, 92, 33, 144, 134, 86, 151, 174, 18, 107, 118,
-			241, 182, 162, 100, 49, 142, 151, 212, 225, 91, 33, 171, 181, 220,
-			196, 241, 80, 66, 26, 237, 41, 134, 8, 151, 65, 180, 229, 69,
-			142, 219, 117, 24, 37, 119, 106, 212, 120, 141, 52, 14, 97, 80,
-			130, 26, 128, 66, 160, 166, 117, 3, 7, 56, 62, 129, 198, 107,
-			26, 20, 203, 175, 2, 23, 190, 11, 71, 43, 60, 51, 212, 88,
-			109, 90, 111, 53, 108, 88, 188, 118, 21, 169, 8, 149, 77, 42,
-			28, 79, 84, 139, 102, 10, 107, 145, 243, 7, 146, 230, 171, 218,
-			192, 132, 4, 13, 0, 15, 101, 54, 211, 72, 124, 23, 200, 79,
-			89, 228, 240, 22, 210, 233, 44, 62, 217, 108, 213, 102, 129, 149,
-			181, 133, 183, 214, 1, 254, 50, 47, 95, 102, 247, 145, 84, 17,
-			222, 207, 109, 147, 177, 138, 223, 200, 119, 189, 159, 35, 248, 118,
-			5, 192, 21, 237, 141, 167, 182, 156, 168, 222, 218, 204, 87, 252,
-			198, 236, 150, 239, 218, 222, 86, 220, 76, 51, 106, 55, 89, 200,
-			91, 251, 182, 166, 253, 51, 221, 184, 182, 50, 247, 97, 253, 24,
-			95, 54, 249, 21, 81, 46, 255, 8, 115, 221, 7, 1, 229, 107,
-			80, 254, 117, 255, 99, 132, 164, 45, 243, 88, 223, 133, 17, 242,
-			203, 67, 232, 95, 113, 172, 207, 58, 255, 243, 67, 116, 69, 46,
-			167, 185, 86, 173, 6, 92, 251, 140, 88, 129, 167, 66, 97, 162,
-			128, 104, 169, 212, 109, 111, 11, 149, 177, 6, 40, 109, 73, 167,
-			140, 179, 175, 145, 75, 182, 228, 85, 242, 20, 207, 192, 241, 29,
-			158, 38, 162, 233, 158, 39, 232, 241, 16, 94, 158, 157, 173, 178,
-			109, 230, 2, 133, 135, 18, 7, 48, 72, 185, 166, 207, 108, 242,
-			78, 204, 226, 30, 163, 114, 73, 144, 146, 82, 112, 136, 208, 111,
-			5, 21, 190, 113, 186, 233, 120, 192, 209, 160, 95, 242, 120, 217,
-			15, 240, 95, 191, 21, 17, 218, 240, 171, 74, 214, 230, 184, 226,
-			194, 130, 134, 19, 193, 50, 86, 219, 148, 200, 78, 81, 149, 242,
-			93, 215, 223, 1, 14, 91, 241, 189, 170, 195, 15, 145, 209, 123,
-			162, 193, 162, 203, 194, 119, 227, 116, 87, 199, 112, 245, 138, 30,
-			225, 41, 111, 131, 31, 195, 163, 31, 8, 212, 106, 111, 250, 219,
-			12, 253, 65, 196, 209, 156, 231, 71, 78, 133, 229, 56, 191, 113,
-			29, 126, 24, 159, 108, 81, 28, 249, 199, 221, 169, 58, 97, 197,
-			181, 157, 6, 11, 242, 123, 117, 194, 241, 146, 184, 144, 157, 104,
-			242, 195, 236, 184, 31, 36, 238, 200, 223, 168, 31, 68, 26, 47,
-			85, 191, 210, 106, 48, 47, 82, 234, 204, 172, 31, 80, 31, 247,
-			232, 27, 118, 196, 2, 199, 118, 195, 24, 213, 210, 115, 38, 225,
-			143, 130, 124, 78, 12, 106, 73, 56, 141, 168, 173, 48, 191, 214,
-			65, 91, 158, 31, 191, 67, 188, 59, 17, 30, 81, 122, 188, 42,
-			48, 34, 249, 30, 183, 218, 43, 101, 94, 213, 15, 66, 6, 68,
-			209, 12, 252, 134, 31, 225, 246, 116, 21, 53, 52, 174, 102, 87,
-			105, 45, 240, 27, 132, 99, 65, 250, 203, 72, 10, 138, 61, 82,
-			154, 129, 3, 132, 21, 0, 237, 120, 9, 103, 20, 100, 147, 215,
-			75, 171, 116, 117, 249, 234, 218, 35, 133, 114, 145, 150, 86, 233,
-			74, 121, 249, 225, 210, 66, 113, 129, 206, 61, 74, 215, 174, 23,
-			233, 252, 242, 202, 163, 229, 210, 181, 235, 107, 244, 250, 242, 226,
-			66, 177, 188, 74, 11, 75, 11, 116, 126, 121, 105, 173, 92, 154,
-			91, 95, 91, 46, 175, 18, 229, 109, 3, 111, 10, 75, 143, 210,
-			226, 27, 86, 202, 197, 85, 116, 177, 41, 61, 180, 178, 88, 42,
-			46, 36, 28, 111, 114, 180, 180, 52, 191, 184, 190, 80, 90, 186,
-			150, 163, 115, 235, 107, 116, 105, 121, 141, 208, 197, 210, 67, 165,
-			181, 226, 2, 93, 91, 206, 97, 179, 187, 191, 163, 203, 87, 233,
-			67, 197, 242, 252, 245, 194, 210, 90, 97, 174, 180, 88, 90, 123,
-			20, 27, 188, 90, 90, 91, 130, 198, 174, 46, 151, 9, 45, 208,
-			149, 66, 121, 173, 52, 191, 190, 88, 40, 211, 149, 245, 242, 202,
-			242, 106, 145, 194, 200, 22, 74, 171, 243, 139, 133, 210, 67, 197,
-			133, 60, 45, 45, 209, 165, 101, 90, 124, 184, 184, 180, 70, 87,
-			175, 23, 22, 23, 59, 7, 74, 232, 242, 35, 75, 197, 178, 112,
-			16, 82, 195, 164, 115, 69, 186, 88, 42, 204, 45, 22, 161, 41,
-			28, 231, 66, 169, 92, 156, 95, 131, 1, 197, 191, 230, 75, 11,
-			197, 165, 181, 194, 98, 142, 80, 244, 210, 43, 44, 230, 104, 241,
-			13, 197, 135, 86, 22, 11, 229, 71, 115, 162, 210, 213, 226, 235,
-			215, 139, 75, 107, 165, 194, 34, 93, 40, 60, 84, 184, 86, 92,
-			165, 211, 183, 195, 202, 74, 121, 121, 126, 189, 92, 124, 8, 122,
-			189, 124, 149, 174, 174, 207, 173, 174, 149, 214, 214, 215, 138, 244,
-			218, 242, 242, 2, 34, 123, 181, 88, 126, 184, 52, 95, 92, 189,
-			66, 23, 151, 87, 17, 97, 235, 171, 197, 28, 161, 11, 133, 181,
-			2, 54, 189, 82, 94, 190, 90, 90, 91, 189, 2, 191, 231, 214,
-			87, 75, 136, 184, 210, 210, 90, 177, 92, 94, 95, 89, 43, 45,
-			47, 205, 208, 235, 203, 143, 20, 31, 46, 150, 233, 124, 97, 125,
-			181, 184, 128, 24, 94, 94, 130, 209, 2, 173, 20, 151, 203, 143,
-			66, 181, 128, 7, 156, 129, 28, 125, 228, 122, 113, 237, 122, 177,
-			12, 72, 69, 108, 21, 0, 13, 171, 107, 229, 210, 252, 90, 178,
-			216, 114, 153, 174, 45, 151, 215, 72, 98, 156, 116, 169, 120, 109,
-			177, 116, 173, 184, 52, 95, 132, 215, 203, 80, 205, 35, 165, 213,
-			226, 12, 45, 148, 75, 171, 80, 160, 132, 13, 211, 71, 10, 143,
-			210, 229, 117, 28, 53, 76, 212, 250, 106, 145, 240, 223, 9, 210,
-			205, 225, 124, 210, 210, 85, 90, 88, 120, 184, 4, 61, 23, 165,
-			87, 150, 87, 87, 75, 130, 92, 16, 109, 243, 215, 5, 206, 149,
-			159, 21, 237, 155, 18, 126, 86, 217, 190, 43, 232, 103, 117, 146,
-			255, 228, 15, 79, 244, 221, 39, 157, 175, 224, 39, 127, 248, 170,
-			190, 28, 62, 212, 248, 79, 254, 240, 100, 223, 157, 248, 80, 252,
-			228, 15, 95, 221, 151, 197, 135, 132, 255, 228, 15, 79, 245, 221,
-			129, 15, 95, 197, 127, 242, 135, 211, 125, 199, 241, 225, 113, 254,
-			243, 47, 117, 60, 39, 48, 46, 244, 141, 100, 190, 169, 211, 2,
-			247, 62, 112, 42, 20, 229, 167, 84, 3, 184, 8, 104, 251, 45,
-			116, 62, 8, 216, 25, 97, 136, 219, 219, 190, 83, 165, 85, 6,
-			10, 10, 176, 191, 22, 122, 221, 69, 172, 74, 58, 191, 71, 246,
-			219, 246, 91, 1, 45, 172, 148, 194, 60, 45, 208, 168, 221, 68,
-			39, 47, 97, 57, 129, 150, 19, 91, 57, 194, 156, 11, 184, 255,
-			36, 161, 130, 171, 9, 223, 27, 6, 31, 51, 97, 132, 20, 86,
-			74, 194, 149, 32, 143, 234, 141, 244, 39, 147, 210, 72, 90, 121,
-			87, 125, 159, 62, 37, 14, 193, 104, 208, 172, 208, 57, 59, 152,
-			238, 82, 50, 242, 168, 99, 204, 8, 59, 38, 164, 123, 188, 191,
-			194, 171, 121, 90, 108, 173, 188, 110, 117, 121, 9, 37, 9, 26,
-			252, 177, 1, 249, 4, 150, 126, 2, 119, 141, 17, 23, 88, 80,
-			232, 124, 79, 60, 245, 244, 19, 137, 131, 149, 11, 253, 251, 149,
-			234, 244, 214, 19, 228, 72, 183, 234, 196, 109, 230, 189, 116, 167,
-			247, 106, 36, 189, 138, 37, 172, 43, 36, 141, 182, 99, 56, 165,
-			81, 99, 122, 240, 252, 137, 110, 69, 42, 207, 11, 230, 175, 98,
-			169, 162, 23, 5, 237, 178, 248, 36, 243, 122, 50, 152, 120, 108,
-			141, 16, 227, 6, 107, 11, 151, 104, 248, 105, 229, 72, 106, 219,
-			118, 91, 76, 248, 108, 79, 236, 170, 252, 97, 120, 91, 230, 133,
-			46, 235, 175, 209, 178, 31, 215, 73, 10, 31, 90, 87, 8, 241,
-			90, 174, 187, 193, 43, 224, 222, 248, 153, 93, 21, 44, 181, 92,
-			23, 203, 95, 239, 43, 15, 120, 18, 176, 78, 144, 33, 175, 213,
-			216, 100, 193, 70, 220, 190, 118, 189, 175, 60, 200, 159, 170, 66,
-			220, 254, 19, 133, 12, 232, 56, 20, 226, 79, 121, 161, 227, 132,
-			108, 250, 190, 236, 134, 73, 181, 233, 126, 104, 10, 158, 241, 2,
-			175, 197, 90, 90, 149, 72, 20, 73, 225, 80, 39, 247, 192, 163,
-			168, 190, 85, 137, 212, 40, 65, 81, 16, 223, 166, 241, 219, 221,
-			163, 92, 116, 194, 72, 141, 210, 149, 192, 92, 154, 152, 55, 28,
-			175, 154, 189, 66, 6, 84, 9, 43, 79, 210, 88, 153, 156, 209,
-			189, 144, 46, 74, 157, 62, 76, 6, 20, 18, 173, 97, 66, 150,
-			214, 23, 23, 55, 30, 46, 44, 174, 23, 71, 250, 230, 222, 170,
-			245, 214, 174, 7, 249, 104, 164, 122, 125, 238, 246, 234, 53, 31,
-			244, 21, 254, 79, 115, 243, 5, 40, 218, 239, 59, 202, 21, 237,
-			127, 160, 189, 162, 104, 191, 162, 104, 191, 162, 104, 191, 162, 104,
-			191, 162, 104, 191, 162, 104, 255, 45, 42, 218, 74, 255, 133, 159,
-			82, 209, 190, 46, 181, 111, 248, 41, 21, 109, 165, 125, 159, 84,
-			218, 247, 171, 197, 67, 241, 83, 42, 218, 74, 251, 62, 165, 180,
-			239, 233, 88, 251, 134, 159, 63, 97, 112, 135, 156, 243, 125, 119,
-			105, 153, 15, 25, 244, 9, 46, 114, 159, 136, 85, 71, 220, 53,
-			149, 167, 254, 98, 215, 21, 133, 122, 14, 61, 154, 156, 144, 31,
-			239, 213, 248, 233, 64, 72, 132, 151, 118, 195, 110, 226, 153, 88,
-			219, 179, 27, 160, 82, 187, 109, 212, 143, 171, 252, 219, 16, 253,
-			161, 209, 201, 65, 222, 25, 9, 115, 170, 113, 66, 27, 200, 128,
-			55, 25, 30, 78, 250, 242, 54, 128, 77, 61, 59, 114, 182, 89,
-			151, 94, 203, 85, 108, 117, 216, 225, 120, 132, 134, 149, 192, 105,
-			98, 199, 84, 245, 212, 117, 110, 128, 90, 172, 134, 195, 183, 149,
-			69, 69, 210, 165, 93, 121, 103, 162, 18, 93, 5, 41, 229, 138,
-			141, 94, 59, 234, 214, 167, 249, 65, 9, 180, 181, 137, 220, 116,
-			139, 1, 119, 38, 241, 69, 35, 20, 219, 114, 12, 202, 85, 84,
-			118, 41, 127, 59, 85, 93, 206, 133, 19, 38, 181, 244, 132, 130,
-			126, 190, 127, 152, 92, 146, 158, 79, 23, 117, 154, 201, 209, 117,
-			207, 15, 170, 12, 102, 10, 102, 192, 175, 237, 61, 3, 73, 159,
-			167, 139, 250, 193, 132, 207, 211, 197, 241, 195, 9, 159, 167, 139,
-			199, 142, 147, 95, 208, 185, 35, 210, 189, 125, 15, 105, 153, 127,
-			173, 211, 39, 80, 145, 235, 162, 146, 61, 90, 18, 4, 33, 110,
-			97, 112, 9, 70, 40, 104, 209, 57, 152, 81, 84, 149, 115, 124,
-			86, 208, 93, 202, 166, 160, 246, 50, 27, 20, 18, 26, 176, 74,
-			43, 8, 97, 206, 197, 164, 9, 210, 243, 3, 106, 19, 37, 148,
-			37, 77, 21, 132, 240, 226, 135, 65, 188, 245, 14, 247, 24, 159,
-			134, 44, 162, 190, 199, 228, 148, 18, 186, 109, 7, 142, 237, 69,
-			97, 142, 218, 155, 33, 238, 172, 163, 9, 215, 150, 47, 168, 227,
-			85, 209, 122, 196, 3, 57, 220, 134, 190, 237, 196, 9, 236, 200,
-			121, 195, 142, 136, 105, 211, 52, 203, 184, 183, 127, 63, 201, 18,
-			211, 212, 250, 251, 44, 243, 126, 125, 209, 200, 28, 196, 250, 64,
-			205, 86, 29, 151, 238, 75, 253, 48, 35, 247, 247, 15, 145, 83,
-			210, 231, 171, 96, 30, 205, 100, 104, 57, 137, 122, 192, 102, 231,
-			103, 56, 173, 5, 115, 127, 194, 175, 171, 48, 60, 149, 240, 235,
-			42, 28, 62, 66, 102, 164, 91, 215, 188, 121, 36, 115, 164, 179,
-			202, 170, 223, 218, 116, 89, 87, 165, 90, 10, 202, 146, 132, 43,
-			213, 252, 224, 100, 194, 149, 106, 62, 115, 88, 84, 170, 91, 70,
-			113, 119, 165, 226, 36, 172, 179, 82, 61, 5, 101, 21, 164, 89,
-			70, 81, 85, 170, 27, 150, 81, 204, 28, 38, 167, 177, 82, 195,
-			50, 174, 153, 83, 153, 163, 157, 149, 10, 114, 233, 170, 213, 72,
-			65, 97, 233, 245, 101, 104, 150, 113, 77, 184, 215, 105, 232, 30,
-			122, 109, 98, 146, 156, 193, 90, 77, 203, 40, 153, 71, 50, 116,
-			87, 87, 37, 207, 235, 172, 216, 76, 67, 121, 5, 105, 150, 81,
-			82, 221, 53, 13, 203, 40, 101, 14, 139, 138, 83, 150, 241, 160,
-			121, 180, 187, 226, 128, 53, 249, 129, 148, 32, 19, 85, 113, 42,
-			13, 229, 229, 140, 165, 52, 203, 120, 80, 205, 88, 202, 176, 140,
-			7, 15, 31, 33, 255, 82, 227, 14, 173, 171, 125, 15, 107, 153,
-			127, 162, 209, 39, 148, 85, 133, 228, 102, 211, 208, 241, 182, 92,
-			22, 249, 30, 101, 94, 171, 145, 112, 146, 87, 116, 202, 53, 68,
-			69, 48, 146, 45, 17, 69, 182, 184, 143, 209, 242, 132, 242, 118,
-			107, 74, 239, 108, 30, 139, 61, 1, 117, 139, 109, 4, 116, 57,
-			93, 77, 13, 147, 140, 116, 57, 93, 215, 71, 51, 251, 233, 82,
-			23, 189, 10, 15, 212, 117, 125, 40, 225, 129, 186, 126, 96, 132,
-			124, 151, 134, 142, 121, 230, 99, 125, 255, 64, 203, 108, 211, 39,
-			148, 5, 42, 134, 187, 19, 216, 205, 38, 11, 168, 29, 248, 45,
-			175, 154, 196, 174, 242, 101, 87, 236, 238, 214, 35, 233, 172, 25,
-			139, 217, 65, 128, 206, 55, 210, 31, 240, 177, 254, 81, 114, 89,
-			184, 3, 26, 143, 235, 71, 50, 103, 96, 102, 187, 154, 187, 13,
-			191, 213, 245, 62, 19, 62, 86, 80, 218, 50, 30, 31, 28, 145,
-			144, 102, 25, 143, 143, 78, 74, 200, 176, 140, 199, 51, 135, 213,
-			30, 204, 175, 205, 146, 227, 221, 123, 48, 145, 211, 96, 97, 100,
-			55, 154, 123, 109, 195, 92, 33, 3, 107, 178, 140, 53, 69, 246,
-			133, 12, 76, 152, 16, 247, 58, 140, 178, 4, 173, 131, 36, 229,
-			217, 158, 31, 226, 38, 70, 170, 204, 129, 185, 183, 244, 54, 204,
-			135, 85, 141, 210, 54, 191, 243, 246, 182, 185, 234, 233, 11, 176,
-			202, 191, 114, 134, 236, 179, 82, 199, 250, 190, 79, 123, 197, 44,
-			127, 197, 44, 127, 197, 44, 127, 197, 44, 127, 197, 44, 127, 197,
-			44, 255, 59, 55, 203, 231, 98, 179, 124, 238, 214, 102, 249, 108,
-			108, 150, 207, 190, 0, 179, 252, 207, 14, 163, 89, 158, 122, 11,
-			72, 190, 204, 215, 14, 211, 2, 85, 34, 183, 211, 232, 106, 250,
-			142, 199, 93, 146, 157, 6, 72, 153, 42, 107, 50, 175, 202, 248,
-			29, 1, 188, 148, 6, 207, 159, 68, 147, 39, 192, 11, 223, 46,
-			161, 21, 219, 101, 94, 213, 14, 114, 148, 121, 192, 252, 133, 115,
-			84, 69, 222, 45, 16, 74, 1, 178, 210, 90, 96, 87, 98, 129,
-			33, 95, 128, 60, 0, 13, 1, 97, 16, 152, 190, 203, 121, 34,
-			247, 235, 197, 138, 28, 113, 43, 17, 172, 55, 126, 187, 135, 53,
-			253, 74, 157, 218, 17, 93, 95, 155, 167, 13, 167, 234, 33, 67,
-			247, 61, 66, 95, 103, 123, 45, 144, 2, 231, 114, 244, 220, 165,
-			123, 206, 230, 36, 159, 110, 6, 190, 203, 154, 145, 83, 161, 215,
-			2, 182, 229, 131, 69, 166, 122, 47, 12, 75, 118, 51, 98, 208,
-			39, 228, 207, 61, 74, 109, 218, 149, 27, 59, 118, 80, 197, 163,
-			188, 54, 179, 3, 176, 255, 100, 212, 135, 134, 227, 181, 34, 113,
-			133, 235, 238, 179, 106, 124, 174, 239, 109, 229, 233, 34, 179, 155,
-			241, 144, 3, 70, 179, 97, 131, 217, 1, 171, 102, 105, 232, 115,
-			249, 235, 249, 212, 101, 118, 147, 136, 98, 52, 66, 103, 37, 39,
-			164, 30, 99, 120, 253, 210, 23, 151, 39, 154, 32, 90, 185, 60,
-			111, 161, 223, 182, 77, 31, 59, 127, 241, 76, 221, 111, 5, 212,
-			117, 60, 102, 7, 132, 98, 237, 143, 79, 223, 90, 231, 128, 249,
-			156, 197, 146, 51, 82, 167, 13, 80, 201, 113, 66, 20, 9, 244,
-			236, 217, 179, 231, 206, 224, 127, 107, 103, 207, 94, 198, 255, 222,
-			8, 67, 191, 116, 233, 210, 165, 51, 231, 206, 159, 185, 112, 110,
-			237, 252, 133, 203, 119, 93, 186, 124, 215, 165, 252, 37, 249, 247,
-			198, 60, 157, 107, 19, 152, 200, 40, 112, 42, 184, 115, 18, 137,
-			33, 98, 237, 57, 186, 195, 40, 243, 66, 188, 155, 11, 79, 119,
-			24, 90, 244, 21, 223, 219, 102, 65, 196, 231, 151, 203, 36, 250,
-			88, 249, 234, 60, 161, 23, 46, 92, 184, 20, 143, 101, 103, 103,
-			39, 239, 176, 168, 134, 1, 83, 130, 90, 5, 254, 7, 37, 242,
-			209, 205, 104, 6, 20, 54, 233, 37, 137, 138, 250, 9, 90, 228,
-			187, 57, 33, 250, 167, 242, 179, 216, 115, 151, 233, 188, 223, 104,
-			182, 34, 150, 88, 11, 216, 224, 202, 242, 106, 233, 13, 244, 9,
-			192, 204, 244, 204, 19, 121, 161, 241, 196, 133, 148, 230, 41, 206,
-			72, 99, 157, 57, 100, 209, 134, 152, 224, 105, 252, 124, 105, 125,
-			113, 113, 102, 166, 103, 57, 164, 247, 233, 179, 51, 87, 18, 125,
-			58, 127, 187, 62, 109, 177, 8, 106, 241, 107, 85, 187, 157, 232,
-			155, 216, 204, 128, 87, 219, 182, 75, 163, 109, 209, 98, 71, 241,
-			87, 71, 219, 57, 138, 29, 186, 242, 98, 135, 180, 157, 143, 182,
-			1, 186, 213, 136, 120, 161, 86, 200, 42, 244, 52, 61, 119, 246,
-			108, 231, 8, 47, 236, 57, 194, 71, 28, 239, 194, 121, 250, 196,
-			53, 22, 173, 182, 195, 136, 53, 224, 117, 33, 188, 234, 184, 108,
-			173, 115, 34, 174, 150, 22, 139, 107, 165, 135, 138, 180, 22, 137,
-			110, 236, 245, 205, 171, 107, 145, 236, 233, 122, 105, 105, 237, 238,
-			139, 52, 114, 42, 55, 66, 122, 47, 157, 158, 158, 230, 79, 102,
-			106, 81, 190, 186, 115, 221, 217, 170, 47, 216, 17, 126, 53, 67,
-			95, 251, 90, 122, 225, 252, 12, 253, 95, 41, 190, 91, 244, 119,
-			228, 43, 137, 183, 217, 89, 90, 128, 254, 86, 49, 196, 131, 83,
-			185, 1, 139, 229, 220, 217, 179, 9, 30, 22, 230, 85, 1, 206,
-			165, 206, 221, 189, 123, 25, 169, 218, 224, 243, 115, 119, 95, 188,
-			120, 241, 158, 11, 119, 159, 141, 217, 134, 184, 218, 190, 238, 57,
-			55, 101, 45, 151, 238, 57, 219, 93, 75, 254, 197, 77, 230, 52,
-			31, 63, 157, 158, 230, 72, 153, 197, 201, 130, 191, 25, 122, 38,
-			217, 157, 219, 80, 48, 212, 3, 232, 146, 245, 156, 76, 212, 131,
-			4, 48, 211, 65, 0, 23, 247, 36, 128, 215, 217, 219, 54, 125,
-			130, 79, 100, 190, 210, 10, 2, 230, 69, 80, 228, 33, 199, 117,
-			157, 48, 65, 0, 192, 77, 105, 3, 159, 210, 123, 233, 222, 31,
-			220, 130, 204, 233, 189, 241, 211, 188, 199, 118, 230, 90, 142, 91,
-			101, 193, 244, 12, 12, 108, 85, 96, 72, 52, 193, 17, 51, 35,
-			221, 42, 40, 133, 50, 75, 124, 236, 142, 23, 193, 200, 69, 73,
-			62, 116, 49, 108, 196, 192, 76, 126, 19, 106, 198, 190, 196, 56,
-			184, 107, 79, 28, 136, 81, 72, 233, 75, 87, 218, 81, 93, 236,
-			155, 36, 209, 159, 236, 254, 244, 76, 247, 220, 92, 99, 209, 124,
-			140, 141, 233, 25, 228, 128, 184, 3, 241, 144, 221, 108, 58, 222,
-			22, 33, 180, 228, 241, 39, 220, 148, 229, 206, 192, 9, 60, 181,
-			155, 124, 167, 51, 41, 206, 197, 102, 27, 151, 164, 4, 217, 242,
-			11, 226, 202, 188, 41, 144, 232, 24, 221, 39, 199, 171, 225, 79,
-			161, 177, 236, 83, 32, 77, 159, 62, 243, 84, 195, 247, 162, 250,
-			211, 103, 158, 170, 218, 237, 167, 215, 158, 2, 145, 246, 244, 229,
-			167, 26, 142, 247, 244, 229, 167, 66, 86, 121, 250, 177, 252, 83,
-			160, 68, 0, 33, 63, 253, 248, 27, 179, 132, 238, 224, 77, 50,
-			254, 53, 191, 180, 190, 99, 183, 67, 25, 115, 10, 175, 29, 224,
-			189, 111, 144, 141, 85, 103, 203, 137, 66, 16, 245, 46, 163, 162,
-			165, 28, 197, 166, 114, 132, 242, 198, 114, 20, 91, 227, 81, 151,
-			176, 73, 148, 214, 79, 178, 192, 63, 211, 180, 171, 85, 113, 247,
-			119, 199, 151, 181, 49, 187, 82, 231, 154, 138, 212, 110, 64, 43,
-			18, 11, 45, 151, 216, 176, 222, 242, 105, 11, 207, 49, 46, 201,
-			79, 249, 37, 117, 254, 240, 92, 111, 29, 104, 38, 71, 176, 125,
-			95, 92, 218, 225, 45, 101, 223, 152, 165, 97, 171, 86, 115, 110,
-			38, 118, 148, 49, 28, 143, 211, 96, 168, 159, 77, 103, 215, 215,
-			230, 179, 51, 87, 58, 158, 146, 164, 247, 187, 216, 225, 142, 252,
-			11, 156, 24, 66, 52, 84, 157, 39, 89, 32, 157, 241, 5, 42,
-			91, 33, 67, 29, 107, 26, 99, 23, 84, 69, 192, 135, 205, 54,
-			129, 110, 204, 112, 191, 239, 102, 224, 120, 145, 188, 185, 215, 69,
-			74, 54, 238, 165, 37, 155, 194, 171, 157, 65, 50, 14, 16, 106,
-			58, 32, 247, 43, 24, 107, 13, 47, 31, 65, 155, 240, 45, 183,
-			164, 229, 24, 194, 93, 253, 192, 187, 45, 181, 90, 200, 34, 84,
-			98, 58, 206, 111, 178, 231, 207, 158, 187, 7, 120, 230, 185, 187,
-			214, 206, 158, 187, 124, 225, 236, 229, 115, 119, 229, 207, 158, 123,
-			99, 86, 80, 119, 72, 17, 86, 76, 183, 105, 135, 17, 161, 88,
-			18, 219, 247, 189, 88, 155, 188, 43, 135, 225, 231, 242, 98, 1,
-			217, 219, 246, 42, 158, 12, 229, 240, 12, 32, 169, 192, 216, 116,
-			1, 35, 50, 36, 34, 37, 129, 66, 197, 137, 157, 211, 35, 146,
-			127, 24, 217, 160, 85, 86, 9, 125, 44, 242, 75, 171, 203, 171,
-			184, 200, 166, 103, 122, 168, 109, 249, 134, 255, 164, 227, 186, 54,
-			174, 46, 230, 157, 89, 95, 157, 173, 250, 149, 112, 246, 17, 182,
-			57, 27, 119, 101, 182, 44, 93, 250, 103, 175, 185, 254, 166, 237,
-			110, 240, 120, 130, 225, 44, 116, 104, 54, 209, 200, 12, 81, 62,
-			101, 37, 201, 105, 248, 193, 9, 239, 18, 125, 2, 244, 40, 188,
-			23, 44, 127, 60, 33, 7, 36, 78, 95, 196, 104, 89, 149, 244,
-			28, 34, 161, 143, 61, 17, 70, 65, 13, 63, 77, 140, 200, 175,
-			132, 249, 38, 231, 108, 48, 150, 243, 179, 174, 179, 25, 216, 65,
-			27, 149, 209, 124, 61, 106, 184, 39, 240, 151, 252, 118, 6, 55,
-			34, 136, 34, 100, 217, 72, 216, 100, 21, 122, 234, 228, 163, 103,
-			78, 54, 206, 156, 172, 174, 157, 188, 126, 249, 228, 67, 151, 79,
-			174, 230, 79, 214, 222, 120, 42, 79, 23, 157, 27, 108, 199, 9,
-			241, 8, 15, 231, 42, 158, 165, 86, 200, 120, 109, 175, 243, 171,
-			54, 18, 235, 169, 144, 62, 246, 68, 105, 117, 89, 138, 250, 171,
-			156, 89, 85, 5, 56, 61, 243, 196, 227, 211, 36, 25, 176, 239,
-			77, 126, 149, 207, 4, 252, 56, 131, 90, 180, 221, 116, 112, 66,
-			228, 83, 174, 91, 243, 190, 206, 238, 174, 27, 199, 41, 27, 56,
-			121, 126, 225, 228, 249, 5, 66, 103, 0, 145, 42, 124, 32, 255,
-			54, 98, 1, 173, 216, 77, 92, 32, 126, 77, 70, 78, 66, 18,
-			146, 203, 44, 148, 49, 227, 36, 151, 37, 36, 62, 214, 123, 75,
-			255, 40, 249, 17, 77, 156, 235, 153, 223, 165, 233, 7, 51, 63,
-			160, 37, 15, 17, 36, 233, 251, 53, 164, 120, 68, 49, 143, 58,
-			17, 171, 30, 164, 183, 238, 65, 31, 106, 133, 120, 178, 122, 43,
-			91, 129, 244, 50, 22, 222, 200, 239, 232, 135, 206, 182, 188, 127,
-			209, 135, 87, 137, 190, 75, 211, 247, 73, 80, 3, 176, 255, 128,
-			4, 13, 0, 173, 49, 242, 53, 77, 196, 76, 48, 191, 87, 211,
-			173, 204, 127, 212, 232, 146, 239, 157, 241, 216, 22, 55, 14, 59,
-			76, 76, 91, 154, 82, 96, 93, 245, 54, 49, 151, 196, 135, 202,
-			234, 226, 59, 236, 124, 155, 46, 174, 12, 55, 19, 121, 112, 42,
-			188, 211, 238, 37, 219, 196, 170, 197, 135, 34, 4, 1, 183, 90,
-			107, 126, 0, 214, 162, 52, 169, 187, 17, 38, 44, 169, 156, 248,
-			31, 233, 129, 20, 45, 133, 227, 148, 72, 209, 112, 216, 253, 251,
-			37, 104, 0, 56, 50, 170, 118, 245, 255, 235, 15, 235, 228, 236,
-			243, 139, 43, 28, 176, 166, 159, 12, 44, 156, 185, 213, 109, 150,
-			204, 45, 253, 53, 51, 183, 59, 73, 200, 188, 208, 88, 199, 217,
-			83, 100, 255, 74, 192, 106, 206, 77, 25, 10, 119, 130, 164, 155,
-			248, 64, 120, 107, 10, 40, 251, 35, 58, 25, 230, 37, 31, 98,
-			145, 93, 181, 35, 123, 175, 162, 22, 37, 131, 53, 199, 219, 2,
-			251, 217, 241, 100, 0, 228, 228, 35, 235, 10, 25, 108, 53, 97,
-			85, 110, 192, 0, 208, 189, 178, 151, 115, 163, 18, 112, 101, 194,
-			139, 195, 3, 235, 184, 250, 184, 21, 178, 0, 29, 47, 7, 100,
-			129, 245, 144, 5, 86, 142, 152, 118, 197, 13, 167, 82, 232, 229,
-			40, 2, 186, 118, 246, 61, 95, 152, 95, 44, 99, 169, 76, 145,
-			24, 133, 249, 69, 235, 24, 49, 3, 223, 149, 238, 164, 132, 127,
-			84, 246, 93, 86, 198, 231, 214, 49, 66, 160, 239, 21, 167, 105,
-			187, 225, 148, 78, 13, 104, 52, 126, 146, 221, 32, 147, 37, 175,
-			206, 2, 39, 98, 213, 46, 60, 45, 144, 177, 38, 11, 54, 56,
-			118, 54, 26, 226, 177, 112, 194, 60, 216, 171, 123, 229, 209, 38,
-			11, 58, 31, 101, 191, 71, 35, 227, 208, 159, 176, 228, 201, 25,
-			227, 62, 204, 214, 125, 36, 5, 93, 148, 110, 157, 211, 113, 223,
-			119, 149, 197, 167, 234, 33, 255, 44, 147, 39, 67, 201, 199, 183,
-			67, 69, 182, 73, 70, 23, 157, 48, 122, 94, 116, 99, 29, 33,
-			3, 202, 31, 0, 73, 161, 191, 28, 63, 176, 78, 146, 97, 17,
-			68, 100, 163, 238, 84, 171, 204, 67, 90, 232, 47, 239, 23, 79,
-			175, 227, 195, 236, 34, 177, 146, 45, 138, 113, 103, 72, 191, 8,
-			213, 201, 135, 62, 80, 86, 48, 190, 195, 210, 76, 78, 150, 130,
-			179, 167, 201, 240, 10, 47, 39, 59, 63, 69, 246, 137, 47, 69,
-			239, 37, 152, 253, 73, 141, 244, 151, 132, 35, 248, 222, 197, 172,
-			59, 73, 191, 116, 23, 223, 43, 10, 181, 42, 96, 157, 32, 251,
-			3, 182, 229, 132, 17, 11, 88, 117, 99, 179, 205, 221, 139, 203,
-			67, 241, 195, 185, 182, 117, 127, 71, 161, 40, 20, 113, 158, 111,
-			181, 72, 18, 21, 172, 133, 217, 143, 104, 100, 170, 44, 30, 200,
-			17, 40, 212, 157, 85, 161, 166, 249, 36, 139, 69, 194, 203, 243,
-			243, 231, 174, 128, 211, 167, 119, 141, 80, 4, 247, 86, 117, 199,
-			3, 60, 79, 6, 84, 56, 109, 177, 184, 123, 7, 46, 46, 247,
-			203, 216, 218, 89, 151, 28, 132, 41, 150, 181, 133, 183, 157, 26,
-			235, 48, 25, 104, 218, 91, 108, 35, 116, 158, 100, 83, 7, 241,
-			4, 180, 31, 30, 172, 58, 79, 50, 235, 40, 33, 248, 18, 111,
-			220, 78, 141, 243, 80, 219, 240, 100, 13, 30, 100, 27, 100, 188,
-			171, 53, 129, 152, 28, 25, 80, 113, 100, 197, 122, 234, 30, 103,
-			92, 192, 122, 53, 57, 224, 177, 155, 209, 70, 162, 169, 131, 216,
-			212, 126, 120, 188, 162, 154, 251, 135, 26, 153, 88, 101, 118, 80,
-			169, 191, 128, 241, 29, 37, 102, 100, 111, 113, 242, 29, 60, 63,
-			192, 123, 177, 102, 111, 149, 241, 241, 223, 104, 248, 62, 153, 220,
-			213, 157, 151, 21, 1, 159, 209, 136, 81, 102, 53, 203, 34, 38,
-			134, 171, 231, 67, 197, 223, 73, 12, 232, 123, 175, 42, 227, 118,
-			171, 234, 56, 25, 228, 71, 176, 124, 77, 9, 177, 32, 31, 205,
-			181, 65, 232, 168, 2, 81, 40, 188, 241, 111, 41, 116, 100, 241,
-			181, 48, 251, 0, 25, 89, 96, 46, 139, 24, 52, 42, 166, 238,
-			5, 13, 38, 123, 39, 57, 0, 36, 87, 102, 181, 219, 207, 125,
-			246, 28, 25, 137, 11, 139, 153, 57, 74, 204, 128, 213, 228, 164,
-			12, 200, 21, 91, 43, 227, 227, 236, 247, 107, 196, 88, 179, 183,
-			122, 220, 181, 56, 152, 188, 107, 49, 32, 238, 84, 0, 190, 236,
-			40, 178, 43, 245, 36, 15, 34, 242, 17, 199, 151, 42, 240, 188,
-			248, 143, 250, 120, 45, 204, 182, 201, 104, 1, 161, 53, 123, 235,
-			121, 208, 250, 11, 226, 159, 114, 97, 24, 61, 23, 6, 52, 189,
-			192, 254, 110, 154, 126, 144, 140, 151, 65, 231, 221, 102, 34, 8,
-			250, 237, 155, 159, 34, 251, 182, 121, 89, 73, 48, 2, 204, 254,
-			3, 50, 126, 141, 41, 22, 149, 200, 20, 241, 210, 140, 37, 251,
-			110, 157, 12, 172, 4, 126, 133, 133, 161, 31, 88, 195, 68, 87,
-			73, 11, 116, 167, 106, 221, 73, 82, 24, 225, 79, 100, 114, 24,
-			151, 106, 138, 40, 159, 7, 241, 192, 202, 188, 12, 144, 138, 188,
-			88, 14, 164, 242, 60, 244, 57, 89, 124, 45, 180, 102, 73, 154,
-			199, 162, 16, 36, 182, 215, 5, 153, 178, 40, 6, 244, 140, 46,
-			125, 34, 149, 1, 7, 178, 87, 73, 10, 251, 100, 141, 147, 209,
-			213, 181, 194, 90, 177, 43, 239, 192, 32, 217, 183, 82, 92, 138,
-			179, 14, 172, 174, 207, 207, 23, 139, 11, 197, 133, 17, 221, 34,
-			36, 205, 163, 35, 142, 24, 217, 223, 214, 200, 228, 130, 112, 16,
-			141, 229, 230, 75, 74, 69, 39, 200, 126, 233, 130, 186, 129, 75,
-			154, 43, 61, 67, 242, 33, 44, 251, 142, 66, 72, 115, 102, 103,
-			33, 160, 110, 107, 150, 140, 169, 66, 77, 57, 55, 156, 183, 245,
-			151, 45, 249, 74, 205, 90, 152, 253, 164, 70, 166, 118, 143, 78,
-			112, 152, 164, 140, 215, 110, 35, 227, 37, 55, 210, 123, 114, 163,
-			219, 44, 20, 107, 22, 180, 105, 213, 93, 19, 11, 29, 232, 34,
-			177, 114, 162, 8, 44, 6, 217, 109, 30, 211, 246, 37, 94, 12,
-			255, 74, 39, 19, 221, 13, 188, 8, 172, 228, 9, 225, 129, 54,
-			96, 94, 247, 204, 71, 82, 17, 13, 212, 172, 139, 100, 191, 40,
-			207, 61, 106, 122, 137, 57, 88, 249, 67, 188, 212, 28, 22, 2,
-			214, 45, 190, 66, 225, 111, 162, 87, 152, 104, 24, 197, 255, 113,
-			50, 232, 178, 45, 187, 210, 222, 8, 235, 246, 57, 177, 78, 8,
-			127, 180, 90, 183, 207, 89, 247, 18, 43, 238, 231, 134, 237, 58,
-			118, 200, 194, 169, 116, 114, 30, 226, 254, 142, 168, 254, 22, 120,
-			193, 211, 247, 19, 19, 236, 1, 235, 32, 25, 41, 47, 47, 118,
-			175, 52, 66, 210, 229, 98, 97, 161, 88, 230, 137, 66, 30, 41,
-			151, 214, 138, 229, 17, 221, 26, 32, 41, 244, 212, 24, 49, 78,
-			135, 196, 218, 173, 107, 90, 39, 200, 241, 114, 241, 90, 105, 117,
-			173, 92, 88, 43, 45, 47, 245, 206, 31, 50, 76, 8, 47, 84,
-			44, 23, 23, 70, 52, 107, 130, 88, 50, 24, 87, 226, 185, 110,
-			141, 144, 161, 165, 229, 181, 13, 30, 2, 22, 86, 246, 249, 127,
-			75, 8, 41, 171, 152, 254, 214, 125, 100, 244, 26, 139, 186, 108,
-			181, 177, 164, 57, 38, 179, 110, 244, 180, 209, 172, 21, 146, 65,
-			254, 220, 219, 232, 235, 89, 209, 81, 73, 56, 189, 191, 153, 35,
-			7, 215, 209, 140, 237, 122, 222, 179, 253, 61, 122, 181, 64, 70,
-			174, 177, 168, 195, 248, 235, 221, 151, 195, 183, 48, 19, 173, 251,
-			9, 137, 13, 46, 107, 146, 23, 221, 101, 244, 101, 166, 118, 191,
-			16, 21, 92, 33, 131, 215, 157, 42, 19, 118, 150, 26, 65, 135,
-			217, 149, 217, 125, 1, 17, 47, 186, 90, 247, 146, 253, 235, 94,
-			253, 111, 242, 57, 215, 213, 94, 220, 231, 115, 100, 164, 219, 110,
-			178, 186, 86, 124, 230, 88, 210, 94, 234, 97, 95, 93, 39, 251,
-			59, 236, 11, 43, 19, 99, 170, 219, 4, 144, 51, 209, 219, 32,
-			89, 34, 7, 186, 84, 117, 235, 8, 47, 223, 219, 160, 144, 52,
-			182, 151, 126, 159, 39, 3, 243, 24, 92, 7, 216, 79, 204, 182,
-			247, 196, 198, 21, 50, 160, 20, 95, 107, 130, 151, 239, 214, 132,
-			247, 252, 248, 18, 233, 151, 106, 172, 53, 30, 143, 50, 161, 3,
-			103, 38, 186, 31, 139, 126, 222, 75, 72, 172, 64, 74, 10, 220,
-			165, 82, 222, 130, 6, 72, 172, 4, 202, 207, 119, 169, 133, 183,
-			248, 124, 184, 83, 145, 179, 228, 114, 233, 165, 222, 101, 186, 200,
-			195, 186, 143, 12, 119, 170, 110, 242, 243, 158, 10, 221, 238, 156,
-			68, 175, 7, 107, 163, 83, 72, 91, 71, 229, 24, 122, 170, 38,
-			146, 34, 247, 148, 237, 15, 146, 225, 78, 249, 38, 187, 212, 83,
-			172, 102, 142, 244, 126, 201, 43, 123, 97, 249, 134, 158, 251, 150,
-			198, 19, 14, 125, 56, 253, 74, 194, 161, 87, 18, 14, 253, 109,
-			36, 28, 26, 32, 186, 209, 103, 25, 7, 251, 78, 226, 79, 205,
-			50, 198, 251, 94, 141, 63, 117, 203, 152, 232, 155, 193, 159, 134,
-			101, 76, 246, 93, 34, 127, 60, 134, 201, 137, 82, 215, 250, 190,
-			166, 107, 153, 223, 30, 163, 15, 217, 30, 222, 61, 75, 38, 23,
-			82, 30, 202, 78, 64, 237, 48, 244, 43, 60, 161, 139, 220, 203,
-			69, 207, 180, 56, 17, 17, 222, 238, 177, 27, 172, 74, 43, 190,
-			235, 178, 138, 76, 114, 34, 28, 250, 48, 61, 132, 176, 242, 196,
-			241, 201, 86, 224, 183, 154, 252, 66, 30, 166, 55, 74, 212, 38,
-			78, 111, 69, 148, 11, 55, 118, 57, 6, 196, 202, 187, 35, 177,
-			55, 49, 144, 178, 239, 161, 47, 97, 64, 208, 171, 30, 131, 37,
-			2, 245, 3, 225, 122, 91, 174, 47, 111, 152, 228, 147, 125, 22,
-			252, 130, 119, 190, 226, 123, 149, 128, 69, 240, 184, 98, 7, 30,
-			143, 13, 43, 14, 122, 90, 149, 186, 236, 252, 101, 145, 144, 193,
-			166, 1, 19, 89, 150, 114, 137, 60, 13, 42, 108, 30, 146, 181,
-			19, 133, 148, 231, 140, 163, 211, 208, 181, 213, 235, 133, 115, 56,
-			6, 223, 133, 249, 149, 136, 158, 17, 217, 147, 114, 29, 247, 252,
-			54, 219, 84, 233, 163, 226, 134, 158, 116, 145, 144, 254, 252, 114,
-			14, 84, 236, 52, 121, 208, 174, 114, 204, 236, 174, 33, 242, 19,
-			46, 23, 33, 15, 212, 198, 3, 214, 97, 20, 190, 172, 194, 74,
-			105, 33, 155, 24, 152, 244, 248, 22, 3, 108, 247, 74, 65, 213,
-			240, 195, 200, 109, 227, 197, 70, 32, 93, 244, 39, 172, 217, 21,
-			76, 195, 225, 39, 130, 214, 35, 149, 85, 252, 70, 3, 170, 119,
-			29, 79, 70, 234, 227, 41, 24, 48, 46, 30, 212, 209, 41, 119,
-			104, 121, 101, 126, 6, 51, 2, 156, 198, 115, 120, 174, 217, 195,
-			44, 132, 64, 82, 136, 90, 142, 70, 145, 250, 67, 184, 152, 32,
-			115, 235, 12, 120, 142, 175, 128, 159, 112, 238, 197, 221, 40, 132,
-			71, 130, 8, 17, 9, 168, 190, 120, 150, 86, 234, 118, 64, 11,
-			171, 243, 165, 146, 116, 251, 155, 201, 243, 46, 44, 35, 55, 232,
-			213, 32, 63, 132, 223, 180, 67, 118, 247, 197, 105, 94, 128, 222,
-			73, 31, 123, 124, 179, 29, 177, 167, 2, 86, 83, 169, 33, 159,
-			158, 81, 29, 96, 1, 163, 167, 248, 39, 24, 47, 117, 189, 188,
-			120, 38, 180, 107, 220, 193, 161, 229, 9, 231, 16, 140, 148, 122,
-			39, 22, 0, 106, 181, 35, 230, 217, 9, 7, 127, 192, 138, 160,
-			54, 217, 47, 100, 252, 201, 33, 93, 236, 49, 36, 126, 170, 200,
-			19, 215, 68, 117, 58, 207, 189, 16, 4, 21, 224, 226, 196, 12,
-			49, 33, 115, 183, 133, 7, 234, 147, 78, 147, 130, 170, 229, 108,
-			203, 211, 76, 188, 34, 107, 87, 249, 221, 6, 219, 77, 112, 9,
-			144, 112, 109, 17, 212, 21, 111, 75, 240, 113, 84, 124, 47, 108,
-			53, 56, 77, 128, 228, 164, 243, 139, 37, 69, 6, 242, 106, 132,
-			140, 231, 203, 9, 139, 168, 120, 193, 21, 168, 206, 222, 244, 91,
-			145, 224, 79, 61, 226, 126, 19, 66, 133, 242, 43, 238, 51, 184,
-			190, 127, 131, 95, 174, 13, 124, 63, 66, 17, 132, 34, 49, 68,
-			63, 47, 145, 220, 96, 154, 229, 183, 242, 52, 107, 207, 110, 206,
-			86, 178, 51, 232, 17, 67, 186, 99, 119, 118, 48, 61, 78, 53,
-			120, 143, 163, 229, 186, 88, 11, 126, 37, 211, 112, 121, 190, 74,
-			69, 150, 85, 94, 198, 216, 86, 22, 111, 135, 102, 243, 121, 254,
-			195, 246, 218, 194, 29, 37, 116, 26, 14, 44, 102, 149, 136, 33,
-			228, 158, 57, 242, 202, 6, 178, 0, 215, 14, 35, 172, 39, 193,
-			253, 234, 54, 54, 233, 120, 48, 175, 161, 83, 193, 0, 240, 120,
-			65, 7, 102, 146, 11, 176, 28, 141, 234, 152, 77, 133, 47, 107,
-			191, 22, 49, 143, 168, 213, 93, 101, 158, 31, 49, 24, 162, 107,
-			71, 120, 211, 37, 153, 28, 206, 225, 201, 31, 60, 225, 48, 220,
-			113, 159, 153, 0, 231, 168, 5, 246, 108, 228, 251, 110, 200, 149,
-			33, 215, 241, 90, 55, 207, 216, 141, 234, 221, 23, 179, 123, 85,
-			66, 23, 161, 16, 13, 90, 30, 74, 69, 144, 212, 80, 158, 112,
-			250, 138, 152, 8, 226, 46, 67, 69, 242, 96, 182, 220, 63, 196,
-			19, 215, 142, 20, 143, 2, 134, 207, 60, 204, 124, 90, 165, 30,
-			48, 92, 148, 163, 97, 228, 251, 61, 178, 180, 209, 162, 93, 169,
-			83, 230, 178, 134, 240, 66, 79, 14, 21, 73, 166, 9, 188, 107,
-			154, 7, 150, 4, 214, 40, 95, 34, 218, 197, 25, 49, 76, 29,
-			116, 138, 208, 172, 120, 50, 131, 158, 32, 120, 146, 143, 171, 66,
-			46, 5, 42, 183, 138, 145, 141, 70, 121, 42, 124, 233, 128, 99,
-			38, 242, 10, 248, 30, 80, 186, 237, 201, 140, 77, 120, 253, 198,
-			119, 249, 13, 162, 233, 194, 252, 226, 140, 200, 187, 37, 66, 238,
-			135, 24, 232, 154, 226, 177, 38, 46, 51, 76, 255, 196, 91, 225,
-			33, 176, 91, 152, 137, 64, 234, 36, 60, 202, 47, 239, 43, 247,
-			159, 66, 178, 21, 34, 31, 17, 230, 49, 24, 32, 134, 199, 239,
-			116, 121, 194, 170, 49, 29, 31, 238, 117, 96, 163, 34, 240, 84,
-			240, 128, 40, 149, 175, 248, 13, 96, 53, 133, 249, 197, 164, 218,
-			192, 29, 109, 68, 179, 98, 125, 97, 95, 194, 221, 95, 199, 170,
-			17, 191, 16, 106, 119, 116, 146, 36, 68, 142, 95, 83, 117, 225,
-			80, 188, 118, 60, 148, 77, 230, 250, 59, 128, 218, 228, 154, 158,
-			173, 194, 170, 6, 125, 173, 236, 3, 209, 50, 175, 213, 64, 212,
-			200, 11, 90, 136, 71, 225, 176, 46, 215, 112, 205, 241, 64, 90,
-			199, 189, 2, 60, 128, 188, 117, 34, 144, 118, 161, 83, 101, 157,
-			235, 4, 131, 22, 99, 66, 141, 60, 39, 77, 188, 149, 134, 25,
-			183, 28, 233, 141, 230, 66, 239, 228, 240, 144, 80, 18, 114, 188,
-			91, 184, 230, 226, 59, 119, 182, 188, 45, 150, 68, 77, 7, 70,
-			18, 93, 73, 36, 35, 188, 214, 111, 145, 191, 212, 101, 50, 194,
-			21, 253, 129, 204, 255, 163, 171, 180, 63, 49, 141, 118, 206, 216,
-			46, 138, 225, 217, 121, 80, 113, 231, 92, 143, 7, 99, 86, 249,
-			24, 248, 181, 36, 164, 139, 154, 138, 59, 134, 230, 21, 94, 9,
-			196, 217, 151, 204, 174, 70, 96, 200, 176, 248, 228, 49, 117, 142,
-			115, 192, 29, 71, 134, 137, 135, 177, 213, 48, 180, 1, 246, 102,
-			87, 204, 230, 248, 158, 187, 76, 32, 199, 187, 3, 156, 144, 119,
-			37, 49, 107, 96, 199, 96, 187, 187, 155, 221, 228, 146, 132, 236,
-			238, 172, 224, 169, 123, 34, 200, 17, 62, 174, 93, 93, 37, 137,
-			188, 69, 178, 143, 113, 14, 198, 149, 244, 100, 34, 7, 227, 202,
-			212, 201, 68, 14, 198, 149, 179, 247, 145, 191, 50, 100, 14, 198,
-			199, 244, 114, 230, 27, 198, 11, 159, 167, 196, 202, 238, 24, 234,
-			223, 219, 249, 91, 2, 185, 195, 35, 79, 239, 154, 201, 78, 126,
-			16, 10, 99, 75, 225, 66, 93, 12, 239, 238, 105, 142, 39, 55,
-			194, 104, 253, 60, 218, 50, 235, 250, 172, 7, 134, 114, 68, 184,
-			168, 9, 153, 194, 239, 199, 192, 138, 13, 67, 214, 192, 213, 43,
-			195, 226, 239, 234, 130, 196, 208, 180, 19, 145, 152, 163, 59, 114,
-			235, 19, 52, 160, 38, 11, 34, 96, 218, 232, 165, 213, 163, 249,
-			153, 142, 252, 121, 143, 165, 105, 34, 127, 222, 99, 119, 228, 19,
-			249, 243, 30, 187, 180, 66, 62, 189, 79, 230, 207, 123, 179, 190,
-			144, 249, 232, 62, 202, 247, 82, 209, 60, 173, 224, 150, 215, 255,
-			31, 214, 246, 90, 50, 227, 97, 157, 85, 110, 132, 244, 84, 194,
-			181, 234, 84, 103, 62, 184, 206, 205, 97, 21, 39, 165, 36, 20,
-			167, 93, 115, 214, 185, 108, 186, 18, 97, 168, 75, 186, 113, 115,
-			202, 10, 19, 131, 87, 90, 41, 230, 36, 224, 99, 19, 222, 246,
-			137, 175, 114, 73, 124, 117, 48, 138, 94, 57, 202, 186, 217, 154,
-			234, 116, 71, 138, 51, 218, 198, 156, 0, 81, 216, 217, 189, 80,
-			68, 251, 151, 249, 109, 209, 215, 142, 8, 109, 63, 79, 87, 125,
-			218, 180, 67, 25, 86, 49, 249, 37, 186, 91, 35, 217, 112, 127,
-			124, 39, 114, 146, 68, 206, 49, 25, 238, 230, 182, 80, 29, 235,
-			81, 161, 226, 171, 9, 26, 236, 196, 47, 217, 3, 43, 221, 185,
-			70, 122, 176, 137, 68, 170, 91, 204, 69, 10, 202, 171, 11, 246,
-			123, 220, 95, 177, 138, 49, 71, 11, 15, 174, 158, 167, 43, 118,
-			200, 61, 136, 121, 111, 123, 18, 11, 134, 211, 4, 93, 17, 58,
-			149, 76, 205, 91, 21, 55, 242, 150, 61, 26, 182, 184, 122, 38,
-			163, 93, 246, 174, 72, 173, 51, 238, 170, 87, 77, 98, 167, 35,
-			139, 224, 155, 211, 153, 68, 22, 193, 55, 31, 158, 73, 100, 17,
-			124, 243, 197, 57, 242, 118, 83, 102, 17, 124, 139, 126, 53, 243,
-			223, 98, 137, 96, 243, 72, 49, 66, 113, 233, 230, 156, 226, 118,
-			98, 247, 42, 95, 247, 208, 14, 218, 117, 20, 36, 242, 217, 242,
-			16, 66, 130, 17, 240, 85, 47, 148, 209, 105, 197, 26, 197, 184,
-			9, 181, 169, 235, 99, 7, 120, 16, 34, 76, 22, 193, 125, 133,
-			241, 138, 1, 237, 62, 153, 81, 46, 222, 208, 75, 225, 99, 223,
-			22, 120, 45, 69, 82, 99, 183, 229, 181, 78, 118, 179, 137, 191,
-			249, 240, 4, 43, 229, 42, 17, 87, 175, 58, 40, 209, 225, 177,
-			145, 176, 207, 114, 197, 129, 241, 155, 144, 1, 34, 101, 42, 229,
-			71, 116, 216, 134, 208, 102, 167, 121, 224, 212, 182, 204, 147, 231,
-			98, 62, 171, 205, 182, 200, 117, 100, 123, 188, 222, 153, 100, 70,
-			76, 78, 69, 29, 83, 176, 91, 126, 121, 126, 199, 110, 159, 184,
-			139, 16, 134, 114, 51, 135, 115, 158, 8, 197, 129, 31, 240, 237,
-			38, 241, 180, 59, 141, 102, 156, 82, 240, 45, 233, 137, 68, 74,
-			193, 183, 76, 190, 42, 145, 82, 240, 45, 179, 11, 228, 131, 92,
-			52, 240, 188, 72, 133, 204, 187, 247, 209, 69, 76, 202, 219, 177,
-			187, 23, 182, 54, 37, 127, 166, 177, 39, 93, 98, 251, 49, 166,
-			25, 254, 181, 216, 5, 197, 196, 219, 34, 193, 108, 149, 133, 21,
-			230, 85, 49, 243, 144, 31, 196, 145, 144, 220, 54, 14, 40, 241,
-			90, 152, 211, 98, 74, 48, 30, 0, 134, 204, 114, 217, 77, 167,
-			226, 111, 5, 118, 179, 206, 247, 251, 118, 25, 30, 192, 171, 85,
-			199, 31, 203, 218, 217, 28, 234, 243, 252, 31, 80, 234, 197, 15,
-			54, 91, 203, 62, 158, 67, 101, 94, 68, 251, 202, 218, 89, 34,
-			243, 245, 136, 221, 163, 167, 100, 77, 151, 161, 170, 217, 77, 248,
-			66, 98, 129, 63, 170, 100, 31, 127, 26, 230, 81, 214, 227, 249,
-			222, 153, 196, 184, 242, 123, 212, 179, 71, 111, 186, 235, 150, 111,
-			121, 35, 226, 234, 153, 104, 169, 163, 149, 152, 204, 16, 223, 29,
-			51, 167, 166, 109, 219, 81, 54, 69, 76, 118, 194, 96, 230, 25,
-			5, 67, 202, 182, 193, 200, 71, 246, 46, 27, 178, 37, 133, 237,
-			166, 85, 49, 175, 220, 184, 144, 105, 131, 59, 131, 151, 145, 142,
-			105, 150, 117, 226, 122, 243, 125, 41, 243, 57, 218, 69, 226, 227,
-			14, 43, 179, 179, 77, 210, 213, 20, 157, 6, 133, 155, 95, 79,
-			224, 25, 97, 68, 242, 7, 135, 5, 118, 80, 169, 183, 227, 85,
-			138, 137, 193, 89, 117, 38, 135, 67, 68, 108, 238, 30, 143, 88,
-			222, 157, 150, 128, 236, 43, 166, 166, 219, 241, 131, 27, 152, 198,
-			164, 99, 237, 242, 116, 217, 181, 128, 193, 24, 121, 70, 63, 193,
-			82, 84, 142, 124, 225, 143, 47, 146, 48, 243, 116, 244, 177, 245,
-			37, 216, 73, 5, 88, 18, 168, 158, 48, 111, 50, 41, 42, 115,
-			67, 225, 137, 15, 139, 20, 115, 143, 165, 71, 36, 136, 185, 199,
-			70, 179, 18, 52, 0, 60, 115, 63, 249, 46, 110, 190, 165, 44,
-			243, 135, 53, 125, 46, 243, 103, 26, 77, 156, 55, 211, 134, 13,
-			131, 72, 218, 161, 118, 72, 185, 135, 177, 82, 162, 2, 166, 210,
-			200, 162, 2, 202, 79, 24, 228, 73, 182, 220, 41, 228, 114, 91,
-			178, 158, 88, 109, 179, 107, 53, 86, 1, 205, 54, 10, 69, 198,
-			157, 233, 216, 196, 21, 55, 24, 48, 105, 163, 189, 233, 178, 153,
-			14, 117, 178, 135, 238, 168, 118, 83, 56, 139, 81, 180, 190, 75,
-			17, 36, 113, 23, 20, 198, 82, 26, 32, 33, 61, 42, 65, 29,
-			64, 139, 74, 208, 0, 240, 206, 7, 48, 33, 100, 186, 79, 231,
-			9, 180, 22, 50, 31, 210, 104, 199, 33, 123, 111, 156, 201, 21,
-			101, 111, 217, 142, 200, 115, 115, 10, 80, 183, 205, 48, 212, 73,
-			45, 137, 245, 151, 117, 144, 105, 204, 244, 149, 30, 147, 160, 14,
-			224, 65, 73, 22, 105, 76, 252, 117, 102, 142, 124, 128, 39, 209,
-			222, 103, 153, 159, 132, 65, 190, 43, 69, 59, 92, 1, 212, 164,
-			39, 251, 51, 109, 227, 101, 87, 158, 198, 222, 117, 113, 74, 213,
-			38, 66, 142, 70, 246, 86, 152, 163, 1, 171, 133, 132, 178, 168,
-			50, 19, 83, 75, 76, 252, 93, 155, 36, 45, 175, 234, 231, 233,
-			178, 72, 41, 155, 220, 238, 227, 122, 89, 53, 39, 232, 100, 203,
-			247, 16, 63, 128, 79, 69, 152, 113, 26, 164, 186, 205, 195, 69,
-			68, 204, 67, 245, 19, 116, 216, 128, 217, 55, 48, 206, 157, 223,
-			10, 105, 211, 241, 60, 86, 165, 117, 39, 140, 252, 0, 163, 160,
-			207, 151, 86, 22, 136, 188, 245, 207, 55, 172, 185, 222, 222, 10,
-			229, 81, 146, 231, 71, 234, 16, 169, 98, 135, 45, 144, 50, 57,
-			190, 96, 29, 143, 178, 155, 81, 192, 26, 140, 103, 173, 202, 19,
-			58, 239, 227, 166, 14, 223, 217, 131, 53, 36, 185, 91, 114, 95,
-			6, 120, 22, 170, 61, 46, 227, 250, 178, 218, 47, 227, 120, 226,
-			231, 18, 24, 22, 136, 171, 34, 201, 84, 79, 130, 98, 236, 106,
-			195, 145, 59, 125, 151, 121, 119, 252, 29, 143, 5, 33, 90, 80,
-			93, 204, 6, 89, 43, 176, 36, 161, 232, 74, 46, 211, 157, 49,
-			121, 183, 37, 181, 75, 73, 226, 71, 99, 88, 33, 182, 135, 18,
-			173, 180, 244, 112, 97, 177, 180, 176, 81, 40, 95, 91, 199, 16,
-			46, 226, 51, 169, 158, 131, 17, 102, 187, 160, 219, 225, 161, 103,
-			175, 212, 204, 2, 61, 221, 90, 11, 39, 216, 125, 26, 80, 168,
-			34, 231, 125, 58, 128, 138, 156, 247, 25, 0, 158, 153, 35, 31,
-			78, 35, 57, 247, 91, 230, 231, 129, 156, 127, 56, 77, 165, 203,
-			73, 152, 220, 123, 87, 135, 119, 94, 55, 158, 166, 227, 124, 216,
-			42, 217, 24, 151, 142, 68, 38, 67, 84, 118, 8, 208, 201, 142,
-			45, 18, 158, 42, 253, 167, 205, 162, 25, 60, 225, 69, 19, 8,
-			227, 110, 96, 146, 249, 93, 9, 176, 49, 223, 124, 34, 29, 89,
-			50, 130, 35, 187, 201, 42, 173, 136, 117, 197, 120, 146, 71, 119,
-			56, 83, 231, 58, 146, 148, 201, 14, 244, 26, 229, 102, 91, 37,
-			162, 239, 118, 192, 193, 169, 56, 15, 75, 143, 38, 157, 191, 84,
-			138, 162, 68, 14, 43, 53, 63, 104, 138, 121, 85, 149, 178, 154,
-			35, 7, 255, 118, 229, 37, 227, 231, 89, 42, 95, 214, 202, 124,
-			156, 251, 205, 147, 73, 184, 55, 252, 166, 98, 17, 162, 30, 153,
-			110, 0, 187, 119, 33, 193, 25, 122, 37, 222, 86, 169, 179, 115,
-			220, 4, 233, 30, 35, 231, 197, 226, 184, 44, 145, 193, 17, 173,
-			48, 185, 159, 193, 7, 76, 99, 143, 56, 234, 249, 59, 201, 100,
-			151, 29, 7, 190, 130, 0, 18, 179, 190, 217, 198, 125, 88, 96,
-			79, 32, 157, 115, 177, 141, 179, 219, 217, 78, 52, 22, 31, 76,
-			224, 138, 74, 196, 221, 148, 84, 129, 151, 251, 112, 251, 132, 91,
-			7, 194, 236, 240, 3, 33, 45, 110, 35, 40, 72, 143, 213, 188,
-			91, 80, 244, 107, 176, 88, 210, 19, 18, 212, 1, 156, 60, 46,
-			65, 3, 192, 211, 115, 228, 171, 92, 26, 14, 88, 230, 23, 53,
-			253, 117, 153, 95, 209, 132, 82, 223, 177, 123, 172, 136, 47, 215,
-			145, 8, 190, 230, 4, 60, 143, 255, 94, 3, 147, 70, 148, 31,
-			112, 158, 55, 243, 55, 29, 154, 220, 100, 232, 201, 84, 212, 228,
-			196, 76, 72, 97, 99, 64, 131, 1, 42, 62, 51, 160, 3, 120,
-			112, 90, 130, 6, 128, 23, 174, 147, 231, 184, 54, 69, 48, 23,
-			216, 74, 230, 39, 226, 221, 240, 221, 71, 219, 168, 231, 241, 132,
-			195, 174, 43, 204, 105, 144, 145, 234, 76, 7, 250, 251, 250, 22,
-			11, 28, 113, 130, 211, 137, 211, 189, 182, 247, 249, 37, 253, 40,
-			73, 66, 132, 110, 225, 237, 106, 250, 230, 22, 11, 218, 51, 255,
-			223, 192, 56, 193, 12, 106, 233, 113, 9, 234, 0, 78, 228, 36,
-			136, 9, 213, 238, 89, 34, 191, 192, 77, 210, 65, 203, 252, 150,
-			166, 223, 149, 249, 196, 62, 58, 47, 246, 40, 57, 123, 13, 88,
-			141, 251, 138, 108, 139, 32, 182, 55, 165, 29, 39, 2, 33, 97,
-			9, 116, 190, 104, 180, 120, 4, 35, 238, 114, 130, 193, 165, 248,
-			78, 37, 162, 90, 125, 184, 139, 143, 242, 88, 186, 98, 11, 2,
-			181, 1, 84, 55, 132, 115, 71, 226, 164, 183, 43, 88, 115, 214,
-			133, 126, 70, 120, 220, 151, 13, 177, 237, 44, 151, 187, 53, 206,
-			249, 213, 129, 97, 85, 245, 98, 247, 132, 231, 105, 17, 143, 162,
-			100, 101, 56, 28, 143, 196, 246, 66, 182, 192, 15, 178, 178, 115,
-			89, 42, 61, 91, 64, 214, 163, 181, 93, 195, 251, 247, 17, 133,
-			46, 226, 30, 50, 16, 12, 158, 41, 11, 129, 99, 87, 234, 66,
-			141, 196, 211, 111, 95, 196, 77, 176, 177, 29, 30, 217, 74, 33,
-			130, 95, 246, 118, 221, 182, 200, 88, 206, 29, 54, 196, 203, 108,
-			213, 9, 43, 254, 54, 11, 196, 56, 133, 86, 131, 219, 66, 160,
-			214, 8, 137, 91, 147, 136, 228, 193, 138, 221, 54, 119, 215, 234,
-			88, 52, 98, 159, 172, 214, 114, 221, 54, 225, 59, 133, 226, 96,
-			194, 15, 163, 51, 65, 194, 33, 154, 10, 215, 119, 199, 219, 122,
-			209, 26, 141, 224, 174, 157, 219, 241, 47, 177, 114, 35, 234, 78,
-			144, 20, 199, 5, 18, 33, 218, 175, 93, 234, 15, 165, 61, 247,
-			120, 69, 181, 73, 161, 196, 77, 40, 190, 221, 36, 176, 33, 122,
-			83, 152, 91, 46, 175, 197, 227, 86, 31, 129, 182, 140, 196, 86,
-			227, 123, 111, 241, 245, 129, 94, 39, 85, 50, 212, 204, 46, 105,
-			200, 208, 178, 227, 214, 252, 102, 128, 25, 59, 113, 123, 104, 151,
-			242, 172, 150, 252, 160, 6, 171, 56, 125, 64, 130, 58, 128, 35,
-			146, 231, 14, 26, 0, 30, 187, 64, 190, 197, 69, 206, 144, 101,
-			254, 37, 152, 172, 127, 160, 209, 178, 176, 70, 144, 42, 197, 150,
-			46, 181, 177, 255, 160, 190, 243, 1, 74, 163, 2, 59, 42, 202,
-			253, 189, 37, 136, 222, 218, 238, 144, 6, 99, 86, 8, 26, 210,
-			1, 28, 145, 22, 234, 144, 1, 224, 157, 15, 144, 255, 206, 17,
-			180, 223, 50, 159, 209, 245, 43, 153, 63, 145, 50, 25, 108, 47,
-			158, 26, 138, 39, 67, 239, 45, 150, 97, 249, 138, 11, 123, 177,
-			132, 126, 81, 136, 18, 194, 228, 239, 0, 81, 251, 53, 24, 123,
-			122, 88, 130, 58, 128, 7, 142, 73, 208, 0, 112, 230, 18, 249,
-			250, 32, 34, 106, 216, 50, 255, 185, 174, 47, 100, 126, 103, 144,
-			114, 207, 104, 20, 184, 177, 143, 33, 74, 229, 78, 94, 7, 56,
-			89, 117, 92, 142, 174, 240, 134, 211, 140, 181, 191, 216, 37, 195,
-			230, 17, 221, 214, 80, 168, 7, 140, 102, 111, 176, 246, 101, 188,
-			53, 152, 165, 77, 219, 233, 177, 158, 18, 46, 131, 221, 98, 70,
-			122, 61, 37, 229, 12, 96, 22, 101, 58, 223, 230, 83, 90, 103,
-			204, 47, 81, 33, 247, 3, 41, 140, 72, 34, 150, 196, 101, 64,
-			165, 13, 189, 68, 241, 151, 112, 36, 129, 177, 99, 36, 27, 180,
-			29, 189, 4, 107, 232, 208, 229, 68, 140, 19, 123, 139, 182, 60,
-			231, 205, 45, 88, 231, 221, 25, 126, 213, 135, 124, 99, 184, 82,
-			199, 226, 221, 162, 210, 35, 20, 175, 206, 200, 211, 182, 132, 187,
-			160, 194, 95, 44, 91, 84, 24, 30, 117, 36, 197, 181, 27, 183,
-			157, 20, 152, 106, 23, 137, 139, 162, 144, 121, 24, 70, 197, 231,
-			248, 82, 7, 181, 61, 145, 38, 166, 66, 169, 100, 57, 106, 87,
-			2, 63, 132, 41, 118, 99, 193, 58, 163, 78, 242, 218, 180, 234,
-			3, 13, 162, 30, 197, 207, 199, 56, 70, 46, 211, 208, 110, 112,
-			20, 137, 32, 183, 9, 52, 19, 218, 104, 185, 145, 131, 169, 214,
-			98, 127, 71, 129, 2, 252, 206, 15, 18, 18, 90, 53, 139, 90,
-			139, 242, 138, 115, 219, 124, 103, 133, 78, 183, 248, 1, 12, 44,
-			242, 25, 126, 220, 32, 93, 162, 120, 151, 132, 39, 37, 24, 25,
-			78, 37, 199, 15, 164, 9, 239, 126, 139, 111, 94, 8, 193, 31,
-			43, 27, 241, 209, 50, 58, 56, 116, 105, 176, 50, 88, 20, 250,
-			78, 243, 106, 149, 30, 17, 27, 49, 177, 198, 130, 158, 45, 141,
-			166, 31, 68, 182, 39, 50, 66, 64, 199, 21, 37, 247, 160, 81,
-			28, 43, 162, 140, 43, 29, 128, 201, 151, 86, 233, 128, 30, 8,
-			122, 84, 115, 243, 63, 173, 218, 241, 63, 131, 146, 49, 172, 1,
-			183, 87, 251, 226, 195, 58, 128, 106, 95, 124, 216, 0, 240, 204,
-			28, 249, 46, 126, 56, 122, 192, 50, 127, 2, 68, 195, 159, 25,
-			148, 223, 122, 233, 37, 26, 248, 216, 218, 116, 135, 5, 172, 195,
-			122, 195, 53, 128, 155, 15, 61, 182, 12, 3, 191, 21, 57, 30,
-			131, 5, 204, 79, 64, 35, 41, 39, 58, 142, 218, 213, 210, 154,
-			86, 251, 143, 80, 75, 21, 123, 163, 54, 197, 75, 220, 243, 178,
-			82, 177, 67, 193, 22, 146, 57, 175, 107, 206, 77, 152, 153, 176,
-			18, 224, 134, 83, 40, 252, 53, 243, 221, 203, 33, 71, 104, 124,
-			183, 135, 239, 104, 184, 254, 142, 92, 17, 155, 109, 190, 191, 176,
-			42, 196, 128, 240, 75, 129, 222, 112, 98, 93, 205, 161, 47, 172,
-			92, 178, 80, 201, 204, 139, 165, 127, 175, 123, 47, 227, 239, 128,
-			254, 5, 77, 28, 208, 128, 8, 20, 197, 28, 208, 1, 84, 20,
-			115, 192, 0, 240, 204, 28, 249, 99, 238, 96, 53, 98, 153, 63,
-			175, 235, 247, 103, 126, 203, 160, 107, 200, 144, 98, 75, 80, 120,
-			200, 219, 50, 164, 147, 56, 53, 17, 254, 243, 183, 144, 252, 96,
-			166, 118, 85, 34, 121, 22, 58, 198, 136, 67, 200, 130, 120, 121,
-			70, 186, 141, 39, 4, 169, 56, 218, 206, 218, 155, 149, 42, 171,
-			229, 243, 249, 124, 54, 47, 191, 2, 75, 3, 68, 167, 44, 35,
-			76, 73, 245, 30, 69, 32, 127, 37, 111, 44, 156, 203, 159, 59,
-			155, 191, 144, 253, 251, 168, 26, 118, 232, 249, 137, 153, 22, 93,
-			191, 29, 91, 3, 17, 163, 102, 39, 242, 123, 8, 105, 69, 24,
-			35, 26, 204, 117, 250, 160, 4, 117, 0, 199, 79, 75, 208, 0,
-			240, 174, 123, 201, 219, 248, 166, 208, 168, 101, 254, 59, 93, 127,
-			32, 243, 23, 26, 93, 225, 94, 235, 60, 103, 201, 22, 104, 227,
-			235, 229, 197, 14, 175, 16, 233, 60, 141, 71, 95, 187, 142, 9,
-			148, 23, 253, 223, 123, 252, 39, 104, 152, 99, 101, 84, 3, 52,
-			40, 156, 141, 234, 0, 42, 156, 141, 26, 0, 222, 117, 31, 249,
-			13, 206, 126, 45, 203, 252, 138, 174, 175, 102, 62, 111, 170, 141,
-			180, 132, 39, 136, 112, 215, 223, 189, 181, 13, 104, 89, 192, 96,
-			224, 194, 255, 155, 231, 231, 194, 61, 5, 181, 183, 143, 195, 137,
-			119, 160, 100, 190, 43, 236, 182, 29, 242, 83, 98, 220, 122, 86,
-			24, 223, 177, 59, 60, 26, 48, 153, 69, 155, 238, 212, 253, 6,
-			63, 215, 226, 230, 186, 240, 163, 69, 39, 206, 228, 118, 30, 170,
-			124, 240, 20, 99, 61, 32, 15, 5, 221, 33, 150, 123, 98, 67,
-			208, 171, 162, 195, 75, 132, 103, 14, 182, 199, 247, 235, 30, 178,
-			219, 220, 213, 164, 99, 139, 41, 116, 26, 73, 202, 164, 252, 162,
-			79, 133, 113, 143, 182, 28, 42, 248, 194, 93, 2, 67, 27, 156,
-			38, 18, 19, 157, 88, 64, 169, 83, 179, 221, 144, 73, 159, 54,
-			245, 70, 236, 134, 243, 8, 168, 221, 8, 225, 30, 87, 127, 31,
-			137, 240, 118, 60, 221, 210, 128, 178, 212, 238, 182, 165, 3, 56,
-			153, 151, 160, 1, 224, 165, 215, 147, 183, 114, 158, 62, 102, 153,
-			255, 73, 215, 31, 202, 252, 23, 253, 150, 100, 200, 239, 32, 225,
-			125, 147, 132, 179, 52, 93, 79, 92, 83, 18, 111, 67, 230, 214,
-			206, 112, 255, 45, 78, 1, 85, 113, 189, 36, 137, 199, 87, 212,
-			182, 132, 218, 54, 166, 193, 28, 40, 190, 49, 166, 3, 168, 248,
-			198, 152, 1, 224, 93, 15, 146, 175, 243, 28, 81, 233, 111, 234,
-			125, 255, 67, 215, 50, 223, 175, 161, 79, 190, 48, 50, 28, 175,
-			107, 23, 155, 22, 230, 23, 67, 46, 94, 91, 33, 6, 174, 20,
-			151, 44, 146, 42, 30, 223, 47, 194, 90, 248, 85, 193, 238, 157,
-			112, 52, 203, 183, 24, 186, 231, 74, 175, 220, 77, 238, 168, 148,
-			240, 133, 202, 147, 243, 203, 179, 47, 233, 31, 33, 131, 60, 203,
-			148, 249, 77, 61, 53, 64, 134, 68, 154, 41, 243, 63, 235, 250,
-			36, 224, 133, 39, 150, 2, 208, 146, 160, 14, 224, 248, 4, 70,
-			216, 76, 97, 80, 202, 63, 215, 245, 253, 60, 194, 166, 93, 101,
-			1, 183, 133, 184, 208, 217, 125, 98, 129, 62, 66, 210, 203, 65,
-			186, 247, 113, 67, 91, 92, 117, 32, 73, 235, 16, 253, 254, 240,
-			208, 159, 91, 218, 53, 48, 153, 55, 91, 17, 191, 183, 192, 39,
-			160, 171, 26, 152, 143, 25, 62, 231, 41, 30, 61, 242, 207, 117,
-			189, 95, 130, 58, 128, 131, 67, 228, 123, 121, 239, 117, 203, 252,
-			54, 244, 254, 73, 250, 8, 32, 93, 116, 190, 234, 83, 182, 205,
-			130, 54, 119, 137, 145, 202, 173, 28, 91, 142, 54, 221, 150, 116,
-			221, 196, 3, 2, 105, 87, 231, 136, 60, 69, 236, 97, 232, 114,
-			30, 46, 70, 211, 240, 183, 185, 153, 173, 58, 10, 136, 252, 118,
-			220, 81, 222, 179, 193, 33, 242, 9, 222, 81, 195, 50, 255, 90,
-			215, 135, 50, 63, 170, 209, 101, 126, 18, 191, 71, 71, 119, 226,
-			113, 136, 142, 162, 23, 83, 55, 174, 106, 126, 64, 122, 249, 121,
-			43, 7, 253, 4, 217, 241, 77, 10, 220, 208, 107, 239, 93, 81,
-			7, 157, 138, 81, 24, 26, 118, 123, 159, 4, 117, 0, 201, 32,
-			25, 194, 244, 28, 233, 183, 26, 125, 111, 51, 52, 32, 65, 19,
-			168, 236, 173, 70, 255, 56, 185, 87, 198, 109, 125, 198, 208, 15,
-			102, 102, 49, 98, 179, 240, 218, 130, 81, 118, 29, 170, 43, 149,
-			20, 47, 219, 116, 196, 85, 125, 198, 224, 200, 20, 113, 85, 159,
-			49, 6, 146, 113, 85, 159, 49, 172, 49, 242, 131, 26, 166, 101,
-			76, 191, 195, 232, 251, 87, 134, 150, 121, 70, 235, 118, 151, 117,
-			18, 110, 234, 114, 211, 19, 172, 120, 224, 94, 187, 116, 237, 216,
-			39, 177, 20, 41, 23, 55, 25, 38, 184, 235, 250, 67, 7, 118,
-			59, 157, 235, 6, 121, 150, 67, 243, 29, 70, 255, 4, 25, 38,
-			166, 169, 25, 125, 86, 250, 251, 13, 253, 7, 13, 3, 7, 160,
-			25, 48, 158, 239, 7, 96, 150, 164, 1, 4, 116, 253, 67, 195,
-			180, 50, 199, 145, 85, 73, 71, 100, 39, 196, 219, 78, 82, 116,
-			195, 180, 28, 32, 251, 248, 7, 105, 252, 162, 63, 126, 160, 193,
-			131, 129, 253, 241, 3, 3, 30, 140, 140, 146, 239, 211, 68, 35,
-			154, 101, 62, 107, 152, 39, 50, 109, 144, 70, 1, 167, 20, 188,
-			44, 157, 60, 135, 140, 226, 235, 229, 104, 159, 114, 253, 146, 95,
-			105, 243, 162, 160, 45, 252, 104, 240, 14, 95, 246, 181, 55, 28,
-			175, 122, 223, 229, 215, 226, 126, 230, 125, 89, 156, 76, 154, 197,
-			58, 47, 131, 117, 129, 167, 90, 192, 85, 47, 115, 91, 67, 117,
-			78, 51, 177, 47, 67, 241, 131, 20, 60, 216, 63, 22, 63, 192,
-			222, 30, 60, 22, 63, 48, 224, 193, 29, 89, 242, 221, 154, 72,
-			4, 105, 190, 7, 40, 236, 166, 152, 115, 142, 175, 70, 98, 230,
-			227, 9, 223, 69, 101, 232, 239, 125, 57, 169, 169, 38, 110, 211,
-			41, 31, 190, 28, 13, 125, 181, 94, 90, 97, 68, 61, 88, 172,
-			32, 150, 178, 146, 84, 53, 36, 213, 247, 72, 82, 197, 68, 147,
-			230, 123, 36, 169, 98, 166, 73, 243, 61, 64, 170, 31, 210, 68,
-			174, 73, 243, 159, 24, 250, 161, 204, 123, 52, 90, 240, 168, 223,
-			180, 223, 220, 146, 153, 63, 196, 45, 149, 120, 247, 212, 78, 158,
-			244, 73, 211, 15, 247, 6, 19, 3, 77, 42, 23, 189, 2, 222,
-			0, 1, 55, 3, 182, 13, 60, 130, 95, 254, 195, 22, 108, 151,
-			250, 219, 44, 64, 102, 131, 249, 195, 48, 81, 92, 168, 70, 5,
-			243, 241, 79, 226, 81, 105, 216, 239, 129, 131, 18, 52, 0, 156,
-			156, 34, 207, 104, 34, 217, 165, 249, 79, 13, 61, 151, 105, 209,
-			71, 132, 130, 24, 227, 19, 20, 102, 117, 150, 16, 97, 250, 215,
-			144, 231, 11, 224, 58, 173, 103, 111, 197, 170, 17, 230, 157, 11,
-			114, 212, 217, 242, 124, 80, 175, 121, 160, 118, 190, 203, 22, 249,
-			61, 7, 168, 186, 172, 167, 177, 23, 135, 37, 168, 1, 120, 228,
-			148, 4, 13, 0, 79, 223, 73, 126, 72, 19, 169, 52, 205, 15,
-			193, 68, 124, 143, 70, 75, 136, 242, 168, 45, 231, 193, 175, 129,
-			62, 143, 83, 221, 209, 113, 53, 164, 151, 115, 20, 70, 10, 59,
-			38, 17, 15, 12, 248, 67, 49, 226, 13, 236, 246, 228, 20, 44,
-			106, 158, 186, 211, 252, 176, 161, 79, 101, 158, 66, 169, 201, 41,
-			8, 56, 87, 251, 121, 241, 45, 92, 201, 13, 158, 98, 65, 164,
-			83, 179, 213, 221, 75, 91, 93, 93, 108, 237, 205, 45, 156, 72,
-			117, 220, 228, 125, 81, 96, 26, 192, 193, 97, 9, 106, 0, 30,
-			24, 147, 160, 1, 224, 196, 36, 10, 18, 221, 74, 127, 212, 232,
-			251, 164, 16, 36, 48, 111, 31, 53, 250, 143, 147, 15, 235, 34,
-			213, 164, 249, 9, 67, 63, 159, 249, 97, 157, 174, 176, 224, 76,
-			183, 248, 82, 67, 22, 204, 218, 86, 119, 180, 100, 30, 224, 77,
-			37, 243, 92, 230, 109, 69, 245, 93, 78, 224, 56, 61, 194, 170,
-			0, 76, 116, 220, 228, 233, 184, 89, 58, 91, 205, 74, 249, 133,
-			123, 205, 109, 162, 220, 254, 129, 57, 58, 66, 61, 236, 242, 35,
-			23, 31, 78, 163, 240, 179, 35, 222, 49, 145, 245, 80, 176, 36,
-			130, 231, 71, 77, 174, 159, 226, 188, 137, 222, 81, 155, 27, 74,
-			117, 123, 155, 111, 27, 181, 119, 223, 251, 21, 202, 18, 79, 173,
-			9, 200, 82, 96, 26, 192, 193, 67, 18, 212, 0, 204, 156, 145,
-			160, 1, 224, 217, 115, 56, 7, 134, 149, 254, 41, 163, 239, 231,
-			196, 28, 0, 213, 253, 148, 209, 127, 20, 197, 151, 1, 226, 235,
-			167, 13, 253, 103, 132, 248, 50, 80, 124, 253, 180, 65, 64, 186,
-			165, 1, 132, 57, 250, 148, 97, 90, 200, 171, 13, 33, 157, 62,
-			37, 165, 147, 33, 164, 211, 167, 164, 116, 50, 132, 116, 250, 20,
-			72, 167, 37, 104, 1, 42, 248, 140, 161, 103, 51, 15, 36, 242,
-			55, 227, 225, 127, 112, 203, 251, 39, 221, 215, 196, 4, 34, 12,
-			68, 196, 103, 36, 34, 12, 236, 207, 103, 140, 193, 73, 9, 106,
-			0, 78, 29, 149, 160, 1, 32, 189, 131, 60, 78, 128, 138, 211,
-			255, 198, 232, 251, 247, 134, 246, 178, 104, 234, 38, 172, 131, 127,
-			99, 244, 31, 66, 253, 213, 52, 97, 224, 159, 7, 41, 246, 228,
-			109, 244, 36, 32, 109, 23, 189, 101, 58, 36, 25, 197, 16, 75,
-			242, 75, 220, 172, 13, 125, 34, 82, 82, 176, 42, 6, 21, 105,
-			48, 219, 11, 105, 150, 59, 101, 214, 186, 234, 149, 114, 204, 68,
-			57, 246, 121, 201, 120, 76, 196, 209, 231, 165, 28, 51, 17, 71,
-			159, 7, 57, 182, 134, 221, 214, 44, 243, 11, 134, 62, 158, 185,
-			74, 75, 53, 190, 105, 144, 19, 236, 98, 175, 11, 28, 181, 132,
-			121, 159, 188, 178, 103, 187, 174, 234, 2, 8, 157, 47, 24, 122,
-			90, 130, 216, 202, 190, 17, 9, 26, 0, 142, 29, 36, 247, 99,
-			23, 116, 203, 252, 101, 67, 207, 100, 206, 225, 110, 69, 208, 194,
-			180, 9, 120, 51, 82, 56, 167, 199, 222, 47, 10, 155, 97, 203,
-			141, 84, 107, 122, 10, 107, 144, 173, 193, 152, 126, 217, 216, 55,
-			46, 65, 3, 192, 169, 67, 184, 58, 82, 86, 250, 139, 70, 223,
-			151, 197, 234, 72, 105, 150, 249, 69, 163, 63, 67, 138, 196, 52,
-			83, 48, 133, 95, 50, 244, 227, 153, 123, 232, 98, 247, 125, 19,
-			117, 17, 69, 112, 82, 17, 202, 65, 116, 11, 79, 42, 69, 119,
-			82, 72, 178, 95, 146, 36, 155, 194, 233, 248, 146, 49, 56, 42,
-			65, 13, 64, 43, 35, 65, 3, 192, 163, 199, 200, 35, 216, 5,
-			205, 50, 127, 29, 186, 80, 186, 125, 23, 42, 117, 199, 77, 220,
-			242, 152, 150, 25, 51, 163, 192, 118, 208, 13, 244, 212, 236, 169,
-			25, 213, 41, 208, 211, 126, 61, 238, 20, 76, 208, 175, 199, 157,
-			210, 176, 93, 213, 41, 152, 160, 95, 135, 78, 61, 11, 106, 121,
-			218, 74, 255, 142, 209, 247, 85, 67, 203, 156, 167, 157, 209, 226,
-			68, 220, 140, 216, 136, 222, 125, 127, 225, 229, 89, 124, 105, 205,
-			50, 127, 7, 180, 242, 33, 98, 154, 105, 152, 184, 223, 53, 116,
-			62, 225, 105, 68, 248, 239, 74, 250, 79, 35, 194, 127, 215, 24,
-			24, 145, 160, 1, 224, 216, 65, 176, 154, 245, 148, 102, 165, 191,
-			102, 244, 125, 221, 208, 50, 247, 209, 221, 177, 23, 229, 137, 39,
-			160, 123, 151, 195, 105, 121, 101, 62, 225, 194, 250, 242, 108, 7,
-			192, 188, 124, 205, 72, 77, 226, 118, 0, 106, 202, 127, 104, 232,
-			175, 70, 27, 142, 43, 168, 127, 104, 232, 119, 72, 80, 7, 240,
-			85, 39, 73, 1, 203, 106, 150, 249, 71, 134, 126, 40, 123, 161,
-			115, 203, 7, 148, 184, 228, 209, 103, 114, 11, 20, 125, 217, 61,
-			127, 135, 136, 26, 53, 94, 199, 144, 4, 117, 0, 39, 167, 200,
-			61, 216, 128, 110, 153, 127, 12, 13, 204, 116, 54, 208, 125, 63,
-			86, 250, 46, 46, 63, 40, 171, 133, 174, 253, 177, 161, 31, 148,
-			32, 86, 52, 57, 69, 30, 192, 106, 13, 203, 252, 19, 168, 246,
-			124, 103, 181, 177, 159, 64, 210, 99, 91, 250, 28, 163, 103, 139,
-			172, 31, 100, 222, 159, 24, 250, 176, 4, 117, 0, 39, 167, 72,
-			145, 232, 230, 62, 43, 253, 77, 163, 239, 219, 134, 150, 185, 39,
-			142, 96, 227, 168, 244, 147, 220, 67, 48, 121, 32, 29, 251, 102,
-			202, 29, 64, 160, 191, 125, 154, 101, 126, 211, 232, 31, 33, 119,
-			19, 211, 220, 7, 243, 242, 45, 96, 162, 211, 180, 144, 140, 193,
-			18, 59, 228, 116, 198, 151, 16, 139, 114, 31, 82, 234, 183, 36,
-			165, 238, 195, 9, 253, 150, 164, 212, 125, 72, 169, 223, 2, 74,
-			125, 0, 27, 209, 44, 243, 207, 64, 207, 61, 207, 207, 120, 120,
-			166, 29, 121, 169, 171, 227, 28, 65, 178, 73, 225, 202, 174, 154,
-			211, 210, 88, 197, 160, 4, 177, 198, 161, 131, 18, 52, 0, 156,
-			156, 66, 174, 188, 15, 102, 229, 47, 12, 253, 112, 230, 28, 218,
-			152, 160, 71, 39, 73, 165, 163, 205, 105, 191, 21, 53, 91, 92,
-			100, 204, 168, 214, 128, 43, 255, 69, 60, 56, 232, 255, 95, 24,
-			3, 19, 18, 52, 0, 60, 148, 17, 173, 25, 150, 249, 223, 12,
-			61, 159, 57, 23, 219, 29, 123, 109, 212, 247, 110, 205, 72, 99,
-			13, 135, 37, 168, 1, 120, 100, 70, 130, 88, 127, 238, 12, 202,
-			128, 126, 43, 253, 151, 70, 223, 247, 153, 92, 6, 244, 107, 150,
-			249, 151, 70, 63, 37, 1, 49, 205, 126, 152, 202, 239, 24, 58,
-			205, 84, 233, 114, 43, 170, 248, 241, 92, 42, 111, 244, 156, 138,
-			47, 160, 66, 131, 240, 176, 29, 142, 203, 157, 37, 240, 184, 2,
-			12, 86, 17, 120, 133, 199, 236, 17, 91, 245, 60, 204, 17, 70,
-			240, 18, 125, 239, 71, 165, 230, 59, 98, 65, 0, 168, 1, 56,
-			126, 88, 130, 6, 128, 199, 142, 147, 183, 107, 216, 67, 205, 50,
-			159, 49, 245, 169, 204, 14, 234, 190, 220, 203, 156, 117, 56, 181,
-			3, 23, 238, 225, 144, 174, 110, 161, 201, 195, 17, 21, 31, 41,
-			222, 232, 203, 163, 179, 29, 40, 108, 48, 174, 30, 183, 5, 84,
-			167, 129, 152, 158, 49, 117, 5, 98, 183, 6, 199, 36, 104, 0,
-			56, 49, 73, 62, 206, 59, 173, 91, 230, 247, 154, 58, 205, 124,
-			80, 75, 244, 186, 179, 126, 213, 189, 228, 77, 138, 196, 37, 128,
-			238, 3, 53, 66, 35, 127, 139, 33, 110, 81, 1, 151, 188, 64,
-			222, 61, 136, 252, 228, 197, 134, 206, 131, 182, 228, 40, 137, 12,
-			170, 36, 48, 169, 70, 8, 102, 232, 247, 154, 250, 168, 4, 53,
-			0, 45, 57, 45, 64, 178, 223, 107, 30, 59, 142, 186, 230, 128,
-			149, 254, 71, 102, 223, 251, 205, 151, 73, 215, 28, 208, 44, 243,
-			31, 153, 253, 71, 128, 243, 154, 3, 64, 163, 207, 154, 250, 120,
-			102, 134, 46, 9, 102, 19, 75, 96, 161, 93, 118, 120, 151, 139,
-			17, 13, 32, 191, 121, 214, 20, 75, 114, 0, 9, 237, 89, 83,
-			240, 155, 1, 36, 180, 103, 205, 177, 131, 228, 117, 216, 138, 102,
-			153, 239, 54, 245, 201, 204, 107, 233, 82, 171, 177, 201, 35, 91,
-			37, 124, 104, 124, 121, 111, 211, 247, 112, 131, 189, 137, 140, 174,
-			202, 106, 182, 184, 186, 123, 238, 236, 89, 213, 48, 168, 27, 239,
-			54, 69, 34, 161, 1, 36, 150, 119, 155, 253, 163, 18, 52, 0,
-			60, 56, 65, 234, 216, 176, 110, 153, 239, 51, 245, 67, 153, 55,
-			210, 135, 109, 183, 133, 227, 59, 213, 149, 79, 225, 84, 124, 221,
-			172, 25, 176, 109, 188, 245, 21, 203, 98, 66, 231, 59, 207, 93,
-			65, 95, 108, 48, 190, 19, 206, 189, 120, 120, 195, 192, 162, 222,
-			23, 227, 3, 134, 252, 62, 115, 96, 76, 130, 6, 128, 19, 83,
-			200, 52, 136, 149, 254, 17, 179, 239, 159, 11, 166, 65, 52, 203,
-			252, 17, 179, 255, 40, 41, 19, 211, 36, 48, 33, 31, 48, 245,
-			108, 102, 65, 5, 20, 75, 236, 54, 11, 181, 109, 83, 138, 90,
-			73, 206, 78, 99, 143, 11, 20, 208, 58, 65, 45, 242, 3, 114,
-			125, 17, 228, 17, 31, 144, 235, 139, 224, 212, 125, 192, 60, 120,
-			84, 130, 6, 128, 244, 14, 206, 35, 8, 12, 228, 131, 166, 126,
-			44, 179, 35, 80, 24, 137, 136, 14, 118, 72, 79, 37, 177, 232,
-			120, 180, 87, 240, 221, 30, 56, 195, 192, 137, 226, 46, 187, 8,
-			13, 216, 113, 62, 140, 135, 43, 226, 118, 168, 26, 3, 76, 251,
-			7, 37, 126, 9, 78, 251, 7, 205, 129, 41, 9, 26, 0, 30,
-			62, 138, 43, 104, 208, 74, 127, 216, 236, 251, 217, 151, 107, 5,
-			13, 106, 150, 249, 97, 179, 255, 24, 153, 39, 166, 57, 8, 19,
-			246, 17, 88, 65, 119, 245, 94, 65, 220, 171, 176, 227, 130, 198,
-			180, 12, 70, 42, 69, 206, 32, 174, 166, 143, 200, 209, 13, 226,
-			148, 124, 68, 174, 166, 65, 156, 146, 143, 192, 106, 250, 51, 29,
-			155, 4, 147, 29, 216, 246, 127, 210, 185, 11, 36, 44, 84, 223,
-			191, 129, 124, 118, 154, 223, 0, 107, 121, 73, 191, 69, 220, 150,
-			41, 44, 46, 2, 150, 67, 214, 121, 250, 140, 167, 200, 11, 124,
-			98, 85, 100, 187, 68, 120, 80, 126, 157, 80, 12, 35, 228, 84,
-			198, 106, 53, 167, 130, 231, 149, 104, 218, 241, 123, 211, 17, 73,
-			132, 9, 131, 101, 44, 124, 242, 106, 142, 11, 202, 208, 102, 59,
-			143, 201, 165, 19, 113, 251, 148, 95, 19, 158, 74, 11, 215, 99,
-			66, 67, 214, 180, 3, 158, 252, 152, 53, 169, 93, 139, 152, 60,
-			214, 228, 49, 66, 176, 47, 187, 92, 148, 120, 47, 66, 140, 202,
-			204, 19, 101, 6, 9, 71, 74, 238, 142, 133, 204, 100, 147, 69,
-			242, 250, 6, 118, 151, 111, 207, 225, 184, 112, 216, 184, 235, 10,
-			197, 147, 139, 104, 16, 173, 158, 79, 200, 69, 52, 136, 50, 235,
-			19, 166, 216, 202, 26, 68, 122, 252, 132, 41, 182, 178, 6, 145,
-			30, 63, 1, 50, 235, 117, 56, 97, 186, 101, 254, 228, 75, 195,
-			255, 6, 145, 209, 252, 164, 228, 127, 131, 200, 104, 126, 82, 242,
-			191, 65, 100, 52, 63, 41, 249, 223, 32, 64, 159, 254, 219, 224,
-			127, 131, 184, 69, 249, 233, 152, 130, 65, 105, 250, 180, 228, 127,
-			131, 168, 52, 125, 90, 242, 191, 33, 43, 253, 89, 179, 239, 223,
-			10, 254, 55, 164, 89, 230, 103, 205, 254, 227, 200, 255, 134, 96,
-			57, 61, 247, 210, 242, 191, 33, 228, 127, 207, 201, 169, 27, 66,
-			254, 247, 156, 228, 127, 67, 184, 216, 158, 147, 252, 111, 8, 23,
-			219, 115, 192, 255, 190, 71, 195, 14, 105, 150, 249, 57, 224, 127,
-			237, 219, 243, 191, 222, 1, 195, 123, 96, 141, 80, 63, 120, 129,
-			28, 112, 8, 57, 224, 231, 36, 134, 135, 144, 226, 62, 39, 57,
-			224, 16, 82, 220, 231, 128, 3, 254, 2, 216, 217, 251, 173, 244,
-			175, 152, 125, 191, 109, 106, 153, 42, 45, 63, 191, 107, 76, 61,
-			111, 47, 117, 42, 73, 123, 221, 94, 122, 153, 44, 243, 253, 154,
-			101, 254, 138, 217, 63, 72, 102, 137, 105, 238, 7, 202, 248, 85,
-			83, 183, 50, 119, 40, 70, 43, 46, 195, 236, 242, 78, 67, 148,
-			236, 71, 166, 250, 171, 18, 97, 251, 113, 158, 127, 213, 28, 216,
-			47, 65, 3, 192, 145, 81, 60, 155, 220, 15, 211, 252, 107, 192,
-			198, 103, 59, 106, 151, 72, 225, 65, 83, 229, 229, 155, 248, 24,
-			73, 181, 5, 147, 243, 107, 113, 91, 26, 86, 39, 24, 248, 126,
-			156, 156, 95, 3, 6, 126, 25, 219, 210, 45, 243, 203, 176, 42,
-			115, 189, 226, 3, 39, 26, 73, 120, 13, 169, 134, 64, 147, 252,
-			178, 41, 12, 175, 253, 184, 252, 191, 108, 10, 195, 107, 63, 46,
-			255, 47, 155, 147, 83, 228, 26, 54, 100, 88, 230, 111, 66, 67,
-			151, 98, 195, 43, 113, 120, 193, 3, 242, 118, 30, 92, 244, 52,
-			137, 246, 227, 234, 254, 205, 120, 120, 176, 186, 127, 211, 28, 144,
-			173, 26, 216, 206, 228, 20, 185, 138, 173, 154, 150, 249, 91, 166,
-			158, 203, 188, 38, 54, 192, 160, 157, 189, 207, 124, 246, 104, 212,
-			76, 99, 69, 135, 37, 168, 1, 40, 206, 110, 246, 227, 105, 193,
-			111, 153, 167, 239, 68, 150, 50, 108, 165, 191, 106, 246, 253, 95,
-			130, 165, 12, 107, 150, 249, 85, 179, 127, 10, 9, 103, 24, 8,
-			231, 247, 95, 0, 225, 12, 35, 225, 252, 190, 28, 237, 48, 18,
-			206, 239, 75, 194, 25, 70, 194, 249, 125, 73, 56, 195, 48, 1,
-			127, 240, 162, 9, 103, 24, 9, 231, 15, 226, 182, 52, 172, 78,
-			16, 206, 48, 18, 206, 31, 0, 225, 192, 32, 15, 88, 233, 63,
-			52, 251, 254, 88, 12, 242, 128, 102, 153, 127, 104, 246, 79, 146,
-			243, 196, 52, 15, 192, 32, 255, 8, 186, 241, 170, 91, 40, 242,
-			232, 138, 166, 116, 248, 3, 56, 206, 63, 146, 109, 31, 192, 113,
-			254, 145, 108, 251, 0, 142, 243, 143, 100, 219, 35, 86, 250, 79,
-			205, 190, 111, 138, 182, 71, 52, 203, 252, 83, 64, 240, 35, 196,
-			52, 71, 160, 237, 111, 128, 62, 82, 162, 113, 20, 136, 90, 7,
-			187, 230, 243, 94, 217, 131, 93, 227, 201, 84, 11, 93, 225, 146,
-			140, 123, 4, 25, 247, 55, 36, 227, 30, 65, 198, 253, 13, 41,
-			115, 71, 176, 191, 223, 144, 50, 119, 4, 251, 251, 13, 144, 185,
-			111, 55, 136, 110, 142, 90, 233, 191, 54, 251, 190, 39, 165, 101,
-			126, 20, 181, 36, 206, 2, 213, 61, 27, 188, 102, 211, 113, 183,
-			5, 153, 96, 210, 79, 240, 65, 214, 14, 247, 186, 191, 145, 139,
-			195, 175, 136, 237, 91, 71, 166, 129, 238, 142, 93, 195, 149, 14,
-			152, 116, 21, 219, 46, 118, 150, 21, 23, 48, 37, 124, 62, 187,
-			203, 51, 80, 93, 250, 32, 29, 157, 91, 171, 67, 117, 17, 168,
-			106, 120, 126, 37, 233, 14, 116, 174, 105, 59, 220, 117, 163, 104,
-			38, 177, 235, 229, 242, 104, 80, 118, 68, 47, 158, 61, 75, 232,
-			102, 59, 122, 217, 156, 144, 204, 81, 205, 50, 255, 26, 120, 248,
-			10, 49, 205, 81, 220, 18, 49, 245, 209, 204, 28, 96, 55, 121,
-			97, 39, 17, 52, 59, 22, 44, 103, 240, 222, 138, 144, 144, 211,
-			143, 217, 103, 158, 60, 123, 230, 210, 198, 255, 114, 230, 241, 59,
-			37, 147, 24, 69, 26, 254, 142, 164, 225, 81, 190, 225, 97, 14,
-			12, 73, 208, 0, 240, 192, 8, 57, 135, 205, 107, 150, 249, 214,
-			148, 62, 150, 57, 33, 100, 185, 242, 149, 166, 118, 176, 233, 68,
-			129, 29, 72, 129, 172, 234, 135, 245, 249, 214, 148, 170, 95, 195,
-			42, 6, 134, 37, 104, 0, 56, 106, 33, 99, 31, 5, 198, 254,
-			182, 20, 50, 118, 228, 183, 252, 24, 82, 205, 37, 48, 92, 156,
-			159, 30, 220, 110, 20, 245, 186, 183, 197, 13, 65, 95, 223, 150,
-			18, 44, 118, 20, 25, 251, 219, 82, 147, 83, 228, 53, 216, 144,
-			97, 153, 223, 157, 210, 115, 153, 211, 49, 139, 133, 154, 129, 197,
-			170, 246, 122, 55, 99, 164, 241, 211, 195, 18, 212, 0, 20, 76,
-			117, 20, 57, 249, 119, 167, 4, 83, 181, 172, 244, 59, 82, 125,
-			207, 166, 248, 154, 183, 52, 203, 124, 71, 170, 255, 16, 50, 121,
-			11, 102, 242, 157, 41, 125, 60, 243, 26, 36, 69, 197, 105, 240,
-			16, 216, 119, 171, 93, 215, 109, 118, 88, 210, 83, 42, 150, 103,
-			22, 206, 223, 59, 229, 176, 45, 156, 191, 119, 166, 4, 15, 178,
-			112, 254, 222, 153, 26, 59, 136, 156, 220, 2, 156, 252, 0, 224,
-			247, 14, 108, 244, 121, 212, 14, 90, 250, 15, 164, 132, 180, 180,
-			112, 246, 126, 32, 37, 164, 165, 133, 179, 247, 3, 128, 212, 57,
-			172, 93, 183, 204, 119, 165, 244, 169, 204, 69, 186, 220, 235, 142,
-			31, 111, 97, 26, 79, 34, 147, 97, 24, 35, 22, 204, 168, 6,
-			65, 248, 189, 43, 165, 43, 48, 13, 160, 224, 88, 22, 78, 234,
-			187, 82, 130, 99, 89, 56, 169, 239, 74, 137, 3, 239, 49, 43,
-			253, 238, 84, 223, 15, 11, 108, 143, 105, 150, 249, 110, 192, 246,
-			117, 98, 154, 99, 128, 237, 31, 2, 108, 95, 126, 190, 216, 230,
-			183, 64, 132, 29, 19, 248, 13, 209, 193, 49, 196, 247, 15, 73,
-			124, 143, 33, 190, 127, 72, 226, 123, 12, 241, 253, 67, 128, 239,
-			115, 216, 172, 102, 153, 239, 5, 124, 159, 216, 133, 239, 61, 235,
-			7, 140, 191, 87, 98, 124, 12, 49, 254, 94, 137, 241, 49, 196,
-			248, 123, 37, 198, 199, 0, 227, 239, 223, 27, 227, 162, 141, 91,
-			98, 124, 12, 49, 254, 126, 137, 241, 49, 196, 248, 251, 37, 198,
-			199, 16, 227, 239, 151, 24, 31, 67, 140, 191, 31, 48, 254, 56,
-			209, 205, 131, 86, 250, 159, 166, 250, 62, 148, 122, 153, 246, 9,
-			14, 106, 150, 249, 79, 83, 253, 71, 201, 42, 49, 205, 131, 48,
-			133, 31, 132, 41, 44, 238, 158, 66, 181, 93, 185, 107, 205, 112,
-			51, 192, 119, 241, 156, 63, 246, 246, 17, 131, 63, 136, 179, 249,
-			65, 57, 155, 7, 113, 54, 63, 40, 103, 243, 32, 206, 230, 7,
-			97, 54, 151, 176, 7, 154, 101, 254, 40, 244, 224, 1, 236, 65,
-			215, 181, 17, 110, 164, 248, 238, 54, 227, 155, 210, 93, 249, 16,
-			106, 126, 32, 247, 122, 85, 227, 192, 26, 127, 52, 110, 92, 195,
-			250, 85, 227, 48, 213, 63, 10, 141, 3, 170, 199, 173, 244, 71,
-			82, 125, 63, 254, 114, 161, 122, 92, 179, 204, 143, 0, 170, 193,
-			220, 30, 7, 84, 127, 20, 6, 250, 218, 231, 187, 90, 118, 108,
-			15, 205, 180, 45, 22, 225, 133, 11, 133, 225, 113, 196, 240, 71,
-			229, 32, 199, 17, 195, 31, 149, 131, 28, 71, 12, 127, 20, 6,
-			121, 1, 27, 214, 44, 243, 99, 176, 94, 78, 238, 90, 47, 183,
-			104, 1, 86, 204, 199, 228, 138, 25, 71, 52, 126, 76, 174, 152,
-			113, 68, 227, 199, 96, 197, 252, 60, 216, 117, 19, 86, 250, 167,
-			83, 125, 191, 148, 210, 50, 155, 84, 229, 241, 139, 125, 1, 49,
-			217, 134, 184, 67, 192, 141, 186, 91, 92, 64, 196, 237, 21, 66,
-			155, 44, 224, 238, 242, 34, 113, 77, 172, 101, 188, 60, 115, 53,
-			161, 89, 230, 79, 167, 250, 71, 209, 141, 100, 194, 236, 179, 210,
-			159, 74, 233, 63, 151, 226, 110, 36, 19, 232, 50, 250, 169, 212,
-			190, 33, 116, 35, 153, 224, 78, 163, 63, 147, 50, 51, 232, 36,
-			50, 33, 220, 62, 127, 38, 101, 142, 199, 15, 116, 120, 48, 117,
-			72, 125, 161, 89, 230, 167, 147, 95, 104, 252, 193, 96, 252, 64,
-			135, 7, 137, 47, 116, 203, 252, 217, 228, 23, 80, 197, 207, 166,
-			204, 253, 241, 3, 44, 145, 248, 194, 176, 204, 207, 36, 191, 0,
-			57, 250, 153, 148, 73, 226, 7, 58, 60, 152, 58, 132, 219, 26,
-			19, 184, 173, 145, 210, 71, 50, 11, 180, 36, 247, 216, 58, 19,
-			61, 240, 253, 23, 49, 163, 210, 48, 169, 56, 205, 234, 70, 71,
-			254, 194, 203, 219, 231, 178, 156, 112, 38, 144, 52, 159, 147, 164,
-			57, 193, 247, 49, 82, 3, 131, 18, 52, 0, 28, 62, 128, 170,
-			201, 4, 140, 232, 23, 83, 186, 149, 201, 33, 105, 42, 42, 17,
-			238, 96, 170, 221, 228, 121, 84, 94, 54, 4, 20, 250, 139, 41,
-			177, 229, 52, 129, 248, 252, 197, 148, 200, 221, 63, 129, 20, 250,
-			139, 169, 145, 81, 92, 124, 19, 128, 169, 207, 129, 106, 242, 218,
-			88, 53, 81, 245, 83, 153, 149, 84, 165, 96, 152, 238, 56, 64,
-			246, 3, 234, 249, 209, 140, 106, 24, 152, 249, 231, 164, 178, 50,
-			129, 211, 242, 57, 169, 172, 76, 32, 51, 255, 28, 40, 43, 247,
-			97, 195, 134, 101, 126, 62, 165, 191, 42, 115, 150, 231, 38, 145,
-			217, 71, 249, 88, 115, 50, 16, 104, 39, 170, 99, 77, 111, 2,
-			53, 163, 207, 167, 244, 41, 9, 106, 0, 30, 58, 46, 65, 172,
-			62, 123, 130, 92, 196, 198, 76, 203, 252, 2, 104, 146, 175, 198,
-			198, 248, 173, 10, 217, 18, 226, 47, 8, 64, 156, 177, 48, 140,
-			79, 77, 39, 116, 51, 133, 159, 201, 25, 3, 139, 246, 11, 82,
-			153, 156, 64, 139, 246, 11, 160, 76, 130, 58, 48, 105, 165, 255,
-			67, 170, 239, 183, 132, 58, 48, 169, 89, 230, 127, 72, 245, 31,
-			71, 53, 122, 18, 168, 233, 139, 192, 224, 230, 94, 12, 131, 227,
-			239, 106, 62, 102, 213, 224, 61, 155, 68, 90, 250, 162, 236, 217,
-			36, 210, 210, 23, 37, 155, 155, 68, 90, 250, 34, 176, 185, 75,
-			216, 188, 102, 153, 95, 2, 54, 119, 231, 45, 217, 92, 207, 118,
-			128, 148, 190, 36, 153, 221, 36, 146, 210, 151, 36, 179, 155, 68,
-			82, 250, 18, 48, 187, 2, 182, 163, 91, 230, 151, 161, 157, 11,
-			64, 74, 120, 176, 38, 60, 118, 112, 115, 82, 154, 15, 210, 193,
-			39, 139, 89, 154, 249, 225, 168, 106, 15, 180, 234, 47, 167, 132,
-			63, 207, 36, 223, 46, 73, 237, 147, 237, 225, 118, 73, 220, 158,
-			97, 153, 191, 241, 2, 218, 195, 140, 196, 93, 237, 25, 41, 172,
-			67, 182, 7, 68, 244, 27, 113, 123, 6, 182, 32, 182, 103, 38,
-			129, 136, 190, 146, 210, 143, 103, 46, 61, 191, 246, 18, 217, 90,
-			187, 90, 5, 186, 250, 74, 220, 42, 208, 213, 87, 82, 251, 50,
-			18, 52, 0, 60, 122, 12, 233, 106, 202, 74, 255, 110, 170, 239,
-			191, 8, 186, 154, 210, 44, 243, 119, 83, 253, 20, 151, 208, 20,
-			208, 213, 239, 129, 62, 118, 182, 107, 98, 101, 224, 70, 97, 81,
-			136, 243, 231, 154, 227, 242, 8, 204, 162, 31, 83, 104, 160, 255,
-			158, 212, 197, 166, 144, 138, 126, 47, 37, 54, 90, 167, 144, 138,
-			126, 15, 148, 175, 247, 105, 216, 154, 102, 153, 95, 131, 214, 222,
-			161, 241, 200, 70, 201, 75, 114, 73, 2, 126, 254, 27, 9, 36,
-			142, 75, 178, 194, 15, 198, 69, 124, 172, 206, 75, 98, 117, 59,
-			164, 29, 249, 129, 233, 189, 247, 162, 183, 152, 26, 136, 102, 98,
-			223, 20, 152, 6, 80, 40, 149, 83, 72, 181, 95, 147, 74, 229,
-			20, 82, 237, 215, 96, 92, 159, 228, 227, 210, 45, 243, 235, 48,
-			174, 15, 105, 34, 194, 71, 151, 53, 223, 107, 92, 210, 130, 187,
-			193, 218, 124, 12, 42, 134, 132, 71, 212, 123, 153, 221, 153, 39,
-			191, 234, 216, 235, 158, 121, 65, 99, 70, 13, 187, 123, 204, 64,
-			142, 95, 143, 199, 12, 188, 247, 235, 241, 152, 97, 182, 190, 30,
-			143, 25, 214, 202, 215, 97, 204, 255, 59, 31, 179, 97, 153, 223,
-			2, 230, 251, 172, 70, 87, 99, 217, 194, 246, 188, 197, 216, 233,
-			244, 33, 238, 51, 118, 33, 196, 9, 136, 8, 244, 241, 188, 7,
-			150, 104, 174, 123, 120, 134, 137, 93, 84, 96, 26, 192, 193, 131,
-			18, 212, 0, 28, 63, 46, 65, 28, 79, 246, 4, 42, 175, 135,
-			172, 244, 183, 83, 125, 255, 91, 250, 101, 82, 94, 15, 105, 150,
-			249, 109, 80, 94, 155, 196, 52, 15, 193, 26, 252, 43, 224, 237,
-			155, 184, 6, 123, 220, 28, 236, 230, 179, 200, 99, 209, 255, 162,
-			203, 137, 153, 158, 186, 85, 146, 164, 83, 2, 51, 135, 144, 247,
-			255, 149, 228, 253, 135, 112, 213, 254, 149, 228, 253, 135, 112, 213,
-			254, 21, 240, 254, 57, 236, 158, 102, 153, 223, 1, 30, 121, 17,
-			187, 39, 122, 118, 91, 17, 32, 187, 199, 171, 132, 229, 244, 29,
-			41, 4, 14, 225, 114, 250, 142, 20, 2, 135, 112, 57, 125, 7,
-			152, 36, 176, 171, 140, 149, 126, 38, 141, 137, 45, 1, 85, 25,
-			205, 50, 159, 73, 247, 31, 67, 118, 149, 1, 84, 189, 61, 253,
-			162, 217, 85, 6, 217, 213, 219, 211, 186, 2, 53, 0, 5, 187,
-			202, 224, 192, 223, 158, 158, 152, 36, 255, 194, 192, 214, 52, 203,
-			124, 54, 173, 31, 206, 188, 215, 0, 118, 213, 233, 51, 197, 110,
-			70, 129, 141, 177, 56, 5, 70, 184, 182, 182, 219, 123, 10, 123,
-			90, 183, 67, 232, 224, 150, 79, 113, 119, 88, 164, 245, 194, 140,
-			14, 194, 171, 25, 95, 226, 145, 27, 158, 106, 114, 231, 19, 25,
-			23, 150, 55, 161, 226, 205, 168, 251, 222, 60, 135, 168, 138, 66,
-			203, 195, 131, 242, 19, 88, 76, 87, 148, 104, 82, 38, 53, 104,
-			211, 42, 102, 13, 163, 97, 171, 217, 244, 3, 188, 90, 76, 18,
-			215, 21, 68, 92, 211, 170, 140, 250, 38, 139, 217, 242, 20, 21,
-			170, 156, 17, 55, 29, 69, 187, 77, 167, 114, 3, 171, 216, 100,
-			33, 166, 16, 218, 242, 121, 83, 242, 99, 60, 111, 220, 157, 29,
-			155, 239, 81, 99, 152, 207, 16, 163, 254, 99, 232, 23, 219, 117,
-			170, 42, 250, 233, 90, 189, 21, 202, 252, 162, 12, 68, 99, 40,
-			131, 215, 92, 125, 180, 164, 166, 21, 200, 235, 217, 180, 32, 175,
-			12, 191, 52, 148, 30, 154, 144, 160, 1, 224, 161, 12, 42, 230,
-			25, 96, 214, 239, 78, 235, 199, 50, 11, 116, 53, 190, 112, 223,
-			45, 137, 158, 247, 244, 242, 38, 128, 117, 190, 59, 238, 0, 186,
-			191, 164, 135, 14, 73, 208, 0, 240, 200, 81, 180, 25, 51, 0,
-			189, 7, 136, 248, 36, 93, 117, 158, 84, 124, 115, 87, 59, 98,
-			151, 86, 212, 1, 106, 198, 123, 210, 66, 35, 207, 32, 247, 122,
-			79, 186, 95, 82, 174, 129, 117, 78, 76, 146, 47, 105, 216, 132,
-			105, 153, 31, 72, 235, 135, 50, 191, 160, 37, 51, 23, 246, 110,
-			106, 218, 14, 49, 109, 33, 223, 22, 152, 81, 55, 137, 100, 160,
-			15, 223, 149, 72, 80, 161, 225, 145, 53, 35, 89, 193, 140, 109,
-			218, 149, 27, 59, 118, 80, 197, 112, 130, 118, 196, 125, 217, 218,
-			68, 198, 40, 198, 123, 86, 108, 135, 5, 170, 22, 188, 68, 181,
-			205, 2, 167, 198, 99, 74, 249, 181, 94, 196, 33, 130, 32, 43,
-			12, 128, 202, 243, 129, 180, 96, 90, 25, 84, 121, 62, 144, 22,
-			219, 165, 25, 84, 121, 62, 144, 158, 156, 34, 95, 215, 17, 3,
-			41, 203, 252, 151, 105, 61, 151, 249, 29, 157, 206, 203, 205, 22,
-			88, 133, 113, 14, 171, 78, 44, 84, 108, 183, 210, 226, 177, 14,
-			121, 68, 218, 194, 226, 34, 82, 114, 40, 169, 88, 100, 107, 72,
-			44, 21, 113, 71, 12, 15, 180, 18, 53, 42, 174, 157, 136, 16,
-			44, 25, 65, 69, 4, 219, 228, 119, 60, 5, 227, 20, 168, 216,
-			221, 41, 100, 27, 183, 91, 100, 113, 74, 11, 217, 8, 95, 86,
-			17, 117, 153, 29, 38, 82, 191, 157, 138, 145, 124, 74, 36, 17,
-			195, 9, 69, 10, 193, 166, 166, 145, 35, 225, 113, 117, 34, 217,
-			61, 95, 237, 252, 190, 10, 230, 234, 244, 58, 143, 180, 50, 122,
-			202, 68, 108, 43, 48, 13, 224, 160, 156, 154, 148, 6, 224, 248,
-			41, 9, 26, 0, 158, 190, 115, 51, 141, 193, 138, 47, 144, 119,
-			101, 200, 249, 231, 149, 251, 120, 22, 239, 175, 133, 60, 179, 180,
-			101, 194, 211, 204, 217, 231, 247, 101, 192, 154, 62, 255, 46, 115,
-			156, 231, 167, 158, 149, 249, 169, 103, 149, 150, 197, 11, 100, 159,
-			211, 73, 170, 8, 45, 89, 39, 136, 121, 195, 241, 170, 83, 26,
-			213, 166, 135, 101, 22, 127, 124, 245, 160, 227, 85, 203, 248, 210,
-			26, 33, 198, 78, 221, 159, 210, 169, 54, 61, 80, 134, 159, 86,
-			158, 152, 192, 196, 167, 12, 170, 77, 15, 158, 207, 236, 74, 136,
-			189, 38, 27, 44, 99, 57, 107, 138, 236, 19, 52, 51, 101, 98,
-			45, 18, 180, 50, 164, 95, 10, 185, 169, 20, 190, 82, 48, 180,
-			27, 176, 218, 84, 154, 183, 27, 176, 26, 60, 137, 236, 173, 169,
-			125, 252, 73, 100, 111, 89, 87, 200, 144, 200, 215, 183, 17, 248,
-			46, 155, 234, 167, 198, 244, 224, 249, 169, 94, 105, 238, 243, 133,
-			249, 197, 242, 160, 40, 93, 246, 93, 6, 31, 7, 108, 219, 191,
-			33, 63, 30, 184, 221, 199, 162, 52, 124, 124, 250, 199, 116, 50,
-			160, 48, 101, 101, 200, 68, 241, 225, 226, 210, 218, 198, 131, 165,
-			165, 133, 141, 245, 165, 213, 149, 226, 124, 233, 106, 169, 184, 48,
-			210, 103, 77, 16, 107, 165, 92, 188, 90, 122, 195, 70, 97, 126,
-			113, 99, 254, 122, 97, 233, 90, 113, 97, 164, 106, 29, 36, 7,
-			86, 10, 243, 15, 22, 174, 21, 55, 230, 203, 197, 194, 90, 113,
-			97, 228, 243, 90, 242, 233, 66, 113, 177, 8, 79, 255, 157, 102,
-			141, 145, 97, 249, 244, 122, 105, 97, 161, 184, 52, 242, 5, 205,
-			26, 39, 35, 242, 225, 250, 146, 120, 252, 75, 248, 184, 180, 180,
-			186, 86, 88, 154, 143, 43, 254, 136, 222, 241, 88, 214, 252, 99,
-			157, 143, 203, 197, 171, 27, 171, 197, 181, 145, 143, 234, 214, 36,
-			177, 58, 30, 175, 47, 193, 139, 255, 83, 183, 50, 100, 92, 189,
-			88, 43, 92, 219, 40, 172, 173, 21, 230, 175, 23, 23, 70, 62,
-			182, 251, 221, 66, 81, 188, 251, 113, 253, 133, 229, 255, 254, 143,
-			99, 36, 109, 153, 195, 125, 197, 91, 102, 255, 126, 205, 43, 217,
-			191, 95, 201, 254, 253, 18, 103, 255, 190, 28, 103, 255, 158, 33,
-			4, 3, 108, 152, 83, 125, 119, 106, 132, 240, 248, 19, 198, 84,
-			106, 152, 12, 138, 240, 19, 198, 33, 253, 40, 25, 146, 209, 39,
-			140, 67, 250, 148, 132, 116, 203, 56, 116, 248, 8, 185, 36, 66,
-			79, 24, 71, 244, 195, 153, 156, 188, 193, 205, 185, 252, 229, 238,
-			16, 132, 60, 121, 184, 188, 224, 55, 36, 195, 66, 24, 71, 244,
-			131, 18, 210, 45, 227, 200, 100, 6, 239, 130, 96, 214, 186, 227,
-			184, 181, 35, 93, 20, 118, 215, 107, 39, 18, 86, 132, 212, 166,
-			59, 117, 159, 7, 81, 27, 146, 177, 28, 140, 227, 250, 168, 132,
-			160, 194, 241, 41, 49, 58, 195, 50, 168, 126, 72, 188, 2, 132,
-			80, 85, 208, 208, 45, 131, 170, 130, 166, 101, 220, 161, 10, 154,
-			26, 64, 35, 18, 210, 45, 227, 14, 85, 48, 101, 25, 89, 85,
-			48, 165, 1, 100, 73, 72, 183, 140, 236, 248, 20, 185, 142, 5,
-			211, 150, 113, 82, 63, 150, 185, 18, 223, 65, 233, 61, 178, 61,
-			211, 125, 170, 1, 166, 53, 168, 74, 182, 146, 214, 45, 227, 100,
-			230, 168, 232, 206, 62, 203, 120, 181, 126, 76, 188, 218, 167, 1,
-			36, 11, 238, 211, 45, 227, 213, 170, 96, 191, 101, 156, 82, 5,
-			251, 53, 128, 100, 193, 126, 221, 50, 78, 169, 130, 3, 150, 49,
-			173, 10, 14, 104, 0, 201, 153, 27, 208, 45, 99, 90, 21, 36,
-			150, 49, 163, 10, 18, 13, 160, 73, 9, 233, 150, 49, 163, 10,
-			14, 90, 198, 105, 85, 112, 80, 3, 72, 22, 28, 212, 45, 227,
-			116, 230, 40, 121, 159, 142, 49, 42, 204, 187, 250, 138, 90, 230,
-			157, 58, 69, 153, 196, 35, 222, 138, 72, 232, 42, 167, 115, 149,
-			227, 145, 186, 254, 150, 136, 254, 192, 175, 33, 161, 50, 238, 71,
-			117, 58, 231, 108, 189, 30, 189, 98, 167, 49, 250, 75, 181, 238,
-			87, 208, 53, 213, 97, 225, 12, 207, 19, 236, 209, 5, 59, 178,
-			193, 58, 96, 188, 80, 88, 199, 188, 12, 4, 25, 13, 219, 164,
-			235, 37, 177, 211, 143, 23, 132, 197, 126, 142, 216, 86, 100, 54,
-			244, 130, 123, 231, 170, 90, 84, 52, 110, 117, 100, 40, 147, 176,
-			179, 170, 24, 140, 29, 82, 39, 20, 65, 66, 98, 94, 26, 242,
-			235, 39, 68, 36, 240, 227, 6, 49, 38, 157, 168, 178, 155, 124,
-			23, 156, 240, 128, 29, 198, 93, 253, 251, 1, 161, 120, 244, 98,
-			220, 173, 143, 3, 10, 77, 188, 190, 107, 220, 173, 15, 74, 72,
-			179, 140, 187, 135, 70, 36, 100, 88, 198, 221, 99, 7, 241, 10,
-			19, 174, 224, 123, 244, 209, 236, 12, 158, 38, 117, 94, 163, 151,
-			7, 27, 24, 254, 225, 181, 172, 97, 59, 238, 125, 89, 34, 106,
-			209, 82, 240, 97, 191, 132, 160, 154, 129, 33, 9, 25, 150, 113,
-			207, 129, 17, 242, 172, 134, 77, 232, 150, 113, 69, 63, 153, 121,
-			6, 195, 211, 184, 92, 231, 150, 121, 227, 209, 82, 129, 177, 181,
-			154, 24, 166, 205, 113, 93, 39, 100, 21, 159, 27, 43, 21, 7,
-			249, 96, 158, 46, 217, 158, 207, 31, 135, 60, 80, 169, 189, 41,
-			125, 92, 185, 110, 203, 151, 17, 101, 13, 39, 146, 129, 123, 40,
-			216, 2, 46, 163, 81, 96, 123, 161, 93, 17, 102, 41, 239, 33,
-			44, 196, 43, 250, 97, 9, 105, 150, 113, 229, 8, 149, 144, 97,
-			25, 87, 78, 188, 10, 47, 40, 33, 179, 184, 87, 31, 207, 158,
-			79, 48, 28, 79, 68, 117, 141, 131, 39, 194, 0, 132, 34, 116,
-			90, 116, 101, 70, 54, 101, 164, 160, 6, 137, 40, 96, 55, 247,
-			14, 200, 169, 48, 160, 246, 177, 131, 36, 135, 77, 153, 150, 113,
-			159, 62, 145, 61, 222, 113, 15, 173, 180, 128, 232, 81, 202, 199,
-			105, 89, 175, 153, 130, 226, 178, 94, 224, 78, 247, 13, 140, 74,
-			200, 176, 140, 251, 14, 142, 139, 122, 83, 150, 113, 191, 62, 10,
-			245, 170, 24, 129, 157, 117, 130, 22, 164, 234, 77, 97, 113, 89,
-			47, 48, 179, 251, 213, 196, 166, 12, 203, 184, 255, 192, 8, 94,
-			71, 65, 102, 246, 128, 62, 154, 61, 45, 34, 186, 78, 59, 30,
-			61, 117, 227, 242, 246, 41, 12, 45, 50, 211, 217, 4, 232, 76,
-			170, 137, 116, 10, 190, 148, 77, 0, 39, 123, 64, 53, 145, 54,
-			44, 227, 129, 3, 35, 232, 40, 135, 156, 108, 94, 191, 152, 57,
-			73, 11, 60, 109, 116, 213, 169, 213, 104, 2, 219, 9, 181, 83,
-			205, 237, 62, 19, 62, 82, 80, 218, 50, 230, 7, 179, 18, 210,
-			44, 99, 254, 196, 172, 132, 12, 203, 152, 63, 127, 65, 44, 160,
-			126, 203, 88, 208, 47, 138, 87, 253, 38, 64, 178, 146, 254, 180,
-			101, 44, 168, 74, 128, 81, 46, 168, 74, 250, 13, 203, 88, 56,
-			127, 65, 153, 68, 239, 24, 38, 23, 159, 167, 73, 116, 147, 219,
-			166, 29, 70, 209, 109, 77, 156, 175, 104, 100, 176, 40, 190, 92,
-			179, 183, 172, 97, 162, 59, 220, 204, 25, 40, 235, 78, 181, 195,
-			238, 208, 187, 236, 142, 132, 181, 98, 116, 90, 43, 35, 196, 184,
-			193, 218, 194, 134, 129, 159, 214, 65, 146, 66, 151, 52, 97, 188,
-			112, 192, 58, 78, 6, 229, 14, 249, 198, 102, 91, 88, 48, 68,
-			62, 154, 107, 91, 87, 18, 5, 162, 16, 13, 154, 91, 219, 81,
-			234, 227, 181, 240, 133, 105, 210, 239, 27, 228, 154, 244, 137, 91,
-			106, 210, 151, 94, 209, 164, 95, 209, 164, 95, 98, 77, 122, 134,
-			252, 156, 198, 85, 147, 195, 125, 39, 180, 204, 199, 53, 154, 88,
-			144, 194, 105, 24, 221, 59, 42, 117, 214, 224, 65, 72, 78, 201,
-			197, 142, 199, 57, 27, 175, 125, 147, 191, 233, 84, 239, 59, 21,
-			235, 37, 232, 237, 31, 198, 65, 128, 155, 126, 83, 236, 105, 109,
-			182, 105, 241, 13, 43, 203, 229, 53, 96, 162, 171, 27, 107, 203,
-			27, 115, 175, 199, 152, 47, 44, 160, 111, 242, 55, 81, 123, 233,
-			14, 39, 47, 50, 162, 17, 161, 233, 216, 158, 237, 182, 67, 135,
-			239, 159, 241, 120, 246, 82, 129, 56, 220, 63, 70, 242, 82, 129,
-			56, 162, 143, 100, 239, 160, 211, 205, 27, 91, 57, 148, 64, 57,
-			234, 84, 103, 208, 35, 182, 197, 163, 192, 209, 27, 172, 45, 153,
-			98, 95, 10, 62, 232, 79, 232, 24, 71, 6, 6, 19, 58, 198,
-			145, 225, 3, 162, 102, 205, 50, 142, 234, 19, 217, 59, 186, 4,
-			155, 212, 47, 10, 213, 149, 202, 245, 124, 62, 223, 161, 91, 28,
-			237, 208, 45, 142, 42, 209, 6, 186, 197, 209, 131, 227, 228, 78,
-			169, 90, 28, 211, 199, 179, 199, 186, 164, 115, 103, 140, 13, 37,
-			244, 83, 80, 186, 63, 33, 244, 143, 41, 73, 12, 114, 254, 216,
-			216, 65, 114, 82, 10, 253, 227, 250, 104, 118, 234, 255, 21, 120,
-			134, 15, 20, 111, 240, 115, 155, 192, 19, 127, 200, 85, 187, 60,
-			74, 213, 46, 15, 175, 199, 64, 85, 187, 60, 191, 0, 151, 58,
-			172, 106, 87, 96, 18, 86, 146, 82, 128, 174, 103, 198, 105, 36,
-			168, 86, 87, 64, 169, 213, 21, 56, 249, 144, 106, 117, 5, 65,
-			33, 168, 215, 89, 193, 157, 19, 37, 57, 240, 70, 129, 196, 20,
-			240, 65, 193, 37, 58, 56, 90, 107, 172, 96, 213, 200, 149, 186,
-			34, 167, 8, 82, 165, 174, 40, 46, 193, 165, 0, 171, 212, 149,
-			152, 116, 148, 132, 97, 251, 196, 32, 203, 84, 65, 198, 195, 107,
-			111, 176, 18, 105, 164, 218, 91, 73, 70, 29, 169, 246, 86, 66,
-			140, 15, 2, 2, 0, 0, 255, 255, 50, 208, 76, 221, 113, 250,
-			0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 15, 120, 36, 87,
+			117, 39, 170, 250, 211, 221, 210, 149, 70, 210, 148, 254, 78, 207,
+			140, 116, 167, 141, 145, 52, 214, 180, 60, 99, 27, 240, 24, 27,
+			107, 36, 141, 45, 123, 60, 51, 72, 26, 188, 132, 240, 244, 149,
+			186, 75, 82, 49, 173, 170, 78, 85, 245, 104, 154, 108, 94, 8,
+			1, 243, 28, 254, 26, 216, 7, 132, 63, 201, 75, 88, 39, 236,
+			35, 144, 120, 73, 8, 11, 89, 2, 78, 32, 132, 240, 17, 248,
+			66, 32, 113, 2, 143, 240, 224, 109, 48, 251, 18, 47, 33, 100,
+			223, 230, 229, 189, 239, 119, 238, 159, 170, 110, 73, 158, 25, 99,
+			39, 108, 54, 254, 252, 125, 163, 83, 93, 117, 239, 185, 231, 158,
+			123, 206, 185, 231, 158, 115, 46, 251, 248, 189, 108, 102, 35, 44,
+			87, 54, 163, 112, 203, 111, 108, 149, 195, 104, 99, 166, 214, 168,
+			248, 51, 21, 191, 94, 157, 113, 235, 242, 143, 75, 199, 103, 42,
+			110, 92, 174, 71, 97, 18, 58, 54, 30, 149, 238, 103, 93, 231,
+			214, 94, 230, 85, 146, 37, 111, 221, 185, 129, 117, 109, 186, 241,
+			230, 170, 91, 219, 8, 71, 13, 110, 76, 246, 158, 232, 45, 227,
+			181, 242, 221, 110, 188, 57, 91, 219, 8, 151, 58, 55, 229, 95,
+			206, 97, 198, 54, 189, 203, 171, 85, 127, 195, 139, 147, 81, 147,
+			27, 147, 93, 75, 93, 155, 222, 229, 121, 122, 80, 186, 200, 6,
+			238, 242, 18, 209, 246, 133, 165, 51, 75, 222, 143, 53, 188, 56,
+			113, 38, 88, 62, 164, 103, 212, 126, 247, 137, 62, 209, 190, 198,
+			97, 73, 254, 236, 220, 192, 246, 87, 195, 237, 160, 22, 186, 213,
+			213, 117, 191, 230, 5, 238, 150, 39, 123, 233, 87, 63, 156, 150,
+			207, 75, 71, 213, 40, 46, 44, 157, 1, 98, 177, 191, 17, 120,
+			213, 213, 70, 84, 163, 110, 186, 150, 186, 196, 147, 11, 81, 173,
+			244, 50, 230, 156, 242, 54, 252, 224, 66, 29, 77, 60, 5, 188,
+			50, 52, 50, 159, 156, 70, 165, 6, 27, 56, 237, 7, 126, 188,
+			217, 218, 89, 153, 13, 52, 232, 193, 106, 88, 247, 34, 55, 241,
+			195, 96, 213, 175, 74, 84, 247, 139, 159, 206, 169, 95, 22, 171,
+			78, 153, 177, 245, 48, 170, 120, 171, 232, 121, 212, 220, 29, 193,
+			46, 122, 5, 19, 85, 90, 96, 3, 115, 110, 80, 241, 106, 63,
+			80, 183, 165, 207, 25, 172, 239, 66, 235, 83, 231, 8, 235, 217,
+			229, 227, 110, 253, 108, 177, 10, 250, 203, 110, 64, 127, 201, 24,
+			226, 201, 133, 168, 230, 28, 101, 249, 56, 113, 147, 70, 60, 106,
+			17, 245, 28, 49, 16, 209, 209, 50, 253, 178, 36, 223, 200, 204,
+			138, 253, 228, 179, 114, 29, 219, 231, 69, 81, 24, 173, 110, 121,
+			113, 236, 110, 120, 163, 57, 234, 182, 135, 30, 222, 39, 158, 29,
+			189, 141, 117, 170, 57, 114, 14, 176, 161, 187, 103, 151, 239, 94,
+			157, 61, 115, 215, 185, 213, 11, 103, 151, 207, 47, 204, 45, 158,
+			94, 92, 152, 239, 239, 112, 58, 153, 189, 124, 247, 236, 241, 126,
+			195, 97, 44, 191, 124, 247, 236, 137, 91, 158, 211, 111, 30, 109,
+			176, 158, 44, 138, 206, 97, 118, 224, 194, 249, 51, 231, 102, 231,
+			87, 151, 87, 102, 87, 46, 44, 183, 53, 178, 143, 117, 137, 159,
+			23, 207, 222, 213, 111, 56, 251, 88, 215, 139, 22, 150, 22, 79,
+			191, 24, 160, 9, 240, 252, 133, 83, 103, 22, 151, 239, 94, 152,
+			239, 183, 156, 110, 86, 88, 88, 90, 58, 183, 180, 48, 223, 111,
+			59, 61, 172, 115, 110, 246, 236, 220, 194, 153, 133, 249, 254, 220,
+			137, 7, 77, 86, 88, 78, 194, 200, 221, 240, 156, 147, 172, 39,
+			187, 164, 156, 3, 130, 26, 187, 44, 179, 98, 11, 161, 176, 40,
+			238, 96, 221, 25, 174, 119, 70, 197, 239, 59, 23, 66, 113, 40,
+			59, 29, 233, 188, 223, 201, 122, 178, 156, 172, 250, 222, 133, 187,
+			159, 164, 133, 44, 83, 170, 22, 118, 97, 212, 61, 90, 56, 117,
+			195, 143, 76, 93, 149, 144, 187, 205, 173, 251, 247, 188, 119, 150,
+			21, 156, 92, 111, 199, 215, 12, 131, 253, 142, 205, 140, 30, 199,
+			234, 237, 112, 78, 124, 196, 230, 115, 97, 189, 25, 249, 27, 155,
+			9, 63, 113, 227, 241, 231, 242, 149, 77, 143, 159, 185, 48, 183,
+			200, 103, 27, 201, 102, 24, 197, 101, 198, 248, 25, 191, 226, 5,
+			177, 87, 229, 141, 160, 234, 69, 60, 217, 244, 248, 108, 221, 173,
+			224, 77, 241, 203, 52, 127, 145, 23, 197, 126, 24, 240, 19, 229,
+			27, 249, 36, 94, 40, 201, 159, 74, 83, 183, 49, 222, 12, 27,
+			124, 203, 109, 242, 32, 76, 120, 35, 246, 120, 178, 233, 199, 28,
+			98, 140, 123, 151, 43, 94, 61, 225, 126, 192, 43, 225, 86, 189,
+			230, 99, 248, 124, 219, 79, 54, 121, 146, 54, 95, 102, 252, 197,
+			178, 133, 112, 45, 113, 253, 128, 187, 188, 18, 214, 155, 60, 92,
+			207, 190, 198, 221, 132, 49, 78, 255, 109, 38, 73, 253, 228, 204,
+			204, 246, 246, 118, 217, 37, 76, 133, 14, 16, 239, 197, 51, 103,
+			22, 231, 22, 206, 46, 47, 28, 59, 81, 190, 145, 49, 126, 33,
+			168, 121, 113, 204, 35, 239, 199, 26, 126, 228, 85, 249, 90, 147,
+			187, 245, 122, 205, 175, 184, 107, 53, 143, 215, 220, 109, 30, 70,
+			220, 221, 136, 60, 175, 202, 147, 16, 184, 110, 71, 126, 226, 7,
+			27, 211, 60, 14, 215, 147, 109, 55, 242, 24, 175, 250, 113, 18,
+			249, 107, 141, 164, 133, 76, 10, 51, 63, 110, 121, 33, 12, 184,
+			27, 240, 210, 236, 50, 95, 92, 46, 241, 83, 179, 203, 139, 203,
+			211, 140, 223, 191, 184, 114, 247, 185, 11, 43, 252, 254, 217, 165,
+			165, 217, 179, 43, 139, 11, 203, 252, 220, 18, 159, 59, 119, 118,
+			126, 113, 101, 241, 220, 217, 101, 126, 238, 52, 159, 61, 251, 98,
+			126, 239, 226, 217, 249, 105, 238, 249, 201, 166, 23, 113, 239, 114,
+			61, 2, 246, 97, 196, 125, 16, 208, 171, 150, 25, 95, 246, 188,
+			150, 238, 215, 67, 49, 107, 113, 221, 171, 248, 235, 126, 133, 215,
+			220, 96, 163, 225, 110, 120, 124, 35, 188, 228, 69, 129, 31, 108,
+			240, 186, 23, 109, 249, 49, 38, 49, 230, 110, 80, 101, 188, 230,
+			111, 249, 9, 177, 90, 188, 115, 68, 101, 198, 58, 153, 97, 58,
+			86, 127, 199, 62, 252, 213, 233, 88, 78, 199, 157, 172, 139, 153,
+			157, 221, 226, 207, 255, 106, 49, 51, 223, 225, 216, 227, 29, 63,
+			98, 20, 191, 99, 241, 133, 203, 245, 48, 246, 98, 94, 9, 131,
+			196, 11, 146, 99, 110, 181, 10, 196, 137, 198, 177, 88, 210, 60,
+			217, 116, 19, 2, 188, 152, 58, 171, 187, 149, 139, 192, 18, 172,
+			66, 156, 56, 91, 171, 241, 185, 197, 243, 243, 250, 23, 63, 136,
+			19, 240, 76, 204, 39, 221, 74, 210, 112, 107, 188, 190, 217, 140,
+			253, 138, 91, 35, 254, 138, 167, 184, 27, 121, 60, 242, 98, 191,
+			138, 65, 250, 1, 241, 30, 83, 61, 78, 99, 168, 45, 93, 69,
+			94, 61, 140, 253, 36, 140, 154, 188, 234, 38, 46, 143, 147, 168,
+			81, 73, 26, 145, 23, 243, 151, 53, 226, 132, 71, 222, 186, 23,
+			121, 224, 210, 100, 211, 219, 226, 107, 77, 134, 207, 253, 136, 11,
+			205, 15, 36, 239, 11, 227, 68, 177, 166, 20, 86, 60, 246, 162,
+			75, 126, 197, 227, 179, 231, 23, 185, 31, 99, 17, 84, 185, 31,
+			36, 94, 20, 184, 181, 90, 19, 44, 135, 183, 215, 220, 202, 69,
+			47, 168, 2, 41, 198, 43, 97, 20, 121, 113, 61, 12, 8, 243,
+			165, 243, 115, 124, 203, 75, 54, 195, 106, 76, 67, 154, 157, 59,
+			67, 140, 84, 107, 130, 39, 221, 234, 150, 31, 196, 211, 98, 229,
+			136, 53, 133, 213, 24, 174, 51, 158, 149, 73, 130, 192, 126, 204,
+			221, 90, 45, 220, 22, 236, 188, 230, 9, 108, 170, 126, 228, 85,
+			18, 129, 75, 165, 230, 123, 65, 18, 227, 231, 117, 63, 112, 107,
+			254, 203, 61, 198, 133, 206, 226, 90, 183, 197, 60, 78, 220, 8,
+			252, 222, 138, 125, 153, 49, 198, 172, 124, 135, 225, 88, 227, 157,
+			125, 236, 85, 6, 179, 243, 29, 102, 135, 99, 93, 103, 62, 191,
+			184, 205, 207, 71, 97, 181, 129, 9, 115, 185, 176, 66, 248, 133,
+			165, 51, 2, 177, 138, 27, 104, 116, 208, 181, 151, 84, 54, 177,
+			80, 132, 210, 3, 105, 151, 188, 164, 17, 5, 49, 63, 123, 110,
+			101, 245, 244, 185, 11, 103, 231, 129, 67, 210, 0, 87, 85, 61,
+			238, 19, 205, 35, 111, 34, 230, 65, 200, 227, 70, 101, 51, 253,
+			180, 135, 229, 128, 133, 225, 88, 215, 229, 29, 5, 153, 142, 117,
+			221, 192, 179, 21, 100, 57, 214, 117, 199, 79, 178, 223, 238, 36,
+			132, 13, 199, 58, 105, 222, 89, 124, 164, 147, 47, 6, 126, 226,
+			187, 9, 80, 14, 118, 80, 1, 88, 157, 147, 236, 160, 126, 244,
+			99, 238, 203, 111, 170, 192, 72, 210, 19, 19, 47, 102, 52, 246,
+			193, 245, 88, 149, 226, 11, 76, 112, 178, 233, 49, 137, 46, 232,
+			142, 207, 18, 111, 171, 30, 70, 110, 212, 228, 181, 176, 66, 189,
+			241, 201, 122, 20, 94, 242, 171, 94, 149, 95, 242, 93, 62, 145,
+			90, 22, 19, 124, 123, 211, 175, 108, 242, 120, 51, 108, 212, 170,
+			124, 13, 19, 134, 105, 117, 179, 88, 131, 210, 126, 192, 239, 10,
+			195, 141, 90, 202, 155, 75, 94, 220, 216, 162, 117, 40, 185, 132,
+			44, 226, 74, 88, 155, 18, 140, 184, 78, 28, 36, 113, 84, 77,
+			17, 167, 185, 188, 226, 214, 106, 64, 55, 203, 101, 211, 18, 149,
+			109, 191, 86, 227, 53, 183, 17, 84, 54, 105, 145, 240, 75, 94,
+			4, 233, 227, 74, 222, 164, 103, 98, 196, 19, 49, 135, 49, 199,
+			67, 172, 79, 177, 90, 188, 136, 199, 126, 213, 3, 125, 23, 215,
+			179, 84, 188, 24, 132, 219, 66, 60, 136, 79, 178, 237, 112, 63,
+			225, 219, 174, 100, 93, 137, 169, 91, 139, 60, 183, 218, 156, 230,
+			126, 194, 136, 197, 36, 9, 241, 46, 17, 81, 162, 192, 215, 125,
+			175, 86, 45, 243, 197, 64, 113, 99, 156, 210, 8, 218, 43, 242,
+			234, 181, 38, 39, 93, 201, 184, 187, 237, 54, 165, 188, 74, 249,
+			76, 245, 197, 189, 203, 126, 156, 196, 88, 74, 145, 151, 52, 72,
+			190, 206, 158, 89, 90, 152, 157, 127, 241, 234, 194, 191, 90, 92,
+			94, 89, 150, 124, 139, 85, 190, 219, 24, 171, 161, 23, 7, 19,
+			98, 172, 233, 80, 155, 94, 194, 39, 235, 94, 180, 233, 214, 99,
+			238, 103, 199, 61, 17, 243, 181, 176, 218, 228, 126, 204, 248, 134,
+			23, 128, 55, 73, 58, 208, 183, 235, 181, 230, 20, 70, 207, 227,
+			4, 51, 2, 18, 132, 117, 47, 216, 141, 155, 49, 225, 64, 45,
+			74, 32, 34, 35, 207, 221, 82, 211, 14, 97, 88, 230, 247, 111,
+			122, 129, 18, 9, 109, 12, 145, 149, 95, 52, 243, 21, 183, 86,
+			105, 212, 220, 196, 99, 212, 42, 8, 17, 129, 180, 36, 142, 27,
+			53, 40, 208, 93, 102, 16, 180, 152, 13, 120, 155, 177, 35, 191,
+			86, 178, 198, 143, 165, 44, 36, 109, 226, 250, 1, 230, 251, 162,
+			135, 127, 32, 71, 54, 34, 55, 72, 152, 148, 104, 10, 63, 183,
+			82, 129, 158, 76, 66, 190, 189, 25, 122, 151, 188, 136, 215, 195,
+			56, 246, 240, 63, 186, 223, 130, 50, 39, 34, 169, 5, 196, 147,
+			200, 35, 50, 186, 49, 227, 46, 143, 189, 74, 228, 37, 101, 210,
+			173, 237, 216, 97, 37, 111, 133, 17, 212, 209, 122, 168, 133, 141,
+			1, 17, 146, 223, 175, 32, 211, 177, 78, 58, 207, 82, 144, 229,
+			88, 39, 103, 238, 96, 191, 145, 35, 97, 99, 58, 214, 189, 230,
+			169, 226, 47, 231, 228, 66, 82, 250, 207, 19, 194, 191, 125, 154,
+			166, 37, 61, 240, 155, 159, 196, 60, 240, 182, 37, 67, 129, 124,
+			115, 82, 122, 67, 77, 120, 151, 235, 94, 37, 145, 242, 148, 154,
+			222, 123, 245, 171, 101, 207, 215, 253, 40, 78, 24, 95, 243, 214,
+			49, 38, 44, 113, 116, 148, 93, 226, 101, 126, 218, 245, 107, 141,
+			200, 195, 218, 175, 134, 32, 157, 156, 115, 97, 222, 41, 174, 96,
+			25, 214, 74, 66, 14, 222, 104, 212, 97, 65, 145, 129, 79, 56,
+			19, 247, 175, 192, 32, 68, 71, 144, 145, 126, 21, 114, 15, 70,
+			2, 49, 142, 79, 26, 43, 29, 8, 102, 88, 41, 40, 234, 244,
+			162, 231, 213, 121, 61, 36, 44, 25, 166, 80, 44, 141, 38, 73,
+			2, 32, 184, 237, 250, 73, 106, 3, 9, 217, 146, 132, 66, 28,
+			53, 119, 72, 18, 129, 185, 87, 101, 79, 170, 119, 228, 242, 211,
+			226, 184, 125, 138, 244, 18, 38, 81, 128, 54, 22, 176, 249, 130,
+			6, 192, 154, 168, 42, 25, 175, 122, 35, 115, 69, 44, 98, 204,
+			91, 37, 220, 218, 106, 4, 144, 149, 52, 228, 40, 108, 108, 108,
+			242, 9, 208, 171, 17, 79, 64, 36, 123, 181, 106, 43, 194, 105,
+			215, 211, 60, 246, 165, 70, 18, 141, 193, 236, 214, 234, 189, 165,
+			127, 200, 11, 88, 22, 32, 253, 52, 95, 107, 36, 60, 114, 161,
+			66, 91, 177, 203, 140, 202, 79, 98, 175, 182, 174, 89, 220, 52,
+			28, 235, 94, 173, 79, 137, 141, 181, 62, 53, 45, 199, 186, 247,
+			248, 157, 236, 239, 44, 98, 113, 203, 177, 94, 108, 158, 42, 126,
+			219, 226, 217, 61, 14, 119, 215, 194, 40, 121, 114, 110, 7, 241,
+			22, 19, 190, 21, 94, 242, 98, 76, 111, 18, 114, 181, 37, 20,
+			28, 4, 89, 72, 130, 31, 122, 151, 235, 141, 166, 252, 17, 60,
+			84, 169, 121, 110, 16, 243, 70, 29, 178, 168, 153, 81, 172, 27,
+			110, 180, 230, 110, 120, 101, 61, 195, 24, 245, 22, 12, 184, 200,
+			171, 128, 3, 69, 27, 225, 250, 30, 76, 61, 185, 189, 233, 38,
+			36, 77, 252, 132, 54, 40, 107, 222, 20, 240, 157, 15, 61, 24,
+			33, 201, 38, 86, 142, 18, 213, 250, 43, 178, 192, 132, 166, 168,
+			16, 49, 32, 172, 35, 190, 238, 250, 53, 175, 154, 225, 183, 147,
+			216, 206, 60, 117, 158, 227, 156, 159, 158, 93, 60, 179, 48, 191,
+			122, 126, 105, 65, 239, 35, 84, 51, 59, 190, 198, 210, 11, 184,
+			222, 137, 3, 35, 189, 75, 151, 251, 42, 65, 141, 73, 191, 236,
+			149, 165, 56, 17, 152, 175, 121, 24, 167, 84, 12, 94, 149, 7,
+			225, 246, 148, 102, 18, 203, 112, 172, 23, 107, 38, 177, 76, 199,
+			122, 177, 102, 18, 11, 108, 113, 252, 78, 246, 26, 147, 153, 185,
+			14, 199, 174, 118, 108, 26, 197, 247, 25, 124, 185, 81, 175, 135,
+			100, 92, 98, 105, 162, 113, 120, 152, 34, 63, 217, 220, 146, 214,
+			179, 52, 58, 159, 100, 59, 1, 66, 158, 241, 19, 47, 114, 107,
+			28, 254, 50, 97, 56, 251, 176, 169, 18, 55, 72, 118, 44, 19,
+			101, 119, 86, 194, 64, 88, 253, 109, 162, 146, 241, 186, 155, 108,
+			198, 25, 187, 189, 204, 78, 156, 155, 121, 90, 255, 35, 219, 57,
+			7, 219, 185, 154, 219, 199, 186, 153, 157, 35, 211, 217, 51, 15,
+			129, 96, 0, 12, 199, 242, 204, 17, 5, 153, 142, 229, 21, 15,
+			202, 23, 13, 199, 90, 215, 47, 66, 251, 172, 155, 121, 5, 153,
+			142, 181, 174, 95, 52, 29, 107, 67, 191, 136, 53, 188, 97, 118,
+			42, 8, 191, 21, 15, 178, 123, 152, 105, 119, 56, 118, 173, 35,
+			52, 138, 119, 240, 217, 236, 190, 39, 76, 109, 114, 48, 204, 149,
+			103, 129, 49, 203, 198, 144, 106, 157, 251, 217, 11, 152, 109, 211,
+			144, 182, 204, 3, 165, 19, 104, 73, 79, 172, 216, 117, 167, 162,
+			145, 132, 97, 18, 242, 13, 47, 161, 169, 198, 28, 18, 79, 161,
+			129, 188, 99, 109, 153, 26, 50, 28, 107, 171, 123, 80, 65, 150,
+			99, 109, 141, 140, 178, 59, 169, 43, 195, 177, 2, 243, 64, 233,
+			38, 221, 4, 119, 99, 142, 221, 79, 84, 113, 99, 143, 111, 122,
+			151, 97, 233, 208, 110, 222, 43, 111, 240, 9, 119, 173, 82, 245,
+			214, 203, 229, 242, 132, 106, 221, 200, 161, 137, 78, 5, 161, 193,
+			174, 1, 5, 89, 142, 21, 140, 140, 50, 198, 76, 219, 112, 236,
+			168, 227, 229, 6, 102, 208, 6, 245, 163, 206, 131, 108, 142, 217,
+			182, 129, 225, 38, 230, 72, 241, 57, 237, 116, 76, 205, 158, 172,
+			241, 167, 13, 88, 218, 0, 137, 101, 132, 70, 242, 104, 165, 91,
+			65, 134, 99, 37, 61, 142, 130, 44, 199, 74, 134, 134, 217, 175,
+			27, 212, 159, 225, 88, 77, 115, 188, 248, 75, 6, 95, 92, 231,
+			240, 15, 120, 96, 119, 116, 161, 237, 40, 108, 5, 72, 123, 174,
+			73, 149, 40, 77, 250, 57, 57, 147, 243, 126, 76, 27, 97, 152,
+			55, 155, 158, 91, 245, 34, 38, 119, 143, 65, 165, 214, 168, 74,
+			3, 101, 195, 191, 68, 6, 161, 112, 60, 151, 73, 76, 187, 23,
+			189, 152, 175, 69, 225, 118, 236, 69, 49, 143, 221, 75, 180, 196,
+			232, 165, 212, 143, 192, 228, 167, 244, 153, 26, 33, 8, 221, 148,
+			132, 54, 76, 144, 176, 217, 85, 84, 144, 229, 88, 205, 195, 99,
+			172, 155, 153, 182, 233, 228, 126, 162, 227, 1, 67, 80, 26, 236,
+			251, 19, 157, 251, 217, 175, 155, 204, 182, 77, 179, 195, 177, 95,
+			109, 152, 163, 197, 135, 77, 62, 171, 54, 152, 119, 175, 172, 156,
+			95, 22, 219, 204, 112, 167, 209, 12, 49, 113, 26, 148, 134, 148,
+			241, 83, 219, 91, 250, 131, 184, 219, 72, 54, 189, 32, 145, 251,
+			150, 50, 28, 25, 62, 220, 1, 238, 122, 130, 61, 74, 184, 229,
+			241, 70, 32, 61, 43, 176, 26, 226, 205, 48, 74, 184, 187, 21,
+			54, 2, 225, 6, 240, 37, 101, 118, 90, 49, 110, 173, 6, 26,
+			209, 60, 192, 112, 130, 237, 178, 181, 229, 85, 177, 219, 132, 116,
+			97, 228, 142, 3, 226, 62, 201, 245, 141, 134, 11, 211, 86, 58,
+			162, 54, 65, 90, 168, 179, 186, 27, 37, 62, 108, 237, 72, 203,
+			166, 212, 111, 81, 230, 243, 33, 52, 17, 227, 110, 2, 189, 71,
+			250, 179, 238, 70, 212, 93, 153, 177, 125, 44, 7, 178, 229, 136,
+			110, 157, 10, 52, 0, 118, 13, 40, 208, 2, 56, 60, 194, 94,
+			202, 204, 156, 225, 228, 95, 103, 116, 188, 213, 48, 158, 126, 217,
+			215, 205, 172, 156, 97, 56, 246, 235, 140, 28, 204, 102, 59, 135,
+			165, 99, 191, 222, 48, 57, 16, 1, 100, 16, 120, 80, 129, 38,
+			192, 177, 113, 86, 166, 119, 13, 199, 126, 163, 97, 246, 151, 184,
+			222, 177, 192, 114, 20, 186, 73, 219, 89, 65, 184, 205, 228, 231,
+			232, 234, 141, 134, 217, 173, 64, 19, 96, 111, 31, 123, 46, 181,
+			102, 58, 246, 155, 208, 218, 84, 43, 203, 64, 31, 165, 205, 146,
+			13, 233, 183, 54, 107, 26, 244, 165, 106, 86, 52, 212, 219, 199,
+			158, 71, 205, 90, 142, 253, 16, 154, 61, 154, 105, 22, 251, 32,
+			190, 230, 121, 1, 175, 55, 214, 106, 66, 171, 74, 201, 58, 55,
+			187, 172, 218, 181, 12, 250, 84, 181, 107, 153, 0, 123, 251, 32,
+			82, 115, 134, 105, 59, 246, 155, 209, 238, 113, 124, 22, 121, 124,
+			219, 139, 60, 190, 238, 38, 110, 141, 147, 219, 63, 230, 213, 70,
+			164, 246, 108, 82, 83, 19, 71, 171, 230, 33, 191, 222, 108, 152,
+			93, 10, 52, 1, 246, 246, 177, 219, 168, 249, 156, 99, 191, 5,
+			205, 31, 107, 51, 101, 182, 221, 56, 181, 97, 176, 157, 206, 90,
+			119, 170, 233, 156, 65, 95, 107, 208, 4, 216, 219, 199, 122, 152,
+			105, 91, 78, 254, 109, 70, 199, 175, 24, 6, 235, 102, 150, 141,
+			49, 190, 205, 232, 44, 178, 159, 182, 153, 109, 91, 152, 254, 95,
+			52, 204, 145, 226, 223, 88, 215, 40, 59, 235, 13, 173, 156, 164,
+			34, 154, 134, 225, 131, 13, 117, 32, 247, 219, 123, 111, 220, 167,
+			201, 40, 166, 101, 41, 55, 77, 36, 27, 247, 216, 194, 147, 71,
+			128, 28, 2, 122, 35, 79, 238, 109, 152, 232, 216, 169, 194, 171,
+			26, 108, 240, 73, 56, 185, 221, 216, 203, 162, 187, 231, 62, 127,
+			106, 154, 101, 223, 187, 239, 194, 242, 10, 143, 27, 117, 56, 32,
+			244, 121, 155, 232, 98, 26, 196, 168, 121, 73, 203, 6, 156, 218,
+			131, 105, 202, 118, 218, 79, 153, 77, 110, 67, 250, 134, 213, 86,
+			29, 236, 209, 194, 232, 74, 4, 233, 189, 124, 149, 30, 107, 205,
+			145, 110, 203, 221, 202, 69, 53, 43, 18, 103, 55, 38, 193, 68,
+			78, 200, 246, 13, 243, 228, 132, 236, 70, 140, 97, 10, 219, 250,
+			236, 254, 146, 68, 163, 20, 78, 22, 52, 159, 253, 139, 146, 241,
+			193, 19, 6, 152, 162, 199, 81, 160, 5, 112, 104, 152, 253, 127,
+			80, 126, 22, 86, 223, 251, 13, 243, 64, 241, 175, 13, 62, 155,
+			181, 46, 224, 17, 2, 245, 67, 94, 245, 34, 255, 146, 226, 30,
+			56, 11, 97, 21, 200, 213, 33, 228, 68, 204, 183, 225, 233, 208,
+			75, 133, 237, 226, 169, 128, 16, 192, 206, 10, 94, 25, 205, 86,
+			231, 200, 241, 234, 214, 192, 107, 122, 140, 126, 172, 148, 176, 100,
+			144, 181, 48, 217, 76, 127, 198, 6, 101, 66, 207, 234, 4, 217,
+			162, 90, 105, 203, 183, 202, 153, 223, 137, 25, 182, 220, 4, 110,
+			181, 204, 119, 154, 92, 70, 158, 40, 160, 65, 34, 72, 247, 160,
+			2, 45, 128, 35, 163, 180, 248, 108, 39, 255, 171, 70, 199, 111,
+			201, 197, 7, 9, 240, 171, 70, 231, 65, 246, 66, 102, 219, 54,
+			214, 222, 35, 134, 121, 164, 56, 7, 58, 250, 85, 232, 192, 117,
+			223, 139, 64, 133, 221, 92, 71, 41, 47, 52, 121, 230, 36, 13,
+			75, 73, 162, 102, 147, 154, 121, 68, 169, 25, 155, 102, 242, 17,
+			163, 235, 144, 2, 45, 244, 56, 206, 217, 223, 66, 151, 219, 152,
+			201, 143, 25, 230, 193, 226, 255, 101, 194, 142, 137, 189, 100, 154,
+			251, 210, 52, 143, 181, 255, 34, 9, 121, 124, 209, 175, 167, 171,
+			167, 197, 203, 8, 218, 146, 223, 222, 141, 227, 198, 22, 173, 61,
+			229, 201, 246, 170, 89, 233, 155, 90, 51, 41, 223, 251, 241, 147,
+			248, 234, 149, 71, 95, 56, 88, 195, 122, 226, 111, 73, 129, 42,
+			151, 20, 28, 75, 224, 34, 114, 73, 36, 81, 35, 150, 219, 92,
+			221, 59, 92, 106, 124, 18, 47, 123, 151, 221, 173, 122, 205, 131,
+			227, 212, 11, 240, 186, 36, 46, 236, 1, 218, 112, 99, 51, 197,
+			23, 46, 75, 221, 174, 236, 6, 48, 74, 213, 11, 160, 126, 26,
+			56, 210, 229, 97, 86, 246, 148, 249, 108, 170, 240, 165, 117, 33,
+			60, 111, 49, 131, 104, 60, 191, 176, 116, 223, 226, 242, 242, 226,
+			185, 179, 171, 243, 11, 103, 23, 23, 230, 245, 44, 129, 129, 62,
+			166, 214, 155, 13, 67, 204, 254, 152, 209, 51, 172, 64, 11, 191,
+			30, 128, 97, 102, 218, 57, 39, 255, 219, 70, 199, 163, 146, 129,
+			32, 231, 127, 91, 49, 80, 14, 12, 244, 201, 167, 149, 129, 114,
+			196, 64, 159, 84, 12, 148, 35, 6, 250, 164, 98, 160, 28, 49,
+			208, 39, 141, 113, 78, 168, 229, 157, 252, 167, 12, 58, 240, 132,
+			98, 201, 27, 142, 253, 41, 163, 115, 132, 253, 2, 164, 68, 30,
+			184, 125, 214, 48, 139, 197, 183, 144, 148, 8, 235, 238, 143, 53,
+			60, 185, 17, 144, 118, 174, 194, 23, 179, 230, 199, 123, 121, 37,
+			92, 240, 34, 120, 32, 227, 84, 128, 197, 24, 70, 138, 27, 200,
+			51, 73, 60, 209, 34, 225, 176, 44, 248, 10, 92, 140, 228, 152,
+			118, 227, 212, 199, 40, 230, 33, 79, 131, 253, 172, 26, 108, 158,
+			6, 251, 89, 163, 107, 72, 129, 22, 134, 48, 122, 128, 61, 38,
+			70, 100, 56, 246, 23, 97, 249, 254, 129, 33, 79, 84, 180, 36,
+			206, 72, 251, 107, 240, 254, 243, 36, 212, 135, 62, 187, 26, 205,
+			103, 195, 54, 219, 152, 251, 153, 83, 212, 212, 1, 79, 124, 46,
+			221, 77, 64, 77, 121, 90, 221, 90, 28, 74, 77, 196, 90, 253,
+			173, 59, 73, 97, 228, 104, 116, 138, 20, 96, 201, 47, 42, 251,
+			52, 79, 44, 249, 69, 99, 120, 132, 205, 16, 37, 76, 199, 254,
+			18, 230, 246, 8, 23, 209, 9, 173, 238, 155, 150, 41, 20, 223,
+			155, 121, 250, 162, 87, 54, 7, 193, 243, 37, 163, 79, 17, 218,
+			180, 0, 142, 30, 96, 191, 45, 8, 109, 57, 246, 99, 176, 73,
+			126, 205, 224, 167, 195, 40, 227, 48, 129, 119, 164, 17, 171, 141,
+			86, 155, 173, 146, 90, 118, 169, 91, 81, 137, 24, 63, 200, 218,
+			240, 141, 216, 91, 111, 212, 50, 199, 67, 25, 109, 180, 167, 26,
+			98, 116, 86, 16, 240, 70, 144, 248, 181, 236, 104, 73, 28, 128,
+			239, 200, 179, 36, 70, 100, 229, 105, 8, 221, 114, 128, 48, 186,
+			30, 83, 10, 53, 111, 90, 52, 192, 161, 97, 218, 188, 230, 97,
+			88, 126, 21, 82, 248, 22, 26, 45, 57, 110, 51, 99, 117, 249,
+			102, 99, 203, 133, 244, 119, 171, 116, 134, 68, 198, 38, 151, 129,
+			39, 186, 71, 59, 71, 173, 168, 249, 131, 166, 249, 170, 209, 53,
+			172, 64, 11, 224, 129, 226, 90, 158, 124, 208, 55, 177, 239, 26,
+			108, 108, 131, 248, 116, 134, 158, 172, 53, 214, 103, 170, 13, 57,
+			107, 244, 196, 233, 19, 191, 151, 213, 239, 165, 147, 172, 115, 94,
+			190, 226, 140, 178, 66, 236, 85, 194, 160, 26, 83, 24, 145, 181,
+			164, 64, 103, 144, 229, 2, 55, 8, 99, 138, 27, 202, 45, 9,
+			224, 212, 191, 102, 3, 149, 112, 171, 220, 214, 228, 169, 125, 170,
+			193, 243, 120, 114, 222, 248, 145, 163, 27, 126, 178, 217, 88, 43,
+			87, 194, 173, 153, 141, 16, 167, 232, 41, 126, 245, 164, 89, 247,
+			98, 141, 230, 223, 25, 198, 47, 152, 214, 93, 231, 79, 253, 59,
+			115, 76, 248, 145, 202, 231, 229, 171, 229, 251, 189, 90, 237, 94,
+			76, 215, 10, 62, 209, 163, 254, 176, 193, 14, 182, 143, 26, 2,
+			188, 185, 215, 144, 11, 44, 183, 128, 223, 79, 93, 218, 29, 127,
+			70, 191, 42, 228, 39, 174, 140, 60, 245, 246, 20, 48, 255, 102,
+			142, 29, 106, 199, 92, 40, 234, 189, 80, 127, 187, 193, 242, 203,
+			244, 134, 115, 27, 203, 147, 21, 136, 185, 178, 38, 187, 79, 92,
+			215, 62, 142, 178, 120, 177, 124, 154, 222, 90, 8, 146, 168, 185,
+			36, 63, 41, 190, 144, 117, 103, 30, 59, 253, 204, 186, 232, 53,
+			101, 28, 22, 254, 116, 166, 89, 238, 146, 91, 107, 120, 50, 80,
+			108, 120, 71, 227, 47, 194, 175, 75, 226, 165, 147, 230, 243, 140,
+			210, 35, 38, 203, 209, 67, 231, 54, 198, 130, 70, 173, 182, 74,
+			191, 201, 16, 192, 226, 142, 6, 206, 54, 106, 53, 122, 255, 238,
+			142, 165, 174, 64, 1, 206, 117, 172, 39, 104, 108, 173, 121, 145,
+			252, 28, 253, 27, 119, 119, 44, 117, 139, 167, 250, 37, 161, 116,
+			228, 75, 8, 2, 235, 194, 75, 226, 169, 120, 105, 156, 177, 181,
+			48, 84, 104, 32, 246, 171, 19, 93, 225, 153, 120, 225, 249, 172,
+			71, 80, 91, 190, 130, 112, 175, 238, 19, 35, 59, 48, 21, 116,
+			148, 205, 55, 42, 137, 30, 101, 205, 143, 213, 183, 121, 250, 118,
+			231, 40, 207, 248, 113, 162, 71, 89, 83, 192, 169, 60, 179, 47,
+			250, 65, 181, 116, 27, 235, 210, 111, 56, 101, 150, 167, 198, 212,
+			140, 238, 69, 116, 249, 214, 209, 131, 172, 75, 19, 209, 233, 101,
+			236, 236, 133, 51, 103, 86, 95, 52, 123, 230, 194, 66, 127, 199,
+			169, 159, 50, 118, 103, 238, 110, 49, 26, 197, 221, 199, 175, 204,
+			221, 98, 208, 183, 137, 127, 234, 107, 79, 129, 207, 191, 111, 176,
+			241, 118, 62, 79, 252, 45, 47, 78, 220, 173, 250, 94, 172, 126,
+			27, 235, 90, 81, 239, 92, 179, 100, 250, 159, 119, 31, 124, 175,
+			110, 81, 141, 255, 134, 43, 143, 95, 99, 250, 20, 70, 254, 218,
+			111, 154, 236, 198, 171, 11, 170, 69, 8, 141, 164, 5, 69, 213,
+			22, 175, 32, 202, 139, 79, 38, 244, 138, 79, 42, 87, 138, 87,
+			154, 141, 226, 181, 6, 2, 151, 38, 216, 190, 243, 145, 183, 238,
+			95, 150, 225, 119, 206, 48, 203, 215, 233, 129, 148, 42, 18, 42,
+			125, 205, 100, 189, 226, 205, 251, 188, 196, 133, 145, 179, 215, 171,
+			14, 103, 221, 235, 126, 176, 225, 69, 245, 200, 15, 84, 116, 112,
+			246, 145, 115, 27, 235, 110, 212, 171, 110, 226, 173, 98, 146, 70,
+			173, 61, 22, 161, 158, 245, 37, 38, 94, 199, 3, 103, 92, 127,
+			220, 136, 189, 136, 4, 68, 151, 122, 225, 66, 236, 69, 206, 52,
+			179, 221, 74, 45, 30, 205, 209, 106, 148, 209, 142, 173, 184, 151,
+			103, 231, 206, 44, 209, 91, 206, 157, 172, 63, 242, 224, 4, 70,
+			80, 107, 61, 172, 249, 149, 166, 148, 10, 50, 18, 113, 73, 253,
+			122, 158, 126, 92, 234, 211, 175, 139, 7, 197, 5, 102, 205, 206,
+			157, 113, 198, 152, 29, 133, 53, 37, 56, 153, 252, 56, 172, 121,
+			75, 244, 220, 25, 99, 12, 4, 169, 248, 117, 183, 6, 182, 183,
+			38, 187, 150, 50, 79, 74, 175, 51, 88, 95, 91, 95, 206, 52,
+			115, 112, 222, 187, 90, 115, 227, 100, 181, 17, 36, 238, 198, 134,
+			39, 98, 133, 115, 75, 253, 248, 229, 140, 27, 39, 23, 228, 115,
+			231, 94, 54, 184, 229, 94, 94, 109, 4, 218, 28, 171, 174, 34,
+			30, 22, 51, 208, 125, 226, 192, 14, 250, 42, 125, 191, 228, 108,
+			185, 151, 47, 100, 190, 154, 221, 240, 74, 171, 108, 100, 49, 216,
+			244, 34, 63, 241, 170, 109, 19, 63, 207, 6, 234, 94, 180, 42,
+			166, 123, 117, 75, 62, 150, 210, 111, 112, 55, 122, 47, 237, 175,
+			123, 81, 235, 163, 210, 107, 12, 54, 180, 20, 214, 188, 120, 49,
+			80, 44, 72, 209, 66, 158, 115, 7, 203, 129, 98, 74, 158, 78,
+			166, 164, 220, 241, 46, 61, 213, 15, 197, 103, 197, 50, 235, 201,
+			62, 190, 210, 204, 148, 234, 108, 63, 164, 249, 85, 45, 4, 231,
+			16, 235, 138, 188, 74, 35, 138, 253, 75, 130, 178, 157, 75, 233,
+			3, 231, 122, 214, 43, 143, 12, 86, 55, 253, 106, 213, 11, 136,
+			185, 59, 151, 246, 201, 167, 119, 211, 195, 210, 25, 230, 100, 123,
+			148, 227, 46, 178, 78, 25, 152, 39, 134, 222, 181, 164, 97, 250,
+			141, 16, 240, 20, 239, 104, 184, 116, 148, 245, 158, 23, 239, 41,
+			228, 71, 89, 65, 126, 41, 177, 87, 96, 233, 195, 6, 235, 92,
+			148, 97, 132, 123, 191, 230, 220, 192, 58, 85, 176, 225, 168, 185,
+			123, 248, 181, 126, 193, 185, 142, 237, 139, 188, 13, 63, 78, 188,
+			200, 171, 174, 174, 53, 105, 204, 93, 75, 61, 233, 195, 83, 77,
+			231, 5, 45, 47, 37, 241, 168, 125, 197, 85, 159, 105, 96, 37,
+			46, 189, 223, 96, 163, 75, 242, 129, 26, 129, 38, 221, 141, 58,
+			176, 220, 160, 192, 114, 185, 234, 197, 251, 130, 203, 219, 194, 203,
+			143, 238, 24, 161, 12, 229, 215, 109, 167, 3, 60, 193, 186, 116,
+			240, 252, 168, 149, 21, 14, 109, 174, 190, 165, 78, 21, 73, 95,
+			170, 177, 65, 76, 177, 106, 45, 190, 226, 212, 56, 7, 89, 87,
+			221, 221, 240, 86, 99, 255, 229, 222, 232, 32, 25, 236, 157, 120,
+			176, 236, 191, 220, 67, 96, 61, 254, 94, 165, 253, 245, 232, 16,
+			125, 73, 175, 175, 224, 65, 105, 139, 13, 181, 245, 38, 9, 51,
+			205, 186, 212, 48, 212, 122, 106, 31, 103, 250, 130, 243, 108, 214,
+			23, 120, 151, 147, 213, 76, 87, 131, 212, 213, 62, 60, 62, 175,
+			187, 123, 189, 193, 134, 151, 61, 55, 170, 108, 94, 195, 248, 14,
+			51, 59, 113, 55, 4, 251, 118, 159, 232, 18, 88, 172, 184, 27,
+			75, 244, 248, 7, 26, 126, 200, 70, 118, 160, 243, 140, 18, 224,
+			95, 51, 71, 116, 136, 52, 20, 61, 118, 135, 217, 27, 181, 112,
+			77, 14, 156, 254, 206, 200, 17, 179, 69, 142, 252, 32, 195, 253,
+			111, 6, 27, 104, 233, 94, 142, 245, 86, 86, 32, 79, 169, 30,
+			233, 184, 32, 242, 46, 239, 150, 239, 195, 139, 75, 234, 253, 171,
+			29, 120, 241, 21, 6, 203, 209, 167, 45, 11, 200, 184, 194, 2,
+			114, 152, 141, 104, 2, 105, 11, 208, 223, 206, 117, 204, 198, 254,
+			126, 212, 218, 93, 188, 208, 143, 142, 195, 108, 34, 16, 132, 133,
+			181, 68, 127, 151, 254, 163, 193, 44, 100, 44, 57, 204, 198, 129,
+			170, 162, 54, 254, 206, 114, 159, 185, 183, 68, 219, 163, 203, 20,
+			223, 113, 214, 189, 21, 86, 225, 15, 35, 121, 38, 109, 12, 245,
+			232, 84, 211, 185, 45, 243, 66, 2, 83, 227, 138, 22, 140, 122,
+			125, 37, 46, 221, 201, 250, 231, 189, 154, 151, 120, 24, 103, 202,
+			58, 87, 63, 152, 210, 13, 172, 15, 203, 125, 201, 91, 191, 242,
+			186, 43, 29, 103, 253, 233, 203, 146, 83, 14, 51, 59, 242, 214,
+			21, 155, 200, 181, 8, 116, 232, 113, 233, 181, 6, 179, 86, 220,
+			141, 93, 54, 152, 131, 217, 13, 102, 151, 220, 72, 194, 38, 115,
+			147, 4, 169, 7, 25, 249, 207, 212, 35, 65, 47, 253, 194, 85,
+			201, 126, 253, 241, 74, 92, 106, 178, 253, 179, 4, 173, 184, 27,
+			87, 30, 239, 181, 233, 46, 37, 148, 172, 93, 133, 18, 186, 158,
+			247, 254, 105, 186, 254, 115, 131, 245, 171, 165, 164, 140, 166, 43,
+			77, 72, 143, 154, 144, 35, 172, 71, 70, 169, 172, 98, 55, 36,
+			103, 164, 91, 62, 131, 35, 166, 221, 76, 183, 119, 154, 233, 109,
+			179, 154, 187, 210, 172, 230, 175, 105, 86, 223, 108, 176, 33, 49,
+			173, 106, 116, 79, 51, 125, 79, 176, 78, 109, 155, 90, 114, 103,
+			222, 34, 160, 116, 191, 250, 61, 66, 106, 222, 251, 33, 67, 170,
+			206, 6, 176, 128, 159, 33, 140, 28, 102, 95, 244, 154, 98, 5,
+			116, 45, 209, 223, 165, 123, 216, 96, 107, 143, 82, 108, 100, 177,
+			55, 174, 18, 251, 123, 217, 208, 146, 23, 135, 181, 75, 158, 76,
+			155, 186, 50, 254, 163, 172, 112, 73, 188, 171, 4, 159, 4, 75,
+			255, 19, 27, 186, 203, 211, 102, 78, 38, 183, 244, 233, 33, 70,
+			233, 173, 38, 235, 58, 31, 133, 8, 158, 14, 35, 167, 151, 153,
+			58, 205, 209, 244, 171, 206, 13, 44, 7, 235, 81, 180, 211, 171,
+			108, 64, 253, 126, 25, 38, 166, 183, 36, 222, 129, 200, 83, 174,
+			232, 213, 36, 30, 181, 174, 188, 56, 212, 235, 43, 177, 51, 195,
+			242, 226, 244, 106, 212, 126, 82, 239, 214, 146, 124, 13, 98, 128,
+			124, 209, 50, 249, 81, 0, 165, 211, 44, 71, 56, 57, 67, 108,
+			63, 82, 21, 23, 218, 50, 21, 187, 89, 225, 252, 194, 217, 52,
+			79, 113, 249, 194, 220, 220, 194, 194, 252, 194, 124, 191, 137, 4,
+			72, 17, 79, 217, 111, 149, 30, 51, 216, 200, 188, 23, 87, 34,
+			127, 205, 83, 196, 127, 122, 41, 143, 77, 68, 85, 246, 176, 10,
+			29, 36, 55, 78, 61, 234, 33, 212, 87, 203, 75, 36, 59, 237,
+			214, 151, 32, 165, 157, 25, 54, 160, 95, 170, 171, 185, 17, 58,
+			186, 115, 201, 81, 63, 233, 89, 139, 75, 191, 110, 176, 209, 157,
+			163, 147, 44, 127, 45, 102, 142, 210, 170, 230, 174, 90, 245, 10,
+			2, 223, 153, 97, 44, 131, 174, 205, 173, 148, 96, 26, 217, 165,
+			204, 43, 88, 12, 10, 109, 17, 5, 255, 52, 47, 134, 255, 221,
+			100, 195, 237, 29, 60, 5, 170, 148, 25, 19, 71, 115, 152, 215,
+			189, 122, 237, 170, 200, 14, 214, 157, 155, 217, 62, 249, 254, 154,
+			31, 184, 81, 115, 55, 11, 17, 43, 191, 71, 188, 117, 138, 94,
+			130, 9, 34, 191, 202, 24, 140, 178, 99, 178, 169, 199, 89, 119,
+			205, 219, 112, 43, 205, 213, 120, 211, 61, 46, 215, 9, 19, 143,
+			150, 55, 221, 227, 206, 237, 204, 145, 45, 68, 222, 250, 170, 91,
+			243, 221, 216, 139, 71, 243, 220, 218, 13, 223, 126, 141, 239, 172,
+			120, 241, 232, 11, 152, 13, 159, 130, 51, 200, 250, 151, 206, 157,
+			105, 95, 105, 140, 229, 17, 78, 179, 176, 36, 82, 139, 239, 95,
+			90, 92, 89, 88, 234, 55, 157, 46, 150, 59, 119, 255, 217, 133,
+			165, 126, 235, 104, 204, 156, 157, 251, 85, 231, 58, 54, 190, 180,
+			112, 215, 226, 242, 202, 210, 44, 242, 34, 119, 207, 56, 238, 101,
+			76, 188, 180, 128, 60, 98, 195, 25, 102, 142, 10, 223, 201, 60,
+			55, 157, 126, 214, 131, 0, 107, 17, 52, 142, 149, 125, 226, 255,
+			232, 97, 108, 73, 103, 1, 58, 119, 176, 253, 119, 121, 210, 47,
+			161, 100, 185, 51, 160, 152, 48, 227, 31, 41, 238, 234, 231, 113,
+			206, 179, 34, 201, 231, 221, 29, 71, 187, 54, 116, 88, 49, 206,
+			238, 223, 156, 98, 131, 23, 200, 249, 215, 246, 124, 215, 254, 247,
+			192, 106, 158, 245, 223, 229, 37, 45, 14, 164, 221, 113, 57, 248,
+			36, 174, 38, 231, 5, 140, 165, 78, 27, 103, 68, 188, 154, 117,
+			227, 136, 54, 70, 119, 254, 32, 27, 184, 141, 117, 223, 237, 87,
+			61, 233, 171, 113, 20, 174, 45, 174, 155, 226, 206, 211, 3, 58,
+			214, 114, 110, 103, 251, 46, 4, 155, 63, 200, 231, 98, 207, 241,
+			212, 62, 63, 197, 250, 219, 125, 47, 78, 219, 138, 47, 142, 41,
+			121, 183, 135, 143, 230, 110, 182, 175, 197, 71, 225, 20, 83, 74,
+			233, 135, 109, 51, 209, 246, 155, 108, 233, 44, 235, 107, 219, 238,
+			59, 135, 178, 59, 221, 204, 23, 45, 60, 182, 227, 87, 217, 222,
+			41, 214, 157, 217, 34, 59, 163, 187, 236, 154, 69, 59, 7, 118,
+			249, 69, 182, 81, 102, 93, 115, 116, 164, 15, 17, 150, 138, 254,
+			61, 41, 122, 27, 235, 210, 155, 64, 71, 90, 81, 237, 187, 194,
+			61, 63, 190, 149, 117, 170, 45, 157, 35, 173, 144, 116, 139, 167,
+			62, 109, 123, 44, 241, 188, 157, 177, 116, 51, 165, 184, 120, 199,
+			246, 106, 207, 158, 111, 103, 108, 222, 107, 255, 124, 222, 187, 218,
+			207, 23, 88, 111, 171, 205, 239, 200, 137, 222, 117, 39, 240, 100,
+			205, 204, 123, 187, 53, 51, 239, 93, 91, 51, 61, 89, 51, 87,
+			149, 37, 104, 53, 125, 69, 19, 197, 221, 126, 210, 36, 237, 109,
+			181, 112, 21, 54, 187, 218, 189, 197, 182, 117, 227, 220, 193, 122,
+			91, 109, 90, 245, 249, 174, 150, 238, 206, 242, 14, 47, 100, 253,
+			237, 214, 139, 35, 25, 190, 253, 185, 106, 99, 108, 175, 159, 229,
+			136, 238, 101, 189, 173, 138, 223, 57, 216, 250, 69, 139, 189, 81,
+			60, 180, 251, 143, 162, 177, 107, 43, 221, 240, 224, 7, 76, 81,
+			187, 225, 137, 194, 191, 212, 110, 248, 151, 218, 13, 255, 24, 181,
+			27, 186, 152, 105, 117, 56, 214, 96, 199, 36, 158, 34, 127, 107,
+			168, 227, 122, 250, 211, 116, 172, 225, 142, 103, 211, 159, 150, 99,
+			141, 116, 76, 209, 159, 182, 99, 141, 118, 220, 202, 254, 114, 128,
+			74, 62, 228, 238, 238, 120, 143, 101, 20, 31, 27, 224, 247, 185,
+			1, 142, 110, 90, 74, 54, 196, 170, 228, 130, 31, 113, 55, 142,
+			195, 10, 146, 29, 170, 92, 109, 142, 17, 22, 53, 171, 222, 5,
+			237, 93, 10, 207, 173, 242, 74, 88, 171, 121, 21, 149, 58, 30,
+			6, 30, 200, 70, 73, 183, 114, 39, 28, 227, 185, 203, 55, 162,
+			176, 81, 199, 159, 178, 104, 68, 166, 53, 25, 7, 151, 52, 235,
+			40, 16, 81, 107, 234, 98, 3, 160, 49, 202, 17, 4, 8, 140,
+			83, 124, 65, 21, 73, 194, 0, 81, 229, 97, 196, 40, 157, 128,
+			66, 80, 177, 16, 92, 36, 30, 111, 212, 194, 45, 21, 73, 150,
+			197, 89, 138, 14, 129, 124, 37, 12, 144, 55, 140, 212, 224, 138,
+			27, 5, 244, 54, 3, 122, 174, 72, 28, 151, 200, 159, 68, 64,
+			28, 125, 16, 121, 178, 118, 197, 116, 38, 251, 85, 7, 79, 18,
+			135, 35, 229, 87, 84, 226, 225, 147, 64, 13, 245, 121, 104, 12,
+			97, 13, 107, 92, 34, 18, 79, 201, 154, 20, 200, 22, 150, 241,
+			196, 34, 58, 87, 219, 236, 34, 214, 79, 198, 201, 51, 29, 249,
+			167, 230, 64, 71, 164, 197, 94, 228, 235, 124, 1, 32, 191, 179,
+			133, 36, 68, 208, 30, 5, 173, 0, 97, 58, 57, 197, 130, 68,
+			16, 161, 27, 243, 146, 166, 202, 226, 124, 41, 51, 48, 149, 115,
+			39, 7, 216, 220, 65, 197, 152, 79, 34, 29, 179, 214, 228, 126,
+			32, 66, 226, 41, 197, 101, 221, 173, 64, 0, 213, 195, 76, 42,
+			32, 113, 25, 18, 103, 209, 124, 205, 15, 84, 252, 163, 72, 108,
+			165, 104, 67, 180, 209, 170, 130, 16, 93, 58, 69, 121, 150, 71,
+			41, 190, 77, 236, 126, 16, 206, 22, 99, 53, 17, 105, 5, 25,
+			101, 66, 117, 128, 140, 125, 153, 44, 213, 154, 70, 70, 63, 65,
+			180, 160, 53, 46, 139, 32, 184, 181, 109, 183, 25, 203, 148, 119,
+			138, 244, 187, 249, 70, 94, 217, 116, 35, 62, 187, 60, 183, 184,
+			40, 73, 22, 79, 81, 182, 230, 81, 126, 142, 4, 195, 110, 29,
+			54, 192, 158, 124, 205, 141, 189, 231, 220, 60, 41, 94, 224, 55,
+			240, 151, 188, 116, 173, 153, 120, 63, 30, 121, 235, 186, 224, 214,
+			79, 76, 105, 4, 144, 2, 50, 33, 62, 153, 192, 172, 92, 88,
+			58, 115, 44, 118, 215, 61, 154, 128, 70, 80, 119, 171, 104, 25,
+			192, 196, 13, 244, 2, 184, 213, 77, 188, 64, 134, 71, 162, 21,
+			162, 138, 228, 54, 133, 23, 233, 128, 236, 144, 110, 222, 101, 72,
+			34, 140, 22, 41, 223, 68, 171, 57, 112, 149, 82, 171, 196, 224,
+			104, 199, 219, 138, 189, 218, 37, 153, 154, 249, 114, 191, 206, 97,
+			96, 250, 120, 64, 31, 81, 118, 149, 91, 173, 250, 50, 170, 62,
+			149, 18, 124, 69, 165, 109, 214, 69, 65, 17, 49, 14, 228, 110,
+			54, 182, 4, 155, 67, 137, 242, 185, 51, 139, 154, 13, 84, 173,
+			23, 85, 166, 64, 48, 22, 211, 25, 95, 21, 52, 231, 174, 133,
+			141, 68, 202, 167, 93, 178, 169, 24, 227, 114, 131, 32, 115, 74,
+			107, 97, 120, 145, 215, 252, 139, 30, 143, 194, 48, 33, 109, 132,
+			193, 241, 184, 25, 39, 222, 150, 76, 25, 157, 244, 202, 27, 101,
+			94, 114, 103, 214, 102, 42, 165, 169, 50, 144, 103, 233, 162, 22,
+			17, 209, 41, 235, 35, 59, 152, 184, 6, 200, 174, 55, 106, 53,
+			106, 133, 190, 82, 197, 77, 130, 80, 46, 69, 198, 75, 148, 209,
+			141, 132, 6, 188, 21, 151, 166, 33, 24, 75, 229, 178, 248, 3,
+			249, 97, 33, 49, 85, 236, 111, 249, 8, 47, 213, 233, 173, 49,
+			181, 72, 195, 144, 218, 152, 241, 154, 27, 39, 212, 91, 70, 250,
+			33, 60, 62, 192, 218, 1, 171, 198, 126, 133, 210, 234, 160, 138,
+			104, 38, 133, 46, 67, 208, 43, 229, 168, 11, 121, 21, 174, 39,
+			8, 124, 87, 171, 187, 234, 5, 33, 50, 177, 121, 189, 230, 38,
+			235, 97, 180, 213, 82, 114, 7, 49, 176, 112, 194, 131, 23, 215,
+			195, 168, 204, 79, 167, 33, 241, 12, 146, 99, 61, 114, 103, 146,
+			48, 172, 197, 194, 64, 170, 249, 65, 227, 242, 49, 119, 171, 250,
+			156, 155, 75, 123, 53, 194, 207, 224, 37, 30, 53, 2, 42, 190,
+			1, 165, 141, 247, 153, 224, 175, 196, 147, 169, 113, 42, 0, 87,
+			164, 8, 240, 74, 24, 92, 130, 28, 10, 131, 172, 240, 133, 192,
+			247, 2, 170, 39, 135, 60, 175, 136, 9, 149, 26, 39, 97, 168,
+			101, 143, 100, 41, 240, 248, 130, 91, 217, 228, 94, 205, 219, 34,
+			205, 177, 222, 50, 84, 208, 58, 174, 67, 118, 77, 138, 112, 93,
+			136, 70, 245, 35, 145, 93, 6, 23, 97, 234, 128, 20, 227, 37,
+			249, 100, 10, 105, 86, 34, 231, 143, 86, 133, 90, 10, 92, 29,
+			32, 128, 208, 126, 82, 230, 115, 141, 40, 242, 2, 84, 242, 241,
+			51, 217, 154, 97, 0, 78, 71, 186, 173, 168, 131, 129, 115, 143,
+			40, 172, 113, 68, 242, 241, 201, 217, 185, 51, 83, 178, 154, 137,
+			76, 100, 68, 204, 177, 155, 112, 68, 131, 136, 197, 185, 65, 153,
+			135, 16, 232, 244, 11, 77, 110, 20, 107, 243, 68, 228, 78, 8,
+			92, 137, 118, 40, 230, 32, 71, 38, 109, 144, 192, 195, 0, 125,
+			4, 117, 103, 167, 120, 90, 52, 141, 105, 130, 127, 102, 97, 137,
+			58, 149, 73, 11, 209, 157, 242, 45, 196, 181, 65, 122, 206, 206,
+			157, 201, 154, 13, 144, 18, 76, 117, 43, 215, 23, 165, 150, 198,
+			59, 191, 78, 173, 36, 52, 142, 128, 229, 44, 146, 44, 163, 114,
+			194, 117, 181, 86, 197, 80, 2, 173, 151, 144, 247, 87, 11, 183,
+			161, 189, 178, 107, 122, 166, 138, 85, 13, 211, 13, 142, 18, 198,
+			189, 160, 177, 69, 164, 33, 242, 134, 235, 52, 164, 88, 102, 83,
+			169, 53, 188, 238, 7, 94, 148, 197, 10, 116, 128, 190, 245, 19,
+			104, 59, 84, 191, 105, 93, 39, 148, 10, 50, 205, 169, 95, 204,
+			109, 204, 144, 216, 73, 117, 76, 168, 160, 16, 116, 112, 13, 216,
+			169, 225, 17, 163, 100, 244, 184, 110, 73, 142, 84, 20, 140, 10,
+			27, 200, 63, 192, 135, 152, 131, 44, 105, 90, 40, 146, 65, 37,
+			83, 226, 233, 238, 78, 135, 253, 55, 83, 149, 120, 122, 161, 121,
+			103, 241, 63, 155, 186, 152, 66, 202, 163, 173, 51, 182, 131, 99,
+			64, 25, 108, 157, 124, 85, 249, 11, 246, 153, 23, 233, 44, 87,
+			114, 8, 18, 17, 53, 195, 33, 209, 192, 139, 209, 162, 156, 125,
+			37, 236, 214, 145, 167, 143, 21, 32, 127, 240, 226, 105, 33, 1,
+			183, 125, 149, 124, 135, 177, 161, 216, 130, 212, 50, 59, 50, 97,
+			68, 198, 100, 182, 44, 143, 64, 7, 146, 80, 160, 146, 153, 53,
+			108, 105, 168, 223, 157, 221, 194, 238, 32, 177, 186, 3, 89, 41,
+			83, 247, 36, 144, 159, 76, 239, 134, 42, 203, 84, 131, 80, 56,
+			202, 234, 85, 134, 99, 189, 48, 63, 162, 32, 211, 177, 94, 56,
+			122, 189, 130, 44, 199, 122, 225, 141, 119, 176, 191, 183, 84, 101,
+			171, 31, 53, 151, 138, 255, 183, 117, 237, 243, 148, 89, 217, 45,
+			67, 253, 161, 157, 191, 179, 208, 59, 36, 216, 252, 245, 44, 98,
+			160, 127, 102, 14, 145, 68, 38, 247, 93, 154, 22, 18, 209, 157,
+			147, 55, 45, 171, 203, 32, 7, 146, 172, 202, 216, 107, 251, 108,
+			23, 10, 77, 51, 89, 110, 66, 234, 20, 207, 131, 186, 195, 138,
+			141, 99, 111, 139, 86, 175, 74, 54, 108, 109, 11, 3, 150, 20,
+			154, 244, 19, 150, 74, 116, 95, 185, 135, 97, 183, 215, 189, 40,
+			65, 170, 210, 122, 20, 110, 237, 54, 65, 105, 53, 14, 164, 213,
+			255, 104, 158, 43, 200, 116, 172, 31, 61, 82, 86, 144, 229, 88,
+			63, 122, 235, 121, 246, 177, 130, 170, 74, 20, 153, 243, 197, 15,
+			20, 184, 240, 55, 83, 149, 193, 10, 185, 244, 254, 57, 172, 237,
+			149, 108, 29, 169, 77, 175, 114, 49, 230, 19, 153, 195, 254, 9,
+			153, 194, 43, 69, 95, 171, 3, 93, 37, 241, 72, 1, 193, 118,
+			206, 89, 235, 178, 105, 75, 47, 86, 150, 104, 166, 59, 189, 11,
+			147, 131, 215, 86, 41, 197, 35, 17, 10, 21, 161, 217, 179, 95,
+			169, 100, 35, 241, 73, 58, 110, 182, 107, 229, 151, 118, 177, 166,
+			145, 86, 125, 81, 250, 51, 111, 98, 155, 139, 77, 102, 11, 122,
+			72, 115, 67, 14, 165, 170, 26, 72, 185, 34, 76, 90, 251, 101,
+			190, 28, 242, 186, 27, 199, 226, 113, 203, 151, 148, 188, 68, 108,
+			3, 253, 34, 42, 227, 101, 152, 92, 80, 50, 222, 41, 109, 209,
+			156, 183, 75, 131, 90, 174, 166, 109, 180, 209, 151, 237, 65, 149,
+			246, 12, 238, 93, 196, 68, 166, 128, 32, 85, 120, 131, 241, 10,
+			23, 116, 6, 95, 185, 138, 41, 243, 29, 163, 242, 170, 101, 126,
+			222, 165, 36, 111, 38, 177, 221, 149, 89, 176, 238, 129, 215, 26,
+			144, 146, 5, 32, 168, 224, 97, 53, 12, 80, 38, 133, 159, 11,
+			224, 19, 32, 243, 44, 146, 146, 121, 247, 134, 244, 58, 107, 208,
+			186, 172, 102, 169, 163, 23, 58, 10, 99, 68, 249, 162, 130, 76,
+			199, 138, 14, 78, 41, 200, 114, 172, 232, 230, 83, 236, 213, 182,
+			170, 205, 244, 147, 230, 233, 226, 247, 83, 141, 128, 4, 188, 212,
+			112, 201, 206, 10, 36, 167, 31, 236, 96, 113, 224, 127, 33, 160,
+			125, 208, 142, 227, 50, 89, 37, 16, 35, 150, 142, 58, 165, 70,
+			165, 49, 58, 169, 69, 163, 28, 55, 10, 174, 213, 66, 66, 160,
+			234, 37, 84, 22, 137, 106, 171, 69, 91, 180, 51, 157, 154, 230,
+			237, 167, 87, 138, 150, 232, 74, 250, 1, 131, 166, 164, 235, 98,
+			162, 44, 118, 55, 168, 10, 255, 147, 119, 185, 78, 127, 67, 37,
+			105, 81, 10, 83, 71, 153, 87, 45, 156, 8, 199, 76, 192, 4,
+			206, 106, 197, 97, 243, 155, 209, 1, 178, 16, 29, 23, 199, 152,
+			212, 135, 180, 102, 39, 221, 56, 173, 49, 36, 253, 124, 12, 8,
+			138, 10, 18, 110, 32, 218, 157, 202, 214, 25, 19, 92, 212, 50,
+			5, 59, 245, 87, 16, 102, 84, 88, 140, 85, 41, 201, 41, 157,
+			57, 68, 22, 23, 158, 143, 26, 4, 40, 185, 155, 228, 211, 150,
+			245, 174, 57, 6, 142, 190, 159, 204, 15, 43, 200, 116, 172, 159,
+			28, 81, 5, 235, 80, 168, 233, 39, 103, 230, 217, 47, 10, 213,
+			96, 163, 130, 131, 57, 91, 124, 107, 129, 227, 108, 32, 86, 150,
+			33, 8, 85, 229, 113, 99, 77, 201, 103, 158, 70, 44, 103, 60,
+			145, 41, 207, 136, 175, 165, 67, 20, 155, 20, 85, 182, 175, 234,
+			197, 21, 47, 168, 82, 45, 156, 48, 226, 58, 160, 188, 214, 164,
+			1, 101, 126, 150, 219, 105, 57, 37, 126, 204, 99, 81, 57, 170,
+			230, 93, 246, 43, 225, 70, 228, 214, 55, 133, 191, 111, 199, 198,
+			3, 178, 90, 35, 254, 146, 146, 91, 154, 38, 187, 95, 252, 83,
+			153, 169, 170, 63, 188, 153, 245, 210, 75, 167, 105, 175, 132, 41,
+			163, 237, 65, 137, 169, 42, 8, 210, 123, 244, 227, 170, 165, 147,
+			252, 37, 212, 202, 75, 167, 229, 36, 168, 71, 149, 210, 75, 127,
+			2, 156, 165, 218, 9, 194, 224, 88, 102, 92, 229, 61, 218, 217,
+			3, 155, 246, 182, 213, 175, 162, 19, 180, 197, 117, 79, 45, 189,
+			164, 108, 70, 244, 86, 221, 209, 204, 169, 70, 249, 37, 95, 239,
+			41, 82, 182, 147, 27, 102, 81, 167, 41, 230, 222, 37, 108, 242,
+			73, 188, 171, 142, 92, 197, 119, 59, 121, 85, 206, 43, 4, 181,
+			102, 212, 176, 101, 179, 63, 205, 90, 166, 89, 181, 137, 166, 224,
+			91, 81, 77, 19, 217, 101, 57, 201, 150, 93, 102, 107, 159, 172,
+			173, 43, 62, 9, 131, 219, 77, 80, 181, 135, 112, 150, 82, 108,
+			211, 247, 34, 120, 5, 154, 233, 42, 165, 114, 171, 94, 117, 106,
+			154, 134, 72, 212, 220, 57, 30, 185, 188, 51, 86, 164, 108, 16,
+			184, 82, 193, 159, 237, 48, 186, 8, 173, 217, 42, 77, 68, 17,
+			210, 245, 200, 195, 24, 69, 157, 36, 41, 82, 84, 204, 129, 84,
+			14, 170, 180, 37, 205, 18, 222, 200, 14, 148, 42, 199, 49, 178,
+			90, 49, 111, 170, 212, 156, 87, 139, 33, 242, 246, 209, 10, 70,
+			150, 237, 91, 140, 124, 191, 2, 169, 236, 202, 254, 146, 2, 45,
+			128, 199, 94, 192, 94, 41, 182, 111, 57, 199, 126, 151, 97, 158,
+			42, 126, 215, 224, 153, 51, 121, 190, 229, 98, 16, 153, 238, 49,
+			32, 145, 201, 161, 141, 168, 200, 211, 197, 249, 200, 0, 197, 219,
+			233, 105, 191, 114, 126, 10, 189, 173, 68, 79, 106, 182, 185, 235,
+			235, 94, 5, 150, 109, 18, 203, 58, 6, 147, 233, 22, 87, 22,
+			45, 165, 82, 88, 200, 47, 158, 106, 49, 39, 119, 177, 29, 21,
+			150, 82, 196, 104, 94, 223, 97, 8, 178, 20, 5, 77, 49, 20,
+			48, 120, 151, 145, 223, 175, 64, 19, 160, 195, 21, 104, 1, 188,
+			225, 78, 42, 179, 149, 239, 48, 243, 142, 253, 94, 195, 156, 47,
+			62, 108, 240, 150, 64, 132, 221, 105, 166, 86, 148, 187, 225, 250,
+			178, 122, 192, 4, 28, 195, 56, 1, 240, 160, 233, 50, 84, 127,
+			70, 7, 137, 82, 8, 239, 53, 242, 3, 10, 52, 1, 14, 42,
+			182, 200, 91, 0, 143, 157, 98, 63, 39, 74, 147, 22, 28, 251,
+			55, 48, 200, 55, 229, 120, 75, 184, 132, 158, 244, 44, 62, 147,
+			110, 45, 12, 54, 100, 113, 96, 84, 243, 76, 226, 212, 137, 48,
+			205, 17, 235, 133, 211, 136, 245, 152, 113, 47, 169, 76, 165, 220,
+			146, 50, 127, 155, 147, 164, 17, 84, 195, 114, 90, 97, 89, 117,
+			132, 130, 234, 132, 77, 117, 90, 242, 201, 6, 142, 132, 214, 67,
+			162, 167, 102, 76, 157, 234, 79, 139, 214, 229, 162, 172, 40, 204,
+			79, 216, 176, 145, 231, 94, 228, 151, 220, 200, 15, 27, 49, 175,
+			251, 65, 128, 114, 131, 62, 202, 5, 65, 95, 208, 169, 21, 92,
+			57, 113, 35, 82, 14, 107, 44, 181, 100, 179, 17, 171, 2, 58,
+			112, 191, 168, 67, 164, 138, 27, 55, 160, 101, 166, 69, 85, 110,
+			63, 224, 222, 229, 36, 242, 182, 176, 238, 99, 58, 137, 154, 67,
+			229, 103, 156, 208, 192, 179, 199, 55, 125, 85, 247, 57, 29, 85,
+			16, 39, 16, 143, 225, 186, 24, 29, 126, 79, 253, 101, 120, 83,
+			157, 75, 184, 107, 225, 37, 111, 186, 173, 22, 147, 54, 174, 168,
+			28, 184, 148, 124, 39, 5, 58, 225, 118, 128, 146, 33, 225, 58,
+			107, 163, 55, 185, 173, 33, 76, 148, 161, 43, 145, 137, 51, 138,
+			130, 52, 220, 14, 222, 218, 105, 36, 129, 28, 174, 104, 144, 250,
+			35, 141, 182, 120, 246, 69, 179, 103, 22, 231, 87, 103, 151, 238,
+			186, 112, 223, 194, 217, 21, 245, 153, 50, 207, 177, 9, 115, 107,
+			240, 10, 163, 66, 193, 174, 5, 47, 37, 121, 218, 173, 22, 193,
+			176, 5, 3, 28, 170, 217, 185, 96, 2, 212, 236, 92, 176, 0,
+			30, 59, 197, 254, 93, 158, 216, 185, 211, 177, 63, 3, 118, 126,
+			103, 158, 171, 176, 156, 56, 235, 123, 151, 204, 170, 76, 220, 12,
+			157, 38, 211, 42, 163, 186, 132, 139, 208, 142, 216, 4, 137, 98,
+			26, 106, 31, 2, 62, 217, 118, 101, 25, 57, 109, 255, 52, 189,
+			100, 10, 150, 152, 42, 14, 142, 142, 81, 186, 119, 71, 89, 81,
+			144, 126, 46, 83, 228, 37, 45, 27, 23, 114, 239, 178, 87, 105,
+			36, 210, 93, 16, 42, 175, 157, 58, 186, 163, 153, 58, 222, 82,
+			250, 69, 33, 176, 219, 40, 215, 154, 186, 188, 111, 123, 144, 18,
+			77, 197, 9, 44, 61, 158, 13, 144, 211, 133, 31, 100, 121, 137,
+			150, 249, 129, 173, 143, 161, 171, 66, 160, 64, 82, 158, 234, 203,
+			215, 83, 102, 21, 231, 89, 186, 10, 201, 249, 57, 93, 35, 31,
+			45, 232, 228, 50, 45, 34, 100, 59, 178, 128, 58, 226, 10, 56,
+			191, 41, 35, 25, 118, 116, 224, 199, 105, 65, 210, 105, 26, 230,
+			142, 49, 10, 89, 44, 143, 203, 50, 117, 177, 104, 23, 166, 252,
+			25, 178, 194, 124, 26, 53, 136, 90, 111, 114, 183, 74, 54, 117,
+			202, 51, 105, 89, 215, 204, 172, 175, 53, 169, 72, 32, 196, 19,
+			180, 179, 170, 221, 28, 51, 190, 51, 32, 81, 118, 150, 30, 76,
+			208, 138, 10, 82, 159, 133, 226, 138, 45, 146, 32, 112, 173, 65,
+			249, 197, 106, 219, 17, 70, 82, 91, 92, 65, 81, 176, 93, 252,
+			34, 59, 21, 69, 167, 129, 197, 146, 31, 86, 160, 9, 112, 100,
+			92, 129, 22, 192, 163, 167, 216, 87, 133, 54, 236, 114, 236, 47,
+			24, 230, 61, 197, 207, 27, 210, 168, 87, 116, 145, 7, 243, 18,
+			149, 233, 150, 242, 186, 84, 106, 250, 201, 6, 166, 54, 81, 240,
+			170, 65, 230, 77, 253, 160, 67, 83, 78, 134, 93, 133, 138, 158,
+			156, 84, 8, 105, 106, 116, 25, 24, 160, 150, 51, 93, 38, 192,
+			193, 73, 5, 90, 0, 111, 186, 155, 125, 82, 88, 83, 204, 177,
+			255, 204, 48, 207, 23, 255, 125, 234, 13, 87, 61, 166, 116, 33,
+			59, 143, 156, 96, 224, 78, 225, 49, 130, 142, 212, 103, 58, 160,
+			204, 11, 27, 94, 228, 203, 19, 156, 204, 183, 225, 250, 158, 238,
+			125, 81, 159, 55, 201, 178, 16, 227, 72, 189, 115, 107, 252, 199,
+			26, 94, 212, 156, 250, 239, 131, 226, 204, 0, 17, 243, 67, 10,
+			52, 1, 14, 79, 43, 208, 2, 248, 220, 179, 236, 113, 65, 241,
+			110, 199, 254, 22, 36, 251, 159, 93, 145, 226, 106, 175, 142, 115,
+			90, 40, 31, 89, 251, 51, 117, 105, 128, 86, 218, 134, 192, 134,
+			167, 133, 122, 220, 173, 68, 97, 28, 75, 55, 171, 84, 150, 176,
+			247, 35, 175, 38, 38, 74, 250, 246, 106, 232, 185, 234, 93, 102,
+			124, 173, 225, 215, 164, 35, 44, 197, 5, 167, 106, 245, 48, 78,
+			142, 201, 0, 118, 28, 197, 83, 77, 209, 84, 156, 137, 82, 73,
+			45, 216, 51, 90, 29, 160, 159, 40, 24, 169, 191, 69, 29, 58,
+			85, 237, 91, 149, 210, 202, 158, 185, 211, 50, 84, 248, 238, 189,
+			159, 193, 178, 131, 246, 67, 83, 177, 95, 163, 147, 68, 70, 37,
+			214, 234, 170, 130, 80, 190, 195, 236, 54, 64, 109, 109, 40, 119,
+			155, 0, 157, 103, 41, 208, 2, 56, 115, 138, 61, 42, 188, 5,
+			61, 142, 253, 247, 134, 121, 75, 241, 67, 5, 62, 39, 221, 199,
+			66, 243, 69, 222, 58, 20, 162, 48, 36, 93, 88, 76, 114, 207,
+			39, 61, 55, 84, 243, 17, 114, 208, 229, 91, 141, 4, 246, 191,
+			140, 6, 170, 135, 56, 237, 165, 3, 6, 90, 5, 250, 67, 57,
+			64, 77, 52, 73, 51, 233, 29, 210, 87, 98, 168, 184, 155, 204,
+			33, 124, 235, 86, 148, 151, 80, 38, 61, 78, 74, 192, 175, 20,
+			83, 223, 37, 144, 19, 17, 165, 164, 148, 245, 89, 110, 85, 99,
+			177, 115, 45, 150, 249, 66, 121, 163, 156, 54, 70, 195, 9, 152,
+			230, 27, 94, 154, 21, 225, 48, 165, 83, 37, 85, 248, 189, 14,
+			187, 182, 214, 228, 85, 127, 157, 138, 22, 224, 244, 57, 241, 201,
+			189, 47, 230, 86, 23, 112, 247, 92, 212, 0, 164, 181, 6, 212,
+			206, 131, 40, 208, 170, 46, 145, 13, 14, 161, 148, 223, 228, 189,
+			16, 168, 96, 39, 74, 244, 38, 155, 25, 42, 149, 170, 126, 92,
+			193, 93, 64, 114, 156, 210, 224, 36, 143, 29, 44, 78, 105, 12,
+			173, 43, 55, 27, 185, 166, 96, 87, 38, 237, 252, 169, 92, 152,
+			136, 91, 104, 98, 160, 196, 156, 180, 86, 192, 235, 81, 38, 158,
+			95, 49, 47, 252, 199, 79, 213, 216, 148, 138, 111, 61, 108, 117,
+			44, 61, 173, 118, 103, 24, 181, 18, 75, 209, 130, 152, 144, 92,
+			11, 215, 84, 120, 93, 183, 163, 119, 183, 194, 19, 168, 151, 50,
+			161, 63, 123, 234, 220, 210, 202, 194, 252, 142, 143, 176, 90, 137,
+			229, 225, 214, 198, 74, 208, 217, 47, 59, 78, 98, 252, 68, 153,
+			54, 59, 13, 21, 143, 54, 221, 194, 209, 178, 22, 33, 145, 153,
+			216, 112, 231, 190, 70, 47, 249, 30, 3, 171, 56, 223, 167, 64,
+			19, 96, 191, 82, 135, 61, 22, 192, 177, 155, 216, 19, 194, 26,
+			216, 231, 216, 15, 152, 230, 169, 226, 95, 24, 124, 73, 110, 20,
+			137, 43, 165, 183, 157, 187, 116, 128, 215, 136, 118, 189, 181, 71,
+			190, 247, 67, 203, 16, 109, 211, 45, 73, 176, 207, 192, 152, 53,
+			129, 246, 153, 0, 251, 149, 243, 96, 159, 5, 240, 134, 59, 217,
+			127, 21, 4, 234, 117, 236, 55, 152, 230, 109, 197, 111, 43, 115,
+			9, 219, 98, 94, 245, 16, 21, 64, 213, 127, 119, 183, 152, 176,
+			124, 101, 222, 116, 106, 60, 61, 37, 66, 73, 121, 255, 79, 64,
+			168, 94, 3, 99, 207, 247, 42, 208, 4, 216, 55, 166, 64, 11,
+			224, 212, 173, 236, 241, 110, 34, 84, 159, 99, 127, 208, 132, 94,
+			239, 198, 174, 6, 102, 81, 220, 18, 254, 73, 6, 83, 171, 172,
+			3, 77, 150, 165, 238, 34, 213, 149, 26, 230, 105, 180, 140, 187,
+			65, 91, 92, 196, 232, 147, 228, 45, 93, 244, 154, 39, 41, 87,
+			24, 81, 69, 254, 46, 235, 41, 19, 205, 169, 6, 152, 246, 8,
+			39, 116, 83, 137, 71, 29, 85, 74, 230, 22, 22, 119, 102, 67,
+			160, 190, 129, 198, 19, 111, 73, 101, 4, 143, 101, 28, 214, 26,
+			144, 141, 39, 177, 240, 93, 96, 201, 253, 212, 30, 132, 172, 193,
+			216, 241, 33, 217, 131, 97, 144, 226, 208, 106, 102, 139, 125, 62,
+			190, 111, 4, 254, 143, 53, 176, 206, 219, 75, 90, 234, 15, 209,
+			28, 45, 60, 188, 158, 29, 2, 156, 38, 1, 130, 67, 124, 87,
+			199, 128, 232, 175, 22, 231, 53, 253, 82, 221, 34, 5, 200, 154,
+			167, 79, 11, 133, 225, 89, 107, 166, 193, 79, 213, 212, 193, 39,
+			84, 81, 140, 176, 100, 134, 193, 17, 189, 244, 25, 250, 174, 68,
+			147, 83, 161, 173, 229, 233, 140, 61, 150, 42, 214, 41, 125, 200,
+			218, 228, 213, 16, 139, 21, 102, 147, 60, 186, 20, 20, 57, 201,
+			99, 132, 190, 97, 204, 8, 79, 95, 243, 178, 100, 102, 124, 11,
+			119, 20, 213, 107, 233, 128, 99, 62, 41, 73, 64, 223, 133, 81,
+			70, 67, 235, 110, 65, 146, 89, 29, 176, 8, 111, 16, 241, 231,
+			100, 67, 156, 141, 97, 145, 139, 59, 233, 116, 180, 154, 64, 73,
+			6, 185, 98, 255, 231, 87, 166, 69, 172, 0, 77, 97, 147, 55,
+			132, 95, 73, 42, 254, 212, 216, 72, 79, 253, 83, 195, 46, 69,
+			86, 221, 194, 69, 17, 238, 162, 89, 109, 71, 164, 251, 203, 212,
+			98, 161, 160, 35, 117, 55, 6, 205, 0, 33, 174, 57, 121, 23,
+			30, 165, 177, 18, 201, 192, 225, 130, 89, 159, 94, 163, 3, 24,
+			72, 126, 212, 115, 243, 63, 172, 217, 241, 63, 130, 145, 209, 103,
+			64, 218, 235, 35, 139, 62, 19, 160, 62, 178, 232, 179, 0, 30,
+			59, 197, 94, 41, 206, 173, 251, 29, 251, 63, 154, 230, 124, 241,
+			187, 22, 159, 247, 246, 82, 13, 250, 54, 40, 47, 74, 185, 72,
+			239, 238, 96, 79, 238, 130, 16, 143, 194, 70, 226, 7, 30, 22,
+			48, 173, 69, 185, 47, 143, 82, 185, 70, 235, 86, 47, 173, 73,
+			237, 26, 70, 43, 56, 180, 206, 156, 87, 136, 203, 31, 194, 74,
+			197, 141, 165, 88, 200, 22, 121, 93, 247, 47, 99, 253, 196, 149,
+			136, 124, 129, 177, 12, 165, 45, 183, 47, 135, 105, 38, 71, 73,
+			42, 43, 115, 139, 35, 173, 136, 181, 166, 112, 253, 44, 75, 53,
+			32, 67, 134, 128, 141, 176, 145, 151, 113, 128, 121, 209, 83, 75,
+			22, 141, 76, 61, 85, 254, 151, 103, 214, 255, 164, 252, 47, 121,
+			162, 223, 0, 19, 104, 142, 233, 55, 1, 106, 142, 233, 183, 0,
+			30, 59, 197, 190, 44, 118, 162, 251, 29, 251, 75, 166, 121, 166,
+			248, 123, 133, 221, 141, 9, 29, 229, 225, 5, 9, 121, 93, 174,
+			104, 88, 232, 23, 33, 183, 32, 216, 119, 88, 26, 194, 29, 179,
+			169, 46, 66, 33, 229, 113, 209, 163, 104, 220, 42, 39, 131, 131,
+			230, 225, 190, 246, 174, 209, 216, 69, 175, 57, 67, 175, 92, 181,
+			73, 146, 198, 177, 150, 85, 64, 6, 88, 119, 186, 245, 58, 176,
+			118, 177, 158, 145, 234, 105, 126, 14, 19, 216, 165, 34, 56, 90,
+			243, 19, 170, 112, 45, 210, 208, 249, 90, 45, 92, 139, 249, 100,
+			163, 14, 121, 124, 203, 241, 19, 252, 222, 83, 34, 184, 189, 229,
+			66, 37, 150, 98, 157, 141, 229, 207, 196, 115, 200, 177, 164, 116,
+			150, 81, 24, 98, 25, 209, 165, 42, 254, 70, 224, 138, 107, 88,
+			117, 188, 255, 90, 19, 85, 183, 81, 88, 221, 171, 82, 76, 186,
+			23, 181, 208, 241, 41, 40, 142, 140, 222, 96, 255, 162, 56, 254,
+			123, 82, 28, 251, 81, 179, 219, 204, 15, 42, 16, 53, 192, 205,
+			161, 163, 10, 68, 9, 111, 243, 150, 123, 216, 231, 69, 8, 172,
+			227, 216, 127, 97, 154, 103, 138, 191, 189, 135, 226, 216, 33, 6,
+			246, 84, 34, 11, 153, 197, 170, 237, 235, 170, 10, 104, 17, 217,
+			1, 126, 148, 13, 208, 162, 40, 74, 253, 131, 94, 224, 76, 174,
+			240, 201, 29, 113, 129, 113, 246, 250, 13, 229, 92, 4, 43, 111,
+			122, 1, 73, 142, 123, 224, 193, 133, 108, 207, 232, 135, 105, 237,
+			255, 189, 178, 150, 248, 103, 160, 5, 28, 3, 51, 170, 167, 223,
+			49, 1, 234, 233, 119, 44, 128, 183, 220, 195, 126, 67, 184, 138,
+			7, 28, 251, 59, 166, 121, 87, 241, 151, 76, 185, 247, 222, 49,
+			223, 90, 104, 236, 148, 255, 217, 25, 15, 163, 170, 58, 227, 1,
+			146, 186, 248, 44, 159, 204, 108, 217, 197, 62, 253, 41, 235, 90,
+			229, 152, 253, 33, 160, 242, 128, 1, 194, 229, 29, 5, 154, 0,
+			7, 158, 173, 64, 11, 224, 241, 5, 246, 151, 98, 145, 13, 58,
+			246, 255, 99, 154, 47, 40, 254, 169, 197, 87, 200, 248, 119, 181,
+			182, 145, 137, 130, 208, 52, 164, 120, 100, 240, 136, 76, 35, 124,
+			146, 93, 54, 227, 179, 237, 141, 40, 49, 79, 177, 227, 50, 22,
+			107, 86, 254, 120, 76, 101, 207, 233, 129, 45, 206, 75, 221, 82,
+			210, 23, 189, 149, 75, 50, 242, 74, 120, 155, 177, 77, 85, 239,
+			72, 183, 173, 254, 157, 182, 155, 226, 39, 137, 197, 201, 227, 229,
+			227, 55, 150, 111, 42, 253, 48, 206, 110, 139, 79, 45, 51, 211,
+			18, 245, 43, 105, 2, 108, 231, 244, 236, 36, 225, 46, 27, 98,
+			205, 24, 131, 6, 230, 90, 47, 191, 65, 19, 160, 94, 126, 131,
+			22, 192, 91, 110, 103, 175, 18, 203, 111, 200, 177, 95, 105, 153,
+			119, 22, 191, 103, 92, 243, 109, 224, 89, 158, 213, 115, 138, 115,
+			156, 31, 122, 250, 43, 108, 53, 205, 134, 12, 144, 65, 211, 108,
+			200, 4, 168, 105, 54, 100, 1, 188, 229, 14, 246, 101, 177, 213,
+			25, 118, 236, 55, 91, 230, 114, 241, 247, 108, 53, 204, 22, 3,
+			74, 100, 45, 186, 59, 104, 3, 178, 204, 123, 234, 102, 213, 48,
+			16, 49, 237, 240, 184, 232, 235, 177, 228, 112, 210, 131, 56, 88,
+			93, 136, 152, 38, 180, 221, 244, 242, 137, 212, 178, 164, 171, 86,
+			51, 206, 25, 172, 227, 181, 38, 223, 222, 12, 183, 208, 204, 122,
+			204, 235, 234, 40, 2, 231, 3, 137, 116, 130, 100, 132, 42, 162,
+			189, 166, 211, 203, 85, 177, 9, 203, 152, 10, 242, 92, 52, 168,
+			82, 220, 111, 66, 161, 23, 110, 32, 142, 45, 239, 115, 17, 0,
+			26, 167, 23, 215, 195, 71, 197, 99, 4, 214, 102, 88, 66, 228,
+			59, 87, 60, 17, 216, 79, 87, 127, 169, 168, 81, 20, 107, 88,
+			61, 42, 239, 208, 141, 219, 168, 64, 118, 235, 186, 139, 40, 58,
+			117, 108, 169, 126, 145, 65, 1, 226, 126, 143, 118, 130, 144, 105,
+			21, 255, 48, 50, 225, 149, 100, 250, 48, 174, 125, 179, 244, 33,
+			255, 48, 174, 125, 179, 70, 202, 10, 180, 0, 222, 250, 66, 246,
+			83, 66, 166, 143, 56, 246, 187, 45, 243, 190, 226, 127, 49, 159,
+			148, 13, 69, 42, 54, 165, 221, 42, 150, 4, 109, 46, 100, 178,
+			181, 213, 221, 52, 94, 109, 253, 152, 8, 99, 23, 198, 98, 85,
+			102, 217, 102, 233, 184, 99, 76, 138, 205, 195, 232, 73, 135, 248,
+			207, 210, 210, 29, 49, 48, 7, 90, 110, 140, 152, 0, 181, 220,
+			24, 177, 0, 222, 114, 47, 123, 220, 160, 27, 117, 243, 191, 108,
+			117, 124, 216, 50, 138, 175, 53, 40, 53, 81, 58, 244, 252, 64,
+			75, 10, 25, 84, 59, 59, 119, 134, 216, 119, 22, 47, 68, 105,
+			174, 105, 214, 42, 38, 228, 113, 62, 44, 210, 62, 83, 105, 35,
+			249, 85, 236, 252, 54, 188, 132, 169, 136, 122, 185, 75, 171, 213,
+			178, 33, 225, 207, 196, 173, 185, 221, 226, 214, 92, 251, 151, 173,
+			92, 23, 221, 28, 137, 59, 102, 237, 247, 89, 230, 8, 232, 146,
+			163, 252, 56, 128, 142, 2, 77, 199, 126, 159, 53, 52, 204, 222,
+			109, 200, 171, 115, 237, 95, 177, 204, 125, 197, 55, 224, 184, 202,
+			173, 34, 18, 5, 36, 16, 74, 71, 13, 83, 205, 155, 12, 149,
+			150, 79, 181, 237, 40, 156, 218, 50, 227, 51, 149, 13, 72, 247,
+			169, 213, 228, 38, 28, 66, 8, 2, 114, 74, 4, 195, 6, 105,
+			84, 115, 91, 51, 152, 15, 200, 187, 125, 234, 50, 95, 224, 215,
+			169, 64, 211, 177, 127, 197, 234, 238, 97, 255, 139, 33, 239, 243,
+			181, 127, 13, 216, 191, 156, 223, 15, 162, 75, 228, 171, 33, 194,
+			151, 163, 102, 34, 163, 27, 132, 35, 73, 141, 109, 154, 215, 107,
+			13, 149, 193, 66, 135, 241, 114, 152, 241, 180, 190, 246, 105, 199,
+			192, 167, 165, 12, 151, 163, 193, 185, 30, 201, 123, 141, 40, 8,
+			249, 107, 41, 162, 184, 251, 242, 215, 128, 232, 135, 4, 162, 150,
+			99, 255, 134, 101, 246, 20, 255, 173, 193, 207, 109, 7, 79, 130,
+			232, 118, 58, 14, 137, 40, 156, 42, 59, 104, 181, 142, 242, 23,
+			187, 164, 187, 233, 60, 197, 12, 219, 137, 3, 1, 58, 60, 107,
+			238, 221, 80, 11, 159, 202, 81, 88, 136, 48, 180, 204, 130, 2,
+			17, 97, 104, 49, 220, 196, 139, 59, 146, 243, 255, 193, 234, 248,
+			45, 75, 92, 128, 6, 46, 251, 15, 86, 231, 16, 187, 157, 238,
+			30, 238, 112, 236, 143, 89, 230, 96, 113, 134, 207, 166, 193, 235,
+			24, 101, 91, 108, 161, 178, 41, 69, 206, 177, 188, 52, 169, 131,
+			238, 255, 250, 152, 36, 38, 90, 195, 61, 108, 86, 87, 159, 2,
+			113, 15, 155, 229, 12, 176, 55, 27, 116, 249, 112, 254, 81, 171,
+			227, 59, 150, 81, 252, 105, 131, 183, 165, 223, 248, 153, 13, 142,
+			58, 96, 132, 227, 3, 251, 244, 29, 182, 182, 92, 203, 242, 118,
+			51, 25, 233, 175, 110, 239, 146, 131, 216, 149, 186, 173, 57, 6,
+			160, 6, 184, 246, 81, 171, 115, 152, 245, 226, 86, 98, 171, 195,
+			201, 255, 142, 101, 126, 214, 178, 104, 0, 134, 5, 98, 253, 14,
+			128, 25, 150, 199, 207, 88, 177, 159, 178, 108, 167, 56, 78, 162,
+			74, 229, 99, 249, 49, 37, 125, 43, 213, 141, 105, 233, 99, 5,
+			241, 65, 158, 190, 232, 76, 31, 224, 150, 55, 171, 107, 95, 250,
+			192, 194, 131, 254, 253, 236, 65, 67, 118, 98, 56, 246, 239, 91,
+			246, 117, 197, 38, 199, 213, 24, 98, 41, 83, 205, 24, 109, 118,
+			40, 63, 157, 186, 210, 151, 226, 162, 210, 204, 254, 32, 137, 154,
+			50, 156, 24, 138, 143, 151, 158, 143, 11, 112, 238, 56, 249, 124,
+			218, 198, 223, 81, 162, 201, 228, 37, 106, 243, 100, 185, 92, 22,
+			17, 36, 144, 170, 4, 101, 208, 55, 108, 194, 165, 39, 125, 144,
+			195, 131, 125, 3, 233, 3, 194, 118, 112, 44, 125, 96, 225, 193,
+			145, 18, 123, 192, 144, 55, 75, 219, 127, 8, 14, 187, 44, 231,
+			92, 76, 147, 158, 109, 63, 61, 81, 118, 147, 29, 92, 70, 105,
+			111, 39, 179, 150, 170, 254, 80, 100, 194, 81, 40, 47, 197, 32,
+			201, 137, 167, 232, 188, 0, 82, 5, 106, 169, 164, 88, 213, 32,
+			86, 253, 67, 197, 170, 226, 158, 222, 63, 84, 172, 74, 215, 83,
+			219, 127, 8, 86, 125, 88, 221, 79, 109, 127, 201, 50, 15, 20,
+			223, 118, 21, 151, 239, 185, 217, 168, 26, 185, 115, 209, 23, 29,
+			42, 124, 179, 198, 197, 110, 181, 17, 177, 129, 168, 35, 52, 28,
+			247, 146, 82, 13, 4, 234, 193, 173, 113, 196, 188, 144, 176, 65,
+			155, 149, 77, 55, 216, 80, 11, 31, 151, 78, 231, 28, 251, 75,
+			233, 168, 192, 207, 95, 178, 186, 6, 21, 8, 167, 146, 53, 50,
+			202, 126, 90, 140, 202, 116, 236, 63, 181, 204, 233, 98, 131, 223,
+			47, 13, 196, 148, 158, 48, 152, 245, 185, 61, 126, 161, 66, 27,
+			240, 23, 0, 117, 81, 39, 73, 155, 70, 8, 132, 69, 73, 13,
+			127, 35, 8, 225, 93, 216, 70, 115, 210, 51, 153, 132, 187, 14,
+			80, 163, 140, 164, 132, 63, 181, 204, 131, 10, 52, 0, 30, 154,
+			80, 160, 5, 240, 232, 13, 236, 127, 21, 40, 91, 142, 253, 53,
+			76, 196, 107, 12, 190, 8, 130, 224, 16, 80, 206, 67, 184, 14,
+			123, 158, 166, 186, 5, 113, 61, 164, 103, 114, 20, 86, 142, 16,
+			83, 132, 135, 0, 254, 90, 74, 120, 92, 80, 247, 53, 16, 254,
+			65, 49, 10, 219, 177, 191, 97, 153, 163, 197, 31, 135, 160, 144,
+			203, 24, 57, 74, 205, 171, 146, 91, 180, 127, 216, 114, 235, 117,
+			40, 159, 245, 40, 220, 66, 242, 161, 42, 65, 225, 42, 125, 206,
+			27, 123, 75, 11, 95, 90, 211, 182, 97, 218, 2, 23, 13, 230,
+			1, 118, 247, 42, 208, 0, 216, 55, 160, 64, 11, 224, 240, 8,
+			251, 37, 220, 119, 106, 152, 57, 199, 126, 220, 50, 39, 138, 111,
+			55, 249, 60, 173, 90, 185, 247, 242, 119, 141, 224, 83, 217, 116,
+			153, 225, 69, 30, 223, 112, 163, 53, 58, 5, 144, 245, 178, 200,
+			112, 228, 179, 87, 45, 199, 5, 245, 178, 39, 230, 228, 166, 12,
+			183, 17, 180, 70, 183, 251, 144, 131, 95, 132, 164, 72, 17, 89,
+			145, 191, 240, 109, 63, 136, 167, 5, 160, 60, 100, 176, 112, 182,
+			188, 104, 195, 171, 170, 13, 21, 100, 13, 10, 87, 200, 143, 166,
+			83, 235, 130, 54, 95, 66, 186, 200, 228, 14, 92, 48, 25, 98,
+			139, 171, 242, 247, 4, 225, 114, 121, 162, 212, 126, 5, 26, 0,
+			157, 146, 2, 45, 128, 215, 63, 155, 253, 29, 20, 163, 233, 228,
+			255, 198, 234, 248, 127, 45, 163, 248, 151, 6, 111, 187, 77, 40,
+			85, 42, 84, 211, 68, 235, 192, 20, 35, 233, 132, 80, 232, 136,
+			5, 202, 20, 149, 85, 81, 50, 153, 84, 173, 191, 74, 89, 99,
+			199, 102, 24, 37, 143, 118, 142, 179, 204, 103, 183, 66, 25, 130,
+			26, 97, 51, 206, 178, 94, 199, 76, 182, 11, 246, 46, 190, 204,
+			9, 7, 193, 2, 93, 72, 47, 106, 192, 46, 95, 243, 106, 20,
+			156, 14, 245, 11, 91, 236, 111, 172, 206, 17, 246, 118, 67, 93,
+			141, 255, 125, 203, 28, 47, 62, 104, 96, 38, 200, 246, 240, 145,
+			68, 130, 91, 147, 228, 225, 8, 240, 222, 66, 166, 125, 107, 196,
+			209, 174, 113, 24, 105, 48, 42, 15, 164, 187, 155, 70, 27, 200,
+			208, 129, 50, 63, 87, 3, 135, 182, 108, 121, 212, 165, 181, 52,
+			24, 57, 159, 226, 246, 249, 239, 75, 3, 11, 215, 205, 27, 0,
+			59, 139, 10, 180, 0, 30, 30, 99, 95, 20, 227, 64, 28, 154,
+			101, 222, 92, 124, 212, 208, 215, 2, 203, 212, 149, 86, 228, 36,
+			110, 168, 103, 130, 144, 77, 164, 100, 120, 145, 151, 29, 12, 146,
+			149, 144, 144, 151, 108, 186, 144, 215, 32, 179, 188, 0, 138, 187,
+			27, 225, 52, 111, 4, 84, 207, 137, 182, 121, 164, 114, 176, 20,
+			244, 65, 240, 122, 228, 197, 112, 242, 203, 175, 65, 13, 113, 212,
+			215, 114, 72, 192, 169, 137, 221, 123, 144, 6, 190, 109, 210, 149,
+			187, 127, 111, 153, 106, 200, 208, 52, 127, 111, 29, 156, 81, 32,
+			130, 235, 172, 19, 55, 169, 11, 211, 95, 97, 119, 188, 198, 78,
+			47, 76, 127, 133, 221, 57, 206, 254, 157, 169, 46, 76, 127, 192,
+			54, 79, 20, 223, 105, 242, 243, 94, 116, 76, 46, 244, 140, 208,
+			150, 178, 81, 74, 3, 117, 4, 48, 157, 117, 101, 203, 143, 106,
+			94, 176, 145, 108, 238, 72, 154, 37, 145, 36, 221, 15, 96, 106,
+			221, 54, 246, 132, 242, 83, 93, 21, 71, 25, 186, 20, 0, 210,
+			100, 58, 244, 90, 249, 217, 241, 77, 91, 222, 173, 252, 16, 215,
+			165, 211, 44, 18, 98, 162, 82, 141, 178, 93, 116, 60, 178, 240,
+			222, 96, 27, 45, 234, 252, 32, 182, 23, 18, 103, 211, 189, 4,
+			212, 136, 147, 21, 122, 106, 66, 20, 209, 45, 179, 195, 118, 236,
+			7, 108, 41, 172, 197, 53, 227, 15, 216, 221, 7, 20, 136, 112,
+			62, 187, 120, 76, 129, 136, 223, 179, 111, 60, 174, 238, 205, 254,
+			25, 187, 227, 33, 57, 7, 182, 225, 216, 63, 99, 119, 30, 38,
+			59, 215, 134, 157, 251, 90, 219, 124, 189, 45, 236, 92, 155, 236,
+			220, 215, 218, 12, 102, 112, 222, 182, 97, 148, 58, 246, 235, 108,
+			219, 33, 163, 206, 150, 102, 236, 235, 108, 187, 51, 125, 96, 224,
+			129, 52, 99, 109, 105, 198, 190, 206, 238, 223, 207, 206, 170, 155,
+			185, 223, 100, 155, 165, 226, 157, 252, 66, 160, 230, 13, 30, 189,
+			48, 122, 210, 124, 253, 246, 178, 26, 146, 16, 54, 17, 226, 77,
+			138, 16, 54, 17, 226, 77, 118, 247, 136, 2, 13, 116, 55, 122,
+			88, 129, 22, 64, 126, 132, 189, 84, 220, 255, 252, 54, 187, 227,
+			231, 108, 227, 25, 217, 210, 219, 200, 199, 124, 155, 221, 121, 128,
+			54, 186, 118, 14, 3, 255, 89, 219, 28, 44, 190, 252, 10, 27,
+			42, 40, 58, 40, 238, 54, 147, 151, 83, 145, 91, 245, 37, 69,
+			80, 196, 168, 79, 88, 65, 33, 83, 175, 74, 69, 24, 183, 60,
+			55, 136, 121, 9, 150, 175, 22, 173, 186, 93, 101, 240, 138, 139,
+			168, 127, 214, 150, 166, 161, 184, 136, 250, 103, 109, 105, 240, 138,
+			139, 168, 127, 214, 118, 6, 216, 10, 93, 132, 141, 172, 82, 219,
+			28, 42, 158, 134, 192, 34, 239, 226, 180, 180, 43, 246, 74, 120,
+			151, 110, 46, 181, 54, 85, 54, 5, 79, 175, 197, 207, 145, 117,
+			250, 46, 219, 204, 43, 144, 122, 41, 244, 43, 16, 201, 170, 246,
+			192, 32, 123, 1, 161, 96, 58, 246, 123, 108, 179, 88, 60, 14,
+			20, 146, 168, 225, 77, 171, 74, 50, 50, 153, 55, 53, 44, 52,
+			53, 145, 79, 175, 123, 131, 117, 242, 158, 180, 55, 40, 151, 247,
+			216, 133, 33, 5, 90, 0, 71, 15, 168, 155, 183, 127, 193, 238,
+			248, 37, 185, 58, 144, 110, 250, 11, 118, 103, 145, 45, 168, 139,
+			183, 223, 107, 155, 227, 197, 231, 242, 51, 237, 249, 249, 58, 113,
+			95, 154, 92, 56, 146, 79, 209, 194, 185, 140, 50, 205, 243, 196,
+			178, 239, 85, 44, 43, 174, 202, 126, 175, 221, 189, 95, 129, 6,
+			64, 167, 168, 64, 228, 180, 218, 135, 199, 216, 253, 234, 166, 236,
+			135, 129, 194, 226, 149, 81, 168, 108, 250, 53, 181, 92, 188, 152,
+			79, 202, 122, 89, 60, 137, 92, 191, 6, 17, 51, 49, 51, 161,
+			4, 74, 158, 54, 116, 15, 167, 72, 97, 130, 30, 78, 145, 130,
+			80, 127, 56, 69, 10, 151, 86, 63, 12, 164, 30, 130, 153, 82,
+			112, 242, 31, 176, 59, 126, 213, 54, 138, 39, 84, 129, 65, 89,
+			75, 88, 214, 25, 76, 189, 109, 59, 243, 189, 159, 153, 197, 135,
+			196, 202, 15, 216, 157, 168, 7, 97, 219, 5, 76, 220, 7, 109,
+			83, 76, 120, 129, 8, 254, 65, 197, 255, 5, 34, 248, 7, 237,
+			174, 126, 5, 90, 120, 121, 96, 16, 238, 53, 51, 103, 56, 249,
+			15, 219, 29, 191, 101, 27, 197, 59, 248, 206, 122, 254, 42, 12,
+			17, 228, 222, 145, 160, 183, 116, 126, 46, 147, 242, 247, 140, 140,
+			51, 135, 121, 249, 176, 157, 27, 193, 56, 115, 6, 198, 249, 155,
+			182, 73, 135, 154, 128, 12, 2, 143, 40, 208, 4, 248, 172, 235,
+			217, 44, 189, 11, 119, 143, 109, 30, 40, 221, 212, 234, 27, 198,
+			110, 175, 37, 172, 36, 99, 115, 80, 238, 111, 16, 110, 51, 217,
+			34, 122, 255, 15, 182, 217, 163, 64, 19, 224, 200, 40, 123, 46,
+			117, 96, 58, 246, 71, 209, 193, 84, 107, 7, 109, 245, 110, 244,
+			89, 255, 185, 123, 85, 179, 96, 242, 143, 218, 230, 160, 2, 169,
+			161, 145, 81, 118, 39, 53, 11, 215, 17, 154, 61, 209, 218, 172,
+			244, 58, 175, 169, 244, 35, 108, 249, 66, 157, 163, 73, 199, 216,
+			170, 125, 216, 29, 31, 179, 205, 94, 5, 154, 0, 71, 70, 217,
+			2, 51, 237, 78, 39, 255, 9, 187, 227, 247, 109, 163, 248, 92,
+			109, 20, 99, 150, 221, 108, 218, 78, 214, 190, 77, 115, 217, 212,
+			81, 1, 248, 15, 233, 135, 159, 176, 59, 251, 217, 115, 152, 109,
+			119, 98, 94, 30, 133, 16, 157, 228, 179, 217, 154, 149, 234, 163,
+			22, 81, 63, 83, 85, 146, 186, 147, 56, 245, 81, 197, 169, 157,
+			52, 161, 143, 42, 78, 237, 36, 73, 253, 40, 196, 228, 157, 212,
+			137, 225, 216, 191, 107, 155, 7, 138, 39, 248, 236, 206, 43, 220,
+			53, 190, 50, 181, 139, 30, 202, 212, 95, 221, 29, 44, 185, 223,
+			181, 205, 110, 5, 82, 139, 61, 131, 10, 180, 0, 142, 140, 146,
+			84, 238, 132, 123, 244, 211, 182, 121, 176, 120, 156, 156, 81, 216,
+			112, 103, 205, 211, 150, 62, 39, 195, 70, 82, 111, 136, 172, 27,
+			37, 113, 58, 73, 42, 127, 58, 29, 28, 102, 253, 211, 182, 188,
+			87, 189, 147, 182, 251, 159, 182, 15, 20, 101, 111, 72, 216, 180,
+			205, 114, 241, 120, 234, 160, 216, 235, 68, 111, 247, 222, 172, 60,
+			181, 112, 80, 129, 200, 15, 181, 15, 77, 41, 144, 218, 159, 62,
+			70, 58, 160, 203, 201, 127, 206, 238, 120, 76, 234, 0, 228, 78,
+			126, 206, 238, 228, 44, 98, 182, 221, 133, 169, 252, 130, 109, 242,
+			98, 149, 159, 107, 36, 149, 212, 186, 78, 179, 119, 167, 117, 61,
+			54, 93, 74, 81, 148, 57, 244, 107, 34, 130, 153, 177, 204, 30,
+			217, 149, 229, 36, 212, 153, 30, 246, 24, 178, 226, 177, 196, 189,
+			139, 140, 154, 47, 200, 5, 1, 20, 144, 205, 105, 15, 29, 84,
+			160, 5, 112, 108, 156, 189, 26, 134, 70, 23, 232, 248, 37, 219,
+			28, 45, 110, 83, 16, 38, 206, 41, 27, 177, 215, 146, 4, 12,
+			41, 188, 75, 2, 175, 52, 112, 211, 83, 84, 93, 79, 54, 61,
+			17, 40, 195, 241, 134, 141, 11, 141, 107, 151, 236, 106, 141, 52,
+			152, 233, 75, 74, 161, 116, 145, 138, 255, 146, 221, 61, 160, 64,
+			56, 160, 236, 225, 17, 246, 136, 64, 26, 14, 40, 144, 245, 23,
+			141, 12, 214, 173, 217, 219, 26, 189, 108, 230, 121, 38, 105, 186,
+			253, 228, 29, 65, 249, 27, 30, 209, 22, 170, 79, 203, 2, 149,
+			171, 157, 132, 170, 141, 22, 110, 194, 2, 105, 25, 165, 204, 82,
+			147, 56, 105, 61, 222, 37, 252, 85, 182, 185, 95, 129, 6, 64,
+			71, 77, 11, 24, 248, 79, 237, 177, 113, 178, 53, 153, 147, 255,
+			186, 221, 241, 157, 103, 202, 214, 68, 182, 233, 215, 237, 206, 67,
+			144, 188, 54, 3, 143, 126, 195, 54, 135, 138, 83, 252, 172, 20,
+			54, 169, 6, 150, 214, 165, 30, 47, 28, 51, 114, 68, 140, 228,
+			205, 55, 212, 146, 100, 196, 104, 223, 80, 242, 134, 145, 188, 249,
+			6, 228, 205, 61, 212, 11, 210, 40, 109, 115, 164, 248, 124, 126,
+			150, 110, 133, 135, 18, 76, 91, 165, 236, 125, 28, 131, 114, 212,
+			250, 13, 32, 32, 33, 232, 170, 222, 186, 43, 75, 29, 29, 191,
+			241, 70, 221, 49, 204, 141, 111, 217, 114, 23, 205, 136, 89, 190,
+			101, 119, 238, 87, 160, 133, 95, 7, 135, 217, 38, 117, 108, 58,
+			246, 227, 16, 116, 63, 194, 233, 214, 117, 244, 59, 209, 118, 221,
+			229, 68, 90, 158, 3, 190, 83, 170, 146, 145, 234, 98, 164, 108,
+			183, 4, 104, 68, 30, 242, 61, 196, 145, 153, 136, 144, 20, 29,
+			67, 68, 61, 158, 210, 3, 75, 235, 113, 187, 107, 64, 129, 112,
+			214, 216, 195, 163, 36, 52, 186, 157, 252, 95, 217, 29, 127, 43,
+			133, 6, 114, 76, 255, 10, 219, 170, 37, 102, 219, 221, 152, 144,
+			39, 176, 233, 153, 87, 246, 81, 74, 168, 105, 101, 182, 173, 41,
+			85, 171, 216, 217, 71, 44, 207, 142, 88, 44, 137, 91, 55, 89,
+			145, 79, 168, 245, 213, 77, 50, 226, 9, 181, 190, 186, 105, 234,
+			158, 176, 7, 15, 43, 208, 2, 6, 252, 136, 144, 17, 221, 24,
+			200, 247, 108, 115, 172, 184, 45, 73, 152, 200, 10, 120, 110, 204,
+			39, 178, 84, 244, 3, 190, 219, 133, 46, 187, 208, 140, 227, 20,
+			202, 219, 170, 167, 94, 216, 214, 64, 18, 138, 77, 148, 213, 116,
+			244, 24, 48, 237, 223, 83, 244, 237, 166, 105, 255, 158, 221, 53,
+			170, 64, 11, 191, 30, 60, 76, 43, 168, 199, 201, 255, 189, 221,
+			241, 198, 220, 51, 180, 130, 40, 67, 208, 238, 28, 99, 115, 204,
+			182, 123, 48, 97, 255, 128, 21, 116, 203, 238, 43, 72, 164, 250,
+			164, 204, 30, 174, 243, 73, 89, 193, 68, 239, 209, 123, 104, 53,
+			253, 131, 26, 93, 15, 77, 201, 63, 168, 213, 212, 67, 171, 233,
+			31, 176, 154, 190, 11, 239, 71, 15, 166, 228, 129, 156, 57, 90,
+			252, 63, 77, 145, 151, 132, 133, 26, 134, 23, 73, 206, 138, 192,
+			202, 176, 17, 100, 157, 88, 228, 34, 154, 61, 131, 144, 35, 47,
+			150, 9, 3, 89, 71, 1, 159, 23, 19, 171, 43, 129, 103, 110,
+			86, 152, 198, 71, 106, 24, 112, 239, 199, 9, 142, 243, 225, 251,
+			12, 228, 214, 142, 124, 37, 168, 218, 169, 14, 147, 68, 180, 185,
+			76, 148, 89, 247, 107, 48, 134, 214, 154, 101, 62, 91, 171, 101,
+			99, 163, 181, 143, 137, 194, 87, 100, 62, 32, 170, 61, 213, 221,
+			8, 145, 18, 113, 226, 213, 51, 201, 226, 170, 166, 34, 225, 178,
+			35, 111, 64, 56, 145, 99, 186, 161, 7, 60, 69, 226, 88, 7,
+			115, 81, 87, 136, 189, 131, 243, 48, 81, 57, 213, 132, 46, 28,
+			130, 34, 51, 73, 92, 40, 129, 143, 233, 245, 236, 34, 234, 161,
+			93, 207, 3, 57, 185, 136, 122, 200, 149, 245, 64, 78, 250, 188,
+			123, 136, 31, 31, 200, 73, 159, 119, 15, 241, 227, 3, 185, 225,
+			17, 146, 127, 61, 48, 128, 30, 204, 61, 45, 242, 175, 135, 108,
+			161, 7, 115, 82, 254, 245, 144, 159, 240, 193, 156, 148, 127, 61,
+			100, 11, 61, 152, 147, 242, 175, 7, 208, 27, 114, 255, 8, 242,
+			175, 135, 206, 50, 222, 144, 211, 28, 12, 195, 249, 13, 57, 41,
+			255, 122, 200, 104, 122, 67, 78, 202, 191, 125, 78, 254, 205, 185,
+			142, 119, 228, 132, 252, 67, 62, 233, 155, 115, 157, 227, 36, 255,
+			246, 97, 57, 189, 53, 247, 180, 202, 191, 125, 36, 255, 222, 170,
+			166, 110, 31, 201, 191, 183, 230, 164, 252, 219, 71, 139, 237, 173,
+			57, 41, 255, 246, 209, 98, 123, 107, 142, 31, 97, 175, 129, 252,
+			219, 7, 250, 190, 61, 103, 142, 21, 155, 87, 150, 127, 187, 95,
+			66, 181, 11, 213, 24, 14, 75, 175, 77, 2, 238, 35, 9, 248,
+			118, 69, 225, 125, 196, 113, 111, 207, 73, 9, 184, 143, 56, 238,
+			237, 57, 41, 1, 123, 157, 252, 207, 229, 58, 126, 253, 153, 146,
+			128, 200, 108, 253, 185, 92, 103, 145, 253, 28, 228, 81, 47, 230,
+			236, 225, 156, 233, 20, 223, 100, 242, 89, 190, 81, 11, 215, 48,
+			100, 114, 222, 139, 242, 16, 40, 249, 30, 139, 42, 52, 113, 210,
+			42, 254, 176, 35, 192, 198, 56, 217, 20, 23, 83, 243, 184, 25,
+			36, 238, 101, 25, 30, 82, 90, 243, 131, 153, 163, 101, 239, 178,
+			87, 154, 34, 215, 182, 47, 11, 89, 224, 164, 87, 52, 199, 120,
+			92, 115, 227, 205, 99, 74, 104, 84, 101, 111, 250, 186, 0, 185,
+			171, 81, 34, 25, 30, 175, 50, 191, 15, 219, 83, 233, 206, 143,
+			19, 55, 74, 100, 57, 89, 28, 132, 37, 72, 229, 147, 206, 144,
+			22, 60, 74, 84, 214, 72, 95, 245, 144, 190, 75, 131, 164, 205,
+			26, 189, 206, 120, 233, 232, 204, 70, 80, 82, 226, 189, 151, 196,
+			251, 195, 106, 234, 122, 137, 227, 30, 206, 117, 237, 83, 32, 92,
+			36, 185, 254, 253, 180, 2, 122, 193, 112, 239, 203, 153, 131, 197,
+			121, 237, 247, 223, 89, 19, 229, 10, 199, 99, 153, 194, 24, 162,
+			11, 240, 206, 251, 82, 4, 192, 59, 239, 203, 73, 63, 94, 47,
+			241, 206, 251, 114, 206, 0, 251, 56, 88, 190, 23, 226, 234, 131,
+			16, 87, 191, 106, 100, 228, 149, 58, 140, 185, 90, 105, 197, 87,
+			244, 233, 40, 156, 226, 234, 163, 117, 111, 155, 30, 136, 198, 38,
+			49, 169, 46, 149, 136, 20, 203, 97, 135, 116, 154, 98, 242, 48,
+			8, 133, 229, 48, 189, 186, 100, 42, 62, 210, 85, 77, 228, 53,
+			56, 114, 64, 144, 145, 31, 84, 50, 178, 151, 100, 228, 7, 149,
+			140, 236, 37, 25, 249, 65, 37, 35, 123, 1, 125, 232, 31, 67,
+			70, 246, 146, 140, 252, 80, 58, 11, 144, 145, 31, 82, 50, 178,
+			151, 100, 228, 135, 148, 140, 236, 115, 242, 191, 153, 235, 248, 125,
+			41, 35, 145, 47, 248, 155, 185, 206, 131, 228, 122, 239, 131, 235,
+			253, 35, 57, 243, 147, 57, 225, 122, 239, 35, 215, 251, 71, 114,
+			172, 143, 221, 193, 242, 118, 159, 112, 189, 127, 52, 103, 23, 139,
+			226, 16, 179, 222, 38, 72, 91, 202, 195, 200, 235, 55, 68, 108,
+			113, 31, 43, 136, 239, 243, 212, 128, 8, 217, 232, 147, 174, 250,
+			143, 230, 246, 13, 165, 15, 44, 60, 24, 61, 192, 206, 203, 46,
+			13, 199, 254, 173, 156, 61, 88, 188, 147, 47, 239, 178, 30, 213,
+			206, 23, 211, 244, 228, 75, 51, 69, 2, 60, 251, 91, 57, 59,
+			243, 128, 250, 232, 238, 75, 31, 88, 120, 224, 12, 176, 25, 137,
+			132, 233, 216, 31, 207, 217, 35, 197, 113, 117, 113, 16, 238, 146,
+			111, 233, 123, 45, 172, 54, 51, 125, 96, 95, 246, 241, 156, 189,
+			47, 125, 96, 224, 65, 175, 147, 62, 176, 240, 96, 104, 152, 77,
+			201, 62, 44, 199, 254, 68, 206, 30, 40, 30, 32, 218, 82, 163,
+			177, 255, 114, 80, 151, 227, 146, 26, 21, 184, 131, 111, 49, 223,
+			159, 200, 217, 93, 233, 3, 248, 122, 114, 172, 55, 125, 64, 173,
+			237, 119, 200, 150, 236, 195, 188, 253, 110, 206, 60, 92, 188, 133,
+			223, 215, 194, 216, 60, 83, 112, 48, 149, 3, 148, 234, 182, 230,
+			209, 79, 73, 40, 185, 172, 143, 180, 221, 239, 42, 109, 215, 71,
+			115, 249, 187, 185, 238, 126, 5, 194, 83, 147, 219, 63, 170, 64,
+			11, 224, 193, 67, 34, 186, 163, 15, 43, 229, 51, 208, 118, 141,
+			171, 213, 118, 217, 107, 18, 159, 30, 91, 191, 143, 52, 221, 103,
+			212, 58, 233, 35, 77, 247, 25, 165, 233, 250, 104, 214, 63, 3,
+			77, 247, 40, 60, 202, 253, 78, 254, 139, 185, 142, 175, 230, 140,
+			98, 149, 47, 93, 93, 21, 157, 61, 214, 67, 186, 134, 217, 158,
+			197, 115, 158, 33, 31, 52, 178, 59, 191, 152, 235, 236, 102, 51,
+			204, 182, 251, 193, 6, 127, 4, 125, 122, 68, 111, 41, 100, 45,
+			150, 29, 9, 27, 52, 139, 253, 164, 95, 254, 72, 17, 172, 159,
+			230, 248, 143, 148, 126, 233, 167, 181, 250, 71, 208, 47, 8, 215,
+			235, 199, 20, 255, 113, 206, 28, 42, 206, 180, 180, 174, 136, 34,
+			174, 83, 82, 181, 95, 210, 200, 42, 221, 23, 38, 231, 143, 211,
+			190, 48, 57, 127, 156, 147, 91, 149, 126, 154, 156, 63, 206, 13,
+			12, 178, 147, 212, 151, 233, 216, 95, 129, 108, 157, 222, 237, 230,
+			176, 76, 39, 153, 216, 1, 221, 17, 214, 230, 87, 114, 210, 197,
+			216, 79, 66, 252, 43, 57, 233, 98, 236, 39, 33, 254, 149, 220,
+			200, 40, 187, 139, 58, 178, 28, 251, 49, 116, 116, 107, 234, 98,
+			204, 196, 243, 80, 241, 214, 245, 214, 88, 158, 93, 157, 127, 253,
+			36, 163, 31, 75, 135, 7, 25, 253, 88, 78, 198, 228, 244, 211,
+			122, 125, 12, 189, 158, 166, 94, 109, 199, 254, 243, 156, 57, 93,
+			124, 94, 234, 106, 196, 128, 246, 14, 131, 218, 163, 83, 59, 79,
+			13, 29, 84, 160, 1, 80, 134, 51, 245, 83, 0, 205, 159, 231,
+			142, 222, 64, 198, 243, 126, 39, 255, 245, 92, 199, 183, 164, 98,
+			64, 62, 224, 215, 115, 157, 163, 196, 56, 251, 193, 56, 223, 184,
+			6, 198, 217, 79, 140, 243, 13, 53, 218, 253, 196, 56, 223, 80,
+			140, 179, 159, 24, 231, 27, 138, 113, 246, 99, 2, 190, 249, 148,
+			25, 103, 63, 173, 234, 111, 166, 125, 129, 113, 190, 169, 24, 103,
+			63, 49, 206, 55, 193, 56, 24, 164, 227, 228, 255, 50, 215, 241,
+			29, 57, 72, 100, 189, 253, 101, 174, 115, 132, 157, 96, 182, 237,
+			96, 144, 143, 3, 141, 103, 237, 190, 225, 166, 51, 45, 138, 133,
+			208, 222, 42, 135, 198, 249, 184, 234, 219, 161, 113, 62, 174, 250,
+			118, 104, 156, 143, 171, 190, 7, 156, 252, 95, 229, 58, 254, 139,
+			236, 27, 185, 96, 127, 5, 2, 227, 76, 109, 0, 125, 63, 129,
+			157, 247, 162, 222, 157, 160, 167, 236, 198, 68, 204, 123, 101, 143,
+			141, 9, 242, 25, 195, 6, 217, 175, 217, 45, 202, 0, 9, 237,
+			39, 148, 208, 30, 32, 161, 253, 132, 218, 93, 14, 16, 190, 79,
+			168, 221, 229, 0, 225, 251, 4, 118, 151, 175, 182, 152, 105, 15,
+			58, 249, 87, 228, 59, 126, 38, 111, 20, 255, 45, 249, 3, 96,
+			130, 185, 92, 151, 121, 161, 132, 75, 189, 219, 215, 66, 80, 173,
+			66, 108, 241, 238, 245, 154, 241, 94, 229, 67, 164, 245, 153, 185,
+			163, 203, 143, 228, 9, 74, 123, 85, 107, 177, 189, 70, 68, 151,
+			190, 245, 34, 205, 31, 147, 245, 191, 20, 124, 162, 212, 234, 133,
+			80, 182, 64, 236, 110, 101, 18, 78, 148, 25, 153, 132, 9, 156,
+			18, 20, 169, 161, 248, 14, 222, 5, 20, 157, 111, 47, 104, 51,
+			149, 57, 223, 81, 65, 41, 9, 191, 249, 198, 27, 153, 210, 207,
+			207, 140, 12, 71, 114, 216, 43, 242, 157, 221, 236, 60, 179, 237,
+			65, 112, 202, 43, 243, 230, 254, 226, 41, 126, 175, 215, 82, 47,
+			38, 115, 157, 94, 170, 88, 142, 81, 94, 171, 212, 144, 147, 47,
+			113, 143, 189, 252, 198, 99, 183, 174, 254, 232, 177, 151, 222, 160,
+			132, 196, 32, 241, 240, 43, 243, 146, 135, 7, 137, 39, 94, 153,
+			239, 234, 81, 32, 50, 169, 242, 125, 253, 236, 56, 117, 111, 56,
+			246, 171, 242, 230, 64, 241, 58, 169, 199, 165, 179, 219, 13, 50,
+			233, 237, 242, 78, 11, 213, 62, 214, 231, 171, 210, 246, 177, 62,
+			95, 149, 239, 234, 85, 160, 5, 112, 191, 67, 130, 125, 16, 91,
+			132, 215, 228, 73, 176, 147, 188, 37, 139, 50, 157, 75, 108, 71,
+			104, 126, 118, 145, 118, 131, 228, 193, 120, 77, 218, 17, 228, 202,
+			107, 242, 82, 196, 14, 146, 96, 127, 77, 126, 100, 148, 61, 143,
+			58, 130, 63, 35, 111, 78, 23, 143, 166, 34, 22, 45, 111, 187,
+			25, 222, 217, 77, 168, 14, 154, 86, 158, 62, 61, 168, 64, 56,
+			74, 242, 82, 168, 14, 146, 36, 127, 48, 47, 133, 234, 144, 147,
+			127, 125, 190, 227, 173, 121, 177, 230, 145, 178, 246, 250, 124, 231,
+			1, 18, 242, 67, 152, 201, 55, 230, 205, 161, 226, 243, 90, 140,
+			105, 26, 241, 102, 136, 115, 152, 214, 19, 38, 21, 19, 165, 42,
+			54, 73, 124, 134, 104, 254, 222, 168, 134, 61, 68, 243, 247, 198,
+			188, 148, 65, 67, 180, 166, 223, 152, 31, 24, 36, 73, 62, 4,
+			154, 60, 4, 250, 30, 225, 43, 87, 215, 58, 252, 81, 15, 229,
+			165, 182, 28, 34, 155, 233, 161, 188, 212, 150, 67, 36, 93, 31,
+			2, 81, 79, 81, 235, 40, 145, 158, 55, 71, 139, 55, 243, 115,
+			153, 196, 23, 217, 164, 234, 97, 146, 98, 110, 116, 178, 145, 168,
+			41, 175, 200, 59, 100, 210, 229, 8, 121, 41, 177, 134, 232, 132,
+			227, 45, 121, 41, 177, 134, 72, 91, 191, 37, 47, 37, 214, 16,
+			77, 234, 91, 242, 195, 56, 124, 54, 237, 97, 39, 255, 182, 124,
+			199, 123, 36, 181, 145, 153, 245, 54, 80, 251, 110, 102, 219, 195,
+			160, 246, 59, 64, 237, 147, 87, 75, 237, 170, 151, 210, 3, 158,
+			44, 137, 224, 48, 209, 251, 29, 138, 222, 195, 68, 239, 119, 40,
+			122, 15, 19, 189, 223, 1, 122, 99, 189, 12, 3, 221, 119, 130,
+			222, 215, 241, 149, 171, 109, 31, 20, 127, 167, 162, 248, 48, 81,
+			252, 157, 138, 226, 195, 68, 241, 119, 42, 138, 15, 99, 189, 188,
+			123, 111, 138, 87, 189, 43, 83, 124, 152, 40, 254, 110, 69, 241,
+			97, 162, 248, 187, 21, 197, 135, 137, 226, 239, 86, 20, 31, 38,
+			138, 191, 27, 20, 255, 172, 201, 76, 123, 196, 201, 191, 47, 223,
+			241, 177, 188, 81, 124, 220, 208, 135, 213, 42, 30, 89, 43, 140,
+			76, 13, 142, 127, 42, 133, 145, 108, 135, 188, 164, 203, 96, 148,
+			116, 166, 58, 109, 125, 210, 66, 84, 178, 98, 135, 66, 146, 101,
+			213, 71, 6, 211, 103, 70, 210, 143, 192, 123, 146, 239, 28, 101,
+			62, 179, 237, 17, 112, 236, 251, 33, 233, 95, 242, 131, 74, 250,
+			105, 142, 184, 212, 16, 62, 173, 155, 111, 188, 81, 104, 43, 142,
+			221, 157, 228, 128, 17, 98, 233, 247, 43, 150, 30, 33, 150, 126,
+			191, 82, 1, 35, 196, 210, 239, 135, 10, 64, 8, 210, 8, 248,
+			225, 3, 121, 211, 41, 62, 87, 170, 0, 63, 110, 21, 255, 104,
+			159, 106, 155, 232, 126, 101, 113, 147, 214, 62, 161, 22, 62, 144,
+			151, 190, 148, 17, 98, 243, 15, 228, 59, 247, 41, 208, 194, 175,
+			253, 251, 217, 43, 177, 127, 28, 1, 159, 63, 146, 55, 139, 197,
+			132, 207, 133, 40, 253, 149, 240, 164, 89, 215, 182, 34, 77, 155,
+			178, 69, 229, 13, 214, 48, 148, 102, 94, 22, 135, 65, 137, 79,
+			134, 117, 64, 110, 45, 83, 78, 232, 244, 139, 23, 73, 125, 76,
+			103, 47, 160, 212, 75, 132, 14, 44, 234, 145, 151, 164, 209, 233,
+			35, 164, 95, 30, 73, 169, 4, 66, 60, 146, 239, 26, 82, 160,
+			5, 112, 244, 0, 114, 101, 128, 177, 229, 216, 31, 198, 202, 255,
+			113, 126, 58, 91, 142, 162, 173, 136, 28, 248, 176, 185, 67, 159,
+			241, 197, 246, 194, 21, 80, 75, 124, 211, 187, 172, 83, 242, 147,
+			168, 65, 151, 205, 86, 149, 75, 2, 215, 170, 60, 255, 162, 215,
+			76, 83, 105, 52, 226, 216, 123, 124, 56, 69, 28, 123, 143, 15,
+			43, 197, 56, 66, 26, 235, 195, 144, 40, 207, 39, 74, 219, 142,
+			253, 17, 224, 93, 222, 83, 3, 239, 142, 179, 104, 204, 206, 209,
+			231, 170, 43, 196, 118, 126, 36, 237, 10, 59, 142, 143, 160, 171,
+			147, 212, 85, 206, 177, 63, 10, 29, 60, 157, 234, 96, 209, 246,
+			21, 181, 240, 8, 5, 177, 127, 84, 105, 225, 17, 68, 73, 217,
+			31, 85, 90, 120, 196, 204, 193, 141, 164, 180, 240, 168, 147, 255,
+			120, 190, 227, 247, 164, 94, 24, 133, 39, 38, 223, 121, 152, 142,
+			80, 70, 177, 202, 62, 1, 189, 240, 252, 107, 212, 194, 105, 76,
+			176, 210, 149, 163, 180, 140, 62, 161, 6, 63, 74, 203, 232, 19,
+			74, 51, 140, 210, 50, 250, 4, 52, 195, 77, 212, 49, 2, 105,
+			64, 231, 235, 249, 202, 213, 247, 0, 221, 240, 168, 210, 13, 163,
+			180, 104, 30, 85, 186, 97, 148, 116, 195, 163, 32, 47, 45, 154,
+			81, 44, 154, 79, 231, 205, 169, 98, 210, 162, 28, 116, 187, 74,
+			6, 94, 133, 106, 230, 231, 224, 42, 190, 232, 53, 167, 69, 81,
+			35, 117, 91, 48, 86, 226, 42, 173, 196, 76, 236, 72, 154, 111,
+			107, 143, 146, 114, 249, 180, 82, 46, 163, 164, 92, 62, 157, 239,
+			62, 168, 64, 68, 220, 228, 15, 61, 75, 129, 136, 184, 201, 79,
+			76, 146, 58, 63, 224, 228, 63, 155, 239, 248, 162, 156, 182, 3,
+			134, 99, 127, 22, 211, 118, 134, 217, 246, 1, 76, 219, 231, 48,
+			109, 119, 92, 163, 58, 215, 131, 207, 168, 220, 3, 52, 113, 159,
+			83, 19, 119, 128, 38, 238, 115, 106, 226, 14, 208, 196, 125, 14,
+			19, 119, 11, 117, 109, 56, 246, 231, 49, 113, 19, 124, 229, 90,
+			250, 192, 212, 125, 94, 77, 221, 1, 154, 186, 207, 171, 169, 59,
+			64, 83, 247, 121, 76, 221, 75, 168, 15, 211, 177, 191, 128, 153,
+			187, 79, 215, 75, 209, 218, 92, 222, 72, 209, 122, 215, 94, 24,
+			241, 137, 139, 94, 115, 130, 230, 101, 130, 166, 104, 98, 143, 41,
+			57, 64, 83, 242, 5, 53, 37, 7, 104, 74, 190, 160, 166, 228,
+			0, 233, 251, 47, 168, 41, 57, 64, 98, 237, 11, 106, 74, 138,
+			78, 254, 75, 249, 142, 63, 147, 83, 82, 68, 60, 77, 190, 243,
+			32, 89, 88, 69, 76, 201, 151, 175, 201, 194, 162, 29, 181, 38,
+			150, 222, 85, 23, 105, 58, 190, 172, 166, 163, 72, 211, 241, 101,
+			53, 29, 69, 154, 142, 47, 43, 11, 171, 8, 116, 255, 100, 23,
+			11, 43, 9, 247, 110, 31, 83, 241, 39, 106, 42, 138, 52, 21,
+			127, 162, 166, 162, 72, 83, 241, 39, 152, 10, 68, 31, 23, 177,
+			136, 30, 203, 155, 7, 139, 167, 211, 210, 88, 23, 177, 175, 165,
+			14, 182, 124, 10, 78, 74, 47, 91, 10, 211, 19, 56, 133, 1,
+			82, 132, 20, 22, 26, 5, 204, 193, 99, 106, 14, 138, 164, 90,
+			30, 203, 203, 88, 215, 34, 205, 193, 99, 121, 103, 88, 129, 240,
+			14, 229, 15, 224, 50, 58, 211, 62, 232, 228, 191, 150, 239, 248,
+			11, 57, 7, 7, 145, 219, 149, 239, 60, 196, 46, 48, 219, 62,
+			136, 57, 248, 58, 248, 230, 46, 62, 47, 139, 67, 122, 213, 12,
+			9, 210, 36, 133, 9, 37, 94, 87, 147, 120, 130, 79, 234, 147,
+			234, 236, 57, 167, 18, 182, 7, 201, 139, 240, 117, 133, 237, 65,
+			242, 34, 124, 93, 113, 204, 65, 154, 159, 175, 43, 142, 57, 72,
+			243, 243, 117, 112, 12, 98, 38, 14, 57, 249, 255, 148, 239, 248,
+			207, 249, 103, 232, 196, 240, 144, 225, 216, 255, 9, 82, 97, 153,
+			217, 246, 33, 12, 255, 219, 96, 193, 133, 157, 44, 216, 114, 44,
+			145, 229, 66, 68, 187, 137, 58, 45, 176, 156, 164, 31, 33, 229,
+			150, 67, 196, 141, 223, 86, 220, 120, 136, 70, 251, 109, 197, 141,
+			135, 104, 180, 223, 6, 55, 34, 183, 224, 16, 38, 238, 59, 192,
+			224, 78, 194, 64, 181, 38, 205, 50, 225, 198, 14, 107, 72, 27,
+			138, 61, 175, 253, 46, 125, 132, 89, 200, 184, 55, 221, 57, 172,
+			164, 239, 164, 157, 131, 85, 191, 147, 118, 14, 86, 253, 14, 58,
+			7, 169, 15, 59, 249, 239, 230, 59, 254, 238, 153, 34, 245, 97,
+			195, 177, 191, 171, 244, 230, 97, 144, 250, 123, 215, 164, 55, 183,
+			221, 128, 46, 84, 217, 240, 18, 170, 82, 163, 41, 124, 152, 40,
+			252, 61, 53, 200, 195, 68, 225, 239, 169, 65, 30, 38, 10, 127,
+			79, 233, 205, 195, 160, 240, 247, 119, 215, 155, 79, 210, 3, 86,
+			252, 247, 213, 138, 63, 76, 43, 254, 251, 106, 197, 31, 166, 21,
+			255, 125, 172, 248, 79, 192, 243, 63, 230, 228, 95, 81, 232, 120,
+			115, 193, 40, 174, 161, 192, 142, 40, 192, 150, 201, 117, 115, 211,
+			194, 43, 228, 241, 122, 178, 66, 119, 20, 106, 194, 112, 209, 160,
+			40, 116, 131, 72, 12, 55, 120, 198, 119, 18, 99, 240, 25, 21,
+			58, 247, 211, 185, 222, 24, 178, 236, 127, 170, 96, 62, 80, 16,
+			231, 122, 99, 54, 8, 252, 83, 133, 66, 15, 165, 212, 140, 217,
+			116, 174, 247, 202, 130, 93, 164, 227, 163, 49, 153, 43, 255, 202,
+			130, 61, 148, 62, 64, 121, 157, 194, 232, 1, 253, 133, 225, 216,
+			63, 157, 253, 2, 20, 253, 233, 130, 221, 157, 62, 48, 241, 32,
+			243, 133, 233, 216, 175, 202, 126, 129, 137, 124, 85, 65, 30, 145,
+			209, 3, 122, 35, 243, 133, 229, 216, 175, 206, 126, 1, 187, 245,
+			213, 5, 121, 112, 71, 15, 76, 60, 24, 61, 64, 7, 220, 99,
+			24, 197, 131, 5, 179, 31, 7, 220, 42, 222, 40, 221, 31, 169,
+			173, 130, 46, 52, 162, 182, 11, 184, 20, 127, 85, 148, 83, 89,
+			21, 133, 25, 79, 94, 58, 94, 18, 235, 111, 140, 88, 243, 193,
+			130, 100, 205, 49, 98, 205, 7, 11, 93, 221, 10, 132, 179, 167,
+			208, 219, 71, 206, 171, 49, 140, 232, 181, 5, 211, 41, 78, 211,
+			226, 215, 92, 34, 79, 209, 117, 191, 217, 216, 92, 201, 161, 99,
+			20, 247, 243, 218, 130, 220, 14, 141, 17, 135, 190, 182, 32, 183,
+			67, 99, 196, 161, 175, 45, 244, 239, 39, 163, 117, 12, 58, 233,
+			245, 5, 115, 186, 248, 252, 212, 112, 214, 237, 99, 223, 235, 199,
+			48, 214, 213, 245, 253, 147, 45, 193, 244, 97, 132, 186, 40, 74,
+			182, 143, 145, 250, 127, 125, 65, 26, 210, 99, 164, 122, 94, 95,
+			144, 134, 244, 24, 169, 255, 215, 23, 142, 222, 192, 238, 160, 142,
+			225, 76, 42, 152, 207, 42, 222, 72, 59, 238, 229, 11, 115, 115,
+			11, 11, 42, 240, 214, 155, 150, 7, 110, 109, 164, 78, 125, 129,
+			99, 228, 59, 123, 99, 193, 28, 85, 160, 129, 246, 14, 140, 43,
+			144, 154, 47, 93, 199, 110, 166, 206, 108, 199, 126, 168, 96, 14,
+			20, 159, 77, 157, 137, 162, 139, 170, 39, 180, 79, 215, 250, 240,
+			45, 47, 142, 211, 8, 242, 49, 36, 85, 217, 15, 165, 51, 134,
+			29, 200, 67, 5, 233, 110, 28, 163, 51, 143, 135, 10, 251, 29,
+			82, 165, 227, 78, 254, 223, 20, 58, 254, 183, 130, 80, 165, 227,
+			134, 99, 255, 155, 66, 231, 56, 57, 90, 199, 193, 77, 111, 43,
+			152, 67, 197, 83, 79, 69, 192, 137, 223, 214, 67, 132, 206, 73,
+			204, 198, 137, 151, 222, 166, 48, 27, 39, 94, 122, 91, 65, 138,
+			185, 113, 18, 115, 111, 43, 12, 12, 178, 91, 169, 123, 120, 149,
+			10, 230, 129, 226, 13, 124, 229, 90, 251, 1, 43, 189, 163, 32,
+			133, 221, 56, 177, 210, 59, 10, 82, 216, 141, 19, 43, 189, 163,
+			48, 50, 138, 28, 12, 123, 28, 172, 244, 46, 244, 115, 19, 88,
+			9, 7, 168, 242, 168, 69, 4, 106, 41, 183, 131, 74, 118, 42,
+			225, 88, 162, 36, 44, 75, 221, 31, 140, 151, 119, 21, 100, 110,
+			211, 56, 113, 208, 187, 10, 5, 213, 31, 56, 232, 93, 105, 127,
+			200, 116, 186, 134, 254, 224, 201, 106, 239, 15, 219, 217, 247, 164,
+			253, 65, 44, 188, 39, 237, 15, 219, 217, 247, 20, 228, 1, 222,
+			56, 236, 172, 159, 47, 152, 227, 197, 91, 175, 174, 63, 189, 140,
+			118, 244, 10, 190, 250, 249, 180, 87, 240, 213, 207, 23, 10, 69,
+			5, 90, 0, 15, 143, 17, 95, 113, 39, 255, 222, 66, 199, 71,
+			36, 95, 113, 195, 177, 223, 91, 232, 228, 180, 132, 56, 248, 234,
+			225, 130, 57, 90, 188, 177, 109, 98, 213, 165, 127, 114, 183, 43,
+			141, 247, 117, 191, 86, 163, 0, 70, 137, 7, 39, 227, 235, 225,
+			130, 180, 197, 56, 113, 209, 195, 5, 25, 116, 198, 137, 139, 30,
+			46, 12, 143, 176, 119, 96, 11, 200, 49, 23, 239, 71, 111, 63,
+			131, 236, 237, 157, 201, 212, 186, 255, 171, 63, 106, 98, 58, 8,
+			14, 69, 51, 98, 29, 172, 233, 175, 103, 179, 36, 169, 196, 135,
+			210, 153, 171, 224, 26, 126, 251, 237, 148, 57, 167, 7, 98, 216,
+			132, 155, 6, 243, 0, 165, 219, 145, 19, 215, 190, 191, 32, 221,
+			142, 156, 184, 246, 253, 24, 215, 175, 139, 113, 193, 31, 132, 113,
+			61, 108, 224, 104, 42, 227, 45, 120, 146, 113, 41, 31, 255, 69,
+			15, 23, 98, 71, 113, 162, 139, 220, 7, 236, 170, 107, 80, 94,
+			245, 152, 193, 185, 59, 198, 12, 118, 124, 36, 29, 51, 100, 239,
+			35, 233, 152, 49, 91, 143, 164, 99, 198, 202, 121, 4, 99, 254,
+			121, 49, 102, 203, 177, 127, 19, 194, 247, 33, 131, 47, 167, 186,
+			37, 35, 250, 219, 74, 191, 101, 233, 160, 139, 192, 181, 17, 4,
+			101, 138, 23, 231, 175, 105, 96, 153, 238, 218, 135, 103, 217, 132,
+			162, 6, 243, 0, 187, 7, 21, 136, 104, 163, 194, 208, 184, 2,
+			105, 60, 165, 235, 40, 178, 240, 136, 147, 255, 120, 161, 227, 211,
+			133, 103, 200, 120, 61, 2, 167, 79, 161, 243, 48, 171, 51, 219,
+			62, 130, 53, 248, 40, 100, 251, 26, 173, 193, 93, 202, 173, 181,
+			203, 89, 146, 229, 148, 139, 210, 150, 208, 205, 39, 252, 96, 61,
+			114, 103, 146, 48, 172, 197, 51, 176, 37, 102, 106, 126, 208, 184,
+			124, 204, 221, 170, 62, 231, 230, 9, 73, 153, 35, 36, 251, 31,
+			85, 178, 255, 8, 173, 218, 71, 149, 236, 63, 66, 171, 246, 81,
+			200, 126, 56, 245, 143, 128, 13, 62, 5, 25, 121, 51, 161, 39,
+			49, 187, 162, 10, 80, 232, 137, 38, 161, 4, 62, 165, 148, 192,
+			17, 90, 78, 159, 82, 74, 224, 8, 45, 167, 79, 65, 72, 66,
+			92, 149, 156, 252, 239, 23, 58, 158, 144, 226, 170, 132, 162, 57,
+			133, 206, 49, 18, 87, 37, 144, 234, 15, 158, 186, 184, 42, 145,
+			184, 250, 3, 197, 18, 37, 26, 248, 31, 40, 113, 85, 162, 129,
+			255, 1, 88, 252, 151, 44, 234, 205, 112, 236, 175, 20, 204, 131,
+			197, 183, 227, 46, 245, 182, 252, 49, 220, 7, 234, 210, 61, 142,
+			146, 34, 178, 140, 246, 142, 76, 50, 162, 26, 69, 104, 185, 181,
+			141, 144, 83, 224, 137, 91, 219, 118, 155, 42, 200, 19, 235, 4,
+			149, 198, 55, 66, 114, 140, 136, 8, 111, 145, 136, 163, 110, 50,
+			19, 93, 232, 11, 49, 84, 32, 27, 159, 163, 231, 250, 6, 83,
+			113, 181, 36, 166, 98, 221, 71, 196, 97, 144, 233, 82, 93, 136,
+			143, 27, 52, 96, 114, 241, 184, 81, 199, 5, 17, 88, 138, 44,
+			83, 227, 69, 222, 137, 89, 85, 215, 82, 169, 215, 92, 21, 81,
+			142, 38, 85, 97, 112, 217, 111, 221, 175, 92, 212, 183, 225, 163,
+			244, 214, 6, 137, 189, 166, 238, 131, 226, 10, 165, 85, 27, 121,
+			235, 171, 116, 7, 9, 220, 254, 228, 145, 192, 21, 145, 56, 61,
+			160, 228, 34, 120, 244, 252, 170, 190, 57, 115, 5, 23, 180, 146,
+			201, 74, 42, 72, 197, 230, 215, 154, 252, 244, 139, 23, 245, 180,
+			130, 189, 190, 162, 216, 171, 68, 236, 245, 149, 66, 207, 176, 2,
+			17, 68, 83, 56, 80, 36, 195, 188, 4, 27, 227, 177, 130, 57,
+			86, 156, 231, 203, 105, 149, 210, 118, 77, 116, 213, 211, 43, 186,
+			128, 232, 124, 44, 69, 0, 107, 230, 177, 66, 207, 1, 5, 194,
+			99, 82, 56, 116, 152, 246, 140, 37, 8, 210, 175, 130, 137, 175,
+			231, 203, 254, 203, 181, 220, 220, 209, 143, 60, 199, 151, 109, 192,
+			204, 248, 170, 178, 200, 75, 20, 99, 247, 213, 66, 167, 226, 92,
+			152, 25, 95, 5, 231, 82, 89, 141, 18, 4, 251, 183, 176, 102,
+			31, 53, 224, 144, 63, 206, 171, 254, 6, 36, 231, 174, 93, 33,
+			188, 96, 211, 187, 44, 221, 2, 42, 92, 57, 173, 49, 29, 214,
+			20, 17, 100, 173, 60, 41, 154, 137, 173, 48, 99, 56, 174, 216,
+			118, 163, 42, 221, 119, 230, 38, 34, 175, 175, 137, 11, 112, 112,
+			241, 7, 134, 192, 207, 82, 60, 172, 106, 133, 42, 79, 93, 242,
+			34, 20, 111, 67, 38, 64, 184, 190, 27, 115, 200, 11, 116, 53,
+			5, 96, 242, 124, 75, 9, 173, 18, 153, 60, 223, 42, 72, 103,
+			126, 137, 76, 233, 111, 65, 134, 224, 6, 65, 187, 4, 43, 240,
+			175, 177, 41, 249, 51, 147, 206, 104, 200, 217, 130, 85, 232, 197,
+			187, 83, 33, 115, 186, 209, 136, 177, 238, 144, 72, 2, 78, 142,
+			21, 23, 123, 85, 173, 151, 229, 82, 145, 133, 181, 160, 165, 179,
+			45, 106, 169, 157, 185, 93, 86, 9, 130, 138, 188, 168, 145, 150,
+			163, 18, 156, 146, 20, 59, 145, 2, 194, 87, 92, 100, 84, 59,
+			8, 130, 80, 119, 2, 255, 31, 78, 187, 106, 30, 34, 178, 164,
+			53, 25, 243, 137, 148, 200, 19, 184, 205, 217, 171, 161, 18, 43,
+			29, 217, 28, 135, 133, 180, 201, 39, 85, 164, 49, 227, 53, 111,
+			195, 173, 52, 87, 227, 77, 247, 184, 88, 237, 162, 168, 8, 22,
+			96, 35, 104, 13, 122, 42, 153, 57, 155, 168, 173, 193, 60, 64,
+			169, 105, 75, 116, 26, 242, 215, 133, 161, 9, 5, 90, 248, 245,
+			232, 13, 107, 121, 186, 232, 246, 38, 246, 183, 135, 217, 137, 141,
+			176, 92, 217, 140, 194, 45, 191, 177, 85, 14, 163, 141, 153, 90,
+			163, 226, 11, 5, 230, 214, 229, 31, 151, 142, 207, 80, 209, 175,
+			184, 76, 223, 57, 54, 158, 22, 111, 188, 186, 47, 113, 247, 175,
+			248, 174, 56, 190, 17, 134, 27, 53, 111, 134, 160, 181, 198, 250,
+			140, 182, 178, 196, 11, 165, 95, 182, 88, 110, 1, 61, 57, 215,
+			49, 251, 162, 31, 84, 71, 13, 110, 76, 246, 158, 232, 43, 163,
+			181, 50, 253, 116, 175, 31, 84, 151, 232, 71, 167, 159, 89, 219,
+			155, 225, 168, 201, 141, 201, 174, 37, 252, 233, 148, 153, 13, 33,
+			62, 106, 113, 99, 178, 251, 68, 177, 44, 58, 44, 171, 14, 203,
+			43, 170, 195, 37, 122, 207, 25, 101, 5, 201, 51, 163, 54, 181,
+			162, 64, 167, 200, 58, 149, 146, 27, 205, 209, 79, 26, 70, 191,
+			145, 183, 62, 154, 167, 199, 86, 228, 173, 227, 73, 226, 110, 140,
+			22, 196, 147, 196, 221, 112, 110, 99, 61, 27, 145, 27, 36, 94,
+			117, 21, 85, 176, 70, 59, 185, 53, 217, 125, 98, 84, 12, 164,
+			173, 94, 215, 236, 220, 153, 165, 110, 249, 54, 106, 246, 225, 227,
+			200, 187, 20, 94, 84, 31, 119, 93, 233, 99, 249, 54, 125, 60,
+			196, 242, 91, 213, 213, 139, 94, 115, 148, 17, 58, 185, 173, 234,
+			189, 94, 211, 121, 54, 235, 219, 170, 174, 102, 207, 106, 70, 187,
+			233, 247, 125, 91, 85, 121, 150, 186, 210, 172, 123, 206, 245, 172,
+			119, 171, 186, 154, 57, 85, 24, 237, 81, 175, 101, 14, 48, 143,
+			254, 149, 201, 186, 244, 124, 56, 69, 54, 188, 240, 162, 133, 179,
+			43, 171, 247, 46, 158, 157, 95, 189, 112, 118, 249, 252, 194, 220,
+			226, 233, 197, 133, 249, 254, 14, 103, 152, 57, 231, 151, 22, 78,
+			47, 254, 171, 213, 217, 185, 51, 171, 115, 119, 207, 158, 189, 107,
+			97, 190, 191, 234, 12, 178, 190, 243, 179, 115, 247, 206, 222, 181,
+			176, 58, 183, 180, 48, 187, 178, 48, 223, 255, 123, 70, 246, 233,
+			252, 194, 153, 5, 60, 253, 140, 225, 12, 176, 94, 245, 244, 238,
+			197, 249, 249, 133, 179, 253, 191, 111, 56, 67, 172, 95, 61, 188,
+			112, 86, 62, 254, 44, 61, 94, 60, 187, 188, 50, 123, 118, 46,
+			109, 248, 253, 102, 203, 99, 213, 242, 175, 180, 62, 94, 90, 56,
+			189, 186, 188, 176, 210, 255, 1, 211, 25, 97, 78, 203, 227, 11,
+			103, 241, 195, 7, 77, 167, 200, 134, 244, 15, 43, 179, 119, 173,
+			206, 174, 172, 204, 206, 221, 189, 48, 223, 255, 171, 59, 127, 155,
+			95, 144, 191, 253, 154, 233, 140, 179, 162, 254, 237, 190, 133, 149,
+			217, 249, 217, 149, 217, 244, 227, 71, 246, 120, 65, 183, 240, 239,
+			205, 83, 55, 252, 200, 212, 85, 173, 184, 219, 220, 186, 127, 207,
+			91, 135, 89, 222, 177, 123, 59, 206, 24, 236, 119, 108, 102, 244,
+			56, 86, 111, 135, 115, 226, 35, 54, 159, 11, 235, 205, 200, 223,
+			216, 76, 248, 137, 27, 143, 139, 144, 163, 51, 23, 230, 22, 249,
+			108, 35, 217, 12, 35, 82, 25, 103, 252, 10, 110, 18, 171, 234,
+			228, 15, 143, 207, 214, 113, 238, 171, 126, 153, 230, 202, 85, 125,
+			162, 124, 163, 56, 52, 40, 201, 159, 74, 83, 183, 49, 222, 12,
+			27, 148, 146, 33, 175, 72, 81, 86, 68, 13, 202, 29, 53, 98,
+			16, 221, 13, 141, 85, 243, 83, 27, 50, 73, 155, 47, 51, 254,
+			98, 217, 66, 184, 70, 117, 142, 112, 101, 92, 93, 215, 232, 146,
+			175, 113, 55, 97, 178, 32, 240, 102, 146, 212, 79, 206, 204, 108,
+			111, 111, 151, 93, 194, 84, 72, 50, 241, 94, 60, 115, 102, 113,
+			110, 225, 236, 242, 194, 177, 19, 229, 27, 161, 98, 69, 57, 42,
+			149, 37, 4, 69, 43, 67, 5, 112, 49, 106, 205, 221, 198, 209,
+			141, 187, 17, 121, 216, 58, 33, 103, 145, 163, 178, 161, 31, 108,
+			96, 203, 180, 158, 108, 211, 85, 96, 85, 120, 121, 253, 181, 70,
+			210, 66, 38, 133, 153, 31, 183, 188, 32, 156, 189, 165, 217, 101,
+			190, 184, 92, 226, 167, 102, 151, 23, 151, 167, 25, 191, 127, 113,
+			229, 238, 115, 23, 86, 248, 253, 179, 75, 75, 179, 103, 87, 22,
+			23, 150, 249, 185, 37, 174, 235, 43, 47, 243, 115, 167, 249, 236,
+			217, 23, 115, 44, 169, 105, 117, 21, 134, 119, 25, 25, 249, 116,
+			249, 5, 106, 117, 251, 56, 97, 229, 203, 158, 215, 210, 189, 42,
+			128, 173, 11, 203, 213, 220, 96, 163, 1, 197, 184, 129, 66, 141,
+			1, 148, 108, 221, 139, 182, 252, 56, 246, 233, 26, 252, 160, 202,
+			178, 41, 153, 59, 71, 84, 102, 172, 147, 25, 166, 99, 245, 119,
+			236, 195, 95, 157, 142, 229, 116, 220, 201, 186, 152, 217, 217, 173,
+			255, 180, 58, 28, 107, 176, 227, 36, 158, 90, 134, 99, 13, 117,
+			76, 49, 70, 213, 147, 237, 209, 142, 99, 6, 99, 162, 184, 176,
+			53, 154, 235, 101, 221, 178, 182, 176, 117, 192, 60, 204, 122, 84,
+			105, 97, 235, 128, 57, 170, 32, 211, 177, 14, 28, 60, 4, 39,
+			24, 213, 21, 182, 14, 153, 7, 139, 211, 170, 60, 167, 208, 70,
+			39, 219, 239, 114, 163, 13, 156, 46, 202, 212, 163, 106, 254, 90,
+			135, 204, 65, 217, 44, 134, 112, 104, 164, 72, 245, 59, 144, 206,
+			97, 141, 147, 11, 74, 5, 219, 238, 108, 55, 13, 253, 133, 177,
+			230, 162, 182, 132, 184, 141, 170, 71, 21, 234, 181, 198, 205, 253,
+			10, 66, 131, 67, 163, 114, 116, 150, 99, 113, 243, 128, 28, 29,
+			8, 194, 245, 139, 150, 233, 88, 92, 191, 104, 59, 214, 17, 253,
+			162, 109, 0, 234, 87, 144, 233, 88, 71, 244, 139, 57, 199, 42,
+			233, 23, 115, 6, 32, 71, 65, 166, 99, 149, 134, 70, 113, 4,
+			155, 235, 48, 243, 142, 117, 189, 121, 93, 241, 182, 180, 110, 200,
+			238, 35, 211, 21, 65, 213, 32, 149, 138, 211, 3, 204, 27, 104,
+			74, 245, 146, 55, 29, 235, 250, 241, 146, 68, 167, 224, 88, 207,
+			54, 175, 147, 63, 21, 12, 64, 234, 197, 130, 233, 88, 207, 214,
+			47, 118, 58, 214, 132, 126, 177, 211, 0, 164, 94, 236, 52, 29,
+			107, 66, 191, 216, 229, 88, 147, 250, 197, 46, 3, 144, 154, 185,
+			46, 211, 177, 38, 245, 139, 204, 177, 166, 244, 139, 204, 0, 52,
+			162, 32, 211, 177, 166, 244, 139, 221, 142, 117, 84, 191, 216, 109,
+			0, 82, 47, 118, 155, 142, 117, 84, 191, 216, 227, 88, 55, 232,
+			23, 123, 12, 64, 135, 20, 100, 58, 214, 13, 250, 197, 125, 142,
+			53, 173, 95, 220, 103, 0, 82, 47, 238, 51, 29, 107, 122, 188,
+			196, 222, 129, 144, 184, 14, 199, 126, 110, 199, 25, 163, 248, 58,
+			147, 147, 158, 132, 32, 209, 55, 96, 199, 73, 212, 168, 36, 141,
+			200, 171, 138, 153, 225, 181, 112, 67, 198, 251, 136, 98, 52, 120,
+			123, 45, 76, 54, 249, 41, 127, 3, 87, 151, 55, 249, 36, 86,
+			181, 91, 221, 12, 43, 148, 44, 237, 123, 177, 76, 89, 12, 248,
+			188, 155, 184, 40, 160, 226, 137, 151, 226, 205, 112, 155, 146, 64,
+			33, 186, 188, 53, 126, 97, 81, 158, 113, 224, 199, 146, 244, 100,
+			73, 135, 170, 71, 113, 112, 96, 232, 76, 43, 234, 130, 195, 244,
+			176, 52, 246, 34, 223, 173, 249, 47, 247, 170, 114, 48, 46, 10,
+			229, 200, 154, 210, 169, 116, 150, 37, 254, 16, 170, 45, 183, 141,
+			162, 54, 29, 221, 223, 45, 252, 255, 76, 212, 119, 182, 158, 219,
+			185, 15, 4, 197, 153, 146, 99, 61, 207, 28, 2, 9, 1, 228,
+			1, 117, 43, 200, 112, 172, 231, 245, 244, 43, 200, 114, 172, 231,
+			13, 12, 82, 33, 27, 146, 9, 183, 154, 251, 41, 111, 83, 30,
+			239, 232, 124, 127, 117, 164, 131, 98, 163, 39, 159, 239, 109, 185,
+			126, 237, 142, 18, 147, 173, 24, 57, 199, 186, 213, 236, 84, 16,
+			154, 233, 234, 81, 144, 229, 88, 183, 246, 245, 179, 135, 176, 151,
+			35, 249, 112, 187, 121, 61, 74, 65, 47, 121, 110, 141, 92, 160,
+			100, 133, 235, 61, 26, 198, 38, 174, 237, 218, 242, 107, 53, 63,
+			246, 42, 161, 216, 166, 85, 124, 146, 172, 101, 126, 214, 13, 66,
+			241, 152, 234, 169, 48, 238, 174, 169, 44, 62, 97, 213, 211, 244,
+			199, 220, 219, 242, 19, 233, 141, 115, 113, 109, 229, 6, 114, 202,
+			35, 55, 136, 221, 138, 220, 144, 11, 12, 177, 180, 111, 55, 15,
+			74, 124, 65, 132, 219, 15, 113, 5, 89, 142, 117, 251, 117, 207,
+			162, 50, 53, 40, 18, 110, 189, 192, 28, 42, 157, 200, 136, 176,
+			64, 94, 184, 169, 202, 21, 10, 118, 145, 198, 217, 81, 137, 202,
+			148, 234, 202, 202, 57, 214, 11, 52, 161, 44, 195, 177, 94, 208,
+			165, 166, 194, 66, 235, 3, 131, 108, 154, 186, 178, 29, 235, 78,
+			115, 184, 52, 222, 82, 109, 115, 113, 158, 166, 94, 219, 52, 71,
+			85, 187, 118, 14, 175, 171, 118, 33, 239, 238, 236, 218, 175, 32,
+			203, 177, 238, 28, 28, 146, 237, 230, 28, 107, 214, 220, 143, 118,
+			245, 149, 50, 173, 109, 194, 96, 211, 237, 230, 232, 117, 213, 46,
+			196, 227, 172, 158, 216, 156, 229, 88, 179, 125, 253, 84, 148, 132,
+			196, 227, 41, 115, 127, 233, 168, 188, 108, 19, 53, 18, 39, 46,
+			158, 188, 52, 129, 198, 183, 166, 90, 187, 128, 29, 167, 187, 200,
+			231, 28, 235, 148, 70, 29, 178, 241, 148, 238, 34, 111, 57, 214,
+			169, 190, 126, 74, 34, 233, 48, 11, 142, 181, 96, 222, 92, 188,
+			30, 149, 153, 169, 238, 182, 191, 190, 206, 51, 212, 206, 152, 194,
+			122, 110, 11, 54, 62, 210, 80, 222, 177, 22, 186, 75, 10, 50,
+			28, 107, 225, 186, 25, 5, 89, 142, 181, 112, 226, 38, 185, 128,
+			58, 29, 235, 180, 121, 179, 252, 169, 211, 6, 164, 26, 233, 204,
+			59, 214, 105, 221, 8, 68, 239, 105, 221, 72, 167, 229, 88, 167,
+			79, 220, 68, 190, 153, 14, 179, 203, 177, 22, 41, 98, 66, 109,
+			44, 144, 225, 49, 173, 98, 187, 68, 148, 37, 196, 77, 102, 99,
+			208, 74, 40, 109, 179, 30, 213, 3, 234, 202, 161, 81, 69, 46,
+			200, 243, 197, 174, 62, 5, 89, 142, 181, 232, 12, 202, 33, 48,
+			199, 186, 199, 28, 147, 67, 96, 57, 64, 234, 51, 72, 247, 123,
+			186, 70, 21, 100, 57, 214, 61, 7, 15, 203, 207, 186, 29, 235,
+			94, 97, 64, 0, 200, 1, 82, 159, 65, 214, 223, 219, 53, 162,
+			32, 203, 177, 238, 45, 30, 210, 27, 223, 159, 233, 101, 55, 95,
+			221, 246, 213, 187, 12, 63, 154, 87, 109, 217, 250, 94, 113, 35,
+			251, 39, 6, 235, 94, 144, 95, 174, 184, 27, 78, 47, 51, 125,
+			177, 153, 237, 90, 50, 253, 106, 203, 238, 210, 108, 219, 93, 102,
+			246, 164, 86, 235, 158, 180, 159, 89, 216, 214, 137, 157, 42, 254,
+			116, 6, 89, 142, 130, 188, 228, 22, 85, 0, 206, 56, 235, 86,
+			231, 32, 171, 107, 77, 185, 79, 101, 234, 209, 169, 166, 115, 91,
+			230, 133, 36, 30, 45, 92, 113, 183, 172, 63, 94, 137, 175, 109,
+			31, 242, 142, 110, 177, 15, 185, 238, 73, 247, 33, 183, 254, 203,
+			62, 228, 95, 246, 33, 79, 243, 62, 100, 10, 213, 6, 200, 12,
+			59, 216, 113, 157, 81, 124, 196, 224, 153, 5, 41, 147, 7, 97,
+			209, 199, 149, 77, 111, 139, 46, 33, 224, 19, 106, 177, 175, 194,
+			58, 90, 125, 254, 203, 194, 53, 191, 122, 199, 68, 106, 131, 37,
+			238, 154, 40, 3, 32, 3, 179, 235, 97, 93, 122, 46, 215, 154,
+			124, 225, 95, 157, 63, 183, 180, 178, 186, 50, 123, 215, 242, 234,
+			202, 185, 213, 83, 47, 164, 114, 232, 94, 196, 95, 22, 174, 145,
+			165, 86, 201, 100, 241, 163, 187, 75, 110, 132, 76, 127, 38, 173,
+			58, 55, 112, 107, 205, 216, 143, 97, 72, 201, 107, 213, 149, 177,
+			116, 176, 115, 128, 149, 149, 177, 116, 200, 236, 47, 29, 225, 147,
+			245, 139, 27, 40, 62, 138, 211, 75, 191, 58, 133, 169, 166, 252,
+			57, 121, 176, 169, 68, 112, 71, 14, 31, 40, 161, 136, 182, 14,
+			117, 105, 235, 202, 114, 172, 67, 189, 125, 178, 101, 195, 177, 14,
+			155, 195, 165, 35, 109, 74, 92, 217, 82, 179, 213, 243, 149, 187,
+			113, 243, 130, 106, 25, 118, 212, 97, 221, 50, 182, 89, 135, 181,
+			26, 55, 44, 199, 58, 60, 56, 196, 110, 80, 102, 212, 152, 57,
+			84, 26, 107, 179, 68, 84, 203, 178, 24, 177, 252, 212, 204, 225,
+			109, 213, 44, 12, 156, 49, 109, 117, 192, 166, 25, 27, 24, 100,
+			215, 43, 3, 103, 220, 220, 95, 26, 133, 162, 226, 152, 55, 125,
+			165, 1, 29, 239, 42, 60, 97, 198, 140, 235, 6, 97, 198, 140,
+			107, 157, 13, 51, 102, 188, 175, 159, 77, 40, 51, 134, 155, 3,
+			165, 162, 12, 109, 222, 187, 73, 88, 48, 92, 55, 9, 11, 134,
+			119, 245, 42, 8, 219, 190, 253, 142, 28, 122, 14, 155, 185, 3,
+			165, 49, 236, 27, 81, 153, 136, 46, 226, 154, 222, 195, 50, 133,
+			1, 115, 68, 55, 11, 3, 230, 72, 215, 96, 198, 128, 57, 50,
+			50, 202, 184, 50, 96, 74, 230, 116, 105, 64, 22, 182, 79, 232,
+			232, 136, 154, 87, 109, 229, 233, 21, 101, 39, 194, 82, 41, 29,
+			154, 80, 144, 229, 88, 165, 163, 55, 172, 229, 235, 81, 152, 132,
+			55, 253, 255, 3, 0, 77, 227, 188, 230, 233, 243, 0, 0},
 	)
 }
 
@@ -25,6 +25,7 @@ import prpc "go.chromium.org/luci/grpc/prpc"
 import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	_struct "github.com/golang/protobuf/ptypes/struct"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
@@ -213,7 +214,7 @@ func (x Processor_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Processor_State.Descriptor instead.
 func (Processor_State) EnumDescriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{22, 0}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{30, 0}
 }
 
 type PrefixRequest struct {
@@ -291,6 +292,13 @@ type PrefixMetadata struct {
 	// ACLs that apply to this prefix and all subprefixes, as a mapping from
 	// a role to a list of users and groups that have it.
 	Acls []*PrefixMetadata_ACL `protobuf:"bytes,5,rep,name=acls,proto3" json:"acls,omitempty"`
+	// Defines when instances of packages under this prefix are garbage
+	// collected.
+	//
+	// Applies to this prefix and all subprefixes that don't have their own
+	// policy. The most specific policy wins, policies are not merged. If there's
+	// no policy, instances are never deleted automatically.
+	RetentionPolicy *RetentionPolicy `protobuf:"bytes,6,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
 }
 
 func (x *PrefixMetadata) Reset() {
@@ -360,6 +368,78 @@ func (x *PrefixMetadata) GetAcls() []*PrefixMetadata_ACL {
 	return nil
 }
 
+func (x *PrefixMetadata) GetRetentionPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.RetentionPolicy
+	}
+	return nil
+}
+
+// RetentionPolicy describes what package instances can be deleted by the
+// garbage collector.
+//
+// Instances that have refs pointing to them are never deleted. Among the rest,
+// an instance is deleted if it matches any of the rules below.
+type RetentionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If positive, keep only that many most recently registered instances that
+	// have neither refs nor tags. Older such instances are deleted.
+	KeepLastUntagged int32 `protobuf:"varint,1,opt,name=keep_last_untagged,json=keepLastUntagged,proto3" json:"keep_last_untagged,omitempty"`
+	// If set, delete instances that have no refs and were registered longer
+	// than this duration ago, unless some of their tags are fresher than that
+	// (i.e. were attached less than this duration ago).
+	MaxUnreferencedAge *duration.Duration `protobuf:"bytes,2,opt,name=max_unreferenced_age,json=maxUnreferencedAge,proto3" json:"max_unreferenced_age,omitempty"`
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RetentionPolicy) GetKeepLastUntagged() int32 {
+	if x != nil {
+		return x.KeepLastUntagged
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetMaxUnreferencedAge() *duration.Duration {
+	if x != nil {
+		return x.MaxUnreferencedAge
+	}
+	return nil
+}
+
 type InheritedPrefixMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -376,7 +456,7 @@ type InheritedPrefixMetadata struct {
 func (x *InheritedPrefixMetadata) Reset() {
 	*x = InheritedPrefixMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[2]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -389,7 +469,7 @@ func (x *InheritedPrefixMetadata) String() string {
 func (*InheritedPrefixMetadata) ProtoMessage() {}
 
 func (x *InheritedPrefixMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[2]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +482,7 @@ func (x *InheritedPrefixMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InheritedPrefixMetadata.ProtoReflect.Descriptor instead.
 func (*InheritedPrefixMetadata) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{2}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *InheritedPrefixMetadata) GetPerPrefixMetadata() []*PrefixMetadata {
@@ -424,7 +504,7 @@ type RolesInPrefixResponse struct {
 func (x *RolesInPrefixResponse) Reset() {
 	*x = RolesInPrefixResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[3]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -437,7 +517,7 @@ func (x *RolesInPrefixResponse) String() string {
 func (*RolesInPrefixResponse) ProtoMessage() {}
 
 func (x *RolesInPrefixResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[3]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +530,7 @@ func (x *RolesInPrefixResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RolesInPrefixResponse.ProtoReflect.Descriptor instead.
 func (*RolesInPrefixResponse) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{3}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RolesInPrefixResponse) GetRoles() []*RolesInPrefixResponse_RoleInPrefix {
@@ -477,7 +557,7 @@ type ListPrefixRequest struct {
 func (x *ListPrefixRequest) Reset() {
 	*x = ListPrefixRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[4]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -490,7 +570,7 @@ func (x *ListPrefixRequest) String() string {
 func (*ListPrefixRequest) ProtoMessage() {}
 
 func (x *ListPrefixRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[4]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,7 +583,7 @@ func (x *ListPrefixRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPrefixRequest.ProtoReflect.Descriptor instead.
 func (*ListPrefixRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{4}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListPrefixRequest) GetPrefix() string {
@@ -541,7 +621,7 @@ type ListPrefixResponse struct {
 func (x *ListPrefixResponse) Reset() {
 	*x = ListPrefixResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[5]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -554,7 +634,7 @@ func (x *ListPrefixResponse) String() string {
 func (*ListPrefixResponse) ProtoMessage() {}
 
 func (x *ListPrefixResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[5]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +647,7 @@ func (x *ListPrefixResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPrefixResponse.ProtoReflect.Descriptor instead.
 func (*ListPrefixResponse) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{5}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListPrefixResponse) GetPackages() []string {
@@ -596,7 +676,7 @@ type PackageRequest struct {
 func (x *PackageRequest) Reset() {
 	*x = PackageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[6]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -609,7 +689,7 @@ func (x *PackageRequest) String() string {
 func (*PackageRequest) ProtoMessage() {}
 
 func (x *PackageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[6]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +702,7 @@ func (x *PackageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PackageRequest.ProtoReflect.Descriptor instead.
 func (*PackageRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{6}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PackageRequest) GetPackage() string {
@@ -651,7 +731,7 @@ type Instance struct {
 func (x *Instance) Reset() {
 	*x = Instance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[7]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -664,7 +744,7 @@ func (x *Instance) String() string {
 func (*Instance) ProtoMessage() {}
 
 func (x *Instance) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[7]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,7 +757,7 @@ func (x *Instance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Instance.ProtoReflect.Descriptor instead.
 func (*Instance) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{7}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Instance) GetPackage() string {
@@ -729,7 +809,7 @@ type RegisterInstanceResponse struct {
 func (x *RegisterInstanceResponse) Reset() {
 	*x = RegisterInstanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[8]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -742,7 +822,7 @@ func (x *RegisterInstanceResponse) String() string {
 func (*RegisterInstanceResponse) ProtoMessage() {}
 
 func (x *RegisterInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[8]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,7 +835,7 @@ func (x *RegisterInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterInstanceResponse.ProtoReflect.Descriptor instead.
 func (*RegisterInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{8}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RegisterInstanceResponse) GetStatus() RegistrationStatus {
@@ -797,7 +877,7 @@ type ListInstancesRequest struct {
 func (x *ListInstancesRequest) Reset() {
 	*x = ListInstancesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[9]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -810,7 +890,7 @@ func (x *ListInstancesRequest) String() string {
 func (*ListInstancesRequest) ProtoMessage() {}
 
 func (x *ListInstancesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[9]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +903,7 @@ func (x *ListInstancesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInstancesRequest.ProtoReflect.Descriptor instead.
 func (*ListInstancesRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{9}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListInstancesRequest) GetPackage() string {
@@ -862,7 +942,7 @@ type ListInstancesResponse struct {
 func (x *ListInstancesResponse) Reset() {
 	*x = ListInstancesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[10]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -875,7 +955,7 @@ func (x *ListInstancesResponse) String() string {
 func (*ListInstancesResponse) ProtoMessage() {}
 
 func (x *ListInstancesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[10]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,7 +968,7 @@ func (x *ListInstancesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInstancesResponse.ProtoReflect.Descriptor instead.
 func (*ListInstancesResponse) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{10}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListInstancesResponse) GetInstances() []*Instance {
@@ -930,7 +1010,7 @@ type SearchInstancesRequest struct {
 func (x *SearchInstancesRequest) Reset() {
 	*x = SearchInstancesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[11]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -943,7 +1023,7 @@ func (x *SearchInstancesRequest) String() string {
 func (*SearchInstancesRequest) ProtoMessage() {}
 
 func (x *SearchInstancesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[11]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,7 +1036,7 @@ func (x *SearchInstancesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchInstancesRequest.ProtoReflect.Descriptor instead.
 func (*SearchInstancesRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{11}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SearchInstancesRequest) GetPackage() string {
@@ -1002,7 +1082,7 @@ type SearchInstancesResponse struct {
 func (x *SearchInstancesResponse) Reset() {
 	*x = SearchInstancesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[12]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1015,7 +1095,7 @@ func (x *SearchInstancesResponse) String() string {
 func (*SearchInstancesResponse) ProtoMessage() {}
 
 func (x *SearchInstancesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[12]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1028,7 +1108,7 @@ func (x *SearchInstancesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchInstancesResponse.ProtoReflect.Descriptor instead.
 func (*SearchInstancesResponse) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{12}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SearchInstancesResponse) GetInstances() []*Instance {
@@ -1045,6 +1125,149 @@ func (x *SearchInstancesResponse) GetNextPageToken() string {
 	return ""
 }
 
+type SearchFilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A glob to match file paths against (required).
+	//
+	// Uses path.Match syntax (e.g. "bin/*.exe") and is matched against
+	// slash-separated paths relative to the package root. Must either start with
+	// a literal prefix (e.g. "bin/*") or end with a literal file name (e.g.
+	// "*/gn").
+	Glob string `protobuf:"bytes,1,opt,name=glob,proto3" json:"glob,omitempty"`
+	// If set, only instances of packages under this prefix are returned.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Number of matches to return on one page, default is 100.
+	//
+	// The server may return fewer matches (and a non-empty 'next_page_token')
+	// if it visited a lot of non-matching files.
+	PageSize int32 `protobuf:"varint,20,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Value of 'next_page_token' from the previous response.
+	//
+	// Can be used to resume fetching.
+	PageToken string `protobuf:"bytes,21,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *SearchFilesRequest) Reset() {
+	*x = SearchFilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilesRequest) ProtoMessage() {}
+
+func (x *SearchFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilesRequest.ProtoReflect.Descriptor instead.
+func (*SearchFilesRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchFilesRequest) GetGlob() string {
+	if x != nil {
+		return x.Glob
+	}
+	return ""
+}
+
+func (x *SearchFilesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SearchFilesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchFilesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchFilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Matching files along with instances they belong to.
+	Matches []*SearchFilesResponse_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Value to pass as 'page_token' in SearchFilesRequest to resume fetching or
+	// empty string if there's no more results.
+	NextPageToken string `protobuf:"bytes,20,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *SearchFilesResponse) Reset() {
+	*x = SearchFilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilesResponse) ProtoMessage() {}
+
+func (x *SearchFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilesResponse.ProtoReflect.Descriptor instead.
+func (*SearchFilesResponse) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchFilesResponse) GetMatches() []*SearchFilesResponse_Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *SearchFilesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // Ref is a mutable named pointer to some package instance that can be used
 // as a version identifier.
 type Ref struct {
@@ -1067,7 +1290,7 @@ type Ref struct {
 func (x *Ref) Reset() {
 	*x = Ref{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[13]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1080,7 +1303,7 @@ func (x *Ref) String() string {
 func (*Ref) ProtoMessage() {}
 
 func (x *Ref) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[13]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1093,7 +1316,7 @@ func (x *Ref) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ref.ProtoReflect.Descriptor instead.
 func (*Ref) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{13}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Ref) GetName() string {
@@ -1145,7 +1368,7 @@ type DeleteRefRequest struct {
 func (x *DeleteRefRequest) Reset() {
 	*x = DeleteRefRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[14]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1158,7 +1381,7 @@ func (x *DeleteRefRequest) String() string {
 func (*DeleteRefRequest) ProtoMessage() {}
 
 func (x *DeleteRefRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[14]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1171,7 +1394,7 @@ func (x *DeleteRefRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRefRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRefRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{14}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeleteRefRequest) GetName() string {
@@ -1200,7 +1423,7 @@ type ListRefsRequest struct {
 func (x *ListRefsRequest) Reset() {
 	*x = ListRefsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[15]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1213,7 +1436,7 @@ func (x *ListRefsRequest) String() string {
 func (*ListRefsRequest) ProtoMessage() {}
 
 func (x *ListRefsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[15]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1226,7 +1449,7 @@ func (x *ListRefsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRefsRequest.ProtoReflect.Descriptor instead.
 func (*ListRefsRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{15}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListRefsRequest) GetPackage() string {
@@ -1248,7 +1471,7 @@ type ListRefsResponse struct {
 func (x *ListRefsResponse) Reset() {
 	*x = ListRefsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[16]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1261,7 +1484,7 @@ func (x *ListRefsResponse) String() string {
 func (*ListRefsResponse) ProtoMessage() {}
 
 func (x *ListRefsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[16]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,7 +1497,7 @@ func (x *ListRefsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRefsResponse.ProtoReflect.Descriptor instead.
 func (*ListRefsResponse) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{16}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListRefsResponse) GetRefs() []*Ref {
@@ -1310,7 +1533,7 @@ type Tag struct {
 func (x *Tag) Reset() {
 	*x = Tag{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[17]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1323,7 +1546,7 @@ func (x *Tag) String() string {
 func (*Tag) ProtoMessage() {}
 
 func (x *Tag) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[17]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1336,7 +1559,7 @@ func (x *Tag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tag.ProtoReflect.Descriptor instead.
 func (*Tag) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{17}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Tag) GetKey() string {
@@ -1383,7 +1606,7 @@ type AttachTagsRequest struct {
 func (x *AttachTagsRequest) Reset() {
 	*x = AttachTagsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[18]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1396,7 +1619,7 @@ func (x *AttachTagsRequest) String() string {
 func (*AttachTagsRequest) ProtoMessage() {}
 
 func (x *AttachTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[18]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1409,7 +1632,7 @@ func (x *AttachTagsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachTagsRequest.ProtoReflect.Descriptor instead.
 func (*AttachTagsRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{18}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *AttachTagsRequest) GetPackage() string {
@@ -1449,7 +1672,7 @@ type DetachTagsRequest struct {
 func (x *DetachTagsRequest) Reset() {
 	*x = DetachTagsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[19]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1462,7 +1685,7 @@ func (x *DetachTagsRequest) String() string {
 func (*DetachTagsRequest) ProtoMessage() {}
 
 func (x *DetachTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[19]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1475,7 +1698,7 @@ func (x *DetachTagsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetachTagsRequest.ProtoReflect.Descriptor instead.
 func (*DetachTagsRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{19}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DetachTagsRequest) GetPackage() string {
@@ -1499,6 +1722,357 @@ func (x *DetachTagsRequest) GetTags() []*Tag {
 	return nil
 }
 
+// InstanceMetadata is a key/value pair attached to some instance.
+//
+// Keys don't have to be unique, only the full pair should. For example,
+// it is fine to have two "signature" entries with different values attached
+// to the same instance.
+type InstanceMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Key should be a lowercase identifier-like string ([a-z0-9_\-]+), at most
+	// 400 bytes long.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Value is an arbitrary byte blob, at most 512 KB long.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Content type of the value, e.g. "application/json" (optional).
+	//
+	// It is FYI only, the backend doesn't interpret it.
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Fingerprint identifies this entry (output only).
+	//
+	// It is calculated as a hex-encoded truncated SHA256 of "<key>:<value>".
+	Fingerprint string `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// User that attached this entry (output only).
+	AttachedBy string `protobuf:"bytes,5,opt,name=attached_by,json=attachedBy,proto3" json:"attached_by,omitempty"`
+	// When the entry was attached (output only).
+	AttachedTs *timestamp.Timestamp `protobuf:"bytes,6,opt,name=attached_ts,json=attachedTs,proto3" json:"attached_ts,omitempty"`
+}
+
+func (x *InstanceMetadata) Reset() {
+	*x = InstanceMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceMetadata) ProtoMessage() {}
+
+func (x *InstanceMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceMetadata.ProtoReflect.Descriptor instead.
+func (*InstanceMetadata) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InstanceMetadata) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *InstanceMetadata) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *InstanceMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *InstanceMetadata) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *InstanceMetadata) GetAttachedBy() string {
+	if x != nil {
+		return x.AttachedBy
+	}
+	return ""
+}
+
+func (x *InstanceMetadata) GetAttachedTs() *timestamp.Timestamp {
+	if x != nil {
+		return x.AttachedTs
+	}
+	return nil
+}
+
+type AttachMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The package that holds the instance we attach metadata to.
+	Package string `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	// The instance we attach metadata to.
+	Instance *ObjectRef `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	// One or more metadata entries to attach (order doesn't matter).
+	//
+	// Only key, value and content_type fields are used.
+	Metadata []*InstanceMetadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *AttachMetadataRequest) Reset() {
+	*x = AttachMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachMetadataRequest) ProtoMessage() {}
+
+func (x *AttachMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachMetadataRequest.ProtoReflect.Descriptor instead.
+func (*AttachMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AttachMetadataRequest) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *AttachMetadataRequest) GetInstance() *ObjectRef {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *AttachMetadataRequest) GetMetadata() []*InstanceMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type DetachMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The package that holds the instance we detach metadata from.
+	Package string `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	// The instance we detach metadata from.
+	Instance *ObjectRef `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Entries to detach: only 'fingerprint' or 'key' and 'value' fields are used.
+	Metadata []*InstanceMetadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *DetachMetadataRequest) Reset() {
+	*x = DetachMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetachMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachMetadataRequest) ProtoMessage() {}
+
+func (x *DetachMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachMetadataRequest.ProtoReflect.Descriptor instead.
+func (*DetachMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DetachMetadataRequest) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *DetachMetadataRequest) GetInstance() *ObjectRef {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *DetachMetadataRequest) GetMetadata() []*InstanceMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ListMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The package that holds the instance we list metadata of.
+	Package string `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	// The instance to list metadata of.
+	Instance *ObjectRef `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Metadata keys to limit the listing to or empty to list all metadata.
+	Keys []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *ListMetadataRequest) Reset() {
+	*x = ListMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetadataRequest) ProtoMessage() {}
+
+func (x *ListMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetadataRequest.ProtoReflect.Descriptor instead.
+func (*ListMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListMetadataRequest) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *ListMetadataRequest) GetInstance() *ObjectRef {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *ListMetadataRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type ListMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Discovered metadata ordered by 'attached_ts' (the most recent first).
+	Metadata []*InstanceMetadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *ListMetadataResponse) Reset() {
+	*x = ListMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetadataResponse) ProtoMessage() {}
+
+func (x *ListMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetadataResponse.ProtoReflect.Descriptor instead.
+func (*ListMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListMetadataResponse) GetMetadata() []*InstanceMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 type ResolveVersionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1513,7 +2087,7 @@ type ResolveVersionRequest struct {
 func (x *ResolveVersionRequest) Reset() {
 	*x = ResolveVersionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[20]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1526,7 +2100,7 @@ func (x *ResolveVersionRequest) String() string {
 func (*ResolveVersionRequest) ProtoMessage() {}
 
 func (x *ResolveVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[20]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1539,7 +2113,7 @@ func (x *ResolveVersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveVersionRequest.ProtoReflect.Descriptor instead.
 func (*ResolveVersionRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{20}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ResolveVersionRequest) GetPackage() string {
@@ -1570,7 +2144,7 @@ type GetInstanceURLRequest struct {
 func (x *GetInstanceURLRequest) Reset() {
 	*x = GetInstanceURLRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[21]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1583,7 +2157,7 @@ func (x *GetInstanceURLRequest) String() string {
 func (*GetInstanceURLRequest) ProtoMessage() {}
 
 func (x *GetInstanceURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[21]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1596,7 +2170,7 @@ func (x *GetInstanceURLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInstanceURLRequest.ProtoReflect.Descriptor instead.
 func (*GetInstanceURLRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{21}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetInstanceURLRequest) GetPackage() string {
@@ -1635,7 +2209,7 @@ type Processor struct {
 func (x *Processor) Reset() {
 	*x = Processor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[22]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1648,7 +2222,7 @@ func (x *Processor) String() string {
 func (*Processor) ProtoMessage() {}
 
 func (x *Processor) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[22]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1661,7 +2235,7 @@ func (x *Processor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Processor.ProtoReflect.Descriptor instead.
 func (*Processor) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{22}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *Processor) GetId() string {
@@ -1719,7 +2293,7 @@ type DescribeInstanceRequest struct {
 func (x *DescribeInstanceRequest) Reset() {
 	*x = DescribeInstanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[23]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1732,7 +2306,7 @@ func (x *DescribeInstanceRequest) String() string {
 func (*DescribeInstanceRequest) ProtoMessage() {}
 
 func (x *DescribeInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[23]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1745,7 +2319,7 @@ func (x *DescribeInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeInstanceRequest.ProtoReflect.Descriptor instead.
 func (*DescribeInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{23}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DescribeInstanceRequest) GetPackage() string {
@@ -1810,7 +2384,7 @@ type DescribeInstanceResponse struct {
 func (x *DescribeInstanceResponse) Reset() {
 	*x = DescribeInstanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[24]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1823,7 +2397,7 @@ func (x *DescribeInstanceResponse) String() string {
 func (*DescribeInstanceResponse) ProtoMessage() {}
 
 func (x *DescribeInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[24]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1836,7 +2410,7 @@ func (x *DescribeInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeInstanceResponse.ProtoReflect.Descriptor instead.
 func (*DescribeInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{24}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *DescribeInstanceResponse) GetInstance() *Instance {
@@ -1883,7 +2457,7 @@ type DescribeClientRequest struct {
 func (x *DescribeClientRequest) Reset() {
 	*x = DescribeClientRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[25]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1896,7 +2470,7 @@ func (x *DescribeClientRequest) String() string {
 func (*DescribeClientRequest) ProtoMessage() {}
 
 func (x *DescribeClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[25]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1909,7 +2483,7 @@ func (x *DescribeClientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeClientRequest.ProtoReflect.Descriptor instead.
 func (*DescribeClientRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{25}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *DescribeClientRequest) GetPackage() string {
@@ -1969,7 +2543,7 @@ type DescribeClientResponse struct {
 func (x *DescribeClientResponse) Reset() {
 	*x = DescribeClientResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[26]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1982,7 +2556,7 @@ func (x *DescribeClientResponse) String() string {
 func (*DescribeClientResponse) ProtoMessage() {}
 
 func (x *DescribeClientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[26]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1995,7 +2569,7 @@ func (x *DescribeClientResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeClientResponse.ProtoReflect.Descriptor instead.
 func (*DescribeClientResponse) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{26}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DescribeClientResponse) GetInstance() *Instance {
@@ -2056,7 +2630,7 @@ type PrefixMetadata_ACL struct {
 func (x *PrefixMetadata_ACL) Reset() {
 	*x = PrefixMetadata_ACL{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[27]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2069,7 +2643,7 @@ func (x *PrefixMetadata_ACL) String() string {
 func (*PrefixMetadata_ACL) ProtoMessage() {}
 
 func (x *PrefixMetadata_ACL) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[27]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2110,7 +2684,7 @@ type RolesInPrefixResponse_RoleInPrefix struct {
 func (x *RolesInPrefixResponse_RoleInPrefix) Reset() {
 	*x = RolesInPrefixResponse_RoleInPrefix{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[28]
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2123,7 +2697,7 @@ func (x *RolesInPrefixResponse_RoleInPrefix) String() string {
 func (*RolesInPrefixResponse_RoleInPrefix) ProtoMessage() {}
 
 func (x *RolesInPrefixResponse_RoleInPrefix) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[28]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2136,7 +2710,7 @@ func (x *RolesInPrefixResponse_RoleInPrefix) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RolesInPrefixResponse_RoleInPrefix.ProtoReflect.Descriptor instead.
 func (*RolesInPrefixResponse_RoleInPrefix) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{3, 0}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *RolesInPrefixResponse_RoleInPrefix) GetRole() Role {
@@ -2146,13 +2720,90 @@ func (x *RolesInPrefixResponse_RoleInPrefix) GetRole() Role {
 	return Role_ROLE_UNSPECIFIED
 }
 
+type SearchFilesResponse_Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The package instance that contains the file.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Slash-separated path of the file relative to the package root.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// SHA256 hash of the file body.
+	Hash *ObjectRef `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// The file size in bytes.
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *SearchFilesResponse_Match) Reset() {
+	*x = SearchFilesResponse_Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchFilesResponse_Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilesResponse_Match) ProtoMessage() {}
+
+func (x *SearchFilesResponse_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilesResponse_Match.ProtoReflect.Descriptor instead.
+func (*SearchFilesResponse_Match) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *SearchFilesResponse_Match) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *SearchFilesResponse_Match) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SearchFilesResponse_Match) GetHash() *ObjectRef {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *SearchFilesResponse_Match) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
 var File_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDesc = []byte{
 	0x0a, 0x30, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
 	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f,
 	0x63, 0x69, 0x70, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x04, 0x63, 0x69, 0x70, 0x64, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x74, 0x6f, 0x12, 0x04, 0x63, 0x69, 0x70, 0x64, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72,
@@ -2163,7 +2814,7 @@ var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDesc = []byte{
 	0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x27, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x9d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0xdf,
 	0x02, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
 	0x61, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e,
@@ -2177,317 +2828,416 @@ var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDesc = []byte{
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x63, 0x6c,
 	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50,
 	0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x43,
-	0x4c, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x1a, 0x45, 0x0a, 0x03, 0x41, 0x43, 0x4c, 0x12, 0x1e,
-	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x63,
-	0x69, 0x70, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x22, 0x5f,
-	0x0a, 0x17, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x13, 0x70, 0x65, 0x72,
-	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x70, 0x65,
-	0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x87, 0x01, 0x0a, 0x15, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x72, 0x6f, 0x6c,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
-	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x2e, 0x0a, 0x0c, 0x52, 0x6f, 0x6c,
-	0x65, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52,
-	0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x70, 0x0a, 0x11, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73,
-	0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72,
-	0x73, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
-	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x4c, 0x0a, 0x12, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x0e, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3f,
-	0x0a, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x73, 0x22,
-	0xac, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63,
-	0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a,
-	0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x75, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x63, 0x69, 0x70, 0x64, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x22, 0x6c,
-	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x4c, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x12, 0x40, 0x0a, 0x10, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x45, 0x0a, 0x03, 0x41, 0x43, 0x4c,
+	0x12, 0x1e, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a,
+	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73,
+	0x22, 0x8c, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x75, 0x6e, 0x74, 0x61, 0x67, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x10, 0x6b, 0x65, 0x65, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x6e, 0x74, 0x61, 0x67, 0x67,
+	0x65, 0x64, 0x12, 0x4b, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x6d, 0x61, 0x78,
+	0x55, 0x6e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x67, 0x65, 0x22,
+	0x5f, 0x0a, 0x17, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x13, 0x70, 0x65,
+	0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x70,
+	0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x87, 0x01, 0x0a, 0x15, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x69, 0x70, 0x64,
+	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x2e, 0x0a, 0x0c, 0x52, 0x6f,
+	0x6c, 0x65, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x70, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75,
+	0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x4c, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x0e, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
+	0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x73,
+	0x22, 0xac, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x22,
+	0x6c, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6d, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8d, 0x01, 0x0a,
+	0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
 	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x14, 0x20,
 	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
 	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6d, 0x0a, 0x15,
-	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
-	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x16,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6f, 0x0a, 0x17,
 	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x12, 0x1d, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09,
-	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x69, 0x70,
+	0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7c, 0x0a,
+	0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
 	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x14, 0x20, 0x01,
 	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
 	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6f, 0x0a, 0x17, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64,
-	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
-	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbe, 0x01, 0x0a,
-	0x03, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79,
-	0x12, 0x3b, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x54, 0x73, 0x22, 0x40, 0x0a,
-	0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xfb, 0x01, 0x0a, 0x13,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x80, 0x01, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x2a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x23, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x03, 0x52, 0x65,
+	0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22,
-	0x2b, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0x31, 0x0a, 0x10,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1d, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09,
-	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x22,
-	0x8b, 0x01, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x42, 0x79,
-	0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12,
+	0x2b, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x66, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3b, 0x0a,
+	0x0b, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x54, 0x73, 0x22, 0x40, 0x0a, 0x10, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0x31, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x63, 0x69,
+	0x70, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x22, 0x8b, 0x01, 0x0a,
+	0x03, 0x54, 0x61, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3b, 0x0a,
+	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x54, 0x73, 0x22, 0x79, 0x0a, 0x11, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69,
+	0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x08, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x54, 0x61, 0x67, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x79, 0x0a, 0x11, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x22, 0xdd, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x54, 0x73,
+	0x22, 0x92, 0x01, 0x0a, 0x15, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x92, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69,
+	0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x08, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x70, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x4a, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70,
+	0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x94, 0x02, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x54, 0x73, 0x22, 0x79, 0x0a,
-	0x11, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x54,
-	0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x79, 0x0a, 0x11, 0x44, 0x65, 0x74, 0x61,
-	0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64,
-	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74,
-	0x61, 0x67, 0x73, 0x22, 0x4b, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55,
-	0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x22, 0x94, 0x02, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x66,
-	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x46, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
-	0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46,
-	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x22, 0xdb, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
-	0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x66, 0x73, 0x12,
-	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x54, 0x61, 0x67, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x12, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x6f, 0x72, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d,
-	0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x63,
-	0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x12, 0x1d, 0x0a,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x63, 0x69,
-	0x70, 0x64, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x2f, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f,
-	0x72, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x22, 0x5e, 0x0a,
-	0x15, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x12, 0x2b, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x66, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xab, 0x02,
-	0x0a, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x69, 0x70,
-	0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72,
-	0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x66, 0x12, 0x34, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x62,
-	0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69,
-	0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x0c, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
-	0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x73, 0x68, 0x61, 0x31, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x53, 0x68, 0x61, 0x31, 0x12, 0x3d, 0x0a, 0x12,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73,
-	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x66, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x2a, 0x3f, 0x0a, 0x04, 0x52,
-	0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x41,
-	0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x10,
-	0x02, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10, 0x03, 0x2a, 0x73, 0x0a, 0x12,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x47, 0x49, 0x53,
-	0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x4c, 0x52, 0x45, 0x41,
-	0x44, 0x59, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12,
-	0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x44, 0x10,
-	0x03, 0x32, 0xc7, 0x0a, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x69, 0x70,
-	0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x50, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64,
-	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x13,
-	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x68, 0x65, 0x72,
-	0x69, 0x74, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x42, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x2e, 0x63, 0x69, 0x70,
-	0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x1a, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c,
-	0x65, 0x73, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x70,
+	0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x73, 0x12, 0x2f, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x46, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a,
+	0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x22, 0xdb, 0x01, 0x0a,
+	0x17, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x66, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x18, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x72, 0x65,
+	0x66, 0x73, 0x12, 0x1d, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x73, 0x22, 0x5e, 0x0a, 0x15, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x22, 0xab, 0x02, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x12, 0x34, 0x0a, 0x0d, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x52,
+	0x4c, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x73, 0x68, 0x61, 0x31, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x53, 0x68, 0x61,
+	0x31, 0x12, 0x3d, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x5f,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x10,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+	0x2a, 0x3f, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x52,
+	0x49, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10,
+	0x03, 0x2a, 0x73, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x47, 0x49, 0x53,
+	0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x55, 0x50, 0x4c, 0x4f,
+	0x41, 0x44, 0x45, 0x44, 0x10, 0x03, 0x32, 0xe0, 0x0c, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x70,
 	0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x50, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x17, 0x2e, 0x63, 0x69, 0x70,
-	0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
-	0x0b, 0x48, 0x69, 0x64, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x63,
-	0x69, 0x70, 0x64, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0d, 0x55, 0x6e,
-	0x68, 0x69, 0x64, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x69,
-	0x70, 0x64, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x69, 0x70,
-	0x64, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x2e, 0x63,
-	0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x1e, 0x2e, 0x63,
-	0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d,
-	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x2e,
-	0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x69, 0x70, 0x64,
-	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x66, 0x12, 0x09, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x66, 0x12, 0x16, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x12,
-	0x15, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
-	0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x12, 0x17, 0x2e, 0x63,
-	0x69, 0x70, 0x64, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x50, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65,
+	0x72, 0x69, 0x74, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
+	0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x42, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x13, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x73, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x17, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x48, 0x69, 0x64, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x12, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x3d, 0x0a, 0x0d, 0x55, 0x6e, 0x68, 0x69, 0x64, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a,
-	0x0a, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x69,
-	0x70, 0x64, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42, 0x0a,
+	0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x1a, 0x1e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1c,
+	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63,
+	0x69, 0x70, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x63, 0x69, 0x70,
+	0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x12, 0x09, 0x2e, 0x63,
+	0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x3b, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x66, 0x12, 0x16, 0x2e, 0x63,
+	0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x08,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x12, 0x15, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x54, 0x61, 0x67, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0a, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
+	0x54, 0x61, 0x67, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x74, 0x61,
+	0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0e,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
-	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x69,
-	0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x1b, 0x2e,
-	0x63, 0x69, 0x70, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x69, 0x70,
-	0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x51, 0x0a, 0x10, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x1d, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
-	0x0a, 0x0e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x12, 0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x63, 0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67,
-	0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c,
-	0x75, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x70,
-	0x64, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0e,
+	0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b,
+	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x63,
+	0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x1b, 0x2e, 0x63, 0x69,
+	0x70, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x52,
+	0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x51, 0x0a, 0x10, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x2e,
+	0x63, 0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63,
+	0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1b,
+	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x69,
+	0x70, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x6f, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63,
+	0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f,
+	0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2503,129 +3253,159 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP() []byte
 }
 
 var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_goTypes = []interface{}{
 	(Role)(0),                                  // 0: cipd.Role
 	(RegistrationStatus)(0),                    // 1: cipd.RegistrationStatus
 	(Processor_State)(0),                       // 2: cipd.Processor.State
 	(*PrefixRequest)(nil),                      // 3: cipd.PrefixRequest
 	(*PrefixMetadata)(nil),                     // 4: cipd.PrefixMetadata
-	(*InheritedPrefixMetadata)(nil),            // 5: cipd.InheritedPrefixMetadata
-	(*RolesInPrefixResponse)(nil),              // 6: cipd.RolesInPrefixResponse
-	(*ListPrefixRequest)(nil),                  // 7: cipd.ListPrefixRequest
-	(*ListPrefixResponse)(nil),                 // 8: cipd.ListPrefixResponse
-	(*PackageRequest)(nil),                     // 9: cipd.PackageRequest
-	(*Instance)(nil),                           // 10: cipd.Instance
-	(*RegisterInstanceResponse)(nil),           // 11: cipd.RegisterInstanceResponse
-	(*ListInstancesRequest)(nil),               // 12: cipd.ListInstancesRequest
-	(*ListInstancesResponse)(nil),              // 13: cipd.ListInstancesResponse
-	(*SearchInstancesRequest)(nil),             // 14: cipd.SearchInstancesRequest
-	(*SearchInstancesResponse)(nil),            // 15: cipd.SearchInstancesResponse
-	(*Ref)(nil),                                // 16: cipd.Ref
-	(*DeleteRefRequest)(nil),                   // 17: cipd.DeleteRefRequest
-	(*ListRefsRequest)(nil),                    // 18: cipd.ListRefsRequest
-	(*ListRefsResponse)(nil),                   // 19: cipd.ListRefsResponse
-	(*Tag)(nil),                                // 20: cipd.Tag
-	(*AttachTagsRequest)(nil),                  // 21: cipd.AttachTagsRequest
-	(*DetachTagsRequest)(nil),                  // 22: cipd.DetachTagsRequest
-	(*ResolveVersionRequest)(nil),              // 23: cipd.ResolveVersionRequest
-	(*GetInstanceURLRequest)(nil),              // 24: cipd.GetInstanceURLRequest
-	(*Processor)(nil),                          // 25: cipd.Processor
-	(*DescribeInstanceRequest)(nil),            // 26: cipd.DescribeInstanceRequest
-	(*DescribeInstanceResponse)(nil),           // 27: cipd.DescribeInstanceResponse
-	(*DescribeClientRequest)(nil),              // 28: cipd.DescribeClientRequest
-	(*DescribeClientResponse)(nil),             // 29: cipd.DescribeClientResponse
-	(*PrefixMetadata_ACL)(nil),                 // 30: cipd.PrefixMetadata.ACL
-	(*RolesInPrefixResponse_RoleInPrefix)(nil), // 31: cipd.RolesInPrefixResponse.RoleInPrefix
-	(*timestamp.Timestamp)(nil),                // 32: google.protobuf.Timestamp
-	(*ObjectRef)(nil),                          // 33: cipd.ObjectRef
-	(*UploadOperation)(nil),                    // 34: cipd.UploadOperation
-	(*_struct.Struct)(nil),                     // 35: google.protobuf.Struct
-	(*ObjectURL)(nil),                          // 36: cipd.ObjectURL
-	(*empty.Empty)(nil),                        // 37: google.protobuf.Empty
+	(*RetentionPolicy)(nil),                    // 5: cipd.RetentionPolicy
+	(*InheritedPrefixMetadata)(nil),            // 6: cipd.InheritedPrefixMetadata
+	(*RolesInPrefixResponse)(nil),              // 7: cipd.RolesInPrefixResponse
+	(*ListPrefixRequest)(nil),                  // 8: cipd.ListPrefixRequest
+	(*ListPrefixResponse)(nil),                 // 9: cipd.ListPrefixResponse
+	(*PackageRequest)(nil),                     // 10: cipd.PackageRequest
+	(*Instance)(nil),                           // 11: cipd.Instance
+	(*RegisterInstanceResponse)(nil),           // 12: cipd.RegisterInstanceResponse
+	(*ListInstancesRequest)(nil),               // 13: cipd.ListInstancesRequest
+	(*ListInstancesResponse)(nil),              // 14: cipd.ListInstancesResponse
+	(*SearchInstancesRequest)(nil),             // 15: cipd.SearchInstancesRequest
+	(*SearchInstancesResponse)(nil),            // 16: cipd.SearchInstancesResponse
+	(*SearchFilesRequest)(nil),                 // 17: cipd.SearchFilesRequest
+	(*SearchFilesResponse)(nil),                // 18: cipd.SearchFilesResponse
+	(*Ref)(nil),                                // 19: cipd.Ref
+	(*DeleteRefRequest)(nil),                   // 20: cipd.DeleteRefRequest
+	(*ListRefsRequest)(nil),                    // 21: cipd.ListRefsRequest
+	(*ListRefsResponse)(nil),                   // 22: cipd.ListRefsResponse
+	(*Tag)(nil),                                // 23: cipd.Tag
+	(*AttachTagsRequest)(nil),                  // 24: cipd.AttachTagsRequest
+	(*DetachTagsRequest)(nil),                  // 25: cipd.DetachTagsRequest
+	(*InstanceMetadata)(nil),                   // 26: cipd.InstanceMetadata
+	(*AttachMetadataRequest)(nil),              // 27: cipd.AttachMetadataRequest
+	(*DetachMetadataRequest)(nil),              // 28: cipd.DetachMetadataRequest
+	(*ListMetadataRequest)(nil),                // 29: cipd.ListMetadataRequest
+	(*ListMetadataResponse)(nil),               // 30: cipd.ListMetadataResponse
+	(*ResolveVersionRequest)(nil),              // 31: cipd.ResolveVersionRequest
+	(*GetInstanceURLRequest)(nil),              // 32: cipd.GetInstanceURLRequest
+	(*Processor)(nil),                          // 33: cipd.Processor
+	(*DescribeInstanceRequest)(nil),            // 34: cipd.DescribeInstanceRequest
+	(*DescribeInstanceResponse)(nil),           // 35: cipd.DescribeInstanceResponse
+	(*DescribeClientRequest)(nil),              // 36: cipd.DescribeClientRequest
+	(*DescribeClientResponse)(nil),             // 37: cipd.DescribeClientResponse
+	(*PrefixMetadata_ACL)(nil),                 // 38: cipd.PrefixMetadata.ACL
+	(*RolesInPrefixResponse_RoleInPrefix)(nil), // 39: cipd.RolesInPrefixResponse.RoleInPrefix
+	(*SearchFilesResponse_Match)(nil),          // 40: cipd.SearchFilesResponse.Match
+	(*timestamp.Timestamp)(nil),                // 41: google.protobuf.Timestamp
+	(*duration.Duration)(nil),                  // 42: google.protobuf.Duration
+	(*ObjectRef)(nil),                          // 43: cipd.ObjectRef
+	(*UploadOperation)(nil),                    // 44: cipd.UploadOperation
+	(*_struct.Struct)(nil),                     // 45: google.protobuf.Struct
+	(*ObjectURL)(nil),                          // 46: cipd.ObjectURL
+	(*empty.Empty)(nil),                        // 47: google.protobuf.Empty
 }
 var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_depIdxs = []int32{
-	32, // 0: cipd.PrefixMetadata.update_time:type_name -> google.protobuf.Timestamp
-	30, // 1: cipd.PrefixMetadata.acls:type_name -> cipd.PrefixMetadata.ACL
-	4,  // 2: cipd.InheritedPrefixMetadata.per_prefix_metadata:type_name -> cipd.PrefixMetadata
-	31, // 3: cipd.RolesInPrefixResponse.roles:type_name -> cipd.RolesInPrefixResponse.RoleInPrefix
-	33, // 4: cipd.Instance.instance:type_name -> cipd.ObjectRef
-	32, // 5: cipd.Instance.registered_ts:type_name -> google.protobuf.Timestamp
-	1,  // 6: cipd.RegisterInstanceResponse.status:type_name -> cipd.RegistrationStatus
-	10, // 7: cipd.RegisterInstanceResponse.instance:type_name -> cipd.Instance
-	34, // 8: cipd.RegisterInstanceResponse.upload_op:type_name -> cipd.UploadOperation
-	10, // 9: cipd.ListInstancesResponse.instances:type_name -> cipd.Instance
-	20, // 10: cipd.SearchInstancesRequest.tags:type_name -> cipd.Tag
-	10, // 11: cipd.SearchInstancesResponse.instances:type_name -> cipd.Instance
-	33, // 12: cipd.Ref.instance:type_name -> cipd.ObjectRef
-	32, // 13: cipd.Ref.modified_ts:type_name -> google.protobuf.Timestamp
-	16, // 14: cipd.ListRefsResponse.refs:type_name -> cipd.Ref
-	32, // 15: cipd.Tag.attached_ts:type_name -> google.protobuf.Timestamp
-	33, // 16: cipd.AttachTagsRequest.instance:type_name -> cipd.ObjectRef
-	20, // 17: cipd.AttachTagsRequest.tags:type_name -> cipd.Tag
-	33, // 18: cipd.DetachTagsRequest.instance:type_name -> cipd.ObjectRef
-	20, // 19: cipd.DetachTagsRequest.tags:type_name -> cipd.Tag
-	33, // 20: cipd.GetInstanceURLRequest.instance:type_name -> cipd.ObjectRef
-	2,  // 21: cipd.Processor.state:type_name -> cipd.Processor.State
-	32, // 22: cipd.Processor.finished_ts:type_name -> google.protobuf.Timestamp
-	35, // 23: cipd.Processor.result:type_name -> google.protobuf.Struct
-	33, // 24: cipd.DescribeInstanceRequest.instance:type_name -> cipd.ObjectRef
-	10, // 25: cipd.DescribeInstanceResponse.instance:type_name -> cipd.Instance
-	16, // 26: cipd.DescribeInstanceResponse.refs:type_name -> cipd.Ref
-	20, // 27: cipd.DescribeInstanceResponse.tags:type_name -> cipd.Tag
-	25, // 28: cipd.DescribeInstanceResponse.processors:type_name -> cipd.Processor
-	33, // 29: cipd.DescribeClientRequest.instance:type_name -> cipd.ObjectRef
-	10, // 30: cipd.DescribeClientResponse.instance:type_name -> cipd.Instance
-	33, // 31: cipd.DescribeClientResponse.client_ref:type_name -> cipd.ObjectRef
-	36, // 32: cipd.DescribeClientResponse.client_binary:type_name -> cipd.ObjectURL
-	33, // 33: cipd.DescribeClientResponse.client_ref_aliases:type_name -> cipd.ObjectRef
-	0,  // 34: cipd.PrefixMetadata.ACL.role:type_name -> cipd.Role
-	0,  // 35: cipd.RolesInPrefixResponse.RoleInPrefix.role:type_name -> cipd.Role
-	3,  // 36: cipd.Repository.GetPrefixMetadata:input_type -> cipd.PrefixRequest
-	3,  // 37: cipd.Repository.GetInheritedPrefixMetadata:input_type -> cipd.PrefixRequest
-	4,  // 38: cipd.Repository.UpdatePrefixMetadata:input_type -> cipd.PrefixMetadata
-	3,  // 39: cipd.Repository.GetRolesInPrefix:input_type -> cipd.PrefixRequest
-	7,  // 40: cipd.Repository.ListPrefix:input_type -> cipd.ListPrefixRequest
-	9,  // 41: cipd.Repository.HidePackage:input_type -> cipd.PackageRequest
-	9,  // 42: cipd.Repository.UnhidePackage:input_type -> cipd.PackageRequest
-	9,  // 43: cipd.Repository.DeletePackage:input_type -> cipd.PackageRequest
-	10, // 44: cipd.Repository.RegisterInstance:input_type -> cipd.Instance
-	12, // 45: cipd.Repository.ListInstances:input_type -> cipd.ListInstancesRequest
-	14, // 46: cipd.Repository.SearchInstances:input_type -> cipd.SearchInstancesRequest
-	16, // 47: cipd.Repository.CreateRef:input_type -> cipd.Ref
-	17, // 48: cipd.Repository.DeleteRef:input_type -> cipd.DeleteRefRequest
-	18, // 49: cipd.Repository.ListRefs:input_type -> cipd.ListRefsRequest
-	21, // 50: cipd.Repository.AttachTags:input_type -> cipd.AttachTagsRequest
-	22, // 51: cipd.Repository.DetachTags:input_type -> cipd.DetachTagsRequest
-	23, // 52: cipd.Repository.ResolveVersion:input_type -> cipd.ResolveVersionRequest
-	24, // 53: cipd.Repository.GetInstanceURL:input_type -> cipd.GetInstanceURLRequest
-	26, // 54: cipd.Repository.DescribeInstance:input_type -> cipd.DescribeInstanceRequest
-	28, // 55: cipd.Repository.DescribeClient:input_type -> cipd.DescribeClientRequest
-	4,  // 56: cipd.Repository.GetPrefixMetadata:output_type -> cipd.PrefixMetadata
-	5,  // 57: cipd.Repository.GetInheritedPrefixMetadata:output_type -> cipd.InheritedPrefixMetadata
-	4,  // 58: cipd.Repository.UpdatePrefixMetadata:output_type -> cipd.PrefixMetadata
-	6,  // 59: cipd.Repository.GetRolesInPrefix:output_type -> cipd.RolesInPrefixResponse
-	8,  // 60: cipd.Repository.ListPrefix:output_type -> cipd.ListPrefixResponse
-	37, // 61: cipd.Repository.HidePackage:output_type -> google.protobuf.Empty
-	37, // 62: cipd.Repository.UnhidePackage:output_type -> google.protobuf.Empty
-	37, // 63: cipd.Repository.DeletePackage:output_type -> google.protobuf.Empty
-	11, // 64: cipd.Repository.RegisterInstance:output_type -> cipd.RegisterInstanceResponse
-	13, // 65: cipd.Repository.ListInstances:output_type -> cipd.ListInstancesResponse
-	15, // 66: cipd.Repository.SearchInstances:output_type -> cipd.SearchInstancesResponse
-	37, // 67: cipd.Repository.CreateRef:output_type -> google.protobuf.Empty
-	37, // 68: cipd.Repository.DeleteRef:output_type -> google.protobuf.Empty
-	19, // 69: cipd.Repository.ListRefs:output_type -> cipd.ListRefsResponse
-	37, // 70: cipd.Repository.AttachTags:output_type -> google.protobuf.Empty
-	37, // 71: cipd.Repository.DetachTags:output_type -> google.protobuf.Empty
-	10, // 72: cipd.Repository.ResolveVersion:output_type -> cipd.Instance
-	36, // 73: cipd.Repository.GetInstanceURL:output_type -> cipd.ObjectURL
-	27, // 74: cipd.Repository.DescribeInstance:output_type -> cipd.DescribeInstanceResponse
-	29, // 75: cipd.Repository.DescribeClient:output_type -> cipd.DescribeClientResponse
-	56, // [56:76] is the sub-list for method output_type
-	36, // [36:56] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	41, // 0: cipd.PrefixMetadata.update_time:type_name -> google.protobuf.Timestamp
+	38, // 1: cipd.PrefixMetadata.acls:type_name -> cipd.PrefixMetadata.ACL
+	5,  // 2: cipd.PrefixMetadata.retention_policy:type_name -> cipd.RetentionPolicy
+	42, // 3: cipd.RetentionPolicy.max_unreferenced_age:type_name -> google.protobuf.Duration
+	4,  // 4: cipd.InheritedPrefixMetadata.per_prefix_metadata:type_name -> cipd.PrefixMetadata
+	39, // 5: cipd.RolesInPrefixResponse.roles:type_name -> cipd.RolesInPrefixResponse.RoleInPrefix
+	43, // 6: cipd.Instance.instance:type_name -> cipd.ObjectRef
+	41, // 7: cipd.Instance.registered_ts:type_name -> google.protobuf.Timestamp
+	1,  // 8: cipd.RegisterInstanceResponse.status:type_name -> cipd.RegistrationStatus
+	11, // 9: cipd.RegisterInstanceResponse.instance:type_name -> cipd.Instance
+	44, // 10: cipd.RegisterInstanceResponse.upload_op:type_name -> cipd.UploadOperation
+	11, // 11: cipd.ListInstancesResponse.instances:type_name -> cipd.Instance
+	23, // 12: cipd.SearchInstancesRequest.tags:type_name -> cipd.Tag
+	11, // 13: cipd.SearchInstancesResponse.instances:type_name -> cipd.Instance
+	40, // 14: cipd.SearchFilesResponse.matches:type_name -> cipd.SearchFilesResponse.Match
+	43, // 15: cipd.Ref.instance:type_name -> cipd.ObjectRef
+	41, // 16: cipd.Ref.modified_ts:type_name -> google.protobuf.Timestamp
+	19, // 17: cipd.ListRefsResponse.refs:type_name -> cipd.Ref
+	41, // 18: cipd.Tag.attached_ts:type_name -> google.protobuf.Timestamp
+	43, // 19: cipd.AttachTagsRequest.instance:type_name -> cipd.ObjectRef
+	23, // 20: cipd.AttachTagsRequest.tags:type_name -> cipd.Tag
+	43, // 21: cipd.DetachTagsRequest.instance:type_name -> cipd.ObjectRef
+	23, // 22: cipd.DetachTagsRequest.tags:type_name -> cipd.Tag
+	41, // 23: cipd.InstanceMetadata.attached_ts:type_name -> google.protobuf.Timestamp
+	43, // 24: cipd.AttachMetadataRequest.instance:type_name -> cipd.ObjectRef
+	26, // 25: cipd.AttachMetadataRequest.metadata:type_name -> cipd.InstanceMetadata
+	43, // 26: cipd.DetachMetadataRequest.instance:type_name -> cipd.ObjectRef
+	26, // 27: cipd.DetachMetadataRequest.metadata:type_name -> cipd.InstanceMetadata
+	43, // 28: cipd.ListMetadataRequest.instance:type_name -> cipd.ObjectRef
+	26, // 29: cipd.ListMetadataResponse.metadata:type_name -> cipd.InstanceMetadata
+	43, // 30: cipd.GetInstanceURLRequest.instance:type_name -> cipd.ObjectRef
+	2,  // 31: cipd.Processor.state:type_name -> cipd.Processor.State
+	41, // 32: cipd.Processor.finished_ts:type_name -> google.protobuf.Timestamp
+	45, // 33: cipd.Processor.result:type_name -> google.protobuf.Struct
+	43, // 34: cipd.DescribeInstanceRequest.instance:type_name -> cipd.ObjectRef
+	11, // 35: cipd.DescribeInstanceResponse.instance:type_name -> cipd.Instance
+	19, // 36: cipd.DescribeInstanceResponse.refs:type_name -> cipd.Ref
+	23, // 37: cipd.DescribeInstanceResponse.tags:type_name -> cipd.Tag
+	33, // 38: cipd.DescribeInstanceResponse.processors:type_name -> cipd.Processor
+	43, // 39: cipd.DescribeClientRequest.instance:type_name -> cipd.ObjectRef
+	11, // 40: cipd.DescribeClientResponse.instance:type_name -> cipd.Instance
+	43, // 41: cipd.DescribeClientResponse.client_ref:type_name -> cipd.ObjectRef
+	46, // 42: cipd.DescribeClientResponse.client_binary:type_name -> cipd.ObjectURL
+	43, // 43: cipd.DescribeClientResponse.client_ref_aliases:type_name -> cipd.ObjectRef
+	0,  // 44: cipd.PrefixMetadata.ACL.role:type_name -> cipd.Role
+	0,  // 45: cipd.RolesInPrefixResponse.RoleInPrefix.role:type_name -> cipd.Role
+	11, // 46: cipd.SearchFilesResponse.Match.instance:type_name -> cipd.Instance
+	43, // 47: cipd.SearchFilesResponse.Match.hash:type_name -> cipd.ObjectRef
+	3,  // 48: cipd.Repository.GetPrefixMetadata:input_type -> cipd.PrefixRequest
+	3,  // 49: cipd.Repository.GetInheritedPrefixMetadata:input_type -> cipd.PrefixRequest
+	4,  // 50: cipd.Repository.UpdatePrefixMetadata:input_type -> cipd.PrefixMetadata
+	3,  // 51: cipd.Repository.GetRolesInPrefix:input_type -> cipd.PrefixRequest
+	8,  // 52: cipd.Repository.ListPrefix:input_type -> cipd.ListPrefixRequest
+	10, // 53: cipd.Repository.HidePackage:input_type -> cipd.PackageRequest
+	10, // 54: cipd.Repository.UnhidePackage:input_type -> cipd.PackageRequest
+	10, // 55: cipd.Repository.DeletePackage:input_type -> cipd.PackageRequest
+	11, // 56: cipd.Repository.RegisterInstance:input_type -> cipd.Instance
+	13, // 57: cipd.Repository.ListInstances:input_type -> cipd.ListInstancesRequest
+	15, // 58: cipd.Repository.SearchInstances:input_type -> cipd.SearchInstancesRequest
+	17, // 59: cipd.Repository.SearchFiles:input_type -> cipd.SearchFilesRequest
+	19, // 60: cipd.Repository.CreateRef:input_type -> cipd.Ref
+	20, // 61: cipd.Repository.DeleteRef:input_type -> cipd.DeleteRefRequest
+	21, // 62: cipd.Repository.ListRefs:input_type -> cipd.ListRefsRequest
+	24, // 63: cipd.Repository.AttachTags:input_type -> cipd.AttachTagsRequest
+	25, // 64: cipd.Repository.DetachTags:input_type -> cipd.DetachTagsRequest
+	27, // 65: cipd.Repository.AttachMetadata:input_type -> cipd.AttachMetadataRequest
+	28, // 66: cipd.Repository.DetachMetadata:input_type -> cipd.DetachMetadataRequest
+	29, // 67: cipd.Repository.ListMetadata:input_type -> cipd.ListMetadataRequest
+	31, // 68: cipd.Repository.ResolveVersion:input_type -> cipd.ResolveVersionRequest
+	32, // 69: cipd.Repository.GetInstanceURL:input_type -> cipd.GetInstanceURLRequest
+	34, // 70: cipd.Repository.DescribeInstance:input_type -> cipd.DescribeInstanceRequest
+	36, // 71: cipd.Repository.DescribeClient:input_type -> cipd.DescribeClientRequest
+	4,  // 72: cipd.Repository.GetPrefixMetadata:output_type -> cipd.PrefixMetadata
+	6,  // 73: cipd.Repository.GetInheritedPrefixMetadata:output_type -> cipd.InheritedPrefixMetadata
+	4,  // 74: cipd.Repository.UpdatePrefixMetadata:output_type -> cipd.PrefixMetadata
+	7,  // 75: cipd.Repository.GetRolesInPrefix:output_type -> cipd.RolesInPrefixResponse
+	9,  // 76: cipd.Repository.ListPrefix:output_type -> cipd.ListPrefixResponse
+	47, // 77: cipd.Repository.HidePackage:output_type -> google.protobuf.Empty
+	47, // 78: cipd.Repository.UnhidePackage:output_type -> google.protobuf.Empty
+	47, // 79: cipd.Repository.DeletePackage:output_type -> google.protobuf.Empty
+	12, // 80: cipd.Repository.RegisterInstance:output_type -> cipd.RegisterInstanceResponse
+	14, // 81: cipd.Repository.ListInstances:output_type -> cipd.ListInstancesResponse
+	16, // 82: cipd.Repository.SearchInstances:output_type -> cipd.SearchInstancesResponse
+	18, // 83: cipd.Repository.SearchFiles:output_type -> cipd.SearchFilesResponse
+	47, // 84: cipd.Repository.CreateRef:output_type -> google.protobuf.Empty
+	47, // 85: cipd.Repository.DeleteRef:output_type -> google.protobuf.Empty
+	22, // 86: cipd.Repository.ListRefs:output_type -> cipd.ListRefsResponse
+	47, // 87: cipd.Repository.AttachTags:output_type -> google.protobuf.Empty
+	47, // 88: cipd.Repository.DetachTags:output_type -> google.protobuf.Empty
+	47, // 89: cipd.Repository.AttachMetadata:output_type -> google.protobuf.Empty
+	47, // 90: cipd.Repository.DetachMetadata:output_type -> google.protobuf.Empty
+	30, // 91: cipd.Repository.ListMetadata:output_type -> cipd.ListMetadataResponse
+	11, // 92: cipd.Repository.ResolveVersion:output_type -> cipd.Instance
+	46, // 93: cipd.Repository.GetInstanceURL:output_type -> cipd.ObjectURL
+	35, // 94: cipd.Repository.DescribeInstance:output_type -> cipd.DescribeInstanceResponse
+	37, // 95: cipd.Repository.DescribeClient:output_type -> cipd.DescribeClientResponse
+	72, // [72:96] is the sub-list for method output_type
+	48, // [48:72] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() }
@@ -2659,8 +3439,104 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InheritedPrefixMetadata); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InheritedPrefixMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolesInPrefixResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPrefixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPrefixResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Instance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterInstanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInstancesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2671,8 +3547,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RolesInPrefixResponse); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInstancesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2683,8 +3559,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPrefixRequest); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchInstancesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2695,8 +3571,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPrefixResponse); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchInstancesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2707,8 +3583,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PackageRequest); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchFilesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2719,8 +3595,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Instance); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchFilesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2731,8 +3607,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterInstanceResponse); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ref); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2743,8 +3619,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListInstancesRequest); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRefRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2755,8 +3631,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListInstancesResponse); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRefsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2767,8 +3643,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchInstancesRequest); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRefsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2779,8 +3655,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchInstancesResponse); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2791,8 +3667,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ref); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachTagsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2803,8 +3679,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRefRequest); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetachTagsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2815,8 +3691,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRefsRequest); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2827,8 +3703,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRefsResponse); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachMetadataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2839,8 +3715,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tag); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetachMetadataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2851,8 +3727,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AttachTagsRequest); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMetadataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2863,8 +3739,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetachTagsRequest); i {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMetadataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2875,7 +3751,7 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResolveVersionRequest); i {
 			case 0:
 				return &v.state
@@ -2887,7 +3763,7 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInstanceURLRequest); i {
 			case 0:
 				return &v.state
@@ -2899,7 +3775,7 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Processor); i {
 			case 0:
 				return &v.state
@@ -2911,7 +3787,7 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DescribeInstanceRequest); i {
 			case 0:
 				return &v.state
@@ -2923,7 +3799,7 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DescribeInstanceResponse); i {
 			case 0:
 				return &v.state
@@ -2935,7 +3811,7 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DescribeClientRequest); i {
 			case 0:
 				return &v.state
@@ -2947,7 +3823,7 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DescribeClientResponse); i {
 			case 0:
 				return &v.state
@@ -2959,7 +3835,7 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrefixMetadata_ACL); i {
 			case 0:
 				return &v.state
@@ -2971,7 +3847,7 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RolesInPrefixResponse_RoleInPrefix); i {
 			case 0:
 				return &v.state
@@ -2983,6 +3859,18 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 				return nil
 			}
 		}
+		file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchFilesResponse_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2990,7 +3878,7 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   29,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -3155,6 +4043,15 @@ type RepositoryClient interface {
 	//
 	// If the package doesn't exist, returns NOT_FOUND.
 	SearchInstances(ctx context.Context, in *SearchInstancesRequest, opts ...grpc.CallOption) (*SearchInstancesResponse, error)
+	// Returns package instances that contain files matching the given glob.
+	//
+	// This is a global query across all packages. It relies on the file index
+	// built when instances are post-processed after the upload, so instances that
+	// haven't been processed yet are not returned.
+	//
+	// Instances of packages the caller has no READER role in are silently
+	// skipped.
+	SearchFiles(ctx context.Context, in *SearchFilesRequest, opts ...grpc.CallOption) (*SearchFilesResponse, error)
 	// Creates a new ref or moves an existing one.
 	//
 	// A ref is a mutable named pointer to some existing package instance that
@@ -3238,6 +4135,49 @@ type RepositoryClient interface {
 	//   INVALID_ARGUMENT if the request is malformed.
 	//   NOT_FOUND if the package or the instance doesn't exist.
 	DetachTags(ctx context.Context, in *DetachTagsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Attaches one or more metadata entries to an instance.
+	//
+	// Silently skips entries that are already attached (i.e. have the same key
+	// and value).
+	//
+	// Metadata entries are key/value pairs associated with a concrete package
+	// instance. Unlike tags, they are not used for version resolution and their
+	// values can be arbitrary binary blobs (up to 512 KB). They are used to
+	// associate additional information with an instance, e.g. detached
+	// signatures produced by a trusted signer.
+	//
+	// Metadata can be attached only to instances that successfully passed all
+	// post-registration processing.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not a WRITER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if the package or the instance doesn't exist.
+	//   FAILED_PRECONDITION if the instance is still being processed.
+	//   ABORTED if the instance has some failed processors associated with it,
+	//       such instance is effectively broken and should not be used.
+	AttachMetadata(ctx context.Context, in *AttachMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Detaches one or more metadata entries if they were attached.
+	//
+	// Entries are identified either by their fingerprints or by their key/value
+	// pairs (the fingerprint is calculated on the fly then).
+	//
+	// Just like DetachTags, this is allowed only by OWNERS of a prefix.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not an OWNER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if the package or the instance doesn't exist.
+	DetachMetadata(ctx context.Context, in *DetachMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Lists metadata entries attached to an instance.
+	//
+	// Entries are ordered by the timestamp (most recent first).
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not a READER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if the package or the instance doesn't exist.
+	ListMetadata(ctx context.Context, in *ListMetadataRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error)
 	// Takes a version string and resolves it into a concrete package instance.
 	//
 	// A version string can be any of:
@@ -3391,6 +4331,15 @@ func (c *repositoryPRPCClient) SearchInstances(ctx context.Context, in *SearchIn
 	return out, nil
 }
 
+func (c *repositoryPRPCClient) SearchFiles(ctx context.Context, in *SearchFilesRequest, opts ...grpc.CallOption) (*SearchFilesResponse, error) {
+	out := new(SearchFilesResponse)
+	err := c.client.Call(ctx, "cipd.Repository", "SearchFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryPRPCClient) CreateRef(ctx context.Context, in *Ref, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.client.Call(ctx, "cipd.Repository", "CreateRef", in, out, opts...)
@@ -3436,6 +4385,33 @@ func (c *repositoryPRPCClient) DetachTags(ctx context.Context, in *DetachTagsReq
 	return out, nil
 }
 
+func (c *repositoryPRPCClient) AttachMetadata(ctx context.Context, in *AttachMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.client.Call(ctx, "cipd.Repository", "AttachMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryPRPCClient) DetachMetadata(ctx context.Context, in *DetachMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.client.Call(ctx, "cipd.Repository", "DetachMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryPRPCClient) ListMetadata(ctx context.Context, in *ListMetadataRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error) {
+	out := new(ListMetadataResponse)
+	err := c.client.Call(ctx, "cipd.Repository", "ListMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryPRPCClient) ResolveVersion(ctx context.Context, in *ResolveVersionRequest, opts ...grpc.CallOption) (*Instance, error) {
 	out := new(Instance)
 	err := c.client.Call(ctx, "cipd.Repository", "ResolveVersion", in, out, opts...)
@@ -3579,6 +4555,15 @@ func (c *repositoryClient) SearchInstances(ctx context.Context, in *SearchInstan
 	return out, nil
 }
 
+func (c *repositoryClient) SearchFiles(ctx context.Context, in *SearchFilesRequest, opts ...grpc.CallOption) (*SearchFilesResponse, error) {
+	out := new(SearchFilesResponse)
+	err := c.cc.Invoke(ctx, "/cipd.Repository/SearchFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryClient) CreateRef(ctx context.Context, in *Ref, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/cipd.Repository/CreateRef", in, out, opts...)
@@ -3624,6 +4609,33 @@ func (c *repositoryClient) DetachTags(ctx context.Context, in *DetachTagsRequest
 	return out, nil
 }
 
+func (c *repositoryClient) AttachMetadata(ctx context.Context, in *AttachMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/cipd.Repository/AttachMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) DetachMetadata(ctx context.Context, in *DetachMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/cipd.Repository/DetachMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ListMetadata(ctx context.Context, in *ListMetadataRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error) {
+	out := new(ListMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cipd.Repository/ListMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryClient) ResolveVersion(ctx context.Context, in *ResolveVersionRequest, opts ...grpc.CallOption) (*Instance, error) {
 	out := new(Instance)
 	err := c.cc.Invoke(ctx, "/cipd.Repository/ResolveVersion", in, out, opts...)
@@ -3800,6 +4812,15 @@ type RepositoryServer interface {
 	//
 	// If the package doesn't exist, returns NOT_FOUND.
 	SearchInstances(context.Context, *SearchInstancesRequest) (*SearchInstancesResponse, error)
+	// Returns package instances that contain files matching the given glob.
+	//
+	// This is a global query across all packages. It relies on the file index
+	// built when instances are post-processed after the upload, so instances that
+	// haven't been processed yet are not returned.
+	//
+	// Instances of packages the caller has no READER role in are silently
+	// skipped.
+	SearchFiles(context.Context, *SearchFilesRequest) (*SearchFilesResponse, error)
 	// Creates a new ref or moves an existing one.
 	//
 	// A ref is a mutable named pointer to some existing package instance that
@@ -3883,6 +4904,49 @@ type RepositoryServer interface {
 	//   INVALID_ARGUMENT if the request is malformed.
 	//   NOT_FOUND if the package or the instance doesn't exist.
 	DetachTags(context.Context, *DetachTagsRequest) (*empty.Empty, error)
+	// Attaches one or more metadata entries to an instance.
+	//
+	// Silently skips entries that are already attached (i.e. have the same key
+	// and value).
+	//
+	// Metadata entries are key/value pairs associated with a concrete package
+	// instance. Unlike tags, they are not used for version resolution and their
+	// values can be arbitrary binary blobs (up to 512 KB). They are used to
+	// associate additional information with an instance, e.g. detached
+	// signatures produced by a trusted signer.
+	//
+	// Metadata can be attached only to instances that successfully passed all
+	// post-registration processing.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not a WRITER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if the package or the instance doesn't exist.
+	//   FAILED_PRECONDITION if the instance is still being processed.
+	//   ABORTED if the instance has some failed processors associated with it,
+	//       such instance is effectively broken and should not be used.
+	AttachMetadata(context.Context, *AttachMetadataRequest) (*empty.Empty, error)
+	// Detaches one or more metadata entries if they were attached.
+	//
+	// Entries are identified either by their fingerprints or by their key/value
+	// pairs (the fingerprint is calculated on the fly then).
+	//
+	// Just like DetachTags, this is allowed only by OWNERS of a prefix.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not an OWNER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if the package or the instance doesn't exist.
+	DetachMetadata(context.Context, *DetachMetadataRequest) (*empty.Empty, error)
+	// Lists metadata entries attached to an instance.
+	//
+	// Entries are ordered by the timestamp (most recent first).
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not a READER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if the package or the instance doesn't exist.
+	ListMetadata(context.Context, *ListMetadataRequest) (*ListMetadataResponse, error)
 	// Takes a version string and resolves it into a concrete package instance.
 	//
 	// A version string can be any of:
@@ -3967,6 +5031,9 @@ func (*UnimplementedRepositoryServer) ListInstances(context.Context, *ListInstan
 func (*UnimplementedRepositoryServer) SearchInstances(context.Context, *SearchInstancesRequest) (*SearchInstancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchInstances not implemented")
 }
+func (*UnimplementedRepositoryServer) SearchFiles(context.Context, *SearchFilesRequest) (*SearchFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchFiles not implemented")
+}
 func (*UnimplementedRepositoryServer) CreateRef(context.Context, *Ref) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRef not implemented")
 }
@@ -3982,6 +5049,15 @@ func (*UnimplementedRepositoryServer) AttachTags(context.Context, *AttachTagsReq
 func (*UnimplementedRepositoryServer) DetachTags(context.Context, *DetachTagsRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DetachTags not implemented")
 }
+func (*UnimplementedRepositoryServer) AttachMetadata(context.Context, *AttachMetadataRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachMetadata not implemented")
+}
+func (*UnimplementedRepositoryServer) DetachMetadata(context.Context, *DetachMetadataRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachMetadata not implemented")
+}
+func (*UnimplementedRepositoryServer) ListMetadata(context.Context, *ListMetadataRequest) (*ListMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetadata not implemented")
+}
 func (*UnimplementedRepositoryServer) ResolveVersion(context.Context, *ResolveVersionRequest) (*Instance, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResolveVersion not implemented")
 }
@@ -4197,6 +5273,24 @@ func _Repository_SearchInstances_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Repository_SearchFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).SearchFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cipd.Repository/SearchFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).SearchFiles(ctx, req.(*SearchFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Repository_CreateRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Ref)
 	if err := dec(in); err != nil {
@@ -4287,6 +5381,60 @@ func _Repository_DetachTags_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Repository_AttachMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).AttachMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cipd.Repository/AttachMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).AttachMetadata(ctx, req.(*AttachMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_DetachMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).DetachMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cipd.Repository/DetachMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).DetachMetadata(ctx, req.(*DetachMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_ListMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).ListMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cipd.Repository/ListMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).ListMetadata(ctx, req.(*ListMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Repository_ResolveVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResolveVersionRequest)
 	if err := dec(in); err != nil {
@@ -4407,6 +5555,10 @@ var _Repository_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SearchInstances",
 			Handler:    _Repository_SearchInstances_Handler,
 		},
+		{
+			MethodName: "SearchFiles",
+			Handler:    _Repository_SearchFiles_Handler,
+		},
 		{
 			MethodName: "CreateRef",
 			Handler:    _Repository_CreateRef_Handler,
@@ -4427,6 +5579,18 @@ var _Repository_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DetachTags",
 			Handler:    _Repository_DetachTags_Handler,
 		},
+		{
+			MethodName: "AttachMetadata",
+			Handler:    _Repository_AttachMetadata_Handler,
+		},
+		{
+			MethodName: "DetachMetadata",
+			Handler:    _Repository_DetachMetadata_Handler,
+		},
+		{
+			MethodName: "ListMetadata",
+			Handler:    _Repository_ListMetadata_Handler,
+		},
 		{
 			MethodName: "ResolveVersion",
 			Handler:    _Repository_ResolveVersion_Handler,
@@ -26,6 +26,8 @@ import (
 	"go.chromium.org/luci/server/auth"
 
 	api "go.chromium.org/luci/cipd/api/admin/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/cas"
+	"go.chromium.org/luci/cipd/appengine/impl/metadata"
 )
 
 // AdminGroup is a name of a group with accounts that can use Admin API.
@@ -33,8 +35,12 @@ const AdminGroup = "administrators"
 
 // AdminAPI returns an ACL-protected implementation of cipd.AdminServer that can
 // be exposed as a public API (i.e. admins can use it via external RPCs).
-func AdminAPI(d *tq.Dispatcher) api.AdminServer {
-	impl := &adminImpl{tq: d}
+func AdminAPI(d *tq.Dispatcher, internalCAS cas.StorageServer) api.AdminServer {
+	impl := &adminImpl{
+		tq:   d,
+		cas:  internalCAS,
+		meta: metadata.GetStorage(),
+	}
 	impl.init()
 	return &api.DecoratedAdmin{
 		Service: impl,
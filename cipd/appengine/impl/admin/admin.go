@@ -29,13 +29,17 @@ import (
 	"go.chromium.org/luci/appengine/tq"
 
 	api "go.chromium.org/luci/cipd/api/admin/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/cas"
+	"go.chromium.org/luci/cipd/appengine/impl/metadata"
 )
 
 // adminImpl implements cipd.AdminServer, with an assumption that auth check has
 // already been done by the decorator setup in AdminAPI(), see acl.go.
 type adminImpl struct {
-	tq  *tq.Dispatcher
-	ctl *mapper.Controller
+	tq   *tq.Dispatcher
+	cas  cas.StorageServer
+	meta metadata.Storage
+	ctl  *mapper.Controller
 }
 
 // init initializes mapper controller and registers mapping tasks.
@@ -45,7 +49,7 @@ func (impl *adminImpl) init() {
 		ControlQueue: "default",
 	}
 	for _, m := range mappers { // see mappers.go
-		impl.ctl.RegisterFactory(m.mapperID(), m.newMapper)
+		impl.ctl.RegisterFactory(m.mapperID(), m.newMapper(impl))
 	}
 	impl.ctl.Install(impl.tq)
 }
@@ -124,3 +128,13 @@ func (impl *adminImpl) FixMarkedTags(ctx context.Context, id *api.JobID) (*api.T
 	}
 	return &api.TagFixReport{Fixed: tags}, nil
 }
+
+// GetRetentionReport implements the corresponding RPC method, see the proto
+// doc.
+func (impl *adminImpl) GetRetentionReport(ctx context.Context, id *api.JobID) (*api.RetentionReport, error) {
+	insts, err := retentionReport(ctx, mapper.JobID(id.JobId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &api.RetentionReport{Instances: insts}, nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"sort"
+
+	"go.chromium.org/luci/appengine/mapper"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	api "go.chromium.org/luci/cipd/api/admin/v1"
+	cipdapi "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/common"
+)
+
+func init() {
+	initMapper(mapperDef{
+		Kind: api.MapperKind_ENFORCE_RETENTION_POLICIES,
+		Func: enforceRetentionMapper,
+		Config: mapper.JobConfig{
+			Query:         mapper.Query{Kind: "Package"},
+			ShardCount:    64,
+			PageSize:      16, // each package is visited in full, keep pages small
+			TrackProgress: true,
+		},
+	})
+}
+
+// markedInstance is a root entity created for each instance that expired
+// according to a retention policy, as found by some particular mapper job.
+//
+// Used to report what was (or would have been, in dry run mode) deleted. See
+// also markedTag.
+type markedInstance struct {
+	_kind  string                `gae:"$kind,mapper.MarkedInstance"`
+	_extra datastore.PropertyMap `gae:"-,extra"`
+
+	ID  string       `gae:"$id"` // see .genID()
+	Job mapper.JobID // ID of a mapping job that produced it, for queries
+
+	Package  string `gae:",noindex"`
+	Instance string `gae:",noindex"` // instance ID
+	Reason   string `gae:",noindex"` // why the instance has expired, for humans
+	Deleted  bool   `gae:",noindex"` // true if it was actually deleted
+}
+
+// genID derives an ID for this markedInstance entity.
+//
+// Each unique instance marked by some particular job gets its own key, i.e.
+// each job has its own namespace for marked instances.
+func (m *markedInstance) genID() {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d\n%s:%s", m.Job, m.Package, m.Instance)
+	m.ID = base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
+func enforceRetentionMapper(ctx context.Context, impl *adminImpl, job mapper.JobID, cfg *api.JobConfig, keys []*datastore.Key) error {
+	var marked []*markedInstance
+	for _, key := range keys {
+		m, err := enforceRetention(ctx, impl, job, key.StringID(), cfg.DryRun)
+		if err != nil {
+			return errors.Annotate(err, "when visiting package %q", key.StringID()).Err()
+		}
+		marked = append(marked, m...)
+	}
+	if err := datastore.Put(ctx, marked); err != nil {
+		return errors.Annotate(err, "failed to store %d markedInstance(s)", len(marked)).Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// enforceRetention deletes expired instances of a package (unless in dry run
+// mode) and returns a list of them.
+func enforceRetention(ctx context.Context, impl *adminImpl, job mapper.JobID, pkg string, dryRun bool) ([]*markedInstance, error) {
+	policy, err := retentionPolicy(ctx, impl, pkg)
+	if err != nil || policy == nil {
+		return nil, err
+	}
+
+	expired, err := model.FindExpiredInstances(ctx, pkg, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	marked := make([]*markedInstance, len(expired))
+	for i, exp := range expired {
+		iid := exp.Instance.InstanceID
+		marked[i] = &markedInstance{
+			Job:      job,
+			Package:  pkg,
+			Instance: iid,
+			Reason:   exp.Reason,
+		}
+		marked[i].genID()
+
+		if dryRun {
+			logging.Infof(ctx, "Would delete %s:%s - %s", pkg, iid, exp.Reason)
+			continue
+		}
+
+		logging.Infof(ctx, "Deleting %s:%s - %s", pkg, iid, exp.Reason)
+		marked[i].Deleted, err = model.DeleteExpiredInstance(ctx, exp, func(ctx context.Context) error {
+			return impl.cas.ScheduleDeletion(ctx, common.InstanceIDToObjectRef(iid))
+		})
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to delete %s", iid).Err()
+		}
+	}
+	return marked, nil
+}
+
+// retentionPolicy returns a retention policy that applies to the package or
+// nil if there's none.
+//
+// The policy defined in the most specific prefix wins.
+func retentionPolicy(ctx context.Context, impl *adminImpl, pkg string) (*cipdapi.RetentionPolicy, error) {
+	md, err := impl.meta.GetMetadata(ctx, pkg)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch prefix metadata").Err()
+	}
+	var policy *cipdapi.RetentionPolicy
+	for _, m := range md {
+		if m.RetentionPolicy != nil {
+			policy = m.RetentionPolicy
+		}
+	}
+	return policy, nil
+}
+
+// retentionReport returns all instances marked by the given mapper job.
+func retentionReport(ctx context.Context, job mapper.JobID) ([]*api.RetentionReport_Instance, error) {
+	var marked []markedInstance
+	q := datastore.NewQuery("mapper.MarkedInstance").Eq("Job", job)
+	if err := datastore.GetAll(ctx, q, &marked); err != nil {
+		return nil, errors.Annotate(err, "failed to query marked instances").Tag(transient.Tag).Err()
+	}
+	sort.Slice(marked, func(i, j int) bool {
+		if marked[i].Package != marked[j].Package {
+			return marked[i].Package < marked[j].Package
+		}
+		return marked[i].Instance < marked[j].Instance
+	})
+	out := make([]*api.RetentionReport_Instance, len(marked))
+	for i, m := range marked {
+		out[i] = &api.RetentionReport_Instance{
+			Pkg:      m.Package,
+			Instance: m.Instance,
+			Reason:   m.Reason,
+			Deleted:  m.Deleted,
+		}
+	}
+	return out, nil
+}
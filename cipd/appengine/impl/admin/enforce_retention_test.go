@@ -0,0 +1,151 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/appengine/mapper"
+	"go.chromium.org/luci/common/proto/google"
+
+	api "go.chromium.org/luci/cipd/api/admin/v1"
+	cipdapi "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestEnforceRetentionPolicies(t *testing.T) {
+	t.Parallel()
+
+	Convey("With instances", t, func() {
+		ctx, admin := SetupTest()
+
+		var scheduled []string
+		admin.cas.(*testutil.MockCAS).ScheduleDeletionImpl = func(_ context.Context, ref *cipdapi.ObjectRef) error {
+			scheduled = append(scheduled, ref.HexDigest[:1])
+			return nil
+		}
+
+		setPolicy := func(prefix string, keep int32) {
+			_, err := admin.meta.UpdateMetadata(ctx, prefix, func(md *cipdapi.PrefixMetadata) error {
+				md.RetentionPolicy = &cipdapi.RetentionPolicy{KeepLastUntagged: keep}
+				md.UpdateTime = google.NewTimestamp(testutil.TestTime)
+				md.UpdateUser = string(testutil.TestUser)
+				return nil
+			})
+			So(err, ShouldBeNil)
+		}
+		setPolicy("a", 1)
+		setPolicy("a/keep", 10)
+
+		register := func(pkg, chr string, age time.Duration) {
+			// Instance files are uploaded by the test, so their usage is tracked.
+			So(model.TrackInstanceUsage(ctx, strings.Repeat(chr, 40)), ShouldBeNil)
+			_, _, err := model.RegisterInstance(ctx, &model.Instance{
+				InstanceID:   strings.Repeat(chr, 40),
+				Package:      model.PackageKey(ctx, pkg),
+				RegisteredTs: testutil.TestTime.Add(-age),
+			}, nil)
+			So(err, ShouldBeNil)
+		}
+		register("a/pkg", "1", 24*time.Hour)
+		register("a/pkg", "2", 48*time.Hour)
+		register("a/pkg", "3", 72*time.Hour)
+		register("a/keep/pkg", "4", 24*time.Hour)
+		register("a/keep/pkg", "5", 48*time.Hour)
+		register("b/pkg", "6", 24*time.Hour)
+		register("b/pkg", "7", 48*time.Hour)
+
+		exists := func(pkg, chr string) bool {
+			inst := &model.Instance{
+				InstanceID: strings.Repeat(chr, 40),
+				Package:    model.PackageKey(ctx, pkg),
+			}
+			return model.CheckInstanceExists(ctx, inst) == nil
+		}
+
+		report := func(job mapper.JobID) []*api.RetentionReport_Instance {
+			resp, err := admin.GetRetentionReport(ctx, &api.JobID{JobId: int64(job)})
+			So(err, ShouldBeNil)
+			return resp.Instances
+		}
+
+		expected := func(deleted bool) []*api.RetentionReport_Instance {
+			reason := "not among 1 most recent untagged instances"
+			return []*api.RetentionReport_Instance{
+				{Pkg: "a/pkg", Instance: strings.Repeat("2", 40), Reason: reason, Deleted: deleted},
+				{Pkg: "a/pkg", Instance: strings.Repeat("3", 40), Reason: reason, Deleted: deleted},
+			}
+		}
+
+		Convey("Dry run", func() {
+			job, err := RunMapper(ctx, admin, &api.JobConfig{
+				Kind:   api.MapperKind_ENFORCE_RETENTION_POLICIES,
+				DryRun: true,
+			})
+			So(err, ShouldBeNil)
+			So(report(job), ShouldResembleProto, expected(false))
+
+			So(scheduled, ShouldBeEmpty)
+			So(exists("a/pkg", "2"), ShouldBeTrue)
+			So(exists("a/pkg", "3"), ShouldBeTrue)
+		})
+
+		Convey("Real run", func() {
+			job, err := RunMapper(ctx, admin, &api.JobConfig{
+				Kind: api.MapperKind_ENFORCE_RETENTION_POLICIES,
+			})
+			So(err, ShouldBeNil)
+			So(report(job), ShouldResembleProto, expected(true))
+
+			So(scheduled, ShouldResemble, []string{"2", "3"})
+			So(exists("a/pkg", "1"), ShouldBeTrue)
+			So(exists("a/pkg", "2"), ShouldBeFalse)
+			So(exists("a/pkg", "3"), ShouldBeFalse)
+			for _, chr := range []string{"4", "5"} {
+				So(exists("a/keep/pkg", chr), ShouldBeTrue)
+			}
+			for _, chr := range []string{"6", "7"} {
+				So(exists("b/pkg", chr), ShouldBeTrue)
+			}
+		})
+
+		Convey("Keeps files shared with other packages", func() {
+			// The same instance as the expired a/pkg:3 in a package without
+			// a retention policy.
+			register("b/another", "3", 72*time.Hour)
+
+			job, err := RunMapper(ctx, admin, &api.JobConfig{
+				Kind: api.MapperKind_ENFORCE_RETENTION_POLICIES,
+			})
+			So(err, ShouldBeNil)
+			So(report(job), ShouldResembleProto, expected(true))
+
+			So(scheduled, ShouldResemble, []string{"2"})
+			So(exists("a/pkg", "3"), ShouldBeFalse)
+			So(exists("b/another", "3"), ShouldBeTrue)
+
+			used, err := model.IsInstanceFileUsed(ctx, strings.Repeat("3", 40))
+			So(err, ShouldBeNil)
+			So(used, ShouldBeTrue)
+		})
+	})
+}
@@ -37,7 +37,7 @@ func init() {
 	})
 }
 
-func enumPackagesMapper(ctx context.Context, _ mapper.JobID, _ *api.JobConfig, keys []*datastore.Key) error {
+func enumPackagesMapper(ctx context.Context, _ *adminImpl, _ mapper.JobID, _ *api.JobConfig, keys []*datastore.Key) error {
 	for _, k := range keys {
 		logging.Infof(ctx, "Found package: %s", k.StringID())
 	}
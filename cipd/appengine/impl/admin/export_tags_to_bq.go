@@ -52,7 +52,7 @@ func init() {
 	})
 }
 
-func exportTagsToBQ(ctx context.Context, job mapper.JobID, _ *api.JobConfig, keys []*datastore.Key) error {
+func exportTagsToBQ(ctx context.Context, _ *adminImpl, job mapper.JobID, _ *api.JobConfig, keys []*datastore.Key) error {
 	rows := make([]bigquery.ValueSaver, 0, len(keys))
 	err := multiGetTags(ctx, keys, func(key *datastore.Key, tag *model.Tag) error {
 		// These checks should never be hit, but just in case...
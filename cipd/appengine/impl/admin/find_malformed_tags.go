@@ -46,7 +46,7 @@ func init() {
 	})
 }
 
-func findMalformedTagsMapper(ctx context.Context, job mapper.JobID, _ *api.JobConfig, keys []*datastore.Key) error {
+func findMalformedTagsMapper(ctx context.Context, _ *adminImpl, job mapper.JobID, _ *api.JobConfig, keys []*datastore.Key) error {
 	return visitAndMarkTags(ctx, job, keys, func(t *model.Tag) string {
 		if err := common.ValidateInstanceTag(t.Tag); err != nil {
 			return err.Error()
@@ -22,8 +22,10 @@ import (
 	"go.chromium.org/luci/appengine/tq/tqtesting"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/gae/service/taskqueue"
 
 	api "go.chromium.org/luci/cipd/api/admin/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/metadata"
 	"go.chromium.org/luci/cipd/appengine/impl/testutil"
 )
 
@@ -35,7 +37,9 @@ func SetupTest() (context.Context, *adminImpl) {
 	datastore.GetTestable(ctx).Consistent(true)
 
 	admin := &adminImpl{
-		tq: &tq.Dispatcher{BaseURL: "/internal/tq/"},
+		tq:   &tq.Dispatcher{BaseURL: "/internal/tq/"},
+		cas:  &testutil.MockCAS{},
+		meta: metadata.GetStorage(),
 	}
 	admin.init()
 
@@ -55,7 +59,15 @@ func RunMapper(ctx context.Context, admin *adminImpl, cfg *api.JobConfig) (mappe
 
 	// Run the tq loop until there are no more pending tasks.
 	tq := tqtesting.GetTestable(ctx, admin.tq)
-	_, _, err = tq.RunSimulation(ctx, nil)
+	_, _, err = tq.RunSimulation(ctx, &tqtesting.SimulationParams{
+		UnknownTaskHandler: func(t *taskqueue.Task) error {
+			// Skip pull tasks, e.g. BigQuery event log tasks emitted by mappers.
+			if t.Method == "PULL" {
+				return nil
+			}
+			return errors.Reason("unrecognized TQ task for handler at %s", t.Path).Err()
+		},
+	})
 	if err != nil {
 		return 0, err
 	}
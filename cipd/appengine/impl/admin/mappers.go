@@ -46,7 +46,7 @@ func initMapper(d mapperDef) {
 // shards, etc), and the actual mapping function.
 type mapperDef struct {
 	Kind   api.MapperKind // also used to derive mapper.ID
-	Func   func(context.Context, mapper.JobID, *api.JobConfig, []*datastore.Key) error
+	Func   func(context.Context, *adminImpl, mapper.JobID, *api.JobConfig, []*datastore.Key) error
 	Config mapper.JobConfig // note: Params will be overwritten
 }
 
@@ -55,13 +55,16 @@ func (m *mapperDef) mapperID() mapper.ID {
 	return mapper.ID(fmt.Sprintf("cipd:v1:%s", m.Kind))
 }
 
-// newMapper creates new instance of a mapping function.
-func (m *mapperDef) newMapper(ctx context.Context, j *mapper.Job, shardIdx int) (mapper.Mapper, error) {
-	cfg := &api.JobConfig{}
-	if err := proto.Unmarshal(j.Config.Params, cfg); err != nil {
-		return nil, errors.Annotate(err, "failed to unmarshal JobConfig").Err()
+// newMapper returns a factory of mapping functions bound to the given admin
+// service implementation.
+func (m *mapperDef) newMapper(impl *adminImpl) mapper.Factory {
+	return func(ctx context.Context, j *mapper.Job, shardIdx int) (mapper.Mapper, error) {
+		cfg := &api.JobConfig{}
+		if err := proto.Unmarshal(j.Config.Params, cfg); err != nil {
+			return nil, errors.Annotate(err, "failed to unmarshal JobConfig").Err()
+		}
+		return func(ctx context.Context, keys []*datastore.Key) error {
+			return m.Func(ctx, impl, j.ID, cfg, keys)
+		}, nil
 	}
-	return func(ctx context.Context, keys []*datastore.Key) error {
-		return m.Func(ctx, j.ID, cfg, keys)
-	}, nil
 }
@@ -40,6 +40,7 @@ import (
 	"go.chromium.org/luci/cipd/appengine/impl/cas/tasks"
 	"go.chromium.org/luci/cipd/appengine/impl/cas/upload"
 	"go.chromium.org/luci/cipd/appengine/impl/gs"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
 	"go.chromium.org/luci/cipd/appengine/impl/monitoring"
 	"go.chromium.org/luci/cipd/appengine/impl/settings"
 	"go.chromium.org/luci/cipd/common"
@@ -61,6 +62,13 @@ type StorageServer interface {
 	// Returns grpc errors. In particular NotFound is returned if there's no such
 	// object in the storage.
 	GetReader(ctx context.Context, ref *api.ObjectRef) (gs.Reader, error)
+
+	// ScheduleDeletion enqueues a task to delete an object from the storage.
+	//
+	// If called inside a datastore transaction, the task is enqueued
+	// transactionally. It is not an error if the object is already gone by the
+	// time the task runs.
+	ScheduleDeletion(ctx context.Context, ref *api.ObjectRef) error
 }
 
 // Internal returns non-ACLed implementation of StorageService.
@@ -101,6 +109,9 @@ func (s *storageImpl) registerTasks() {
 	s.tq.RegisterTask(&tasks.CleanupUpload{}, func(ctx context.Context, m proto.Message) error {
 		return s.cleanupUploadTask(ctx, m.(*tasks.CleanupUpload))
 	}, "cas-uploads", nil)
+	s.tq.RegisterTask(&tasks.DeleteObject{}, func(ctx context.Context, m proto.Message) error {
+		return s.deleteObjectTask(ctx, m.(*tasks.DeleteObject))
+	}, "cas-uploads", nil)
 }
 
 // GetReader is part of StorageServer interface.
@@ -127,6 +138,17 @@ func (s *storageImpl) GetReader(ctx context.Context, ref *api.ObjectRef) (r gs.R
 	return r, nil
 }
 
+// ScheduleDeletion is part of StorageServer interface.
+func (s *storageImpl) ScheduleDeletion(ctx context.Context, ref *api.ObjectRef) error {
+	if err := common.ValidateObjectRef(ref, common.KnownHash); err != nil {
+		return errors.Annotate(err, "bad ref").Err()
+	}
+	return s.tq.AddTask(ctx, &tq.Task{
+		Payload: &tasks.DeleteObject{Object: ref},
+		Title:   fmt.Sprintf("%s-%s", ref.HashAlgo, ref.HexDigest),
+	})
+}
+
 // GetObjectURL implements the corresponding RPC method, see the proto doc.
 func (s *storageImpl) GetObjectURL(ctx context.Context, r *api.GetObjectURLRequest) (resp *api.ObjectURL, err error) {
 	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
@@ -574,6 +596,38 @@ func (s *storageImpl) cleanupUploadTask(ctx context.Context, task *tasks.Cleanup
 	return nil
 }
 
+// deleteObjectTask is called to delete an object scheduled for deletion via
+// ScheduleDeletion.
+//
+// Retries transient errors. Logs and ignores fatal ones, since retrying won't
+// help.
+func (s *storageImpl) deleteObjectTask(ctx context.Context, task *tasks.DeleteObject) error {
+	cfg, err := s.settings(ctx)
+	if err != nil {
+		return errors.Annotate(err, "failed to get settings").Tag(transient.Tag).Err()
+	}
+	path := cfg.ObjectPath(task.Object)
+
+	// An instance with this file may have been registered since the deletion
+	// was scheduled.
+	switch used, err := model.IsInstanceFileUsed(ctx, common.ObjectRefToInstanceID(task.Object)); {
+	case err != nil:
+		return err
+	case used:
+		logging.Infof(ctx, "Not deleting %s, it is used by an instance", path)
+		return nil
+	}
+
+	logging.Infof(ctx, "Deleting %s", path)
+	if err := s.getGS(ctx).Delete(ctx, path); err != nil {
+		if transient.Tag.In(err) {
+			return errors.Annotate(err, "transient error when deleting the object").Err()
+		}
+		logging.WithError(err).Errorf(ctx, "Failed to delete the object")
+	}
+	return nil
+}
+
 // maybeDelete is called to delete temporary file when finishing an upload.
 //
 // If this fails transiently, we ask the client (or the task queue) to retry the
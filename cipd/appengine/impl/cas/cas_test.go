@@ -42,8 +42,10 @@ import (
 	"go.chromium.org/luci/cipd/appengine/impl/cas/tasks"
 	"go.chromium.org/luci/cipd/appengine/impl/cas/upload"
 	"go.chromium.org/luci/cipd/appengine/impl/gs"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
 	"go.chromium.org/luci/cipd/appengine/impl/settings"
 	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+	"go.chromium.org/luci/cipd/common"
 
 	. "github.com/smartystreets/goconvey/convey"
 	. "go.chromium.org/luci/common/testing/assertions"
@@ -823,3 +825,48 @@ func TestCancelUpload(t *testing.T) {
 		})
 	})
 }
+
+func TestScheduleDeletion(t *testing.T) {
+	t.Parallel()
+
+	Convey("With mocks", t, func() {
+		ctx, gsMock, tq, impl := storageMocks()
+
+		ref := &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_SHA256,
+			HexDigest: strings.Repeat("a", 64),
+		}
+
+		Convey("OK", func() {
+			So(impl.ScheduleDeletion(ctx, ref), ShouldBeNil)
+
+			t := tq.GetScheduledTasks()
+			So(t, ShouldHaveLength, 1)
+			So(t[0].Payload, ShouldResembleProto, &tasks.DeleteObject{Object: ref})
+
+			So(impl.deleteObjectTask(ctx, t[0].Payload.(*tasks.DeleteObject)), ShouldBeNil)
+			So(gsMock.deleteCalls, ShouldResemble, []string{"/bucket/store/SHA256/" + ref.HexDigest})
+		})
+
+		Convey("Skips objects used by new instances", func() {
+			So(impl.ScheduleDeletion(ctx, ref), ShouldBeNil)
+
+			// An instance with this file is registered before the task runs.
+			So(datastore.Put(ctx, &model.InstanceUsage{
+				InstanceID: common.ObjectRefToInstanceID(ref),
+				Packages:   []string{"a/b"},
+				Complete:   true,
+			}), ShouldBeNil)
+
+			t := tq.GetScheduledTasks()
+			So(t, ShouldHaveLength, 1)
+			So(impl.deleteObjectTask(ctx, t[0].Payload.(*tasks.DeleteObject)), ShouldBeNil)
+			So(gsMock.deleteCalls, ShouldBeEmpty)
+		})
+
+		Convey("Bad object ref", func() {
+			So(impl.ScheduleDeletion(ctx, &api.ObjectRef{HashAlgo: api.HashAlgo_SHA256}), ShouldErrLike, "bad ref")
+			So(tq.GetScheduledTasks(), ShouldHaveLength, 0)
+		})
+	})
+}
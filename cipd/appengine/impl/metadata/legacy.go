@@ -15,6 +15,7 @@
 package metadata
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sort"
@@ -60,6 +61,9 @@ func init() {
 // bunch of datastore entities to be compatible with Python version of the
 // backend that has no idea about PrefixMetadata abstraction.
 //
+// Retention policies are not known to the Python version of the backend at all.
+// They are stored in separate prefixRetention entities.
+//
 // The processes of constructing and deconstructing PrefixMetadata are not
 // perfectly reversible:
 //   * Order of ACL entries in PrefixMetadata is not preserved.
@@ -73,24 +77,24 @@ type legacyStorageImpl struct {
 // GetMetadata is part of Storage interface.
 //
 // For each subprefix in 'prefix' (e.g. for "a/b/c" it would be "a", "a/b", ...)
-// it fetches a bunch of packageACL entities (one per possible role), and merges
-// them into single PrefixMetadata object, thus faking new metadata API on top
-// of legacy entities.
+// it fetches a bunch of packageACL entities (one per possible role) and
+// a prefixRetention entity, and merges them into single PrefixMetadata object,
+// thus faking new metadata API on top of legacy entities.
 //
 // The result also always includes the hardcoded root metadata.
 func (legacyStorageImpl) GetMetadata(ctx context.Context, prefix string) ([]*api.PrefixMetadata, error) {
-	md, _, err := getMetadataImpl(ctx, prefix)
+	md, _, _, err := getMetadataImpl(ctx, prefix)
 	return md, err
 }
 
 // getMetadataImpl implements GetMetadata.
 //
-// As a bonus it returns all packageACL entities it fetched. This is used by
-// VisitMetadata to avoid unnecessary refetches.
-func getMetadataImpl(ctx context.Context, prefix string) ([]*api.PrefixMetadata, []*packageACL, error) {
+// As a bonus it returns all packageACL and prefixRetention entities it fetched.
+// This is used by VisitMetadata to avoid unnecessary refetches.
+func getMetadataImpl(ctx context.Context, prefix string) ([]*api.PrefixMetadata, []*packageACL, []*prefixRetention, error) {
 	prefix, err := common.ValidatePackagePrefix(prefix)
 	if err != nil {
-		return nil, nil, errors.Annotate(err, "bad prefix given to GetMetadata").Err()
+		return nil, nil, nil, errors.Annotate(err, "bad prefix given to GetMetadata").Err()
 	}
 
 	// Grab all subprefixes, i.e. ["a", "a/b", "a/b/c"]
@@ -100,7 +104,9 @@ func getMetadataImpl(ctx context.Context, prefix string) ([]*api.PrefixMetadata,
 			pfxs = append(pfxs, prefix[:i])
 		}
 	}
-	pfxs = append(pfxs, prefix)
+	if prefix != "" {
+		pfxs = append(pfxs, prefix)
+	}
 
 	// Start with the root metadata.
 	out := make([]*api.PrefixMetadata, 0, len(pfxs)+1)
@@ -108,28 +114,30 @@ func getMetadataImpl(ctx context.Context, prefix string) ([]*api.PrefixMetadata,
 
 	// And finish with it if nothing else is requested.
 	if len(pfxs) == 0 {
-		return out, nil, nil
+		return out, nil, nil, nil
 	}
 
 	// Prepare the keys.
 	ents := make([]*packageACL, 0, len(pfxs)*len(legacyRoles))
-	for _, p := range pfxs {
+	rets := make([]*prefixRetention, len(pfxs))
+	for i, p := range pfxs {
 		ents = prefixACLs(ctx, p, ents)
+		rets[i] = prefixRetentionEnt(ctx, p)
 	}
 
 	// Fetch everything. ErrNoSuchEntity errors are fine, everything else is not.
-	if err = datastore.Get(ctx, ents); isInternalDSError(err) {
-		return nil, nil, errors.Annotate(err, "datastore error when fetching PackageACL").Tag(transient.Tag).Err()
+	if err = datastore.Get(ctx, ents, rets); isInternalDSError(err) {
+		return nil, nil, nil, errors.Annotate(err, "datastore error when fetching PackageACL").Tag(transient.Tag).Err()
 	}
 
 	// Combine the result into a bunch of PrefixMetadata structs.
 	legLen := len(legacyRoles)
 	for i, pfx := range pfxs {
-		if md := mergeIntoPrefixMetadata(ctx, pfx, ents[i*legLen:(i+1)*legLen]); md != nil {
+		if md := mergeIntoPrefixMetadata(ctx, pfx, ents[i*legLen:(i+1)*legLen], rets[i]); md != nil {
 			out = append(out, md)
 		}
 	}
-	return out, ents, nil
+	return out, ents, rets, nil
 }
 
 // VisitMetadata is part of Storage interface.
@@ -141,7 +149,7 @@ func (legacyStorageImpl) VisitMetadata(ctx context.Context, prefix string, cb Vi
 
 	// Visit 'prefix' directly first, per VisitMetadata contract. There's a chance
 	// we won't need to recurse deeper at all and can skip all expensive fetches.
-	md, ents, err := getMetadataImpl(ctx, prefix)
+	md, ents, rets, err := getMetadataImpl(ctx, prefix)
 	if err != nil {
 		return err
 	}
@@ -172,8 +180,10 @@ func (legacyStorageImpl) VisitMetadata(ctx context.Context, prefix string, cb Vi
 	// The traversal will be started form "a/b", but we still need the nodes
 	// leading to the root to get all inherited metadata.
 	gr.insert(ctx, ents)
+	gr.insertRetention(ctx, rets)
 
 	// Fetch each per-role subtree separately, they have different key prefixes.
+	// Retention policies are stored in a separate subtree too.
 	err = parallel.FanOutIn(func(tasks chan<- func() error) {
 		mu := sync.Mutex{}
 		for _, role := range legacyRoles {
@@ -188,6 +198,15 @@ func (legacyStorageImpl) VisitMetadata(ctx context.Context, prefix string, cb Vi
 				return err
 			}
 		}
+		tasks <- func() error {
+			listing, err := listRetentionByPrefix(ctx, prefix)
+			if err == nil {
+				mu.Lock()
+				gr.insertRetention(ctx, listing)
+				mu.Unlock()
+			}
+			return err
+		}
 	})
 	if err != nil {
 		return errors.Annotate(err, "failed to fetch metadata").Tag(transient.Tag).Err()
@@ -218,9 +237,10 @@ func (legacyStorageImpl) VisitMetadata(ctx context.Context, prefix string, cb Vi
 
 // UpdateMetadata is part of Storage interface.
 //
-// It assembles prefix metadata from a bunch of packageACL entities, passes it
-// to the callback for modification, then deconstructs it back into a bunch of
-// packageACL entities, to be saved in the datastore. All done transactionally.
+// It assembles prefix metadata from a bunch of packageACL entities (and
+// a prefixRetention entity), passes it to the callback for modification, then
+// deconstructs it back into a bunch of entities, to be saved in the datastore.
+// All done transactionally.
 func (legacyStorageImpl) UpdateMetadata(ctx context.Context, prefix string, cb func(m *api.PrefixMetadata) error) (*api.PrefixMetadata, error) {
 	prefix, err := common.ValidatePackagePrefix(prefix)
 	if err != nil {
@@ -239,7 +259,8 @@ func (legacyStorageImpl) UpdateMetadata(ctx context.Context, prefix string, cb f
 
 		// Fetch the existing metadata.
 		ents := prefixACLs(ctx, prefix, nil)
-		if err := datastore.Get(ctx, ents); isInternalDSError(err) {
+		ret := prefixRetentionEnt(ctx, prefix)
+		if err := datastore.Get(ctx, ents, []*prefixRetention{ret}); isInternalDSError(err) {
 			return errors.Annotate(err, "datastore error when fetching PackageACL").Err()
 		}
 
@@ -247,7 +268,7 @@ func (legacyStorageImpl) UpdateMetadata(ctx context.Context, prefix string, cb f
 		// existing metadata, in which case we construct the default metadata
 		// with no fingerprint (to indicate it is new), see UpdateMetadata doc in
 		// Storage interface.
-		updated = mergeIntoPrefixMetadata(ctx, prefix, ents)
+		updated = mergeIntoPrefixMetadata(ctx, prefix, ents, ret)
 		if updated == nil {
 			updated = &api.PrefixMetadata{Prefix: prefix}
 		}
@@ -277,7 +298,10 @@ func (legacyStorageImpl) UpdateMetadata(ctx context.Context, prefix string, cb f
 		if err := applyACLDiff(ctx, ents, updated); err != nil {
 			return errors.Annotate(err, "failed to update PackageACL entities").Err()
 		}
-		updated = mergeIntoPrefixMetadata(ctx, prefix, ents)
+		if err := applyRetentionDiff(ctx, ret, updated); err != nil {
+			return errors.Annotate(err, "failed to update PrefixRetentionPolicy entity").Err()
+		}
+		updated = mergeIntoPrefixMetadata(ctx, prefix, ents, ret)
 		return nil
 	}, nil)
 
@@ -334,6 +358,23 @@ type packageACL struct {
 	ModifiedTS time.Time `gae:"modified_ts"`
 }
 
+// prefixRetention contains a retention policy of some prefix.
+//
+// There's no such entity in the Python version of the backend. It lives in the
+// same entity group as packageACL entities, so they can be updated together.
+type prefixRetention struct {
+	_kind  string                `gae:"$kind,PrefixRetentionPolicy"`
+	_extra datastore.PropertyMap `gae:"-,extra"`
+
+	ID     string         `gae:"$id"`     // "<pkg/path>"
+	Parent *datastore.Key `gae:"$parent"` // see rootKey()
+	Rev    int            `gae:",noindex"`
+
+	Policy     []byte    `gae:",noindex"` // serialized api.RetentionPolicy, nil if removed
+	ModifiedBy string    `gae:",noindex"`
+	ModifiedTS time.Time `gae:",noindex"`
+}
+
 // parseKey parses the entity key into its components, validating them.
 //
 // On success, role is one of legacyRoles and prefix is non-empty valid prefix.
@@ -355,6 +396,9 @@ func (e *packageACL) parseKey() (role, prefix string, err error) {
 
 // isInternalDSError is true for datastore errors that aren't entirely
 // ErrNoSuchEntity.
+//
+// Understands nested multi-errors returned when fetching multiple slices of
+// entities at once.
 func isInternalDSError(err error) bool {
 	if err == nil {
 		return false
@@ -366,7 +410,12 @@ func isInternalDSError(err error) bool {
 	}
 
 	for _, e := range merr {
-		if e != nil && e != datastore.ErrNoSuchEntity {
+		switch _, nested := e.(errors.MultiError); {
+		case e == nil || e == datastore.ErrNoSuchEntity:
+			continue
+		case nested && !isInternalDSError(e):
+			continue // all suberrors of this slice are fine
+		default:
 			return true // some serious issues with this single entity
 		}
 	}
@@ -402,15 +451,27 @@ func prefixACLs(ctx context.Context, prefix string, in []*packageACL) []*package
 	return in
 }
 
-// mergeIntoPrefixMetadata takes exactly len(legacyRoles) entities and combines
-// them into a single PrefixMetadata object if at least one of the entities is
-// not empty. Returns nil of all entities are empty.
+// prefixRetentionEnt returns an empty prefixRetention entity (with the key
+// populated).
+func prefixRetentionEnt(ctx context.Context, prefix string) *prefixRetention {
+	return &prefixRetention{
+		ID:     prefix,
+		Parent: rootKey(ctx),
+	}
+}
+
+// mergeIntoPrefixMetadata takes exactly len(legacyRoles) entities and
+// a retention policy entity (which may be nil) and combines them into a single
+// PrefixMetadata object if at least one of the entities is not empty. Returns
+// nil of all entities are empty.
 //
 // The entities are expected to have IDs matching ones generated by
-// prefixACLs(ctx, prefix). Panics otherwise.
+// prefixACLs(ctx, prefix) and prefixRetentionEnt(ctx, prefix). Panics
+// otherwise.
 //
-// Logs and skips invalid principal names (should not be happening in reality).
-func mergeIntoPrefixMetadata(ctx context.Context, prefix string, ents []*packageACL) *api.PrefixMetadata {
+// Logs and skips invalid principal names and retention policies (should not be
+// happening in reality).
+func mergeIntoPrefixMetadata(ctx context.Context, prefix string, ents []*packageACL, ret *prefixRetention) *api.PrefixMetadata {
 	if len(ents) != len(legacyRoles) {
 		panic(fmt.Sprintf("expecting %d entities, got %d", len(legacyRoles), len(ents)))
 	}
@@ -462,6 +523,25 @@ func mergeIntoPrefixMetadata(ctx context.Context, prefix string, ents []*package
 		}
 	}
 
+	if ret != nil && !ret.ModifiedTS.IsZero() {
+		if ret.ID != prefix {
+			panic(fmt.Sprintf("expecting key %q, got %q", prefix, ret.ID))
+		}
+		if modTime.IsZero() || modTime.Before(ret.ModifiedTS) {
+			modTime = ret.ModifiedTS
+			md.UpdateUser = ret.ModifiedBy
+			md.UpdateTime = google.NewTimestamp(modTime)
+		}
+		if len(ret.Policy) != 0 {
+			policy := &api.RetentionPolicy{}
+			if err := proto.Unmarshal(ret.Policy, policy); err != nil {
+				logging.Errorf(ctx, "Bad retention policy in PrefixRetentionPolicy %q - %s", ret.ID, err)
+			} else {
+				md.RetentionPolicy = policy
+			}
+		}
+	}
+
 	// If modTime is zero, then none of packageACL entities exist. Return nil in
 	// this case to indicate there's no metadata at all. Note that it is possible
 	// that some packageACL entities exist, but have empty ACLs. We consider this
@@ -531,6 +611,26 @@ func applyACLDiff(ctx context.Context, ents []*packageACL, md *api.PrefixMetadat
 	return datastore.Put(ctx, toPut)
 }
 
+// applyRetentionDiff compares the retention policy in 'md' to the one stored in
+// 'ent', and Puts the updated entity into the datastore if they differ.
+func applyRetentionDiff(ctx context.Context, ent *prefixRetention, md *api.PrefixMetadata) error {
+	var blob []byte
+	if md.RetentionPolicy != nil {
+		var err error
+		if blob, err = proto.Marshal(md.RetentionPolicy); err != nil {
+			return err
+		}
+	}
+	if bytes.Equal(blob, ent.Policy) {
+		return nil // no changes, do not touch the entity
+	}
+	ent.Rev++
+	ent.Policy = blob
+	ent.ModifiedBy = md.UpdateUser
+	ent.ModifiedTS = google.TimeFromProto(md.UpdateTime)
+	return datastore.Put(ctx, ent)
+}
+
 func isEqualStrSlice(a, b []string) bool {
 	if len(a) != len(b) {
 		return false
@@ -580,6 +680,30 @@ func listACLsByPrefix(ctx context.Context, role, prefix string) (acls []*package
 	return
 }
 
+// listRetentionByPrefix returns prefixRetention entities under the given
+// prefix.
+//
+// Same as listACLsByPrefix, but for retention policies.
+func listRetentionByPrefix(ctx context.Context, prefix string) (rets []*prefixRetention, err error) {
+	if prefix, err = common.ValidatePackagePrefix(prefix); err != nil {
+		return nil, err
+	}
+
+	keyPfx := ""
+	if prefix != "" {
+		keyPfx = prefix + "/"
+	}
+
+	q := datastore.NewQuery("PrefixRetentionPolicy").Ancestor(rootKey(ctx))
+	q = q.Gt("__key__", datastore.KeyForObj(ctx, prefixRetentionEnt(ctx, keyPfx+"\x00")))
+	q = q.Lt("__key__", datastore.KeyForObj(ctx, prefixRetentionEnt(ctx, keyPfx+"\xff")))
+
+	if err = datastore.GetAll(ctx, q, &rets); err != nil {
+		return nil, errors.Annotate(err, "failed to query the list of retention policies").Tag(transient.Tag).Err()
+	}
+	return
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Metadata graph used by VisitMetadata implementation.
 
@@ -588,8 +712,9 @@ func listACLsByPrefix(ctx context.Context, role, prefix string) (acls []*package
 // It can be in non-frozen (== under construction) and frozen (== constructed)
 // states.
 type metadataNode struct {
-	prefix string        // this node's full prefix, e.g. "a/b/c"
-	acls   []*packageACL // exactly len(legacyRoles) items with node's ACLs, nil when frozen
+	prefix string           // this node's full prefix, e.g. "a/b/c"
+	acls   []*packageACL    // exactly len(legacyRoles) items with node's ACLs, nil when frozen
+	ret    *prefixRetention // node's retention policy or nil if not set
 
 	parent   *metadataNode
 	children map[string]*metadataNode // direct children of the node
@@ -660,8 +785,19 @@ func (n *metadataNode) attachACL(role string, e *packageACL) {
 	panic(fmt.Sprintf("unexpected impossible role %q", role))
 }
 
+// attachRetention attaches a prefixRetention to this node.
+//
+// It is merged into PrefixMetadata together with ACLs in 'freeze'.
+func (n *metadataNode) attachRetention(e *prefixRetention) {
+	n.assertNonFrozen()
+	if n.ret != nil {
+		panic(fmt.Sprintf("retention policy at %q is already attached", n.prefix))
+	}
+	n.ret = e
+}
+
 // freeze marks the node and its subtree as fully constructed, calculating their
-// PrefixMetadata from attached ACLs.
+// PrefixMetadata from attached ACLs and retention policies.
 //
 // The context is used only for logging.
 func (n *metadataNode) freeze(ctx context.Context) {
@@ -669,9 +805,10 @@ func (n *metadataNode) freeze(ctx context.Context) {
 
 	// md may be already non-nil for the root, this is fine.
 	if n.md == nil {
-		n.md = mergeIntoPrefixMetadata(ctx, n.prefix, n.acls)
+		n.md = mergeIntoPrefixMetadata(ctx, n.prefix, n.acls, n.ret)
 	}
 	n.acls = nil // mark as frozen, release unnecessary memory
+	n.ret = nil
 
 	for _, child := range n.children {
 		child.freeze(ctx)
@@ -783,6 +920,25 @@ func (g *metadataGraph) insert(ctx context.Context, ents []*packageACL) {
 	}
 }
 
+// insertRetention attaches retention policies in the given entities to nodes in
+// the graph.
+//
+// Silently ignores empty entities (based on ModifiedTS value). Logs and ignores
+// broken ones. The context is used only for logging.
+func (g *metadataGraph) insertRetention(ctx context.Context, ents []*prefixRetention) {
+	for _, e := range ents {
+		if e.ModifiedTS.IsZero() {
+			continue // zero body, no such entity in the datastore, skip
+		}
+		pfx, err := common.ValidatePackagePrefix(e.ID)
+		if err != nil || pfx == "" {
+			logging.Errorf(ctx, "Skipping bad PrefixRetentionPolicy entity with key %q", e.ID)
+			continue
+		}
+		g.node(pfx).attachRetention(e)
+	}
+}
+
 // freeze finalizes graph construction by calculating all PrefixMetadata items.
 //
 // The graph is not modifiable after this point, but it becomes traversable.
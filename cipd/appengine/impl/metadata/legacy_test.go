@@ -317,6 +317,59 @@ func TestLegacyMetadata(t *testing.T) {
 			So(md, ShouldResembleProto, []*api.PrefixMetadata{rootMeta, expected})
 		})
 
+		Convey("UpdateMetadata updates retention policy", func() {
+			policy := &api.RetentionPolicy{KeepLastUntagged: 5}
+
+			// Adding a policy to existing ACLs.
+			updated, err := impl.UpdateMetadata(ctx, "a/b/c/d", func(md *api.PrefixMetadata) error {
+				md.UpdateTime = google.NewTimestamp(ts.Add(time.Hour))
+				md.UpdateUser = "user:updater@example.com"
+				md.RetentionPolicy = policy
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(updated.RetentionPolicy, ShouldResembleProto, policy)
+			So(updated.UpdateUser, ShouldEqual, "user:updater@example.com")
+			So(updated.Acls, ShouldResembleProto, expected["a/b/c/d"].Acls)
+
+			md, err := impl.GetMetadata(ctx, "a/b/c/d")
+			So(err, ShouldBeNil)
+			So(md, ShouldHaveLength, 4)
+			So(md[3], ShouldResembleProto, updated)
+
+			// Creating new metadata with only a retention policy.
+			updated, err = impl.UpdateMetadata(ctx, "z", func(md *api.PrefixMetadata) error {
+				md.UpdateTime = google.NewTimestamp(ts)
+				md.UpdateUser = "user:updater@example.com"
+				md.RetentionPolicy = policy
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(updated.Fingerprint, ShouldNotEqual, "")
+			So(updated.RetentionPolicy, ShouldResembleProto, policy)
+
+			// It is visible when visiting.
+			var visited []*api.PrefixMetadata
+			err = impl.VisitMetadata(ctx, "", func(p string, md []*api.PrefixMetadata) (bool, error) {
+				if p == "z" {
+					visited = md
+				}
+				return true, nil
+			})
+			So(err, ShouldBeNil)
+			So(visited, ShouldResembleProto, []*api.PrefixMetadata{rootMeta, updated})
+
+			// Removing the policy.
+			removed, err := impl.UpdateMetadata(ctx, "z", func(md *api.PrefixMetadata) error {
+				md.UpdateTime = google.NewTimestamp(ts.Add(time.Hour))
+				md.RetentionPolicy = nil
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(removed.RetentionPolicy, ShouldBeNil)
+			So(removed.Fingerprint, ShouldNotEqual, updated.Fingerprint)
+		})
+
 		Convey("UpdateMetadata call with failing callback", func() {
 			cbErr := errors.New("blah")
 			updated, err := impl.UpdateMetadata(ctx, "z", func(md *api.PrefixMetadata) error {
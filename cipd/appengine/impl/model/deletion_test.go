@@ -63,9 +63,9 @@ func TestDeletePackage(t *testing.T) {
 			return
 		}
 
-		// This will create 20 entities to be deleted. For deletionBatchSize == 3
-		// set above, it means we'll have 6 full batches and one incomplete final
-		// batch, thus covering all important code paths.
+		// This will create 28 entities to be deleted. For deletionBatchSize == 3
+		// set above, it means we'll have a bunch of full batches and incomplete
+		// final batches, thus covering all important code paths.
 		for _, chr := range []string{"a", "b", "c", "d"} {
 			reg, inst, _ := RegisterInstance(ctx, &Instance{
 				InstanceID: strings.Repeat(chr, 40),
@@ -77,12 +77,18 @@ func TestDeletePackage(t *testing.T) {
 				ProcID:   "zzz",
 				Instance: datastore.KeyForObj(ctx, inst),
 			}), ShouldBeNil)
+			So(IndexInstanceFiles(ctx, inst, []*InstanceFile{
+				{Path: "bin/" + chr},
+			}), ShouldBeNil)
 
 			So(SetRef(ctx, chr+"-ref", inst), ShouldBeNil)
 			So(AttachTags(ctx, inst, []*api.Tag{
 				{Key: "k1", Value: chr},
 				{Key: "k2", Value: chr},
 			}), ShouldBeNil)
+			So(AttachMetadata(ctx, inst, []*api.InstanceMetadata{
+				{Key: "md", Value: []byte(chr)},
+			}), ShouldBeNil)
 		}
 
 		// Some unrelated instance in a different package to be left alone.
@@ -93,7 +99,7 @@ func TestDeletePackage(t *testing.T) {
 		So(reg, ShouldBeTrue)
 
 		// Before the deletion.
-		So(entitiesCount(PackageKey(ctx, "pkg")), ShouldEqual, 21)
+		So(entitiesCount(PackageKey(ctx, "pkg")), ShouldEqual, 29)
 		So(entitiesCount(PackageKey(ctx, "another-pkg")), ShouldEqual, 2)
 
 		So(DeletePackage(ctx, "pkg"), ShouldBeNil)
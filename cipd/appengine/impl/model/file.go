@@ -0,0 +1,216 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"path"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	"go.chromium.org/luci/cipd/common"
+)
+
+// InstanceFile is a file inside some package instance.
+//
+// Such entities form an index that allows to find instances containing some
+// particular file. They are produced by the file indexer processor after the
+// instance is uploaded. Immutable.
+//
+// Entity ID is a hex SHA256 digest of the file path, see InstanceFileID().
+// Parent entity is the PackageInstance the file is in.
+type InstanceFile struct {
+	_kind  string                `gae:"$kind,InstanceFile"`
+	_extra datastore.PropertyMap `gae:"-,extra"`
+
+	ID       string         `gae:"$id"`     // see InstanceFileID()
+	Instance *datastore.Key `gae:"$parent"` // a key of the corresponding Instance
+
+	Path     string `gae:"path"`         // slash-separated path inside the package
+	BaseName string `gae:"base_name"`    // the last component of the path
+	Hash     string `gae:"hash"`         // hex-encoded SHA256 of the file body
+	Size     int64  `gae:"size,noindex"` // the file size in bytes
+	Exec     bool   `gae:"exec,noindex"` // true if the file is executable
+}
+
+// InstanceFileID returns ID of an InstanceFile entity given the file path.
+func InstanceFileID(p string) string {
+	digest := sha256.Sum256([]byte(p))
+	return hex.EncodeToString(digest[:])
+}
+
+// FileMatch is returned by SearchInstanceFiles.
+type FileMatch struct {
+	Instance *Instance     // the instance that contains the file
+	File     *InstanceFile // the file itself
+}
+
+var (
+	// Number of entities to put or delete at once. Replaced in tests.
+	fileIndexBatchSize = 500
+	// Maximum number of entities to visit in a single SearchInstanceFiles call.
+	fileSearchMaxScan = 1000
+)
+
+// IndexInstanceFiles stores InstanceFile entities of the given instance.
+//
+// Populates their ID and Instance fields based on Path and 'inst'. Overwrites
+// existing entities, if any. Returned errors are tagged as transient.
+func IndexInstanceFiles(c context.Context, inst *Instance, files []*InstanceFile) error {
+	instKey := datastore.KeyForObj(c, inst)
+	for _, f := range files {
+		f.ID = InstanceFileID(f.Path)
+		f.Instance = instKey
+	}
+	for len(files) > 0 {
+		batch := files
+		if len(batch) > fileIndexBatchSize {
+			batch = batch[:fileIndexBatchSize]
+		}
+		if err := datastore.Put(c, batch); err != nil {
+			return errors.Annotate(err, "failed to store %d InstanceFile entities", len(batch)).Tag(transient.Tag).Err()
+		}
+		files = files[len(batch):]
+	}
+	return nil
+}
+
+// ListInstanceFiles returns all indexed files of an instance, ordered by path.
+//
+// Returns an empty list if the instance wasn't indexed or doesn't exist.
+func ListInstanceFiles(c context.Context, inst *Instance) (out []*InstanceFile, err error) {
+	q := datastore.NewQuery("InstanceFile").Ancestor(datastore.KeyForObj(c, inst))
+	if err := datastore.GetAll(c, q.Order("path"), &out); err != nil {
+		return nil, errors.Annotate(err, "datastore query failed").Tag(transient.Tag).Err()
+	}
+	return
+}
+
+// DeleteInstanceFiles deletes all InstanceFile entities of an instance.
+//
+// Not transactional, since an instance may have many files. Returned errors are
+// tagged as transient.
+func DeleteInstanceFiles(c context.Context, inst *Instance) error {
+	var keys []*datastore.Key
+	q := datastore.NewQuery("InstanceFile").Ancestor(datastore.KeyForObj(c, inst)).KeysOnly(true)
+	if err := datastore.GetAll(c, q, &keys); err != nil {
+		return errors.Annotate(err, "failed to list InstanceFile entities").Tag(transient.Tag).Err()
+	}
+	for len(keys) > 0 {
+		batch := keys
+		if len(batch) > fileIndexBatchSize {
+			batch = batch[:fileIndexBatchSize]
+		}
+		if err := datastore.Delete(c, batch); err != nil {
+			return errors.Annotate(err, "failed to delete %d InstanceFile entities", len(batch)).Tag(transient.Tag).Err()
+		}
+		keys = keys[len(batch):]
+	}
+	return nil
+}
+
+// SearchInstanceFiles finds indexed files matching the glob.
+//
+// The glob should be validated already with common.ValidateFileGlob. The query
+// is done either by the literal file name or by the literal path prefix of the
+// glob, and the results are then filtered using path.Match.
+//
+// 'visible' is called for each matching file to check whether the caller can
+// see the package it belongs to. Errors from it are returned as is.
+//
+// Returns up to pageSize matches (perhaps fewer, since it gives up after
+// visiting a bunch of non-matching entities), plus non-nil cursor (if there are
+// more results). Skips files of instances that no longer exist. 'pageSize' must
+// be positive.
+func SearchInstanceFiles(c context.Context, glob string, visible func(pkg string) (bool, error), pageSize int32, cursor datastore.Cursor) (out []*FileMatch, nextCur datastore.Cursor, err error) {
+	if pageSize <= 0 {
+		panic("pageSize must be positive")
+	}
+
+	q := datastore.NewQuery("InstanceFile")
+	switch prefix, base := common.FileGlobLiterals(glob); {
+	case base != "":
+		q = q.Eq("base_name", base)
+	case prefix != "":
+		q = q.Gte("path", prefix).Lt("path", prefix+"\U0010FFFF")
+	default:
+		panic("the glob can't be searched for, it should have been validated")
+	}
+	if cursor != nil {
+		q = q.Start(cursor)
+	}
+
+	var files []*InstanceFile
+	var visErr error
+	scanned := 0
+	err = datastore.Run(c, q, func(f *InstanceFile, cb datastore.CursorCB) error {
+		scanned++
+		if matched, _ := path.Match(glob, f.Path); matched {
+			switch yes, err := visible(f.Instance.Parent().StringID()); {
+			case err != nil:
+				visErr = err
+				return datastore.Stop
+			case yes:
+				files = append(files, f)
+			}
+		}
+		if len(files) >= int(pageSize) || scanned >= fileSearchMaxScan {
+			var err error
+			if nextCur, err = cb(); err != nil {
+				return err
+			}
+			return datastore.Stop
+		}
+		return nil
+	})
+	switch {
+	case visErr != nil:
+		return nil, nil, visErr
+	case err != nil:
+		return nil, nil, errors.Annotate(err, "failed to query files").Tag(transient.Tag).Err()
+	case len(files) == 0:
+		return nil, nextCur, nil
+	}
+
+	// Fetch instances the files belong to, skipping ones that are gone.
+	var keys []*datastore.Key
+	seen := map[string]bool{}
+	for _, f := range files {
+		if k := f.Instance.Encode(); !seen[k] {
+			seen[k] = true
+			keys = append(keys, f.Instance)
+		}
+	}
+	instances, err := fetchExistingInstances(c, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	existing := make(map[string]*Instance, len(instances))
+	for _, inst := range instances {
+		existing[datastore.KeyForObj(c, inst).Encode()] = inst
+	}
+
+	out = make([]*FileMatch, 0, len(files))
+	for _, f := range files {
+		if inst := existing[f.Instance.Encode()]; inst != nil {
+			out = append(out, &FileMatch{Instance: inst, File: f})
+		}
+	}
+	return
+}
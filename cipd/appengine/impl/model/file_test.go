@@ -0,0 +1,123 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"go.chromium.org/luci/gae/service/datastore"
+
+	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestInstanceFiles(t *testing.T) {
+	t.Parallel()
+
+	Convey("With datastore", t, func() {
+		ctx, _, _ := testutil.TestingContext()
+
+		register := func(pkg, chr string, paths ...string) *Instance {
+			_, inst, err := RegisterInstance(ctx, &Instance{
+				InstanceID: strings.Repeat(chr, 40),
+				Package:    PackageKey(ctx, pkg),
+			}, nil)
+			So(err, ShouldBeNil)
+			files := make([]*InstanceFile, len(paths))
+			for i, p := range paths {
+				files[i] = &InstanceFile{
+					Path:     p,
+					BaseName: p[strings.LastIndex(p, "/")+1:],
+					Hash:     strings.Repeat(chr, 64),
+				}
+			}
+			So(IndexInstanceFiles(ctx, inst, files), ShouldBeNil)
+			return inst
+		}
+
+		a := register("pkg/a", "a", "bin/gn", "bin/ninja", "README")
+		b := register("pkg/b", "b", "tools/bin/gn", "gn.py")
+		register("secret/c", "c", "bin/gn")
+
+		datastore.GetTestable(ctx).CatchupIndexes()
+
+		visible := func(pkg string) (bool, error) {
+			return strings.HasPrefix(pkg, "pkg/"), nil
+		}
+
+		search := func(glob string, pageSize int32) (out []string) {
+			var cur datastore.Cursor
+			for {
+				res, next, err := SearchInstanceFiles(ctx, glob, visible, pageSize, cur)
+				So(err, ShouldBeNil)
+				for _, m := range res {
+					out = append(out, m.Instance.Package.StringID()+":"+m.File.Path)
+				}
+				if next == nil {
+					return
+				}
+				cur = next
+			}
+		}
+
+		Convey("ListInstanceFiles works", func() {
+			files, err := ListInstanceFiles(ctx, a)
+			So(err, ShouldBeNil)
+			So(files, ShouldHaveLength, 3)
+			So(files[0].Path, ShouldEqual, "README")
+			So(files[0].ID, ShouldEqual, InstanceFileID("README"))
+			So(files[0].Instance, ShouldResemble, datastore.KeyForObj(ctx, a))
+		})
+
+		Convey("By base name", func() {
+			So(search("bin/gn", 10), ShouldResemble, []string{"pkg/a:bin/gn"})
+			So(search("*/gn", 10), ShouldResemble, []string{"pkg/a:bin/gn"})
+			So(search("*/*/gn", 10), ShouldResemble, []string{"pkg/b:tools/bin/gn"})
+			So(search("gn", 10), ShouldBeEmpty)
+		})
+
+		Convey("By prefix", func() {
+			So(search("bin/*", 10), ShouldResemble, []string{"pkg/a:bin/gn", "pkg/a:bin/ninja"})
+			So(search("gn*", 10), ShouldResemble, []string{"pkg/b:gn.py"})
+		})
+
+		Convey("Pagination", func() {
+			So(search("bin/*", 1), ShouldResemble, []string{"pkg/a:bin/gn", "pkg/a:bin/ninja"})
+		})
+
+		Convey("Skips deleted instances", func() {
+			So(datastore.Delete(ctx, b), ShouldBeNil)
+			So(search("*/*/gn", 10), ShouldBeEmpty)
+		})
+
+		Convey("Visibility errors", func() {
+			_, _, err := SearchInstanceFiles(ctx, "bin/gn", func(string) (bool, error) {
+				return false, errors.New("boom")
+			}, 10, nil)
+			So(err, ShouldErrLike, "boom")
+		})
+
+		Convey("DeleteInstanceFiles works", func() {
+			So(DeleteInstanceFiles(ctx, a), ShouldBeNil)
+			files, err := ListInstanceFiles(ctx, a)
+			So(err, ShouldBeNil)
+			So(files, ShouldBeEmpty)
+		})
+	})
+}
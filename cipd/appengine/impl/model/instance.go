@@ -138,6 +138,11 @@ func RegisterInstance(c context.Context, inst *Instance, cb func(context.Context
 			}
 		}
 
+		// Record that the instance file is used by this package too.
+		if err := addInstanceUsage(c, inst.Package.StringID(), inst.InstanceID); err != nil {
+			return err
+		}
+
 		// Finally register the package instance entity.
 		if err := datastore.Put(c, &toPut); err != nil {
 			return errors.Annotate(err, "failed to create the package instance entity").Tag(transient.Tag).Err()
@@ -0,0 +1,274 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"go.chromium.org/luci/gae/service/datastore"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/server/auth"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// InstanceMetadata represents a metadata entry attached to some package
+// instance.
+//
+// Just like tags, metadata entries exist as separate entities to allow
+// essentially unlimited number of them and to avoid fetching them when fetching
+// Instance entity.
+//
+// ID is a fingerprint of the key/value pair (see
+// common.InstanceMetadataFingerprint). The parent entity is the corresponding
+// Instance.
+//
+// Just like with tags, we double check 'Key' and 'Value' fields in all
+// entities we touch to safeguard against (extremely unlikely) fingerprint
+// collisions.
+type InstanceMetadata struct {
+	_kind  string                `gae:"$kind,InstanceMetadata"`
+	_extra datastore.PropertyMap `gae:"-,extra"`
+
+	Fingerprint string         `gae:"$id"`     // see common.InstanceMetadataFingerprint
+	Instance    *datastore.Key `gae:"$parent"` // key of corresponding Instance entity
+
+	Key         string    `gae:"key"`                  // the metadata key
+	Value       []byte    `gae:"value,noindex"`        // the metadata value
+	ContentType string    `gae:"content_type,noindex"` // its content type, FYI
+	AttachedBy  string    `gae:"attached_by"`          // who added this entry
+	AttachedTs  time.Time `gae:"attached_ts"`          // when it was added
+}
+
+// Proto returns cipd.InstanceMetadata proto with information from this entity.
+func (md *InstanceMetadata) Proto() *api.InstanceMetadata {
+	return &api.InstanceMetadata{
+		Key:         md.Key,
+		Value:       md.Value,
+		ContentType: md.ContentType,
+		Fingerprint: md.Fingerprint,
+		AttachedBy:  md.AttachedBy,
+		AttachedTs:  google.NewTimestamp(md.AttachedTs),
+	}
+}
+
+// AttachMetadata transactionally attaches a bunch of metadata entries to an
+// instance.
+//
+// Assumes inputs are already validated. Launches a transaction inside (and thus
+// can't be a part of a transaction itself). Updates 'inst' in-place with the
+// most recent instance state.
+//
+// Returns gRPC-tagged errors:
+//    NotFound if there's no such instance or package.
+//    FailedPrecondition if some processors are still running.
+//    Aborted if some processors have failed.
+//    Internal on fingerprint collision.
+func AttachMetadata(c context.Context, inst *Instance, md []*api.InstanceMetadata) error {
+	return Txn(c, "AttachMetadata", func(c context.Context) error {
+		if err := CheckInstanceReady(c, inst); err != nil {
+			return err
+		}
+
+		_, missing, err := checkExistingMetadata(c, inst, md)
+		if err != nil {
+			return err
+		}
+
+		events := Events{}
+		now := clock.Now(c).UTC()
+		who := string(auth.CurrentIdentity(c))
+		for _, ent := range missing {
+			ent.AttachedBy = who
+			ent.AttachedTs = now
+			events.Emit(&api.Event{
+				Kind:          api.EventKind_INSTANCE_METADATA_ATTACHED,
+				Package:       inst.Package.StringID(),
+				Instance:      inst.InstanceID,
+				MdKey:         ent.Key,
+				MdContentType: ent.ContentType,
+				MdFingerprint: ent.Fingerprint,
+				Who:           who,
+				When:          google.NewTimestamp(now),
+			})
+		}
+
+		if err := datastore.Put(c, missing); err != nil {
+			return transient.Tag.Apply(err)
+		}
+		return events.Flush(c)
+	})
+}
+
+// DetachMetadata detaches a bunch of metadata entries from an instance.
+//
+// Entries are identified either by their fingerprints or by their key/value
+// pairs. Assumes inputs are already validated. Launches a transaction inside
+// (and thus can't be a part of a transaction itself).
+//
+// 'inst' is used only for its key.
+func DetachMetadata(c context.Context, inst *Instance, md []*api.InstanceMetadata) error {
+	return Txn(c, "DetachMetadata", func(c context.Context) error {
+		instKey := datastore.KeyForObj(c, inst)
+		ents := make([]*InstanceMetadata, len(md))
+		for i, m := range md {
+			fp := m.Fingerprint
+			if fp == "" {
+				fp = common.InstanceMetadataFingerprint(m.Key, m.Value)
+			}
+			ents[i] = &InstanceMetadata{Fingerprint: fp, Instance: instKey}
+		}
+
+		existing, _, err := fetchMetadata(c, ents, func(i int) *api.InstanceMetadata {
+			if md[i].Fingerprint != "" {
+				return nil // no key/value to compare to, trust the fingerprint
+			}
+			return md[i]
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := datastore.Delete(c, existing); err != nil {
+			return transient.Tag.Apply(err)
+		}
+
+		events := Events{}
+		for _, ent := range existing {
+			events.Emit(&api.Event{
+				Kind:          api.EventKind_INSTANCE_METADATA_DETACHED,
+				Package:       inst.Package.StringID(),
+				Instance:      inst.InstanceID,
+				MdKey:         ent.Key,
+				MdContentType: ent.ContentType,
+				MdFingerprint: ent.Fingerprint,
+			})
+		}
+		return events.Flush(c)
+	})
+}
+
+// ListMetadata returns metadata entries attached to an instance, sorting them
+// by the timestamp (most recent first), then by key.
+//
+// If 'keys' is not empty, returns only entries with these keys.
+//
+// Returns an empty list if there's no such instance at all.
+func ListMetadata(c context.Context, inst *Instance, keys []string) (out []*InstanceMetadata, err error) {
+	// Filtering by key is done in memory, since instances typically have very
+	// few metadata entries and this allows us to avoid composite indexes.
+	q := datastore.NewQuery("InstanceMetadata").Ancestor(datastore.KeyForObj(c, inst))
+	if err := datastore.GetAll(c, q, &out); err != nil {
+		return nil, errors.Annotate(err, "datastore query failed").Tag(transient.Tag).Err()
+	}
+	if len(keys) != 0 {
+		filter := stringset.NewFromSlice(keys...)
+		filtered := out[:0]
+		for _, md := range out {
+			if filter.Has(md.Key) {
+				filtered = append(filtered, md)
+			}
+		}
+		out = filtered
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].AttachedTs.Equal(out[j].AttachedTs) {
+			return out[i].AttachedTs.After(out[j].AttachedTs)
+		}
+		if out[i].Key != out[j].Key {
+			return out[i].Key < out[j].Key
+		}
+		return out[i].Fingerprint < out[j].Fingerprint
+	})
+	return out, nil
+}
+
+// checkExistingMetadata takes a list of metadata entries and returns the ones
+// that exist in the datastore and ones that don't.
+//
+// Entities in 'miss' list have their key, Key, Value and ContentType fields
+// set and nothing more.
+func checkExistingMetadata(c context.Context, inst *Instance, md []*api.InstanceMetadata) (exist, miss []*InstanceMetadata, err error) {
+	instKey := datastore.KeyForObj(c, inst)
+	ents := make([]*InstanceMetadata, len(md))
+	for i, m := range md {
+		ents[i] = &InstanceMetadata{
+			Fingerprint: common.InstanceMetadataFingerprint(m.Key, m.Value),
+			Instance:    instKey,
+		}
+	}
+	return fetchMetadata(c, ents, func(i int) *api.InstanceMetadata { return md[i] })
+}
+
+// fetchMetadata fetches given metadata entities and categorizes them into
+// existing and missing ones.
+//
+// Checks 'Key' and 'Value' fields on existing entities (by comparing them to
+// what 'expected' callback returns for the corresponding index), thus
+// safeguarding against malicious fingerprint collisions. If the callback
+// returns nil, the check is skipped.
+func fetchMetadata(c context.Context, ents []*InstanceMetadata, expected func(idx int) *api.InstanceMetadata) (exist, miss []*InstanceMetadata, err error) {
+	found := make([]bool, len(ents))
+	if err := datastore.Get(c, ents); err != nil {
+		merr, ok := err.(errors.MultiError)
+		if !ok {
+			return nil, nil, errors.Annotate(err, "failed to fetch metadata").Tag(transient.Tag).Err()
+		}
+		for i, err := range merr {
+			switch err {
+			case nil:
+				found[i] = true
+			case datastore.ErrNoSuchEntity:
+			default:
+				return nil, nil, errors.Annotate(err, "failed to fetch metadata with fingerprint %q", ents[i].Fingerprint).Tag(transient.Tag).Err()
+			}
+		}
+	} else {
+		for i := range found {
+			found[i] = true
+		}
+	}
+
+	for i, ent := range ents {
+		exp := expected(i)
+		switch {
+		case !found[i]:
+			if exp != nil {
+				// So the caller knows what metadata this is.
+				ent.Key = exp.Key
+				ent.Value = exp.Value
+				ent.ContentType = exp.ContentType
+			}
+			miss = append(miss, ent)
+		case exp == nil || (ent.Key == exp.Key && bytes.Equal(ent.Value, exp.Value)):
+			exist = append(exist, ent)
+		default:
+			return nil, nil, errors.Reason("metadata with fingerprint %q collides with an existing entry, refusing to touch it", ent.Fingerprint).
+				Tag(grpcutil.InternalTag).Err()
+		}
+	}
+
+	return
+}
@@ -0,0 +1,214 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+	"go.chromium.org/luci/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestMetadata(t *testing.T) {
+	t.Parallel()
+
+	Convey("With datastore", t, func() {
+		digest := strings.Repeat("a", 40)
+
+		ctx, tc, as := testutil.TestingContext()
+
+		putInst := func(pkg, iid string, pendingProcs []string) *Instance {
+			inst := &Instance{
+				InstanceID:        iid,
+				Package:           PackageKey(ctx, pkg),
+				ProcessorsPending: pendingProcs,
+			}
+			So(datastore.Put(ctx, &Package{Name: pkg}, inst), ShouldBeNil)
+			return inst
+		}
+
+		md := func(kv ...string) []*api.InstanceMetadata {
+			out := make([]*api.InstanceMetadata, len(kv))
+			for i, s := range kv {
+				chunks := strings.SplitN(s, ":", 2)
+				out[i] = &api.InstanceMetadata{
+					Key:         chunks[0],
+					Value:       []byte(chunks[1]),
+					ContentType: "text/plain",
+				}
+			}
+			return out
+		}
+
+		listMD := func(inst *Instance, keys ...string) []string {
+			ents, err := ListMetadata(ctx, inst, keys)
+			So(err, ShouldBeNil)
+			out := make([]string, len(ents))
+			for i, ent := range ents {
+				out[i] = ent.Key + ":" + string(ent.Value)
+			}
+			return out
+		}
+
+		Convey("Proto() works", func() {
+			inst := putInst("pkg", digest, nil)
+			ent := &InstanceMetadata{
+				Fingerprint: common.InstanceMetadataFingerprint("k", []byte("v")),
+				Instance:    datastore.KeyForObj(ctx, inst),
+				Key:         "k",
+				Value:       []byte("v"),
+				ContentType: "text/plain",
+				AttachedBy:  string(testutil.TestUser),
+				AttachedTs:  testutil.TestTime,
+			}
+			So(ent.Proto(), ShouldResembleProto, &api.InstanceMetadata{
+				Key:         "k",
+				Value:       []byte("v"),
+				ContentType: "text/plain",
+				Fingerprint: common.InstanceMetadataFingerprint("k", []byte("v")),
+				AttachedBy:  string(testutil.TestUser),
+				AttachedTs:  google.NewTimestamp(testutil.TestTime),
+			})
+		})
+
+		Convey("AttachMetadata, ListMetadata and DetachMetadata", func() {
+			inst := putInst("pkg", digest, nil)
+
+			So(AttachMetadata(ctx, inst, md("a:0", "b:0")), ShouldBeNil)
+			tc.Add(time.Second)
+			So(AttachMetadata(ctx, inst, md("a:1")), ShouldBeNil)
+
+			// Reattaching existing entries (by another user) is noop.
+			tc.Add(time.Second)
+			So(AttachMetadata(as("def@example.com"), inst, md("a:0")), ShouldBeNil)
+
+			So(listMD(inst), ShouldResemble, []string{"a:1", "a:0", "b:0"})
+			So(listMD(inst, "a"), ShouldResemble, []string{"a:1", "a:0"})
+			So(listMD(inst, "zzz"), ShouldHaveLength, 0)
+
+			ents, err := ListMetadata(ctx, inst, []string{"b"})
+			So(err, ShouldBeNil)
+			So(ents, ShouldHaveLength, 1)
+			So(ents[0].AttachedBy, ShouldEqual, string(testutil.TestUser))
+			So(ents[0].AttachedTs.Equal(testutil.TestTime), ShouldBeTrue)
+
+			// Detach one by key/value and another one by its fingerprint, plus
+			// a missing one.
+			tc.Add(time.Second)
+			So(DetachMetadata(ctx, inst, append(md("a:0", "z:0"), &api.InstanceMetadata{
+				Fingerprint: common.InstanceMetadataFingerprint("b", []byte("0")),
+			})), ShouldBeNil)
+			So(listMD(inst), ShouldResemble, []string{"a:1"})
+
+			// Events are collected.
+			fp := func(k, v string) string { return common.InstanceMetadataFingerprint(k, []byte(v)) }
+			So(GetEvents(ctx), ShouldResembleProto, []*api.Event{
+				{
+					Kind:          api.EventKind_INSTANCE_METADATA_DETACHED,
+					Package:       "pkg",
+					Instance:      digest,
+					MdKey:         "b",
+					MdContentType: "text/plain",
+					MdFingerprint: fp("b", "0"),
+					Who:           string(testutil.TestUser),
+					When:          google.NewTimestamp(testutil.TestTime.Add(3*time.Second + 1)),
+				},
+				{
+					Kind:          api.EventKind_INSTANCE_METADATA_DETACHED,
+					Package:       "pkg",
+					Instance:      digest,
+					MdKey:         "a",
+					MdContentType: "text/plain",
+					MdFingerprint: fp("a", "0"),
+					Who:           string(testutil.TestUser),
+					When:          google.NewTimestamp(testutil.TestTime.Add(3 * time.Second)),
+				},
+				{
+					Kind:          api.EventKind_INSTANCE_METADATA_ATTACHED,
+					Package:       "pkg",
+					Instance:      digest,
+					MdKey:         "a",
+					MdContentType: "text/plain",
+					MdFingerprint: fp("a", "1"),
+					Who:           string(testutil.TestUser),
+					When:          google.NewTimestamp(testutil.TestTime.Add(time.Second)),
+				},
+				{
+					Kind:          api.EventKind_INSTANCE_METADATA_ATTACHED,
+					Package:       "pkg",
+					Instance:      digest,
+					MdKey:         "b",
+					MdContentType: "text/plain",
+					MdFingerprint: fp("b", "0"),
+					Who:           string(testutil.TestUser),
+					When:          google.NewTimestamp(testutil.TestTime.Add(1)),
+				},
+				{
+					Kind:          api.EventKind_INSTANCE_METADATA_ATTACHED,
+					Package:       "pkg",
+					Instance:      digest,
+					MdKey:         "a",
+					MdContentType: "text/plain",
+					MdFingerprint: fp("a", "0"),
+					Who:           string(testutil.TestUser),
+					When:          google.NewTimestamp(testutil.TestTime),
+				},
+			})
+		})
+
+		Convey("AttachMetadata to not ready instance", func() {
+			inst := putInst("pkg", digest, []string{"proc"})
+
+			err := AttachMetadata(ctx, inst, md("a:0"))
+			So(grpcutil.Code(err), ShouldEqual, codes.FailedPrecondition)
+			So(err, ShouldErrLike, "the instance is not ready yet")
+		})
+
+		Convey("Handles fingerprint collision", func() {
+			inst := putInst("pkg", digest, nil)
+
+			So(datastore.Put(ctx, &InstanceMetadata{
+				Fingerprint: common.InstanceMetadataFingerprint("a", []byte("0")),
+				Instance:    datastore.KeyForObj(ctx, inst),
+				Key:         "another",
+				Value:       []byte("value"),
+			}), ShouldBeNil)
+
+			Convey("AttachMetadata", func() {
+				err := AttachMetadata(ctx, inst, md("a:0"))
+				So(grpcutil.Code(err), ShouldEqual, codes.Internal)
+				So(err, ShouldErrLike, "collides with an existing entry")
+			})
+
+			Convey("DetachMetadata", func() {
+				err := DetachMetadata(ctx, inst, md("a:0"))
+				So(grpcutil.Code(err), ShouldEqual, codes.Internal)
+				So(err, ShouldErrLike, "collides with an existing entry")
+			})
+		})
+	})
+}
@@ -0,0 +1,256 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+)
+
+// RetentionGracePeriod is how long a freshly registered instance is protected
+// from deletion by retention policies.
+//
+// Clients usually attach tags and set refs right after registering an
+// instance. This gives them a chance to do so before the instance is
+// considered unreferenced.
+const RetentionGracePeriod = time.Hour
+
+// ExpiredInstance is an instance picked for deletion by a retention policy.
+type ExpiredInstance struct {
+	Instance *Instance
+	Tags     []string // tags the instance had when it was picked, sorted
+	Reason   string   // human readable explanation why it was picked
+}
+
+// FindExpiredInstances returns instances of a package that should be deleted
+// according to the given retention policy, most recently registered first.
+//
+// The result is a snapshot. Refs and tags may change by the time the instances
+// are deleted, DeleteExpiredInstance rechecks them.
+//
+// Returns an empty list if there's no such package at all.
+func FindExpiredInstances(c context.Context, pkg string, policy *api.RetentionPolicy) ([]*ExpiredInstance, error) {
+	var maxAge time.Duration
+	if policy.MaxUnreferencedAge != nil {
+		var err error
+		if maxAge, err = ptypes.Duration(policy.MaxUnreferencedAge); err != nil {
+			return nil, errors.Annotate(err, "bad max_unreferenced_age").Err()
+		}
+	}
+	if policy.KeepLastUntagged <= 0 && maxAge <= 0 {
+		return nil, nil // nothing to enforce
+	}
+
+	refs, err := ListPackageRefs(c, pkg)
+	if err != nil {
+		return nil, err
+	}
+	referenced := stringset.New(len(refs))
+	for _, ref := range refs {
+		referenced.Add(ref.InstanceID)
+	}
+
+	var insts []*Instance
+	q := datastore.NewQuery("PackageInstance").
+		Ancestor(PackageKey(c, pkg)).
+		Order("-registered_ts")
+	if err := datastore.GetAll(c, q, &insts); err != nil {
+		return nil, errors.Annotate(err, "failed to list instances").Tag(transient.Tag).Err()
+	}
+
+	// Grab all tags in the package at once, it is faster than querying them
+	// instance by instance.
+	var tags []*Tag
+	q = datastore.NewQuery("InstanceTag").Ancestor(PackageKey(c, pkg))
+	if err := datastore.GetAll(c, q, &tags); err != nil {
+		return nil, errors.Annotate(err, "failed to list tags").Tag(transient.Tag).Err()
+	}
+	perInstance := map[string][]*Tag{}
+	for _, t := range tags {
+		iid := t.Instance.StringID()
+		perInstance[iid] = append(perInstance[iid], t)
+	}
+
+	now := clock.Now(c).UTC()
+	untagged := 0
+
+	var out []*ExpiredInstance
+	for _, inst := range insts {
+		if referenced.Has(inst.InstanceID) {
+			continue
+		}
+		tags := perInstance[inst.InstanceID]
+		age := now.Sub(inst.RegisteredTs)
+
+		reason := ""
+		if len(tags) == 0 && policy.KeepLastUntagged > 0 {
+			if untagged++; untagged > int(policy.KeepLastUntagged) {
+				reason = fmt.Sprintf("not among %d most recent untagged instances", policy.KeepLastUntagged)
+			}
+		}
+		if reason == "" && maxAge > 0 && age > maxAge && !hasFreshTags(tags, now.Add(-maxAge)) {
+			reason = fmt.Sprintf("unreferenced and older than %s", maxAge)
+		}
+
+		if reason != "" && age > RetentionGracePeriod {
+			exp := &ExpiredInstance{
+				Instance: inst,
+				Tags:     make([]string, len(tags)),
+				Reason:   reason,
+			}
+			for i, t := range tags {
+				exp.Tags[i] = t.Tag
+			}
+			sort.Strings(exp.Tags)
+			out = append(out, exp)
+		}
+	}
+	return out, nil
+}
+
+// hasFreshTags is true if some of the tags were attached after the given time.
+func hasFreshTags(tags []*Tag, after time.Time) bool {
+	for _, t := range tags {
+		if t.RegisteredTs.After(after) {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteExpiredInstance transactionally deletes an instance picked by
+// FindExpiredInstances, along with all its tags, metadata and processing
+// results. Indexed files of the instance are deleted non-transactionally
+// afterwards, since there may be a lot of them.
+//
+// Skips the deletion if the instance has changed since it was picked, i.e. it
+// has refs pointing to it now, or its tags have changed. Returns false in this
+// case or if the instance is already gone.
+//
+// Calls the given callback (if any) inside the transaction if it is indeed
+// deleting the instance and no other package has an instance with the same ID
+// (they share the instance file). It can be used to transactionally schedule
+// deletion of the instance file.
+//
+// Emits INSTANCE_DELETED event.
+func DeleteExpiredInstance(c context.Context, exp *ExpiredInstance, cb func(context.Context) error) (deleted bool, err error) {
+	pkg := exp.Instance.Package.StringID()
+	iid := exp.Instance.InstanceID
+
+	err = Txn(c, "DeleteExpiredInstance", func(c context.Context) error {
+		deleted = false
+
+		inst := &Instance{InstanceID: iid, Package: exp.Instance.Package}
+		switch err := datastore.Get(c, inst); {
+		case err == datastore.ErrNoSuchEntity:
+			logging.Infof(c, "Instance %s:%s is already gone", pkg, iid)
+			return nil
+		case err != nil:
+			return errors.Annotate(err, "failed to fetch the instance").Tag(transient.Tag).Err()
+		}
+
+		// Note: packages usually have few refs, it is simpler to filter them here
+		// than to use ListInstanceRefs, which needs a composite index.
+		refs, err := ListPackageRefs(c, pkg)
+		if err != nil {
+			return err
+		}
+		for _, ref := range refs {
+			if ref.InstanceID == iid {
+				logging.Infof(c, "Instance %s:%s has refs now, skipping", pkg, iid)
+				return nil
+			}
+		}
+
+		tags, err := ListInstanceTags(c, inst)
+		if err != nil {
+			return err
+		}
+		current := make([]string, len(tags))
+		for i, t := range tags {
+			current[i] = t.Tag
+		}
+		sort.Strings(current)
+		if !isEqualStrSlice(current, exp.Tags) {
+			logging.Infof(c, "Instance %s:%s has different tags now, skipping", pkg, iid)
+			return nil
+		}
+
+		// Collect keys of all entities that belong to the instance.
+		instKey := datastore.KeyForObj(c, inst)
+		toDelete := []*datastore.Key{instKey}
+		for _, kind := range []string{"InstanceTag", "InstanceMetadata", "ProcessingResult"} {
+			var keys []*datastore.Key
+			q := datastore.NewQuery(kind).Ancestor(instKey).KeysOnly(true)
+			if err := datastore.GetAll(c, q, &keys); err != nil {
+				return errors.Annotate(err, "failed to list %s entities", kind).Tag(transient.Tag).Err()
+			}
+			toDelete = append(toDelete, keys...)
+		}
+		if err := datastore.Delete(c, toDelete); err != nil {
+			return errors.Annotate(err, "failed to delete %d entities", len(toDelete)).Tag(transient.Tag).Err()
+		}
+
+		unused, err := removeInstanceUsage(c, pkg, iid)
+		switch {
+		case err != nil:
+			return err
+		case !unused:
+			logging.Infof(c, "Instance file of %s:%s may be used by other packages, keeping it", pkg, iid)
+		case cb != nil:
+			if err := cb(c); err != nil {
+				return errors.Annotate(err, "instance deletion callback error").Err()
+			}
+		}
+
+		deleted = true
+		return EmitEvent(c, &api.Event{
+			Kind:     api.EventKind_INSTANCE_DELETED,
+			Package:  pkg,
+			Instance: iid,
+		})
+	})
+	if err == nil && deleted {
+		// Note: if this fails, the orphaned InstanceFile entities are just ignored
+		// by SearchInstanceFiles.
+		err = DeleteInstanceFiles(c, exp.Instance)
+	}
+	return
+}
+
+func isEqualStrSlice(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
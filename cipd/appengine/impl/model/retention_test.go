@@ -0,0 +1,203 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRetention(t *testing.T) {
+	t.Parallel()
+
+	Convey("With instances", t, func() {
+		ctx, tc, _ := testutil.TestingContext()
+
+		const day = 24 * time.Hour
+
+		insts := map[string]*Instance{}
+		register := func(chr string, age time.Duration) *Instance {
+			So(TrackInstanceUsage(ctx, strings.Repeat(chr, 40)), ShouldBeNil)
+			_, inst, err := RegisterInstance(ctx, &Instance{
+				InstanceID:   strings.Repeat(chr, 40),
+				Package:      PackageKey(ctx, "pkg"),
+				RegisteredTs: testutil.TestTime.Add(-age),
+			}, nil)
+			So(err, ShouldBeNil)
+			insts[chr] = inst
+			return inst
+		}
+		tag := func(inst *Instance, kv string, age time.Duration) {
+			So(datastore.Put(ctx, &Tag{
+				ID:           TagID(&api.Tag{Key: strings.Split(kv, ":")[0], Value: strings.Split(kv, ":")[1]}),
+				Instance:     datastore.KeyForObj(ctx, inst),
+				Tag:          kv,
+				RegisteredTs: testutil.TestTime.Add(-age),
+			}), ShouldBeNil)
+		}
+
+		register("g", 30*time.Minute) // untagged, but in the grace period
+		register("a", 1*day)          // untagged
+		register("b", 2*day)          // untagged
+		register("c", 3*day)          // untagged
+		So(SetRef(ctx, "latest", register("d", 4*day)), ShouldBeNil)
+		tag(register("e", 5*day), "k:stale", 5*day)
+		tag(register("f", 6*day), "k:fresh", time.Hour)
+
+		find := func(policy *api.RetentionPolicy) (out []string) {
+			exp, err := FindExpiredInstances(ctx, "pkg", policy)
+			So(err, ShouldBeNil)
+			for _, e := range exp {
+				out = append(out, e.Instance.InstanceID[:1])
+			}
+			return
+		}
+
+		Convey("Empty policy", func() {
+			So(find(&api.RetentionPolicy{}), ShouldBeEmpty)
+		})
+
+		Convey("Keep last untagged", func() {
+			So(find(&api.RetentionPolicy{KeepLastUntagged: 1}), ShouldResemble, []string{"a", "b", "c"})
+			So(find(&api.RetentionPolicy{KeepLastUntagged: 3}), ShouldResemble, []string{"c"})
+			So(find(&api.RetentionPolicy{KeepLastUntagged: 4}), ShouldBeEmpty)
+		})
+
+		Convey("Max unreferenced age", func() {
+			So(find(&api.RetentionPolicy{
+				MaxUnreferencedAge: ptypes.DurationProto(36 * time.Hour),
+			}), ShouldResemble, []string{"b", "c", "e"})
+		})
+
+		Convey("Both rules", func() {
+			So(find(&api.RetentionPolicy{
+				KeepLastUntagged:   3,
+				MaxUnreferencedAge: ptypes.DurationProto(4 * day),
+			}), ShouldResemble, []string{"c", "e"})
+		})
+
+		Convey("Deletion", func() {
+			exp, err := FindExpiredInstances(ctx, "pkg", &api.RetentionPolicy{
+				MaxUnreferencedAge: ptypes.DurationProto(36 * time.Hour),
+			})
+			So(err, ShouldBeNil)
+			So(exp, ShouldHaveLength, 3)
+			So(exp[2].Tags, ShouldResemble, []string{"k:stale"})
+
+			var called []string
+			cb := func(c context.Context) error {
+				So(datastore.CurrentTransaction(c), ShouldNotBeNil)
+				called = append(called, "called")
+				return nil
+			}
+
+			Convey("Deletes unchanged instances", func() {
+				So(IndexInstanceFiles(ctx, insts["e"], []*InstanceFile{{Path: "bin/e"}}), ShouldBeNil)
+
+				tc.Add(time.Minute)
+				deleted, err := DeleteExpiredInstance(ctx, exp[2], cb)
+				So(err, ShouldBeNil)
+				So(deleted, ShouldBeTrue)
+				So(called, ShouldHaveLength, 1)
+
+				used, err := IsInstanceFileUsed(ctx, insts["e"].InstanceID)
+				So(err, ShouldBeNil)
+				So(used, ShouldBeFalse)
+
+				So(CheckInstanceExists(ctx, insts["e"]), ShouldErrLike, "no such instance")
+				var tags []*Tag
+				So(datastore.GetAll(ctx, datastore.NewQuery("InstanceTag").Ancestor(datastore.KeyForObj(ctx, insts["e"])), &tags), ShouldBeNil)
+				So(tags, ShouldBeEmpty)
+				files, err := ListInstanceFiles(ctx, insts["e"])
+				So(err, ShouldBeNil)
+				So(files, ShouldBeEmpty)
+
+				events := GetEvents(ctx)
+				So(events[0], ShouldResembleProto, &api.Event{
+					Kind:     api.EventKind_INSTANCE_DELETED,
+					Package:  "pkg",
+					Instance: insts["e"].InstanceID,
+					Who:      string(testutil.TestUser),
+					When:     google.NewTimestamp(testutil.TestTime.Add(time.Minute)),
+				})
+
+				// Already gone.
+				deleted, err = DeleteExpiredInstance(ctx, exp[2], cb)
+				So(err, ShouldBeNil)
+				So(deleted, ShouldBeFalse)
+				So(called, ShouldHaveLength, 1)
+			})
+
+			Convey("Keeps files used by other packages", func() {
+				_, _, err := RegisterInstance(ctx, &Instance{
+					InstanceID:   insts["e"].InstanceID,
+					Package:      PackageKey(ctx, "another"),
+					RegisteredTs: testutil.TestTime,
+				}, nil)
+				So(err, ShouldBeNil)
+
+				deleted, err := DeleteExpiredInstance(ctx, exp[2], cb)
+				So(err, ShouldBeNil)
+				So(deleted, ShouldBeTrue)
+				So(called, ShouldBeEmpty)
+				So(CheckInstanceExists(ctx, insts["e"]), ShouldErrLike, "no such instance")
+
+				used, err := IsInstanceFileUsed(ctx, insts["e"].InstanceID)
+				So(err, ShouldBeNil)
+				So(used, ShouldBeTrue)
+			})
+
+			Convey("Keeps files of untracked instances", func() {
+				So(datastore.Delete(ctx, &InstanceUsage{InstanceID: insts["e"].InstanceID}), ShouldBeNil)
+
+				deleted, err := DeleteExpiredInstance(ctx, exp[2], cb)
+				So(err, ShouldBeNil)
+				So(deleted, ShouldBeTrue)
+				So(called, ShouldBeEmpty)
+			})
+
+			Convey("Skips instances that have refs now", func() {
+				So(SetRef(ctx, "another", insts["b"]), ShouldBeNil)
+				deleted, err := DeleteExpiredInstance(ctx, exp[0], cb)
+				So(err, ShouldBeNil)
+				So(deleted, ShouldBeFalse)
+				So(called, ShouldBeEmpty)
+				So(CheckInstanceExists(ctx, insts["b"]), ShouldBeNil)
+			})
+
+			Convey("Skips instances that have new tags now", func() {
+				tag(insts["c"], "k:new", 3*day)
+				deleted, err := DeleteExpiredInstance(ctx, exp[1], cb)
+				So(err, ShouldBeNil)
+				So(deleted, ShouldBeFalse)
+				So(called, ShouldBeEmpty)
+				So(CheckInstanceExists(ctx, insts["c"]), ShouldBeNil)
+			})
+		})
+	})
+}
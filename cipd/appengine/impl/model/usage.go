@@ -0,0 +1,147 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"sort"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+)
+
+// InstanceUsage is a root entity that records packages that have an instance
+// with the given ID.
+//
+// Instance files are stored in the CAS and addressed only by their hash, so
+// the same file backs all instances with the same ID, regardless of their
+// package. The file can be deleted only when the last of them is deleted.
+//
+// Created when the instance file upload starts or when an instance is
+// registered, updated when an instance is registered or deleted.
+type InstanceUsage struct {
+	_kind  string                `gae:"$kind,InstanceUsage"`
+	_extra datastore.PropertyMap `gae:"-,extra"`
+
+	InstanceID string `gae:"$id"` // see common.ObjectRefToInstanceID()
+
+	// Packages are sorted names of packages that have the instance.
+	Packages []string `gae:"packages,noindex"`
+
+	// Complete is true if Packages lists all packages that have the instance.
+	//
+	// It is false if the instance file was uploaded before the usage was
+	// tracked, since packages registered back then are unknown. Such files are
+	// never deleted.
+	Complete bool `gae:"complete,noindex"`
+}
+
+// TrackInstanceUsage starts tracking usage of an instance file that is not in
+// the storage yet, i.e. is not used by any instance.
+//
+// Must be called before the file upload starts. Does nothing if the usage is
+// already tracked.
+func TrackInstanceUsage(c context.Context, instanceID string) error {
+	return Txn(c, "TrackInstanceUsage", func(c context.Context) error {
+		usage := &InstanceUsage{InstanceID: instanceID}
+		switch err := datastore.Get(c, usage); {
+		case err == nil:
+			return nil
+		case err != datastore.ErrNoSuchEntity:
+			return errors.Annotate(err, "failed to fetch the instance usage").Tag(transient.Tag).Err()
+		}
+		usage.Complete = true
+		if err := datastore.Put(c, usage); err != nil {
+			return errors.Annotate(err, "failed to store the instance usage").Tag(transient.Tag).Err()
+		}
+		return nil
+	})
+}
+
+// IsInstanceFileUsed returns true if the instance file may be used by some
+// instance.
+//
+// Used to double check there were no new instances registered before deleting
+// the file.
+func IsInstanceFileUsed(c context.Context, instanceID string) (bool, error) {
+	usage := &InstanceUsage{InstanceID: instanceID}
+	switch err := datastore.Get(c, usage); {
+	case err == datastore.ErrNoSuchEntity:
+		return false, nil
+	case err != nil:
+		return false, errors.Annotate(err, "failed to fetch the instance usage").Tag(transient.Tag).Err()
+	default:
+		return !usage.Complete || len(usage.Packages) > 0, nil
+	}
+}
+
+// addInstanceUsage records that the package has the instance.
+//
+// Must be called inside a transaction.
+func addInstanceUsage(c context.Context, pkg, instanceID string) error {
+	usage := &InstanceUsage{InstanceID: instanceID}
+	switch err := datastore.Get(c, usage); {
+	case err == datastore.ErrNoSuchEntity:
+		// The file was uploaded before the usage was tracked.
+		usage.Complete = false
+	case err != nil:
+		return errors.Annotate(err, "failed to fetch the instance usage").Tag(transient.Tag).Err()
+	}
+
+	idx := sort.SearchStrings(usage.Packages, pkg)
+	if idx < len(usage.Packages) && usage.Packages[idx] == pkg {
+		return nil
+	}
+	usage.Packages = append(usage.Packages, "")
+	copy(usage.Packages[idx+1:], usage.Packages[idx:])
+	usage.Packages[idx] = pkg
+
+	if err := datastore.Put(c, usage); err != nil {
+		return errors.Annotate(err, "failed to store the instance usage").Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// removeInstanceUsage records that the package no longer has the instance.
+//
+// Returns true if no instance uses the file anymore, in which case the usage
+// entity is deleted as well. Must be called inside a transaction.
+func removeInstanceUsage(c context.Context, pkg, instanceID string) (unused bool, err error) {
+	usage := &InstanceUsage{InstanceID: instanceID}
+	switch err := datastore.Get(c, usage); {
+	case err == datastore.ErrNoSuchEntity:
+		// The instance was registered before the usage was tracked.
+		return false, nil
+	case err != nil:
+		return false, errors.Annotate(err, "failed to fetch the instance usage").Tag(transient.Tag).Err()
+	}
+
+	idx := sort.SearchStrings(usage.Packages, pkg)
+	if idx < len(usage.Packages) && usage.Packages[idx] == pkg {
+		usage.Packages = append(usage.Packages[:idx], usage.Packages[idx+1:]...)
+	}
+
+	if usage.Complete && len(usage.Packages) == 0 {
+		if err := datastore.Delete(c, usage); err != nil {
+			return false, errors.Annotate(err, "failed to delete the instance usage").Tag(transient.Tag).Err()
+		}
+		return true, nil
+	}
+	if err := datastore.Put(c, usage); err != nil {
+		return false, errors.Annotate(err, "failed to store the instance usage").Tag(transient.Tag).Err()
+	}
+	return false, nil
+}
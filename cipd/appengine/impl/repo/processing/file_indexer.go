@@ -0,0 +1,121 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+)
+
+// FileIndexerProcID is identifier of FileIndexer processor.
+const FileIndexerProcID = "file_index:v1"
+
+// packageServiceDir is a directory inside the package with CIPD's own files
+// (like the manifest). They are not indexed.
+const packageServiceDir = ".cipdpkg/"
+
+// FileIndexerResult is stored in JSON form as a result of FileIndexer
+// execution.
+//
+// If format of this struct changes in a non backward compatible way, the
+// version number in FileIndexerProcID should change too.
+type FileIndexerResult struct {
+	Files     int   `json:"files"`      // number of indexed files
+	TotalSize int64 `json:"total_size"` // their total size in bytes
+}
+
+// FileIndexer is a processor that records paths and hashes of all files in
+// the package as InstanceFile entities, so instances can be found by files
+// they contain (see SearchFiles RPC).
+type FileIndexer struct{}
+
+// ID is part of Processor interface.
+func (f *FileIndexer) ID() string {
+	return FileIndexerProcID
+}
+
+// Applicable is part of Processor interface.
+func (f *FileIndexer) Applicable(inst *model.Instance) bool {
+	return true
+}
+
+// Run is part of Processor interface.
+func (f *FileIndexer) Run(ctx context.Context, inst *model.Instance, pkg *PackageReader) (res Result, err error) {
+	// Put fatal errors into 'res' and return transient ones as is.
+	defer func() {
+		if err != nil && !transient.Tag.In(err) {
+			res.Err = err
+			err = nil
+		}
+	}()
+
+	var files []*model.InstanceFile
+	var totalSize int64
+	for _, fi := range pkg.Files() {
+		if strings.HasPrefix(fi.Name, packageServiceDir) {
+			continue
+		}
+		var digest string
+		if digest, err = hashFile(pkg, fi.Name); err != nil {
+			err = errors.Annotate(err, "failed to hash %q", fi.Name).Err()
+			return
+		}
+		files = append(files, &model.InstanceFile{
+			Path:     fi.Name,
+			BaseName: path.Base(fi.Name),
+			Hash:     digest,
+			Size:     fi.Size,
+			Exec:     fi.Executable,
+		})
+		totalSize += fi.Size
+	}
+
+	if err = model.IndexInstanceFiles(ctx, inst, files); err != nil {
+		return
+	}
+
+	res.Result = FileIndexerResult{
+		Files:     len(files),
+		TotalSize: totalSize,
+	}
+	return
+}
+
+// hashFile returns hex-encoded SHA256 of a file inside the package.
+func hashFile(pkg *PackageReader, name string) (string, error) {
+	r, size, err := pkg.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close() // we don't care about errors here
+	h := sha256.New()
+	switch copied, err := io.Copy(h, r); {
+	case err != nil:
+		return "", err
+	case copied != size:
+		return "", fmt.Errorf("unexpected file size - expecting %d bytes, read %d bytes", size, copied)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
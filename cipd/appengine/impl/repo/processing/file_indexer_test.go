@@ -0,0 +1,74 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processing
+
+import (
+	"testing"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFileIndexer(t *testing.T) {
+	t.Parallel()
+
+	Convey("With datastore", t, func() {
+		ctx, _, _ := testutil.TestingContext()
+		inst := instance(ctx, "some/pkg", api.HashAlgo_SHA256)
+
+		proc := FileIndexer{}
+		So(proc.Applicable(inst), ShouldBeTrue)
+
+		Convey("Happy path", func() {
+			res, err := proc.Run(ctx, inst, packageReader(map[string]string{
+				"bin/gn":                 "gn body",
+				"README":                 "hello",
+				".cipdpkg/manifest.json": "{}",
+			}))
+			So(err, ShouldBeNil)
+			So(res.Err, ShouldBeNil)
+			So(res.Result, ShouldResemble, FileIndexerResult{
+				Files:     2,
+				TotalSize: 12,
+			})
+
+			files, err := model.ListInstanceFiles(ctx, inst)
+			So(err, ShouldBeNil)
+			So(files, ShouldHaveLength, 2)
+
+			So(files[0].Path, ShouldEqual, "README")
+			So(files[0].BaseName, ShouldEqual, "README")
+			So(files[0].Hash, ShouldEqual, hexDigest(api.HashAlgo_SHA256, "hello"))
+			So(files[0].Size, ShouldEqual, 5)
+
+			So(files[1].Path, ShouldEqual, "bin/gn")
+			So(files[1].BaseName, ShouldEqual, "gn")
+			So(files[1].Hash, ShouldEqual, hexDigest(api.HashAlgo_SHA256, "gn body"))
+			So(files[1].Size, ShouldEqual, 7)
+		})
+
+		Convey("Empty package", func() {
+			res, err := proc.Run(ctx, inst, packageReader(map[string]string{
+				".cipdpkg/manifest.json": "{}",
+			}))
+			So(err, ShouldBeNil)
+			So(res.Err, ShouldBeNil)
+			So(res.Result, ShouldResemble, FileIndexerResult{})
+		})
+	})
+}
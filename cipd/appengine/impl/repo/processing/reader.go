@@ -17,11 +17,19 @@ package processing
 import (
 	"archive/zip"
 	"io"
+	"io/ioutil"
 	"math"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
 
 	"go.chromium.org/luci/common/errors"
 )
 
+// zstdMethod is a zip compression method ID used for zstd-compressed files in
+// chunked packages. Must match ZstdMethod in the client's "pkg" package.
+const zstdMethod uint16 = 93
+
 // TODO(vadimsh): Share code with the client.
 
 // PackageReader knows how to extract files from CIPD packages.
@@ -29,7 +37,15 @@ import (
 // CIPD packages are actually zip archives, but we don't want to expose it
 // everywhere.
 type PackageReader struct {
-	zr *zip.Reader
+	zr    *zip.Reader
+	files map[string]*zip.File
+}
+
+// FileInfo describes a file inside the package.
+type FileInfo struct {
+	Name       string // slash-separated path relative to the package root
+	Size       int64  // uncompressed size in bytes
+	Executable bool   // true if the file has the executable bit set
 }
 
 // NewPackageReader opens the package by reading its directory.
@@ -41,24 +57,65 @@ func NewPackageReader(r io.ReaderAt, size int64) (*PackageReader, error) {
 		// in case of transient Google Storage errors.
 		return nil, err
 	}
-	return &PackageReader{zr}, nil
+	zr.RegisterDecompressor(zstdMethod, zstdDecompressor)
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		if _, ok := files[f.Name]; !ok {
+			files[f.Name] = f
+		}
+	}
+	return &PackageReader{zr, files}, nil
+}
+
+// Files lists all regular files inside the package, in the order they are
+// stored in the zip directory.
+//
+// Skips directories and symlinks.
+func (p *PackageReader) Files() []FileInfo {
+	out := make([]FileInfo, 0, len(p.zr.File))
+	for _, f := range p.zr.File {
+		if strings.HasSuffix(f.Name, "/") || !f.Mode().IsRegular() || f.UncompressedSize64 > math.MaxInt64 {
+			continue
+		}
+		out = append(out, FileInfo{
+			Name:       f.Name,
+			Size:       int64(f.UncompressedSize64),
+			Executable: f.Mode()&0111 != 0,
+		})
+	}
+	return out
 }
 
 // Open opens some file inside the package for reading.
 //
 // Returns the ReadCloser and the uncompressed file size.
 func (p *PackageReader) Open(path string) (io.ReadCloser, int64, error) {
-	for _, f := range p.zr.File {
-		if f.Name == path {
-			if f.UncompressedSize64 > math.MaxInt64 {
-				return nil, 0, errors.Reason("the file %q is unbelievably huge (%d bytes)", path, f.UncompressedSize64).Err()
-			}
-			rc, err := f.Open()
-			if err != nil {
-				return nil, 0, err
-			}
-			return rc, int64(f.UncompressedSize64), nil
-		}
+	f := p.files[path]
+	if f == nil {
+		return nil, 0, errors.Reason("no file %q inside the package", path).Err()
+	}
+	if f.UncompressedSize64 > math.MaxInt64 {
+		return nil, 0, errors.Reason("the file %q is unbelievably huge (%d bytes)", path, f.UncompressedSize64).Err()
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, 0, err
+	}
+	return rc, int64(f.UncompressedSize64), nil
+}
+
+// zstdDecompressor decompresses files stored with zstdMethod.
+func zstdDecompressor(r io.Reader) io.ReadCloser {
+	// Concatenated zstd frames (one per chunk) form a valid zstd stream.
+	d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return ioutil.NopCloser(errorReader{err})
 	}
-	return nil, 0, errors.Reason("no file %q inside the package", path).Err()
+	return d.IOReadCloser()
 }
+
+type errorReader struct {
+	err error
+}
+
+func (r errorReader) Read([]byte) (int, error) { return 0, r.err }
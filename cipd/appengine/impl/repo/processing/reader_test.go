@@ -58,6 +58,15 @@ func TestPackageReader(t *testing.T) {
 		So(string(blob), ShouldEqual, "blah")
 	})
 
+	Convey("Listing files", t, func() {
+		pkg, err := NewPackageReader(reader, size)
+		So(err, ShouldBeNil)
+		So(pkg.Files(), ShouldResemble, []FileInfo{
+			{Name: "file1", Size: 250},
+			{Name: "file2", Size: 4},
+		})
+	})
+
 	Convey("No such file", t, func() {
 		pkg, err := NewPackageReader(reader, size)
 		So(err, ShouldBeNil)
@@ -66,6 +66,7 @@ func Public(internalCAS cas.StorageServer, d *tq.Dispatcher) Server {
 	}
 	impl.registerTasks()
 	impl.registerProcessor(&processing.ClientExtractor{CAS: internalCAS})
+	impl.registerProcessor(&processing.FileIndexer{})
 	return impl
 }
 
@@ -573,7 +574,11 @@ func (impl *repoImpl) RegisterInstance(c context.Context, r *api.Instance) (resp
 		break // the object is already there
 	case code == codes.OK:
 		// The object is not in the storage and we have just started the upload. Let
-		// the client finish it.
+		// the client finish it. No instance uses the object yet, so all instances
+		// that will use it are known.
+		if err := model.TrackInstanceUsage(c, instance.InstanceID); err != nil {
+			return nil, errors.Annotate(err, "failed to start tracking the instance usage").Err()
+		}
 		return &api.RegisterInstanceResponse{
 			Status:   api.RegistrationStatus_NOT_UPLOADED,
 			UploadOp: uploadOp,
@@ -897,6 +902,80 @@ func (impl *repoImpl) SearchInstances(c context.Context, r *api.SearchInstancesR
 	}, nil
 }
 
+// SearchFiles implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) SearchFiles(c context.Context, r *api.SearchFilesRequest) (resp *api.SearchFilesResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request, decode the cursor.
+	if err := common.ValidateFileGlob(r.Glob); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'glob' - %s", err)
+	}
+	prefix := ""
+	if r.Prefix != "" {
+		if prefix, err = common.ValidatePackagePrefix(r.Prefix); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad 'prefix' - %s", err)
+		}
+	}
+
+	switch {
+	case r.PageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'page_size' %d - it should be non-negative", r.PageSize)
+	case r.PageSize == 0:
+		r.PageSize = 100
+	}
+
+	var cursor datastore.Cursor
+	if r.PageToken != "" {
+		if cursor, err = datastore.DecodeCursor(c, r.PageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad 'page_token' - %s", err)
+		}
+	}
+
+	logging.Infof(c, "SearchFiles: %s %q in %q", auth.CurrentIdentity(c), r.Glob, prefix)
+
+	// Files of the same package usually come in bunches, cache ACL checks.
+	visible := map[string]bool{}
+	isVisible := func(pkg string) (bool, error) {
+		if prefix != "" && pkg != prefix && !strings.HasPrefix(pkg, prefix+"/") {
+			return false, nil
+		}
+		if yes, ok := visible[pkg]; ok {
+			return yes, nil
+		}
+		metas, err := impl.meta.GetMetadata(c, pkg)
+		if err != nil {
+			return false, err
+		}
+		yes, err := hasRole(c, metas, api.Role_READER)
+		if err != nil {
+			return false, err
+		}
+		visible[pkg] = yes
+		return yes, nil
+	}
+
+	matches, cursor, err := model.SearchInstanceFiles(c, r.Glob, isVisible, r.PageSize, cursor)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to search files").Err()
+	}
+
+	resp = &api.SearchFilesResponse{
+		Matches: make([]*api.SearchFilesResponse_Match, len(matches)),
+	}
+	for i, m := range matches {
+		resp.Matches[i] = &api.SearchFilesResponse_Match{
+			Instance: m.Instance.Proto(),
+			Path:     m.File.Path,
+			Hash:     &api.ObjectRef{HashAlgo: api.HashAlgo_SHA256, HexDigest: m.File.Hash},
+			Size:     m.File.Size,
+		}
+	}
+	if cursor != nil {
+		resp.NextPageToken = cursor.String()
+	}
+	return resp, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Refs support.
 
@@ -1074,6 +1153,153 @@ func (impl *repoImpl) DetachTags(c context.Context, r *api.DetachTagsRequest) (r
 	return &empty.Empty{}, nil
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// Instance metadata support.
+
+// validateMetadataList validates a list of metadata entries.
+//
+// If 'detach' is true, allows entries identified only by their fingerprints.
+func validateMetadataList(md []*api.InstanceMetadata, detach bool) error {
+	if len(md) == 0 {
+		return status.Errorf(codes.InvalidArgument, "bad 'metadata' - cannot be empty")
+	}
+	for _, m := range md {
+		if detach && m.Fingerprint != "" {
+			if m.Key != "" || len(m.Value) != 0 {
+				return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - either 'fingerprint' or 'key' and 'value' should be set, not both")
+			}
+			if err := common.ValidateInstanceMetadataFingerprint(m.Fingerprint); err != nil {
+				return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - %s", err)
+			}
+			continue
+		}
+		if err := common.ValidateInstanceMetadataKey(m.Key); err != nil {
+			return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - %s", err)
+		}
+		if err := common.ValidateInstanceMetadataLen(len(m.Value)); err != nil {
+			return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - %s", err)
+		}
+		if !detach {
+			if err := common.ValidateContentType(m.ContentType); err != nil {
+				return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - %s", err)
+			}
+		}
+	}
+	return nil
+}
+
+// AttachMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) AttachMetadata(c context.Context, r *api.AttachMetadataRequest) (resp *empty.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateObjectRef(r.Instance, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+	if err := validateMetadataList(r.Metadata, false); err != nil {
+		return nil, err
+	}
+
+	// Check ACLs.
+	if _, err := impl.checkRole(c, r.Package, api.Role_WRITER); err != nil {
+		return nil, err
+	}
+
+	// Actually attach the metadata. This will also transactionally check the
+	// instance exists and it has passed the processing successfully.
+	inst := &model.Instance{
+		InstanceID: common.ObjectRefToInstanceID(r.Instance),
+		Package:    model.PackageKey(c, r.Package),
+	}
+	if err := model.AttachMetadata(c, inst, r.Metadata); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// DetachMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) DetachMetadata(c context.Context, r *api.DetachMetadataRequest) (resp *empty.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateObjectRef(r.Instance, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+	if err := validateMetadataList(r.Metadata, true); err != nil {
+		return nil, err
+	}
+
+	// Check ACLs. Note this is scoped to OWNERS, see the proto doc.
+	if _, err := impl.checkRole(c, r.Package, api.Role_OWNER); err != nil {
+		return nil, err
+	}
+
+	// Verify the instance exists, per DetachMetadata contract.
+	inst := &model.Instance{
+		InstanceID: common.ObjectRefToInstanceID(r.Instance),
+		Package:    model.PackageKey(c, r.Package),
+	}
+	if err := model.CheckInstanceExists(c, inst); err != nil {
+		return nil, err
+	}
+
+	// Actually detach the metadata.
+	if err := model.DetachMetadata(c, inst, r.Metadata); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// ListMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) ListMetadata(c context.Context, r *api.ListMetadataRequest) (resp *api.ListMetadataResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateObjectRef(r.Instance, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+	for _, k := range r.Keys {
+		if err := common.ValidateInstanceMetadataKey(k); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad key in 'keys' - %s", err)
+		}
+	}
+
+	// Check ACLs.
+	if _, err := impl.checkRole(c, r.Package, api.Role_READER); err != nil {
+		return nil, err
+	}
+
+	// Make sure the instance exists, to return NotFound instead of an empty list.
+	inst := &model.Instance{
+		InstanceID: common.ObjectRefToInstanceID(r.Instance),
+		Package:    model.PackageKey(c, r.Package),
+	}
+	if err := model.CheckInstanceExists(c, inst); err != nil {
+		return nil, err
+	}
+
+	md, err := model.ListMetadata(c, inst, r.Keys)
+	if err != nil {
+		return nil, err
+	}
+	resp = &api.ListMetadataResponse{
+		Metadata: make([]*api.InstanceMetadata, len(md)),
+	}
+	for i, m := range md {
+		resp.Metadata[i] = m.Proto()
+	}
+	return resp, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Version resolution and instance info fetching.
 
@@ -858,6 +858,12 @@ func TestRegisterInstance(t *testing.T) {
 				Instance: fullInstProto,
 			})
 
+			// The usage of the uploaded file is tracked.
+			usage := &model.InstanceUsage{InstanceID: common.ObjectRefToInstanceID(inst.Instance)}
+			So(datastore.Get(ctx, usage), ShouldBeNil)
+			So(usage.Packages, ShouldResemble, []string{"a/b"})
+			So(usage.Complete, ShouldBeTrue)
+
 			// Launched post-processors.
 			ent := (&model.Instance{}).FromProto(ctx, inst)
 			So(datastore.Get(ctx, ent), ShouldBeNil)
@@ -1452,6 +1458,141 @@ func TestSearchInstances(t *testing.T) {
 	})
 }
 
+func TestSearchFiles(t *testing.T) {
+	t.Parallel()
+
+	Convey("With fakes", t, func() {
+		ctx, _, as := testutil.TestingContext()
+		ctx = as("reader@example.com")
+
+		meta := testutil.MetadataStore{}
+		meta.Populate("a", &api.PrefixMetadata{
+			Acls: []*api.PrefixMetadata_ACL{
+				{
+					Role:       api.Role_READER,
+					Principals: []string{"user:reader@example.com"},
+				},
+			},
+		})
+
+		put := func(pkg, chr string, paths ...string) {
+			inst := &model.Instance{
+				InstanceID:   strings.Repeat(chr, 40),
+				Package:      model.PackageKey(ctx, pkg),
+				RegisteredTs: testutil.TestTime,
+			}
+			So(datastore.Put(ctx, &model.Package{Name: pkg}, inst), ShouldBeNil)
+			files := make([]*model.InstanceFile, len(paths))
+			for i, p := range paths {
+				files[i] = &model.InstanceFile{
+					Path:     p,
+					BaseName: p[strings.LastIndex(p, "/")+1:],
+					Hash:     strings.Repeat(chr, 64),
+					Size:     123,
+				}
+			}
+			So(model.IndexInstanceFiles(ctx, inst, files), ShouldBeNil)
+		}
+
+		put("a/b", "a", "bin/gn", "bin/ninja")
+		put("a/c", "b", "bin/gn")
+		put("z/secret", "c", "bin/gn")
+		datastore.GetTestable(ctx).CatchupIndexes()
+
+		impl := repoImpl{meta: &meta}
+
+		found := func(out *api.SearchFilesResponse) (res []string) {
+			for _, m := range out.Matches {
+				res = append(res, m.Instance.Package+":"+m.Path)
+			}
+			return
+		}
+
+		Convey("Bad glob", func() {
+			_, err := impl.SearchFiles(ctx, &api.SearchFilesRequest{
+				Glob: "*/gn*",
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(err, ShouldErrLike, "bad 'glob'")
+		})
+
+		Convey("Bad prefix", func() {
+			_, err := impl.SearchFiles(ctx, &api.SearchFilesRequest{
+				Glob:   "bin/gn",
+				Prefix: "///",
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(err, ShouldErrLike, "bad 'prefix'")
+		})
+
+		Convey("Bad page size", func() {
+			_, err := impl.SearchFiles(ctx, &api.SearchFilesRequest{
+				Glob:     "bin/gn",
+				PageSize: -1,
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(err, ShouldErrLike, "it should be non-negative")
+		})
+
+		Convey("Bad page token", func() {
+			_, err := impl.SearchFiles(ctx, &api.SearchFilesRequest{
+				Glob:      "bin/gn",
+				PageToken: "zzzz",
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(err, ShouldErrLike, "invalid cursor")
+		})
+
+		Convey("Skips invisible packages", func() {
+			out, err := impl.SearchFiles(ctx, &api.SearchFilesRequest{
+				Glob: "bin/gn",
+			})
+			So(err, ShouldBeNil)
+			So(found(out), ShouldResemble, []string{"a/b:bin/gn", "a/c:bin/gn"})
+			So(out.Matches[0], ShouldResembleProto, &api.SearchFilesResponse_Match{
+				Instance: &api.Instance{
+					Package:      "a/b",
+					Instance:     common.InstanceIDToObjectRef(strings.Repeat("a", 40)),
+					RegisteredTs: google.NewTimestamp(testutil.TestTime),
+				},
+				Path: "bin/gn",
+				Hash: &api.ObjectRef{
+					HashAlgo:  api.HashAlgo_SHA256,
+					HexDigest: strings.Repeat("a", 64),
+				},
+				Size: 123,
+			})
+		})
+
+		Convey("With prefix", func() {
+			out, err := impl.SearchFiles(ctx, &api.SearchFilesRequest{
+				Glob:   "bin/*",
+				Prefix: "a/b",
+			})
+			So(err, ShouldBeNil)
+			So(found(out), ShouldResemble, []string{"a/b:bin/gn", "a/b:bin/ninja"})
+		})
+
+		Convey("With pagination", func() {
+			out, err := impl.SearchFiles(ctx, &api.SearchFilesRequest{
+				Glob:     "*/gn",
+				PageSize: 1,
+			})
+			So(err, ShouldBeNil)
+			So(found(out), ShouldResemble, []string{"a/b:bin/gn"})
+			So(out.NextPageToken, ShouldNotEqual, "")
+
+			out, err = impl.SearchFiles(ctx, &api.SearchFilesRequest{
+				Glob:      "*/gn",
+				PageSize:  1,
+				PageToken: out.NextPageToken,
+			})
+			So(err, ShouldBeNil)
+			So(found(out), ShouldResemble, []string{"a/c:bin/gn"})
+		})
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Refs support.
 
@@ -1939,6 +2080,203 @@ func TestTags(t *testing.T) {
 	})
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// Instance metadata support.
+
+func TestMetadata(t *testing.T) {
+	t.Parallel()
+
+	Convey("With fakes", t, func() {
+		ctx, _, as := testutil.TestingContext()
+
+		meta := testutil.MetadataStore{}
+		meta.Populate("a", &api.PrefixMetadata{
+			Acls: []*api.PrefixMetadata_ACL{
+				{
+					Role:       api.Role_READER,
+					Principals: []string{"user:reader@example.com"},
+				},
+				{
+					Role:       api.Role_WRITER,
+					Principals: []string{"user:writer@example.com"},
+				},
+				{
+					Role:       api.Role_OWNER,
+					Principals: []string{"user:owner@example.com"},
+				},
+			},
+		})
+
+		digest := strings.Repeat("a", 40)
+		So(datastore.Put(ctx, &model.Package{Name: "a/b/c"}, &model.Instance{
+			InstanceID: digest,
+			Package:    model.PackageKey(ctx, "a/b/c"),
+		}), ShouldBeNil)
+		objRef := &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_SHA1,
+			HexDigest: digest,
+		}
+
+		md := func(kv ...string) []*api.InstanceMetadata {
+			out := make([]*api.InstanceMetadata, len(kv))
+			for i, s := range kv {
+				chunks := strings.SplitN(s, ":", 2)
+				out[i] = &api.InstanceMetadata{
+					Key:         chunks[0],
+					Value:       []byte(chunks[1]),
+					ContentType: "text/plain",
+				}
+			}
+			return out
+		}
+
+		impl := repoImpl{meta: &meta}
+
+		Convey("Attach/List/Detach happy path", func() {
+			_, err := impl.AttachMetadata(as("writer@example.com"), &api.AttachMetadataRequest{
+				Package:  "a/b/c",
+				Instance: objRef,
+				Metadata: md("k0:v0", "k1:v1"),
+			})
+			So(err, ShouldBeNil)
+
+			resp, err := impl.ListMetadata(as("reader@example.com"), &api.ListMetadataRequest{
+				Package:  "a/b/c",
+				Instance: objRef,
+			})
+			So(err, ShouldBeNil)
+			So(resp.Metadata, ShouldHaveLength, 2)
+			So(resp.Metadata[0], ShouldResembleProto, &api.InstanceMetadata{
+				Key:         "k0",
+				Value:       []byte("v0"),
+				ContentType: "text/plain",
+				Fingerprint: common.InstanceMetadataFingerprint("k0", []byte("v0")),
+				AttachedBy:  "user:writer@example.com",
+				AttachedTs:  google.NewTimestamp(testutil.TestTime),
+			})
+
+			// Filtering by key works.
+			resp, err = impl.ListMetadata(as("reader@example.com"), &api.ListMetadataRequest{
+				Package:  "a/b/c",
+				Instance: objRef,
+				Keys:     []string{"k1"},
+			})
+			So(err, ShouldBeNil)
+			So(resp.Metadata, ShouldHaveLength, 1)
+			So(resp.Metadata[0].Key, ShouldEqual, "k1")
+
+			// Detaching requires OWNER.
+			_, err = impl.DetachMetadata(as("owner@example.com"), &api.DetachMetadataRequest{
+				Package:  "a/b/c",
+				Instance: objRef,
+				Metadata: []*api.InstanceMetadata{
+					{Key: "k0", Value: []byte("v0")},
+					{Fingerprint: common.InstanceMetadataFingerprint("k1", []byte("v1"))},
+				},
+			})
+			So(err, ShouldBeNil)
+
+			resp, err = impl.ListMetadata(as("reader@example.com"), &api.ListMetadataRequest{
+				Package:  "a/b/c",
+				Instance: objRef,
+			})
+			So(err, ShouldBeNil)
+			So(resp.Metadata, ShouldHaveLength, 0)
+		})
+
+		Convey("Bad requests", func() {
+			Convey("Empty metadata list", func() {
+				_, err := impl.AttachMetadata(as("owner@example.com"), &api.AttachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(err, ShouldErrLike, "cannot be empty")
+			})
+			Convey("Bad key", func() {
+				_, err := impl.AttachMetadata(as("owner@example.com"), &api.AttachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+					Metadata: md("BAD:v"),
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(err, ShouldErrLike, "invalid metadata key")
+			})
+			Convey("Bad content type", func() {
+				_, err := impl.AttachMetadata(as("owner@example.com"), &api.AttachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+					Metadata: []*api.InstanceMetadata{{Key: "k", ContentType: "zzz//"}},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(err, ShouldErrLike, "bad metadata in 'metadata'")
+			})
+			Convey("Both fingerprint and key", func() {
+				_, err := impl.DetachMetadata(as("owner@example.com"), &api.DetachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+					Metadata: []*api.InstanceMetadata{{
+						Key:         "k",
+						Fingerprint: common.InstanceMetadataFingerprint("k", nil),
+					}},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(err, ShouldErrLike, "not both")
+			})
+			Convey("Bad fingerprint", func() {
+				_, err := impl.DetachMetadata(as("owner@example.com"), &api.DetachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+					Metadata: []*api.InstanceMetadata{{Fingerprint: "zzz"}},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(err, ShouldErrLike, "bad metadata fingerprint")
+			})
+		})
+
+		Convey("No access", func() {
+			Convey("AttachMetadata", func() {
+				_, err := impl.AttachMetadata(as("reader@example.com"), &api.AttachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+					Metadata: md("k:v"),
+				})
+				So(grpc.Code(err), ShouldEqual, codes.PermissionDenied)
+				So(err, ShouldErrLike, "has no required WRITER role")
+			})
+			Convey("DetachMetadata", func() {
+				_, err := impl.DetachMetadata(as("writer@example.com"), &api.DetachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+					Metadata: md("k:v"),
+				})
+				So(grpc.Code(err), ShouldEqual, codes.PermissionDenied)
+				So(err, ShouldErrLike, "has no required OWNER role")
+			})
+			Convey("ListMetadata", func() {
+				_, err := impl.ListMetadata(as("someone@example.com"), &api.ListMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.PermissionDenied)
+				So(err, ShouldErrLike, "not allowed to see it")
+			})
+		})
+
+		Convey("Missing instance", func() {
+			_, err := impl.ListMetadata(as("reader@example.com"), &api.ListMetadataRequest{
+				Package: "a/b/c",
+				Instance: &api.ObjectRef{
+					HashAlgo:  api.HashAlgo_SHA1,
+					HexDigest: strings.Repeat("b", 40),
+				},
+			})
+			So(grpc.Code(err), ShouldEqual, codes.NotFound)
+			So(err, ShouldErrLike, "no such instance")
+		})
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Version resolution and instance info fetching.
 
@@ -49,5 +49,5 @@ var (
 
 	// AdminAPI is ACL-protected implementation of cipd.AdminServer that can be
 	// exposed as an external API to be used by administrators.
-	AdminAPI = admin.AdminAPI(&TQ)
+	AdminAPI = admin.AdminAPI(&TQ, InternalCAS)
 )
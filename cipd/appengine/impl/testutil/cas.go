@@ -30,6 +30,8 @@ type MockCAS struct {
 	BeginUploadImpl  func(context.Context, *api.BeginUploadRequest) (*api.UploadOperation, error)
 	FinishUploadImpl func(context.Context, *api.FinishUploadRequest) (*api.UploadOperation, error)
 	CancelUploadImpl func(context.Context, *api.CancelUploadRequest) (*api.UploadOperation, error)
+
+	ScheduleDeletionImpl func(context.Context, *api.ObjectRef) error
 }
 
 // GetReader implements the corresponding method of cas.StorageServer interface.
@@ -86,3 +88,15 @@ func (m *MockCAS) CancelUpload(c context.Context, r *api.CancelUploadRequest) (*
 	}
 	return m.CancelUploadImpl(c, r)
 }
+
+// ScheduleDeletion implements the corresponding method of cas.StorageServer
+// interface.
+func (m *MockCAS) ScheduleDeletion(c context.Context, ref *api.ObjectRef) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	if m.ScheduleDeletionImpl == nil {
+		panic("must not be called")
+	}
+	return m.ScheduleDeletionImpl(c, ref)
+}
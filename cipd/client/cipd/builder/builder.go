@@ -19,19 +19,25 @@ import (
 	"bytes"
 	"compress/flate"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
+
 	"go.chromium.org/luci/common/data/stringset"
 	"go.chromium.org/luci/common/logging"
 
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
 	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/client/cipd/internal/cdc"
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
 	"go.chromium.org/luci/cipd/common"
 )
@@ -54,8 +60,28 @@ type Options struct {
 	InstallMode pkg.InstallMode
 
 	// CompressionLevel defines deflate compression level in range [0-9].
+	//
+	// For chunked packages it is mapped to one of zstd compression levels.
 	CompressionLevel int
 
+	// Chunked, if true, instructs the builder to produce a package in the
+	// chunked format (see pkg.ChunkedFormatVersion).
+	//
+	// Regular files in such packages are split into content-defined chunks, each
+	// compressed with zstd. This allows the client to fetch only chunks it
+	// doesn't have yet when updating the package. Such packages can't be read by
+	// older clients.
+	Chunked bool
+
+	// Reproducible, if true, instructs the builder to produce byte-for-byte
+	// identical packages from identical file contents.
+	//
+	// Files are sorted by name, modification times and Windows file attributes
+	// are dropped and only the executable permission bit is preserved. Together
+	// this makes the instance ID depend only on the package name, the file names
+	// and their contents (and the builder options, e.g. the compression level).
+	Reproducible bool
+
 	// HashAlgo specifies what hashing algorithm to use for computing instance ID.
 	//
 	// By default it is common.DefaultHashAlgo.
@@ -109,7 +135,14 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 	if err != nil {
 		return common.Pin{}, err
 	}
-	files := append(opts.Input, manifestFile)
+	files := make([]fs.File, 0, len(opts.Input)+1)
+	files = append(files, opts.Input...)
+	if opts.Reproducible {
+		sort.SliceStable(files, func(i, j int) bool {
+			return files[i].Name() < files[j].Name()
+		})
+	}
+	files = append(files, manifestFile)
 
 	// Make sure filenames are unique.
 	seenNames := make(map[string]struct{}, len(files))
@@ -122,7 +155,7 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 	}
 
 	// Write the final zip file, calculate its hash to use for instance ID.
-	if err := zipInputFiles(ctx, files, io.MultiWriter(opts.Output, hash), opts.CompressionLevel); err != nil {
+	if err := zipInputFiles(ctx, files, io.MultiWriter(opts.Output, hash), opts); err != nil {
 		return common.Pin{}, err
 	}
 	return common.Pin{
@@ -136,16 +169,45 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 
 // zipInputFiles deterministically builds a zip archive out of input files and
 // writes it to the writer. Files are written in the order given.
-func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int) error {
+//
+// Uses CompressionLevel, Chunked and Reproducible fields of 'opts'. If Chunked
+// is true, regular files are compressed with zstd chunk by chunk and the chunk
+// index is appended to the archive. If Reproducible is true, file metadata that
+// depends on the environment (mtime, writable bit, Windows attributes) is not
+// stored.
+func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, opts Options) error {
+	level := opts.CompressionLevel
+	chunked := opts.Chunked
 	logging.Infof(ctx, "About to zip %d files with compression level %d", len(files), level)
 
-	writer := zip.NewWriter(w)
+	// Need to know offsets of chunks within the output when using the chunked
+	// format.
+	counter := &countingWriter{w: w}
+	writer := zip.NewWriter(counter)
 	defer writer.Close()
 
 	writer.RegisterCompressor(zip.Deflate, func(out io.Writer) (io.WriteCloser, error) {
 		return flate.NewWriter(out, level)
 	})
 
+	// 'compressor' is the compressor of the file currently being written. It is
+	// used to collect chunks of this file.
+	var index pkg.ChunkIndex
+	var compressor *chunkCompressor
+	if chunked {
+		enc, err := zstd.NewWriter(nil,
+			zstd.WithEncoderLevel(zstdLevel(level)),
+			zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return err
+		}
+		defer enc.Close()
+		writer.RegisterCompressor(pkg.ZstdMethod, func(out io.Writer) (io.WriteCloser, error) {
+			compressor = newChunkCompressor(enc, out)
+			return compressor, nil
+		})
+	}
+
 	// Reports zipping progress to the log each second.
 	lastReport := time.Time{}
 	progress := func(count int) {
@@ -170,7 +232,11 @@ func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int)
 			Name:   in.Name(),
 			Method: zip.Deflate,
 		}
-		if level == 0 || in.Symlink() || isLikelyAlreadyCompressed(in) {
+		useChunks := chunked && !in.Symlink() && in.Size() != 0 && in.Name() != pkg.ManifestName
+		switch {
+		case useChunks:
+			fh.Method = pkg.ZstdMethod
+		case level == 0 || in.Symlink() || isLikelyAlreadyCompressed(in):
 			fh.Method = zip.Store
 		}
 
@@ -178,7 +244,7 @@ func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int)
 		if in.Executable() {
 			mode |= 0100
 		}
-		if in.Writable() {
+		if in.Writable() && !opts.Reproducible {
 			mode |= 0200
 		}
 		if in.Symlink() {
@@ -186,11 +252,13 @@ func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int)
 		}
 		fh.SetMode(mode)
 
-		if !in.ModTime().IsZero() {
+		if !in.ModTime().IsZero() && !opts.Reproducible {
 			fh.SetModTime(in.ModTime())
 		}
 
-		fh.ExternalAttrs |= uint32(in.WinAttrs())
+		if !opts.Reproducible {
+			fh.ExternalAttrs |= uint32(in.WinAttrs())
+		}
 
 		dst, err := writer.CreateHeader(&fh)
 		if err != nil {
@@ -204,11 +272,56 @@ func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int)
 		if err != nil {
 			return err
 		}
+
+		// Flush the last chunk and find where the file data starts in the output
+		// to calculate absolute offsets of chunks.
+		if useChunks {
+			if err := compressor.Close(); err != nil {
+				return err
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			start := counter.n - compressor.written
+			for _, c := range compressor.chunks {
+				c.Offset += start
+				index.Chunks = append(index.Chunks, c)
+			}
+		}
 	}
 
+	if chunked {
+		return zipChunkIndex(writer, counter, &index)
+	}
 	return nil
 }
 
+// zipChunkIndex writes the chunk index file uncompressed and records its
+// location in the zip comment.
+func zipChunkIndex(writer *zip.Writer, counter *countingWriter, index *pkg.ChunkIndex) error {
+	buf := bytes.Buffer{}
+	if err := pkg.WriteChunkIndex(index, &buf); err != nil {
+		return err
+	}
+	fh := zip.FileHeader{
+		Name:   pkg.ChunkIndexName,
+		Method: zip.Store,
+	}
+	fh.SetMode(0400)
+	dst, err := writer.CreateHeader(&fh)
+	if err != nil {
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	start := counter.n
+	if _, err := dst.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	return writer.SetComment(pkg.ChunkIndexComment(start, int64(buf.Len())))
+}
+
 func zipRegularFile(dst io.Writer, f fs.File) error {
 	src, err := f.Open()
 	if err != nil {
@@ -320,6 +433,80 @@ var compressedExt = stringset.NewFromSlice(
 
 ////////////////////////////////////////////////////////////////////////////////
 
+// zstdLevel maps deflate compression level to a zstd one.
+func zstdLevel(level int) zstd.EncoderLevel {
+	switch {
+	case level <= 3:
+		return zstd.SpeedFastest
+	case level <= 7:
+		return zstd.SpeedDefault
+	default:
+		return zstd.SpeedBetterCompression
+	}
+}
+
+// chunkCompressor splits the data into content-defined chunks and writes each
+// one as a separate zstd frame.
+type chunkCompressor struct {
+	enc     *zstd.Encoder
+	out     io.Writer
+	split   *cdc.Splitter
+	frame   []byte
+	chunks  []pkg.Chunk // offsets are relative to the start of the file data
+	written int64       // total size of all frames
+	closed  bool
+}
+
+func newChunkCompressor(enc *zstd.Encoder, out io.Writer) *chunkCompressor {
+	c := &chunkCompressor{enc: enc, out: out}
+	c.split = cdc.NewSplitter(c.writeChunk)
+	return c
+}
+
+func (c *chunkCompressor) writeChunk(chunk []byte) error {
+	c.frame = c.enc.EncodeAll(chunk, c.frame[:0])
+	if _, err := c.out.Write(c.frame); err != nil {
+		return err
+	}
+	digest := sha256.Sum256(c.frame)
+	c.chunks = append(c.chunks, pkg.Chunk{
+		Offset: c.written,
+		Size:   int64(len(c.frame)),
+		Hash:   hex.EncodeToString(digest[:]),
+	})
+	c.written += int64(len(c.frame))
+	return nil
+}
+
+func (c *chunkCompressor) Write(p []byte) (int, error) {
+	return c.split.Write(p)
+}
+
+// Close flushes the last chunk.
+//
+// It is called by zipInputFiles explicitly and then again by the zip writer.
+func (c *chunkCompressor) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.split.Close()
+}
+
+// countingWriter counts bytes passed through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
 type manifestFile []byte
 
 func (m *manifestFile) Name() string          { return pkg.ManifestName }
@@ -348,6 +535,9 @@ func makeManifestFile(opts Options) (fs.File, error) {
 		return nil, err
 	}
 	formatVer := pkg.ManifestFormatVersion
+	if opts.Chunked {
+		formatVer = pkg.ChunkedFormatVersion
+	}
 	if opts.OverrideFormatVersion != "" {
 		formatVer = opts.OverrideFormatVersion
 	}
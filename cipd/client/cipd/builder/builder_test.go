@@ -22,10 +22,13 @@ import (
 	"encoding/hex"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
+
 	"go.chromium.org/luci/cipd/client/cipd/fs"
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
 	"go.chromium.org/luci/cipd/common"
@@ -306,6 +309,156 @@ func TestBuildInstance(t *testing.T) {
 	})
 }
 
+func TestBuildChunkedInstance(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Building chunked package", t, func() {
+		big := make([]byte, 4*1024*1024)
+		rand.New(rand.NewSource(1)).Read(big)
+
+		build := func(bigBody []byte) []byte {
+			out := bytes.Buffer{}
+			_, err := BuildInstance(ctx, Options{
+				Input: []fs.File{
+					fs.NewTestFile("big", string(bigBody), fs.TestFileOpts{}),
+					fs.NewTestFile("small", "12345", fs.TestFileOpts{Executable: true}),
+					fs.NewTestFile("empty", "", fs.TestFileOpts{}),
+					fs.NewTestSymlink("symlink", "small"),
+				},
+				Output:           &out,
+				PackageName:      "testing",
+				CompressionLevel: 5,
+				Chunked:          true,
+			})
+			So(err, ShouldBeNil)
+			return out.Bytes()
+		}
+
+		readIndex := func(data []byte) *pkg.ChunkIndex {
+			offset, size, ok := pkg.FindChunkIndex(data)
+			So(ok, ShouldBeTrue)
+			idx, err := pkg.ReadChunkIndex(bytes.NewReader(data[offset : offset+size]))
+			So(err, ShouldBeNil)
+			return idx
+		}
+
+		data := build(big)
+
+		Convey("Is deterministic", func() {
+			So(bytes.Equal(build(big), data), ShouldBeTrue)
+		})
+
+		Convey("Has all files", func() {
+			files := readZip(data)
+			So(files, ShouldHaveLength, 6)
+			So(files[0].name, ShouldEqual, "big")
+			So(bytes.Equal(files[0].body, big), ShouldBeTrue)
+			So(files[1:4], ShouldResemble, []zippedFile{
+				{name: "small", size: 5, mode: 0500, body: []byte("12345")},
+				{name: "empty", size: 0, mode: 0400, body: []byte{}},
+				{name: "symlink", size: 5, mode: 0400 | os.ModeSymlink, body: []byte("small")},
+			})
+			So(files[4].name, ShouldEqual, pkg.ManifestName)
+			So(string(files[4].body), ShouldContainSubstring, `"format_version": "2"`)
+			So(files[5].name, ShouldEqual, pkg.ChunkIndexName)
+		})
+
+		Convey("Chunk index points to zstd frames", func() {
+			idx := readIndex(data)
+			So(len(idx.Chunks), ShouldBeGreaterThan, 4)
+
+			var unpacked []byte
+			for _, c := range idx.Chunks {
+				frame := data[c.Offset:c.End()]
+				digest := sha256.Sum256(frame)
+				So(hex.EncodeToString(digest[:]), ShouldEqual, c.Hash)
+				dec, err := zstd.NewReader(nil)
+				So(err, ShouldBeNil)
+				chunk, err := dec.DecodeAll(frame, nil)
+				So(err, ShouldBeNil)
+				unpacked = append(unpacked, chunk...)
+				dec.Close()
+			}
+			So(bytes.Equal(unpacked, append(append([]byte(nil), big...), "12345"...)), ShouldBeTrue)
+		})
+
+		Convey("Local edits change only few chunks", func() {
+			edited := append([]byte(nil), big[:2*1024*1024]...)
+			edited = append(edited, "inserted"...)
+			edited = append(edited, big[2*1024*1024:]...)
+
+			before := map[string]bool{}
+			for _, c := range readIndex(data).Chunks {
+				before[c.Hash] = true
+			}
+			changed := 0
+			for _, c := range readIndex(build(edited)).Chunks {
+				if !before[c.Hash] {
+					changed++
+				}
+			}
+			So(changed, ShouldBeBetweenOrEqual, 1, 2)
+		})
+	})
+}
+
+func TestBuildReproducibleInstance(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Building reproducible package", t, func() {
+		build := func(files ...fs.File) (common.Pin, []byte) {
+			out := bytes.Buffer{}
+			pin, err := BuildInstance(ctx, Options{
+				Input:            files,
+				Output:           &out,
+				PackageName:      "testing",
+				CompressionLevel: 5,
+				Reproducible:     true,
+			})
+			So(err, ShouldBeNil)
+			return pin, out.Bytes()
+		}
+
+		mtime1 := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+		mtime2 := time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+		pin1, data := build(
+			fs.NewTestFile("b", "b body", fs.TestFileOpts{Executable: true, ModTime: mtime1}),
+			fs.NewTestFile("a", "a body", fs.TestFileOpts{Writable: true, ModTime: mtime1}),
+			fs.NewTestSymlink("c", "a"),
+		)
+		pin2, _ := build(
+			fs.NewTestSymlink("c", "a"),
+			fs.NewTestFile("a", "a body", fs.TestFileOpts{ModTime: mtime2}),
+			fs.NewTestFile("b", "b body", fs.TestFileOpts{Executable: true, Writable: true}),
+		)
+
+		Convey("Has stable instance ID", func() {
+			So(pin1, ShouldResemble, pin2)
+		})
+
+		Convey("Normalizes files", func() {
+			files := readZip(data)
+			So(files, ShouldHaveLength, 4)
+			So(files[:3], ShouldResemble, []zippedFile{
+				{name: "a", size: 6, mode: 0400, body: []byte("a body")},
+				{name: "b", size: 6, mode: 0500, body: []byte("b body")},
+				{name: "c", size: 1, mode: 0400 | os.ModeSymlink, body: []byte("a")},
+			})
+			So(files[3].name, ShouldEqual, pkg.ManifestName)
+		})
+
+		Convey("Depends on file contents", func() {
+			pin3, _ := build(
+				fs.NewTestFile("b", "b body", fs.TestFileOpts{Executable: true}),
+				fs.NewTestFile("a", "another body", fs.TestFileOpts{}),
+				fs.NewTestSymlink("c", "a"),
+			)
+			So(pin3, ShouldNotResemble, pin1)
+		})
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 // getSHA256 returns SHA256 hex digest of a byte buffer.
@@ -332,6 +485,13 @@ func readZip(data []byte) []zippedFile {
 	if err != nil {
 		panic("Failed to open zip file")
 	}
+	z.RegisterDecompressor(pkg.ZstdMethod, func(r io.Reader) io.ReadCloser {
+		d, err := zstd.NewReader(r)
+		if err != nil {
+			panic("Failed to create zstd decoder")
+		}
+		return d.IOReadCloser()
+	})
 	files := make([]zippedFile, len(z.File))
 	for i, zf := range z.File {
 		reader, err := zf.Open()
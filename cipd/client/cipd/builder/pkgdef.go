@@ -52,6 +52,11 @@ type PackageDef struct {
 	// mode on the files.
 	PreserveWritable bool `yaml:"preserve_writable"`
 
+	// Reproducible instructs CIPD to build the package in a reproducible way,
+	// see Options.Reproducible. Can't be used with PreserveModTime and
+	// PreserveWritable.
+	Reproducible bool `yaml:"reproducible"`
+
 	// Data describes what is deployed with the package.
 	Data []PackageChunkDef
 }
@@ -104,6 +109,14 @@ func LoadPackageDef(r io.Reader, vars map[string]string) (PackageDef, error) {
 	if err = pkg.ValidateInstallMode(out.InstallMode); err != nil {
 		return PackageDef{}, err
 	}
+	if out.Reproducible {
+		if out.PreserveModTime {
+			return PackageDef{}, fmt.Errorf("'preserve_mtime' can't be used in a reproducible package")
+		}
+		if out.PreserveWritable {
+			return PackageDef{}, fmt.Errorf("'preserve_writable' can't be used in a reproducible package")
+		}
+	}
 
 	versionFile := ""
 	for i, chunk := range out.Data {
@@ -25,6 +25,7 @@ import (
 	"go.chromium.org/luci/cipd/client/cipd/fs"
 
 	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
 func TestLoadPackageDef(t *testing.T) {
@@ -101,6 +102,29 @@ func TestLoadPackageDef(t *testing.T) {
 		So(def.VersionFile(), ShouldEqual, "some/path/version_value1.json")
 	})
 
+	Convey("LoadPackageDef reproducible works", t, func() {
+		body := strings.NewReader(`{"package": "package/name", "reproducible": true}`)
+		def, err := LoadPackageDef(body, nil)
+		So(err, ShouldBeNil)
+		So(def, ShouldResemble, PackageDef{
+			Package:      "package/name",
+			Root:         ".",
+			Reproducible: true,
+		})
+	})
+
+	Convey("LoadPackageDef reproducible with preserve_mtime", t, func() {
+		body := strings.NewReader(`{"package": "package/name", "reproducible": true, "preserve_mtime": true}`)
+		_, err := LoadPackageDef(body, nil)
+		So(err, ShouldErrLike, "'preserve_mtime' can't be used in a reproducible package")
+	})
+
+	Convey("LoadPackageDef reproducible with preserve_writable", t, func() {
+		body := strings.NewReader(`{"package": "package/name", "reproducible": true, "preserve_writable": true}`)
+		_, err := LoadPackageDef(body, nil)
+		So(err, ShouldErrLike, "'preserve_writable' can't be used in a reproducible package")
+	})
+
 	Convey("LoadPackageDef not yaml", t, func() {
 		body := strings.NewReader(`{ not yaml)`)
 		_, err := LoadPackageDef(body, nil)
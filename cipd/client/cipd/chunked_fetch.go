@@ -0,0 +1,190 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cipd
+
+import (
+	"bytes"
+	"context"
+	"hash"
+	"io"
+	"os"
+
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/cipd/client/cipd/internal"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+)
+
+const (
+	// chunkedFetchTailLen is how many bytes to fetch from the end of a package
+	// file to discover whether it is a chunked package.
+	//
+	// It is large enough to grab the chunk index of most packages in the same
+	// request.
+	chunkedFetchTailLen = 64 * 1024
+
+	// chunkedFetchMergeGap defines when to merge remote ranges.
+	//
+	// If two ranges are separated by a cached chunk smaller than this, they are
+	// merged into one (i.e. the cached chunk is fetched again), to avoid making
+	// too many small requests.
+	chunkedFetchMergeGap = 64 * 1024
+
+	// chunkedFetchMaxRanges is the maximum number of remote ranges to fetch.
+	//
+	// If more is needed, the package is fetched in full.
+	chunkedFetchMaxRanges = 100
+
+	// chunkedFetchMaxRangeLen is the maximum size of a single range request.
+	chunkedFetchMaxRangeLen = 8 * 1024 * 1024
+)
+
+// fetchSegment is a part of a package file to get either from a cached chunk
+// or from the storage.
+type fetchSegment struct {
+	offset int64
+	length int64
+	hash   string // the hash of the cached chunk or "" to fetch remotely
+}
+
+// planChunkedFetch splits a package file into segments to get from cached
+// chunks and ones to fetch remotely.
+//
+// Returns the segments (covering the entire file, in order), the number of
+// remote segments and their total size.
+func planChunkedFetch(idx *pkg.ChunkIndex, total int64, cached func(hash string) bool) (plan []fetchSegment, ranges int, remote int64) {
+	appendRemote := func(offset, length int64) {
+		if length == 0 {
+			return
+		}
+		if n := len(plan); n != 0 && plan[n-1].hash == "" {
+			plan[n-1].length += length
+		} else {
+			plan = append(plan, fetchSegment{offset: offset, length: length})
+			ranges++
+		}
+		remote += length
+	}
+
+	var pos int64
+	for _, c := range idx.Chunks {
+		if !cached(c.Hash) {
+			continue // will be fetched together with data around it
+		}
+		appendRemote(pos, c.Offset-pos)
+		if n := len(plan); n != 0 && plan[n-1].hash == "" && c.Size < chunkedFetchMergeGap {
+			appendRemote(c.Offset, c.Size)
+		} else {
+			plan = append(plan, fetchSegment{offset: c.Offset, length: c.Size, hash: c.Hash})
+		}
+		pos = c.End()
+	}
+	appendRemote(pos, total-pos)
+	return
+}
+
+// remoteFetchChunked attempts to assemble a chunked package file from chunks
+// already present in the instance cache, fetching only the rest of it.
+//
+// Writes the package file to 'output' and passes it through the hasher.
+// Returns false if the package is not chunked or there are too few chunks in
+// the cache to bother. In that case the caller should fetch the package file in
+// full. The caller is also responsible for verifying the hash.
+func (client *clientImpl) remoteFetchChunked(ctx context.Context, url string, cache *internal.InstanceCache, output io.WriteSeeker, h hash.Hash) (bool, error) {
+	tail, total, err := client.storage.downloadRange(ctx, url, -1, chunkedFetchTailLen)
+	if err != nil {
+		return false, err
+	}
+	offset, length, ok := pkg.FindChunkIndex(tail)
+	switch {
+	case !ok:
+		return false, nil
+	case offset+length > total:
+		return false, errors.Reason("the chunk index is out of bounds").Err()
+	}
+
+	// The tail likely contains the index already.
+	var blob []byte
+	if tailStart := total - int64(len(tail)); offset >= tailStart {
+		blob = tail[offset-tailStart : offset-tailStart+length]
+	} else if blob, _, err = client.storage.downloadRange(ctx, url, offset, length); err != nil {
+		return false, err
+	}
+	idx, err := pkg.ReadChunkIndex(bytes.NewReader(blob))
+	if err != nil {
+		return false, err
+	}
+	if n := len(idx.Chunks); n != 0 && idx.Chunks[n-1].End() > total {
+		return false, errors.Reason("the chunk index refers to data outside of the file").Err()
+	}
+
+	hashes := stringset.New(len(idx.Chunks))
+	for _, c := range idx.Chunks {
+		hashes.Add(c.Hash)
+	}
+	cached := cache.FindChunks(ctx, hashes)
+	plan, ranges, remote := planChunkedFetch(idx, total, cached.Has)
+	if ranges > chunkedFetchMaxRanges || remote*10 > total*9 {
+		logging.Infof(ctx, "cipd: too few chunks in the instance cache (%d of %d), fetching the package in full", cached.Len(), hashes.Len())
+		return false, nil
+	}
+	logging.Infof(ctx, "cipd: reusing %d of %d chunks from the instance cache, about to fetch %.1f MB of %.1f MB",
+		cached.Len(), hashes.Len(), float32(remote)/1000.0/1000.0, float32(total)/1000.0/1000.0)
+
+	h.Reset()
+	if _, err := output.Seek(0, os.SEEK_SET); err != nil {
+		return false, err
+	}
+	out := io.MultiWriter(output, h)
+	for _, seg := range plan {
+		if seg.hash != "" {
+			data, err := cached.Read(seg.hash)
+			if err == nil {
+				if _, err := out.Write(data); err != nil {
+					return false, err
+				}
+				continue
+			}
+			logging.Warningf(ctx, "cipd: failed to read a cached chunk, fetching it - %s", err)
+		}
+		if err := client.fetchRange(ctx, url, seg.offset, seg.length, out); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// fetchRange fetches a byte range of a file, splitting it into reasonably sized
+// requests, and writes it to 'out'.
+func (client *clientImpl) fetchRange(ctx context.Context, url string, offset, length int64, out io.Writer) error {
+	for length > 0 {
+		n := length
+		if n > chunkedFetchMaxRangeLen {
+			n = chunkedFetchMaxRangeLen
+		}
+		data, _, err := client.storage.downloadRange(ctx, url, offset, n)
+		if err != nil {
+			return err
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+		offset += n
+		length -= n
+	}
+	return nil
+}
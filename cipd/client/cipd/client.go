@@ -26,6 +26,12 @@
 //   }
 //   DeterministicZip = zip archive with deterministic ordering of files and stripped timestamps
 //
+// Chunked packages (format version "2", see pkg.ChunkedFormatVersion) are zip
+// archives too, but their files are compressed with zstd chunk by chunk and
+// there's an additional ".cipdpkg/chunks.json" file with the chunk index. When
+// fetching such packages the client reuses chunks of instances in the instance
+// cache, fetching only the rest via HTTP range requests.
+//
 // Main package data (<zipped data> above) is deterministic, meaning its content
 // depends only on inputs used to built it (byte to byte): contents and names of
 // all files added to the package (plus 'executable' file mode bit) and
@@ -75,6 +81,7 @@ import (
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
 	"go.chromium.org/luci/cipd/client/cipd/platform"
 	"go.chromium.org/luci/cipd/client/cipd/reader"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/common"
 	"go.chromium.org/luci/cipd/version"
@@ -92,6 +99,10 @@ const (
 	// TagAttachTimeout is how long to wait for an instance to be processed when
 	// attaching tags in AttachTagsWhenReady.
 	TagAttachTimeout = 3 * time.Minute
+
+	// MetadataAttachTimeout is how long to wait for an instance to be processed
+	// when attaching metadata in AttachMetadataWhenReady.
+	MetadataAttachTimeout = 3 * time.Minute
 )
 
 // Environment variable definitions
@@ -110,9 +121,10 @@ var (
 	// us to upload it again.
 	ErrBadUpload = errors.New("package file is uploaded, but servers asks us to upload it again", transient.Tag)
 
-	// ErrProcessingTimeout is returned by SetRefWhenReady or AttachTagsWhenReady
-	// if the instance processing on the backend takes longer than expected. Refs
-	// and tags can be attached only to processed instances.
+	// ErrProcessingTimeout is returned by SetRefWhenReady, AttachTagsWhenReady
+	// or AttachMetadataWhenReady if the instance processing on the backend takes
+	// longer than expected. Refs, tags and metadata can be attached only to
+	// processed instances.
 	ErrProcessingTimeout = errors.New("timeout while waiting for the instance to become ready", transient.Tag)
 
 	// ErrDownloadError is returned by FetchInstance on download errors.
@@ -227,6 +239,15 @@ type Client interface {
 	// AttachTagsWhenReady attaches tags to an instance.
 	AttachTagsWhenReady(ctx context.Context, pin common.Pin, tags []string) error
 
+	// AttachMetadataWhenReady attaches metadata to an instance.
+	AttachMetadataWhenReady(ctx context.Context, pin common.Pin, md []Metadata) error
+
+	// FetchInstanceMetadata returns metadata attached to an instance.
+	//
+	// If 'keys' is not empty, returns only metadata with these keys. The returned
+	// list is sorted by attachment timestamp (newest first).
+	FetchInstanceMetadata(ctx context.Context, pin common.Pin, keys []string) ([]MetadataInfo, error)
+
 	// FetchPackageRefs returns information about all refs defined for a package.
 	//
 	// The returned list is sorted by modification timestamp (newest first).
@@ -255,6 +276,9 @@ type Client interface {
 	//
 	// Deploys to the given subdir under the site root (see ClientOptions.Root).
 	// It doesn't check whether the instance is already deployed.
+	//
+	// If ClientOptions.TrustedSigners is set, refuses to fetch instances that are
+	// not signed by any of the trusted signers.
 	FetchAndDeployInstance(ctx context.Context, subdir string, pin common.Pin, maxThreads int) error
 
 	// ListPackages returns a list packages and prefixes under the given prefix.
@@ -266,6 +290,13 @@ type Client interface {
 	// empty slice and nil error.
 	SearchInstances(ctx context.Context, packageName string, tags []string) (common.PinSlice, error)
 
+	// SearchFiles finds package instances that contain files matching the glob.
+	//
+	// The glob uses path.Match syntax (e.g. "bin/gn" or "*/gn"). If 'prefix' is
+	// not empty, searches only instances of packages under it. Returns up to
+	// 'limit' matches or all of them if 'limit' is 0.
+	SearchFiles(ctx context.Context, glob, prefix string, limit int) ([]FileMatch, error)
+
 	// ListInstances enumerates instances of a package, most recent first.
 	//
 	// Returns an object that can be used to fetch the listing, page by page.
@@ -284,9 +315,28 @@ type Client interface {
 	// If dryRun is true, will just check for changes and return them in Actions
 	// struct, but won't actually perform them.
 	//
+	// All new instances are fetched and unpacked before any package in the site
+	// root is touched, so failures to fetch them leave the site root as is. If
+	// some package then fails to install, all packages are switched back to the
+	// versions installed before the call, using instances kept locally. On
+	// success, the new set of packages is recorded as the current generation of
+	// the site root (see RollbackPackages).
+	//
 	// If the update was only partially applied, returns both Actions and error.
 	EnsurePackages(ctx context.Context, pkgs common.PinSliceBySubdir, paranoia ParanoidMode, maxThreads int, dryRun bool) (ActionMap, error)
 
+	// RollbackPackages restores the previous generation of the site root, i.e.
+	// the set of packages that was installed before the last EnsurePackages call
+	// that changed something.
+	//
+	// Instances kept in the site root by the deployer are restored without
+	// fetching them. Rolling back twice in a row undoes the rollback.
+	//
+	// Returns an error if no previous generation is recorded, or if it was
+	// fetched from another backend or with other trusted signers than those in
+	// ClientOptions, since its instances may need to be refetched.
+	RollbackPackages(ctx context.Context, maxThreads int) (ActionMap, error)
+
 	// CheckDeployment looks at what is supposed to be installed and compares it
 	// to what is really installed.
 	//
@@ -331,6 +381,15 @@ type ClientOptions struct {
 	// This is primarily used to implement $ResolvedVersions ensure file feature.
 	Versions ensure.VersionsFile
 
+	// TrustedSigners is an optional list of public keys of trusted signers.
+	//
+	// If set, the client will refuse to deploy package instances that don't have
+	// a valid signature made by at least one of these keys. See the signing
+	// package for the format of keys.
+	//
+	// This is primarily used to implement $TrustedSigners ensure file feature.
+	TrustedSigners []string
+
 	// AnonymousClient is http.Client that doesn't attach authentication headers.
 	//
 	// Will be used when talking to the Google Storage. We use signed URLs that do
@@ -407,6 +466,13 @@ func NewClient(opts ClientOptions) (Client, error) {
 	}
 	opts.ServiceURL = fmt.Sprintf("%s://%s", parsed.Scheme, parsed.Host)
 
+	var verifier *signing.Verifier
+	if len(opts.TrustedSigners) != 0 {
+		if verifier, err = signing.NewVerifier(opts.TrustedSigners); err != nil {
+			return nil, err
+		}
+	}
+
 	prpcC := &prpc.Client{
 		C:    opts.AuthenticatedClient,
 		Host: parsed.Host,
@@ -447,6 +513,7 @@ func NewClient(opts ClientOptions) (Client, error) {
 		repo:          repo,
 		storage:       s,
 		deployer:      deployer.New(opts.Root),
+		verifier:      verifier,
 	}, nil
 }
 
@@ -504,6 +571,8 @@ type clientImpl struct {
 	storage storage
 	// deployer knows how to install packages to local file system. Thread safe.
 	deployer deployer.Deployer
+	// verifier checks instance signatures, nil if TrustedSigners is empty.
+	verifier *signing.Verifier
 
 	// tagCache is a file-system based cache of resolved tags.
 	tagCache     *internal.TagCache
@@ -1135,6 +1204,53 @@ func (client *clientImpl) AttachTagsWhenReady(ctx context.Context, pin common.Pi
 	return err
 }
 
+func (client *clientImpl) AttachMetadataWhenReady(ctx context.Context, pin common.Pin, md []Metadata) error {
+	if err := common.ValidatePin(pin, common.AnyHash); err != nil {
+		return err
+	}
+	if len(md) == 0 {
+		return nil
+	}
+
+	apiMD := make([]*api.InstanceMetadata, len(md))
+	for i, m := range md {
+		if err := common.ValidateInstanceMetadataKey(m.Key); err != nil {
+			return err
+		}
+		if err := common.ValidateInstanceMetadataLen(len(m.Value)); err != nil {
+			return err
+		}
+		if err := common.ValidateContentType(m.ContentType); err != nil {
+			return err
+		}
+		apiMD[i] = &api.InstanceMetadata{
+			Key:         m.Key,
+			Value:       m.Value,
+			ContentType: m.ContentType,
+		}
+		logging.Infof(ctx, "cipd: attaching metadata %s (%s)", m.Key, common.InstanceMetadataFingerprint(m.Key, m.Value))
+	}
+
+	err := client.retryUntilReady(ctx, MetadataAttachTimeout, func(ctx context.Context) error {
+		_, err := client.repo.AttachMetadata(ctx, &api.AttachMetadataRequest{
+			Package:  pin.PackageName,
+			Instance: common.InstanceIDToObjectRef(pin.InstanceID),
+			Metadata: apiMD,
+		}, expectedCodes)
+		return err
+	})
+
+	switch err {
+	case nil:
+		logging.Infof(ctx, "cipd: all metadata attached")
+	case ErrProcessingTimeout:
+		logging.Errorf(ctx, "cipd: failed to attach metadata - deadline exceeded")
+	default:
+		logging.Errorf(ctx, "cipd: failed to attach metadata - %s", err)
+	}
+	return err
+}
+
 // How long to wait between retries in retryUntilReady.
 const retryDelay = 5 * time.Second
 
@@ -1206,6 +1322,49 @@ func (client *clientImpl) SearchInstances(ctx context.Context, packageName strin
 	return out, nil
 }
 
+func (client *clientImpl) SearchFiles(ctx context.Context, glob, prefix string, limit int) ([]FileMatch, error) {
+	if err := common.ValidateFileGlob(glob); err != nil {
+		return nil, err
+	}
+	if prefix != "" {
+		var err error
+		if prefix, err = common.ValidatePackagePrefix(prefix); err != nil {
+			return nil, err
+		}
+	}
+
+	var out []FileMatch
+	pageToken := ""
+	for limit == 0 || len(out) < limit {
+		pageSize := 1000
+		if limit != 0 && limit-len(out) < pageSize {
+			pageSize = limit - len(out)
+		}
+		resp, err := client.repo.SearchFiles(ctx, &api.SearchFilesRequest{
+			Glob:      glob,
+			Prefix:    prefix,
+			PageSize:  int32(pageSize),
+			PageToken: pageToken,
+		}, expectedCodes)
+		if err != nil {
+			return nil, client.humanErr(err)
+		}
+		for _, m := range resp.Matches {
+			out = append(out, FileMatch{
+				Pin:  apiInstanceToInfo(m.Instance).Pin,
+				Path: m.Path,
+				Hash: m.Hash.GetHexDigest(),
+				Size: m.Size,
+			})
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	return out, nil
+}
+
 func (client *clientImpl) ListInstances(ctx context.Context, packageName string) (InstanceEnumerator, error) {
 	if err := common.ValidatePackageName(packageName); err != nil {
 		return nil, err
@@ -1229,6 +1388,32 @@ func (client *clientImpl) ListInstances(ctx context.Context, packageName string)
 	}, nil
 }
 
+func (client *clientImpl) FetchInstanceMetadata(ctx context.Context, pin common.Pin, keys []string) ([]MetadataInfo, error) {
+	if err := common.ValidatePin(pin, common.AnyHash); err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		if err := common.ValidateInstanceMetadataKey(k); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := client.repo.ListMetadata(ctx, &api.ListMetadataRequest{
+		Package:  pin.PackageName,
+		Instance: common.InstanceIDToObjectRef(pin.InstanceID),
+		Keys:     keys,
+	}, expectedCodes)
+	if err != nil {
+		return nil, client.humanErr(err)
+	}
+
+	out := make([]MetadataInfo, len(resp.Metadata))
+	for i, md := range resp.Metadata {
+		out[i] = apiMetadataToInfo(md)
+	}
+	return out, nil
+}
+
 func (client *clientImpl) FetchPackageRefs(ctx context.Context, packageName string) ([]RefInfo, error) {
 	if err := common.ValidatePackageName(packageName); err != nil {
 		return nil, err
@@ -1393,6 +1578,20 @@ func (client *clientImpl) remoteFetchInstance(ctx context.Context, pin common.Pi
 	}
 
 	hash := common.MustNewHash(objRef.HashAlgo)
+
+	// If the instance cache is used, try to reuse chunks of other instances from
+	// it first. This works only for chunked packages.
+	if cache := client.getInstanceCache(ctx); cache != nil {
+		switch ok, err := client.remoteFetchChunked(ctx, resp.SignedUrl, cache, output, hash); {
+		case err != nil:
+			logging.Warningf(ctx, "cipd: failed to fetch only missing chunks of %s, fetching it in full - %s", pin, err)
+		case ok && common.HexDigest(hash) == objRef.HexDigest:
+			return nil
+		case ok:
+			logging.Warningf(ctx, "cipd: %s assembled from chunks has wrong hash, fetching it in full", pin)
+		}
+	}
+
 	if err = client.storage.download(ctx, resp.SignedUrl, output, hash); err != nil {
 		return
 	}
@@ -1415,6 +1614,9 @@ func (client *clientImpl) fetchAndDo(ctx context.Context, pin common.Pin, cb fun
 	if err := common.ValidatePin(pin, common.KnownHash); err != nil {
 		return err
 	}
+	if err := client.verifySignature(ctx, pin); err != nil {
+		return err
+	}
 
 	doit := func() (err error) {
 		// Fetch the package (verifying its hash) and obtain a pointer to its data.
@@ -1460,6 +1662,28 @@ func (client *clientImpl) fetchAndDo(ctx context.Context, pin common.Pin, cb fun
 	return err
 }
 
+// verifySignature checks the instance is signed by a trusted signer.
+//
+// Does nothing if ClientOptions.TrustedSigners is empty.
+func (client *clientImpl) verifySignature(ctx context.Context, pin common.Pin) error {
+	if client.verifier == nil {
+		return nil
+	}
+	md, err := client.FetchInstanceMetadata(ctx, pin, []string{signing.MetadataKey})
+	if err != nil {
+		return errors.Annotate(err, "failed to fetch signatures of %s", pin).Err()
+	}
+	sigs := make([][]byte, len(md))
+	for i, m := range md {
+		sigs[i] = m.Value
+	}
+	if err := client.verifier.Verify(pin, sigs); err != nil {
+		logging.Errorf(ctx, "cipd: refusing to install %s - %s", pin, err)
+		return err
+	}
+	return nil
+}
+
 func (client *clientImpl) FetchAndDeployInstance(ctx context.Context, subdir string, pin common.Pin, maxThreads int) error {
 	if err := common.ValidateSubdir(subdir); err != nil {
 		return err
@@ -1497,6 +1721,9 @@ func (client *clientImpl) ensurePackagesImpl(ctx context.Context, allPins common
 		if !silent {
 			logging.Debugf(ctx, "Everything is up-to-date.")
 		}
+		if !dryRun {
+			client.commitGeneration(ctx, allPins)
+		}
 		return
 	}
 
@@ -1511,20 +1738,78 @@ func (client *clientImpl) ensurePackagesImpl(ctx context.Context, allPins common
 		return
 	}
 
-	hasErrors := false
+	touched, hasErrors := client.applyActionPlan(ctx, aMap, allPins, maxThreads)
+	switch {
+	case hasErrors && touched:
+		// Do not leave the site root with a mix of old and new packages.
+		client.rollbackTo(ctx, existing, maxThreads)
+	case !hasErrors:
+		client.commitGeneration(ctx, allPins)
+	}
+
+	// Opportunistically cleanup the trash left from previous installs.
+	client.doBatchAwareOp(ctx, batchAwareOpCleanupTrash)
+
+	if !hasErrors {
+		logging.Infof(ctx, "All changes applied.")
+	} else {
+		err = ErrEnsurePackagesFailed
+	}
+	return
+}
+
+// applyActionPlan performs actions from the action map, recording errors in it.
+//
+// All new instances are fetched and unpacked into the guts first. If this
+// fails, the site root is not touched at all. Packages being removed are only
+// detached from the site root until all other actions succeed, so they can be
+// restored without fetching them.
+//
+// Returns true if some actions failed and whether the site root was modified.
+func (client *clientImpl) applyActionPlan(ctx context.Context, aMap ActionMap, allPins common.PinSliceBySubdir, maxThreads int) (touched, hasErrors bool) {
+	fail := func(actions *Actions, action string, pin common.Pin, err error) {
+		logging.Errorf(ctx, "Failed to %s %s - %s", action, pin, err)
+		hasErrors = true
+		actions.Errors = append(actions.Errors, ActionError{
+			Action: action,
+			Pin:    pin,
+			Error:  JSONError{err},
+		})
+	}
 
-	// Remove all unneeded stuff.
+	// Stage all new instances.
+	aMap.LoopOrdered(func(subdir string, actions *Actions) {
+		for _, pin := range actions.ToInstall {
+			if err := client.stageInstance(ctx, subdir, pin, maxThreads); err != nil {
+				fail(actions, "install", pin, err)
+			}
+		}
+		for _, pair := range actions.ToUpdate {
+			if err := client.stageInstance(ctx, subdir, pair.To, maxThreads); err != nil {
+				fail(actions, "install", pair.To, err)
+			}
+		}
+	})
+	if hasErrors {
+		// Forget packages that were never installed, along with their staged
+		// instances. Staged instances of installed packages are cleaned up when
+		// the package is deployed next time.
+		aMap.LoopOrdered(func(subdir string, actions *Actions) {
+			for _, pin := range actions.ToInstall {
+				if err := client.deployer.RemoveDeployed(ctx, subdir, pin.PackageName); err != nil {
+					logging.Warningf(ctx, "Failed to clean up %s - %s", pin, err)
+				}
+			}
+		})
+		return
+	}
+
+	// Take all unneeded stuff out of the site root.
+	touched = true
 	aMap.LoopOrdered(func(subdir string, actions *Actions) {
 		for _, pin := range actions.ToRemove {
-			err = client.deployer.RemoveDeployed(ctx, subdir, pin.PackageName)
-			if err != nil {
-				logging.Errorf(ctx, "Failed to remove %s - %s (subdir %q)", pin.PackageName, err, subdir)
-				hasErrors = true
-				actions.Errors = append(actions.Errors, ActionError{
-					Action: "remove",
-					Pin:    pin,
-					Error:  JSONError{err},
-				})
+			if err := client.deployer.DetachDeployed(ctx, subdir, pin.PackageName); err != nil {
+				fail(actions, "remove", pin, err)
 			}
 		}
 	})
@@ -1554,34 +1839,111 @@ func (client *clientImpl) ensurePackagesImpl(ctx context.Context, allPins common
 			var err error
 			if toDeploy[pin.PackageName] {
 				action = "install"
-				err = client.FetchAndDeployInstance(ctx, subdir, pin, maxThreads)
+				err = client.restoreOrDeployInstance(ctx, subdir, pin, maxThreads)
 			} else if plan := toRepair[pin.PackageName]; plan != nil {
 				action = "repair"
 				err = client.repairDeployed(ctx, subdir, pin, plan, maxThreads)
 			}
 			if err != nil {
-				logging.Errorf(ctx, "Failed to %s %s - %s", action, pin, err)
-				hasErrors = true
-				actions.Errors = append(actions.Errors, ActionError{
-					Action: action,
-					Pin:    pin,
-					Error:  JSONError{err},
-				})
+				fail(actions, action, pin, err)
 			}
 		}
 	})
 
-	// Opportunistically cleanup the trash left from previous installs.
-	client.doBatchAwareOp(ctx, batchAwareOpCleanupTrash)
-
+	// Now that all packages are in place, get rid of the removed ones for good.
 	if !hasErrors {
-		logging.Infof(ctx, "All changes applied.")
-	} else {
-		err = ErrEnsurePackagesFailed
+		aMap.LoopOrdered(func(subdir string, actions *Actions) {
+			for _, pin := range actions.ToRemove {
+				if err := client.deployer.RemoveDeployed(ctx, subdir, pin.PackageName); err != nil {
+					fail(actions, "remove", pin, err)
+				}
+			}
+		})
 	}
+
 	return
 }
 
+// stageInstance makes sure all files of the instance are in the guts, fetching
+// it if necessary.
+func (client *clientImpl) stageInstance(ctx context.Context, subdir string, pin common.Pin, maxThreads int) error {
+	switch staged, err := client.deployer.IsStaged(ctx, subdir, pin); {
+	case err != nil:
+		return err
+	case staged:
+		return nil
+	}
+	return client.fetchAndDo(ctx, pin, func(instance pkg.Instance) error {
+		return client.deployer.StageInstance(ctx, subdir, instance, maxThreads)
+	})
+}
+
+// restoreOrDeployInstance switches to an instance kept in the guts by the
+// deployer, if possible, or fetches and deploys it otherwise.
+func (client *clientImpl) restoreOrDeployInstance(ctx context.Context, subdir string, pin common.Pin, maxThreads int) error {
+	switch restored, err := client.deployer.RestoreInstance(ctx, subdir, pin); {
+	case err != nil:
+		return err
+	case restored:
+		return nil
+	}
+	return client.FetchAndDeployInstance(ctx, subdir, pin, maxThreads)
+}
+
+// rollbackTo brings the site root back to the given set of packages after
+// a failed update. Errors are logged.
+func (client *clientImpl) rollbackTo(ctx context.Context, pins common.PinSliceBySubdir, maxThreads int) {
+	logging.Warningf(ctx, "Rolling back to previously installed packages...")
+	existing, err := client.deployer.FindDeployed(ctx)
+	if err != nil {
+		logging.Errorf(ctx, "Failed to roll back - %s", err)
+		return
+	}
+	noRepairs := func(string, common.Pin) *RepairPlan { return nil }
+	aMap := buildActionPlan(pins, existing, noRepairs)
+	if _, hasErrors := client.applyActionPlan(ctx, aMap, pins, maxThreads); hasErrors {
+		logging.Errorf(ctx, "Failed to roll back, the site root is left in an inconsistent state")
+	} else {
+		logging.Warningf(ctx, "Rolled back.")
+	}
+}
+
+// commitGeneration records the given set of packages as the current generation
+// of the site root. Errors are logged.
+func (client *clientImpl) commitGeneration(ctx context.Context, pins common.PinSliceBySubdir) {
+	if err := client.deployer.CommitGeneration(ctx, pins, client.generationSource()); err != nil {
+		logging.Warningf(ctx, "Failed to record the site root generation - %s", err)
+	}
+}
+
+// generationSource returns the source of packages installed by this client.
+func (client *clientImpl) generationSource() deployer.Source {
+	return deployer.Source{
+		ServiceURL:     client.ServiceURL,
+		TrustedSigners: client.TrustedSigners,
+	}
+}
+
+func (client *clientImpl) RollbackPackages(ctx context.Context, maxThreads int) (ActionMap, error) {
+	gen, err := client.deployer.Generations(ctx)
+	switch {
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to read the site root generations").Err()
+	case gen.Previous == nil:
+		return nil, errors.Reason("no previous generation is recorded in the site root").Err()
+	}
+
+	// Instances removed since the previous generation are refetched, and they
+	// must come from the same backend and pass the same checks as before.
+	if src, cur := gen.Previous.Source, client.generationSource(); !src.Equal(cur) {
+		return nil, errors.Reason(
+			"generation #%d was installed from %s with trusted signers %q, but the client uses %s with %q",
+			gen.Previous.Number, src.ServiceURL, src.TrustedSigners, cur.ServiceURL, cur.TrustedSigners).Err()
+	}
+	logging.Infof(ctx, "Rolling back to generation #%d...", gen.Previous.Number)
+	return client.EnsurePackages(ctx, gen.Previous.Pins, NotParanoid, maxThreads, false)
+}
+
 func (client *clientImpl) CheckDeployment(ctx context.Context, paranoia ParanoidMode) (ActionMap, error) {
 	// This is essentially a dry run of EnsurePackages(already installed pkgs),
 	// but with some paranoia mode, so it detects breakages.
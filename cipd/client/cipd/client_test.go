@@ -17,11 +17,13 @@ package cipd
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sort"
 	"strings"
 	"testing"
@@ -34,15 +36,18 @@ import (
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/proto/google"
 
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
 	"go.chromium.org/luci/cipd/client/cipd/builder"
+	"go.chromium.org/luci/cipd/client/cipd/deployer"
 	"go.chromium.org/luci/cipd/client/cipd/digests"
 	"go.chromium.org/luci/cipd/client/cipd/fs"
 	"go.chromium.org/luci/cipd/client/cipd/internal"
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
 	"go.chromium.org/luci/cipd/client/cipd/platform"
 	"go.chromium.org/luci/cipd/client/cipd/reader"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/common"
 
@@ -466,6 +471,107 @@ func TestSetRefAndAttachTags(t *testing.T) {
 	})
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// Instance metadata.
+
+func TestInstanceMetadata(t *testing.T) {
+	t.Parallel()
+
+	Convey("With mocks", t, func(c C) {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestTimeLocal)
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			if testclock.HasTags(t, "cipd-sleeping") {
+				tc.Add(d)
+			}
+		})
+
+		client, _, repo, _ := mockedCipdClient(c)
+
+		objRef := &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_SHA256,
+			HexDigest: strings.Repeat("a", 64),
+		}
+		pin := common.Pin{
+			PackageName: "pkg/name",
+			InstanceID:  common.ObjectRefToInstanceID(objRef),
+		}
+
+		md := []Metadata{
+			{Key: "k1", Value: []byte("v1"), ContentType: "text/plain"},
+			{Key: "k2", Value: []byte("v2")},
+		}
+
+		attachMetadataRPC := func() rpcCall {
+			return rpcCall{
+				method: "AttachMetadata",
+				in: &api.AttachMetadataRequest{
+					Package:  "pkg/name",
+					Instance: objRef,
+					Metadata: []*api.InstanceMetadata{
+						{Key: "k1", Value: []byte("v1"), ContentType: "text/plain"},
+						{Key: "k2", Value: []byte("v2")},
+					},
+				},
+				out: &empty.Empty{},
+			}
+		}
+
+		Convey("AttachMetadataWhenReady happy path", func() {
+			repo.expect(attachMetadataRPC())
+			So(client.AttachMetadataWhenReady(ctx, pin, md), ShouldBeNil)
+		})
+
+		Convey("AttachMetadataWhenReady timeout", func() {
+			rpc := attachMetadataRPC()
+			rpc.err = status.Errorf(codes.FailedPrecondition, "not ready")
+			repo.expectMany(rpc)
+			So(client.AttachMetadataWhenReady(ctx, pin, md), ShouldEqual, ErrProcessingTimeout)
+		})
+
+		Convey("AttachMetadataWhenReady noop", func() {
+			So(client.AttachMetadataWhenReady(ctx, pin, nil), ShouldBeNil)
+		})
+
+		Convey("AttachMetadataWhenReady bad key", func() {
+			So(client.AttachMetadataWhenReady(ctx, pin, []Metadata{{Key: "BAD"}}), ShouldErrLike,
+				"invalid metadata key")
+		})
+
+		Convey("FetchInstanceMetadata works", func() {
+			repo.expect(rpcCall{
+				method: "ListMetadata",
+				in: &api.ListMetadataRequest{
+					Package:  "pkg/name",
+					Instance: objRef,
+					Keys:     []string{"k1"},
+				},
+				out: &api.ListMetadataResponse{
+					Metadata: []*api.InstanceMetadata{
+						{
+							Key:         "k1",
+							Value:       []byte("v1"),
+							ContentType: "text/plain",
+							Fingerprint: "fingerprint",
+							AttachedBy:  "user:a@example.com",
+							AttachedTs:  google.NewTimestamp(time.Unix(1000, 0)),
+						},
+					},
+				},
+			})
+			out, err := client.FetchInstanceMetadata(ctx, pin, []string{"k1"})
+			So(err, ShouldBeNil)
+			So(out, ShouldResemble, []MetadataInfo{
+				{
+					Metadata:     Metadata{Key: "k1", Value: []byte("v1"), ContentType: "text/plain"},
+					Fingerprint:  "fingerprint",
+					RegisteredBy: "user:a@example.com",
+					RegisteredTs: UnixTime(time.Unix(1000, 0).UTC()),
+				},
+			})
+		})
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Fetching info about packages and instances.
 
@@ -599,6 +705,107 @@ func TestSearchInstances(t *testing.T) {
 	})
 }
 
+func TestSearchFiles(t *testing.T) {
+	t.Parallel()
+
+	Convey("With mocks", t, func(c C) {
+		ctx := context.Background()
+		client, _, repo, _ := mockedCipdClient(c)
+
+		match := func(id, path string) *api.SearchFilesResponse_Match {
+			return &api.SearchFilesResponse_Match{
+				Instance: &api.Instance{
+					Package:  "a/b",
+					Instance: fakeObjectRef(id),
+				},
+				Path: path,
+				Hash: &api.ObjectRef{HashAlgo: api.HashAlgo_SHA256, HexDigest: strings.Repeat("f", 64)},
+				Size: 123,
+			}
+		}
+
+		fileMatch := func(id, path string) FileMatch {
+			return FileMatch{
+				Pin:  common.Pin{PackageName: "a/b", InstanceID: fakeIID(id)},
+				Path: path,
+				Hash: strings.Repeat("f", 64),
+				Size: 123,
+			}
+		}
+
+		Convey("Works", func() {
+			repo.expect(rpcCall{
+				method: "SearchFiles",
+				in: &api.SearchFilesRequest{
+					Glob:     "bin/gn",
+					Prefix:   "a",
+					PageSize: 1000,
+				},
+				out: &api.SearchFilesResponse{
+					Matches:       []*api.SearchFilesResponse_Match{match("0", "bin/gn")},
+					NextPageToken: "cursor",
+				},
+			})
+			repo.expect(rpcCall{
+				method: "SearchFiles",
+				in: &api.SearchFilesRequest{
+					Glob:      "bin/gn",
+					Prefix:    "a",
+					PageSize:  1000,
+					PageToken: "cursor",
+				},
+				out: &api.SearchFilesResponse{
+					Matches: []*api.SearchFilesResponse_Match{match("1", "bin/gn")},
+				},
+			})
+
+			out, err := client.SearchFiles(ctx, "bin/gn", "a/", 0)
+			So(err, ShouldBeNil)
+			So(out, ShouldResemble, []FileMatch{
+				fileMatch("0", "bin/gn"),
+				fileMatch("1", "bin/gn"),
+			})
+		})
+
+		Convey("Respects the limit", func() {
+			repo.expect(rpcCall{
+				method: "SearchFiles",
+				in: &api.SearchFilesRequest{
+					Glob:     "bin/*",
+					PageSize: 1,
+				},
+				out: &api.SearchFilesResponse{
+					Matches:       []*api.SearchFilesResponse_Match{match("0", "bin/gn")},
+					NextPageToken: "cursor",
+				},
+			})
+
+			out, err := client.SearchFiles(ctx, "bin/*", "", 1)
+			So(err, ShouldBeNil)
+			So(out, ShouldResemble, []FileMatch{fileMatch("0", "bin/gn")})
+		})
+
+		Convey("Bad glob", func() {
+			_, err := client.SearchFiles(ctx, "*/gn*", "", 0)
+			So(err, ShouldErrLike, "bad file glob")
+		})
+
+		Convey("Error response", func() {
+			repo.expect(rpcCall{
+				method: "SearchFiles",
+				in: &api.SearchFilesRequest{
+					Glob:     "bin/gn",
+					PageSize: 1000,
+				},
+				err: status.Errorf(codes.InvalidArgument, "blah error"),
+			})
+
+			_, err := client.SearchFiles(ctx, "bin/gn", "", 0)
+			So(err, ShouldErrLike, "blah error")
+		})
+	})
+}
+
 func TestListInstances(t *testing.T) {
 	t.Parallel()
 
@@ -1057,6 +1264,194 @@ func TestFetchInstance(t *testing.T) {
 	})
 }
 
+func TestFetchChunkedInstance(t *testing.T) {
+	t.Parallel()
+
+	randomBody := func(seed int64, size int) string {
+		buf := make([]byte, size)
+		rand.New(rand.NewSource(seed)).Read(buf)
+		return string(buf)
+	}
+	unchanged := randomBody(1, 2*1024*1024)
+	original := randomBody(2, 2*1024*1024)
+	edited := original[:1024*1024] + "edited" + original[1024*1024:]
+
+	Convey("With mocks", t, func(c C) {
+		ctx := makeTestContext()
+		client, _, repo, storage := mockedCipdClient(c)
+		setupInstanceCache(client, c)
+
+		body1, pin1 := buildChunkedTestInstance("pkg", map[string]string{
+			"unchanged": unchanged,
+			"changed":   original,
+		})
+		body2, pin2 := buildChunkedTestInstance("pkg", map[string]string{
+			"unchanged": unchanged,
+			"changed":   edited,
+		})
+		setupRemoteInstance(body1, pin1, repo, storage)
+		setupRemoteInstance(body2, pin2, repo, storage)
+
+		deployed := func(subdir, name string) string {
+			body, err := ioutil.ReadFile(filepath.Join(client.Root, subdir, name))
+			So(err, ShouldBeNil)
+			return string(body)
+		}
+
+		Convey("Fetches only missing chunks", func() {
+			// Nothing is in the cache yet, the package is fetched in full.
+			So(client.FetchAndDeployInstance(ctx, "1", pin1, 0), ShouldBeNil)
+			So(storage.downloads(), ShouldEqual, 1)
+			So(storage.rangeDownloads(), ShouldEqual, 1) // the tail with the index
+
+			// Most of chunks of the new version are in the cache now.
+			So(client.FetchAndDeployInstance(ctx, "2", pin2, 0), ShouldBeNil)
+			So(storage.downloads(), ShouldEqual, 1)
+			So(storage.rangeDownloads(), ShouldBeGreaterThan, 1)
+
+			So(deployed("2", "unchanged") == unchanged, ShouldBeTrue)
+			So(deployed("2", "changed") == edited, ShouldBeTrue)
+		})
+
+		Convey("Falls back to full fetch if the assembled file is broken", func() {
+			So(client.FetchAndDeployInstance(ctx, "1", pin1, 0), ShouldBeNil)
+			So(storage.downloads(), ShouldEqual, 1)
+
+			// Corrupt the zip header of the first file in the remote file.
+			corrupted := []byte(body2)
+			for i := 0; i < 30; i++ {
+				corrupted[i] = 0
+			}
+			storage.putStored(fmt.Sprintf("https://example.com/fake/%s/%s", pin2.PackageName, pin2.InstanceID), string(corrupted))
+
+			So(client.FetchAndDeployInstance(ctx, "2", pin2, 0), ShouldNotBeNil)
+			So(storage.downloads(), ShouldEqual, 2)
+		})
+	})
+}
+
+func TestPlanChunkedFetch(t *testing.T) {
+	t.Parallel()
+
+	Convey("Works", t, func() {
+		idx := &pkg.ChunkIndex{
+			Chunks: []pkg.Chunk{
+				{Offset: 10, Size: 100000, Hash: "a"},
+				{Offset: 100010, Size: 100000, Hash: "b"},
+				{Offset: 200020, Size: 100, Hash: "c"},
+				{Offset: 200130, Size: 100, Hash: "d"},
+				{Offset: 200230, Size: 100000, Hash: "e"},
+			},
+		}
+		cached := func(hashes ...string) func(string) bool {
+			return func(h string) bool {
+				for _, c := range hashes {
+					if c == h {
+						return true
+					}
+				}
+				return false
+			}
+		}
+
+		Convey("Nothing is cached", func() {
+			plan, ranges, remote := planChunkedFetch(idx, 300300, cached())
+			So(plan, ShouldResemble, []fetchSegment{{offset: 0, length: 300300}})
+			So(ranges, ShouldEqual, 1)
+			So(remote, ShouldEqual, 300300)
+		})
+
+		Convey("Everything is cached", func() {
+			plan, ranges, remote := planChunkedFetch(idx, 300300, cached("a", "b", "c", "d", "e"))
+			So(plan, ShouldResemble, []fetchSegment{
+				{offset: 0, length: 10},
+				{offset: 10, length: 100000, hash: "a"},
+				{offset: 100010, length: 100000, hash: "b"},
+				{offset: 200010, length: 10 + 100 + 10 + 100}, // small chunks are merged
+				{offset: 200230, length: 100000, hash: "e"},
+				{offset: 300230, length: 70},
+			})
+			So(ranges, ShouldEqual, 3)
+			So(remote, ShouldEqual, 10+220+70)
+		})
+
+		Convey("Some are cached", func() {
+			plan, ranges, remote := planChunkedFetch(idx, 300300, cached("b", "d"))
+			So(plan, ShouldResemble, []fetchSegment{
+				{offset: 0, length: 100010},
+				{offset: 100010, length: 100000, hash: "b"},
+				{offset: 200010, length: 300300 - 200010},
+			})
+			So(ranges, ShouldEqual, 2)
+			So(remote, ShouldEqual, 300300-100000)
+		})
+	})
+}
+
+func TestFetchSignedInstance(t *testing.T) {
+	t.Parallel()
+
+	Convey("With mocks", t, func(c C) {
+		ctx := makeTestContext()
+
+		trusted := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{1}, ed25519.SeedSize))
+		untrusted := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{2}, ed25519.SeedSize))
+
+		opts, _, repo, storage := mockedClientOpts(c)
+		opts.TrustedSigners = []string{signing.EncodePublicKey(trusted.Public().(ed25519.PublicKey))}
+		client, err := NewClient(opts)
+		So(err, ShouldBeNil)
+
+		body, pin := buildTestInstance("pkg", map[string]string{"test_name": "body"})
+
+		expectSignatures := func(keys ...ed25519.PrivateKey) {
+			resp := &api.ListMetadataResponse{}
+			for _, key := range keys {
+				sig, err := signing.Sign(key, pin)
+				So(err, ShouldBeNil)
+				resp.Metadata = append(resp.Metadata, &api.InstanceMetadata{
+					Key:   signing.MetadataKey,
+					Value: sig.Serialize(),
+				})
+			}
+			repo.expect(rpcCall{
+				method: "ListMetadata",
+				in: &api.ListMetadataRequest{
+					Package:  pin.PackageName,
+					Instance: common.InstanceIDToObjectRef(pin.InstanceID),
+					Keys:     []string{signing.MetadataKey},
+				},
+				out: resp,
+			})
+		}
+
+		Convey("Signed by a trusted key", func() {
+			expectSignatures(untrusted, trusted)
+			setupRemoteInstance(body, pin, repo, storage)
+			So(client.FetchAndDeployInstance(ctx, "", pin, 0), ShouldBeNil)
+		})
+
+		Convey("Signed by an untrusted key", func() {
+			expectSignatures(untrusted)
+			So(client.FetchAndDeployInstance(ctx, "", pin, 0), ShouldErrLike,
+				"is not signed by any trusted signer")
+			So(storage.downloads(), ShouldEqual, 0)
+		})
+
+		Convey("Not signed", func() {
+			expectSignatures()
+			So(client.FetchAndDeployInstance(ctx, "", pin, 0), ShouldErrLike, "is not signed")
+			So(storage.downloads(), ShouldEqual, 0)
+		})
+
+		Convey("Bad trusted signer", func() {
+			opts.TrustedSigners = []string{"zzz"}
+			_, err := NewClient(opts)
+			So(err, ShouldErrLike, "bad trusted signer")
+		})
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Instance installation.
 
@@ -1066,6 +1461,116 @@ func TestEnsurePackage(t *testing.T) {
 	// TODO
 }
 
+func TestEnsurePackagesRollback(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping on Windows: instances can't be restored without symlinks")
+	}
+
+	Convey("With mocks", t, func(c C) {
+		ctx := makeTestContext()
+		client, _, repo, storage := mockedCipdClient(c)
+
+		a1Body, a1 := buildTestInstance("pkg/a", map[string]string{"a": "a1"})
+		b1Body, b1 := buildTestInstance("pkg/b", map[string]string{"b": "b1"})
+		a2Body, a2 := buildTestInstance("pkg/a", map[string]string{"a": "a2"})
+		_, b2 := buildTestInstance("pkg/b", map[string]string{"b": "b2"})
+
+		read := func(name string) string {
+			body, err := ioutil.ReadFile(filepath.Join(client.Root, name))
+			So(err, ShouldBeNil)
+			return string(body)
+		}
+
+		ensure := func(pins ...common.Pin) error {
+			_, err := client.EnsurePackages(ctx, common.PinSliceBySubdir{"": pins}, NotParanoid, 1, false)
+			return err
+		}
+
+		setupRemoteInstance(a1Body, a1, repo, storage)
+		setupRemoteInstance(b1Body, b1, repo, storage)
+		So(ensure(a1, b1), ShouldBeNil)
+
+		Convey("Failed update is rolled back", func() {
+			setupRemoteInstance(a2Body, a2, repo, storage)
+			repo.expect(rpcCall{
+				method: "GetInstanceURL",
+				in: &api.GetInstanceURLRequest{
+					Package:  b2.PackageName,
+					Instance: common.InstanceIDToObjectRef(b2.InstanceID),
+				},
+				err: status.Errorf(codes.NotFound, "no such instance"),
+			})
+			So(ensure(a2, b2), ShouldEqual, ErrEnsurePackagesFailed)
+
+			// a1 was restored from the guts without fetching it again.
+			So(read("a"), ShouldEqual, "a1")
+			So(read("b"), ShouldEqual, "b1")
+			So(storage.downloads(), ShouldEqual, 3)
+
+			// The failed attempt is not a generation.
+			_, err := client.RollbackPackages(ctx, 1)
+			So(err, ShouldErrLike, "no previous generation")
+		})
+
+		Convey("Failed switch is rolled back without refetching", func() {
+			// c1 is installed in copy mode, its files are overwritten by c2.
+			c1Body, c1 := buildTestInstanceWithMode("pkg/c", map[string]string{"c": "c1"}, pkg.InstallModeCopy)
+			c2Body, c2 := buildTestInstanceWithMode("pkg/c", map[string]string{"c": "c2"}, pkg.InstallModeCopy)
+			setupRemoteInstance(c1Body, c1, repo, storage)
+			So(ensure(a1, b1, c1), ShouldBeNil)
+
+			// b2 can be fetched, but fails to install after c2 has been installed.
+			b2Body, b2 := buildTestInstance("pkg/b", map[string]string{"b": "b2"})
+			client.deployer = failingDeployer{client.deployer, b2}
+
+			setupRemoteInstance(c2Body, c2, repo, storage)
+			setupRemoteInstance(b2Body, b2, repo, storage)
+			So(ensure(c2, b2), ShouldEqual, ErrEnsurePackagesFailed)
+
+			// The removed package and the copy mode package are restored from the
+			// guts.
+			So(read("a"), ShouldEqual, "a1")
+			So(read("b"), ShouldEqual, "b1")
+			So(read("c"), ShouldEqual, "c1")
+			So(storage.downloads(), ShouldEqual, 5)
+
+			found, err := client.deployer.FindDeployed(ctx)
+			So(err, ShouldBeNil)
+			So(found, ShouldResemble, common.PinSliceBySubdir{"": {a1, b1, c1}})
+		})
+
+		Convey("RollbackPackages works", func() {
+			setupRemoteInstance(a2Body, a2, repo, storage)
+			So(ensure(a2, b1), ShouldBeNil)
+			So(read("a"), ShouldEqual, "a2")
+
+			_, err := client.RollbackPackages(ctx, 1)
+			So(err, ShouldBeNil)
+			So(read("a"), ShouldEqual, "a1")
+
+			// Rolling back again undoes the rollback.
+			_, err = client.RollbackPackages(ctx, 1)
+			So(err, ShouldBeNil)
+			So(read("a"), ShouldEqual, "a2")
+
+			// All without fetching anything.
+			So(storage.downloads(), ShouldEqual, 3)
+		})
+
+		Convey("RollbackPackages refuses to use another source", func() {
+			setupRemoteInstance(a2Body, a2, repo, storage)
+			So(ensure(a2, b1), ShouldBeNil)
+
+			client.ServiceURL = "https://other.example.com"
+			_, err := client.RollbackPackages(ctx, 1)
+			So(err, ShouldErrLike, "generation #1 was installed from")
+			So(read("a"), ShouldEqual, "a2")
+		})
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Client self-update.
 
@@ -1346,6 +1851,47 @@ func fakeIID(letter string) string {
 }
 
 func buildTestInstance(pkg string, blobs map[string]string) ([]byte, common.Pin) {
+	return buildTestInstanceImpl(pkg, blobs, false)
+}
+
+func buildChunkedTestInstance(pkg string, blobs map[string]string) ([]byte, common.Pin) {
+	return buildTestInstanceImpl(pkg, blobs, true)
+}
+
+// failingDeployer fails to switch to the given instance.
+type failingDeployer struct {
+	deployer.Deployer
+
+	fail common.Pin
+}
+
+func (d failingDeployer) RestoreInstance(ctx context.Context, subdir string, pin common.Pin) (bool, error) {
+	if pin == d.fail {
+		return false, fmt.Errorf("failing on purpose")
+	}
+	return d.Deployer.RestoreInstance(ctx, subdir, pin)
+}
+
+func buildTestInstanceWithMode(pkgName string, blobs map[string]string, mode pkg.InstallMode) ([]byte, common.Pin) {
+	files := make([]fs.File, 0, len(blobs))
+	for k, v := range blobs {
+		files = append(files, fs.NewTestFile(k, v, fs.TestFileOpts{}))
+	}
+	out := bytes.Buffer{}
+	pin, err := builder.BuildInstance(context.Background(), builder.Options{
+		Input:            files,
+		Output:           &out,
+		PackageName:      pkgName,
+		InstallMode:      mode,
+		CompressionLevel: 5,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return out.Bytes(), pin
+}
+
+func buildTestInstanceImpl(pkg string, blobs map[string]string, chunked bool) ([]byte, common.Pin) {
 	keys := make([]string, 0, len(blobs))
 	for k := range blobs {
 		keys = append(keys, k)
@@ -1363,6 +1909,7 @@ func buildTestInstance(pkg string, blobs map[string]string) ([]byte, common.Pin)
 		Output:           &out,
 		PackageName:      pkg,
 		CompressionLevel: 5,
+		Chunked:          chunked,
 	})
 	if err != nil {
 		panic(err)
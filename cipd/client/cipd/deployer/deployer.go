@@ -48,11 +48,15 @@ import (
 //   <arbitrary index>/
 //     description.json
 //     _current -> symlink to fea3ab83440e9dfb813785e16d4101f331ed44f4
+//     _previous -> symlink to 0a5e1ee3d8dc4c9e5d9f2a1ab7ed1e0e5a5c8c10
 //     fea3ab83440e9dfb813785e16d4101f331ed44f4/
 //       bin/
 //         tool
 //         ...
 //       ...
+//     0a5e1ee3d8dc4c9e5d9f2a1ab7ed1e0e5a5c8c10/
+//       ...
+// <base>/.cipd/generations.json
 // bin/
 //    tool -> symlink to ../.cipd/pkgs/<package name digest>/_current/bin/tool
 //    ...
@@ -64,10 +68,16 @@ import (
 // Some efforts are made to make sure that during the deployment a window of
 // inconsistency in the file system is as small as possible.
 //
+// The instance deployed before the current one is kept in the guts (and
+// referenced by _previous), so that it can be restored without refetching it.
+// generations.json records the current and the previous complete sets of
+// packages deployed into the site root (see CommitGeneration).
+//
 // For "copy" install method everything is much simpler: files are directly
 // copied to the site root directory and .cipd/pkgs/* contains only metadata,
 // such as description and manifest files with a list of extracted files (to
-// know what to uninstall).
+// know what to uninstall). When such instance is replaced, its files are moved
+// from the site root back to the guts, so it can be restored too.
 
 // DeployedPackage represents a state of the deployed (or partially deployed)
 // package, as returned by CheckDeployed.
@@ -162,6 +172,13 @@ type Deployer interface {
 	// RemoveDeployed deletes a package from a subdir given its name.
 	RemoveDeployed(ctx context.Context, subdir, packageName string) error
 
+	// DetachDeployed removes a package from the site root, but keeps all its
+	// files in the guts, so it can be brought back by RestoreInstance.
+	//
+	// Files of packages installed in "copy" mode are moved back into the guts.
+	// Use RemoveDeployed to get rid of the kept files for good.
+	DetachDeployed(ctx context.Context, subdir, packageName string) error
+
 	// RepairDeployed attempts to restore broken deployed instance.
 	//
 	// Use CheckDeployed first to figure out what parts of the package need
@@ -172,6 +189,36 @@ type Deployer interface {
 	// the one specified in the pin, returns an error.
 	RepairDeployed(ctx context.Context, subdir string, pin common.Pin, maxThreads int, params RepairParams) error
 
+	// StageInstance unpacks an instance of a package into <base>/.cipd/pkgs/*
+	// without touching the site root.
+	//
+	// The package is switched to the staged instance by RestoreInstance.
+	StageInstance(ctx context.Context, subdir string, inst pkg.Instance, maxThreads int) error
+
+	// IsStaged returns true if all files of the given instance are present in
+	// the guts, i.e. RestoreInstance can switch to it without fetching it.
+	IsStaged(ctx context.Context, subdir string, pin common.Pin) (bool, error)
+
+	// RestoreInstance switches a package to an instance whose files are all
+	// present in the guts.
+	//
+	// Such instances are left by StageInstance and DetachDeployed. DeployInstance
+	// also keeps the instance it replaces (one per package). Returns false if
+	// 'pin' is not available locally or is already the current instance. In that
+	// case it should be deployed via DeployInstance.
+	RestoreInstance(ctx context.Context, subdir string, pin common.Pin) (bool, error)
+
+	// Generations returns the current and the previous generations of the site
+	// root, as recorded by CommitGeneration.
+	Generations(ctx context.Context) (*Generations, error)
+
+	// CommitGeneration records the given set of packages, fetched from 'src',
+	// as the current generation of the site root.
+	//
+	// The replaced generation becomes the previous one. Does nothing if the set
+	// and the source match the current generation.
+	CommitGeneration(ctx context.Context, pins common.PinSliceBySubdir, src Source) error
+
 	// TempFile returns os.File located in <base>/.cipd/tmp/*.
 	//
 	// The file is open for reading and writing.
@@ -222,6 +269,24 @@ func (d errDeployer) RepairDeployed(context.Context, string, common.Pin, int, Re
 	return d.err
 }
 
+func (d errDeployer) DetachDeployed(context.Context, string, string) error { return d.err }
+
+func (d errDeployer) StageInstance(context.Context, string, pkg.Instance, int) error { return d.err }
+
+func (d errDeployer) IsStaged(context.Context, string, common.Pin) (bool, error) {
+	return false, d.err
+}
+
+func (d errDeployer) RestoreInstance(context.Context, string, common.Pin) (bool, error) {
+	return false, d.err
+}
+
+func (d errDeployer) Generations(context.Context) (*Generations, error) { return nil, d.err }
+
+func (d errDeployer) CommitGeneration(context.Context, common.PinSliceBySubdir, Source) error {
+	return d.err
+}
+
 func (d errDeployer) TempFile(context.Context, string) (*os.File, error) { return nil, d.err }
 
 func (d errDeployer) CleanupTrash(context.Context) {}
@@ -244,6 +309,18 @@ const (
 	// version. Used on Windows.
 	currentTxt = "_current.txt"
 
+	// previousSymlink is a name of a symlink that points to the version deployed
+	// before the current one, if it is still in the guts. Used on Linux and Mac.
+	previousSymlink = "_previous"
+
+	// previousTxt is a name of a text file with instance ID of the version
+	// deployed before the current one. Used on Windows.
+	previousTxt = "_previous.txt"
+
+	// generationsName is a name of a file in .cipd/ with a record of the
+	// current and previous generations of the site root.
+	generationsName = "generations.json"
+
 	// fsLockName is name of a per-package lock file in .cipd/pkgs/<index>/.
 	fsLockName = ".lock"
 
@@ -293,19 +370,52 @@ type deployerImpl struct {
 }
 
 func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst pkg.Instance, maxThreads int) (pin common.Pin, err error) {
-	if err = common.ValidateSubdir(subdir); err != nil {
+	pin = inst.Pin()
+	logging.Infof(ctx, "Deploying %s into %s(/%s)", pin, d.fs.Root(), subdir)
+
+	pkgPath, unlock, err := d.extractInstance(ctx, subdir, inst, maxThreads)
+	if err != nil {
 		return common.Pin{}, err
 	}
+	defer unlock()
 
-	pin = inst.Pin()
-	logging.Infof(ctx, "Deploying %s into %s(/%s)", pin, d.fs.Root(), subdir)
+	// We want to cleanup 'destPath' if something is not right with it.
+	if err := d.activateInstance(ctx, subdir, pkgPath, pin); err != nil {
+		destPath := filepath.Join(pkgPath, pin.InstanceID)
+		logging.Warningf(ctx, "Deploy aborted, cleaning up %s", destPath)
+		d.fs.EnsureDirectoryGone(ctx, destPath)
+		return common.Pin{}, err
+	}
+	return d.verifyDeployed(ctx, subdir, pin)
+}
+
+func (d *deployerImpl) StageInstance(ctx context.Context, subdir string, inst pkg.Instance, maxThreads int) error {
+	logging.Infof(ctx, "Staging %s in %s(/%s)", inst.Pin(), d.fs.Root(), subdir)
+	_, unlock, err := d.extractInstance(ctx, subdir, inst, maxThreads)
+	if err != nil {
+		return err
+	}
+	unlock()
+	return nil
+}
+
+// extractInstance unpacks an instance into the guts of the package
+// (.cipd/pkgs/<index>/<instance id>).
+//
+// On success returns the package directory and keeps it locked. The caller is
+// responsible for calling 'unlock'.
+func (d *deployerImpl) extractInstance(ctx context.Context, subdir string, inst pkg.Instance, maxThreads int) (pkgPath string, unlock func(), err error) {
+	if err = common.ValidateSubdir(subdir); err != nil {
+		return "", nil, err
+	}
 
 	// Be paranoid (but not too much).
+	pin := inst.Pin()
 	if err = common.ValidatePin(pin, common.AnyHash); err != nil {
-		return common.Pin{}, err
+		return "", nil, err
 	}
 	if _, err = d.fs.EnsureDirectory(ctx, filepath.Join(d.fs.Root(), subdir)); err != nil {
-		return common.Pin{}, err
+		return "", nil, err
 	}
 
 	// Extract new version to the .cipd/pkgs/* guts. For "symlink" install mode it
@@ -314,20 +424,19 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst p
 
 	// Allocate '.cipd/pkgs/<index>' directory for the (subdir, PackageName) pair
 	// or grab an existing one, if any. This operation is atomic.
-	pkgPath, err := d.packagePath(ctx, subdir, pin.PackageName, true)
+	pkgPath, err = d.packagePath(ctx, subdir, pin.PackageName, true)
 	if err != nil {
-		return common.Pin{}, err
+		return "", nil, err
 	}
 
 	// Concurrently messing with a single pkgPath directory doesn't work well,
 	// use exclusive file system lock. In practice it means we aren't allowing
 	// installing instances of the *same* package concurrently. Installing
 	// different packages at the same time is still allowed.
-	unlock, err := d.lockPkg(ctx, pkgPath)
+	unlock, err = d.lockPkg(ctx, pkgPath)
 	if err != nil {
-		return common.Pin{}, fmt.Errorf("failed to acquire FS lock - %s", err)
+		return "", nil, fmt.Errorf("failed to acquire FS lock - %s", err)
 	}
-	defer unlock()
 
 	// Skip extracting .cipd/* guts if they mistakenly ended up inside the
 	// package. Extracting them clobbers REAL guts.
@@ -342,27 +451,33 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst p
 
 	// Unzip the package into the final destination inside .cipd/* guts.
 	destPath := filepath.Join(pkgPath, pin.InstanceID)
-	if _, err := reader.ExtractFilesTxn(ctx, files, fs.NewDestination(destPath, d.fs), maxThreads, pkg.WithManifest); err != nil {
-		return common.Pin{}, err
+	if _, err = reader.ExtractFilesTxn(ctx, files, fs.NewDestination(destPath, d.fs), maxThreads, pkg.WithManifest); err != nil {
+		unlock()
+		return "", nil, err
 	}
+	return pkgPath, unlock, nil
+}
 
-	// We want to cleanup 'destPath' if something is not right with it.
-	deleteFailedInstall := true
-	defer func() {
-		if deleteFailedInstall {
-			logging.Warningf(ctx, "Deploy aborted, cleaning up %s", destPath)
-			d.fs.EnsureDirectoryGone(ctx, destPath)
-		}
-	}()
+// activateInstance makes an instance already extracted into the guts of
+// the package (.cipd/pkgs/<index>/<instance id>) the current one.
+//
+// It links (or moves) its files into the site root, removes files of the
+// previously current instance that are no longer needed, and takes care of
+// instances kept around for RestoreInstance.
+//
+// Must be called under the package lock. Returns an error only if the instance
+// wasn't marked as current. All cleanup after that is best effort.
+func (d *deployerImpl) activateInstance(ctx context.Context, subdir, pkgPath string, pin common.Pin) error {
+	destPath := filepath.Join(pkgPath, pin.InstanceID)
 
 	// Read and sanity check the manifest.
 	newManifest, err := d.readManifest(ctx, destPath)
 	if err != nil {
-		return common.Pin{}, err
+		return err
 	}
 	installMode, err := pkg.PickInstallMode(newManifest.InstallMode)
 	if err != nil {
-		return common.Pin{}, err
+		return err
 	}
 
 	// Remember currently deployed version (to remove it later). Do not freak out
@@ -372,11 +487,25 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst p
 	if err == nil && prevInstanceID != "" {
 		prevManifest, err = d.readManifest(ctx, filepath.Join(pkgPath, prevInstanceID))
 	}
+	prevMode := pkg.InstallModeSymlink
+	if err == nil && prevInstanceID != "" {
+		prevMode, err = pkg.PickInstallMode(prevManifest.InstallMode)
+	}
 	if err != nil {
 		logging.Warningf(ctx, "Previous version of the package is broken: %s", err)
 		prevManifest = pkg.Manifest{} // to make sure prevManifest.Files == nil.
 	}
 
+	// Files of an instance installed in 'symlink' mode stay in the guts. Files of
+	// an instance installed in 'copy' mode are moved back there before the new
+	// instance overwrites them.
+	replacing := err == nil && prevInstanceID != "" && prevInstanceID != pin.InstanceID
+	prevInGuts := replacing && prevMode == pkg.InstallModeSymlink
+	var prevMoved []pkg.FileInfo
+	if replacing && prevMode == pkg.InstallModeCopy {
+		prevMoved, prevInGuts = d.moveToGuts(ctx, subdir, prevManifest.Files, filepath.Join(pkgPath, prevInstanceID))
+	}
+
 	// Install all new files to the site root, collect a set of paths (files and
 	// directories) that should exist now, to make sure removeFromSiteRoot doesn't
 	// delete them later. This is important when updating files to directories:
@@ -384,30 +513,24 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst p
 	// a file.
 	logging.Infof(ctx, "Moving files to their final destination...")
 	keep, err := d.addToSiteRoot(ctx, subdir, newManifest.Files, installMode, pkgPath, destPath)
-	if err != nil {
-		return common.Pin{}, err
+	if err == nil {
+		// Mark installed instance as a current one. After this call the package is
+		// considered installed and the function must not fail. All cleanup below
+		// is best effort.
+		err = d.setCurrentInstanceID(ctx, pkgPath, pin.InstanceID)
 	}
-
-	// Mark installed instance as a current one. After this call the package is
-	// considered installed and the function must not fail. All cleanup below is
-	// best effort.
-	if err = d.setCurrentInstanceID(ctx, pkgPath, pin.InstanceID); err != nil {
-		return common.Pin{}, err
+	if err != nil {
+		if len(prevMoved) != 0 {
+			logging.Warningf(ctx, "Moving files of %s back to the site root...", prevInstanceID)
+			d.addToSiteRoot(ctx, subdir, prevMoved, pkg.InstallModeCopy, pkgPath, filepath.Join(pkgPath, prevInstanceID))
+		}
+		return err
 	}
-	deleteFailedInstall = false
 
 	// Wait for async cleanup to finish.
 	logging.Infof(ctx, "Cleaning up...")
 	wg := sync.WaitGroup{}
-	origPin := pin // to prevent `return common.Pin{}, err` from clobbering it
-	defer func() {
-		wg.Wait()
-		if err == nil {
-			logging.Infof(ctx, "Deployed %s", origPin)
-		} else {
-			logging.Errorf(ctx, "Failed to deploy %s: %s", origPin, err)
-		}
-	}()
+	defer wg.Wait()
 
 	// When using 'copy' install mode all files (except .cipdpkg/*) are moved away
 	// from 'destPath', leaving only an empty husk with directory structure.
@@ -420,13 +543,42 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst p
 		}()
 	}
 
-	// Remove old instance directory completely.
-	if prevInstanceID != "" && prevInstanceID != pin.InstanceID {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			d.fs.EnsureDirectoryGone(ctx, filepath.Join(pkgPath, prevInstanceID))
-		}()
+	// Keep the replaced instance in the guts if all its files are there, so
+	// RestoreInstance can switch back to it without refetching. Only one such
+	// instance is kept. All other instances (e.g. ones staged, but never
+	// activated) are removed.
+	kept := ""
+	switch {
+	case prevInGuts:
+		if err := d.setPreviousInstanceID(ctx, pkgPath, prevInstanceID); err != nil {
+			logging.Warningf(ctx, "Failed to keep the previous instance: %s", err)
+			d.clearPreviousInstanceID(ctx, pkgPath)
+		} else {
+			kept = prevInstanceID
+		}
+	case replacing:
+		d.clearPreviousInstanceID(ctx, pkgPath)
+	default:
+		// Nothing has been replaced. Keep the instance kept by the previous
+		// deployment, unless it has just become the current one.
+		switch old, err := d.getPreviousInstanceID(pkgPath); {
+		case err != nil:
+			logging.Warningf(ctx, "Ignoring broken pointer to the previous instance: %s", err)
+			d.clearPreviousInstanceID(ctx, pkgPath)
+		case old == pin.InstanceID:
+			d.clearPreviousInstanceID(ctx, pkgPath)
+		default:
+			kept = old
+		}
+	}
+	for _, id := range d.instancesInGuts(ctx, pkgPath) {
+		if id != pin.InstanceID && id != kept {
+			wg.Add(1)
+			go func(id string) {
+				defer wg.Done()
+				d.fs.EnsureDirectoryGone(ctx, filepath.Join(pkgPath, id))
+			}(id)
+		}
 	}
 
 	// Remove no longer present files from the site root directory.
@@ -438,18 +590,69 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst p
 		}()
 	}
 
-	// Verify it's all right.
+	return nil
+}
+
+// moveToGuts moves files of an instance installed in 'copy' mode from the site
+// root back to its directory in the guts.
+//
+// Returns the moved files and true if all files were moved. Best effort, errors
+// are logged.
+func (d *deployerImpl) moveToGuts(ctx context.Context, subdir string, files []pkg.FileInfo, instDir string) (moved []pkg.FileInfo, complete bool) {
+	complete = true
+	for _, f := range files {
+		relPath := filepath.FromSlash(f.Name)
+		siteAbs, err := d.fs.RootRelToAbs(filepath.Join(subdir, relPath))
+		if err == nil {
+			err = d.fs.Replace(ctx, siteAbs, filepath.Join(instDir, relPath))
+		}
+		if err != nil {
+			if !os.IsNotExist(err) {
+				logging.Warningf(ctx, "Failed to move %q back to the guts: %s", f.Name, err)
+			}
+			complete = false
+		} else {
+			moved = append(moved, f)
+		}
+	}
+	return
+}
+
+// instancesInGuts lists instance directories in the package directory.
+//
+// Errors are logged.
+func (d *deployerImpl) instancesInGuts(ctx context.Context, pkgPath string) []string {
+	infos, err := ioutil.ReadDir(pkgPath)
+	if err != nil {
+		logging.Warningf(ctx, "Failed to list %s: %s", pkgPath, err)
+		return nil
+	}
+	var ids []string
+	for _, info := range infos {
+		if info.IsDir() && common.ValidateInstanceID(info.Name(), common.AnyHash) == nil {
+			ids = append(ids, info.Name())
+		}
+	}
+	return ids
+}
+
+// verifyDeployed checks the given pin is the currently deployed instance of
+// the package after activateInstance.
+func (d *deployerImpl) verifyDeployed(ctx context.Context, subdir string, pin common.Pin) (common.Pin, error) {
 	state, err := d.CheckDeployed(ctx, subdir, pin.PackageName, NotParanoid, pkg.WithoutManifest)
 	switch {
 	case err != nil:
-		return common.Pin{}, err
 	case !state.Deployed: // should not happen really...
-		return common.Pin{}, fmt.Errorf("the package is reported as not installed, see logs")
+		err = fmt.Errorf("the package is reported as not installed, see logs")
 	case state.Pin.InstanceID != pin.InstanceID:
+		logging.Errorf(ctx, "Failed to deploy %s: other instance (%s) was deployed concurrently", pin, state.Pin.InstanceID)
 		return state.Pin, fmt.Errorf("other instance (%s) was deployed concurrently", state.Pin.InstanceID)
 	default:
+		logging.Infof(ctx, "Deployed %s", pin)
 		return pin, nil
 	}
+	logging.Errorf(ctx, "Failed to deploy %s: %s", pin, err)
+	return common.Pin{}, err
 }
 
 func (d *deployerImpl) CheckDeployed(ctx context.Context, subdir, pkgname string, par ParanoidMode, m pkg.ManifestMode) (out *DeployedPackage, err error) {
@@ -572,13 +775,54 @@ func (d *deployerImpl) RemoveDeployed(ctx context.Context, subdir, packageName s
 	case deployed.Deployed:
 		d.removeFromSiteRoot(ctx, subdir, deployed.Manifest.Files, nil)
 	default:
-		// The package was partially installed in the guts, but not into the site
-		// root. We can just remove the guts thus forgetting about the package.
-		logging.Warningf(ctx, "Package %s is partially installed, removing it", packageName)
+		// The package was detached from the site root or partially installed in
+		// the guts. We can just remove the guts thus forgetting about the package.
+		if prev, _ := d.getPreviousInstanceID(deployed.packagePath); prev == "" {
+			logging.Warningf(ctx, "Package %s is partially installed, removing it", packageName)
+		}
 	}
 	return d.fs.EnsureDirectoryGone(ctx, deployed.packagePath)
 }
 
+func (d *deployerImpl) DetachDeployed(ctx context.Context, subdir, packageName string) error {
+	logging.Infof(ctx, "Detaching %s from %s(/%s)", packageName, d.fs.Root(), subdir)
+
+	deployed, err := d.CheckDeployed(ctx, subdir, packageName, NotParanoid, pkg.WithManifest)
+	switch {
+	case err != nil:
+		return err
+	case !deployed.Deployed:
+		// Nothing to detach. RemoveDeployed will take care of the guts, if any.
+		return nil
+	}
+
+	// See the comment about locking in DeployInstance.
+	unlock, err := d.lockPkg(ctx, deployed.packagePath)
+	if err != nil {
+		return fmt.Errorf("failed to acquire FS lock - %s", err)
+	}
+	defer unlock()
+
+	// The package has no current instance after this point. The detached one
+	// replaces an instance kept by the previous deployment, if any.
+	old, _ := d.getPreviousInstanceID(deployed.packagePath)
+	if err := d.setPreviousInstanceID(ctx, deployed.packagePath, deployed.Pin.InstanceID); err != nil {
+		return err
+	}
+	if err := d.clearInstancePointer(ctx, deployed.packagePath, currentSymlink, currentTxt); err != nil {
+		return err
+	}
+	if old != "" && old != deployed.Pin.InstanceID {
+		d.fs.EnsureDirectoryGone(ctx, filepath.Join(deployed.packagePath, old))
+	}
+
+	if deployed.InstallMode == pkg.InstallModeCopy {
+		d.moveToGuts(ctx, subdir, deployed.Manifest.Files, deployed.instancePath)
+	}
+	d.removeFromSiteRoot(ctx, subdir, deployed.Manifest.Files, nil)
+	return nil
+}
+
 func (d *deployerImpl) RepairDeployed(ctx context.Context, subdir string, pin common.Pin, maxThreads int, params RepairParams) error {
 	switch {
 	case len(params.ToRedeploy) != 0 && params.Instance == nil:
@@ -712,6 +956,78 @@ func (d *deployerImpl) RepairDeployed(ctx context.Context, subdir string, pin co
 	return nil
 }
 
+func (d *deployerImpl) IsStaged(ctx context.Context, subdir string, pin common.Pin) (bool, error) {
+	if err := common.ValidateSubdir(subdir); err != nil {
+		return false, err
+	}
+	if err := common.ValidatePin(pin, common.AnyHash); err != nil {
+		return false, err
+	}
+	pkgPath, err := d.packagePath(ctx, subdir, pin.PackageName, false)
+	if err != nil || pkgPath == "" {
+		return false, err
+	}
+	return d.isComplete(ctx, pkgPath, pin), nil
+}
+
+func (d *deployerImpl) RestoreInstance(ctx context.Context, subdir string, pin common.Pin) (bool, error) {
+	if err := common.ValidateSubdir(subdir); err != nil {
+		return false, err
+	}
+	if err := common.ValidatePin(pin, common.AnyHash); err != nil {
+		return false, err
+	}
+
+	pkgPath, err := d.packagePath(ctx, subdir, pin.PackageName, false)
+	if err != nil || pkgPath == "" {
+		return false, err
+	}
+
+	// See the comment about locking in DeployInstance.
+	unlock, err := d.lockPkg(ctx, pkgPath)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire FS lock - %s", err)
+	}
+	defer unlock()
+
+	if cur, err := d.getCurrentInstanceID(pkgPath); err == nil && cur == pin.InstanceID {
+		return false, nil
+	}
+	if !d.isComplete(ctx, pkgPath, pin) {
+		return false, nil
+	}
+
+	logging.Infof(ctx, "Restoring %s in %s(/%s)", pin, d.fs.Root(), subdir)
+	if err := d.activateInstance(ctx, subdir, pkgPath, pin); err != nil {
+		return false, err
+	}
+	_, err = d.verifyDeployed(ctx, subdir, pin)
+	return true, err
+}
+
+// isComplete returns true if all files of the instance are present in its
+// directory in the guts of the package.
+//
+// If some files are gone, it is simpler to deploy the instance from scratch.
+func (d *deployerImpl) isComplete(ctx context.Context, pkgPath string, pin common.Pin) bool {
+	instancePath := filepath.Join(pkgPath, pin.InstanceID)
+	if _, err := os.Stat(instancePath); err != nil {
+		return false
+	}
+	manifest, err := d.readManifest(ctx, instancePath)
+	if err != nil {
+		logging.Warningf(ctx, "Can't use %s kept locally: %s", pin, err)
+		return false
+	}
+	for _, f := range manifest.Files {
+		if !d.isPresentInGuts(ctx, instancePath, f) {
+			logging.Debugf(ctx, "Can't use %s kept locally: %q is missing", pin, f.Name)
+			return false
+		}
+	}
+	return true
+}
+
 func (d *deployerImpl) TempFile(ctx context.Context, prefix string) (*os.File, error) {
 	dir, err := d.fs.EnsureDirectory(ctx, filepath.Join(d.fs.Root(), fs.SiteServiceDir, "tmp"))
 	if err != nil {
@@ -1006,16 +1322,56 @@ func (d *deployerImpl) resolveValidPackageDirs(ctx context.Context, pkgsAbsDir s
 //
 // It returns ("", nil) if no package is installed there.
 func (d *deployerImpl) getCurrentInstanceID(packageDir string) (string, error) {
-	var current string
+	return d.readInstancePointer(packageDir, currentSymlink, currentTxt, "currently installed instance")
+}
+
+// setCurrentInstanceID changes a pointer to currently installed instance ID.
+//
+// It takes a path to a package directory (.cipd/pkgs/<name>) as input.
+func (d *deployerImpl) setCurrentInstanceID(ctx context.Context, packageDir, instanceID string) error {
+	return d.writeInstancePointer(ctx, packageDir, currentSymlink, currentTxt, instanceID)
+}
+
+// getPreviousInstanceID returns instance ID of an instance kept in the guts
+// after it was replaced by the current one, given a path to a package
+// directory (.cipd/pkgs/<name>).
+//
+// It returns ("", nil) if there's no such instance.
+func (d *deployerImpl) getPreviousInstanceID(packageDir string) (string, error) {
+	return d.readInstancePointer(packageDir, previousSymlink, previousTxt, "previously installed instance")
+}
+
+// setPreviousInstanceID changes a pointer to the previously installed instance.
+//
+// It takes a path to a package directory (.cipd/pkgs/<name>) as input.
+func (d *deployerImpl) setPreviousInstanceID(ctx context.Context, packageDir, instanceID string) error {
+	return d.writeInstancePointer(ctx, packageDir, previousSymlink, previousTxt, instanceID)
+}
+
+// clearPreviousInstanceID removes a pointer to the previously installed
+// instance. Best effort, errors are logged.
+func (d *deployerImpl) clearPreviousInstanceID(ctx context.Context, packageDir string) {
+	if err := d.clearInstancePointer(ctx, packageDir, previousSymlink, previousTxt); err != nil {
+		logging.Warningf(ctx, "Failed to remove the pointer to the previous instance: %s", err)
+	}
+}
+
+// readInstancePointer reads an instance ID stored in a symlink (on Posix) or
+// a text file (on Windows) inside a package directory.
+//
+// It returns ("", nil) if there's no such pointer. 'what' is used in error
+// messages.
+func (d *deployerImpl) readInstancePointer(packageDir, symlinkName, txtName, what string) (string, error) {
+	var id string
 	var err error
 	if runtime.GOOS == "windows" {
 		var bytes []byte
-		bytes, err = ioutil.ReadFile(filepath.Join(packageDir, currentTxt))
+		bytes, err = ioutil.ReadFile(filepath.Join(packageDir, txtName))
 		if err == nil {
-			current = strings.TrimSpace(string(bytes))
+			id = strings.TrimSpace(string(bytes))
 		}
 	} else {
-		current, err = os.Readlink(filepath.Join(packageDir, currentSymlink))
+		id, err = os.Readlink(filepath.Join(packageDir, symlinkName))
 	}
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -1023,26 +1379,33 @@ func (d *deployerImpl) getCurrentInstanceID(packageDir string) (string, error) {
 		}
 		return "", err
 	}
-	if err = common.ValidateInstanceID(current, common.AnyHash); err != nil {
+	if err = common.ValidateInstanceID(id, common.AnyHash); err != nil {
 		return "", fmt.Errorf(
-			"pointer to currently installed instance doesn't look like a valid instance id: %s", err)
+			"pointer to %s doesn't look like a valid instance id: %s", what, err)
 	}
-	return current, nil
+	return id, nil
 }
 
-// setCurrentInstanceID changes a pointer to currently installed instance ID.
-//
-// It takes a path to a package directory (.cipd/pkgs/<name>) as input.
-func (d *deployerImpl) setCurrentInstanceID(ctx context.Context, packageDir, instanceID string) error {
+// clearInstancePointer removes a pointer written by writeInstancePointer.
+func (d *deployerImpl) clearInstancePointer(ctx context.Context, packageDir, symlinkName, txtName string) error {
+	name := symlinkName
+	if runtime.GOOS == "windows" {
+		name = txtName
+	}
+	return d.fs.EnsureFileGone(ctx, filepath.Join(packageDir, name))
+}
+
+// writeInstancePointer is the counterpart of readInstancePointer.
+func (d *deployerImpl) writeInstancePointer(ctx context.Context, packageDir, symlinkName, txtName, instanceID string) error {
 	if err := common.ValidateInstanceID(instanceID, common.AnyHash); err != nil {
 		return err
 	}
 	if runtime.GOOS == "windows" {
 		return fs.EnsureFile(
-			ctx, d.fs, filepath.Join(packageDir, currentTxt),
+			ctx, d.fs, filepath.Join(packageDir, txtName),
 			strings.NewReader(instanceID))
 	}
-	return d.fs.EnsureSymlink(ctx, filepath.Join(packageDir, currentSymlink), instanceID)
+	return d.fs.EnsureSymlink(ctx, filepath.Join(packageDir, symlinkName), instanceID)
 }
 
 // readDescription reads the package description.json given a path to a package
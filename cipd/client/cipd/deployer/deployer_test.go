@@ -363,6 +363,16 @@ func TestDeployInstanceSymlinkMode(t *testing.T) {
 			So(err, ShouldBeNil)
 
 			So(scanDir(tempDir), ShouldResemble, []string{
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 1*",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 2",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/old only*",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/to-be-empty-dir/file",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink changed:old target",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink removed:target",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink unchanged:target",
 				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 1",
 				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 2*",
@@ -371,6 +381,7 @@ func TestDeployInstanceSymlinkMode(t *testing.T) {
 				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink changed:new target",
 				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink unchanged:target",
 				".cipd/pkgs/0/_current:111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+				".cipd/pkgs/0/_previous:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
 				".cipd/pkgs/0/description.json",
 				".cipd/tmp!",
 				"mode change 1:.cipd/pkgs/0/_current/mode change 1",
@@ -388,6 +399,16 @@ func TestDeployInstanceSymlinkMode(t *testing.T) {
 				So(err, ShouldBeNil)
 
 				So(scanDir(tempDir), ShouldResemble, []string{
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 1*",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 2",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/old only*",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/to-be-empty-dir/file",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink changed:old target",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink removed:target",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink unchanged:target",
 					".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 					".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 1",
 					".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 2*",
@@ -396,7 +417,18 @@ func TestDeployInstanceSymlinkMode(t *testing.T) {
 					".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink changed:new target",
 					".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink unchanged:target",
 					".cipd/pkgs/0/_current:111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+					".cipd/pkgs/0/_previous:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
 					".cipd/pkgs/0/description.json",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 1*",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 2",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/old only*",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/to-be-empty-dir/file",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink changed:old target",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink removed:target",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink unchanged:target",
 					".cipd/pkgs/1/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 					".cipd/pkgs/1/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 1",
 					".cipd/pkgs/1/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 2*",
@@ -405,6 +437,7 @@ func TestDeployInstanceSymlinkMode(t *testing.T) {
 					".cipd/pkgs/1/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink changed:new target",
 					".cipd/pkgs/1/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink unchanged:target",
 					".cipd/pkgs/1/_current:111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+					".cipd/pkgs/1/_previous:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
 					".cipd/pkgs/1/description.json",
 					".cipd/tmp!",
 					"mode change 1:.cipd/pkgs/0/_current/mode change 1",
@@ -671,8 +704,19 @@ func TestDeployInstanceCopyModePosix(t *testing.T) {
 			So(err, ShouldBeNil)
 
 			So(scanDir(tempDir), ShouldResemble, []string{
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 1*",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 2",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/old only*",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/to-be-empty-dir/file",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink changed:old target",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink removed:target",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink unchanged:target",
 				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 				".cipd/pkgs/0/_current:111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+				".cipd/pkgs/0/_previous:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
 				".cipd/pkgs/0/description.json",
 				".cipd/tmp!",
 				"mode change 1",
@@ -690,11 +734,33 @@ func TestDeployInstanceCopyModePosix(t *testing.T) {
 				So(err, ShouldBeNil)
 
 				So(scanDir(tempDir), ShouldResemble, []string{
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 1*",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 2",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/old only*",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/to-be-empty-dir/file",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink changed:old target",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink removed:target",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink unchanged:target",
 					".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 					".cipd/pkgs/0/_current:111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+					".cipd/pkgs/0/_previous:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
 					".cipd/pkgs/0/description.json",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 1*",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 2",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/old only*",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/to-be-empty-dir/file",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink changed:old target",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink removed:target",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/symlink unchanged:target",
 					".cipd/pkgs/1/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 					".cipd/pkgs/1/_current:111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+					".cipd/pkgs/1/_previous:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
 					".cipd/pkgs/1/description.json",
 					".cipd/tmp!",
 					"mode change 1",
@@ -943,8 +1009,16 @@ func TestDeployInstanceCopyModeWindows(t *testing.T) {
 			So(err, ShouldBeNil)
 
 			So(scanDir(tempDir), ShouldResemble, []string{
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 1",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 2",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/old only",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/to-be-empty-dir/file",
 				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 				".cipd/pkgs/0/_current.txt",
+				".cipd/pkgs/0/_previous.txt",
 				".cipd/pkgs/0/description.json",
 				".cipd/tmp!",
 				"mode change 1",
@@ -962,11 +1036,27 @@ func TestDeployInstanceCopyModeWindows(t *testing.T) {
 				So(err, ShouldBeNil)
 
 				So(scanDir(tempDir), ShouldResemble, []string{
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 1",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 2",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/old only",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/to-be-empty-dir/file",
 					".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 					".cipd/pkgs/0/_current.txt",
+					".cipd/pkgs/0/_previous.txt",
 					".cipd/pkgs/0/description.json",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 1",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/mode change 2",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/old only",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/to-be-empty-dir/file",
 					".cipd/pkgs/1/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 					".cipd/pkgs/1/_current.txt",
+					".cipd/pkgs/1/_previous.txt",
 					".cipd/pkgs/1/description.json",
 					".cipd/tmp!",
 					"mode change 1",
@@ -1092,11 +1182,16 @@ func TestDeployInstanceSwitchingModes(t *testing.T) {
 
 			So(err, ShouldBeNil)
 			So(scanDir(tempDir), ShouldResemble, []string{
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/symlink:executable",
 				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
 				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
 				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/symlink:executable",
 				".cipd/pkgs/0/_current:111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+				".cipd/pkgs/0/_previous:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
 				".cipd/pkgs/0/description.json",
 				".cipd/tmp!",
 				"some/executable:../.cipd/pkgs/0/_current/some/executable",
@@ -1116,17 +1211,27 @@ func TestDeployInstanceSwitchingModes(t *testing.T) {
 
 				So(err, ShouldBeNil)
 				So(scanDir(tempDir), ShouldResemble, []string{
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/symlink:executable",
 					".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 					".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
 					".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
 					".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/symlink:executable",
 					".cipd/pkgs/0/_current:111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+					".cipd/pkgs/0/_previous:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
 					".cipd/pkgs/0/description.json",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/symlink:executable",
 					".cipd/pkgs/1/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 					".cipd/pkgs/1/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
 					".cipd/pkgs/1/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
 					".cipd/pkgs/1/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/symlink:executable",
 					".cipd/pkgs/1/_current:111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+					".cipd/pkgs/1/_previous:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
 					".cipd/pkgs/1/description.json",
 					".cipd/tmp!",
 					"some/executable:../.cipd/pkgs/0/_current/some/executable",
@@ -1151,8 +1256,13 @@ func TestDeployInstanceSwitchingModes(t *testing.T) {
 
 			So(err, ShouldBeNil)
 			So(scanDir(tempDir), ShouldResemble, []string{
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/symlink:executable",
 				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 				".cipd/pkgs/0/_current:111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+				".cipd/pkgs/0/_previous:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
 				".cipd/pkgs/0/description.json",
 				".cipd/tmp!",
 				"some/executable*",
@@ -1172,11 +1282,21 @@ func TestDeployInstanceSwitchingModes(t *testing.T) {
 
 				So(err, ShouldBeNil)
 				So(scanDir(tempDir), ShouldResemble, []string{
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+					".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/symlink:executable",
 					".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 					".cipd/pkgs/0/_current:111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+					".cipd/pkgs/0/_previous:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
 					".cipd/pkgs/0/description.json",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/executable*",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/file/path",
+					".cipd/pkgs/1/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/some/symlink:executable",
 					".cipd/pkgs/1/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
 					".cipd/pkgs/1/_current:111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+					".cipd/pkgs/1/_previous:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
 					".cipd/pkgs/1/description.json",
 					".cipd/tmp!",
 					"some/executable*",
@@ -1641,6 +1761,200 @@ func TestRemoveDeployedWindows(t *testing.T) {
 	})
 }
 
+func TestRestoreInstance(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping on Windows: no symlinks")
+	}
+
+	ctx := context.Background()
+
+	Convey("Given a temp directory", t, func() {
+		tempDir := mkTempDir()
+		d := New(tempDir)
+
+		inst := func(id, data string, mode pkg.InstallMode) *testPackageInstance {
+			i := makeTestInstance("test/package", []fs.File{
+				fs.NewTestFile("file", data, fs.TestFileOpts{}),
+				fs.NewTestFile(data+" only", data, fs.TestFileOpts{}),
+			}, mode)
+			i.instanceID = strings.Repeat(id, 9) + "_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC"
+			return i
+		}
+		v0 := inst("0", "v0", pkg.InstallModeSymlink)
+		v1 := inst("1", "v1", pkg.InstallModeSymlink)
+		v2 := inst("2", "v2", pkg.InstallModeSymlink)
+
+		deploy := func(i *testPackageInstance) {
+			_, err := d.DeployInstance(ctx, "", i, 0)
+			So(err, ShouldBeNil)
+		}
+
+		Convey("Restores the previous instance", func() {
+			deploy(v0)
+			deploy(v1)
+
+			ok, err := d.RestoreInstance(ctx, "", v0.Pin())
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+
+			So(readFile(tempDir, "file"), ShouldEqual, "v0")
+			So(scanDir(tempDir), ShouldResemble, []string{
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/file",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/v0 only",
+				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/file",
+				".cipd/pkgs/0/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/v1 only",
+				".cipd/pkgs/0/_current:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+				".cipd/pkgs/0/_previous:111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+				".cipd/pkgs/0/description.json",
+				".cipd/tmp!",
+				"file:.cipd/pkgs/0/_current/file",
+				"v0 only:.cipd/pkgs/0/_current/v0 only",
+			})
+
+			// Can go forward again.
+			ok, err = d.RestoreInstance(ctx, "", v1.Pin())
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+			So(readFile(tempDir, "file"), ShouldEqual, "v1")
+		})
+
+		Convey("Keeps only one previous instance", func() {
+			deploy(v0)
+			deploy(v1)
+			deploy(v2)
+
+			ok, err := d.RestoreInstance(ctx, "", v0.Pin())
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeFalse)
+
+			So(scanDirAndSkipGuts(tempDir), ShouldResemble, []string{
+				"file:.cipd/pkgs/0/_current/file",
+				"v2 only:.cipd/pkgs/0/_current/v2 only",
+			})
+			_, err = os.Stat(filepath.Join(tempDir, ".cipd", "pkgs", "0", v0.instanceID))
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("Skips the current instance", func() {
+			deploy(v0)
+
+			ok, err := d.RestoreInstance(ctx, "", v0.Pin())
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("Skips unknown packages", func() {
+			ok, err := d.RestoreInstance(ctx, "", v0.Pin())
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("Restores instances installed in copy mode", func() {
+			deploy(inst("0", "v0", pkg.InstallModeCopy))
+			deploy(inst("1", "v1", pkg.InstallModeCopy))
+
+			So(readFile(tempDir, "file"), ShouldEqual, "v1")
+			_, err := os.Stat(filepath.Join(tempDir, "v0 only"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+
+			ok, err := d.RestoreInstance(ctx, "", v0.Pin())
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+
+			So(scanDirAndSkipGuts(tempDir), ShouldResemble, []string{
+				"file",
+				"v0 only",
+			})
+			So(readFile(tempDir, "file"), ShouldEqual, "v0")
+		})
+
+		Convey("Switches to staged instances", func() {
+			deploy(v0)
+
+			staged, err := d.IsStaged(ctx, "", v1.Pin())
+			So(err, ShouldBeNil)
+			So(staged, ShouldBeFalse)
+
+			So(d.StageInstance(ctx, "", v1, 0), ShouldBeNil)
+			So(readFile(tempDir, "file"), ShouldEqual, "v0")
+
+			staged, err = d.IsStaged(ctx, "", v1.Pin())
+			So(err, ShouldBeNil)
+			So(staged, ShouldBeTrue)
+
+			ok, err := d.RestoreInstance(ctx, "", v1.Pin())
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+			So(readFile(tempDir, "file"), ShouldEqual, "v1")
+		})
+
+		Convey("Removes abandoned staged instances", func() {
+			deploy(v0)
+			So(d.StageInstance(ctx, "", v1, 0), ShouldBeNil)
+			deploy(v2)
+
+			So(scanDir(tempDir), ShouldResemble, []string{
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/file",
+				".cipd/pkgs/0/000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/v0 only",
+				".cipd/pkgs/0/222222222_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+				".cipd/pkgs/0/222222222_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/file",
+				".cipd/pkgs/0/222222222_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/v2 only",
+				".cipd/pkgs/0/_current:222222222_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+				".cipd/pkgs/0/_previous:000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+				".cipd/pkgs/0/description.json",
+				".cipd/tmp!",
+				"file:.cipd/pkgs/0/_current/file",
+				"v2 only:.cipd/pkgs/0/_current/v2 only",
+			})
+		})
+
+		for _, mode := range []pkg.InstallMode{pkg.InstallModeSymlink, pkg.InstallModeCopy} {
+			mode := mode
+			Convey(fmt.Sprintf("Restores detached packages (%s)", mode), func() {
+				v0 := inst("0", "v0", mode)
+				deploy(v0)
+
+				So(d.DetachDeployed(ctx, "", v0.Pin().PackageName), ShouldBeNil)
+				So(scanDirAndSkipGuts(tempDir), ShouldHaveLength, 0)
+
+				found, err := d.FindDeployed(ctx)
+				So(err, ShouldBeNil)
+				So(found, ShouldHaveLength, 0)
+
+				ok, err := d.RestoreInstance(ctx, "", v0.Pin())
+				So(err, ShouldBeNil)
+				So(ok, ShouldBeTrue)
+				So(readFile(tempDir, "file"), ShouldEqual, "v0")
+				So(readFile(tempDir, "v0 only"), ShouldEqual, "v0")
+			})
+		}
+
+		Convey("Removes detached packages", func() {
+			deploy(v0)
+			So(d.DetachDeployed(ctx, "", v0.Pin().PackageName), ShouldBeNil)
+			So(d.RemoveDeployed(ctx, "", v0.Pin().PackageName), ShouldBeNil)
+			So(scanDir(tempDir), ShouldResemble, []string{".cipd/pkgs!", ".cipd/tmp!"})
+		})
+
+		Convey("Skips damaged instances", func() {
+			deploy(v0)
+			deploy(v1)
+
+			So(os.Remove(filepath.Join(tempDir, ".cipd", "pkgs", "0", v0.instanceID, "file")), ShouldBeNil)
+
+			ok, err := d.RestoreInstance(ctx, "", v0.Pin())
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeFalse)
+			So(readFile(tempDir, "file"), ShouldEqual, "v1")
+		})
+	})
+}
+
 func TestCheckDeployedAndRepair(t *testing.T) {
 	t.Parallel()
 
@@ -1888,12 +2202,24 @@ func TestUpgradeOldPkgDir(t *testing.T) {
 			inst.instanceID = "111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC"
 			_, err := d.DeployInstance(ctx, "", inst, 0)
 			So(err, ShouldBeNil)
-			So(scanDir(tempDir), ShouldResemble, []string{
-				".cipd/pkgs/test_package-deadbeef/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
-				currentLine("test_package-deadbeef", "111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC"),
-				".cipd/pkgs/test_package-deadbeef/description.json",
-				".cipd/tmp!",
-			})
+			if runtime.GOOS == "windows" {
+				So(scanDir(tempDir), ShouldResemble, []string{
+					".cipd/pkgs/test_package-deadbeef/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+					currentLine("test_package-deadbeef", "111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC"),
+					".cipd/pkgs/test_package-deadbeef/description.json",
+					".cipd/tmp!",
+				})
+			} else {
+				// The replaced instance is kept, since it was in "symlink" mode.
+				So(scanDir(tempDir), ShouldResemble, []string{
+					".cipd/pkgs/test_package-deadbeef/-wEu41lw0_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+					".cipd/pkgs/test_package-deadbeef/111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+					currentLine("test_package-deadbeef", "111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC"),
+					".cipd/pkgs/test_package-deadbeef/_previous:-wEu41lw0_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+					".cipd/pkgs/test_package-deadbeef/description.json",
+					".cipd/tmp!",
+				})
+			}
 		})
 
 		Convey("can deploy other package", func() {
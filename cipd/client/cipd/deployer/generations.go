@@ -0,0 +1,140 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployer
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// Generation is a complete set of packages deployed into a site root.
+//
+// Generations are recorded by CommitGeneration after all packages have been
+// successfully deployed, so a recorded generation never describes a site root
+// in some intermediate state.
+type Generation struct {
+	Source
+
+	// Number is incremented each time a new generation is committed.
+	Number int `json:"number"`
+	// Pins is a set of deployed packages, per subdir.
+	Pins common.PinSliceBySubdir `json:"pins"`
+}
+
+// Source describes where the packages of a generation were fetched from and
+// how they were verified.
+//
+// Restoring a generation may require refetching some of its packages, which
+// must be done the same way.
+type Source struct {
+	// ServiceURL is the root URL of the backend the packages were fetched from.
+	ServiceURL string `json:"service_url,omitempty"`
+	// TrustedSigners is a list of public keys of trusted package signers, as
+	// set by $TrustedSigners in the ensure file.
+	TrustedSigners []string `json:"trusted_signers,omitempty"`
+}
+
+// Generations is stored in .cipd/generations.json.
+type Generations struct {
+	Current  *Generation `json:"current,omitempty"`
+	Previous *Generation `json:"previous,omitempty"`
+}
+
+// generationsPath is a native path to the file with Generations.
+func (d *deployerImpl) generationsPath() string {
+	return filepath.Join(d.fs.Root(), fs.SiteServiceDir, generationsName)
+}
+
+func (d *deployerImpl) Generations(ctx context.Context) (*Generations, error) {
+	blob, err := ioutil.ReadFile(d.generationsPath())
+	switch {
+	case os.IsNotExist(err):
+		return &Generations{}, nil
+	case err != nil:
+		return nil, err
+	}
+	gen := &Generations{}
+	if err := json.Unmarshal(blob, gen); err != nil {
+		return nil, err
+	}
+	return gen, nil
+}
+
+func (d *deployerImpl) CommitGeneration(ctx context.Context, pins common.PinSliceBySubdir, src Source) error {
+	if err := pins.Validate(common.AnyHash); err != nil {
+		return err
+	}
+	gen, err := d.Generations(ctx)
+	if err != nil {
+		logging.Warningf(ctx, "Overwriting broken %s: %s", generationsName, err)
+		gen = &Generations{}
+	}
+
+	pins = nonEmptySubdirs(pins)
+	if gen.Current != nil && gen.Current.Source.Equal(src) &&
+		reflect.DeepEqual(pins.ToMap(), nonEmptySubdirs(gen.Current.Pins).ToMap()) {
+		return nil // nothing has changed
+	}
+
+	next := &Generation{Source: src, Number: 1, Pins: pins}
+	if gen.Current != nil {
+		next.Number = gen.Current.Number + 1
+	}
+	gen.Previous, gen.Current = gen.Current, next
+
+	blob, err := json.MarshalIndent(gen, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err := d.fs.EnsureDirectory(ctx, filepath.Dir(d.generationsPath())); err != nil {
+		return err
+	}
+	return d.fs.EnsureFile(ctx, d.generationsPath(), func(f *os.File) error {
+		_, err := f.Write(blob)
+		return err
+	})
+}
+
+// nonEmptySubdirs returns a copy of pins without subdirs that have no packages.
+func nonEmptySubdirs(pins common.PinSliceBySubdir) common.PinSliceBySubdir {
+	out := make(common.PinSliceBySubdir, len(pins))
+	for subdir, slice := range pins {
+		if len(slice) != 0 {
+			out[subdir] = slice
+		}
+	}
+	return out
+}
+
+// Equal is true if both sources have the same service URL and the same set
+// of trusted signers.
+func (s Source) Equal(o Source) bool {
+	if s.ServiceURL != o.ServiceURL {
+		return false
+	}
+	mine := stringset.NewFromSlice(s.TrustedSigners...)
+	theirs := stringset.NewFromSlice(o.TrustedSigners...)
+	return mine.Len() == theirs.Len() && mine.Contains(theirs)
+}
@@ -0,0 +1,122 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployer
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "go.chromium.org/luci/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGenerations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	Convey("Given a temp directory", t, func() {
+		tempDir := mkTempDir()
+		d := New(tempDir)
+
+		pins := func(ids ...string) PinSliceBySubdir {
+			out := PinSliceBySubdir{}
+			for _, id := range ids {
+				out[""] = append(out[""], Pin{
+					PackageName: "pkg/" + id,
+					InstanceID:  id + "_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+				})
+			}
+			return out
+		}
+
+		Convey("Empty", func() {
+			gen, err := d.Generations(ctx)
+			So(err, ShouldBeNil)
+			So(gen, ShouldResemble, &Generations{})
+		})
+
+		Convey("Commits", func() {
+			So(d.CommitGeneration(ctx, pins("000000000"), Source{}), ShouldBeNil)
+			So(d.CommitGeneration(ctx, pins("000000000", "111111111"), Source{}), ShouldBeNil)
+
+			gen, err := d.Generations(ctx)
+			So(err, ShouldBeNil)
+			So(gen, ShouldResemble, &Generations{
+				Current:  &Generation{Number: 2, Pins: pins("000000000", "111111111")},
+				Previous: &Generation{Number: 1, Pins: pins("000000000")},
+			})
+
+			// Committing the same set again (perhaps in a different order) or with
+			// empty subdirs is noop.
+			same := pins("111111111", "000000000")
+			same["empty"] = nil
+			So(d.CommitGeneration(ctx, same, Source{}), ShouldBeNil)
+
+			gen2, err := d.Generations(ctx)
+			So(err, ShouldBeNil)
+			So(gen2, ShouldResemble, gen)
+
+			// Next one pushes out the oldest.
+			So(d.CommitGeneration(ctx, pins("222222222"), Source{}), ShouldBeNil)
+			gen, err = d.Generations(ctx)
+			So(err, ShouldBeNil)
+			So(gen, ShouldResemble, &Generations{
+				Current:  &Generation{Number: 3, Pins: pins("222222222")},
+				Previous: &Generation{Number: 2, Pins: pins("000000000", "111111111")},
+			})
+		})
+
+		Convey("Records the source", func() {
+			src := Source{ServiceURL: "https://example.com", TrustedSigners: []string{"a", "b"}}
+			So(d.CommitGeneration(ctx, pins("000000000"), src), ShouldBeNil)
+
+			// The same signers in another order is the same source.
+			same := Source{ServiceURL: "https://example.com", TrustedSigners: []string{"b", "a"}}
+			So(d.CommitGeneration(ctx, pins("000000000"), same), ShouldBeNil)
+
+			// Another source is a new generation, even with the same pins.
+			other := Source{ServiceURL: "https://other.example.com"}
+			So(d.CommitGeneration(ctx, pins("000000000"), other), ShouldBeNil)
+
+			gen, err := d.Generations(ctx)
+			So(err, ShouldBeNil)
+			So(gen, ShouldResemble, &Generations{
+				Current:  &Generation{Source: other, Number: 2, Pins: pins("000000000")},
+				Previous: &Generation{Source: src, Number: 1, Pins: pins("000000000")},
+			})
+		})
+
+		Convey("Overwrites broken file", func() {
+			path := filepath.Join(tempDir, ".cipd", generationsName)
+			So(os.MkdirAll(filepath.Dir(path), 0777), ShouldBeNil)
+			So(ioutil.WriteFile(path, []byte("not json"), 0666), ShouldBeNil)
+
+			_, err := d.Generations(ctx)
+			So(err, ShouldNotBeNil)
+
+			So(d.CommitGeneration(ctx, pins("000000000"), Source{}), ShouldBeNil)
+			gen, err := d.Generations(ctx)
+			So(err, ShouldBeNil)
+			So(gen, ShouldResemble, &Generations{
+				Current: &Generation{Number: 1, Pins: pins("000000000")},
+			})
+		})
+	})
+}
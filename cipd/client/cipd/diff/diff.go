@@ -0,0 +1,131 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff implements comparison of package instances.
+package diff
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+)
+
+// File describes a single file inside a package instance.
+type File struct {
+	Name       string `json:"name"`
+	Size       uint64 `json:"size"`
+	Hash       string `json:"sha256,omitempty"` // hex digest of the body, "" for symlinks
+	Executable bool   `json:"executable,omitempty"`
+	Symlink    string `json:"symlink,omitempty"` // the symlink target
+}
+
+// ChangeKind is a kind of a change of a file.
+type ChangeKind string
+
+const (
+	Added    ChangeKind = "added"    // the file exists only in the new instance
+	Removed  ChangeKind = "removed"  // the file exists only in the old instance
+	Modified ChangeKind = "modified" // the file body or attributes changed
+)
+
+// Change describes how a file differs between two package instances.
+type Change struct {
+	Kind   ChangeKind `json:"kind"`
+	Name   string     `json:"name"`
+	Before *File      `json:"before,omitempty"` // nil if the file was added
+	After  *File      `json:"after,omitempty"`  // nil if the file was removed
+}
+
+// ListFiles returns all files in the package instance, sorted by name.
+//
+// Hashes bodies of all regular files, i.e. reads the entire instance. Skips
+// files in the package service directory (like the manifest).
+func ListFiles(inst pkg.Instance) ([]File, error) {
+	return listFiles(inst.Files())
+}
+
+func listFiles(files []fs.File) ([]File, error) {
+	out := make([]File, 0, len(files))
+	for _, f := range files {
+		if strings.HasPrefix(f.Name(), pkg.ServiceDir+"/") {
+			continue
+		}
+		entry := File{Name: f.Name(), Size: f.Size(), Executable: f.Executable()}
+		if f.Symlink() {
+			target, err := f.SymlinkTarget()
+			if err != nil {
+				return nil, errors.Annotate(err, "failed to read symlink %q", f.Name()).Err()
+			}
+			entry.Symlink = target
+		} else {
+			hash, err := hashFile(f)
+			if err != nil {
+				return nil, errors.Annotate(err, "failed to hash %q", f.Name()).Err()
+			}
+			entry.Hash = hash
+		}
+		out = append(out, entry)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func hashFile(f fs.File) (string, error) {
+	r, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Files compares two lists of files produced by ListFiles.
+//
+// Returns changes ordered by file name. Files that are the same in both lists
+// are omitted.
+func Files(before, after []File) []Change {
+	byName := make(map[string]*File, len(before))
+	for i := range before {
+		byName[before[i].Name] = &before[i]
+	}
+
+	var out []Change
+	for i := range after {
+		a := &after[i]
+		switch b := byName[a.Name]; {
+		case b == nil:
+			out = append(out, Change{Kind: Added, Name: a.Name, After: a})
+		case *b != *a:
+			out = append(out, Change{Kind: Modified, Name: a.Name, Before: b, After: a})
+		}
+		delete(byName, a.Name)
+	}
+	for _, b := range byName {
+		out = append(out, Change{Kind: Removed, Name: b.Name, Before: b})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"testing"
+
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	const (
+		helloSHA256 = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+		worldSHA256 = "486ea46224d1bb4fb680f34f7c9ad96a8f24ec88be73ea8e5a6c65260e9cb8a7"
+	)
+
+	Convey("ListFiles", t, func() {
+		files, err := listFiles([]fs.File{
+			fs.NewTestFile("b", "hello", fs.TestFileOpts{Executable: true}),
+			fs.NewTestSymlink("a", "b"),
+			fs.NewTestFile(pkg.ManifestName, "{}", fs.TestFileOpts{}),
+		})
+		So(err, ShouldBeNil)
+		So(files, ShouldResemble, []File{
+			{Name: "a", Symlink: "b"},
+			{Name: "b", Size: 5, Hash: helloSHA256, Executable: true},
+		})
+	})
+
+	Convey("Files", t, func() {
+		before := []File{
+			{Name: "removed", Size: 5, Hash: helloSHA256},
+			{Name: "same", Size: 5, Hash: helloSHA256},
+			{Name: "modified", Size: 5, Hash: helloSHA256},
+			{Name: "chmod", Size: 5, Hash: helloSHA256},
+		}
+		after := []File{
+			{Name: "same", Size: 5, Hash: helloSHA256},
+			{Name: "modified", Size: 5, Hash: worldSHA256},
+			{Name: "chmod", Size: 5, Hash: helloSHA256, Executable: true},
+			{Name: "added", Symlink: "same"},
+		}
+
+		So(Files(before, before), ShouldBeEmpty)
+		So(Files(before, after), ShouldResemble, []Change{
+			{Kind: Added, Name: "added", After: &after[3]},
+			{Kind: Modified, Name: "chmod", Before: &before[3], After: &after[2]},
+			{Kind: Modified, Name: "modified", Before: &before[2], After: &after[1]},
+			{Kind: Removed, Name: "removed", Before: &before[0]},
+		})
+	})
+}
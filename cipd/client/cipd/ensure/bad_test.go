@@ -204,6 +204,22 @@ var badEnsureFiles = []struct {
 		),
 		`invalid platform entry #2, should be <os>-<arch>, not "baz"`,
 	},
+
+	{
+		"no trusted signers",
+		f(
+			"$TrustedSigners",
+		),
+		`expecting '$TrustedSigners <public key> [<public key>]*'`,
+	},
+
+	{
+		"bad trusted signer",
+		f(
+			"$TrustedSigners MCowBQYDK2VwAyEAAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE= zzz",
+		),
+		`invalid trusted signer entry #2: public key is not base64`,
+	},
 }
 
 func TestBadEnsureFiles(t *testing.T) {
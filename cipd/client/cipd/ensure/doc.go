@@ -62,6 +62,13 @@
 //     commit, since all hashes of all packages become part of the git commit
 //     object. Use this if you want to reduce trust in the CIPD backend and root
 //     in the git server instead.
+//   - `$TrustedSigners <public key> [ <public key>]*` makes CIPD refuse to
+//     install package instances that are not signed by at least one of the
+//     given keys. Keys are base64-encoded DER PKIX ed25519 public keys (i.e.
+//     the body of the PEM file produced by `openssl pkey -pubout`). Multiple
+//     $TrustedSigners directives can be specified, and will accumulate.
+//     Signatures are attached to instances by passing `-signing-key` to
+//     `cipd create` or `cipd pkg-register`.
 //
 //
 // Package Definitions
@@ -38,6 +38,7 @@ type File struct {
 	ServiceURL       string
 	ParanoidMode     deployer.ParanoidMode
 	ResolvedVersions string
+	TrustedSigners   []string
 
 	PackagesBySubdir map[string]PackageSlice
 	VerifyPlatforms  []template.Platform
@@ -322,6 +323,11 @@ func (f *File) Serialize(w io.Writer) error {
 			fmt.Fprintf(w, "$ResolvedVersions %s", f.ResolvedVersions)
 			needsNLs = 1
 		}
+		for _, key := range f.TrustedSigners {
+			maybeAddNL()
+			fmt.Fprintf(w, "$TrustedSigners %s", key)
+			needsNLs = 1
+		}
 
 		if needsNLs != 0 {
 			needsNLs++ // new line separator if any of $Directives were used
@@ -45,7 +45,7 @@ var fileSerializationTests = []struct {
 
 	{
 		"ServiceURL",
-		&File{"https://something.example.com", "", "", nil, nil},
+		&File{"https://something.example.com", "", "", nil, nil, nil},
 		f(
 			"$ServiceURL https://something.example.com",
 		),
@@ -53,7 +53,7 @@ var fileSerializationTests = []struct {
 
 	{
 		"simple packages",
-		&File{"", "", "", map[string]PackageSlice{
+		&File{"", "", "", nil, map[string]PackageSlice{
 			"": {
 				PackageDef{"some/thing", "version", 0},
 				PackageDef{"some/other_thing", "latest", 0},
@@ -71,6 +71,7 @@ var fileSerializationTests = []struct {
 			ServiceURL:       "https://some.example.com",
 			ParanoidMode:     deployer.CheckPresence,
 			ResolvedVersions: "resolved.versions",
+			TrustedSigners:   []string{"MCowBQYDK2VwAyEAAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=", "MCowBQYDK2VwAyEAAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI="},
 			PackagesBySubdir: map[string]PackageSlice{
 				"": {
 					PackageDef{"some/thing", "version", 0},
@@ -89,6 +90,8 @@ var fileSerializationTests = []struct {
 			"$ServiceURL https://some.example.com",
 			"$ParanoidMode CheckPresence",
 			"$ResolvedVersions resolved.versions",
+			"$TrustedSigners MCowBQYDK2VwAyEAAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=",
+			"$TrustedSigners MCowBQYDK2VwAyEAAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=",
 			"",
 			"$VerifiedPlatform zoops-ohai",
 			"$VerifiedPlatform foos-barch",
@@ -201,6 +201,18 @@ var goodEnsureFiles = []struct {
 		},
 	},
 
+	{
+		"TrustedSigners setting",
+		f(
+			"$TrustedSigners MCowBQYDK2VwAyEAAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE= MCowBQYDK2VwAyEAAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=",
+			"$TrustedSigners MCowBQYDK2VwAyEAAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=",
+		),
+		&File{
+			TrustedSigners:   []string{"MCowBQYDK2VwAyEAAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=", "MCowBQYDK2VwAyEAAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=", "MCowBQYDK2VwAyEAAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE="},
+			PackagesBySubdir: map[string]PackageSlice{},
+		},
+	},
+
 	{
 		"empty",
 		"",
@@ -22,6 +22,7 @@ import (
 	"go.chromium.org/luci/common/errors"
 
 	"go.chromium.org/luci/cipd/client/cipd/deployer"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/common"
 )
@@ -89,6 +90,20 @@ func resolvedVersionsParser(_ *itemParserState, f *File, val string) error {
 	return nil
 }
 
+func trustedSignersParser(_ *itemParserState, f *File, val string) error {
+	fields := strings.Fields(val)
+	if len(fields) == 0 {
+		return fmt.Errorf("expecting '$TrustedSigners <public key> [<public key>]*'")
+	}
+	for i, field := range fields {
+		if _, err := signing.ParsePublicKey(field); err != nil {
+			return fmt.Errorf("invalid trusted signer entry #%d: %s", i+1, err)
+		}
+	}
+	f.TrustedSigners = append(f.TrustedSigners, fields...)
+	return nil
+}
+
 // itemParsers is the main way that the ensure file format is extended. If you
 // need to add a new setting or directive, please add an appropriate function
 // above and then add it to this map.
@@ -98,4 +113,5 @@ var itemParsers = map[string]itemParser{
 	"$verifiedplatform": verifyParser,
 	"$paranoidmode":     paranoidModeParser,
 	"$resolvedversions": resolvedVersionsParser,
+	"$trustedsigners":   trustedSignersParser,
 }
@@ -226,3 +226,105 @@ func ParseVersionsFile(r io.Reader) (VersionsFile, error) {
 	}
 	return res, nil
 }
+
+// VersionChange describes how a package is resolved differently in two
+// versions files.
+//
+// If a package was added, Before* fields are empty. If a package was removed,
+// After* fields are empty.
+type VersionChange struct {
+	Package       string `json:"package"`
+	BeforeVersion string `json:"before_version,omitempty"`
+	BeforeID      string `json:"before_instance_id,omitempty"`
+	AfterVersion  string `json:"after_version,omitempty"`
+	AfterID       string `json:"after_instance_id,omitempty"`
+}
+
+// Diff returns a list of changes needed to get 'after' from 'v'.
+//
+// Entries with the same version that resolve to different instances are
+// reported as a single change. If a package has exactly one entry removed and
+// exactly one entry added (e.g. its version was bumped in the ensure file),
+// they are reported as a single change too. Everything else is reported as
+// additions and removals.
+//
+// The result is ordered by package name.
+func (v VersionsFile) Diff(after VersionsFile) []VersionChange {
+	type entry struct{ ver, iid string }
+
+	// Package name => entries that are not present in the other file as is.
+	removed := map[string][]entry{}
+	added := map[string][]entry{}
+	for k, iid := range v {
+		if after[k] != iid {
+			removed[k.pkg] = append(removed[k.pkg], entry{k.ver, iid})
+		}
+	}
+	for k, iid := range after {
+		if v[k] != iid {
+			added[k.pkg] = append(added[k.pkg], entry{k.ver, iid})
+		}
+	}
+
+	pkgs := make([]string, 0, len(removed)+len(added))
+	for pkg := range removed {
+		pkgs = append(pkgs, pkg)
+	}
+	for pkg := range added {
+		if _, ok := removed[pkg]; !ok {
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	sort.Strings(pkgs)
+
+	var out []VersionChange
+	for _, pkg := range pkgs {
+		before, after := removed[pkg], added[pkg]
+		sort.Slice(before, func(i, j int) bool { return before[i].ver < before[j].ver })
+		sort.Slice(after, func(i, j int) bool { return after[i].ver < after[j].ver })
+
+		// Pair entries with the same version first.
+		var unpairedBefore []entry
+		for _, b := range before {
+			paired := false
+			for i, a := range after {
+				if a.ver == b.ver {
+					out = append(out, VersionChange{
+						Package:       pkg,
+						BeforeVersion: b.ver,
+						BeforeID:      b.iid,
+						AfterVersion:  a.ver,
+						AfterID:       a.iid,
+					})
+					after = append(after[:i:i], after[i+1:]...)
+					paired = true
+					break
+				}
+			}
+			if !paired {
+				unpairedBefore = append(unpairedBefore, b)
+			}
+		}
+		before = unpairedBefore
+
+		// A single replaced entry is likely a version bump.
+		if len(before) == 1 && len(after) == 1 {
+			out = append(out, VersionChange{
+				Package:       pkg,
+				BeforeVersion: before[0].ver,
+				BeforeID:      before[0].iid,
+				AfterVersion:  after[0].ver,
+				AfterID:       after[0].iid,
+			})
+			continue
+		}
+
+		for _, b := range before {
+			out = append(out, VersionChange{Package: pkg, BeforeVersion: b.ver, BeforeID: b.iid})
+		}
+		for _, a := range after {
+			out = append(out, VersionChange{Package: pkg, AfterVersion: a.ver, AfterID: a.iid})
+		}
+	}
+	return out
+}
@@ -86,6 +86,37 @@ func TestVersionsFile(t *testing.T) {
 		So(v1.Equal(v3), ShouldBeFalse)
 	})
 
+	Convey("Diff", t, func() {
+		const iid3 = "33333joOfFfFcq7fHCKAIrU34oeFAT174Bf8eHMajMUC"
+
+		before := VersionsFile{
+			{"same", "ver"}:    iid1,
+			{"moved", "ref"}:   iid1,
+			{"bumped", "v1"}:   iid1,
+			{"removed", "ver"}: iid1,
+			{"many", "a"}:      iid1,
+			{"many", "b"}:      iid2,
+		}
+		after := VersionsFile{
+			{"same", "ver"}:  iid1,
+			{"moved", "ref"}: iid2,
+			{"bumped", "v2"}: iid2,
+			{"added", "ver"}: iid3,
+			{"many", "c"}:    iid3,
+		}
+
+		So(before.Diff(before), ShouldBeEmpty)
+		So(before.Diff(after), ShouldResemble, []VersionChange{
+			{Package: "added", AfterVersion: "ver", AfterID: iid3},
+			{Package: "bumped", BeforeVersion: "v1", BeforeID: iid1, AfterVersion: "v2", AfterID: iid2},
+			{Package: "many", BeforeVersion: "a", BeforeID: iid1},
+			{Package: "many", BeforeVersion: "b", BeforeID: iid2},
+			{Package: "many", AfterVersion: "c", AfterID: iid3},
+			{Package: "moved", BeforeVersion: "ref", BeforeID: iid1, AfterVersion: "ref", AfterID: iid2},
+			{Package: "removed", BeforeVersion: "ver", BeforeID: iid1},
+		})
+	})
+
 	Convey("Serialization and successful parsing", t, func() {
 
 		testVersion := VersionsFile{
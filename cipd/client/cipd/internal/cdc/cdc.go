@@ -0,0 +1,109 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cdc implements content-defined chunking of byte streams.
+//
+// It uses a "gear" rolling hash (as in FastCDC): a chunk boundary is placed
+// where the hash of the last 64 bytes has some fixed number of zero bits. Since
+// boundaries depend only on the content near them, inserting or removing bytes
+// in some place of a stream affects only chunks around this place.
+//
+// Chunk sizes are part of the CIPD package format, so the parameters here must
+// never change.
+package cdc
+
+const (
+	// MinSize is the minimum size of a chunk (except the last one).
+	MinSize = 64 * 1024
+	// MaxSize is the maximum size of a chunk.
+	MaxSize = 1024 * 1024
+	// boundaryMask defines the average chunk size (~MinSize + 256 KB).
+	boundaryMask = 1<<18 - 1
+)
+
+// gear is a table of random 64-bit values, one per byte value.
+var gear [256]uint64
+
+func init() {
+	// Fill the table using splitmix64 with a fixed seed, to make it the same
+	// everywhere.
+	seed := uint64(0x63697064) // "cipd"
+	for i := range gear {
+		seed += 0x9e3779b97f4a7c15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		gear[i] = z ^ (z >> 31)
+	}
+}
+
+// Splitter is an io.WriteCloser that splits everything written to it into
+// chunks, calling the callback for each chunk.
+//
+// The last chunk is emitted when Splitter is closed. The callback must not
+// retain the chunk slice, it is reused after the callback returns.
+type Splitter struct {
+	emit func(chunk []byte) error
+	buf  []byte // the pending chunk
+	pos  int    // how many bytes of 'buf' were already hashed
+	hash uint64 // the rolling hash of buf[:pos]
+	err  error  // the sticky error from 'emit'
+}
+
+// NewSplitter returns a splitter that calls 'emit' for each chunk.
+func NewSplitter(emit func(chunk []byte) error) *Splitter {
+	return &Splitter{emit: emit}
+}
+
+// Write splits the data into chunks, emitting all complete ones.
+func (s *Splitter) Write(p []byte) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	s.buf = append(s.buf, p...)
+	for s.pos < len(s.buf) {
+		s.hash = (s.hash << 1) + gear[s.buf[s.pos]]
+		s.pos++
+		if s.pos == MaxSize || (s.pos >= MinSize && s.hash&boundaryMask == 0) {
+			if s.err = s.flush(); s.err != nil {
+				return 0, s.err
+			}
+		}
+	}
+	return len(p), nil
+}
+
+// Close emits the last chunk, if any.
+func (s *Splitter) Close() error {
+	if s.err != nil {
+		return s.err
+	}
+	s.pos = len(s.buf)
+	s.err = s.flush()
+	return s.err
+}
+
+// flush emits buf[:pos] and moves the rest of the buffer to the front.
+func (s *Splitter) flush() error {
+	if s.pos == 0 {
+		return nil
+	}
+	if err := s.emit(s.buf[:s.pos]); err != nil {
+		return err
+	}
+	s.buf = s.buf[:copy(s.buf, s.buf[s.pos:])]
+	s.pos = 0
+	s.hash = 0
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/rand"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func split(data []byte, writeSize int) (chunks [][]byte) {
+	s := NewSplitter(func(c []byte) error {
+		chunks = append(chunks, append([]byte(nil), c...))
+		return nil
+	})
+	for len(data) > 0 {
+		n := writeSize
+		if n > len(data) {
+			n = len(data)
+		}
+		if _, err := s.Write(data[:n]); err != nil {
+			panic(err)
+		}
+		data = data[n:]
+	}
+	if err := s.Close(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func hashes(chunks [][]byte) map[[32]byte]bool {
+	out := make(map[[32]byte]bool, len(chunks))
+	for _, c := range chunks {
+		out[sha256.Sum256(c)] = true
+	}
+	return out
+}
+
+func TestSplitter(t *testing.T) {
+	t.Parallel()
+
+	Convey("With random data", t, func() {
+		data := make([]byte, 8*1024*1024)
+		rand.New(rand.NewSource(1)).Read(data)
+
+		Convey("Chunks cover all data and respect size limits", func() {
+			chunks := split(data, 10000)
+			So(len(chunks), ShouldBeGreaterThan, 8)
+			So(bytes.Join(chunks, nil), ShouldResemble, data)
+			for i, c := range chunks {
+				So(len(c), ShouldBeLessThanOrEqualTo, MaxSize)
+				if i != len(chunks)-1 {
+					So(len(c), ShouldBeGreaterThanOrEqualTo, MinSize)
+				}
+			}
+		})
+
+		Convey("Chunking doesn't depend on write sizes", func() {
+			So(split(data, 333), ShouldResemble, split(data, 1024*1024))
+		})
+
+		Convey("Local edits change only few chunks", func() {
+			before := hashes(split(data, 10000))
+
+			edited := append([]byte(nil), data[:4*1024*1024]...)
+			edited = append(edited, []byte("some inserted bytes")...)
+			edited = append(edited, data[4*1024*1024:]...)
+			after := hashes(split(edited, 10000))
+
+			changed := 0
+			for h := range after {
+				if !before[h] {
+					changed++
+				}
+			}
+			So(changed, ShouldBeBetweenOrEqual, 1, 2)
+		})
+
+		Convey("Small inputs are a single chunk", func() {
+			So(split(data[:1000], 100), ShouldResemble, [][]byte{data[:1000]})
+			So(split(nil, 100), ShouldHaveLength, 0)
+		})
+	})
+}
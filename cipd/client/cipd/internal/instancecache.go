@@ -18,7 +18,10 @@ import (
 	"bytes"
 	"container/heap"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math/rand"
 	"os"
@@ -415,3 +418,147 @@ func touch(state *messages.InstanceCache, instanceID string, now time.Time) {
 	}
 	entry.LastAccess = google.NewTimestamp(now)
 }
+
+// CachedChunks knows where to find chunks of chunked packages in the cache.
+//
+// See FindChunks.
+type CachedChunks struct {
+	fs   fs.FileSystem
+	locs map[string]cachedChunk // chunk hash => where it is
+}
+
+type cachedChunk struct {
+	instanceID string
+	chunk      pkg.Chunk
+}
+
+// Has is true if the chunk with the given hash is in the cache.
+func (c *CachedChunks) Has(hash string) bool {
+	_, ok := c.locs[hash]
+	return ok
+}
+
+// Len is the number of found chunks.
+func (c *CachedChunks) Len() int {
+	return len(c.locs)
+}
+
+// Read reads the chunk with the given hash and verifies its hash.
+//
+// May fail if the instance file with the chunk was removed from the cache
+// after FindChunks call or it is corrupted.
+func (c *CachedChunks) Read(hash string) ([]byte, error) {
+	loc, ok := c.locs[hash]
+	if !ok {
+		return nil, fmt.Errorf("no chunk %s in the cache", hash)
+	}
+	path, err := c.fs.RootRelToAbs(loc.instanceID)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	buf := make([]byte, loc.chunk.Size)
+	if _, err := f.ReadAt(buf, loc.chunk.Offset); err != nil {
+		return nil, err
+	}
+	if digest := sha256.Sum256(buf); hex.EncodeToString(digest[:]) != hash {
+		return nil, fmt.Errorf("chunk %s in cached instance %s is corrupted", hash, loc.instanceID)
+	}
+	return buf, nil
+}
+
+// FindChunks scans cached instances of chunked packages looking for chunks with
+// given hashes.
+//
+// Instance files that are not chunked packages or can't be read are skipped.
+// Doesn't update last access time of instances.
+func (c *InstanceCache) FindChunks(ctx context.Context, hashes stringset.Set) *CachedChunks {
+	found := &CachedChunks{fs: c.fs, locs: map[string]cachedChunk{}}
+
+	root, err := os.Open(c.fs.Root())
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logging.Warningf(ctx, "cipd: failed to list the instance cache - %s", err)
+		}
+		return found
+	}
+	instanceIDs, err := root.Readdirnames(0)
+	root.Close()
+	if err != nil {
+		logging.Warningf(ctx, "cipd: failed to list the instance cache - %s", err)
+		return found
+	}
+
+	for _, id := range instanceIDs {
+		if found.Len() == hashes.Len() {
+			break
+		}
+		if common.ValidateInstanceID(id, common.AnyHash) != nil {
+			continue
+		}
+		idx, err := c.readChunkIndex(id)
+		if err != nil {
+			logging.Warningf(ctx, "cipd: failed to read chunk index of cached instance %s - %s", id, err)
+			continue
+		}
+		if idx == nil {
+			continue // not a chunked package
+		}
+		for _, chunk := range idx.Chunks {
+			if hashes.Has(chunk.Hash) && !found.Has(chunk.Hash) {
+				found.locs[chunk.Hash] = cachedChunk{instanceID: id, chunk: chunk}
+			}
+		}
+	}
+
+	return found
+}
+
+// readChunkIndex reads the chunk index of a cached instance.
+//
+// Returns nil if the instance is not a chunked package.
+func (c *InstanceCache) readChunkIndex(instanceID string) (*pkg.ChunkIndex, error) {
+	path, err := c.fs.RootRelToAbs(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	size := stat.Size()
+	tailLen := int64(pkg.ChunkIndexTrailerLen)
+	if tailLen > size {
+		tailLen = size
+	}
+	tail := make([]byte, tailLen)
+	if _, err := f.ReadAt(tail, size-tailLen); err != nil {
+		return nil, err
+	}
+
+	offset, length, ok := pkg.FindChunkIndex(tail)
+	switch {
+	case !ok:
+		return nil, nil
+	case offset+length > size:
+		return nil, fmt.Errorf("the chunk index is out of bounds")
+	}
+	idx, err := pkg.ReadChunkIndex(io.NewSectionReader(f, offset, length))
+	if err != nil {
+		return nil, err
+	}
+	if n := len(idx.Chunks); n != 0 && idx.Chunks[n-1].End() > size {
+		return nil, fmt.Errorf("the chunk index refers to data outside of the file")
+	}
+	return idx, nil
+}
@@ -15,7 +15,10 @@
 package internal
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -24,10 +27,14 @@ import (
 	"testing"
 	"time"
 
+	"go.chromium.org/luci/common/data/stringset"
+
 	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
 	"go.chromium.org/luci/cipd/common"
 
 	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
 // No need to create a lot of files in tests.
@@ -91,6 +98,53 @@ func TestInstanceCache(t *testing.T) {
 			return pin
 		}
 
+		Convey("FindChunks works", func() {
+			sha := func(s string) string {
+				h := sha256.Sum256([]byte(s))
+				return hex.EncodeToString(h[:])
+			}
+
+			// Makes something that looks like a chunked package to FindChunks.
+			chunked := func(chunks ...string) string {
+				buf := bytes.Buffer{}
+				idx := pkg.ChunkIndex{}
+				for _, c := range chunks {
+					buf.WriteString("-")
+					idx.Chunks = append(idx.Chunks, pkg.Chunk{
+						Offset: int64(buf.Len()),
+						Size:   int64(len(c)),
+						Hash:   sha(c),
+					})
+					buf.WriteString(c)
+				}
+				start := buf.Len()
+				So(pkg.WriteChunkIndex(&idx, &buf), ShouldBeNil)
+				buf.WriteString(pkg.ChunkIndexComment(int64(start), int64(buf.Len()-start)))
+				return buf.String()
+			}
+
+			put(cache, pini(0), chunked("aaa", "bbb"))
+			put(cache, pini(1), chunked("ccc"))
+			put(cache, pini(2), "not a chunked package")
+
+			found := cache.FindChunks(ctx, stringset.NewFromSlice(sha("bbb"), sha("ccc"), sha("zzz")))
+			So(found.Len(), ShouldEqual, 2)
+			So(found.Has(sha("aaa")), ShouldBeFalse)
+			So(found.Has(sha("bbb")), ShouldBeTrue)
+
+			data, err := found.Read(sha("ccc"))
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, "ccc")
+
+			_, err = found.Read(sha("zzz"))
+			So(err, ShouldErrLike, "no chunk")
+
+			// The cached file was modified after FindChunks call.
+			put(cache, pini(0), strings.Replace(chunked("aaa", "bbb"), "bbb", "BBB", 1))
+			_, err = found.Read(sha("bbb"))
+			So(err, ShouldErrLike, "is corrupted")
+		})
+
 		Convey("GC respects MaxSize", func() {
 			// Add twice more the limit.
 			for i := 0; i < testInstanceCacheMaxSize*2; i++ {
@@ -93,6 +93,29 @@ type TagInfo struct {
 	RegisteredTs UnixTime `json:"registered_ts"`
 }
 
+// Metadata is a metadata entry to attach to an instance via
+// AttachMetadataWhenReady.
+type Metadata struct {
+	// Key is the metadata key.
+	Key string `json:"key"`
+	// Value is the metadata value.
+	Value []byte `json:"value"`
+	// ContentType is the content type of the value, optional.
+	ContentType string `json:"content_type,omitempty"`
+}
+
+// MetadataInfo is returned by FetchInstanceMetadata.
+type MetadataInfo struct {
+	Metadata
+
+	// Fingerprint identifies this metadata entry.
+	Fingerprint string `json:"fingerprint"`
+	// RegisteredBy is identity of whoever attached this metadata.
+	RegisteredBy string `json:"registered_by"`
+	// RegisteredTs is when the metadata was attached.
+	RegisteredTs UnixTime `json:"registered_ts"`
+}
+
 // RefInfo is returned by DescribeInstance and FetchPackageRefs.
 type RefInfo struct {
 	// Ref is the ref name.
@@ -105,6 +128,19 @@ type RefInfo struct {
 	ModifiedTs UnixTime `json:"modified_ts"`
 }
 
+// FileMatch is a file inside some package instance, as returned by
+// SearchFiles.
+type FileMatch struct {
+	// Pin identifies the package instance that contains the file.
+	Pin common.Pin `json:"pin"`
+	// Path is a slash-separated path of the file relative to the package root.
+	Path string `json:"path"`
+	// Hash is hex-encoded SHA256 digest of the file body.
+	Hash string `json:"hash"`
+	// Size is the file size in bytes.
+	Size int64 `json:"size"`
+}
+
 // InstanceDescription contains extended information about an instance as
 // returned by DescribeInstance.
 type InstanceDescription struct {
@@ -186,6 +222,19 @@ func apiTagToInfo(t *api.Tag) TagInfo {
 	}
 }
 
+func apiMetadataToInfo(md *api.InstanceMetadata) MetadataInfo {
+	return MetadataInfo{
+		Metadata: Metadata{
+			Key:         md.Key,
+			Value:       md.Value,
+			ContentType: md.ContentType,
+		},
+		Fingerprint:  md.Fingerprint,
+		RegisteredBy: md.AttachedBy,
+		RegisteredTs: UnixTime(google.TimeFromProto(md.AttachedTs)),
+	}
+}
+
 func apiDescToInfo(d *api.DescribeInstanceResponse) *InstanceDescription {
 	desc := &InstanceDescription{
 		InstanceInfo: apiInstanceToInfo(d.Instance),
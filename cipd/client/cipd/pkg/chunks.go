@@ -0,0 +1,150 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// Chunked package format.
+//
+// A chunked package is still a zip archive, but its regular files are split
+// into content-defined chunks (so that an edit in one place of a file doesn't
+// change chunks in other places), and each chunk is compressed as a separate
+// zstd frame. Concatenated zstd frames form a valid zstd stream, so zip readers
+// that know about ZstdMethod can read such files as usual.
+//
+// Offsets and hashes of all chunks are stored in ChunkIndexName file inside the
+// package, which itself is stored uncompressed. Its location is recorded in the
+// zip archive comment (see ChunkIndexComment), allowing to find it by reading
+// only the tail of the package file. This allows the client to fetch only
+// chunks it doesn't have locally yet, reconstructing the rest of the package
+// file from chunks of other packages in the instance cache.
+
+const (
+	// ChunkedFormatVersion is a manifest format version of chunked packages.
+	ChunkedFormatVersion = "2"
+
+	// ChunkIndexName is a full name of the chunk index file inside the package.
+	ChunkIndexName = ServiceDir + "/chunks.json"
+
+	// ZstdMethod is a zip compression method ID used for zstd-compressed files.
+	//
+	// The value is taken from the zip APPNOTE.
+	ZstdMethod uint16 = 93
+
+	// ChunkIndexTrailerLen is how many bytes from the end of a package file are
+	// sufficient to locate the chunk index via FindChunkIndex.
+	//
+	// It is the size of the zip "end of central directory" record plus the max
+	// length of the comment produced by ChunkIndexComment.
+	ChunkIndexTrailerLen = 22 + len(chunkIndexMarker) + 2*20 + 1
+
+	// chunkIndexMarker is a prefix of the zip comment with the index location.
+	chunkIndexMarker = "cipd-chunks:"
+)
+
+// Chunk describes a single compressed chunk of a chunked package.
+type Chunk struct {
+	// Offset is an offset of the zstd frame from the start of the package file.
+	Offset int64 `json:"offset"`
+	// Size is the size of the zstd frame.
+	Size int64 `json:"size"`
+	// Hash is hex-encoded SHA256 of the zstd frame.
+	Hash string `json:"hash"`
+}
+
+// End is an offset of the first byte after the chunk.
+func (c *Chunk) End() int64 {
+	return c.Offset + c.Size
+}
+
+// ChunkIndex defines structure of the chunk index file.
+type ChunkIndex struct {
+	// Chunks is a list of all chunks in the package, ordered by their offsets.
+	Chunks []Chunk `json:"chunks"`
+}
+
+// Validate checks the chunks are ordered, don't overlap and have valid hashes.
+func (idx *ChunkIndex) Validate() error {
+	var end int64
+	for i := range idx.Chunks {
+		c := &idx.Chunks[i]
+		switch {
+		case c.Offset < end:
+			return fmt.Errorf("chunk #%d at %d is out of order or overlaps with the previous one", i, c.Offset)
+		case c.Size <= 0:
+			return fmt.Errorf("chunk #%d at %d has invalid size %d", i, c.Offset, c.Size)
+		}
+		if h, err := hex.DecodeString(c.Hash); err != nil || len(h) != 32 {
+			return fmt.Errorf("chunk #%d at %d has invalid hash %q", i, c.Offset, c.Hash)
+		}
+		end = c.End()
+	}
+	return nil
+}
+
+// ReadChunkIndex reads and validates the chunk index.
+func ReadChunkIndex(r io.Reader) (*ChunkIndex, error) {
+	blob, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	idx := &ChunkIndex{}
+	if err := json.Unmarshal(blob, idx); err != nil {
+		return nil, fmt.Errorf("malformed chunk index - %s", err)
+	}
+	if err := idx.Validate(); err != nil {
+		return nil, fmt.Errorf("bad chunk index - %s", err)
+	}
+	return idx, nil
+}
+
+// WriteChunkIndex encodes the chunk index and writes it to the writer.
+func WriteChunkIndex(idx *ChunkIndex, w io.Writer) error {
+	return json.NewEncoder(w).Encode(idx)
+}
+
+// ChunkIndexComment returns a zip archive comment that records the location of
+// the chunk index file data inside the package file.
+func ChunkIndexComment(offset, size int64) string {
+	return fmt.Sprintf("%s%d:%d", chunkIndexMarker, offset, size)
+}
+
+// FindChunkIndex locates the chunk index given the tail of a package file.
+//
+// The tail should be at least ChunkIndexTrailerLen bytes long (unless the file
+// itself is shorter). Returns ok == false if this is not a chunked package.
+func FindChunkIndex(tail []byte) (offset, size int64, ok bool) {
+	if len(tail) > ChunkIndexTrailerLen {
+		tail = tail[len(tail)-ChunkIndexTrailerLen:]
+	}
+	idx := bytes.LastIndex(tail, []byte(chunkIndexMarker))
+	if idx == -1 {
+		return 0, 0, false
+	}
+	comment := string(tail[idx:])
+	var rest string
+	n, _ := fmt.Sscanf(comment, chunkIndexMarker+"%d:%d%s", &offset, &size, &rest)
+	if n != 2 || offset < 0 || size <= 0 {
+		return 0, 0, false
+	}
+	return offset, size, true
+}
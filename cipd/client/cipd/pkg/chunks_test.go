@@ -0,0 +1,76 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestChunkIndex(t *testing.T) {
+	t.Parallel()
+
+	Convey("FindChunkIndex works", t, func() {
+		tail := append(bytes.Repeat([]byte{0}, 100), ChunkIndexComment(123456789012, 345)...)
+		offset, size, ok := FindChunkIndex(tail)
+		So(ok, ShouldBeTrue)
+		So(offset, ShouldEqual, 123456789012)
+		So(size, ShouldEqual, 345)
+
+		_, _, ok = FindChunkIndex([]byte("not a chunked package"))
+		So(ok, ShouldBeFalse)
+
+		_, _, ok = FindChunkIndex([]byte(ChunkIndexComment(1, 2) + "garbage"))
+		So(ok, ShouldBeFalse)
+
+		// The longest possible comment fits.
+		tail = append(bytes.Repeat([]byte{0}, 22), ChunkIndexComment(1<<63-1, 1<<63-1)...)
+		So(len(tail), ShouldBeLessThanOrEqualTo, ChunkIndexTrailerLen)
+	})
+
+	Convey("ReadChunkIndex works", t, func() {
+		hash := strings.Repeat("a", 64)
+		idx := &ChunkIndex{
+			Chunks: []Chunk{
+				{Offset: 10, Size: 20, Hash: hash},
+				{Offset: 30, Size: 20, Hash: hash},
+			},
+		}
+		buf := bytes.Buffer{}
+		So(WriteChunkIndex(idx, &buf), ShouldBeNil)
+		read, err := ReadChunkIndex(&buf)
+		So(err, ShouldBeNil)
+		So(read, ShouldResemble, idx)
+	})
+
+	Convey("ReadChunkIndex validates", t, func() {
+		call := func(idx string) error {
+			_, err := ReadChunkIndex(strings.NewReader(idx))
+			return err
+		}
+		hash := strings.Repeat("a", 64)
+		So(call("???"), ShouldErrLike, "malformed chunk index")
+		So(call(`{"chunks": [{"offset": 10, "size": 20, "hash": "`+hash+`"}, {"offset": 29, "size": 1, "hash": "`+hash+`"}]}`),
+			ShouldErrLike, "chunk #1 at 29 is out of order")
+		So(call(`{"chunks": [{"offset": 10, "size": 0, "hash": "`+hash+`"}]}`),
+			ShouldErrLike, "has invalid size 0")
+		So(call(`{"chunks": [{"offset": 10, "size": 1, "hash": "zzz"}]}`),
+			ShouldErrLike, `has invalid hash "zzz"`)
+	})
+}
@@ -32,6 +32,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
+
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
@@ -519,6 +521,7 @@ func (inst *packageInstance) open(opts OpenInstanceOpts) error {
 	if err != nil {
 		return err
 	}
+	inst.zip.RegisterDecompressor(pkg.ZstdMethod, zstdDecompressor)
 	inst.files = make([]fs.File, len(inst.zip.File))
 	for i, zf := range inst.zip.File {
 		fiz := &fileInZip{z: zf}
@@ -591,6 +594,10 @@ func IsCorruptionError(err error) bool {
 		switch err {
 		case io.ErrUnexpectedEOF, zip.ErrFormat, zip.ErrChecksum, zip.ErrAlgorithm, ErrHashMismatch:
 			return true
+		case zstd.ErrReservedBlockType, zstd.ErrCompressedSizeTooBig, zstd.ErrBlockTooSmall,
+			zstd.ErrMagicMismatch, zstd.ErrWindowSizeExceeded, zstd.ErrWindowSizeTooSmall,
+			zstd.ErrUnknownDictionary, zstd.ErrFrameSizeExceeded, zstd.ErrCRCMismatch:
+			return true
 		default:
 			_, flateCorrupt := err.(flate.CorruptInputError)
 			return flateCorrupt
@@ -737,6 +744,24 @@ func (f *fileInZip) Open() (io.ReadCloser, error) {
 	return f.z.Open()
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// Decompressor for zstd-compressed files in chunked packages.
+
+func zstdDecompressor(r io.Reader) io.ReadCloser {
+	// Concatenated zstd frames (one per chunk) form a valid zstd stream.
+	d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return ioutil.NopCloser(errorReader{err})
+	}
+	return d.IOReadCloser()
+}
+
+type errorReader struct {
+	err error
+}
+
+func (r errorReader) Read([]byte) (int, error) { return 0, r.err }
+
 ////////////////////////////////////////////////////////////////////////////////
 // ReaderAt thread-safe implementation via ReadSeeker.
 
@@ -144,6 +144,51 @@ func TestPackageReading(t *testing.T) {
 		So(string(manifest), shouldBeSameJSONDict, goodManifest)
 	})
 
+	Convey("ExtractFiles works with chunked packages", t, func() {
+		big := bytes.Repeat([]byte("0123456789abcdef"), 200*1024)
+
+		out := bytes.Buffer{}
+		_, err := builder.BuildInstance(ctx, builder.Options{
+			Input: []fs.File{
+				fs.NewTestFile("big", string(big), fs.TestFileOpts{}),
+				fs.NewTestFile("abc", "duh", fs.TestFileOpts{Executable: true}),
+				fs.NewTestSymlink("rel_symlink", "abc"),
+			},
+			Output:           &out,
+			PackageName:      "testing",
+			CompressionLevel: 5,
+			Chunked:          true,
+		})
+		So(err, ShouldBeNil)
+
+		inst, err := OpenInstance(ctx, bytesFile(&out), OpenInstanceOpts{
+			VerificationMode: CalculateHash,
+			HashAlgo:         api.HashAlgo_SHA256,
+		})
+		So(err, ShouldBeNil)
+		defer inst.Close(ctx, false)
+
+		dest := &testDestination{}
+		_, err = ExtractFilesTxn(ctx, inst.Files(), dest, 16, pkg.WithManifest)
+		So(err, ShouldBeNil)
+
+		// The chunk index is not extracted.
+		names := make([]string, len(dest.files))
+		for i, f := range dest.files {
+			names[i] = f.name
+		}
+		So(names, shouldContainSameStrings, []string{
+			"big",
+			"abc",
+			"rel_symlink",
+			".cipdpkg/manifest.json",
+		})
+		So(bytes.Equal(dest.fileByName("big").Bytes(), big), ShouldBeTrue)
+		So(string(dest.fileByName("abc").Bytes()), ShouldEqual, "duh")
+		So(dest.fileByName("abc").executable, ShouldBeTrue)
+		So(dest.fileByName("rel_symlink").symlinkTarget, ShouldEqual, "abc")
+	})
+
 	Convey("Open empty package with unexpected instance ID", t, func() {
 		// Build an empty package.
 		out := bytes.Buffer{}
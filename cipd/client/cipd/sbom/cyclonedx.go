@@ -0,0 +1,116 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"fmt"
+	"time"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+)
+
+// See https://cyclonedx.org/docs/1.3/json/ for the format description.
+
+type cdxDocument struct {
+	BOMFormat    string         `json:"bomFormat"`
+	SpecVersion  string         `json:"specVersion"`
+	SerialNumber string         `json:"serialNumber"`
+	Version      int            `json:"version"`
+	Metadata     cdxMetadata    `json:"metadata"`
+	Components   []cdxComponent `json:"components"`
+}
+
+type cdxMetadata struct {
+	Timestamp string    `json:"timestamp"`
+	Tools     []cdxTool `json:"tools"`
+}
+
+type cdxTool struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type cdxComponent struct {
+	Type       string         `json:"type"`
+	BOMRef     string         `json:"bom-ref,omitempty"`
+	Name       string         `json:"name"`
+	Version    string         `json:"version,omitempty"`
+	Hashes     []cdxHash      `json:"hashes,omitempty"`
+	Properties []cdxProperty  `json:"properties,omitempty"`
+	Components []cdxComponent `json:"components,omitempty"`
+}
+
+type cdxHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cdxProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+var cdxHashAlgo = map[api.HashAlgo]string{
+	api.HashAlgo_SHA1:   "SHA-1",
+	api.HashAlgo_SHA256: "SHA-256",
+}
+
+// cycloneDX converts the document to CycloneDX representation.
+//
+// Each package is a component of type "application" with its files as nested
+// components of type "file".
+func (doc *Document) cycloneDX() *cdxDocument {
+	digest := doc.digest()
+	out := &cdxDocument{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.3",
+		SerialNumber: fmt.Sprintf("urn:uuid:%x-%x-%x-%x-%x",
+			digest[0:4], digest[4:6], digest[6:8], digest[8:10], digest[10:16]),
+		Version: 1,
+		Metadata: cdxMetadata{
+			Timestamp: doc.Created.UTC().Format(time.RFC3339),
+			Tools:     []cdxTool{{Name: doc.Tool, Version: doc.ToolVersion}},
+		},
+		Components: make([]cdxComponent, 0, len(doc.Packages)),
+	}
+
+	for i := range doc.Packages {
+		p := &doc.Packages[i]
+		comp := cdxComponent{
+			Type:       "application",
+			BOMRef:     fmt.Sprintf("%s@%s", p.Subdir, p.Pin),
+			Name:       p.Pin.PackageName,
+			Version:    p.Pin.InstanceID,
+			Properties: []cdxProperty{{Name: "cipd:subdir", Value: p.Subdir}},
+		}
+		for _, tag := range p.Tags {
+			comp.Properties = append(comp.Properties, cdxProperty{Name: "cipd:tag", Value: tag})
+		}
+		for j := range p.Files {
+			f := &p.Files[j]
+			file := cdxComponent{Type: "file", Name: filePath(p, f)}
+			if ref := fileDigest(f); ref != nil && cdxHashAlgo[ref.HashAlgo] != "" {
+				file.Hashes = []cdxHash{{Alg: cdxHashAlgo[ref.HashAlgo], Content: ref.HexDigest}}
+			}
+			if f.Symlink != "" {
+				file.Properties = []cdxProperty{{Name: "cipd:symlink", Value: f.Symlink}}
+			}
+			comp.Components = append(comp.Components, file)
+		}
+		out.Components = append(out.Components, comp)
+	}
+
+	return out
+}
@@ -0,0 +1,161 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbom generates software bill of materials (SBOM) documents that
+// describe packages deployed into a CIPD site root.
+//
+// Supported formats are SPDX 2.3 JSON and CycloneDX 1.3 JSON.
+package sbom
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/client/cipd/deployer"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// Format is a format of an SBOM document.
+type Format string
+
+const (
+	// SPDX is SPDX 2.3 JSON format.
+	SPDX Format = "spdx"
+	// CycloneDX is CycloneDX 1.3 JSON format.
+	CycloneDX Format = "cyclonedx"
+)
+
+// Validate returns an error if the format is not supported.
+func (f Format) Validate() error {
+	switch f {
+	case SPDX, CycloneDX:
+		return nil
+	default:
+		return errors.Reason("unsupported SBOM format %q, expecting %q or %q", f, SPDX, CycloneDX).Err()
+	}
+}
+
+// Package describes a deployed package.
+type Package struct {
+	Subdir string         // a site root subdirectory the package is deployed to
+	Pin    common.Pin     // the deployed instance
+	Tags   []string       // tags attached to the instance, if known
+	Files  []pkg.FileInfo // files installed by the package
+}
+
+// Document is a software bill of materials.
+type Document struct {
+	Name        string    // a human readable name of the document, e.g. a site root path
+	Created     time.Time // when the document was created
+	Tool        string    // name of the tool that created the document, e.g. "cipd"
+	ToolVersion string    // version of the tool that created the document
+	Packages    []Package // all deployed packages
+}
+
+// Collect reads manifests of all packages deployed into the site root.
+//
+// The result is ordered by (subdir, package name). Tags are not populated,
+// since they are stored only on the backend.
+func Collect(ctx context.Context, d deployer.Deployer) ([]Package, error) {
+	deployed, err := d.FindDeployed(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to enumerate deployed packages").Err()
+	}
+
+	subdirs := make([]string, 0, len(deployed))
+	for subdir := range deployed {
+		subdirs = append(subdirs, subdir)
+	}
+	sort.Strings(subdirs)
+
+	var out []Package
+	for _, subdir := range subdirs {
+		pins := append(common.PinSlice(nil), deployed[subdir]...)
+		sort.Slice(pins, func(i, j int) bool { return pins[i].PackageName < pins[j].PackageName })
+		for _, pin := range pins {
+			state, err := d.CheckDeployed(ctx, subdir, pin.PackageName, deployer.NotParanoid, pkg.WithManifest)
+			switch {
+			case err != nil:
+				return nil, errors.Annotate(err, "failed to read the manifest of %s", pin.PackageName).Err()
+			case !state.Deployed || state.Manifest == nil:
+				return nil, errors.Reason("package %s in %q is not fully deployed", pin.PackageName, subdir).Err()
+			}
+			files := append([]pkg.FileInfo(nil), state.Manifest.Files...)
+			sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+			out = append(out, Package{
+				Subdir: subdir,
+				Pin:    state.Pin,
+				Files:  files,
+			})
+		}
+	}
+	return out, nil
+}
+
+// Write serializes the document in the given format.
+func (doc *Document) Write(w io.Writer, f Format) error {
+	var body interface{}
+	switch f {
+	case SPDX:
+		body = doc.spdx()
+	case CycloneDX:
+		body = doc.cycloneDX()
+	default:
+		return f.Validate()
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(body)
+}
+
+// digest returns a stable digest of the document contents.
+//
+// It is used to derive unique document identifiers.
+func (doc *Document) digest() [sha256.Size]byte {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n", doc.Name, doc.Created.UTC().Format(time.RFC3339Nano))
+	for _, p := range doc.Packages {
+		fmt.Fprintf(h, "%s\n%s\n", p.Subdir, p.Pin)
+	}
+	var out [sha256.Size]byte
+	h.Sum(out[:0])
+	return out
+}
+
+// fileDigest decodes the hash of a file from its manifest entry.
+//
+// Returns nil if the file has no hash (e.g. it is a symlink or the manifest is
+// old).
+func fileDigest(f *pkg.FileInfo) *api.ObjectRef {
+	if f.Hash == "" || common.ValidateInstanceID(f.Hash, common.AnyHash) != nil {
+		return nil
+	}
+	return common.InstanceIDToObjectRef(f.Hash)
+}
+
+// filePath returns a slash-separated path to the file relative to the site
+// root.
+func filePath(p *Package, f *pkg.FileInfo) string {
+	return path.Join(p.Subdir, f.Name)
+}
@@ -0,0 +1,181 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/cipd/client/cipd/deployer"
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// Hash of "hello" in the instance ID format and as a hex digest.
+const (
+	helloIID = "LPJNul-wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQC"
+	helloHex = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+)
+
+type testInstance struct {
+	pin   common.Pin
+	files []fs.File
+}
+
+func (i *testInstance) Pin() common.Pin                   { return i.pin }
+func (i *testInstance) Files() []fs.File                  { return i.files }
+func (i *testInstance) Source() io.ReadSeeker             { panic("not implemented") }
+func (i *testInstance) Close(context.Context, bool) error { return nil }
+
+func makeInstance(name, iid string, files ...fs.File) *testInstance {
+	out := bytes.Buffer{}
+	err := pkg.WriteManifest(&pkg.Manifest{
+		FormatVersion: pkg.ManifestFormatVersion,
+		PackageName:   name,
+	}, &out)
+	if err != nil {
+		panic(err)
+	}
+	files = append(files, fs.NewTestFile(pkg.ManifestName, out.String(), fs.TestFileOpts{}))
+	return &testInstance{
+		pin:   common.Pin{PackageName: name, InstanceID: iid},
+		files: files,
+	}
+}
+
+func TestSBOM(t *testing.T) {
+	t.Parallel()
+
+	Convey("With a site root", t, func() {
+		ctx := context.Background()
+
+		root, err := ioutil.TempDir("", "cipd_sbom_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(root)
+
+		iid1 := "-wEu41lw0_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC"
+		iid2 := "22222joOfFfFcq7fHCKAIrU34oeFAT174Bf8eHMajMUC"
+
+		d := deployer.New(root)
+		_, err = d.DeployInstance(ctx, "", makeInstance("pkg/b", iid1,
+			fs.NewTestFile("bin/tool", "hello", fs.TestFileOpts{Executable: true}),
+		), 1)
+		So(err, ShouldBeNil)
+		_, err = d.DeployInstance(ctx, "sub", makeInstance("pkg/a", iid2,
+			fs.NewTestFile("data", "hello", fs.TestFileOpts{}),
+		), 1)
+		So(err, ShouldBeNil)
+
+		pkgs, err := Collect(ctx, d)
+		So(err, ShouldBeNil)
+		So(pkgs, ShouldHaveLength, 2)
+		So(pkgs[0].Subdir, ShouldEqual, "")
+		So(pkgs[0].Pin, ShouldResemble, common.Pin{PackageName: "pkg/b", InstanceID: iid1})
+		So(pkgs[0].Files, ShouldHaveLength, 1)
+		So(pkgs[0].Files[0].Name, ShouldEqual, "bin/tool")
+		So(pkgs[0].Files[0].Hash, ShouldEqual, helloIID)
+		So(pkgs[1].Subdir, ShouldEqual, "sub")
+		So(pkgs[1].Pin, ShouldResemble, common.Pin{PackageName: "pkg/a", InstanceID: iid2})
+
+		pkgs[0].Tags = []string{"version:1.0"}
+		doc := &Document{
+			Name:        "test",
+			Created:     time.Date(2020, time.February, 1, 2, 3, 4, 0, time.UTC),
+			Tool:        "cipd",
+			ToolVersion: "1.2.3",
+			Packages:    pkgs,
+		}
+
+		write := func(f Format) map[string]interface{} {
+			buf := bytes.Buffer{}
+			So(doc.Write(&buf, f), ShouldBeNil)
+			out := map[string]interface{}{}
+			So(json.Unmarshal(buf.Bytes(), &out), ShouldBeNil)
+			return out
+		}
+
+		Convey("SPDX", func() {
+			out := write(SPDX)
+			So(out["spdxVersion"], ShouldEqual, "SPDX-2.3")
+			So(out["creationInfo"], ShouldResemble, map[string]interface{}{
+				"created":  "2020-02-01T02:03:04Z",
+				"creators": []interface{}{"Tool: cipd-1.2.3"},
+			})
+
+			pkgs := out["packages"].([]interface{})
+			So(pkgs, ShouldHaveLength, 2)
+			So(pkgs[0].(map[string]interface{})["name"], ShouldEqual, "pkg/b")
+			So(pkgs[0].(map[string]interface{})["versionInfo"], ShouldEqual, iid1)
+			So(pkgs[0].(map[string]interface{})["comment"], ShouldEqual, `Installed into "". Tags: version:1.0.`)
+
+			So(out["files"], ShouldResemble, []interface{}{
+				map[string]interface{}{
+					"SPDXID":           "SPDXRef-File-0-0",
+					"fileName":         "./bin/tool",
+					"checksums":        []interface{}{map[string]interface{}{"algorithm": "SHA256", "checksumValue": helloHex}},
+					"licenseConcluded": "NOASSERTION",
+					"copyrightText":    "NOASSERTION",
+				},
+				map[string]interface{}{
+					"SPDXID":           "SPDXRef-File-1-0",
+					"fileName":         "./sub/data",
+					"checksums":        []interface{}{map[string]interface{}{"algorithm": "SHA256", "checksumValue": helloHex}},
+					"licenseConcluded": "NOASSERTION",
+					"copyrightText":    "NOASSERTION",
+				},
+			})
+			So(out["relationships"], ShouldHaveLength, 4)
+		})
+
+		Convey("CycloneDX", func() {
+			out := write(CycloneDX)
+			So(out["bomFormat"], ShouldEqual, "CycloneDX")
+			So(out["serialNumber"], ShouldStartWith, "urn:uuid:")
+
+			comps := out["components"].([]interface{})
+			So(comps, ShouldHaveLength, 2)
+			So(comps[0], ShouldResemble, map[string]interface{}{
+				"type":    "application",
+				"bom-ref": "@pkg/b:" + iid1,
+				"name":    "pkg/b",
+				"version": iid1,
+				"properties": []interface{}{
+					map[string]interface{}{"name": "cipd:subdir", "value": ""},
+					map[string]interface{}{"name": "cipd:tag", "value": "version:1.0"},
+				},
+				"components": []interface{}{
+					map[string]interface{}{
+						"type":   "file",
+						"name":   "bin/tool",
+						"hashes": []interface{}{map[string]interface{}{"alg": "SHA-256", "content": helloHex}},
+					},
+				},
+			})
+		})
+
+		Convey("Unknown format", func() {
+			So(doc.Write(ioutil.Discard, "huh"), ShouldNotBeNil)
+		})
+	})
+}
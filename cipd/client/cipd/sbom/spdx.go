@@ -0,0 +1,152 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+)
+
+// See https://spdx.github.io/spdx-spec/v2.3/ for the format description.
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Files             []spdxFile         `json:"files,omitempty"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string `json:"SPDXID"`
+	Name             string `json:"name"`
+	VersionInfo      string `json:"versionInfo"`
+	DownloadLocation string `json:"downloadLocation"`
+	FilesAnalyzed    bool   `json:"filesAnalyzed"`
+	LicenseConcluded string `json:"licenseConcluded"`
+	LicenseDeclared  string `json:"licenseDeclared"`
+	CopyrightText    string `json:"copyrightText"`
+	Comment          string `json:"comment,omitempty"`
+}
+
+type spdxFile struct {
+	SPDXID           string         `json:"SPDXID"`
+	FileName         string         `json:"fileName"`
+	Checksums        []spdxChecksum `json:"checksums"`
+	LicenseConcluded string         `json:"licenseConcluded"`
+	CopyrightText    string         `json:"copyrightText"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+const spdxNoAssertion = "NOASSERTION"
+
+var spdxHashAlgo = map[api.HashAlgo]string{
+	api.HashAlgo_SHA1:   "SHA1",
+	api.HashAlgo_SHA256: "SHA256",
+}
+
+// spdx converts the document to SPDX representation.
+//
+// Files without known hashes (e.g. symlinks) are omitted, since SPDX requires
+// a checksum for every file.
+func (doc *Document) spdx() *spdxDocument {
+	out := &spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              doc.Name,
+		DocumentNamespace: fmt.Sprintf("https://spdx.org/spdxdocs/cipd-%x", doc.digest()),
+		CreationInfo: spdxCreationInfo{
+			Created:  doc.Created.UTC().Format(time.RFC3339),
+			Creators: []string{fmt.Sprintf("Tool: %s-%s", doc.Tool, doc.ToolVersion)},
+		},
+		Packages:      make([]spdxPackage, 0, len(doc.Packages)),
+		Relationships: make([]spdxRelationship, 0, len(doc.Packages)),
+	}
+
+	for i := range doc.Packages {
+		p := &doc.Packages[i]
+		pkgID := fmt.Sprintf("SPDXRef-Package-%d", i)
+
+		comment := fmt.Sprintf("Installed into %q.", p.Subdir)
+		if len(p.Tags) != 0 {
+			comment += " Tags: " + strings.Join(p.Tags, ", ") + "."
+		}
+		out.Packages = append(out.Packages, spdxPackage{
+			SPDXID:           pkgID,
+			Name:             p.Pin.PackageName,
+			VersionInfo:      p.Pin.InstanceID,
+			DownloadLocation: spdxNoAssertion,
+			FilesAnalyzed:    false,
+			LicenseConcluded: spdxNoAssertion,
+			LicenseDeclared:  spdxNoAssertion,
+			CopyrightText:    spdxNoAssertion,
+			Comment:          comment,
+		})
+		out.Relationships = append(out.Relationships, spdxRelationship{
+			SPDXElementID:      out.SPDXID,
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: pkgID,
+		})
+
+		for j := range p.Files {
+			f := &p.Files[j]
+			ref := fileDigest(f)
+			if ref == nil || spdxHashAlgo[ref.HashAlgo] == "" {
+				continue
+			}
+			fileID := fmt.Sprintf("SPDXRef-File-%d-%d", i, j)
+			out.Files = append(out.Files, spdxFile{
+				SPDXID:   fileID,
+				FileName: "./" + filePath(p, f),
+				Checksums: []spdxChecksum{{
+					Algorithm:     spdxHashAlgo[ref.HashAlgo],
+					ChecksumValue: ref.HexDigest,
+				}},
+				LicenseConcluded: spdxNoAssertion,
+				CopyrightText:    spdxNoAssertion,
+			})
+			out.Relationships = append(out.Relationships, spdxRelationship{
+				SPDXElementID:      pkgID,
+				RelationshipType:   "CONTAINS",
+				RelatedSPDXElement: fileID,
+			})
+		}
+	}
+
+	return out
+}
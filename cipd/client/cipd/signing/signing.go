@@ -0,0 +1,213 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signing implements detached signatures of CIPD package instances.
+//
+// A signature is produced by a private key (currently only ed25519 keys are
+// supported) over a message that binds together the package name and the
+// instance ID. It is stored on the backend as an instance metadata entry with
+// key MetadataKey and verified by the client before deploying the instance if
+// the ensure file has $TrustedSigners directive.
+//
+// Private keys are read from PKCS#8 PEM files, e.g. as produced by:
+//
+//   openssl genpkey -algorithm ed25519 -out key.pem
+//
+// Public keys are represented as base64-encoded DER PKIX blobs, i.e. the body
+// of the PEM file produced by:
+//
+//   openssl pkey -in key.pem -pubout
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/cipd/common"
+)
+
+const (
+	// MetadataKey is the instance metadata key used to store signatures.
+	MetadataKey = "signature"
+
+	// ContentType is the content type of the instance metadata with signatures.
+	ContentType = "application/json"
+
+	// AlgoEd25519 identifies ed25519 signatures.
+	AlgoEd25519 = "ed25519"
+)
+
+// Signature is a detached signature of some package instance.
+//
+// It is serialized to JSON when stored as an instance metadata value or in
+// a *.sig file next to a package file.
+type Signature struct {
+	KeyID     string `json:"key_id"`    // see KeyID
+	Algorithm string `json:"algorithm"` // only AlgoEd25519 currently
+	Signature []byte `json:"signature"` // the raw signature, base64 in JSON
+}
+
+// ParseSignature deserializes and validates a signature.
+func ParseSignature(blob []byte) (*Signature, error) {
+	sig := &Signature{}
+	if err := json.Unmarshal(blob, sig); err != nil {
+		return nil, errors.Annotate(err, "malformed signature").Err()
+	}
+	if sig.Algorithm != AlgoEd25519 {
+		return nil, errors.Reason("unsupported signature algorithm %q", sig.Algorithm).Err()
+	}
+	if len(sig.Signature) != ed25519.SignatureSize {
+		return nil, errors.Reason("bad ed25519 signature length %d", len(sig.Signature)).Err()
+	}
+	return sig, nil
+}
+
+// Serialize serializes the signature to JSON.
+func (s *Signature) Serialize() []byte {
+	blob, err := json.Marshal(s)
+	if err != nil {
+		panic(err) // impossible
+	}
+	return blob
+}
+
+// KeyID returns an identifier of a public key.
+//
+// It is hex-encoded SHA256 of DER PKIX form of the key, truncated to 16 bytes.
+func KeyID(pub ed25519.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		panic(err) // impossible for ed25519 keys
+	}
+	h := sha256.Sum256(der)
+	return hex.EncodeToString(h[:16])
+}
+
+// ParsePrivateKey parses a PEM-encoded PKCS#8 ed25519 private key.
+func ParsePrivateKey(pemBlob []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(pemBlob)
+	if block == nil {
+		return nil, errors.Reason("not a PEM file").Err()
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad PKCS#8 private key").Err()
+	}
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.Reason("unsupported private key type %T, only ed25519 keys are supported", key).Err()
+	}
+	return priv, nil
+}
+
+// ParsePublicKey parses a base64-encoded DER PKIX ed25519 public key.
+func ParsePublicKey(b64 string) (ed25519.PublicKey, error) {
+	der, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return nil, errors.Annotate(err, "public key is not base64").Err()
+	}
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad PKIX public key").Err()
+	}
+	pub, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.Reason("unsupported public key type %T, only ed25519 keys are supported", key).Err()
+	}
+	return pub, nil
+}
+
+// EncodePublicKey is the inverse of ParsePublicKey.
+func EncodePublicKey(pub ed25519.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		panic(err) // impossible for ed25519 keys
+	}
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+// signedMessage is what is actually signed.
+//
+// It binds the package name to the instance ID, so a signature of one package
+// can't be reused for another package with the same content.
+func signedMessage(pin common.Pin) []byte {
+	return []byte(fmt.Sprintf("cipd-instance-signature-v1\n%s\n%s", pin.PackageName, pin.InstanceID))
+}
+
+// Sign produces a signature of the given package instance.
+func Sign(priv ed25519.PrivateKey, pin common.Pin) (*Signature, error) {
+	if err := common.ValidatePin(pin, common.AnyHash); err != nil {
+		return nil, err
+	}
+	return &Signature{
+		KeyID:     KeyID(priv.Public().(ed25519.PublicKey)),
+		Algorithm: AlgoEd25519,
+		Signature: ed25519.Sign(priv, signedMessage(pin)),
+	}, nil
+}
+
+// Verifier checks signatures against a set of trusted public keys.
+type Verifier struct {
+	keys map[string]ed25519.PublicKey // key ID => the key
+}
+
+// NewVerifier constructs a verifier that trusts given public keys.
+//
+// Keys are in the format accepted by ParsePublicKey.
+func NewVerifier(keys []string) (*Verifier, error) {
+	v := &Verifier{keys: make(map[string]ed25519.PublicKey, len(keys))}
+	for _, k := range keys {
+		pub, err := ParsePublicKey(k)
+		if err != nil {
+			return nil, errors.Annotate(err, "bad trusted signer %q", k).Err()
+		}
+		v.keys[KeyID(pub)] = pub
+	}
+	return v, nil
+}
+
+// Verify checks that at least one of the given signatures is a valid signature
+// of the instance made by a trusted key.
+//
+// 'sigs' are serialized signatures, as stored in the instance metadata. Ones
+// that can't be parsed or are made by unknown keys are skipped.
+func (v *Verifier) Verify(pin common.Pin, sigs [][]byte) error {
+	if len(sigs) == 0 {
+		return errors.Reason("%s is not signed", pin).Err()
+	}
+	msg := signedMessage(pin)
+	var seen []string
+	for _, blob := range sigs {
+		sig, err := ParseSignature(blob)
+		if err != nil {
+			continue
+		}
+		seen = append(seen, sig.KeyID)
+		if pub := v.keys[sig.KeyID]; pub != nil && ed25519.Verify(pub, msg, sig.Signature) {
+			return nil
+		}
+	}
+	sort.Strings(seen)
+	return errors.Reason("%s is not signed by any trusted signer (signed by: [%s])", pin, strings.Join(seen, ", ")).Err()
+}
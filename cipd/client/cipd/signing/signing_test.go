@@ -0,0 +1,124 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"go.chromium.org/luci/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func testKey(seed byte) ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(bytes.Repeat([]byte{seed}, ed25519.SeedSize))
+}
+
+func TestSigning(t *testing.T) {
+	t.Parallel()
+
+	Convey("With keys", t, func() {
+		priv := testKey(1)
+		pub := priv.Public().(ed25519.PublicKey)
+		another := testKey(2)
+
+		pin := common.Pin{
+			PackageName: "some/pkg",
+			InstanceID:  strings.Repeat("a", 40),
+		}
+
+		Convey("ParsePrivateKey works", func() {
+			der, err := x509.MarshalPKCS8PrivateKey(priv)
+			So(err, ShouldBeNil)
+			parsed, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{
+				Type:  "PRIVATE KEY",
+				Bytes: der,
+			}))
+			So(err, ShouldBeNil)
+			So(parsed.Equal(priv), ShouldBeTrue)
+
+			_, err = ParsePrivateKey([]byte("garbage"))
+			So(err, ShouldErrLike, "not a PEM file")
+		})
+
+		Convey("ParsePublicKey works", func() {
+			parsed, err := ParsePublicKey(EncodePublicKey(pub))
+			So(err, ShouldBeNil)
+			So(parsed.Equal(pub), ShouldBeTrue)
+
+			_, err = ParsePublicKey("???")
+			So(err, ShouldErrLike, "not base64")
+		})
+
+		Convey("Signature roundtrip", func() {
+			sig, err := Sign(priv, pin)
+			So(err, ShouldBeNil)
+			So(sig.KeyID, ShouldEqual, KeyID(pub))
+			So(sig.KeyID, ShouldHaveLength, 32)
+
+			parsed, err := ParseSignature(sig.Serialize())
+			So(err, ShouldBeNil)
+			So(parsed, ShouldResemble, sig)
+
+			_, err = ParseSignature([]byte(`{"algorithm": "rsa"}`))
+			So(err, ShouldErrLike, "unsupported signature algorithm")
+		})
+
+		Convey("Verifier", func() {
+			v, err := NewVerifier([]string{EncodePublicKey(pub)})
+			So(err, ShouldBeNil)
+
+			sign := func(key ed25519.PrivateKey, pin common.Pin) []byte {
+				sig, err := Sign(key, pin)
+				So(err, ShouldBeNil)
+				return sig.Serialize()
+			}
+
+			Convey("Good signature", func() {
+				So(v.Verify(pin, [][]byte{
+					[]byte("garbage"),
+					sign(another, pin),
+					sign(priv, pin),
+				}), ShouldBeNil)
+			})
+
+			Convey("Not signed", func() {
+				So(v.Verify(pin, nil), ShouldErrLike, "is not signed")
+			})
+
+			Convey("Signed by untrusted key", func() {
+				So(v.Verify(pin, [][]byte{sign(another, pin)}), ShouldErrLike,
+					"is not signed by any trusted signer (signed by: ["+KeyID(another.Public().(ed25519.PublicKey))+"])")
+			})
+
+			Convey("Signature of another package", func() {
+				otherPin := pin
+				otherPin.PackageName = "another/pkg"
+				So(v.Verify(pin, [][]byte{sign(priv, otherPin)}), ShouldErrLike, "is not signed by any trusted signer")
+			})
+
+			Convey("Bad trusted key", func() {
+				_, err := NewVerifier([]string{"zzz"})
+				So(err, ShouldErrLike, `bad trusted signer "zzz"`)
+			})
+		})
+	})
+}
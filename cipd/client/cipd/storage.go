@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"hash"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
@@ -71,6 +72,13 @@ func isTemporaryHTTPError(statusCode int) bool {
 type storage interface {
 	upload(ctx context.Context, url string, data io.ReadSeeker) error
 	download(ctx context.Context, url string, output io.WriteSeeker, h hash.Hash) error
+
+	// downloadRange fetches [offset, offset+length) byte range of the file.
+	//
+	// If offset is negative, fetches the last 'length' bytes of the file (or
+	// the entire file, if it is shorter). Returns the fetched data and the total
+	// size of the file.
+	downloadRange(ctx context.Context, url string, offset, length int64) (data []byte, total int64, err error)
 }
 
 // storageImpl implements 'storage' via Google Storage signed URLs.
@@ -349,6 +357,76 @@ func (s *storageImpl) download(ctx context.Context, url string, output io.WriteS
 	return ErrDownloadError
 }
 
+func (s *storageImpl) downloadRange(ctx context.Context, url string, offset, length int64) ([]byte, int64, error) {
+	rng := fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)
+	if offset < 0 {
+		rng = fmt.Sprintf("bytes=-%d", length)
+	}
+
+	// reportTransientError logs the error and sleep few seconds.
+	reportTransientError := func(msg string, args ...interface{}) {
+		if err := ctx.Err(); err != nil {
+			return
+		}
+		logging.Warningf(ctx, msg, args...)
+		clock.Sleep(ctx, 2*time.Second)
+	}
+
+	for attempt := 0; attempt < downloadMaxAttempts; attempt++ {
+		// Context canceled?
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		req.Header.Set("User-Agent", s.userAgent)
+		req.Header.Set("Range", rng)
+		resp, err := ctxhttp.Do(ctx, s.client, req)
+		if err != nil {
+			if isTemporaryNetError(err) {
+				reportTransientError("cipd: failed to initiate the fetch - %s", err)
+				continue
+			}
+			return nil, 0, err
+		}
+
+		// Transient error, retry.
+		if isTemporaryHTTPError(resp.StatusCode) {
+			resp.Body.Close()
+			reportTransientError("cipd: transient HTTP error %d while fetching %s", resp.StatusCode, rng)
+			continue
+		}
+
+		// Anything else (including 200 from servers that ignore Range header) is
+		// a fatal error.
+		if resp.StatusCode != http.StatusPartialContent {
+			resp.Body.Close()
+			return nil, 0, fmt.Errorf("server replied with HTTP code %d to a range request", resp.StatusCode)
+		}
+		var start, end, total int64
+		if _, err := fmt.Sscanf(resp.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &total); err != nil {
+			resp.Body.Close()
+			return nil, 0, fmt.Errorf("bad Content-Range header %q", resp.Header.Get("Content-Range"))
+		}
+
+		data, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			reportTransientError("cipd: transient error fetching %s: %s", rng, err)
+			continue
+		}
+		if int64(len(data)) != end-start+1 || (offset >= 0 && (start != offset || int64(len(data)) != length)) {
+			return nil, 0, fmt.Errorf("server replied with unexpected range %d-%d to %s", start, end, rng)
+		}
+		return data, total, nil
+	}
+
+	return nil, 0, ErrDownloadError
+}
+
 // readerWithProgress is io.Reader that calls callback whenever something is
 // read from it.
 type readerWithProgress struct {
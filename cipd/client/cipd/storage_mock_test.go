@@ -16,6 +16,7 @@ package cipd
 
 import (
 	"context"
+	"fmt"
 	"hash"
 	"io"
 	"io/ioutil"
@@ -32,6 +33,7 @@ type mockedStorage struct {
 	store         map[string]string // URL -> data
 	err           error
 	downloadCount int64
+	rangeCount    int64
 }
 
 func (s *mockedStorage) getStored(url string) string {
@@ -53,6 +55,10 @@ func (s *mockedStorage) downloads() int {
 	return int(atomic.LoadInt64(&s.downloadCount))
 }
 
+func (s *mockedStorage) rangeDownloads() int {
+	return int(atomic.LoadInt64(&s.rangeCount))
+}
+
 func (s *mockedStorage) returnErr(err error) {
 	s.err = err
 }
@@ -96,3 +102,29 @@ func (s *mockedStorage) download(ctx context.Context, url string, output io.Writ
 	_, err := io.MultiWriter(output, h).Write([]byte(body))
 	return err
 }
+
+func (s *mockedStorage) downloadRange(ctx context.Context, url string, offset, length int64) ([]byte, int64, error) {
+	atomic.AddInt64(&s.rangeCount, 1)
+
+	if s.err != nil {
+		return nil, 0, s.err
+	}
+
+	body := s.getStored(url)
+	if body == "" {
+		return nil, 0, ErrDownloadError
+	}
+
+	total := int64(len(body))
+	if offset < 0 {
+		offset = total - length
+		if offset < 0 {
+			offset = 0
+		}
+		length = total - offset
+	}
+	if offset+length > total {
+		return nil, 0, fmt.Errorf("range %d-%d is out of bounds", offset, offset+length-1)
+	}
+	return []byte(body[offset : offset+length]), total, nil
+}
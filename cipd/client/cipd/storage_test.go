@@ -33,6 +33,7 @@ import (
 	"go.chromium.org/luci/common/logging/gologger"
 
 	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
 func TestUpload(t *testing.T) {
@@ -132,6 +133,66 @@ func TestDownload(t *testing.T) {
 	})
 }
 
+func TestDownloadRange(t *testing.T) {
+	ctx := makeTestContext()
+
+	Convey("Download range works", t, func(c C) {
+		storage := mockStorageImpl(c, []expectedHTTPCall{
+			// Simulate a transient error.
+			{
+				Method:  "GET",
+				Path:    "/dwn",
+				Headers: http.Header{"Range": []string{"bytes=5-8"}},
+				Status:  500,
+				Reply:   "error",
+			},
+			{
+				Method:          "GET",
+				Path:            "/dwn",
+				Headers:         http.Header{"Range": []string{"bytes=5-8"}},
+				Status:          206,
+				Reply:           "data",
+				ResponseHeaders: http.Header{"Content-Range": []string{"bytes 5-8/100"}},
+			},
+		})
+		data, total, err := storage.downloadRange(ctx, "http://localhost/dwn", 5, 4)
+		So(err, ShouldBeNil)
+		So(string(data), ShouldEqual, "data")
+		So(total, ShouldEqual, 100)
+	})
+
+	Convey("Download tail works", t, func(c C) {
+		storage := mockStorageImpl(c, []expectedHTTPCall{
+			{
+				Method:          "GET",
+				Path:            "/dwn",
+				Headers:         http.Header{"Range": []string{"bytes=-10"}},
+				Status:          206,
+				Reply:           "tail",
+				ResponseHeaders: http.Header{"Content-Range": []string{"bytes 0-3/4"}},
+			},
+		})
+		data, total, err := storage.downloadRange(ctx, "http://localhost/dwn", -1, 10)
+		So(err, ShouldBeNil)
+		So(string(data), ShouldEqual, "tail")
+		So(total, ShouldEqual, 4)
+	})
+
+	Convey("Range requests not supported", t, func(c C) {
+		storage := mockStorageImpl(c, []expectedHTTPCall{
+			{
+				Method:  "GET",
+				Path:    "/dwn",
+				Headers: http.Header{"Range": []string{"bytes=-10"}},
+				Status:  200,
+				Reply:   "entire file",
+			},
+		})
+		_, _, err := storage.downloadRange(ctx, "http://localhost/dwn", -1, 10)
+		So(err, ShouldErrLike, "HTTP code 200 to a range request")
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func makeTestContext() context.Context {
@@ -35,6 +35,7 @@ import (
 	"go.chromium.org/luci/auth"
 	"go.chromium.org/luci/client/versioncli"
 	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/data/stringset"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/flag/fixflagpos"
@@ -48,11 +49,14 @@ import (
 	"go.chromium.org/luci/cipd/client/cipd"
 	"go.chromium.org/luci/cipd/client/cipd/builder"
 	"go.chromium.org/luci/cipd/client/cipd/deployer"
+	"go.chromium.org/luci/cipd/client/cipd/diff"
 	"go.chromium.org/luci/cipd/client/cipd/digests"
 	"go.chromium.org/luci/cipd/client/cipd/ensure"
 	"go.chromium.org/luci/cipd/client/cipd/fs"
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
 	"go.chromium.org/luci/cipd/client/cipd/reader"
+	"go.chromium.org/luci/cipd/client/cipd/sbom"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/common"
 )
@@ -315,10 +319,11 @@ const (
 type clientOptions struct {
 	hardcoded Parameters // whatever was passed to registerFlags(...)
 
-	serviceURL string // also mutated by loadEnsureFile
-	cacheDir   string
-	rootDir    string              // used only if registerFlags got withRootDir arg
-	versions   ensure.VersionsFile // mutated by loadEnsureFile
+	serviceURL     string // also mutated by loadEnsureFile
+	cacheDir       string
+	rootDir        string              // used only if registerFlags got withRootDir arg
+	versions       ensure.VersionsFile // mutated by loadEnsureFile
+	trustedSigners []string            // mutated by loadEnsureFile
 
 	authFlags authcli.Flags
 }
@@ -361,6 +366,7 @@ func (opts *clientOptions) toCIPDClientOpts(ctx context.Context) (cipd.ClientOpt
 		Root:                opts.rootDir,
 		CacheDir:            opts.cacheDir,
 		Versions:            opts.versions,
+		TrustedSigners:      opts.trustedSigners,
 		AuthenticatedClient: client,
 		AnonymousClient:     http.DefaultClient,
 		LoginInstructions:   "run `cipd auth-login` to login or relogin",
@@ -429,6 +435,12 @@ type inputOptions struct {
 	//
 	// Default is 5.
 	compressionLevel int
+
+	// If true, build a package in the chunked format (zstd compressed chunks).
+	chunked bool
+
+	// If true, build a package in the reproducible mode.
+	reproducible bool
 }
 
 func (opts *inputOptions) registerFlags(f *flag.FlagSet) {
@@ -456,6 +468,12 @@ func (opts *inputOptions) registerFlags(f *flag.FlagSet) {
 	// Options for the builder.
 	f.IntVar(&opts.compressionLevel, "compression-level", 5,
 		"Deflate compression level [0-9]: 0 - disable, 1 - best speed, 9 - best compression.")
+	f.BoolVar(&opts.chunked, "chunked", false,
+		"Build the package in the chunked format: files are split into content-defined chunks compressed with zstd, "+
+			"allowing clients to fetch only changed chunks when updating. Such packages can't be installed by older clients.")
+	f.BoolVar(&opts.reproducible, "reproducible", false,
+		"Build the package in the reproducible mode: files are sorted, timestamps and non-executable permission bits "+
+			"are dropped, so identical inputs always produce the same instance ID.")
 }
 
 // prepareInput processes inputOptions by collecting all files to be added to
@@ -479,6 +497,13 @@ func (opts *inputOptions) prepareInput() (builder.Options, error) {
 			return empty, makeCLIError("-pkg-def and -in can not be used together")
 		}
 
+		if opts.reproducible && opts.preserveModTime {
+			return empty, makeCLIError("-preserve-mtime can't be used with -reproducible")
+		}
+		if opts.reproducible && opts.preserveWritable {
+			return empty, makeCLIError("-preserve-writable can't be used with -reproducible")
+		}
+
 		packageName, err := expandTemplate(opts.packageName)
 		if err != nil {
 			return empty, err
@@ -497,6 +522,8 @@ func (opts *inputOptions) prepareInput() (builder.Options, error) {
 			PackageName:      packageName,
 			InstallMode:      opts.installMode,
 			CompressionLevel: opts.compressionLevel,
+			Chunked:          opts.chunked,
+			Reproducible:     opts.reproducible,
 		}, nil
 	}
 
@@ -525,6 +552,9 @@ func (opts *inputOptions) prepareInput() (builder.Options, error) {
 		if err != nil {
 			return empty, err
 		}
+		if opts.reproducible && (pkgDef.PreserveModTime || pkgDef.PreserveWritable) {
+			return empty, makeCLIError("-reproducible can't be used with a package definition that preserves mtime or writable bit")
+		}
 
 		// Scan the file system. Package definition may use path relative to the
 		// package definition file itself, so pass its location.
@@ -539,6 +569,8 @@ func (opts *inputOptions) prepareInput() (builder.Options, error) {
 			VersionFile:      pkgDef.VersionFile(),
 			InstallMode:      pkgDef.InstallMode,
 			CompressionLevel: opts.compressionLevel,
+			Chunked:          opts.chunked,
+			Reproducible:     opts.reproducible || pkgDef.Reproducible,
 		}, nil
 	}
 
@@ -614,6 +646,76 @@ func (opts *tagsOptions) registerFlags(f *flag.FlagSet) {
 	f.Var(&opts.tags, "tag", "A tag to attach to the package instance (can be used multiple times).")
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// signingOptions mixin.
+
+// signingOptions defines command line options for commands that can sign
+// package instances.
+type signingOptions struct {
+	signingKey    string
+	signatureFile string // registered only by registerSignatureFileFlag
+}
+
+func (opts *signingOptions) registerFlags(f *flag.FlagSet) {
+	f.StringVar(&opts.signingKey, "signing-key", "",
+		"Path to a PEM file with a PKCS#8 ed25519 private key to sign the package instance with.")
+}
+
+func (opts *signingOptions) registerSignatureFileFlag(f *flag.FlagSet) {
+	f.StringVar(&opts.signatureFile, "signature-file", "",
+		"Path to a detached signature of the package instance, as produced by 'pkg-build -signing-key'.")
+}
+
+// sign signs the instance using the key passed via -signing-key.
+//
+// Returns nil if -signing-key wasn't used.
+func (opts *signingOptions) sign(pin common.Pin) (*signing.Signature, error) {
+	if opts.signingKey == "" {
+		return nil, nil
+	}
+	pem, err := ioutil.ReadFile(opts.signingKey)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read the signing key").Err()
+	}
+	key, err := signing.ParsePrivateKey(pem)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad signing key %q", opts.signingKey).Err()
+	}
+	return signing.Sign(key, pin)
+}
+
+// signatures returns metadata entries with signatures to attach to the
+// instance, based on -signing-key and -signature-file flags.
+func (opts *signingOptions) signatures(pin common.Pin) ([]cipd.Metadata, error) {
+	var sigs []*signing.Signature
+	switch sig, err := opts.sign(pin); {
+	case err != nil:
+		return nil, err
+	case sig != nil:
+		sigs = append(sigs, sig)
+	}
+	if opts.signatureFile != "" {
+		blob, err := ioutil.ReadFile(opts.signatureFile)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to read the signature file").Err()
+		}
+		sig, err := signing.ParseSignature(blob)
+		if err != nil {
+			return nil, errors.Annotate(err, "bad signature file %q", opts.signatureFile).Err()
+		}
+		sigs = append(sigs, sig)
+	}
+	md := make([]cipd.Metadata, len(sigs))
+	for i, sig := range sigs {
+		md[i] = cipd.Metadata{
+			Key:         signing.MetadataKey,
+			Value:       sig.Serialize(),
+			ContentType: signing.ContentType,
+		}
+	}
+	return md, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // uploadOptions mixin.
 
@@ -760,7 +862,7 @@ func (opts *ensureFileOptions) registerFlags(f *flag.FlagSet, out ensureOutFlag,
 }
 
 // loadEnsureFile parses the ensure file and mutates clientOpts to point to a
-// service URL specified in the ensure file.
+// service URL and to use trusted signers specified in the ensure file.
 func (opts *ensureFileOptions) loadEnsureFile(ctx context.Context, clientOpts *clientOptions, verifying verifyingEnsureFile, parseVers versionFileOpt) (*ensure.File, error) {
 	parsedFile, err := ensure.LoadEnsureFile(opts.ensureFile)
 	if err != nil {
@@ -776,6 +878,7 @@ func (opts *ensureFileOptions) loadEnsureFile(ctx context.Context, clientOpts *c
 		}
 		clientOpts.serviceURL = parsedFile.ServiceURL
 	}
+	clientOpts.trustedSigners = parsedFile.TrustedSigners
 
 	if verifying && len(parsedFile.VerifyPlatforms) == 0 {
 		logging.Errorf(ctx,
@@ -795,6 +898,88 @@ func (opts *ensureFileOptions) loadEnsureFile(ctx context.Context, clientOpts *c
 	return parsedFile, nil
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// sbomOptions mixin.
+
+// sbomOptions defines command line arguments for commands that can write
+// a software bill of materials describing the site root.
+type sbomOptions struct {
+	sbomOut    string
+	sbomFormat string
+}
+
+func (opts *sbomOptions) registerFlags(f *flag.FlagSet) {
+	f.StringVar(&opts.sbomOut, "sbom-out", "",
+		"A path to write a software bill of materials (SBOM) describing all deployed packages to.")
+	f.StringVar(&opts.sbomFormat, "sbom-format", string(sbom.SPDX),
+		fmt.Sprintf("Format of the -sbom-out document, either %q or %q.", sbom.SPDX, sbom.CycloneDX))
+}
+
+// validate checks the flags before the command does any work.
+func (opts *sbomOptions) validate() error {
+	if opts.sbomOut == "" {
+		return nil
+	}
+	if err := sbom.Format(opts.sbomFormat).Validate(); err != nil {
+		return makeCLIError("%s", err)
+	}
+	return nil
+}
+
+// maybeWrite writes the SBOM document if -sbom-out was given.
+func (opts *sbomOptions) maybeWrite(ctx context.Context, clientOpts clientOptions) error {
+	if opts.sbomOut == "" {
+		return nil
+	}
+	return writeSBOM(ctx, clientOpts, opts.sbomOut, sbom.Format(opts.sbomFormat))
+}
+
+// writeSBOM writes an SBOM document describing packages deployed into the site
+// root.
+//
+// Tags of deployed instances are fetched from the backend.
+func writeSBOM(ctx context.Context, clientOpts clientOptions, out string, format sbom.Format) error {
+	client, err := clientOpts.makeCIPDClient(ctx)
+	if err != nil {
+		return err
+	}
+	root, err := filepath.Abs(clientOpts.rootDir)
+	if err != nil {
+		return err
+	}
+
+	pkgs, err := sbom.Collect(ctx, deployer.New(root))
+	if err != nil {
+		return err
+	}
+	for i := range pkgs {
+		desc, err := client.DescribeInstance(ctx, pkgs[i].Pin, &cipd.DescribeInstanceOpts{DescribeTags: true})
+		if err != nil {
+			return errors.Annotate(err, "failed to fetch tags of %s", pkgs[i].Pin).Err()
+		}
+		for _, t := range desc.Tags {
+			pkgs[i].Tags = append(pkgs[i].Tags, t.Tag)
+		}
+	}
+
+	doc := &sbom.Document{
+		Name:        root,
+		Created:     clock.Now(ctx),
+		Tool:        "cipd",
+		ToolVersion: strings.TrimPrefix(cipd.UserAgent, "cipd "),
+		Packages:    pkgs,
+	}
+	buf := bytes.Buffer{}
+	if err := doc.Write(&buf, format); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(out, buf.Bytes(), 0666); err != nil {
+		return err
+	}
+	logging.Infof(ctx, "Wrote SBOM describing %d package(s) to %s", len(pkgs), out)
+	return nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Support for running operations concurrently.
 
@@ -1029,6 +1214,7 @@ func cmdCreate(params Parameters) *subcommands.Command {
 			c.Opts.clientOptions.registerFlags(&c.Flags, params, withoutRootDir)
 			c.Opts.uploadOptions.registerFlags(&c.Flags)
 			c.Opts.hashOptions.registerFlags(&c.Flags)
+			c.Opts.signingOptions.registerFlags(&c.Flags)
 			return c
 		},
 	}
@@ -1041,6 +1227,7 @@ type createOpts struct {
 	clientOptions
 	uploadOptions
 	hashOptions
+	signingOptions
 }
 
 type createRun struct {
@@ -1071,11 +1258,12 @@ func buildAndUploadInstance(ctx context.Context, opts *createOpts) (common.Pin,
 		return common.Pin{}, err
 	}
 	return registerInstanceFile(ctx, f.Name(), &pin, &registerOpts{
-		refsOptions:   opts.refsOptions,
-		tagsOptions:   opts.tagsOptions,
-		clientOptions: opts.clientOptions,
-		uploadOptions: opts.uploadOptions,
-		hashOptions:   opts.hashOptions,
+		refsOptions:    opts.refsOptions,
+		tagsOptions:    opts.tagsOptions,
+		clientOptions:  opts.clientOptions,
+		uploadOptions:  opts.uploadOptions,
+		hashOptions:    opts.hashOptions,
+		signingOptions: opts.signingOptions,
 	})
 }
 
@@ -1109,6 +1297,7 @@ For the full syntax of the ensure file, see:
 			c.clientOptions.registerFlags(&c.Flags, params, withRootDir)
 			c.ensureFileOptions.registerFlags(&c.Flags, withEnsureOutFlag, withLegacyListFlag)
 			c.deployOptions.registerFlags(&c.Flags)
+			c.sbomOptions.registerFlags(&c.Flags)
 			return c
 		},
 	}
@@ -1119,6 +1308,7 @@ type ensureRun struct {
 	clientOptions
 	ensureFileOptions
 	deployOptions
+	sbomOptions
 }
 
 func (c *ensureRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
@@ -1127,6 +1317,10 @@ func (c *ensureRun) Run(a subcommands.Application, args []string, env subcommand
 	}
 	ctx := cli.GetContext(a, c, env)
 
+	if err := c.sbomOptions.validate(); err != nil {
+		return c.done(nil, err)
+	}
+
 	maxThreads, err := c.loadMaxThreads(ctx)
 	if err != nil {
 		return c.done(nil, err)
@@ -1138,6 +1332,9 @@ func (c *ensureRun) Run(a subcommands.Application, args []string, env subcommand
 	}
 
 	pins, _, err := ensurePackages(ctx, ef, c.ensureFileOut, maxThreads, false, c.clientOptions)
+	if err == nil {
+		err = c.sbomOptions.maybeWrite(ctx, c.clientOptions)
+	}
 	return c.done(pins, err)
 }
 
@@ -1482,6 +1679,224 @@ func describeInstance(ctx context.Context, pkg, version string, clientOpts clien
 	return desc, nil
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'diff' subcommand.
+
+func cmdDiff(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "diff <package> <version1> <version2> | diff -versions <versions file 1> <versions file 2>",
+		ShortDesc: "compares files in two package instances",
+		LongDesc: "Compares files in two package instances.\n\n" +
+			"Fetches both instances and lists files that were added, removed or " +
+			"modified in the second one compared to the first one.\n\n" +
+			"With -versions flag compares two resolved versions files (as produced by " +
+			"'ensure-file-resolve') instead, listing packages that resolve to " +
+			"different instances. Add -files to also compare files of such packages.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &diffRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params, withoutRootDir)
+			c.Flags.BoolVar(&c.versions, "versions", false, "Compare two resolved versions files.")
+			c.Flags.BoolVar(&c.files, "files", false, "With -versions, also compare files of changed packages.")
+			return c
+		},
+	}
+}
+
+type diffRun struct {
+	cipdSubcommand
+	clientOptions
+
+	versions bool
+	files    bool
+}
+
+func (c *diffRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, c, env)
+	if c.versions {
+		if !c.checkArgs(args, 2, 2) {
+			return 1
+		}
+		return c.done(diffVersionsFiles(ctx, args[0], args[1], c.files, c.clientOptions))
+	}
+	if c.files {
+		return c.done(nil, makeCLIError("-files can only be used with -versions"))
+	}
+	if !c.checkArgs(args, 3, 3) {
+		return 1
+	}
+	pkg, err := expandTemplate(args[0])
+	if err != nil {
+		return c.done(nil, err)
+	}
+	return c.done(diffInstances(ctx, pkg, args[1], args[2], c.clientOptions))
+}
+
+// instancesDiff is the result of comparing two instances of a package.
+type instancesDiff struct {
+	Before  common.Pin    `json:"before"`
+	After   common.Pin    `json:"after"`
+	Changes []diff.Change `json:"changes"`
+}
+
+// versionsFileDiff is a change in a versions file, see diffVersionsFiles.
+type versionsFileDiff struct {
+	ensure.VersionChange
+	Files []diff.Change `json:"files,omitempty"`
+}
+
+func diffInstances(ctx context.Context, pkg, version1, version2 string, clientOpts clientOptions) (*instancesDiff, error) {
+	client, err := clientOpts.makeCIPDClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := client.ResolveVersion(ctx, pkg, version1)
+	if err != nil {
+		return nil, err
+	}
+	after, err := client.ResolveVersion(ctx, pkg, version2)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := diffPins(ctx, client, before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Comparing %s and %s:\n", before, after)
+	printFileChanges(changes, "  ")
+	return &instancesDiff{Before: before, After: after, Changes: changes}, nil
+}
+
+func diffVersionsFiles(ctx context.Context, path1, path2 string, files bool, clientOpts clientOptions) ([]versionsFileDiff, error) {
+	parse := func(path string) (ensure.VersionsFile, error) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return ensure.ParseVersionsFile(f)
+	}
+	v1, err := parse(path1)
+	if err != nil {
+		return nil, err
+	}
+	v2, err := parse(path2)
+	if err != nil {
+		return nil, err
+	}
+
+	var client cipd.Client
+	if files {
+		if client, err = clientOpts.makeCIPDClient(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := v1.Diff(v2)
+	out := make([]versionsFileDiff, len(changes))
+	for i, ch := range changes {
+		out[i].VersionChange = ch
+		switch {
+		case ch.BeforeID == "":
+			fmt.Printf("+ %s %s -> %s\n", ch.Package, ch.AfterVersion, ch.AfterID)
+		case ch.AfterID == "":
+			fmt.Printf("- %s %s -> %s\n", ch.Package, ch.BeforeVersion, ch.BeforeID)
+		default:
+			fmt.Printf("M %s %s -> %s => %s -> %s\n", ch.Package,
+				ch.BeforeVersion, ch.BeforeID, ch.AfterVersion, ch.AfterID)
+			if files {
+				out[i].Files, err = diffPins(ctx, client,
+					common.Pin{PackageName: ch.Package, InstanceID: ch.BeforeID},
+					common.Pin{PackageName: ch.Package, InstanceID: ch.AfterID})
+				if err != nil {
+					return nil, err
+				}
+				printFileChanges(out[i].Files, "    ")
+			}
+		}
+	}
+	if len(out) == 0 {
+		fmt.Println("No changes.")
+	}
+	return out, nil
+}
+
+// diffPins fetches two instances and compares their files.
+func diffPins(ctx context.Context, client cipd.Client, before, after common.Pin) ([]diff.Change, error) {
+	files1, err := listInstanceFiles(ctx, client, before)
+	if err != nil {
+		return nil, err
+	}
+	files2, err := listInstanceFiles(ctx, client, after)
+	if err != nil {
+		return nil, err
+	}
+	return diff.Files(files1, files2), nil
+}
+
+// listInstanceFiles fetches an instance into a temp file and lists files in it.
+func listInstanceFiles(ctx context.Context, client cipd.Client, pin common.Pin) ([]diff.File, error) {
+	tmp, err := ioutil.TempFile("", "cipd_diff")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+
+	err = client.FetchInstanceTo(ctx, pin, tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return listLocalInstanceFiles(ctx, tmp.Name(), pin.InstanceID) // FetchInstanceTo verified it
+}
+
+// listLocalInstanceFiles lists files in an instance file with a known ID.
+//
+// Doesn't verify the instance hash.
+func listLocalInstanceFiles(ctx context.Context, path, instanceID string) ([]diff.File, error) {
+	inst, err := reader.OpenInstanceFile(ctx, path, reader.OpenInstanceOpts{
+		VerificationMode: reader.SkipHashVerification,
+		InstanceID:       instanceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer inst.Close(ctx, false)
+	return diff.ListFiles(inst)
+}
+
+func printFileChanges(changes []diff.Change, indent string) {
+	if len(changes) == 0 {
+		fmt.Printf("%sNo changes.\n", indent)
+		return
+	}
+	describe := func(f *diff.File) string {
+		if f.Symlink != "" {
+			return fmt.Sprintf("symlink to %s", f.Symlink)
+		}
+		desc := fmt.Sprintf("%d bytes, sha256:%s", f.Size, f.Hash)
+		if f.Executable {
+			desc += ", +x"
+		}
+		return desc
+	}
+	for _, ch := range changes {
+		switch ch.Kind {
+		case diff.Added:
+			fmt.Printf("%s+ %s (%s)\n", indent, ch.Name, describe(ch.After))
+		case diff.Removed:
+			fmt.Printf("%s- %s (%s)\n", indent, ch.Name, describe(ch.Before))
+		case diff.Modified:
+			fmt.Printf("%sM %s (%s => %s)\n", indent, ch.Name, describe(ch.Before), describe(ch.After))
+		}
+	}
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'instances' subcommand.
 
@@ -1905,6 +2320,64 @@ func searchInstances(ctx context.Context, packageName string, tags []string, cli
 	return pins, err
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'search-files' subcommand.
+
+func cmdSearchFiles(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "search-files <glob> [options]",
+		ShortDesc: "searches for package instances that contain some file",
+		LongDesc: "Searches for package instances that contain files matching the glob.\n\n" +
+			"The glob is matched against slash-separated paths relative to the " +
+			"package root (e.g. \"bin/gn\" or \"*/gn\"). It must either start with " +
+			"a literal prefix or end with a literal file name.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &searchFilesRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params, withoutRootDir)
+			c.Flags.StringVar(&c.prefix, "prefix", "", "Search only packages under this prefix.")
+			c.Flags.IntVar(&c.limit, "limit", 100, "How many matches to return or 0 for all.")
+			return c
+		},
+	}
+}
+
+type searchFilesRun struct {
+	cipdSubcommand
+	clientOptions
+
+	prefix string
+	limit  int
+}
+
+func (c *searchFilesRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 1, 1) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.done(searchFiles(ctx, args[0], c.prefix, c.limit, c.clientOptions))
+}
+
+func searchFiles(ctx context.Context, glob, prefix string, limit int, clientOpts clientOptions) ([]cipd.FileMatch, error) {
+	client, err := clientOpts.makeCIPDClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := client.SearchFiles(ctx, glob, prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		fmt.Println("No matching files.")
+	} else {
+		fmt.Println("Files:")
+		for _, m := range matches {
+			fmt.Printf("  %s %s\n", m.Pin, m.Path)
+		}
+	}
+	return matches, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'acl-list' subcommand.
 
@@ -2181,7 +2654,9 @@ func cmdBuild() *subcommands.Command {
 			c.registerBaseFlags()
 			c.inputOptions.registerFlags(&c.Flags)
 			c.hashOptions.registerFlags(&c.Flags)
-			c.Flags.StringVar(&c.outputFile, "out", "<path>", "Path to a file to write the final package to.")
+			c.signingOptions.registerFlags(&c.Flags)
+			c.Flags.StringVar(&c.outputFile, "out", "<path>", "Path to a file to write the final package to. "+
+				"If -signing-key is used, the detached signature is written to <path>.sig.")
 			return c
 		},
 	}
@@ -2191,6 +2666,7 @@ type buildRun struct {
 	cipdSubcommand
 	inputOptions
 	hashOptions
+	signingOptions
 
 	outputFile string
 }
@@ -2200,10 +2676,18 @@ func (c *buildRun) Run(a subcommands.Application, args []string, env subcommands
 		return 1
 	}
 	ctx := cli.GetContext(a, c, env)
-	_, err := buildInstanceFile(ctx, c.outputFile, c.inputOptions, c.hashAlgo())
+	pin, err := buildInstanceFile(ctx, c.outputFile, c.inputOptions, c.hashAlgo())
 	if err != nil {
 		return c.done(nil, err)
 	}
+	switch sig, err := c.sign(pin); {
+	case err != nil:
+		return c.done(nil, err)
+	case sig != nil:
+		if err := ioutil.WriteFile(c.outputFile+".sig", sig.Serialize(), 0666); err != nil {
+			return c.done(nil, err)
+		}
+	}
 	return c.done(inspectInstanceFile(ctx, c.outputFile, c.hashAlgo(), false))
 }
 
@@ -2238,6 +2722,113 @@ func buildInstanceFile(ctx context.Context, instanceFile string, inputOpts input
 	return pin, nil
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'pkg-verify-reproducible' subcommand.
+
+func cmdVerifyReproducible(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		Advanced:  true,
+		UsageLine: "pkg-verify-reproducible [options]",
+		ShortDesc: "verifies a registered instance can be rebuilt from the same files",
+		LongDesc: "Rebuilds a package in the reproducible mode and compares the result to a registered instance.\n\n" +
+			"The registered instance must have been built in the reproducible mode as well, using the same " +
+			"-compression-level and -chunked flags. If instance IDs don't match, lists files that differ.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &verifyReproducibleRun{}
+			c.registerBaseFlags()
+			c.inputOptions.registerFlags(&c.Flags)
+			c.clientOptions.registerFlags(&c.Flags, params, withoutRootDir)
+			c.Flags.StringVar(&c.version, "version", "latest", "Version of the registered instance to compare to.")
+			return c
+		},
+	}
+}
+
+type verifyReproducibleRun struct {
+	cipdSubcommand
+	inputOptions
+	clientOptions
+
+	version string
+}
+
+func (c *verifyReproducibleRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.done(verifyReproducible(ctx, c.version, c.inputOptions, c.clientOptions))
+}
+
+// reproducibilityCheck is the result of pkg-verify-reproducible.
+type reproducibilityCheck struct {
+	Registered   common.Pin    `json:"registered"`
+	Rebuilt      common.Pin    `json:"rebuilt"`
+	Reproducible bool          `json:"reproducible"`
+	Changes      []diff.Change `json:"changes,omitempty"`
+}
+
+func verifyReproducible(ctx context.Context, version string, inputOpts inputOptions, clientOpts clientOptions) (*reproducibilityCheck, error) {
+	inputOpts.reproducible = true
+	buildOpts, err := inputOpts.prepareInput()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clientOpts.makeCIPDClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	registered, err := client.ResolveVersion(ctx, buildOpts.PackageName, version)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the same hash algo as the registered instance, otherwise instance IDs
+	// are never equal.
+	buildOpts.HashAlgo = common.InstanceIDToObjectRef(registered.InstanceID).HashAlgo
+
+	tmp, err := ioutil.TempFile("", "cipd_rebuilt")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	buildOpts.Output = tmp
+	rebuilt, err := builder.BuildInstance(ctx, buildOpts)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &reproducibilityCheck{
+		Registered:   registered,
+		Rebuilt:      rebuilt,
+		Reproducible: registered == rebuilt,
+	}
+	if res.Reproducible {
+		fmt.Printf("The rebuilt instance matches %s.\n", registered)
+		return res, nil
+	}
+
+	fmt.Printf("The rebuilt instance %s doesn't match %s:\n", rebuilt.InstanceID, registered)
+	before, err := listInstanceFiles(ctx, client, registered)
+	if err != nil {
+		return nil, err
+	}
+	after, err := listLocalInstanceFiles(ctx, tmp.Name(), rebuilt.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+	if res.Changes = diff.Files(before, after); len(res.Changes) == 0 {
+		fmt.Println("  Files are the same, the difference is in file metadata or compression settings.")
+	} else {
+		printFileChanges(res.Changes, "  ")
+	}
+	return res, errors.Reason("the rebuilt instance doesn't match the registered one").Err()
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'pkg-deploy' subcommand.
 
@@ -2482,6 +3073,8 @@ func cmdRegister(params Parameters) *subcommands.Command {
 			c.Opts.clientOptions.registerFlags(&c.Flags, params, withoutRootDir)
 			c.Opts.uploadOptions.registerFlags(&c.Flags)
 			c.Opts.hashOptions.registerFlags(&c.Flags)
+			c.Opts.signingOptions.registerFlags(&c.Flags)
+			c.Opts.signingOptions.registerSignatureFileFlag(&c.Flags)
 			return c
 		},
 	}
@@ -2493,6 +3086,7 @@ type registerOpts struct {
 	clientOptions
 	uploadOptions
 	hashOptions
+	signingOptions
 }
 
 type registerRun struct {
@@ -2528,6 +3122,12 @@ func registerInstanceFile(ctx context.Context, instanceFile string, knownPin *co
 	}
 	inspectPin(ctx, pin)
 
+	// Prepare signatures before uploading anything, to fail early on bad keys.
+	sigs, err := opts.signingOptions.signatures(pin)
+	if err != nil {
+		return common.Pin{}, err
+	}
+
 	client, err := opts.clientOptions.makeCIPDClient(ctx)
 	if err != nil {
 		return common.Pin{}, err
@@ -2537,6 +3137,10 @@ func registerInstanceFile(ctx context.Context, instanceFile string, knownPin *co
 	if err != nil {
 		return common.Pin{}, err
 	}
+	err = client.AttachMetadataWhenReady(ctx, pin, sigs)
+	if err != nil {
+		return common.Pin{}, err
+	}
 	err = client.AttachTagsWhenReady(ctx, pin, opts.tagsOptions.tags)
 	if err != nil {
 		return common.Pin{}, err
@@ -2862,11 +3466,14 @@ func cmdCheckDeployment(params Parameters) *subcommands.Command {
 		UsageLine: "deployment-check [options]",
 		ShortDesc: "verifies all files that are supposed to be installed are present",
 		LongDesc: "Compares CIPD package manifests stored in .cipd/* with what's on disk.\n\n" +
-			"Useful when debugging issues with broken installations.",
+			"Useful when debugging issues with broken installations.\n\n" +
+			"If -sbom-out is given and the deployment is intact, also writes a " +
+			"software bill of materials describing it.",
 		CommandRun: func() subcommands.CommandRun {
 			c := &checkDeploymentRun{}
 			c.registerBaseFlags()
 			c.clientOptions.registerFlags(&c.Flags, params, withRootDir)
+			c.sbomOptions.registerFlags(&c.Flags)
 			return c
 		},
 	}
@@ -2875,6 +3482,7 @@ func cmdCheckDeployment(params Parameters) *subcommands.Command {
 type checkDeploymentRun struct {
 	cipdSubcommand
 	clientOptions
+	sbomOptions
 }
 
 func (c *checkDeploymentRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
@@ -2882,7 +3490,14 @@ func (c *checkDeploymentRun) Run(a subcommands.Application, args []string, env s
 		return 1
 	}
 	ctx := cli.GetContext(a, c, env)
-	return c.done(checkDeployment(ctx, c.clientOptions))
+	if err := c.sbomOptions.validate(); err != nil {
+		return c.done(nil, err)
+	}
+	actions, err := checkDeployment(ctx, c.clientOptions)
+	if err == nil {
+		err = c.sbomOptions.maybeWrite(ctx, c.clientOptions)
+	}
+	return c.done(actions, err)
 }
 
 func checkDeployment(ctx context.Context, clientOpts clientOptions) (cipd.ActionMap, error) {
@@ -2946,6 +3561,113 @@ func repairDeployment(ctx context.Context, clientOpts clientOptions, maxThreads
 	return client.RepairDeployment(ctx, cipd.CheckIntegrity, maxThreads)
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'rollback' subcommand.
+
+func cmdRollback(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "rollback [options]",
+		ShortDesc: "restores packages installed before the last 'ensure'",
+		LongDesc: "Restores the previous complete set of packages in a site root.\n\n" +
+			"Each 'ensure' that changes something records the resulting set of " +
+			"packages in the site root metadata. This command switches the site root " +
+			"back to the set recorded before the current one. Previous instances are " +
+			"restored without fetching them, if they are still in the site root.\n\n" +
+			"Running 'rollback' twice in a row undoes the rollback. Instances that " +
+			"have to be fetched are fetched from the backend and checked against " +
+			"the trusted signers used by the 'ensure' that installed them.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &rollbackRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params, withRootDir)
+			c.deployOptions.registerFlags(&c.Flags)
+			return c
+		},
+	}
+}
+
+type rollbackRun struct {
+	cipdSubcommand
+	clientOptions
+	deployOptions
+}
+
+func (c *rollbackRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	maxThreads, err := c.loadMaxThreads(ctx)
+	if err != nil {
+		return c.done(nil, err)
+	}
+	return c.done(rollback(ctx, c.clientOptions, maxThreads))
+}
+
+func rollback(ctx context.Context, clientOpts clientOptions, maxThreads int) (cipd.ActionMap, error) {
+	// Instances of the previous generation may need to be refetched, the same
+	// way they were fetched by the ensure file that installed them. If they
+	// can't be, RollbackPackages refuses to roll back.
+	if gen, err := deployer.New(clientOpts.rootDir).Generations(ctx); err == nil && gen.Previous != nil {
+		if clientOpts.serviceURL == "" {
+			clientOpts.serviceURL = gen.Previous.ServiceURL
+		}
+		clientOpts.trustedSigners = gen.Previous.TrustedSigners
+	}
+	client, err := clientOpts.makeCIPDClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.RollbackPackages(ctx, maxThreads)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 'sbom' subcommand.
+
+func cmdSBOM(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		Advanced:  true,
+		UsageLine: "sbom -root <path> -out <path> [options]",
+		ShortDesc: "writes a software bill of materials describing a site root",
+		LongDesc: "Writes a software bill of materials (SBOM) describing all packages " +
+			"deployed into a site root.\n\n" +
+			"The document lists every deployed package instance, its tags and " +
+			"hashes of all files it installed. Package manifests are read from " +
+			".cipd/*, tags are fetched from the backend.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &sbomRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params, withRootDir)
+			c.Flags.StringVar(&c.out, "out", "", "A path to write the document to.")
+			c.Flags.StringVar(&c.format, "format", string(sbom.SPDX),
+				fmt.Sprintf("Format of the document, either %q or %q.", sbom.SPDX, sbom.CycloneDX))
+			return c
+		},
+	}
+}
+
+type sbomRun struct {
+	cipdSubcommand
+	clientOptions
+
+	out    string
+	format string
+}
+
+func (c *sbomRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	if c.out == "" {
+		return c.done(nil, makeCLIError("missing required flag: -out"))
+	}
+	if err := sbom.Format(c.format).Validate(); err != nil {
+		return c.done(nil, makeCLIError("%s", err))
+	}
+	return c.done(nil, writeSBOM(ctx, c.clientOptions, c.out, sbom.Format(c.format)))
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Main.
 
@@ -2996,9 +3718,11 @@ func GetApplication(params Parameters) *cli.Application {
 			{},
 			cmdListPackages(params),
 			cmdSearch(params),
+			cmdSearchFiles(params),
 			cmdResolve(params),
 			cmdDescribe(params),
 			cmdInstances(params),
+			cmdDiff(params),
 
 			// High level remote write commands.
 			{},
@@ -3009,6 +3733,7 @@ func GetApplication(params Parameters) *cli.Application {
 			// High level local write commands.
 			{},
 			cmdEnsure(params),
+			cmdRollback(params),
 			cmdSelfUpdate(params),
 			cmdSelfUpdateRoll(params),
 
@@ -3033,6 +3758,7 @@ func GetApplication(params Parameters) *cli.Application {
 			// Low level pkg-* commands.
 			{Advanced: true},
 			cmdBuild(),
+			cmdVerifyReproducible(params),
 			cmdDeploy(),
 			cmdFetch(params),
 			cmdInspect(),
@@ -3042,6 +3768,7 @@ func GetApplication(params Parameters) *cli.Application {
 			{Advanced: true},
 			cmdCheckDeployment(params),
 			cmdRepairDeployment(params),
+			cmdSBOM(params),
 
 			// Low level misc commands.
 			{Advanced: true},
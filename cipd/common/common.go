@@ -15,12 +15,17 @@
 package common
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"mime"
 	"path"
 	"regexp"
 	"sort"
 	"strings"
 
+	"github.com/golang/protobuf/ptypes"
+
 	"go.chromium.org/luci/auth/identity"
 	"go.chromium.org/luci/common/data/stringset"
 
@@ -54,8 +59,18 @@ var (
 
 	// packageRefRe is a regular expression for a ref.
 	packageRefRe = regexp.MustCompile(`^[a-z0-9_./\-]{1,256}$`)
+
+	// A regular expression for an instance metadata key.
+	metadataKeyReStr = `^[a-z0-9_\-]{1,400}$`
+	metadataKeyRe    = regexp.MustCompile(metadataKeyReStr)
+
+	// A regular expression for an instance metadata fingerprint.
+	metadataFingerprintRe = regexp.MustCompile(`^[0-9a-f]{32}$`)
 )
 
+// MetadataMaxLen is the maximum allowed length of an instance metadata value.
+const MetadataMaxLen = 512 * 1024
+
 // Pin uniquely identifies an instance of some package.
 type Pin struct {
 	PackageName string `json:"package"`
@@ -164,6 +179,64 @@ func JoinInstanceTag(t *api.Tag) string {
 	return t.Key + ":" + t.Value
 }
 
+// ValidateInstanceMetadataKey returns an error if the given string can't be
+// used as an instance metadata key.
+func ValidateInstanceMetadataKey(key string) error {
+	if !metadataKeyRe.MatchString(key) {
+		return fmt.Errorf("invalid metadata key %q (should match %q)", key, metadataKeyReStr)
+	}
+	return nil
+}
+
+// ValidateInstanceMetadataLen returns an error if the given metadata value is
+// too long.
+func ValidateInstanceMetadataLen(l int) error {
+	if l > MetadataMaxLen {
+		return fmt.Errorf("the metadata value is too long, should be <=%d bytes, got %d", MetadataMaxLen, l)
+	}
+	return nil
+}
+
+// ValidateContentType returns an error if the given string can't be used as
+// a content type of an instance metadata value.
+//
+// Empty string is fine.
+func ValidateContentType(ct string) error {
+	if ct == "" {
+		return nil
+	}
+	if len(ct) > 400 {
+		return fmt.Errorf("the content type is too long, should be <=400 chars: %q", ct)
+	}
+	if _, _, err := mime.ParseMediaType(ct); err != nil {
+		return fmt.Errorf("bad content type %q: %s", ct, err)
+	}
+	return nil
+}
+
+// InstanceMetadataFingerprint calculates a fingerprint of an instance metadata
+// entry.
+//
+// It is a hex-encoded SHA256 of "<key>:<value>" truncated to 16 bytes.
+//
+// Doesn't validate the key.
+func InstanceMetadataFingerprint(key string, value []byte) string {
+	h := sha256.New()
+	h.Write([]byte(key))
+	h.Write([]byte{':'})
+	h.Write(value)
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+// ValidateInstanceMetadataFingerprint returns an error if the given string
+// doesn't look like an instance metadata fingerprint.
+func ValidateInstanceMetadataFingerprint(fp string) error {
+	if !metadataFingerprintRe.MatchString(fp) {
+		return fmt.Errorf("bad metadata fingerprint %q, should be 32 lowercase hex chars", fp)
+	}
+	return nil
+}
+
 // ValidateInstanceVersion return error if a string can't be used as version.
 //
 // A version can be specified as:
@@ -203,6 +276,52 @@ func ValidateSubdir(subdir string) error {
 	return nil
 }
 
+// ValidateFileGlob returns an error if the string can't be used as a glob when
+// searching for files inside packages.
+//
+// The glob uses path.Match syntax and is matched against slash-separated file
+// paths relative to the package root. To be searchable it must either have
+// a literal (wildcard-free) last path component or a non-empty literal prefix,
+// e.g. "bin/gn", "*/gn" or "bin/*".
+func ValidateFileGlob(glob string) error {
+	if glob == "" {
+		return fmt.Errorf("bad file glob: empty")
+	}
+	if strings.Contains(glob, "\\") {
+		return fmt.Errorf(`bad file glob: backslashes not allowed (use "/"): %q`, glob)
+	}
+	if strings.HasPrefix(glob, "/") {
+		return fmt.Errorf("bad file glob: absolute paths not allowed: %q", glob)
+	}
+	if _, err := path.Match(glob, ""); err != nil {
+		return fmt.Errorf("bad file glob %q: %s", glob, err)
+	}
+	if prefix, base := FileGlobLiterals(glob); prefix == "" && base == "" {
+		return fmt.Errorf("bad file glob %q: should either start with a literal prefix or end with a literal file name", glob)
+	}
+	return nil
+}
+
+// FileGlobLiterals returns wildcard-free parts of a file glob.
+//
+// 'prefix' is the part of the glob before the first wildcard character (or
+// the entire glob if it has no wildcards). 'base' is the last path component
+// of the glob if it has no wildcards, or an empty string otherwise.
+//
+// Doesn't validate the glob.
+func FileGlobLiterals(glob string) (prefix, base string) {
+	const wildcards = `*?[\`
+	if idx := strings.IndexAny(glob, wildcards); idx != -1 {
+		prefix = glob[:idx]
+	} else {
+		prefix = glob
+	}
+	if b := path.Base(glob); !strings.ContainsAny(b, wildcards) && b != "." && b != "/" {
+		base = b
+	}
+	return
+}
+
 // ValidatePrincipalName validates strings used to identify principals in ACLs.
 //
 // The expected format is "<key>:<value>" pair, where <key> is one of "group",
@@ -223,8 +342,9 @@ func ValidatePrincipalName(p string) error {
 // NormalizePrefixMetadata validates and normalizes the prefix metadata proto.
 //
 // Updates r.Prefix in-place by stripping trailing '/', sorts r.Acls and
-// principals lists inside them. Skips r.Fingerprint, r.UpdateTime and
-// r.UpdateUser, since they are always overridden on the server side.
+// principals lists inside them, removes empty r.RetentionPolicy. Skips
+// r.Fingerprint, r.UpdateTime and r.UpdateUser, since they are always
+// overridden on the server side.
 func NormalizePrefixMetadata(m *api.PrefixMetadata) error {
 	var err error
 	if m.Prefix, err = ValidatePackagePrefix(m.Prefix); err != nil {
@@ -264,6 +384,24 @@ func NormalizePrefixMetadata(m *api.PrefixMetadata) error {
 		m.Acls[i] = perRole[api.Role(role)]
 	}
 
+	// An empty retention policy is the same as no policy at all.
+	if p := m.RetentionPolicy; p != nil {
+		if p.KeepLastUntagged < 0 {
+			return fmt.Errorf("bad retention policy - keep_last_untagged should be non-negative")
+		}
+		if p.MaxUnreferencedAge != nil {
+			switch age, err := ptypes.Duration(p.MaxUnreferencedAge); {
+			case err != nil:
+				return fmt.Errorf("bad retention policy - bad max_unreferenced_age - %s", err)
+			case age <= 0:
+				return fmt.Errorf("bad retention policy - max_unreferenced_age should be positive")
+			}
+		}
+		if p.KeepLastUntagged == 0 && p.MaxUnreferencedAge == nil {
+			m.RetentionPolicy = nil
+		}
+	}
+
 	return nil
 }
 
@@ -19,6 +19,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/golang/protobuf/ptypes/duration"
+
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -145,6 +147,38 @@ func TestParseInstanceTag(t *testing.T) {
 	})
 }
 
+func TestInstanceMetadata(t *testing.T) {
+	t.Parallel()
+
+	Convey("ValidateInstanceMetadataKey works", t, func() {
+		So(ValidateInstanceMetadataKey("good-key_1"), ShouldBeNil)
+		So(ValidateInstanceMetadataKey(""), ShouldNotBeNil)
+		So(ValidateInstanceMetadataKey("BAD"), ShouldNotBeNil)
+		So(ValidateInstanceMetadataKey("bad:key"), ShouldNotBeNil)
+		So(ValidateInstanceMetadataKey(strings.Repeat("z", 401)), ShouldNotBeNil)
+	})
+
+	Convey("ValidateInstanceMetadataLen works", t, func() {
+		So(ValidateInstanceMetadataLen(MetadataMaxLen), ShouldBeNil)
+		So(ValidateInstanceMetadataLen(MetadataMaxLen+1), ShouldNotBeNil)
+	})
+
+	Convey("ValidateContentType works", t, func() {
+		So(ValidateContentType(""), ShouldBeNil)
+		So(ValidateContentType("application/json"), ShouldBeNil)
+		So(ValidateContentType("text/plain; charset=utf-8"), ShouldBeNil)
+		So(ValidateContentType("zzz zzz"), ShouldNotBeNil)
+	})
+
+	Convey("InstanceMetadataFingerprint works", t, func() {
+		fp := InstanceMetadataFingerprint("key", []byte("value"))
+		So(fp, ShouldEqual, "06dd3884aa86b22603764bd7e5b0b41e")
+		So(ValidateInstanceMetadataFingerprint(fp), ShouldBeNil)
+		So(InstanceMetadataFingerprint("key", []byte("another")), ShouldNotEqual, fp)
+		So(ValidateInstanceMetadataFingerprint("zzz"), ShouldNotBeNil)
+	})
+}
+
 func TestValidatePin(t *testing.T) {
 	t.Parallel()
 
@@ -179,6 +213,34 @@ func TestValidateInstanceVersion(t *testing.T) {
 	})
 }
 
+func TestValidateFileGlob(t *testing.T) {
+	t.Parallel()
+
+	Convey("ValidateFileGlob works", t, func() {
+		So(ValidateFileGlob("bin/gn"), ShouldBeNil)
+		So(ValidateFileGlob("*/gn"), ShouldBeNil)
+		So(ValidateFileGlob("bin/*"), ShouldBeNil)
+		So(ValidateFileGlob("gn*"), ShouldBeNil)
+		So(ValidateFileGlob(""), ShouldErrLike, "empty")
+		So(ValidateFileGlob("/bin/gn"), ShouldErrLike, "absolute paths not allowed")
+		So(ValidateFileGlob(`bin\gn`), ShouldErrLike, "backslashes not allowed")
+		So(ValidateFileGlob("bin/[a"), ShouldErrLike, "syntax error in pattern")
+		So(ValidateFileGlob("*/gn*"), ShouldErrLike, "should either start with a literal prefix")
+	})
+
+	Convey("FileGlobLiterals works", t, func() {
+		split := func(glob string) []string {
+			prefix, base := FileGlobLiterals(glob)
+			return []string{prefix, base}
+		}
+		So(split("bin/gn"), ShouldResemble, []string{"bin/gn", "gn"})
+		So(split("*/gn"), ShouldResemble, []string{"", "gn"})
+		So(split("bin/*.exe"), ShouldResemble, []string{"bin/", ""})
+		So(split("bin/g?"), ShouldResemble, []string{"bin/g", ""})
+		So(split("*"), ShouldResemble, []string{"", ""})
+	})
+}
+
 func TestValidateSubdir(t *testing.T) {
 	t.Parallel()
 
@@ -312,6 +374,25 @@ func TestNormalizePrefixMetadata(t *testing.T) {
 			},
 		}), ShouldErrLike, `in ACL entry for role READER - ":" doesn't look like principal id`)
 	})
+
+	Convey("Retention policy", t, func() {
+		m := &api.PrefixMetadata{
+			Prefix:          "abc",
+			RetentionPolicy: &api.RetentionPolicy{KeepLastUntagged: 5},
+		}
+		So(NormalizePrefixMetadata(m), ShouldBeNil)
+		So(m.RetentionPolicy, ShouldResembleProto, &api.RetentionPolicy{KeepLastUntagged: 5})
+
+		m.RetentionPolicy = &api.RetentionPolicy{}
+		So(NormalizePrefixMetadata(m), ShouldBeNil)
+		So(m.RetentionPolicy, ShouldBeNil)
+
+		m.RetentionPolicy = &api.RetentionPolicy{KeepLastUntagged: -1}
+		So(NormalizePrefixMetadata(m), ShouldErrLike, "keep_last_untagged should be non-negative")
+
+		m.RetentionPolicy = &api.RetentionPolicy{MaxUnreferencedAge: &duration.Duration{}}
+		So(NormalizePrefixMetadata(m), ShouldErrLike, "max_unreferenced_age should be positive")
+	})
 }
 
 func TestPinToString(t *testing.T) {
@@ -0,0 +1,396 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/server/router"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// storageImpl implements cipd.StorageServer and HTTP endpoints to upload and
+// download objects.
+type storageImpl struct {
+	api.UnimplementedStorageServer
+
+	root   string      // the server root directory
+	state  *stateStore // the repository state
+	caller string      // identity of the caller
+
+	uploadsM sync.Mutex // serializes writes to upload files
+}
+
+// objectPath returns a path to a file with the object in the CAS.
+func (s *storageImpl) objectPath(ref *api.ObjectRef) string {
+	return filepath.Join(s.root, "cas", ref.HashAlgo.String(), ref.HexDigest)
+}
+
+// uploadPath returns a path to a file with the data being uploaded.
+func (s *storageImpl) uploadPath(opID string) string {
+	return filepath.Join(s.root, "uploads", opID)
+}
+
+// hasObject is true if the object is in the CAS.
+func (s *storageImpl) hasObject(ref *api.ObjectRef) (bool, error) {
+	switch _, err := os.Stat(s.objectPath(ref)); {
+	case os.IsNotExist(err):
+		return false, nil
+	case err != nil:
+		return false, errors.Annotate(err, "failed to check the object's presence").Err()
+	default:
+		return true, nil
+	}
+}
+
+// GetObjectURL implements the corresponding RPC method, see the proto doc.
+func (s *storageImpl) GetObjectURL(ctx context.Context, r *api.GetObjectURLRequest) (resp *api.ObjectURL, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := common.ValidateObjectRef(r.Object, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'object' field - %s", err)
+	}
+	if strings.ContainsAny(r.DownloadFilename, "\"\r\n") {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'download_filename' field, contains one of %q", "\"\r\n")
+	}
+
+	switch yes, err := s.hasObject(r.Object); {
+	case err != nil:
+		return nil, err
+	case !yes:
+		return nil, status.Errorf(codes.NotFound, "no such object")
+	}
+
+	u := fmt.Sprintf("%s/cas/%s/%s", baseURL(ctx), r.Object.HashAlgo, r.Object.HexDigest)
+	if r.DownloadFilename != "" {
+		u += "?filename=" + url.QueryEscape(r.DownloadFilename)
+	}
+	return &api.ObjectURL{SignedUrl: u}, nil
+}
+
+// BeginUpload implements the corresponding RPC method, see the proto doc.
+func (s *storageImpl) BeginUpload(ctx context.Context, r *api.BeginUploadRequest) (resp *api.UploadOperation, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	// Either Object or HashAlgo should be given. If both are, algos must match.
+	op := &uploadEntry{
+		Status:    api.UploadStatus_UPLOADING,
+		CreatedBy: s.caller,
+		CreatedTs: clock.Now(ctx).UTC(),
+	}
+	if r.Object != nil {
+		if err := common.ValidateObjectRef(r.Object, common.KnownHash); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad 'object' - %s", err)
+		}
+		if r.HashAlgo != 0 && r.HashAlgo != r.Object.HashAlgo {
+			return nil, status.Errorf(codes.InvalidArgument, "'hash_algo' and 'object.hash_algo' do not match")
+		}
+		op.HashAlgo = r.Object.HashAlgo
+		op.HexDigest = r.Object.HexDigest
+	} else if err := common.ValidateHashAlgo(r.HashAlgo); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'hash_algo' - %s", err)
+	} else {
+		op.HashAlgo = r.HashAlgo
+	}
+
+	// Skip the upload if we already have the object.
+	if r.Object != nil {
+		switch yes, err := s.hasObject(r.Object); {
+		case err != nil:
+			return nil, err
+		case yes:
+			return nil, status.Errorf(codes.AlreadyExists, "the object is already in the store")
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.uploadPath("x")), 0700); err != nil {
+		return nil, errors.Annotate(err, "failed to create the uploads directory").Err()
+	}
+
+	var opID string
+	err = s.state.update(func(st *state) error {
+		st.LastOpID++
+		opID = fmt.Sprintf("%d", st.LastOpID)
+		st.Uploads[opID] = op
+		f, err := os.Create(s.uploadPath(opID))
+		if err != nil {
+			return errors.Annotate(err, "failed to create the upload file").Err()
+		}
+		return f.Close()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.opProto(ctx, opID, op), nil
+}
+
+// FinishUpload implements the corresponding RPC method, see the proto doc.
+func (s *storageImpl) FinishUpload(ctx context.Context, r *api.FinishUploadRequest) (resp *api.UploadOperation, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if r.ForceHash != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "usage of 'force_hash' is forbidden")
+	}
+
+	err = s.state.update(func(st *state) error {
+		op := st.Uploads[r.UploadOperationId]
+		switch {
+		case op == nil:
+			return status.Errorf(codes.NotFound, "no such upload operation")
+		case op.Status != api.UploadStatus_UPLOADING:
+			// Nothing to do if the operation is already closed.
+			resp = s.opProto(ctx, r.UploadOperationId, op)
+			return nil
+		}
+
+		// Verify the hash right away and move the file into the CAS. Verification
+		// failures are communicated through the operation status.
+		switch digest, err := s.publishUpload(r.UploadOperationId, op); {
+		case err != nil:
+			op.Status = api.UploadStatus_ERRORED
+			op.ErrorMessage = err.Error()
+		default:
+			op.Status = api.UploadStatus_PUBLISHED
+			op.HexDigest = digest
+		}
+		resp = s.opProto(ctx, r.UploadOperationId, op)
+		return nil
+	})
+	return resp, err
+}
+
+// publishUpload verifies the hash of the uploaded file and moves it to the
+// CAS.
+//
+// Returns the hex digest of the file.
+func (s *storageImpl) publishUpload(opID string, op *uploadEntry) (string, error) {
+	s.uploadsM.Lock()
+	defer s.uploadsM.Unlock()
+
+	path := s.uploadPath(opID)
+	f, err := os.Open(path)
+	if err != nil {
+		return "", errors.Annotate(err, "failed to open the uploaded file").Err()
+	}
+	h := common.MustNewHash(op.HashAlgo)
+	_, err = io.Copy(h, f)
+	f.Close()
+	if err != nil {
+		return "", errors.Annotate(err, "failed to read the uploaded file").Err()
+	}
+
+	digest := common.HexDigest(h)
+	if op.HexDigest != "" && op.HexDigest != digest {
+		os.Remove(path)
+		return "", errors.Reason("expected %s to be %s, got %s", op.HashAlgo, op.HexDigest, digest).Err()
+	}
+
+	dest := s.objectPath(&api.ObjectRef{HashAlgo: op.HashAlgo, HexDigest: digest})
+	if err := os.MkdirAll(filepath.Dir(dest), 0700); err != nil {
+		return "", errors.Annotate(err, "failed to create the CAS directory").Err()
+	}
+	if err := os.Rename(path, dest); err != nil {
+		return "", errors.Annotate(err, "failed to move the uploaded file to the CAS").Err()
+	}
+	return digest, nil
+}
+
+// CancelUpload implements the corresponding RPC method, see the proto doc.
+func (s *storageImpl) CancelUpload(ctx context.Context, r *api.CancelUploadRequest) (resp *api.UploadOperation, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	err = s.state.update(func(st *state) error {
+		op := st.Uploads[r.UploadOperationId]
+		switch {
+		case op == nil:
+			return status.Errorf(codes.NotFound, "no such upload operation")
+		case op.Status == api.UploadStatus_UPLOADING:
+			s.uploadsM.Lock()
+			os.Remove(s.uploadPath(r.UploadOperationId))
+			s.uploadsM.Unlock()
+			op.Status = api.UploadStatus_CANCELED
+		case op.Status != api.UploadStatus_ERRORED && op.Status != api.UploadStatus_CANCELED:
+			return status.Errorf(codes.FailedPrecondition, "the operation is in state %s and can't be canceled", op.Status)
+		}
+		resp = s.opProto(ctx, r.UploadOperationId, op)
+		return nil
+	})
+	return resp, err
+}
+
+// opProto converts an upload operation to the proto.
+func (s *storageImpl) opProto(ctx context.Context, opID string, op *uploadEntry) *api.UploadOperation {
+	out := &api.UploadOperation{
+		OperationId:  opID,
+		Status:       op.Status,
+		Object:       op.objectRef(),
+		ErrorMessage: op.ErrorMessage,
+	}
+	if op.Status == api.UploadStatus_UPLOADING {
+		out.UploadUrl = fmt.Sprintf("%s/upload/%s", baseURL(ctx), opID)
+	}
+	return out
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// HTTP endpoints.
+
+// downloadHandler serves GET /cas/:algo/:digest.
+//
+// Supports Range requests.
+func (s *storageImpl) downloadHandler(c *router.Context) {
+	algo := api.HashAlgo(api.HashAlgo_value[c.Params.ByName("algo")])
+	ref := &api.ObjectRef{HashAlgo: algo, HexDigest: c.Params.ByName("digest")}
+	if err := common.ValidateObjectRef(ref, common.KnownHash); err != nil {
+		http.Error(c.Writer, fmt.Sprintf("Bad object reference - %s", err), http.StatusBadRequest)
+		return
+	}
+
+	f, err := os.Open(s.objectPath(ref))
+	switch {
+	case os.IsNotExist(err):
+		http.Error(c.Writer, "No such object", http.StatusNotFound)
+		return
+	case err != nil:
+		replyErr(c, err)
+		return
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		replyErr(c, err)
+		return
+	}
+
+	if name := c.Request.URL.Query().Get("filename"); name != "" {
+		c.Writer.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+	}
+	c.Writer.Header().Set("Content-Type", "application/octet-stream")
+	http.ServeContent(c.Writer, c.Request, "", st.ModTime(), f)
+}
+
+// uploadHandler serves PUT /upload/:op.
+//
+// Implements a subset of Google Storage resumable upload protocol used by the
+// CIPD client: each request either uploads a range of bytes (with
+// "Content-Range: bytes <first>-<last>/<total>" header) or asks for the number
+// of bytes uploaded so far ("Content-Range: bytes */<total>"). The server
+// replies with HTTP 308 if the upload is still incomplete, or HTTP 200 when all
+// data is uploaded.
+func (s *storageImpl) uploadHandler(c *router.Context) {
+	opID := c.Params.ByName("op")
+
+	var opStatus api.UploadStatus
+	s.state.view(func(st *state) error {
+		if op := st.Uploads[opID]; op != nil {
+			opStatus = op.Status
+		}
+		return nil
+	})
+	switch opStatus {
+	case api.UploadStatus_UPLOADING:
+	case 0:
+		http.Error(c.Writer, "No such upload operation", http.StatusNotFound)
+		return
+	default:
+		http.Error(c.Writer, fmt.Sprintf("The upload operation is in state %s", opStatus), http.StatusGone)
+		return
+	}
+
+	var first, last, total int64
+	rng := c.Request.Header.Get("Content-Range")
+	query := false
+	if _, err := fmt.Sscanf(rng, "bytes */%d", &total); err == nil {
+		query = true
+	} else if _, err := fmt.Sscanf(rng, "bytes %d-%d/%d", &first, &last, &total); err != nil || first < 0 || last+1 < first || last >= total {
+		http.Error(c.Writer, fmt.Sprintf("Bad Content-Range header %q", rng), http.StatusBadRequest)
+		return
+	}
+
+	s.uploadsM.Lock()
+	defer s.uploadsM.Unlock()
+
+	f, err := os.OpenFile(s.uploadPath(opID), os.O_WRONLY, 0600)
+	if err != nil {
+		replyErr(c, err)
+		return
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		replyErr(c, err)
+		return
+	}
+	size := st.Size()
+
+	if !query {
+		if first > size {
+			http.Error(c.Writer, fmt.Sprintf("Non-contiguous upload, have only %d bytes", size), http.StatusBadRequest)
+			return
+		}
+		// Overwrite whatever was uploaded past 'first' already.
+		if err := f.Truncate(first); err != nil {
+			replyErr(c, err)
+			return
+		}
+		if _, err := f.Seek(first, io.SeekStart); err != nil {
+			replyErr(c, err)
+			return
+		}
+		n, err := io.Copy(f, io.LimitReader(c.Request.Body, last-first+1))
+		size = first + n
+		if err == nil && size != last+1 {
+			err = errors.Reason("expected %d bytes, got %d", last-first+1, n).Err()
+		}
+		if err != nil {
+			logging.WithError(err).Warningf(c.Context, "Failed to receive uploaded data")
+			http.Error(c.Writer, fmt.Sprintf("Failed to receive the data - %s", err), http.StatusServiceUnavailable)
+			return
+		}
+	}
+
+	if size == total {
+		c.Writer.WriteHeader(http.StatusOK)
+		return
+	}
+	if size > 0 {
+		c.Writer.Header().Set("Range", fmt.Sprintf("bytes=0-%d", size-1))
+	}
+	c.Writer.WriteHeader(http.StatusPermanentRedirect)
+}
+
+// replyErr logs the error and replies with HTTP 500.
+func replyErr(c *router.Context, err error) {
+	logging.WithError(err).Errorf(c.Context, "HTTP request failed")
+	http.Error(c.Writer, fmt.Sprintf("Internal server error - %s", err), http.StatusInternalServerError)
+}
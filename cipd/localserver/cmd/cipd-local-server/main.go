@@ -0,0 +1,88 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command cipd-local-server runs a CIPD backend that keeps its state in a local
+// directory.
+//
+// It is intended for offline development and hermetic tests. Point the CIPD
+// client to it via -service-url flag (or CIPD_SERVICE_URL env var), e.g.:
+//
+//    cipd-local-server -root /tmp/cipd-repo -listen 127.0.0.1:8080
+//    cipd ensure -service-url http://127.0.0.1:8080 ...
+//
+// See go.chromium.org/luci/cipd/localserver for the limitations.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/logging/gologger"
+	"go.chromium.org/luci/common/system/signals"
+	"go.chromium.org/luci/server/router"
+
+	"go.chromium.org/luci/cipd/localserver"
+)
+
+var (
+	root   = flag.String("root", "", "A directory to keep the repository in (required).")
+	listen = flag.String("listen", "127.0.0.1:8080", "An address to listen on.")
+	caller = flag.String("caller", string(identity.AnonymousIdentity), "An identity to attribute all requests to.")
+)
+
+func main() {
+	flag.Parse()
+
+	ctx := gologger.StdConfig.Use(context.Background())
+	if err := run(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "cipd-local-server: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	if *root == "" {
+		return fmt.Errorf("-root is required")
+	}
+
+	srv, err := localserver.New(*root, identity.Identity(*caller))
+	if err != nil {
+		return err
+	}
+
+	r := router.NewWithRootContext(ctx)
+	srv.InstallHandlers(r, router.NewMiddlewareChain())
+
+	l, err := net.Listen("tcp", *listen)
+	if err != nil {
+		return err
+	}
+	httpSrv := &http.Server{Handler: r}
+	defer signals.HandleInterrupt(func() {
+		logging.Infof(ctx, "Shutting down...")
+		httpSrv.Shutdown(ctx)
+	})()
+
+	logging.Infof(ctx, "Serving the repository in %s at http://%s", *root, l.Addr())
+	if err := httpSrv.Serve(l); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package localserver implements a standalone CIPD backend that keeps all its
+// state on the local file system.
+//
+// It serves cipd.Repository and cipd.Storage pRPC services (see
+// cipd/api/cipd/v1), as well as HTTP endpoints used to upload and download
+// package files. It speaks the same protocol as the real CIPD backend, so the
+// regular CIPD client works with it unchanged. This is useful for offline
+// development and for hermetic tests that need a CIPD repository.
+//
+// The state is stored in a directory:
+//
+//    <root>/state.json - refs, tags, metadata, ACLs and pending uploads.
+//    <root>/cas/<HashAlgo>/<hex digest> - package files.
+//    <root>/uploads/<operation ID> - package files being uploaded.
+//
+// Limitations (compared to the real backend):
+//   * There's no authentication. All requests are assumed to be coming from
+//     the same caller (specified when launching the server).
+//   * ACLs are stored and returned, but not enforced. The caller is an OWNER
+//     of all prefixes.
+//   * There are no post-registration processors. Instances are ready right
+//     after they are registered.
+//   * DescribeClient RPC is not implemented.
+//   * The entire state is kept in memory and rewritten on each mutation, so
+//     it is not suitable for large repositories.
+package localserver
@@ -0,0 +1,216 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localserver
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.chromium.org/luci/server/router"
+
+	"go.chromium.org/luci/cipd/client/cipd"
+	"go.chromium.org/luci/cipd/client/cipd/builder"
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func buildInstance(name, body string) ([]byte, common.Pin) {
+	out := bytes.Buffer{}
+	pin, err := builder.BuildInstance(context.Background(), builder.Options{
+		Input:       []fs.File{fs.NewTestFile("file", body, fs.TestFileOpts{})},
+		Output:      &out,
+		PackageName: name,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return out.Bytes(), pin
+}
+
+func TestWithClient(t *testing.T) {
+	t.Parallel()
+
+	Convey("With a local server", t, func() {
+		ctx := context.Background()
+
+		tmp, err := ioutil.TempDir("", "cipd_localserver")
+		So(err, ShouldBeNil)
+		Reset(func() { os.RemoveAll(tmp) })
+
+		repoDir := filepath.Join(tmp, "repo")
+		serve := func() (cipd.Client, func()) {
+			srv, err := New(repoDir, "user:someone@example.com")
+			So(err, ShouldBeNil)
+			r := router.New()
+			srv.InstallHandlers(r, router.NewMiddlewareChain())
+			ts := httptest.NewServer(r)
+			client, err := cipd.NewClient(cipd.ClientOptions{
+				ServiceURL: ts.URL,
+				Root:       filepath.Join(tmp, "site"),
+			})
+			So(err, ShouldBeNil)
+			return client, ts.Close
+		}
+
+		client, stop := serve()
+		Reset(func() { stop() })
+
+		blob, pin := buildInstance("a/b/pkg", "body 1")
+		So(client.RegisterInstance(ctx, pin, bytes.NewReader(blob), 0), ShouldBeNil)
+
+		Convey("Registration is idempotent", func() {
+			So(client.RegisterInstance(ctx, pin, bytes.NewReader(blob), 0), ShouldBeNil)
+			pins, err := client.ListInstances(ctx, "a/b/pkg")
+			So(err, ShouldBeNil)
+			list, err := pins.Next(ctx, 100)
+			So(err, ShouldBeNil)
+			So(list, ShouldHaveLength, 1)
+			So(list[0].Pin, ShouldResemble, pin)
+			So(list[0].RegisteredBy, ShouldEqual, "user:someone@example.com")
+		})
+
+		Convey("Refs and tags", func() {
+			So(client.SetRefWhenReady(ctx, "latest", pin), ShouldBeNil)
+			So(client.AttachTagsWhenReady(ctx, pin, []string{"version:1"}), ShouldBeNil)
+
+			resolved, err := client.ResolveVersion(ctx, "a/b/pkg", "latest")
+			So(err, ShouldBeNil)
+			So(resolved, ShouldResemble, pin)
+
+			resolved, err = client.ResolveVersion(ctx, "a/b/pkg", "version:1")
+			So(err, ShouldBeNil)
+			So(resolved, ShouldResemble, pin)
+
+			_, err = client.ResolveVersion(ctx, "a/b/pkg", "version:2")
+			So(err, ShouldErrLike, "no such tag")
+			_, err = client.ResolveVersion(ctx, "a/b/another", "latest")
+			So(err, ShouldErrLike, "no such package")
+
+			found, err := client.SearchInstances(ctx, "a/b/pkg", []string{"version:1"})
+			So(err, ShouldBeNil)
+			So(found, ShouldResemble, common.PinSlice{pin})
+
+			desc, err := client.DescribeInstance(ctx, pin, &cipd.DescribeInstanceOpts{
+				DescribeRefs: true,
+				DescribeTags: true,
+			})
+			So(err, ShouldBeNil)
+			So(desc.Refs, ShouldHaveLength, 1)
+			So(desc.Refs[0].Ref, ShouldEqual, "latest")
+			So(desc.Tags, ShouldHaveLength, 1)
+			So(desc.Tags[0].Tag, ShouldEqual, "version:1")
+
+			Convey("Moving refs", func() {
+				blob2, pin2 := buildInstance("a/b/pkg", "body 2")
+				So(client.RegisterInstance(ctx, pin2, bytes.NewReader(blob2), 0), ShouldBeNil)
+				So(client.SetRefWhenReady(ctx, "latest", pin2), ShouldBeNil)
+
+				resolved, err := client.ResolveVersion(ctx, "a/b/pkg", "latest")
+				So(err, ShouldBeNil)
+				So(resolved, ShouldResemble, pin2)
+			})
+
+			Convey("The state survives restarts", func() {
+				stop()
+				client, stop = serve()
+
+				resolved, err := client.ResolveVersion(ctx, "a/b/pkg", "version:1")
+				So(err, ShouldBeNil)
+				So(resolved, ShouldResemble, pin)
+			})
+		})
+
+		Convey("Fetching and installing", func() {
+			out := &fetchBuffer{}
+			So(client.FetchInstanceTo(ctx, pin, out), ShouldBeNil)
+			So(out.Bytes(), ShouldResemble, blob)
+
+			_, err := client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {pin}}, cipd.CheckPresence, 1, false)
+			So(err, ShouldBeNil)
+			body, err := ioutil.ReadFile(filepath.Join(tmp, "site", "file"))
+			So(err, ShouldBeNil)
+			So(string(body), ShouldEqual, "body 1")
+		})
+
+		Convey("Listing", func() {
+			blob2, pin2 := buildInstance("a/c", "body")
+			So(client.RegisterInstance(ctx, pin2, bytes.NewReader(blob2), 0), ShouldBeNil)
+
+			pkgs, err := client.ListPackages(ctx, "a", false, false)
+			So(err, ShouldBeNil)
+			So(pkgs, ShouldResemble, []string{"a/b/", "a/c"})
+
+			pkgs, err = client.ListPackages(ctx, "", true, false)
+			So(err, ShouldBeNil)
+			So(pkgs, ShouldResemble, []string{"a/", "a/b/", "a/b/pkg", "a/c"})
+		})
+
+		Convey("ACLs", func() {
+			So(client.ModifyACL(ctx, "a/b", []cipd.PackageACLChange{
+				{Action: cipd.GrantRole, Role: "READER", Principal: "group:all"},
+			}), ShouldBeNil)
+
+			acls, err := client.FetchACL(ctx, "a/b/pkg")
+			So(err, ShouldBeNil)
+			So(acls, ShouldHaveLength, 1)
+			So(acls[0].PackagePath, ShouldEqual, "a/b")
+			So(acls[0].Role, ShouldEqual, "READER")
+			So(acls[0].Principals, ShouldResemble, []string{"group:all"})
+
+			roles, err := client.FetchRoles(ctx, "a/b")
+			So(err, ShouldBeNil)
+			So(roles, ShouldResemble, []string{"READER", "WRITER", "OWNER"})
+		})
+	})
+}
+
+// fetchBuffer is an in-memory io.WriteSeeker.
+type fetchBuffer struct {
+	buf []byte
+	pos int64
+}
+
+func (b *fetchBuffer) Write(p []byte) (int, error) {
+	if end := b.pos + int64(len(p)); end > int64(len(b.buf)) {
+		b.buf = append(b.buf, make([]byte, end-int64(len(b.buf)))...)
+	}
+	copy(b.buf[b.pos:], p)
+	b.pos += int64(len(p))
+	return len(p), nil
+}
+
+func (b *fetchBuffer) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case 0:
+		b.pos = offset
+	case 1:
+		b.pos += offset
+	case 2:
+		b.pos = int64(len(b.buf)) + offset
+	}
+	return b.pos, nil
+}
+
+func (b *fetchBuffer) Bytes() []byte {
+	return b.buf
+}
@@ -0,0 +1,1134 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localserver
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// repoImpl implements cipd.RepositoryServer on top of the local state.
+type repoImpl struct {
+	api.UnimplementedRepositoryServer
+
+	state  *stateStore  // the repository state
+	cas    *storageImpl // the storage with package files
+	caller string       // identity of the caller
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Prefix metadata RPC methods.
+
+// GetPrefixMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) GetPrefixMetadata(c context.Context, r *api.PrefixRequest) (resp *api.PrefixMetadata, err error) {
+	inherited, err := impl.GetInheritedPrefixMetadata(c, r)
+	if err != nil {
+		return nil, err
+	}
+	// Have the metadata for the requested prefix? It should be the last if so.
+	if m := inherited.PerPrefixMetadata; len(m) != 0 && m[len(m)-1].Prefix == r.Prefix {
+		return m[len(m)-1], nil
+	}
+	return nil, noMetadataErr(r.Prefix)
+}
+
+// GetInheritedPrefixMetadata implements the corresponding RPC method, see the
+// proto doc.
+//
+// Note: it normalizes Prefix field inside the request.
+func (impl *repoImpl) GetInheritedPrefixMetadata(c context.Context, r *api.PrefixRequest) (resp *api.InheritedPrefixMetadata, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	r.Prefix, err = common.ValidatePackagePrefix(r.Prefix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'prefix' - %s", err)
+	}
+
+	resp = &api.InheritedPrefixMetadata{}
+	impl.state.view(func(st *state) error {
+		for _, pfx := range parentPrefixes(r.Prefix) {
+			if ent := st.Prefixes[pfx]; ent != nil {
+				resp.PerPrefixMetadata = append(resp.PerPrefixMetadata, ent.proto(pfx))
+			}
+		}
+		return nil
+	})
+	return resp, nil
+}
+
+// UpdatePrefixMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) UpdatePrefixMetadata(c context.Context, r *api.PrefixMetadata) (resp *api.PrefixMetadata, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Fill in server-assigned fields.
+	r.UpdateTime = google.NewTimestamp(clock.Now(c))
+	r.UpdateUser = impl.caller
+
+	// Normalize and validate format of the PrefixMetadata.
+	if err := common.NormalizePrefixMetadata(r); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad prefix metadata - %s", err)
+	}
+
+	// The root metadata is not modifiable through API.
+	if r.Prefix == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "the root metadata is not modifiable")
+	}
+
+	err = impl.state.update(func(st *state) error {
+		var curFingerprint string
+		if cur := st.Prefixes[r.Prefix]; cur != nil {
+			curFingerprint = cur.Fingerprint
+		}
+		if curFingerprint != r.Fingerprint {
+			switch {
+			case curFingerprint == "":
+				return noMetadataErr(r.Prefix)
+			case r.Fingerprint == "":
+				return status.Errorf(
+					codes.AlreadyExists, "metadata for prefix %q already exists and has fingerprint %q, "+
+						"use combination of GetPrefixMetadata and UpdatePrefixMetadata to "+
+						"update it", r.Prefix, curFingerprint)
+			default:
+				return status.Errorf(
+					codes.FailedPrecondition, "metadata for prefix %q was updated concurrently "+
+						"(the fingerprint in the request %q doesn't match the current fingerprint %q), "+
+						"fetch new metadata with GetPrefixMetadata and reapply your "+
+						"modifications", r.Prefix, r.Fingerprint, curFingerprint)
+			}
+		}
+
+		resp = proto.Clone(r).(*api.PrefixMetadata)
+		resp.Fingerprint = prefixMetadataFingerprint(resp)
+		ent := &prefixEntry{
+			Fingerprint: resp.Fingerprint,
+			UpdateTime:  google.TimeFromProto(resp.UpdateTime).UTC(),
+			UpdateUser:  resp.UpdateUser,
+		}
+		for _, acl := range resp.Acls {
+			ent.ACLs = append(ent.ACLs, &aclEntry{Role: acl.Role, Principals: acl.Principals})
+		}
+		st.Prefixes[r.Prefix] = ent
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetRolesInPrefix implements the corresponding RPC method, see the proto doc.
+//
+// ACLs are not enforced, so the caller always has all roles.
+func (impl *repoImpl) GetRolesInPrefix(c context.Context, r *api.PrefixRequest) (resp *api.RolesInPrefixResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	if _, err := common.ValidatePackagePrefix(r.Prefix); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'prefix' - %s", err)
+	}
+
+	resp = &api.RolesInPrefixResponse{}
+	for r := api.Role_READER; r <= api.Role_OWNER; r++ {
+		resp.Roles = append(resp.Roles, &api.RolesInPrefixResponse_RoleInPrefix{Role: r})
+	}
+	return resp, nil
+}
+
+// noMetadataErr produces a grpc error saying that the given prefix doesn't have
+// metadata attached.
+func noMetadataErr(prefix string) error {
+	return status.Errorf(codes.NotFound, "prefix %q has no metadata", prefix)
+}
+
+// parentPrefixes returns ["a", "a/b", "a/b/c"] for "a/b/c".
+func parentPrefixes(prefix string) (out []string) {
+	if prefix == "" {
+		return nil
+	}
+	chunks := strings.Split(prefix, "/")
+	for i := 1; i <= len(chunks); i++ {
+		out = append(out, strings.Join(chunks[:i], "/"))
+	}
+	return
+}
+
+// prefixMetadataFingerprint derives a fingerprint string for the given
+// metadata.
+//
+// It is base64-encoded SHA1 digest of the serialized proto (excluding
+// 'fingerprint' field itself), same as the real backend uses.
+func prefixMetadataFingerprint(m *api.PrefixMetadata) string {
+	m = proto.Clone(m).(*api.PrefixMetadata)
+	m.Fingerprint = ""
+	blob, err := proto.Marshal(m)
+	if err != nil {
+		panic(fmt.Sprintf("failed to proto-marshal PrefixMetadata for prefix %q - %s", m.Prefix, err))
+	}
+	h := sha1.New()
+	h.Write([]byte("PrefixMetadata:"))
+	h.Write(blob)
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
+// proto returns PrefixMetadata proto for the prefix.
+func (e *prefixEntry) proto(prefix string) *api.PrefixMetadata {
+	out := &api.PrefixMetadata{
+		Prefix:      prefix,
+		Fingerprint: e.Fingerprint,
+		UpdateTime:  google.NewTimestamp(e.UpdateTime),
+		UpdateUser:  e.UpdateUser,
+	}
+	for _, acl := range e.ACLs {
+		out.Acls = append(out.Acls, &api.PrefixMetadata_ACL{
+			Role:       acl.Role,
+			Principals: acl.Principals,
+		})
+	}
+	return out
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Prefix listing.
+
+// ListPrefix implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) ListPrefix(c context.Context, r *api.ListPrefixRequest) (resp *api.ListPrefixResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	r.Prefix, err = common.ValidatePackagePrefix(r.Prefix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'prefix' - %s", err)
+	}
+
+	// searchRoot is lexicographical prefix of packages we are concerned about.
+	searchRoot := r.Prefix
+	if searchRoot != "" {
+		searchRoot += "/"
+	}
+
+	var pkgs []string
+	impl.state.view(func(st *state) error {
+		for name, pkg := range st.Packages {
+			if strings.HasPrefix(name, searchRoot) && (r.IncludeHidden || !pkg.Hidden) {
+				pkgs = append(pkgs, name)
+			}
+		}
+		return nil
+	})
+	sort.Strings(pkgs)
+
+	resp = &api.ListPrefixResponse{}
+	dirs := stringset.New(0)
+	for _, pkg := range pkgs {
+		// E.g. "a/b/c", relative to searchRoot.
+		rel := strings.TrimPrefix(pkg, searchRoot)
+		if r.Recursive {
+			// If listing recursively, add everything to the result.
+			resp.Packages = append(resp.Packages, pkg)
+			// For rel "a/b/c", add [".../a", ".../a/b"] to the directories set.
+			if chunks := strings.Split(rel, "/"); len(chunks) > 1 {
+				for i := 1; i < len(chunks); i++ {
+					dirs.Add(searchRoot + strings.Join(chunks[:i], "/"))
+				}
+			}
+		} else {
+			// Otherwise add only packages that directly reside under searchRoot,
+			// and pick only first path component of directories.
+			if idx := strings.IndexRune(rel, '/'); idx != -1 {
+				dirs.Add(searchRoot + rel[:idx])
+			} else {
+				resp.Packages = append(resp.Packages, pkg)
+			}
+		}
+	}
+
+	resp.Prefixes = dirs.ToSlice()
+	sort.Strings(resp.Prefixes)
+	return resp, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Hide/unhide and deletion of packages.
+
+// HidePackage implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) HidePackage(c context.Context, r *api.PackageRequest) (*empty.Empty, error) {
+	return impl.setPackageHidden(c, r, true)
+}
+
+// UnhidePackage implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) UnhidePackage(c context.Context, r *api.PackageRequest) (*empty.Empty, error) {
+	return impl.setPackageHidden(c, r, false)
+}
+
+// setPackageHidden is common implementation of HidePackage and UnhidePackage.
+func (impl *repoImpl) setPackageHidden(c context.Context, r *api.PackageRequest, hidden bool) (resp *empty.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+
+	err = impl.state.update(func(st *state) error {
+		pkg, err := st.pkg(r.Package)
+		if err != nil {
+			return err
+		}
+		pkg.Hidden = hidden
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeletePackage implements the corresponding RPC method, see the proto doc.
+//
+// Package files are left in the storage.
+func (impl *repoImpl) DeletePackage(c context.Context, r *api.PackageRequest) (resp *empty.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+
+	err = impl.state.update(func(st *state) error {
+		if _, err := st.pkg(r.Package); err != nil {
+			return err
+		}
+		delete(st.Packages, r.Package)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Package instance registration.
+
+// RegisterInstance implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) RegisterInstance(c context.Context, r *api.Instance) (resp *api.RegisterInstanceResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request format.
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateObjectRef(r.Instance, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+	iid := common.ObjectRefToInstanceID(r.Instance)
+
+	// Is such instance already registered?
+	impl.state.view(func(st *state) error {
+		if _, inst, _ := st.instance(r.Package, r.Instance); inst != nil {
+			resp = &api.RegisterInstanceResponse{
+				Status:   api.RegistrationStatus_ALREADY_REGISTERED,
+				Instance: inst.proto(r.Package, iid),
+			}
+		}
+		return nil
+	})
+	if resp != nil {
+		return resp, nil
+	}
+
+	// Attempt to start a new upload session. This will fail with ALREADY_EXISTS
+	// if such object is already in the storage.
+	uploadOp, err := impl.cas.BeginUpload(c, &api.BeginUploadRequest{
+		Object: r.Instance,
+	})
+	switch code := grpc.Code(err); {
+	case code == codes.AlreadyExists:
+		break // the object is already there
+	case code == codes.OK:
+		return &api.RegisterInstanceResponse{
+			Status:   api.RegistrationStatus_NOT_UPLOADED,
+			UploadOp: uploadOp,
+		}, nil
+	default:
+		return nil, errors.Annotate(err, "failed to initiate an upload op (code %s)", code).Err()
+	}
+
+	// The instance is already in the storage. Register it in the repository.
+	err = impl.state.update(func(st *state) error {
+		pkg := st.Packages[r.Package]
+		if pkg == nil {
+			pkg = &packageEntry{
+				Instances: map[string]*instanceEntry{},
+				Refs:      map[string]*refEntry{},
+			}
+			st.Packages[r.Package] = pkg
+		}
+		resp = &api.RegisterInstanceResponse{Status: api.RegistrationStatus_ALREADY_REGISTERED}
+		inst := pkg.Instances[iid]
+		if inst == nil {
+			resp.Status = api.RegistrationStatus_REGISTERED
+			inst = &instanceEntry{
+				RegisteredBy: impl.caller,
+				RegisteredTs: clock.Now(c).UTC(),
+			}
+			pkg.Instances[iid] = inst
+		}
+		resp.Instance = inst.proto(r.Package, iid)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Instance listing and querying.
+
+// paginate returns a page of the sorted list of instances of a package and
+// the token for the next page.
+func (impl *repoImpl) paginate(c context.Context, pkgName, pageToken string, pageSize int32, filter func(*instanceEntry) bool) (out []*api.Instance, nextTok string, err error) {
+	if err := common.ValidatePackageName(pkgName); err != nil {
+		return nil, "", status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+
+	switch {
+	case pageSize < 0:
+		return nil, "", status.Errorf(codes.InvalidArgument, "bad 'page_size' %d - it should be non-negative", pageSize)
+	case pageSize == 0:
+		pageSize = 100
+	}
+
+	offset := 0
+	if pageToken != "" {
+		if offset, err = strconv.Atoi(pageToken); err != nil || offset < 0 {
+			return nil, "", status.Errorf(codes.InvalidArgument, "bad 'page_token' - not a valid page token")
+		}
+	}
+
+	err = impl.state.view(func(st *state) error {
+		pkg, err := st.pkg(pkgName)
+		if err != nil {
+			return err
+		}
+		for _, iid := range pkg.sortedInstances() {
+			if inst := pkg.Instances[iid]; filter == nil || filter(inst) {
+				out = append(out, inst.proto(pkgName, iid))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if offset >= len(out) {
+		return nil, "", nil
+	}
+	out = out[offset:]
+	if len(out) > int(pageSize) {
+		out = out[:pageSize]
+		nextTok = strconv.Itoa(offset + int(pageSize))
+	}
+	return
+}
+
+// ListInstances implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) ListInstances(c context.Context, r *api.ListInstancesRequest) (resp *api.ListInstancesResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	result, nextPage, err := impl.paginate(c, r.Package, r.PageToken, r.PageSize, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ListInstancesResponse{
+		Instances:     result,
+		NextPageToken: nextPage,
+	}, nil
+}
+
+// SearchInstances implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) SearchInstances(c context.Context, r *api.SearchInstancesRequest) (resp *api.SearchInstancesResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	if err := validateTagList(r.Tags); err != nil {
+		return nil, err
+	}
+	tags := make([]string, len(r.Tags))
+	for i, t := range r.Tags {
+		tags[i] = common.JoinInstanceTag(t)
+	}
+
+	result, nextPage, err := impl.paginate(c, r.Package, r.PageToken, r.PageSize, func(inst *instanceEntry) bool {
+		for _, t := range tags {
+			if inst.tag(t) == nil {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.SearchInstancesResponse{
+		Instances:     result,
+		NextPageToken: nextPage,
+	}, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Refs support.
+
+// CreateRef implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) CreateRef(c context.Context, r *api.Ref) (resp *empty.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageRef(r.Name); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'name' - %s", err)
+	}
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateObjectRef(r.Instance, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+
+	err = impl.state.update(func(st *state) error {
+		pkg, _, err := st.instance(r.Package, r.Instance)
+		if err != nil {
+			return err
+		}
+		iid := common.ObjectRefToInstanceID(r.Instance)
+		if ref := pkg.Refs[r.Name]; ref == nil || ref.InstanceID != iid {
+			pkg.Refs[r.Name] = &refEntry{
+				InstanceID: iid,
+				ModifiedBy: impl.caller,
+				ModifiedTs: clock.Now(c).UTC(),
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteRef implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) DeleteRef(c context.Context, r *api.DeleteRefRequest) (resp *empty.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageRef(r.Name); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'name' - %s", err)
+	}
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+
+	err = impl.state.update(func(st *state) error {
+		pkg, err := st.pkg(r.Package)
+		if err != nil {
+			return err
+		}
+		delete(pkg.Refs, r.Name)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// ListRefs implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) ListRefs(c context.Context, r *api.ListRefsRequest) (resp *api.ListRefsResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+
+	resp = &api.ListRefsResponse{}
+	err = impl.state.view(func(st *state) error {
+		pkg, err := st.pkg(r.Package)
+		if err != nil {
+			return err
+		}
+		resp.Refs = pkg.refs(r.Package, "")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Tags support.
+
+func validateTagList(tags []*api.Tag) error {
+	if len(tags) == 0 {
+		return status.Errorf(codes.InvalidArgument, "bad 'tags' - cannot be empty")
+	}
+	for _, t := range tags {
+		kv := common.JoinInstanceTag(t)
+		if err := common.ValidateInstanceTag(kv); err != nil {
+			return status.Errorf(codes.InvalidArgument, "bad tag in 'tags' - %s", err)
+		}
+	}
+	return nil
+}
+
+func validateMultiTagReq(pkg string, inst *api.ObjectRef, tags []*api.Tag) error {
+	if err := common.ValidatePackageName(pkg); err != nil {
+		return status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateObjectRef(inst, common.KnownHash); err != nil {
+		return status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+	return validateTagList(tags)
+}
+
+// AttachTags implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) AttachTags(c context.Context, r *api.AttachTagsRequest) (resp *empty.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	if err := validateMultiTagReq(r.Package, r.Instance, r.Tags); err != nil {
+		return nil, err
+	}
+
+	err = impl.state.update(func(st *state) error {
+		_, inst, err := st.instance(r.Package, r.Instance)
+		if err != nil {
+			return err
+		}
+		now := clock.Now(c).UTC()
+		for _, t := range r.Tags {
+			kv := common.JoinInstanceTag(t)
+			if inst.tag(kv) == nil {
+				inst.Tags = append(inst.Tags, &tagEntry{
+					Tag:        kv,
+					AttachedBy: impl.caller,
+					AttachedTs: now,
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// DetachTags implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) DetachTags(c context.Context, r *api.DetachTagsRequest) (resp *empty.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	if err := validateMultiTagReq(r.Package, r.Instance, r.Tags); err != nil {
+		return nil, err
+	}
+
+	err = impl.state.update(func(st *state) error {
+		_, inst, err := st.instance(r.Package, r.Instance)
+		if err != nil {
+			return err
+		}
+		detach := stringset.New(len(r.Tags))
+		for _, t := range r.Tags {
+			detach.Add(common.JoinInstanceTag(t))
+		}
+		filtered := inst.Tags[:0]
+		for _, t := range inst.Tags {
+			if !detach.Has(t.Tag) {
+				filtered = append(filtered, t)
+			}
+		}
+		inst.Tags = filtered
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Instance metadata support.
+
+func validateMetadataList(md []*api.InstanceMetadata, detach bool) error {
+	if len(md) == 0 {
+		return status.Errorf(codes.InvalidArgument, "bad 'metadata' - cannot be empty")
+	}
+	for _, m := range md {
+		if detach && m.Fingerprint != "" {
+			if m.Key != "" || len(m.Value) != 0 {
+				return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - either 'fingerprint' or 'key' and 'value' should be set, not both")
+			}
+			if err := common.ValidateInstanceMetadataFingerprint(m.Fingerprint); err != nil {
+				return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - %s", err)
+			}
+			continue
+		}
+		if err := common.ValidateInstanceMetadataKey(m.Key); err != nil {
+			return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - %s", err)
+		}
+		if err := common.ValidateInstanceMetadataLen(len(m.Value)); err != nil {
+			return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - %s", err)
+		}
+		if !detach {
+			if err := common.ValidateContentType(m.ContentType); err != nil {
+				return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - %s", err)
+			}
+		}
+	}
+	return nil
+}
+
+// AttachMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) AttachMetadata(c context.Context, r *api.AttachMetadataRequest) (resp *empty.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateObjectRef(r.Instance, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+	if err := validateMetadataList(r.Metadata, false); err != nil {
+		return nil, err
+	}
+
+	err = impl.state.update(func(st *state) error {
+		_, inst, err := st.instance(r.Package, r.Instance)
+		if err != nil {
+			return err
+		}
+		now := clock.Now(c).UTC()
+		for _, m := range r.Metadata {
+			fp := common.InstanceMetadataFingerprint(m.Key, m.Value)
+			if inst.metadata(fp) == nil {
+				inst.Metadata = append(inst.Metadata, &metadataEntry{
+					Key:         m.Key,
+					Value:       m.Value,
+					ContentType: m.ContentType,
+					Fingerprint: fp,
+					AttachedBy:  impl.caller,
+					AttachedTs:  now,
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// DetachMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) DetachMetadata(c context.Context, r *api.DetachMetadataRequest) (resp *empty.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateObjectRef(r.Instance, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+	if err := validateMetadataList(r.Metadata, true); err != nil {
+		return nil, err
+	}
+
+	err = impl.state.update(func(st *state) error {
+		_, inst, err := st.instance(r.Package, r.Instance)
+		if err != nil {
+			return err
+		}
+		detach := stringset.New(len(r.Metadata))
+		for _, m := range r.Metadata {
+			fp := m.Fingerprint
+			if fp == "" {
+				fp = common.InstanceMetadataFingerprint(m.Key, m.Value)
+			}
+			detach.Add(fp)
+		}
+		filtered := inst.Metadata[:0]
+		for _, m := range inst.Metadata {
+			if !detach.Has(m.Fingerprint) {
+				filtered = append(filtered, m)
+			}
+		}
+		inst.Metadata = filtered
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// ListMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) ListMetadata(c context.Context, r *api.ListMetadataRequest) (resp *api.ListMetadataResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateObjectRef(r.Instance, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+	for _, k := range r.Keys {
+		if err := common.ValidateInstanceMetadataKey(k); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad key in 'keys' - %s", err)
+		}
+	}
+
+	var md []*metadataEntry
+	err = impl.state.view(func(st *state) error {
+		_, inst, err := st.instance(r.Package, r.Instance)
+		if err != nil {
+			return err
+		}
+		filter := stringset.NewFromSlice(r.Keys...)
+		for _, m := range inst.Metadata {
+			if filter.Len() == 0 || filter.Has(m.Key) {
+				md = append(md, m)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Most recent first, then by key.
+	sort.Slice(md, func(i, j int) bool {
+		if !md[i].AttachedTs.Equal(md[j].AttachedTs) {
+			return md[i].AttachedTs.After(md[j].AttachedTs)
+		}
+		if md[i].Key != md[j].Key {
+			return md[i].Key < md[j].Key
+		}
+		return md[i].Fingerprint < md[j].Fingerprint
+	})
+	resp = &api.ListMetadataResponse{
+		Metadata: make([]*api.InstanceMetadata, len(md)),
+	}
+	for i, m := range md {
+		resp.Metadata[i] = m.proto()
+	}
+	return resp, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Version resolution and instance info fetching.
+
+// ResolveVersion implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) ResolveVersion(c context.Context, r *api.ResolveVersionRequest) (resp *api.Instance, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateInstanceVersion(r.Version); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'version' - %s", err)
+	}
+
+	err = impl.state.view(func(st *state) error {
+		pkg, err := st.pkg(r.Package)
+		if err != nil {
+			return err
+		}
+
+		// Pick a resolution method based on the format of the version string.
+		var iid string
+		switch {
+		case common.ValidateInstanceID(r.Version, common.KnownHash) == nil:
+			iid = r.Version
+		case common.ValidatePackageRef(r.Version) == nil:
+			ref := pkg.Refs[r.Version]
+			if ref == nil {
+				return status.Errorf(codes.NotFound, "no such ref")
+			}
+			iid = ref.InstanceID
+		default:
+			var found []string
+			for id, inst := range pkg.Instances {
+				if inst.tag(r.Version) != nil {
+					found = append(found, id)
+				}
+			}
+			switch len(found) {
+			case 0:
+				return status.Errorf(codes.NotFound, "no such tag")
+			case 1:
+				iid = found[0]
+			default:
+				return status.Errorf(codes.FailedPrecondition, "ambiguity when resolving the tag, more than one instance has it")
+			}
+		}
+
+		inst := pkg.Instances[iid]
+		if inst == nil {
+			return status.Errorf(codes.NotFound, "no such instance")
+		}
+		resp = inst.proto(r.Package, iid)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetInstanceURL implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) GetInstanceURL(c context.Context, r *api.GetInstanceURLRequest) (resp *api.ObjectURL, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateObjectRef(r.Instance, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+
+	// Make sure this instance actually exists.
+	err = impl.state.view(func(st *state) error {
+		_, _, err := st.instance(r.Package, r.Instance)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return impl.cas.GetObjectURL(c, &api.GetObjectURLRequest{
+		Object: r.Instance,
+	})
+}
+
+// DescribeInstance implements the corresponding RPC method, see the proto doc.
+//
+// There are no processors, so 'describe_processors' is ignored.
+func (impl *repoImpl) DescribeInstance(c context.Context, r *api.DescribeInstanceRequest) (resp *api.DescribeInstanceResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateObjectRef(r.Instance, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+
+	err = impl.state.view(func(st *state) error {
+		pkg, inst, err := st.instance(r.Package, r.Instance)
+		if err != nil {
+			return err
+		}
+		iid := common.ObjectRefToInstanceID(r.Instance)
+		resp = &api.DescribeInstanceResponse{Instance: inst.proto(r.Package, iid)}
+		if r.DescribeRefs {
+			resp.Refs = pkg.refs(r.Package, iid)
+		}
+		if r.DescribeTags {
+			resp.Tags = inst.tags()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DescribeClient implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) DescribeClient(c context.Context, r *api.DescribeClientRequest) (resp *api.DescribeClientResponse, err error) {
+	return nil, status.Errorf(codes.Unimplemented, "DescribeClient is not supported by the local server")
+}
+
+// SearchFiles implements the corresponding RPC method, see the proto doc.
+//
+// The local server doesn't index package files.
+func (impl *repoImpl) SearchFiles(c context.Context, r *api.SearchFilesRequest) (resp *api.SearchFilesResponse, err error) {
+	return nil, status.Errorf(codes.Unimplemented, "SearchFiles is not supported by the local server")
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// State helpers.
+
+// pkg returns a registered package or NotFound error.
+func (st *state) pkg(name string) (*packageEntry, error) {
+	if pkg := st.Packages[name]; pkg != nil {
+		return pkg, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "no such package: %s", name)
+}
+
+// instance returns a registered instance or NotFound error.
+func (st *state) instance(pkgName string, ref *api.ObjectRef) (*packageEntry, *instanceEntry, error) {
+	pkg, err := st.pkg(pkgName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if inst := pkg.Instances[common.ObjectRefToInstanceID(ref)]; inst != nil {
+		return pkg, inst, nil
+	}
+	return nil, nil, status.Errorf(codes.NotFound, "no such instance")
+}
+
+// sortedInstances returns IDs of all instances, most recently registered
+// first.
+func (pkg *packageEntry) sortedInstances() []string {
+	out := make([]string, 0, len(pkg.Instances))
+	for iid := range pkg.Instances {
+		out = append(out, iid)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		ti, tj := pkg.Instances[out[i]].RegisteredTs, pkg.Instances[out[j]].RegisteredTs
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return out[i] < out[j]
+	})
+	return out
+}
+
+// refs returns refs of the package (or only ones pointing to the given
+// instance, if 'iid' is not empty), most recently modified first.
+func (pkg *packageEntry) refs(pkgName, iid string) (out []*api.Ref) {
+	names := make([]string, 0, len(pkg.Refs))
+	for name, ref := range pkg.Refs {
+		if iid == "" || ref.InstanceID == iid {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		ti, tj := pkg.Refs[names[i]].ModifiedTs, pkg.Refs[names[j]].ModifiedTs
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return names[i] < names[j]
+	})
+	for _, name := range names {
+		ref := pkg.Refs[name]
+		out = append(out, &api.Ref{
+			Name:       name,
+			Package:    pkgName,
+			Instance:   common.InstanceIDToObjectRef(ref.InstanceID),
+			ModifiedBy: ref.ModifiedBy,
+			ModifiedTs: google.NewTimestamp(ref.ModifiedTs),
+		})
+	}
+	return
+}
+
+// proto returns Instance proto for the instance.
+func (inst *instanceEntry) proto(pkgName, iid string) *api.Instance {
+	return &api.Instance{
+		Package:      pkgName,
+		Instance:     common.InstanceIDToObjectRef(iid),
+		RegisteredBy: inst.RegisteredBy,
+		RegisteredTs: google.NewTimestamp(inst.RegisteredTs),
+	}
+}
+
+// tag returns an attached tag given as "k:v" or nil.
+func (inst *instanceEntry) tag(kv string) *tagEntry {
+	for _, t := range inst.Tags {
+		if t.Tag == kv {
+			return t
+		}
+	}
+	return nil
+}
+
+// tags returns all attached tags, sorting them by the tag key first, and then
+// by the timestamp (most recent first).
+func (inst *instanceEntry) tags() (out []*api.Tag) {
+	tags := append([]*tagEntry(nil), inst.Tags...)
+	sort.Slice(tags, func(i, j int) bool {
+		if k1, k2 := tagKey(tags[i].Tag), tagKey(tags[j].Tag); k1 != k2 {
+			return k1 < k2
+		}
+		if !tags[i].AttachedTs.Equal(tags[j].AttachedTs) {
+			return tags[i].AttachedTs.After(tags[j].AttachedTs)
+		}
+		return tags[i].Tag < tags[j].Tag
+	})
+	for _, t := range tags {
+		tag := common.MustParseInstanceTag(t.Tag)
+		tag.AttachedBy = t.AttachedBy
+		tag.AttachedTs = google.NewTimestamp(t.AttachedTs)
+		out = append(out, tag)
+	}
+	return
+}
+
+// metadata returns an attached metadata entry with the given fingerprint or
+// nil.
+func (inst *instanceEntry) metadata(fp string) *metadataEntry {
+	for _, m := range inst.Metadata {
+		if m.Fingerprint == fp {
+			return m
+		}
+	}
+	return nil
+}
+
+// proto returns InstanceMetadata proto for the metadata entry.
+func (m *metadataEntry) proto() *api.InstanceMetadata {
+	return &api.InstanceMetadata{
+		Key:         m.Key,
+		Value:       m.Value,
+		ContentType: m.ContentType,
+		Fingerprint: m.Fingerprint,
+		AttachedBy:  m.AttachedBy,
+		AttachedTs:  google.NewTimestamp(m.AttachedTs),
+	}
+}
+
+// tagKey takes "key:<stuff>" and returns "key".
+func tagKey(kv string) string {
+	if idx := strings.IndexRune(kv, ':'); idx != -1 {
+		return kv[:idx]
+	}
+	return kv
+}
@@ -0,0 +1,103 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localserver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/grpc/discovery"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/server/router"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+)
+
+// Server is a local CIPD backend.
+type Server struct {
+	repo *repoImpl
+	cas  *storageImpl
+}
+
+// New opens (or creates) a repository in the given directory.
+//
+// All requests to the server are assumed to be coming from 'caller'.
+func New(root string, caller identity.Identity) (*Server, error) {
+	if err := caller.Validate(); err != nil {
+		return nil, errors.Annotate(err, "bad caller identity").Err()
+	}
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, errors.Annotate(err, "failed to create the root directory").Err()
+	}
+	state, err := openStateStore(filepath.Join(root, "state.json"))
+	if err != nil {
+		return nil, err
+	}
+	cas := &storageImpl{
+		root:   root,
+		state:  state,
+		caller: string(caller),
+	}
+	return &Server{
+		repo: &repoImpl{
+			state:  state,
+			cas:    cas,
+			caller: string(caller),
+		},
+		cas: cas,
+	}, nil
+}
+
+// InstallHandlers installs pRPC services and HTTP endpoints into the router.
+func (s *Server) InstallHandlers(r *router.Router, base router.MiddlewareChain) {
+	base = base.Extend(withBaseURL)
+
+	srv := &prpc.Server{Authenticator: prpc.NoAuthentication}
+	api.RegisterRepositoryServer(srv, s.repo)
+	api.RegisterStorageServer(srv, s.cas)
+	discovery.Enable(srv)
+	srv.InstallHandlers(r, base)
+
+	r.GET("/cas/:algo/:digest", base, s.cas.downloadHandler)
+	r.PUT("/upload/:op", base, s.cas.uploadHandler)
+}
+
+var baseURLKey = "cipd localserver base URL"
+
+// withBaseURL is a middleware that puts the root URL of the server (as seen
+// by the client) into the context.
+//
+// It is used to generate upload and download URLs.
+func withBaseURL(c *router.Context, next router.Handler) {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	c.Context = context.WithValue(c.Context, &baseURLKey, scheme+"://"+c.Request.Host)
+	next(c)
+}
+
+// baseURL returns the root URL of the server.
+func baseURL(ctx context.Context) string {
+	url, _ := ctx.Value(&baseURLKey).(string)
+	return url
+}
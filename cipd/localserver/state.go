@@ -0,0 +1,219 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localserver
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+)
+
+// state is the entire state of the repository, except package files.
+//
+// It is serialized to JSON and stored in <root>/state.json.
+type state struct {
+	Prefixes map[string]*prefixEntry  `json:"prefixes,omitempty"` // prefix => its metadata
+	Packages map[string]*packageEntry `json:"packages,omitempty"` // name => the package
+	Uploads  map[string]*uploadEntry  `json:"uploads,omitempty"`  // op ID => the upload
+	LastOpID int64                    `json:"last_op_id,omitempty"`
+}
+
+// prefixEntry is metadata attached to a prefix.
+type prefixEntry struct {
+	Fingerprint string      `json:"fingerprint"`
+	UpdateTime  time.Time   `json:"update_time"`
+	UpdateUser  string      `json:"update_user"`
+	ACLs        []*aclEntry `json:"acls,omitempty"`
+}
+
+// aclEntry is a single ACL of a prefix.
+type aclEntry struct {
+	Role       api.Role `json:"role"`
+	Principals []string `json:"principals"`
+}
+
+// packageEntry is a registered package.
+type packageEntry struct {
+	Hidden    bool                      `json:"hidden,omitempty"`
+	Instances map[string]*instanceEntry `json:"instances,omitempty"` // instance ID => the instance
+	Refs      map[string]*refEntry      `json:"refs,omitempty"`      // ref name => the ref
+}
+
+// instanceEntry is a registered package instance.
+type instanceEntry struct {
+	RegisteredBy string           `json:"registered_by"`
+	RegisteredTs time.Time        `json:"registered_ts"`
+	Tags         []*tagEntry      `json:"tags,omitempty"`
+	Metadata     []*metadataEntry `json:"metadata,omitempty"`
+}
+
+// tagEntry is a tag attached to an instance.
+type tagEntry struct {
+	Tag        string    `json:"tag"` // "key:value"
+	AttachedBy string    `json:"attached_by"`
+	AttachedTs time.Time `json:"attached_ts"`
+}
+
+// metadataEntry is a metadata entry attached to an instance.
+type metadataEntry struct {
+	Key         string    `json:"key"`
+	Value       []byte    `json:"value"`
+	ContentType string    `json:"content_type"`
+	Fingerprint string    `json:"fingerprint"`
+	AttachedBy  string    `json:"attached_by"`
+	AttachedTs  time.Time `json:"attached_ts"`
+}
+
+// refEntry is a ref pointing to an instance.
+type refEntry struct {
+	InstanceID string    `json:"instance_id"`
+	ModifiedBy string    `json:"modified_by"`
+	ModifiedTs time.Time `json:"modified_ts"`
+}
+
+// uploadEntry is an upload operation.
+type uploadEntry struct {
+	Status       api.UploadStatus `json:"status"`
+	HashAlgo     api.HashAlgo     `json:"hash_algo"`
+	HexDigest    string           `json:"hex_digest,omitempty"` // expected or calculated
+	ErrorMessage string           `json:"error_message,omitempty"`
+	CreatedBy    string           `json:"created_by"`
+	CreatedTs    time.Time        `json:"created_ts"`
+}
+
+// objectRef returns a reference to the uploaded object, if known.
+func (u *uploadEntry) objectRef() *api.ObjectRef {
+	if u.HexDigest == "" {
+		return nil
+	}
+	return &api.ObjectRef{HashAlgo: u.HashAlgo, HexDigest: u.HexDigest}
+}
+
+// stateStore holds the state in memory and persists it on each mutation.
+type stateStore struct {
+	path  string
+	m     sync.RWMutex
+	st    *state
+	saved []byte // serialized 'st', as it is on disk
+}
+
+// openStateStore loads the state from the given file, if it exists.
+func openStateStore(path string) (*stateStore, error) {
+	s := &stateStore{path: path}
+	switch blob, err := ioutil.ReadFile(path); {
+	case os.IsNotExist(err):
+		s.saved = []byte("{}")
+		s.st, _ = decodeState(s.saved)
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to read the state").Err()
+	default:
+		s.saved = blob
+		if s.st, err = decodeState(blob); err != nil {
+			return nil, errors.Annotate(err, "failed to parse the state in %s", path).Err()
+		}
+	}
+	return s, nil
+}
+
+// decodeState deserializes the state, initializing all maps.
+func decodeState(blob []byte) (*state, error) {
+	st := &state{}
+	if err := json.Unmarshal(blob, st); err != nil {
+		return nil, err
+	}
+	if st.Prefixes == nil {
+		st.Prefixes = map[string]*prefixEntry{}
+	}
+	if st.Packages == nil {
+		st.Packages = map[string]*packageEntry{}
+	}
+	if st.Uploads == nil {
+		st.Uploads = map[string]*uploadEntry{}
+	}
+	for _, pkg := range st.Packages {
+		if pkg.Instances == nil {
+			pkg.Instances = map[string]*instanceEntry{}
+		}
+		if pkg.Refs == nil {
+			pkg.Refs = map[string]*refEntry{}
+		}
+	}
+	return st, nil
+}
+
+// view calls the callback under the read lock.
+//
+// The callback must not modify the state.
+func (s *stateStore) view(cb func(st *state) error) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	return cb(s.st)
+}
+
+// update calls the callback under the write lock and persists the modified
+// state if the callback succeeds.
+//
+// If the callback fails, all modifications it made to the state are reverted.
+func (s *stateStore) update(cb func(st *state) error) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	err := cb(s.st)
+	if err == nil {
+		err = s.save()
+	}
+	if err != nil {
+		// This never fails, since 's.saved' was produced by json.Marshal.
+		st, decodeErr := decodeState(s.saved)
+		if decodeErr != nil {
+			panic(decodeErr)
+		}
+		s.st = st
+		return err
+	}
+	return nil
+}
+
+// save atomically writes the state to the disk.
+func (s *stateStore) save() error {
+	blob, err := json.MarshalIndent(s.st, "", "  ")
+	if err != nil {
+		return errors.Annotate(err, "failed to serialize the state").Err()
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return errors.Annotate(err, "failed to open a temp file").Err()
+	}
+	_, err = tmp.Write(blob)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), s.path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return errors.Annotate(err, "failed to write the state").Err()
+	}
+	s.saved = blob
+	return nil
+}
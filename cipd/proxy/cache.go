@@ -0,0 +1,203 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"container/list"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+)
+
+// diskCache is a directory with objects, evicted in LRU order when their total
+// size exceeds the limit.
+//
+// Objects are stored as <dir>/<HashAlgo>/<hex digest>. The last access time of
+// an object is its file modification time, so the LRU order survives restarts.
+type diskCache struct {
+	dir   string
+	limit int64
+
+	m     sync.Mutex
+	size  int64                    // total size of all objects
+	lru   *list.List               // of *cacheEntry, most recently used first
+	items map[string]*list.Element // object path relative to dir => lru item
+}
+
+type cacheEntry struct {
+	key  string // object path relative to dir
+	size int64
+}
+
+// openDiskCache scans the cache directory, evicting objects if necessary.
+func openDiskCache(ctx context.Context, dir string, limit int64) (*diskCache, error) {
+	c := &diskCache{
+		dir:   dir,
+		limit: limit,
+		lru:   list.New(),
+		items: map[string]*list.Element{},
+	}
+
+	// Leftovers of interrupted downloads are garbage.
+	if err := os.RemoveAll(filepath.Join(dir, "tmp")); err != nil {
+		return nil, errors.Annotate(err, "failed to cleanup temp files").Err()
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "tmp"), 0700); err != nil {
+		return nil, errors.Annotate(err, "failed to create the cache directory").Err()
+	}
+
+	type found struct {
+		key   string
+		size  int64
+		mtime time.Time
+	}
+	var all []found
+	for algo := range api.HashAlgo_value {
+		files, err := ioutil.ReadDir(filepath.Join(dir, algo))
+		switch {
+		case os.IsNotExist(err):
+			continue
+		case err != nil:
+			return nil, errors.Annotate(err, "failed to scan the cache directory").Err()
+		}
+		for _, f := range files {
+			if f.Mode().IsRegular() {
+				all = append(all, found{
+					key:   filepath.Join(algo, f.Name()),
+					size:  f.Size(),
+					mtime: f.ModTime(),
+				})
+			}
+		}
+	}
+
+	// Most recently used first.
+	sort.Slice(all, func(i, j int) bool { return all[i].mtime.After(all[j].mtime) })
+	for _, f := range all {
+		c.items[f.key] = c.lru.PushBack(&cacheEntry{key: f.key, size: f.size})
+		c.size += f.size
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.evictLocked(ctx, "")
+	logging.Infof(ctx, "Cache has %d objects, %.1f MB", c.lru.Len(), float64(c.size)/1e6)
+	return c, nil
+}
+
+// cacheKey returns a path to the object relative to the cache directory.
+func cacheKey(ref *api.ObjectRef) string {
+	return filepath.Join(ref.HashAlgo.String(), ref.HexDigest)
+}
+
+// open opens a cached object for reading, marking it as recently used.
+//
+// Returns nil if there's no such object.
+func (c *diskCache) open(ctx context.Context, ref *api.ObjectRef) (*os.File, error) {
+	key := cacheKey(ref)
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	item := c.items[key]
+	if item == nil {
+		return nil, nil
+	}
+	c.lru.MoveToFront(item)
+
+	path := filepath.Join(c.dir, key)
+	now := clock.Now(ctx)
+	if err := os.Chtimes(path, now, now); err != nil {
+		logging.Warningf(ctx, "Failed to touch %s - %s", path, err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to open the cached object").Err()
+	}
+	return f, nil
+}
+
+// tempFile creates a new temp file to download an object into.
+func (c *diskCache) tempFile() (*os.File, error) {
+	return ioutil.TempFile(filepath.Join(c.dir, "tmp"), "fetch_*")
+}
+
+// add moves a downloaded temp file into the cache and evicts old objects if
+// the cache is too large now.
+func (c *diskCache) add(ctx context.Context, ref *api.ObjectRef, tmp string) error {
+	st, err := os.Stat(tmp)
+	if err != nil {
+		return err
+	}
+
+	key := cacheKey(ref)
+	path := filepath.Join(c.dir, key)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Annotate(err, "failed to create the cache directory").Err()
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if err := os.Rename(tmp, path); err != nil {
+		return errors.Annotate(err, "failed to move the object into the cache").Err()
+	}
+	if item := c.items[key]; item != nil {
+		c.size -= item.Value.(*cacheEntry).size
+		c.lru.Remove(item)
+	}
+	c.items[key] = c.lru.PushFront(&cacheEntry{key: key, size: st.Size()})
+	c.size += st.Size()
+	c.evictLocked(ctx, key)
+	return nil
+}
+
+// evictLocked removes least recently used objects until the cache fits into
+// the limit.
+//
+// Never evicts 'keep' object, even if it alone exceeds the limit.
+func (c *diskCache) evictLocked(ctx context.Context, keep string) {
+	for c.size > c.limit {
+		item := c.lru.Back()
+		ent := item.Value.(*cacheEntry)
+		if ent.key == keep {
+			break
+		}
+		logging.Infof(ctx, "Evicting %s (%.1f MB) from the cache", ent.key, float64(ent.size)/1e6)
+		if err := os.Remove(filepath.Join(c.dir, ent.key)); err != nil && !os.IsNotExist(err) {
+			logging.Warningf(ctx, "Failed to remove %s - %s", ent.key, err)
+		}
+		c.lru.Remove(item)
+		delete(c.items, ent.key)
+		c.size -= ent.size
+	}
+}
+
+// stats returns the number of cached objects and their total size.
+func (c *diskCache) stats() (count int, size int64) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.lru.Len(), c.size
+}
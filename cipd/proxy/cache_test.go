@@ -0,0 +1,124 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDiskCache(t *testing.T) {
+	t.Parallel()
+
+	Convey("With a cache", t, func() {
+		ctx, _ := testclock.UseTime(context.Background(), time.Now().Add(time.Hour))
+
+		tmp, err := ioutil.TempDir("", "cipd_proxy_cache")
+		So(err, ShouldBeNil)
+		Reset(func() { os.RemoveAll(tmp) })
+
+		put := func(c *diskCache, body string) *api.ObjectRef {
+			h := common.MustNewHash(api.HashAlgo_SHA256)
+			h.Write([]byte(body))
+			ref := &api.ObjectRef{HashAlgo: api.HashAlgo_SHA256, HexDigest: common.HexDigest(h)}
+			f, err := c.tempFile()
+			So(err, ShouldBeNil)
+			_, err = f.Write([]byte(body))
+			So(err, ShouldBeNil)
+			So(f.Close(), ShouldBeNil)
+			So(c.add(ctx, ref, f.Name()), ShouldBeNil)
+			return ref
+		}
+
+		has := func(c *diskCache, ref *api.ObjectRef) bool {
+			f, err := c.open(ctx, ref)
+			So(err, ShouldBeNil)
+			if f == nil {
+				return false
+			}
+			defer f.Close()
+			body, err := ioutil.ReadAll(f)
+			So(err, ShouldBeNil)
+			So(body, ShouldHaveLength, 10)
+			return true
+		}
+
+		Convey("Evicts least recently used objects", func() {
+			c, err := openDiskCache(ctx, tmp, 25)
+			So(err, ShouldBeNil)
+
+			a := put(c, "aaaaaaaaaa")
+			b := put(c, "bbbbbbbbbb")
+			So(has(c, a), ShouldBeTrue) // now 'b' is the least recently used
+
+			put(c, "cccccccccc")
+			So(has(c, b), ShouldBeFalse)
+			So(has(c, a), ShouldBeTrue)
+
+			count, size := c.stats()
+			So(count, ShouldEqual, 2)
+			So(size, ShouldEqual, 20)
+
+			_, err = os.Stat(filepath.Join(tmp, cacheKey(b)))
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("Keeps objects larger than the limit until the next add", func() {
+			c, err := openDiskCache(ctx, tmp, 5)
+			So(err, ShouldBeNil)
+
+			a := put(c, "aaaaaaaaaa")
+			So(has(c, a), ShouldBeTrue)
+			b := put(c, "bbbbbbbbbb")
+			So(has(c, a), ShouldBeFalse)
+			So(has(c, b), ShouldBeTrue)
+		})
+
+		Convey("LRU order survives reopening", func() {
+			c, err := openDiskCache(ctx, tmp, 100)
+			So(err, ShouldBeNil)
+
+			a := put(c, "aaaaaaaaaa")
+			b := put(c, "bbbbbbbbbb")
+			cc := put(c, "cccccccccc")
+			So(has(c, a), ShouldBeTrue) // touches it using the test clock
+
+			// Leftover temp files are cleaned up.
+			f, err := c.tempFile()
+			So(err, ShouldBeNil)
+			f.Close()
+
+			c, err = openDiskCache(ctx, tmp, 15)
+			So(err, ShouldBeNil)
+			So(has(c, a), ShouldBeTrue)
+			So(has(c, b), ShouldBeFalse)
+			So(has(c, cc), ShouldBeFalse)
+
+			_, err = os.Stat(f.Name())
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,147 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command cipd-proxy runs a read-only caching proxy in front of a CIPD backend.
+//
+// Package files are cached on the local disk. Point the CIPD client to the
+// proxy via -service-url flag (or CIPD_SERVICE_URL env var), e.g.:
+//
+//    cipd-proxy -cache-dir /tmp/cipd-cache -listen 127.0.0.1:8080 \
+//        -prewarm ensure.txt
+//    cipd ensure -service-url http://127.0.0.1:8080 ...
+//
+// The proxy talks to the upstream using its own credentials (see -help for
+// authentication flags). See go.chromium.org/luci/cipd/proxy for details.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+
+	"go.chromium.org/luci/auth"
+	"go.chromium.org/luci/auth/client/authcli"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/flag/stringlistflag"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/logging/gologger"
+	"go.chromium.org/luci/common/system/signals"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/hardcoded/chromeinfra"
+	"go.chromium.org/luci/server/router"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/proxy"
+)
+
+var (
+	upstream        = flag.String("upstream", chromeinfra.CIPDServiceURL, "URL of the upstream CIPD backend.")
+	listen          = flag.String("listen", "127.0.0.1:8080", "An address to listen on.")
+	cacheDir        = flag.String("cache-dir", "", "A directory to cache package files in (required).")
+	cacheSize       = flag.Int64("cache-size", 10*1024, "Maximum size of the cache, in megabytes.")
+	prewarm         stringlistflag.Flag
+	prewarmInterval = flag.Duration("prewarm-interval", 0, "If positive, how often to repeat the cache pre-warming.")
+)
+
+func init() {
+	flag.Var(&prewarm, "prewarm", "An ensure file with packages to put into the cache on startup. Can be repeated.")
+}
+
+func main() {
+	authFlags := authcli.Flags{}
+	authFlags.Register(flag.CommandLine, chromeinfra.DefaultAuthOptions())
+	flag.Parse()
+
+	ctx := gologger.StdConfig.Use(context.Background())
+	if err := run(ctx, &authFlags); err != nil {
+		fmt.Fprintf(os.Stderr, "cipd-proxy: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, authFlags *authcli.Flags) error {
+	if *cacheDir == "" {
+		return fmt.Errorf("-cache-dir is required")
+	}
+	upstreamURL, err := url.Parse(*upstream)
+	if err != nil {
+		return fmt.Errorf("bad -upstream - %s", err)
+	}
+	if upstreamURL.Scheme != "http" && upstreamURL.Scheme != "https" {
+		return fmt.Errorf("bad -upstream - expecting http:// or https:// URL")
+	}
+
+	authOpts, err := authFlags.Options()
+	if err != nil {
+		return err
+	}
+	authClient, err := auth.NewAuthenticator(ctx, auth.SilentLogin, authOpts).Client()
+	if err != nil {
+		return fmt.Errorf("failed to get credentials, login with 'cipd auth-login' first - %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	srv, err := proxy.New(ctx, proxy.Options{
+		Upstream: api.NewRepositoryPRPCClient(&prpc.Client{
+			C:       authClient,
+			Host:    upstreamURL.Host,
+			Options: &prpc.Options{Insecure: upstreamURL.Scheme == "http"},
+		}),
+		CacheDir:  *cacheDir,
+		CacheSize: *cacheSize * 1024 * 1024,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(prewarm) != 0 {
+		go func() {
+			for ctx.Err() == nil {
+				if err := srv.Prewarm(ctx, prewarm); err != nil {
+					logging.Errorf(ctx, "Failed to pre-warm the cache: %s", err)
+				}
+				if *prewarmInterval <= 0 {
+					return
+				}
+				clock.Sleep(ctx, *prewarmInterval)
+			}
+		}()
+	}
+
+	r := router.NewWithRootContext(ctx)
+	srv.InstallHandlers(r, router.NewMiddlewareChain())
+
+	l, err := net.Listen("tcp", *listen)
+	if err != nil {
+		return err
+	}
+	httpSrv := &http.Server{Handler: r}
+	defer signals.HandleInterrupt(func() {
+		logging.Infof(ctx, "Shutting down...")
+		cancel()
+		httpSrv.Shutdown(context.Background())
+	})()
+
+	logging.Infof(ctx, "Proxying %s at http://%s", *upstream, l.Addr())
+	if err := httpSrv.Serve(l); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
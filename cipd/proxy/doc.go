@@ -0,0 +1,37 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proxy implements a read-only pull-through caching proxy for a CIPD
+// backend.
+//
+// It serves cipd.Repository pRPC service (see cipd/api/cipd/v1) by forwarding
+// all read calls to the upstream CIPD backend, using the proxy's own
+// credentials. Package files are served by the proxy itself from a local disk
+// cache, which is filled on demand from the upstream and evicted in LRU order
+// when it grows larger than the configured limit. The cache can also be
+// pre-warmed from a list of ensure files.
+//
+// Clients just need to use the proxy URL as their CIPD service URL.
+//
+// URLs of package files returned by the proxy embed everything needed to fetch
+// the file from the upstream, so the proxy has no state besides the cache. It
+// can be restarted or replicated freely.
+//
+// Limitations:
+//   * All mutating calls (registering packages, moving refs, etc.) are
+//     rejected. Use the upstream backend directly for them.
+//   * Clients are not authenticated. Anyone who can reach the proxy can read
+//     everything the proxy itself can read from the upstream.
+//   * Storage service (used for uploads) is not exposed.
+package proxy
@@ -0,0 +1,263 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+
+	"golang.org/x/net/context/ctxhttp"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/server/router"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// objectSource describes where to get an object from if it is not in the
+// cache.
+//
+// It is encoded in query parameters of download URLs returned by the proxy, so
+// that the proxy itself stays stateless. The object is either a package
+// instance (then it is fetched through GetInstanceURL) or a CIPD client binary
+// (then it is fetched through DescribeClient).
+type objectSource struct {
+	Package  string
+	Instance string // instance ID
+	Client   bool   // if true, the object is a client binary of the instance
+}
+
+// values encodes the source as URL query parameters.
+func (s *objectSource) values() url.Values {
+	v := url.Values{}
+	v.Set("package", s.Package)
+	v.Set("instance", s.Instance)
+	if s.Client {
+		v.Set("client", "1")
+	}
+	return v
+}
+
+// parseObjectSource decodes the source from URL query parameters.
+func parseObjectSource(v url.Values) (*objectSource, error) {
+	s := &objectSource{
+		Package:  v.Get("package"),
+		Instance: v.Get("instance"),
+		Client:   v.Get("client") == "1",
+	}
+	if err := common.ValidatePackageName(s.Package); err != nil {
+		return nil, errors.Annotate(err, "bad 'package'").Err()
+	}
+	if err := common.ValidateInstanceID(s.Instance, common.KnownHash); err != nil {
+		return nil, errors.Annotate(err, "bad 'instance'").Err()
+	}
+	return s, nil
+}
+
+// fetcher downloads objects from the upstream storage into the cache.
+type fetcher struct {
+	ctx      context.Context      // the root context for fetches
+	upstream api.RepositoryClient // used to get signed URLs
+	client   *http.Client         // used to download objects via signed URLs
+	cache    *diskCache
+
+	m        sync.Mutex
+	inflight map[string]*fetchOp // cache key => pending fetch
+}
+
+// fetchOp is an ongoing fetch of some object.
+type fetchOp struct {
+	done chan struct{} // closed when the fetch is finished
+	err  error         // set before 'done' is closed
+}
+
+// fetch ensures the object is in the cache, downloading it from the upstream if
+// necessary.
+//
+// Concurrent fetches of the same object are coalesced into a single download.
+// The download itself is not canceled if 'ctx' is (it continues in background
+// to make the object available for the future calls).
+func (f *fetcher) fetch(ctx context.Context, ref *api.ObjectRef, src *objectSource) error {
+	key := cacheKey(ref)
+
+	f.m.Lock()
+	op := f.inflight[key]
+	if op == nil {
+		op = &fetchOp{done: make(chan struct{})}
+		f.inflight[key] = op
+		go func() {
+			op.err = f.download(f.ctx, ref, src)
+			f.m.Lock()
+			delete(f.inflight, key)
+			f.m.Unlock()
+			close(op.done)
+		}()
+	}
+	f.m.Unlock()
+
+	select {
+	case <-op.done:
+		return op.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// download fetches the object from the upstream, verifies its hash and puts
+// it into the cache.
+func (f *fetcher) download(ctx context.Context, ref *api.ObjectRef, src *objectSource) error {
+	if cached, err := f.cache.open(ctx, ref); cached != nil || err != nil {
+		if cached != nil {
+			cached.Close()
+		}
+		return err
+	}
+
+	signedURL, err := f.signedURL(ctx, ref, src)
+	if err != nil {
+		return err
+	}
+
+	logging.Infof(ctx, "Fetching %s from the upstream", cacheKey(ref))
+
+	tmp, err := f.cache.tempFile()
+	if err != nil {
+		return errors.Annotate(err, "failed to create a temp file").Err()
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name()) // noop if it was moved into the cache already
+	}()
+
+	resp, err := ctxhttp.Get(ctx, f.client, signedURL)
+	if err != nil {
+		return errors.Annotate(err, "failed to send the request").Tag(transient.Tag).Err()
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		err := errors.Reason("unexpected response from the storage: HTTP %d", resp.StatusCode)
+		if resp.StatusCode >= 500 {
+			err = err.Tag(transient.Tag)
+		}
+		return err.Err()
+	}
+
+	h := common.MustNewHash(ref.HashAlgo)
+	if _, err := io.Copy(io.MultiWriter(tmp, h), resp.Body); err != nil {
+		return errors.Annotate(err, "failed to download the object").Tag(transient.Tag).Err()
+	}
+	if got := common.HexDigest(h); got != ref.HexDigest {
+		return errors.Reason("the downloaded object has wrong hash: expected %s, got %s", ref.HexDigest, got).Err()
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Annotate(err, "failed to flush the temp file").Err()
+	}
+	return f.cache.add(ctx, ref, tmp.Name())
+}
+
+// signedURL asks the upstream for an URL to fetch the object from.
+func (f *fetcher) signedURL(ctx context.Context, ref *api.ObjectRef, src *objectSource) (string, error) {
+	if src.Client {
+		resp, err := f.upstream.DescribeClient(ctx, &api.DescribeClientRequest{
+			Package:  src.Package,
+			Instance: common.InstanceIDToObjectRef(src.Instance),
+		})
+		if err != nil {
+			return "", errors.Annotate(err, "failed to describe the client").Err()
+		}
+		for _, alias := range append([]*api.ObjectRef{resp.ClientRef}, resp.ClientRefAliases...) {
+			if alias.HashAlgo == ref.HashAlgo && alias.HexDigest == ref.HexDigest {
+				return resp.ClientBinary.SignedUrl, nil
+			}
+		}
+		return "", errors.Reason("the client binary of %s doesn't match %s", src.Instance, cacheKey(ref)).Err()
+	}
+
+	if common.ObjectRefToInstanceID(ref) != src.Instance {
+		return "", errors.Reason("the instance %s doesn't match %s", src.Instance, cacheKey(ref)).Err()
+	}
+	resp, err := f.upstream.GetInstanceURL(ctx, &api.GetInstanceURLRequest{
+		Package:  src.Package,
+		Instance: ref,
+	})
+	if err != nil {
+		return "", errors.Annotate(err, "failed to get the instance URL").Err()
+	}
+	return resp.SignedUrl, nil
+}
+
+// downloadHandler serves GET /cas/:algo/:digest?<objectSource>.
+//
+// Serves the object from the cache, fetching it from the upstream first if
+// necessary. Supports Range requests.
+func (f *fetcher) downloadHandler(c *router.Context) {
+	algo := api.HashAlgo(api.HashAlgo_value[c.Params.ByName("algo")])
+	ref := &api.ObjectRef{HashAlgo: algo, HexDigest: c.Params.ByName("digest")}
+	if err := common.ValidateObjectRef(ref, common.KnownHash); err != nil {
+		http.Error(c.Writer, fmt.Sprintf("Bad object reference - %s", err), http.StatusBadRequest)
+		return
+	}
+
+	cached, err := f.cache.open(c.Context, ref)
+	if err == nil && cached == nil {
+		var src *objectSource
+		if src, err = parseObjectSource(c.Request.URL.Query()); err != nil {
+			http.Error(c.Writer, fmt.Sprintf("Bad request - %s", err), http.StatusBadRequest)
+			return
+		}
+		if err = f.fetch(c.Context, ref, src); err == nil {
+			cached, err = f.cache.open(c.Context, ref)
+		}
+	}
+	if err == nil && cached == nil {
+		err = errors.Reason("the object was evicted right after it was fetched").Tag(transient.Tag).Err()
+	}
+	if err != nil {
+		logging.WithError(err).Errorf(c.Context, "Failed to fetch %s", cacheKey(ref))
+		code := http.StatusInternalServerError
+		switch grpcCode := grpcutil.Code(err); {
+		case transient.Tag.In(err):
+			code = http.StatusServiceUnavailable
+		case grpcCode != codes.Unknown:
+			code = grpcutil.CodeStatus(grpcCode)
+		}
+		http.Error(c.Writer, fmt.Sprintf("Failed to fetch the object - %s", err), code)
+		return
+	}
+	defer cached.Close()
+
+	st, err := cached.Stat()
+	if err != nil {
+		http.Error(c.Writer, fmt.Sprintf("Internal server error - %s", err), http.StatusInternalServerError)
+		return
+	}
+	c.Writer.Header().Set("Content-Type", "application/octet-stream")
+	http.ServeContent(c.Writer, c.Request, "", st.ModTime(), cached)
+}
+
+// proxyURL returns an URL of the object served by the proxy.
+func proxyURL(ctx context.Context, ref *api.ObjectRef, src *objectSource) string {
+	return fmt.Sprintf("%s/cas/%s/%s?%s", baseURL(ctx), ref.HashAlgo, ref.HexDigest, src.values().Encode())
+}
@@ -0,0 +1,134 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/sync/parallel"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/client/cipd/ensure"
+	"go.chromium.org/luci/cipd/client/cipd/template"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// Prewarm resolves packages in the given ensure files and fetches them into
+// the cache.
+//
+// Packages are resolved for all platforms listed in $VerifiedPlatform
+// directives of an ensure file, or only for the current platform if there are
+// none.
+//
+// Returns a multi-error with all resolution and fetch errors. Packages that
+// were resolved and fetched successfully end up in the cache regardless.
+func (s *Server) Prewarm(ctx context.Context, ensureFiles []string) error {
+	var merr errors.MultiError
+	var pins []common.Pin
+	seen := map[common.Pin]bool{}
+
+	for _, path := range ensureFiles {
+		resolved, err := s.resolveEnsureFile(ctx, path)
+		if err != nil {
+			logging.Errorf(ctx, "Failed to resolve %s: %s", path, err)
+			merr = append(merr, errors.Annotate(err, "resolving %s", path).Err())
+		}
+		for _, pin := range resolved {
+			if !seen[pin] {
+				seen[pin] = true
+				pins = append(pins, pin)
+			}
+		}
+	}
+
+	logging.Infof(ctx, "Prewarming the cache with %d instance(s)", len(pins))
+
+	var m sync.Mutex
+	parallel.WorkPool(8, func(tasks chan<- func() error) {
+		for _, pin := range pins {
+			pin := pin
+			tasks <- func() error {
+				ref := common.InstanceIDToObjectRef(pin.InstanceID)
+				err := s.fetcher.fetch(ctx, ref, &objectSource{
+					Package:  pin.PackageName,
+					Instance: pin.InstanceID,
+				})
+				if err != nil {
+					logging.Errorf(ctx, "Failed to fetch %s: %s", pin, err)
+					m.Lock()
+					merr = append(merr, errors.Annotate(err, "fetching %s", pin).Err())
+					m.Unlock()
+				}
+				return nil
+			}
+		}
+	})
+
+	if len(merr) != 0 {
+		return merr
+	}
+	return nil
+}
+
+// resolveEnsureFile parses the ensure file and resolves all versions there.
+func (s *Server) resolveEnsureFile(ctx context.Context, path string) ([]common.Pin, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	parsed, err := ensure.ParseFile(f)
+	if err != nil {
+		return nil, err
+	}
+
+	expanders := []template.Expander{template.DefaultExpander()}
+	if len(parsed.VerifyPlatforms) != 0 {
+		expanders = expanders[:0]
+		for _, plat := range parsed.VerifyPlatforms {
+			expanders = append(expanders, plat.Expander())
+		}
+	}
+
+	resolver := func(pkg, vers string) (common.Pin, error) {
+		inst, err := s.repo.upstream.ResolveVersion(ctx, &api.ResolveVersionRequest{
+			Package: pkg,
+			Version: vers,
+		})
+		if err != nil {
+			return common.Pin{}, err
+		}
+		return common.Pin{
+			PackageName: inst.Package,
+			InstanceID:  common.ObjectRefToInstanceID(inst.Instance),
+		}, nil
+	}
+
+	var pins []common.Pin
+	for _, exp := range expanders {
+		resolved, err := parsed.Resolve(resolver, exp)
+		if err != nil {
+			return pins, err
+		}
+		for _, slice := range resolved.PackagesBySubdir {
+			pins = append(pins, slice...)
+		}
+	}
+	return pins, nil
+}
@@ -0,0 +1,199 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/server/router"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/client/cipd"
+	"go.chromium.org/luci/cipd/client/cipd/builder"
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/cipd/localserver"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func buildInstance(name, body string) ([]byte, common.Pin) {
+	out := bytes.Buffer{}
+	pin, err := builder.BuildInstance(context.Background(), builder.Options{
+		Input:       []fs.File{fs.NewTestFile("file", body, fs.TestFileOpts{})},
+		Output:      &out,
+		PackageName: name,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return out.Bytes(), pin
+}
+
+func TestProxy(t *testing.T) {
+	t.Parallel()
+
+	Convey("With upstream and proxy", t, func() {
+		ctx := context.Background()
+
+		tmp, err := ioutil.TempDir("", "cipd_proxy")
+		So(err, ShouldBeNil)
+		Reset(func() { os.RemoveAll(tmp) })
+
+		// The upstream is a local server that counts downloads.
+		var downloads int32
+		upstreamSrv, err := localserver.New(filepath.Join(tmp, "upstream"), "user:someone@example.com")
+		So(err, ShouldBeNil)
+		r := router.New()
+		upstreamSrv.InstallHandlers(r, router.NewMiddlewareChain(func(c *router.Context, next router.Handler) {
+			if c.Request.Method == "GET" && strings.HasPrefix(c.Request.URL.Path, "/cas/") {
+				atomic.AddInt32(&downloads, 1)
+			}
+			next(c)
+		}))
+		upstreamTS := httptest.NewServer(r)
+		Reset(upstreamTS.Close)
+
+		upstreamClient, err := cipd.NewClient(cipd.ClientOptions{
+			ServiceURL: upstreamTS.URL,
+			Root:       filepath.Join(tmp, "upstream_site"),
+		})
+		So(err, ShouldBeNil)
+
+		blob, pin := buildInstance("a/b/pkg", "body 1")
+		So(upstreamClient.RegisterInstance(ctx, pin, bytes.NewReader(blob), 0), ShouldBeNil)
+		So(upstreamClient.SetRefWhenReady(ctx, "latest", pin), ShouldBeNil)
+
+		// The proxy talks to the upstream via pRPC.
+		proxySrv, err := New(ctx, Options{
+			Upstream: api.NewRepositoryPRPCClient(&prpc.Client{
+				Host:    strings.TrimPrefix(upstreamTS.URL, "http://"),
+				Options: &prpc.Options{Insecure: true},
+			}),
+			CacheDir:  filepath.Join(tmp, "cache"),
+			CacheSize: 1024 * 1024,
+		})
+		So(err, ShouldBeNil)
+		r = router.New()
+		proxySrv.InstallHandlers(r, router.NewMiddlewareChain())
+		proxyTS := httptest.NewServer(r)
+		Reset(proxyTS.Close)
+
+		client, err := cipd.NewClient(cipd.ClientOptions{
+			ServiceURL: proxyTS.URL,
+			Root:       filepath.Join(tmp, "site"),
+		})
+		So(err, ShouldBeNil)
+
+		Convey("Resolves versions", func() {
+			resolved, err := client.ResolveVersion(ctx, "a/b/pkg", "latest")
+			So(err, ShouldBeNil)
+			So(resolved, ShouldResemble, pin)
+
+			_, err = client.ResolveVersion(ctx, "a/b/pkg", "missing")
+			So(err, ShouldErrLike, "no such ref")
+		})
+
+		Convey("Serves files from the cache", func() {
+			for i := 0; i < 3; i++ {
+				out := &fetchBuffer{}
+				So(client.FetchInstanceTo(ctx, pin, out), ShouldBeNil)
+				So(out.Bytes(), ShouldResemble, blob)
+			}
+			So(atomic.LoadInt32(&downloads), ShouldEqual, 1)
+
+			count, _ := proxySrv.fetcher.cache.stats()
+			So(count, ShouldEqual, 1)
+		})
+
+		Convey("Installs packages", func() {
+			_, err := client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {pin}}, cipd.CheckPresence, 1, false)
+			So(err, ShouldBeNil)
+			body, err := ioutil.ReadFile(filepath.Join(tmp, "site", "file"))
+			So(err, ShouldBeNil)
+			So(string(body), ShouldEqual, "body 1")
+		})
+
+		Convey("Rejects unknown instances", func() {
+			_, missing := buildInstance("a/b/pkg", "body 2")
+			out := &fetchBuffer{}
+			So(client.FetchInstanceTo(ctx, missing, out), ShouldErrLike, "no such instance")
+			So(atomic.LoadInt32(&downloads), ShouldEqual, 0)
+		})
+
+		Convey("Rejects writes", func() {
+			So(client.SetRefWhenReady(ctx, "another", pin), ShouldErrLike, "the proxy is read-only")
+		})
+
+		Convey("Prewarms the cache", func() {
+			ensureFile := filepath.Join(tmp, "ensure.txt")
+			So(ioutil.WriteFile(ensureFile, []byte("a/b/pkg latest\n"), 0600), ShouldBeNil)
+
+			So(proxySrv.Prewarm(ctx, []string{ensureFile}), ShouldBeNil)
+			So(atomic.LoadInt32(&downloads), ShouldEqual, 1)
+
+			out := &fetchBuffer{}
+			So(client.FetchInstanceTo(ctx, pin, out), ShouldBeNil)
+			So(out.Bytes(), ShouldResemble, blob)
+			So(atomic.LoadInt32(&downloads), ShouldEqual, 1)
+
+			Convey("Reports resolution errors", func() {
+				So(ioutil.WriteFile(ensureFile, []byte("a/b/pkg missing\n"), 0600), ShouldBeNil)
+				So(proxySrv.Prewarm(ctx, []string{ensureFile}), ShouldErrLike, "no such ref")
+			})
+		})
+	})
+}
+
+// fetchBuffer is an in-memory io.WriteSeeker.
+type fetchBuffer struct {
+	buf []byte
+	pos int64
+}
+
+func (b *fetchBuffer) Write(p []byte) (int, error) {
+	if end := b.pos + int64(len(p)); end > int64(len(b.buf)) {
+		b.buf = append(b.buf, make([]byte, end-int64(len(b.buf)))...)
+	}
+	copy(b.buf[b.pos:], p)
+	b.pos += int64(len(p))
+	return len(p), nil
+}
+
+func (b *fetchBuffer) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case 0:
+		b.pos = offset
+	case 1:
+		b.pos += offset
+	case 2:
+		b.pos = int64(len(b.buf)) + offset
+	}
+	return b.pos, nil
+}
+
+func (b *fetchBuffer) Bytes() []byte {
+	return b.buf
+}
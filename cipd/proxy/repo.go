@@ -0,0 +1,198 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// repoImpl implements cipd.RepositoryServer by forwarding read-only calls to
+// the upstream.
+//
+// Signed URLs returned by the upstream are replaced with URLs that point to the
+// proxy itself.
+type repoImpl struct {
+	upstream api.RepositoryClient
+}
+
+// readOnlyErr is returned by all mutating RPCs.
+func readOnlyErr() error {
+	return status.Errorf(codes.PermissionDenied, "the proxy is read-only, use the upstream CIPD service to modify the repository")
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Forwarded calls.
+
+// GetPrefixMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) GetPrefixMetadata(c context.Context, r *api.PrefixRequest) (*api.PrefixMetadata, error) {
+	return impl.upstream.GetPrefixMetadata(c, r)
+}
+
+// GetInheritedPrefixMetadata implements the corresponding RPC method, see the
+// proto doc.
+func (impl *repoImpl) GetInheritedPrefixMetadata(c context.Context, r *api.PrefixRequest) (*api.InheritedPrefixMetadata, error) {
+	return impl.upstream.GetInheritedPrefixMetadata(c, r)
+}
+
+// GetRolesInPrefix implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) GetRolesInPrefix(c context.Context, r *api.PrefixRequest) (*api.RolesInPrefixResponse, error) {
+	return impl.upstream.GetRolesInPrefix(c, r)
+}
+
+// ListPrefix implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) ListPrefix(c context.Context, r *api.ListPrefixRequest) (*api.ListPrefixResponse, error) {
+	return impl.upstream.ListPrefix(c, r)
+}
+
+// ListInstances implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) ListInstances(c context.Context, r *api.ListInstancesRequest) (*api.ListInstancesResponse, error) {
+	return impl.upstream.ListInstances(c, r)
+}
+
+// SearchInstances implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) SearchInstances(c context.Context, r *api.SearchInstancesRequest) (*api.SearchInstancesResponse, error) {
+	return impl.upstream.SearchInstances(c, r)
+}
+
+// SearchFiles implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) SearchFiles(c context.Context, r *api.SearchFilesRequest) (*api.SearchFilesResponse, error) {
+	return impl.upstream.SearchFiles(c, r)
+}
+
+// ListRefs implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) ListRefs(c context.Context, r *api.ListRefsRequest) (*api.ListRefsResponse, error) {
+	return impl.upstream.ListRefs(c, r)
+}
+
+// ListMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) ListMetadata(c context.Context, r *api.ListMetadataRequest) (*api.ListMetadataResponse, error) {
+	return impl.upstream.ListMetadata(c, r)
+}
+
+// ResolveVersion implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) ResolveVersion(c context.Context, r *api.ResolveVersionRequest) (*api.Instance, error) {
+	return impl.upstream.ResolveVersion(c, r)
+}
+
+// DescribeInstance implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) DescribeInstance(c context.Context, r *api.DescribeInstanceRequest) (*api.DescribeInstanceResponse, error) {
+	return impl.upstream.DescribeInstance(c, r)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Calls that return URLs.
+
+// GetInstanceURL implements the corresponding RPC method, see the proto doc.
+//
+// It checks the instance exists upstream (and the proxy has access to it), but
+// returns an URL that points to the proxy.
+func (impl *repoImpl) GetInstanceURL(c context.Context, r *api.GetInstanceURLRequest) (*api.ObjectURL, error) {
+	if err := common.ValidateObjectRef(r.Instance, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+	if _, err := impl.upstream.GetInstanceURL(c, r); err != nil {
+		return nil, err
+	}
+	return &api.ObjectURL{
+		SignedUrl: proxyURL(c, r.Instance, &objectSource{
+			Package:  r.Package,
+			Instance: common.ObjectRefToInstanceID(r.Instance),
+		}),
+	}, nil
+}
+
+// DescribeClient implements the corresponding RPC method, see the proto doc.
+//
+// The client binary URL is replaced with an URL that points to the proxy.
+func (impl *repoImpl) DescribeClient(c context.Context, r *api.DescribeClientRequest) (*api.DescribeClientResponse, error) {
+	resp, err := impl.upstream.DescribeClient(c, r)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ClientRef != nil && resp.ClientBinary != nil {
+		resp.ClientBinary.SignedUrl = proxyURL(c, resp.ClientRef, &objectSource{
+			Package:  r.Package,
+			Instance: common.ObjectRefToInstanceID(r.Instance),
+			Client:   true,
+		})
+	}
+	return resp, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Mutating calls, not supported.
+
+// UpdatePrefixMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) UpdatePrefixMetadata(c context.Context, r *api.PrefixMetadata) (*api.PrefixMetadata, error) {
+	return nil, readOnlyErr()
+}
+
+// HidePackage implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) HidePackage(c context.Context, r *api.PackageRequest) (*empty.Empty, error) {
+	return nil, readOnlyErr()
+}
+
+// UnhidePackage implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) UnhidePackage(c context.Context, r *api.PackageRequest) (*empty.Empty, error) {
+	return nil, readOnlyErr()
+}
+
+// DeletePackage implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) DeletePackage(c context.Context, r *api.PackageRequest) (*empty.Empty, error) {
+	return nil, readOnlyErr()
+}
+
+// RegisterInstance implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) RegisterInstance(c context.Context, r *api.Instance) (*api.RegisterInstanceResponse, error) {
+	return nil, readOnlyErr()
+}
+
+// CreateRef implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) CreateRef(c context.Context, r *api.Ref) (*empty.Empty, error) {
+	return nil, readOnlyErr()
+}
+
+// DeleteRef implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) DeleteRef(c context.Context, r *api.DeleteRefRequest) (*empty.Empty, error) {
+	return nil, readOnlyErr()
+}
+
+// AttachTags implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) AttachTags(c context.Context, r *api.AttachTagsRequest) (*empty.Empty, error) {
+	return nil, readOnlyErr()
+}
+
+// DetachTags implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) DetachTags(c context.Context, r *api.DetachTagsRequest) (*empty.Empty, error) {
+	return nil, readOnlyErr()
+}
+
+// AttachMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) AttachMetadata(c context.Context, r *api.AttachMetadataRequest) (*empty.Empty, error) {
+	return nil, readOnlyErr()
+}
+
+// DetachMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) DetachMetadata(c context.Context, r *api.DetachMetadataRequest) (*empty.Empty, error) {
+	return nil, readOnlyErr()
+}
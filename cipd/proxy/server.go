@@ -0,0 +1,121 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.chromium.org/luci/grpc/discovery"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/server/router"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+)
+
+// Options are passed to New.
+type Options struct {
+	// Upstream is a client of the upstream CIPD repository service.
+	//
+	// It should be authenticated as some identity that can read all packages
+	// served through the proxy.
+	Upstream api.RepositoryClient
+
+	// HTTPClient is used to download package files via signed URLs produced by
+	// the upstream.
+	//
+	// Default is http.DefaultClient.
+	HTTPClient *http.Client
+
+	// CacheDir is a directory to store cached package files in.
+	CacheDir string
+
+	// CacheSize is the maximum total size of cached files, in bytes.
+	//
+	// Least recently used files are evicted when the cache grows larger.
+	CacheSize int64
+}
+
+// Server is a caching CIPD proxy.
+type Server struct {
+	repo    *repoImpl
+	fetcher *fetcher
+}
+
+// New initializes the proxy server.
+//
+// The given context is used for logging and as a root context for background
+// fetches.
+func New(ctx context.Context, opts Options) (*Server, error) {
+	switch {
+	case opts.Upstream == nil:
+		return nil, fmt.Errorf("Upstream is required")
+	case opts.CacheDir == "":
+		return nil, fmt.Errorf("CacheDir is required")
+	case opts.CacheSize <= 0:
+		return nil, fmt.Errorf("CacheSize should be positive")
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+
+	cache, err := openDiskCache(ctx, opts.CacheDir, opts.CacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{
+		repo: &repoImpl{upstream: opts.Upstream},
+		fetcher: &fetcher{
+			ctx:      ctx,
+			upstream: opts.Upstream,
+			client:   opts.HTTPClient,
+			cache:    cache,
+			inflight: map[string]*fetchOp{},
+		},
+	}, nil
+}
+
+// InstallHandlers installs pRPC services and HTTP endpoints into the router.
+func (s *Server) InstallHandlers(r *router.Router, base router.MiddlewareChain) {
+	base = base.Extend(withBaseURL)
+
+	srv := &prpc.Server{Authenticator: prpc.NoAuthentication}
+	api.RegisterRepositoryServer(srv, s.repo)
+	discovery.Enable(srv)
+	srv.InstallHandlers(r, base)
+
+	r.GET("/cas/:algo/:digest", base, s.fetcher.downloadHandler)
+}
+
+var baseURLKey = "cipd proxy base URL"
+
+// withBaseURL is a middleware that puts the root URL of the server (as seen
+// by the client) into the context.
+func withBaseURL(c *router.Context, next router.Handler) {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	c.Context = context.WithValue(c.Context, &baseURLKey, scheme+"://"+c.Request.Host)
+	next(c)
+}
+
+// baseURL returns the root URL of the server.
+func baseURL(ctx context.Context) string {
+	url, _ := ctx.Value(&baseURLKey).(string)
+	return url
+}
@@ -27,6 +27,10 @@ import (
 	"go.chromium.org/luci/config/vars"
 )
 
+// ErrNotConfigured is returned by all calls of a client created by New when
+// neither a LUCI Config service nor a local config directory is set.
+var ErrNotConfigured = errors.New("LUCI Config client is not configured")
+
 // Options describe how to configure a LUCI Config client.
 type Options struct {
 	// Vars define how to substitute ${var} placeholders in config sets and paths.
@@ -64,11 +68,11 @@ type Options struct {
 // The client fetches configs either from a LUCI Config service or from a local
 // directory on disk (e.g. when running locally in development mode), depending
 // on values of ServiceHost and ConfigsDir. If neither are set, returns a client
-// that fails all calls with an error.
+// that fails all calls with ErrNotConfigured.
 func New(opts Options) (config.Interface, error) {
 	switch {
 	case opts.ServiceHost == "" && opts.ConfigsDir == "":
-		return erroring.New(errors.New("LUCI Config client is not configured")), nil
+		return erroring.New(ErrNotConfigured), nil
 	case opts.ServiceHost != "" && opts.ConfigsDir != "":
 		return nil, errors.New("either a LUCI Config service or a local config directory should be used, not both")
 	case opts.ServiceHost != "" && opts.ClientFactory == nil:
@@ -39,6 +39,7 @@ type Storage struct {
 	//
 	// Types that are assignable to Type:
 	//	*Storage_Bigtable
+	//	*Storage_Filesystem_
 	Type isStorage_Type `protobuf_oneof:"Type"`
 }
 
@@ -88,6 +89,13 @@ func (x *Storage) GetBigtable() *Storage_BigTable {
 	return nil
 }
 
+func (x *Storage) GetFilesystem() *Storage_Filesystem {
+	if x, ok := x.GetType().(*Storage_Filesystem_); ok {
+		return x.Filesystem
+	}
+	return nil
+}
+
 type isStorage_Type interface {
 	isStorage_Type()
 }
@@ -96,8 +104,14 @@ type Storage_Bigtable struct {
 	Bigtable *Storage_BigTable `protobuf:"bytes,1,opt,name=bigtable,proto3,oneof"`
 }
 
+type Storage_Filesystem_ struct {
+	Filesystem *Storage_Filesystem `protobuf:"bytes,3,opt,name=filesystem,proto3,oneof"`
+}
+
 func (*Storage_Bigtable) isStorage_Type() {}
 
+func (*Storage_Filesystem_) isStorage_Type() {}
+
 // BigTable is the set of BigTable configuration parameters.
 type Storage_BigTable struct {
 	state         protoimpl.MessageState
@@ -165,6 +179,59 @@ func (x *Storage_BigTable) GetLogTableName() string {
 	return ""
 }
 
+// Filesystem is the set of local filesystem storage configuration
+// parameters.
+//
+// This is intended for local development, where all LogDog services run on a
+// single machine.
+type Storage_Filesystem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The root directory of the storage.
+	Dir string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+}
+
+func (x *Storage_Filesystem) Reset() {
+	*x = Storage_Filesystem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Storage_Filesystem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Storage_Filesystem) ProtoMessage() {}
+
+func (x *Storage_Filesystem) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Storage_Filesystem.ProtoReflect.Descriptor instead.
+func (*Storage_Filesystem) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Storage_Filesystem) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
 var File_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_rawDesc = []byte{
@@ -172,24 +239,30 @@ var file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_rawDesc
 	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x76, 0x63, 0x63, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x09, 0x73, 0x76, 0x63, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xc7, 0x01, 0x0a, 0x07,
+	0x12, 0x09, 0x73, 0x76, 0x63, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xa8, 0x02, 0x0a, 0x07,
 	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x62, 0x69, 0x67, 0x74, 0x61,
 	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x76, 0x63, 0x63,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x69,
 	0x67, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x08, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x1a, 0x66, 0x0a, 0x08, 0x42, 0x69, 0x67, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x5f, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f,
-	0x67, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f,
-	0x67, 0x5f, 0x61, 0x67, 0x65, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x6c, 0x6f,
-	0x67, 0x64, 0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f,
-	0x73, 0x76, 0x63, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x76, 0x63, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x1a, 0x66, 0x0a, 0x08, 0x42, 0x69, 0x67, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x5f, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c,
+	0x6f, 0x67, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x1e, 0x0a, 0x0a, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x6c,
+	0x6f, 0x67, 0x5f, 0x61, 0x67, 0x65, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x6c,
+	0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2f, 0x73, 0x76, 0x63, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -204,18 +277,20 @@ func file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_rawDesc
 	return file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_goTypes = []interface{}{
-	(*Storage)(nil),          // 0: svcconfig.Storage
-	(*Storage_BigTable)(nil), // 1: svcconfig.Storage.BigTable
+	(*Storage)(nil),            // 0: svcconfig.Storage
+	(*Storage_BigTable)(nil),   // 1: svcconfig.Storage.BigTable
+	(*Storage_Filesystem)(nil), // 2: svcconfig.Storage.Filesystem
 }
 var file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_depIdxs = []int32{
 	1, // 0: svcconfig.Storage.bigtable:type_name -> svcconfig.Storage.BigTable
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: svcconfig.Storage.filesystem:type_name -> svcconfig.Storage.Filesystem
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_init() }
@@ -248,9 +323,22 @@ func file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_init()
 				return nil
 			}
 		}
+		file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Storage_Filesystem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Storage_Bigtable)(nil),
+		(*Storage_Filesystem_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -258,7 +346,7 @@ func file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_init()
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_logdog_api_config_svcconfig_storage_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -104,6 +104,13 @@ func (c *Client) Query(_ context.Context, in *logs_api.QueryRequest, _ ...grpc.C
 	return c.logsServ.Query(c.ctx, realIn)
 }
 
+// Search implements logs.Search.
+func (c *Client) Search(_ context.Context, in *logs_api.SearchRequest, _ ...grpc.CallOption) (*logs_api.SearchResponse, error) {
+	realIn := proto.Clone(in).(*logs_api.SearchRequest)
+	realIn.Project = coordinatorTest.AllAccessProject
+	return c.logsServ.Search(c.ctx, realIn)
+}
+
 // OpenTextStream returns a stream for text (line delimited) data.
 //
 //  - Lines are always delimited with "\n".
@@ -121,6 +128,14 @@ func (c *Client) OpenDatagramStream(prefix, path logdog_types.StreamName, flags
 	return c.open(logpb.StreamType_DATAGRAM, prefix, path, flags...)
 }
 
+// OpenStructuredStream returns a stream for structured records.
+//
+// Each Write must be a single serialized logpb.Structured record, and will
+// produce a single record in the stream.
+func (c *Client) OpenStructuredStream(prefix, path logdog_types.StreamName, flags ...*streamproto.Flags) (*Stream, error) {
+	return c.open(logpb.StreamType_STRUCTURED, prefix, path, flags...)
+}
+
 // OpenBinaryStream returns a stream for binary data.
 //
 // Each Write will append to the binary data like a normal raw file.
@@ -20,6 +20,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/proto/google"
 	services "go.chromium.org/luci/logdog/api/endpoints/coordinator/services/v1"
@@ -32,7 +34,8 @@ import (
 //
 // Each invocation of Write() will append a new LogEntry to the stream
 // internally. For datagram streams, this means that each Write is a single
-// datagram.
+// datagram. For structured streams, each Write must be a single serialized
+// logpb.Structured record.
 //
 // Once the Stream is Close()'d it will be marked as complete.
 type Stream struct {
@@ -51,6 +54,14 @@ type Stream struct {
 }
 
 func (s *Stream) Write(bs []byte) (int, error) {
+	var rec *logpb.Structured
+	if s.streamType == logpb.StreamType_STRUCTURED {
+		rec = &logpb.Structured{}
+		if err := proto.Unmarshal(bs, rec); err != nil {
+			return 0, err
+		}
+	}
+
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -91,6 +102,10 @@ func (s *Stream) Write(bs []byte) (int, error) {
 			Data: bs,
 		}}
 		s.sequence++
+
+	case logpb.StreamType_STRUCTURED:
+		entry.Content = &logpb.LogEntry_Structured{Structured: rec}
+		s.sequence++
 	}
 
 	s.c.storage.PutEntries(s.c.ctx, coordinatorTest.AllAccessProject, s.pth, entry)
@@ -454,6 +454,9 @@ type QueryRequest struct {
 	Tags map[string]string `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Purged restricts the query to streams that have or haven't been purged.
 	Purged QueryRequest_Trinary `protobuf:"varint,16,opt,name=purged,proto3,enum=logdog.QueryRequest_Trinary" json:"purged,omitempty"`
+	// Fields, if not empty, restricts results to structured streams that declare
+	// all of the supplied field keys.
+	Fields []string `protobuf:"bytes,17,rep,name=fields,proto3" json:"fields,omitempty"`
 }
 
 func (x *QueryRequest) Reset() {
@@ -572,6 +575,13 @@ func (x *QueryRequest) GetPurged() QueryRequest_Trinary {
 	return QueryRequest_BOTH
 }
 
+func (x *QueryRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 // QueryResponse is the response structure for the user Query endpoint.
 type QueryResponse struct {
 	state         protoimpl.MessageState
@@ -641,6 +651,182 @@ func (x *QueryResponse) GetNext() string {
 	return ""
 }
 
+// SearchRequest is the request structure for the user Search endpoint.
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// (required) The project to search in.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// (required) The stream query parameter selecting the log streams to search.
+	//
+	// This uses the same syntax as QueryRequest's path. Only TEXT log streams
+	// that have not been purged are searched.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// (required) The pattern to search for.
+	//
+	// By default, this is a literal substring. If Regexp is true, this is an RE2
+	// regular expression.
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// If true, Pattern is interpreted as an RE2 regular expression.
+	Regexp bool `protobuf:"varint,4,opt,name=regexp,proto3" json:"regexp,omitempty"`
+	// Next, if not empty, indicates that this search should continue at the
+	// point where the previous search left off.
+	Next string `protobuf:"bytes,5,opt,name=next,proto3" json:"next,omitempty"`
+	// MaxResults is the maximum number of matches to return.
+	//
+	// If MaxResults is zero, no upper bound will be indicated. However, the
+	// returned match count is still be subject to internal constraints.
+	MaxResults int32 `protobuf:"varint,6,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetRegexp() bool {
+	if x != nil {
+		return x.Regexp
+	}
+	return false
+}
+
+func (x *SearchRequest) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+// SearchResponse is the response structure for the user Search endpoint.
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Project is the project name that all responses belong to.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// The list of matching lines, ordered by stream and then by stream index.
+	Matches []*SearchResponse_Match `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
+	// If not empty, indicates that there may be more matches available. These
+	// can be requested by repeating the Search request with the same Path and
+	// Pattern and supplying this value in the Next field.
+	//
+	// Next may be set even if Matches is empty, if the search stopped because
+	// it reached its internal scan limit.
+	Next string `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchResponse) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *SearchResponse) GetMatches() []*SearchResponse_Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
 // If supplied, the response will contain a SignedUrls message with the
 // requested signed URLs. If signed URLs are not supported by the log's
 // current storage system, the response message will be empty.
@@ -660,7 +846,7 @@ type GetRequest_SignURLRequest struct {
 func (x *GetRequest_SignURLRequest) Reset() {
 	*x = GetRequest_SignURLRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[5]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -673,7 +859,7 @@ func (x *GetRequest_SignURLRequest) String() string {
 func (*GetRequest_SignURLRequest) ProtoMessage() {}
 
 func (x *GetRequest_SignURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[5]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,7 +913,7 @@ type GetResponse_SignedUrls struct {
 func (x *GetResponse_SignedUrls) Reset() {
 	*x = GetResponse_SignedUrls{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[6]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -740,7 +926,7 @@ func (x *GetResponse_SignedUrls) String() string {
 func (*GetResponse_SignedUrls) ProtoMessage() {}
 
 func (x *GetResponse_SignedUrls) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[6]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +976,7 @@ type QueryRequest_StreamTypeFilter struct {
 func (x *QueryRequest_StreamTypeFilter) Reset() {
 	*x = QueryRequest_StreamTypeFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[7]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -803,7 +989,7 @@ func (x *QueryRequest_StreamTypeFilter) String() string {
 func (*QueryRequest_StreamTypeFilter) ProtoMessage() {}
 
 func (x *QueryRequest_StreamTypeFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[7]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +1041,7 @@ type QueryResponse_Stream struct {
 func (x *QueryResponse_Stream) Reset() {
 	*x = QueryResponse_Stream{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[9]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -868,7 +1054,7 @@ func (x *QueryResponse_Stream) String() string {
 func (*QueryResponse_Stream) ProtoMessage() {}
 
 func (x *QueryResponse_Stream) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[9]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,6 +1098,84 @@ func (x *QueryResponse_Stream) GetDescProto() []byte {
 	return nil
 }
 
+// Match is a single log line that matched the search pattern.
+type SearchResponse_Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path is the log stream path.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Index is the stream index of the log entry containing the line.
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Line is the zero-based index of the matching line within the log entry.
+	Line int32 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	// Snippet is the matching line, without its delimiter.
+	//
+	// Very long lines are truncated around the match.
+	Snippet string `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+}
+
+func (x *SearchResponse_Match) Reset() {
+	*x = SearchResponse_Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse_Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse_Match) ProtoMessage() {}
+
+func (x *SearchResponse_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse_Match.ProtoReflect.Descriptor instead.
+func (*SearchResponse_Match) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *SearchResponse_Match) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SearchResponse_Match) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SearchResponse_Match) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *SearchResponse_Match) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
 var File_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_rawDesc = []byte{
@@ -984,7 +1248,7 @@ var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_prot
 	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65,
 	0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
 	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x87, 0x05, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x9f, 0x05, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
 	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
 	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -1013,51 +1277,80 @@ var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_prot
 	0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x75, 0x72,
 	0x67, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x67, 0x64,
 	0x6f, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x54, 0x72, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x06, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x1a,
-	0x3b, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x37, 0x0a, 0x09,
-	0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x24, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x59, 0x45,
-	0x53, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x4f, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x0e, 0x10,
-	0x0f, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x91, 0x02, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x36, 0x0a, 0x07,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x07, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x1a, 0x99, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e,
-	0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52,
-	0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x73, 0x63, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x32, 0x9e, 0x01, 0x0a, 0x04, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x2e, 0x0a,
-	0x03, 0x47, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f,
-	0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
-	0x04, 0x54, 0x61, 0x69, 0x6c, 0x12, 0x13, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x54,
-	0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x67,
-	0x64, 0x6f, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x34, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f,
-	0x67, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
-	0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x6c, 0x6f,
-	0x67, 0x64, 0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x6c,
-	0x6f, 0x67, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x72, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x06, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x3b, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x67,
+	0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x24, 0x0a,
+	0x07, 0x54, 0x72, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x54, 0x48,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x59, 0x45, 0x53, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4e,
+	0x4f, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x0e, 0x10, 0x0f, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x91, 0x02, 0x0a, 0x0d, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74,
+	0x1a, 0x99, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a,
+	0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f,
+	0x67, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x1d, 0x0a,
+	0x0a, 0x64, 0x65, 0x73, 0x63, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x64, 0x65, 0x73, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x01, 0x0a,
+	0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65,
+	0x78, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x36, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x1a, 0x5f, 0x0a, 0x05,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x32, 0xd7, 0x01,
+	0x0a, 0x04, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x12, 0x2e,
+	0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x54, 0x61, 0x69, 0x6c, 0x12, 0x13,
+	0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x54, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x14, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f,
+	0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f,
+	0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1073,7 +1366,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 }
 
 var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_goTypes = []interface{}{
 	(QueryRequest_Trinary)(0),             // 0: logdog.QueryRequest.Trinary
 	(*GetRequest)(nil),                    // 1: logdog.GetRequest
@@ -1081,46 +1374,52 @@ var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_prot
 	(*GetResponse)(nil),                   // 3: logdog.GetResponse
 	(*QueryRequest)(nil),                  // 4: logdog.QueryRequest
 	(*QueryResponse)(nil),                 // 5: logdog.QueryResponse
-	(*GetRequest_SignURLRequest)(nil),     // 6: logdog.GetRequest.SignURLRequest
-	(*GetResponse_SignedUrls)(nil),        // 7: logdog.GetResponse.SignedUrls
-	(*QueryRequest_StreamTypeFilter)(nil), // 8: logdog.QueryRequest.StreamTypeFilter
-	nil,                                   // 9: logdog.QueryRequest.TagsEntry
-	(*QueryResponse_Stream)(nil),          // 10: logdog.QueryResponse.Stream
-	(*LogStreamState)(nil),                // 11: logdog.LogStreamState
-	(*logpb.LogStreamDescriptor)(nil),     // 12: logpb.LogStreamDescriptor
-	(*logpb.LogEntry)(nil),                // 13: logpb.LogEntry
-	(*timestamp.Timestamp)(nil),           // 14: google.protobuf.Timestamp
-	(*duration.Duration)(nil),             // 15: google.protobuf.Duration
-	(logpb.StreamType)(0),                 // 16: logpb.StreamType
+	(*SearchRequest)(nil),                 // 6: logdog.SearchRequest
+	(*SearchResponse)(nil),                // 7: logdog.SearchResponse
+	(*GetRequest_SignURLRequest)(nil),     // 8: logdog.GetRequest.SignURLRequest
+	(*GetResponse_SignedUrls)(nil),        // 9: logdog.GetResponse.SignedUrls
+	(*QueryRequest_StreamTypeFilter)(nil), // 10: logdog.QueryRequest.StreamTypeFilter
+	nil,                                   // 11: logdog.QueryRequest.TagsEntry
+	(*QueryResponse_Stream)(nil),          // 12: logdog.QueryResponse.Stream
+	(*SearchResponse_Match)(nil),          // 13: logdog.SearchResponse.Match
+	(*LogStreamState)(nil),                // 14: logdog.LogStreamState
+	(*logpb.LogStreamDescriptor)(nil),     // 15: logpb.LogStreamDescriptor
+	(*logpb.LogEntry)(nil),                // 16: logpb.LogEntry
+	(*timestamp.Timestamp)(nil),           // 17: google.protobuf.Timestamp
+	(*duration.Duration)(nil),             // 18: google.protobuf.Duration
+	(logpb.StreamType)(0),                 // 19: logpb.StreamType
 }
 var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_depIdxs = []int32{
-	6,  // 0: logdog.GetRequest.get_signed_urls:type_name -> logdog.GetRequest.SignURLRequest
-	11, // 1: logdog.GetResponse.state:type_name -> logdog.LogStreamState
-	12, // 2: logdog.GetResponse.desc:type_name -> logpb.LogStreamDescriptor
-	13, // 3: logdog.GetResponse.logs:type_name -> logpb.LogEntry
-	7,  // 4: logdog.GetResponse.signed_urls:type_name -> logdog.GetResponse.SignedUrls
-	8,  // 5: logdog.QueryRequest.stream_type:type_name -> logdog.QueryRequest.StreamTypeFilter
-	14, // 6: logdog.QueryRequest.newer:type_name -> google.protobuf.Timestamp
-	14, // 7: logdog.QueryRequest.older:type_name -> google.protobuf.Timestamp
-	9,  // 8: logdog.QueryRequest.tags:type_name -> logdog.QueryRequest.TagsEntry
+	8,  // 0: logdog.GetRequest.get_signed_urls:type_name -> logdog.GetRequest.SignURLRequest
+	14, // 1: logdog.GetResponse.state:type_name -> logdog.LogStreamState
+	15, // 2: logdog.GetResponse.desc:type_name -> logpb.LogStreamDescriptor
+	16, // 3: logdog.GetResponse.logs:type_name -> logpb.LogEntry
+	9,  // 4: logdog.GetResponse.signed_urls:type_name -> logdog.GetResponse.SignedUrls
+	10, // 5: logdog.QueryRequest.stream_type:type_name -> logdog.QueryRequest.StreamTypeFilter
+	17, // 6: logdog.QueryRequest.newer:type_name -> google.protobuf.Timestamp
+	17, // 7: logdog.QueryRequest.older:type_name -> google.protobuf.Timestamp
+	11, // 8: logdog.QueryRequest.tags:type_name -> logdog.QueryRequest.TagsEntry
 	0,  // 9: logdog.QueryRequest.purged:type_name -> logdog.QueryRequest.Trinary
-	10, // 10: logdog.QueryResponse.streams:type_name -> logdog.QueryResponse.Stream
-	15, // 11: logdog.GetRequest.SignURLRequest.lifetime:type_name -> google.protobuf.Duration
-	14, // 12: logdog.GetResponse.SignedUrls.expiration:type_name -> google.protobuf.Timestamp
-	16, // 13: logdog.QueryRequest.StreamTypeFilter.value:type_name -> logpb.StreamType
-	11, // 14: logdog.QueryResponse.Stream.state:type_name -> logdog.LogStreamState
-	12, // 15: logdog.QueryResponse.Stream.desc:type_name -> logpb.LogStreamDescriptor
-	1,  // 16: logdog.Logs.Get:input_type -> logdog.GetRequest
-	2,  // 17: logdog.Logs.Tail:input_type -> logdog.TailRequest
-	4,  // 18: logdog.Logs.Query:input_type -> logdog.QueryRequest
-	3,  // 19: logdog.Logs.Get:output_type -> logdog.GetResponse
-	3,  // 20: logdog.Logs.Tail:output_type -> logdog.GetResponse
-	5,  // 21: logdog.Logs.Query:output_type -> logdog.QueryResponse
-	19, // [19:22] is the sub-list for method output_type
-	16, // [16:19] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	12, // 10: logdog.QueryResponse.streams:type_name -> logdog.QueryResponse.Stream
+	13, // 11: logdog.SearchResponse.matches:type_name -> logdog.SearchResponse.Match
+	18, // 12: logdog.GetRequest.SignURLRequest.lifetime:type_name -> google.protobuf.Duration
+	17, // 13: logdog.GetResponse.SignedUrls.expiration:type_name -> google.protobuf.Timestamp
+	19, // 14: logdog.QueryRequest.StreamTypeFilter.value:type_name -> logpb.StreamType
+	14, // 15: logdog.QueryResponse.Stream.state:type_name -> logdog.LogStreamState
+	15, // 16: logdog.QueryResponse.Stream.desc:type_name -> logpb.LogStreamDescriptor
+	1,  // 17: logdog.Logs.Get:input_type -> logdog.GetRequest
+	2,  // 18: logdog.Logs.Tail:input_type -> logdog.TailRequest
+	4,  // 19: logdog.Logs.Query:input_type -> logdog.QueryRequest
+	6,  // 20: logdog.Logs.Search:input_type -> logdog.SearchRequest
+	3,  // 21: logdog.Logs.Get:output_type -> logdog.GetResponse
+	3,  // 22: logdog.Logs.Tail:output_type -> logdog.GetResponse
+	5,  // 23: logdog.Logs.Query:output_type -> logdog.QueryResponse
+	7,  // 24: logdog.Logs.Search:output_type -> logdog.SearchResponse
+	21, // [21:25] is the sub-list for method output_type
+	17, // [17:21] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_init() }
@@ -1191,7 +1490,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRequest_SignURLRequest); i {
+			switch v := v.(*SearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1203,7 +1502,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetResponse_SignedUrls); i {
+			switch v := v.(*SearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1215,7 +1514,19 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequest_StreamTypeFilter); i {
+			switch v := v.(*GetRequest_SignURLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResponse_SignedUrls); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1227,6 +1538,18 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequest_StreamTypeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryResponse_Stream); i {
 			case 0:
 				return &v.state
@@ -1238,6 +1561,18 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 				return nil
 			}
 		}
+		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1245,7 +1580,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1278,6 +1613,9 @@ type LogsClient interface {
 	Tail(ctx context.Context, in *TailRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	// Query returns log stream paths that match the requested query.
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// Search returns log lines that match the requested pattern, across all of
+	// the log streams selected by a stream query.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 }
 type logsPRPCClient struct {
 	client *prpc.Client
@@ -1314,6 +1652,15 @@ func (c *logsPRPCClient) Query(ctx context.Context, in *QueryRequest, opts ...gr
 	return out, nil
 }
 
+func (c *logsPRPCClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.client.Call(ctx, "logdog.Logs", "Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type logsClient struct {
 	cc grpc.ClientConnInterface
 }
@@ -1349,6 +1696,15 @@ func (c *logsClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *logsClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/logdog.Logs/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LogsServer is the server API for Logs service.
 type LogsServer interface {
 	// Get returns state and log data for a single log stream.
@@ -1357,6 +1713,9 @@ type LogsServer interface {
 	Tail(context.Context, *TailRequest) (*GetResponse, error)
 	// Query returns log stream paths that match the requested query.
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// Search returns log lines that match the requested pattern, across all of
+	// the log streams selected by a stream query.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 }
 
 // UnimplementedLogsServer can be embedded to have forward compatible implementations.
@@ -1372,6 +1731,9 @@ func (*UnimplementedLogsServer) Tail(context.Context, *TailRequest) (*GetRespons
 func (*UnimplementedLogsServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (*UnimplementedLogsServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
 
 func RegisterLogsServer(s prpc.Registrar, srv LogsServer) {
 	s.RegisterService(&_Logs_serviceDesc, srv)
@@ -1431,6 +1793,24 @@ func _Logs_Query_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Logs_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogsServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logdog.Logs/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogsServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Logs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "logdog.Logs",
 	HandlerType: (*LogsServer)(nil),
@@ -1447,6 +1827,10 @@ var _Logs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _Logs_Query_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _Logs_Search_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1/logs.proto",
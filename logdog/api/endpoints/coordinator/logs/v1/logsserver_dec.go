@@ -73,3 +73,20 @@ func (s *DecoratedLogs) Query(ctx context.Context, req *QueryRequest) (rsp *Quer
 	}
 	return
 }
+
+func (s *DecoratedLogs) Search(ctx context.Context, req *SearchRequest) (rsp *SearchResponse, err error) {
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "Search", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		rsp, err = s.Service.Search(ctx, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "Search", rsp, err)
+	}
+	return
+}
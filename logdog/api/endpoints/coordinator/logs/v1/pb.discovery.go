@@ -12,976 +12,867 @@ func init() {
 			"logdog.Logs",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 125, 112, 28, 201,
-			117, 24, 190, 243, 177, 139, 69, 3, 36, 192, 33, 200, 195, 13,
-			191, 154, 123, 228, 1, 32, 23, 139, 15, 30, 121, 58, 240, 78,
-			50, 8, 44, 201, 61, 129, 0, 180, 88, 232, 116, 119, 82, 241,
-			102, 119, 122, 23, 115, 55, 59, 179, 55, 51, 11, 16, 119, 58,
-			249, 75, 63, 201, 63, 169, 126, 46, 75, 118, 249, 247, 179, 245,
-			139, 171, 172, 148, 82, 145, 28, 37, 229, 178, 93, 73, 217, 142,
-			34, 43, 113, 165, 226, 47, 57, 113, 162, 178, 28, 75, 85, 46,
-			197, 78, 249, 15, 231, 143, 40, 74, 226, 63, 172, 164, 222, 235,
-			238, 249, 88, 128, 60, 210, 58, 87, 202, 46, 177, 238, 200, 125,
-			61, 61, 221, 239, 189, 126, 253, 250, 189, 126, 175, 123, 200, 31,
-			204, 145, 115, 29, 223, 239, 184, 108, 174, 23, 248, 145, 223, 236,
-			183, 231, 34, 167, 203, 194, 200, 234, 246, 42, 88, 100, 140, 241,
-			10, 21, 89, 161, 116, 157, 12, 55, 100, 29, 99, 146, 12, 133,
-			172, 229, 123, 118, 56, 169, 80, 101, 90, 171, 75, 208, 152, 32,
-			121, 207, 242, 252, 112, 82, 165, 202, 116, 190, 206, 129, 27, 31,
-			33, 199, 91, 126, 183, 50, 208, 230, 141, 163, 113, 139, 155, 80,
-			180, 169, 188, 116, 185, 227, 68, 59, 253, 102, 165, 229, 119, 231,
-			58, 190, 107, 121, 157, 4, 197, 94, 180, 223, 99, 97, 130, 233,
-			255, 84, 148, 207, 170, 218, 173, 205, 27, 95, 80, 207, 222, 226,
-			45, 111, 138, 186, 149, 23, 152, 235, 190, 215, 243, 247, 188, 6,
-			188, 243, 252, 215, 103, 201, 144, 145, 63, 155, 251, 191, 21, 133,
-			252, 206, 40, 81, 70, 13, 237, 108, 206, 88, 252, 141, 81, 138,
-			111, 180, 124, 151, 222, 232, 183, 219, 44, 8, 233, 44, 229, 109,
-			77, 133, 212, 182, 34, 139, 58, 94, 196, 130, 214, 142, 229, 117,
-			24, 109, 251, 65, 215, 138, 8, 93, 241, 123, 251, 129, 211, 217,
-			137, 232, 226, 252, 252, 187, 196, 11, 180, 230, 181, 42, 148, 46,
-			187, 46, 197, 103, 33, 13, 88, 200, 130, 93, 102, 87, 8, 221,
-			137, 162, 94, 184, 52, 55, 103, 179, 93, 230, 250, 61, 22, 132,
-			146, 25, 64, 105, 79, 32, 49, 219, 228, 72, 204, 17, 66, 235,
-			204, 118, 194, 40, 112, 154, 253, 200, 241, 61, 106, 121, 54, 237,
-			135, 140, 58, 30, 13, 253, 126, 208, 98, 88, 210, 116, 60, 43,
-			216, 71, 188, 194, 50, 221, 115, 162, 29, 234, 7, 248, 175, 223,
-			143, 8, 237, 250, 182, 211, 118, 90, 22, 180, 80, 166, 86, 192,
-			104, 143, 5, 93, 39, 138, 152, 77, 123, 129, 191, 235, 216, 204,
-			166, 209, 142, 21, 209, 104, 7, 168, 115, 93, 127, 207, 241, 58,
-			20, 134, 210, 129, 151, 66, 120, 137, 208, 46, 139, 150, 8, 161,
-			240, 231, 210, 0, 98, 33, 245, 219, 18, 163, 150, 111, 51, 218,
-			237, 135, 17, 13, 88, 100, 57, 30, 182, 106, 53, 253, 93, 120,
-			36, 56, 70, 168, 231, 71, 78, 139, 149, 105, 180, 227, 132, 212,
-			117, 194, 8, 90, 72, 247, 232, 217, 3, 232, 216, 78, 216, 114,
-			45, 167, 203, 130, 202, 253, 144, 112, 188, 52, 47, 36, 18, 189,
-			192, 183, 251, 45, 150, 224, 65, 18, 68, 190, 39, 60, 8, 21,
-			212, 217, 126, 171, 223, 101, 94, 100, 201, 65, 154, 243, 3, 234,
-			71, 59, 44, 160, 93, 43, 98, 129, 99, 185, 97, 194, 106, 28,
-			160, 104, 135, 17, 154, 198, 62, 38, 106, 157, 57, 248, 38, 52,
-			236, 89, 93, 6, 8, 165, 101, 203, 243, 147, 103, 200, 119, 39,
-			10, 129, 34, 143, 55, 229, 7, 33, 237, 90, 251, 180, 201, 64,
-			82, 108, 26, 249, 148, 121, 182, 31, 132, 12, 132, 162, 23, 248,
-			93, 63, 98, 148, 243, 36, 10, 169, 205, 2, 103, 151, 217, 180,
-			29, 248, 93, 194, 185, 16, 250, 237, 104, 15, 196, 68, 72, 16,
-			13, 123, 172, 5, 18, 68, 123, 129, 3, 130, 21, 128, 236, 120,
-			92, 138, 194, 16, 113, 39, 180, 113, 187, 182, 69, 183, 54, 110,
-			54, 94, 88, 174, 87, 105, 109, 139, 110, 214, 55, 222, 95, 91,
-			173, 174, 210, 27, 47, 210, 198, 237, 42, 93, 217, 216, 124, 177,
-			94, 187, 117, 187, 65, 111, 111, 172, 173, 86, 235, 91, 116, 121,
-			125, 149, 174, 108, 172, 55, 234, 181, 27, 219, 141, 141, 250, 22,
-			161, 165, 229, 45, 90, 219, 42, 225, 147, 229, 245, 23, 105, 245,
-			3, 155, 245, 234, 214, 22, 221, 168, 211, 218, 157, 205, 181, 90,
-			117, 149, 190, 176, 92, 175, 47, 175, 55, 106, 213, 173, 50, 173,
-			173, 175, 172, 109, 175, 214, 214, 111, 149, 233, 141, 237, 6, 93,
-			223, 104, 16, 186, 86, 187, 83, 107, 84, 87, 105, 99, 163, 140,
-			221, 30, 124, 143, 110, 220, 164, 119, 170, 245, 149, 219, 203, 235,
-			141, 229, 27, 181, 181, 90, 227, 69, 236, 240, 102, 173, 177, 14,
-			157, 221, 220, 168, 19, 186, 76, 55, 151, 235, 141, 218, 202, 246,
-			218, 114, 157, 110, 110, 215, 55, 55, 182, 170, 20, 40, 91, 173,
-			109, 173, 172, 45, 215, 238, 84, 87, 43, 180, 182, 78, 215, 55,
-			104, 245, 253, 213, 245, 6, 221, 186, 189, 188, 182, 150, 37, 148,
-			208, 141, 23, 214, 171, 117, 192, 62, 77, 38, 189, 81, 165, 107,
-			181, 229, 27, 107, 85, 232, 10, 233, 92, 173, 213, 171, 43, 13,
-			32, 40, 249, 181, 82, 91, 173, 174, 55, 150, 215, 202, 132, 110,
-			109, 86, 87, 106, 203, 107, 101, 90, 253, 64, 245, 206, 230, 218,
-			114, 253, 197, 178, 104, 116, 171, 250, 190, 237, 234, 122, 163, 182,
-			188, 70, 87, 151, 239, 44, 223, 170, 110, 209, 233, 183, 227, 202,
-			102, 125, 99, 101, 187, 94, 189, 3, 88, 111, 220, 164, 91, 219,
-			55, 182, 26, 181, 198, 118, 163, 74, 111, 109, 108, 172, 34, 179,
-			183, 170, 245, 247, 215, 86, 170, 91, 215, 233, 218, 198, 22, 50,
-			108, 123, 171, 90, 38, 116, 117, 185, 177, 140, 93, 111, 214, 55,
-			110, 214, 26, 91, 215, 225, 247, 141, 237, 173, 26, 50, 174, 182,
-			222, 168, 214, 235, 219, 155, 141, 218, 198, 250, 12, 189, 189, 241,
-			66, 245, 253, 213, 58, 93, 89, 222, 222, 170, 174, 34, 135, 55,
-			214, 129, 90, 144, 149, 234, 70, 253, 69, 104, 22, 248, 128, 35,
-			80, 166, 47, 220, 174, 54, 110, 87, 235, 192, 84, 228, 214, 50,
-			176, 97, 171, 81, 175, 173, 52, 210, 213, 54, 234, 180, 177, 81,
-			111, 144, 20, 157, 116, 189, 122, 107, 173, 118, 171, 186, 190, 82,
-			133, 199, 27, 208, 204, 11, 181, 173, 234, 12, 93, 174, 215, 182,
-			160, 66, 13, 59, 166, 47, 44, 191, 72, 55, 182, 145, 106, 24,
-			168, 237, 173, 42, 225, 191, 83, 162, 91, 198, 241, 164, 181, 155,
-			116, 121, 245, 253, 53, 192, 92, 212, 222, 220, 216, 218, 170, 9,
-			113, 65, 182, 173, 220, 22, 60, 175, 16, 82, 36, 138, 106, 104,
-			52, 55, 9, 191, 138, 134, 86, 202, 93, 39, 195, 68, 45, 94,
-			228, 63, 121, 225, 19, 185, 115, 88, 120, 142, 255, 228, 133, 23,
-			114, 55, 176, 112, 132, 255, 228, 133, 23, 115, 101, 44, 84, 248,
-			79, 94, 248, 100, 110, 14, 11, 197, 79, 94, 56, 149, 43, 97,
-			33, 225, 63, 121, 225, 116, 238, 60, 22, 94, 224, 63, 191, 125,
-			138, 168, 122, 206, 200, 127, 4, 86, 62, 243, 79, 79, 209, 101,
-			26, 47, 185, 168, 31, 89, 200, 188, 40, 164, 22, 237, 249, 142,
-			23, 161, 86, 115, 186, 176, 202, 216, 172, 199, 60, 155, 121, 168,
-			21, 45, 111, 159, 151, 191, 225, 123, 168, 76, 92, 191, 101, 185,
-			132, 182, 44, 151, 121, 182, 21, 148, 41, 243, 64, 249, 219, 212,
-			130, 182, 90, 126, 159, 191, 39, 140, 2, 84, 165, 237, 192, 106,
-			37, 11, 134, 124, 0, 235, 1, 88, 8, 8, 195, 130, 233, 187,
-			92, 39, 210, 198, 14, 19, 13, 57, 176, 146, 186, 86, 228, 236,
-			50, 208, 105, 150, 71, 89, 207, 111, 237, 80, 43, 162, 219, 141,
-			21, 218, 117, 108, 15, 21, 186, 239, 17, 250, 188, 229, 245, 97,
-			21, 88, 40, 211, 133, 103, 158, 158, 47, 75, 61, 221, 11, 124,
-			151, 245, 34, 167, 69, 111, 5, 172, 227, 7, 142, 229, 197, 216,
-			211, 189, 29, 167, 181, 67, 217, 189, 136, 1, 78, 168, 159, 15,
-			169, 213, 180, 90, 175, 237, 89, 1, 212, 240, 233, 62, 179, 2,
-			234, 123, 12, 244, 31, 172, 248, 93, 199, 235, 71, 12, 151, 75,
-			122, 109, 62, 166, 207, 245, 189, 78, 133, 174, 49, 171, 151, 144,
-			28, 48, 90, 10, 187, 204, 10, 152, 93, 162, 161, 207, 215, 95,
-			207, 167, 46, 179, 122, 68, 84, 163, 145, 213, 116, 25, 80, 238,
-			49, 6, 124, 109, 251, 1, 183, 68, 122, 176, 180, 242, 245, 188,
-			31, 194, 162, 100, 209, 151, 23, 159, 154, 221, 241, 251, 1, 117,
-			29, 143, 89, 1, 161, 216, 250, 135, 166, 31, 108, 115, 192, 120,
-			206, 97, 205, 25, 84, 226, 59, 140, 6, 104, 228, 56, 33, 46,
-			9, 116, 126, 126, 126, 97, 22, 255, 107, 204, 207, 47, 225, 127,
-			47, 1, 233, 207, 60, 243, 204, 51, 179, 11, 139, 179, 87, 22,
-			26, 139, 87, 150, 174, 62, 179, 116, 245, 153, 202, 51, 242, 207,
-			75, 21, 122, 99, 159, 192, 64, 70, 129, 211, 138, 0, 193, 72,
-			144, 136, 173, 151, 233, 30, 163, 204, 11, 251, 1, 227, 165, 123,
-			140, 182, 128, 203, 190, 183, 203, 130, 136, 143, 47, 95, 147, 232,
-			203, 245, 155, 43, 132, 94, 185, 114, 229, 153, 132, 150, 189, 189,
-			189, 138, 195, 162, 118, 197, 15, 58, 115, 65, 187, 5, 255, 67,
-			141, 74, 116, 47, 154, 1, 131, 141, 81, 232, 217, 235, 132, 64,
-			212, 19, 180, 122, 207, 234, 246, 92, 22, 18, 34, 127, 210, 133,
-			37, 186, 226, 119, 123, 253, 136, 165, 230, 2, 118, 184, 185, 177,
-			85, 251, 0, 125, 5, 56, 51, 61, 243, 74, 69, 88, 60, 73,
-			165, 216, 242, 188, 206, 159, 36, 54, 115, 200, 162, 187, 98, 128,
-			167, 241, 245, 245, 237, 181, 181, 153, 153, 67, 235, 161, 188, 79,
-			207, 207, 92, 79, 225, 180, 248, 118, 56, 117, 88, 4, 173, 248,
-			109, 219, 218, 79, 225, 22, 70, 65, 191, 21, 97, 7, 187, 150,
-			75, 163, 93, 209, 99, 166, 250, 147, 209, 110, 153, 34, 66, 215,
-			255, 186, 36, 237, 86, 162, 93, 128, 30, 68, 17, 175, 212, 15,
-			89, 139, 94, 162, 11, 243, 243, 89, 10, 175, 220, 151, 194, 23,
-			28, 239, 202, 34, 125, 229, 22, 139, 182, 246, 195, 136, 117, 225,
-			241, 114, 120, 211, 113, 89, 35, 59, 16, 55, 107, 107, 213, 70,
-			237, 78, 149, 182, 35, 129, 198, 253, 222, 121, 178, 29, 73, 76,
-			183, 107, 235, 141, 107, 79, 209, 200, 105, 189, 22, 210, 231, 232,
-			244, 244, 52, 47, 153, 105, 71, 21, 123, 239, 182, 211, 217, 89,
-			181, 34, 124, 107, 134, 62, 251, 44, 189, 178, 56, 67, 63, 76,
-			241, 217, 154, 191, 39, 31, 73, 190, 205, 205, 209, 101, 192, 215,
-			246, 247, 66, 108, 18, 38, 203, 194, 252, 124, 74, 135, 133, 149,
-			184, 2, 215, 82, 11, 215, 14, 78, 163, 184, 53, 120, 125, 225,
-			218, 83, 79, 61, 245, 244, 149, 107, 243, 137, 218, 104, 178, 182,
-			31, 48, 186, 237, 57, 247, 100, 43, 207, 60, 61, 63, 216, 74,
-			229, 175, 55, 152, 211, 156, 126, 58, 61, 205, 153, 50, 135, 131,
-			5, 127, 102, 232, 108, 26, 157, 183, 145, 96, 104, 7, 216, 37,
-			219, 185, 152, 106, 7, 5, 96, 38, 35, 0, 79, 221, 87, 0,
-			158, 183, 118, 45, 250, 10, 31, 200, 74, 171, 31, 4, 204, 139,
-			160, 202, 29, 199, 117, 157, 48, 37, 0, 160, 77, 105, 23, 75,
-			233, 115, 244, 254, 47, 60, 64, 204, 233, 115, 73, 105, 197, 99,
-			123, 55, 250, 142, 107, 179, 96, 122, 6, 8, 219, 18, 28, 18,
-			93, 112, 198, 204, 240, 182, 224, 15, 212, 89, 231, 180, 59, 94,
-			4, 148, 139, 154, 156, 116, 65, 54, 114, 96, 166, 210, 132, 150,
-			17, 151, 132, 7, 87, 239, 203, 3, 65, 133, 92, 125, 233, 230,
-			126, 180, 195, 173, 235, 12, 251, 211, 232, 79, 207, 12, 142, 205,
-			45, 22, 173, 36, 220, 152, 158, 65, 13, 248, 252, 214, 198, 58,
-			189, 99, 245, 122, 142, 215, 33, 132, 214, 60, 94, 194, 93, 217,
-			50, 46, 142, 41, 62, 237, 247, 112, 1, 200, 44, 231, 92, 161,
-			138, 149, 148, 160, 90, 126, 36, 173, 204, 187, 130, 21, 221, 130,
-			197, 188, 204, 155, 225, 165, 208, 89, 233, 77, 88, 77, 223, 154,
-			125, 179, 235, 123, 209, 206, 91, 179, 111, 218, 214, 254, 91, 141,
-			55, 97, 73, 123, 107, 233, 205, 174, 227, 189, 181, 244, 102, 200,
-			90, 111, 189, 92, 121, 19, 140, 8, 16, 228, 183, 62, 244, 82,
-			137, 208, 189, 29, 22, 48, 202, 223, 134, 134, 44, 119, 207, 218,
-			15, 41, 187, 7, 118, 13, 120, 64, 124, 133, 108, 195, 218, 104,
-			59, 29, 39, 10, 97, 169, 119, 25, 21, 61, 149, 41, 118, 85,
-			38, 148, 119, 86, 166, 216, 91, 25, 151, 32, 236, 18, 87, 235,
-			55, 88, 224, 207, 246, 44, 219, 230, 62, 85, 180, 231, 203, 214,
-			152, 213, 218, 225, 150, 138, 180, 110, 192, 42, 18, 19, 173, 44,
-			236, 10, 88, 222, 58, 62, 237, 247, 112, 241, 148, 175, 78, 59,
-			21, 86, 17, 133, 11, 135, 219, 64, 51, 101, 130, 253, 251, 61,
-			222, 50, 239, 169, 244, 82, 137, 134, 253, 118, 219, 185, 7, 86,
-			26, 56, 247, 12, 109, 22, 148, 3, 180, 207, 166, 75, 219, 141,
-			149, 210, 204, 245, 76, 41, 225, 102, 212, 235, 125, 39, 96, 118,
-			133, 46, 83, 220, 115, 184, 194, 133, 33, 68, 71, 213, 121, 131,
-			5, 52, 220, 241, 251, 174, 45, 89, 217, 15, 25, 218, 88, 211,
-			86, 24, 247, 102, 211, 230, 62, 1, 52, 102, 96, 0, 60, 112,
-			13, 61, 190, 208, 31, 20, 37, 96, 164, 149, 233, 170, 103, 5,
-			97, 210, 77, 147, 17, 138, 150, 14, 172, 251, 173, 22, 235, 69,
-			180, 233, 71, 59, 216, 39, 188, 203, 61, 105, 73, 67, 120, 0,
-			15, 48, 6, 253, 118, 59, 100, 17, 26, 49, 55, 253, 128, 50,
-			62, 215, 202, 180, 180, 56, 191, 240, 52, 232, 204, 133, 171, 141,
-			249, 133, 165, 43, 243, 75, 11, 87, 43, 243, 11, 47, 149, 132,
-			116, 135, 20, 225, 88, 233, 246, 172, 48, 34, 20, 107, 98, 255,
-			190, 151, 88, 147, 87, 203, 20, 90, 171, 136, 9, 100, 237, 90,
-			91, 173, 192, 233, 69, 101, 176, 1, 51, 6, 140, 69, 97, 209,
-			160, 126, 243, 85, 214, 138, 184, 237, 3, 6, 21, 23, 118, 46,
-			143, 40, 254, 97, 100, 129, 85, 105, 19, 250, 114, 228, 215, 182,
-			54, 182, 112, 146, 77, 207, 28, 98, 182, 85, 186, 254, 27, 142,
-			235, 90, 56, 187, 152, 55, 187, 189, 53, 103, 251, 173, 112, 238,
-			5, 214, 156, 75, 80, 153, 171, 179, 54, 11, 152, 215, 98, 115,
-			183, 92, 191, 105, 185, 119, 55, 16, 135, 112, 14, 16, 154, 75,
-			117, 50, 131, 27, 58, 59, 190, 93, 1, 98, 184, 166, 41, 227,
-			60, 231, 40, 209, 87, 192, 142, 2, 166, 87, 228, 143, 87, 36,
-			65, 64, 106, 147, 73, 106, 153, 77, 14, 37, 145, 208, 151, 95,
-			9, 163, 160, 141, 175, 166, 40, 242, 91, 97, 165, 199, 53, 27,
-			208, 178, 56, 231, 58, 205, 192, 10, 246, 209, 24, 173, 236, 68,
-			93, 247, 9, 252, 37, 223, 157, 193, 141, 8, 18, 11, 178, 236,
-			36, 236, 177, 22, 157, 186, 248, 226, 236, 197, 238, 236, 69, 187,
-			113, 241, 246, 210, 197, 59, 75, 23, 183, 42, 23, 219, 47, 77,
-			85, 232, 154, 243, 26, 219, 115, 66, 134, 198, 63, 48, 40, 25,
-			165, 126, 200, 120, 107, 207, 251, 182, 133, 194, 58, 21, 210, 151,
-			95, 169, 109, 109, 200, 165, 254, 38, 87, 86, 182, 0, 167, 103,
-			94, 249, 208, 52, 223, 190, 19, 122, 238, 85, 223, 230, 35, 1,
-			63, 102, 209, 138, 182, 122, 14, 14, 136, 44, 229, 182, 53, 199,
-			117, 238, 96, 219, 72, 167, 236, 224, 226, 226, 234, 197, 197, 85,
-			66, 103, 128, 145, 126, 19, 183, 205, 44, 65, 103, 196, 2, 218,
-			178, 122, 56, 65, 252, 54, 237, 48, 143, 5, 22, 159, 106, 114,
-			154, 133, 92, 45, 199, 252, 175, 16, 252, 163, 233, 57, 197, 208,
-			62, 82, 60, 70, 254, 158, 66, 116, 61, 167, 230, 12, 253, 71,
-			20, 117, 194, 252, 113, 133, 214, 19, 183, 79, 138, 190, 223, 70,
-			137, 71, 22, 135, 142, 215, 74, 155, 30, 228, 112, 219, 131, 222,
-			233, 135, 17, 136, 194, 131, 124, 5, 114, 152, 179, 240, 18, 117,
-			188, 150, 219, 15, 157, 93, 240, 158, 142, 144, 60, 160, 151, 71,
-			252, 134, 36, 168, 0, 88, 28, 147, 160, 6, 160, 113, 156, 252,
-			41, 39, 70, 49, 244, 31, 83, 84, 195, 252, 154, 66, 215, 125,
-			111, 214, 99, 29, 238, 28, 102, 92, 76, 75, 186, 82, 224, 93,
-			29, 238, 98, 174, 139, 23, 99, 175, 107, 215, 114, 251, 44, 228,
-			219, 116, 73, 99, 184, 153, 24, 70, 142, 235, 210, 29, 107, 151,
-			81, 47, 221, 39, 54, 45, 94, 36, 220, 165, 225, 94, 107, 219,
-			15, 192, 91, 148, 46, 245, 32, 195, 132, 39, 85, 22, 255, 147,
-			67, 152, 162, 228, 145, 78, 201, 20, 5, 201, 46, 30, 145, 160,
-			6, 224, 248, 177, 102, 129, 171, 87, 242, 233, 9, 82, 235, 248,
-			149, 214, 78, 224, 119, 157, 126, 23, 101, 212, 237, 183, 156, 57,
-			215, 239, 216, 126, 7, 164, 116, 142, 121, 54, 58, 250, 225, 92,
-			203, 247, 3, 219, 241, 172, 200, 15, 160, 66, 56, 183, 187, 48,
-			23, 70, 86, 36, 118, 231, 141, 2, 127, 203, 124, 187, 64, 65,
-			233, 39, 53, 114, 116, 205, 239, 108, 69, 1, 179, 186, 91, 208,
-			130, 241, 4, 57, 130, 207, 238, 238, 178, 32, 116, 124, 15, 99,
-			4, 195, 245, 81, 44, 124, 63, 47, 51, 158, 34, 67, 173, 128,
-			129, 30, 199, 80, 193, 200, 162, 57, 24, 30, 168, 196, 139, 73,
-			93, 86, 53, 46, 146, 163, 17, 11, 186, 142, 103, 185, 119, 29,
-			207, 102, 247, 38, 53, 140, 63, 28, 145, 165, 53, 40, 52, 158,
-			37, 67, 86, 208, 218, 113, 118, 217, 164, 142, 141, 151, 42, 156,
-			158, 74, 22, 213, 202, 50, 175, 85, 243, 218, 126, 93, 190, 98,
-			156, 36, 133, 94, 63, 232, 48, 123, 50, 79, 149, 233, 98, 93,
-			64, 230, 63, 84, 200, 72, 234, 5, 227, 20, 25, 70, 28, 238,
-			246, 3, 87, 208, 88, 196, 130, 237, 192, 53, 206, 16, 18, 98,
-			71, 248, 84, 197, 167, 195, 188, 4, 30, 63, 78, 138, 182, 21,
-			89, 248, 80, 195, 135, 67, 0, 195, 35, 147, 20, 91, 62, 172,
-			97, 17, 199, 190, 88, 143, 97, 227, 73, 50, 230, 250, 157, 187,
-			204, 139, 130, 253, 187, 40, 104, 136, 163, 86, 63, 226, 250, 157,
-			42, 148, 174, 64, 225, 141, 155, 47, 173, 126, 79, 178, 112, 157,
-			87, 124, 254, 87, 198, 72, 193, 208, 245, 220, 162, 66, 126, 69,
-			193, 184, 137, 158, 51, 22, 191, 160, 100, 66, 32, 11, 215, 208,
-			50, 89, 219, 94, 169, 209, 229, 126, 180, 227, 7, 97, 229, 62,
-			113, 144, 237, 16, 85, 153, 216, 109, 78, 162, 6, 78, 72, 59,
-			254, 46, 11, 60, 176, 218, 60, 91, 108, 130, 47, 247, 172, 22,
-			52, 236, 180, 152, 7, 250, 92, 200, 14, 93, 172, 204, 203, 185,
-			198, 215, 163, 182, 223, 247, 108, 185, 215, 179, 86, 91, 169, 174,
-			111, 85, 105, 219, 113, 89, 188, 65, 87, 200, 141, 137, 45, 178,
-			98, 238, 101, 185, 237, 38, 126, 106, 57, 67, 35, 185, 25, 242,
-			115, 10, 238, 150, 233, 99, 185, 69, 197, 252, 148, 66, 179, 178,
-			130, 22, 38, 109, 58, 182, 19, 48, 97, 235, 81, 156, 49, 124,
-			246, 243, 61, 119, 199, 163, 219, 61, 208, 237, 252, 69, 218, 178,
-			92, 23, 183, 34, 14, 182, 197, 186, 77, 102, 219, 124, 159, 199,
-			163, 85, 57, 26, 104, 164, 177, 48, 154, 11, 88, 216, 243, 189,
-			144, 9, 39, 63, 172, 36, 138, 125, 172, 120, 146, 172, 10, 189,
-			174, 29, 43, 158, 55, 159, 230, 113, 44, 201, 31, 39, 148, 155,
-			94, 56, 145, 168, 152, 135, 184, 139, 196, 89, 143, 168, 84, 8,
-			25, 149, 234, 87, 59, 86, 60, 42, 33, 197, 208, 142, 141, 157,
-			150, 144, 102, 104, 199, 206, 81, 178, 41, 84, 175, 54, 81, 172,
-			152, 43, 56, 224, 184, 94, 160, 13, 8, 189, 185, 126, 71, 180,
-			75, 247, 44, 32, 163, 227, 132, 17, 11, 82, 97, 15, 186, 146,
-			8, 90, 220, 183, 82, 128, 38, 207, 75, 8, 58, 40, 205, 72,
-			72, 51, 180, 137, 242, 44, 217, 197, 190, 85, 67, 155, 44, 158,
-			55, 29, 236, 91, 244, 132, 211, 141, 75, 84, 26, 131, 169, 144,
-			74, 133, 64, 187, 44, 12, 173, 14, 171, 208, 26, 175, 197, 71,
-			203, 9, 233, 236, 66, 153, 196, 239, 33, 83, 64, 197, 243, 6,
-			28, 175, 19, 99, 168, 230, 161, 227, 35, 18, 82, 12, 109, 242,
-			168, 228, 142, 170, 25, 218, 228, 57, 74, 110, 3, 134, 90, 206,
-			208, 79, 169, 151, 52, 115, 137, 166, 212, 4, 6, 104, 44, 7,
-			99, 105, 88, 72, 109, 22, 89, 142, 27, 138, 225, 72, 227, 45,
-			251, 212, 96, 12, 78, 145, 19, 228, 125, 164, 0, 16, 140, 243,
-			25, 253, 113, 243, 6, 210, 46, 98, 66, 91, 145, 31, 88, 29,
-			70, 183, 235, 107, 194, 21, 58, 192, 4, 206, 30, 39, 238, 218,
-			174, 16, 114, 148, 12, 241, 38, 243, 208, 102, 10, 86, 12, 237,
-			204, 200, 68, 2, 107, 134, 118, 230, 177, 73, 242, 178, 64, 65,
-			49, 180, 115, 186, 105, 174, 61, 34, 10, 129, 181, 39, 7, 139,
-			199, 83, 15, 69, 70, 201, 67, 235, 41, 24, 122, 27, 57, 145,
-			192, 154, 161, 157, 155, 124, 156, 188, 36, 144, 81, 13, 237, 188,
-			62, 105, 190, 247, 17, 145, 177, 194, 144, 117, 155, 46, 179, 31,
-			132, 11, 140, 247, 249, 20, 46, 64, 249, 249, 145, 227, 9, 172,
-			25, 218, 249, 147, 143, 145, 111, 40, 2, 25, 205, 208, 158, 212,
-			79, 154, 191, 171, 160, 136, 5, 125, 86, 166, 150, 235, 98, 207,
-			160, 168, 29, 22, 210, 38, 139, 246, 24, 243, 232, 60, 15, 43,
-			102, 150, 48, 186, 7, 184, 198, 136, 208, 90, 155, 208, 182, 229,
-			134, 50, 54, 153, 118, 243, 68, 168, 118, 96, 174, 121, 62, 88,
-			29, 124, 137, 112, 247, 169, 235, 91, 54, 234, 34, 176, 192, 112,
-			215, 184, 203, 108, 135, 239, 141, 114, 22, 197, 147, 150, 247, 106,
-			185, 188, 26, 252, 96, 247, 122, 232, 34, 38, 244, 107, 121, 160,
-			175, 152, 192, 138, 161, 61, 57, 124, 44, 129, 129, 254, 137, 19,
-			164, 36, 216, 161, 27, 218, 140, 126, 214, 60, 142, 99, 227, 245,
-			187, 77, 22, 192, 12, 117, 253, 78, 210, 166, 158, 135, 74, 195,
-			9, 172, 24, 218, 12, 121, 60, 129, 53, 67, 155, 57, 125, 134,
-			88, 56, 243, 53, 67, 155, 85, 77, 179, 1, 252, 69, 235, 203,
-			113, 203, 131, 124, 72, 141, 101, 89, 196, 110, 193, 42, 118, 152,
-			107, 15, 206, 64, 112, 210, 197, 28, 140, 39, 185, 86, 128, 62,
-			228, 36, 7, 18, 103, 143, 158, 144, 16, 244, 63, 249, 56, 249,
-			65, 68, 70, 55, 180, 133, 226, 164, 25, 208, 90, 198, 253, 230,
-			54, 130, 88, 17, 208, 254, 116, 253, 14, 56, 218, 128, 35, 14,
-			220, 142, 5, 114, 0, 30, 51, 175, 234, 132, 212, 247, 220, 125,
-			66, 173, 214, 107, 158, 191, 231, 50, 187, 195, 119, 24, 44, 187,
-			235, 120, 78, 24, 5, 220, 196, 108, 185, 14, 88, 235, 49, 170,
-			192, 187, 133, 226, 168, 132, 20, 67, 91, 56, 114, 92, 66, 154,
-			161, 45, 156, 124, 44, 54, 10, 127, 255, 34, 57, 59, 104, 193,
-			217, 253, 0, 35, 8, 247, 203, 244, 88, 34, 197, 85, 81, 229,
-			145, 19, 61, 62, 124, 120, 162, 199, 17, 217, 160, 204, 243, 184,
-			244, 246, 121, 30, 18, 205, 71, 72, 243, 248, 163, 39, 192, 90,
-			57, 155, 11, 191, 159, 229, 241, 253, 44, 143, 239, 103, 121, 124,
-			63, 203, 227, 251, 89, 30, 223, 207, 242, 248, 63, 158, 229, 177,
-			156, 100, 121, 44, 63, 56, 203, 163, 146, 100, 121, 84, 30, 33,
-			203, 227, 231, 198, 185, 223, 218, 206, 133, 138, 249, 169, 113, 186,
-			76, 229, 114, 155, 205, 241, 8, 157, 142, 7, 214, 81, 219, 185,
-			199, 236, 89, 151, 121, 157, 104, 135, 134, 61, 220, 67, 231, 206,
-			92, 92, 157, 217, 228, 209, 115, 57, 82, 251, 108, 36, 179, 209,
-			86, 195, 56, 207, 33, 73, 37, 113, 114, 5, 180, 218, 242, 189,
-			22, 235, 69, 160, 166, 95, 99, 180, 100, 91, 251, 37, 2, 58,
-			173, 132, 209, 154, 146, 108, 6, 115, 65, 184, 226, 75, 162, 13,
-			168, 168, 197, 10, 103, 59, 109, 177, 19, 46, 205, 110, 130, 65,
-			155, 164, 182, 216, 231, 67, 135, 62, 102, 21, 160, 224, 196, 59,
-			10, 60, 220, 227, 7, 52, 236, 55, 35, 32, 23, 56, 130, 91,
-			119, 86, 42, 130, 72, 235, 50, 101, 194, 234, 245, 2, 255, 158,
-			3, 203, 129, 187, 79, 47, 207, 46, 204, 151, 231, 231, 231, 49,
-			79, 228, 161, 82, 17, 98, 52, 176, 143, 12, 186, 184, 238, 245,
-			66, 214, 183, 125, 92, 124, 15, 38, 38, 132, 145, 21, 68, 244,
-			57, 90, 169, 84, 174, 15, 62, 99, 158, 157, 121, 18, 119, 36,
-			237, 43, 249, 148, 63, 142, 141, 67, 57, 172, 207, 65, 11, 49,
-			52, 203, 251, 146, 240, 245, 129, 151, 248, 110, 40, 127, 133, 255,
-			150, 47, 32, 36, 59, 113, 218, 116, 250, 64, 71, 207, 210, 121,
-			250, 228, 147, 131, 109, 189, 155, 206, 207, 208, 55, 101, 12, 246,
-			192, 75, 151, 159, 163, 11, 215, 15, 60, 21, 93, 63, 23, 71,
-			164, 231, 231, 69, 165, 183, 40, 115, 193, 190, 58, 12, 129, 119,
-			31, 138, 192, 179, 15, 70, 96, 246, 1, 8, 92, 62, 12, 129,
-			135, 202, 250, 72, 192, 203, 201, 128, 61, 186, 20, 220, 119, 172,
-			239, 47, 35, 252, 81, 122, 200, 159, 203, 14, 57, 189, 124, 128,
-			9, 215, 147, 151, 164, 0, 164, 6, 61, 253, 194, 1, 41, 72,
-			222, 201, 242, 57, 35, 115, 105, 22, 39, 47, 28, 202, 221, 100,
-			120, 147, 138, 239, 78, 87, 188, 79, 31, 151, 15, 239, 227, 80,
-			17, 58, 60, 171, 37, 59, 129, 227, 8, 26, 252, 101, 51, 23,
-			93, 140, 193, 168, 62, 48, 253, 96, 197, 105, 219, 218, 15, 159,
-			187, 82, 150, 57, 101, 207, 45, 200, 28, 132, 212, 40, 202, 222,
-			166, 7, 30, 85, 110, 6, 62, 102, 195, 240, 166, 34, 251, 161,
-			147, 0, 98, 252, 31, 148, 3, 16, 88, 194, 116, 181, 64, 95,
-			18, 17, 21, 44, 167, 182, 91, 68, 69, 204, 164, 19, 214, 179,
-			136, 86, 151, 194, 18, 157, 22, 123, 25, 80, 69, 176, 126, 6,
-			20, 47, 70, 167, 123, 1, 107, 97, 178, 91, 115, 159, 27, 192,
-			241, 214, 65, 18, 81, 7, 211, 58, 149, 108, 147, 138, 245, 91,
-			33, 73, 69, 225, 105, 156, 141, 147, 137, 9, 95, 137, 87, 43,
-			108, 41, 147, 184, 147, 142, 73, 75, 218, 157, 12, 163, 128, 21,
-			165, 43, 97, 169, 44, 210, 8, 174, 100, 22, 197, 76, 20, 159,
-			183, 69, 96, 37, 73, 80, 60, 172, 181, 138, 148, 174, 5, 104,
-			23, 218, 25, 104, 149, 208, 174, 211, 10, 178, 237, 62, 108, 179,
-			11, 97, 41, 19, 16, 108, 23, 199, 201, 31, 203, 128, 160, 246,
-			170, 58, 97, 254, 158, 66, 183, 208, 42, 72, 199, 2, 113, 208,
-			82, 102, 193, 64, 236, 106, 246, 202, 194, 213, 242, 213, 167, 175,
-			193, 2, 7, 255, 99, 12, 248, 242, 64, 97, 42, 158, 69, 215,
-			253, 136, 45, 65, 171, 33, 163, 77, 191, 47, 179, 32, 91, 124,
-			230, 112, 223, 100, 137, 136, 188, 201, 185, 174, 227, 209, 75, 0,
-			116, 29, 111, 110, 39, 160, 151, 232, 226, 83, 116, 39, 152, 179,
-			173, 125, 122, 137, 94, 185, 118, 181, 178, 120, 149, 194, 28, 153,
-			195, 36, 76, 145, 115, 195, 87, 218, 244, 134, 246, 171, 234, 80,
-			106, 67, 251, 213, 226, 88, 106, 67, 251, 85, 227, 56, 249, 81,
-			77, 238, 104, 7, 170, 97, 126, 71, 149, 140, 120, 180, 40, 98,
-			154, 95, 36, 97, 152, 156, 77, 33, 117, 89, 24, 242, 9, 227,
-			123, 44, 110, 45, 200, 216, 90, 92, 26, 45, 58, 79, 232, 43,
-			98, 28, 94, 17, 91, 87, 34, 131, 194, 15, 29, 220, 11, 242,
-			3, 26, 135, 30, 95, 65, 132, 68, 69, 46, 232, 82, 13, 132,
-			136, 74, 170, 67, 63, 160, 93, 63, 96, 101, 106, 225, 6, 218,
-			27, 44, 240, 197, 70, 184, 220, 132, 206, 180, 198, 93, 107, 152,
-			9, 146, 60, 11, 67, 195, 29, 15, 196, 11, 171, 103, 241, 28,
-			20, 145, 76, 104, 19, 196, 35, 85, 144, 9, 117, 142, 202, 80,
-			167, 22, 196, 227, 165, 192, 152, 196, 27, 238, 138, 102, 104, 65,
-			42, 206, 249, 141, 247, 145, 185, 183, 139, 109, 185, 126, 167, 215,
-			132, 191, 197, 30, 87, 30, 11, 222, 54, 152, 105, 190, 205, 94,
-			89, 233, 47, 84, 114, 60, 142, 228, 172, 178, 16, 51, 47, 252,
-			0, 35, 134, 1, 107, 59, 247, 68, 24, 80, 64, 134, 65, 116,
-			112, 227, 69, 248, 15, 127, 27, 139, 100, 68, 4, 6, 65, 211,
-			98, 240, 239, 232, 226, 177, 10, 98, 88, 225, 45, 55, 246, 123,
-			172, 46, 194, 135, 240, 219, 56, 79, 70, 91, 190, 23, 49, 47,
-			226, 47, 233, 216, 222, 136, 40, 195, 42, 239, 34, 195, 49, 53,
-			24, 19, 124, 112, 68, 53, 169, 108, 188, 139, 232, 145, 213, 9,
-			39, 11, 84, 155, 30, 89, 188, 32, 48, 57, 132, 204, 74, 195,
-			234, 132, 24, 100, 172, 227, 27, 198, 147, 100, 140, 239, 199, 220,
-			109, 59, 46, 187, 203, 238, 69, 147, 67, 136, 217, 17, 94, 124,
-			211, 113, 89, 245, 94, 100, 62, 77, 134, 227, 87, 141, 113, 162,
-			189, 198, 246, 5, 163, 224, 167, 49, 65, 242, 40, 139, 130, 77,
-			28, 88, 82, 223, 165, 148, 94, 37, 122, 131, 221, 139, 140, 39,
-			73, 222, 117, 60, 22, 78, 42, 136, 227, 184, 192, 17, 158, 85,
-			214, 28, 143, 213, 249, 99, 115, 137, 232, 0, 38, 45, 66, 47,
-			163, 162, 69, 227, 52, 25, 182, 153, 235, 116, 157, 136, 5, 50,
-			34, 27, 23, 148, 74, 164, 112, 3, 177, 134, 81, 179, 173, 200,
-			194, 42, 163, 117, 252, 253, 188, 94, 84, 198, 213, 210, 207, 42,
-			164, 184, 106, 69, 86, 39, 176, 186, 113, 53, 37, 169, 102, 44,
-			144, 161, 158, 21, 68, 142, 229, 138, 168, 246, 99, 2, 85, 249,
-			86, 101, 147, 63, 174, 203, 122, 230, 45, 50, 36, 202, 0, 109,
-			30, 212, 134, 38, 143, 212, 57, 0, 253, 132, 206, 27, 156, 59,
-			122, 29, 127, 67, 153, 107, 133, 17, 74, 79, 177, 142, 191, 75,
-			255, 88, 37, 197, 53, 17, 5, 54, 150, 200, 8, 140, 240, 93,
-			158, 29, 133, 13, 142, 44, 62, 126, 64, 32, 164, 158, 170, 19,
-			168, 189, 129, 149, 65, 218, 184, 252, 138, 16, 59, 239, 120, 132,
-			151, 241, 0, 251, 121, 50, 42, 132, 56, 137, 194, 235, 117, 33,
-			216, 188, 138, 73, 138, 33, 123, 189, 15, 126, 24, 202, 171, 94,
-			143, 97, 227, 60, 209, 35, 144, 22, 130, 104, 141, 164, 134, 243,
-			118, 174, 142, 143, 140, 41, 82, 224, 66, 52, 57, 130, 149, 142,
-			136, 74, 124, 140, 110, 231, 234, 226, 177, 49, 203, 35, 233, 192,
-			220, 201, 81, 172, 58, 54, 192, 243, 219, 185, 122, 92, 229, 198,
-			48, 25, 18, 211, 166, 244, 11, 26, 50, 140, 163, 91, 33, 186,
-			205, 194, 150, 224, 148, 121, 255, 89, 80, 199, 122, 198, 28, 25,
-			18, 193, 157, 73, 21, 133, 242, 68, 242, 10, 182, 88, 225, 51,
-			69, 214, 50, 46, 145, 99, 48, 76, 119, 51, 172, 229, 124, 27,
-			131, 7, 155, 41, 246, 202, 186, 25, 30, 235, 73, 221, 173, 20,
-			159, 239, 147, 18, 160, 15, 164, 4, 152, 255, 92, 33, 121, 46,
-			27, 39, 73, 33, 37, 22, 122, 93, 64, 153, 17, 83, 15, 140,
-			216, 232, 33, 136, 63, 80, 38, 244, 131, 50, 49, 32, 149, 249,
-			71, 144, 202, 75, 243, 132, 36, 218, 209, 40, 18, 189, 81, 253,
-			64, 99, 60, 103, 16, 82, 184, 81, 91, 95, 174, 191, 56, 174,
-			24, 163, 164, 184, 186, 220, 88, 190, 85, 95, 190, 51, 174, 222,
-			152, 122, 233, 226, 67, 45, 20, 207, 255, 72, 149, 12, 25, 121,
-			61, 247, 5, 245, 129, 105, 14, 87, 255, 54, 164, 57, 28, 141,
-			211, 28, 158, 77, 210, 28, 158, 77, 167, 57, 192, 79, 197, 208,
-			70, 114, 211, 132, 18, 53, 159, 51, 244, 163, 57, 67, 49, 39,
-			68, 172, 74, 196, 211, 96, 157, 169, 80, 48, 29, 243, 96, 63,
-			29, 205, 143, 145, 17, 162, 231, 209, 114, 28, 83, 71, 96, 113,
-			206, 115, 211, 106, 76, 45, 72, 72, 53, 180, 177, 97, 34, 42,
-			42, 134, 54, 206, 35, 106, 121, 190, 166, 143, 171, 69, 9, 169,
-			134, 54, 62, 50, 42, 42, 170, 134, 118, 76, 29, 19, 143, 224,
-			181, 99, 42, 145, 16, 60, 59, 114, 148, 188, 206, 119, 184, 38,
-			115, 119, 20, 147, 93, 194, 116, 138, 56, 170, 28, 207, 76, 12,
-			229, 84, 104, 3, 131, 166, 220, 76, 105, 247, 93, 240, 5, 112,
-			195, 201, 241, 184, 133, 140, 78, 206, 142, 21, 17, 241, 106, 147,
-			133, 60, 90, 215, 65, 183, 68, 6, 226, 165, 209, 60, 89, 60,
-			69, 190, 17, 27, 205, 231, 213, 9, 243, 171, 10, 73, 229, 32,
-			76, 161, 231, 2, 30, 206, 180, 204, 175, 157, 17, 25, 31, 33,
-			245, 3, 167, 227, 120, 220, 225, 225, 59, 59, 210, 152, 186, 209,
-			143, 92, 22, 80, 199, 11, 35, 203, 107, 49, 186, 135, 201, 7,
-			59, 96, 25, 90, 148, 235, 2, 60, 92, 4, 102, 154, 99, 203,
-			46, 226, 228, 5, 139, 242, 201, 176, 14, 109, 73, 58, 64, 54,
-			150, 146, 48, 212, 161, 194, 223, 242, 187, 93, 223, 147, 115, 0,
-			67, 109, 105, 171, 249, 60, 31, 35, 97, 53, 159, 31, 78, 91,
-			205, 231, 141, 227, 228, 207, 101, 10, 158, 118, 89, 53, 204, 255,
-			40, 56, 145, 9, 178, 99, 80, 35, 203, 11, 57, 36, 50, 98,
-			209, 247, 156, 215, 251, 204, 221, 167, 142, 205, 188, 200, 105, 239,
-			243, 1, 136, 99, 218, 78, 180, 35, 157, 199, 150, 223, 99, 113,
-			20, 164, 119, 128, 47, 216, 217, 223, 40, 87, 192, 54, 189, 28,
-			115, 5, 228, 248, 242, 112, 218, 54, 189, 60, 126, 140, 188, 75,
-			38, 168, 84, 212, 51, 230, 229, 131, 44, 17, 43, 14, 247, 173,
-			83, 172, 161, 50, 141, 164, 0, 175, 142, 166, 82, 76, 42, 71,
-			38, 83, 41, 38, 149, 83, 167, 201, 55, 21, 25, 11, 127, 90,
-			53, 205, 223, 31, 148, 193, 251, 117, 33, 185, 47, 44, 122, 106,
-			121, 244, 118, 163, 177, 73, 87, 120, 253, 89, 208, 166, 156, 129,
-			114, 131, 181, 107, 217, 140, 90, 187, 150, 227, 202, 212, 236, 53,
-			191, 179, 234, 119, 136, 140, 68, 243, 244, 129, 215, 251, 44, 216,
-			79, 102, 12, 237, 178, 200, 226, 19, 176, 22, 113, 105, 182, 220,
-			208, 199, 46, 123, 61, 215, 17, 161, 109, 17, 162, 39, 50, 144,
-			7, 108, 194, 183, 226, 64, 124, 30, 8, 44, 166, 2, 241, 79,
-			15, 167, 3, 241, 79, 79, 62, 78, 126, 77, 145, 145, 248, 247,
-			168, 151, 204, 47, 30, 38, 132, 77, 43, 100, 169, 115, 19, 135,
-			48, 196, 243, 101, 232, 158, 111, 156, 225, 6, 248, 129, 76, 34,
-			174, 225, 133, 89, 229, 176, 120, 199, 1, 170, 57, 1, 73, 117,
-			97, 133, 25, 79, 157, 47, 113, 161, 156, 245, 50, 23, 33, 137,
-			226, 23, 0, 251, 83, 169, 40, 254, 123, 78, 95, 76, 69, 241,
-			223, 51, 61, 67, 126, 146, 211, 153, 55, 180, 155, 234, 57, 243,
-			255, 2, 58, 45, 76, 85, 178, 60, 106, 5, 77, 39, 10, 128,
-			133, 175, 177, 253, 57, 62, 3, 34, 171, 67, 173, 48, 244, 91,
-			142, 21, 37, 129, 72, 39, 204, 102, 24, 137, 225, 140, 71, 19,
-			22, 23, 28, 76, 244, 5, 147, 170, 156, 137, 54, 5, 117, 105,
-			117, 196, 166, 121, 140, 127, 190, 0, 88, 201, 145, 201, 43, 134,
-			118, 243, 164, 41, 33, 205, 208, 110, 158, 57, 75, 126, 134, 227,
-			95, 48, 180, 53, 245, 140, 249, 73, 133, 208, 90, 27, 180, 113,
-			89, 110, 21, 241, 201, 238, 186, 32, 37, 175, 250, 142, 135, 66,
-			210, 97, 184, 201, 100, 247, 113, 39, 41, 206, 80, 137, 124, 26,
-			48, 158, 7, 202, 163, 171, 66, 183, 202, 148, 45, 116, 149, 7,
-			100, 215, 138, 232, 179, 92, 103, 188, 123, 238, 242, 220, 179, 160,
-			44, 222, 93, 1, 167, 65, 82, 81, 200, 3, 110, 82, 218, 10,
-			138, 161, 173, 13, 203, 137, 87, 208, 12, 109, 237, 212, 105, 82,
-			34, 48, 60, 250, 102, 174, 163, 152, 39, 41, 24, 169, 178, 71,
-			49, 231, 248, 50, 169, 131, 106, 216, 44, 142, 146, 127, 171, 18,
-			93, 87, 180, 156, 161, 191, 164, 50, 205, 252, 138, 74, 112, 178,
-			57, 157, 190, 223, 15, 41, 24, 183, 120, 82, 51, 142, 61, 59,
-			1, 141, 189, 144, 80, 158, 197, 180, 130, 192, 218, 199, 180, 25,
-			172, 202, 195, 211, 225, 64, 168, 186, 135, 57, 226, 160, 220, 40,
-			157, 165, 243, 224, 229, 47, 200, 68, 32, 88, 229, 248, 187, 178,
-			64, 28, 209, 0, 251, 81, 10, 244, 254, 84, 200, 9, 226, 199,
-			81, 120, 182, 61, 252, 177, 18, 148, 104, 179, 143, 97, 116, 208,
-			192, 204, 109, 39, 203, 42, 180, 62, 195, 187, 182, 188, 253, 212,
-			118, 157, 76, 69, 18, 253, 39, 109, 39, 141, 150, 241, 236, 12,
-			52, 73, 253, 61, 47, 221, 148, 160, 66, 120, 71, 188, 9, 62,
-			90, 10, 102, 197, 189, 68, 142, 146, 155, 164, 0, 16, 172, 199,
-			31, 212, 39, 204, 167, 249, 244, 119, 60, 54, 37, 248, 43, 6,
-			166, 204, 241, 246, 90, 110, 223, 198, 35, 76, 24, 16, 23, 40,
-			84, 40, 102, 27, 41, 34, 21, 238, 131, 34, 27, 73, 17, 169,
-			112, 31, 36, 99, 9, 172, 25, 218, 7, 141, 227, 228, 151, 20,
-			209, 177, 98, 104, 182, 254, 184, 249, 247, 165, 230, 225, 93, 39,
-			44, 227, 187, 160, 120, 92, 68, 88, 129, 124, 210, 178, 110, 47,
-			218, 23, 79, 227, 156, 4, 15, 151, 46, 64, 217, 241, 250, 44,
-			182, 239, 60, 20, 52, 180, 191, 129, 19, 4, 107, 150, 227, 132,
-			5, 209, 167, 52, 208, 37, 251, 109, 159, 241, 124, 48, 203, 222,
-			5, 187, 66, 228, 113, 41, 34, 199, 206, 214, 83, 48, 80, 33,
-			18, 254, 20, 145, 99, 103, 63, 54, 9, 70, 153, 174, 0, 111,
-			219, 42, 159, 208, 138, 154, 211, 1, 34, 18, 42, 24, 90, 123,
-			100, 76, 66, 138, 161, 181, 199, 79, 72, 72, 51, 180, 246, 228,
-			227, 228, 2, 81, 117, 213, 208, 95, 205, 245, 20, 115, 146, 114,
-			167, 237, 240, 105, 163, 226, 238, 220, 81, 114, 11, 126, 15, 27,
-			154, 171, 30, 49, 175, 211, 155, 126, 208, 101, 129, 203, 119, 130,
-			165, 180, 86, 182, 36, 181, 92, 223, 237, 89, 33, 181, 251, 61,
-			87, 156, 7, 218, 97, 1, 208, 11, 248, 171, 195, 57, 67, 115,
-			71, 248, 138, 170, 14, 3, 142, 41, 72, 229, 208, 52, 84, 4,
-			66, 125, 245, 152, 121, 10, 71, 178, 153, 70, 84, 36, 7, 137,
-			85, 90, 69, 49, 241, 197, 46, 149, 138, 116, 251, 34, 13, 75,
-			69, 186, 253, 177, 113, 82, 38, 160, 189, 243, 33, 30, 184, 63,
-			71, 165, 11, 58, 64, 122, 202, 186, 214, 97, 137, 11, 139, 227,
-			164, 68, 116, 93, 3, 108, 250, 234, 49, 243, 4, 95, 163, 164,
-			215, 154, 198, 67, 67, 60, 250, 2, 15, 13, 241, 232, 11, 60,
-			52, 196, 163, 63, 54, 78, 126, 24, 84, 175, 166, 229, 140, 252,
-			155, 234, 199, 21, 205, 12, 50, 162, 136, 2, 18, 207, 49, 217,
-			75, 57, 157, 51, 135, 106, 153, 79, 29, 70, 3, 38, 50, 39,
-			247, 249, 110, 61, 207, 114, 27, 204, 99, 69, 173, 32, 27, 19,
-			42, 86, 195, 73, 251, 38, 57, 70, 154, 164, 0, 144, 154, 51,
-			244, 31, 82, 244, 19, 102, 157, 207, 29, 244, 21, 203, 124, 13,
-			134, 57, 138, 122, 226, 13, 22, 248, 229, 216, 131, 138, 185, 208,
-			14, 172, 78, 151, 137, 123, 10, 68, 135, 36, 221, 227, 24, 25,
-			226, 125, 228, 177, 147, 84, 129, 2, 5, 35, 227, 73, 129, 6,
-			5, 199, 39, 200, 156, 64, 75, 49, 244, 31, 85, 244, 9, 243,
-			28, 55, 171, 156, 55, 98, 99, 32, 67, 22, 141, 91, 80, 242,
-			248, 70, 210, 135, 130, 77, 140, 140, 37, 5, 26, 20, 24, 199,
-			201, 182, 232, 67, 53, 244, 143, 41, 186, 97, 86, 147, 68, 209,
-			40, 229, 172, 160, 102, 30, 28, 20, 73, 43, 184, 141, 86, 154,
-			191, 9, 38, 106, 30, 219, 45, 38, 5, 10, 20, 12, 31, 73,
-			10, 52, 40, 24, 63, 70, 70, 81, 200, 248, 1, 154, 147, 120,
-			146, 68, 83, 149, 2, 130, 195, 18, 196, 167, 228, 152, 4, 241,
-			156, 201, 196, 9, 242, 37, 133, 168, 186, 110, 20, 62, 165, 228,
-			254, 149, 162, 152, 255, 68, 185, 68, 232, 178, 135, 217, 169, 187,
-			142, 221, 183, 146, 132, 215, 253, 216, 198, 138, 19, 47, 121, 28,
-			168, 199, 2, 225, 139, 69, 129, 229, 133, 152, 93, 4, 203, 116,
-			108, 4, 210, 90, 148, 88, 178, 40, 135, 33, 73, 197, 60, 226,
-			27, 16, 18, 45, 137, 149, 239, 69, 135, 166, 128, 103, 38, 27,
-			232, 5, 77, 135, 133, 252, 83, 74, 113, 156, 252, 2, 204, 15,
-			29, 132, 241, 255, 83, 212, 203, 230, 255, 159, 49, 167, 165, 45,
-			8, 22, 92, 146, 52, 47, 213, 150, 36, 78, 60, 7, 205, 30,
-			159, 13, 61, 212, 12, 45, 197, 70, 98, 137, 91, 49, 161, 239,
-			238, 10, 35, 38, 177, 31, 147, 228, 124, 153, 142, 181, 18, 119,
-			8, 67, 161, 131, 222, 5, 108, 77, 9, 42, 0, 158, 122, 82,
-			130, 26, 128, 51, 151, 184, 163, 0, 106, 85, 255, 121, 69, 53,
-			99, 111, 85, 228, 193, 139, 156, 240, 148, 139, 181, 121, 152, 247,
-			42, 61, 182, 216, 181, 226, 46, 91, 124, 220, 79, 170, 100, 106,
-			129, 201, 203, 7, 24, 22, 170, 128, 73, 119, 91, 236, 123, 17,
-			116, 106, 165, 241, 17, 51, 70, 120, 184, 194, 229, 144, 126, 161,
-			205, 66, 167, 227, 129, 110, 233, 123, 86, 183, 41, 76, 38, 23,
-			124, 15, 63, 176, 153, 88, 84, 57, 189, 48, 255, 126, 94, 81,
-			139, 18, 68, 122, 135, 79, 72, 80, 3, 112, 242, 113, 242, 117,
-			206, 13, 213, 208, 63, 15, 220, 248, 173, 7, 113, 3, 236, 3,
-			113, 112, 227, 16, 110, 12, 178, 66, 80, 14, 147, 82, 166, 121,
-			103, 72, 5, 31, 84, 240, 22, 22, 109, 222, 48, 161, 224, 155,
-			62, 52, 221, 49, 217, 25, 159, 88, 90, 242, 156, 84, 152, 254,
-			159, 79, 24, 1, 3, 255, 249, 132, 17, 48, 245, 63, 15, 140,
-			248, 93, 21, 25, 161, 25, 250, 47, 43, 234, 73, 243, 75, 170,
-			144, 248, 1, 3, 66, 42, 61, 39, 8, 99, 59, 138, 207, 234,
-			248, 168, 97, 188, 28, 3, 103, 216, 189, 104, 41, 179, 229, 194,
-			13, 154, 244, 185, 12, 222, 150, 88, 75, 108, 172, 80, 161, 107,
-			162, 154, 211, 194, 244, 248, 142, 227, 9, 203, 51, 66, 237, 95,
-			33, 194, 104, 200, 54, 222, 220, 143, 226, 137, 153, 105, 29, 31,
-			8, 254, 196, 61, 113, 199, 50, 94, 132, 179, 77, 101, 80, 76,
-			180, 106, 35, 110, 50, 214, 192, 59, 150, 56, 73, 193, 49, 228,
-			232, 9, 246, 106, 121, 228, 167, 100, 190, 166, 0, 56, 124, 76,
-			130, 200, 237, 137, 19, 36, 2, 222, 23, 115, 70, 225, 87, 21,
-			245, 95, 42, 154, 105, 19, 113, 193, 13, 231, 175, 192, 34, 62,
-			170, 194, 145, 128, 101, 56, 226, 135, 230, 105, 207, 239, 245, 221,
-			216, 202, 65, 111, 158, 208, 174, 21, 181, 118, 164, 210, 153, 10,
-			233, 43, 169, 192, 210, 43, 18, 197, 34, 232, 137, 95, 85, 138,
-			99, 100, 14, 5, 64, 55, 244, 95, 87, 244, 227, 230, 121, 110,
-			249, 115, 177, 92, 194, 241, 8, 69, 158, 62, 26, 209, 21, 42,
-			136, 208, 11, 248, 134, 36, 17, 84, 232, 175, 195, 226, 34, 64,
-			13, 192, 113, 131, 148, 177, 245, 188, 161, 255, 11, 69, 127, 204,
-			60, 155, 181, 249, 150, 120, 154, 117, 200, 112, 253, 142, 155, 206,
-			23, 176, 186, 100, 102, 94, 1, 112, 68, 114, 47, 175, 1, 56,
-			113, 146, 92, 198, 166, 11, 134, 254, 21, 69, 63, 101, 158, 25,
-			180, 170, 150, 226, 130, 48, 110, 185, 192, 107, 143, 74, 80, 1,
-			240, 136, 156, 20, 5, 13, 192, 73, 147, 124, 75, 37, 170, 158,
-			55, 10, 95, 85, 114, 95, 80, 21, 243, 223, 171, 124, 111, 177,
-			22, 159, 155, 241, 228, 25, 26, 143, 95, 61, 100, 69, 179, 1,
-			11, 35, 161, 229, 241, 52, 133, 116, 217, 82, 27, 88, 48, 223,
-			241, 84, 17, 190, 107, 5, 76, 30, 222, 77, 178, 27, 248, 9,
-			33, 7, 228, 44, 235, 232, 226, 238, 150, 23, 239, 148, 12, 158,
-			113, 192, 195, 250, 124, 164, 90, 137, 87, 25, 171, 227, 118, 96,
-			117, 89, 88, 73, 76, 43, 144, 146, 158, 216, 224, 156, 66, 157,
-			226, 180, 248, 90, 29, 103, 21, 129, 218, 227, 61, 148, 197, 14,
-			155, 112, 51, 156, 46, 195, 217, 28, 249, 124, 251, 13, 27, 135,
-			85, 141, 15, 173, 92, 0, 211, 71, 73, 178, 8, 55, 93, 191,
-			41, 86, 94, 24, 219, 175, 194, 202, 251, 85, 80, 200, 121, 88,
-			121, 191, 166, 168, 231, 204, 47, 11, 133, 124, 72, 164, 37, 89,
-			18, 83, 77, 14, 42, 102, 57, 145, 97, 48, 226, 253, 28, 177,
-			200, 28, 214, 166, 188, 113, 201, 18, 251, 31, 180, 101, 121, 132,
-			190, 230, 249, 123, 7, 54, 140, 161, 87, 185, 171, 5, 195, 102,
-			251, 123, 158, 235, 91, 177, 43, 137, 29, 139, 105, 150, 199, 213,
-			249, 107, 138, 122, 66, 130, 10, 128, 39, 77, 9, 106, 0, 158,
-			57, 75, 62, 135, 228, 107, 57, 163, 240, 77, 69, 253, 184, 170,
-			153, 63, 165, 16, 202, 199, 142, 15, 175, 227, 117, 92, 73, 84,
-			202, 154, 98, 201, 97, 46, 240, 175, 125, 220, 153, 105, 103, 228,
-			65, 172, 66, 194, 183, 110, 249, 1, 63, 66, 104, 139, 91, 152,
-			44, 146, 114, 39, 105, 232, 89, 189, 112, 199, 71, 66, 15, 238,
-			122, 9, 162, 192, 120, 215, 191, 169, 144, 49, 240, 39, 10, 0,
-			195, 184, 125, 75, 209, 79, 154, 175, 115, 164, 210, 10, 89, 8,
-			2, 19, 103, 15, 82, 131, 38, 58, 168, 179, 150, 31, 216, 181,
-			13, 177, 158, 8, 191, 129, 36, 210, 123, 0, 103, 28, 125, 185,
-			216, 128, 53, 155, 23, 214, 253, 183, 164, 229, 157, 23, 214, 253,
-			183, 64, 111, 196, 5, 26, 20, 128, 209, 170, 10, 180, 21, 67,
-			255, 11, 69, 159, 52, 191, 248, 200, 203, 222, 59, 182, 202, 241,
-			213, 3, 151, 186, 191, 61, 171, 156, 228, 40, 88, 91, 127, 145,
-			230, 185, 130, 28, 29, 57, 158, 20, 104, 80, 112, 242, 49, 242,
-			143, 164, 168, 168, 134, 254, 29, 69, 63, 109, 126, 38, 181, 65,
-			203, 241, 17, 185, 186, 152, 101, 6, 227, 158, 4, 98, 30, 16,
-			55, 104, 238, 199, 91, 150, 160, 144, 146, 200, 65, 108, 48, 199,
-			114, 36, 140, 37, 75, 204, 100, 18, 239, 104, 74, 3, 45, 21,
-			98, 145, 248, 195, 210, 245, 157, 52, 133, 32, 51, 223, 81, 70,
-			30, 75, 10, 52, 40, 48, 79, 145, 79, 73, 10, 53, 67, 255,
-			43, 160, 240, 135, 4, 133, 105, 191, 65, 122, 175, 177, 87, 244,
-			78, 211, 134, 102, 113, 60, 95, 37, 146, 96, 144, 252, 85, 154,
-			12, 48, 73, 254, 42, 77, 134, 134, 88, 155, 167, 200, 127, 145,
-			100, 232, 134, 254, 49, 85, 159, 53, 191, 241, 48, 100, 224, 181,
-			26, 169, 157, 238, 48, 77, 76, 198, 19, 74, 34, 115, 83, 97,
-			198, 9, 18, 166, 77, 138, 80, 126, 39, 138, 164, 53, 113, 138,
-			82, 189, 103, 108, 230, 251, 241, 139, 28, 194, 176, 38, 191, 188,
-			48, 197, 35, 176, 104, 62, 166, 234, 167, 147, 2, 112, 152, 213,
-			51, 211, 73, 1, 56, 204, 234, 229, 50, 249, 134, 138, 171, 149,
-			98, 232, 63, 169, 170, 103, 204, 223, 83, 9, 93, 78, 169, 92,
-			43, 108, 49, 84, 86, 179, 104, 168, 51, 91, 168, 114, 97, 201,
-			133, 73, 150, 23, 70, 74, 132, 206, 229, 175, 122, 228, 176, 53,
-			19, 184, 249, 130, 180, 245, 193, 27, 228, 99, 144, 109, 150, 111,
-			160, 151, 248, 16, 149, 202, 180, 148, 142, 205, 151, 202, 132, 150,
-			210, 145, 120, 145, 88, 88, 74, 133, 222, 197, 24, 132, 241, 238,
-			123, 76, 136, 92, 109, 218, 1, 215, 144, 251, 7, 123, 151, 59,
-			72, 54, 107, 59, 30, 179, 175, 83, 135, 15, 76, 79, 14, 124,
-			108, 219, 16, 218, 11, 252, 22, 134, 75, 124, 218, 218, 241, 253,
-			144, 81, 43, 105, 58, 94, 59, 21, 29, 249, 27, 131, 5, 0,
-			71, 198, 37, 136, 220, 63, 54, 41, 65, 13, 192, 83, 167, 201,
-			151, 184, 37, 161, 26, 250, 103, 84, 245, 28, 223, 145, 104, 196,
-			251, 40, 220, 243, 146, 214, 219, 193, 5, 46, 150, 217, 228, 126,
-			165, 90, 91, 236, 60, 69, 104, 100, 131, 122, 226, 103, 181, 60,
-			63, 19, 61, 182, 154, 126, 63, 138, 183, 108, 72, 166, 175, 50,
-			229, 171, 26, 30, 233, 229, 151, 71, 197, 238, 97, 124, 25, 165,
-			80, 61, 156, 30, 80, 60, 159, 81, 133, 11, 145, 71, 181, 243,
-			25, 117, 88, 26, 14, 160, 99, 62, 163, 158, 57, 43, 169, 213,
-			12, 253, 179, 7, 169, 205, 28, 135, 255, 27, 166, 54, 221, 215,
-			67, 80, 155, 181, 40, 80, 63, 125, 54, 161, 22, 180, 211, 103,
-			19, 106, 53, 36, 239, 204, 89, 242, 21, 78, 173, 110, 232, 159,
-			135, 121, 247, 75, 146, 218, 204, 121, 226, 251, 118, 245, 142, 80,
-			203, 187, 34, 3, 125, 61, 58, 197, 122, 30, 137, 144, 20, 131,
-			174, 249, 188, 58, 44, 165, 25, 52, 205, 231, 213, 83, 167, 227,
-			252, 198, 63, 179, 201, 237, 239, 237, 30, 23, 248, 119, 224, 26,
-			151, 119, 238, 102, 24, 243, 81, 147, 47, 191, 247, 172, 203, 79,
-			106, 132, 220, 98, 81, 157, 95, 140, 97, 76, 146, 161, 94, 224,
-			191, 202, 90, 145, 72, 34, 148, 160, 97, 16, 189, 103, 69, 59,
-			50, 221, 18, 126, 27, 19, 36, 143, 216, 139, 84, 57, 14, 36,
-			153, 118, 58, 158, 106, 22, 153, 118, 103, 8, 1, 11, 43, 149,
-			69, 149, 175, 15, 67, 9, 102, 80, 25, 167, 200, 176, 235, 119,
-			196, 211, 2, 62, 45, 186, 126, 135, 63, 188, 72, 142, 122, 190,
-			119, 55, 241, 201, 48, 21, 178, 88, 63, 226, 249, 94, 18, 254,
-			51, 106, 100, 172, 195, 162, 187, 252, 40, 214, 221, 126, 224, 134,
-			147, 69, 204, 130, 58, 47, 111, 168, 73, 40, 173, 108, 57, 29,
-			111, 187, 190, 38, 192, 250, 145, 14, 139, 120, 146, 242, 118, 224,
-			134, 102, 159, 28, 205, 86, 48, 174, 146, 162, 235, 180, 241, 60,
-			193, 219, 103, 252, 197, 85, 141, 147, 164, 192, 69, 22, 25, 87,
-			172, 11, 40, 97, 146, 96, 29, 95, 76, 222, 71, 70, 26, 150,
-			227, 190, 131, 163, 81, 250, 150, 74, 70, 144, 108, 126, 227, 201,
-			3, 218, 44, 203, 247, 121, 118, 229, 201, 195, 175, 245, 145, 163,
-			44, 115, 250, 180, 135, 204, 233, 123, 130, 232, 32, 241, 147, 58,
-			38, 244, 141, 37, 245, 69, 210, 43, 60, 52, 222, 67, 70, 210,
-			163, 199, 115, 216, 206, 102, 70, 143, 147, 81, 73, 198, 170, 78,
-			194, 100, 220, 118, 9, 73, 158, 24, 75, 132, 224, 149, 11, 56,
-			40, 113, 246, 225, 253, 19, 119, 83, 181, 7, 6, 110, 248, 240,
-			129, 27, 150, 3, 247, 241, 60, 25, 125, 95, 159, 5, 251, 239,
-			240, 68, 66, 36, 197, 77, 69, 28, 192, 188, 103, 118, 143, 79,
-			161, 225, 58, 254, 54, 206, 145, 145, 174, 117, 239, 110, 192, 194,
-			190, 27, 133, 98, 254, 144, 174, 117, 175, 206, 75, 14, 36, 57,
-			147, 131, 73, 206, 55, 179, 185, 211, 60, 51, 244, 162, 228, 125,
-			154, 184, 84, 38, 245, 77, 199, 141, 88, 144, 201, 167, 158, 39,
-			121, 143, 237, 177, 64, 36, 140, 62, 136, 223, 188, 34, 188, 225,
-			187, 54, 11, 38, 143, 188, 253, 27, 88, 209, 88, 20, 105, 213,
-			99, 40, 76, 103, 15, 69, 114, 48, 161, 250, 169, 248, 230, 169,
-			113, 76, 11, 63, 125, 248, 91, 1, 250, 140, 241, 189, 84, 215,
-			201, 248, 32, 181, 198, 84, 58, 3, 250, 208, 252, 114, 254, 252,
-			175, 159, 155, 125, 129, 12, 9, 68, 140, 34, 209, 111, 108, 52,
-			110, 143, 231, 140, 33, 162, 189, 88, 221, 26, 87, 140, 2, 81,
-			215, 55, 198, 213, 231, 245, 226, 209, 241, 177, 122, 246, 62, 176,
-			210, 167, 84, 114, 68, 80, 244, 182, 51, 254, 26, 25, 18, 206,
-			155, 200, 179, 29, 228, 137, 156, 108, 88, 169, 46, 43, 199, 34,
-			168, 37, 34, 104, 254, 140, 66, 10, 188, 94, 44, 225, 74, 74,
-			194, 255, 102, 149, 203, 25, 66, 224, 223, 187, 201, 116, 25, 173,
-			15, 67, 9, 94, 78, 177, 248, 115, 10, 209, 215, 64, 191, 84,
-			136, 118, 139, 69, 134, 113, 112, 61, 48, 143, 31, 162, 101, 140,
-			121, 162, 131, 62, 54, 226, 135, 41, 237, 124, 248, 27, 79, 145,
-			60, 242, 206, 152, 56, 76, 188, 204, 19, 135, 50, 248, 29, 187,
-			82, 236, 187, 117, 158, 108, 251, 201, 7, 39, 219, 254, 157, 184,
-			83, 108, 43, 73, 182, 125, 6, 127, 170, 134, 54, 42, 82, 112,
-			53, 67, 59, 146, 155, 38, 191, 169, 242, 212, 214, 147, 185, 45,
-			197, 252, 103, 42, 77, 134, 91, 110, 225, 136, 11, 193, 196, 61,
-			96, 253, 32, 57, 179, 211, 15, 89, 0, 47, 80, 201, 248, 56,
-			227, 36, 126, 43, 187, 15, 199, 238, 57, 97, 20, 150, 169, 37,
-			178, 39, 83, 157, 241, 252, 211, 126, 171, 197, 24, 158, 222, 238,
-			88, 129, 141, 103, 151, 252, 54, 221, 219, 97, 241, 149, 21, 217,
-			118, 249, 149, 241, 123, 86, 152, 36, 93, 1, 14, 235, 126, 196,
-			50, 155, 60, 28, 61, 188, 184, 34, 96, 81, 63, 240, 104, 27,
-			212, 42, 180, 33, 78, 71, 37, 237, 218, 60, 204, 200, 205, 115,
-			34, 27, 118, 92, 39, 218, 167, 242, 26, 124, 207, 114, 105, 203,
-			247, 194, 40, 176, 28, 47, 115, 55, 218, 201, 162, 65, 42, 50,
-			91, 119, 82, 61, 97, 158, 231, 119, 219, 11, 50, 133, 90, 225,
-			113, 88, 174, 78, 211, 233, 175, 147, 153, 244, 215, 201, 225, 241,
-			84, 250, 235, 228, 241, 9, 242, 203, 170, 76, 127, 61, 175, 26,
-			230, 63, 80, 177, 109, 80, 30, 7, 247, 80, 161, 139, 14, 75,
-			194, 197, 32, 86, 194, 229, 0, 127, 91, 102, 225, 137, 202, 188,
-			13, 206, 226, 173, 219, 203, 139, 87, 175, 209, 29, 43, 220, 225,
-			91, 188, 50, 255, 50, 85, 23, 154, 221, 242, 187, 140, 246, 35,
-			224, 12, 120, 31, 192, 220, 182, 227, 217, 180, 103, 133, 252, 211,
-			0, 1, 138, 176, 197, 55, 181, 69, 127, 216, 81, 132, 121, 153,
-			45, 116, 112, 66, 191, 203, 136, 100, 186, 19, 133, 148, 95, 12,
-			128, 123, 132, 251, 136, 181, 223, 67, 126, 65, 179, 178, 77, 64,
-			19, 241, 115, 188, 48, 98, 150, 205, 211, 66, 2, 6, 126, 208,
-			46, 227, 195, 202, 111, 187, 117, 162, 204, 41, 175, 243, 153, 76,
-			218, 243, 153, 76, 218, 243, 227, 199, 72, 77, 102, 210, 94, 80,
-			143, 153, 207, 38, 121, 18, 98, 176, 14, 189, 3, 107, 42, 20,
-			9, 41, 120, 59, 0, 72, 23, 179, 51, 183, 183, 93, 224, 25,
-			234, 34, 181, 246, 194, 208, 104, 42, 181, 246, 194, 216, 184, 72,
-			223, 213, 12, 109, 74, 53, 68, 250, 174, 227, 57, 60, 255, 44,
-			21, 125, 225, 91, 151, 126, 76, 102, 38, 103, 117, 42, 62, 190,
-			6, 243, 125, 42, 62, 190, 6, 243, 124, 106, 252, 24, 249, 51,
-			85, 230, 172, 206, 169, 143, 153, 127, 200, 37, 167, 107, 221, 115,
-			186, 253, 110, 202, 171, 5, 111, 35, 20, 157, 244, 3, 175, 34,
-			175, 188, 226, 190, 43, 223, 104, 145, 249, 180, 48, 235, 72, 106,
-			26, 192, 107, 152, 129, 54, 112, 124, 86, 78, 178, 72, 156, 59,
-			140, 57, 36, 242, 24, 60, 87, 206, 202, 48, 149, 187, 7, 78,
-			36, 93, 14, 195, 126, 23, 134, 17, 219, 192, 228, 116, 57, 207,
-			17, 27, 208, 26, 68, 78, 105, 43, 162, 46, 179, 194, 8, 15,
-			30, 2, 227, 166, 217, 46, 243, 168, 211, 134, 154, 187, 142, 239,
-			198, 151, 101, 97, 122, 77, 130, 248, 12, 63, 230, 24, 18, 218,
-			181, 188, 125, 106, 217, 252, 118, 30, 206, 254, 16, 232, 20, 23,
-			51, 200, 27, 107, 216, 61, 80, 83, 60, 210, 206, 51, 51, 68,
-			75, 153, 75, 178, 230, 226, 33, 209, 21, 67, 155, 43, 26, 169,
-			244, 218, 185, 19, 39, 201, 167, 85, 153, 94, 123, 77, 61, 105,
-			126, 244, 126, 67, 130, 122, 14, 35, 23, 97, 86, 109, 196, 25,
-			85, 113, 102, 0, 31, 37, 207, 23, 215, 102, 164, 134, 38, 222,
-			103, 227, 99, 87, 201, 190, 139, 231, 159, 147, 147, 162, 113, 51,
-			233, 61, 14, 217, 66, 60, 126, 137, 90, 73, 238, 2, 34, 180,
-			205, 162, 214, 78, 90, 108, 147, 93, 216, 48, 230, 31, 86, 66,
-			21, 225, 237, 167, 233, 75, 178, 123, 145, 41, 67, 169, 236, 222,
-			107, 197, 99, 169, 236, 222, 107, 19, 39, 200, 15, 233, 50, 187,
-			119, 85, 53, 205, 255, 170, 101, 110, 191, 147, 201, 170, 124, 129,
-			144, 218, 55, 150, 107, 148, 233, 84, 252, 50, 233, 159, 46, 211,
-			76, 57, 127, 113, 218, 102, 109, 171, 239, 70, 51, 34, 47, 45,
-			194, 96, 170, 188, 100, 55, 76, 157, 173, 232, 115, 6, 19, 202,
-			99, 184, 32, 88, 97, 228, 247, 64, 10, 83, 23, 47, 49, 204,
-			208, 78, 197, 85, 153, 135, 67, 134, 91, 184, 241, 6, 143, 131,
-			19, 12, 211, 148, 188, 172, 26, 168, 208, 101, 186, 158, 118, 236,
-			99, 76, 17, 191, 128, 117, 253, 93, 113, 255, 32, 230, 232, 146,
-			228, 206, 169, 3, 23, 149, 135, 214, 254, 224, 210, 1, 130, 3,
-			43, 53, 245, 219, 75, 132, 190, 124, 165, 76, 159, 42, 211, 107,
-			101, 250, 244, 135, 238, 199, 32, 76, 155, 227, 36, 95, 145, 56,
-			0, 163, 151, 248, 219, 31, 42, 35, 23, 122, 64, 71, 147, 181,
-			44, 188, 8, 251, 42, 102, 251, 115, 234, 128, 160, 3, 99, 146,
-			161, 8, 135, 45, 141, 74, 38, 137, 122, 53, 86, 177, 5, 197,
-			208, 86, 135, 78, 164, 146, 168, 87, 39, 31, 39, 127, 168, 200,
-			27, 50, 107, 234, 251, 52, 243, 223, 224, 93, 137, 114, 176, 202,
-			194, 178, 16, 23, 158, 98, 135, 34, 117, 140, 90, 52, 113, 150,
-			227, 172, 157, 228, 138, 173, 196, 108, 224, 30, 54, 221, 174, 175,
-			133, 56, 187, 82, 48, 42, 46, 207, 143, 176, 67, 63, 72, 69,
-			225, 49, 40, 78, 226, 207, 47, 200, 251, 17, 67, 252, 182, 196,
-			0, 90, 73, 231, 124, 34, 98, 50, 111, 230, 198, 206, 26, 153,
-			36, 183, 147, 27, 59, 223, 171, 95, 50, 159, 17, 9, 194, 124,
-			171, 37, 89, 189, 18, 236, 226, 246, 154, 124, 101, 245, 43, 184,
-			244, 166, 46, 230, 44, 64, 83, 167, 51, 23, 117, 190, 247, 204,
-			197, 204, 69, 157, 239, 157, 158, 33, 207, 39, 23, 117, 174, 235,
-			19, 230, 117, 90, 23, 106, 57, 221, 89, 234, 206, 209, 169, 48,
-			9, 200, 198, 87, 197, 138, 179, 24, 233, 123, 57, 215, 83, 119,
-			63, 194, 162, 189, 62, 60, 150, 185, 151, 115, 221, 56, 78, 170,
-			201, 189, 156, 155, 250, 113, 243, 218, 67, 244, 29, 167, 89, 196,
-			46, 116, 246, 10, 206, 205, 84, 183, 64, 211, 230, 240, 209, 204,
-			21, 156, 155, 199, 12, 76, 245, 205, 169, 67, 134, 86, 87, 229,
-			217, 137, 161, 2, 64, 210, 110, 27, 82, 12, 173, 126, 76, 30,
-			179, 25, 210, 12, 173, 254, 196, 5, 242, 179, 10, 79, 233, 127,
-			33, 215, 86, 204, 255, 71, 161, 41, 143, 233, 33, 141, 110, 120,
-			35, 177, 186, 105, 45, 146, 11, 40, 73, 50, 57, 121, 184, 147,
-			90, 180, 227, 192, 50, 152, 78, 15, 225, 50, 144, 62, 113, 146,
-			172, 42, 242, 40, 193, 11, 197, 227, 104, 200, 98, 42, 246, 139,
-			15, 111, 200, 42, 104, 200, 190, 40, 236, 44, 158, 159, 253, 162,
-			48, 100, 121, 126, 246, 139, 210, 144, 85, 128, 175, 205, 239, 27,
-			178, 143, 102, 200, 42, 56, 43, 154, 49, 131, 97, 176, 154, 194,
-			144, 85, 112, 70, 52, 133, 33, 171, 192, 124, 96, 239, 136, 33,
-			171, 224, 156, 96, 66, 203, 42, 56, 35, 152, 48, 100, 21, 156,
-			15, 108, 108, 156, 108, 96, 214, 125, 222, 201, 125, 82, 81, 204,
-			27, 52, 229, 244, 39, 114, 157, 185, 85, 250, 237, 188, 73, 153,
-			160, 239, 20, 97, 142, 139, 116, 249, 215, 212, 19, 230, 187, 232,
-			166, 16, 192, 228, 178, 105, 4, 241, 64, 158, 164, 45, 20, 28,
-			108, 50, 252, 118, 79, 228, 11, 106, 120, 46, 253, 107, 130, 133,
-			60, 151, 254, 53, 33, 163, 60, 151, 254, 181, 227, 19, 124, 205,
-			64, 74, 95, 87, 79, 153, 191, 165, 12, 102, 89, 165, 78, 126,
-			242, 101, 94, 152, 4, 3, 119, 92, 195, 236, 20, 86, 82, 178,
-			90, 52, 247, 105, 200, 162, 248, 80, 140, 120, 48, 21, 82, 126,
-			63, 119, 28, 161, 134, 65, 147, 87, 71, 19, 126, 143, 168, 120,
-			8, 116, 139, 20, 110, 198, 223, 154, 10, 105, 58, 121, 41, 73,
-			163, 135, 165, 167, 153, 100, 108, 196, 76, 80, 10, 64, 154, 36,
-			27, 228, 232, 245, 99, 39, 37, 164, 25, 218, 235, 143, 155, 228,
-			127, 112, 38, 168, 134, 182, 175, 94, 52, 255, 156, 51, 129, 221,
-			235, 89, 158, 205, 236, 67, 51, 156, 98, 125, 26, 223, 187, 22,
-			49, 79, 126, 68, 238, 249, 173, 141, 117, 238, 51, 244, 187, 61,
-			105, 142, 136, 45, 131, 100, 55, 0, 19, 116, 51, 164, 166, 47,
-			36, 150, 11, 86, 156, 147, 120, 157, 240, 219, 48, 249, 87, 55,
-			112, 121, 147, 95, 148, 177, 147, 165, 69, 190, 38, 239, 151, 20,
-			193, 226, 4, 115, 236, 146, 100, 206, 201, 169, 120, 52, 114, 95,
-			156, 147, 83, 81, 24, 246, 79, 203, 3, 25, 32, 246, 251, 79,
-			92, 32, 239, 33, 60, 209, 85, 251, 176, 250, 132, 185, 136, 135,
-			131, 83, 23, 223, 113, 124, 48, 138, 46, 231, 181, 125, 136, 209,
-			171, 170, 154, 14, 45, 196, 80, 193, 208, 62, 60, 242, 184, 132,
-			20, 67, 251, 176, 121, 86, 66, 208, 215, 249, 18, 121, 47, 116,
-			172, 229, 140, 252, 15, 226, 33, 139, 103, 233, 109, 223, 197, 139,
-			143, 14, 141, 158, 102, 230, 57, 95, 22, 121, 98, 200, 118, 125,
-			77, 34, 129, 118, 196, 15, 146, 9, 242, 44, 41, 0, 164, 230,
-			12, 253, 135, 21, 125, 214, 44, 31, 184, 114, 221, 9, 15, 88,
-			17, 252, 242, 102, 145, 233, 160, 114, 219, 1, 94, 63, 147, 20,
-			40, 80, 112, 118, 58, 41, 208, 160, 224, 114, 153, 44, 137, 14,
-			21, 67, 255, 168, 162, 159, 52, 47, 137, 131, 16, 216, 67, 106,
-			214, 109, 215, 215, 202, 96, 77, 199, 115, 41, 213, 157, 146, 199,
-			151, 83, 5, 216, 154, 200, 204, 82, 69, 150, 208, 71, 149, 137,
-			19, 228, 25, 209, 29, 63, 19, 113, 194, 156, 25, 236, 142, 187,
-			219, 15, 234, 77, 156, 123, 72, 21, 224, 185, 7, 113, 202, 67,
-			141, 207, 61, 28, 159, 32, 61, 148, 16, 221, 208, 63, 161, 168,
-			103, 204, 38, 93, 246, 226, 208, 112, 186, 79, 62, 28, 7, 173,
-			162, 3, 88, 208, 93, 199, 34, 180, 4, 175, 138, 155, 23, 250,
-			129, 123, 87, 154, 121, 37, 17, 251, 85, 49, 211, 228, 19, 138,
-			58, 42, 65, 5, 192, 35, 147, 18, 212, 0, 60, 117, 154, 212,
-			241, 212, 80, 225, 199, 149, 220, 215, 21, 197, 92, 165, 233, 253,
-			216, 135, 180, 72, 240, 149, 180, 234, 30, 225, 103, 139, 244, 31,
-			87, 138, 19, 228, 42, 209, 117, 77, 207, 25, 133, 159, 80, 212,
-			255, 87, 209, 204, 139, 84, 236, 221, 103, 111, 136, 140, 68, 33,
-			63, 15, 204, 137, 192, 109, 53, 253, 39, 148, 33, 126, 238, 78,
-			227, 95, 147, 249, 180, 162, 31, 49, 175, 209, 27, 126, 180, 147,
-			220, 78, 4, 90, 56, 190, 158, 72, 4, 122, 14, 250, 173, 226,
-			196, 140, 248, 206, 203, 167, 227, 131, 43, 58, 94, 32, 160, 127,
-			90, 25, 25, 69, 233, 208, 248, 167, 94, 126, 74, 209, 71, 205,
-			25, 186, 225, 185, 251, 73, 79, 15, 209, 184, 194, 223, 29, 74,
-			10, 84, 40, 32, 35, 113, 227, 170, 161, 255, 180, 162, 143, 200,
-			198, 31, 5, 115, 64, 236, 167, 21, 189, 144, 20, 96, 99, 195,
-			132, 115, 26, 56, 244, 179, 138, 122, 194, 156, 74, 37, 125, 113,
-			19, 43, 49, 219, 196, 153, 219, 192, 151, 169, 2, 120, 178, 11,
-			222, 43, 74, 80, 1, 112, 120, 92, 130, 26, 128, 199, 39, 200,
-			127, 83, 229, 49, 158, 207, 41, 170, 97, 254, 169, 58, 216, 139,
-			152, 170, 188, 135, 158, 21, 88, 93, 22, 177, 0, 84, 253, 166,
-			21, 237, 112, 127, 72, 38, 22, 250, 65, 151, 150, 192, 176, 154,
-			3, 139, 106, 142, 39, 167, 204, 93, 158, 227, 109, 204, 193, 122,
-			94, 74, 221, 240, 22, 231, 22, 241, 39, 20, 252, 41, 208, 115,
-			226, 35, 88, 232, 179, 117, 92, 191, 57, 27, 70, 251, 46, 163,
-			165, 75, 37, 158, 124, 116, 233, 82, 137, 250, 61, 112, 219, 125,
-			126, 202, 53, 117, 28, 237, 213, 126, 24, 29, 196, 161, 132, 75,
-			73, 58, 165, 2, 187, 68, 175, 60, 12, 251, 93, 158, 104, 214,
-			4, 140, 160, 241, 233, 46, 179, 60, 180, 22, 227, 175, 56, 132,
-			152, 104, 183, 209, 117, 146, 133, 30, 45, 72, 52, 29, 101, 210,
-			14, 63, 152, 25, 4, 126, 64, 167, 61, 31, 6, 221, 110, 225,
-			158, 66, 230, 38, 237, 153, 120, 140, 64, 199, 125, 46, 25, 35,
-			5, 71, 65, 164, 195, 243, 227, 82, 159, 83, 198, 143, 17, 27,
-			135, 136, 31, 59, 57, 102, 190, 63, 109, 0, 242, 157, 182, 196,
-			21, 228, 184, 78, 137, 220, 227, 65, 3, 48, 182, 76, 253, 54,
-			50, 138, 160, 109, 187, 201, 77, 103, 222, 169, 56, 1, 82, 144,
-			32, 158, 0, 25, 26, 149, 32, 158, 0, 25, 27, 39, 63, 134,
-			103, 2, 1, 252, 34, 224, 244, 70, 130, 19, 110, 8, 132, 3,
-			251, 251, 28, 19, 110, 97, 199, 200, 88, 225, 97, 107, 59, 145,
-			183, 168, 199, 152, 218, 44, 85, 141, 127, 100, 78, 234, 173, 48,
-			198, 91, 203, 35, 42, 18, 111, 208, 86, 95, 76, 240, 214, 16,
-			209, 177, 113, 242, 17, 68, 91, 55, 244, 95, 4, 105, 239, 209,
-			117, 118, 47, 66, 117, 12, 118, 21, 122, 225, 229, 131, 31, 73,
-			112, 66, 33, 254, 226, 248, 152, 60, 95, 43, 157, 47, 84, 147,
-			36, 117, 115, 97, 47, 96, 187, 142, 223, 151, 175, 185, 172, 13,
-			38, 67, 59, 70, 86, 207, 35, 2, 114, 220, 65, 149, 255, 98,
-			50, 238, 160, 202, 127, 17, 198, 253, 235, 156, 201, 121, 67, 255,
-			167, 138, 58, 9, 86, 235, 157, 56, 196, 45, 85, 249, 193, 61,
-			70, 222, 167, 84, 60, 201, 246, 47, 159, 39, 217, 22, 226, 221,
-			193, 126, 175, 7, 62, 24, 250, 49, 82, 87, 197, 95, 199, 171,
-			208, 219, 254, 30, 219, 101, 65, 89, 110, 148, 136, 17, 228, 157,
-			36, 223, 86, 230, 159, 61, 105, 50, 26, 246, 227, 143, 214, 221,
-			39, 198, 194, 73, 205, 115, 218, 134, 36, 168, 0, 88, 60, 46,
-			65, 13, 192, 147, 143, 145, 29, 228, 67, 193, 208, 127, 77, 81,
-			79, 153, 47, 201, 211, 108, 141, 253, 30, 27, 28, 60, 249, 185,
-			224, 48, 205, 1, 153, 178, 27, 127, 61, 38, 217, 218, 27, 56,
-			216, 199, 59, 46, 228, 177, 43, 57, 62, 5, 5, 64, 113, 12,
-			74, 195, 19, 31, 191, 166, 76, 154, 120, 8, 70, 211, 114, 70,
-			225, 75, 138, 250, 27, 138, 38, 207, 123, 38, 151, 222, 80, 52,
-			123, 221, 8, 6, 198, 147, 173, 99, 34, 252, 151, 20, 98, 146,
-			167, 146, 99, 172, 95, 86, 244, 115, 230, 5, 124, 63, 137, 171,
-			139, 205, 224, 129, 70, 226, 99, 167, 5, 124, 109, 34, 115, 48,
-			245, 203, 202, 9, 51, 115, 48, 245, 203, 202, 153, 179, 226, 168,
-			230, 144, 161, 127, 69, 81, 47, 8, 42, 134, 240, 84, 139, 106,
-			72, 16, 79, 181, 28, 63, 43, 65, 60, 213, 114, 254, 9, 210,
-			192, 87, 139, 134, 254, 155, 138, 58, 101, 222, 164, 235, 24, 107,
-			123, 32, 151, 197, 7, 182, 168, 213, 142, 196, 22, 169, 72, 164,
-			229, 9, 230, 9, 155, 139, 5, 108, 246, 148, 4, 21, 0, 79,
-			159, 151, 160, 6, 224, 133, 39, 201, 54, 162, 48, 108, 232, 255,
-			26, 80, 184, 69, 55, 92, 251, 97, 81, 16, 95, 191, 125, 0,
-			14, 195, 5, 108, 87, 226, 48, 172, 0, 24, 227, 48, 172, 1,
-			120, 225, 73, 242, 49, 5, 76, 32, 98, 232, 191, 13, 18, 184,
-			79, 95, 94, 173, 110, 214, 171, 43, 203, 141, 234, 234, 135, 120,
-			92, 37, 150, 192, 88, 206, 99, 47, 89, 98, 23, 237, 248, 33,
-			152, 220, 240, 119, 162, 238, 228, 23, 149, 196, 117, 167, 120, 16,
-			139, 133, 25, 49, 149, 117, 208, 178, 215, 117, 141, 228, 0, 145,
-			17, 147, 27, 102, 195, 134, 254, 59, 138, 122, 148, 63, 26, 206,
-			1, 52, 34, 116, 201, 48, 200, 68, 26, 84, 5, 248, 39, 124,
-			217, 39, 134, 254, 31, 20, 149, 154, 255, 78, 165, 13, 171, 19,
-			43, 21, 153, 172, 13, 69, 145, 159, 80, 132, 15, 185, 126, 129,
-			169, 196, 183, 216, 27, 150, 52, 118, 187, 214, 126, 178, 155, 179,
-			68, 232, 44, 93, 78, 221, 40, 130, 239, 97, 198, 183, 252, 152,
-			105, 200, 50, 28, 226, 23, 121, 138, 174, 184, 95, 13, 30, 63,
-			161, 84, 240, 44, 178, 58, 210, 241, 21, 186, 57, 105, 189, 103,
-			57, 65, 37, 238, 82, 220, 130, 233, 197, 27, 238, 211, 158, 227,
-			206, 240, 249, 244, 54, 40, 64, 119, 41, 85, 37, 177, 144, 223,
-			93, 217, 149, 59, 18, 86, 7, 58, 42, 15, 132, 183, 97, 61,
-			205, 88, 188, 42, 41, 32, 143, 165, 242, 32, 10, 128, 226, 44,
-			143, 166, 18, 13, 192, 51, 231, 200, 11, 56, 30, 35, 134, 254,
-			135, 138, 122, 210, 172, 209, 77, 254, 97, 151, 68, 202, 19, 214,
-			167, 228, 60, 65, 202, 15, 240, 95, 111, 42, 74, 127, 24, 38,
-			198, 98, 164, 128, 45, 203, 131, 217, 35, 10, 128, 68, 90, 131,
-			35, 26, 128, 199, 79, 144, 6, 63, 151, 253, 199, 74, 238, 127,
-			41, 138, 121, 147, 102, 210, 54, 30, 118, 223, 231, 80, 247, 1,
-			214, 184, 63, 86, 138, 39, 112, 51, 11, 15, 75, 127, 19, 140,
-			218, 235, 111, 191, 247, 99, 97, 200, 128, 119, 153, 221, 254, 17,
-			135, 149, 243, 216, 86, 49, 117, 148, 249, 155, 210, 208, 229, 71,
-			153, 191, 9, 134, 238, 10, 244, 11, 202, 250, 79, 20, 245, 191,
-			43, 154, 121, 69, 30, 207, 29, 184, 215, 30, 143, 76, 197, 107,
-			104, 76, 104, 234, 148, 44, 42, 240, 63, 1, 238, 85, 72, 1,
-			64, 113, 144, 105, 194, 60, 139, 182, 84, 124, 174, 39, 187, 147,
-			41, 84, 183, 62, 120, 234, 72, 79, 78, 29, 141, 37, 5, 120,
-			234, 200, 56, 78, 254, 147, 34, 250, 80, 12, 253, 207, 21, 253,
-			140, 249, 7, 10, 141, 191, 251, 22, 253, 157, 217, 196, 146, 116,
-			43, 5, 36, 211, 72, 10, 144, 238, 227, 147, 73, 129, 6, 5,
-			167, 78, 147, 255, 172, 10, 206, 168, 134, 254, 109, 69, 159, 50,
-			191, 198, 119, 158, 193, 66, 156, 237, 89, 173, 215, 178, 123, 13,
-			246, 33, 187, 90, 168, 191, 82, 40, 178, 129, 147, 99, 161, 220,
-			88, 194, 97, 77, 172, 7, 116, 29, 196, 137, 61, 185, 227, 37,
-			69, 230, 48, 190, 241, 179, 23, 229, 129, 147, 44, 210, 208, 34,
-			201, 238, 87, 252, 101, 185, 195, 183, 204, 86, 15, 188, 123, 191,
-			141, 179, 129, 157, 176, 131, 213, 83, 212, 36, 198, 117, 130, 27,
-			73, 51, 73, 178, 30, 44, 176, 111, 203, 29, 32, 93, 108, 146,
-			124, 91, 57, 91, 74, 10, 52, 40, 184, 248, 36, 249, 1, 49,
-			54, 120, 204, 73, 159, 52, 231, 197, 73, 185, 184, 171, 212, 200,
-			28, 182, 223, 152, 116, 170, 241, 179, 84, 195, 73, 1, 158, 165,
-			34, 19, 73, 1, 118, 114, 242, 49, 180, 85, 240, 178, 130, 191,
-			84, 212, 179, 230, 77, 17, 46, 227, 223, 233, 201, 104, 76, 252,
-			74, 154, 56, 233, 35, 178, 31, 146, 85, 64, 122, 179, 56, 160,
-			201, 21, 1, 58, 54, 27, 131, 5, 0, 227, 227, 197, 10, 118,
-			106, 60, 158, 186, 49, 224, 47, 149, 211, 103, 200, 31, 197, 55,
-			6, 124, 23, 252, 141, 223, 86, 6, 108, 133, 131, 223, 99, 19,
-			103, 1, 186, 96, 182, 100, 45, 249, 84, 86, 20, 80, 22, 38,
-			171, 214, 97, 147, 57, 96, 61, 102, 197, 211, 249, 125, 105, 225,
-			76, 133, 60, 249, 197, 2, 32, 220, 201, 21, 209, 104, 116, 236,
-			199, 9, 17, 34, 33, 129, 175, 253, 224, 46, 197, 155, 170, 201,
-			149, 1, 223, 205, 94, 25, 240, 221, 228, 72, 55, 8, 192, 119,
-			193, 151, 89, 39, 106, 33, 103, 20, 126, 68, 205, 125, 82, 85,
-			204, 31, 224, 87, 26, 10, 21, 6, 171, 198, 108, 219, 106, 137,
-			228, 16, 252, 124, 119, 200, 239, 180, 122, 61, 179, 150, 128, 0,
-			237, 58, 45, 113, 3, 71, 1, 191, 237, 171, 22, 71, 201, 45,
-			162, 23, 80, 11, 127, 84, 85, 203, 230, 51, 24, 121, 144, 190,
-			49, 215, 130, 242, 20, 53, 250, 150, 160, 18, 99, 85, 63, 120,
-			82, 181, 192, 181, 241, 71, 213, 194, 176, 4, 85, 0, 201, 132,
-			4, 53, 0, 207, 93, 130, 37, 187, 128, 122, 249, 227, 170, 90,
-			49, 107, 60, 144, 151, 184, 228, 217, 208, 221, 128, 170, 126, 96,
-			216, 142, 247, 163, 96, 203, 133, 24, 84, 1, 28, 57, 41, 65,
-			13, 192, 243, 101, 178, 142, 88, 168, 134, 254, 9, 85, 93, 52,
-			127, 32, 30, 106, 142, 198, 192, 26, 36, 228, 44, 57, 236, 159,
-			200, 76, 74, 220, 11, 124, 102, 127, 66, 45, 140, 72, 16, 219,
-			31, 157, 148, 160, 6, 224, 19, 243, 242, 160, 201, 255, 14, 0,
-			0, 255, 255, 136, 134, 206, 3, 7, 144, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 125, 144, 93, 199,
+			85, 32, 254, 186, 251, 190, 55, 111, 250, 205, 104, 102, 122, 62,
+			52, 186, 210, 72, 173, 177, 236, 145, 236, 209, 27, 91, 118, 28,
+			71, 54, 96, 201, 146, 173, 137, 109, 89, 121, 26, 37, 56, 41,
+			74, 220, 121, 175, 231, 205, 141, 239, 187, 247, 249, 222, 251, 36,
+			77, 126, 169, 36, 64, 226, 95, 128, 221, 64, 130, 193, 155, 4,
+			8, 1, 76, 32, 36, 164, 146, 221, 108, 168, 117, 37, 11, 36,
+			64, 65, 178, 100, 23, 243, 185, 108, 40, 240, 18, 82, 252, 193,
+			86, 177, 187, 85, 192, 86, 165, 106, 235, 156, 254, 184, 247, 205,
+			140, 44, 57, 152, 173, 202, 214, 254, 99, 207, 233, 215, 183, 251,
+			244, 57, 167, 79, 159, 115, 250, 156, 22, 255, 123, 194, 15, 117,
+			147, 164, 27, 169, 149, 126, 154, 228, 201, 250, 96, 99, 37, 15,
+			123, 42, 203, 131, 94, 191, 137, 77, 98, 66, 119, 104, 218, 14,
+			139, 247, 243, 209, 53, 219, 71, 204, 243, 145, 76, 181, 147, 184,
+			147, 205, 19, 73, 142, 178, 150, 5, 197, 12, 175, 198, 65, 156,
+			100, 243, 84, 146, 163, 213, 150, 6, 78, 191, 131, 79, 183, 147,
+			94, 115, 219, 152, 167, 247, 184, 17, 47, 64, 211, 5, 242, 230,
+			59, 186, 97, 190, 57, 88, 111, 182, 147, 222, 74, 55, 137, 130,
+			184, 91, 160, 216, 207, 183, 250, 42, 43, 48, 253, 7, 66, 126,
+			150, 178, 71, 46, 156, 254, 56, 61, 248, 136, 30, 249, 130, 25,
+			185, 249, 38, 21, 69, 143, 198, 201, 213, 120, 13, 190, 89, 175,
+			225, 32, 119, 243, 15, 204, 240, 213, 110, 210, 108, 111, 166, 73,
+			47, 28, 244, 154, 73, 218, 93, 137, 6, 237, 112, 37, 74, 186,
+			157, 164, 187, 18, 244, 195, 21, 21, 119, 250, 73, 24, 231, 217,
+			74, 59, 73, 210, 78, 24, 7, 121, 146, 66, 135, 108, 229, 202,
+			93, 43, 89, 30, 228, 102, 5, 162, 166, 191, 242, 111, 68, 204,
+			197, 31, 99, 124, 207, 99, 73, 247, 98, 158, 170, 160, 119, 17,
+			70, 16, 183, 240, 113, 236, 126, 249, 138, 74, 179, 48, 137, 145,
+			142, 163, 173, 49, 108, 124, 163, 110, 19, 247, 240, 145, 118, 170,
+			130, 92, 117, 144, 156, 141, 19, 254, 118, 18, 54, 29, 5, 91,
+			182, 171, 184, 149, 239, 201, 85, 218, 11, 227, 32, 186, 28, 198,
+			29, 117, 109, 158, 33, 143, 198, 109, 235, 42, 52, 138, 7, 248,
+			72, 144, 182, 55, 195, 43, 106, 222, 195, 193, 23, 155, 122, 61,
+			205, 97, 84, 155, 167, 116, 175, 213, 120, 35, 105, 217, 79, 196,
+			28, 175, 245, 7, 105, 87, 117, 230, 171, 146, 28, 173, 183, 12,
+			228, 255, 34, 225, 141, 210, 7, 98, 63, 31, 69, 28, 46, 15,
+			210, 200, 172, 177, 142, 13, 151, 210, 72, 44, 112, 158, 225, 68,
+			248, 43, 197, 95, 71, 117, 11, 252, 188, 143, 215, 59, 65, 30,
+			224, 143, 12, 127, 28, 1, 24, 126, 242, 121, 189, 157, 244, 250,
+			145, 202, 53, 246, 245, 150, 131, 197, 109, 124, 34, 74, 186, 151,
+			85, 156, 167, 91, 151, 219, 201, 32, 206, 17, 71, 214, 26, 143,
+			146, 238, 89, 104, 125, 8, 26, 79, 63, 252, 230, 51, 255, 36,
+			89, 184, 95, 147, 235, 245, 159, 157, 224, 53, 225, 121, 149, 19,
+			132, 127, 150, 112, 50, 38, 152, 87, 17, 39, 62, 78, 228, 67,
+			73, 127, 43, 13, 187, 155, 185, 60, 113, 231, 93, 247, 202, 181,
+			77, 37, 31, 187, 244, 208, 170, 60, 53, 200, 55, 147, 52, 107,
+			202, 83, 81, 36, 177, 67, 38, 83, 149, 169, 244, 138, 234, 52,
+			185, 188, 148, 41, 153, 108, 200, 124, 51, 204, 100, 150, 12, 210,
+			182, 146, 237, 164, 163, 100, 152, 201, 110, 114, 69, 165, 177, 234,
+			200, 65, 220, 81, 169, 204, 55, 149, 60, 213, 15, 218, 48, 112,
+			216, 86, 113, 166, 150, 165, 145, 29, 121, 162, 121, 39, 151, 249,
+			102, 144, 203, 118, 16, 203, 117, 37, 55, 146, 65, 220, 145, 97,
+			140, 95, 61, 182, 250, 208, 217, 243, 23, 207, 202, 141, 48, 82,
+			77, 206, 235, 156, 80, 193, 106, 149, 9, 248, 171, 46, 88, 189,
+			242, 22, 62, 202, 105, 189, 225, 254, 100, 21, 193, 120, 229, 24,
+			255, 105, 194, 169, 87, 17, 222, 68, 229, 4, 241, 223, 79, 228,
+			176, 172, 0, 142, 129, 92, 15, 59, 97, 170, 218, 121, 152, 196,
+			65, 36, 113, 199, 200, 43, 65, 52, 80, 114, 144, 41, 68, 225,
+			82, 191, 19, 228, 74, 239, 7, 217, 14, 162, 40, 107, 114, 190,
+			203, 88, 170, 183, 174, 58, 157, 96, 61, 82, 240, 213, 89, 203,
+			13, 153, 170, 167, 7, 42, 203, 87, 82, 149, 245, 147, 56, 83,
+			50, 203, 211, 65, 59, 135, 81, 56, 103, 94, 133, 8, 54, 81,
+			159, 227, 103, 184, 231, 85, 104, 69, 176, 169, 250, 97, 255, 181,
+			242, 66, 105, 111, 1, 166, 64, 8, 187, 145, 164, 217, 135, 114,
+			35, 73, 13, 233, 17, 187, 38, 231, 99, 188, 10, 163, 84, 97,
+			152, 61, 22, 34, 130, 77, 77, 28, 176, 16, 19, 108, 234, 144,
+			228, 23, 112, 62, 34, 216, 76, 189, 233, 63, 132, 12, 7, 109,
+			37, 175, 110, 42, 77, 246, 40, 233, 154, 113, 229, 213, 0, 152,
+			222, 13, 179, 92, 165, 170, 35, 175, 134, 249, 38, 118, 121, 168,
+			16, 52, 55, 55, 169, 193, 144, 135, 45, 4, 19, 44, 30, 179,
+			16, 19, 108, 102, 249, 56, 191, 130, 115, 83, 193, 230, 235, 135,
+			253, 16, 231, 54, 51, 225, 118, 211, 18, 85, 198, 96, 41, 147,
+			86, 33, 200, 158, 202, 178, 160, 171, 154, 114, 85, 247, 210, 220,
+			10, 51, 121, 252, 174, 101, 238, 190, 67, 162, 132, 81, 100, 6,
+			8, 227, 174, 195, 144, 86, 97, 226, 113, 11, 17, 193, 230, 247,
+			88, 234, 80, 38, 216, 252, 33, 201, 207, 1, 134, 172, 34, 188,
+			253, 244, 118, 230, 159, 148, 37, 53, 33, 219, 73, 156, 7, 97,
+			156, 73, 163, 95, 100, 71, 229, 65, 24, 101, 134, 29, 101, 188,
+			237, 156, 12, 184, 188, 159, 207, 242, 55, 240, 26, 64, 192, 231,
+			5, 111, 159, 127, 26, 215, 174, 15, 4, 121, 49, 79, 210, 160,
+			171, 228, 165, 214, 99, 192, 133, 84, 109, 27, 108, 41, 51, 228,
+			9, 221, 212, 157, 38, 231, 123, 248, 136, 30, 178, 10, 99, 150,
+			96, 34, 216, 66, 99, 166, 128, 153, 96, 11, 123, 231, 249, 91,
+			12, 10, 68, 176, 67, 158, 239, 63, 246, 10, 81, 72, 131, 171,
+			6, 144, 160, 224, 174, 131, 12, 169, 194, 232, 37, 24, 102, 107,
+			204, 22, 48, 19, 236, 208, 252, 62, 254, 102, 131, 12, 21, 236,
+			176, 55, 239, 63, 250, 10, 145, 9, 178, 76, 245, 214, 35, 213,
+			121, 57, 92, 128, 223, 135, 75, 184, 80, 34, 216, 225, 198, 116,
+			1, 51, 193, 14, 207, 237, 229, 95, 35, 6, 25, 38, 216, 109,
+			222, 156, 255, 31, 8, 138, 88, 58, 80, 203, 50, 136, 34, 228,
+			4, 40, 234, 80, 101, 114, 93, 229, 87, 149, 138, 229, 157, 50,
+			136, 59, 78, 54, 245, 17, 38, 175, 2, 174, 14, 17, 185, 186,
+			193, 229, 70, 16, 129, 194, 195, 205, 26, 198, 157, 176, 29, 228,
+			10, 54, 117, 144, 111, 91, 20, 238, 181, 56, 201, 165, 61, 34,
+			162, 45, 25, 37, 65, 7, 117, 81, 158, 112, 248, 175, 74, 123,
+			170, 19, 130, 218, 201, 12, 137, 220, 166, 213, 179, 6, 145, 238,
+			118, 37, 136, 164, 186, 214, 15, 211, 33, 122, 176, 42, 172, 175,
+			94, 192, 68, 176, 219, 70, 167, 10, 24, 214, 63, 51, 203, 23,
+			13, 57, 60, 193, 142, 121, 7, 253, 105, 228, 77, 60, 232, 173,
+			171, 20, 118, 104, 148, 116, 139, 49, 189, 42, 116, 26, 45, 96,
+			34, 216, 49, 190, 175, 128, 153, 96, 199, 14, 44, 240, 0, 246,
+			21, 108, 178, 227, 212, 247, 215, 128, 190, 113, 18, 31, 143, 195,
+			104, 121, 59, 29, 74, 188, 92, 214, 68, 6, 218, 109, 132, 42,
+			234, 108, 223, 129, 65, 196, 237, 30, 116, 155, 156, 213, 96, 14,
+			187, 201, 25, 17, 236, 248, 158, 89, 11, 193, 252, 243, 251, 248,
+			59, 17, 25, 79, 176, 187, 234, 243, 126, 42, 87, 75, 124, 81,
+			82, 219, 8, 230, 68, 72, 54, 100, 0, 76, 106, 202, 83, 240,
+			63, 205, 184, 205, 0, 228, 64, 197, 182, 107, 152, 201, 36, 142,
+			182, 184, 12, 218, 79, 197, 201, 213, 72, 117, 160, 53, 79, 100,
+			208, 233, 133, 113, 152, 229, 105, 144, 131, 186, 104, 71, 161, 138,
+			243, 2, 85, 160, 221, 93, 245, 49, 11, 17, 193, 238, 26, 159,
+			182, 16, 19, 236, 174, 185, 189, 206, 40, 252, 31, 132, 31, 220,
+			110, 193, 117, 6, 48, 112, 18, 95, 207, 26, 62, 201, 235, 103,
+			76, 151, 87, 108, 12, 191, 125, 119, 99, 120, 220, 14, 104, 109,
+			225, 219, 111, 108, 11, 91, 52, 191, 5, 83, 248, 217, 203, 124,
+			229, 70, 230, 79, 148, 116, 251, 235, 208, 96, 200, 80, 197, 134,
+			27, 218, 187, 254, 13, 200, 185, 248, 30, 198, 167, 221, 97, 127,
+			70, 101, 237, 52, 236, 231, 73, 138, 70, 101, 170, 54, 194, 107,
+			198, 82, 52, 144, 16, 220, 139, 131, 158, 66, 35, 120, 180, 133,
+			127, 139, 19, 188, 97, 108, 71, 32, 5, 154, 184, 123, 78, 76,
+			129, 9, 219, 95, 111, 94, 196, 95, 214, 182, 250, 170, 101, 44,
+			76, 248, 91, 28, 230, 99, 32, 230, 42, 206, 245, 71, 96, 57,
+			142, 182, 26, 166, 13, 187, 220, 199, 71, 221, 106, 230, 171, 55,
+			52, 186, 139, 206, 226, 62, 238, 229, 65, 55, 155, 175, 73, 118,
+			180, 113, 226, 136, 193, 100, 151, 101, 54, 215, 130, 110, 134, 118,
+			104, 11, 191, 0, 131, 117, 61, 140, 131, 116, 235, 50, 152, 99,
+			151, 213, 181, 124, 126, 4, 49, 27, 215, 205, 15, 135, 145, 58,
+			123, 45, 7, 242, 224, 94, 205, 230, 235, 146, 1, 121, 52, 228,
+			191, 150, 143, 186, 33, 197, 36, 103, 79, 169, 45, 67, 64, 248,
+			19, 164, 16, 207, 115, 67, 62, 13, 156, 164, 247, 145, 197, 183,
+			114, 111, 77, 93, 203, 197, 109, 188, 26, 133, 177, 2, 249, 5,
+			220, 39, 13, 238, 240, 91, 243, 177, 48, 86, 45, 253, 179, 127,
+			146, 123, 0, 22, 35, 194, 44, 99, 102, 68, 113, 128, 143, 118,
+			84, 20, 246, 194, 92, 165, 102, 174, 162, 97, 113, 145, 215, 78,
+			227, 106, 128, 155, 112, 178, 96, 151, 177, 22, 254, 253, 122, 175,
+			78, 38, 233, 226, 135, 8, 175, 159, 9, 242, 160, 155, 6, 61,
+			215, 141, 20, 221, 196, 93, 124, 164, 31, 164, 121, 24, 68, 198,
+			33, 218, 107, 80, 181, 95, 53, 47, 232, 159, 91, 182, 159, 255,
+			8, 31, 49, 109, 128, 54, 30, 38, 72, 156, 241, 150, 6, 96,
+			158, 44, 124, 155, 22, 46, 175, 133, 127, 67, 91, 20, 100, 57,
+			74, 85, 189, 133, 127, 47, 254, 17, 229, 252, 34, 154, 153, 131,
+			84, 117, 196, 138, 99, 134, 38, 154, 197, 164, 232, 210, 124, 24,
+			216, 227, 184, 244, 19, 148, 87, 177, 101, 23, 22, 221, 194, 199,
+			178, 60, 13, 227, 238, 229, 18, 167, 206, 85, 90, 32, 226, 97,
+			220, 125, 35, 52, 138, 5, 112, 165, 114, 211, 3, 48, 99, 231,
+			42, 173, 122, 24, 231, 250, 231, 91, 248, 88, 39, 25, 172, 71,
+			202, 244, 0, 225, 38, 48, 134, 110, 213, 157, 14, 113, 190, 158,
+			36, 145, 233, 2, 242, 93, 63, 87, 105, 141, 66, 155, 238, 112,
+			152, 55, 214, 183, 114, 149, 153, 30, 53, 32, 254, 185, 74, 139,
+			99, 163, 238, 114, 150, 79, 56, 169, 55, 221, 70, 110, 180, 81,
+			206, 85, 90, 123, 220, 71, 56, 204, 233, 17, 35, 68, 139, 255,
+			149, 242, 250, 99, 198, 51, 19, 39, 121, 3, 250, 93, 78, 54,
+			54, 50, 149, 35, 167, 26, 39, 246, 237, 24, 216, 234, 202, 22,
+			135, 222, 79, 96, 103, 216, 222, 90, 97, 24, 183, 23, 168, 232,
+			181, 26, 186, 77, 59, 189, 135, 145, 208, 224, 113, 22, 158, 177,
+			215, 50, 154, 68, 119, 241, 121, 61, 3, 247, 34, 110, 107, 215,
+			210, 107, 57, 88, 28, 230, 94, 14, 219, 147, 35, 90, 13, 195,
+			114, 216, 39, 231, 42, 45, 252, 73, 44, 241, 154, 222, 181, 243,
+			13, 236, 52, 110, 58, 105, 225, 63, 87, 105, 153, 159, 197, 113,
+			237, 221, 130, 212, 206, 143, 97, 215, 137, 109, 194, 12, 236, 181,
+			93, 196, 221, 232, 43, 27, 209, 154, 31, 199, 15, 166, 118, 200,
+			28, 176, 170, 232, 118, 122, 148, 143, 24, 229, 182, 248, 203, 12,
+			169, 172, 215, 216, 228, 94, 71, 101, 109, 67, 94, 255, 250, 186,
+			170, 133, 253, 196, 10, 31, 49, 86, 218, 60, 69, 105, 159, 45,
+			62, 193, 17, 155, 200, 189, 150, 237, 37, 110, 231, 83, 81, 144,
+			229, 151, 135, 248, 161, 137, 61, 1, 63, 92, 40, 241, 196, 246,
+			29, 98, 140, 87, 244, 189, 88, 98, 206, 117, 124, 123, 111, 155,
+			111, 239, 127, 158, 240, 42, 162, 4, 74, 179, 36, 75, 94, 203,
+			64, 67, 108, 166, 59, 216, 60, 44, 72, 236, 198, 130, 228, 237,
+			20, 164, 109, 162, 92, 125, 5, 162, 124, 251, 131, 156, 23, 103,
+			152, 168, 115, 111, 237, 236, 119, 175, 77, 86, 4, 231, 181, 211,
+			171, 231, 79, 181, 158, 156, 36, 98, 140, 215, 207, 156, 90, 59,
+			245, 72, 235, 212, 227, 147, 84, 236, 225, 252, 226, 90, 235, 210,
+			67, 107, 151, 90, 103, 207, 76, 178, 211, 75, 111, 190, 245, 166,
+			142, 247, 215, 255, 192, 19, 124, 68, 84, 189, 202, 87, 232, 203,
+			198, 47, 94, 243, 237, 16, 191, 216, 227, 226, 23, 15, 20, 241,
+			139, 7, 202, 241, 11, 248, 147, 8, 214, 168, 28, 229, 146, 211,
+			106, 69, 120, 123, 42, 211, 196, 159, 145, 167, 202, 134, 50, 88,
+			7, 77, 201, 57, 103, 85, 240, 50, 247, 84, 39, 120, 131, 123,
+			85, 140, 37, 76, 208, 6, 24, 146, 0, 64, 152, 129, 214, 44,
+			68, 5, 155, 24, 229, 166, 35, 17, 108, 146, 142, 155, 142, 4,
+			161, 186, 133, 168, 96, 147, 141, 49, 211, 145, 10, 54, 69, 39,
+			204, 79, 224, 70, 77, 81, 110, 33, 248, 109, 124, 143, 233, 200,
+			4, 19, 116, 202, 252, 4, 198, 183, 160, 99, 22, 162, 130, 137,
+			137, 73, 222, 215, 177, 153, 125, 149, 55, 18, 191, 131, 241, 20,
+			187, 160, 142, 219, 209, 232, 213, 53, 229, 26, 88, 254, 38, 6,
+			178, 49, 0, 159, 94, 229, 192, 181, 48, 222, 72, 210, 30, 26,
+			107, 104, 145, 115, 243, 233, 186, 130, 200, 78, 148, 116, 187, 97,
+			108, 201, 84, 138, 182, 236, 171, 239, 7, 95, 207, 132, 91, 22,
+			233, 140, 255, 85, 194, 75, 65, 136, 165, 76, 234, 29, 37, 143,
+			66, 236, 6, 188, 167, 99, 38, 228, 147, 201, 36, 13, 187, 16,
+			241, 128, 145, 55, 210, 164, 135, 72, 101, 65, 79, 201, 211, 131,
+			60, 82, 169, 12, 227, 44, 15, 226, 182, 146, 87, 49, 250, 176,
+			25, 128, 47, 40, 181, 14, 129, 81, 78, 65, 120, 41, 236, 216,
+			41, 92, 244, 34, 144, 122, 19, 157, 135, 177, 236, 58, 64, 134,
+			78, 114, 185, 153, 231, 253, 236, 228, 202, 238, 54, 112, 59, 233,
+			245, 146, 216, 238, 21, 144, 134, 204, 122, 22, 149, 170, 96, 139,
+			180, 110, 60, 11, 144, 142, 197, 209, 9, 11, 49, 193, 22, 197,
+			52, 255, 27, 98, 3, 65, 203, 84, 248, 127, 102, 40, 81, 200,
+			215, 82, 38, 193, 134, 221, 70, 11, 203, 18, 12, 146, 229, 137,
+			28, 196, 225, 211, 3, 21, 109, 201, 176, 163, 226, 60, 220, 216,
+			146, 65, 105, 12, 140, 24, 153, 221, 144, 181, 147, 62, 198, 12,
+			195, 60, 227, 178, 191, 131, 46, 56, 217, 63, 43, 85, 72, 85,
+			176, 101, 71, 21, 144, 247, 229, 81, 235, 40, 18, 38, 216, 242,
+			228, 20, 191, 207, 70, 168, 86, 232, 130, 127, 199, 78, 146, 152,
+			147, 74, 194, 192, 101, 210, 72, 51, 14, 173, 193, 167, 214, 167,
+			131, 173, 178, 50, 62, 111, 33, 38, 216, 202, 254, 3, 252, 207,
+			137, 117, 134, 239, 163, 190, 255, 31, 183, 203, 224, 245, 166, 176,
+			212, 239, 13, 178, 28, 116, 77, 16, 203, 115, 107, 107, 23, 228,
+			67, 186, 255, 241, 53, 64, 9, 9, 216, 148, 171, 57, 48, 169,
+			23, 116, 148, 12, 174, 4, 97, 132, 209, 201, 60, 129, 221, 118,
+			38, 233, 114, 235, 138, 66, 184, 41, 150, 79, 15, 84, 186, 85,
+			236, 24, 217, 83, 121, 160, 55, 224, 106, 174, 165, 57, 136, 178,
+			4, 167, 236, 247, 163, 208, 248, 182, 198, 71, 231, 82, 155, 10,
+			72, 38, 252, 202, 146, 155, 85, 5, 187, 207, 145, 27, 148, 193,
+			125, 163, 101, 79, 252, 190, 249, 125, 252, 5, 98, 93, 241, 7,
+			233, 237, 254, 39, 119, 19, 194, 245, 32, 83, 210, 153, 101, 187,
+			17, 36, 78, 172, 239, 158, 229, 65, 154, 99, 231, 157, 161, 68,
+			29, 201, 54, 54, 92, 168, 50, 8, 144, 164, 42, 195, 15, 195,
+			148, 151, 166, 8, 50, 217, 11, 219, 105, 162, 29, 102, 169, 143,
+			198, 204, 238, 122, 27, 140, 40, 220, 248, 154, 96, 15, 210, 253,
+			22, 34, 130, 61, 120, 224, 86, 11, 49, 193, 30, 60, 122, 140,
+			255, 152, 94, 103, 85, 176, 71, 232, 33, 255, 61, 176, 206, 0,
+			99, 149, 65, 44, 131, 116, 61, 204, 211, 32, 221, 146, 79, 169,
+			173, 21, 100, 160, 204, 131, 174, 12, 178, 44, 105, 67, 180, 199,
+			5, 94, 195, 172, 188, 30, 173, 153, 206, 36, 93, 199, 77, 8,
+			162, 35, 51, 49, 34, 89, 116, 213, 68, 236, 200, 36, 198, 129,
+			113, 138, 34, 12, 81, 173, 1, 86, 150, 51, 85, 34, 216, 35,
+			115, 190, 133, 152, 96, 143, 44, 28, 228, 31, 212, 248, 215, 4,
+			123, 156, 46, 248, 63, 76, 184, 92, 221, 0, 109, 188, 108, 200,
+			110, 54, 123, 20, 129, 148, 188, 53, 9, 33, 252, 159, 39, 93,
+			149, 111, 170, 84, 118, 6, 224, 53, 184, 152, 13, 72, 79, 170,
+			244, 69, 16, 124, 206, 173, 110, 181, 49, 91, 140, 250, 108, 147,
+			221, 32, 151, 15, 104, 157, 241, 157, 43, 119, 172, 60, 0, 202,
+			226, 59, 155, 224, 250, 217, 85, 212, 170, 128, 155, 149, 182, 26,
+			17, 236, 241, 81, 187, 241, 106, 76, 176, 199, 247, 31, 224, 47,
+			233, 85, 140, 8, 118, 137, 46, 248, 127, 64, 184, 124, 56, 73,
+			101, 97, 145, 152, 249, 51, 189, 170, 167, 212, 86, 102, 197, 72,
+			187, 71, 69, 220, 206, 73, 103, 170, 218, 73, 218, 201, 184, 236,
+			5, 91, 178, 29, 164, 233, 86, 83, 182, 116, 155, 230, 154, 249,
+			18, 34, 123, 17, 196, 209, 59, 18, 35, 188, 112, 58, 164, 234,
+			173, 170, 157, 171, 206, 13, 57, 89, 24, 203, 215, 97, 234, 166,
+			202, 52, 190, 142, 28, 35, 30, 44, 210, 65, 85, 193, 46, 53,
+			166, 44, 68, 4, 187, 36, 44, 113, 70, 152, 96, 151, 246, 31,
+			224, 139, 28, 100, 215, 123, 178, 210, 39, 254, 156, 92, 83, 215,
+			114, 51, 145, 85, 72, 218, 214, 240, 64, 111, 62, 89, 31, 227,
+			255, 137, 114, 207, 35, 16, 42, 111, 211, 152, 249, 191, 65, 57,
+			106, 162, 176, 59, 72, 6, 16, 181, 191, 6, 11, 142, 225, 68,
+			198, 24, 158, 10, 83, 233, 28, 237, 12, 150, 12, 186, 53, 72,
+			211, 96, 11, 136, 172, 187, 110, 36, 81, 148, 92, 53, 7, 62,
+			254, 13, 130, 211, 15, 242, 92, 165, 241, 73, 46, 165, 60, 46,
+			239, 148, 73, 42, 239, 178, 97, 82, 48, 1, 244, 183, 182, 33,
+			238, 226, 231, 81, 144, 229, 118, 183, 111, 45, 101, 122, 65, 71,
+			195, 166, 106, 34, 95, 96, 44, 41, 131, 2, 37, 185, 62, 200,
+			145, 75, 97, 158, 169, 200, 112, 29, 108, 14, 24, 253, 24, 116,
+			63, 46, 131, 120, 171, 20, 7, 53, 19, 42, 51, 127, 49, 118,
+			49, 232, 178, 84, 65, 123, 83, 134, 121, 38, 147, 171, 113, 121,
+			40, 179, 10, 19, 0, 192, 95, 204, 57, 69, 240, 206, 160, 205,
+			247, 240, 135, 121, 13, 8, 12, 246, 156, 242, 102, 252, 215, 106,
+			221, 24, 198, 106, 201, 208, 215, 48, 102, 89, 227, 29, 183, 163,
+			65, 7, 232, 5, 211, 57, 20, 154, 18, 99, 177, 56, 78, 21,
+			6, 26, 45, 96, 34, 152, 226, 19, 5, 204, 4, 83, 98, 154,
+			255, 107, 98, 38, 38, 130, 245, 188, 125, 254, 207, 89, 181, 172,
+			167, 118, 67, 131, 124, 232, 251, 21, 208, 6, 185, 177, 12, 130,
+			88, 170, 94, 63, 223, 50, 191, 154, 216, 55, 44, 16, 126, 5,
+			148, 195, 120, 160, 156, 145, 28, 195, 66, 180, 147, 3, 161, 16,
+			142, 179, 216, 192, 175, 155, 211, 122, 61, 150, 252, 157, 68, 161,
+			222, 151, 65, 231, 10, 24, 93, 38, 202, 77, 204, 13, 68, 207,
+			68, 253, 137, 185, 129, 232, 153, 235, 16, 98, 110, 32, 122, 123,
+			231, 193, 96, 245, 8, 208, 54, 161, 90, 219, 17, 90, 241, 0,
+			50, 108, 160, 149, 154, 96, 73, 99, 194, 66, 68, 176, 100, 114,
+			214, 66, 76, 176, 100, 126, 31, 63, 194, 169, 71, 133, 151, 85,
+			182, 136, 63, 47, 181, 251, 188, 251, 182, 1, 83, 32, 171, 239,
+			225, 143, 112, 230, 209, 81, 193, 6, 116, 220, 191, 31, 244, 79,
+			79, 165, 209, 22, 138, 155, 149, 214, 230, 69, 187, 90, 212, 212,
+			120, 51, 208, 25, 244, 35, 12, 82, 107, 253, 209, 68, 19, 222,
+			163, 163, 21, 193, 6, 13, 109, 110, 208, 81, 16, 28, 128, 142,
+			114, 207, 163, 176, 180, 107, 116, 202, 223, 143, 188, 51, 135, 180,
+			211, 91, 120, 82, 107, 163, 133, 226, 13, 210, 53, 58, 98, 33,
+			34, 216, 53, 19, 150, 166, 40, 20, 215, 38, 38, 249, 50, 135,
+			195, 172, 250, 246, 202, 7, 8, 241, 15, 73, 235, 254, 111, 91,
+			108, 201, 41, 241, 224, 196, 127, 123, 125, 146, 47, 114, 207, 99,
+			128, 205, 59, 232, 148, 63, 171, 143, 108, 27, 49, 48, 134, 62,
+			206, 197, 16, 143, 119, 24, 60, 24, 226, 241, 14, 131, 7, 67,
+			60, 222, 49, 49, 201, 191, 31, 116, 56, 99, 21, 81, 123, 55,
+			161, 63, 74, 152, 159, 14, 73, 31, 202, 132, 219, 86, 118, 26,
+			35, 132, 168, 139, 245, 49, 165, 119, 11, 232, 96, 115, 149, 180,
+			5, 34, 199, 77, 216, 127, 251, 197, 30, 58, 31, 118, 176, 38,
+			231, 227, 128, 18, 236, 83, 239, 221, 132, 79, 241, 117, 94, 3,
+			144, 86, 132, 247, 131, 196, 155, 245, 91, 122, 191, 160, 95, 190,
+			12, 67, 166, 168, 150, 80, 55, 188, 77, 165, 201, 178, 115, 61,
+			237, 152, 114, 35, 13, 186, 61, 32, 160, 217, 22, 48, 35, 119,
+			248, 55, 57, 159, 224, 35, 122, 142, 42, 78, 82, 106, 32, 208,
+			208, 152, 44, 26, 24, 52, 76, 207, 240, 21, 131, 22, 17, 222,
+			191, 32, 222, 140, 127, 8, 177, 130, 216, 161, 59, 214, 202, 235,
+			146, 110, 4, 82, 197, 47, 138, 57, 8, 14, 209, 152, 40, 26,
+			24, 52, 136, 105, 126, 201, 204, 65, 133, 247, 35, 196, 19, 254,
+			217, 226, 234, 204, 178, 196, 105, 227, 237, 92, 177, 107, 133, 124,
+			129, 160, 76, 224, 2, 19, 90, 197, 113, 235, 69, 3, 129, 134,
+			209, 241, 162, 129, 65, 195, 36, 156, 109, 32, 102, 68, 120, 239,
+			39, 116, 78, 115, 136, 146, 26, 130, 163, 22, 196, 95, 249, 148,
+			5, 25, 128, 51, 179, 252, 81, 78, 61, 79, 212, 126, 156, 84,
+			126, 129, 16, 255, 59, 100, 17, 170, 218, 38, 222, 39, 101, 32,
+			179, 48, 238, 70, 32, 56, 112, 200, 35, 33, 183, 250, 170, 99,
+			206, 249, 166, 228, 13, 206, 60, 56, 72, 127, 156, 212, 5, 191,
+			139, 123, 158, 7, 194, 250, 28, 161, 63, 79, 152, 127, 88, 158,
+			178, 3, 192, 78, 233, 148, 76, 62, 28, 160, 41, 17, 55, 15,
+			101, 235, 57, 194, 39, 248, 113, 94, 131, 17, 64, 182, 62, 72,
+			188, 105, 127, 1, 153, 136, 157, 151, 50, 248, 188, 108, 17, 107,
+			194, 121, 70, 76, 62, 104, 89, 232, 25, 49, 249, 32, 105, 236,
+			41, 26, 24, 52, 76, 9, 126, 155, 158, 161, 94, 17, 181, 159,
+			36, 222, 207, 145, 170, 63, 51, 52, 135, 113, 41, 236, 135, 117,
+			24, 233, 39, 1, 181, 61, 6, 53, 34, 188, 159, 34, 181, 131,
+			110, 100, 16, 159, 159, 34, 181, 177, 162, 1, 123, 140, 239, 43,
+			26, 24, 52, 28, 88, 112, 67, 80, 225, 253, 52, 169, 249, 174,
+			3, 240, 253, 167, 73, 173, 81, 52, 16, 104, 24, 155, 45, 26,
+			24, 52, 204, 239, 115, 67, 48, 225, 125, 164, 140, 5, 171, 98,
+			67, 129, 5, 35, 208, 80, 194, 130, 225, 39, 37, 44, 60, 225,
+			253, 76, 25, 11, 175, 138, 13, 5, 17, 129, 175, 63, 67, 26,
+			5, 22, 30, 131, 134, 18, 22, 85, 225, 125, 148, 212, 14, 184,
+			14, 85, 221, 80, 44, 164, 74, 160, 97, 108, 111, 209, 192, 160,
+			193, 223, 239, 134, 168, 9, 239, 103, 73, 237, 30, 215, 161, 166,
+			27, 14, 21, 13, 4, 26, 228, 74, 209, 192, 160, 225, 196, 221,
+			184, 7, 60, 16, 149, 231, 9, 61, 160, 37, 9, 14, 53, 239,
+			121, 66, 185, 5, 107, 240, 107, 99, 210, 130, 4, 192, 169, 189,
+			22, 100, 0, 250, 251, 249, 231, 33, 133, 166, 42, 106, 191, 66,
+			42, 127, 77, 136, 255, 43, 132, 203, 83, 49, 164, 33, 132, 87,
+			194, 206, 32, 40, 46, 197, 183, 156, 27, 230, 46, 103, 97, 79,
+			103, 131, 190, 74, 77, 184, 38, 79, 131, 56, 235, 133, 89, 22,
+			130, 23, 234, 252, 68, 185, 154, 23, 206, 46, 10, 116, 198, 101,
+			182, 153, 12, 162, 14, 120, 17, 120, 145, 221, 79, 85, 94, 216,
+			10, 48, 3, 152, 11, 70, 123, 93, 215, 49, 199, 211, 145, 121,
+			64, 234, 95, 33, 245, 73, 254, 203, 112, 102, 84, 129, 46, 159,
+			37, 244, 14, 255, 39, 141, 61, 99, 246, 182, 113, 23, 193, 201,
+			51, 10, 223, 44, 6, 14, 111, 187, 56, 243, 59, 120, 108, 157,
+			14, 58, 55, 59, 81, 0, 39, 75, 46, 58, 63, 114, 17, 58,
+			165, 42, 75, 162, 43, 198, 207, 113, 63, 21, 243, 100, 125, 213,
+			14, 55, 194, 182, 245, 226, 205, 225, 82, 5, 235, 195, 251, 44,
+			161, 190, 5, 9, 32, 191, 255, 54, 11, 50, 0, 143, 221, 174,
+			99, 9, 85, 74, 132, 247, 5, 66, 125, 23, 208, 50, 185, 50,
+			38, 111, 164, 20, 133, 185, 176, 91, 128, 203, 6, 117, 92, 244,
+			69, 71, 117, 0, 127, 84, 226, 214, 48, 145, 1, 120, 197, 90,
+			163, 131, 185, 150, 42, 27, 145, 51, 33, 117, 224, 95, 144, 90,
+			19, 220, 17, 198, 4, 193, 76, 84, 194, 134, 142, 58, 42, 11,
+			187, 49, 100, 51, 12, 226, 160, 183, 110, 28, 135, 8, 194, 19,
+			73, 218, 81, 198, 180, 212, 235, 5, 149, 242, 5, 66, 235, 102,
+			249, 112, 30, 125, 129, 140, 206, 90, 144, 1, 56, 191, 143, 255,
+			169, 166, 6, 21, 222, 111, 1, 53, 126, 247, 229, 168, 1, 86,
+			178, 73, 238, 218, 133, 26, 219, 73, 97, 86, 14, 199, 148, 89,
+			235, 240, 82, 131, 158, 163, 45, 152, 174, 122, 96, 46, 33, 168,
+			119, 211, 235, 118, 203, 30, 10, 155, 89, 103, 95, 47, 21, 20,
+			227, 111, 21, 132, 0, 198, 255, 86, 65, 8, 202, 0, 156, 223,
+			199, 63, 202, 144, 16, 76, 120, 47, 18, 58, 231, 127, 128, 25,
+			137, 223, 102, 70, 91, 51, 32, 76, 51, 231, 77, 224, 250, 182,
+			244, 217, 92, 226, 61, 82, 70, 93, 203, 79, 14, 69, 101, 193,
+			60, 55, 100, 29, 26, 203, 152, 87, 29, 180, 223, 155, 242, 49,
+			211, 45, 108, 99, 10, 77, 55, 140, 141, 255, 149, 163, 61, 212,
+			228, 198, 116, 30, 30, 28, 174, 243, 236, 198, 27, 26, 29, 127,
+			48, 244, 113, 51, 161, 78, 225, 206, 48, 29, 30, 106, 8, 197,
+			194, 206, 88, 115, 67, 218, 54, 76, 238, 192, 222, 26, 67, 131,
+			94, 97, 17, 188, 204, 184, 218, 36, 40, 143, 106, 140, 132, 93,
+			199, 52, 44, 131, 99, 234, 197, 130, 161, 112, 72, 189, 72, 70,
+			167, 44, 136, 28, 156, 153, 229, 57, 240, 19, 142, 232, 63, 37,
+			244, 235, 132, 65, 228, 124, 205, 106, 196, 216, 145, 199, 8, 186,
+			69, 1, 172, 93, 12, 89, 132, 153, 236, 39, 253, 65, 228, 252,
+			7, 12, 34, 66, 208, 34, 111, 111, 90, 69, 182, 148, 201, 239,
+			53, 215, 69, 249, 86, 95, 125, 175, 69, 17, 15, 252, 63, 37,
+			245, 9, 190, 2, 72, 80, 79, 120, 127, 6, 150, 200, 97, 237,
+			83, 107, 81, 63, 137, 60, 206, 76, 126, 16, 186, 167, 198, 150,
+			169, 66, 128, 12, 190, 176, 75, 132, 67, 244, 207, 200, 232, 184,
+			5, 25, 128, 147, 130, 47, 227, 232, 85, 225, 253, 57, 241, 246,
+			250, 7, 135, 189, 169, 147, 104, 11, 203, 76, 161, 149, 236, 134,
+			174, 214, 176, 187, 221, 29, 160, 241, 255, 156, 52, 44, 245, 170,
+			12, 192, 153, 57, 126, 7, 14, 93, 19, 222, 95, 18, 111, 191,
+			191, 176, 221, 123, 57, 233, 26, 50, 55, 50, 156, 186, 127, 73,
+			188, 49, 11, 18, 0, 199, 237, 70, 171, 49, 0, 231, 125, 254,
+			90, 28, 121, 68, 120, 127, 69, 188, 67, 254, 177, 157, 166, 227,
+			73, 176, 248, 138, 70, 43, 35, 102, 156, 145, 26, 126, 185, 199,
+			130, 4, 192, 137, 125, 22, 100, 0, 30, 56, 200, 95, 162, 28,
+			168, 88, 251, 59, 2, 55, 96, 254, 139, 20, 3, 70, 171, 46,
+			41, 48, 54, 130, 24, 198, 121, 2, 80, 144, 31, 79, 85, 150,
+			155, 227, 9, 83, 197, 108, 196, 165, 56, 177, 192, 225, 193, 30,
+			250, 219, 32, 85, 178, 171, 98, 149, 162, 144, 172, 107, 151, 84,
+			167, 63, 134, 89, 190, 61, 136, 7, 195, 157, 138, 13, 56, 20,
+			151, 130, 173, 17, 200, 76, 193, 21, 43, 136, 67, 187, 8, 10,
+			185, 115, 100, 35, 13, 122, 42, 107, 22, 94, 18, 136, 98, 223,
+			92, 222, 44, 161, 50, 12, 219, 218, 200, 208, 183, 60, 70, 95,
+			107, 196, 151, 205, 237, 129, 137, 18, 132, 61, 5, 90, 6, 84,
+			43, 94, 45, 224, 224, 75, 153, 141, 75, 219, 147, 187, 156, 39,
+			55, 140, 240, 122, 148, 172, 27, 147, 161, 70, 132, 247, 119, 96,
+			50, 124, 21, 78, 146, 26, 152, 12, 255, 64, 232, 33, 255, 215,
+			204, 73, 178, 203, 237, 115, 113, 150, 151, 134, 220, 126, 162, 88,
+			77, 1, 121, 123, 42, 27, 62, 29, 119, 27, 51, 131, 147, 55,
+			0, 87, 86, 199, 118, 33, 32, 200, 37, 100, 151, 21, 126, 155,
+			81, 139, 48, 171, 141, 216, 203, 245, 45, 217, 73, 174, 198, 144,
+			56, 104, 35, 65, 56, 177, 217, 203, 53, 52, 43, 254, 129, 208,
+			89, 11, 18, 88, 224, 156, 111, 65, 6, 224, 194, 65, 254, 60,
+			46, 31, 28, 151, 119, 81, 250, 75, 148, 249, 207, 18, 46, 241,
+			28, 48, 236, 213, 62, 12, 142, 93, 54, 3, 109, 19, 90, 80,
+			189, 126, 2, 71, 125, 178, 49, 36, 15, 230, 248, 52, 161, 177,
+			118, 146, 234, 252, 104, 140, 92, 129, 244, 242, 82, 52, 72, 102,
+			113, 208, 207, 54, 19, 92, 168, 209, 113, 5, 149, 237, 162, 208,
+			89, 122, 23, 229, 19, 252, 251, 32, 114, 85, 3, 231, 70, 120,
+			239, 161, 222, 156, 255, 180, 70, 170, 124, 146, 24, 65, 80, 189,
+			48, 207, 135, 229, 192, 76, 160, 195, 183, 171, 79, 152, 131, 208,
+			196, 0, 184, 59, 9, 119, 226, 140, 7, 165, 61, 37, 193, 40,
+			175, 25, 15, 236, 61, 212, 120, 96, 53, 227, 129, 189, 135, 54,
+			166, 138, 6, 6, 13, 51, 179, 252, 11, 212, 160, 13, 206, 45,
+			245, 230, 253, 79, 210, 87, 122, 94, 191, 106, 199, 179, 62, 246,
+			214, 85, 55, 140, 191, 109, 142, 103, 71, 81, 48, 19, 127, 164,
+			76, 115, 48, 20, 127, 132, 54, 166, 139, 6, 8, 23, 208, 185,
+			189, 252, 19, 86, 84, 168, 240, 158, 163, 222, 1, 255, 195, 102,
+			139, 23, 26, 209, 68, 251, 33, 197, 31, 72, 236, 174, 33, 179,
+			235, 152, 207, 104, 224, 173, 111, 185, 32, 62, 40, 164, 226, 86,
+			212, 89, 250, 78, 142, 140, 149, 23, 152, 157, 204, 141, 28, 22,
+			150, 101, 233, 250, 216, 226, 15, 246, 223, 115, 229, 21, 130, 5,
+			248, 28, 109, 236, 45, 26, 24, 52, 248, 251, 249, 251, 237, 10,
+			193, 205, 133, 21, 190, 203, 172, 176, 236, 240, 216, 64, 148, 115,
+			231, 94, 237, 181, 161, 61, 239, 246, 171, 69, 18, 172, 158, 143,
+			148, 151, 1, 118, 207, 71, 202, 203, 64, 231, 156, 250, 251, 249,
+			127, 179, 203, 240, 132, 247, 139, 212, 59, 238, 127, 237, 102, 150,
+			177, 12, 10, 191, 116, 139, 103, 110, 28, 194, 108, 135, 11, 87,
+			100, 29, 44, 101, 67, 222, 155, 177, 159, 74, 11, 69, 53, 224,
+			214, 234, 186, 150, 103, 31, 50, 246, 175, 71, 47, 190, 11, 193,
+			224, 192, 13, 123, 170, 68, 35, 48, 155, 126, 145, 122, 7, 138,
+			6, 2, 13, 11, 71, 139, 6, 6, 13, 119, 44, 243, 175, 193,
+			93, 76, 13, 68, 225, 179, 148, 46, 248, 191, 71, 33, 221, 160,
+			80, 185, 65, 214, 86, 168, 172, 142, 163, 135, 161, 58, 70, 149,
+			27, 115, 17, 18, 99, 250, 144, 27, 3, 161, 249, 174, 211, 185,
+			168, 173, 225, 216, 217, 229, 204, 4, 106, 190, 201, 58, 41, 97,
+			102, 207, 162, 225, 97, 33, 4, 168, 228, 162, 102, 209, 226, 178,
+			92, 44, 231, 43, 45, 46, 115, 185, 88, 206, 78, 90, 212, 199,
+			249, 98, 41, 29, 201, 240, 32, 115, 55, 139, 110, 33, 246, 180,
+			217, 0, 97, 85, 113, 123, 107, 231, 236, 54, 26, 220, 81, 27,
+			112, 29, 121, 191, 12, 181, 247, 217, 183, 140, 119, 182, 13, 100,
+			35, 36, 109, 188, 10, 78, 100, 123, 51, 73, 50, 200, 220, 112,
+			67, 187, 179, 147, 120, 72, 95, 7, 130, 135, 78, 77, 36, 165,
+			134, 65, 174, 207, 210, 169, 121, 11, 130, 135, 78, 247, 31, 128,
+			72, 10, 240, 134, 10, 239, 243, 148, 30, 194, 72, 202, 154, 139,
+			136, 34, 65, 140, 186, 49, 26, 115, 152, 200, 86, 100, 147, 62,
+			216, 65, 65, 132, 213, 47, 160, 245, 144, 184, 41, 186, 168, 42,
+			132, 63, 101, 156, 12, 37, 198, 4, 235, 201, 192, 20, 25, 4,
+			96, 235, 151, 231, 90, 134, 219, 167, 220, 93, 68, 194, 120, 206,
+			173, 53, 104, 184, 4, 13, 189, 28, 208, 59, 159, 167, 198, 77,
+			169, 97, 56, 238, 243, 116, 212, 218, 13, 224, 119, 126, 158, 46,
+			28, 180, 139, 101, 194, 251, 210, 142, 197, 154, 83, 246, 255, 200,
+			98, 203, 115, 221, 196, 98, 29, 10, 122, 57, 160, 157, 190, 84,
+			44, 22, 116, 211, 151, 138, 197, 130, 102, 250, 18, 44, 246, 215,
+			245, 98, 61, 225, 125, 25, 118, 221, 103, 204, 98, 139, 179, 218,
+			106, 163, 221, 102, 122, 85, 22, 171, 167, 226, 219, 230, 122, 229,
+			11, 134, 64, 231, 151, 139, 5, 131, 135, 246, 101, 58, 106, 69,
+			25, 130, 156, 95, 166, 251, 15, 184, 180, 252, 127, 247, 78, 126,
+			110, 215, 228, 155, 155, 173, 74, 196, 255, 111, 43, 80, 125, 245,
+			106, 94, 253, 149, 27, 13, 181, 173, 102, 224, 159, 94, 44, 240,
+			195, 140, 243, 71, 84, 222, 2, 149, 145, 229, 80, 109, 209, 79,
+			19, 184, 221, 55, 9, 212, 22, 132, 164, 237, 126, 144, 111, 154,
+			212, 115, 252, 27, 82, 190, 241, 218, 201, 100, 114, 107, 160, 72,
+			4, 135, 172, 77, 102, 19, 193, 23, 56, 7, 187, 171, 148, 86,
+			90, 109, 141, 66, 11, 166, 148, 66, 37, 43, 148, 149, 234, 95,
+			107, 248, 107, 61, 74, 186, 250, 199, 91, 249, 158, 56, 137, 47,
+			23, 14, 25, 102, 240, 215, 91, 227, 113, 18, 23, 87, 247, 98,
+			149, 79, 116, 85, 126, 25, 130, 84, 170, 115, 121, 144, 70, 144,
+			202, 15, 105, 161, 135, 109, 237, 109, 177, 210, 230, 197, 176, 27,
+			95, 106, 61, 102, 192, 214, 120, 87, 229, 208, 164, 58, 151, 210,
+			40, 243, 7, 124, 207, 112, 7, 241, 26, 94, 143, 194, 13, 5,
+			244, 189, 113, 222, 180, 235, 10, 9, 178, 122, 143, 34, 225, 234,
+			45, 3, 21, 68, 50, 164, 67, 96, 241, 13, 188, 177, 22, 132,
+			209, 171, 200, 13, 200, 255, 110, 224, 178, 193, 87, 201, 212, 203,
+			140, 185, 108, 191, 135, 65, 27, 39, 230, 118, 47, 88, 54, 227,
+			186, 36, 103, 118, 147, 73, 206, 183, 112, 15, 246, 206, 188, 39,
+			89, 41, 25, 219, 90, 17, 45, 252, 81, 124, 23, 111, 148, 185,
+			167, 147, 122, 15, 14, 113, 79, 47, 163, 89, 240, 170, 197, 179,
+			130, 111, 87, 56, 47, 126, 17, 39, 57, 199, 98, 50, 100, 138,
+			75, 199, 190, 110, 26, 125, 171, 212, 123, 27, 227, 70, 119, 103,
+			220, 168, 101, 220, 71, 170, 124, 236, 13, 3, 149, 110, 189, 138,
+			172, 131, 169, 80, 180, 76, 13, 182, 6, 96, 35, 66, 234, 1,
+			110, 33, 40, 215, 129, 180, 248, 67, 188, 209, 11, 174, 93, 78,
+			85, 54, 136, 242, 204, 236, 31, 222, 11, 174, 181, 116, 203, 142,
+			218, 28, 190, 179, 54, 231, 225, 225, 146, 31, 157, 95, 127, 171,
+			165, 125, 121, 113, 165, 2, 160, 135, 195, 40, 87, 233, 80, 25,
+			208, 157, 188, 26, 171, 171, 42, 157, 31, 187, 33, 189, 117, 71,
+			113, 39, 175, 38, 81, 71, 165, 243, 227, 55, 254, 2, 59, 138,
+			19, 166, 26, 104, 66, 178, 178, 128, 12, 33, 185, 189, 14, 232,
+			30, 87, 83, 63, 137, 213, 76, 7, 118, 255, 42, 197, 224, 139,
+			173, 184, 47, 85, 5, 77, 13, 85, 5, 221, 207, 39, 183, 83,
+			65, 44, 149, 11, 119, 118, 45, 151, 210, 191, 127, 235, 37, 69,
+			71, 248, 136, 65, 16, 178, 214, 79, 63, 177, 118, 110, 178, 34,
+			70, 56, 123, 242, 236, 197, 73, 34, 106, 156, 158, 127, 98, 146,
+			190, 222, 171, 239, 153, 156, 104, 13, 191, 128, 176, 248, 126, 202,
+			199, 205, 74, 111, 168, 9, 238, 229, 35, 198, 163, 51, 5, 9,
+			219, 105, 101, 55, 33, 118, 106, 217, 206, 78, 52, 89, 33, 154,
+			254, 7, 9, 175, 105, 10, 56, 201, 39, 37, 201, 255, 231, 85,
+			58, 11, 156, 131, 146, 186, 92, 108, 163, 177, 214, 40, 180, 96,
+			21, 224, 226, 207, 19, 62, 126, 81, 129, 151, 240, 173, 109, 91,
+			232, 173, 83, 188, 140, 54, 176, 32, 136, 77, 170, 186, 234, 90,
+			223, 236, 93, 3, 125, 75, 155, 119, 241, 143, 9, 223, 99, 209,
+			188, 25, 222, 97, 112, 92, 237, 224, 221, 240, 16, 205, 199, 161,
+			87, 203, 118, 222, 149, 119, 151, 121, 21, 123, 237, 202, 57, 167,
+			8, 129, 34, 158, 81, 132, 208, 19, 98, 55, 56, 76, 181, 133,
+			127, 3, 178, 89, 28, 246, 251, 42, 71, 106, 140, 182, 44, 120,
+			226, 143, 9, 247, 32, 73, 93, 52, 57, 123, 68, 229, 66, 236,
+			60, 168, 253, 233, 161, 54, 179, 254, 59, 185, 7, 7, 165, 112,
+			63, 150, 142, 205, 221, 191, 184, 135, 87, 81, 252, 197, 204, 54,
+			89, 214, 223, 204, 110, 107, 53, 95, 189, 150, 215, 52, 217, 196,
+			236, 118, 50, 234, 239, 230, 182, 55, 235, 15, 95, 181, 231, 47,
+			222, 245, 86, 93, 63, 242, 7, 244, 255, 254, 247, 47, 46, 22,
+			245, 35, 175, 195, 63, 169, 96, 99, 166, 170, 132, 9, 54, 94,
+			57, 202, 127, 147, 234, 34, 140, 185, 202, 69, 226, 255, 42, 149,
+			133, 156, 216, 136, 156, 121, 188, 162, 184, 186, 48, 97, 110, 5,
+			145, 160, 20, 62, 144, 150, 240, 46, 255, 207, 125, 53, 28, 86,
+			85, 215, 194, 44, 207, 150, 101, 96, 18, 253, 75, 147, 161, 95,
+			159, 13, 218, 109, 165, 58, 28, 94, 154, 8, 210, 78, 4, 142,
+			120, 178, 33, 175, 110, 234, 236, 225, 157, 227, 166, 65, 12, 165,
+			239, 65, 86, 228, 7, 3, 14, 231, 147, 92, 13, 197, 236, 52,
+			122, 152, 153, 155, 170, 124, 144, 198, 114, 3, 14, 74, 192, 13,
+			22, 25, 196, 165, 113, 59, 250, 186, 91, 59, 92, 220, 14, 28,
+			70, 97, 190, 5, 222, 20, 38, 35, 196, 65, 4, 65, 87, 168,
+			231, 14, 227, 161, 119, 60, 230, 234, 130, 55, 237, 59, 30, 243,
+			116, 214, 63, 44, 215, 74, 68, 52, 74, 5, 38, 48, 77, 46,
+			79, 23, 82, 222, 230, 93, 218, 50, 164, 188, 205, 143, 78, 90,
+			8, 222, 164, 152, 158, 225, 255, 6, 162, 59, 88, 169, 113, 152,
+			10, 255, 23, 40, 142, 13, 202, 195, 70, 76, 75, 196, 206, 19,
+			217, 85, 69, 218, 2, 136, 149, 113, 34, 33, 124, 98, 211, 140,
+			77, 103, 61, 134, 38, 241, 197, 115, 167, 78, 188, 230, 94, 136,
+			175, 226, 176, 182, 171, 115, 165, 161, 47, 12, 123, 49, 233, 41,
+			57, 200, 129, 50, 161, 130, 18, 131, 45, 185, 17, 198, 29, 217,
+			15, 50, 72, 108, 146, 65, 138, 34, 28, 232, 59, 10, 51, 31,
+			124, 12, 171, 95, 87, 178, 141, 46, 107, 150, 244, 20, 183, 68,
+			135, 91, 138, 72, 197, 221, 124, 19, 67, 190, 144, 71, 29, 131,
+			91, 12, 95, 192, 176, 118, 76, 64, 19, 241, 131, 82, 27, 21,
+			116, 32, 94, 4, 82, 3, 158, 237, 21, 164, 2, 196, 221, 0,
+			137, 176, 200, 11, 135, 116, 208, 195, 142, 192, 144, 12, 122, 120,
+			168, 232, 227, 240, 228, 20, 95, 181, 69, 31, 71, 232, 148, 255,
+			64, 145, 193, 102, 152, 85, 202, 251, 46, 40, 13, 73, 169, 246,
+			5, 24, 45, 93, 170, 40, 9, 128, 151, 39, 142, 208, 90, 169,
+			10, 228, 200, 136, 171, 9, 97, 130, 29, 153, 152, 52, 149, 38,
+			76, 176, 37, 42, 76, 165, 73, 24, 135, 144, 3, 91, 230, 167,
+			137, 68, 39, 110, 153, 110, 14, 40, 175, 88, 50, 201, 146, 24,
+			63, 101, 75, 117, 187, 48, 40, 175, 88, 154, 156, 226, 127, 77,
+			109, 121, 197, 10, 221, 235, 255, 137, 150, 156, 94, 112, 45, 236,
+			13, 122, 165, 59, 5, 240, 31, 51, 51, 201, 32, 141, 155, 246,
+			121, 6, 29, 141, 208, 113, 51, 91, 250, 1, 187, 142, 151, 182,
+			1, 124, 134, 249, 192, 50, 223, 30, 251, 48, 116, 131, 8, 83,
+			137, 66, 38, 159, 38, 142, 236, 174, 116, 169, 219, 154, 133, 77,
+			121, 42, 203, 6, 61, 96, 35, 128, 24, 194, 48, 219, 49, 82,
+			136, 13, 104, 13, 110, 62, 134, 59, 138, 72, 65, 174, 119, 18,
+			227, 247, 242, 168, 186, 162, 98, 25, 110, 64, 207, 43, 97, 18,
+			185, 135, 29, 48, 241, 177, 64, 252, 24, 136, 143, 12, 48, 117,
+			63, 222, 130, 180, 158, 208, 60, 6, 164, 167, 205, 96, 0, 144,
+			68, 8, 211, 67, 40, 77, 93, 3, 53, 5, 120, 217, 12, 33,
+			51, 146, 99, 9, 60, 232, 176, 226, 88, 226, 17, 193, 86, 234,
+			194, 66, 80, 252, 51, 59, 199, 63, 64, 109, 37, 200, 189, 116,
+			206, 127, 247, 245, 88, 2, 43, 73, 77, 29, 193, 144, 218, 112,
+			201, 174, 46, 67, 69, 115, 41, 78, 36, 122, 253, 101, 214, 184,
+			176, 169, 230, 93, 115, 248, 91, 14, 108, 69, 109, 139, 186, 208,
+			13, 83, 142, 90, 217, 17, 28, 255, 10, 181, 178, 110, 94, 74,
+			130, 183, 73, 54, 20, 228, 17, 68, 187, 149, 242, 101, 142, 126,
+			216, 9, 200, 7, 105, 244, 165, 245, 57, 242, 85, 145, 40, 150,
+			124, 80, 136, 114, 111, 221, 214, 44, 84, 153, 96, 247, 206, 204,
+			242, 119, 121, 182, 16, 229, 12, 245, 253, 255, 206, 138, 205, 26,
+			20, 165, 3, 250, 128, 48, 52, 43, 228, 26, 101, 186, 116, 29,
+			93, 204, 47, 79, 149, 175, 169, 237, 135, 71, 59, 106, 35, 24,
+			68, 249, 49, 147, 50, 156, 227, 221, 56, 28, 132, 87, 131, 180,
+			227, 10, 130, 48, 217, 13, 9, 204, 225, 41, 17, 117, 13, 5,
+			43, 203, 147, 62, 72, 161, 209, 190, 128, 150, 138, 241, 50, 212,
+			238, 108, 184, 86, 66, 150, 225, 219, 74, 46, 100, 7, 247, 171,
+			92, 98, 186, 92, 81, 146, 133, 106, 0, 30, 33, 57, 95, 14,
+			213, 56, 76, 17, 191, 84, 245, 146, 43, 230, 173, 28, 180, 159,
+			113, 155, 106, 169, 6, 193, 129, 90, 23, 117, 45, 128, 173, 182,
+			44, 179, 96, 107, 251, 209, 1, 130, 19, 102, 80, 170, 176, 113,
+			146, 203, 183, 220, 189, 44, 239, 89, 150, 247, 46, 203, 215, 126,
+			207, 245, 8, 4, 156, 53, 75, 190, 219, 226, 0, 132, 62, 169,
+			191, 254, 30, 200, 125, 78, 250, 125, 224, 249, 186, 106, 7, 131,
+			76, 113, 249, 26, 88, 184, 89, 29, 44, 104, 7, 79, 134, 86,
+			4, 163, 13, 161, 226, 132, 165, 86, 5, 17, 176, 42, 22, 234,
+			125, 206, 140, 216, 26, 38, 168, 247, 57, 51, 191, 143, 255, 9,
+			177, 175, 57, 173, 210, 55, 48, 255, 119, 240, 93, 31, 203, 172,
+			101, 99, 89, 152, 199, 185, 112, 66, 147, 194, 8, 213, 135, 46,
+			252, 97, 47, 20, 220, 235, 87, 220, 34, 9, 233, 193, 216, 13,
+			94, 110, 202, 112, 119, 149, 96, 84, 92, 112, 1, 1, 19, 38,
+			105, 41, 169, 2, 115, 28, 184, 108, 15, 210, 20, 242, 173, 236,
+			91, 62, 217, 86, 150, 171, 222, 54, 180, 138, 201, 245, 70, 196,
+			210, 10, 75, 4, 184, 248, 102, 171, 124, 158, 159, 51, 47, 246,
+			84, 4, 123, 212, 187, 221, 127, 157, 41, 215, 208, 113, 182, 226,
+			244, 42, 176, 115, 227, 173, 227, 105, 157, 39, 77, 60, 122, 139,
+			119, 125, 160, 248, 225, 81, 239, 64, 1, 19, 193, 30, 93, 184,
+			181, 128, 153, 96, 143, 30, 61, 198, 95, 111, 102, 38, 130, 157,
+			247, 102, 252, 251, 101, 203, 168, 229, 242, 100, 214, 116, 196, 133,
+			155, 242, 168, 213, 39, 138, 103, 205, 76, 217, 160, 29, 27, 142,
+			236, 243, 165, 119, 138, 224, 208, 62, 63, 58, 81, 192, 76, 176,
+			243, 98, 154, 159, 53, 115, 83, 193, 46, 120, 211, 254, 189, 55,
+			49, 183, 203, 154, 113, 65, 145, 98, 90, 56, 180, 47, 148, 166,
+			5, 131, 235, 194, 232, 158, 2, 102, 130, 93, 152, 18, 88, 120,
+			129, 69, 100, 45, 106, 203, 252, 70, 106, 130, 181, 168, 181, 219,
+			70, 136, 96, 173, 41, 91, 17, 10, 245, 85, 173, 91, 142, 240,
+			15, 65, 70, 45, 17, 222, 155, 42, 27, 196, 255, 151, 68, 150,
+			92, 173, 155, 52, 186, 225, 139, 194, 234, 134, 139, 70, 115, 128,
+			114, 119, 243, 97, 242, 121, 100, 32, 187, 33, 28, 131, 165, 237,
+			109, 100, 192, 220, 154, 150, 231, 51, 134, 44, 144, 249, 77, 245,
+			105, 52, 100, 177, 48, 230, 201, 155, 55, 100, 9, 212, 37, 176,
+			39, 141, 157, 165, 171, 101, 158, 52, 134, 172, 174, 150, 121, 210,
+			26, 178, 4, 232, 186, 254, 255, 12, 217, 87, 102, 200, 18, 220,
+			21, 235, 142, 192, 192, 172, 117, 99, 200, 18, 52, 100, 215, 141,
+			33, 75, 192, 144, 85, 175, 138, 33, 75, 112, 79, 40, 163, 101,
+			9, 26, 178, 202, 24, 178, 4, 247, 131, 154, 152, 228, 79, 96,
+			13, 84, 53, 172, 252, 48, 33, 254, 105, 89, 138, 22, 20, 114,
+			109, 224, 155, 243, 38, 109, 185, 84, 88, 135, 61, 110, 74, 153,
+			158, 162, 179, 254, 125, 240, 58, 34, 10, 160, 25, 216, 202, 99,
+			28, 148, 212, 92, 102, 40, 184, 174, 162, 4, 140, 181, 196, 172,
+			70, 215, 57, 61, 101, 72, 72, 81, 70, 159, 50, 50, 170, 235,
+			156, 158, 154, 158, 209, 103, 6, 174, 244, 105, 186, 223, 255, 93,
+			178, 61, 105, 174, 176, 108, 204, 49, 111, 76, 2, 19, 10, 112,
+			215, 123, 171, 206, 167, 55, 196, 215, 250, 63, 83, 121, 110, 147,
+			134, 205, 15, 75, 144, 135, 140, 163, 216, 132, 3, 96, 154, 125,
+			230, 144, 195, 154, 243, 196, 252, 24, 102, 174, 184, 6, 222, 201,
+			11, 114, 40, 200, 43, 226, 117, 166, 23, 42, 119, 56, 122, 214,
+			139, 4, 28, 71, 4, 82, 19, 236, 105, 163, 171, 40, 220, 90,
+			179, 167, 167, 230, 44, 196, 4, 123, 122, 159, 15, 143, 239, 2,
+			17, 168, 96, 91, 244, 86, 255, 111, 52, 17, 212, 181, 126, 16,
+			119, 84, 103, 215, 132, 53, 167, 79, 77, 254, 3, 56, 204, 216,
+			25, 72, 243, 250, 139, 79, 156, 71, 99, 36, 27, 244, 250, 214,
+			28, 49, 33, 131, 34, 26, 176, 148, 109, 95, 106, 249, 241, 60,
+			123, 96, 185, 60, 214, 251, 185, 76, 64, 31, 92, 13, 51, 67,
+			15, 72, 120, 8, 162, 240, 109, 170, 83, 28, 45, 246, 179, 171,
+			41, 164, 123, 197, 246, 238, 191, 192, 28, 169, 203, 135, 74, 186,
+			41, 5, 251, 114, 203, 148, 116, 83, 20, 134, 173, 3, 182, 88,
+			14, 196, 126, 235, 150, 35, 252, 187, 176, 200, 142, 9, 246, 118,
+			122, 139, 127, 2, 136, 82, 164, 81, 24, 135, 67, 39, 69, 216,
+			125, 221, 217, 197, 232, 165, 148, 121, 48, 130, 131, 106, 130, 189,
+			189, 177, 207, 66, 80, 65, 231, 31, 180, 16, 204, 117, 120, 145,
+			63, 10, 19, 179, 138, 168, 190, 147, 254, 255, 132, 249, 15, 200,
+			115, 73, 212, 201, 174, 119, 29, 62, 180, 207, 245, 145, 12, 198,
+			253, 22, 28, 142, 22, 9, 180, 35, 222, 201, 103, 248, 3, 188,
+			6, 16, 164, 207, 125, 31, 241, 142, 251, 203, 69, 162, 141, 121,
+			105, 48, 204, 118, 88, 17, 120, 219, 99, 19, 87, 240, 235, 26,
+			126, 190, 80, 52, 16, 104, 56, 120, 180, 104, 96, 208, 112, 199,
+			50, 63, 105, 38, 196, 74, 58, 111, 206, 191, 221, 148, 168, 33,
+			158, 165, 93, 119, 169, 245, 216, 50, 88, 211, 110, 47, 149, 166,
+			131, 164, 175, 119, 219, 82, 39, 106, 146, 190, 222, 13, 89, 192,
+			174, 129, 65, 195, 204, 44, 127, 157, 153, 142, 10, 239, 25, 40,
+			212, 59, 182, 125, 58, 180, 179, 95, 118, 54, 72, 132, 120, 166,
+			60, 27, 164, 66, 60, 99, 235, 239, 168, 73, 192, 122, 134, 76,
+			207, 240, 62, 48, 10, 210, 165, 127, 136, 208, 5, 127, 29, 10,
+			104, 236, 101, 127, 121, 78, 205, 142, 157, 86, 209, 14, 44, 228,
+			149, 48, 128, 164, 153, 176, 27, 155, 199, 133, 6, 105, 116, 217,
+			154, 121, 139, 230, 54, 159, 66, 166, 176, 247, 67, 132, 142, 89,
+			144, 0, 6, 227, 243, 22, 100, 0, 238, 63, 192, 91, 88, 209,
+			89, 123, 31, 169, 124, 141, 16, 255, 140, 44, 7, 114, 111, 210,
+			34, 193, 79, 202, 170, 27, 138, 223, 32, 93, 226, 125, 164, 62,
+			195, 95, 3, 149, 154, 94, 69, 212, 126, 148, 208, 231, 8, 243,
+			111, 149, 230, 214, 165, 188, 81, 2, 153, 155, 70, 116, 74, 205,
+			34, 240, 121, 92, 239, 71, 201, 136, 174, 130, 102, 16, 13, 19,
+			222, 7, 136, 55, 238, 223, 43, 79, 39, 249, 166, 236, 39, 89,
+			136, 175, 42, 130, 22, 142, 85, 87, 63, 177, 104, 162, 255, 78,
+			91, 148, 14, 52, 96, 15, 142, 67, 112, 32, 83, 82, 8, 13,
+			20, 26, 26, 99, 40, 29, 208, 131, 8, 239, 89, 226, 141, 249,
+			199, 228, 19, 16, 165, 112, 51, 221, 196, 224, 32, 122, 207, 18,
+			111, 164, 104, 160, 208, 192, 27, 110, 112, 42, 188, 159, 32, 94,
+			195, 14, 254, 74, 48, 7, 73, 251, 9, 226, 213, 138, 6, 28,
+			108, 148, 107, 74, 3, 133, 62, 68, 232, 172, 191, 84, 202, 225,
+			147, 107, 165, 99, 50, 79, 236, 163, 2, 105, 98, 147, 63, 176,
+			234, 214, 251, 144, 173, 64, 96, 184, 89, 63, 68, 70, 39, 45,
+			200, 96, 212, 233, 25, 254, 63, 169, 45, 176, 124, 158, 80, 225,
+			127, 157, 110, 159, 197, 108, 85, 61, 67, 63, 72, 131, 158, 130,
+			250, 115, 206, 229, 133, 32, 223, 4, 227, 199, 25, 158, 160, 169,
+			228, 34, 68, 8, 87, 192, 28, 91, 209, 201, 70, 43, 119, 172,
+			232, 49, 86, 224, 60, 95, 92, 46, 30, 127, 117, 169, 98, 250,
+			23, 217, 79, 82, 216, 71, 120, 194, 90, 159, 173, 27, 37, 235,
+			199, 179, 124, 43, 82, 114, 241, 246, 69, 60, 156, 23, 111, 191,
+			125, 81, 38, 125, 112, 219, 147, 52, 43, 7, 79, 194, 76, 190,
+			21, 158, 81, 217, 129, 195, 34, 30, 37, 229, 36, 25, 156, 18,
+			246, 67, 0, 97, 41, 12, 120, 200, 117, 197, 245, 224, 71, 123,
+			42, 136, 225, 60, 135, 194, 32, 115, 127, 135, 121, 147, 79, 244,
+			194, 226, 160, 135, 73, 180, 233, 104, 147, 176, 116, 153, 124, 154,
+			38, 169, 60, 26, 39, 192, 244, 78, 27, 99, 10, 64, 164, 62,
+			188, 42, 11, 201, 201, 199, 28, 143, 64, 199, 61, 95, 240, 8,
+			196, 236, 121, 91, 66, 193, 224, 216, 246, 158, 39, 147, 83, 188,
+			131, 44, 162, 194, 251, 37, 66, 167, 252, 55, 150, 13, 64, 16,
+			213, 146, 253, 103, 112, 93, 50, 169, 228, 219, 13, 64, 103, 153,
+			38, 27, 72, 40, 142, 182, 45, 240, 209, 161, 4, 138, 240, 151,
+			8, 173, 89, 144, 0, 56, 50, 102, 65, 6, 224, 196, 36, 127,
+			47, 152, 18, 12, 116, 226, 39, 1, 167, 183, 21, 56, 97, 64,
+			96, 72, 205, 184, 103, 87, 243, 164, 188, 5, 32, 22, 183, 203,
+			217, 206, 205, 219, 191, 5, 166, 29, 85, 234, 6, 70, 71, 161,
+			183, 50, 135, 55, 36, 119, 125, 178, 192, 27, 180, 213, 39, 11,
+			188, 33, 185, 235, 147, 100, 98, 146, 191, 3, 209, 246, 132, 247,
+			105, 144, 246, 190, 60, 175, 174, 229, 120, 40, 128, 93, 133, 94,
+			248, 242, 206, 7, 125, 195, 204, 108, 48, 83, 198, 104, 95, 59,
+			144, 134, 234, 168, 38, 121, 33, 215, 144, 0, 120, 37, 132, 144,
+			138, 254, 44, 82, 27, 96, 50, 108, 56, 100, 33, 49, 235, 211,
+			5, 223, 33, 49, 235, 211, 5, 223, 33, 49, 235, 211, 192, 119,
+			172, 123, 99, 192, 145, 207, 17, 58, 15, 86, 235, 227, 238, 222,
+			211, 170, 242, 157, 49, 70, 61, 167, 85, 60, 69, 248, 87, 239,
+			147, 225, 17, 92, 116, 112, 208, 239, 131, 15, 134, 126, 140, 213,
+			85, 150, 14, 157, 166, 60, 151, 92, 85, 87, 224, 225, 11, 19,
+			40, 49, 28, 212, 147, 152, 8, 165, 123, 162, 123, 29, 66, 103,
+			235, 86, 53, 93, 231, 142, 69, 47, 21, 234, 104, 63, 71, 232,
+			136, 89, 57, 20, 250, 124, 142, 212, 167, 45, 200, 0, 156, 219,
+			203, 55, 145, 14, 53, 225, 189, 64, 232, 126, 255, 205, 182, 170,
+			114, 109, 171, 175, 182, 51, 15, 10, 100, 210, 176, 157, 103, 101,
+			10, 152, 109, 225, 98, 61, 165, 208, 222, 182, 2, 83, 61, 113,
+			173, 138, 83, 89, 254, 64, 249, 200, 11, 182, 28, 143, 97, 93,
+			238, 11, 100, 222, 199, 194, 41, 124, 179, 224, 11, 132, 254, 58,
+			97, 182, 18, 223, 120, 186, 91, 125, 244, 30, 55, 48, 9, 68,
+			38, 177, 29, 29, 204, 50, 239, 11, 132, 251, 252, 30, 83, 101,
+			95, 17, 222, 175, 65, 157, 209, 17, 252, 190, 200, 136, 48, 149,
+			155, 219, 6, 177, 37, 242, 80, 243, 241, 107, 196, 155, 41, 26,
+			8, 52, 204, 250, 69, 3, 131, 134, 133, 131, 166, 136, 126, 68,
+			120, 191, 65, 232, 17, 179, 10, 168, 81, 250, 13, 66, 133, 5,
+			9, 128, 211, 7, 45, 200, 0, 60, 124, 11, 95, 131, 53, 210,
+			186, 240, 126, 147, 208, 37, 255, 97, 121, 30, 239, 218, 94, 150,
+			202, 230, 31, 131, 144, 193, 70, 110, 66, 164, 38, 47, 26, 46,
+			228, 130, 188, 32, 115, 189, 134, 195, 238, 183, 32, 1, 240, 192,
+			97, 11, 50, 0, 143, 220, 198, 47, 33, 10, 163, 194, 251, 109,
+			64, 225, 17, 249, 68, 212, 185, 89, 20, 214, 213, 70, 146, 170,
+			151, 195, 97, 180, 134, 227, 90, 28, 70, 9, 128, 14, 135, 81,
+			6, 224, 145, 219, 248, 51, 4, 76, 32, 46, 188, 47, 131, 4,
+			110, 201, 183, 156, 57, 123, 161, 117, 246, 161, 83, 107, 103, 207,
+			124, 143, 92, 29, 146, 64, 39, 231, 86, 9, 58, 236, 242, 77,
+			200, 65, 190, 138, 255, 45, 212, 157, 125, 253, 31, 150, 19, 119,
+			165, 73, 57, 24, 18, 83, 219, 7, 45, 123, 207, 99, 188, 2,
+			136, 52, 124, 44, 110, 98, 163, 194, 251, 10, 161, 240, 207, 3,
+			120, 30, 27, 173, 0, 212, 48, 186, 4, 222, 14, 209, 224, 95,
+			234, 115, 158, 11, 239, 15, 9, 149, 254, 239, 83, 9, 73, 54,
+			86, 139, 152, 68, 111, 72, 27, 2, 153, 117, 75, 64, 44, 180,
+			66, 129, 189, 3, 71, 224, 41, 248, 208, 88, 183, 112, 77, 232,
+			194, 55, 39, 185, 60, 46, 79, 149, 158, 62, 192, 239, 64, 157,
+			202, 171, 155, 33, 20, 5, 65, 33, 117, 153, 36, 112, 42, 186,
+			169, 128, 91, 120, 129, 144, 65, 13, 136, 38, 82, 30, 116, 173,
+			167, 107, 148, 113, 49, 122, 63, 8, 211, 166, 155, 82, 111, 237,
+			32, 118, 17, 246, 163, 113, 24, 29, 211, 27, 232, 6, 40, 192,
+			116, 14, 139, 60, 179, 88, 216, 71, 193, 175, 216, 16, 68, 208,
+			133, 181, 45, 111, 187, 207, 134, 3, 116, 200, 196, 165, 240, 47,
+			114, 252, 161, 173, 197, 98, 148, 19, 0, 77, 45, 22, 163, 156,
+			1, 184, 112, 136, 191, 9, 249, 209, 16, 222, 159, 64, 41, 239,
+			170, 188, 160, 95, 29, 47, 196, 186, 32, 125, 73, 176, 11, 164,
+			146, 20, 255, 31, 47, 229, 229, 87, 203, 29, 22, 141, 26, 142,
+			108, 223, 200, 104, 16, 0, 185, 53, 255, 26, 12, 192, 233, 89,
+			254, 255, 33, 22, 99, 194, 251, 47, 132, 30, 244, 123, 18, 95,
+			9, 206, 110, 86, 169, 14, 215, 68, 26, 236, 58, 170, 29, 33,
+			89, 193, 120, 74, 54, 134, 5, 25, 29, 114, 251, 166, 149, 198,
+			101, 204, 195, 217, 29, 88, 5, 208, 20, 123, 50, 58, 70, 0,
+			20, 243, 22, 100, 0, 238, 95, 224, 107, 250, 113, 143, 191, 32,
+			149, 31, 160, 196, 127, 216, 186, 57, 175, 44, 66, 181, 171, 163,
+			3, 167, 241, 95, 144, 250, 44, 134, 221, 240, 217, 133, 151, 192,
+			252, 190, 255, 198, 81, 42, 88, 176, 157, 114, 56, 80, 101, 158,
+			97, 168, 10, 239, 37, 123, 172, 120, 168, 175, 95, 178, 38, 185,
+			7, 161, 42, 239, 37, 112, 48, 31, 178, 175, 139, 124, 157, 208,
+			255, 69, 152, 127, 183, 41, 198, 29, 246, 175, 204, 123, 35, 238,
+			180, 119, 11, 45, 50, 192, 245, 123, 35, 95, 39, 124, 146, 55,
+			205, 43, 20, 21, 225, 125, 3, 30, 141, 57, 136, 214, 187, 93,
+			74, 201, 37, 55, 113, 84, 251, 6, 5, 120, 17, 223, 216, 254,
+			224, 200, 55, 72, 99, 98, 232, 193, 145, 111, 16, 49, 205, 255,
+			138, 152, 57, 136, 240, 254, 150, 120, 11, 254, 139, 196, 68, 192,
+			118, 206, 242, 109, 28, 110, 179, 235, 134, 71, 104, 254, 150, 120,
+			194, 17, 2, 172, 247, 191, 37, 211, 243, 69, 3, 131, 134, 253,
+			7, 248, 55, 168, 161, 12, 21, 222, 223, 19, 111, 201, 255, 35,
+			29, 35, 7, 91, 246, 120, 63, 104, 63, 165, 58, 215, 33, 142,
+			61, 37, 128, 22, 167, 202, 40, 170, 109, 37, 139, 230, 124, 81,
+			154, 173, 133, 157, 131, 78, 142, 41, 21, 53, 180, 112, 6, 226,
+			110, 116, 11, 179, 226, 253, 191, 18, 30, 198, 36, 228, 69, 156,
+			206, 82, 246, 58, 193, 189, 51, 59, 190, 189, 94, 136, 175, 232,
+			169, 71, 218, 209, 189, 180, 154, 194, 13, 40, 112, 227, 206, 115,
+			40, 49, 135, 214, 144, 210, 11, 69, 3, 129, 134, 131, 139, 69,
+			3, 131, 134, 91, 111, 227, 15, 26, 222, 48, 225, 253, 35, 241,
+			230, 253, 59, 229, 218, 240, 84, 37, 206, 156, 217, 149, 51, 118,
+			72, 112, 65, 254, 145, 120, 163, 69, 3, 129, 6, 62, 83, 52,
+			224, 36, 115, 123, 209, 170, 242, 192, 241, 255, 38, 168, 221, 135,
+			113, 74, 120, 71, 176, 184, 72, 54, 202, 20, 255, 237, 17, 83,
+			98, 102, 242, 52, 138, 227, 203, 106, 87, 100, 168, 211, 50, 80,
+			207, 244, 205, 226, 101, 24, 144, 211, 111, 90, 125, 234, 161, 148,
+			126, 147, 136, 125, 22, 100, 0, 30, 88, 224, 255, 25, 124, 13,
+			143, 82, 225, 125, 63, 165, 194, 255, 50, 145, 171, 47, 239, 20,
+			217, 58, 148, 94, 146, 22, 34, 101, 14, 213, 34, 127, 11, 86,
+			150, 21, 199, 237, 110, 155, 57, 85, 125, 21, 184, 237, 252, 134,
+			178, 112, 58, 214, 115, 243, 20, 71, 96, 159, 94, 212, 106, 3,
+			78, 149, 45, 151, 186, 97, 82, 39, 180, 151, 15, 142, 157, 11,
+			255, 234, 181, 130, 35, 245, 253, 182, 28, 6, 194, 226, 0, 26,
+			175, 11, 2, 177, 0, 78, 78, 225, 201, 82, 21, 181, 103, 104,
+			229, 103, 241, 100, 25, 74, 105, 180, 26, 211, 34, 120, 157, 131,
+			69, 127, 179, 253, 100, 1, 247, 230, 25, 90, 159, 229, 247, 216,
+			135, 107, 222, 75, 233, 172, 127, 219, 203, 4, 118, 50, 61, 80,
+			104, 189, 135, 42, 198, 117, 222, 107, 87, 81, 197, 67, 228, 189,
+			212, 28, 34, 85, 212, 198, 239, 165, 211, 51, 252, 247, 220, 11,
+			50, 239, 3, 126, 254, 123, 114, 115, 113, 29, 153, 169, 72, 181,
+			29, 55, 10, 217, 207, 10, 108, 220, 237, 157, 115, 244, 145, 57,
+			217, 86, 156, 7, 215, 192, 171, 47, 135, 28, 151, 50, 12, 145,
+			52, 117, 92, 108, 237, 236, 119, 175, 149, 54, 84, 102, 210, 36,
+			193, 144, 49, 170, 182, 248, 247, 87, 64, 184, 244, 242, 157, 89,
+			83, 133, 235, 50, 239, 125, 197, 234, 65, 154, 223, 103, 121, 88,
+			69, 141, 251, 62, 58, 57, 197, 255, 149, 123, 49, 230, 89, 160,
+			240, 15, 238, 88, 189, 73, 69, 46, 145, 120, 3, 255, 209, 43,
+			121, 122, 75, 154, 252, 16, 115, 67, 1, 33, 34, 25, 193, 179,
+			143, 16, 223, 29, 172, 155, 215, 17, 97, 123, 180, 48, 109, 185,
+			124, 156, 152, 254, 177, 108, 157, 61, 1, 9, 5, 221, 65, 20,
+			164, 246, 73, 218, 208, 249, 128, 250, 193, 151, 103, 139, 117, 128,
+			44, 62, 91, 112, 17, 100, 241, 89, 224, 226, 227, 246, 189, 151,
+			231, 128, 137, 223, 85, 68, 89, 46, 24, 252, 205, 157, 140, 125,
+			81, 41, 176, 147, 191, 220, 220, 160, 167, 158, 163, 38, 84, 82,
+			69, 45, 245, 28, 29, 177, 52, 132, 80, 201, 115, 64, 195, 119,
+			17, 251, 46, 200, 135, 97, 242, 244, 166, 99, 37, 134, 160, 187,
+			7, 75, 224, 8, 193, 36, 166, 157, 193, 18, 243, 221, 182, 104,
+			73, 21, 30, 172, 242, 62, 92, 208, 10, 236, 179, 15, 23, 60,
+			135, 104, 201, 135, 1, 223, 223, 39, 246, 165, 145, 143, 82, 58,
+			239, 127, 241, 230, 162, 37, 206, 221, 250, 231, 140, 147, 224, 36,
+			223, 114, 152, 164, 138, 97, 146, 143, 82, 19, 38, 169, 82, 208,
+			35, 31, 165, 38, 76, 162, 223, 67, 249, 40, 20, 191, 191, 81,
+			63, 39, 242, 60, 173, 124, 137, 18, 255, 156, 28, 206, 186, 190,
+			89, 163, 120, 119, 221, 5, 49, 144, 231, 105, 125, 14, 173, 98,
+			124, 65, 227, 99, 244, 213, 176, 138, 107, 168, 208, 62, 102, 217,
+			171, 223, 174, 248, 152, 221, 10, 250, 237, 138, 143, 217, 173, 80,
+			3, 171, 248, 227, 148, 254, 42, 101, 254, 119, 72, 204, 190, 31,
+			122, 184, 2, 52, 11, 36, 213, 107, 97, 68, 170, 67, 100, 119,
+			211, 42, 18, 251, 230, 172, 157, 27, 237, 227, 143, 195, 19, 19,
+			77, 83, 141, 94, 17, 222, 39, 232, 77, 218, 199, 246, 57, 136,
+			79, 148, 43, 222, 97, 196, 79, 208, 198, 196, 208, 115, 16, 159,
+			160, 98, 154, 191, 193, 76, 65, 132, 247, 41, 234, 205, 250, 167,
+			76, 142, 149, 153, 195, 140, 63, 244, 102, 66, 113, 143, 100, 194,
+			225, 78, 47, 195, 3, 15, 197, 164, 160, 21, 63, 85, 198, 2,
+			244, 226, 167, 104, 99, 114, 232, 129, 132, 79, 1, 25, 159, 52,
+			88, 80, 225, 125, 134, 122, 211, 254, 170, 121, 38, 66, 35, 1,
+			241, 192, 227, 240, 224, 89, 103, 24, 17, 36, 37, 76, 142, 212,
+			45, 189, 99, 229, 48, 44, 97, 3, 186, 237, 51, 212, 27, 29,
+			122, 204, 224, 51, 148, 239, 25, 122, 204, 224, 51, 116, 74, 240,
+			107, 6, 27, 8, 244, 81, 111, 175, 191, 41, 47, 234, 226, 7,
+			139, 208, 208, 196, 203, 46, 233, 112, 248, 45, 92, 206, 225, 159,
+			110, 0, 183, 221, 116, 68, 191, 30, 108, 252, 24, 247, 101, 57,
+			65, 3, 7, 44, 33, 11, 202, 240, 115, 101, 210, 129, 58, 252,
+			28, 109, 136, 162, 1, 145, 155, 157, 67, 143, 29, 171, 241, 95,
+			128, 186, 224, 213, 33, 163, 109, 8, 79, 44, 213, 213, 21, 249,
+			235, 246, 165, 35, 251, 244, 109, 92, 106, 26, 126, 195, 5, 236,
+			182, 23, 134, 235, 208, 95, 24, 174, 67, 127, 97, 184, 14, 253,
+			5, 168, 67, 255, 77, 106, 235, 208, 191, 8, 90, 250, 223, 210,
+			155, 177, 219, 32, 110, 179, 110, 76, 55, 171, 0, 93, 50, 46,
+			166, 140, 65, 78, 223, 141, 141, 53, 163, 42, 182, 91, 107, 37,
+			99, 13, 83, 96, 236, 97, 245, 138, 108, 54, 60, 110, 44, 166,
+			25, 164, 146, 152, 4, 224, 199, 13, 194, 97, 230, 214, 104, 130,
+			12, 230, 220, 201, 147, 126, 95, 117, 138, 220, 196, 16, 50, 170,
+			160, 82, 163, 99, 94, 206, 49, 154, 54, 131, 5, 162, 12, 57,
+			30, 128, 232, 126, 177, 208, 69, 192, 236, 47, 218, 163, 70, 215,
+			195, 127, 17, 142, 154, 243, 156, 214, 42, 162, 246, 219, 180, 242,
+			7, 148, 248, 15, 66, 150, 128, 59, 98, 6, 153, 74, 143, 111,
+			4, 109, 147, 233, 44, 131, 54, 190, 65, 0, 107, 127, 122, 40,
+			220, 0, 62, 198, 149, 16, 31, 57, 110, 112, 86, 3, 197, 241,
+			219, 180, 62, 198, 31, 225, 94, 13, 21, 209, 239, 80, 186, 236,
+			191, 14, 211, 104, 236, 69, 15, 22, 122, 185, 23, 158, 240, 162,
+			4, 236, 206, 33, 5, 88, 14, 1, 192, 64, 68, 120, 191, 67,
+			107, 163, 22, 164, 0, 242, 25, 11, 50, 0, 15, 221, 14, 194,
+			93, 171, 192, 122, 191, 66, 105, 211, 95, 197, 60, 54, 115, 128,
+			101, 59, 242, 208, 236, 206, 191, 153, 28, 52, 61, 15, 136, 239,
+			87, 104, 205, 129, 20, 192, 198, 156, 5, 25, 128, 135, 151, 249,
+			121, 196, 130, 10, 239, 171, 148, 158, 240, 31, 116, 222, 128, 94,
+			125, 105, 74, 80, 195, 70, 164, 139, 215, 206, 204, 164, 202, 80,
+			218, 77, 14, 203, 250, 42, 173, 53, 44, 136, 227, 143, 205, 91,
+			144, 1, 120, 203, 157, 252, 61, 4, 103, 103, 194, 123, 145, 210,
+			215, 248, 87, 11, 241, 46, 166, 7, 253, 247, 50, 243, 154, 243,
+			101, 185, 252, 176, 32, 60, 1, 51, 76, 176, 204, 88, 217, 32,
+			164, 248, 134, 76, 201, 20, 119, 72, 131, 30, 122, 145, 214, 198,
+			44, 72, 1, 28, 247, 45, 136, 88, 222, 122, 247, 122, 173, 159,
+			38, 121, 114, 247, 255, 30, 0, 39, 100, 113, 46, 89, 123, 0,
+			0},
 	)
 }
 
@@ -12,556 +12,466 @@ func init() {
 			"logdog.Services",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 124, 239, 111, 28, 201,
-			149, 216, 84, 119, 207, 112, 88, 164, 248, 163, 73, 81, 100, 115,
-			87, 42, 81, 187, 18, 37, 13, 135, 164, 100, 121, 119, 165, 149,
-			188, 67, 114, 36, 206, 46, 197, 161, 103, 134, 43, 203, 134, 163,
-			109, 78, 23, 103, 122, 213, 211, 61, 238, 238, 145, 196, 245, 45,
-			140, 203, 1, 135, 187, 252, 114, 140, 28, 242, 33, 240, 93, 236,
-			248, 114, 118, 236, 77, 14, 78, 46, 155, 0, 7, 7, 151, 224,
-			242, 193, 64, 112, 56, 228, 130, 59, 224, 16, 228, 15, 72, 62,
-			220, 167, 0, 1, 2, 4, 193, 123, 85, 213, 211, 195, 31, 90,
-			173, 111, 147, 15, 198, 18, 208, 110, 191, 234, 170, 247, 94, 189,
-			247, 234, 213, 171, 215, 175, 134, 254, 171, 17, 122, 191, 21, 20,
-			155, 237, 48, 232, 184, 189, 78, 49, 8, 91, 203, 94, 175, 233,
-			46, 123, 65, 203, 9, 90, 203, 118, 215, 93, 230, 190, 211, 13,
-			92, 63, 142, 150, 155, 65, 16, 58, 174, 111, 199, 65, 184, 28,
-			241, 240, 137, 219, 228, 209, 242, 147, 213, 229, 40, 182, 99, 94,
-			236, 134, 65, 28, 152, 57, 49, 114, 225, 71, 132, 142, 109, 5,
-			173, 122, 28, 114, 187, 83, 135, 14, 230, 5, 122, 10, 251, 60,
-			122, 194, 195, 200, 13, 252, 89, 194, 200, 226, 112, 109, 20, 27,
-			223, 21, 109, 230, 12, 205, 69, 188, 25, 242, 120, 86, 99, 100,
-			113, 180, 38, 33, 243, 85, 58, 22, 243, 176, 227, 250, 182, 247,
-			200, 245, 29, 254, 108, 86, 103, 100, 81, 175, 157, 82, 173, 21,
-			104, 52, 45, 154, 183, 195, 102, 219, 125, 194, 157, 89, 131, 145,
-			197, 124, 45, 129, 1, 117, 183, 23, 182, 184, 51, 155, 197, 55,
-			18, 90, 123, 251, 171, 155, 127, 109, 25, 220, 18, 157, 223, 254,
-			203, 60, 205, 153, 134, 145, 97, 132, 254, 107, 66, 201, 168, 169,
-			27, 25, 243, 218, 143, 9, 91, 15, 186, 7, 161, 219, 106, 199,
-			236, 218, 202, 234, 23, 89, 163, 205, 217, 214, 238, 122, 133, 149,
-			122, 113, 59, 8, 163, 34, 43, 121, 30, 195, 14, 17, 11, 57,
-			96, 230, 78, 145, 178, 221, 136, 179, 96, 159, 197, 109, 55, 98,
-			81, 208, 11, 155, 156, 53, 3, 135, 51, 55, 98, 173, 224, 9,
-			15, 125, 238, 176, 158, 239, 240, 144, 197, 109, 206, 74, 93, 187,
-			9, 136, 221, 38, 247, 35, 94, 96, 82, 168, 236, 90, 113, 133,
-			178, 184, 109, 199, 172, 105, 251, 108, 143, 179, 253, 160, 231, 59,
-			204, 245, 113, 212, 86, 101, 189, 188, 93, 47, 179, 125, 215, 227,
-			69, 74, 243, 148, 104, 166, 158, 203, 140, 195, 83, 222, 212, 243,
-			153, 71, 116, 152, 106, 249, 17, 241, 184, 75, 53, 35, 99, 26,
-			163, 25, 70, 172, 10, 27, 84, 49, 176, 5, 24, 189, 160, 197,
-			34, 108, 102, 104, 27, 236, 137, 237, 245, 128, 243, 78, 167, 231,
-			187, 77, 59, 230, 14, 139, 3, 202, 148, 0, 139, 148, 82, 170,
-			27, 25, 98, 234, 163, 249, 25, 186, 65, 13, 35, 163, 101, 76,
-			125, 76, 155, 183, 94, 99, 59, 41, 251, 80, 20, 208, 102, 246,
-			122, 251, 76, 218, 18, 219, 15, 66, 41, 37, 164, 91, 164, 116,
-			148, 102, 1, 75, 22, 208, 228, 21, 68, 76, 125, 108, 120, 70,
-			65, 186, 169, 143, 205, 89, 244, 183, 53, 36, 72, 76, 125, 70,
-			155, 178, 190, 173, 161, 118, 250, 115, 184, 20, 49, 97, 132, 69,
-			74, 217, 118, 16, 115, 33, 74, 96, 67, 180, 3, 83, 33, 143,
-			123, 160, 141, 243, 172, 1, 92, 184, 17, 11, 30, 219, 7, 5,
-			22, 185, 126, 147, 11, 206, 148, 17, 225, 75, 223, 59, 160, 204,
-			110, 54, 121, 20, 185, 123, 30, 103, 113, 192, 226, 176, 23, 129,
-			100, 18, 169, 32, 31, 146, 132, 84, 92, 19, 20, 236, 176, 189,
-			131, 164, 23, 10, 242, 137, 237, 185, 14, 8, 90, 74, 221, 245,
-			247, 131, 176, 99, 199, 32, 153, 167, 110, 220, 14, 122, 49, 243,
-			57, 119, 92, 191, 5, 148, 186, 248, 255, 54, 103, 235, 125, 107,
-			102, 174, 79, 217, 30, 143, 159, 114, 238, 51, 110, 55, 219, 172,
-			215, 5, 148, 137, 36, 73, 22, 228, 51, 164, 32, 144, 86, 126,
-			76, 65, 186, 169, 207, 76, 154, 244, 9, 10, 82, 51, 117, 75,
-			155, 183, 92, 193, 191, 226, 200, 225, 207, 132, 41, 31, 146, 173,
-			90, 192, 172, 195, 163, 200, 110, 241, 34, 171, 136, 94, 194, 104,
-			220, 136, 45, 173, 22, 104, 50, 14, 85, 236, 122, 158, 68, 224,
-			250, 173, 132, 67, 45, 11, 132, 21, 135, 160, 79, 43, 175, 116,
-			173, 233, 166, 110, 205, 89, 244, 58, 114, 168, 155, 250, 203, 218,
-			180, 117, 81, 145, 74, 25, 108, 219, 142, 216, 30, 200, 64, 249,
-			141, 4, 189, 158, 133, 81, 57, 5, 17, 83, 127, 121, 104, 92,
-			65, 128, 209, 156, 162, 171, 136, 222, 48, 245, 115, 154, 105, 189,
-			242, 60, 244, 194, 249, 36, 200, 141, 44, 140, 81, 200, 13, 98,
-			234, 231, 134, 78, 41, 72, 55, 245, 115, 19, 147, 123, 57, 180,
-			250, 235, 244, 191, 12, 127, 22, 94, 59, 182, 163, 199, 209, 33,
-			175, 189, 79, 71, 74, 98, 218, 13, 59, 122, 108, 206, 210, 161,
-			110, 24, 188, 207, 155, 177, 244, 213, 10, 52, 199, 168, 230, 58,
-			232, 162, 135, 107, 154, 235, 152, 243, 116, 24, 240, 61, 242, 237,
-			14, 159, 29, 194, 230, 60, 52, 108, 219, 29, 110, 78, 80, 253,
-			49, 63, 64, 135, 61, 90, 131, 199, 207, 212, 229, 254, 187, 33,
-			225, 114, 231, 126, 105, 93, 174, 163, 92, 238, 28, 177, 190, 194,
-			82, 10, 2, 158, 108, 6, 114, 102, 223, 232, 241, 30, 23, 143,
-			14, 143, 154, 161, 219, 141, 251, 110, 145, 75, 99, 182, 61, 152,
-			153, 13, 78, 169, 229, 113, 154, 178, 203, 1, 15, 60, 149, 246,
-			192, 167, 173, 215, 80, 116, 160, 89, 181, 126, 165, 21, 40, 63,
-			152, 120, 93, 224, 103, 15, 231, 25, 7, 207, 245, 192, 19, 105,
-			15, 60, 53, 77, 95, 87, 14, 120, 66, 155, 176, 174, 34, 189,
-			182, 29, 181, 89, 101, 227, 168, 203, 0, 7, 38, 215, 230, 128,
-			111, 154, 72, 104, 128, 111, 154, 24, 30, 73, 249, 166, 137, 177,
-			113, 218, 81, 190, 105, 74, 59, 99, 189, 135, 52, 64, 134, 95,
-			70, 193, 109, 39, 179, 131, 13, 198, 142, 30, 23, 88, 47, 226,
-			137, 190, 160, 69, 72, 184, 235, 118, 185, 231, 250, 188, 72, 19,
-			71, 31, 242, 111, 244, 220, 144, 59, 40, 236, 210, 250, 59, 135,
-			93, 210, 84, 194, 24, 204, 112, 106, 216, 76, 185, 164, 169, 211,
-			51, 244, 207, 136, 242, 73, 179, 218, 132, 245, 31, 9, 107, 84,
-			55, 170, 139, 109, 215, 15, 30, 219, 151, 111, 178, 26, 239, 4,
-			79, 228, 22, 98, 239, 199, 60, 100, 205, 112, 175, 215, 42, 54,
-			131, 206, 242, 27, 215, 174, 223, 184, 241, 26, 197, 201, 36, 42,
-			126, 204, 15, 142, 74, 77, 122, 85, 55, 194, 215, 78, 192, 35,
-			255, 82, 204, 58, 118, 220, 108, 99, 79, 104, 133, 29, 224, 184,
-			237, 187, 128, 173, 48, 77, 30, 225, 238, 21, 245, 186, 60, 220,
-			247, 122, 65, 47, 98, 182, 239, 176, 168, 29, 244, 60, 7, 172,
-			220, 225, 30, 143, 15, 249, 204, 217, 196, 37, 131, 207, 156, 205,
-			143, 164, 124, 230, 236, 216, 120, 226, 214, 254, 244, 85, 122, 182,
-			21, 4, 45, 143, 47, 171, 237, 125, 217, 233, 133, 184, 139, 73,
-			63, 53, 46, 222, 23, 213, 251, 133, 155, 52, 191, 33, 187, 128,
-			183, 138, 120, 51, 240, 157, 8, 189, 149, 94, 83, 160, 57, 77,
-			179, 190, 237, 7, 17, 58, 172, 108, 77, 0, 107, 191, 66, 167,
-			154, 65, 167, 120, 8, 229, 218, 41, 133, 16, 227, 142, 29, 242,
-			213, 43, 45, 55, 110, 247, 246, 80, 224, 173, 192, 179, 253, 86,
-			159, 191, 110, 124, 208, 229, 81, 194, 230, 255, 34, 228, 159, 104,
-			250, 189, 157, 181, 31, 107, 103, 239, 9, 188, 59, 178, 107, 241,
-			1, 247, 188, 119, 252, 224, 169, 223, 128, 33, 111, 255, 229, 5,
-			112, 91, 103, 51, 17, 161, 255, 105, 20, 221, 214, 217, 140, 121,
-			237, 223, 143, 138, 112, 167, 25, 120, 108, 173, 183, 191, 207, 195,
-			136, 45, 49, 129, 234, 82, 196, 28, 59, 182, 153, 235, 199, 60,
-			108, 182, 109, 191, 5, 94, 5, 182, 121, 58, 224, 235, 86, 94,
-			151, 3, 88, 197, 111, 22, 217, 9, 110, 174, 29, 199, 221, 232,
-			230, 242, 178, 195, 159, 112, 47, 232, 242, 48, 82, 146, 128, 121,
-			118, 37, 19, 75, 123, 130, 137, 101, 74, 89, 141, 59, 110, 20,
-			135, 238, 94, 15, 93, 11, 40, 190, 23, 113, 88, 34, 210, 77,
-			66, 203, 158, 235, 219, 225, 1, 242, 21, 21, 48, 246, 96, 65,
-			168, 98, 16, 202, 58, 129, 227, 238, 67, 4, 232, 6, 126, 129,
-			217, 33, 103, 93, 216, 254, 99, 136, 123, 186, 97, 240, 196, 117,
-			32, 52, 84, 225, 213, 126, 224, 121, 193, 83, 8, 86, 64, 143,
-			46, 12, 138, 96, 16, 101, 29, 30, 223, 164, 148, 193, 223, 149,
-			67, 140, 69, 96, 252, 105, 199, 221, 233, 69, 49, 196, 103, 182,
-			92, 204, 246, 30, 172, 167, 166, 146, 24, 101, 126, 16, 187, 77,
-			180, 114, 55, 98, 158, 27, 197, 128, 33, 77, 17, 28, 218, 0,
-			59, 142, 27, 53, 61, 219, 237, 240, 176, 120, 18, 19, 174, 159,
-			150, 133, 98, 162, 27, 6, 78, 15, 3, 66, 201, 7, 237, 51,
-			242, 215, 226, 131, 42, 87, 229, 4, 205, 94, 135, 251, 177, 173,
-			148, 180, 28, 132, 44, 136, 219, 60, 132, 213, 206, 67, 215, 246,
-			162, 190, 168, 81, 65, 113, 155, 83, 150, 230, 62, 153, 212, 54,
-			119, 113, 100, 156, 218, 0, 210, 182, 229, 7, 253, 119, 40, 119,
-			55, 142, 96, 70, 190, 64, 21, 132, 17, 235, 216, 7, 224, 24,
-			208, 155, 198, 1, 4, 193, 65, 8, 59, 108, 8, 76, 116, 32,
-			154, 22, 50, 137, 35, 230, 240, 16, 2, 46, 182, 31, 6, 29,
-			170, 54, 224, 253, 248, 41, 152, 137, 138, 98, 163, 46, 111, 130,
-			5, 177, 110, 232, 130, 97, 133, 96, 59, 190, 176, 162, 40, 66,
-			222, 41, 107, 108, 86, 234, 172, 94, 189, 219, 120, 80, 170, 149,
-			89, 165, 206, 118, 106, 213, 119, 43, 27, 229, 13, 182, 246, 144,
-			53, 54, 203, 108, 189, 186, 243, 176, 86, 185, 183, 217, 96, 155,
-			213, 173, 141, 114, 173, 206, 74, 219, 27, 108, 189, 186, 221, 168,
-			85, 214, 118, 27, 213, 90, 157, 178, 133, 82, 157, 85, 234, 11,
-			248, 166, 180, 253, 144, 149, 191, 178, 83, 43, 215, 235, 172, 90,
-			99, 149, 251, 59, 91, 149, 242, 6, 123, 80, 170, 213, 74, 219,
-			141, 74, 185, 94, 96, 149, 237, 245, 173, 221, 141, 202, 246, 189,
-			2, 91, 219, 109, 176, 237, 106, 131, 178, 173, 202, 253, 74, 163,
-			188, 193, 26, 213, 2, 146, 61, 58, 142, 85, 239, 178, 251, 229,
-			218, 250, 102, 105, 187, 81, 90, 171, 108, 85, 26, 15, 145, 224,
-			221, 74, 99, 27, 136, 221, 173, 214, 40, 43, 177, 157, 82, 173,
-			81, 89, 223, 221, 42, 213, 216, 206, 110, 109, 167, 90, 47, 51,
-			152, 217, 70, 165, 190, 190, 85, 170, 220, 47, 111, 20, 89, 101,
-			155, 109, 87, 89, 249, 221, 242, 118, 131, 213, 55, 75, 91, 91,
-			131, 19, 165, 172, 250, 96, 187, 92, 3, 238, 211, 211, 100, 107,
-			101, 182, 85, 41, 173, 109, 149, 129, 20, 206, 115, 163, 82, 43,
-			175, 55, 96, 66, 253, 167, 245, 202, 70, 121, 187, 81, 218, 42,
-			80, 86, 223, 41, 175, 87, 74, 91, 5, 86, 254, 74, 249, 254,
-			206, 86, 169, 246, 176, 32, 145, 214, 203, 95, 222, 45, 111, 55,
-			42, 165, 45, 182, 81, 186, 95, 186, 87, 174, 179, 197, 79, 146,
-			202, 78, 173, 186, 190, 91, 43, 223, 7, 174, 171, 119, 89, 125,
-			119, 173, 222, 168, 52, 118, 27, 101, 118, 175, 90, 221, 64, 97,
-			215, 203, 181, 119, 43, 235, 229, 250, 45, 182, 85, 173, 163, 192,
-			118, 235, 229, 2, 101, 27, 165, 70, 9, 73, 239, 212, 170, 119,
-			43, 141, 250, 45, 120, 94, 219, 173, 87, 80, 112, 149, 237, 70,
-			185, 86, 219, 221, 105, 84, 170, 219, 151, 217, 102, 245, 65, 249,
-			221, 114, 141, 173, 151, 118, 235, 229, 13, 148, 112, 117, 27, 102,
-			11, 182, 82, 174, 214, 30, 2, 90, 144, 3, 106, 160, 192, 30,
-			108, 150, 27, 155, 229, 26, 8, 21, 165, 85, 2, 49, 212, 27,
-			181, 202, 122, 35, 221, 173, 90, 99, 141, 106, 173, 65, 83, 243,
-			100, 219, 229, 123, 91, 149, 123, 229, 237, 245, 50, 188, 174, 2,
-			154, 7, 149, 122, 249, 50, 43, 213, 42, 117, 232, 80, 65, 194,
-			236, 65, 233, 33, 171, 238, 226, 172, 65, 81, 187, 245, 50, 21,
-			207, 41, 211, 45, 160, 62, 89, 229, 46, 43, 109, 188, 91, 1,
-			206, 101, 239, 157, 106, 189, 94, 145, 230, 130, 98, 91, 223, 148,
-			50, 79, 162, 73, 150, 153, 149, 209, 228, 66, 230, 22, 70, 147,
-			175, 138, 71, 209, 120, 33, 115, 14, 27, 207, 137, 71, 209, 248,
-			74, 166, 164, 226, 78, 120, 20, 141, 175, 102, 10, 216, 72, 196,
-			163, 104, 188, 152, 41, 98, 163, 124, 20, 141, 151, 50, 11, 216,
-			72, 197, 163, 104, 92, 204, 156, 199, 198, 87, 196, 227, 239, 76,
-			136, 96, 118, 63, 19, 17, 235, 59, 19, 172, 196, 212, 118, 139,
-			222, 145, 71, 220, 143, 35, 12, 81, 91, 62, 119, 10, 108, 223,
-			125, 198, 157, 37, 143, 251, 173, 184, 205, 162, 174, 237, 99, 104,
-			227, 118, 120, 191, 59, 119, 40, 179, 97, 76, 51, 232, 249, 232,
-			51, 229, 190, 143, 14, 115, 63, 180, 155, 253, 109, 65, 189, 136,
-			25, 198, 0, 8, 82, 216, 22, 3, 79, 120, 62, 86, 193, 8,
-			7, 142, 171, 93, 238, 59, 92, 32, 180, 253, 3, 214, 180, 61,
-			238, 59, 118, 136, 88, 155, 129, 223, 228, 221, 24, 220, 244, 99,
-			206, 22, 28, 251, 96, 129, 130, 79, 91, 232, 4, 126, 220, 94,
-			80, 104, 66, 238, 201, 100, 7, 107, 184, 29, 30, 197, 118, 167,
-			43, 28, 181, 220, 225, 28, 23, 182, 87, 238, 55, 185, 58, 123,
-			83, 22, 63, 77, 247, 198, 83, 112, 36, 34, 253, 68, 84, 192,
-			130, 155, 28, 45, 108, 7, 92, 121, 16, 178, 168, 183, 23, 195,
-			116, 65, 34, 224, 68, 153, 221, 71, 84, 100, 53, 140, 24, 0,
-			81, 183, 27, 6, 207, 92, 216, 14, 188, 3, 118, 117, 105, 117,
-			165, 176, 178, 178, 194, 14, 184, 29, 70, 224, 62, 47, 176, 242,
-			51, 187, 211, 245, 120, 68, 169, 122, 100, 171, 55, 217, 122, 208,
-			233, 246, 98, 222, 103, 3, 105, 12, 176, 139, 251, 94, 55, 226,
-			61, 39, 192, 205, 183, 40, 55, 233, 254, 124, 162, 216, 14, 99,
-			118, 155, 21, 139, 197, 91, 135, 223, 113, 223, 25, 120, 147, 16,
-			82, 241, 149, 122, 43, 94, 39, 193, 161, 82, 235, 109, 192, 144,
-			64, 75, 130, 150, 130, 111, 29, 26, 132, 6, 32, 135, 136, 103,
-			53, 0, 33, 69, 196, 221, 103, 139, 71, 8, 189, 201, 86, 216,
-			197, 139, 135, 113, 221, 97, 43, 151, 217, 55, 197, 176, 99, 184,
-			187, 122, 155, 173, 222, 58, 242, 86, 146, 190, 205, 86, 87, 212,
-			159, 236, 244, 33, 227, 30, 196, 87, 199, 49, 112, 231, 88, 6,
-			222, 124, 62, 3, 75, 207, 97, 224, 234, 113, 12, 164, 212, 127,
-			173, 175, 254, 190, 190, 80, 255, 125, 240, 106, 95, 97, 159, 222,
-			10, 78, 212, 245, 201, 54, 34, 94, 165, 85, 126, 123, 80, 229,
-			236, 234, 17, 33, 220, 234, 15, 82, 6, 144, 82, 122, 122, 192,
-			17, 43, 232, 143, 25, 148, 243, 128, 205, 165, 69, 220, 31, 112,
-			172, 116, 251, 234, 237, 119, 188, 147, 238, 120, 2, 141, 171, 199,
-			211, 56, 214, 132, 82, 26, 188, 126, 210, 2, 118, 236, 152, 131,
-			71, 45, 194, 127, 28, 238, 225, 17, 131, 237, 28, 196, 109, 17,
-			77, 193, 95, 12, 66, 63, 218, 113, 209, 177, 15, 162, 219, 215,
-			11, 172, 227, 250, 189, 152, 71, 183, 87, 87, 46, 15, 46, 51,
-			118, 59, 161, 182, 120, 232, 85, 241, 110, 24, 116, 26, 9, 170,
-			216, 185, 140, 190, 231, 237, 122, 117, 155, 221, 183, 187, 93, 215,
-			111, 81, 202, 42, 190, 104, 17, 39, 29, 113, 20, 77, 248, 135,
-			211, 23, 195, 212, 42, 152, 153, 35, 182, 1, 136, 99, 253, 22,
-			11, 109, 25, 186, 218, 224, 47, 41, 11, 246, 222, 231, 205, 184,
-			192, 158, 182, 121, 40, 2, 112, 217, 145, 131, 80, 101, 244, 28,
-			245, 246, 247, 221, 103, 108, 33, 90, 96, 139, 174, 239, 224, 73,
-			197, 111, 169, 125, 227, 50, 56, 94, 10, 4, 187, 33, 111, 114,
-			71, 100, 98, 49, 0, 238, 117, 246, 120, 152, 218, 98, 228, 217,
-			167, 191, 203, 68, 140, 63, 131, 13, 43, 66, 54, 105, 178, 47,
-			217, 158, 26, 82, 100, 119, 131, 144, 113, 161, 174, 2, 187, 158,
-			236, 86, 136, 105, 101, 0, 87, 114, 226, 166, 201, 220, 221, 1,
-			65, 129, 40, 22, 174, 71, 11, 48, 95, 23, 180, 63, 176, 41,
-			174, 166, 144, 73, 92, 20, 118, 146, 62, 139, 199, 97, 43, 42,
-			235, 90, 5, 188, 128, 231, 16, 86, 202, 58, 110, 51, 28, 196,
-			251, 162, 104, 87, 163, 5, 76, 66, 169, 52, 212, 126, 126, 130,
-			254, 87, 162, 242, 80, 239, 107, 211, 214, 159, 16, 86, 199, 168,
-			32, 33, 42, 179, 28, 233, 176, 160, 200, 238, 195, 73, 107, 143,
-			11, 219, 94, 186, 190, 122, 163, 112, 227, 181, 47, 194, 6, 7,
-			255, 40, 108, 197, 87, 15, 53, 50, 215, 111, 122, 189, 200, 125,
-			194, 139, 152, 223, 191, 9, 88, 35, 46, 82, 89, 120, 224, 100,
-			77, 177, 114, 196, 217, 228, 38, 101, 95, 92, 1, 38, 150, 59,
-			174, 207, 174, 0, 208, 113, 253, 229, 118, 200, 174, 176, 107, 95,
-			96, 237, 112, 217, 177, 15, 216, 21, 118, 253, 139, 55, 138, 215,
-			110, 48, 88, 35, 203, 176, 185, 178, 43, 98, 133, 138, 157, 54,
-			157, 31, 123, 63, 73, 145, 192, 212, 223, 207, 143, 167, 242, 99,
-			239, 155, 83, 244, 215, 116, 149, 32, 11, 53, 211, 250, 159, 154,
-			18, 196, 64, 112, 99, 75, 185, 12, 70, 55, 169, 224, 38, 45,
-			47, 218, 23, 152, 90, 77, 17, 243, 120, 20, 137, 5, 19, 248,
-			60, 193, 22, 14, 196, 90, 194, 26, 109, 182, 66, 217, 123, 82,
-			15, 239, 177, 125, 151, 123, 14, 218, 128, 205, 186, 65, 228, 198,
-			238, 19, 60, 226, 249, 188, 101, 227, 243, 123, 200, 144, 236, 40,
-			12, 93, 185, 129, 8, 89, 73, 17, 12, 66, 214, 9, 66, 94,
-			96, 54, 243, 3, 127, 233, 3, 30, 6, 242, 91, 128, 202, 102,
-			14, 96, 19, 71, 107, 88, 9, 106, 122, 112, 80, 133, 248, 17,
-			204, 11, 187, 15, 242, 121, 216, 68, 222, 120, 227, 141, 130, 252,
-			39, 204, 35, 213, 144, 50, 141, 116, 174, 49, 28, 248, 14, 18,
-			230, 79, 165, 114, 141, 97, 42, 83, 255, 111, 77, 58, 127, 56,
-			165, 197, 59, 221, 248, 224, 164, 124, 214, 16, 205, 150, 225, 253,
-			218, 147, 227, 147, 83, 20, 223, 170, 204, 212, 165, 79, 206, 76,
-			33, 181, 79, 145, 150, 250, 63, 19, 34, 45, 117, 125, 226, 243,
-			180, 212, 231, 105, 169, 207, 211, 82, 159, 167, 165, 62, 79, 75,
-			125, 158, 150, 250, 255, 152, 150, 186, 163, 50, 80, 240, 168, 210,
-			82, 73, 6, 234, 149, 36, 3, 245, 106, 230, 170, 202, 64, 193,
-			163, 74, 75, 37, 25, 168, 139, 73, 6, 234, 82, 63, 3, 5,
-			143, 42, 45, 149, 228, 191, 224, 241, 127, 107, 152, 150, 210, 175,
-			103, 38, 172, 191, 210, 88, 137, 181, 184, 207, 67, 183, 201, 112,
-			255, 84, 165, 9, 98, 11, 56, 8, 122, 152, 121, 9, 249, 18,
-			108, 52, 113, 192, 236, 39, 129, 235, 48, 135, 239, 187, 62, 186,
-			191, 94, 215, 19, 85, 46, 116, 112, 60, 186, 223, 131, 160, 23,
-			178, 210, 78, 37, 42, 178, 18, 28, 96, 220, 166, 237, 169, 168,
-			31, 203, 91, 2, 177, 127, 197, 42, 124, 145, 31, 222, 48, 191,
-			36, 224, 168, 27, 248, 64, 25, 78, 63, 152, 157, 2, 124, 176,
-			249, 180, 3, 25, 92, 185, 126, 20, 219, 126, 147, 171, 221, 72,
-			126, 55, 103, 119, 131, 160, 127, 168, 12, 187, 77, 182, 102, 135,
-			139, 135, 130, 140, 34, 198, 24, 151, 101, 73, 75, 196, 78, 120,
-			159, 58, 98, 54, 218, 92, 132, 245, 73, 156, 104, 39, 95, 159,
-			223, 195, 222, 239, 225, 41, 13, 101, 129, 29, 197, 97, 140, 189,
-			247, 205, 15, 223, 75, 125, 122, 190, 158, 63, 149, 132, 78, 223,
-			217, 165, 213, 207, 160, 52, 77, 60, 15, 150, 57, 88, 159, 109,
-			205, 219, 103, 129, 46, 85, 140, 97, 125, 194, 71, 80, 235, 121,
-			17, 229, 66, 131, 102, 203, 97, 24, 132, 230, 60, 29, 110, 133,
-			221, 230, 35, 136, 53, 240, 123, 104, 182, 150, 135, 134, 245, 192,
-			225, 230, 75, 116, 56, 14, 109, 63, 114, 185, 47, 10, 237, 242,
-			181, 126, 131, 57, 65, 245, 78, 212, 194, 122, 141, 225, 26, 60,
-			46, 252, 51, 66, 79, 215, 120, 203, 141, 98, 30, 138, 122, 175,
-			154, 48, 202, 231, 148, 136, 156, 84, 201, 119, 164, 12, 80, 63,
-			166, 12, 208, 164, 134, 195, 163, 38, 214, 240, 141, 214, 240, 249,
-			152, 18, 192, 236, 49, 37, 128, 11, 223, 164, 51, 135, 89, 21,
-			235, 69, 22, 173, 144, 164, 104, 165, 64, 179, 168, 70, 100, 112,
-			228, 218, 76, 81, 168, 172, 56, 88, 212, 86, 19, 157, 204, 11,
-			52, 203, 65, 178, 200, 239, 200, 181, 83, 170, 55, 138, 187, 38,
-			222, 45, 124, 153, 78, 110, 5, 182, 243, 162, 50, 58, 92, 70,
-			163, 166, 173, 163, 74, 240, 121, 225, 247, 8, 53, 211, 56, 229,
-			100, 18, 230, 201, 139, 48, 175, 16, 107, 41, 121, 94, 165, 186,
-			221, 226, 114, 58, 115, 135, 143, 26, 69, 117, 44, 172, 65, 47,
-			243, 60, 29, 85, 5, 6, 143, 30, 243, 3, 169, 152, 17, 213,
-			246, 14, 63, 88, 248, 85, 66, 103, 26, 66, 21, 49, 255, 69,
-			37, 208, 183, 26, 253, 19, 234, 63, 141, 227, 148, 255, 31, 52,
-			58, 45, 11, 100, 126, 81, 14, 46, 210, 113, 47, 104, 61, 226,
-			126, 28, 30, 60, 194, 79, 10, 170, 212, 212, 11, 90, 101, 104,
-			93, 135, 198, 23, 228, 200, 156, 86, 102, 147, 69, 10, 2, 48,
-			95, 166, 84, 84, 88, 60, 234, 133, 222, 44, 197, 87, 195, 162,
-			101, 55, 244, 204, 115, 116, 68, 190, 142, 220, 15, 248, 236, 8,
-			34, 150, 35, 234, 238, 7, 28, 86, 55, 210, 196, 225, 211, 162,
-			222, 10, 27, 96, 244, 203, 148, 138, 151, 56, 248, 52, 14, 22,
-			221, 97, 236, 219, 70, 254, 236, 196, 185, 183, 141, 252, 185, 9,
-			86, 203, 195, 209, 13, 144, 212, 134, 241, 9, 70, 44, 252, 15,
-			141, 142, 174, 217, 113, 179, 173, 132, 87, 160, 122, 200, 191, 49,
-			75, 152, 190, 56, 114, 205, 82, 150, 150, 238, 82, 68, 193, 212,
-			160, 155, 245, 145, 70, 179, 8, 154, 155, 116, 60, 148, 75, 241,
-			145, 96, 94, 90, 235, 203, 10, 199, 177, 78, 101, 51, 83, 27,
-			11, 7, 94, 152, 111, 210, 17, 47, 176, 29, 133, 69, 147, 54,
-			155, 216, 252, 161, 37, 183, 153, 169, 81, 47, 105, 52, 223, 161,
-			19, 177, 50, 76, 133, 66, 152, 253, 89, 133, 226, 120, 195, 221,
-			204, 212, 198, 227, 193, 55, 102, 153, 142, 201, 242, 35, 133, 202,
-			64, 84, 47, 41, 84, 199, 217, 223, 102, 166, 118, 202, 78, 183,
-			175, 13, 209, 44, 38, 53, 22, 254, 161, 70, 79, 73, 81, 202,
-			165, 189, 76, 13, 216, 227, 165, 188, 231, 15, 201, 91, 116, 146,
-			2, 199, 142, 214, 159, 17, 37, 241, 105, 154, 21, 182, 40, 60,
-			190, 0, 204, 243, 84, 231, 97, 40, 165, 54, 232, 184, 54, 51,
-			53, 120, 103, 86, 142, 170, 234, 144, 132, 142, 119, 170, 199, 232,
-			234, 246, 160, 174, 132, 116, 172, 227, 116, 149, 160, 72, 41, 171,
-			47, 24, 78, 71, 183, 184, 29, 113, 101, 133, 243, 116, 184, 99,
-			63, 123, 132, 91, 165, 172, 240, 201, 119, 236, 103, 13, 128, 205,
-			215, 41, 245, 160, 243, 163, 216, 237, 240, 196, 62, 78, 244, 105,
-			195, 216, 185, 225, 118, 248, 194, 77, 122, 74, 146, 145, 226, 191,
-			76, 179, 138, 6, 200, 127, 234, 144, 94, 129, 94, 77, 244, 128,
-			177, 27, 88, 241, 164, 120, 124, 241, 177, 215, 254, 74, 167, 249,
-			186, 12, 1, 204, 42, 29, 27, 148, 175, 249, 252, 37, 98, 125,
-			130, 90, 204, 117, 74, 251, 146, 54, 79, 94, 41, 214, 115, 20,
-			3, 70, 113, 104, 93, 152, 159, 176, 96, 172, 153, 35, 50, 199,
-			248, 207, 44, 211, 83, 3, 235, 194, 124, 238, 114, 57, 17, 205,
-			23, 104, 22, 151, 129, 57, 125, 156, 23, 178, 78, 31, 187, 86,
-			204, 183, 232, 36, 170, 56, 165, 133, 168, 143, 33, 109, 100, 125,
-			12, 131, 54, 177, 78, 77, 161, 232, 1, 20, 73, 231, 1, 35,
-			56, 137, 249, 207, 180, 234, 245, 191, 223, 161, 67, 102, 214, 200,
-			252, 5, 249, 165, 45, 123, 29, 166, 154, 158, 49, 117, 154, 121,
-			128, 143, 196, 212, 71, 228, 163, 102, 234, 163, 153, 69, 124, 212,
-			77, 253, 84, 230, 85, 250, 231, 68, 148, 21, 76, 103, 230, 137,
-			245, 115, 194, 208, 183, 137, 143, 230, 114, 159, 151, 249, 111, 155,
-			181, 106, 59, 235, 12, 119, 97, 172, 231, 175, 54, 202, 55, 69,
-			41, 167, 248, 168, 30, 177, 54, 247, 186, 60, 100, 251, 61, 95,
-			230, 214, 251, 185, 162, 253, 158, 135, 185, 105, 113, 60, 180, 61,
-			239, 160, 200, 238, 219, 7, 123, 156, 50, 12, 159, 187, 158, 237,
-			203, 138, 88, 56, 22, 14, 244, 101, 93, 207, 110, 114, 22, 245,
-			154, 109, 252, 238, 177, 188, 12, 225, 248, 2, 115, 247, 225, 172,
-			135, 31, 161, 122, 93, 182, 199, 93, 191, 69, 37, 173, 212, 209,
-			104, 58, 127, 138, 22, 212, 215, 144, 25, 109, 198, 58, 135, 154,
-			197, 201, 160, 158, 146, 11, 16, 106, 106, 253, 175, 11, 51, 3,
-			95, 23, 102, 242, 147, 169, 175, 11, 51, 211, 167, 233, 29, 245,
-			113, 97, 86, 59, 109, 173, 178, 134, 58, 8, 224, 105, 52, 236,
-			225, 55, 202, 88, 22, 187, 218, 44, 57, 39, 28, 162, 68, 176,
-			212, 51, 151, 202, 139, 207, 14, 77, 164, 242, 226, 179, 83, 211,
-			180, 216, 191, 31, 48, 105, 157, 103, 37, 159, 5, 93, 249, 17,
-			204, 142, 162, 160, 233, 98, 181, 132, 186, 8, 48, 88, 215, 159,
-			46, 162, 181, 134, 71, 211, 117, 253, 227, 19, 244, 87, 168, 102,
-			16, 211, 56, 151, 41, 18, 171, 203, 142, 245, 153, 234, 238, 72,
-			196, 69, 6, 211, 246, 60, 30, 46, 69, 189, 110, 215, 115, 185,
-			35, 146, 216, 242, 11, 3, 61, 132, 97, 224, 162, 132, 58, 75,
-			171, 181, 42, 181, 4, 51, 62, 151, 127, 153, 46, 82, 195, 32,
-			160, 165, 243, 218, 105, 107, 254, 232, 93, 18, 105, 143, 114, 118,
-			4, 53, 116, 94, 206, 142, 160, 134, 206, 203, 250, 104, 130, 26,
-			58, 63, 53, 77, 47, 33, 78, 98, 234, 23, 180, 41, 203, 122,
-			206, 253, 20, 49, 12, 84, 113, 65, 42, 157, 160, 42, 46, 200,
-			171, 26, 4, 85, 113, 97, 210, 164, 183, 16, 165, 102, 234, 23,
-			181, 121, 75, 92, 53, 57, 114, 169, 70, 126, 32, 61, 254, 110,
-			13, 65, 189, 92, 76, 56, 7, 246, 46, 202, 187, 53, 4, 245,
-			114, 113, 206, 162, 239, 32, 25, 221, 212, 23, 181, 73, 235, 142,
-			188, 209, 18, 186, 182, 231, 126, 192, 157, 254, 245, 161, 13, 89,
-			179, 46, 242, 175, 130, 139, 147, 200, 234, 89, 192, 166, 102, 7,
-			126, 97, 49, 63, 170, 32, 160, 52, 62, 65, 127, 68, 144, 174,
-			97, 234, 75, 218, 188, 245, 143, 72, 234, 42, 74, 250, 202, 9,
-			134, 71, 88, 26, 125, 231, 54, 91, 17, 31, 151, 7, 95, 178,
-			167, 174, 231, 193, 234, 143, 120, 156, 36, 179, 69, 192, 147, 148,
-			45, 161, 117, 21, 68, 22, 72, 221, 172, 241, 185, 44, 8, 183,
-			125, 102, 59, 34, 85, 110, 123, 84, 97, 199, 145, 181, 157, 245,
-			100, 82, 70, 22, 88, 85, 147, 50, 136, 169, 47, 229, 149, 44,
-			13, 221, 212, 151, 230, 44, 122, 135, 106, 134, 102, 26, 171, 153,
-			55, 136, 117, 13, 103, 148, 164, 130, 84, 130, 74, 125, 33, 59,
-			100, 190, 130, 18, 88, 41, 104, 105, 53, 127, 150, 126, 129, 26,
-			134, 6, 86, 122, 93, 155, 176, 46, 33, 178, 180, 141, 31, 87,
-			120, 47, 121, 213, 208, 98, 175, 75, 189, 107, 104, 177, 215, 101,
-			181, 189, 134, 22, 123, 125, 108, 156, 222, 64, 252, 196, 212, 111,
-			104, 243, 214, 162, 20, 191, 29, 39, 87, 8, 164, 212, 184, 115,
-			28, 1, 146, 131, 113, 19, 10, 2, 44, 147, 51, 10, 210, 77,
-			253, 198, 156, 69, 175, 34, 1, 205, 212, 95, 215, 76, 235, 108,
-			223, 225, 3, 114, 116, 76, 137, 112, 18, 180, 90, 14, 122, 15,
-			41, 136, 152, 250, 235, 242, 203, 157, 134, 246, 250, 250, 196, 36,
-			221, 164, 32, 110, 227, 205, 204, 6, 177, 222, 100, 71, 194, 37,
-			6, 129, 170, 32, 210, 236, 133, 33, 120, 193, 100, 90, 246, 209,
-			251, 20, 96, 156, 111, 230, 231, 208, 39, 232, 32, 237, 59, 47,
-			226, 19, 116, 148, 240, 29, 41, 97, 29, 37, 124, 71, 250, 4,
-			29, 37, 124, 103, 106, 26, 53, 168, 195, 36, 222, 74, 52, 56,
-			136, 211, 142, 219, 135, 116, 154, 224, 7, 7, 241, 86, 130, 31,
-			4, 252, 150, 212, 160, 142, 2, 126, 107, 108, 156, 222, 68, 252,
-			154, 169, 175, 107, 19, 214, 18, 94, 31, 8, 123, 188, 32, 190,
-			136, 58, 252, 240, 77, 1, 39, 89, 193, 9, 21, 240, 15, 235,
-			114, 71, 208, 81, 222, 235, 67, 138, 10, 200, 123, 29, 169, 104,
-			134, 97, 26, 247, 50, 187, 68, 122, 161, 19, 109, 186, 175, 140,
-			148, 61, 195, 74, 185, 151, 183, 208, 222, 12, 144, 112, 229, 83,
-			219, 155, 161, 101, 114, 48, 110, 66, 65, 196, 212, 43, 210, 222,
-			12, 20, 119, 101, 206, 162, 191, 77, 144, 2, 49, 245, 251, 218,
-			164, 245, 109, 2, 2, 73, 144, 22, 228, 198, 146, 56, 182, 227,
-			5, 115, 172, 151, 165, 73, 216, 213, 239, 41, 188, 78, 255, 118,
-			198, 194, 64, 198, 108, 65, 126, 248, 118, 125, 182, 128, 147, 92,
-			72, 102, 2, 138, 189, 47, 77, 220, 64, 197, 222, 151, 190, 209,
-			64, 197, 222, 31, 159, 160, 151, 113, 34, 154, 169, 239, 104, 23,
-			172, 151, 196, 221, 145, 22, 63, 113, 189, 27, 184, 110, 118, 52,
-			75, 65, 196, 212, 119, 230, 207, 42, 72, 55, 245, 157, 243, 11,
-			244, 251, 66, 60, 186, 169, 55, 180, 57, 235, 31, 144, 207, 248,
-			74, 74, 130, 41, 241, 182, 191, 224, 149, 20, 3, 183, 143, 70,
-			34, 34, 88, 161, 141, 252, 180, 130, 128, 255, 51, 179, 52, 164,
-			224, 144, 141, 135, 153, 38, 177, 246, 217, 241, 71, 157, 79, 21,
-			78, 28, 66, 113, 82, 12, 145, 37, 166, 254, 48, 127, 22, 253,
-			69, 22, 172, 249, 107, 47, 226, 47, 178, 232, 47, 190, 38, 215,
-			115, 22, 13, 248, 107, 210, 95, 100, 209, 128, 191, 38, 253, 69,
-			22, 148, 247, 245, 79, 235, 47, 178, 104, 86, 95, 79, 240, 131,
-			89, 125, 93, 250, 139, 44, 154, 213, 215, 199, 198, 49, 70, 201,
-			130, 89, 61, 122, 129, 24, 37, 139, 206, 225, 145, 84, 67, 22,
-			141, 234, 145, 140, 81, 178, 104, 84, 143, 38, 77, 186, 130, 40,
-			117, 83, 223, 211, 230, 173, 11, 136, 242, 208, 238, 172, 74, 62,
-			210, 6, 155, 69, 21, 239, 37, 184, 65, 197, 123, 114, 51, 205,
-			162, 138, 247, 230, 44, 26, 80, 205, 200, 153, 217, 86, 230, 215,
-			8, 177, 246, 216, 113, 167, 208, 79, 161, 225, 193, 241, 244, 36,
-			253, 230, 136, 169, 183, 242, 47, 161, 126, 115, 160, 95, 247, 69,
-			244, 155, 67, 253, 186, 82, 254, 57, 212, 175, 43, 245, 155, 67,
-			253, 186, 83, 211, 244, 46, 226, 36, 166, 254, 88, 155, 176, 222,
-			120, 161, 29, 93, 156, 212, 158, 218, 209, 225, 203, 174, 57, 212,
-			248, 227, 132, 34, 104, 252, 177, 212, 120, 14, 53, 254, 120, 108,
-			156, 190, 137, 20, 53, 83, 239, 104, 47, 89, 203, 226, 150, 96,
-			82, 7, 7, 84, 184, 31, 135, 46, 143, 36, 25, 30, 242, 163,
-			116, 192, 12, 58, 82, 85, 57, 52, 131, 78, 254, 140, 130, 116,
-			83, 239, 88, 243, 120, 62, 201, 1, 16, 104, 243, 112, 62, 105,
-			115, 214, 118, 91, 109, 177, 29, 183, 6, 111, 24, 159, 60, 35,
-			48, 138, 32, 161, 4, 70, 17, 72, 163, 200, 161, 81, 4, 115,
-			22, 253, 111, 4, 73, 25, 166, 222, 211, 166, 172, 63, 69, 39,
-			239, 7, 177, 248, 114, 133, 62, 39, 228, 2, 187, 223, 119, 73,
-			201, 89, 18, 79, 145, 194, 53, 227, 177, 26, 15, 129, 178, 138,
-			80, 126, 59, 180, 125, 25, 156, 4, 77, 140, 31, 28, 182, 136,
-			39, 62, 172, 116, 226, 226, 187, 27, 214, 24, 198, 1, 67, 67,
-			59, 128, 33, 162, 250, 73, 76, 13, 175, 62, 183, 131, 167, 112,
-			88, 148, 225, 104, 90, 205, 135, 236, 48, 185, 115, 238, 5, 173,
-			40, 17, 5, 4, 155, 189, 68, 185, 176, 133, 246, 134, 199, 20,
-			164, 155, 122, 111, 210, 164, 95, 66, 73, 100, 77, 253, 153, 54,
-			39, 163, 77, 41, 82, 182, 91, 219, 58, 246, 38, 183, 218, 240,
-			236, 216, 78, 72, 101, 17, 131, 34, 5, 254, 237, 217, 240, 148,
-			130, 116, 83, 127, 54, 51, 139, 183, 63, 115, 176, 201, 124, 160,
-			205, 201, 219, 159, 145, 251, 193, 49, 59, 210, 9, 52, 114, 89,
-			24, 170, 52, 11, 107, 236, 131, 188, 162, 145, 211, 77, 253, 131,
-			153, 89, 105, 67, 67, 166, 254, 161, 54, 43, 109, 232, 147, 166,
-			35, 12, 106, 128, 210, 80, 22, 16, 168, 217, 12, 17, 83, 255,
-			80, 94, 231, 204, 105, 67, 186, 169, 127, 120, 250, 12, 125, 13,
-			41, 229, 77, 253, 91, 218, 172, 117, 229, 249, 179, 57, 134, 68,
-			62, 11, 35, 213, 100, 242, 196, 212, 191, 149, 87, 36, 242, 186,
-			169, 127, 235, 244, 25, 58, 2, 174, 132, 154, 198, 175, 18, 237,
-			2, 29, 5, 122, 52, 3, 208, 200, 105, 9, 17, 128, 206, 44,
-			136, 142, 195, 166, 241, 55, 137, 102, 138, 87, 195, 25, 128, 70,
-			78, 209, 83, 128, 113, 56, 67, 6, 65, 77, 130, 162, 47, 190,
-			28, 159, 148, 47, 201, 33, 80, 147, 224, 87, 169, 102, 12, 153,
-			185, 95, 39, 153, 239, 16, 98, 109, 177, 116, 70, 78, 164, 12,
-			246, 112, 79, 15, 246, 113, 49, 60, 113, 157, 94, 127, 35, 199,
-			53, 210, 177, 31, 139, 95, 64, 24, 52, 230, 34, 197, 9, 12,
-			17, 211, 248, 117, 146, 159, 198, 24, 111, 72, 207, 152, 185, 223,
-			32, 218, 183, 137, 110, 189, 138, 194, 109, 6, 158, 215, 207, 239,
-			32, 41, 238, 36, 232, 139, 20, 217, 29, 210, 97, 166, 191, 65,
-			232, 56, 157, 160, 57, 0, 243, 25, 51, 247, 155, 196, 248, 123,
-			36, 75, 199, 233, 144, 104, 33, 166, 241, 155, 208, 101, 76, 116,
-			209, 50, 166, 241, 183, 72, 110, 69, 117, 128, 56, 17, 26, 230,
-			251, 13, 4, 26, 94, 186, 218, 111, 208, 161, 161, 184, 156, 160,
-			32, 166, 241, 183, 73, 110, 49, 233, 64, 114, 216, 112, 166, 223,
-			128, 61, 102, 47, 244, 27, 116, 104, 184, 120, 41, 65, 161, 153,
-			198, 223, 33, 185, 107, 73, 7, 45, 135, 13, 47, 245, 27, 8,
-			52, 188, 188, 212, 111, 208, 161, 97, 101, 53, 65, 161, 155, 198,
-			223, 37, 185, 98, 210, 65, 207, 97, 131, 213, 111, 32, 208, 48,
-			127, 185, 223, 128, 67, 10, 75, 104, 9, 67, 32, 137, 191, 79,
-			180, 57, 33, 75, 45, 99, 32, 72, 21, 152, 3, 112, 100, 66,
-			129, 4, 192, 201, 105, 5, 234, 0, 158, 153, 197, 227, 107, 222,
-			204, 253, 22, 201, 252, 136, 16, 107, 133, 13, 228, 104, 133, 161,
-			244, 75, 27, 2, 102, 15, 24, 146, 180, 133, 60, 49, 141, 223,
-			34, 249, 211, 244, 103, 26, 53, 140, 60, 24, 195, 119, 137, 246,
-			123, 68, 183, 62, 210, 94, 204, 26, 88, 217, 110, 182, 113, 103,
-			58, 96, 205, 32, 20, 20, 157, 72, 153, 223, 128, 241, 138, 94,
-			170, 24, 77, 21, 121, 97, 25, 179, 195, 159, 33, 50, 236, 33,
-			170, 25, 68, 162, 208, 238, 118, 185, 29, 178, 160, 135, 49, 67,
-			16, 58, 60, 76, 48, 184, 97, 63, 100, 5, 12, 60, 42, 178,
-			122, 208, 225, 52, 153, 184, 196, 225, 69, 1, 132, 174, 88, 59,
-			230, 183, 10, 34, 1, 7, 241, 46, 86, 166, 113, 188, 128, 4,
-			225, 45, 236, 77, 178, 104, 14, 58, 116, 40, 115, 122, 40, 188,
-			102, 224, 71, 113, 104, 187, 126, 28, 177, 69, 94, 108, 21, 11,
-			232, 132, 10, 88, 242, 123, 89, 46, 138, 60, 46, 138, 239, 130,
-			197, 127, 137, 230, 0, 4, 61, 255, 14, 49, 166, 229, 110, 46,
-			220, 147, 220, 101, 6, 36, 211, 207, 54, 130, 0, 138, 20, 237,
-			6, 17, 100, 17, 195, 112, 191, 129, 64, 3, 29, 239, 55, 232,
-			208, 96, 78, 225, 66, 204, 139, 133, 248, 61, 98, 252, 83, 185,
-			16, 243, 114, 33, 126, 79, 45, 196, 188, 88, 69, 223, 39, 185,
-			233, 4, 7, 172, 162, 239, 147, 220, 72, 191, 1, 123, 140, 246,
-			169, 192, 42, 250, 62, 80, 81, 40, 52, 211, 248, 1, 201, 173,
-			38, 29, 96, 21, 253, 64, 173, 162, 188, 92, 69, 63, 32, 47,
-			23, 250, 13, 58, 52, 44, 175, 36, 40, 116, 211, 248, 93, 146,
-			187, 156, 116, 128, 85, 244, 187, 36, 55, 219, 111, 32, 208, 48,
-			247, 74, 191, 1, 135, 92, 90, 196, 85, 148, 7, 233, 254, 144,
-			104, 150, 16, 62, 174, 162, 31, 170, 85, 148, 199, 85, 244, 67,
-			181, 138, 242, 40, 183, 31, 146, 201, 211, 10, 212, 1, 156, 157,
-			163, 163, 84, 51, 134, 205, 220, 143, 73, 230, 159, 19, 130, 107,
-			2, 220, 244, 143, 9, 158, 97, 12, 99, 24, 136, 252, 132, 104,
-			51, 56, 110, 24, 245, 241, 19, 162, 13, 41, 144, 0, 152, 159,
-			84, 160, 14, 224, 244, 105, 57, 148, 152, 198, 71, 68, 187, 34,
-			95, 130, 144, 63, 82, 236, 14, 163, 136, 63, 34, 243, 175, 42,
-			80, 7, 112, 241, 50, 50, 68, 205, 220, 239, 147, 204, 191, 148,
-			12, 193, 246, 243, 251, 176, 72, 223, 165, 134, 65, 129, 161, 159,
-			18, 237, 188, 181, 137, 191, 183, 32, 234, 233, 241, 19, 24, 195,
-			143, 112, 142, 90, 122, 34, 190, 46, 50, 86, 245, 189, 3, 182,
-			35, 127, 99, 2, 14, 115, 21, 81, 133, 190, 239, 122, 30, 6,
-			116, 192, 1, 69, 249, 253, 84, 201, 143, 162, 252, 126, 74, 70,
-			102, 20, 72, 0, 60, 243, 146, 2, 117, 0, 207, 49, 100, 119,
-			196, 204, 253, 1, 201, 252, 27, 201, 238, 8, 49, 141, 63, 0,
-			118, 255, 6, 53, 140, 17, 96, 247, 99, 96, 119, 231, 8, 187,
-			125, 38, 217, 83, 219, 23, 91, 151, 56, 93, 62, 135, 105, 245,
-			235, 16, 146, 237, 17, 100, 251, 99, 197, 246, 8, 178, 253, 177,
-			98, 123, 4, 217, 254, 88, 177, 61, 130, 108, 127, 12, 108, 71,
-			84, 203, 101, 204, 220, 31, 146, 204, 95, 16, 98, 113, 166, 190,
-			28, 38, 39, 13, 244, 159, 91, 65, 107, 35, 104, 13, 4, 132,
-			73, 4, 136, 225, 35, 22, 105, 239, 219, 48, 16, 156, 18, 85,
-			35, 186, 97, 128, 63, 69, 132, 119, 93, 146, 31, 101, 26, 161,
-			122, 14, 24, 250, 67, 146, 159, 160, 59, 212, 200, 161, 131, 248,
-			25, 209, 238, 91, 107, 135, 83, 140, 248, 1, 133, 185, 14, 239,
-			116, 131, 88, 228, 197, 82, 63, 0, 165, 62, 17, 179, 160, 203,
-			101, 49, 19, 10, 32, 39, 28, 196, 207, 96, 113, 75, 80, 3,
-			240, 244, 21, 5, 234, 0, 222, 120, 135, 174, 35, 121, 98, 26,
-			127, 68, 180, 146, 117, 35, 157, 13, 122, 241, 156, 156, 192, 73,
-			16, 75, 110, 66, 129, 26, 128, 147, 11, 10, 212, 1, 92, 250,
-			18, 221, 67, 138, 154, 105, 252, 49, 209, 182, 173, 198, 145, 51,
-			252, 145, 25, 39, 115, 195, 26, 62, 252, 245, 164, 212, 233, 52,
-			149, 119, 166, 201, 6, 34, 72, 194, 180, 254, 152, 228, 78, 43,
-			16, 105, 206, 20, 20, 168, 3, 248, 218, 22, 253, 23, 4, 57,
-			210, 77, 227, 231, 68, 123, 219, 250, 199, 100, 48, 218, 255, 4,
-			134, 66, 222, 12, 66, 103, 64, 34, 151, 162, 228, 20, 67, 89,
-			215, 14, 237, 14, 143, 121, 24, 169, 219, 171, 88, 28, 45, 47,
-			56, 9, 74, 110, 20, 39, 6, 215, 235, 6, 62, 139, 122, 248,
-			19, 86, 251, 61, 245, 123, 76, 52, 193, 152, 204, 14, 188, 226,
-			207, 73, 110, 74, 129, 26, 128, 211, 139, 10, 196, 233, 92, 223,
-			164, 127, 36, 102, 103, 152, 198, 159, 16, 237, 154, 245, 83, 34,
-			118, 28, 97, 216, 17, 199, 131, 100, 176, 223, 143, 27, 163, 222,
-			158, 172, 208, 119, 125, 177, 209, 35, 223, 170, 106, 209, 166, 135,
-			2, 140, 102, 224, 199, 182, 40, 208, 76, 110, 137, 244, 143, 171,
-			242, 168, 42, 239, 6, 240, 126, 140, 128, 154, 162, 169, 80, 65,
-			254, 134, 150, 27, 71, 131, 155, 121, 50, 95, 131, 192, 20, 114,
-			35, 10, 212, 0, 28, 157, 85, 160, 14, 224, 133, 21, 250, 61,
-			49, 223, 172, 105, 252, 103, 162, 221, 177, 190, 67, 250, 63, 71,
-			131, 223, 158, 229, 215, 75, 59, 138, 226, 118, 24, 244, 90, 109,
-			12, 138, 186, 93, 238, 183, 92, 159, 95, 138, 250, 63, 74, 35,
-			190, 85, 23, 233, 115, 126, 56, 230, 105, 155, 251, 201, 221, 138,
-			166, 23, 244, 156, 244, 181, 10, 116, 111, 203, 248, 187, 100, 34,
-			108, 89, 227, 120, 34, 17, 28, 103, 9, 176, 152, 59, 163, 64,
-			13, 192, 217, 87, 20, 168, 3, 184, 252, 38, 253, 137, 152, 79,
-			206, 52, 254, 156, 104, 155, 214, 119, 83, 243, 17, 159, 199, 239,
-			247, 188, 216, 253, 20, 179, 74, 141, 250, 127, 53, 181, 28, 1,
-			110, 115, 74, 55, 57, 13, 192, 185, 139, 10, 212, 1, 92, 189,
-			171, 106, 82, 255, 111, 0, 0, 0, 255, 255, 236, 20, 141, 51,
-			47, 81, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 220, 123, 107, 140, 100, 71,
+			117, 112, 87, 213, 237, 59, 221, 53, 175, 158, 154, 199, 246, 220,
+			217, 71, 237, 44, 222, 157, 181, 103, 123, 246, 193, 226, 7, 102,
+			177, 119, 118, 63, 239, 218, 187, 246, 210, 158, 53, 15, 203, 223,
+			112, 167, 187, 166, 251, 122, 111, 223, 219, 190, 247, 246, 204, 142,
+			99, 89, 36, 63, 16, 66, 73, 80, 20, 164, 40, 9, 33, 128,
+			68, 176, 121, 5, 36, 80, 126, 132, 16, 8, 144, 24, 1, 145,
+			5, 33, 206, 15, 68, 196, 15, 126, 160, 72, 72, 17, 82, 64,
+			34, 82, 162, 115, 110, 213, 237, 199, 204, 206, 142, 205, 230, 15,
+			191, 102, 78, 221, 170, 115, 78, 157, 119, 157, 170, 230, 175, 142,
+			243, 171, 141, 176, 82, 107, 70, 97, 203, 235, 180, 42, 97, 212,
+			88, 242, 59, 53, 111, 201, 15, 27, 245, 176, 177, 228, 182, 189,
+			37, 21, 212, 219, 161, 23, 36, 241, 82, 45, 12, 163, 186, 23,
+			184, 73, 24, 45, 197, 42, 218, 240, 106, 42, 94, 218, 56, 181,
+			20, 39, 110, 162, 42, 237, 40, 76, 66, 97, 167, 43, 231, 255,
+			139, 240, 177, 43, 97, 227, 201, 36, 82, 110, 235, 73, 152, 32,
+			142, 240, 81, 156, 179, 186, 161, 162, 216, 11, 131, 50, 145, 100,
+			161, 88, 29, 193, 193, 167, 210, 49, 49, 195, 237, 88, 213, 34,
+			149, 148, 169, 36, 11, 35, 85, 13, 137, 187, 248, 88, 162, 162,
+			150, 23, 184, 254, 170, 23, 212, 213, 205, 50, 147, 100, 129, 85,
+			71, 205, 232, 101, 24, 20, 14, 47, 184, 81, 173, 233, 109, 168,
+			122, 217, 146, 100, 161, 80, 205, 96, 64, 221, 238, 68, 13, 85,
+			47, 231, 241, 139, 134, 0, 181, 23, 52, 84, 156, 168, 250, 234,
+			218, 86, 162, 226, 178, 157, 162, 54, 163, 231, 97, 16, 166, 181,
+			35, 181, 238, 221, 92, 141, 113, 83, 113, 121, 40, 157, 150, 142,
+			166, 59, 141, 207, 63, 250, 158, 75, 191, 177, 68, 223, 154, 10,
+			241, 209, 151, 71, 184, 45, 44, 43, 119, 55, 225, 95, 38, 156,
+			140, 8, 102, 229, 196, 233, 79, 19, 185, 28, 182, 183, 34, 175,
+			209, 76, 228, 233, 147, 167, 222, 34, 87, 154, 74, 94, 185, 190,
+			124, 89, 62, 220, 73, 154, 97, 20, 87, 228, 195, 190, 47, 113,
+			66, 44, 35, 5, 152, 85, 189, 194, 229, 245, 88, 201, 112, 93,
+			38, 77, 47, 150, 113, 216, 137, 106, 74, 214, 194, 186, 146, 94,
+			44, 27, 225, 134, 138, 2, 85, 151, 157, 160, 174, 34, 153, 52,
+			149, 124, 184, 237, 214, 0, 177, 87, 83, 65, 172, 22, 165, 86,
+			145, 60, 93, 57, 201, 101, 210, 116, 19, 89, 115, 3, 185, 166,
+			228, 122, 216, 9, 234, 210, 11, 112, 213, 149, 203, 203, 23, 31,
+			127, 242, 162, 92, 247, 124, 85, 225, 188, 192, 9, 21, 204, 206,
+			141, 195, 127, 5, 193, 10, 185, 85, 94, 228, 180, 48, 156, 254,
+			123, 157, 83, 43, 39, 172, 145, 220, 221, 196, 185, 44, 251, 13,
+			6, 216, 2, 140, 126, 216, 144, 169, 200, 37, 90, 154, 220, 112,
+			253, 14, 112, 222, 106, 117, 2, 175, 230, 38, 170, 46, 147, 144,
+			75, 35, 192, 10, 231, 156, 51, 43, 71, 4, 27, 41, 204, 240,
+			11, 220, 178, 114, 52, 39, 216, 24, 157, 115, 238, 149, 215, 122,
+			172, 205, 80, 64, 11, 92, 235, 172, 75, 109, 153, 114, 61, 140,
+			180, 148, 144, 110, 133, 243, 17, 158, 7, 44, 121, 64, 83, 48,
+			16, 17, 108, 172, 56, 99, 32, 38, 216, 216, 172, 195, 255, 130,
+			34, 65, 34, 216, 12, 157, 116, 62, 68, 229, 74, 223, 30, 142,
+			197, 50, 53, 233, 10, 231, 242, 241, 48, 81, 169, 40, 129, 141,
+			116, 28, 152, 138, 84, 210, 1, 109, 28, 150, 43, 160, 43, 47,
+			150, 225, 13, 119, 107, 81, 198, 94, 80, 131, 5, 94, 44, 141,
+			17, 193, 244, 48, 240, 183, 184, 116, 107, 53, 21, 199, 222, 154,
+			175, 100, 18, 202, 36, 234, 128, 237, 118, 165, 34, 87, 186, 36,
+			180, 226, 106, 160, 224, 186, 92, 219, 202, 102, 161, 32, 55, 92,
+			223, 171, 131, 160, 181, 212, 189, 96, 61, 140, 90, 110, 2, 146,
+			217, 244, 146, 102, 216, 73, 100, 160, 84, 221, 11, 26, 64, 169,
+			141, 127, 155, 74, 46, 119, 173, 89, 122, 1, 151, 107, 42, 217,
+			84, 42, 144, 202, 173, 53, 101, 167, 13, 40, 51, 73, 146, 60,
+			200, 103, 200, 64, 32, 173, 194, 152, 129, 152, 96, 51, 19, 130,
+			111, 160, 32, 169, 96, 14, 157, 115, 188, 148, 127, 195, 81, 93,
+			221, 76, 77, 121, 64, 182, 38, 28, 200, 150, 138, 99, 183, 161,
+			42, 242, 114, 58, 43, 53, 26, 47, 150, 39, 78, 45, 242, 108,
+			29, 170, 216, 243, 125, 141, 192, 11, 26, 25, 135, 52, 15, 132,
+			13, 135, 148, 8, 230, 20, 140, 174, 41, 19, 204, 153, 117, 248,
+			25, 228, 144, 9, 118, 128, 78, 57, 71, 229, 229, 65, 134, 100,
+			211, 141, 229, 26, 200, 192, 68, 161, 12, 61, 203, 195, 42, 219,
+			64, 68, 176, 3, 67, 227, 6, 2, 140, 98, 146, 159, 66, 244,
+			150, 96, 135, 168, 112, 222, 180, 27, 250, 52, 148, 101, 200, 173,
+			60, 172, 49, 200, 45, 34, 216, 161, 161, 81, 3, 49, 193, 14,
+			149, 38, 248, 38, 34, 207, 11, 118, 132, 206, 57, 207, 162, 116,
+			131, 78, 107, 77, 69, 32, 87, 140, 131, 240, 15, 16, 83, 65,
+			18, 109, 201, 186, 155, 184, 50, 78, 194, 72, 213, 181, 127, 244,
+			178, 178, 40, 221, 152, 203, 72, 181, 195, 8, 172, 46, 105, 70,
+			97, 167, 209, 148, 215, 81, 235, 105, 108, 188, 142, 250, 48, 44,
+			230, 145, 178, 17, 111, 158, 8, 118, 36, 19, 111, 158, 9, 118,
+			100, 214, 225, 255, 67, 144, 71, 91, 176, 227, 116, 206, 249, 79,
+			50, 192, 100, 151, 58, 184, 177, 155, 200, 77, 21, 41, 25, 169,
+			134, 23, 39, 42, 234, 139, 101, 221, 169, 199, 98, 46, 211, 136,
+			45, 55, 155, 42, 144, 94, 34, 55, 221, 184, 103, 213, 162, 244,
+			130, 154, 223, 65, 235, 246, 146, 88, 249, 235, 224, 169, 203, 97,
+			39, 72, 96, 40, 78, 194, 54, 120, 28, 250, 161, 210, 168, 142,
+			153, 72, 17, 175, 182, 85, 180, 170, 241, 63, 215, 9, 19, 23,
+			252, 83, 221, 172, 41, 85, 7, 212, 110, 80, 231, 48, 242, 188,
+			138, 66, 233, 173, 155, 232, 243, 172, 170, 37, 168, 204, 32, 148,
+			113, 167, 214, 76, 151, 102, 234, 180, 243, 32, 1, 35, 43, 155,
+			8, 118, 60, 147, 149, 205, 4, 59, 62, 235, 172, 217, 24, 196,
+			206, 240, 127, 41, 222, 137, 148, 158, 184, 241, 141, 120, 32, 165,
+			175, 243, 225, 135, 83, 43, 94, 113, 227, 27, 162, 204, 135, 52,
+			231, 58, 145, 27, 80, 140, 113, 234, 213, 49, 127, 23, 171, 212,
+			171, 139, 57, 94, 4, 124, 171, 129, 219, 82, 152, 52, 139, 213,
+			2, 12, 60, 238, 182, 148, 40, 113, 118, 67, 109, 97, 54, 31,
+			169, 194, 191, 119, 52, 131, 126, 117, 40, 205, 160, 179, 191, 181,
+			25, 180, 110, 50, 232, 44, 113, 222, 37, 123, 20, 4, 60, 185,
+			18, 228, 44, 159, 235, 168, 142, 74, 255, 173, 171, 184, 22, 121,
+			237, 164, 155, 229, 148, 142, 77, 174, 15, 59, 115, 33, 199, 52,
+			124, 197, 123, 92, 166, 47, 161, 78, 246, 38, 212, 105, 231, 94,
+			20, 29, 104, 214, 132, 99, 109, 5, 38, 173, 101, 73, 20, 248,
+			89, 195, 74, 33, 9, 119, 77, 168, 37, 3, 65, 66, 157, 156,
+			226, 247, 153, 124, 90, 162, 37, 231, 30, 164, 215, 116, 227, 166,
+			188, 124, 97, 123, 6, 128, 124, 164, 67, 109, 70, 3, 82, 77,
+			41, 163, 65, 136, 96, 165, 226, 176, 129, 152, 96, 165, 177, 113,
+			222, 50, 169, 102, 146, 238, 115, 222, 139, 52, 192, 200, 223, 129,
+			130, 123, 60, 219, 29, 212, 11, 110, 124, 99, 81, 118, 98, 149,
+			233, 11, 228, 154, 74, 184, 237, 181, 149, 239, 5, 170, 194, 179,
+			188, 29, 169, 231, 58, 158, 9, 153, 15, 47, 63, 54, 152, 97,
+			38, 51, 198, 96, 135, 147, 69, 97, 32, 38, 216, 228, 244, 12,
+			255, 1, 49, 41, 166, 76, 75, 206, 183, 136, 92, 121, 226, 194,
+			19, 11, 77, 47, 8, 111, 184, 199, 31, 144, 85, 213, 10, 55,
+			116, 69, 224, 174, 39, 42, 146, 181, 104, 173, 211, 168, 212, 194,
+			214, 210, 253, 167, 207, 156, 61, 123, 47, 176, 210, 163, 226, 27,
+			106, 107, 187, 212, 116, 146, 244, 98, 252, 92, 15, 85, 28, 28,
+			75, 100, 203, 77, 106, 77, 220, 33, 140, 66, 66, 31, 144, 53,
+			86, 99, 139, 56, 3, 182, 169, 226, 4, 116, 28, 119, 218, 42,
+			90, 247, 59, 97, 39, 150, 110, 80, 151, 113, 51, 236, 248, 117,
+			176, 242, 186, 242, 85, 50, 144, 2, 203, 89, 88, 99, 68, 176,
+			114, 193, 40, 134, 193, 142, 199, 198, 179, 176, 246, 11, 194, 15,
+			54, 194, 176, 225, 171, 37, 83, 173, 45, 213, 59, 17, 22, 37,
+			58, 78, 141, 167, 223, 43, 230, 251, 252, 3, 188, 112, 65, 79,
+			129, 104, 21, 171, 90, 24, 212, 99, 140, 86, 172, 106, 64, 49,
+			197, 243, 129, 27, 132, 49, 6, 172, 124, 53, 5, 206, 191, 192,
+			39, 107, 97, 171, 50, 128, 242, 252, 168, 65, 136, 101, 228, 53,
+			242, 158, 187, 27, 94, 210, 236, 172, 161, 192, 27, 161, 239, 6,
+			141, 46, 127, 237, 100, 171, 173, 226, 140, 205, 95, 17, 242, 9,
+			202, 30, 185, 118, 254, 211, 244, 224, 35, 41, 222, 107, 26, 111,
+			229, 157, 202, 247, 31, 11, 194, 205, 96, 5, 150, 100, 187, 254,
+			27, 194, 231, 6, 119, 173, 90, 237, 100, 235, 86, 91, 30, 226,
+			249, 139, 240, 253, 252, 198, 206, 252, 115, 252, 106, 152, 63, 118,
+			123, 230, 145, 218, 27, 224, 252, 203, 235, 252, 137, 223, 60, 13,
+			233, 255, 251, 19, 145, 115, 103, 143, 172, 206, 157, 77, 151, 206,
+			109, 204, 212, 217, 77, 161, 243, 43, 60, 127, 49, 138, 194, 8,
+			114, 102, 35, 106, 215, 86, 33, 187, 160, 197, 230, 171, 5, 24,
+			88, 14, 235, 74, 236, 231, 197, 36, 114, 131, 216, 83, 65, 122,
+			78, 46, 84, 187, 3, 144, 81, 91, 113, 3, 51, 106, 177, 202,
+			90, 113, 99, 254, 101, 194, 167, 171, 186, 192, 73, 107, 177, 106,
+			234, 175, 187, 36, 241, 91, 29, 196, 183, 157, 226, 217, 14, 167,
+			120, 193, 45, 72, 52, 120, 4, 31, 169, 226, 255, 59, 156, 224,
+			243, 59, 156, 224, 231, 127, 135, 207, 12, 178, 26, 183, 195, 32,
+			86, 186, 172, 32, 89, 89, 177, 200, 243, 24, 129, 80, 2, 195,
+			167, 103, 42, 169, 5, 84, 250, 79, 145, 213, 116, 146, 56, 194,
+			243, 10, 36, 139, 114, 25, 62, 61, 106, 102, 163, 184, 171, 233,
+			183, 249, 119, 240, 137, 43, 161, 91, 223, 171, 140, 6, 11, 29,
+			179, 109, 32, 81, 72, 183, 61, 255, 87, 132, 139, 94, 156, 122,
+			51, 25, 243, 100, 47, 204, 27, 196, 180, 71, 158, 247, 112, 230,
+			54, 148, 222, 206, 236, 160, 167, 87, 76, 160, 170, 194, 44, 113,
+			152, 143, 152, 20, 176, 10, 229, 86, 170, 152, 97, 51, 246, 152,
+			218, 154, 255, 21, 225, 51, 43, 169, 42, 18, 245, 70, 37, 208,
+			181, 26, 118, 155, 246, 141, 181, 83, 251, 230, 65, 110, 37, 110,
+			35, 46, 231, 37, 91, 24, 62, 189, 96, 132, 178, 51, 91, 149,
+			21, 183, 17, 95, 132, 179, 73, 21, 87, 57, 247, 242, 98, 54,
+			100, 234, 74, 16, 111, 17, 235, 74, 8, 244, 120, 8, 212, 236,
+			166, 192, 3, 244, 62, 50, 31, 241, 242, 182, 131, 202, 157, 219,
+			251, 20, 207, 227, 113, 74, 111, 57, 5, 230, 107, 124, 118, 7,
+			154, 218, 58, 14, 243, 145, 52, 199, 234, 134, 20, 193, 134, 212,
+			112, 58, 150, 182, 163, 82, 63, 4, 190, 244, 28, 138, 115, 70,
+			244, 32, 78, 154, 255, 7, 202, 167, 116, 73, 248, 70, 53, 122,
+			148, 143, 251, 97, 99, 21, 207, 128, 171, 53, 56, 5, 153, 206,
+			155, 31, 54, 80, 212, 120, 52, 218, 171, 134, 167, 140, 27, 130,
+			243, 23, 181, 223, 137, 3, 156, 235, 253, 118, 34, 191, 204, 241,
+			83, 49, 29, 185, 30, 249, 226, 16, 215, 91, 95, 141, 189, 231,
+			85, 121, 24, 17, 235, 21, 79, 122, 207, 43, 136, 150, 104, 85,
+			184, 124, 10, 151, 23, 112, 0, 86, 31, 224, 28, 255, 79, 23,
+			79, 227, 226, 34, 142, 192, 218, 71, 173, 194, 193, 210, 161, 71,
+			173, 194, 161, 146, 172, 22, 224, 144, 11, 72, 170, 69, 252, 15,
+			86, 204, 255, 61, 227, 35, 231, 161, 22, 50, 194, 91, 228, 44,
+			82, 207, 149, 9, 26, 169, 99, 140, 180, 119, 74, 5, 5, 83,
+			133, 105, 206, 251, 24, 207, 35, 40, 46, 241, 113, 115, 204, 212,
+			61, 68, 20, 254, 240, 233, 3, 6, 199, 96, 228, 67, 122, 151,
+			114, 213, 49, 179, 46, 253, 32, 30, 228, 195, 126, 232, 214, 13,
+			22, 138, 88, 102, 13, 150, 109, 33, 236, 82, 174, 202, 253, 108,
+			80, 60, 198, 75, 90, 41, 137, 50, 40, 24, 162, 56, 184, 187,
+			199, 93, 202, 85, 199, 179, 149, 26, 217, 69, 62, 166, 11, 110,
+			131, 202, 66, 84, 251, 13, 170, 157, 236, 239, 82, 174, 58, 170,
+			87, 105, 52, 85, 62, 153, 118, 136, 52, 150, 213, 14, 116, 11,
+			176, 83, 59, 124, 90, 26, 92, 183, 242, 210, 75, 185, 234, 68,
+			103, 240, 219, 249, 33, 237, 240, 243, 255, 65, 249, 168, 86, 143,
+			118, 176, 37, 110, 69, 42, 110, 107, 29, 206, 13, 232, 48, 157,
+			164, 149, 136, 19, 157, 151, 168, 209, 226, 20, 207, 163, 241, 232,
+			172, 156, 2, 226, 48, 103, 42, 138, 202, 116, 135, 228, 114, 41,
+			87, 133, 111, 226, 242, 118, 245, 15, 72, 125, 80, 253, 41, 31,
+			59, 232, 255, 109, 253, 250, 79, 37, 238, 236, 164, 255, 12, 69,
+			175, 1, 60, 185, 155, 176, 15, 239, 34, 236, 12, 219, 110, 210,
+			86, 124, 228, 138, 114, 99, 163, 27, 112, 206, 150, 123, 115, 21,
+			107, 36, 93, 124, 23, 90, 238, 77, 56, 95, 197, 226, 62, 206,
+			125, 152, 188, 154, 120, 45, 85, 166, 183, 75, 102, 69, 156, 188,
+			226, 181, 212, 252, 3, 124, 84, 147, 209, 58, 61, 206, 243, 134,
+			6, 56, 230, 228, 128, 1, 2, 189, 106, 58, 3, 214, 94, 192,
+			195, 136, 225, 113, 239, 107, 79, 127, 219, 226, 133, 39, 117, 237,
+			39, 158, 224, 99, 253, 74, 19, 187, 251, 178, 115, 27, 93, 139,
+			101, 206, 187, 234, 19, 183, 118, 105, 103, 23, 109, 131, 165, 13,
+			56, 176, 184, 141, 103, 59, 51, 219, 100, 142, 39, 5, 241, 46,
+			62, 177, 205, 14, 196, 109, 253, 209, 185, 189, 17, 137, 139, 124,
+			84, 75, 87, 179, 184, 107, 196, 184, 37, 131, 111, 230, 121, 244,
+			90, 49, 53, 224, 196, 233, 178, 233, 129, 81, 77, 252, 33, 62,
+			129, 198, 163, 73, 129, 109, 196, 93, 12, 189, 230, 235, 76, 15,
+			140, 106, 12, 203, 92, 164, 38, 212, 135, 34, 155, 220, 103, 94,
+			183, 98, 254, 142, 182, 186, 254, 246, 49, 62, 36, 242, 86, 238,
+			191, 201, 111, 109, 175, 171, 200, 41, 203, 9, 198, 115, 239, 132,
+			59, 36, 70, 4, 27, 214, 255, 82, 193, 70, 114, 11, 248, 47,
+			19, 108, 52, 119, 23, 255, 87, 146, 54, 198, 166, 114, 115, 196,
+			249, 54, 145, 24, 138, 129, 119, 215, 116, 168, 160, 5, 134, 29,
+			175, 70, 245, 218, 178, 196, 66, 4, 58, 189, 143, 63, 177, 114,
+			241, 129, 180, 127, 3, 237, 11, 47, 137, 101, 83, 249, 109, 21,
+			201, 245, 78, 128, 171, 98, 217, 114, 183, 96, 43, 157, 88, 173,
+			119, 124, 217, 10, 35, 37, 27, 42, 80, 145, 235, 251, 91, 21,
+			121, 213, 221, 90, 83, 92, 226, 137, 172, 237, 187, 129, 110, 131,
+			65, 107, 170, 111, 174, 108, 251, 110, 77, 165, 13, 95, 55, 150,
+			243, 75, 75, 112, 194, 155, 135, 190, 176, 151, 192, 5, 79, 44,
+			59, 109, 185, 166, 188, 160, 193, 53, 173, 158, 86, 220, 84, 97,
+			148, 47, 154, 86, 220, 12, 157, 113, 14, 161, 102, 113, 51, 168,
+			167, 236, 18, 203, 108, 77, 183, 213, 250, 110, 94, 114, 120, 243,
+			50, 97, 32, 184, 121, 153, 154, 230, 231, 76, 203, 173, 76, 167,
+			157, 83, 114, 197, 156, 45, 65, 126, 73, 212, 81, 192, 98, 162,
+			59, 92, 174, 204, 142, 158, 153, 16, 117, 115, 13, 251, 59, 230,
+			22, 2, 26, 111, 229, 33, 211, 220, 35, 208, 223, 153, 156, 226,
+			149, 238, 29, 207, 132, 115, 88, 62, 28, 200, 16, 155, 147, 174,
+			47, 221, 56, 14, 107, 30, 94, 239, 153, 203, 28, 131, 25, 58,
+			103, 78, 95, 231, 204, 41, 142, 24, 8, 238, 102, 198, 75, 252,
+			5, 78, 45, 34, 172, 67, 185, 10, 113, 218, 114, 199, 104, 108,
+			238, 255, 98, 149, 128, 165, 215, 92, 223, 87, 209, 137, 184, 211,
+			110, 251, 158, 170, 75, 40, 1, 77, 147, 148, 15, 96, 232, 187,
+			236, 210, 222, 152, 221, 201, 105, 45, 193, 142, 15, 21, 14, 240,
+			5, 110, 89, 4, 180, 116, 152, 78, 59, 115, 219, 239, 3, 181,
+			61, 234, 221, 17, 108, 138, 30, 214, 187, 35, 216, 20, 61, 92,
+			44, 25, 136, 9, 118, 120, 114, 138, 31, 67, 156, 112, 107, 66,
+			39, 29, 103, 151, 59, 198, 116, 25, 233, 185, 109, 33, 120, 221,
+			118, 68, 95, 183, 17, 236, 129, 30, 153, 16, 252, 173, 136, 146,
+			10, 118, 148, 206, 57, 21, 68, 185, 237, 98, 52, 78, 34, 184,
+			4, 217, 249, 126, 148, 160, 94, 142, 102, 156, 131, 1, 29, 213,
+			247, 163, 4, 245, 114, 116, 214, 225, 143, 33, 25, 38, 216, 2,
+			157, 112, 206, 233, 91, 201, 200, 115, 125, 239, 121, 85, 239, 94,
+			1, 95, 208, 141, 234, 48, 234, 94, 207, 222, 138, 44, 220, 165,
+			45, 100, 187, 131, 184, 176, 80, 24, 49, 16, 80, 26, 47, 241,
+			151, 160, 145, 74, 168, 37, 216, 9, 58, 231, 252, 25, 233, 185,
+			78, 236, 189, 54, 196, 106, 14, 251, 161, 231, 222, 38, 79, 166,
+			205, 205, 254, 143, 114, 19, 238, 11, 215, 208, 106, 184, 137, 99,
+			105, 125, 150, 158, 184, 77, 51, 116, 81, 186, 27, 161, 87, 55,
+			183, 163, 112, 99, 138, 214, 228, 6, 210, 173, 215, 61, 152, 234,
+			250, 220, 96, 7, 80, 86, 175, 45, 103, 155, 130, 59, 188, 19,
+			217, 166, 224, 14, 239, 132, 190, 244, 33, 120, 135, 119, 98, 214,
+			225, 231, 56, 181, 168, 176, 78, 229, 238, 39, 206, 105, 220, 17,
+			20, 171, 144, 159, 204, 253, 167, 150, 153, 26, 52, 223, 148, 18,
+			220, 147, 131, 150, 78, 21, 14, 242, 55, 115, 203, 162, 96, 165,
+			103, 104, 201, 57, 38, 87, 6, 46, 116, 119, 234, 182, 107, 94,
+			41, 90, 236, 25, 173, 119, 138, 22, 123, 70, 183, 216, 41, 198,
+			148, 51, 99, 227, 252, 44, 226, 39, 130, 157, 165, 115, 206, 130,
+			22, 63, 220, 50, 135, 235, 189, 45, 100, 85, 223, 137, 0, 177,
+			97, 93, 201, 64, 128, 101, 98, 198, 64, 76, 176, 179, 179, 14,
+			191, 7, 9, 80, 193, 238, 163, 194, 57, 216, 13, 248, 192, 51,
+			6, 166, 76, 56, 25, 90, 106, 195, 236, 33, 3, 17, 193, 238,
+			43, 140, 26, 136, 9, 118, 95, 105, 130, 95, 226, 32, 110, 235,
+			193, 220, 5, 226, 60, 40, 183, 21, 98, 18, 234, 234, 148, 72,
+			173, 19, 69, 42, 72, 186, 219, 114, 251, 183, 2, 210, 6, 227,
+			124, 176, 48, 139, 49, 129, 129, 180, 207, 237, 37, 38, 48, 148,
+			240, 57, 45, 97, 134, 18, 62, 167, 99, 2, 67, 9, 159, 155,
+			156, 66, 13, 50, 80, 231, 67, 153, 6, 251, 113, 186, 73, 115,
+			64, 167, 25, 126, 8, 16, 15, 101, 248, 33, 64, 60, 164, 53,
+			200, 48, 64, 60, 52, 54, 206, 31, 64, 252, 84, 176, 101, 90,
+			114, 78, 128, 143, 64, 46, 48, 247, 165, 106, 240, 122, 192, 92,
+			53, 101, 25, 129, 97, 124, 88, 166, 182, 129, 136, 96, 203, 67,
+			134, 10, 196, 135, 101, 164, 66, 45, 75, 88, 143, 228, 174, 19,
+			167, 178, 187, 77, 119, 149, 209, 99, 207, 224, 41, 143, 20, 28,
+			180, 55, 11, 36, 124, 249, 117, 219, 155, 69, 115, 54, 172, 43,
+			25, 136, 8, 118, 89, 219, 155, 133, 226, 190, 12, 15, 61, 32,
+			162, 88, 32, 239, 171, 116, 194, 249, 16, 1, 129, 100, 72, 23,
+			101, 210, 31, 216, 118, 22, 204, 142, 81, 150, 103, 101, 87, 119,
+			102, 26, 117, 186, 87, 50, 243, 125, 77, 216, 121, 185, 238, 41,
+			31, 171, 170, 121, 180, 190, 249, 108, 39, 160, 216, 171, 218, 196,
+			45, 140, 252, 87, 117, 108, 180, 80, 177, 87, 199, 75, 252, 56,
+			110, 132, 10, 118, 141, 30, 113, 246, 35, 79, 110, 35, 19, 212,
+			14, 226, 1, 191, 185, 70, 29, 3, 17, 193, 174, 205, 29, 52,
+			16, 19, 236, 218, 225, 121, 254, 241, 84, 60, 76, 176, 21, 58,
+			235, 252, 49, 185, 195, 247, 80, 25, 38, 45, 242, 55, 124, 15,
+			101, 225, 61, 212, 74, 38, 34, 240, 208, 149, 194, 148, 129, 128,
+			255, 125, 101, 30, 113, 106, 229, 133, 245, 238, 220, 179, 196, 89,
+			151, 59, 31, 162, 94, 87, 57, 49, 128, 226, 86, 53, 4, 60,
+			140, 120, 119, 225, 32, 198, 139, 60, 88, 243, 211, 123, 137, 23,
+			121, 140, 23, 79, 107, 127, 206, 99, 188, 120, 90, 199, 139, 60,
+			26, 240, 211, 58, 94, 228, 193, 126, 159, 121, 189, 241, 34, 143,
+			102, 245, 76, 134, 31, 204, 234, 25, 29, 47, 242, 104, 86, 207,
+			140, 141, 99, 141, 146, 135, 218, 110, 117, 15, 53, 74, 30, 131,
+			195, 170, 86, 67, 30, 141, 106, 85, 215, 40, 121, 52, 170, 213,
+			9, 193, 79, 34, 74, 38, 216, 26, 157, 115, 142, 200, 149, 237,
+			217, 89, 27, 85, 159, 193, 230, 81, 197, 107, 25, 110, 80, 241,
+			154, 78, 166, 121, 84, 241, 218, 172, 195, 255, 28, 30, 110, 229,
+			161, 66, 240, 232, 33, 231, 15, 168, 132, 102, 178, 92, 143, 194,
+			86, 15, 198, 99, 125, 78, 169, 31, 110, 65, 239, 194, 60, 36,
+			49, 233, 28, 237, 43, 69, 209, 125, 155, 18, 132, 9, 188, 24,
+			137, 33, 75, 224, 235, 147, 46, 226, 129, 87, 40, 114, 65, 85,
+			26, 21, 25, 6, 170, 247, 89, 205, 218, 150, 60, 223, 73, 124,
+			56, 139, 120, 126, 162, 162, 120, 27, 150, 154, 31, 198, 42, 62,
+			46, 221, 72, 65, 149, 1, 79, 113, 66, 253, 29, 94, 238, 240,
+			190, 224, 243, 20, 116, 192, 99, 240, 67, 117, 211, 139, 241, 121,
+			11, 52, 210, 113, 113, 160, 54, 224, 54, 185, 233, 6, 221, 135,
+			69, 121, 106, 217, 32, 157, 105, 3, 17, 193, 188, 25, 199, 64,
+			76, 48, 239, 192, 65, 190, 193, 97, 154, 21, 228, 110, 18, 231,
+			217, 237, 47, 128, 222, 136, 179, 108, 67, 114, 43, 119, 129, 183,
+			49, 65, 65, 162, 187, 216, 224, 46, 237, 189, 184, 139, 141, 238,
+			210, 214, 230, 108, 163, 187, 180, 181, 187, 216, 232, 46, 109, 237,
+			46, 54, 152, 101, 244, 122, 221, 197, 70, 119, 137, 50, 252, 224,
+			46, 145, 118, 23, 27, 221, 37, 210, 238, 98, 131, 187, 36, 123,
+			112, 23, 27, 221, 37, 209, 38, 109, 163, 187, 36, 218, 93, 108,
+			116, 151, 100, 66, 240, 235, 136, 146, 9, 182, 73, 133, 115, 105,
+			143, 111, 188, 186, 230, 58, 240, 218, 171, 207, 167, 108, 244, 169,
+			205, 140, 1, 240, 169, 77, 93, 60, 217, 232, 83, 155, 165, 9,
+			222, 226, 212, 26, 18, 249, 23, 114, 191, 71, 136, 243, 222, 157,
+			140, 65, 167, 118, 109, 13, 183, 76, 245, 219, 86, 242, 91, 153,
+			192, 16, 17, 236, 133, 194, 97, 254, 14, 110, 89, 67, 96, 2,
+			47, 210, 89, 231, 2, 238, 61, 9, 19, 215, 127, 253, 175, 220,
+			250, 246, 61, 132, 182, 242, 162, 222, 247, 16, 218, 202, 139, 58,
+			93, 12, 161, 173, 188, 184, 175, 204, 63, 0, 201, 111, 136, 18,
+			97, 253, 46, 161, 142, 243, 252, 30, 69, 191, 35, 225, 174, 185,
+			114, 89, 239, 68, 230, 60, 145, 85, 155, 248, 172, 97, 81, 186,
+			181, 40, 140, 99, 233, 250, 190, 92, 14, 125, 95, 213, 18, 232,
+			237, 112, 62, 138, 140, 145, 60, 178, 50, 100, 64, 228, 172, 48,
+			109, 64, 6, 96, 121, 150, 183, 57, 181, 10, 194, 126, 63, 201,
+			125, 130, 16, 103, 205, 188, 39, 122, 163, 105, 174, 127, 253, 142,
+			42, 27, 230, 204, 42, 16, 97, 189, 159, 20, 246, 99, 33, 82,
+			160, 57, 97, 125, 128, 220, 206, 111, 129, 241, 2, 232, 2, 230,
+			22, 12, 72, 96, 105, 177, 100, 64, 6, 224, 228, 20, 127, 4,
+			241, 18, 97, 125, 144, 208, 146, 115, 255, 158, 14, 55, 233, 3,
+			38, 136, 202, 250, 166, 162, 158, 81, 5, 97, 126, 176, 75, 149,
+			32, 226, 226, 176, 1, 25, 128, 99, 227, 252, 109, 72, 149, 10,
+			235, 247, 9, 221, 239, 44, 13, 216, 128, 81, 189, 167, 122, 51,
+			196, 54, 90, 52, 143, 235, 135, 12, 72, 0, 44, 236, 51, 32,
+			3, 208, 153, 227, 111, 71, 90, 76, 88, 127, 72, 232, 28, 180,
+			108, 154, 74, 54, 189, 70, 19, 244, 213, 179, 167, 52, 71, 222,
+			122, 103, 44, 143, 24, 12, 53, 70, 0, 44, 204, 24, 16, 241,
+			207, 58, 252, 223, 193, 188, 11, 212, 18, 214, 135, 9, 157, 116,
+			94, 197, 218, 23, 146, 27, 62, 23, 193, 82, 44, 82, 41, 5,
+			243, 244, 213, 245, 179, 238, 80, 218, 92, 75, 43, 86, 176, 8,
+			16, 64, 8, 135, 107, 124, 204, 157, 10, 195, 13, 244, 153, 45,
+			172, 161, 161, 215, 229, 2, 54, 194, 100, 16, 6, 39, 144, 202,
+			113, 168, 234, 56, 172, 196, 10, 107, 11, 72, 225, 5, 132, 222,
+			30, 212, 203, 113, 51, 220, 132, 30, 154, 62, 165, 247, 170, 124,
+			192, 50, 141, 69, 130, 1, 24, 175, 41, 96, 201, 247, 225, 174,
+			162, 161, 159, 244, 97, 82, 28, 51, 32, 3, 112, 66, 240, 135,
+			80, 26, 121, 97, 253, 9, 161, 179, 250, 32, 174, 69, 43, 175,
+			87, 175, 108, 183, 173, 236, 189, 40, 22, 133, 25, 185, 124, 138,
+			194, 144, 203, 19, 0, 139, 147, 6, 100, 0, 206, 148, 249, 253,
+			72, 206, 22, 214, 159, 2, 185, 244, 69, 28, 220, 84, 238, 153,
+			142, 157, 199, 181, 70, 203, 54, 1, 176, 96, 232, 216, 12, 192,
+			153, 178, 182, 169, 33, 97, 125, 132, 208, 178, 115, 106, 79, 219,
+			74, 13, 172, 143, 218, 80, 30, 49, 152, 93, 13, 17, 0, 139,
+			194, 128, 12, 192, 233, 125, 248, 204, 175, 64, 11, 194, 250, 40,
+			80, 187, 123, 247, 93, 237, 64, 166, 144, 199, 165, 102, 83, 16,
+			83, 62, 74, 10, 134, 76, 129, 1, 56, 189, 47, 141, 55, 92,
+			88, 31, 35, 244, 8, 31, 1, 154, 60, 7, 208, 240, 180, 134,
+			8, 64, 251, 230, 211, 137, 69, 97, 125, 156, 80, 145, 78, 44,
+			230, 0, 26, 30, 77, 81, 22, 115, 68, 131, 233, 71, 132, 198,
+			39, 244, 71, 130, 31, 199, 39, 248, 123, 56, 181, 138, 194, 254,
+			36, 201, 125, 145, 16, 231, 138, 236, 189, 157, 128, 3, 131, 43,
+			215, 96, 4, 132, 9, 30, 176, 225, 213, 59, 221, 67, 13, 58,
+			70, 203, 189, 161, 76, 21, 215, 99, 193, 58, 118, 22, 137, 176,
+			62, 73, 10, 83, 120, 222, 45, 178, 156, 176, 63, 69, 232, 95,
+			19, 230, 220, 133, 18, 172, 165, 169, 64, 247, 186, 145, 148, 170,
+			103, 232, 181, 240, 138, 12, 246, 242, 41, 194, 199, 121, 137, 219,
+			86, 145, 229, 10, 57, 97, 191, 68, 172, 207, 147, 60, 31, 231,
+			67, 233, 8, 17, 214, 75, 48, 101, 44, 157, 2, 97, 250, 101,
+			98, 159, 52, 19, 224, 204, 108, 189, 76, 236, 185, 238, 0, 129,
+			25, 251, 239, 233, 14, 48, 24, 168, 44, 101, 40, 136, 176, 62,
+			77, 236, 133, 108, 2, 177, 113, 96, 95, 119, 0, 103, 148, 143,
+			116, 7, 24, 12, 28, 61, 150, 161, 160, 194, 250, 12, 177, 79,
+			103, 19, 168, 141, 3, 251, 187, 3, 4, 6, 14, 156, 232, 14,
+			48, 24, 56, 121, 42, 67, 193, 132, 245, 89, 98, 87, 178, 9,
+			204, 198, 1, 167, 59, 64, 96, 96, 238, 120, 119, 0, 151, 44,
+			158, 200, 80, 88, 194, 250, 28, 177, 239, 205, 38, 64, 1, 252,
+			57, 98, 31, 236, 14, 16, 24, 56, 212, 229, 19, 122, 74, 159,
+			35, 103, 223, 130, 166, 85, 4, 97, 126, 129, 208, 217, 84, 29,
+			52, 103, 33, 168, 181, 131, 146, 253, 2, 25, 46, 25, 144, 192,
+			215, 137, 41, 3, 50, 0, 247, 149, 177, 27, 200, 133, 253, 37,
+			146, 251, 54, 33, 206, 73, 99, 106, 221, 10, 203, 237, 182, 82,
+			240, 50, 162, 215, 22, 181, 57, 113, 34, 172, 47, 145, 194, 52,
+			255, 59, 56, 13, 113, 176, 167, 175, 16, 250, 79, 132, 57, 159,
+			165, 123, 51, 40, 121, 17, 126, 238, 1, 153, 109, 75, 214, 194,
+			40, 165, 88, 143, 141, 5, 247, 210, 212, 179, 224, 55, 37, 248,
+			45, 110, 171, 154, 183, 238, 225, 211, 90, 104, 195, 114, 46, 241,
+			206, 93, 134, 107, 80, 254, 164, 247, 46, 110, 187, 173, 220, 72,
+			194, 143, 80, 194, 117, 25, 70, 240, 196, 223, 96, 240, 34, 195,
+			73, 138, 65, 197, 21, 249, 100, 216, 82, 60, 219, 184, 198, 225,
+			199, 33, 92, 224, 180, 188, 24, 158, 59, 47, 154, 167, 248, 145,
+			106, 193, 47, 114, 84, 80, 151, 53, 124, 181, 10, 57, 173, 29,
+			133, 27, 94, 218, 222, 106, 65, 5, 134, 194, 171, 133, 1, 244,
+			123, 225, 145, 97, 122, 84, 131, 31, 231, 60, 175, 22, 241, 44,
+			120, 92, 251, 21, 71, 191, 250, 10, 56, 205, 219, 185, 109, 241,
+			212, 105, 190, 74, 172, 41, 93, 13, 32, 147, 38, 59, 245, 73,
+			38, 107, 117, 163, 136, 42, 28, 237, 6, 17, 228, 17, 67, 177,
+			59, 64, 96, 128, 143, 119, 7, 24, 12, 136, 73, 244, 101, 158,
+			250, 242, 215, 136, 245, 143, 218, 151, 185, 246, 229, 175, 25, 95,
+			6, 172, 68, 88, 95, 39, 246, 84, 134, 3, 28, 241, 235, 196,
+			30, 238, 14, 224, 140, 145, 46, 21, 194, 96, 64, 76, 102, 40,
+			168, 176, 190, 65, 236, 83, 217, 4, 112, 196, 111, 24, 71, 228,
+			218, 17, 191, 65, 14, 44, 118, 7, 24, 12, 44, 157, 204, 80,
+			48, 97, 125, 147, 216, 199, 51, 20, 224, 136, 223, 36, 118, 185,
+			59, 64, 96, 96, 246, 77, 221, 1, 92, 114, 108, 33, 67, 97,
+			9, 235, 91, 196, 190, 47, 155, 0, 142, 248, 45, 98, 31, 234,
+			14, 16, 24, 144, 103, 186, 3, 12, 6, 222, 114, 47, 58, 34,
+			7, 5, 189, 66, 168, 147, 234, 15, 29, 241, 21, 227, 136, 28,
+			29, 241, 21, 227, 136, 28, 69, 255, 10, 153, 152, 54, 32, 131,
+			175, 229, 89, 62, 194, 169, 53, 44, 236, 239, 144, 220, 63, 19,
+			130, 110, 53, 76, 132, 245, 29, 136, 210, 64, 100, 24, 136, 124,
+			151, 208, 180, 172, 26, 198, 34, 246, 187, 38, 115, 13, 35, 214,
+			239, 146, 194, 132, 1, 25, 76, 158, 154, 214, 75, 137, 176, 190,
+			71, 232, 221, 250, 35, 232, 233, 123, 134, 221, 97, 212, 210, 247,
+			200, 220, 93, 6, 100, 240, 117, 225, 56, 50, 52, 34, 236, 87,
+			73, 238, 7, 154, 161, 17, 34, 172, 87, 193, 207, 159, 226, 150,
+			53, 2, 12, 125, 159, 208, 195, 112, 70, 132, 123, 108, 233, 70,
+			233, 239, 5, 98, 137, 15, 46, 178, 46, 66, 90, 236, 87, 164,
+			124, 34, 240, 183, 224, 183, 116, 224, 151, 80, 136, 201, 203, 117,
+			92, 180, 238, 249, 126, 86, 79, 142, 160, 252, 190, 111, 228, 55,
+			130, 242, 251, 62, 25, 158, 49, 32, 1, 178, 251, 246, 27, 144,
+			1, 120, 72, 34, 187, 163, 194, 254, 33, 201, 253, 155, 102, 119,
+			148, 8, 235, 135, 192, 238, 255, 231, 150, 53, 10, 236, 190, 6,
+			236, 94, 219, 198, 110, 151, 73, 185, 233, 130, 115, 38, 161, 238,
+			247, 237, 194, 180, 121, 164, 175, 217, 30, 69, 182, 95, 51, 108,
+			143, 34, 219, 175, 25, 182, 71, 81, 65, 175, 25, 182, 71, 145,
+			237, 215, 200, 33, 201, 99, 78, 237, 156, 176, 127, 68, 224, 14,
+			222, 81, 210, 188, 18, 201, 142, 61, 152, 238, 175, 132, 141, 11,
+			97, 163, 239, 248, 145, 21, 159, 88, 185, 122, 65, 162, 162, 117,
+			183, 166, 98, 140, 107, 220, 172, 104, 71, 33, 254, 192, 47, 104,
+			24, 132, 80, 168, 14, 115, 102, 3, 67, 63, 34, 133, 18, 191,
+			198, 45, 27, 99, 204, 143, 9, 189, 234, 156, 31, 188, 244, 1,
+			250, 129, 244, 234, 170, 213, 14, 19, 60, 59, 234, 222, 35, 180,
+			140, 179, 230, 147, 12, 219, 74, 191, 88, 70, 1, 0, 70, 34,
+			172, 31, 67, 124, 208, 32, 5, 112, 250, 110, 3, 50, 0, 207,
+			62, 198, 151, 145, 60, 17, 214, 79, 8, 125, 216, 57, 219, 219,
+			159, 223, 251, 45, 73, 138, 19, 44, 249, 39, 196, 46, 25, 144,
+			2, 56, 49, 111, 64, 6, 224, 137, 183, 243, 53, 164, 72, 133,
+			245, 83, 66, 31, 119, 86, 6, 27, 179, 219, 119, 156, 237, 13,
+			44, 58, 125, 196, 212, 127, 212, 54, 237, 68, 158, 229, 160, 148,
+			36, 68, 175, 159, 18, 123, 218, 128, 72, 115, 102, 209, 128, 12,
+			192, 123, 175, 240, 15, 17, 228, 136, 9, 235, 103, 132, 62, 229,
+			188, 143, 236, 208, 187, 114, 235, 245, 120, 240, 228, 143, 154, 119,
+			187, 39, 247, 222, 30, 132, 171, 185, 227, 198, 11, 51, 102, 205,
+			3, 5, 125, 44, 198, 250, 27, 126, 179, 12, 251, 235, 232, 27,
+			243, 148, 65, 56, 201, 253, 140, 216, 251, 12, 72, 1, 44, 159,
+			52, 32, 242, 251, 214, 21, 254, 249, 148, 125, 75, 88, 63, 39,
+			244, 81, 231, 47, 73, 255, 57, 233, 54, 242, 140, 84, 45, 140,
+			234, 125, 10, 61, 102, 14, 153, 32, 209, 182, 27, 185, 45, 5,
+			125, 203, 138, 188, 140, 125, 4, 252, 29, 205, 218, 86, 79, 175,
+			192, 139, 147, 204, 95, 58, 109, 184, 114, 238, 224, 239, 90, 225,
+			161, 133, 17, 131, 193, 152, 237, 14, 14, 102, 63, 39, 246, 164,
+			1, 41, 128, 83, 11, 6, 100, 0, 158, 185, 196, 191, 150, 238,
+			46, 47, 172, 95, 16, 122, 218, 249, 34, 73, 75, 36, 96, 196,
+			5, 162, 112, 20, 15, 179, 75, 28, 248, 41, 203, 90, 203, 75,
+			160, 241, 234, 5, 105, 153, 142, 124, 167, 191, 206, 141, 165, 203,
+			7, 74, 172, 90, 24, 36, 174, 23, 152, 14, 77, 236, 182, 122,
+			15, 252, 250, 176, 191, 136, 94, 157, 254, 40, 22, 134, 224, 74,
+			163, 174, 110, 242, 158, 98, 73, 255, 176, 22, 52, 219, 87, 206,
+			100, 251, 133, 147, 225, 47, 32, 61, 107, 144, 2, 56, 82, 54,
+			32, 3, 240, 200, 73, 254, 177, 116, 191, 182, 176, 126, 73, 232,
+			57, 231, 143, 72, 247, 71, 77, 248, 152, 73, 63, 135, 113, 227,
+			216, 252, 94, 51, 9, 177, 190, 10, 26, 94, 160, 142, 197, 221,
+			159, 54, 85, 112, 126, 133, 239, 242, 243, 35, 236, 68, 55, 147,
+			164, 29, 63, 176, 180, 84, 243, 195, 78, 221, 60, 122, 130, 223,
+			198, 96, 50, 89, 2, 130, 186, 112, 59, 175, 204, 217, 205, 206,
+			225, 9, 244, 151, 93, 235, 180, 41, 128, 229, 55, 25, 144, 1,
+			184, 244, 32, 255, 76, 186, 159, 33, 97, 253, 154, 208, 75, 206,
+			71, 122, 246, 147, 190, 183, 186, 218, 241, 19, 239, 117, 236, 170,
+			103, 213, 255, 213, 214, 224, 184, 251, 107, 168, 97, 52, 72, 1,
+			156, 61, 106, 64, 6, 224, 169, 255, 183, 102, 183, 163, 48, 9,
+			207, 252, 239, 0, 190, 15, 30, 200, 146, 65, 0, 0},
 	)
 }
 
@@ -423,6 +423,12 @@ type TerminateStreamRequest struct {
 	Secret []byte `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
 	// The terminal index of the stream.
 	TerminalIndex int64 `protobuf:"varint,4,opt,name=terminal_index,json=terminalIndex,proto3" json:"terminal_index,omitempty"`
+	// Tags from the stream's descriptor at the time it was terminated.
+	//
+	// Tags that were not present when the stream was registered (e.g. ones
+	// reported by Butler filters when the stream closes) are added to the stored
+	// descriptor. Values of existing tags are never changed.
+	Tags map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *TerminateStreamRequest) Reset() {
@@ -485,6 +491,150 @@ func (x *TerminateStreamRequest) GetTerminalIndex() int64 {
 	return 0
 }
 
+func (x *TerminateStreamRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// UpdateStreamUsageRequest is the set of caller-supplied data for the
+// UpdateStreamUsage service endpoint.
+type UpdateStreamUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The log stream's project.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// The log stream's path Coordinator ID.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The log stream's secret.
+	Secret []byte `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// The number of bytes of log entry data that were stored for the stream.
+	Bytes int64 `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *UpdateStreamUsageRequest) Reset() {
+	*x = UpdateStreamUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateStreamUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStreamUsageRequest) ProtoMessage() {}
+
+func (x *UpdateStreamUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStreamUsageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStreamUsageRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateStreamUsageRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *UpdateStreamUsageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateStreamUsageRequest) GetSecret() []byte {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *UpdateStreamUsageRequest) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+// UpdateStreamUsageResponse is the response message for the UpdateStreamUsage
+// service endpoint.
+type UpdateStreamUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The total number of bytes of log entry data stored for the stream.
+	StreamBytes int64 `protobuf:"varint,1,opt,name=stream_bytes,json=streamBytes,proto3" json:"stream_bytes,omitempty"`
+	// The number of bytes of log entry data stored for the stream's project
+	// during the current second, across all Collectors.
+	ProjectBytes int64 `protobuf:"varint,2,opt,name=project_bytes,json=projectBytes,proto3" json:"project_bytes,omitempty"`
+}
+
+func (x *UpdateStreamUsageResponse) Reset() {
+	*x = UpdateStreamUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateStreamUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStreamUsageResponse) ProtoMessage() {}
+
+func (x *UpdateStreamUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStreamUsageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStreamUsageResponse) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateStreamUsageResponse) GetStreamBytes() int64 {
+	if x != nil {
+		return x.StreamBytes
+	}
+	return 0
+}
+
+func (x *UpdateStreamUsageResponse) GetProjectBytes() int64 {
+	if x != nil {
+		return x.ProjectBytes
+	}
+	return 0
+}
+
 // ArchiveStreamRequest is the set of caller-supplied data for the ArchiveStream
 // service endpoint.
 type ArchiveStreamRequest struct {
@@ -519,7 +669,7 @@ type ArchiveStreamRequest struct {
 func (x *ArchiveStreamRequest) Reset() {
 	*x = ArchiveStreamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[6]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -532,7 +682,7 @@ func (x *ArchiveStreamRequest) String() string {
 func (*ArchiveStreamRequest) ProtoMessage() {}
 
 func (x *ArchiveStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[6]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,7 +695,7 @@ func (x *ArchiveStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveStreamRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveStreamRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{6}
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ArchiveStreamRequest) GetProject() string {
@@ -623,7 +773,7 @@ type BatchRequest struct {
 func (x *BatchRequest) Reset() {
 	*x = BatchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[7]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -636,7 +786,7 @@ func (x *BatchRequest) String() string {
 func (*BatchRequest) ProtoMessage() {}
 
 func (x *BatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[7]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +799,7 @@ func (x *BatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchRequest.ProtoReflect.Descriptor instead.
 func (*BatchRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{7}
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BatchRequest) GetReq() []*BatchRequest_Entry {
@@ -671,7 +821,7 @@ type BatchResponse struct {
 func (x *BatchResponse) Reset() {
 	*x = BatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[8]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -684,7 +834,7 @@ func (x *BatchResponse) String() string {
 func (*BatchResponse) ProtoMessage() {}
 
 func (x *BatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[8]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +847,7 @@ func (x *BatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
 func (*BatchResponse) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{8}
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BatchResponse) GetResp() []*BatchResponse_Entry {
@@ -719,7 +869,7 @@ type LeaseRequest struct {
 func (x *LeaseRequest) Reset() {
 	*x = LeaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[9]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -732,7 +882,7 @@ func (x *LeaseRequest) String() string {
 func (*LeaseRequest) ProtoMessage() {}
 
 func (x *LeaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[9]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +895,7 @@ func (x *LeaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaseRequest.ProtoReflect.Descriptor instead.
 func (*LeaseRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{9}
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LeaseRequest) GetMaxTasks() int64 {
@@ -774,7 +924,7 @@ type LeaseResponse struct {
 func (x *LeaseResponse) Reset() {
 	*x = LeaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[10]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -787,7 +937,7 @@ func (x *LeaseResponse) String() string {
 func (*LeaseResponse) ProtoMessage() {}
 
 func (x *LeaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[10]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +950,7 @@ func (x *LeaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaseResponse.ProtoReflect.Descriptor instead.
 func (*LeaseResponse) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{10}
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LeaseResponse) GetTasks() []*ArchiveTask {
@@ -822,7 +972,7 @@ type DeleteRequest struct {
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[11]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -835,7 +985,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[11]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,7 +998,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{11}
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteRequest) GetTasks() []*ArchiveTask {
@@ -869,13 +1019,14 @@ type BatchRequest_Entry struct {
 	//	*BatchRequest_Entry_LoadStream
 	//	*BatchRequest_Entry_TerminateStream
 	//	*BatchRequest_Entry_ArchiveStream
+	//	*BatchRequest_Entry_UpdateStreamUsage
 	Value isBatchRequest_Entry_Value `protobuf_oneof:"value"`
 }
 
 func (x *BatchRequest_Entry) Reset() {
 	*x = BatchRequest_Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[12]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -888,7 +1039,7 @@ func (x *BatchRequest_Entry) String() string {
 func (*BatchRequest_Entry) ProtoMessage() {}
 
 func (x *BatchRequest_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[12]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,7 +1052,7 @@ func (x *BatchRequest_Entry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchRequest_Entry.ProtoReflect.Descriptor instead.
 func (*BatchRequest_Entry) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{7, 0}
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (m *BatchRequest_Entry) GetValue() isBatchRequest_Entry_Value {
@@ -939,6 +1090,13 @@ func (x *BatchRequest_Entry) GetArchiveStream() *ArchiveStreamRequest {
 	return nil
 }
 
+func (x *BatchRequest_Entry) GetUpdateStreamUsage() *UpdateStreamUsageRequest {
+	if x, ok := x.GetValue().(*BatchRequest_Entry_UpdateStreamUsage); ok {
+		return x.UpdateStreamUsage
+	}
+	return nil
+}
+
 type isBatchRequest_Entry_Value interface {
 	isBatchRequest_Entry_Value()
 }
@@ -959,6 +1117,10 @@ type BatchRequest_Entry_ArchiveStream struct {
 	ArchiveStream *ArchiveStreamRequest `protobuf:"bytes,4,opt,name=archive_stream,json=archiveStream,proto3,oneof"`
 }
 
+type BatchRequest_Entry_UpdateStreamUsage struct {
+	UpdateStreamUsage *UpdateStreamUsageRequest `protobuf:"bytes,5,opt,name=update_stream_usage,json=updateStreamUsage,proto3,oneof"`
+}
+
 func (*BatchRequest_Entry_RegisterStream) isBatchRequest_Entry_Value() {}
 
 func (*BatchRequest_Entry_LoadStream) isBatchRequest_Entry_Value() {}
@@ -967,6 +1129,8 @@ func (*BatchRequest_Entry_TerminateStream) isBatchRequest_Entry_Value() {}
 
 func (*BatchRequest_Entry_ArchiveStream) isBatchRequest_Entry_Value() {}
 
+func (*BatchRequest_Entry_UpdateStreamUsage) isBatchRequest_Entry_Value() {}
+
 // The collection of batched requests.
 //
 // Each entry corresponds to the BatchRequest entry with the specified index.
@@ -985,13 +1149,14 @@ type BatchResponse_Entry struct {
 	//	*BatchResponse_Entry_Err
 	//	*BatchResponse_Entry_RegisterStream
 	//	*BatchResponse_Entry_LoadStream
+	//	*BatchResponse_Entry_UpdateStreamUsage
 	Value isBatchResponse_Entry_Value `protobuf_oneof:"value"`
 }
 
 func (x *BatchResponse_Entry) Reset() {
 	*x = BatchResponse_Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[13]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1004,7 +1169,7 @@ func (x *BatchResponse_Entry) String() string {
 func (*BatchResponse_Entry) ProtoMessage() {}
 
 func (x *BatchResponse_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[13]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,7 +1182,7 @@ func (x *BatchResponse_Entry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchResponse_Entry.ProtoReflect.Descriptor instead.
 func (*BatchResponse_Entry) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{8, 0}
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *BatchResponse_Entry) GetIndex() int32 {
@@ -1055,6 +1220,13 @@ func (x *BatchResponse_Entry) GetLoadStream() *LoadStreamResponse {
 	return nil
 }
 
+func (x *BatchResponse_Entry) GetUpdateStreamUsage() *UpdateStreamUsageResponse {
+	if x, ok := x.GetValue().(*BatchResponse_Entry_UpdateStreamUsage); ok {
+		return x.UpdateStreamUsage
+	}
+	return nil
+}
+
 type isBatchResponse_Entry_Value interface {
 	isBatchResponse_Entry_Value()
 }
@@ -1071,12 +1243,18 @@ type BatchResponse_Entry_LoadStream struct {
 	LoadStream *LoadStreamResponse `protobuf:"bytes,4,opt,name=load_stream,json=loadStream,proto3,oneof"`
 }
 
+type BatchResponse_Entry_UpdateStreamUsage struct {
+	UpdateStreamUsage *UpdateStreamUsageResponse `protobuf:"bytes,5,opt,name=update_stream_usage,json=updateStreamUsage,proto3,oneof"`
+}
+
 func (*BatchResponse_Entry_Err) isBatchResponse_Entry_Value() {}
 
 func (*BatchResponse_Entry_RegisterStream) isBatchResponse_Entry_Value() {}
 
 func (*BatchResponse_Entry_LoadStream) isBatchResponse_Entry_Value() {}
 
+func (*BatchResponse_Entry_UpdateStreamUsage) isBatchResponse_Entry_Value() {}
+
 var File_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDesc = []byte{
@@ -1139,7 +1317,7 @@ var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_servi
 	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c,
 	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x0c, 0x52, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x22,
-	0x81, 0x01, 0x0a, 0x16, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72,
+	0xf8, 0x01, 0x0a, 0x16, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72,
 	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
 	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f,
 	0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
@@ -1147,7 +1325,28 @@ var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_servi
 	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e,
 	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x22, 0xc2, 0x02, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53,
+	0x64, 0x65, 0x78, 0x12, 0x3c, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x18, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x63,
+	0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0xc2, 0x02, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53,
 	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
 	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
 	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
@@ -1167,11 +1366,11 @@ var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_servi
 	0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x69, 0x6e,
 	0x64, 0x65, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x4a, 0x04, 0x08, 0x1e, 0x10, 0x1f, 0x4a, 0x04, 0x08,
 	0x1f, 0x10, 0x20, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x52, 0x09, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xeb, 0x02, 0x0a, 0x0c, 0x42, 0x61, 0x74,
+	0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xbf, 0x03, 0x0a, 0x0c, 0x42, 0x61, 0x74,
 	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x72, 0x65, 0x71,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e,
 	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x03, 0x72, 0x65, 0x71, 0x1a, 0xac, 0x02, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72,
+	0x72, 0x79, 0x52, 0x03, 0x72, 0x65, 0x71, 0x1a, 0x80, 0x03, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x12, 0x48, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74,
 	0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x6f, 0x67,
 	0x64, 0x6f, 0x67, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65,
@@ -1189,76 +1388,92 @@ var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_servi
 	0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53,
 	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d,
-	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x07, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x96, 0x02, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x72, 0x65, 0x73, 0x70,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x04, 0x72, 0x65, 0x73, 0x70, 0x1a, 0xd3, 0x01, 0x0a, 0x05, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x03, 0x65, 0x72, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x03, 0x65, 0x72, 0x72, 0x12, 0x49, 0x0a, 0x0f,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x52,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x52, 0x0a,
+	0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x6f, 0x67,
+	0x64, 0x6f, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x11,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xeb, 0x02, 0x0a, 0x0d, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04,
+	0x72, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x67,
+	0x64, 0x6f, 0x67, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x72, 0x65, 0x73, 0x70, 0x1a, 0xa8, 0x02,
+	0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a,
+	0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x6f, 0x67,
+	0x64, 0x6f, 0x67, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x03, 0x65, 0x72, 0x72,
+	0x12, 0x49, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x6f, 0x67, 0x64,
+	0x6f, 0x67, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x3d, 0x0a, 0x0b, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x53, 0x0a, 0x13, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x11, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42,
+	0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x65, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x3a, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x3a, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x05,
+	0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f,
+	0x67, 0x64, 0x6f, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x32, 0xc9, 0x04, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x52,
 	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x3d, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c,
-	0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x64,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x65, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x38, 0x0a, 0x0a,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73,
-	0x6b, 0x73, 0x22, 0x3a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x32, 0xef,
-	0x03, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x2e,
-	0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c,
-	0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a,
-	0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x6c, 0x6f, 0x67,
-	0x64, 0x6f, 0x67, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x4c,
-	0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x49, 0x0a, 0x0f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x12, 0x1e, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x54, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0d,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e,
-	0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x4c, 0x6f, 0x61,
+	0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0f, 0x54, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1e, 0x2e,
+	0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x58, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x6c, 0x6f, 0x67,
+	0x64, 0x6f, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c,
+	0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x14, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x11,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x12, 0x14, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67,
+	0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x14, 0x2e, 0x6c,
-	0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x11, 0x4c, 0x65, 0x61,
-	0x73, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x14,
-	0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x4c, 0x65,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x12, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x73, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x42, 0x4a, 0x5a, 0x48, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e,
-	0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f,
-	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x74, 0x79, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69,
+	0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x6c, 0x6f, 0x67, 0x64,
+	0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1273,63 +1488,71 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_serv
 	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_goTypes = []interface{}{
-	(*Error)(nil),                  // 0: logdog.Error
-	(*RegisterStreamRequest)(nil),  // 1: logdog.RegisterStreamRequest
-	(*RegisterStreamResponse)(nil), // 2: logdog.RegisterStreamResponse
-	(*LoadStreamRequest)(nil),      // 3: logdog.LoadStreamRequest
-	(*LoadStreamResponse)(nil),     // 4: logdog.LoadStreamResponse
-	(*TerminateStreamRequest)(nil), // 5: logdog.TerminateStreamRequest
-	(*ArchiveStreamRequest)(nil),   // 6: logdog.ArchiveStreamRequest
-	(*BatchRequest)(nil),           // 7: logdog.BatchRequest
-	(*BatchResponse)(nil),          // 8: logdog.BatchResponse
-	(*LeaseRequest)(nil),           // 9: logdog.LeaseRequest
-	(*LeaseResponse)(nil),          // 10: logdog.LeaseResponse
-	(*DeleteRequest)(nil),          // 11: logdog.DeleteRequest
-	(*BatchRequest_Entry)(nil),     // 12: logdog.BatchRequest.Entry
-	(*BatchResponse_Entry)(nil),    // 13: logdog.BatchResponse.Entry
-	(*LogStreamState)(nil),         // 14: logdog.LogStreamState
-	(*duration.Duration)(nil),      // 15: google.protobuf.Duration
-	(*ArchiveTask)(nil),            // 16: logdog.ArchiveTask
-	(*empty.Empty)(nil),            // 17: google.protobuf.Empty
+	(*Error)(nil),                     // 0: logdog.Error
+	(*RegisterStreamRequest)(nil),     // 1: logdog.RegisterStreamRequest
+	(*RegisterStreamResponse)(nil),    // 2: logdog.RegisterStreamResponse
+	(*LoadStreamRequest)(nil),         // 3: logdog.LoadStreamRequest
+	(*LoadStreamResponse)(nil),        // 4: logdog.LoadStreamResponse
+	(*TerminateStreamRequest)(nil),    // 5: logdog.TerminateStreamRequest
+	(*UpdateStreamUsageRequest)(nil),  // 6: logdog.UpdateStreamUsageRequest
+	(*UpdateStreamUsageResponse)(nil), // 7: logdog.UpdateStreamUsageResponse
+	(*ArchiveStreamRequest)(nil),      // 8: logdog.ArchiveStreamRequest
+	(*BatchRequest)(nil),              // 9: logdog.BatchRequest
+	(*BatchResponse)(nil),             // 10: logdog.BatchResponse
+	(*LeaseRequest)(nil),              // 11: logdog.LeaseRequest
+	(*LeaseResponse)(nil),             // 12: logdog.LeaseResponse
+	(*DeleteRequest)(nil),             // 13: logdog.DeleteRequest
+	nil,                               // 14: logdog.TerminateStreamRequest.TagsEntry
+	(*BatchRequest_Entry)(nil),        // 15: logdog.BatchRequest.Entry
+	(*BatchResponse_Entry)(nil),       // 16: logdog.BatchResponse.Entry
+	(*LogStreamState)(nil),            // 17: logdog.LogStreamState
+	(*duration.Duration)(nil),         // 18: google.protobuf.Duration
+	(*ArchiveTask)(nil),               // 19: logdog.ArchiveTask
+	(*empty.Empty)(nil),               // 20: google.protobuf.Empty
 }
 var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_depIdxs = []int32{
-	14, // 0: logdog.RegisterStreamResponse.state:type_name -> logdog.LogStreamState
+	17, // 0: logdog.RegisterStreamResponse.state:type_name -> logdog.LogStreamState
 	0,  // 1: logdog.RegisterStreamResponse.error:type_name -> logdog.Error
-	14, // 2: logdog.LoadStreamResponse.state:type_name -> logdog.LogStreamState
-	15, // 3: logdog.LoadStreamResponse.age:type_name -> google.protobuf.Duration
-	12, // 4: logdog.BatchRequest.req:type_name -> logdog.BatchRequest.Entry
-	13, // 5: logdog.BatchResponse.resp:type_name -> logdog.BatchResponse.Entry
-	15, // 6: logdog.LeaseRequest.lease_time:type_name -> google.protobuf.Duration
-	16, // 7: logdog.LeaseResponse.tasks:type_name -> logdog.ArchiveTask
-	16, // 8: logdog.DeleteRequest.tasks:type_name -> logdog.ArchiveTask
-	1,  // 9: logdog.BatchRequest.Entry.register_stream:type_name -> logdog.RegisterStreamRequest
-	3,  // 10: logdog.BatchRequest.Entry.load_stream:type_name -> logdog.LoadStreamRequest
-	5,  // 11: logdog.BatchRequest.Entry.terminate_stream:type_name -> logdog.TerminateStreamRequest
-	6,  // 12: logdog.BatchRequest.Entry.archive_stream:type_name -> logdog.ArchiveStreamRequest
-	0,  // 13: logdog.BatchResponse.Entry.err:type_name -> logdog.Error
-	2,  // 14: logdog.BatchResponse.Entry.register_stream:type_name -> logdog.RegisterStreamResponse
-	4,  // 15: logdog.BatchResponse.Entry.load_stream:type_name -> logdog.LoadStreamResponse
-	1,  // 16: logdog.Services.RegisterStream:input_type -> logdog.RegisterStreamRequest
-	3,  // 17: logdog.Services.LoadStream:input_type -> logdog.LoadStreamRequest
-	5,  // 18: logdog.Services.TerminateStream:input_type -> logdog.TerminateStreamRequest
-	6,  // 19: logdog.Services.ArchiveStream:input_type -> logdog.ArchiveStreamRequest
-	7,  // 20: logdog.Services.Batch:input_type -> logdog.BatchRequest
-	9,  // 21: logdog.Services.LeaseArchiveTasks:input_type -> logdog.LeaseRequest
-	11, // 22: logdog.Services.DeleteArchiveTasks:input_type -> logdog.DeleteRequest
-	2,  // 23: logdog.Services.RegisterStream:output_type -> logdog.RegisterStreamResponse
-	4,  // 24: logdog.Services.LoadStream:output_type -> logdog.LoadStreamResponse
-	17, // 25: logdog.Services.TerminateStream:output_type -> google.protobuf.Empty
-	17, // 26: logdog.Services.ArchiveStream:output_type -> google.protobuf.Empty
-	8,  // 27: logdog.Services.Batch:output_type -> logdog.BatchResponse
-	10, // 28: logdog.Services.LeaseArchiveTasks:output_type -> logdog.LeaseResponse
-	17, // 29: logdog.Services.DeleteArchiveTasks:output_type -> google.protobuf.Empty
-	23, // [23:30] is the sub-list for method output_type
-	16, // [16:23] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	17, // 2: logdog.LoadStreamResponse.state:type_name -> logdog.LogStreamState
+	18, // 3: logdog.LoadStreamResponse.age:type_name -> google.protobuf.Duration
+	14, // 4: logdog.TerminateStreamRequest.tags:type_name -> logdog.TerminateStreamRequest.TagsEntry
+	15, // 5: logdog.BatchRequest.req:type_name -> logdog.BatchRequest.Entry
+	16, // 6: logdog.BatchResponse.resp:type_name -> logdog.BatchResponse.Entry
+	18, // 7: logdog.LeaseRequest.lease_time:type_name -> google.protobuf.Duration
+	19, // 8: logdog.LeaseResponse.tasks:type_name -> logdog.ArchiveTask
+	19, // 9: logdog.DeleteRequest.tasks:type_name -> logdog.ArchiveTask
+	1,  // 10: logdog.BatchRequest.Entry.register_stream:type_name -> logdog.RegisterStreamRequest
+	3,  // 11: logdog.BatchRequest.Entry.load_stream:type_name -> logdog.LoadStreamRequest
+	5,  // 12: logdog.BatchRequest.Entry.terminate_stream:type_name -> logdog.TerminateStreamRequest
+	8,  // 13: logdog.BatchRequest.Entry.archive_stream:type_name -> logdog.ArchiveStreamRequest
+	6,  // 14: logdog.BatchRequest.Entry.update_stream_usage:type_name -> logdog.UpdateStreamUsageRequest
+	0,  // 15: logdog.BatchResponse.Entry.err:type_name -> logdog.Error
+	2,  // 16: logdog.BatchResponse.Entry.register_stream:type_name -> logdog.RegisterStreamResponse
+	4,  // 17: logdog.BatchResponse.Entry.load_stream:type_name -> logdog.LoadStreamResponse
+	7,  // 18: logdog.BatchResponse.Entry.update_stream_usage:type_name -> logdog.UpdateStreamUsageResponse
+	1,  // 19: logdog.Services.RegisterStream:input_type -> logdog.RegisterStreamRequest
+	3,  // 20: logdog.Services.LoadStream:input_type -> logdog.LoadStreamRequest
+	5,  // 21: logdog.Services.TerminateStream:input_type -> logdog.TerminateStreamRequest
+	6,  // 22: logdog.Services.UpdateStreamUsage:input_type -> logdog.UpdateStreamUsageRequest
+	8,  // 23: logdog.Services.ArchiveStream:input_type -> logdog.ArchiveStreamRequest
+	9,  // 24: logdog.Services.Batch:input_type -> logdog.BatchRequest
+	11, // 25: logdog.Services.LeaseArchiveTasks:input_type -> logdog.LeaseRequest
+	13, // 26: logdog.Services.DeleteArchiveTasks:input_type -> logdog.DeleteRequest
+	2,  // 27: logdog.Services.RegisterStream:output_type -> logdog.RegisterStreamResponse
+	4,  // 28: logdog.Services.LoadStream:output_type -> logdog.LoadStreamResponse
+	20, // 29: logdog.Services.TerminateStream:output_type -> google.protobuf.Empty
+	7,  // 30: logdog.Services.UpdateStreamUsage:output_type -> logdog.UpdateStreamUsageResponse
+	20, // 31: logdog.Services.ArchiveStream:output_type -> google.protobuf.Empty
+	10, // 32: logdog.Services.Batch:output_type -> logdog.BatchResponse
+	12, // 33: logdog.Services.LeaseArchiveTasks:output_type -> logdog.LeaseResponse
+	20, // 34: logdog.Services.DeleteArchiveTasks:output_type -> google.protobuf.Empty
+	27, // [27:35] is the sub-list for method output_type
+	19, // [19:27] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() {
@@ -1415,7 +1638,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_serv
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveStreamRequest); i {
+			switch v := v.(*UpdateStreamUsageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1427,7 +1650,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_serv
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchRequest); i {
+			switch v := v.(*UpdateStreamUsageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1439,7 +1662,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_serv
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchResponse); i {
+			switch v := v.(*ArchiveStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1451,7 +1674,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_serv
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaseRequest); i {
+			switch v := v.(*BatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1463,7 +1686,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_serv
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaseResponse); i {
+			switch v := v.(*BatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1475,7 +1698,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_serv
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRequest); i {
+			switch v := v.(*LeaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1487,7 +1710,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_serv
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchRequest_Entry); i {
+			switch v := v.(*LeaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1499,6 +1722,30 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_serv
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRequest_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchResponse_Entry); i {
 			case 0:
 				return &v.state
@@ -1511,16 +1758,18 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_serv
 			}
 		}
 	}
-	file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[12].OneofWrappers = []interface{}{
+	file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[15].OneofWrappers = []interface{}{
 		(*BatchRequest_Entry_RegisterStream)(nil),
 		(*BatchRequest_Entry_LoadStream)(nil),
 		(*BatchRequest_Entry_TerminateStream)(nil),
 		(*BatchRequest_Entry_ArchiveStream)(nil),
+		(*BatchRequest_Entry_UpdateStreamUsage)(nil),
 	}
-	file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[13].OneofWrappers = []interface{}{
+	file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*BatchResponse_Entry_Err)(nil),
 		(*BatchResponse_Entry_RegisterStream)(nil),
 		(*BatchResponse_Entry_LoadStream)(nil),
+		(*BatchResponse_Entry_UpdateStreamUsage)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1528,7 +1777,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_serv
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1561,6 +1810,9 @@ type ServicesClient interface {
 	// TerminateStream is an idempotent operation to update the stream's terminal
 	// index.
 	TerminateStream(ctx context.Context, in *TerminateStreamRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// UpdateStreamUsage adds log entry data that a Collector stored for a stream
+	// to the stream's and its project's ingestion usage.
+	UpdateStreamUsage(ctx context.Context, in *UpdateStreamUsageRequest, opts ...grpc.CallOption) (*UpdateStreamUsageResponse, error)
 	// ArchiveStream is an idempotent operation to record a log stream's archival
 	// parameters. It is used by the Archivist service upon successful stream
 	// archival.
@@ -1611,6 +1863,15 @@ func (c *servicesPRPCClient) TerminateStream(ctx context.Context, in *TerminateS
 	return out, nil
 }
 
+func (c *servicesPRPCClient) UpdateStreamUsage(ctx context.Context, in *UpdateStreamUsageRequest, opts ...grpc.CallOption) (*UpdateStreamUsageResponse, error) {
+	out := new(UpdateStreamUsageResponse)
+	err := c.client.Call(ctx, "logdog.Services", "UpdateStreamUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *servicesPRPCClient) ArchiveStream(ctx context.Context, in *ArchiveStreamRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.client.Call(ctx, "logdog.Services", "ArchiveStream", in, out, opts...)
@@ -1682,6 +1943,15 @@ func (c *servicesClient) TerminateStream(ctx context.Context, in *TerminateStrea
 	return out, nil
 }
 
+func (c *servicesClient) UpdateStreamUsage(ctx context.Context, in *UpdateStreamUsageRequest, opts ...grpc.CallOption) (*UpdateStreamUsageResponse, error) {
+	out := new(UpdateStreamUsageResponse)
+	err := c.cc.Invoke(ctx, "/logdog.Services/UpdateStreamUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *servicesClient) ArchiveStream(ctx context.Context, in *ArchiveStreamRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/logdog.Services/ArchiveStream", in, out, opts...)
@@ -1727,6 +1997,9 @@ type ServicesServer interface {
 	// TerminateStream is an idempotent operation to update the stream's terminal
 	// index.
 	TerminateStream(context.Context, *TerminateStreamRequest) (*empty.Empty, error)
+	// UpdateStreamUsage adds log entry data that a Collector stored for a stream
+	// to the stream's and its project's ingestion usage.
+	UpdateStreamUsage(context.Context, *UpdateStreamUsageRequest) (*UpdateStreamUsageResponse, error)
 	// ArchiveStream is an idempotent operation to record a log stream's archival
 	// parameters. It is used by the Archivist service upon successful stream
 	// archival.
@@ -1756,6 +2029,9 @@ func (*UnimplementedServicesServer) LoadStream(context.Context, *LoadStreamReque
 func (*UnimplementedServicesServer) TerminateStream(context.Context, *TerminateStreamRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateStream not implemented")
 }
+func (*UnimplementedServicesServer) UpdateStreamUsage(context.Context, *UpdateStreamUsageRequest) (*UpdateStreamUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStreamUsage not implemented")
+}
 func (*UnimplementedServicesServer) ArchiveStream(context.Context, *ArchiveStreamRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveStream not implemented")
 }
@@ -1827,6 +2103,24 @@ func _Services_TerminateStream_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Services_UpdateStreamUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStreamUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServicesServer).UpdateStreamUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logdog.Services/UpdateStreamUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServicesServer).UpdateStreamUsage(ctx, req.(*UpdateStreamUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Services_ArchiveStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ArchiveStreamRequest)
 	if err := dec(in); err != nil {
@@ -1915,6 +2209,10 @@ var _Services_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TerminateStream",
 			Handler:    _Services_TerminateStream_Handler,
 		},
+		{
+			MethodName: "UpdateStreamUsage",
+			Handler:    _Services_UpdateStreamUsage_Handler,
+		},
 		{
 			MethodName: "ArchiveStream",
 			Handler:    _Services_ArchiveStream_Handler,
@@ -76,6 +76,23 @@ func (s *DecoratedServices) TerminateStream(ctx context.Context, req *TerminateS
 	return
 }
 
+func (s *DecoratedServices) UpdateStreamUsage(ctx context.Context, req *UpdateStreamUsageRequest) (rsp *UpdateStreamUsageResponse, err error) {
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "UpdateStreamUsage", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		rsp, err = s.Service.UpdateStreamUsage(ctx, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "UpdateStreamUsage", rsp, err)
+	}
+	return
+}
+
 func (s *DecoratedServices) ArchiveStream(ctx context.Context, req *ArchiveStreamRequest) (rsp *empty.Empty, err error) {
 	if s.Prelude != nil {
 		var newCtx context.Context
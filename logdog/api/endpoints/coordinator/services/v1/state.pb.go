@@ -52,6 +52,15 @@ type LogStreamState struct {
 	Archived bool `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
 	// If the log stream has been purged.
 	Purged bool `protobuf:"varint,5,opt,name=purged,proto3" json:"purged,omitempty"`
+	// The number of bytes of log entry data stored for the log stream, as
+	// reported through UpdateStreamUsage.
+	IngestedBytes int64 `protobuf:"varint,6,opt,name=ingested_bytes,json=ingestedBytes,proto3" json:"ingested_bytes,omitempty"`
+	// The number of log streams that were registered under the log stream's
+	// prefix when it was registered, including itself.
+	//
+	// Counting stops once the prefix's streams_per_prefix quota is exceeded, and
+	// is zero if the project has no such quota.
+	PrefixStreams int64 `protobuf:"varint,7,opt,name=prefix_streams,json=prefixStreams,proto3" json:"prefix_streams,omitempty"`
 }
 
 func (x *LogStreamState) Reset() {
@@ -121,6 +130,20 @@ func (x *LogStreamState) GetPurged() bool {
 	return false
 }
 
+func (x *LogStreamState) GetIngestedBytes() int64 {
+	if x != nil {
+		return x.IngestedBytes
+	}
+	return 0
+}
+
+func (x *LogStreamState) GetPrefixStreams() int64 {
+	if x != nil {
+		return x.PrefixStreams
+	}
+	return 0
+}
+
 var File_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_state_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_state_proto_rawDesc = []byte{
@@ -129,7 +152,7 @@ var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_state
 	0x69, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6f, 0x72,
 	0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
 	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x06, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x22, 0xa8, 0x01, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x53,
+	0x06, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x22, 0xf6, 0x01, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x53,
 	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
@@ -140,12 +163,17 @@ var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_services_v1_state
 	0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
 	0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
 	0x72, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x72, 0x67,
-	0x65, 0x64, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75,
-	0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x6c, 0x6f, 0x67, 0x64, 0x6f,
-	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f,
-	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x69, 0x6e, 0x67, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
+	0x42, 0x4a, 0x5a, 0x48, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e,
+	0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -31,6 +31,9 @@ func (ar *LoadStreamRequest) GetMessageProject() string { return ar.Project }
 // GetMessageProject implements ProjectBoundMessage.
 func (ar *TerminateStreamRequest) GetMessageProject() string { return ar.Project }
 
+// GetMessageProject implements ProjectBoundMessage.
+func (ar *UpdateStreamUsageRequest) GetMessageProject() string { return ar.Project }
+
 // GetMessageProject implements ProjectBoundMessage.
 func (ar *ArchiveStreamRequest) GetMessageProject() string { return ar.Project }
 
@@ -35,9 +35,10 @@ const _ = proto.ProtoPackageIsVersion4
 type StreamType int32
 
 const (
-	StreamType_TEXT     StreamType = 0
-	StreamType_BINARY   StreamType = 1
-	StreamType_DATAGRAM StreamType = 2
+	StreamType_TEXT       StreamType = 0
+	StreamType_BINARY     StreamType = 1
+	StreamType_DATAGRAM   StreamType = 2
+	StreamType_STRUCTURED StreamType = 3
 )
 
 // Enum value maps for StreamType.
@@ -46,11 +47,13 @@ var (
 		0: "TEXT",
 		1: "BINARY",
 		2: "DATAGRAM",
+		3: "STRUCTURED",
 	}
 	StreamType_value = map[string]int32{
-		"TEXT":     0,
-		"BINARY":   1,
-		"DATAGRAM": 2,
+		"TEXT":       0,
+		"BINARY":     1,
+		"DATAGRAM":   2,
+		"STRUCTURED": 3,
 	}
 )
 
@@ -130,6 +133,13 @@ type LogStreamDescriptor struct {
 	// If set, the stream will be joined together during archival to recreate the
 	// original stream and made available at <prefix>/+/<name>.ext.
 	BinaryFileExt string `protobuf:"bytes,7,opt,name=binary_file_ext,json=binaryFileExt,proto3" json:"binary_file_ext,omitempty"`
+	//
+	// For STRUCTURED streams, the keys of the fields that the stream's records
+	// may carry. If any fields are declared, records with fields not listed
+	// here are rejected.
+	//
+	// LogDog clients can query for structured log streams based on these keys.
+	Fields []string `protobuf:"bytes,8,rep,name=fields,proto3" json:"fields,omitempty"`
 }
 
 func (x *LogStreamDescriptor) Reset() {
@@ -213,6 +223,13 @@ func (x *LogStreamDescriptor) GetBinaryFileExt() string {
 	return ""
 }
 
+func (x *LogStreamDescriptor) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 // Text stream content.
 type Text struct {
 	state         protoimpl.MessageState
@@ -367,6 +384,54 @@ func (x *Datagram) GetPartial() *Datagram_Partial {
 	return nil
 }
 
+// Structured stream content: a single record of typed fields.
+type Structured struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*Structured_Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *Structured) Reset() {
+	*x = Structured{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Structured) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Structured) ProtoMessage() {}
+
+func (x *Structured) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Structured.ProtoReflect.Descriptor instead.
+func (*Structured) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_logdog_api_logpb_log_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Structured) GetFields() []*Structured_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 //*
 // An individual log entry.
 //
@@ -404,6 +469,8 @@ type LogEntry struct {
 	// Binary: This is the byte offset of the first byte in the included data.
 	// Datagram: This is the index of the datagram. The first datagram has index
 	//     zero.
+	// Structured: This is the index of the record. The first record has index
+	//     zero.
 	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	//
 	// The content of the message. The field that is populated here must
@@ -413,13 +480,14 @@ type LogEntry struct {
 	//	*LogEntry_Text
 	//	*LogEntry_Binary
 	//	*LogEntry_Datagram
+	//	*LogEntry_Structured
 	Content isLogEntry_Content `protobuf_oneof:"content"`
 }
 
 func (x *LogEntry) Reset() {
 	*x = LogEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[4]
+		mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -432,7 +500,7 @@ func (x *LogEntry) String() string {
 func (*LogEntry) ProtoMessage() {}
 
 func (x *LogEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[4]
+	mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +513,7 @@ func (x *LogEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_logdog_api_logpb_log_proto_rawDescGZIP(), []int{4}
+	return file_go_chromium_org_luci_logdog_api_logpb_log_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *LogEntry) GetTimeOffset() *duration.Duration {
@@ -504,6 +572,13 @@ func (x *LogEntry) GetDatagram() *Datagram {
 	return nil
 }
 
+func (x *LogEntry) GetStructured() *Structured {
+	if x, ok := x.GetContent().(*LogEntry_Structured); ok {
+		return x.Structured
+	}
+	return nil
+}
+
 type isLogEntry_Content interface {
 	isLogEntry_Content()
 }
@@ -523,12 +598,19 @@ type LogEntry_Datagram struct {
 	Datagram *Datagram `protobuf:"bytes,12,opt,name=datagram,proto3,oneof"`
 }
 
+type LogEntry_Structured struct {
+	// Structured stream: A structured record.
+	Structured *Structured `protobuf:"bytes,13,opt,name=structured,proto3,oneof"`
+}
+
 func (*LogEntry_Text) isLogEntry_Content() {}
 
 func (*LogEntry_Binary) isLogEntry_Content() {}
 
 func (*LogEntry_Datagram) isLogEntry_Content() {}
 
+func (*LogEntry_Structured) isLogEntry_Content() {}
+
 //*
 // LogIndex is an index into an at-rest log storage.
 //
@@ -581,7 +663,7 @@ type LogIndex struct {
 func (x *LogIndex) Reset() {
 	*x = LogIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[5]
+		mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -594,7 +676,7 @@ func (x *LogIndex) String() string {
 func (*LogIndex) ProtoMessage() {}
 
 func (x *LogIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[5]
+	mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,7 +689,7 @@ func (x *LogIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogIndex.ProtoReflect.Descriptor instead.
 func (*LogIndex) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_logdog_api_logpb_log_proto_rawDescGZIP(), []int{5}
+	return file_go_chromium_org_luci_logdog_api_logpb_log_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LogIndex) GetDesc() *LogStreamDescriptor {
@@ -671,7 +753,7 @@ type Text_Line struct {
 func (x *Text_Line) Reset() {
 	*x = Text_Line{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[7]
+		mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -684,7 +766,7 @@ func (x *Text_Line) String() string {
 func (*Text_Line) ProtoMessage() {}
 
 func (x *Text_Line) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[7]
+	mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,7 +817,7 @@ type Datagram_Partial struct {
 func (x *Datagram_Partial) Reset() {
 	*x = Datagram_Partial{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[8]
+		mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -748,7 +830,7 @@ func (x *Datagram_Partial) String() string {
 func (*Datagram_Partial) ProtoMessage() {}
 
 func (x *Datagram_Partial) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[8]
+	mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,6 +867,153 @@ func (x *Datagram_Partial) GetLast() bool {
 	return false
 }
 
+// A single typed key/value field.
+type Structured_Field struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The field's key (required).
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The field's value.
+	// Types that are assignable to Value:
+	//	*Structured_Field_StringValue
+	//	*Structured_Field_IntValue
+	//	*Structured_Field_DoubleValue
+	//	*Structured_Field_BoolValue
+	//	*Structured_Field_BytesValue
+	//	*Structured_Field_TimestampValue
+	Value isStructured_Field_Value `protobuf_oneof:"value"`
+}
+
+func (x *Structured_Field) Reset() {
+	*x = Structured_Field{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Structured_Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Structured_Field) ProtoMessage() {}
+
+func (x *Structured_Field) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Structured_Field.ProtoReflect.Descriptor instead.
+func (*Structured_Field) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_logdog_api_logpb_log_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *Structured_Field) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (m *Structured_Field) GetValue() isStructured_Field_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *Structured_Field) GetStringValue() string {
+	if x, ok := x.GetValue().(*Structured_Field_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *Structured_Field) GetIntValue() int64 {
+	if x, ok := x.GetValue().(*Structured_Field_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (x *Structured_Field) GetDoubleValue() float64 {
+	if x, ok := x.GetValue().(*Structured_Field_DoubleValue); ok {
+		return x.DoubleValue
+	}
+	return 0
+}
+
+func (x *Structured_Field) GetBoolValue() bool {
+	if x, ok := x.GetValue().(*Structured_Field_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *Structured_Field) GetBytesValue() []byte {
+	if x, ok := x.GetValue().(*Structured_Field_BytesValue); ok {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (x *Structured_Field) GetTimestampValue() *timestamp.Timestamp {
+	if x, ok := x.GetValue().(*Structured_Field_TimestampValue); ok {
+		return x.TimestampValue
+	}
+	return nil
+}
+
+type isStructured_Field_Value interface {
+	isStructured_Field_Value()
+}
+
+type Structured_Field_StringValue struct {
+	StringValue string `protobuf:"bytes,2,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type Structured_Field_IntValue struct {
+	IntValue int64 `protobuf:"varint,3,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type Structured_Field_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,4,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type Structured_Field_BoolValue struct {
+	BoolValue bool `protobuf:"varint,5,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type Structured_Field_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,6,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+}
+
+type Structured_Field_TimestampValue struct {
+	TimestampValue *timestamp.Timestamp `protobuf:"bytes,7,opt,name=timestamp_value,json=timestampValue,proto3,oneof"`
+}
+
+func (*Structured_Field_StringValue) isStructured_Field_Value() {}
+
+func (*Structured_Field_IntValue) isStructured_Field_Value() {}
+
+func (*Structured_Field_DoubleValue) isStructured_Field_Value() {}
+
+func (*Structured_Field_BoolValue) isStructured_Field_Value() {}
+
+func (*Structured_Field_BytesValue) isStructured_Field_Value() {}
+
+func (*Structured_Field_TimestampValue) isStructured_Field_Value() {}
+
 //
 // Entry is a single index entry.
 //
@@ -832,7 +1061,7 @@ type LogIndex_Entry struct {
 func (x *LogIndex_Entry) Reset() {
 	*x = LogIndex_Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[9]
+		mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -845,7 +1074,7 @@ func (x *LogIndex_Entry) String() string {
 func (*LogIndex_Entry) ProtoMessage() {}
 
 func (x *LogIndex_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[9]
+	mi := &file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,7 +1087,7 @@ func (x *LogIndex_Entry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogIndex_Entry.ProtoReflect.Descriptor instead.
 func (*LogIndex_Entry) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_logdog_api_logpb_log_proto_rawDescGZIP(), []int{5, 0}
+	return file_go_chromium_org_luci_logdog_api_logpb_log_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *LogIndex_Entry) GetOffset() uint64 {
@@ -906,7 +1135,7 @@ var file_go_chromium_org_luci_logdog_api_logpb_log_proto_rawDesc = []byte{
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
 	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xed, 0x02, 0x0a, 0x13, 0x4c, 0x6f,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x03, 0x0a, 0x13, 0x4c, 0x6f,
 	0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
 	0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
@@ -926,81 +1155,108 @@ var file_go_chromium_org_luci_logdog_api_logpb_log_proto_rawDesc = []byte{
 	0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x69, 0x6e, 0x61,
 	0x72, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0d, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74,
-	0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6a, 0x0a, 0x04, 0x54, 0x65, 0x78,
-	0x74, 0x12, 0x26, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x2e, 0x4c, 0x69,
-	0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x04, 0x4c, 0x69, 0x6e,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x65, 0x72, 0x22, 0x22, 0x0a, 0x06, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x9a, 0x01, 0x0a, 0x08, 0x44, 0x61,
-	0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f,
-	0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x61, 0x6c, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x1a, 0x47, 0x0a,
-	0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69,
-	0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x22, 0xae, 0x02, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x04,
-	0x74, 0x65, 0x78, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x42, 0x69, 0x6e,
-	0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x2d, 0x0a,
-	0x08, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d,
-	0x48, 0x00, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x09, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xab, 0x03, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x04,
-	0x64, 0x65, 0x73, 0x63, 0x12, 0x2f, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
-	0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6c, 0x61,
-	0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a,
-	0x0f, 0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xbd, 0x01, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x69, 0x6d,
-	0x65, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x4f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x2a, 0x30, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x41, 0x54,
-	0x41, 0x47, 0x52, 0x41, 0x4d, 0x10, 0x02, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x6f, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f,
-	0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6c, 0x6f, 0x67, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x6a, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62,
+	0x2e, 0x54, 0x65, 0x78, 0x74, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x1a, 0x3a, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x22, 0x22, 0x0a,
+	0x06, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x04, 0x08, 0x01, 0x10,
+	0x02, 0x22, 0x9a, 0x01, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x67, 0x72, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x07, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x61, 0x6c, 0x1a, 0x47, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x22, 0xd6,
+	0x02, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x12, 0x2f, 0x0a,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x96,
+	0x02, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23,
+	0x0a, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x45, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0e,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe3, 0x02, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x42, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x2d,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61,
+	0x6d, 0x48, 0x00, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x33, 0x0a,
+	0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xab, 0x03,
+	0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x65,
+	0x73, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x70, 0x62,
+	0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x2f, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f,
+	0x67, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x6f,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xbd, 0x01, 0x0a, 0x05,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x3a, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x2a, 0x40, 0x0a, 0x0a, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58,
+	0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x44, 0x41, 0x54, 0x41, 0x47, 0x52, 0x41, 0x4d, 0x10, 0x02, 0x12, 0x0e, 0x0a,
+	0x0a, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x03, 0x42, 0x27, 0x5a,
+	0x25, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67,
+	0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1016,40 +1272,45 @@ func file_go_chromium_org_luci_logdog_api_logpb_log_proto_rawDescGZIP() []byte {
 }
 
 var file_go_chromium_org_luci_logdog_api_logpb_log_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_go_chromium_org_luci_logdog_api_logpb_log_proto_goTypes = []interface{}{
 	(StreamType)(0),             // 0: logpb.StreamType
 	(*LogStreamDescriptor)(nil), // 1: logpb.LogStreamDescriptor
 	(*Text)(nil),                // 2: logpb.Text
 	(*Binary)(nil),              // 3: logpb.Binary
 	(*Datagram)(nil),            // 4: logpb.Datagram
-	(*LogEntry)(nil),            // 5: logpb.LogEntry
-	(*LogIndex)(nil),            // 6: logpb.LogIndex
-	nil,                         // 7: logpb.LogStreamDescriptor.TagsEntry
-	(*Text_Line)(nil),           // 8: logpb.Text.Line
-	(*Datagram_Partial)(nil),    // 9: logpb.Datagram.Partial
-	(*LogIndex_Entry)(nil),      // 10: logpb.LogIndex.Entry
-	(*timestamp.Timestamp)(nil), // 11: google.protobuf.Timestamp
-	(*duration.Duration)(nil),   // 12: google.protobuf.Duration
+	(*Structured)(nil),          // 5: logpb.Structured
+	(*LogEntry)(nil),            // 6: logpb.LogEntry
+	(*LogIndex)(nil),            // 7: logpb.LogIndex
+	nil,                         // 8: logpb.LogStreamDescriptor.TagsEntry
+	(*Text_Line)(nil),           // 9: logpb.Text.Line
+	(*Datagram_Partial)(nil),    // 10: logpb.Datagram.Partial
+	(*Structured_Field)(nil),    // 11: logpb.Structured.Field
+	(*LogIndex_Entry)(nil),      // 12: logpb.LogIndex.Entry
+	(*timestamp.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(*duration.Duration)(nil),   // 14: google.protobuf.Duration
 }
 var file_go_chromium_org_luci_logdog_api_logpb_log_proto_depIdxs = []int32{
 	0,  // 0: logpb.LogStreamDescriptor.stream_type:type_name -> logpb.StreamType
-	11, // 1: logpb.LogStreamDescriptor.timestamp:type_name -> google.protobuf.Timestamp
-	7,  // 2: logpb.LogStreamDescriptor.tags:type_name -> logpb.LogStreamDescriptor.TagsEntry
-	8,  // 3: logpb.Text.lines:type_name -> logpb.Text.Line
-	9,  // 4: logpb.Datagram.partial:type_name -> logpb.Datagram.Partial
-	12, // 5: logpb.LogEntry.time_offset:type_name -> google.protobuf.Duration
-	2,  // 6: logpb.LogEntry.text:type_name -> logpb.Text
-	3,  // 7: logpb.LogEntry.binary:type_name -> logpb.Binary
-	4,  // 8: logpb.LogEntry.datagram:type_name -> logpb.Datagram
-	1,  // 9: logpb.LogIndex.desc:type_name -> logpb.LogStreamDescriptor
-	10, // 10: logpb.LogIndex.entries:type_name -> logpb.LogIndex.Entry
-	12, // 11: logpb.LogIndex.Entry.time_offset:type_name -> google.protobuf.Duration
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	13, // 1: logpb.LogStreamDescriptor.timestamp:type_name -> google.protobuf.Timestamp
+	8,  // 2: logpb.LogStreamDescriptor.tags:type_name -> logpb.LogStreamDescriptor.TagsEntry
+	9,  // 3: logpb.Text.lines:type_name -> logpb.Text.Line
+	10, // 4: logpb.Datagram.partial:type_name -> logpb.Datagram.Partial
+	11, // 5: logpb.Structured.fields:type_name -> logpb.Structured.Field
+	14, // 6: logpb.LogEntry.time_offset:type_name -> google.protobuf.Duration
+	2,  // 7: logpb.LogEntry.text:type_name -> logpb.Text
+	3,  // 8: logpb.LogEntry.binary:type_name -> logpb.Binary
+	4,  // 9: logpb.LogEntry.datagram:type_name -> logpb.Datagram
+	5,  // 10: logpb.LogEntry.structured:type_name -> logpb.Structured
+	1,  // 11: logpb.LogIndex.desc:type_name -> logpb.LogStreamDescriptor
+	12, // 12: logpb.LogIndex.entries:type_name -> logpb.LogIndex.Entry
+	13, // 13: logpb.Structured.Field.timestamp_value:type_name -> google.protobuf.Timestamp
+	14, // 14: logpb.LogIndex.Entry.time_offset:type_name -> google.protobuf.Duration
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_logdog_api_logpb_log_proto_init() }
@@ -1107,7 +1368,7 @@ func file_go_chromium_org_luci_logdog_api_logpb_log_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogEntry); i {
+			switch v := v.(*Structured); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1119,6 +1380,18 @@ func file_go_chromium_org_luci_logdog_api_logpb_log_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogIndex); i {
 			case 0:
 				return &v.state
@@ -1130,7 +1403,7 @@ func file_go_chromium_org_luci_logdog_api_logpb_log_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Text_Line); i {
 			case 0:
 				return &v.state
@@ -1142,7 +1415,7 @@ func file_go_chromium_org_luci_logdog_api_logpb_log_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Datagram_Partial); i {
 			case 0:
 				return &v.state
@@ -1154,7 +1427,19 @@ func file_go_chromium_org_luci_logdog_api_logpb_log_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Structured_Field); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogIndex_Entry); i {
 			case 0:
 				return &v.state
@@ -1167,10 +1452,19 @@ func file_go_chromium_org_luci_logdog_api_logpb_log_proto_init() {
 			}
 		}
 	}
-	file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*LogEntry_Text)(nil),
 		(*LogEntry_Binary)(nil),
 		(*LogEntry_Datagram)(nil),
+		(*LogEntry_Structured)(nil),
+	}
+	file_go_chromium_org_luci_logdog_api_logpb_log_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*Structured_Field_StringValue)(nil),
+		(*Structured_Field_IntValue)(nil),
+		(*Structured_Field_DoubleValue)(nil),
+		(*Structured_Field_BoolValue)(nil),
+		(*Structured_Field_BytesValue)(nil),
+		(*Structured_Field_TimestampValue)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1178,7 +1472,7 @@ func file_go_chromium_org_luci_logdog_api_logpb_log_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_logdog_api_logpb_log_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -49,7 +49,21 @@ func (d *LogStreamDescriptor) Validate(prefix bool) error {
 
 	switch d.StreamType {
 	case StreamType_TEXT, StreamType_BINARY, StreamType_DATAGRAM:
-		break
+		if len(d.Fields) > 0 {
+			return errors.New("fields may only be declared on structured streams")
+		}
+
+	case StreamType_STRUCTURED:
+		seen := make(map[string]struct{}, len(d.Fields))
+		for _, k := range d.Fields {
+			if k == "" {
+				return errors.New("empty field key")
+			}
+			if _, ok := seen[k]; ok {
+				return fmt.Errorf("duplicate field key %q", k)
+			}
+			seen[k] = struct{}{}
+		}
 
 	default:
 		return fmt.Errorf("invalid stream type: %v", d.StreamType)
@@ -107,6 +121,48 @@ func (e *LogEntry) Validate(d *LogStreamDescriptor) error {
 		if d := e.GetDatagram(); d == nil {
 			return ErrNoContent
 		}
+
+	case StreamType_STRUCTURED:
+		if s := e.GetStructured(); s == nil {
+			return ErrNoContent
+		}
 	}
 	return nil
 }
+
+// ValidateRecord returns an error if the supplied structured record has
+// fields that are unkeyed, repeated, or valueless.
+//
+// If the LogStreamDescriptor declares Fields, the record's fields must also be
+// among them.
+func (d *LogStreamDescriptor) ValidateRecord(s *Structured) error {
+	seen := make(map[string]struct{}, len(s.GetFields()))
+	for _, f := range s.GetFields() {
+		switch {
+		case f.Key == "":
+			return errors.New("field has no key")
+		case f.Value == nil:
+			return fmt.Errorf("field %q has no value", f.Key)
+		}
+		if _, ok := seen[f.Key]; ok {
+			return fmt.Errorf("duplicate field %q", f.Key)
+		}
+		seen[f.Key] = struct{}{}
+
+		if len(d.Fields) > 0 && !d.HasField(f.Key) {
+			return fmt.Errorf("field %q is not declared by the stream", f.Key)
+		}
+	}
+	return nil
+}
+
+// HasField returns true if the LogStreamDescriptor declares the supplied
+// structured field key.
+func (d *LogStreamDescriptor) HasField(key string) bool {
+	for _, k := range d.GetFields() {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
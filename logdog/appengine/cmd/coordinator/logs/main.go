@@ -92,6 +92,7 @@ func main() {
 			server.CookieAuth,
 			&auth.GoogleOAuth2Method{Scopes: []string{commonAuth.OAuthScopeEmail}}))
 	r.GET("/logs/*path", httpMW, logs.GetHandler)
+	r.GET("/follow/*path", httpMW, logs.FollowHandler)
 
 	// Run forever.
 	logging.Infof(c, "Listening on port 8080...")
@@ -177,6 +177,16 @@ func (ts *TestStream) LogEntry(c context.Context, i int) *logpb.LogEntry {
 				Data: []byte(message),
 			},
 		}
+
+	case logpb.StreamType_STRUCTURED:
+		le.Content = &logpb.LogEntry_Structured{
+			Structured: &logpb.Structured{
+				Fields: []*logpb.Structured_Field{{
+					Key:   "message",
+					Value: &logpb.Structured_Field_StringValue{StringValue: message},
+				}},
+			},
+		}
 	}
 	return &le
 }
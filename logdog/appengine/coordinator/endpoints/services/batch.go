@@ -123,6 +123,15 @@ func (s *server) processBatchEntry(c context.Context, e *logdog.BatchRequest_Ent
 			return
 		})
 
+	case *logdog.BatchRequest_Entry_UpdateStreamUsage:
+		var resp *logdog.UpdateStreamUsageResponse
+		err = enterSingleContext(c, req.UpdateStreamUsage, func(c context.Context) (err error) {
+			if resp, err = s.UpdateStreamUsage(c, req.UpdateStreamUsage); err == nil {
+				r.Value = &logdog.BatchResponse_Entry_UpdateStreamUsage{UpdateStreamUsage: resp}
+			}
+			return
+		})
+
 	case *logdog.BatchRequest_Entry_ArchiveStream:
 		err = enterSingleContext(c, req.ArchiveStream, func(c context.Context) (err error) {
 			_, err = s.ArchiveStream(c, req.ArchiveStream)
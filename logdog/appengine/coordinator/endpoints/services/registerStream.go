@@ -53,6 +53,8 @@ func buildLogStreamState(ls *coordinator.LogStream, lst *coordinator.LogStreamSt
 		TerminalIndex: lst.TerminalIndex,
 		Archived:      lst.ArchivalState().Archived(),
 		Purged:        ls.Purged,
+		IngestedBytes: lst.IngestedBytes,
+		PrefixStreams: lst.PrefixStreams,
 	}
 	if !lst.Terminated() {
 		st.TerminalIndex = -1
@@ -168,7 +170,15 @@ func (s *server) RegisterStream(c context.Context, req *logdog.RegisterStreamReq
 			return nil, err
 		}
 
-		// The stream does not exist. Proceed with transactional registration.
+		// The stream does not exist. Count the streams that share its prefix for
+		// the Collector's quota. This can't be done transactionally, so streams
+		// that are registered concurrently may be given the same count.
+		prefixStreams, err := countPrefixStreams(c, prefix)
+		if err != nil {
+			return nil, err
+		}
+
+		// Proceed with transactional registration.
 		err = ds.RunInTransaction(c, func(c context.Context) error {
 			// Load our state and stream (transactional).
 			switch err := ds.Get(c, ls, lst); {
@@ -189,6 +199,7 @@ func (s *server) RegisterStream(c context.Context, req *logdog.RegisterStreamReq
 			lst.Created = now
 			lst.Updated = now
 			lst.Secret = pfx.Secret // Copy Prefix Secret to reduce datastore Gets.
+			lst.PrefixStreams = prefixStreams
 
 			// Construct our LogStream.
 			ls.Created = now
@@ -244,3 +255,27 @@ func (s *server) RegisterStream(c context.Context, req *logdog.RegisterStreamReq
 		State: buildLogStreamState(ls, lst),
 	}, nil
 }
+
+// countPrefixStreams returns the number of log streams registered under prefix,
+// plus one for the log stream being registered.
+//
+// Counting stops at one past the project's streams-per-prefix quota. If the
+// project has no such quota, nothing is counted and zero is returned.
+func countPrefixStreams(c context.Context, prefix types.StreamName) (int64, error) {
+	q, err := config.CollectorQuota(c, coordinator.CurrentProject(c))
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to load project quota.")
+		return 0, grpcutil.Internal
+	}
+	limit := q.StreamsPerPrefix
+	if limit <= 0 {
+		return 0, nil
+	}
+
+	count, err := ds.Count(c, ds.NewQuery("LogStream").Eq("Prefix", string(prefix)).KeysOnly(true).Limit(limit))
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to count log streams under prefix.")
+		return 0, grpcutil.Internal
+	}
+	return count + 1, nil
+}
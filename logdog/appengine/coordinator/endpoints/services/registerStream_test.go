@@ -204,6 +204,27 @@ func TestRegisterStream(t *testing.T) {
 					env.Clock.Add(10 * time.Minute)
 				})
 
+				Convey(`Counts the streams under the prefix if the project has a stream quota.`, func() {
+					env.ModProjectConfig(c, "proj-foo", func(pcfg *svcconfig.ProjectConfig) {
+						pcfg.CollectorQuota = &svcconfig.CollectorQuota{StreamsPerPrefix: 2}
+					})
+
+					register := func(name string) *logdog.LogStreamState {
+						req.Desc = ct.MakeStream(c, "proj-foo", types.StreamPath("testing/+/"+name)).DescBytes()
+						resp, err := svr.RegisterStream(c, &req)
+						So(err, ShouldBeRPCOK)
+						ds.GetTestable(c).CatchupIndexes()
+						return resp.State
+					}
+					So(register("foo/bar").PrefixStreams, ShouldEqual, 1)
+					So(register("foo/baz").PrefixStreams, ShouldEqual, 2)
+					So(register("foo/qux").PrefixStreams, ShouldEqual, 3)
+					So(register("foo/quux").PrefixStreams, ShouldEqual, 3)
+
+					// Registering a stream again doesn't count it again.
+					So(register("foo/bar").PrefixStreams, ShouldEqual, 1)
+				})
+
 				Convey(`Will schedule the correct archival expiration delay`, func() {
 					Convey(`When there is no project config delay.`, func() {
 						// Make it so that any 2s sleep timers progress.
@@ -27,6 +27,7 @@ import (
 	"go.chromium.org/luci/grpc/grpcutil"
 	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/services/v1"
 	"go.chromium.org/luci/logdog/appengine/coordinator"
+	"go.chromium.org/luci/logdog/common/types"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
@@ -57,6 +58,12 @@ func (s *server) TerminateStream(c context.Context, req *logdog.TerminateStreamR
 		return nil, grpcutil.Errf(codes.InvalidArgument, "Invalid ID (%s): %s", id, err)
 	}
 
+	for k, v := range req.Tags {
+		if err := types.ValidateTag(k, v); err != nil {
+			return nil, grpcutil.Errf(codes.InvalidArgument, "Invalid tag %q: %s", k, err)
+		}
+	}
+
 	// Initialize our log stream state.
 	lst := coordinator.NewLogStreamState(c, id)
 
@@ -79,11 +86,13 @@ func (s *server) TerminateStream(c context.Context, req *logdog.TerminateStreamR
 
 		case lst.Terminated():
 			// Succeed if this is non-conflicting (idempotent).
+			// Streams registered with their terminal index still report their
+			// tags here.
 			if lst.TerminalIndex == req.TerminalIndex {
 				log.Fields{
 					"terminalIndex": lst.TerminalIndex,
 				}.Infof(c, "Log stream is already terminated.")
-				return nil
+				return addStreamTags(c, id, req.Tags)
 			}
 
 			log.Fields{
@@ -104,6 +113,10 @@ func (s *server) TerminateStream(c context.Context, req *logdog.TerminateStreamR
 				}.Errorf(c, "Failed to Put() LogStream.")
 				return grpcutil.Internal
 			}
+
+			if err := addStreamTags(c, id, req.Tags); err != nil {
+				return err
+			}
 		}
 
 		return s.taskArchival(c, lst, coordinator.GetSettings(c).OptimisticArchivalDelay)
@@ -118,3 +131,50 @@ func (s *server) TerminateStream(c context.Context, req *logdog.TerminateStreamR
 	terminateStreamMetric.Add(c, 1, req.Project)
 	return &empty.Empty{}, nil
 }
+
+// addStreamTags adds tags that the stream doesn't have yet to its descriptor.
+//
+// Must be called within a transaction.
+func addStreamTags(c context.Context, id coordinator.HashID, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	ls := &coordinator.LogStream{ID: id}
+	if err := ds.Get(c, ls); err != nil {
+		log.WithError(err).Errorf(c, "Failed to load LogStream.")
+		return grpcutil.Internal
+	}
+	desc, err := ls.DescriptorProto()
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to unmarshal descriptor.")
+		return grpcutil.Internal
+	}
+
+	added := 0
+	for k, v := range tags {
+		if _, ok := desc.Tags[k]; !ok {
+			if desc.Tags == nil {
+				desc.Tags = make(map[string]string, len(tags))
+			}
+			desc.Tags[k] = v
+			added++
+		}
+	}
+	if added == 0 {
+		return nil
+	}
+
+	if err := ls.LoadDescriptor(desc); err != nil {
+		log.WithError(err).Errorf(c, "Invalid descriptor with added tags.")
+		return grpcutil.Errf(codes.InvalidArgument, "Invalid tags: %s", err)
+	}
+	if err := ds.Put(c, ls); err != nil {
+		log.WithError(err).Errorf(c, "Failed to Put() LogStream.")
+		return grpcutil.Internal
+	}
+	log.Fields{
+		"count": added,
+	}.Infof(c, "Added tags to the log stream descriptor.")
+	return nil
+}
@@ -106,6 +106,18 @@ func TestTerminateStream(t *testing.T) {
 						So(tls.State.ArchivalState(), ShouldEqual, coordinator.ArchiveTasked)
 					})
 
+					Convey(`Adds new tags when marked terminal again.`, func() {
+						req.Tags = map[string]string{"logdog.redactions": "3"}
+						_, err := svr.TerminateStream(c, &req)
+						So(err, ShouldBeRPCOK)
+
+						So(tls.Get(c), ShouldBeNil)
+						desc, err := tls.Stream.DescriptorProto()
+						So(err, ShouldBeNil)
+						So(desc.Tags, ShouldResemble, map[string]string{"logdog.redactions": "3"})
+						So(tls.State.TerminalIndex, ShouldEqual, 1337)
+					})
+
 					Convey(`Will reject attempts to change the terminal index.`, func() {
 						req.TerminalIndex = 1338
 						_, err := svr.TerminateStream(c, &req)
@@ -133,6 +145,37 @@ func TestTerminateStream(t *testing.T) {
 					})
 				})
 
+				Convey(`Adds new tags to the stream's descriptor.`, func() {
+					tls.Desc.Tags = map[string]string{"foo": "bar"}
+					tls.Reload(c)
+					So(tls.Put(c), ShouldBeNil)
+
+					req.Tags = map[string]string{
+						"foo":               "baz",
+						"logdog.redactions": "3",
+					}
+					_, err := svr.TerminateStream(c, &req)
+					So(err, ShouldBeRPCOK)
+
+					So(tls.Get(c), ShouldBeNil)
+					desc, err := tls.Stream.DescriptorProto()
+					So(err, ShouldBeNil)
+					So(desc.Tags, ShouldResemble, map[string]string{
+						"foo":               "bar",
+						"logdog.redactions": "3",
+					})
+					So(tls.State.TerminalIndex, ShouldEqual, 1337)
+				})
+
+				Convey(`Will reject invalid tags.`, func() {
+					req.Tags = map[string]string{"": "value"}
+					_, err := svr.TerminateStream(c, &req)
+					So(err, ShouldBeRPCInvalidArgument, "Invalid tag")
+
+					So(tls.Get(c), ShouldBeNil)
+					So(tls.State.Terminated(), ShouldBeFalse)
+				})
+
 				Convey(`Will return an internal server error if Put() fails.`, func() {
 					c, fb := featureBreaker.FilterRDS(c, nil)
 					fb.BreakFeatures(errors.New("test error"), "PutMulti")
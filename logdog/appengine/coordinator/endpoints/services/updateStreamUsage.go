@@ -0,0 +1,107 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+	mc "go.chromium.org/luci/gae/service/memcache"
+
+	"go.chromium.org/luci/common/clock"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/grpc/grpcutil"
+	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/services/v1"
+	"go.chromium.org/luci/logdog/appengine/coordinator"
+
+	"google.golang.org/grpc/codes"
+)
+
+// projectUsageKey returns the memcache key that holds the number of bytes
+// ingested for a project during the second containing now.
+func projectUsageKey(project string, now time.Time) string {
+	return fmt.Sprintf("collector_usage.%s.%d", project, now.Unix())
+}
+
+// UpdateStreamUsage adds stored log entry data to a stream's and its project's
+// ingestion usage.
+//
+// The stream's usage is stored with its state. The project's usage is counted
+// in one second windows in memcache, which is shared by all Collectors.
+//
+// Collectors aggregate a stream's usage and report it about once per second,
+// rather than for each bundle, so that this transaction rarely contends with
+// the stream's registration and termination. They retry failed updates.
+func (s *server) UpdateStreamUsage(c context.Context, req *logdog.UpdateStreamUsageRequest) (*logdog.UpdateStreamUsageResponse, error) {
+	log.Fields{
+		"project": req.Project,
+		"id":      req.Id,
+		"bytes":   req.Bytes,
+	}.Debugf(c, "Request to update log stream usage.")
+
+	if req.Bytes < 0 {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "Negative bytes.")
+	}
+
+	id := coordinator.HashID(req.Id)
+	if err := id.Normalize(); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "Invalid ID (%s): %s", id, err)
+	}
+
+	lst := coordinator.NewLogStreamState(c, id)
+	err := ds.RunInTransaction(c, func(c context.Context) error {
+		if err := ds.Get(c, lst); err != nil {
+			if err == ds.ErrNoSuchEntity {
+				log.Debugf(c, "Log stream state not found.")
+				return grpcutil.Errf(codes.NotFound, "Log stream %q is not registered", id)
+			}
+
+			log.WithError(err).Errorf(c, "Failed to load LogStreamState.")
+			return grpcutil.Internal
+		}
+
+		if subtle.ConstantTimeCompare(lst.Secret, req.Secret) != 1 {
+			log.Errorf(c, "Secrets do not match.")
+			return grpcutil.Errf(codes.InvalidArgument, "Request secret doesn't match the stream secret.")
+		}
+
+		lst.IngestedBytes += req.Bytes
+		lst.Updated = clock.Now(c).UTC()
+		if err := ds.Put(c, lst); err != nil {
+			log.WithError(err).Errorf(c, "Failed to Put() LogStreamState.")
+			return grpcutil.Internal
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to update LogStreamState.")
+		return nil, err
+	}
+
+	resp := logdog.UpdateStreamUsageResponse{StreamBytes: lst.IngestedBytes}
+
+	// The stream's usage is already stored, so failing to count the project's
+	// usage only leaves its rate quota unenforced for now.
+	key := projectUsageKey(req.Project, clock.Now(c))
+	if v, err := mc.Increment(c, key, req.Bytes, 0); err != nil {
+		log.WithError(err).Warningf(c, "Failed to update project usage.")
+	} else {
+		resp.ProjectBytes = int64(v)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/services/v1"
+	ct "go.chromium.org/luci/logdog/appengine/coordinator/coordinatorTest"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestUpdateStreamUsage(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration`, t, func() {
+		c, env := ct.Install(true)
+
+		svr := New(ServerSettings{NumQueues: 2})
+
+		tls := ct.MakeStream(c, "proj-foo", "testing/+/foo/bar")
+
+		req := logdog.UpdateStreamUsageRequest{
+			Project: string(tls.Project),
+			Id:      string(tls.Stream.ID),
+			Secret:  tls.Prefix.Secret,
+			Bytes:   100,
+		}
+
+		Convey(`Returns Forbidden error if not a service.`, func() {
+			_, err := svr.UpdateStreamUsage(c, &req)
+			So(err, ShouldBeRPCPermissionDenied)
+		})
+
+		Convey(`When logged in as a service`, func() {
+			env.JoinGroup("services")
+
+			Convey(`A registered stream, "testing/+/foo/bar"`, func() {
+				So(tls.Put(c), ShouldBeNil)
+
+				Convey(`Accumulates the stream's and the project's usage.`, func() {
+					resp, err := svr.UpdateStreamUsage(c, &req)
+					So(err, ShouldBeRPCOK)
+					So(resp, ShouldResembleProto, &logdog.UpdateStreamUsageResponse{
+						StreamBytes:  100,
+						ProjectBytes: 100,
+					})
+
+					// Another stream in the same project.
+					other := ct.MakeStream(c, "proj-foo", "testing/+/foo/baz")
+					So(other.Put(c), ShouldBeNil)
+					resp, err = svr.UpdateStreamUsage(c, &logdog.UpdateStreamUsageRequest{
+						Project: string(other.Project),
+						Id:      string(other.Stream.ID),
+						Secret:  other.Prefix.Secret,
+						Bytes:   50,
+					})
+					So(err, ShouldBeRPCOK)
+					So(resp, ShouldResembleProto, &logdog.UpdateStreamUsageResponse{
+						StreamBytes:  50,
+						ProjectBytes: 150,
+					})
+
+					So(tls.Get(c), ShouldBeNil)
+					So(tls.State.IngestedBytes, ShouldEqual, 100)
+
+					Convey(`Counts the project's usage per second.`, func() {
+						env.Clock.Add(time.Second)
+
+						resp, err := svr.UpdateStreamUsage(c, &req)
+						So(err, ShouldBeRPCOK)
+						So(resp, ShouldResembleProto, &logdog.UpdateStreamUsageResponse{
+							StreamBytes:  200,
+							ProjectBytes: 100,
+						})
+					})
+				})
+
+				Convey(`Will return a bad request error if the secret doesn't match.`, func() {
+					req.Secret[0] ^= 0xFF
+					_, err := svr.UpdateStreamUsage(c, &req)
+					So(err, ShouldBeRPCInvalidArgument, "Request secret doesn't match the stream secret.")
+				})
+
+				Convey(`Will reject negative usage.`, func() {
+					req.Bytes = -1
+					_, err := svr.UpdateStreamUsage(c, &req)
+					So(err, ShouldBeRPCInvalidArgument, "Negative bytes.")
+				})
+			})
+
+			Convey(`Will fail if the stream is not registered.`, func() {
+				_, err := svr.UpdateStreamUsage(c, &req)
+				So(err, ShouldBeRPCNotFound, "is not registered")
+			})
+		})
+	})
+}
@@ -0,0 +1,212 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/logdog/common/types"
+	"go.chromium.org/luci/server/router"
+)
+
+// Server-Sent Event types emitted by FollowHandler.
+const (
+	// followEventDescriptor carries the stream's LogStreamDescriptor. It is
+	// always the first event.
+	followEventDescriptor = "descriptor"
+	// followEventEntry carries a single LogEntry. Its event ID is the entry's
+	// stream index.
+	followEventEntry = "entry"
+	// followEventError carries a (non-fatal) error message.
+	followEventError = "error"
+	// followEventEnd signals that the stream is complete and that there are no
+	// more entries to send.
+	followEventEnd = "end"
+)
+
+// followHeader is set on every FollowHandler response, including errors, so
+// that clients can tell its 404 responses from those of a service that does
+// not serve the follow endpoint at all.
+//
+// Keep in sync with logdog/client/coordinator/follow.go.
+const followHeader = "X-LogDog-Follow"
+
+// followStartIndex returns the stream index that a follow request should start
+// at.
+//
+// A "Last-Event-ID" header, sent by Server-Sent Event clients when they
+// reconnect, takes precedence over the "index" query parameter.
+func followStartIndex(request *http.Request) (types.MessageIndex, error) {
+	if id := request.Header.Get("Last-Event-ID"); id != "" {
+		v, err := strconv.ParseInt(id, 10, 64)
+		if err != nil || v < 0 {
+			return 0, errors.Reason("invalid Last-Event-ID %q", id).Err()
+		}
+		return types.MessageIndex(v + 1), nil
+	}
+
+	if idx := request.URL.Query().Get("index"); idx != "" {
+		v, err := strconv.ParseInt(idx, 10, 64)
+		if err != nil || v < 0 {
+			return 0, errors.Reason("invalid index %q", idx).Err()
+		}
+		return types.MessageIndex(v), nil
+	}
+	return 0, nil
+}
+
+// writeEvent writes a single Server-Sent Event to w.
+//
+// data must not contain newlines. If id is negative, no event ID is written.
+func writeEvent(w io.Writer, event string, id int64, data string) error {
+	if id >= 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", id); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+	return err
+}
+
+// serveEvents reads log entries from data.ch and writes them into w as
+// Server-Sent Events.
+//
+// flush is called whenever the fetcher is idle, so that the client sees
+// entries promptly.
+func serveEvents(c context.Context, data logData, w io.Writer, flush func()) error {
+	m := jsonpb.Marshaler{OrigName: true}
+	marshal := func(pb proto.Message) (string, error) { return m.MarshalToString(pb) }
+
+	d, err := marshal(data.logDesc)
+	if err != nil {
+		return errors.Annotate(err, "marshalling descriptor").Err()
+	}
+	if err := writeEvent(w, followEventDescriptor, -1, d); err != nil {
+		return err
+	}
+	flush()
+
+	// lastErr is the error from the last response from the fetcher. If the
+	// fetcher stops after an error, the stream is not complete.
+	var lastErr error
+	for resp := range data.ch {
+		lastErr = resp.err
+		switch {
+		case resp.err != nil:
+			msg := resp.err.Error()
+			if grpcutil.Code(resp.err) == codes.Internal {
+				msg = "internal error"
+			}
+			if err := writeEvent(w, followEventError, -1, strconv.Quote(msg)); err != nil {
+				return err
+			}
+
+		case resp.log != nil:
+			d, err := marshal(resp.log)
+			if err != nil {
+				return errors.Annotate(err, "marshalling log entry").Err()
+			}
+			if err := writeEvent(w, followEventEntry, int64(resp.log.StreamIndex), d); err != nil {
+				return err
+			}
+			continue
+
+		default:
+			// The fetcher is idle. Send a comment to keep the connection alive.
+			if _, err := io.WriteString(w, ": keepalive\n\n"); err != nil {
+				return err
+			}
+		}
+		flush()
+	}
+
+	if lastErr != nil || c.Err() != nil {
+		// The fetcher stopped early. The client should reconnect to resume.
+		return lastErr
+	}
+	if err := writeEvent(w, followEventEnd, -1, "{}"); err != nil {
+		return err
+	}
+	flush()
+	return nil
+}
+
+// FollowHandler is an HTTP handler that pushes a log stream's entries to the
+// client as Server-Sent Events while they are ingested.
+//
+// The stream is followed from the stream index in the "index" query parameter,
+// or from the entry after the one named in the "Last-Event-ID" header. The
+// response ends with an "end" event once the stream's terminal entry has been
+// sent.
+func FollowHandler(ctx *router.Context) {
+	c, w := ctx.Context, ctx.Writer
+
+	w.Header().Set(followHeader, "1")
+
+	writeErr := func(err error) {
+		code := grpcutil.Code(err)
+		msg := err.Error()
+		if code == codes.Internal || code == codes.Unknown {
+			code, msg = codes.Internal, "LogDog encountered an internal error"
+		}
+		http.Error(w, msg, grpcutil.CodeStatus(code))
+	}
+
+	index, err := followStartIndex(ctx.Request)
+	if err != nil {
+		writeErr(grpcutil.InvalidArgumentTag.Apply(err))
+		return
+	}
+
+	data, err := startFetch(c, ctx.Request, ctx.Params.ByName("path"), index)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "failed to start fetch")
+		writeErr(err)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		flusher = &nopFlusher{}
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Tell nginx not to buffer anything.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if err := serveEvents(c, data, w, flusher.Flush); err != nil {
+		logging.WithError(err).Errorf(c, "failed to serve log events")
+
+		// Unblock the fetcher, which stops once the request is cancelled.
+		go func() {
+			for range data.ch {
+			}
+		}()
+	}
+}
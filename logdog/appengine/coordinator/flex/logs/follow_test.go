@@ -0,0 +1,152 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/grpc/grpcutil"
+	ct "go.chromium.org/luci/logdog/appengine/coordinator/coordinatorTest"
+	"go.chromium.org/luci/logdog/common/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestFollow(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration`, t, func() {
+		c, _ := ct.Install(true)
+		tls := ct.MakeStream(c, "proj-foo", "testing/+/foo/bar")
+		So(tls.Put(c), ShouldBeNil)
+
+		Convey(`followStartIndex`, func() {
+			req := httptest.NewRequest("GET", "/follow/proj-foo/testing/+/foo/bar", nil)
+
+			Convey(`Defaults to 0.`, func() {
+				idx, err := followStartIndex(req)
+				So(err, ShouldBeNil)
+				So(idx, ShouldEqual, types.MessageIndex(0))
+			})
+
+			Convey(`Uses the index query parameter.`, func() {
+				req = httptest.NewRequest("GET", "/follow/proj-foo/testing/+/foo/bar?index=42", nil)
+				idx, err := followStartIndex(req)
+				So(err, ShouldBeNil)
+				So(idx, ShouldEqual, types.MessageIndex(42))
+			})
+
+			Convey(`Resumes after Last-Event-ID.`, func() {
+				req = httptest.NewRequest("GET", "/follow/proj-foo/testing/+/foo/bar?index=42", nil)
+				req.Header.Set("Last-Event-ID", "7")
+				idx, err := followStartIndex(req)
+				So(err, ShouldBeNil)
+				So(idx, ShouldEqual, types.MessageIndex(8))
+			})
+
+			Convey(`Rejects invalid values.`, func() {
+				req = httptest.NewRequest("GET", "/follow/proj-foo/testing/+/foo/bar?index=-1", nil)
+				_, err := followStartIndex(req)
+				So(err, ShouldErrLike, "invalid index")
+
+				req.Header.Set("Last-Event-ID", "foo")
+				_, err = followStartIndex(req)
+				So(err, ShouldErrLike, "invalid Last-Event-ID")
+			})
+		})
+
+		Convey(`serveEvents`, func() {
+			resp := httptest.NewRecorder()
+			flushes := 0
+			flush := func() { flushes++ }
+
+			fakeData := func(data []logResp) logData {
+				ch := make(chan logResp)
+				go func() {
+					defer close(ch)
+					for _, item := range data {
+						ch <- item
+					}
+				}()
+				return logData{ch: ch, logDesc: tls.Desc}
+			}
+
+			events := func() []string {
+				var ret []string
+				for _, ev := range strings.Split(strings.TrimSuffix(resp.Body.String(), "\n\n"), "\n\n") {
+					ret = append(ret, strings.SplitN(ev, "\n", 2)[0])
+				}
+				return ret
+			}
+
+			Convey(`Sends the descriptor, entries and an end event.`, func() {
+				data := fakeData([]logResp{
+					{desc: tls.Desc, log: tls.LogEntry(c, 0)},
+					{},
+					{desc: tls.Desc, log: tls.LogEntry(c, 1)},
+					{},
+				})
+				So(serveEvents(c, data, resp, flush), ShouldBeNil)
+				So(events(), ShouldResemble, []string{
+					"event: descriptor",
+					"id: 0",
+					": keepalive",
+					"id: 1",
+					": keepalive",
+					"event: end",
+				})
+				So(resp.Body.String(), ShouldContainSubstring, `"stream_index":"1"`)
+				So(resp.Body.String(), ShouldContainSubstring, `"content_type":"`+tls.Desc.ContentType+`"`)
+				So(flushes, ShouldEqual, 4)
+			})
+
+			Convey(`Sends errors, hiding internal ones.`, func() {
+				data := fakeData([]logResp{
+					{err: errors.New("bad entry", grpcutil.InvalidArgumentTag)},
+					{err: errors.New("secret", grpcutil.InternalTag)},
+					{},
+				})
+				So(serveEvents(c, data, resp, flush), ShouldBeNil)
+				So(events(), ShouldResemble, []string{
+					"event: descriptor",
+					"event: error",
+					"event: error",
+					": keepalive",
+					"event: end",
+				})
+				So(resp.Body.String(), ShouldContainSubstring, `data: "bad entry"`)
+				So(resp.Body.String(), ShouldNotContainSubstring, "secret")
+			})
+
+			Convey(`Does not send an end event if the fetcher stopped on an error.`, func() {
+				data := fakeData([]logResp{
+					{desc: tls.Desc, log: tls.LogEntry(c, 0)},
+					{err: context.Canceled},
+				})
+				So(serveEvents(c, data, resp, flush), ShouldEqual, context.Canceled)
+				So(events(), ShouldResemble, []string{
+					"event: descriptor",
+					"id: 0",
+					"event: error",
+				})
+			})
+		})
+	})
+}
@@ -272,7 +272,9 @@ func initParams(c context.Context, stream *coordinator.LogStream, desc *logpb.Lo
 // It returns a logData struct containing:
 // * A channel where logs entries are sent back.
 // * Log Stream metadata and state.
-func startFetch(c context.Context, request *http.Request, pathStr string) (data logData, err error) {
+//
+// Log entries are fetched starting at stream index "index".
+func startFetch(c context.Context, request *http.Request, pathStr string, index types.MessageIndex) (data logData, err error) {
 	if data.options, err = resolveOptions(request, pathStr); err != nil {
 		err = errors.Annotate(err, "resolving options").Tag(grpcutil.InvalidArgumentTag).Err()
 		return
@@ -306,6 +308,7 @@ func startFetch(c context.Context, request *http.Request, pathStr string) (data
 	if err != nil {
 		return
 	}
+	param.index = index
 
 	// Create a channel to transfer log data.  This channel will be closed by
 	// fetch() to signal that all logs have been returned (or an error was encountered).
@@ -322,6 +325,9 @@ type fetchParams struct {
 	stream  *coordinator.LogStream
 	desc    *logpb.LogStreamDescriptor
 	state   *coordinator.LogStreamState
+
+	// index is the first stream index to fetch.
+	index types.MessageIndex
 }
 
 // fetch is a goroutine that fetches log entries from all storage layers and
@@ -334,7 +340,7 @@ func fetch(c context.Context, ch chan<- logResp, params fetchParams) {
 	st := params.storage
 	defer st.Close() // Close the connection to the backend when we're done.
 
-	index := types.MessageIndex(0)
+	index := params.index
 	backoff := time.Second // How long to wait between fetch requests from storage.
 	var err error
 	for {
@@ -745,7 +751,7 @@ func serve(c context.Context, data logData, w http.ResponseWriter) (err error) {
 func GetHandler(ctx *router.Context) {
 	start := clock.Now(ctx.Context)
 	// Start the fetcher and wait for fetched logs to arrive into ch.
-	data, err := startFetch(ctx.Context, ctx.Request, ctx.Params.ByName("path"))
+	data, err := startFetch(ctx.Context, ctx.Request, ctx.Params.ByName("path"), 0)
 	if err != nil {
 		logging.WithError(err).Errorf(ctx.Context, "failed to start fetch")
 		writeErrorPage(ctx, err, data)
@@ -153,6 +153,14 @@ func (r *queryRunner) runQuery(resp *logdog.QueryResponse) error {
 	}
 	q.MustHaveTags(r.Tags)
 
+	// Add structured field constraints.
+	for _, k := range r.Fields {
+		if k == "" {
+			return grpcutil.Errf(codes.InvalidArgument, "invalid field constraint: empty key")
+		}
+	}
+	q.MustHaveFields(r.Fields)
+
 	// The "State" boolean in the query request populates two pieces of data:
 	//   1) The Desc field in logdog.QueryResponse_Stream
 	//   2) The State field (of type logdog.LogStreamState) in
@@ -99,6 +99,9 @@ func TestQuery(t *testing.T) {
 			"meta/+/datagram/foo",
 			"meta/+/binary/foo",
 
+			"structured/+/build",
+			"structured/+/test",
+
 			"testing/+/foo/bar/baz",
 			"testing/+/baz",
 		} {
@@ -142,6 +145,10 @@ func TestQuery(t *testing.T) {
 					}
 				}
 			}
+			if prefix == "structured" {
+				tls.Desc.StreamType = logpb.StreamType_STRUCTURED
+				tls.Desc.Fields = []string{"step", tls.Stream.Name}
+			}
 
 			tls.Reload(c)
 			if err := tls.Put(c); err != nil {
@@ -488,5 +495,42 @@ func TestQuery(t *testing.T) {
 				So(err, ShouldBeRPCInvalidArgument, "invalid tag constraint")
 			})
 		})
+
+		Convey(`When querying for fields`, func() {
+			Convey(`Field "step", returns [structured/+/test, structured/+/build]`, func() {
+				req.Path = "structured/+/**"
+				req.Fields = []string{"step"}
+
+				resp, err := svr.Query(c, &req)
+				So(err, ShouldBeRPCOK)
+				So(resp, shouldHaveLogPaths, "structured/+/test", "structured/+/build")
+			})
+
+			Convey(`Fields "step", "test", returns [structured/+/test]`, func() {
+				req.Path = "structured/+/**"
+				req.Fields = []string{"step", "test"}
+
+				resp, err := svr.Query(c, &req)
+				So(err, ShouldBeRPCOK)
+				So(resp, shouldHaveLogPaths, "structured/+/test")
+			})
+
+			Convey(`Field "foo" does not match text streams`, func() {
+				req.Path = "testing/+/**"
+				req.Fields = []string{"foo"}
+
+				resp, err := svr.Query(c, &req)
+				So(err, ShouldBeRPCOK)
+				So(resp, shouldHaveLogPaths)
+			})
+
+			Convey(`When an empty field is specified, returns BadRequest error`, func() {
+				req.Path = "structured/+/**"
+				req.Fields = []string{""}
+
+				_, err := svr.Query(c, &req)
+				So(err, ShouldBeRPCInvalidArgument, "invalid field constraint")
+			})
+		})
 	})
 }
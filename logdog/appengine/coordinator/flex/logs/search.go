@@ -0,0 +1,346 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+
+	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/appengine/coordinator"
+	"go.chromium.org/luci/logdog/appengine/coordinator/flex"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/retry/transient"
+	ds "go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// searchResultLimit is the maximum number of matches that will be returned
+	// in a single search.
+	searchResultLimit = 100
+
+	// searchScanLimit is the maximum number of log entries that will be scanned
+	// in a single search. If the search is stopped by this limit, a Next cursor
+	// is returned so the caller can continue where it left off.
+	searchScanLimit = 10000
+
+	// searchSnippetLimit is the maximum size, in bytes, of a returned snippet.
+	searchSnippetLimit = 512
+)
+
+// searchCursor is the decoded form of a SearchResponse's Next value.
+type searchCursor struct {
+	// Cursor is the datastore cursor of the LogStream query, positioned at the
+	// stream to resume in.
+	Cursor string `json:"c,omitempty"`
+	// Index is the stream index to resume at within that stream.
+	Index uint64 `json:"i,omitempty"`
+	// Line is the line within the log entry at Index to resume at.
+	Line int `json:"l,omitempty"`
+}
+
+func (sc *searchCursor) encode() (string, error) {
+	d, err := json.Marshal(sc)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(d), nil
+}
+
+func decodeSearchCursor(v string) (*searchCursor, error) {
+	sc := &searchCursor{}
+	if v == "" {
+		return sc, nil
+	}
+	d, err := base64.RawURLEncoding.DecodeString(v)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(d, sc); err != nil {
+		return nil, err
+	}
+	return sc, nil
+}
+
+// Search returns log lines that match the requested pattern.
+func (s *server) Search(c context.Context, req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+	log.Fields{
+		"project": req.Project,
+		"path":    req.Path,
+		"pattern": req.Pattern,
+		"regexp":  req.Regexp,
+	}.Debugf(c, "Received search request.")
+
+	if req.Pattern == "" {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "`pattern` is required")
+	}
+	expr := req.Pattern
+	if !req.Regexp {
+		expr = regexp.QuoteMeta(expr)
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid `pattern`: %s", err)
+	}
+
+	q, err := coordinator.NewLogStreamQuery(req.Path)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       req.Path,
+		}.Errorf(c, "Invalid search path.")
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid search `path`")
+	}
+	if err := q.OnlyStreamType(logpb.StreamType_TEXT); err != nil {
+		panic(err) // TEXT is always a valid StreamType.
+	}
+
+	start, err := decodeSearchCursor(req.Next)
+	if err == nil {
+		err = q.SetCursor(c, start.Cursor)
+	}
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"cursor":     req.Next,
+		}.Errorf(c, "Failed to decode search cursor.")
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid `next` value")
+	}
+
+	r := searchRunner{
+		Context: c,
+		re:      re,
+		limit:   s.limit(int(req.MaxResults), searchResultLimit),
+		budget:  searchScanLimit,
+	}
+
+	startTime := clock.Now(c)
+	resp := logdog.SearchResponse{Project: coordinator.Project(c)}
+	if err := r.run(q, start, &resp); err != nil {
+		log.WithError(err).Errorf(c, "Failed to execute search.")
+		return nil, getGRPCError(err)
+	}
+
+	log.Fields{
+		"duration": clock.Now(c).Sub(startTime).String(),
+		"matches":  len(resp.Matches),
+		"scanned":  searchScanLimit - r.budget,
+	}.Debugf(c, "Search request completed successfully.")
+	return &resp, nil
+}
+
+type searchRunner struct {
+	context.Context
+
+	re *regexp.Regexp
+
+	// limit is the maximum number of matches to return.
+	limit int
+	// budget is the number of log entries that may still be scanned.
+	budget int
+}
+
+// run executes the search, populating resp.
+//
+// Streams are visited in LogStream query order. Within a stream, entries are
+// visited in stream index order. When either the match limit or the scan
+// budget is exhausted, resp.Next is set to resume at the next unvisited line.
+func (r *searchRunner) run(q *coordinator.LogStreamQuery, start *searchCursor, resp *logdog.SearchResponse) error {
+	// streamCursor is the query cursor positioned at the current stream.
+	streamCursor := start.Cursor
+	first := true
+
+	var next *searchCursor
+	err := q.Run(r, func(ls *coordinator.LogStream, cb ds.CursorCB) error {
+		pos := searchCursor{Cursor: streamCursor}
+		if first {
+			pos.Index, pos.Line = start.Index, start.Line
+			first = false
+		}
+
+		done, err := r.searchStream(ls, &pos, resp)
+		if err != nil {
+			return err
+		}
+		if !done {
+			next = &pos
+			return ds.Stop
+		}
+
+		cursor, err := cb()
+		if err != nil {
+			return err
+		}
+		streamCursor = cursor.String()
+		return nil
+	})
+	if err != nil {
+		return errors.Annotate(err, "failed to execute search").Err()
+	}
+
+	if next != nil {
+		if resp.Next, err = next.encode(); err != nil {
+			return errors.Annotate(err, "failed to encode search cursor").Err()
+		}
+	}
+	return nil
+}
+
+// searchStream scans a single log stream for matching lines, starting at pos.
+//
+// It returns true if the stream was fully scanned. Otherwise, the match limit
+// or scan budget was reached and pos is updated to the point at which the
+// search should be resumed.
+func (r *searchRunner) searchStream(ls *coordinator.LogStream, pos *searchCursor, resp *logdog.SearchResponse) (bool, error) {
+	lst := ls.State(r)
+	if err := ds.Get(r, lst); err != nil {
+		if ds.IsErrNoSuchEntity(err) {
+			// The stream was registered but has no state yet; nothing to search.
+			return true, nil
+		}
+		return false, errors.Annotate(err, "failed to load log stream state").Err()
+	}
+
+	st, err := flex.GetServices(r).StorageForStream(r, lst, coordinator.Project(r))
+	if err != nil {
+		return false, errors.Annotate(err, "failed to create storage instance").Err()
+	}
+	defer st.Close()
+
+	path := ls.Path()
+	for {
+		if r.budget <= 0 {
+			return false, nil
+		}
+
+		sreq := storage.GetRequest{
+			Project: coordinator.Project(r),
+			Path:    path,
+			Index:   types.MessageIndex(pos.Index),
+			Limit:   r.budget,
+		}
+
+		var ierr error
+		full := false
+		scanned := 0
+		err := retry.Retry(r, transient.Only(retry.Default), func() error {
+			return st.Get(r, sreq, func(e *storage.Entry) bool {
+				var le *logpb.LogEntry
+				if le, ierr = e.GetLogEntry(); ierr != nil {
+					return false
+				}
+				if le.StreamIndex < pos.Index {
+					// Already scanned (e.g. during a retried request).
+					return true
+				}
+				if le.StreamIndex > pos.Index {
+					pos.Index, pos.Line = le.StreamIndex, 0
+				}
+
+				scanned++
+				r.budget--
+				if full = r.searchEntry(path, le, pos, resp); full {
+					return false
+				}
+				pos.Index, pos.Line = le.StreamIndex+1, 0
+				return r.budget > 0
+			})
+		}, func(err error, delay time.Duration) {
+			log.Fields{
+				log.ErrorKey: err,
+				"delay":      delay,
+				"path":       path,
+				"index":      pos.Index,
+			}.Warningf(r, "Transient error while searching logs; retrying.")
+		})
+		switch {
+		case err == storage.ErrDoesNotExist:
+			return true, nil
+		case err != nil:
+			return false, errors.Annotate(err, "failed to read log entries").Err()
+		case ierr != nil:
+			return false, errors.Annotate(ierr, "bad log entry data").Err()
+		case full:
+			return false, nil
+		case scanned == 0:
+			// No more entries are available in this stream.
+			return true, nil
+		}
+	}
+}
+
+// searchEntry adds matches from le's lines, starting at pos.Line, to resp.
+//
+// It returns true if the match limit was reached, in which case pos is updated
+// to point to the line after the last match.
+func (r *searchRunner) searchEntry(path types.StreamPath, le *logpb.LogEntry, pos *searchCursor, resp *logdog.SearchResponse) bool {
+	lines := le.GetText().GetLines()
+	for i := pos.Line; i < len(lines); i++ {
+		v := lines[i].Value
+		loc := r.re.FindIndex(v)
+		if loc == nil {
+			continue
+		}
+
+		resp.Matches = append(resp.Matches, &logdog.SearchResponse_Match{
+			Path:    string(path),
+			Index:   le.StreamIndex,
+			Line:    int32(i),
+			Snippet: snippet(v, loc[0], loc[1]),
+		})
+		if len(resp.Matches) >= r.limit {
+			pos.Line = i + 1
+			return true
+		}
+	}
+	return false
+}
+
+// snippet returns the portion of line to report for a match at [start, end).
+//
+// Lines longer than searchSnippetLimit are trimmed to a window that begins a
+// little before the match.
+func snippet(line []byte, start, end int) string {
+	if len(line) > searchSnippetLimit {
+		from := start - searchSnippetLimit/4
+		if end-from > searchSnippetLimit {
+			from = start
+		}
+		if from < 0 {
+			from = 0
+		}
+		to := from + searchSnippetLimit
+		if to > len(line) {
+			to = len(line)
+			from = to - searchSnippetLimit
+		}
+		line = line[from:to]
+	}
+	return strings.ToValidUTF8(string(line), "\uFFFD")
+}
@@ -0,0 +1,252 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/api/logpb"
+	ct "go.chromium.org/luci/logdog/appengine/coordinator/coordinatorTest"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration, a Search request`, t, func() {
+		c, env := ct.Install(true)
+
+		var svrBase server
+		svr := newService(&svrBase)
+
+		const project = "proj-foo"
+
+		// Install a set of log streams, each with a few lines of text.
+		putStream := func(path types.StreamPath, st logpb.StreamType, purged bool, lines ...[]string) {
+			tls := ct.MakeStream(c, project, path)
+			tls.Desc.StreamType = st
+			tls.Stream.Purged = purged
+			tls.Reload(c)
+			if err := tls.Put(c); err != nil {
+				panic(err)
+			}
+
+			for i, entryLines := range lines {
+				le := tls.LogEntry(c, i)
+				if st == logpb.StreamType_TEXT {
+					text := &logpb.Text{}
+					for _, l := range entryLines {
+						text.Lines = append(text.Lines, &logpb.Text_Line{Value: []byte(l), Delimiter: "\n"})
+					}
+					le.Content = &logpb.LogEntry_Text{Text: text}
+				}
+				d, err := proto.Marshal(le)
+				if err != nil {
+					panic(err)
+				}
+				err = env.BigTable.Put(c, storage.PutRequest{
+					Project: project,
+					Path:    path,
+					Index:   types.MessageIndex(i),
+					Values:  [][]byte{d},
+				})
+				if err != nil {
+					panic(err)
+				}
+			}
+			env.Clock.Add(time.Second)
+		}
+
+		putStream("testing/+/steps/compile", logpb.StreamType_TEXT, false,
+			[]string{"compiling foo.cc", "ERROR: foo.cc:1: oops"},
+			[]string{"compiling bar.cc"},
+			[]string{"ERROR: bar.cc:2: oops", "error: lowercase"})
+		putStream("testing/+/steps/test", logpb.StreamType_TEXT, false,
+			[]string{"running tests"},
+			[]string{"ERROR: test failed"})
+		putStream("testing/+/steps/binary", logpb.StreamType_BINARY, false,
+			[]string{"ERROR: binary"})
+		putStream("testing/+/steps/purged", logpb.StreamType_TEXT, true,
+			[]string{"ERROR: purged"})
+		putStream("other/+/steps/compile", logpb.StreamType_TEXT, false,
+			[]string{"ERROR: other"})
+		ds.GetTestable(c).CatchupIndexes()
+
+		req := logdog.SearchRequest{
+			Project: project,
+			Path:    "testing/+/**",
+			Pattern: "ERROR:",
+		}
+
+		type match struct {
+			path    string
+			index   uint64
+			line    int32
+			snippet string
+		}
+		matches := func(resp *logdog.SearchResponse) []match {
+			var ret []match
+			for _, m := range resp.Matches {
+				ret = append(ret, match{m.Path, m.Index, m.Line, m.Snippet})
+			}
+			return ret
+		}
+
+		// Streams are returned in descending creation order.
+		allErrors := []match{
+			{"testing/+/steps/test", 1, 0, "ERROR: test failed"},
+			{"testing/+/steps/compile", 0, 1, "ERROR: foo.cc:1: oops"},
+			{"testing/+/steps/compile", 2, 0, "ERROR: bar.cc:2: oops"},
+		}
+
+		Convey(`Will return all matching lines in text streams.`, func() {
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp.Project, ShouldEqual, project)
+			So(matches(resp), ShouldResemble, allErrors)
+			So(resp.Next, ShouldEqual, "")
+		})
+
+		Convey(`Will treat the pattern as a literal by default.`, func() {
+			req.Pattern = "foo.cc"
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(matches(resp), ShouldResemble, []match{
+				{"testing/+/steps/compile", 0, 0, "compiling foo.cc"},
+				{"testing/+/steps/compile", 0, 1, "ERROR: foo.cc:1: oops"},
+			})
+
+			req.Pattern = "f.o"
+			resp, err = svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp.Matches, ShouldBeEmpty)
+		})
+
+		Convey(`Will use a regular expression if requested.`, func() {
+			req.Pattern = "(?i)^error: .*oops$"
+			req.Regexp = true
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(matches(resp), ShouldResemble, allErrors[1:])
+		})
+
+		Convey(`Will restrict the search to the requested streams.`, func() {
+			req.Path = "testing/+/steps/test"
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(matches(resp), ShouldResemble, allErrors[:1])
+		})
+
+		Convey(`Will paginate through matches.`, func() {
+			for _, limit := range []int32{1, 2} {
+				Convey(fmt.Sprintf(`With MaxResults %d`, limit), func() {
+					req.MaxResults = limit
+
+					var all []match
+					for i := 0; ; i++ {
+						So(i, ShouldBeLessThan, 10)
+
+						resp, err := svr.Search(c, &req)
+						So(err, ShouldBeRPCOK)
+						So(len(resp.Matches), ShouldBeLessThanOrEqualTo, limit)
+						all = append(all, matches(resp)...)
+
+						if resp.Next == "" {
+							break
+						}
+						req.Next = resp.Next
+					}
+					So(all, ShouldResemble, allErrors)
+				})
+			}
+		})
+
+		Convey(`Will truncate long lines around the match.`, func() {
+			long := strings.Repeat("a", 1000) + "NEEDLE" + strings.Repeat("b", 1000)
+			putStream("testing/+/steps/long", logpb.StreamType_TEXT, false, []string{long})
+			ds.GetTestable(c).CatchupIndexes()
+
+			req.Pattern = "NEEDLE"
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp.Matches, ShouldHaveLength, 1)
+			So(len(resp.Matches[0].Snippet), ShouldEqual, searchSnippetLimit)
+			So(resp.Matches[0].Snippet, ShouldContainSubstring, "NEEDLE")
+		})
+
+		Convey(`Will fail with InvalidArgument if the pattern is empty.`, func() {
+			req.Pattern = ""
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "`pattern` is required")
+		})
+
+		Convey(`Will fail with InvalidArgument if the regular expression is invalid.`, func() {
+			req.Pattern = "("
+			req.Regexp = true
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid `pattern`")
+		})
+
+		Convey(`Will fail with InvalidArgument if the path is invalid.`, func() {
+			req.Path = "***"
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid search `path`")
+		})
+
+		Convey(`Will fail with InvalidArgument if the Next cursor is invalid.`, func() {
+			req.Next = "!!!"
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid `next` value")
+		})
+
+		Convey(`Will fail with Unauthenticated if the user can't access the project.`, func() {
+			req.Project = "proj-exclusive"
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCUnauthenticated)
+		})
+	})
+}
+
+func TestSearchSnippet(t *testing.T) {
+	t.Parallel()
+
+	Convey(`snippet`, t, func() {
+		Convey(`Returns short lines unmodified.`, func() {
+			So(snippet([]byte("hello world"), 6, 11), ShouldEqual, "hello world")
+		})
+
+		Convey(`Replaces invalid UTF-8.`, func() {
+			So(snippet([]byte("a\xffb"), 0, 1), ShouldEqual, "a�b")
+		})
+
+		Convey(`Keeps the match at the end of a long line.`, func() {
+			line := []byte(strings.Repeat("a", 1000) + "END")
+			s := snippet(line, 1000, 1003)
+			So(len(s), ShouldEqual, searchSnippetLimit)
+			So(strings.HasSuffix(s, "END"), ShouldBeTrue)
+		})
+	})
+}
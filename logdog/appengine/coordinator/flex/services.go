@@ -33,6 +33,7 @@ import (
 	"go.chromium.org/luci/logdog/common/storage"
 	"go.chromium.org/luci/logdog/common/storage/archive"
 	"go.chromium.org/luci/logdog/common/storage/bigtable"
+	"go.chromium.org/luci/logdog/common/storage/filesystem"
 	"go.chromium.org/luci/logdog/server/config"
 
 	gcbt "cloud.google.com/go/bigtable"
@@ -69,8 +70,9 @@ type GlobalServices struct {
 	// Signer is the signer instance to use.
 	Signer gaesigner.Signer
 
-	// gsClient is the application-global Google Storage client.
-	btStorage *bigtable.Storage
+	// intermediateStorage is the application-global intermediate Storage
+	// instance.
+	intermediateStorage storage.Storage
 
 	// gsClientFactory is the application-global creator of Google Storage clients.
 	gsClientFactory func(ctx context.Context, project string) (gs.Client, error)
@@ -103,8 +105,8 @@ func NewGlobalServices(c context.Context) (*GlobalServices, error) {
 	}
 
 	// Connect our clients.
-	if err := s.connectBigTableClient(c, cfg); err != nil {
-		return nil, errors.Annotate(err, "failed to connect BigTable client").Err()
+	if err := s.connectIntermediateStorage(c, cfg); err != nil {
+		return nil, errors.Annotate(err, "failed to connect intermediate storage").Err()
 	}
 
 	if err := s.createGoogleStorageClientFactory(c); err != nil {
@@ -114,12 +116,27 @@ func NewGlobalServices(c context.Context) (*GlobalServices, error) {
 	return &s, nil
 }
 
-func (gsvc *GlobalServices) connectBigTableClient(c context.Context, cfg *svcconfig.Config) error {
-	// Is BigTable configured?
+func (gsvc *GlobalServices) connectIntermediateStorage(c context.Context, cfg *svcconfig.Config) error {
 	if cfg.Storage == nil {
 		return errors.New("no storage configuration")
 	}
-	bt := cfg.Storage.GetBigtable()
+
+	if fs := cfg.Storage.GetFilesystem(); fs != nil {
+		if fs.Dir == "" {
+			return errors.New("missing filesystem storage directory")
+		}
+		log.Fields{
+			"dir": fs.Dir,
+		}.Debugf(c, "Using filesystem intermediate storage.")
+		gsvc.intermediateStorage = &filesystem.Storage{Dir: fs.Dir}
+		return nil
+	}
+
+	return gsvc.connectBigTableClient(c, cfg.Storage.GetBigtable())
+}
+
+func (gsvc *GlobalServices) connectBigTableClient(c context.Context, bt *svcconfig.Storage_BigTable) error {
+	// Is BigTable configured?
 	if bt == nil {
 		return errors.New("no BigTable configuration")
 	}
@@ -157,7 +174,7 @@ func (gsvc *GlobalServices) connectBigTableClient(c context.Context, cfg *svccon
 		return errors.Annotate(err, "failed to create BigTable client").Err()
 	}
 
-	gsvc.btStorage = &bigtable.Storage{
+	gsvc.intermediateStorage = &bigtable.Storage{
 		Client:   client,
 		LogTable: bt.LogTableName,
 		Cache:    gsvc.storageCache,
@@ -203,7 +220,7 @@ func (gsvc *GlobalServices) StorageForStream(c context.Context, lst *coordinator
 
 	if !lst.ArchivalState().Archived() {
 		log.Debugf(c, "Log is not archived. Fetching from intermediate storage.")
-		return noSignedURLStorage{gsvc.btStorage}, nil
+		return noSignedURLStorage{gsvc.intermediateStorage}, nil
 	}
 
 	// Some very old logs have malformed data where they claim to be archived but
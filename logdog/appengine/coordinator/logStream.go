@@ -393,6 +393,25 @@ func (lsp *LogStreamQuery) MustHaveTags(tags map[string]string) {
 	})
 }
 
+// MustHaveFields constrains LogStreams returned to be structured streams that
+// declare all of the given field keys.
+func (lsp *LogStreamQuery) MustHaveFields(fields []string) {
+	if len(fields) == 0 {
+		return
+	}
+	lsp.descChecks = append(lsp.descChecks, func(desc *logpb.LogStreamDescriptor) bool {
+		if desc.StreamType != logpb.StreamType_STRUCTURED {
+			return false
+		}
+		for _, k := range fields {
+			if !desc.HasField(k) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
 func (lsp *LogStreamQuery) filter(ls *LogStream) bool {
 	for _, checkFn := range lsp.checks {
 		if !checkFn(ls) {
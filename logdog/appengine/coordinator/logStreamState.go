@@ -130,6 +130,16 @@ type LogStreamState struct {
 	// zero if the file is not archived.
 	ArchiveStreamSize int64 `gae:",noindex"`
 
+	// IngestedBytes is the number of bytes of log entry data that Collectors
+	// have stored for this log stream.
+	IngestedBytes int64 `gae:",noindex"`
+	// PrefixStreams is the number of log streams that were registered under
+	// this log stream's prefix when it was registered, including itself.
+	//
+	// It is only counted up to one past the project's streams-per-prefix quota,
+	// and is zero if the project has no such quota.
+	PrefixStreams int64 `gae:",noindex"`
+
 	// extra causes datastore to ignore unrecognized fields and strip them in
 	// future writes.
 	extra ds.PropertyMap `gae:"-,extra"`
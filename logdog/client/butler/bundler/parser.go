@@ -87,6 +87,13 @@ func newParser(d *logpb.LogStreamDescriptor, c *counter) (parser, error) {
 			maxSize:    int64(types.MaxDatagramSize),
 		}, nil
 
+	case logpb.StreamType_STRUCTURED:
+		return &structuredParser{
+			baseParser: base,
+			desc:       d,
+			maxSize:    int64(types.MaxStructuredRecordSize),
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("unknown stream type: %v", d.StreamType)
 	}
@@ -20,6 +20,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"go.chromium.org/luci/common/proto/google"
 	"go.chromium.org/luci/logdog/api/logpb"
 )
@@ -49,6 +51,10 @@ type Stream interface {
 	// an error. The supplied Data must not be referenced after calling Append.
 	Append(Data) error
 
+	// SetTags adds or replaces tags in the Stream's descriptor. Bundle entries
+	// that are emitted after SetTags returns will carry the updated descriptor.
+	SetTags(tags map[string]string)
+
 	// Close closes the Stream, flushing any remaining data.
 	Close()
 }
@@ -183,6 +189,26 @@ func (s *streamImpl) signalDataConsumed() {
 	}
 }
 
+func (s *streamImpl) SetTags(tags map[string]string) {
+	if len(tags) == 0 {
+		return
+	}
+
+	s.stateLock.Lock()
+	defer s.stateLock.Unlock()
+
+	// Bundles that have already been built reference the current descriptor, so
+	// update a copy.
+	d := proto.Clone(s.c.template.Desc).(*logpb.LogStreamDescriptor)
+	if d.Tags == nil {
+		d.Tags = make(map[string]string, len(tags))
+	}
+	for k, v := range tags {
+		d.Tags[k] = v
+	}
+	s.c.template.Desc = d
+}
+
 func (s *streamImpl) Close() {
 	s.stateLock.Lock()
 	defer s.stateLock.Unlock()
@@ -388,5 +414,8 @@ func (s *streamImpl) setAppendErrorLocked(err error) {
 }
 
 func (s *streamImpl) streamDesc() *logpb.LogStreamDescriptor {
+	s.stateLock.Lock()
+	defer s.stateLock.Unlock()
+
 	return s.c.template.Desc
 }
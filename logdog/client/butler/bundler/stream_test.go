@@ -336,6 +336,21 @@ func TestStream(t *testing.T) {
 				So(bb.bundle(), shouldHaveBundleEntries, "test:a:b")
 			})
 
+			Convey(`SetTags updates the descriptor of subsequent entries only.`, func() {
+				tp.tags(tc.Now(), "a")
+				So(s.nextBundleEntry(bb, false), ShouldBeTrue)
+				first := bb.bundle().Entries[0].Desc
+
+				s.SetTags(map[string]string{"foo": "bar"})
+				So(first.Tags, ShouldBeNil)
+				So(s.streamDesc().Tags, ShouldResemble, map[string]string{"foo": "bar"})
+
+				bb = &builder{size: 1024}
+				tp.tags(tc.Now(), "b")
+				So(s.nextBundleEntry(bb, false), ShouldBeTrue)
+				So(bb.bundle().Entries[0].Desc.Tags, ShouldResemble, map[string]string{"foo": "bar"})
+			})
+
 			Convey(`When split is allowed, returns nil.`, func() {
 				tp.tags(tc.Now(), "a", "b")
 				tp.setAllowSplit(true)
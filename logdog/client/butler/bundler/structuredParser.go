@@ -0,0 +1,97 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"io"
+
+	"github.com/golang/protobuf/proto"
+
+	"go.chromium.org/luci/common/data/recordio"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/logdog/api/logpb"
+)
+
+// structuredParser is a parser implementation for the LogDog structured stream
+// type.
+//
+// Structured stream data is a sequence of size-prefixed frames, each holding a
+// serialized logpb.Structured record. Each record is emitted as its own
+// LogEntry, and is never split.
+type structuredParser struct {
+	baseParser
+
+	// desc is the stream's descriptor, used to validate records.
+	desc *logpb.LogStreamDescriptor
+
+	// maxSize is the maximum allowed record size. Records larger than this will
+	// result in a processing error.
+	maxSize int64
+
+	// seq is the current record sequence number.
+	seq int64
+}
+
+var _ parser = (*structuredParser)(nil)
+
+func (p *structuredParser) nextEntry(c *constraints) (*logpb.LogEntry, error) {
+	// Use the current Buffer timestamp.
+	ts, has := p.firstChunkTime()
+	if !has {
+		// No chunks, so no data.
+		return nil, nil
+	}
+
+	bv := p.View()
+	size, fr, err := recordio.NewReader(bv, p.maxSize).ReadFrame()
+	if err != nil {
+		switch err {
+		case io.EOF:
+			// Not enough data for a size header.
+			return nil, nil
+
+		case recordio.ErrFrameTooLarge:
+			return nil, recordio.ErrFrameTooLarge
+		}
+		// Other errors should not be possible, since all operations are against
+		// in-memory buffers.
+		memoryCorruption(err)
+	}
+	headerSize := bv.Consumed()
+
+	// Only emit complete records, and only if they fit.
+	if bv.Remaining() < size || size > int64(c.limit) {
+		return nil, nil
+	}
+
+	data := make([]byte, size)
+	_, err = io.ReadFull(fr, data)
+	memoryCorruptionIf(err != nil, err)
+
+	rec := &logpb.Structured{}
+	if err := proto.Unmarshal(data, rec); err != nil {
+		return nil, errors.Annotate(err, "invalid structured record %d", p.seq).Err()
+	}
+	if err := p.desc.ValidateRecord(rec); err != nil {
+		return nil, errors.Annotate(err, "invalid structured record %d", p.seq).Err()
+	}
+	p.Consume(headerSize + size)
+
+	le := p.baseLogEntry(ts)
+	le.Sequence = uint64(p.seq)
+	le.Content = &logpb.LogEntry_Structured{Structured: rec}
+	p.seq++
+	return le, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"go.chromium.org/luci/common/data/recordio"
+	"go.chromium.org/luci/logdog/api/logpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func record(fields ...*logpb.Structured_Field) *logpb.Structured {
+	return &logpb.Structured{Fields: fields}
+}
+
+func stringField(k, v string) *logpb.Structured_Field {
+	return &logpb.Structured_Field{Key: k, Value: &logpb.Structured_Field_StringValue{StringValue: v}}
+}
+
+func intField(k string, v int64) *logpb.Structured_Field {
+	return &logpb.Structured_Field{Key: k, Value: &logpb.Structured_Field_IntValue{IntValue: v}}
+}
+
+func recordFrame(rec *logpb.Structured) []byte {
+	d, err := proto.Marshal(rec)
+	if err != nil {
+		panic(err)
+	}
+	return dgram(d...)
+}
+
+func TestStructuredParser(t *testing.T) {
+	Convey(`A structuredParser`, t, func() {
+		s := &parserTestStream{
+			now:         time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+			prefixIndex: 1337,
+		}
+		p := &structuredParser{
+			baseParser: s.base(),
+			desc:       &logpb.LogStreamDescriptor{},
+			maxSize:    64,
+		}
+		c := &constraints{
+			limit: 32,
+		}
+
+		Convey(`Yields complete records as individual LogEntry.`, func() {
+			r0 := record(stringField("step", "compile"))
+			r1 := record(intField("count", 3), stringField("step", "test"))
+
+			chunks, offset := spread(s.now, time.Second, 3, recordFrame(r0))
+			p.Append(chunks...)
+			chunks, _ = spread(s.now.Add(offset), time.Second, 0, recordFrame(r1))
+			p.Append(chunks...)
+
+			le, err := p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, ShouldResembleProto, s.le(0, r0))
+
+			le, err = p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, ShouldResembleProto, s.add(offset).le(1, r1))
+
+			le, err = p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, ShouldBeNil)
+		})
+
+		Convey(`Will not yield a partially-buffered record.`, func() {
+			frame := recordFrame(record(stringField("step", "compile")))
+			p.Append(data(s.now, frame[:len(frame)-1]...))
+
+			le, err := p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, ShouldBeNil)
+
+			p.Append(data(s.now, frame[len(frame)-1]))
+			le, err = p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, ShouldNotBeNil)
+		})
+
+		Convey(`Will not split a record that exceeds the limit.`, func() {
+			c.limit = 4
+			c.allowSplit = true
+			p.Append(data(s.now, recordFrame(record(stringField("step", "compile")))...))
+
+			le, err := p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, ShouldBeNil)
+		})
+
+		Convey(`Rejects records larger than the maximum size.`, func() {
+			p.Append(data(s.now, recordFrame(record(stringField("step", string(make([]byte, 64)))))...))
+
+			_, err := p.nextEntry(c)
+			So(err, ShouldEqual, recordio.ErrFrameTooLarge)
+		})
+
+		Convey(`Rejects records that are not valid.`, func() {
+			p.Append(data(s.now, dgram(0xff, 0xff)...))
+
+			_, err := p.nextEntry(c)
+			So(err, ShouldErrLike, "invalid structured record 0")
+		})
+
+		Convey(`With declared fields, rejects records with undeclared fields.`, func() {
+			p.desc.Fields = []string{"step"}
+			p.Append(data(s.now, recordFrame(record(intField("count", 3)))...))
+
+			_, err := p.nextEntry(c)
+			So(err, ShouldErrLike, `field "count" is not declared by the stream`)
+		})
+	})
+}
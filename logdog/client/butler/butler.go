@@ -31,6 +31,7 @@ import (
 	"go.chromium.org/luci/common/sync/parallel"
 	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/client/butler/bundler"
+	"go.chromium.org/luci/logdog/client/butler/filter"
 	"go.chromium.org/luci/logdog/client/butler/output"
 	"go.chromium.org/luci/logdog/client/butlerlib/streamproto"
 	"go.chromium.org/luci/logdog/common/types"
@@ -63,6 +64,11 @@ type Config struct {
 	// be buffered before being marked for dispatch. If this is zero,
 	// DefaultMaxBufferAge will be used.
 	MaxBufferAge time.Duration
+
+	// Filters are applied, in order, to each stream's data before it is
+	// bundled. The tags that they report are added to the stream when it
+	// closes.
+	Filters []filter.Filter
 }
 
 // Validate validates that the configuration is sufficient to instantiate a
@@ -395,10 +401,10 @@ func (b *Butler) AddStream(rc io.ReadCloser, d *logpb.LogStreamDescriptor) error
 	s := stream{
 		log:  logging.Get(streamCtx),
 		now:  clock.Get(streamCtx).Now,
-		r:    rc,
 		c:    rc,
 		name: types.StreamName(d.Name),
 	}
+	s.r, s.filters = filter.Apply(b.c.Filters, d, rc)
 
 	// Register this stream with our Bundler. It will take ownership of "d", so
 	// we should not use it after this point.
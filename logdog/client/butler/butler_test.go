@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"regexp"
 	"sync"
 	"testing"
 	"time"
@@ -30,6 +31,7 @@ import (
 	. "go.chromium.org/luci/common/testing/assertions"
 	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/client/butler/bootstrap"
+	"go.chromium.org/luci/logdog/client/butler/filter"
 	"go.chromium.org/luci/logdog/client/butler/output"
 	"go.chromium.org/luci/logdog/client/butler/output/null"
 	"go.chromium.org/luci/logdog/client/butlerlib/streamproto"
@@ -45,6 +47,7 @@ type testOutput struct {
 	maxSize  int
 	streams  map[string][]*logpb.LogEntry
 	terminal map[string]struct{}
+	tags     map[string]map[string]string
 
 	closed bool
 }
@@ -60,9 +63,11 @@ func (to *testOutput) SendBundle(b *logpb.ButlerLogBundle) error {
 	if to.streams == nil {
 		to.streams = map[string][]*logpb.LogEntry{}
 		to.terminal = map[string]struct{}{}
+		to.tags = map[string]map[string]string{}
 	}
 	for _, be := range b.Entries {
 		name := string(be.Desc.Name)
+		to.tags[name] = be.Desc.Tags
 
 		to.streams[name] = append(to.streams[name], be.Logs...)
 		if be.TerminalIndex >= 0 {
@@ -100,6 +105,13 @@ func (to *testOutput) logs(name string) []*logpb.LogEntry {
 	return to.streams[name]
 }
 
+func (to *testOutput) streamTags(name string) map[string]string {
+	to.Lock()
+	defer to.Unlock()
+
+	return to.tags[name]
+}
+
 func (to *testOutput) isTerminal(name string) bool {
 	to.Lock()
 	defer to.Unlock()
@@ -418,6 +430,28 @@ func TestButler(t *testing.T) {
 				})
 			})
 
+			Convey(`Can redact text streams and report redactions as tags.`, func() {
+				conf.Filters = []filter.Filter{
+					&filter.Redactor{Rules: []*regexp.Regexp{regexp.MustCompile(`hunter\d`)}},
+				}
+				b := mkb(c, conf)
+
+				s := newTestStream(nil)
+				So(b.AddStream(s, s.desc), ShouldBeNil)
+				s.data([]byte("password=hunter2\n"), nil)
+				s.data([]byte("hunter3, hunter4 and hunter\n"), io.EOF)
+
+				b.Activate()
+				So(b.Wait(), ShouldBeNil)
+
+				So(to.logs("test"), shouldHaveTextLogs,
+					"password=[REDACTED]", "[REDACTED], [REDACTED] and hunter")
+				So(to.isTerminal("test"), ShouldBeTrue)
+				So(to.streamTags("test"), ShouldResemble, map[string]string{
+					filter.RedactionsTag: "3",
+				})
+			})
+
 			Convey(`Run with 256 streams, stream{0..256} will deplete and finish.`, func() {
 				b := mkb(c, conf)
 				streams := make([]*testStream, 256)
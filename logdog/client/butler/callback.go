@@ -59,8 +59,9 @@ type StreamChunkCallback func(*logpb.LogEntry)
 // the partially buffered datagram will not be observed by the buffered
 // callback.
 //
-// Wrapping a binary stream is a noop (i.e. your callback will see the exact
-// same values wrapped and unwrapped).
+// Wrapping a binary or structured stream is a noop (i.e. your callback will
+// see the exact same values wrapped and unwrapped). Structured records are
+// never split across LogEntries.
 //
 // When the stream ends (either due to EOF from the user, or when the butler
 // is stopped), your callback will be invoked exactly once with `nil`.
@@ -0,0 +1,70 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"io"
+
+	"go.chromium.org/luci/logdog/api/logpb"
+)
+
+// Stream is a filtered stream's data.
+type Stream interface {
+	io.Reader
+
+	// Tags returns the stream tags that describe the filtering performed so
+	// far.
+	Tags() map[string]string
+}
+
+// Filter transforms stream data before it is bundled.
+type Filter interface {
+	// Filter returns a Stream that reads r's data, filtered, for the stream
+	// described by desc.
+	//
+	// If the Filter does not apply to the stream, Filter returns nil.
+	Filter(desc *logpb.LogStreamDescriptor, r io.Reader) Stream
+}
+
+// Apply applies each Filter in filters to r, in order.
+//
+// It returns the filtered Reader and the Streams of the filters that apply to
+// the stream. If no filter applies, r is returned.
+func Apply(filters []Filter, desc *logpb.LogStreamDescriptor, r io.Reader) (io.Reader, []Stream) {
+	var streams []Stream
+	for _, f := range filters {
+		if s := f.Filter(desc, r); s != nil {
+			streams = append(streams, s)
+			r = s
+		}
+	}
+	return r, streams
+}
+
+// Tags merges the tags of streams.
+//
+// If several streams report the same tag, the last one wins.
+func Tags(streams []Stream) map[string]string {
+	var tags map[string]string
+	for _, s := range streams {
+		for k, v := range s.Tags() {
+			if tags == nil {
+				tags = make(map[string]string)
+			}
+			tags[k] = v
+		}
+	}
+	return tags
+}
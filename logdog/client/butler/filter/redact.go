@@ -0,0 +1,153 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/logdog/api/logpb"
+)
+
+const (
+	// RedactionsTag is the stream tag that holds the number of redactions a
+	// Redactor made.
+	RedactionsTag = "logdog.redactions"
+
+	// DefaultRedactionReplacement is the text that replaces redacted secrets if
+	// a Redactor has no Replacement.
+	DefaultRedactionReplacement = "[REDACTED]"
+
+	// maxRedactionLineSize is the longest line that a Redactor will buffer.
+	// Longer lines are redacted in pieces, so a secret that straddles a piece
+	// boundary will not be matched.
+	maxRedactionLineSize = 64 * 1024
+)
+
+// Redactor is a Filter that replaces text matching any of its rules.
+//
+// Only TEXT streams are redacted. Rules are applied to one line at a time, so
+// they cannot match across line boundaries. A line is held back until it is
+// complete, which delays partial lines (e.g., prompts) until their newline.
+type Redactor struct {
+	// Rules are the regular expressions whose matches are redacted.
+	Rules []*regexp.Regexp
+
+	// Replacement is the text that replaces each match. If empty,
+	// DefaultRedactionReplacement will be used.
+	Replacement string
+}
+
+// ParseRedactionRules parses redaction rules, one regular expression per
+// line, from r.
+//
+// Empty lines and lines starting with "#" are ignored.
+func ParseRedactionRules(r io.Reader) ([]*regexp.Regexp, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []*regexp.Regexp
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		re, err := regexp.Compile(line)
+		if err != nil {
+			return nil, errors.Annotate(err, "invalid redaction rule on line %d", i+1).Err()
+		}
+		rules = append(rules, re)
+	}
+	return rules, nil
+}
+
+// Filter implements Filter.
+func (r *Redactor) Filter(desc *logpb.LogStreamDescriptor, rd io.Reader) Stream {
+	if len(r.Rules) == 0 || desc.StreamType != logpb.StreamType_TEXT {
+		return nil
+	}
+
+	repl := r.Replacement
+	if repl == "" {
+		repl = DefaultRedactionReplacement
+	}
+	return &redactStream{
+		r:     bufio.NewReaderSize(rd, maxRedactionLineSize),
+		rules: r.Rules,
+		repl:  []byte(repl),
+	}
+}
+
+// redactStream is a Stream that redacts its underlying text line by line.
+type redactStream struct {
+	r     *bufio.Reader
+	rules []*regexp.Regexp
+	repl  []byte
+
+	// pending is redacted data that has not been read yet.
+	pending []byte
+	// err is the error returned by the underlying Reader.
+	err error
+
+	// count is the number of redactions made. It is accessed atomically.
+	count int64
+}
+
+func (s *redactStream) Read(p []byte) (int, error) {
+	for len(s.pending) == 0 {
+		if s.err != nil {
+			return 0, s.err
+		}
+
+		// ReadSlice returns a full line, a full buffer, or whatever was read
+		// before an error.
+		line, err := s.r.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			err = nil
+		}
+		s.err = err
+		s.pending = s.redact(line)
+	}
+
+	n := copy(p, s.pending)
+	s.pending = s.pending[n:]
+	return n, nil
+}
+
+// redact returns a redacted copy of line.
+func (s *redactStream) redact(line []byte) []byte {
+	line = append([]byte(nil), line...)
+	for _, re := range s.rules {
+		line = re.ReplaceAllFunc(line, func([]byte) []byte {
+			atomic.AddInt64(&s.count, 1)
+			return s.repl
+		})
+	}
+	return line
+}
+
+func (s *redactStream) Tags() map[string]string {
+	return map[string]string{
+		RedactionsTag: strconv.FormatInt(atomic.LoadInt64(&s.count), 10),
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"go.chromium.org/luci/logdog/api/logpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRedactor(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A Redactor`, t, func() {
+		r := &Redactor{
+			Rules: []*regexp.Regexp{
+				regexp.MustCompile(`token=\w+`),
+				regexp.MustCompile(`hunter\d`),
+			},
+		}
+		desc := &logpb.LogStreamDescriptor{StreamType: logpb.StreamType_TEXT}
+
+		redact := func(rd io.Reader) (string, map[string]string) {
+			s := r.Filter(desc, rd)
+			So(s, ShouldNotBeNil)
+			data, err := ioutil.ReadAll(s)
+			So(err, ShouldBeNil)
+			return string(data), s.Tags()
+		}
+
+		Convey(`Redacts matches on each line.`, func() {
+			out, tags := redact(strings.NewReader("token=abc123 ok\nhunter2 hunter3\nnothing here"))
+			So(out, ShouldEqual, "[REDACTED] ok\n[REDACTED] [REDACTED]\nnothing here")
+			So(tags, ShouldResemble, map[string]string{RedactionsTag: "3"})
+		})
+
+		Convey(`Redacts lines split across reads.`, func() {
+			out, tags := redact(iotest.OneByteReader(strings.NewReader("pw: hunter2\n")))
+			So(out, ShouldEqual, "pw: [REDACTED]\n")
+			So(tags, ShouldResemble, map[string]string{RedactionsTag: "1"})
+		})
+
+		Convey(`Uses a custom replacement.`, func() {
+			r.Replacement = "***"
+			out, _ := redact(strings.NewReader("hunter2\n"))
+			So(out, ShouldEqual, "***\n")
+		})
+
+		Convey(`Passes through long lines.`, func() {
+			line := bytes.Repeat([]byte("x"), maxRedactionLineSize*2+1)
+			out, tags := redact(bytes.NewReader(line))
+			So(out, ShouldEqual, string(line))
+			So(tags, ShouldResemble, map[string]string{RedactionsTag: "0"})
+		})
+
+		Convey(`Does not apply to non-text streams.`, func() {
+			desc.StreamType = logpb.StreamType_BINARY
+			So(r.Filter(desc, strings.NewReader("hunter2")), ShouldBeNil)
+		})
+
+		Convey(`Does not apply without rules.`, func() {
+			r.Rules = nil
+			So(r.Filter(desc, strings.NewReader("hunter2")), ShouldBeNil)
+		})
+
+		Convey(`Can be applied with other filters.`, func() {
+			rd, streams := Apply([]Filter{r, &Redactor{}}, desc, strings.NewReader("hunter2\n"))
+			So(streams, ShouldHaveLength, 1)
+			data, err := ioutil.ReadAll(rd)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, "[REDACTED]\n")
+			So(Tags(streams), ShouldResemble, map[string]string{RedactionsTag: "1"})
+		})
+	})
+}
+
+func TestParseRedactionRules(t *testing.T) {
+	t.Parallel()
+
+	Convey(`ParseRedactionRules`, t, func() {
+		Convey(`Parses rules, skipping comments and blank lines.`, func() {
+			rules, err := ParseRedactionRules(strings.NewReader("# secrets\ntoken=\\w+\n\r\n\nhunter\\d\r\n"))
+			So(err, ShouldBeNil)
+			So(rules, ShouldHaveLength, 2)
+			So(rules[0].String(), ShouldEqual, `token=\w+`)
+			So(rules[1].String(), ShouldEqual, `hunter\d`)
+		})
+
+		Convey(`Reports invalid rules.`, func() {
+			_, err := ParseRedactionRules(strings.NewReader("ok\n(unclosed\n"))
+			So(err, ShouldErrLike, "invalid redaction rule on line 2")
+		})
+	})
+}
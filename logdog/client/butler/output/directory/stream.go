@@ -120,6 +120,11 @@ func (s *stream) ingestBundleEntry(be *logpb.ButlerLogBundle_Entry) (closed bool
 			}
 		case *logpb.LogEntry_Binary:
 			_, err = curFile.Write(x.Binary.Data)
+		case *logpb.LogEntry_Structured:
+			err = (&jsonpb.Marshaler{OrigName: true}).Marshal(curFile, x.Structured)
+			if err == nil {
+				_, err = curFile.WriteString("\n")
+			}
 		}
 
 		if err != nil {
@@ -22,4 +22,5 @@
 // The package current provides the following implementations:
 //   - pubsub: Write logs to Google Cloud Pub/Sub.
 //   - log: (Debug/testing) data is dumped to the installed Logger instance.
+//   - otlp: Write text logs to an OpenTelemetry (OTLP) collector.
 package output
@@ -93,6 +93,11 @@ func (o *logOutput) SendBundle(bundle *logpb.ButlerLogBundle) error {
 					log.Infof(ctx, "Datagram (%d bytes): %s", len(c.Data), hex.EncodeToString(c.Data))
 				}
 			}
+			if c := le.GetStructured(); c != nil {
+				for _, f := range c.Fields {
+					log.Infof(ctx, "Field %q) %v", f.Key, f.Value)
+				}
+			}
 
 			o.stats.F.SentMessages++
 		}
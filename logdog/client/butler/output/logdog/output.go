@@ -82,6 +82,12 @@ type Config struct {
 
 	// RPCTimeout, if > 0, is the timeout to apply to an individual RPC.
 	RPCTimeout time.Duration
+
+	// DisableCompression, if true, publishes bundles uncompressed.
+	DisableCompression bool
+	// CompressThreshold is the minimum size, in bytes, of a bundle that will be
+	// compressed. If zero, pubsubprotocol.DefaultCompressThreshold will be used.
+	CompressThreshold int
 }
 
 // Register registers the supplied Prefix with the Coordinator. Upon success,
@@ -213,13 +219,14 @@ func (cfg *Config) Register(c context.Context) (output.Output, error) {
 	//
 	// Note that we use our publishing context here.
 	return newPubsub(pctx, pubsubConfig{
-		Topic:      pubSubTopicWrapper{psTopic},
-		Host:       cfg.Host,
-		Project:    cfg.Project,
-		Prefix:     string(cfg.Prefix),
-		Secret:     resp.Secret,
-		Compress:   true,
-		RPCTimeout: cfg.RPCTimeout,
+		Topic:             pubSubTopicWrapper{psTopic},
+		Host:              cfg.Host,
+		Project:           cfg.Project,
+		Prefix:            string(cfg.Prefix),
+		Secret:            resp.Secret,
+		Compress:          !cfg.DisableCompression,
+		CompressThreshold: cfg.CompressThreshold,
+		RPCTimeout:        cfg.RPCTimeout,
 	}), nil
 }
 
@@ -64,6 +64,9 @@ type pubsubConfig struct {
 
 	// Compress, if true, enables zlib compression.
 	Compress bool
+	// CompressThreshold is the minimum size of bundle data to compress. If zero,
+	// pubsubprotocol.DefaultCompressThreshold will be used.
+	CompressThreshold int
 
 	// RPCTimeout is the timeout to apply to an individual RPC.
 	RPCTimeout time.Duration
@@ -176,9 +179,13 @@ func (o *pubSubOutput) Close() {
 // data.
 func (o *pubSubOutput) buildMessage(buf *buffer, bundle *logpb.ButlerLogBundle) (*pubsub.Message, error) {
 	if buf.protoWriter == nil {
+		threshold := o.CompressThreshold
+		if threshold <= 0 {
+			threshold = pubsubprotocol.DefaultCompressThreshold
+		}
 		buf.protoWriter = &pubsubprotocol.Writer{
 			Compress:          o.Compress,
-			CompressThreshold: pubsubprotocol.DefaultCompressThreshold,
+			CompressThreshold: threshold,
 		}
 	}
 
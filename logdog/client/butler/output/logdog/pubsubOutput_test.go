@@ -169,6 +169,23 @@ func TestOutput(t *testing.T) {
 			})
 		})
 
+		Convey(`Can send/receive a compressed bundle.`, func() {
+			o.Compress = true
+			o.CompressThreshold = 1
+
+			errC := make(chan error)
+			go func() {
+				errC <- o.SendBundle(bundle)
+			}()
+			msg := <-tt.msgC
+			So(<-errC, ShouldBeNil)
+
+			h, b, err := deconstructMessage(msg)
+			So(err, ShouldBeNil)
+			So(h.Compression, ShouldEqual, logpb.ButlerMetadata_ZLIB)
+			So(b, ShouldResembleProto, bundle)
+		})
+
 		Convey(`Will return an error if Publish failed non-transiently.`, func() {
 			tt.err = func() error { return grpcutil.InvalidArgument }
 			So(o.SendBundle(bundle), ShouldEqual, grpcutil.InvalidArgument)
@@ -0,0 +1,127 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"sort"
+	"time"
+
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/client/butler/output/otlp/otlppb"
+)
+
+// Attribute keys of the OTLP log records and resource emitted by the Output.
+const (
+	// AttrStreamName is the log stream's name.
+	AttrStreamName = "logdog.stream.name"
+	// AttrStreamIndex is the stream index of the LogEntry that held the line.
+	AttrStreamIndex = "logdog.stream.index"
+	// AttrContentType is the log stream's content type.
+	AttrContentType = "logdog.content_type"
+	// AttrTagPrefix prefixes the key of each of the log stream's tags.
+	AttrTagPrefix = "logdog.tag."
+
+	// AttrServiceName is the resource's service name.
+	AttrServiceName = "service.name"
+	// AttrProject is the resource's LogDog project.
+	AttrProject = "logdog.project"
+	// AttrPrefix is the resource's LogDog stream prefix.
+	AttrPrefix = "logdog.prefix"
+)
+
+// scopeName is the instrumentation scope of the emitted log records.
+const scopeName = "go.chromium.org/luci/logdog/client/butler"
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func stringAttr(k, v string) *otlppb.KeyValue {
+	return &otlppb.KeyValue{
+		Key:   k,
+		Value: &otlppb.AnyValue{Value: &otlppb.AnyValue_StringValue{StringValue: v}},
+	}
+}
+
+func intAttr(k string, v int64) *otlppb.KeyValue {
+	return &otlppb.KeyValue{
+		Key:   k,
+		Value: &otlppb.AnyValue{Value: &otlppb.AnyValue_IntValue{IntValue: v}},
+	}
+}
+
+// streamAttrs returns the attributes shared by every log record of the stream
+// described by desc.
+func streamAttrs(desc *logpb.LogStreamDescriptor) []*otlppb.KeyValue {
+	attrs := make([]*otlppb.KeyValue, 0, 2+len(desc.Tags))
+	attrs = append(attrs,
+		stringAttr(AttrStreamName, desc.Name),
+		stringAttr(AttrContentType, desc.ContentType))
+	for _, k := range sortedKeys(desc.Tags) {
+		attrs = append(attrs, stringAttr(AttrTagPrefix+k, desc.Tags[k]))
+	}
+	return attrs
+}
+
+// convertEntries converts the text log entries of a bundle into OTLP log
+// records.
+//
+// It returns the records and the number of non-text log entries that were
+// discarded.
+func convertEntries(entries []*logpb.ButlerLogBundle_Entry, observed time.Time) (records []*otlppb.LogRecord, discarded int) {
+	for _, be := range entries {
+		desc := be.Desc
+		if desc == nil {
+			continue
+		}
+
+		var base time.Time
+		if desc.Timestamp != nil {
+			base = google.TimeFromProto(desc.Timestamp)
+		}
+		attrs := streamAttrs(desc)
+
+		for _, le := range be.Logs {
+			text := le.GetText()
+			if text == nil {
+				discarded++
+				continue
+			}
+
+			ts := base.Add(google.DurationFromProto(le.TimeOffset))
+			for _, line := range text.Lines {
+				recAttrs := make([]*otlppb.KeyValue, len(attrs), len(attrs)+1)
+				copy(recAttrs, attrs)
+
+				records = append(records, &otlppb.LogRecord{
+					TimeUnixNano:         uint64(ts.UnixNano()),
+					ObservedTimeUnixNano: uint64(observed.UnixNano()),
+					SeverityNumber:       otlppb.SeverityNumber_SEVERITY_NUMBER_INFO,
+					Body: &otlppb.AnyValue{
+						Value: &otlppb.AnyValue_StringValue{StringValue: string(line.Value)},
+					},
+					Attributes: append(recAttrs, intAttr(AttrStreamIndex, int64(le.StreamIndex))),
+				})
+			}
+		}
+	}
+	return
+}
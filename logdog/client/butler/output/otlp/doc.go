@@ -0,0 +1,22 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otlp implements a Butler Output that sends text log entries to an
+// OpenTelemetry (OTLP) log collector over gRPC or HTTP.
+//
+// Each text line becomes an OTLP log record. The record's timestamp is the
+// line's time in the stream, and the stream's name, index, content type and
+// tags are recorded as record attributes. Entries of binary and datagram
+// streams are discarded.
+package otlp
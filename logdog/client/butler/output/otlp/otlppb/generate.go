@@ -0,0 +1,23 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate cproto
+
+// Package otlppb contains the OpenTelemetry protocol (OTLP) log messages that
+// the Butler's OTLP output sends.
+package otlppb
+
+// LogsServiceExportMethod is the full gRPC method name of the OTLP logs
+// service's Export RPC.
+const LogsServiceExportMethod = "/opentelemetry.proto.collector.logs.v1.LogsService/Export"
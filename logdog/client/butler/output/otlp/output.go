@@ -0,0 +1,346 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/client/butler/bootstrap"
+	"go.chromium.org/luci/logdog/client/butler/output"
+	"go.chromium.org/luci/logdog/client/butler/output/otlp/otlppb"
+)
+
+// Protocol is an OTLP transport protocol.
+type Protocol string
+
+const (
+	// GRPC sends logs with the OTLP/gRPC protocol.
+	GRPC Protocol = "grpc"
+	// HTTP sends binary-encoded logs with the OTLP/HTTP protocol.
+	HTTP Protocol = "http"
+)
+
+const (
+	// DefaultServiceName is the default service.name resource attribute.
+	DefaultServiceName = "logdog-butler"
+
+	// DefaultBundleSize is the default maximum bundle size.
+	DefaultBundleSize = 1024 * 1024
+
+	// httpLogsPath is the default OTLP/HTTP logs path.
+	httpLogsPath = "/v1/logs"
+)
+
+// Config is the set of configuration parameters for an OTLP Output.
+type Config struct {
+	// Protocol is the transport protocol to use. If empty, GRPC will be used.
+	Protocol Protocol
+
+	// Endpoint is the collector endpoint.
+	//
+	// For GRPC, this is a "host:port" address. For HTTP, this is a URL. If the
+	// URL has no path, the standard "/v1/logs" path will be used.
+	Endpoint string
+
+	// Insecure, if true, connects to a GRPC Endpoint without TLS.
+	Insecure bool
+
+	// Headers are additional headers (or gRPC metadata) to send with each
+	// request, e.g. for authentication.
+	Headers map[string]string
+
+	// ServiceName is the service.name resource attribute. If empty,
+	// DefaultServiceName will be used.
+	ServiceName string
+	// Project and Prefix, if not empty, are recorded as resource attributes.
+	Project string
+	Prefix  string
+
+	// HTTPClient is the client to use for HTTP. If nil, http.DefaultClient will
+	// be used.
+	HTTPClient *http.Client
+
+	// RPCTimeout, if > 0, is the timeout to apply to an individual export.
+	RPCTimeout time.Duration
+
+	// BundleSize is the maximum bundle size. If <= 0, DefaultBundleSize will be
+	// used.
+	BundleSize int
+}
+
+// exporter sends an export request to a collector.
+type exporter interface {
+	export(ctx context.Context, req *otlppb.ExportLogsServiceRequest) (*otlppb.ExportLogsServiceResponse, error)
+	close() error
+}
+
+// otlpOutput is an Output implementation that sends logs to an OTLP collector.
+type otlpOutput struct {
+	ctx context.Context
+	cfg Config
+
+	exp      exporter
+	resource *otlppb.Resource
+
+	statsMu sync.Mutex
+	stats   output.StatsBase
+}
+
+// otlpOutput implements output.Output.
+var _ output.Output = (*otlpOutput)(nil)
+
+// New instantiates a new OTLP Output.
+func New(ctx context.Context, cfg Config) (output.Output, error) {
+	if cfg.Endpoint == "" {
+		return nil, errors.New("an OTLP endpoint is required")
+	}
+	if cfg.ServiceName == "" {
+		cfg.ServiceName = DefaultServiceName
+	}
+	if cfg.BundleSize <= 0 {
+		cfg.BundleSize = DefaultBundleSize
+	}
+
+	o := &otlpOutput{
+		ctx: ctx,
+		cfg: cfg,
+	}
+
+	switch cfg.Protocol {
+	case GRPC, "":
+		var creds grpc.DialOption
+		if cfg.Insecure {
+			creds = grpc.WithInsecure()
+		} else {
+			creds = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{}))
+		}
+		conn, err := grpc.DialContext(ctx, cfg.Endpoint, creds)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to dial %q", cfg.Endpoint).Err()
+		}
+		o.exp = &grpcExporter{conn: conn, headers: cfg.Headers}
+
+	case HTTP:
+		u, err := url.Parse(cfg.Endpoint)
+		if err != nil || u.Host == "" {
+			return nil, errors.Reason("invalid OTLP/HTTP endpoint %q", cfg.Endpoint).Err()
+		}
+		if u.Path == "" || u.Path == "/" {
+			u.Path = httpLogsPath
+		}
+		client := cfg.HTTPClient
+		if client == nil {
+			client = http.DefaultClient
+		}
+		o.exp = &httpExporter{client: client, url: u.String(), headers: cfg.Headers}
+
+	default:
+		return nil, errors.Reason("unknown OTLP protocol %q", cfg.Protocol).Err()
+	}
+
+	o.resource = &otlppb.Resource{
+		Attributes: []*otlppb.KeyValue{stringAttr(AttrServiceName, cfg.ServiceName)},
+	}
+	if cfg.Project != "" {
+		o.resource.Attributes = append(o.resource.Attributes, stringAttr(AttrProject, cfg.Project))
+	}
+	if cfg.Prefix != "" {
+		o.resource.Attributes = append(o.resource.Attributes, stringAttr(AttrPrefix, cfg.Prefix))
+	}
+	return o, nil
+}
+
+func (o *otlpOutput) String() string { return fmt.Sprintf("otlp(%s)", o.cfg.Endpoint) }
+
+func (o *otlpOutput) SendBundle(bundle *logpb.ButlerLogBundle) error {
+	records, discarded := convertEntries(bundle.Entries, clock.Now(o.ctx))
+	o.updateStats(func(st *output.StatsBase) {
+		st.F.DiscardedMessages += int64(discarded)
+	})
+	if len(records) == 0 {
+		return nil
+	}
+
+	req := &otlppb.ExportLogsServiceRequest{
+		ResourceLogs: []*otlppb.ResourceLogs{{
+			Resource: o.resource,
+			ScopeLogs: []*otlppb.ScopeLogs{{
+				Scope:      &otlppb.InstrumentationScope{Name: scopeName},
+				LogRecords: records,
+			}},
+		}},
+	}
+
+	var resp *otlppb.ExportLogsServiceResponse
+	err := retry.Retry(o.ctx, transient.Only(retry.Default), func() (err error) {
+		ctx := o.ctx
+		if o.cfg.RPCTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = clock.WithTimeout(ctx, o.cfg.RPCTimeout)
+			defer cancel()
+		}
+		resp, err = o.exp.export(ctx, req)
+		return
+	}, retry.LogCallback(o.ctx, "export logs"))
+	if err != nil {
+		o.updateStats(func(st *output.StatsBase) {
+			st.F.Errors++
+			st.F.DiscardedMessages += int64(len(records))
+		})
+		log.WithError(err).Errorf(o.ctx, "Failed to export logs.")
+		return err
+	}
+
+	rejected := int64(0)
+	if ps := resp.GetPartialSuccess(); ps != nil && ps.RejectedLogRecords > 0 {
+		rejected = ps.RejectedLogRecords
+		log.Fields{
+			"rejected": rejected,
+			"message":  ps.ErrorMessage,
+		}.Warningf(o.ctx, "Collector rejected some log records.")
+	}
+	o.updateStats(func(st *output.StatsBase) {
+		st.F.SentBytes += int64(proto.Size(req))
+		st.F.SentMessages += int64(len(records)) - rejected
+		st.F.DiscardedMessages += rejected
+	})
+	return nil
+}
+
+func (o *otlpOutput) updateStats(f func(*output.StatsBase)) {
+	o.statsMu.Lock()
+	defer o.statsMu.Unlock()
+	f(&o.stats)
+}
+
+func (o *otlpOutput) MaxSendBundles() int {
+	return 1
+}
+
+func (o *otlpOutput) MaxSize() int {
+	return o.cfg.BundleSize
+}
+
+func (o *otlpOutput) Stats() output.Stats {
+	o.statsMu.Lock()
+	defer o.statsMu.Unlock()
+
+	st := o.stats
+	return &st
+}
+
+func (o *otlpOutput) URLConstructionEnv() bootstrap.Environment {
+	return bootstrap.Environment{}
+}
+
+func (o *otlpOutput) Close() {
+	if err := o.exp.close(); err != nil {
+		log.WithError(err).Warningf(o.ctx, "Failed to close OTLP exporter.")
+	}
+}
+
+// grpcExporter exports logs using OTLP/gRPC.
+type grpcExporter struct {
+	conn    *grpc.ClientConn
+	headers map[string]string
+}
+
+func (e *grpcExporter) export(ctx context.Context, req *otlppb.ExportLogsServiceRequest) (*otlppb.ExportLogsServiceResponse, error) {
+	if len(e.headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(e.headers))
+	}
+	resp := &otlppb.ExportLogsServiceResponse{}
+	if err := e.conn.Invoke(ctx, otlppb.LogsServiceExportMethod, req, resp); err != nil {
+		return nil, grpcutil.WrapIfTransient(err)
+	}
+	return resp, nil
+}
+
+func (e *grpcExporter) close() error { return e.conn.Close() }
+
+// httpExporter exports logs using binary-encoded OTLP/HTTP.
+type httpExporter struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+}
+
+func (e *httpExporter) export(ctx context.Context, req *otlppb.ExportLogsServiceRequest) (*otlppb.ExportLogsServiceResponse, error) {
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to marshal request").Err()
+	}
+
+	hreq, err := http.NewRequest("POST", e.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	hreq = hreq.WithContext(ctx)
+	for k, v := range e.headers {
+		hreq.Header.Set(k, v)
+	}
+	hreq.Header.Set("Content-Type", "application/x-protobuf")
+
+	hresp, err := e.client.Do(hreq)
+	if err != nil {
+		return nil, transient.Tag.Apply(err)
+	}
+	defer hresp.Body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(hresp.Body, 1024*1024))
+	if err != nil {
+		return nil, transient.Tag.Apply(err)
+	}
+
+	switch code := hresp.StatusCode; {
+	case code >= 200 && code < 300:
+		resp := &otlppb.ExportLogsServiceResponse{}
+		if err := proto.Unmarshal(data, resp); err != nil {
+			// The response body is optional; ignore anything we can't parse.
+			return &otlppb.ExportLogsServiceResponse{}, nil
+		}
+		return resp, nil
+
+	case code == http.StatusTooManyRequests || code == http.StatusBadGateway ||
+		code == http.StatusServiceUnavailable || code == http.StatusGatewayTimeout:
+		return nil, errors.Reason("HTTP %d", code).Tag(transient.Tag).Err()
+
+	default:
+		return nil, errors.Reason("HTTP %d", code).Err()
+	}
+}
+
+func (e *httpExporter) close() error { return nil }
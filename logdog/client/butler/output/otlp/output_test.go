@@ -0,0 +1,260 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/client/butler/output/otlp/otlppb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// testReceiver is an in-process OTLP log receiver.
+type testReceiver struct {
+	sync.Mutex
+
+	reqs    []*otlppb.ExportLogsServiceRequest
+	headers []string
+	resp    *otlppb.ExportLogsServiceResponse
+}
+
+func (r *testReceiver) receive(req *otlppb.ExportLogsServiceRequest, header string) *otlppb.ExportLogsServiceResponse {
+	r.Lock()
+	defer r.Unlock()
+
+	r.reqs = append(r.reqs, req)
+	r.headers = append(r.headers, header)
+	if r.resp != nil {
+		return r.resp
+	}
+	return &otlppb.ExportLogsServiceResponse{}
+}
+
+func (r *testReceiver) records() []*otlppb.LogRecord {
+	r.Lock()
+	defer r.Unlock()
+
+	var records []*otlppb.LogRecord
+	for _, req := range r.reqs {
+		for _, rl := range req.ResourceLogs {
+			for _, sl := range rl.ScopeLogs {
+				records = append(records, sl.LogRecords...)
+			}
+		}
+	}
+	return records
+}
+
+// testLogsServiceDesc mirrors the upstream OTLP LogsService.
+var testLogsServiceDesc = grpc.ServiceDesc{
+	ServiceName: "opentelemetry.proto.collector.logs.v1.LogsService",
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "Export",
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+			req := &otlppb.ExportLogsServiceRequest{}
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+			md, _ := metadata.FromIncomingContext(ctx)
+			return srv.(*testReceiver).receive(req, firstValue(md.Get("x-test"))), nil
+		},
+	}},
+}
+
+func firstValue(v []string) string {
+	if len(v) == 0 {
+		return ""
+	}
+	return v[0]
+}
+
+func (r *testReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path != "/v1/logs" || req.Header.Get("Content-Type") != "application/x-protobuf" {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	body, _ := ioutil.ReadAll(req.Body)
+	ereq := &otlppb.ExportLogsServiceRequest{}
+	if err := proto.Unmarshal(body, ereq); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	data, _ := proto.Marshal(r.receive(ereq, req.Header.Get("X-Test")))
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Write(data)
+}
+
+func TestOutput(t *testing.T) {
+	t.Parallel()
+
+	Convey(`An OTLP Output`, t, func() {
+		ctx, _ := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		recv := &testReceiver{}
+
+		base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		bundle := &logpb.ButlerLogBundle{
+			Entries: []*logpb.ButlerLogBundle_Entry{
+				{
+					Desc: &logpb.LogStreamDescriptor{
+						Name:        "stdout",
+						ContentType: "text/plain",
+						StreamType:  logpb.StreamType_TEXT,
+						Timestamp:   google.NewTimestamp(base),
+						Tags:        map[string]string{"b": "2", "a": "1"},
+					},
+					Logs: []*logpb.LogEntry{
+						{
+							StreamIndex: 3,
+							TimeOffset:  google.NewDuration(time.Second),
+							Content: &logpb.LogEntry_Text{Text: &logpb.Text{
+								Lines: []*logpb.Text_Line{
+									{Value: []byte("hello"), Delimiter: "\n"},
+									{Value: []byte("world"), Delimiter: "\n"},
+								},
+							}},
+						},
+					},
+				},
+				{
+					Desc: &logpb.LogStreamDescriptor{
+						Name:       "data",
+						StreamType: logpb.StreamType_BINARY,
+					},
+					Logs: []*logpb.LogEntry{
+						{Content: &logpb.LogEntry_Binary{Binary: &logpb.Binary{Data: []byte{0x01}}}},
+					},
+				},
+			},
+		}
+
+		checkRecords := func() {
+			recs := recv.records()
+			So(recs, ShouldHaveLength, 2)
+			So(recs[0], ShouldResembleProto, &otlppb.LogRecord{
+				TimeUnixNano:         uint64(base.Add(time.Second).UnixNano()),
+				ObservedTimeUnixNano: uint64(testclock.TestTimeUTC.UnixNano()),
+				SeverityNumber:       otlppb.SeverityNumber_SEVERITY_NUMBER_INFO,
+				Body:                 &otlppb.AnyValue{Value: &otlppb.AnyValue_StringValue{StringValue: "hello"}},
+				Attributes: []*otlppb.KeyValue{
+					stringAttr(AttrStreamName, "stdout"),
+					stringAttr(AttrContentType, "text/plain"),
+					stringAttr(AttrTagPrefix+"a", "1"),
+					stringAttr(AttrTagPrefix+"b", "2"),
+					intAttr(AttrStreamIndex, 3),
+				},
+			})
+			So(recs[1].Body.GetStringValue(), ShouldEqual, "world")
+
+			So(recv.reqs[0].ResourceLogs[0].Resource.Attributes, ShouldResembleProto, []*otlppb.KeyValue{
+				stringAttr(AttrServiceName, DefaultServiceName),
+				stringAttr(AttrProject, "proj"),
+				stringAttr(AttrPrefix, "pre/fix"),
+			})
+			So(recv.headers, ShouldResemble, []string{"value"})
+		}
+
+		cfg := Config{
+			Project: "proj",
+			Prefix:  "pre/fix",
+			Headers: map[string]string{"X-Test": "value"},
+		}
+
+		Convey(`Over gRPC`, func() {
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			So(err, ShouldBeNil)
+			srv := grpc.NewServer()
+			srv.RegisterService(&testLogsServiceDesc, recv)
+			go srv.Serve(l)
+			defer srv.Stop()
+
+			cfg.Protocol = GRPC
+			cfg.Endpoint = l.Addr().String()
+			cfg.Insecure = true
+			cfg.Headers = map[string]string{"x-test": "value"}
+			o, err := New(ctx, cfg)
+			So(err, ShouldBeNil)
+			defer o.Close()
+
+			So(o.SendBundle(bundle), ShouldBeNil)
+			checkRecords()
+
+			st := o.Stats()
+			So(st.SentMessages(), ShouldEqual, 2)
+			So(st.DiscardedMessages(), ShouldEqual, 1)
+			So(st.SentBytes(), ShouldBeGreaterThan, 0)
+		})
+
+		Convey(`Over HTTP`, func() {
+			ts := httptest.NewServer(recv)
+			defer ts.Close()
+
+			cfg.Protocol = HTTP
+			cfg.Endpoint = ts.URL
+			cfg.HTTPClient = ts.Client()
+			o, err := New(ctx, cfg)
+			So(err, ShouldBeNil)
+			defer o.Close()
+
+			So(o.SendBundle(bundle), ShouldBeNil)
+			checkRecords()
+
+			Convey(`Counts rejected records.`, func() {
+				recv.resp = &otlppb.ExportLogsServiceResponse{
+					PartialSuccess: &otlppb.ExportLogsPartialSuccess{RejectedLogRecords: 1},
+				}
+				So(o.SendBundle(bundle), ShouldBeNil)
+
+				st := o.Stats()
+				So(st.SentMessages(), ShouldEqual, 3)
+				So(st.DiscardedMessages(), ShouldEqual, 3)
+			})
+
+			Convey(`Fails on a non-transient error.`, func() {
+				o, err := New(ctx, Config{Protocol: HTTP, Endpoint: ts.URL + "/wrong", HTTPClient: ts.Client()})
+				So(err, ShouldBeNil)
+				So(o.SendBundle(bundle), ShouldErrLike, "HTTP 400")
+				So(o.Stats().Errors(), ShouldEqual, 1)
+			})
+		})
+
+		Convey(`Validates its configuration.`, func() {
+			_, err := New(ctx, Config{})
+			So(err, ShouldErrLike, "endpoint is required")
+
+			_, err = New(ctx, Config{Protocol: "carrier-pigeon", Endpoint: "x"})
+			So(err, ShouldErrLike, "unknown OTLP protocol")
+
+			_, err = New(ctx, Config{Protocol: HTTP, Endpoint: "not a url"})
+			So(err, ShouldErrLike, "invalid OTLP/HTTP endpoint")
+		})
+	})
+}
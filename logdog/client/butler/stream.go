@@ -20,6 +20,7 @@ import (
 
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/logdog/client/butler/bundler"
+	"go.chromium.org/luci/logdog/client/butler/filter"
 	"go.chromium.org/luci/logdog/common/types"
 )
 
@@ -32,6 +33,9 @@ type stream struct {
 	r  io.Reader
 	c  io.Closer
 	bs bundler.Stream
+
+	// filters are the filters applied to r.
+	filters []filter.Stream
 }
 
 func (s *stream) readChunk() bool {
@@ -80,5 +84,6 @@ func (s *stream) closeStream() {
 	if err := s.c.Close(); err != nil {
 		s.log.Warningf("Error closing stream: ", err)
 	}
+	s.bs.SetTags(filter.Tags(s.filters))
 	s.bs.Close()
 }
@@ -33,6 +33,7 @@ type testBundlerStream struct {
 	appended []byte
 	ts       []time.Time
 	err      error
+	tags     map[string]string
 
 	data []*testBundlerData
 }
@@ -57,6 +58,15 @@ func (bs *testBundlerStream) Append(d bundler.Data) error {
 	return nil
 }
 
+func (bs *testBundlerStream) SetTags(tags map[string]string) {
+	if bs.tags == nil {
+		bs.tags = map[string]string{}
+	}
+	for k, v := range tags {
+		bs.tags[k] = v
+	}
+}
+
 func (bs *testBundlerStream) Close() {
 	if bs.closed {
 		panic("double close")
@@ -52,8 +52,8 @@ type dialer interface {
 
 // Client is a client to a local LogDog Butler.
 //
-// The methods here allow you to open a stream (text, binary or datagram) which
-// you can then use to send data to LogDog.
+// The methods here allow you to open a stream (text, binary, datagram or
+// structured) which you can then use to send data to LogDog.
 type Client struct {
 	dial dialer
 
@@ -161,3 +161,26 @@ func (c *Client) NewDatagramStream(ctx context.Context, name types.StreamName, o
 	ret, err := c.dial.DialDgramStream(fullOpts.desc)
 	return ret, errors.Annotate(err, "attempting to connect datagram stream %q", name).Err()
 }
+
+// NewStructuredStream returns a new structured stream to the butler.
+//
+// Structured streams carry records of typed key/value fields. Use WithFields
+// to declare the keys that the stream's records will carry, so that LogDog
+// clients can query for the stream by field.
+//
+// NOTE: It is an error to pass ForProcess as an Option (see documentation on
+// ForProcess for more detail).
+func (c *Client) NewStructuredStream(ctx context.Context, name types.StreamName, opts ...Option) (StructuredStream, error) {
+	fullOpts, err := c.mkOptions(ctx, name, logpb.StreamType_STRUCTURED, opts)
+	if err != nil {
+		return nil, err
+	}
+	if fullOpts.forProcess {
+		return nil, errors.Reason("cannot specify ForProcess on a structured stream").Err()
+	}
+	dg, err := c.dial.DialDgramStream(fullOpts.desc)
+	if err != nil {
+		return nil, errors.Annotate(err, "attempting to connect structured stream %q", name).Err()
+	}
+	return &structuredStreamWriter{dg}, nil
+}
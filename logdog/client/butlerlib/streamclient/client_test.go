@@ -42,6 +42,13 @@ func TestClientGeneral(t *testing.T) {
 			So(err, ShouldErrLike, "cannot specify ForProcess on a datagram stream")
 		})
 
+		Convey(`ForProcess used with structured stream`, func() {
+			client := NewFake("")
+
+			_, err := client.NewStructuredStream(ctx, "test", ForProcess())
+			So(err, ShouldErrLike, "cannot specify ForProcess on a structured stream")
+		})
+
 		Convey(`bad options`, func() {
 			client := NewFake("")
 
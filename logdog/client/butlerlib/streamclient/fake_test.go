@@ -19,6 +19,8 @@ import (
 	"io"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+
 	"go.chromium.org/luci/common/clock/clockflag"
 	"go.chromium.org/luci/common/clock/testclock"
 	"go.chromium.org/luci/common/errors"
@@ -102,6 +104,32 @@ func TestFakeProtocol(t *testing.T) {
 					Tags:        nil,
 				})
 			})
+
+			Convey(`can use a structured stream`, func() {
+				stream, err := client.NewStructuredStream(ctx, "test", WithFields("step", "ok"))
+				So(err, ShouldBeNil)
+
+				So(stream.WriteRecord(StringField("step", "compile"), BoolField("ok", true)), ShouldBeNil)
+				So(stream.Close(), ShouldBeNil)
+
+				streamData := client.GetFakeData()["namespace/test"]
+				So(streamData, ShouldNotBeNil)
+				So(streamData.GetDatagrams(), ShouldHaveLength, 1)
+
+				rec := &logpb.Structured{}
+				So(proto.Unmarshal([]byte(streamData.GetDatagrams()[0]), rec), ShouldBeNil)
+				So(rec, ShouldResembleProto, &logpb.Structured{Fields: []*logpb.Structured_Field{
+					StringField("step", "compile"),
+					BoolField("ok", true),
+				}})
+				So(streamData.GetFlags(), ShouldResemble, streamproto.Flags{
+					Name:        "namespace/test",
+					ContentType: "application/x-logdog-structured",
+					Type:        streamproto.StreamType(logpb.StreamType_STRUCTURED),
+					Timestamp:   clockflag.Time(testclock.TestTimeUTC),
+					Fields:      []string{"step", "ok"},
+				})
+			})
 		})
 
 		Convey(`bad`, func() {
@@ -42,6 +42,7 @@ type Option func(*options)
 //   * Text streams have the type "text/plain"
 //   * Binary streams have the type "application/octet-stream"
 //   * Datagram streams have the type "application/x-logdog-datagram"
+//   * Structured streams have the type "application/x-logdog-structured"
 func WithContentType(contentType string) Option {
 	return func(o *options) {
 		o.desc.ContentType = contentType
@@ -89,15 +90,27 @@ func WithTagMap(tags map[string]string) Option {
 	}
 }
 
+// WithFields declares the keys of the fields that a structured stream's
+// records will carry. Records with undeclared fields will be rejected by the
+// butler.
+//
+// By default, structured streams declare no fields, and their records may
+// carry any fields.
+func WithFields(keys ...string) Option {
+	return func(o *options) {
+		o.desc.Fields = keys
+	}
+}
+
 // ForProcess opens this stream optimized for subprocess IO (i.e. to attach to
 // "os/exec".Cmd.Std{out,err}).
 //
 // Accidentally passing a non-`ForProcess` stream to a subprocess will result in
 // an extra pipe, and an extra goroutine with a copy loop in the parent process.
 //
-// ForProcess is only allowed on Text and Binary streams, not datagram streams.
-// This is because the datagram stream is "packet" oriented, but stdout/stderr
-// are not. If an application knows enough about the butler protocol to properly
+// ForProcess is only allowed on Text and Binary streams, not datagram or
+// structured streams. This is because those streams are "packet" oriented, but
+// stdout/stderr are not. If an application knows enough about the butler protocol to properly
 // frame its output, it should just open a butler connection directly, rather
 // than emitting framed data on its standard outputs.
 //
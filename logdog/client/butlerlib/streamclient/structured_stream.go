@@ -0,0 +1,84 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamclient
+
+import (
+	"io"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/logdog/api/logpb"
+)
+
+// StructuredStream is the interface for structured record streams.
+type StructuredStream interface {
+	io.Closer
+
+	// WriteRecord writes a single structured record made up of `fields`.
+	WriteRecord(fields ...*logpb.Structured_Field) error
+}
+
+// structuredStreamWriter implements a StructuredStream on top of a
+// DatagramStream, sending each record as a single serialized logpb.Structured
+// datagram.
+type structuredStreamWriter struct {
+	dg DatagramStream
+}
+
+// Close implements io.Closer.
+func (w *structuredStreamWriter) Close() error {
+	return w.dg.Close()
+}
+
+// WriteRecord implements StructuredStream.
+func (w *structuredStreamWriter) WriteRecord(fields ...*logpb.Structured_Field) error {
+	data, err := proto.Marshal(&logpb.Structured{Fields: fields})
+	if err != nil {
+		return err
+	}
+	return w.dg.WriteDatagram(data)
+}
+
+// StringField returns a structured record field with a string value.
+func StringField(key, v string) *logpb.Structured_Field {
+	return &logpb.Structured_Field{Key: key, Value: &logpb.Structured_Field_StringValue{StringValue: v}}
+}
+
+// IntField returns a structured record field with an integer value.
+func IntField(key string, v int64) *logpb.Structured_Field {
+	return &logpb.Structured_Field{Key: key, Value: &logpb.Structured_Field_IntValue{IntValue: v}}
+}
+
+// DoubleField returns a structured record field with a floating point value.
+func DoubleField(key string, v float64) *logpb.Structured_Field {
+	return &logpb.Structured_Field{Key: key, Value: &logpb.Structured_Field_DoubleValue{DoubleValue: v}}
+}
+
+// BoolField returns a structured record field with a boolean value.
+func BoolField(key string, v bool) *logpb.Structured_Field {
+	return &logpb.Structured_Field{Key: key, Value: &logpb.Structured_Field_BoolValue{BoolValue: v}}
+}
+
+// BytesField returns a structured record field with a binary value.
+func BytesField(key string, v []byte) *logpb.Structured_Field {
+	return &logpb.Structured_Field{Key: key, Value: &logpb.Structured_Field_BytesValue{BytesValue: v}}
+}
+
+// TimeField returns a structured record field with a timestamp value.
+func TimeField(key string, v time.Time) *logpb.Structured_Field {
+	return &logpb.Structured_Field{Key: key, Value: &logpb.Structured_Field_TimestampValue{TimestampValue: google.NewTimestamp(v)}}
+}
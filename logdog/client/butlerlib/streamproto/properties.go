@@ -39,6 +39,7 @@ type Flags struct {
 	Type        StreamType     `json:"type,omitempty"`
 	Timestamp   clockflag.Time `json:"timestamp,omitempty"`
 	Tags        TagMap         `json:"tags,omitempty"`
+	Fields      []string       `json:"fields,omitempty"`
 }
 
 // Descriptor converts the Flags to a LogStreamDescriptor.
@@ -54,6 +55,7 @@ func (f *Flags) Descriptor() *logpb.LogStreamDescriptor {
 		StreamType:  logpb.StreamType(f.Type),
 		Timestamp:   google.NewTimestamp(time.Time(f.Timestamp)),
 		Tags:        f.Tags,
+		Fields:      f.Fields,
 	}
 }
 
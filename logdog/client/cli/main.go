@@ -170,6 +170,9 @@ func Main(ctx context.Context, params Parameters) int {
 				subcommands.CmdHelp,
 				newCatCommand(),
 				newQueryCommand(),
+				newSearchCommand(),
+				newExportCommand(),
+				newImportCommand(),
 				newLatestCommand(),
 				authcli.SubcommandLogin(authOptions, "auth-login", false),
 				authcli.SubcommandLogout(authOptions, "auth-logout", false),
@@ -65,6 +65,7 @@ type catCommandRun struct {
 	fetchSize  int
 	fetchBytes int
 	raw        bool
+	follow     bool
 
 	timestamps      timestampsFlag
 	showStreamIndex bool
@@ -80,16 +81,19 @@ func newCatCommand() *subcommands.Command {
 			cmd.Flags.Int64Var(&cmd.index, "index", 0, "Starting index.")
 			cmd.Flags.Int64Var(&cmd.count, "count", 0, "The number of log entries to fetch.")
 			cmd.Flags.Var(&cmd.timestamps, "timestamps",
-				"When rendering text logs, prefix them with their timestamps. Options are: "+timestampFlagEnum.Choices())
+				"When rendering text or structured logs, prefix them with their timestamps. Options are: "+timestampFlagEnum.Choices())
 			cmd.Flags.BoolVar(&cmd.showStreamIndex, "show-stream-index", false,
-				"When rendering text logs, show their stream index.")
+				"When rendering text or structured logs, show their stream index.")
 			cmd.Flags.IntVar(&cmd.buffer, "buffer", 64,
 				"The size of the read buffer. A smaller buffer will more responsive while streaming, whereas "+
 					"a larger buffer will have higher throughput.")
 			cmd.Flags.IntVar(&cmd.fetchSize, "fetch-size", 0, "Constrains the number of log entries to fetch per request.")
 			cmd.Flags.IntVar(&cmd.fetchBytes, "fetch-bytes", 0, "Constrains the number of bytes to fetch per request.")
 			cmd.Flags.BoolVar(&cmd.raw, "raw", false,
-				"Reproduce original log stream, instead of attempting to render for humans.")
+				"Reproduce original log stream, instead of attempting to render for humans. "+
+					"Structured streams are rendered as one JSON object per record.")
+			cmd.Flags.BoolVar(&cmd.follow, "follow", false,
+				"Have the Coordinator push new log entries as they are ingested, instead of polling for them.")
 			return cmd
 		},
 	}
@@ -179,14 +183,32 @@ func (cmd *catCommandRun) Run(scApp subcommands.Application, args []string, _ su
 	return 0
 }
 
+// catSource is a renderer.Source that also knows its stream's descriptor.
+type catSource interface {
+	renderer.Source
+
+	// Descriptor returns the LogStreamDescriptor for the stream, if known, or
+	// nil.
+	Descriptor() *logpb.LogStreamDescriptor
+}
+
 func (cmd *catCommandRun) catPath(c context.Context, coord *coordinator.Client, addr *types.StreamAddr) error {
 	// Pull stream information.
-	f := coord.Stream(addr.Project, addr.Path).Fetcher(c, &fetcher.Options{
-		Index:       types.MessageIndex(cmd.index),
-		Count:       cmd.count,
-		BufferCount: cmd.fetchSize,
-		BufferBytes: int64(cmd.fetchBytes),
-	})
+	stream := coord.Stream(addr.Project, addr.Path)
+	var f catSource
+	if cmd.follow {
+		f = stream.Follow(c, &coordinator.FollowOptions{
+			Index: types.MessageIndex(cmd.index),
+			Count: cmd.count,
+		})
+	} else {
+		f = stream.Fetcher(c, &fetcher.Options{
+			Index:       types.MessageIndex(cmd.index),
+			Count:       cmd.count,
+			BufferCount: cmd.fetchSize,
+			BufferBytes: int64(cmd.fetchBytes),
+		})
+	}
 
 	rend := renderer.Renderer{
 		Source: f,
@@ -0,0 +1,275 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/logdog/client/coordinator"
+	"go.chromium.org/luci/logdog/common/export"
+	"go.chromium.org/luci/logdog/common/fetcher"
+	"go.chromium.org/luci/logdog/common/storage/filesystem"
+	"go.chromium.org/luci/logdog/common/types"
+
+	"github.com/maruel/subcommands"
+)
+
+type exportCommandRun struct {
+	subcommands.CommandRunBase
+
+	out        string
+	tmpDir     string
+	incomplete bool
+	fetchSize  int
+	fetchBytes int
+}
+
+func newExportCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "export [options] PREFIX",
+		ShortDesc: "Export all log streams under a prefix as a tarball.",
+		LongDesc: "Downloads every log stream under PREFIX and writes them to a tarball. " +
+			"Text streams are stored as plain text files, binary and datagram streams are " +
+			"stored raw, and a JSON Lines manifest records each stream's descriptor. " +
+			"The tarball is gzip-compressed if the output file ends in \".gz\" or \".tgz\".",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &exportCommandRun{}
+
+			fs := cmd.GetFlags()
+			fs.StringVar(&cmd.out, "out", "-", "Path to the export output. Use '-' for STDOUT (default).")
+			fs.StringVar(&cmd.tmpDir, "tmp-dir", "", "Directory to spool stream data in while exporting.")
+			fs.BoolVar(&cmd.incomplete, "incomplete", false,
+				"Export streams that have not yet terminated, using whatever data is currently available.")
+			fs.IntVar(&cmd.fetchSize, "fetch-size", 0, "Constrains the number of log entries to fetch per request.")
+			fs.IntVar(&cmd.fetchBytes, "fetch-bytes", 0, "Constrains the number of bytes to fetch per request.")
+
+			return cmd
+		},
+	}
+}
+
+func (cmd *exportCommandRun) Run(scApp subcommands.Application, args []string, _ subcommands.Env) int {
+	a := scApp.(*application)
+
+	if len(args) != 1 {
+		log.Errorf(a, "Exactly one PREFIX must be supplied.")
+		return 1
+	}
+
+	project, prefix, _, err := a.splitPath(strings.Trim(args[0], types.StreamNameSepStr))
+	if err != nil {
+		log.WithError(err).Errorf(a, "Invalid path specifier.")
+		return 1
+	}
+	if err := types.StreamName(prefix).Validate(); err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"prefix":     prefix,
+		}.Errorf(a, "Invalid prefix.")
+		return 1
+	}
+
+	coord, err := a.coordinatorClient("")
+	if err != nil {
+		errors.Log(a, errors.Annotate(err, "could not create Coordinator client").Err())
+		return 1
+	}
+
+	b, err := export.NewBuilder(cmd.tmpDir)
+	if err != nil {
+		log.WithError(err).Errorf(a, "Failed to create export builder.")
+		return 1
+	}
+	defer b.Close()
+
+	tctx, _ := a.timeoutCtx(a)
+	count, err := cmd.exportPrefix(tctx, coord, b, project, types.StreamName(prefix))
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"count":      count,
+		}.Errorf(a, "Export failed.")
+
+		if err == context.DeadlineExceeded {
+			return 2
+		}
+		return 1
+	}
+
+	if err := cmd.writeOutput(b); err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       cmd.out,
+		}.Errorf(a, "Failed to write export.")
+		return 1
+	}
+	log.Fields{
+		"count": count,
+	}.Infof(a, "Export completed.")
+	return 0
+}
+
+// exportPrefix adds every stream under prefix to b, returning the number of
+// streams that were exported.
+func (cmd *exportCommandRun) exportPrefix(c context.Context, coord *coordinator.Client, b *export.Builder,
+	project string, prefix types.StreamName) (int, error) {
+
+	// Collect the set of streams first, so that we aren't holding a query
+	// cursor open while we fetch each stream's data.
+	var streams []*coordinator.LogStream
+	path := string(prefix.AsPathPrefix("**"))
+	err := coord.Query(c, project, path, coordinator.QueryOptions{}, func(s *coordinator.LogStream) bool {
+		streams = append(streams, s)
+		return true
+	})
+	if err != nil {
+		return 0, errors.Annotate(err, "failed to query streams").Err()
+	}
+
+	for i, s := range streams {
+		log.Fields{
+			"path": s.Path,
+		}.Debugf(c, "Exporting stream.")
+
+		f := coord.Stream(s.Project, s.Path).Fetcher(c, &fetcher.Options{
+			BufferCount:           cmd.fetchSize,
+			BufferBytes:           int64(cmd.fetchBytes),
+			RequireCompleteStream: !cmd.incomplete,
+			NoWait:                cmd.incomplete,
+		})
+		if err := b.AddStream(s.Project, s.Path, &s.Desc, f); err != nil {
+			return i, errors.Annotate(err, "failed to export %q", s.Path).Err()
+		}
+	}
+	return len(streams), nil
+}
+
+// writeOutput writes the export tarball to the configured output.
+func (cmd *exportCommandRun) writeOutput(b *export.Builder) error {
+	if cmd.out == "-" {
+		_, err := b.WriteTo(os.Stdout)
+		return err
+	}
+
+	f, err := os.OpenFile(cmd.out, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	// Close the gzip writer before the file, since it flushes the compressed
+	// data, and keep the first error: either one means a truncated export.
+	var gz *gzip.Writer
+	w := io.Writer(f)
+	if strings.HasSuffix(cmd.out, ".gz") || strings.HasSuffix(cmd.out, ".tgz") {
+		gz = gzip.NewWriter(f)
+		w = gz
+	}
+	_, err = b.WriteTo(w)
+	if gz != nil {
+		if cerr := gz.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+type importCommandRun struct {
+	subcommands.CommandRunBase
+
+	dir string
+}
+
+func newImportCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "import [options] EXPORT",
+		ShortDesc: "Load an export tarball into local storage.",
+		LongDesc: "Loads a tarball produced by \"export\" into filesystem-backed log storage " +
+			"rooted at -dir, so that it can be replayed offline.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &importCommandRun{}
+
+			fs := cmd.GetFlags()
+			fs.StringVar(&cmd.dir, "dir", "", "The root directory of the filesystem storage to import into.")
+
+			return cmd
+		},
+	}
+}
+
+func (cmd *importCommandRun) Run(scApp subcommands.Application, args []string, _ subcommands.Env) int {
+	a := scApp.(*application)
+
+	if len(args) != 1 {
+		log.Errorf(a, "Exactly one EXPORT file must be supplied.")
+		return 1
+	}
+	if cmd.dir == "" {
+		log.Errorf(a, "A storage directory must be supplied (-dir).")
+		return 1
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		log.WithError(err).Errorf(a, "Failed to open export.")
+		return 1
+	}
+	defer f.Close()
+
+	r, err := maybeGunzip(f)
+	if err != nil {
+		log.WithError(err).Errorf(a, "Failed to read export.")
+		return 1
+	}
+
+	st := &filesystem.Storage{Dir: cmd.dir}
+	defer st.Close()
+
+	streams, err := export.Import(a, r, st)
+	if err != nil {
+		log.WithError(err).Errorf(a, "Import failed.")
+		return 1
+	}
+	log.Fields{
+		"count": len(streams),
+		"dir":   cmd.dir,
+	}.Infof(a, "Import completed.")
+	return 0
+}
+
+// maybeGunzip returns a Reader for f's content, transparently decompressing
+// it if it is gzip-compressed.
+func maybeGunzip(f io.ReadSeeker) (io.Reader, error) {
+	var magic [2]byte
+	n, err := io.ReadFull(f, magic[:])
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if n == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		return gzip.NewReader(f)
+	}
+	return f, nil
+}
@@ -23,6 +23,7 @@ import (
 
 	"go.chromium.org/luci/common/clock/clockflag"
 	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/flag/stringlistflag"
 	log "go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/client/butlerlib/streamproto"
@@ -42,6 +43,7 @@ type queryCommandRun struct {
 	path        string
 	contentType string
 	tags        streamproto.TagMap
+	fields      stringlistflag.Flag
 	results     int
 	before      clockflag.Time
 	after       clockflag.Time
@@ -62,6 +64,7 @@ func newQueryCommand() *subcommands.Command {
 			fs.StringVar(&cmd.path, "path", "", "Filter logs matching this path (may include globbing).")
 			fs.StringVar(&cmd.contentType, "contentType", "", "Limit results to a content type.")
 			fs.Var(&cmd.tags, "tag", "Filter logs containing this tag (key[=value]).")
+			fs.Var(&cmd.fields, "field", "Filter structured logs declaring this field key. Can be specified multiple times.")
 			fs.Var(&cmd.purged, "purged", "Include purged streams in the result. This requires administrative privileges.")
 			fs.Var(&cmd.before, "before", "Limit the query to streams registered at or before this RFC3339 time.")
 			fs.Var(&cmd.after, "after", "Limit the query to streams registered at or after this RFC3339 time.")
@@ -126,6 +129,7 @@ func (cmd *queryCommandRun) Run(scApp subcommands.Application, args []string, _
 
 	qo := coordinator.QueryOptions{
 		ContentType: cmd.contentType,
+		Fields:      cmd.fields,
 		State:       cmd.json,
 		Before:      cmd.before.Time(),
 		After:       cmd.after.Time(),
@@ -0,0 +1,146 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/logdog/client/coordinator"
+
+	"github.com/maruel/subcommands"
+)
+
+const (
+	// defaultSearchResults is the default number of search matches to return.
+	defaultSearchResults = 100
+)
+
+type searchCommandRun struct {
+	subcommands.CommandRunBase
+
+	path    string
+	regexp  bool
+	results int
+	json    bool
+}
+
+func newSearchCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "search [options] PATTERN",
+		ShortDesc: "Search log stream contents for a pattern.",
+		LongDesc: "Searches the text log streams matching -path for lines containing PATTERN, " +
+			"and prints the stream path, message index and matching line for each.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &searchCommandRun{}
+
+			fs := cmd.GetFlags()
+			fs.StringVar(&cmd.path, "path", "", "Search logs matching this path (may include globbing).")
+			fs.BoolVar(&cmd.regexp, "regexp", false, "Interpret PATTERN as an RE2 regular expression instead of a literal string.")
+			fs.IntVar(&cmd.results, "results", defaultSearchResults,
+				"The maximum number of matches to return. If 0, no limit will be applied.")
+			fs.BoolVar(&cmd.json, "json", false, "Output one JSON object per match instead of text.")
+
+			return cmd
+		},
+	}
+}
+
+func (cmd *searchCommandRun) Run(scApp subcommands.Application, args []string, _ subcommands.Env) int {
+	a := scApp.(*application)
+
+	if len(args) != 1 {
+		log.Errorf(a, "Exactly one search PATTERN must be supplied.")
+		return 1
+	}
+	pattern := args[0]
+
+	project, path, unified, err := a.splitPath(cmd.path)
+	if err != nil {
+		log.WithError(err).Errorf(a, "Invalid path specifier.")
+		return 1
+	}
+
+	coord, err := a.coordinatorClient("")
+	if err != nil {
+		errors.Log(a, errors.Annotate(err, "could not create Coordinator client").Err())
+		return 1
+	}
+
+	bw := bufio.NewWriter(os.Stdout)
+	defer bw.Flush()
+	enc := json.NewEncoder(bw)
+
+	emit := func(m *coordinator.SearchMatch) error {
+		p := string(m.Path)
+		if unified {
+			p = makeUnifiedPath(m.Project, m.Path)
+		}
+
+		if cmd.json {
+			return enc.Encode(struct {
+				Project string `json:"project"`
+				Path    string `json:"path"`
+				Index   int64  `json:"index"`
+				Line    int    `json:"line"`
+				Snippet string `json:"snippet"`
+			}{m.Project, p, int64(m.Index), m.Line, m.Snippet})
+		}
+		_, err := fmt.Fprintf(bw, "%s:%d:%d: %s\n", p, m.Index, m.Line, m.Snippet)
+		return err
+	}
+
+	count := 0
+	log.Debugf(a, "Issuing search...")
+
+	tctx, _ := a.timeoutCtx(a)
+	ierr := error(nil)
+	so := coordinator.SearchOptions{
+		Regexp: cmd.regexp,
+	}
+	err = coord.Search(tctx, project, path, pattern, so, func(m *coordinator.SearchMatch) bool {
+		if err := emit(m); err != nil {
+			ierr = err
+			return false
+		}
+
+		count++
+		return !(cmd.results > 0 && count >= cmd.results)
+	})
+	if err == nil {
+		// Propagate internal error.
+		err = ierr
+	}
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"count":      count,
+		}.Errorf(a, "Search failed.")
+
+		if err == context.DeadlineExceeded {
+			return 2
+		}
+		return 1
+	}
+	log.Fields{
+		"count": count,
+	}.Infof(a, "Search completed.")
+	return 0
+}
@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"regexp"
 	"strings"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"go.chromium.org/luci/common/data/rand/mathrand"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/flag/multiflag"
+	"go.chromium.org/luci/common/flag/stringlistflag"
 	log "go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/logging/gologger"
 	"go.chromium.org/luci/common/runtime/paniccatcher"
@@ -41,6 +43,7 @@ import (
 	"go.chromium.org/luci/config"
 	grpcLogging "go.chromium.org/luci/grpc/logging"
 	"go.chromium.org/luci/logdog/client/butler"
+	"go.chromium.org/luci/logdog/client/butler/filter"
 	"go.chromium.org/luci/logdog/client/butler/output"
 	"go.chromium.org/luci/logdog/client/butlerlib/streamproto"
 	"go.chromium.org/luci/logdog/common/types"
@@ -80,6 +83,9 @@ type application struct {
 	maxBufferAge clockflag.Duration
 	noBufferLogs bool
 
+	redact     stringlistflag.Flag
+	redactFile string
+
 	prof profiling.Profiler
 
 	client *http.Client
@@ -119,6 +125,43 @@ func (a *application) addFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&a.noBufferLogs, "output-no-buffer", false,
 		"If true, dispatch logs immediately. Setting this flag simplifies output at the expense "+
 			"of wire-format efficiency.")
+	fs.Var(&a.redact, "redact",
+		"A regular expression whose matches are redacted from text streams. Can be specified "+
+			"multiple times.")
+	fs.StringVar(&a.redactFile, "redact-file", "",
+		"Path to a file of regular expressions, one per line, whose matches are redacted from "+
+			"text streams. This is intended to be written by the bootstrap.")
+}
+
+// filters returns the stream filters configured by flags.
+func (a *application) filters() ([]filter.Filter, error) {
+	var rules []*regexp.Regexp
+	for _, r := range a.redact {
+		re, err := regexp.Compile(r)
+		if err != nil {
+			return nil, errors.Annotate(err, "invalid -redact rule %q", r).Err()
+		}
+		rules = append(rules, re)
+	}
+
+	if a.redactFile != "" {
+		f, err := os.Open(a.redactFile)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to open -redact-file").Err()
+		}
+		defer f.Close()
+
+		fileRules, err := filter.ParseRedactionRules(f)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to parse -redact-file").Err()
+		}
+		rules = append(rules, fileRules...)
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	return []filter.Filter{&filter.Redactor{Rules: rules}}, nil
 }
 
 func (a *application) authenticator(ctx context.Context) (*auth.Authenticator, error) {
@@ -149,12 +192,18 @@ func (a *application) runWithButler(out output.Output, runFunc func(*butler.Butl
 	}
 	defer a.prof.Stop()
 
+	filters, err := a.filters()
+	if err != nil {
+		return err
+	}
+
 	// Instantiate our Butler.
 	butlerOpts := butler.Config{
 		GlobalTags:   a.globalTags,
 		MaxBufferAge: time.Duration(a.maxBufferAge),
 		BufferLogs:   !a.noBufferLogs,
 		Output:       out,
+		Filters:      filters,
 	}
 	b, err := butler.New(a, butlerOpts)
 	if err != nil {
@@ -23,6 +23,7 @@ import (
 	"go.chromium.org/luci/common/flag/multiflag"
 	"go.chromium.org/luci/logdog/client/butler/output"
 	out "go.chromium.org/luci/logdog/client/butler/output/logdog"
+	"go.chromium.org/luci/logdog/client/pubsubprotocol"
 )
 
 func init() {
@@ -31,8 +32,10 @@ func init() {
 
 // logdogOutputFactory for publishing logs using a LogDog Coordinator host.
 type logdogOutputFactory struct {
-	service          string
-	prefixExpiration clockflag.Duration
+	service           string
+	prefixExpiration  clockflag.Duration
+	noCompress        bool
+	compressThreshold int
 }
 
 var _ outputFactory = (*logdogOutputFactory)(nil)
@@ -46,6 +49,10 @@ func (f *logdogOutputFactory) option() multiflag.Option {
 	flags.Var(&f.prefixExpiration, "prefix-expiration",
 		"Amount of time after registration that the prefix will be active. If omitted, the service "+
 			"default will be used. This should exceed the expected lifetime of the job by a fair margin.")
+	flags.BoolVar(&f.noCompress, "no-compress", false,
+		"Publish log bundles without compressing them.")
+	flags.IntVar(&f.compressThreshold, "compress-threshold", pubsubprotocol.DefaultCompressThreshold,
+		"The minimum size, in bytes, of a log bundle that will be compressed.")
 
 	return opt
 }
@@ -73,8 +80,10 @@ func (f *logdogOutputFactory) configOutput(a *application) (output.Output, error
 		SourceInfo: []string{
 			"LogDog Butler",
 		},
-		PublishContext: a.ncCtx,
-		RPCTimeout:     30 * time.Second,
+		PublishContext:     a.ncCtx,
+		RPCTimeout:         30 * time.Second,
+		DisableCompression: f.noCompress,
+		CompressThreshold:  f.compressThreshold,
 	}
 	return cfg.Register(a)
 }
@@ -0,0 +1,93 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/clock/clockflag"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/flag/multiflag"
+	"go.chromium.org/luci/common/flag/stringlistflag"
+	"go.chromium.org/luci/logdog/client/butler/output"
+	otlpOutput "go.chromium.org/luci/logdog/client/butler/output/otlp"
+)
+
+func init() {
+	registerOutputFactory(&otlpOutputFactory{})
+}
+
+// otlpOutputFactory for sending text logs to an OpenTelemetry collector.
+type otlpOutputFactory struct {
+	endpoint    string
+	protocol    string
+	insecure    bool
+	headers     stringlistflag.Flag
+	serviceName string
+	timeout     clockflag.Duration
+	bundleSize  int
+}
+
+var _ outputFactory = (*otlpOutputFactory)(nil)
+
+func (f *otlpOutputFactory) option() multiflag.Option {
+	opt := newOutputOption("otlp", "Send text logs to an OpenTelemetry (OTLP) collector.", f)
+
+	f.timeout = clockflag.Duration(30 * time.Second)
+
+	flags := opt.Flags()
+	flags.StringVar(&f.endpoint, "endpoint", "",
+		"The collector endpoint: host:port for grpc, or a URL for http (required).")
+	flags.StringVar(&f.protocol, "protocol", string(otlpOutput.GRPC),
+		"The OTLP protocol to use, either 'grpc' or 'http'.")
+	flags.BoolVar(&f.insecure, "insecure", false,
+		"Connect to a grpc endpoint without TLS.")
+	flags.Var(&f.headers, "header",
+		"A 'Name:Value' header to send with each request. Can be specified multiple times.")
+	flags.StringVar(&f.serviceName, "service-name", otlpOutput.DefaultServiceName,
+		"The service.name resource attribute.")
+	flags.Var(&f.timeout, "timeout",
+		"The timeout of an individual export request.")
+	flags.IntVar(&f.bundleSize, "bundle-size", otlpOutput.DefaultBundleSize,
+		"Maximum bundle size.")
+
+	return opt
+}
+
+func (f *otlpOutputFactory) configOutput(a *application) (output.Output, error) {
+	headers := make(map[string]string, len(f.headers))
+	for _, h := range f.headers {
+		parts := strings.SplitN(h, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Reason("invalid header %q, expected 'Name:Value'", h).Err()
+		}
+		headers[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+
+	return otlpOutput.New(a, otlpOutput.Config{
+		Protocol:    otlpOutput.Protocol(f.protocol),
+		Endpoint:    f.endpoint,
+		Insecure:    f.insecure,
+		Headers:     headers,
+		ServiceName: f.serviceName,
+		Project:     a.project,
+		Prefix:      string(a.prefix),
+		RPCTimeout:  time.Duration(f.timeout),
+		BundleSize:  f.bundleSize,
+	})
+}
+
+func (f *otlpOutputFactory) scopes() []string { return nil }
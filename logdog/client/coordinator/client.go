@@ -15,6 +15,8 @@
 package coordinator
 
 import (
+	"net/http"
+
 	"go.chromium.org/luci/auth"
 	"go.chromium.org/luci/grpc/prpc"
 	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
@@ -37,13 +39,23 @@ type Client struct {
 	C logdog.LogsClient
 	// Host is the LogDog host. This is loaded from the pRPC client in NewClient.
 	Host string
+
+	// HTTPClient is the HTTP client used for non-RPC endpoints, such as Follow.
+	// If nil, http.DefaultClient will be used. This is loaded from the pRPC
+	// client in NewClient.
+	HTTPClient *http.Client
+	// Insecure, if true, uses HTTP instead of HTTPS for non-RPC endpoints. This
+	// is loaded from the pRPC client in NewClient.
+	Insecure bool
 }
 
 // NewClient returns a new Client instance bound to a pRPC Client.
 func NewClient(c *prpc.Client) *Client {
 	return &Client{
-		C:    logdog.NewLogsPRPCClient(c),
-		Host: c.Host,
+		C:          logdog.NewLogsPRPCClient(c),
+		Host:       c.Host,
+		HTTPClient: c.C,
+		Insecure:   c.Options != nil && c.Options.Insecure,
 	}
 }
 
@@ -0,0 +1,266 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+// followHeader is set by the Coordinator's follow endpoint on all of its
+// responses. A 404 response without it comes from a service that does not
+// serve the endpoint, not from a missing stream.
+//
+// Keep in sync with logdog/appengine/coordinator/flex/logs/follow.go.
+const followHeader = "X-LogDog-Follow"
+
+// FollowOptions is the set of options for a Follower.
+type FollowOptions struct {
+	// Index is the stream index of the first log entry to return.
+	Index types.MessageIndex
+	// Count, if >0, is the maximum number of log entries to return.
+	Count int64
+}
+
+// Follower returns a log stream's entries as they are pushed by the
+// Coordinator's follow endpoint.
+//
+// If the connection to the Coordinator is lost, the Follower reconnects and
+// resumes after the last log entry that it returned.
+//
+// Follower implements renderer.Source. It is not safe for concurrent use.
+type Follower struct {
+	c context.Context
+	s *Stream
+	o FollowOptions
+
+	desc *logpb.LogStreamDescriptor
+
+	// next is the stream index of the next log entry to return.
+	next types.MessageIndex
+	// count is the number of log entries returned so far.
+	count int64
+	// done is true once the stream has been fully returned.
+	done bool
+
+	body io.ReadCloser
+	r    *bufio.Reader
+}
+
+// Follow returns a Follower for this Stream.
+//
+// If you pass a nil FollowOptions, the stream will be followed from its
+// beginning.
+func (s *Stream) Follow(c context.Context, o *FollowOptions) *Follower {
+	f := &Follower{c: c, s: s}
+	if o != nil {
+		f.o = *o
+	}
+	f.next = f.o.Index
+	return f
+}
+
+// Descriptor returns the LogStreamDescriptor for this stream, if known,
+// or returns nil.
+func (f *Follower) Descriptor() *logpb.LogStreamDescriptor { return f.desc }
+
+// NextLogEntry implements renderer.Source.
+//
+// It blocks until the next log entry is available. Once the stream is complete
+// (or Count log entries have been returned), it returns io.EOF.
+func (f *Follower) NextLogEntry() (*logpb.LogEntry, error) {
+	for {
+		if f.done || (f.o.Count > 0 && f.count >= f.o.Count) {
+			f.close()
+			return nil, io.EOF
+		}
+
+		if f.body == nil {
+			if err := f.connect(); err != nil {
+				return nil, err
+			}
+		}
+
+		event, data, err := readEvent(f.r)
+		if err != nil {
+			// The connection was lost before the stream was complete.
+			log.WithError(err).Warningf(f.c, "Lost connection to the follow endpoint; reconnecting.")
+			f.close()
+			continue
+		}
+
+		switch event {
+		case "descriptor":
+			desc := &logpb.LogStreamDescriptor{}
+			if err := jsonpb.UnmarshalString(data, desc); err != nil {
+				return nil, errors.Annotate(err, "failed to unmarshal descriptor").Err()
+			}
+			f.desc = desc
+
+		case "entry":
+			le := &logpb.LogEntry{}
+			if err := jsonpb.UnmarshalString(data, le); err != nil {
+				return nil, errors.Annotate(err, "failed to unmarshal log entry").Err()
+			}
+			if types.MessageIndex(le.StreamIndex) < f.next {
+				// Already returned before a reconnect.
+				continue
+			}
+			f.next = types.MessageIndex(le.StreamIndex) + 1
+			f.count++
+			return le, nil
+
+		case "error":
+			msg, err := strconv.Unquote(data)
+			if err != nil {
+				msg = data
+			}
+			log.Fields{
+				"message": msg,
+			}.Warningf(f.c, "Follow endpoint reported an error.")
+
+		case "end":
+			f.done = true
+		}
+	}
+}
+
+// followURL returns the URL of the follow endpoint for the next log entry.
+func (f *Follower) followURL() string {
+	scheme := "https"
+	if f.s.c.Insecure {
+		scheme = "http"
+	}
+	u := url.URL{
+		Scheme:   scheme,
+		Host:     f.s.c.Host,
+		Path:     fmt.Sprintf("/follow/%s/%s", f.s.project, f.s.path),
+		RawQuery: url.Values{"index": {strconv.FormatInt(int64(f.next), 10)}}.Encode(),
+	}
+	return u.String()
+}
+
+// connect opens a connection to the follow endpoint, retrying transient
+// failures.
+func (f *Follower) connect() error {
+	client := f.s.c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return retry.Retry(f.c, transient.Only(retry.Default), func() error {
+		req, err := http.NewRequest("GET", f.followURL(), nil)
+		if err != nil {
+			return err
+		}
+		req = req.WithContext(f.c)
+		req.Header.Set("Accept", "text/event-stream")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			if f.c.Err() != nil {
+				return f.c.Err()
+			}
+			return transient.Tag.Apply(err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+			resp.Body.Close()
+
+			switch code := resp.StatusCode; {
+			case code == http.StatusNotFound && resp.Header.Get(followHeader) == "":
+				return errors.Reason("follow endpoint not found at %q (HTTP 404)", req.URL).Err()
+			case code == http.StatusNotFound:
+				return ErrNoSuchStream
+			case code == http.StatusUnauthorized || code == http.StatusForbidden:
+				return ErrNoAccess
+			case code >= 500:
+				return errors.Reason("HTTP %d: %s", code, strings.TrimSpace(string(body))).Tag(transient.Tag).Err()
+			default:
+				return errors.Reason("HTTP %d: %s", code, strings.TrimSpace(string(body))).Err()
+			}
+		}
+
+		f.body, f.r = resp.Body, bufio.NewReader(resp.Body)
+		return nil
+	}, retry.LogCallback(f.c, "connect to follow endpoint"))
+}
+
+func (f *Follower) close() {
+	if f.body != nil {
+		f.body.Close()
+		f.body, f.r = nil, nil
+	}
+}
+
+// readEvent reads the next Server-Sent Event from r, returning its type and
+// data. Comments and events without data are skipped.
+func readEvent(r *bufio.Reader) (event, data string, err error) {
+	var dataLines []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return "", "", err
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		if line == "" {
+			// End of the event.
+			if dataLines != nil {
+				if event == "" {
+					event = "message"
+				}
+				return event, strings.Join(dataLines, "\n"), nil
+			}
+			event = ""
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			// Comment.
+			continue
+		}
+
+		field, value := line, ""
+		if i := strings.IndexByte(line, ':'); i >= 0 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+		switch field {
+		case "event":
+			event = value
+		case "data":
+			dataLines = append(dataLines, value)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.chromium.org/luci/logdog/api/logpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// testFollowServer is a fake follow endpoint.
+type testFollowServer struct {
+	sync.Mutex
+
+	// entries is the number of entries in the stream.
+	entries int
+	// dropAfter, if >0, drops the first connection after this many entries.
+	dropAfter int
+	// status, if non-zero, is returned instead of a stream.
+	status int
+	// notFollow, if true, makes the server act like a service that does not
+	// serve the follow endpoint.
+	notFollow bool
+
+	paths   []string
+	indexes []int
+}
+
+func (s *testFollowServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.paths = append(s.paths, r.URL.Path)
+	if !s.notFollow {
+		w.Header().Set(followHeader, "1")
+	}
+	if s.status != 0 {
+		http.Error(w, "failure", s.status)
+		return
+	}
+
+	index, _ := strconv.Atoi(r.URL.Query().Get("index"))
+	s.indexes = append(s.indexes, index)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	fmt.Fprintf(w, "event: descriptor\ndata: {\"content_type\":\"text/plain\"}\n\n")
+	fmt.Fprintf(w, ": keepalive\n\n")
+	for i := index; i < s.entries; i++ {
+		if s.dropAfter > 0 && i == s.dropAfter {
+			// Repeat the previous entry, then drop the connection.
+			fmt.Fprintf(w, "id: %d\nevent: entry\ndata: {\"stream_index\":\"%d\"}\n\n", i-1, i-1)
+			s.dropAfter = 0
+			return
+		}
+		fmt.Fprintf(w, "id: %d\nevent: entry\ndata: {\"stream_index\":\"%d\"}\n\n", i, i)
+	}
+	fmt.Fprintf(w, "event: error\ndata: \"transient\"\n\n")
+	fmt.Fprintf(w, "event: end\ndata: {}\n\n")
+}
+
+func TestFollow(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A testing Client`, t, func() {
+		c := context.Background()
+
+		svc := testFollowServer{entries: 5}
+		ts := httptest.NewServer(&svc)
+		defer ts.Close()
+
+		client := Client{
+			Host:       strings.TrimPrefix(ts.URL, "http://"),
+			HTTPClient: ts.Client(),
+			Insecure:   true,
+		}
+		s := client.Stream("myproj", "test/+/a")
+
+		readAll := func(f *Follower) ([]uint64, error) {
+			var indexes []uint64
+			for {
+				le, err := f.NextLogEntry()
+				if le != nil {
+					indexes = append(indexes, le.StreamIndex)
+				}
+				if err != nil {
+					if err == io.EOF {
+						err = nil
+					}
+					return indexes, err
+				}
+			}
+		}
+
+		Convey(`Can follow a stream to its end.`, func() {
+			f := s.Follow(c, nil)
+			indexes, err := readAll(f)
+			So(err, ShouldBeNil)
+			So(indexes, ShouldResemble, []uint64{0, 1, 2, 3, 4})
+			So(f.Descriptor(), ShouldResembleProto, &logpb.LogStreamDescriptor{ContentType: "text/plain"})
+			So(svc.paths, ShouldResemble, []string{"/follow/myproj/test/+/a"})
+		})
+
+		Convey(`Can follow from an index, up to a count.`, func() {
+			indexes, err := readAll(s.Follow(c, &FollowOptions{Index: 1, Count: 2}))
+			So(err, ShouldBeNil)
+			So(indexes, ShouldResemble, []uint64{1, 2})
+			So(svc.indexes, ShouldResemble, []int{1})
+		})
+
+		Convey(`Resumes after a disconnect.`, func() {
+			svc.dropAfter = 3
+			indexes, err := readAll(s.Follow(c, nil))
+			So(err, ShouldBeNil)
+			So(indexes, ShouldResemble, []uint64{0, 1, 2, 3, 4})
+			So(svc.indexes, ShouldResemble, []int{0, 3})
+		})
+
+		Convey(`Returns ErrNoSuchStream if the stream does not exist.`, func() {
+			svc.status = http.StatusNotFound
+			_, err := readAll(s.Follow(c, nil))
+			So(err, ShouldEqual, ErrNoSuchStream)
+		})
+
+		Convey(`Does not return ErrNoSuchStream if the follow endpoint is not served.`, func() {
+			svc.status = http.StatusNotFound
+			svc.notFollow = true
+			_, err := readAll(s.Follow(c, nil))
+			So(err, ShouldNotEqual, ErrNoSuchStream)
+			So(err, ShouldErrLike, "follow endpoint not found")
+		})
+
+		Convey(`Returns ErrNoAccess if access is denied.`, func() {
+			svc.status = http.StatusForbidden
+			_, err := readAll(s.Follow(c, nil))
+			So(err, ShouldEqual, ErrNoAccess)
+		})
+	})
+}
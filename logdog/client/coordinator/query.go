@@ -59,6 +59,8 @@ const (
 	Binary
 	// Datagram selects only datagram streams.
 	Datagram
+	// Structured selects only structured streams.
+	Structured
 )
 
 // queryValue returns the StreamType for a specified QueryStreamType parameter.
@@ -71,6 +73,8 @@ func (t QueryStreamType) queryValue() logpb.StreamType {
 		return logpb.StreamType_BINARY
 	case Datagram:
 		return logpb.StreamType_DATAGRAM
+	case Structured:
+		return logpb.StreamType_STRUCTURED
 	default:
 		return -1
 	}
@@ -88,6 +92,10 @@ type QueryOptions struct {
 	// StreamType, if not STAny, is the stream type to query for.
 	StreamType QueryStreamType
 
+	// Fields, if not empty, restricts results to structured streams that
+	// declare all of the supplied field keys.
+	Fields []string
+
 	// Before, if not zero, specifies that only streams registered at or before
 	// the supplied time should be returned.
 	Before time.Time
@@ -143,6 +151,7 @@ func (c *Client) Query(ctx context.Context, project string, path string, o Query
 		Newer:       google.NewTimestamp(o.After),
 		Purged:      o.Purged.queryValue(),
 		State:       o.State,
+		Fields:      o.Fields,
 	}
 	if st := o.StreamType.queryValue(); st >= 0 {
 		req.StreamType = &logdog.QueryRequest_StreamTypeFilter{Value: st}
@@ -0,0 +1,91 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+
+	"go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+// SearchOptions is the set of options that can accompany a search.
+type SearchOptions struct {
+	// Regexp, if true, causes the search pattern to be interpreted as an RE2
+	// regular expression instead of a literal substring.
+	Regexp bool
+}
+
+// SearchMatch is a single log line that matched a search.
+type SearchMatch struct {
+	// Project is the log stream's project.
+	Project string
+	// Path is the log stream's path.
+	Path types.StreamPath
+
+	// Index is the stream index of the log entry containing the line.
+	Index types.MessageIndex
+	// Line is the zero-based index of the matching line within the log entry.
+	Line int
+	// Snippet is the text of the matching line. Very long lines are truncated
+	// around the match.
+	Snippet string
+}
+
+// SearchCallback is a callback method type that is used in search requests.
+//
+// If it returns false, additional callbacks and searches will be aborted.
+type SearchCallback func(m *SearchMatch) bool
+
+// Search scans the log streams selected by path for lines matching pattern,
+// invoking the supplied callback once for each matching line.
+//
+// The path uses the same syntax as Query. Only text streams are searched.
+func (c *Client) Search(ctx context.Context, project, path, pattern string, o SearchOptions, cb SearchCallback) error {
+	req := logdog.SearchRequest{
+		Project: project,
+		Path:    path,
+		Pattern: pattern,
+		Regexp:  o.Regexp,
+	}
+
+	// Iteratively search until either our search is done (Next is empty) or we
+	// are asked to stop via callback.
+	for {
+		resp, err := c.C.Search(ctx, &req)
+		if err != nil {
+			return normalizeError(err)
+		}
+
+		for _, m := range resp.Matches {
+			sm := SearchMatch{
+				Project: resp.Project,
+				Path:    types.StreamPath(m.Path),
+				Index:   types.MessageIndex(m.Index),
+				Line:    int(m.Line),
+				Snippet: m.Snippet,
+			}
+			if !cb(&sm) {
+				return nil
+			}
+		}
+
+		// Advance our search cursor.
+		if resp.Next == "" {
+			return nil
+		}
+		req.Next = resp.Next
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/common/renderer"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+// ManifestName is the name of the manifest file in an export.
+const ManifestName = "manifest.jsonl"
+
+// Stream is a manifest record describing an exported log stream.
+type Stream struct {
+	// Project is the stream's project.
+	Project string `json:"project"`
+	// Path is the stream's path.
+	Path types.StreamPath `json:"path"`
+	// Descriptor is the stream's descriptor, in JSONPB form.
+	Descriptor json.RawMessage `json:"descriptor"`
+
+	// File is the name of the file holding a text or binary stream's data.
+	File string `json:"file,omitempty"`
+	// Datagrams are the names of the files holding a datagram stream's
+	// datagrams, in order.
+	Datagrams []string `json:"datagrams,omitempty"`
+	// Entries is the number of log entries that were exported.
+	Entries int64 `json:"entries"`
+}
+
+// Desc unmarshals the stream's descriptor.
+func (s *Stream) Desc() (*logpb.LogStreamDescriptor, error) {
+	desc := &logpb.LogStreamDescriptor{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(s.Descriptor), desc); err != nil {
+		return nil, errors.Annotate(err, "invalid descriptor for %q", s.Path).Err()
+	}
+	return desc, nil
+}
+
+// spooled is a file that has been spooled to disk for a Builder.
+type spooled struct {
+	name string
+	path string
+	size int64
+}
+
+// Builder builds an export.
+//
+// Stream data is spooled to a temporary directory as it is added, so that the
+// manifest can be written at the start of the tarball.
+type Builder struct {
+	// Now is the modification time to record in the tarball. If zero, the
+	// current time is used.
+	Now time.Time
+
+	dir     string
+	streams []*Stream
+	files   []*spooled
+}
+
+// NewBuilder returns a Builder that spools stream data to a new temporary
+// directory within tmpDir. If tmpDir is empty, the system default is used.
+//
+// The Builder must be closed with Close to remove its temporary directory.
+func NewBuilder(tmpDir string) (*Builder, error) {
+	dir, err := ioutil.TempDir(tmpDir, "logdog_export")
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to create spool directory").Err()
+	}
+	return &Builder{dir: dir}, nil
+}
+
+// Close removes the Builder's spooled data.
+func (b *Builder) Close() error {
+	return os.RemoveAll(b.dir)
+}
+
+// AddStream reads a log stream from src until io.EOF and adds it to the
+// export.
+func (b *Builder) AddStream(project string, p types.StreamPath, desc *logpb.LogStreamDescriptor, src renderer.Source) error {
+	d, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(desc)
+	if err != nil {
+		return errors.Annotate(err, "failed to marshal descriptor").Err()
+	}
+	st := &Stream{
+		Project:    project,
+		Path:       p,
+		Descriptor: json.RawMessage(d),
+	}
+	name := path.Join(project, string(p))
+
+	switch desc.StreamType {
+	case logpb.StreamType_TEXT, logpb.StreamType_BINARY:
+		st.File = name
+		err = b.spool(name, func(w io.Writer) error {
+			return b.copyEntries(st, src, func(le *logpb.LogEntry) error {
+				return writeEntry(w, le)
+			})
+		})
+
+	case logpb.StreamType_DATAGRAM:
+		var cur bytes.Buffer
+		err = b.copyEntries(st, src, func(le *logpb.LogEntry) error {
+			dg := le.GetDatagram()
+			if dg == nil {
+				return errors.Reason("non-datagram entry %d in datagram stream", le.StreamIndex).Err()
+			}
+			cur.Write(dg.Data)
+			if dg.Partial != nil && !dg.Partial.Last {
+				return nil
+			}
+
+			dgName := fmt.Sprintf("%s.%05d", name, len(st.Datagrams))
+			st.Datagrams = append(st.Datagrams, dgName)
+			defer cur.Reset()
+			return b.spool(dgName, func(w io.Writer) error {
+				_, err := w.Write(cur.Bytes())
+				return err
+			})
+		})
+
+	case logpb.StreamType_STRUCTURED:
+		st.File = name
+		m := jsonpb.Marshaler{OrigName: true}
+		err = b.spool(name, func(w io.Writer) error {
+			return b.copyEntries(st, src, func(le *logpb.LogEntry) error {
+				rec := le.GetStructured()
+				if rec == nil {
+					return errors.Reason("non-structured entry %d in structured stream", le.StreamIndex).Err()
+				}
+				if err := m.Marshal(w, rec); err != nil {
+					return err
+				}
+				_, err := io.WriteString(w, "\n")
+				return err
+			})
+		})
+
+	default:
+		err = errors.Reason("unsupported stream type %s", desc.StreamType).Err()
+	}
+	if err != nil {
+		return errors.Annotate(err, "failed to export %q", p).Err()
+	}
+
+	b.streams = append(b.streams, st)
+	return nil
+}
+
+func (b *Builder) copyEntries(st *Stream, src renderer.Source, cb func(*logpb.LogEntry) error) error {
+	for {
+		le, err := src.NextLogEntry()
+		if le != nil {
+			if err := cb(le); err != nil {
+				return err
+			}
+			st.Entries++
+		}
+		switch err {
+		case nil:
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
+// writeEntry writes a text or binary log entry's raw content to w.
+func writeEntry(w io.Writer, le *logpb.LogEntry) error {
+	switch c := le.Content.(type) {
+	case *logpb.LogEntry_Text:
+		for _, line := range c.Text.Lines {
+			if _, err := w.Write(line.Value); err != nil {
+				return err
+			}
+			if _, err := io.WriteString(w, line.Delimiter); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case *logpb.LogEntry_Binary:
+		_, err := w.Write(c.Binary.Data)
+		return err
+
+	default:
+		return errors.Reason("unexpected %T content in entry %d", le.Content, le.StreamIndex).Err()
+	}
+}
+
+// spool writes the named file's data to the spool directory.
+func (b *Builder) spool(name string, write func(io.Writer) error) error {
+	f, err := ioutil.TempFile(b.dir, "stream")
+	if err != nil {
+		return err
+	}
+	werr := write(f)
+	cerr := f.Close()
+	if werr != nil {
+		return werr
+	}
+	if cerr != nil {
+		return cerr
+	}
+
+	fi, err := os.Stat(f.Name())
+	if err != nil {
+		return err
+	}
+	b.files = append(b.files, &spooled{name: name, path: f.Name(), size: fi.Size()})
+	return nil
+}
+
+// WriteTo writes the export tarball to w.
+func (b *Builder) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	tw := tar.NewWriter(cw)
+
+	now := b.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	var manifest bytes.Buffer
+	enc := json.NewEncoder(&manifest)
+	for _, st := range b.streams {
+		if err := enc.Encode(st); err != nil {
+			return cw.n, err
+		}
+	}
+	err := tw.WriteHeader(&tar.Header{
+		Name:    ManifestName,
+		Mode:    0644,
+		Size:    int64(manifest.Len()),
+		ModTime: now,
+	})
+	if err != nil {
+		return cw.n, err
+	}
+	if _, err := tw.Write(manifest.Bytes()); err != nil {
+		return cw.n, err
+	}
+
+	for _, f := range b.files {
+		if err := writeFile(tw, f, now); err != nil {
+			return cw.n, errors.Annotate(err, "failed to write %q", f.name).Err()
+		}
+	}
+	return cw.n, tw.Close()
+}
+
+func writeFile(tw *tar.Writer, f *spooled, now time.Time) error {
+	err := tw.WriteHeader(&tar.Header{
+		Name:    f.name,
+		Mode:    0644,
+		Size:    f.size,
+		ModTime: now,
+	})
+	if err != nil {
+		return err
+	}
+
+	src, err := os.Open(filepath.Clean(f.path))
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	_, err = io.Copy(tw, src)
+	return err
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,31 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package export reads and writes LogDog stream exports.
+//
+// An export is a tarball with one file per log stream, in the stream's native
+// format:
+//   - Text streams are stored as plain text, with their original line
+//     delimiters.
+//   - Binary streams are stored as their raw data.
+//   - Datagram streams are stored as one file per datagram, named after the
+//     stream with a ".NNNNN" datagram number suffix.
+//   - Structured streams are stored as JSON Lines, one record per line.
+//
+// The first file in the tarball, ManifestName, is a JSON Lines manifest with
+// one Stream record per exported stream, including its descriptor.
+//
+// An export can be loaded back into a storage.Storage with Import, e.g. for
+// offline replay from memory or filesystem storage.
+package export
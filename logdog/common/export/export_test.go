@@ -0,0 +1,241 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/storage/filesystem"
+	"go.chromium.org/luci/logdog/common/storage/memory"
+	"go.chromium.org/luci/logdog/common/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// sliceSource is a renderer.Source that returns a fixed set of entries.
+type sliceSource []*logpb.LogEntry
+
+func (s *sliceSource) NextLogEntry() (*logpb.LogEntry, error) {
+	if len(*s) == 0 {
+		return nil, io.EOF
+	}
+	le := (*s)[0]
+	*s = (*s)[1:]
+	return le, nil
+}
+
+func textEntry(lines ...string) *logpb.LogEntry {
+	t := &logpb.Text{}
+	for _, l := range lines {
+		line := &logpb.Text_Line{Value: []byte(l), Delimiter: "\n"}
+		if n := len(l); n > 0 && l[n-1] == '!' {
+			// Mark a partial (undelimited) line.
+			line = &logpb.Text_Line{Value: []byte(l)}
+		}
+		t.Lines = append(t.Lines, line)
+	}
+	return &logpb.LogEntry{Content: &logpb.LogEntry_Text{Text: t}}
+}
+
+func datagramEntry(data string, partial *logpb.Datagram_Partial) *logpb.LogEntry {
+	return &logpb.LogEntry{Content: &logpb.LogEntry_Datagram{Datagram: &logpb.Datagram{
+		Data:    []byte(data),
+		Partial: partial,
+	}}}
+}
+
+func readTar(data []byte) (names []string, files map[string]string) {
+	files = map[string]string{}
+	tr := tar.NewReader(bytes.NewReader(data))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return
+		}
+		So(err, ShouldBeNil)
+		content, err := ioutil.ReadAll(tr)
+		So(err, ShouldBeNil)
+		names = append(names, hdr.Name)
+		files[hdr.Name] = string(content)
+	}
+}
+
+func getEntries(c context.Context, st storage.Storage, project string, path types.StreamPath) []*logpb.LogEntry {
+	var entries []*logpb.LogEntry
+	err := st.Get(c, storage.GetRequest{Project: project, Path: path}, func(e *storage.Entry) bool {
+		le, err := e.GetLogEntry()
+		So(err, ShouldBeNil)
+		entries = append(entries, le)
+		return true
+	})
+	So(err, ShouldBeNil)
+	return entries
+}
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+
+	Convey(`An export Builder`, t, func() {
+		c := context.Background()
+
+		b, err := NewBuilder("")
+		So(err, ShouldBeNil)
+		defer b.Close()
+		b.Now = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		addStream := func(name types.StreamName, st logpb.StreamType, entries ...*logpb.LogEntry) {
+			desc := &logpb.LogStreamDescriptor{
+				Prefix:      "pre",
+				Name:        string(name),
+				StreamType:  st,
+				ContentType: "test/data",
+			}
+			src := sliceSource(entries)
+			So(b.AddStream("proj", desc.Path(), desc, &src), ShouldBeNil)
+		}
+
+		addStream("stdout", logpb.StreamType_TEXT,
+			textEntry("hello", "world"),
+			textEntry("partial!"))
+		addStream("data", logpb.StreamType_BINARY,
+			&logpb.LogEntry{Content: &logpb.LogEntry_Binary{Binary: &logpb.Binary{Data: []byte{0x00, 0x01}}}},
+			&logpb.LogEntry{Content: &logpb.LogEntry_Binary{Binary: &logpb.Binary{Data: []byte{0x02}}}})
+		addStream("dg", logpb.StreamType_DATAGRAM,
+			datagramEntry("first", nil),
+			datagramEntry("sec", &logpb.Datagram_Partial{Index: 0, Size: 6}),
+			datagramEntry("ond", &logpb.Datagram_Partial{Index: 1, Size: 6, Last: true}))
+		addStream("rec", logpb.StreamType_STRUCTURED,
+			&logpb.LogEntry{Content: &logpb.LogEntry_Structured{Structured: &logpb.Structured{
+				Fields: []*logpb.Structured_Field{
+					{Key: "step", Value: &logpb.Structured_Field_StringValue{StringValue: "compile"}},
+					{Key: "count", Value: &logpb.Structured_Field_IntValue{IntValue: 3}},
+				},
+			}}})
+
+		var buf bytes.Buffer
+		n, err := b.WriteTo(&buf)
+		So(err, ShouldBeNil)
+		So(n, ShouldEqual, buf.Len())
+
+		Convey(`Writes a tarball with a manifest and one file per stream.`, func() {
+			names, files := readTar(buf.Bytes())
+			So(names, ShouldResemble, []string{
+				ManifestName,
+				"proj/pre/+/stdout",
+				"proj/pre/+/data",
+				"proj/pre/+/dg.00000",
+				"proj/pre/+/dg.00001",
+				"proj/pre/+/rec",
+			})
+			So(files["proj/pre/+/stdout"], ShouldEqual, "hello\nworld\npartial!")
+			So(files["proj/pre/+/data"], ShouldEqual, "\x00\x01\x02")
+			So(files["proj/pre/+/dg.00000"], ShouldEqual, "first")
+			So(files["proj/pre/+/dg.00001"], ShouldEqual, "second")
+			So(files["proj/pre/+/rec"], ShouldEqual,
+				`{"fields":[{"key":"step","string_value":"compile"},{"key":"count","int_value":"3"}]}`+"\n")
+
+			streams, err := readManifest(bytes.NewReader([]byte(files[ManifestName])))
+			So(err, ShouldBeNil)
+			So(streams, ShouldHaveLength, 4)
+			So(streams[0].Path, ShouldEqual, types.StreamPath("pre/+/stdout"))
+			So(streams[0].File, ShouldEqual, "proj/pre/+/stdout")
+			So(streams[0].Entries, ShouldEqual, 2)
+			So(streams[2].Datagrams, ShouldResemble, []string{"proj/pre/+/dg.00000", "proj/pre/+/dg.00001"})
+
+			desc, err := streams[1].Desc()
+			So(err, ShouldBeNil)
+			So(desc, ShouldResembleProto, &logpb.LogStreamDescriptor{
+				Prefix:      "pre",
+				Name:        "data",
+				StreamType:  logpb.StreamType_BINARY,
+				ContentType: "test/data",
+			})
+		})
+
+		checkImport := func(st storage.Storage) {
+			streams, err := Import(c, bytes.NewReader(buf.Bytes()), st)
+			So(err, ShouldBeNil)
+			So(streams, ShouldHaveLength, 4)
+
+			text := getEntries(c, st, "proj", "pre/+/stdout")
+			So(text, ShouldHaveLength, 3)
+			So(text[1], ShouldResembleProto, &logpb.LogEntry{
+				StreamIndex: 1,
+				Sequence:    1,
+				Content: &logpb.LogEntry_Text{Text: &logpb.Text{
+					Lines: []*logpb.Text_Line{{Value: []byte("world"), Delimiter: "\n"}},
+				}},
+			})
+			So(text[2].GetText().Lines[0].Delimiter, ShouldEqual, "")
+
+			bin := getEntries(c, st, "proj", "pre/+/data")
+			So(bin, ShouldHaveLength, 1)
+			So(bin[0].GetBinary().Data, ShouldResemble, []byte{0x00, 0x01, 0x02})
+
+			dg := getEntries(c, st, "proj", "pre/+/dg")
+			So(dg, ShouldHaveLength, 2)
+			So(dg[1].StreamIndex, ShouldEqual, 1)
+			So(string(dg[1].GetDatagram().Data), ShouldEqual, "second")
+
+			rec := getEntries(c, st, "proj", "pre/+/rec")
+			So(rec, ShouldHaveLength, 1)
+			So(rec[0].GetStructured().Fields, ShouldHaveLength, 2)
+			So(rec[0].GetStructured().Fields[1].GetIntValue(), ShouldEqual, 3)
+		}
+
+		Convey(`Can be imported into memory storage.`, func() {
+			checkImport(&memory.Storage{})
+		})
+
+		Convey(`Can be imported into filesystem storage.`, func() {
+			dir, err := ioutil.TempDir("", "logdog_export_test")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(dir)
+
+			checkImport(&filesystem.Storage{Dir: dir})
+		})
+
+		Convey(`Import rejects files that are not in the manifest.`, func() {
+			var bad bytes.Buffer
+			tw := tar.NewWriter(&bad)
+			So(tw.WriteHeader(&tar.Header{Name: ManifestName, Mode: 0644}), ShouldBeNil)
+			So(tw.WriteHeader(&tar.Header{Name: "stray", Mode: 0644}), ShouldBeNil)
+			So(tw.Close(), ShouldBeNil)
+
+			_, err := Import(c, &bad, &memory.Storage{})
+			So(err, ShouldErrLike, `file "stray" is not in the manifest`)
+		})
+
+		Convey(`Import requires a manifest.`, func() {
+			var bad bytes.Buffer
+			tw := tar.NewWriter(&bad)
+			So(tw.WriteHeader(&tar.Header{Name: "stray", Mode: 0644}), ShouldBeNil)
+			So(tw.Close(), ShouldBeNil)
+
+			_, err := Import(c, &bad, &memory.Storage{})
+			So(err, ShouldErrLike, "not \"manifest.jsonl\"")
+		})
+	})
+}
@@ -0,0 +1,297 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+const (
+	// binaryChunkSize is the size of the log entries that binary stream data
+	// is split into on import.
+	binaryChunkSize = 32 * 1024
+
+	// maxPutEntries is the maximum number of log entries to put in a single
+	// storage request.
+	maxPutEntries = 128
+)
+
+// Import loads the export read from r into st, returning its manifest.
+//
+// Log entries are regenerated from each stream's data: one entry per text
+// line, per datagram, or per 32KiB of binary data. The original entry
+// boundaries and timestamps are not preserved.
+func Import(ctx context.Context, r io.Reader, st storage.Storage) ([]*Stream, error) {
+	tr := tar.NewReader(r)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read manifest").Err()
+	}
+	if hdr.Name != ManifestName {
+		return nil, errors.Reason("first file is %q, not %q", hdr.Name, ManifestName).Err()
+	}
+	streams, err := readManifest(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Index the files of each stream.
+	type fileRef struct {
+		s        *importer
+		datagram int
+	}
+	files := make(map[string]fileRef)
+	for _, s := range streams {
+		desc, err := s.Desc()
+		if err != nil {
+			return nil, err
+		}
+		imp := &importer{ctx: ctx, st: st, s: s, streamType: desc.StreamType}
+		if s.File != "" {
+			files[s.File] = fileRef{s: imp, datagram: -1}
+		}
+		for i, name := range s.Datagrams {
+			files[name] = fileRef{s: imp, datagram: i}
+		}
+	}
+
+	for {
+		hdr, err := tr.Next()
+		switch {
+		case err == io.EOF:
+			return streams, nil
+		case err != nil:
+			return nil, errors.Annotate(err, "failed to read export").Err()
+		case hdr.Typeflag != tar.TypeReg:
+			continue
+		}
+
+		ref, ok := files[hdr.Name]
+		if !ok {
+			return nil, errors.Reason("file %q is not in the manifest", hdr.Name).Err()
+		}
+		if err := ref.s.importFile(tr, ref.datagram); err != nil {
+			return nil, errors.Annotate(err, "failed to import %q", hdr.Name).Err()
+		}
+	}
+}
+
+func readManifest(r io.Reader) ([]*Stream, error) {
+	var streams []*Stream
+	dec := json.NewDecoder(r)
+	for {
+		s := &Stream{}
+		switch err := dec.Decode(s); {
+		case err == io.EOF:
+			return streams, nil
+		case err != nil:
+			return nil, errors.Annotate(err, "invalid manifest").Err()
+		}
+
+		if err := s.Path.Validate(); err != nil {
+			return nil, errors.Annotate(err, "invalid stream path in manifest").Err()
+		}
+		streams = append(streams, s)
+	}
+}
+
+// importer regenerates and stores a single stream's log entries.
+type importer struct {
+	ctx        context.Context
+	st         storage.Storage
+	s          *Stream
+	streamType logpb.StreamType
+
+	// next is the next stream index.
+	next types.MessageIndex
+	// seq is the next entry's sequence number.
+	seq uint64
+	// datagrams is the number of datagrams imported.
+	datagrams int
+
+	// pending are marshalled entries, starting at pendingIndex, that have not
+	// been put yet.
+	pending      [][]byte
+	pendingIndex types.MessageIndex
+}
+
+func (imp *importer) importFile(r io.Reader, datagram int) error {
+	var err error
+	switch imp.streamType {
+	case logpb.StreamType_TEXT:
+		err = imp.importText(r)
+
+	case logpb.StreamType_BINARY:
+		err = imp.importBinary(r)
+
+	case logpb.StreamType_DATAGRAM:
+		if datagram != imp.datagrams {
+			return errors.Reason("datagram %d is out of order, expected %d", datagram, imp.datagrams).Err()
+		}
+		imp.datagrams++
+
+		var data []byte
+		if data, err = ioutil.ReadAll(r); err == nil {
+			err = imp.add(&logpb.LogEntry{
+				Sequence: imp.seq,
+				Content:  &logpb.LogEntry_Datagram{Datagram: &logpb.Datagram{Data: data}},
+			}, 1)
+		}
+
+	case logpb.StreamType_STRUCTURED:
+		err = imp.importStructured(r)
+
+	default:
+		err = errors.Reason("unsupported stream type %s", imp.streamType).Err()
+	}
+	if err != nil {
+		return err
+	}
+	return imp.flush()
+}
+
+func (imp *importer) importText(r io.Reader) error {
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			delim := ""
+			switch {
+			case bytes.HasSuffix(line, []byte("\r\n")):
+				delim = "\r\n"
+			case bytes.HasSuffix(line, []byte("\n")):
+				delim = "\n"
+			}
+			line = line[:len(line)-len(delim)]
+
+			aerr := imp.add(&logpb.LogEntry{
+				Sequence: imp.seq,
+				Content: &logpb.LogEntry_Text{Text: &logpb.Text{
+					Lines: []*logpb.Text_Line{{Value: line, Delimiter: delim}},
+				}},
+			}, 1)
+			if aerr != nil {
+				return aerr
+			}
+		}
+
+		switch err {
+		case nil:
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
+func (imp *importer) importStructured(r io.Reader) error {
+	dec := json.NewDecoder(r)
+	for {
+		var raw json.RawMessage
+		switch err := dec.Decode(&raw); {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+
+		rec := &logpb.Structured{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(raw), rec); err != nil {
+			return errors.Annotate(err, "invalid structured record %d", imp.seq).Err()
+		}
+		err := imp.add(&logpb.LogEntry{
+			Sequence: imp.seq,
+			Content:  &logpb.LogEntry_Structured{Structured: rec},
+		}, 1)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (imp *importer) importBinary(r io.Reader) error {
+	for {
+		buf := make([]byte, binaryChunkSize)
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			aerr := imp.add(&logpb.LogEntry{
+				Sequence: imp.seq,
+				Content:  &logpb.LogEntry_Binary{Binary: &logpb.Binary{Data: buf[:n]}},
+			}, uint64(n))
+			if aerr != nil {
+				return aerr
+			}
+		}
+
+		switch err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
+// add adds the next log entry, advancing the sequence number by seqDelta.
+func (imp *importer) add(le *logpb.LogEntry, seqDelta uint64) error {
+	le.StreamIndex = uint64(imp.next)
+	data, err := proto.Marshal(le)
+	if err != nil {
+		return err
+	}
+
+	if len(imp.pending) == 0 {
+		imp.pendingIndex = imp.next
+	}
+	imp.pending = append(imp.pending, data)
+	imp.next++
+	imp.seq += seqDelta
+
+	if len(imp.pending) >= maxPutEntries {
+		return imp.flush()
+	}
+	return nil
+}
+
+func (imp *importer) flush() error {
+	if len(imp.pending) == 0 {
+		return nil
+	}
+	err := imp.st.Put(imp.ctx, storage.PutRequest{
+		Project: imp.s.Project,
+		Path:    imp.s.Path,
+		Index:   imp.pendingIndex,
+		Values:  imp.pending,
+	})
+	imp.pending = nil
+	return err
+}
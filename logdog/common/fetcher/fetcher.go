@@ -108,6 +108,11 @@ type Options struct {
 	// confirm this.
 	RequireCompleteStream bool
 
+	// Set this to stop at the current end of a stream that isn't complete yet,
+	// instead of waiting for more logs. The Fetcher returns io.EOF after the
+	// logs that the Source returns before its first empty response.
+	NoWait bool
+
 	// sizeFunc is a function that calculates the byte size of a LogEntry
 	// protobuf.
 	//
@@ -229,7 +234,14 @@ func (f *Fetcher) fetch(c context.Context) {
 	}
 
 	count := int64(0)
+	// atEnd is true once the Source has no more logs, and NoWait is set.
+	atEnd := false
 	for tidx < 0 || lastSentIndex < tidx {
+		if atEnd && lb.current() == nil {
+			log.Debugf(c, "Reached the current end of the stream.")
+			break
+		}
+
 		// If we're configured with an upper delivery bound and we've delivered
 		// that many entries, we're done.
 		remaining := int64(-1)
@@ -257,7 +269,7 @@ func (f *Fetcher) fetch(c context.Context) {
 
 		// If we're not currently fetching logs, and we are below our thresholds,
 		// request a new batch of logs.
-		if logFetchC == nil && (tidx < 0 || nextFetchIndex <= tidx) {
+		if logFetchC == nil && !atEnd && (tidx < 0 || nextFetchIndex <= tidx) {
 
 			// We always have a byte constraint. Are we below it?
 			fetchCount := f.applyConstraint(int64(f.o.BufferCount), int64(lb.size()))
@@ -326,6 +338,8 @@ func (f *Fetcher) fetch(c context.Context) {
 			}
 			if resp.tidx >= 0 {
 				tidx = resp.tidx
+			} else if len(resp.logs) == 0 && f.o.NoWait {
+				atEnd = true
 			}
 
 		case sendC <- lr:
@@ -391,6 +405,10 @@ func (f *Fetcher) fetchLogs(c context.Context, req *fetchRequest) {
 			// index.
 			return
 
+		case f.o.NoWait:
+			// No logs this round, and we shouldn't wait for more.
+			return
+
 		default:
 			// No logs this round. Sleep for more.
 			log.Fields{
@@ -271,6 +271,23 @@ func TestFetcher(t *testing.T) {
 				So(delayed, ShouldBeTrue)
 			})
 
+			Convey(`Stops at the current end of the stream if NoWait is set.`, func() {
+				tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+					panic("should not wait for more logs")
+				})
+
+				var cmd testSourceCommand
+				ts.send(cmd.logs(0, 1, 2, 3, 4))
+
+				o.NoWait = true
+				f := newFetcher()
+				defer reap(f)
+
+				logs, err := loadLogs(f, 0)
+				So(err, ShouldEqual, io.EOF)
+				So(logs, ShouldResemble, []types.MessageIndex{0, 1, 2, 3, 4})
+			})
+
 			Convey(`When an error is countered getting the terminal index, returns the error.`, func() {
 				var cmd testSourceCommand
 				ts.send(cmd.error(errors.New("test error"), false))
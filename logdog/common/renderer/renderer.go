@@ -19,6 +19,8 @@
 //     order.
 //   - Binary streams are rendered by emitting the sequential binary data
 //     verbatim.
+//   - Structured streams are rendered one record per line as space-separated
+//     key=value pairs.
 package renderer
 
 import (
@@ -50,10 +52,13 @@ type Renderer struct {
 	//   newline delimiters. If this is false, UTF8 and "\n" will be used.
 	// - For binary and datagram streams, this skips any associated
 	//   DatagramWriters and hex translation and dumps data directly to output.
+	// - For structured streams, this renders each record as a JSON object on
+	//   its own line.
 	Raw bool
 
-	// TextPrefix, if not nil, is called prior to rendering a text line. The
-	// resulting string is prepended to that text line on render.
+	// TextPrefix, if not nil, is called prior to rendering a text line or a
+	// structured record. The resulting string is prepended to that line on
+	// render. For structured records, line will be nil.
 	TextPrefix func(le *logpb.LogEntry, line *logpb.Text_Line) string
 
 	// DatagramWriter is a function to call to render a complete datagram stream.
@@ -152,6 +157,19 @@ func (r *Renderer) bufferNext() error {
 
 				r.dgBuf.Reset()
 			}
+
+		case le.GetStructured() != nil:
+			if r.Raw {
+				if err := writeStructuredJSON(&r.buf, le.GetStructured()); err != nil {
+					return err
+				}
+				break
+			}
+
+			if r.TextPrefix != nil {
+				r.buf.WriteString(r.TextPrefix(le, nil))
+			}
+			writeStructured(&r.buf, le.GetStructured())
 		}
 	}
 
@@ -18,7 +18,9 @@ import (
 	"bytes"
 	"io"
 	"testing"
+	"time"
 
+	"go.chromium.org/luci/common/proto/google"
 	"go.chromium.org/luci/logdog/api/logpb"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -86,6 +88,16 @@ func (ts *testSource) loadDatagram(data []byte, term bool) {
 	})
 }
 
+func (ts *testSource) loadStructured(fields ...*logpb.Structured_Field) {
+	ts.loadLogEntry(&logpb.LogEntry{
+		Content: &logpb.LogEntry_Structured{
+			Structured: &logpb.Structured{
+				Fields: fields,
+			},
+		},
+	})
+}
+
 func TestRenderer(t *testing.T) {
 	t.Parallel()
 
@@ -210,6 +222,41 @@ func TestRenderer(t *testing.T) {
 			})
 		})
 
+		Convey(`With STRUCTURED log entries`, func() {
+			ts.loadStructured(
+				&logpb.Structured_Field{Key: "step", Value: &logpb.Structured_Field_StringValue{StringValue: "unit tests"}},
+				&logpb.Structured_Field{Key: "count", Value: &logpb.Structured_Field_IntValue{IntValue: 12}},
+				&logpb.Structured_Field{Key: "ok", Value: &logpb.Structured_Field_BoolValue{BoolValue: true}})
+			ts.loadStructured(
+				&logpb.Structured_Field{Key: "ratio", Value: &logpb.Structured_Field_DoubleValue{DoubleValue: 0.5}},
+				&logpb.Structured_Field{Key: "data", Value: &logpb.Structured_Field_BytesValue{BytesValue: []byte{0xca, 0xfe}}},
+				&logpb.Structured_Field{Key: "at", Value: &logpb.Structured_Field_TimestampValue{
+					TimestampValue: google.NewTimestamp(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))}})
+
+			Convey(`Renders key=value lines.`, func() {
+				r.TextPrefix = func(le *logpb.LogEntry, line *logpb.Text_Line) string {
+					So(line, ShouldBeNil)
+					return "> "
+				}
+
+				_, err := b.ReadFrom(r)
+				So(err, ShouldBeNil)
+				So(b.String(), ShouldEqual,
+					"> step=\"unit tests\" count=12 ok=true\n"+
+						"> ratio=0.5 data=cafe at=2020-01-02T03:04:05Z\n")
+			})
+
+			Convey(`When configured to render raw, renders JSON lines.`, func() {
+				r.Raw = true
+
+				_, err := b.ReadFrom(r)
+				So(err, ShouldBeNil)
+				So(b.String(), ShouldEqual,
+					`{"step":"unit tests","count":12,"ok":true}`+"\n"+
+						`{"ratio":0.5,"data":"yv4=","at":"2020-01-02T03:04:05Z"}`+"\n")
+			})
+		})
+
 		Convey(`With empty log entries, renders nothing.`, func() {
 			ts.loadLogEntry(&logpb.LogEntry{})
 			c, err := b.ReadFrom(r)
@@ -0,0 +1,100 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package renderer
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/logdog/api/logpb"
+)
+
+// writeStructured renders a structured record as a line of space-separated
+// key=value pairs, quoting string values where necessary.
+func writeStructured(buf *bytes.Buffer, rec *logpb.Structured) {
+	for i, f := range rec.Fields {
+		if i > 0 {
+			buf.WriteRune(' ')
+		}
+		buf.WriteString(f.Key)
+		buf.WriteRune('=')
+
+		switch v := f.Value.(type) {
+		case *logpb.Structured_Field_StringValue:
+			s := v.StringValue
+			if s == "" || strings.ContainsAny(s, " \"=") || !strconv.CanBackquote(s) {
+				s = strconv.Quote(s)
+			}
+			buf.WriteString(s)
+		case *logpb.Structured_Field_IntValue:
+			buf.WriteString(strconv.FormatInt(v.IntValue, 10))
+		case *logpb.Structured_Field_DoubleValue:
+			buf.WriteString(strconv.FormatFloat(v.DoubleValue, 'g', -1, 64))
+		case *logpb.Structured_Field_BoolValue:
+			buf.WriteString(strconv.FormatBool(v.BoolValue))
+		case *logpb.Structured_Field_BytesValue:
+			buf.WriteString(hex.EncodeToString(v.BytesValue))
+		case *logpb.Structured_Field_TimestampValue:
+			buf.WriteString(google.TimeFromProto(v.TimestampValue).UTC().Format(time.RFC3339Nano))
+		}
+	}
+	buf.WriteRune('\n')
+}
+
+// writeStructuredJSON renders a structured record as a single-line JSON
+// object, preserving the order of its fields.
+func writeStructuredJSON(buf *bytes.Buffer, rec *logpb.Structured) error {
+	buf.WriteRune('{')
+	for i, f := range rec.Fields {
+		if i > 0 {
+			buf.WriteRune(',')
+		}
+
+		var v interface{}
+		switch fv := f.Value.(type) {
+		case *logpb.Structured_Field_StringValue:
+			v = fv.StringValue
+		case *logpb.Structured_Field_IntValue:
+			v = fv.IntValue
+		case *logpb.Structured_Field_DoubleValue:
+			v = fv.DoubleValue
+		case *logpb.Structured_Field_BoolValue:
+			v = fv.BoolValue
+		case *logpb.Structured_Field_BytesValue:
+			v = fv.BytesValue
+		case *logpb.Structured_Field_TimestampValue:
+			v = google.TimeFromProto(fv.TimestampValue).UTC().Format(time.RFC3339Nano)
+		}
+
+		k, err := json.Marshal(f.Key)
+		if err != nil {
+			return err
+		}
+		d, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		buf.Write(k)
+		buf.WriteRune(':')
+		buf.Write(d)
+	}
+	buf.WriteString("}\n")
+	return nil
+}
@@ -17,24 +17,26 @@ package bigtable
 import (
 	"bytes"
 	"context"
-	"strconv"
 	"testing"
 
 	"go.chromium.org/luci/common/data/recordio"
 	"go.chromium.org/luci/logdog/common/storage"
 	"go.chromium.org/luci/logdog/common/storage/memory"
+	"go.chromium.org/luci/logdog/common/storage/storagetest"
 	"go.chromium.org/luci/logdog/common/types"
 
 	. "github.com/smartystreets/goconvey/convey"
 	. "go.chromium.org/luci/common/testing/assertions"
 )
 
-func mustGetIndex(e *storage.Entry) types.MessageIndex {
-	idx, err := e.GetStreamIndex()
-	if err != nil {
-		panic(err)
-	}
-	return idx
+func TestStorageImplementation(t *testing.T) {
+	t.Parallel()
+
+	storagetest.RunStorageImplementationTests(t, context.Background(), storagetest.TestOptions{
+		Factory: func() storage.Storage {
+			return NewMemoryInstance(&memory.Cache{})
+		},
+	})
 }
 
 func TestStorage(t *testing.T) {
@@ -48,26 +50,6 @@ func TestStorage(t *testing.T) {
 		defer s.Close()
 
 		project := "test-project"
-		get := func(path string, index int, limit int, keysOnly bool) ([]string, error) {
-			req := storage.GetRequest{
-				Project:  project,
-				Path:     types.StreamPath(path),
-				Index:    types.MessageIndex(index),
-				Limit:    limit,
-				KeysOnly: keysOnly,
-			}
-			var got []string
-			err := s.Get(c, req, func(e *storage.Entry) bool {
-				if keysOnly {
-					got = append(got, strconv.Itoa(int(mustGetIndex(e))))
-				} else {
-					got = append(got, string(e.D))
-				}
-				return true
-			})
-			return got, err
-		}
-
 		put := func(path string, index int, d ...string) error {
 			data := make([][]byte, len(d))
 			for i, v := range d {
@@ -140,128 +122,6 @@ func TestStorage(t *testing.T) {
 					})
 				})
 			})
-
-			Convey(`Testing "Get"...`, func() {
-				Convey(`Can fetch the full row, "A".`, func() {
-					got, err := get("A", 0, 0, false)
-					So(err, ShouldBeNil)
-					So(got, ShouldResemble, []string{"0", "1", "2", "3", "4"})
-				})
-
-				Convey(`Will fetch A{1, 2, 3, 4} with index=1.`, func() {
-					got, err := get("A", 1, 0, false)
-					So(err, ShouldBeNil)
-					So(got, ShouldResemble, []string{"1", "2", "3", "4"})
-				})
-
-				Convey(`Will fetch A{1, 2} with index=1 and limit=2.`, func() {
-					got, err := get("A", 1, 2, false)
-					So(err, ShouldBeNil)
-					So(got, ShouldResemble, []string{"1", "2"})
-				})
-
-				Convey(`Will fetch B{10, 12, 13} for B.`, func() {
-					got, err := get("B", 0, 0, false)
-					So(err, ShouldBeNil)
-					So(got, ShouldResemble, []string{"10", "12", "13"})
-				})
-
-				Convey(`Will fetch B{12, 13} when index=11.`, func() {
-					got, err := get("B", 11, 0, false)
-					So(err, ShouldBeNil)
-					So(got, ShouldResemble, []string{"12", "13"})
-				})
-
-				Convey(`Will fetch {} for INVALID.`, func() {
-					got, err := get("INVALID", 0, 0, false)
-					So(err, ShouldBeNil)
-					So(got, ShouldBeEmpty)
-				})
-			})
-
-			Convey(`Testing "Get" (keys only)`, func() {
-				Convey(`Can fetch the full row, "A".`, func() {
-					got, err := get("A", 0, 0, true)
-					So(err, ShouldBeNil)
-					So(got, ShouldResemble, []string{"0", "1", "2", "3", "4"})
-				})
-
-				Convey(`Will fetch A{1, 2, 3, 4} with index=1.`, func() {
-					got, err := get("A", 1, 0, true)
-					So(err, ShouldBeNil)
-					So(got, ShouldResemble, []string{"1", "2", "3", "4"})
-				})
-
-				Convey(`Will fetch A{1, 2} with index=1 and limit=2.`, func() {
-					got, err := get("A", 1, 2, true)
-					So(err, ShouldBeNil)
-					So(got, ShouldResemble, []string{"1", "2"})
-				})
-
-				Convey(`Will fetch B{10, 12, 13} for B.`, func() {
-					got, err := get("B", 0, 0, true)
-					So(err, ShouldBeNil)
-					So(got, ShouldResemble, []string{"10", "12", "13"})
-				})
-
-				Convey(`Will fetch B{12, 13} when index=11.`, func() {
-					got, err := get("B", 11, 0, true)
-					So(err, ShouldBeNil)
-					So(got, ShouldResemble, []string{"12", "13"})
-				})
-			})
-
-			Convey(`Testing "Tail"...`, func() {
-				tail := func(path string) (string, error) {
-					e, err := s.Tail(c, project, types.StreamPath(path))
-					if err != nil {
-						return "", err
-					}
-					return string(e.D), nil
-				}
-
-				Convey(`A tail request for "A" returns A{4}.`, func() {
-					got, err := tail("A")
-					So(err, ShouldBeNil)
-					So(got, ShouldEqual, "4")
-
-					Convey(`(Cache) A second request also returns A{4}.`, func() {
-						got, err := tail("A")
-						So(err, ShouldBeNil)
-						So(got, ShouldEqual, "4")
-					})
-				})
-
-				Convey(`A tail request for "B" returns nothing (no contiguous logs).`, func() {
-					_, err := tail("B")
-					So(err, ShouldEqual, storage.ErrDoesNotExist)
-				})
-
-				Convey(`A tail request for "C" returns 2.`, func() {
-					got, err := tail("C")
-					So(err, ShouldBeNil)
-					So(got, ShouldEqual, "2")
-
-					Convey(`(Cache) A second request also returns 2.`, func() {
-						got, err := tail("C")
-						So(err, ShouldBeNil)
-						So(got, ShouldEqual, "2")
-					})
-
-					Convey(`(Cache) After "3" is added, a second request returns 4.`, func() {
-						So(put("C", 3, "3"), ShouldBeNil)
-
-						got, err := tail("C")
-						So(err, ShouldBeNil)
-						So(got, ShouldEqual, "4")
-					})
-				})
-
-				Convey(`A tail request for "INVALID" errors NOT FOUND.`, func() {
-					_, err := tail("INVALID")
-					So(err, ShouldEqual, storage.ErrDoesNotExist)
-				})
-			})
 		})
 	})
 }
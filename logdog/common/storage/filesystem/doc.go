@@ -0,0 +1,45 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filesystem implements a durable storage.Storage backed by files on a
+// local disk.
+//
+// It is intended for running a LogDog collector and Coordinator on a single
+// machine for local development and debugging, in place of BigTable. It
+// follows the same semantics as the BigTable intermediate storage.
+//
+// Layout
+//
+// Each log stream is stored in its own directory beneath the storage root,
+// named after the hex-encoded SHA256 of its project and path:
+//
+//   <root>/<hash>/stream       The stream's project and path, for humans.
+//   <root>/<hash>/index        Fixed-size index records, one per log entry.
+//   <root>/<hash>/<n>.segment  Append-only log entry data segments.
+//
+// Each index record is indexRecordSize bytes, big-endian:
+//
+//   [8 bytes] stream index
+//   [4 bytes] segment number
+//   [8 bytes] offset of the entry within the segment
+//   [4 bytes] size of the entry
+//
+// Entry data is always made durable before its index record is written, so a
+// crash can at worst leave unreferenced bytes at the end of a segment. A torn
+// index record at the end of the index file is ignored.
+//
+// A single Storage instance is goroutine-safe. Any number of processes may
+// read from the same root, but only one process may write to a given stream
+// at a time.
+package filesystem
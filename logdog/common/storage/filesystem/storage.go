@@ -0,0 +1,494 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+const (
+	// DefaultMaxSegmentSize is the default value of Storage's MaxSegmentSize.
+	DefaultMaxSegmentSize = 64 * 1024 * 1024
+
+	// indexRecordSize is the size of a single index record, in bytes.
+	indexRecordSize = 8 + 4 + 8 + 4
+
+	indexFileName  = "index"
+	streamFileName = "stream"
+)
+
+// Storage is a storage.Storage implementation that stores log entries in files
+// beneath a local directory.
+type Storage struct {
+	// Dir is the root directory of the storage. It will be created if it does not
+	// exist.
+	Dir string
+
+	// MaxSegmentSize, if not zero, is the size at which a stream's current data
+	// segment is closed and a new one started. If zero, DefaultMaxSegmentSize
+	// will be used.
+	//
+	// A single Put may cause a segment to exceed this size by at most one entry.
+	MaxSegmentSize int64
+
+	mu      sync.Mutex
+	streams map[string]*streamState
+}
+
+var _ storage.Storage = (*Storage)(nil)
+
+// entryLoc is the location of a single log entry's data.
+type entryLoc struct {
+	index   types.MessageIndex
+	segment uint32
+	offset  int64
+	size    int64
+}
+
+// streamState is the in-memory view of a single stream's index file.
+type streamState struct {
+	dir string
+
+	// entries maps each stored stream index to its location.
+	entries map[types.MessageIndex]entryLoc
+	// indices is the sorted list of stored stream indices.
+	indices []types.MessageIndex
+
+	// indexSize is the number of bytes of the index file that have been loaded.
+	indexSize int64
+	// lastSegment is the highest segment number referenced by the index.
+	lastSegment uint32
+}
+
+func (ss *streamState) reset() {
+	ss.entries = map[types.MessageIndex]entryLoc{}
+	ss.indices = nil
+	ss.indexSize = 0
+	ss.lastSegment = 0
+}
+
+func (ss *streamState) add(loc entryLoc) {
+	if _, ok := ss.entries[loc.index]; !ok {
+		// Entries are usually added in order, so this is almost always an append.
+		i := sort.Search(len(ss.indices), func(i int) bool { return ss.indices[i] >= loc.index })
+		ss.indices = append(ss.indices, 0)
+		copy(ss.indices[i+1:], ss.indices[i:])
+		ss.indices[i] = loc.index
+	}
+	ss.entries[loc.index] = loc
+	if loc.segment > ss.lastSegment {
+		ss.lastSegment = loc.segment
+	}
+}
+
+// refresh loads any index records that have been appended to the stream's
+// index file since it was last loaded.
+//
+// It returns false if the stream has no index file.
+func (ss *streamState) refresh() (bool, error) {
+	f, err := os.Open(filepath.Join(ss.dir, indexFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			// The stream doesn't exist, or was expunged.
+			ss.reset()
+			return false, nil
+		}
+		return false, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return false, err
+	}
+	size := fi.Size()
+	size -= size % indexRecordSize // Ignore a torn trailing record.
+	if size < ss.indexSize {
+		// The index was replaced (e.g., expunged and rewritten). Reload it.
+		ss.reset()
+	}
+	if size == ss.indexSize {
+		return true, nil
+	}
+
+	buf := make([]byte, size-ss.indexSize)
+	if _, err := f.ReadAt(buf, ss.indexSize); err != nil {
+		return false, err
+	}
+	for len(buf) > 0 {
+		ss.add(decodeIndexRecord(buf[:indexRecordSize]))
+		buf = buf[indexRecordSize:]
+	}
+	ss.indexSize = size
+	return true, nil
+}
+
+func segmentPath(dir string, segment uint32) string {
+	return filepath.Join(dir, fmt.Sprintf("%08d.segment", segment))
+}
+
+func encodeIndexRecord(buf []byte, loc entryLoc) {
+	binary.BigEndian.PutUint64(buf[0:8], uint64(loc.index))
+	binary.BigEndian.PutUint32(buf[8:12], loc.segment)
+	binary.BigEndian.PutUint64(buf[12:20], uint64(loc.offset))
+	binary.BigEndian.PutUint32(buf[20:24], uint32(loc.size))
+}
+
+func decodeIndexRecord(buf []byte) entryLoc {
+	return entryLoc{
+		index:   types.MessageIndex(binary.BigEndian.Uint64(buf[0:8])),
+		segment: binary.BigEndian.Uint32(buf[8:12]),
+		offset:  int64(binary.BigEndian.Uint64(buf[12:20])),
+		size:    int64(binary.BigEndian.Uint32(buf[20:24])),
+	}
+}
+
+// Close implements storage.Storage.
+func (s *Storage) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.streams = nil
+}
+
+// Put implements storage.Storage.
+func (s *Storage) Put(c context.Context, r storage.PutRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ss, err := s.getStreamLocked(r.Project, r.Path)
+	if err != nil {
+		return err
+	}
+	exists, err := ss.refresh()
+	if err != nil {
+		return err
+	}
+
+	for i, v := range r.Values {
+		if _, ok := ss.entries[r.Index+types.MessageIndex(i)]; ok {
+			return storage.ErrExists
+		}
+		if int64(len(v)) > int64(^uint32(0)) {
+			return fmt.Errorf("entry exceeds maximum size (%d)", len(v))
+		}
+	}
+	if len(r.Values) == 0 {
+		return nil
+	}
+
+	if !exists {
+		if err := os.MkdirAll(ss.dir, 0755); err != nil {
+			return err
+		}
+		desc := fmt.Sprintf("%s\n%s\n", r.Project, r.Path)
+		if err := ioutil.WriteFile(filepath.Join(ss.dir, streamFileName), []byte(desc), 0644); err != nil {
+			return err
+		}
+	}
+
+	// Write and sync all of the entry data first.
+	locs, err := s.writeSegmentData(ss, r)
+	if err != nil {
+		return err
+	}
+
+	// Then commit the entries by appending their index records.
+	buf := make([]byte, len(locs)*indexRecordSize)
+	for i, loc := range locs {
+		encodeIndexRecord(buf[i*indexRecordSize:], loc)
+	}
+	if err := appendIndex(filepath.Join(ss.dir, indexFileName), buf); err != nil {
+		return err
+	}
+
+	log.Fields{
+		"project": r.Project,
+		"path":    r.Path,
+		"index":   r.Index,
+		"count":   len(locs),
+	}.Debugf(c, "Added entries to filesystem storage.")
+
+	_, err = ss.refresh()
+	return err
+}
+
+// writeSegmentData appends the request's values to the stream's current data
+// segment, starting new segments as needed, and returns their locations.
+func (s *Storage) writeSegmentData(ss *streamState, r storage.PutRequest) ([]entryLoc, error) {
+	maxSize := s.MaxSegmentSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSegmentSize
+	}
+
+	locs := make([]entryLoc, 0, len(r.Values))
+	segment := ss.lastSegment
+	for len(r.Values) > 0 {
+		f, err := os.OpenFile(segmentPath(ss.dir, segment), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, err
+		}
+
+		// Start at the end of the file rather than the end of the last indexed
+		// entry, in case a previous write failed before being indexed.
+		offset, err := f.Seek(0, io.SeekEnd)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		if offset >= maxSize {
+			f.Close()
+			segment++
+			continue
+		}
+
+		for len(r.Values) > 0 && offset < maxSize {
+			v := r.Values[0]
+			if _, err := f.Write(v); err != nil {
+				f.Close()
+				return nil, err
+			}
+			locs = append(locs, entryLoc{
+				index:   r.Index,
+				segment: segment,
+				offset:  offset,
+				size:    int64(len(v)),
+			})
+			offset += int64(len(v))
+			r.Index++
+			r.Values = r.Values[1:]
+		}
+
+		if err := f.Sync(); err != nil {
+			f.Close()
+			return nil, err
+		}
+		if err := f.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return locs, nil
+}
+
+// Expunge implements storage.Storage.
+func (s *Storage) Expunge(c context.Context, r storage.ExpungeRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ss, err := s.getStreamLocked(r.Project, r.Path)
+	if err != nil {
+		return err
+	}
+	ss.reset()
+	return os.RemoveAll(ss.dir)
+}
+
+// Get implements storage.Storage.
+func (s *Storage) Get(c context.Context, r storage.GetRequest, cb storage.GetCallback) error {
+	locs, dir, err := s.getLocs(r)
+	if err != nil {
+		return err
+	}
+
+	rd := segmentReader{dir: dir}
+	defer rd.close()
+	for _, loc := range locs {
+		var d []byte
+		if !r.KeysOnly {
+			if d, err = rd.read(loc); err != nil {
+				if os.IsNotExist(err) {
+					// The stream was expunged out from under us.
+					return nil
+				}
+				return err
+			}
+		}
+
+		if !cb(storage.MakeEntry(d, loc.index)) {
+			break
+		}
+	}
+	return nil
+}
+
+// getLocs returns the locations of the entries requested by r.
+func (s *Storage) getLocs(r storage.GetRequest) ([]entryLoc, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ss, err := s.getStreamLocked(r.Project, r.Path)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := ss.refresh(); err != nil {
+		return nil, "", err
+	}
+
+	i := sort.Search(len(ss.indices), func(i int) bool { return ss.indices[i] >= r.Index })
+	indices := ss.indices[i:]
+	if r.Limit > 0 && r.Limit < len(indices) {
+		indices = indices[:r.Limit]
+	}
+
+	locs := make([]entryLoc, len(indices))
+	for i, idx := range indices {
+		locs[i] = ss.entries[idx]
+	}
+	return locs, ss.dir, nil
+}
+
+// Tail implements storage.Storage.
+//
+// As with BigTable storage, Tail returns the last entry in the contiguous
+// sequence of entries beginning at index 0.
+func (s *Storage) Tail(c context.Context, project string, path types.StreamPath) (*storage.Entry, error) {
+	loc, dir, err := s.getTailLoc(project, path)
+	if err != nil {
+		return nil, err
+	}
+
+	rd := segmentReader{dir: dir}
+	defer rd.close()
+	d, err := rd.read(loc)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, storage.ErrDoesNotExist
+		}
+		return nil, err
+	}
+	return storage.MakeEntry(d, loc.index), nil
+}
+
+func (s *Storage) getTailLoc(project string, path types.StreamPath) (entryLoc, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ss, err := s.getStreamLocked(project, path)
+	if err != nil {
+		return entryLoc{}, "", err
+	}
+	if _, err := ss.refresh(); err != nil {
+		return entryLoc{}, "", err
+	}
+
+	// indices is sorted and unique, so the contiguous run starting at 0 ends at
+	// the last position whose value equals its offset.
+	n := sort.Search(len(ss.indices), func(i int) bool { return ss.indices[i] != types.MessageIndex(i) })
+	if n == 0 {
+		return entryLoc{}, "", storage.ErrDoesNotExist
+	}
+	return ss.entries[ss.indices[n-1]], ss.dir, nil
+}
+
+func (s *Storage) getStreamLocked(project string, path types.StreamPath) (*streamState, error) {
+	if s.Dir == "" {
+		return nil, errors.New("no storage directory configured")
+	}
+
+	key := storage.HashKey(project, string(path))
+	ss := s.streams[key]
+	if ss == nil {
+		ss = &streamState{dir: filepath.Join(s.Dir, key)}
+		ss.reset()
+
+		if s.streams == nil {
+			s.streams = map[string]*streamState{}
+		}
+		s.streams[key] = ss
+	}
+	return ss, nil
+}
+
+// segmentReader reads entry data from a stream's segment files, keeping the
+// most recently used segment open.
+type segmentReader struct {
+	dir string
+
+	segment uint32
+	f       *os.File
+}
+
+func (sr *segmentReader) read(loc entryLoc) ([]byte, error) {
+	if sr.f == nil || sr.segment != loc.segment {
+		sr.close()
+
+		f, err := os.Open(segmentPath(sr.dir, loc.segment))
+		if err != nil {
+			return nil, err
+		}
+		sr.f, sr.segment = f, loc.segment
+	}
+
+	d := make([]byte, loc.size)
+	if _, err := sr.f.ReadAt(d, loc.offset); err != nil {
+		if err == io.EOF {
+			return nil, storage.ErrBadData
+		}
+		return nil, err
+	}
+	return d, nil
+}
+
+func (sr *segmentReader) close() {
+	if sr.f != nil {
+		sr.f.Close()
+		sr.f = nil
+	}
+}
+
+// appendIndex appends index records to the named index file, creating it if
+// necessary, and syncs it to disk.
+//
+// If a previous write left a torn record at the end of the file, it is
+// discarded first so that the new records are correctly aligned.
+func appendIndex(path string, d []byte) error {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	size := fi.Size()
+	if torn := size % indexRecordSize; torn != 0 {
+		size -= torn
+		if err := f.Truncate(size); err != nil {
+			return err
+		}
+	}
+
+	if _, err := f.WriteAt(d, size); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,151 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/storage/storagetest"
+	"go.chromium.org/luci/logdog/common/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestStorageImplementation(t *testing.T) {
+	t.Parallel()
+
+	root, err := ioutil.TempDir("", "logdog_filesystem_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	storagetest.RunStorageImplementationTests(t, context.Background(), storagetest.TestOptions{
+		Factory: func() storage.Storage {
+			dir, err := ioutil.TempDir(root, "")
+			if err != nil {
+				panic(err)
+			}
+			return &Storage{Dir: dir}
+		},
+	})
+}
+
+func TestStorage(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A filesystem Storage instance`, t, func() {
+		c := context.Background()
+
+		dir, err := ioutil.TempDir("", "logdog_filesystem_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		s := &Storage{Dir: dir}
+		defer s.Close()
+
+		const project = "test-project"
+		const path = types.StreamPath("testing/+/foo")
+
+		put := func(s *Storage, index int, d ...string) error {
+			data := make([][]byte, len(d))
+			for i, v := range d {
+				data[i] = []byte(v)
+			}
+			return s.Put(c, storage.PutRequest{
+				Project: project,
+				Path:    path,
+				Index:   types.MessageIndex(index),
+				Values:  data,
+			})
+		}
+
+		getAll := func(s *Storage) []string {
+			var got []string
+			err := s.Get(c, storage.GetRequest{Project: project, Path: path}, func(e *storage.Entry) bool {
+				got = append(got, string(e.D))
+				return true
+			})
+			So(err, ShouldBeNil)
+			return got
+		}
+
+		streamDir := filepath.Join(dir, storage.HashKey(project, string(path)))
+
+		Convey(`Will start new segments once they reach MaxSegmentSize.`, func() {
+			s.MaxSegmentSize = 4
+			So(put(s, 0, "aa", "bb", "cc"), ShouldBeNil)
+			So(put(s, 3, "dddd", "e"), ShouldBeNil)
+
+			So(getAll(s), ShouldResemble, []string{"aa", "bb", "cc", "dddd", "e"})
+			for _, seg := range []uint32{0, 1, 2} {
+				_, err := os.Stat(segmentPath(streamDir, seg))
+				So(err, ShouldBeNil)
+			}
+		})
+
+		Convey(`Is durable across instances.`, func() {
+			So(put(s, 0, "0", "1"), ShouldBeNil)
+
+			other := &Storage{Dir: dir}
+			defer other.Close()
+			So(getAll(other), ShouldResemble, []string{"0", "1"})
+
+			Convey(`And observes writes made by other instances.`, func() {
+				So(put(s, 2, "2"), ShouldBeNil)
+				So(getAll(other), ShouldResemble, []string{"0", "1", "2"})
+
+				e, err := other.Tail(c, project, path)
+				So(err, ShouldBeNil)
+				So(string(e.D), ShouldEqual, "2")
+			})
+
+			Convey(`And observes expunges made by other instances.`, func() {
+				So(other.Expunge(c, storage.ExpungeRequest{Project: project, Path: path}), ShouldBeNil)
+				So(getAll(s), ShouldBeEmpty)
+			})
+		})
+
+		Convey(`Will ignore and repair a torn index record.`, func() {
+			So(put(s, 0, "0"), ShouldBeNil)
+
+			// Simulate a crash part-way through writing an index record.
+			f, err := os.OpenFile(filepath.Join(streamDir, indexFileName), os.O_WRONLY|os.O_APPEND, 0)
+			So(err, ShouldBeNil)
+			_, err = f.Write([]byte{0x00, 0x01, 0x02})
+			So(err, ShouldBeNil)
+			So(f.Close(), ShouldBeNil)
+
+			other := &Storage{Dir: dir}
+			defer other.Close()
+			So(getAll(other), ShouldResemble, []string{"0"})
+
+			So(put(other, 1, "1"), ShouldBeNil)
+			So(getAll(other), ShouldResemble, []string{"0", "1"})
+			So(getAll(&Storage{Dir: dir}), ShouldResemble, []string{"0", "1"})
+		})
+
+		Convey(`Will fail if no directory is configured.`, func() {
+			s.Dir = ""
+			So(put(s, 0, "0"), ShouldErrLike, "no storage directory configured")
+		})
+	})
+}
@@ -0,0 +1,255 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storagetest is imported exclusively by tests for Storage
+// implementations.
+package storagetest
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// TestOptions contains options for RunStorageImplementationTests.
+type TestOptions struct {
+	// Factory creates and returns a new, empty Storage implementation.
+	Factory func() storage.Storage
+}
+
+func mustGetIndex(e *storage.Entry) types.MessageIndex {
+	idx, err := e.GetStreamIndex()
+	if err != nil {
+		panic(err)
+	}
+	return idx
+}
+
+// RunStorageImplementationTests runs all the standard tests that all
+// intermediate storage implementations are expected to pass. When you write a
+// new Storage implementation you should ensure you run these tests against it.
+func RunStorageImplementationTests(t *testing.T, c context.Context, opts TestOptions) {
+	Convey(`A Storage instance`, t, func() {
+		s := opts.Factory()
+		defer s.Close()
+
+		project := "test-project"
+		get := func(path string, index int, limit int, keysOnly bool) ([]string, error) {
+			req := storage.GetRequest{
+				Project:  project,
+				Path:     types.StreamPath(path),
+				Index:    types.MessageIndex(index),
+				Limit:    limit,
+				KeysOnly: keysOnly,
+			}
+			var got []string
+			err := s.Get(c, req, func(e *storage.Entry) bool {
+				if keysOnly {
+					got = append(got, strconv.Itoa(int(mustGetIndex(e))))
+				} else {
+					got = append(got, string(e.D))
+				}
+				return true
+			})
+			return got, err
+		}
+
+		put := func(path string, index int, d ...string) error {
+			data := make([][]byte, len(d))
+			for i, v := range d {
+				data[i] = []byte(v)
+			}
+
+			return s.Put(c, storage.PutRequest{
+				Project: project,
+				Path:    types.StreamPath(path),
+				Index:   types.MessageIndex(index),
+				Values:  data,
+			})
+		}
+
+		tail := func(path string) (string, error) {
+			e, err := s.Tail(c, project, types.StreamPath(path))
+			if err != nil {
+				return "", err
+			}
+			return string(e.D), nil
+		}
+
+		Convey(`With row data: A{0, 1, 2, 3, 4}, B{10, 12, 13}, C{0, 1, 2, 4}`, func() {
+			So(put("A", 0, "0", "1", "2"), ShouldBeNil)
+			So(put("A", 3, "3", "4"), ShouldBeNil)
+			So(put("B", 10, "10"), ShouldBeNil)
+			So(put("B", 12, "12", "13"), ShouldBeNil)
+			So(put("C", 0, "0", "1", "2"), ShouldBeNil)
+			So(put("C", 4, "4"), ShouldBeNil)
+
+			Convey(`Testing "Put"...`, func() {
+				Convey(`Will return ErrExists when putting existing entries.`, func() {
+					So(put("A", 0, "0", "1", "2"), ShouldEqual, storage.ErrExists)
+				})
+			})
+
+			Convey(`Testing "Get"...`, func() {
+				Convey(`Can fetch the full row, "A".`, func() {
+					got, err := get("A", 0, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"0", "1", "2", "3", "4"})
+				})
+
+				Convey(`Will fetch A{1, 2, 3, 4} with index=1.`, func() {
+					got, err := get("A", 1, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"1", "2", "3", "4"})
+				})
+
+				Convey(`Will fetch A{1, 2} with index=1 and limit=2.`, func() {
+					got, err := get("A", 1, 2, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"1", "2"})
+				})
+
+				Convey(`Will fetch B{10, 12, 13} for B.`, func() {
+					got, err := get("B", 0, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"10", "12", "13"})
+				})
+
+				Convey(`Will fetch B{12, 13} when index=11.`, func() {
+					got, err := get("B", 11, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"12", "13"})
+				})
+
+				Convey(`Will fetch {} for INVALID.`, func() {
+					got, err := get("INVALID", 0, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldBeEmpty)
+				})
+			})
+
+			Convey(`Testing "Get" (keys only)`, func() {
+				Convey(`Can fetch the full row, "A".`, func() {
+					got, err := get("A", 0, 0, true)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"0", "1", "2", "3", "4"})
+				})
+
+				Convey(`Will fetch A{1, 2, 3, 4} with index=1.`, func() {
+					got, err := get("A", 1, 0, true)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"1", "2", "3", "4"})
+				})
+
+				Convey(`Will fetch A{1, 2} with index=1 and limit=2.`, func() {
+					got, err := get("A", 1, 2, true)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"1", "2"})
+				})
+
+				Convey(`Will fetch B{10, 12, 13} for B.`, func() {
+					got, err := get("B", 0, 0, true)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"10", "12", "13"})
+				})
+
+				Convey(`Will fetch B{12, 13} when index=11.`, func() {
+					got, err := get("B", 11, 0, true)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"12", "13"})
+				})
+			})
+
+			Convey(`Testing "Tail"...`, func() {
+				Convey(`A tail request for "A" returns A{4}.`, func() {
+					got, err := tail("A")
+					So(err, ShouldBeNil)
+					So(got, ShouldEqual, "4")
+
+					Convey(`(Cache) A second request also returns A{4}.`, func() {
+						got, err := tail("A")
+						So(err, ShouldBeNil)
+						So(got, ShouldEqual, "4")
+					})
+				})
+
+				Convey(`A tail request for "B" returns nothing (no contiguous logs).`, func() {
+					_, err := tail("B")
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+
+				Convey(`A tail request for "C" returns 2.`, func() {
+					got, err := tail("C")
+					So(err, ShouldBeNil)
+					So(got, ShouldEqual, "2")
+
+					Convey(`(Cache) A second request also returns 2.`, func() {
+						got, err := tail("C")
+						So(err, ShouldBeNil)
+						So(got, ShouldEqual, "2")
+					})
+
+					Convey(`(Cache) After "3" is added, a second request returns 4.`, func() {
+						So(put("C", 3, "3"), ShouldBeNil)
+
+						got, err := tail("C")
+						So(err, ShouldBeNil)
+						So(got, ShouldEqual, "4")
+					})
+				})
+
+				Convey(`A tail request for "INVALID" errors NOT FOUND.`, func() {
+					_, err := tail("INVALID")
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+
+			Convey(`Testing "Expunge"...`, func() {
+				So(s.Expunge(c, storage.ExpungeRequest{Project: project, Path: "A"}), ShouldBeNil)
+
+				Convey(`Removes all entries for "A".`, func() {
+					got, err := get("A", 0, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldBeEmpty)
+
+					_, err = tail("A")
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+
+				Convey(`Does not affect "C".`, func() {
+					got, err := get("C", 0, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"0", "1", "2", "4"})
+				})
+
+				Convey(`Allows "A" to be written again.`, func() {
+					So(put("A", 0, "new"), ShouldBeNil)
+
+					got, err := get("A", 0, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"new"})
+				})
+
+				Convey(`Is a no-op for "INVALID".`, func() {
+					So(s.Expunge(c, storage.ExpungeRequest{Project: project, Path: "INVALID"}), ShouldBeNil)
+				})
+			})
+		})
+	})
+}
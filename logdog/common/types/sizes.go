@@ -25,4 +25,10 @@ const (
 
 	// MaxDatagramSize is the maximum size, in bytes, of datagram data.
 	MaxDatagramSize int = MaxLogEntryDataSize
+
+	// MaxStructuredRecordSize is the maximum size, in bytes, of a single
+	// serialized structured record. Unlike datagrams, structured records are
+	// never split across log entries, so this is kept well below the size of a
+	// bundle.
+	MaxStructuredRecordSize int = 64 * 1024
 )
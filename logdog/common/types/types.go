@@ -29,6 +29,9 @@ const (
 	// ContentTypeLogdogDatagram is a content type for size-prefixed datagram
 	// frame stream.
 	ContentTypeLogdogDatagram = "application/x-logdog-datagram"
+	// ContentTypeLogdogStructured is a content type for size-prefixed
+	// structured record frame stream.
+	ContentTypeLogdogStructured = "application/x-logdog-structured"
 	// ContentTypeLogdogLog is a LogDog log stream.
 	ContentTypeLogdogLog = "application/x-logdog-logs"
 	// ContentTypeLogdogIndex is a LogDog log index.
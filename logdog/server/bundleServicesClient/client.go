@@ -110,6 +110,20 @@ func (c *Client) TerminateStream(ctx context.Context, in *s.TerminateStreamReque
 	return &empty.Empty{}, nil
 }
 
+// UpdateStreamUsage implements ServicesClient.
+func (c *Client) UpdateStreamUsage(ctx context.Context, in *s.UpdateStreamUsageRequest, opts ...grpc.CallOption) (
+	*s.UpdateStreamUsageResponse, error) {
+
+	resp, err := c.bundleRPC(ctx, opts, &s.BatchRequest_Entry{
+		Value: &s.BatchRequest_Entry_UpdateStreamUsage{UpdateStreamUsage: in},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetUpdateStreamUsage(), nil
+}
+
 // ArchiveStream implements ServicesClient.
 func (c *Client) ArchiveStream(ctx context.Context, in *s.ArchiveStreamRequest, opts ...grpc.CallOption) (
 	*empty.Empty, error) {
@@ -36,6 +36,7 @@ import (
 	"go.chromium.org/luci/logdog/server/bundleServicesClient"
 	"go.chromium.org/luci/logdog/server/collector"
 	"go.chromium.org/luci/logdog/server/collector/coordinator"
+	"go.chromium.org/luci/logdog/server/config"
 	"go.chromium.org/luci/logdog/server/service"
 
 	"cloud.google.com/go/pubsub"
@@ -146,12 +147,26 @@ func (a *application) runCollector(c context.Context) error {
 		Coordinator:       coord,
 		Storage:           st,
 		MaxMessageWorkers: int(ccfg.MaxMessageWorkers),
+		QuotaLoader:       config.CollectorQuota,
 	}
 	defer coll.Close()
 
+	// Report the usage of stored log data to the Coordinator once per second,
+	// and once more on shutdown, before the Coordinator client is flushed.
+	defer coll.FlushUsage(c)
+
 	c, cancelFunc := context.WithCancel(c)
 	defer cancelFunc()
 
+	go func() {
+		for {
+			if tr := <-clock.After(c, time.Second); tr.Incomplete() {
+				return
+			}
+			coll.FlushUsage(c)
+		}
+	}()
+
 	// Application shutdown will now operate by cancelling the Collector's
 	// shutdown Context.
 	a.SetShutdownFunc(cancelFunc)
@@ -28,6 +28,7 @@ import (
 	"go.chromium.org/luci/common/tsmon/metric"
 	tsmon_types "go.chromium.org/luci/common/tsmon/types"
 	"go.chromium.org/luci/config"
+	"go.chromium.org/luci/logdog/api/config/svcconfig"
 	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/client/pubsubprotocol"
 	"go.chromium.org/luci/logdog/common/storage"
@@ -105,6 +106,15 @@ type Collector struct {
 	// MaxMessageWorkers is the maximum number of concurrent workers to employ
 	// for any given message. If <= 0, DefaultMaxMessageWorkers will be applied.
 	MaxMessageWorkers int
+
+	// QuotaLoader, if not nil, loads the ingestion quotas to enforce for a
+	// project. If nil, no quotas will be enforced.
+	QuotaLoader QuotaLoader
+
+	// quotaTracker holds the latest project usage returned by the Coordinator.
+	quotaTracker quotaTracker
+	// usage holds the usage that has not been reported to the Coordinator yet.
+	usage usageAggregator
 }
 
 // Process ingests an encoded ButlerLogBundle message, registering it with
@@ -188,6 +198,15 @@ func (c *Collector) Process(ctx context.Context, msg []byte) error {
 		return nil
 	}
 
+	// Enforce the project's ingestion rate quota.
+	var err error
+	if lw.quota, err = c.loadQuota(ctx, lw.project); err != nil {
+		return err
+	}
+	if err := c.applyRateQuota(ctx, &lw); err != nil {
+		return err
+	}
+
 	// Handle each bundle entry in parallel. We will use a separate work pool
 	// here so that top-level bundle dispatch can't deadlock the processing tasks.
 	workers := c.MaxMessageWorkers
@@ -225,6 +244,8 @@ type bundleHandler struct {
 
 	// project is the validated project name.
 	project string
+	// quota is the project's ingestion quota. If nil, no quota is enforced.
+	quota *svcconfig.CollectorQuota
 }
 
 type bundleEntryHandler struct {
@@ -380,6 +401,12 @@ func (c *Collector) processLogStream(ctx context.Context, h *bundleEntryHandler)
 		return nil
 	}
 
+	// Enforce the per-stream quotas. This may truncate the log data and mark
+	// the bundle entry terminal.
+	if logData, err = c.applyStreamQuota(ctx, h, state, logData); err != nil {
+		return err
+	}
+
 	// Update our terminal index if we have one.
 	//
 	// Note that even if our cached value is marked terminal, we could have failed
@@ -417,7 +444,12 @@ func (c *Collector) processLogStream(ctx context.Context, h *bundleEntryHandler)
 
 				// If the log entry already exists, consider the "put" successful.
 				// Storage will return a transient error if one occurred.
-				if err != nil && err != storage.ErrExists {
+				switch {
+				case err == nil:
+					// Only count usage for data that this put actually stored, so a
+					// redelivered bundle isn't counted twice.
+					c.updateUsage(ctx, h, state, logData)
+				case err != storage.ErrExists:
 					log.Fields{
 						log.ErrorKey: err,
 						"blockIndex": blockIndex,
@@ -441,6 +473,7 @@ func (c *Collector) processLogStream(ctx context.Context, h *bundleEntryHandler)
 					ID:            state.ID,
 					Secret:        state.Secret,
 					TerminalIndex: types.MessageIndex(h.be.TerminalIndex),
+					Tags:          h.be.Desc.Tags,
 				}
 
 				log.Fields{
@@ -18,14 +18,21 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
 	"sync/atomic"
 	"testing"
 
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/clock/testclock"
 	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/proto/google"
 	"go.chromium.org/luci/common/retry/transient"
 	"go.chromium.org/luci/config"
 	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/client/butler"
+	"go.chromium.org/luci/logdog/client/butler/filter"
 	"go.chromium.org/luci/logdog/client/pubsubprotocol"
 	"go.chromium.org/luci/logdog/common/storage/memory"
 	"go.chromium.org/luci/logdog/common/types"
@@ -71,6 +78,37 @@ func testCollectorImpl(t *testing.T, caching bool) {
 			So(st, shouldHaveStoredStream, "test-project", "foo/+/baz", indexRange{0, 255})
 		})
 
+		Convey(`Stores the redaction count a Butler reports when a stream closes.`, func() {
+			out := &butlerOutput{}
+			b, err := butler.New(c, butler.Config{
+				Output: out,
+				Filters: []filter.Filter{
+					&filter.Redactor{Rules: []*regexp.Regexp{regexp.MustCompile(`hunter\d`)}},
+				},
+			})
+			So(err, ShouldBeNil)
+
+			desc := &logpb.LogStreamDescriptor{
+				Name:        "bar",
+				ContentType: "text/plain",
+				StreamType:  logpb.StreamType_TEXT,
+				Timestamp:   google.NewTimestamp(clock.Now(c)),
+			}
+			data := ioutil.NopCloser(strings.NewReader("password=hunter2\nhunter3 and hunter4\n"))
+			So(b.AddStream(data, desc), ShouldBeNil)
+			b.Activate()
+			So(b.Wait(), ShouldBeNil)
+
+			for _, bundle := range out.bundles {
+				So(coll.Process(c, bundle), ShouldBeNil)
+			}
+
+			So(tcc, shouldHaveRegisteredStream, "test-project", "foo/+/bar", 1)
+			So(tcc.streamTags("test-project", "foo/+/bar"), ShouldResemble, map[string]string{
+				filter.RedactionsTag: "3",
+			})
+		})
+
 		Convey(`Will return a transient error if a transient error happened while registering.`, func() {
 			tcc.registerCallback = func(cc.LogStreamState) error { return errors.New("test error", transient.Tag) }
 
@@ -16,6 +16,10 @@ package coordinator
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -129,6 +133,23 @@ func (c *cache) TerminateStream(ctx context.Context, r *TerminateRequest) error
 	return entry.terminateStream(ctx, c.Coordinator, *r)
 }
 
+// UpdateStreamUsage invokes the wrapped Coordinator's UpdateStreamUsage method
+// and retains the returned stream usage, so that cached stream state reflects
+// it.
+func (c *cache) UpdateStreamUsage(ctx context.Context, r *UsageRequest) (*Usage, error) {
+	u, err := c.Coordinator.UpdateStreamUsage(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, _ := c.getCacheEntry(ctx, cacheEntryKey{
+		project: r.Project,
+		path:    r.Path,
+	})
+	entry.loadRemoteIngestedBytes(u.StreamBytes)
+	return u, nil
+}
+
 // cacheEntryKey is the LRU key for a cacheEntry.
 type cacheEntryKey struct {
 	project string
@@ -158,6 +179,10 @@ type cacheEntry struct {
 	// If a TerminateStream RPC succeeds, we will use this value in our returned
 	// RegisterStream state.
 	terminalIndex types.MessageIndex
+	// ingestedBytes is the latest stream usage returned by an UpdateStreamUsage
+	// RPC. We will use this value in our returned RegisterStream state if it is
+	// larger.
+	ingestedBytes int64
 
 	// registerP is a Promise that is blocking pending stream registration.
 	// Upon successful resolution, it will contain a *LogStreamState.
@@ -165,6 +190,31 @@ type cacheEntry struct {
 	// terminateP is a Promise that is blocking pending stream termination.
 	// Upon successful resolution, it will contain a nil result with no error.
 	terminateP *promise.Promise
+	// terminateKey identifies the request that terminateP was created for.
+	terminateKey terminateKey
+}
+
+// terminateKey identifies the content of a TerminateStream request, so that
+// identical requests are sent once, but requests that report other tags are
+// sent again.
+type terminateKey struct {
+	terminalIndex types.MessageIndex
+	// tagsHash is a hash of the sorted tags.
+	tagsHash string
+}
+
+func makeTerminateKey(tr *TerminateRequest) terminateKey {
+	keys := make([]string, 0, len(tr.Tags))
+	for k := range tr.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s\x00%s\x00", k, tr.Tags[k])
+	}
+	return terminateKey{tr.TerminalIndex, hex.EncodeToString(h.Sum(nil))}
 }
 
 // registerStream performs a RegisterStream Coordinator RPC.
@@ -174,8 +224,9 @@ func (ce *cacheEntry) registerStream(ctx context.Context, coord Coordinator, st
 	// While locked, load the current registration promise and the local
 	// terminal index value.
 	var (
-		p    *promise.Promise
-		tidx types.MessageIndex
+		p        *promise.Promise
+		tidx     types.MessageIndex
+		ingested int64
 	)
 	ce.withLock(func() {
 		if ce.registerP == nil {
@@ -191,7 +242,7 @@ func (ce *cacheEntry) registerStream(ctx context.Context, coord Coordinator, st
 			})
 		}
 
-		p, tidx = ce.registerP, ce.terminalIndex
+		p, tidx, ingested = ce.registerP, ce.terminalIndex, ce.ingestedBytes
 	})
 
 	// Resolve our registration Promise.
@@ -213,10 +264,16 @@ func (ce *cacheEntry) registerStream(ctx context.Context, coord Coordinator, st
 
 	// If our remote state doesn't include a terminal index and our local state
 	// has recorded a successful remote terminal index, return a copy of the
-	// remote state with the remote terminal index added.
-	if remoteState.TerminalIndex < 0 && tidx >= 0 {
+	// remote state with the remote terminal index added. Likewise for usage
+	// that has been recorded since the remote state was fetched.
+	if (remoteState.TerminalIndex < 0 && tidx >= 0) || remoteState.IngestedBytes < ingested {
 		remoteStateCopy := *remoteState
-		remoteStateCopy.TerminalIndex = tidx
+		if remoteStateCopy.TerminalIndex < 0 {
+			remoteStateCopy.TerminalIndex = tidx
+		}
+		if remoteStateCopy.IngestedBytes < ingested {
+			remoteStateCopy.IngestedBytes = ingested
+		}
 		remoteState = &remoteStateCopy
 	}
 	return remoteState, nil
@@ -224,14 +281,21 @@ func (ce *cacheEntry) registerStream(ctx context.Context, coord Coordinator, st
 
 // terminateStream performs a TerminateStream Coordinator RPC.
 func (ce *cacheEntry) terminateStream(ctx context.Context, coord Coordinator, tr TerminateRequest) error {
-	// Initialize the termination Promise if one is not defined. Also, grab our
-	// cached remote terminal index.
+	// Initialize the termination Promise if one is not defined for this request.
+	// Also, grab our cached remote terminal index.
+	//
+	// If the stream is known to be terminated on the Coordinator side, we don't
+	// need to issue another request unless it has tags to report.
 	var (
 		p    *promise.Promise
 		tidx types.MessageIndex = -1
 	)
+	key := makeTerminateKey(&tr)
 	ce.withLock(func() {
-		if ce.terminateP == nil {
+		if tidx = ce.terminalIndex; tidx >= 0 && len(tr.Tags) == 0 {
+			return
+		}
+		if ce.terminateP == nil || ce.terminateKey != key {
 			// We're creating a new promise, so our tr's TerminalIndex will be set.
 			ce.terminateP = promise.NewDeferred(func(ctx context.Context) (interface{}, error) {
 				// Execute our TerminateStream RPC. If successful, retain the successful
@@ -244,14 +308,12 @@ func (ce *cacheEntry) terminateStream(ctx context.Context, coord Coordinator, tr
 				}
 				return nil, err
 			})
+			ce.terminateKey = key
 		}
-
-		p, tidx = ce.terminateP, ce.terminalIndex
+		p = ce.terminateP
 	})
 
-	// If the stream is known to be terminated on the Coordinator side, we don't
-	// need to issue another request.
-	if tidx >= 0 {
+	if p == nil {
 		if tr.TerminalIndex != tidx {
 			// Not much we can do here, and this probably will never happen, but let's
 			// log it if it does.
@@ -298,6 +360,16 @@ func (ce *cacheEntry) loadRemoteTerminalIndex(tidx types.MessageIndex) {
 	})
 }
 
+// loadRemoteIngestedBytes updates our cached remote stream usage with n, if it
+// is larger.
+func (ce *cacheEntry) loadRemoteIngestedBytes(n int64) {
+	ce.withLock(func() {
+		if ce.ingestedBytes < n {
+			ce.ingestedBytes = n
+		}
+	})
+}
+
 func (ce *cacheEntry) withLock(f func()) {
 	ce.Lock()
 	defer ce.Unlock()
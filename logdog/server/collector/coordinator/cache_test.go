@@ -33,6 +33,8 @@ import (
 // testCoordinator is an implementation of Coordinator that can be used for
 // testing.
 type testCoordinator struct {
+	// bytes is the total stream usage reported to UpdateStreamUsage.
+	bytes int64
 	// calls is the number of calls made to the interface's methods.
 	calls int32
 	// callC, if not nil, will have a token pushed to it when a call is made.
@@ -60,6 +62,14 @@ func (c *testCoordinator) TerminateStream(ctx context.Context, tr *TerminateRequ
 	return nil
 }
 
+func (c *testCoordinator) UpdateStreamUsage(ctx context.Context, r *UsageRequest) (*Usage, error) {
+	if err := c.incCalls(); err != nil {
+		return nil, err
+	}
+	n := atomic.AddInt64(&c.bytes, r.Bytes)
+	return &Usage{StreamBytes: n, ProjectBytes: n}, nil
+}
+
 // incCalls is an entry point for client goroutines. It offers the opportunity
 // to track call count as well as trap executing goroutines within client calls.
 //
@@ -150,6 +160,26 @@ func TestStreamStateCache(t *testing.T) {
 					})
 				})
 
+				Convey(`Can update a registered stream's usage`, func() {
+					u, err := ssc.UpdateStreamUsage(c, &UsageRequest{
+						Project: st.Project,
+						Path:    st.Path,
+						ID:      st.ID,
+						Bytes:   1024,
+					})
+					So(err, ShouldBeNil)
+					So(u.StreamBytes, ShouldEqual, 1024)
+					So(tcc.calls, ShouldEqual, 2) // +1 call
+
+					Convey(`Registering the stream will include the usage.`, func() {
+						s, err := ssc.RegisterStream(c, &st, nil)
+						So(err, ShouldBeNil)
+						So(s.ProtoVersion, ShouldEqual, "remote")
+						So(s.IngestedBytes, ShouldEqual, 1024)
+						So(tcc.calls, ShouldEqual, 2) // No additional calls.
+					})
+				})
+
 				Convey(`Can terminate a registered stream`, func() {
 					So(ssc.TerminateStream(c, &tr), ShouldBeNil)
 					So(tcc.calls, ShouldEqual, 2) // +1 call
@@ -165,6 +195,32 @@ func TestStreamStateCache(t *testing.T) {
 						So(s.TerminalIndex, ShouldEqual, 1337)
 						So(tcc.calls, ShouldEqual, 2) // No additional calls.
 					})
+
+					Convey(`Will not re-terminate the stream.`, func() {
+						So(ssc.TerminateStream(c, &tr), ShouldBeNil)
+						So(tcc.calls, ShouldEqual, 2) // No additional calls.
+					})
+				})
+
+				Convey(`Can terminate a registered stream with tags`, func() {
+					tr.Tags = map[string]string{"foo": "bar"}
+					So(ssc.TerminateStream(c, &tr), ShouldBeNil)
+					So(tcc.calls, ShouldEqual, 2) // +1 call
+
+					Convey(`Will not re-terminate the stream with the same tags.`, func() {
+						tr.Tags = map[string]string{"foo": "bar"}
+						So(ssc.TerminateStream(c, &tr), ShouldBeNil)
+						So(tcc.calls, ShouldEqual, 2) // No additional calls.
+					})
+
+					Convey(`Will re-terminate the stream with different tags.`, func() {
+						tr.Tags = map[string]string{"foo": "baz"}
+						So(ssc.TerminateStream(c, &tr), ShouldBeNil)
+						So(tcc.calls, ShouldEqual, 3) // +1 call
+
+						So(ssc.TerminateStream(c, &tr), ShouldBeNil)
+						So(tcc.calls, ShouldEqual, 3) // No additional calls.
+					})
 				})
 			})
 
@@ -182,6 +238,16 @@ func TestStreamStateCache(t *testing.T) {
 					So(ssc.TerminateStream(c, &tr), ShouldBeNil)
 					So(tcc.calls, ShouldEqual, 1) // (No additional calls)
 
+					Convey(`A subsequent call to TerminateStream with tags will be sent once.`, func() {
+						tr.Tags = map[string]string{"foo": "bar"}
+
+						So(ssc.TerminateStream(c, &tr), ShouldBeNil)
+						So(tcc.calls, ShouldEqual, 2) // +1 call
+
+						So(ssc.TerminateStream(c, &tr), ShouldBeNil)
+						So(tcc.calls, ShouldEqual, 2) // (No additional calls)
+					})
+
 					Convey(`A register stream call will return the confirmed terminal index.`, func() {
 						st.TerminalIndex = 0
 
@@ -34,6 +34,9 @@ type Coordinator interface {
 	RegisterStream(c context.Context, s *LogStreamState, desc []byte) (*LogStreamState, error)
 	// TerminateStream registers the terminal index of a log stream state.
 	TerminateStream(c context.Context, s *TerminateRequest) error
+	// UpdateStreamUsage adds log entry data that was stored for a log stream to
+	// its usage, returning the updated usage.
+	UpdateStreamUsage(c context.Context, r *UsageRequest) (*Usage, error)
 }
 
 // LogStreamState is a local representation of a remote stream's state. It is a
@@ -62,6 +65,15 @@ type LogStreamState struct {
 	// Purged is true if the log stream has been archived. This is returned by
 	// the remote service.
 	Purged bool
+
+	// IngestedBytes is the number of bytes of log entry data that have been
+	// stored for the log stream. This is returned by the remote service.
+	IngestedBytes int64
+	// PrefixStreams is the number of log streams that were registered under the
+	// log stream's prefix when it was registered, including itself. It is zero
+	// if the project has no streams-per-prefix quota. This is returned by the
+	// remote service.
+	PrefixStreams int64
 }
 
 // TerminateRequest is a local representation of a Coordinator stream
@@ -79,6 +91,36 @@ type TerminateRequest struct {
 	TerminalIndex types.MessageIndex
 	// Secret is the log stream's prefix secret.
 	Secret types.PrefixSecret
+	// Tags are the tags of the stream's descriptor in the terminal bundle entry.
+	//
+	// Tags added after the stream was registered (e.g. by Butler filters) are
+	// stored by the Coordinator.
+	Tags map[string]string
+}
+
+// UsageRequest is a local representation of a Coordinator stream usage update
+// request.
+type UsageRequest struct {
+	Project string           // Project name.
+	Path    types.StreamPath // Stream path. Needed for cache lookup.
+
+	// ID is the stream's Coordinator ID, as indicated by the Coordinator.
+	ID string
+	// Secret is the log stream's prefix secret.
+	Secret types.PrefixSecret
+	// Bytes is the number of bytes of log entry data that were stored.
+	Bytes int64
+}
+
+// Usage is the ingestion usage of a log stream and its project, as counted by
+// the Coordinator across all Collectors.
+type Usage struct {
+	// StreamBytes is the number of bytes of log entry data stored for the log
+	// stream.
+	StreamBytes int64
+	// ProjectBytes is the number of bytes of log entry data stored for the
+	// project during the current second.
+	ProjectBytes int64
 }
 
 type coordinatorImpl struct {
@@ -128,6 +170,8 @@ func (c *coordinatorImpl) RegisterStream(ctx context.Context, s *LogStreamState,
 		TerminalIndex: types.MessageIndex(resp.State.TerminalIndex),
 		Archived:      resp.State.Archived,
 		Purged:        resp.State.Purged,
+		IngestedBytes: resp.State.IngestedBytes,
+		PrefixStreams: resp.State.PrefixStreams,
 	}, nil
 }
 
@@ -148,6 +192,7 @@ func (c *coordinatorImpl) TerminateStream(ctx context.Context, r *TerminateReque
 		Id:            r.ID,
 		Secret:        []byte(r.Secret),
 		TerminalIndex: int64(r.TerminalIndex),
+		Tags:          r.Tags,
 	}
 
 	if _, err := c.c.TerminateStream(ctx, &req); err != nil {
@@ -155,3 +200,29 @@ func (c *coordinatorImpl) TerminateStream(ctx context.Context, r *TerminateReque
 	}
 	return nil
 }
+
+func (c *coordinatorImpl) UpdateStreamUsage(ctx context.Context, r *UsageRequest) (*Usage, error) {
+	// Client-side validate our parameters.
+	if err := config.ValidateProjectName(r.Project); err != nil {
+		return nil, fmt.Errorf("failed to validate project: %s", err)
+	}
+	if r.ID == "" {
+		return nil, errors.New("missing stream ID")
+	}
+
+	req := logdog.UpdateStreamUsageRequest{
+		Project: string(r.Project),
+		Id:      r.ID,
+		Secret:  []byte(r.Secret),
+		Bytes:   r.Bytes,
+	}
+
+	resp, err := c.c.UpdateStreamUsage(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	return &Usage{
+		StreamBytes:  resp.StreamBytes,
+		ProjectBytes: resp.ProjectBytes,
+	}, nil
+}
@@ -0,0 +1,434 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+	tsmon_types "go.chromium.org/luci/common/tsmon/types"
+	"go.chromium.org/luci/logdog/api/config/svcconfig"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/common/types"
+	"go.chromium.org/luci/logdog/server/collector/coordinator"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// QuotaMarker is the text that begins the content of the log entry written to
+// a log stream that was terminated because its project exceeded a quota.
+const QuotaMarker = "[LogDog quota exceeded]"
+
+const (
+	quotaBytesPerSecond   = "bytes_per_second"
+	quotaStreamsPerPrefix = "streams_per_prefix"
+	quotaMaxStreamSize    = "max_stream_size"
+)
+
+var (
+	// tsQuotaBytes tracks the number of bytes of log entry data that have been
+	// stored for each project.
+	tsQuotaBytes = metric.NewCounter("logdog/collector/quota/bytes",
+		"The number of bytes of log entry data ingested for a project.",
+		&tsmon_types.MetricMetadata{Units: tsmon_types.Bytes},
+		field.String("project"))
+	// tsQuotaLimit tracks the configured value of each of a project's quotas.
+	tsQuotaLimit = metric.NewInt("logdog/collector/quota/limit",
+		"The configured value of a project's ingestion quota (0 if unlimited).",
+		nil,
+		field.String("project"),
+		field.String("quota"))
+	// tsQuotaExceeded tracks the number of times that a project has exceeded
+	// each of its quotas.
+	tsQuotaExceeded = metric.NewCounter("logdog/collector/quota/exceeded",
+		"The number of times that a project has exceeded an ingestion quota.",
+		nil,
+		field.String("project"),
+		field.String("quota"))
+)
+
+// QuotaLoader loads the ingestion quotas for a project.
+//
+// A nil quota, or a zero-valued limit within it, means that the respective
+// limit is not enforced.
+type QuotaLoader func(ctx context.Context, project string) (*svcconfig.CollectorQuota, error)
+
+// quotaTracker holds the latest project usage returned by the Coordinator.
+//
+// The Coordinator counts each project's usage across all Collectors in one
+// second windows. This only remembers the count for the current window, so
+// that bundles can be rejected without asking the Coordinator first.
+type quotaTracker struct {
+	sync.Mutex
+
+	// projects maps project names to their usage in the latest window.
+	projects map[string]projectUsage
+}
+
+type projectUsage struct {
+	// window is the Unix time, in seconds, of the window that bytes were
+	// counted in.
+	window int64
+	// bytes is the number of bytes of log entry data stored during the window.
+	bytes int64
+}
+
+// projectBytes returns the last known number of bytes stored for a project
+// during the current second.
+func (t *quotaTracker) projectBytes(ctx context.Context, project string) int64 {
+	t.Lock()
+	defer t.Unlock()
+
+	if u, ok := t.projects[project]; ok && u.window == clock.Now(ctx).Unix() {
+		return u.bytes
+	}
+	return 0
+}
+
+// observe records the number of bytes stored for a project during the current
+// second, as returned by the Coordinator.
+func (t *quotaTracker) observe(ctx context.Context, project string, bytes int64) {
+	window := clock.Now(ctx).Unix()
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.projects == nil {
+		t.projects = make(map[string]projectUsage)
+	}
+	if u := t.projects[project]; u.window == window && u.bytes > bytes {
+		// Responses can arrive out of order; keep the largest count.
+		return
+	}
+	t.projects[project] = projectUsage{window: window, bytes: bytes}
+}
+
+// usageAggregator accumulates the usage of log data stored by a Collector
+// until FlushUsage reports it to the Coordinator.
+//
+// Reporting usage updates the stream's state on the Coordinator, so reporting
+// it for every bundle would contend with stream registration and termination.
+// Unreported usage still counts against the Collector's quotas.
+type usageAggregator struct {
+	sync.Mutex
+
+	// streams maps streams to their unreported usage.
+	streams map[usageKey]*coordinator.UsageRequest
+	// projects maps project names to their unreported usage, in bytes.
+	projects map[string]int64
+}
+
+type usageKey struct {
+	project string
+	path    types.StreamPath
+}
+
+// add adds the size of log data stored for a stream to its unreported usage.
+func (a *usageAggregator) add(state *coordinator.LogStreamState, bytes int64) {
+	a.Lock()
+	defer a.Unlock()
+
+	if a.streams == nil {
+		a.streams = make(map[usageKey]*coordinator.UsageRequest)
+		a.projects = make(map[string]int64)
+	}
+	key := usageKey{state.Project, state.Path}
+	r := a.streams[key]
+	if r == nil {
+		r = &coordinator.UsageRequest{
+			Project: state.Project,
+			Path:    state.Path,
+			ID:      state.ID,
+			Secret:  state.Secret,
+		}
+		a.streams[key] = r
+	}
+	r.Bytes += bytes
+	a.projects[state.Project] += bytes
+}
+
+// streamBytes returns the unreported usage of a stream.
+func (a *usageAggregator) streamBytes(project string, path types.StreamPath) int64 {
+	a.Lock()
+	defer a.Unlock()
+
+	if r := a.streams[usageKey{project, path}]; r != nil {
+		return r.Bytes
+	}
+	return 0
+}
+
+// projectBytes returns the unreported usage of a project.
+func (a *usageAggregator) projectBytes(project string) int64 {
+	a.Lock()
+	defer a.Unlock()
+
+	return a.projects[project]
+}
+
+// take returns the unreported usage of each stream and resets it.
+func (a *usageAggregator) take() []*coordinator.UsageRequest {
+	a.Lock()
+	defer a.Unlock()
+
+	reqs := make([]*coordinator.UsageRequest, 0, len(a.streams))
+	for _, r := range a.streams {
+		reqs = append(reqs, r)
+	}
+	a.streams, a.projects = nil, nil
+	return reqs
+}
+
+// restore adds back the usage of a request that could not be reported.
+func (a *usageAggregator) restore(r *coordinator.UsageRequest) {
+	a.add(&coordinator.LogStreamState{
+		Project: r.Project,
+		Path:    r.Path,
+		ID:      r.ID,
+		Secret:  r.Secret,
+	}, r.Bytes)
+}
+
+// FlushUsage reports the usage of log data stored since the last flush to the
+// Coordinator, which counts it against the quotas of the streams and their
+// projects across all Collectors.
+//
+// It should be called about once per second, the window of the project rate
+// quota. Usage that fails to be reported is retained for the next flush, and
+// still counts against this Collector's quotas.
+func (c *Collector) FlushUsage(ctx context.Context) {
+	reqs := c.usage.take()
+	if len(reqs) == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, r := range reqs {
+		r := r
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			u, err := c.Coordinator.UpdateStreamUsage(ctx, r)
+			if err != nil {
+				log.Fields{
+					log.ErrorKey: err,
+					"project":    r.Project,
+					"path":       r.Path,
+					"bytes":      r.Bytes,
+				}.Warningf(ctx, "Failed to update stream usage; will retry.")
+				c.usage.restore(r)
+				return
+			}
+			c.quotaTracker.observe(ctx, r.Project, u.ProjectBytes)
+		}()
+	}
+	wg.Wait()
+}
+
+// loadQuota loads the quota for a project and reports its limits.
+//
+// If the quota could not be loaded due to a non-transient error, it is logged
+// and no quota is enforced.
+func (c *Collector) loadQuota(ctx context.Context, project string) (*svcconfig.CollectorQuota, error) {
+	if c.QuotaLoader == nil {
+		return nil, nil
+	}
+
+	q, err := c.QuotaLoader(ctx, project)
+	switch {
+	case transient.Tag.In(err):
+		log.WithError(err).Errorf(ctx, "Failed to load project quota.")
+		return nil, err
+	case err != nil:
+		log.WithError(err).Warningf(ctx, "Failed to load project quota; not enforcing quotas.")
+		return nil, nil
+	case q == nil:
+		return nil, nil
+	}
+
+	tsQuotaLimit.Set(ctx, q.BytesPerSecond, project, quotaBytesPerSecond)
+	tsQuotaLimit.Set(ctx, int64(q.StreamsPerPrefix), project, quotaStreamsPerPrefix)
+	tsQuotaLimit.Set(ctx, q.MaxStreamSize, project, quotaMaxStreamSize)
+	return q, nil
+}
+
+// applyRateQuota checks the project's byte rate quota before its bundle is
+// ingested.
+//
+// If the project is over quota, a transient error is returned so that the
+// bundle will be redelivered later.
+func (c *Collector) applyRateQuota(ctx context.Context, h *bundleHandler) error {
+	limit := h.quota.GetBytesPerSecond()
+	if limit <= 0 {
+		return nil
+	}
+	used := c.quotaTracker.projectBytes(ctx, h.project) + c.usage.projectBytes(h.project)
+	if used >= limit {
+		log.Fields{
+			"limit": limit,
+			"used":  used,
+		}.Warningf(ctx, "Project is over its ingestion rate quota.")
+		tsQuotaExceeded.Add(ctx, 1, h.project, quotaBytesPerSecond)
+		return errors.New("project is over its ingestion rate quota", transient.Tag)
+	}
+	return nil
+}
+
+// applyStreamQuota enforces the per-stream quotas on a bundle entry whose log
+// entries have been serialized into logData.
+//
+// Log entries beyond the stream's terminal index are discarded. If the bundle
+// entry causes its stream to exceed a quota, the offending log entries are
+// replaced with a single quota marker entry and the bundle entry is made
+// terminal at the marker's index.
+//
+// The stream's usage is taken from its Coordinator state, so it covers the log
+// data stored by all Collectors, plus the usage that this Collector has not
+// reported yet.
+//
+// The retained (and possibly modified) log data is returned.
+func (c *Collector) applyStreamQuota(ctx context.Context, h *bundleEntryHandler,
+	state *coordinator.LogStreamState, logData [][]byte) ([][]byte, error) {
+
+	if h.quota == nil {
+		return logData, nil
+	}
+
+	// If the stream was terminated for exceeding its quota, possibly by another
+	// Collector instance, its terminal index will differ from the one that the
+	// Butler eventually sends. Ignore the latter.
+	tidx := state.TerminalIndex
+	if tidx >= 0 && h.be.Terminal && types.MessageIndex(h.be.TerminalIndex) != tidx {
+		log.Fields{
+			"terminalIndex": tidx,
+			"bundleIndex":   h.be.TerminalIndex,
+		}.Infof(ctx, "Ignoring bundle terminal index for terminated stream.")
+		h.be.Terminal = false
+	}
+
+	if len(logData) == 0 {
+		return logData, nil
+	}
+	blockIndex := types.MessageIndex(h.be.Logs[0].StreamIndex)
+
+	// Discard any log entries beyond the stream's terminal index.
+	if tidx >= 0 {
+		switch keep := int64(tidx-blockIndex) + 1; {
+		case keep <= 0:
+			logData = nil
+		case keep < int64(len(logData)):
+			logData = logData[:keep]
+		}
+		if len(logData) == 0 {
+			log.Infof(ctx, "Discarding log entries beyond the stream's terminal index.")
+			return nil, nil
+		}
+	}
+
+	cut, quota, limit := -1, "", int64(0)
+	if max := int64(h.quota.StreamsPerPrefix); max > 0 && state.PrefixStreams > max {
+		cut, quota, limit = 0, quotaStreamsPerPrefix, max
+	}
+	if max := h.quota.MaxStreamSize; cut < 0 && max > 0 {
+		size := state.IngestedBytes + c.usage.streamBytes(state.Project, state.Path)
+		for i, d := range logData {
+			if size += int64(len(d)); size > max {
+				cut, quota, limit = i, quotaMaxStreamSize, max
+				break
+			}
+		}
+	}
+	if cut < 0 {
+		return logData, nil
+	}
+
+	marker := quotaMarkerEntry(h.be.Desc, h.be.Logs[cut], quota, limit)
+	markerData, err := proto.Marshal(marker)
+	if err != nil {
+		log.WithError(err).Errorf(ctx, "Failed to marshal quota marker entry.")
+		return nil, errors.New("failed to marshal quota marker entry")
+	}
+
+	log.Fields{
+		"quota":         quota,
+		"limit":         limit,
+		"terminalIndex": marker.StreamIndex,
+	}.Warningf(ctx, "Stream exceeded its quota; terminating.")
+	tsQuotaExceeded.Add(ctx, 1, h.project, quota)
+
+	h.be.Terminal = true
+	h.be.TerminalIndex = marker.StreamIndex
+	return append(logData[:cut], markerData), nil
+}
+
+// updateUsage counts log data that was stored for a stream against the
+// stream's and its project's quotas. It is reported to the Coordinator by the
+// next FlushUsage.
+//
+// Usage is counted only once the data is stored, so that a redelivered bundle
+// isn't counted twice.
+func (c *Collector) updateUsage(ctx context.Context, h *bundleEntryHandler,
+	state *coordinator.LogStreamState, logData [][]byte) {
+
+	var size int64
+	for _, d := range logData {
+		size += int64(len(d))
+	}
+	tsQuotaBytes.Add(ctx, size, h.project)
+
+	if h.quota.GetBytesPerSecond() <= 0 && h.quota.GetMaxStreamSize() <= 0 {
+		return
+	}
+	c.usage.add(state, size)
+}
+
+// quotaMarkerEntry builds the log entry that replaces le, the first log entry
+// of a stream to exceed a quota.
+func quotaMarkerEntry(desc *logpb.LogStreamDescriptor, le *logpb.LogEntry, quota string, limit int64) *logpb.LogEntry {
+	msg := fmt.Sprintf("%s Stream terminated: %s quota of %d exceeded.", QuotaMarker, quota, limit)
+
+	marker := &logpb.LogEntry{
+		TimeOffset:  le.TimeOffset,
+		PrefixIndex: le.PrefixIndex,
+		StreamIndex: le.StreamIndex,
+		Sequence:    le.Sequence,
+	}
+	switch desc.StreamType {
+	case logpb.StreamType_TEXT:
+		marker.Content = &logpb.LogEntry_Text{Text: &logpb.Text{
+			Lines: []*logpb.Text_Line{{Value: []byte(msg), Delimiter: "\n"}},
+		}}
+	case logpb.StreamType_BINARY:
+		marker.Content = &logpb.LogEntry_Binary{Binary: &logpb.Binary{Data: []byte(msg)}}
+	case logpb.StreamType_DATAGRAM:
+		marker.Content = &logpb.LogEntry_Datagram{Datagram: &logpb.Datagram{Data: []byte(msg)}}
+	case logpb.StreamType_STRUCTURED:
+		marker.Content = &logpb.LogEntry_Structured{Structured: &logpb.Structured{
+			Fields: []*logpb.Structured_Field{{
+				Key:   "message",
+				Value: &logpb.Structured_Field_StringValue{StringValue: msg},
+			}},
+		}}
+	}
+	return marker
+}
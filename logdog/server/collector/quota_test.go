@@ -0,0 +1,295 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/logdog/api/config/svcconfig"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/storage/memory"
+	"go.chromium.org/luci/logdog/common/types"
+	cc "go.chromium.org/luci/logdog/server/collector/coordinator"
+
+	"github.com/golang/protobuf/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestCollectorQuota(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a Collector enforcing quotas`, t, func() {
+		c, tc := testclock.UseTime(context.Background(), testclock.TestTimeLocal)
+
+		tcc := &testCoordinator{}
+		st := &testStorage{Storage: &memory.Storage{}}
+		quota := &svcconfig.CollectorQuota{}
+
+		coll := &Collector{
+			Coordinator: tcc,
+			Storage:     st,
+			QuotaLoader: func(context.Context, string) (*svcconfig.CollectorQuota, error) {
+				return quota, nil
+			},
+		}
+		defer coll.Close()
+
+		bb := bundleBuilder{
+			Context: c,
+		}
+		entrySize := proto.Size(bb.logEntry(0))
+
+		loadEntry := func(path string, idx int) *logpb.LogEntry {
+			var le *logpb.LogEntry
+			var ierr error
+			err := st.Get(c, storage.GetRequest{
+				Project: "test-project",
+				Path:    types.StreamPath(path),
+				Index:   types.MessageIndex(idx),
+				Limit:   1,
+			}, func(e *storage.Entry) bool {
+				le, ierr = e.GetLogEntry()
+				return false
+			})
+			So(err, ShouldBeNil)
+			So(ierr, ShouldBeNil)
+			So(le, ShouldNotBeNil)
+			return le
+		}
+
+		Convey(`Will not enforce quotas when none are configured.`, func() {
+			bb.addFullStream("foo/+/bar", 128)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+			So(tcc, shouldHaveRegisteredStream, "test-project", "foo/+/bar", 127)
+			So(st, shouldHaveStoredStream, "test-project", "foo/+/bar", indexRange{0, 127})
+		})
+
+		Convey(`Will return a transient error if the ingestion rate is exceeded.`, func() {
+			quota.BytesPerSecond = int64(entrySize * 2)
+
+			bb.addStreamEntries("foo/+/bar", -1, 0, 1, 2)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+
+			bb.addStreamEntries("foo/+/bar", -1, 3, 4)
+			err := coll.Process(c, bb.bundle())
+			So(err, ShouldErrLike, "ingestion rate quota")
+			So(transient.Tag.In(err), ShouldBeTrue)
+			So(st, shouldHaveStoredStream, "test-project", "foo/+/bar", indexRange{0, 2})
+
+			// In the next second, after the usage is reported, the bundle is
+			// accepted.
+			coll.FlushUsage(c)
+			tc.Add(time.Second)
+			bb.addStreamEntries("foo/+/bar", -1, 3, 4)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+			So(st, shouldHaveStoredStream, "test-project", "foo/+/bar", indexRange{0, 4})
+		})
+
+		Convey(`Will terminate streams beyond the per-prefix stream limit.`, func() {
+			quota.StreamsPerPrefix = 1
+
+			bb.addStreamEntries("foo/+/bar", -1, 0, 1)
+			bb.addStreamEntries("foo/+/bar", -1, 2)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+			bb.addStreamEntries("foo/+/baz", -1, 0, 1)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+
+			So(tcc, shouldHaveRegisteredStream, "test-project", "foo/+/bar", -1)
+			So(st, shouldHaveStoredStream, "test-project", "foo/+/bar", indexRange{0, 2})
+
+			So(tcc, shouldHaveRegisteredStream, "test-project", "foo/+/baz", 0)
+			So(st, shouldHaveStoredStream, "test-project", "foo/+/baz", 0)
+			le := loadEntry("foo/+/baz", 0)
+			line := string(le.GetText().Lines[0].Value)
+			So(strings.HasPrefix(line, QuotaMarker), ShouldBeTrue)
+			So(line, ShouldContainSubstring, "streams_per_prefix quota of 1")
+
+			Convey(`And will discard further log entries for the terminated stream.`, func() {
+				bb.addStreamEntries("foo/+/baz", 3, 2, 3)
+				So(coll.Process(c, bb.bundle()), ShouldBeNil)
+				So(st, shouldHaveStoredStream, "test-project", "foo/+/baz", 0)
+			})
+		})
+
+		Convey(`Will terminate streams that exceed the maximum stream size.`, func() {
+			quota.MaxStreamSize = int64(entrySize*3 + entrySize/2)
+
+			bb.addStreamEntries("foo/+/bar", -1, 0, 1)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+			bb.addStreamEntries("foo/+/bar", -1, 2, 3, 4)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+
+			So(tcc, shouldHaveRegisteredStream, "test-project", "foo/+/bar", 3)
+			So(st, shouldHaveStoredStream, "test-project", "foo/+/bar", indexRange{0, 3})
+			line := string(loadEntry("foo/+/bar", 3).GetText().Lines[0].Value)
+			So(strings.HasPrefix(line, QuotaMarker), ShouldBeTrue)
+			So(line, ShouldContainSubstring, "max_stream_size quota")
+
+			bb.addStreamEntries("foo/+/bar", 6, 5, 6)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+			So(st, shouldHaveStoredStream, "test-project", "foo/+/bar", indexRange{0, 3})
+		})
+
+		Convey(`Will share usage between Collectors.`, func() {
+			other := &Collector{
+				Coordinator: tcc,
+				Storage:     st,
+				QuotaLoader: coll.QuotaLoader,
+			}
+			defer other.Close()
+
+			Convey(`For the maximum stream size.`, func() {
+				quota.MaxStreamSize = int64(entrySize*3 + entrySize/2)
+
+				bb.addStreamEntries("foo/+/bar", -1, 0, 1)
+				So(coll.Process(c, bb.bundle()), ShouldBeNil)
+				coll.FlushUsage(c)
+				bb.addStreamEntries("foo/+/bar", -1, 2, 3, 4)
+				So(other.Process(c, bb.bundle()), ShouldBeNil)
+
+				So(tcc, shouldHaveRegisteredStream, "test-project", "foo/+/bar", 3)
+				So(st, shouldHaveStoredStream, "test-project", "foo/+/bar", indexRange{0, 3})
+			})
+
+			Convey(`For the ingestion rate.`, func() {
+				quota.BytesPerSecond = int64(entrySize * 2)
+
+				bb.addStreamEntries("foo/+/bar", -1, 0, 1, 2)
+				So(coll.Process(c, bb.bundle()), ShouldBeNil)
+				coll.FlushUsage(c)
+
+				// The other Collector learns the project's usage once it reports its
+				// own.
+				bb.addStreamEntries("foo/+/baz", -1, 0)
+				So(other.Process(c, bb.bundle()), ShouldBeNil)
+				other.FlushUsage(c)
+				bb.addStreamEntries("foo/+/baz", -1, 1)
+				So(other.Process(c, bb.bundle()), ShouldErrLike, "ingestion rate quota")
+			})
+		})
+
+		Convey(`Will only count usage for log data that was stored.`, func() {
+			quota.MaxStreamSize = 1024 * 1024
+			size := int64(proto.Size(bb.logEntry(0)) + proto.Size(bb.logEntry(1)))
+
+			bb.addStreamEntries("foo/+/bar", -1, 0, 1)
+			bundle := bb.bundle()
+
+			st.err = func() error { return errors.New("test error", transient.Tag) }
+			So(coll.Process(c, bundle), ShouldErrLike, "test error")
+			So(tcc.ingestedBytes("test-project", "foo/+/bar"), ShouldEqual, 0)
+
+			st.err = nil
+			So(coll.Process(c, bundle), ShouldBeNil)
+			coll.FlushUsage(c)
+			So(tcc.ingestedBytes("test-project", "foo/+/bar"), ShouldEqual, size)
+
+			// A redelivered bundle is not counted again.
+			So(coll.Process(c, bundle), ShouldBeNil)
+			coll.FlushUsage(c)
+			So(tcc.ingestedBytes("test-project", "foo/+/bar"), ShouldEqual, size)
+		})
+
+		Convey(`Will aggregate usage until it is flushed.`, func() {
+			quota.MaxStreamSize = 1024 * 1024
+			size := int64(proto.Size(bb.logEntry(0)) + proto.Size(bb.logEntry(1)) + proto.Size(bb.logEntry(2)))
+
+			var reqs []cc.UsageRequest
+			tcc.usageCallback = func(r cc.UsageRequest) error {
+				reqs = append(reqs, r)
+				return nil
+			}
+
+			bb.addStreamEntries("foo/+/bar", -1, 0, 1)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+			bb.addStreamEntries("foo/+/bar", -1, 2)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+			So(reqs, ShouldHaveLength, 0)
+
+			coll.FlushUsage(c)
+			So(reqs, ShouldHaveLength, 1)
+			So(reqs[0].Bytes, ShouldEqual, size)
+			So(tcc.ingestedBytes("test-project", "foo/+/bar"), ShouldEqual, size)
+		})
+
+		Convey(`Will count usage that failed to be reported.`, func() {
+			quota.BytesPerSecond = int64(entrySize * 2)
+			size := int64(proto.Size(bb.logEntry(0)) + proto.Size(bb.logEntry(1)) + proto.Size(bb.logEntry(2)))
+			tcc.usageCallback = func(cc.UsageRequest) error {
+				return errors.New("test error", transient.Tag)
+			}
+
+			bb.addStreamEntries("foo/+/bar", -1, 0, 1, 2)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+			coll.FlushUsage(c)
+			So(tcc.ingestedBytes("test-project", "foo/+/bar"), ShouldEqual, 0)
+
+			// The unreported usage is still over the rate quota.
+			tc.Add(time.Second)
+			bb.addStreamEntries("foo/+/bar", -1, 3)
+			So(coll.Process(c, bb.bundle()), ShouldErrLike, "ingestion rate quota")
+
+			// And it is reported by the next flush.
+			tcc.usageCallback = nil
+			coll.FlushUsage(c)
+			So(tcc.ingestedBytes("test-project", "foo/+/bar"), ShouldEqual, size)
+		})
+
+		Convey(`Will build quota markers for each stream type.`, func() {
+			le := bb.logEntry(7)
+			for _, t := range []logpb.StreamType{
+				logpb.StreamType_TEXT,
+				logpb.StreamType_BINARY,
+				logpb.StreamType_DATAGRAM,
+				logpb.StreamType_STRUCTURED,
+			} {
+				desc := &logpb.LogStreamDescriptor{StreamType: t}
+				m := quotaMarkerEntry(desc, le, quotaMaxStreamSize, 1024)
+				So(m.StreamIndex, ShouldEqual, 7)
+				So(m.Validate(desc), ShouldBeNil)
+			}
+		})
+
+		Convey(`Will return a transient error if the quota fails to load transiently.`, func() {
+			coll.QuotaLoader = func(context.Context, string) (*svcconfig.CollectorQuota, error) {
+				return nil, errors.New("test error", transient.Tag)
+			}
+
+			bb.addFullStream("foo/+/bar", 1)
+			err := coll.Process(c, bb.bundle())
+			So(transient.Tag.In(err), ShouldBeTrue)
+			So(tcc, shouldNotHaveRegisteredStream, "test-project", "foo/+/bar")
+		})
+
+		Convey(`Will not enforce quotas if the quota fails to load.`, func() {
+			coll.QuotaLoader = func(context.Context, string) (*svcconfig.CollectorQuota, error) {
+				return nil, errors.New("test error")
+			}
+
+			bb.addFullStream("foo/+/bar", 1)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+			So(st, shouldHaveStoredStream, "test-project", "foo/+/bar", 0)
+		})
+	})
+}
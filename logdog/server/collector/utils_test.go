@@ -28,6 +28,8 @@ import (
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/proto/google"
 	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/client/butler/bootstrap"
+	"go.chromium.org/luci/logdog/client/butler/output"
 	"go.chromium.org/luci/logdog/client/pubsubprotocol"
 	"go.chromium.org/luci/logdog/common/storage"
 	"go.chromium.org/luci/logdog/common/types"
@@ -54,9 +56,15 @@ type testCoordinator struct {
 	registerCallback func(cc.LogStreamState) error
 	// terminateCallback, if not nil, is called when stream termination happens.
 	terminateCallback func(cc.TerminateRequest) error
+	// usageCallback, if not nil, is called when stream usage is updated.
+	usageCallback func(cc.UsageRequest) error
 
 	// state is the latest tracked stream state.
 	state map[streamKey]*cc.LogStreamState
+	// tags are the tags reported for each stream when it was terminated.
+	tags map[streamKey]map[string]string
+	// projectBytes is the usage reported for each project, by Unix second.
+	projectBytes map[string]map[int64]int64
 }
 
 var _ cc.Coordinator = (*testCoordinator)(nil)
@@ -77,6 +85,16 @@ func (c *testCoordinator) register(s cc.LogStreamState) cc.LogStreamState {
 		return *sp
 	}
 
+	// Count the streams under the prefix, as the Coordinator would for a
+	// project with a streams-per-prefix quota.
+	prefix, _ := s.Path.Split()
+	s.PrefixStreams = 1
+	for k, st := range c.state {
+		if p, _ := st.Path.Split(); k.project == string(s.Project) && p == prefix {
+			s.PrefixStreams++
+		}
+	}
+
 	s.ID = id
 	c.state[key] = &s
 	return s
@@ -117,9 +135,48 @@ func (c *testCoordinator) TerminateStream(ctx context.Context, tr *cc.TerminateR
 	}
 
 	cachedState.TerminalIndex = tr.TerminalIndex
+	if len(tr.Tags) > 0 {
+		if c.tags == nil {
+			c.tags = make(map[streamKey]map[string]string)
+		}
+		c.tags[mkStreamKey(string(tr.Project), tr.ID)] = tr.Tags
+	}
 	return nil
 }
 
+func (c *testCoordinator) UpdateStreamUsage(ctx context.Context, r *cc.UsageRequest) (*cc.Usage, error) {
+	if cb := c.usageCallback; cb != nil {
+		if err := cb(*r); err != nil {
+			return nil, err
+		}
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	cachedState, ok := c.state[mkStreamKey(string(r.Project), r.ID)]
+	if !ok {
+		return nil, fmt.Errorf("no such stream: %s", r.ID)
+	}
+	cachedState.IngestedBytes += r.Bytes
+
+	if c.projectBytes == nil {
+		c.projectBytes = make(map[string]map[int64]int64)
+	}
+	windows := c.projectBytes[r.Project]
+	if windows == nil {
+		windows = make(map[int64]int64)
+		c.projectBytes[r.Project] = windows
+	}
+	window := clock.Now(ctx).Unix()
+	windows[window] += r.Bytes
+
+	return &cc.Usage{
+		StreamBytes:  cachedState.IngestedBytes,
+		ProjectBytes: windows[window],
+	}, nil
+}
+
 func (c *testCoordinator) stream(project, id string) (int, bool) {
 	c.Lock()
 	defer c.Unlock()
@@ -135,6 +192,23 @@ func (c *testCoordinator) streamForPath(project, path string) (int, bool) {
 	return c.stream(project, idFromPath(path))
 }
 
+func (c *testCoordinator) ingestedBytes(project, path string) int64 {
+	c.Lock()
+	defer c.Unlock()
+
+	if sp, ok := c.state[mkStreamKey(project, idFromPath(path))]; ok {
+		return sp.IngestedBytes
+	}
+	return 0
+}
+
+func (c *testCoordinator) streamTags(project, path string) map[string]string {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.tags[mkStreamKey(project, idFromPath(path))]
+}
+
 // testStorage is a testing storage instance that returns errors.
 type testStorage struct {
 	storage.Storage
@@ -150,6 +224,40 @@ func (s *testStorage) Put(c context.Context, r storage.PutRequest) error {
 	return s.Storage.Put(c, r)
 }
 
+// butlerOutput is a Butler output.Output that serializes each bundle it is
+// sent, as if it were published for the Collector to consume.
+type butlerOutput struct {
+	sync.Mutex
+
+	bundles [][]byte
+}
+
+var _ output.Output = (*butlerOutput)(nil)
+
+func (o *butlerOutput) SendBundle(b *logpb.ButlerLogBundle) error {
+	// The Butler leaves these to its Output.
+	b.Project = "test-project"
+	b.Prefix = "foo"
+	b.Secret = testSecret
+
+	buf := bytes.Buffer{}
+	w := pubsubprotocol.Writer{Compress: true}
+	if err := w.Write(&buf, b); err != nil {
+		return err
+	}
+
+	o.Lock()
+	defer o.Unlock()
+	o.bundles = append(o.bundles, buf.Bytes())
+	return nil
+}
+
+func (o *butlerOutput) MaxSendBundles() int                       { return 1 }
+func (o *butlerOutput) MaxSize() int                              { return 1024 * 1024 }
+func (o *butlerOutput) Stats() output.Stats                       { return &output.StatsBase{} }
+func (o *butlerOutput) URLConstructionEnv() bootstrap.Environment { return bootstrap.Environment{} }
+func (o *butlerOutput) Close()                                    {}
+
 // bundleBuilder is a set of utility functions to help test cases construct
 // specific logpb.ButlerLogBundle layouts.
 type bundleBuilder struct {
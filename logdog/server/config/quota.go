@@ -0,0 +1,53 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+
+	"go.chromium.org/luci/logdog/api/config/svcconfig"
+)
+
+// CollectorQuota returns the ingestion quotas that are enforced for a project.
+//
+// Each quota that is not set (zero) in the project's configuration falls back
+// to the service Collector config's default.
+func CollectorQuota(ctx context.Context, projectID string) (*svcconfig.CollectorQuota, error) {
+	cfg, err := Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pcfg, err := ProjectConfig(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	pq, dq := pcfg.GetCollectorQuota(), cfg.GetCollector().GetDefaultQuota()
+	q := svcconfig.CollectorQuota{
+		BytesPerSecond:   pq.GetBytesPerSecond(),
+		StreamsPerPrefix: pq.GetStreamsPerPrefix(),
+		MaxStreamSize:    pq.GetMaxStreamSize(),
+	}
+	if q.BytesPerSecond == 0 {
+		q.BytesPerSecond = dq.GetBytesPerSecond()
+	}
+	if q.StreamsPerPrefix == 0 {
+		q.StreamsPerPrefix = dq.GetStreamsPerPrefix()
+	}
+	if q.MaxStreamSize == 0 {
+		q.MaxStreamSize = dq.GetMaxStreamSize()
+	}
+	return &q, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/config"
+	"go.chromium.org/luci/config/cfgclient"
+	cfgmem "go.chromium.org/luci/config/impl/memory"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/logdog/api/config/svcconfig"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCollectorQuota(t *testing.T) {
+	t.Parallel()
+
+	Convey("With mocks", t, func() {
+		configs := map[config.Set]cfgmem.Files{
+			"services/${appid}": {
+				"services.cfg": `collector {
+					default_quota { bytes_per_second: 100 max_stream_size: 1000 }
+				}`,
+			},
+			"projects/a": {
+				"${appid}.cfg": `collector_quota { bytes_per_second: 10 streams_per_prefix: 5 }`,
+			},
+		}
+
+		ctx := context.Background()
+		ctx = memory.Use(ctx)
+		ctx, _ = testclock.UseTime(ctx, testclock.TestTimeUTC)
+		ctx = cfgclient.Use(ctx, cfgmem.New(configs))
+		ctx = WithStore(ctx, &Store{NoCache: true})
+		So(Sync(ctx), ShouldBeNil)
+
+		Convey("Project quotas override the service defaults", func() {
+			q, err := CollectorQuota(ctx, "a")
+			So(err, ShouldBeNil)
+			So(q, ShouldResemble, &svcconfig.CollectorQuota{
+				BytesPerSecond:   10,
+				StreamsPerPrefix: 5,
+				MaxStreamSize:    1000,
+			})
+		})
+
+		Convey("Unknown projects fail", func() {
+			_, err := CollectorQuota(ctx, "unknown")
+			So(err, ShouldEqual, config.ErrNoConfig)
+		})
+	})
+}
@@ -47,6 +47,7 @@ import (
 	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/services/v1"
 	"go.chromium.org/luci/logdog/common/storage"
 	"go.chromium.org/luci/logdog/common/storage/bigtable"
+	"go.chromium.org/luci/logdog/common/storage/filesystem"
 	"go.chromium.org/luci/logdog/server/config"
 	serverAuth "go.chromium.org/luci/server/auth"
 	"go.chromium.org/luci/server/caching"
@@ -388,6 +389,14 @@ func (s *Service) IntermediateStorage(c context.Context, rw bool) (storage.Stora
 		return nil, ErrInvalidConfig
 	}
 
+	if fscfg := storageCfg.GetFilesystem(); fscfg != nil {
+		if fscfg.Dir == "" {
+			log.Errorf(c, "Missing filesystem storage directory.")
+			return nil, ErrInvalidConfig
+		}
+		return &filesystem.Storage{Dir: fscfg.Dir}, nil
+	}
+
 	btcfg := storageCfg.GetBigtable()
 	if btcfg == nil {
 		log.Errorf(c, "Missing BigTable storage configuration")
@@ -49,6 +49,7 @@ func application(p Params) *cli.Application {
 		},
 		Commands: []*subcommands.Command{
 			cmdDerive(p),
+			cmdDiff(p),
 			cmdRPC(p),
 			cmdQuery(p),
 			cmdStream(p),
@@ -86,10 +86,7 @@ func (r *baseCommandRun) initClients(ctx context.Context, loginMode auth.LoginMo
 	if err != nil {
 		return err
 	}
-	r.http, err = auth.NewAuthenticator(ctx, loginMode, authOpts).Client()
-	if err != nil {
-		return err
-	}
+	httpClient, authErr := auth.NewAuthenticator(ctx, loginMode, authOpts).Client()
 
 	r.resultdbCtx = lucictx.GetResultDB(ctx)
 
@@ -111,6 +108,17 @@ func (r *baseCommandRun) initClients(ctx context.Context, loginMode auth.LoginMo
 		return fmt.Errorf("invalid host %q", r.host)
 	}
 
+	// A local ResultDB, e.g. resultdb/cmd/local, does not require
+	// authentication, so do not require credentials to talk to it.
+	switch {
+	case authErr == auth.ErrLoginRequired && lhttp.IsLocalHost(r.host):
+		r.http = http.DefaultClient
+	case authErr != nil:
+		return authErr
+	default:
+		r.http = httpClient
+	}
+
 	if r.maxConcurrentRPCs < 0 {
 		return fmt.Errorf("invalid -max-concurrent-rpcs %d", r.maxConcurrentRPCs)
 	}
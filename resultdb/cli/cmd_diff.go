@@ -0,0 +1,302 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/maruel/subcommands"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/genproto/protobuf/field_mask"
+
+	"go.chromium.org/luci/auth"
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/data/text"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// ExitCodeNewUnexpectedResults indicates that the diff command found test
+// variants with new unexpected results.
+const ExitCodeNewUnexpectedResults = 1
+
+func cmdDiff(p Params) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: `diff [flags] BASE_INVOCATION_ID INVOCATION_ID`,
+		ShortDesc: "compare test results of two invocations",
+		LongDesc: text.Doc(`
+			Compare test results of two invocations.
+
+			Test variants are matched by test id and variant hash, and each
+			invocation has a verdict per test variant: expected if the variant has at
+			least one expected result, e.g. it passed after a retry, and unexpected
+			otherwise.
+
+			Prints test variants that newly fail, newly pass, disappeared or were
+			added in the second invocation compared to the first one.
+			Exits with code 1 if any test variant newly fails or was added with
+			unexpected results, so it can be used as a gate. Example:
+			  rdb diff build-1 build-2 || echo "new failures"
+		`),
+		CommandRun: func() subcommands.CommandRun {
+			r := &diffRun{}
+			r.RegisterGlobalFlags(p)
+			r.RegisterJSONFlag(text.Doc(`
+				Print changes in JSON format separated by newline.
+				One change takes exactly one line. Change object properties are
+				change, testId, variant, variantHash, and for the added test variants,
+				expected.
+			`))
+			r.Flags.StringVar(&r.testID, "test", "", text.Doc(`
+				A regular expression for test id. Implicitly wrapped with ^ and $.
+
+				Example: ninja://chrome/test:browser_tests/.+
+			`))
+			return r
+		},
+	}
+}
+
+type diffRun struct {
+	baseCommandRun
+	testID string
+
+	baseInvID string
+	invID     string
+}
+
+func (r *diffRun) parseArgs(args []string) error {
+	if len(args) != 2 {
+		return errors.Reason("expected 2 positional arguments, got %d", len(args)).Err()
+	}
+	r.baseInvID, r.invID = args[0], args[1]
+
+	for _, id := range args {
+		if err := pbutil.ValidateInvocationID(id); err != nil {
+			return errors.Annotate(err, "invocation id %q", id).Err()
+		}
+	}
+	return nil
+}
+
+func (r *diffRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, r, env)
+
+	if err := r.parseArgs(args); err != nil {
+		return r.done(err)
+	}
+
+	if err := r.initClients(ctx, auth.SilentLogin); err != nil {
+		return r.done(err)
+	}
+
+	changes, err := r.diff(ctx)
+	if err != nil {
+		return r.done(err)
+	}
+
+	r.printChanges(changes)
+	for _, c := range changes {
+		if c.newUnexpected() {
+			return ExitCodeNewUnexpectedResults
+		}
+	}
+	return 0
+}
+
+// variantVerdict is the verdict of a test variant in an invocation.
+type variantVerdict struct {
+	testID      string
+	variant     *pb.Variant
+	variantHash string
+	expected    bool
+}
+
+// diffKind is a kind of a change of a test variant between two invocations.
+type diffKind string
+
+const (
+	diffNewlyFailing diffKind = "newlyFailing"
+	diffNewlyPassing diffKind = "newlyPassing"
+	diffDisappeared  diffKind = "disappeared"
+	diffAdded        diffKind = "added"
+)
+
+// diffKinds is the order in which changes are printed.
+var diffKinds = []diffKind{diffNewlyFailing, diffAdded, diffNewlyPassing, diffDisappeared}
+
+// variantChange is a change of a test variant between two invocations.
+type variantChange struct {
+	kind diffKind
+	// verdict is the verdict in the second invocation, or in the first one if
+	// the test variant disappeared.
+	verdict *variantVerdict
+}
+
+// newUnexpected returns true if the change introduces unexpected results.
+func (c *variantChange) newUnexpected() bool {
+	return c.kind == diffNewlyFailing || (c.kind == diffAdded && !c.verdict.expected)
+}
+
+// diff fetches test results of both invocations and returns the changes,
+// ordered by kind, test id and variant hash.
+func (r *diffRun) diff(ctx context.Context) ([]*variantChange, error) {
+	var base, cur map[string]*variantVerdict
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		base, err = r.fetchVerdicts(ctx, r.baseInvID)
+		return
+	})
+	eg.Go(func() (err error) {
+		cur, err = r.fetchVerdicts(ctx, r.invID)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	var changes []*variantChange
+	for key, v := range cur {
+		b, ok := base[key]
+		switch {
+		case !ok:
+			changes = append(changes, &variantChange{kind: diffAdded, verdict: v})
+		case b.expected && !v.expected:
+			changes = append(changes, &variantChange{kind: diffNewlyFailing, verdict: v})
+		case !b.expected && v.expected:
+			changes = append(changes, &variantChange{kind: diffNewlyPassing, verdict: v})
+		}
+	}
+	for key, b := range base {
+		if _, ok := cur[key]; !ok {
+			changes = append(changes, &variantChange{kind: diffDisappeared, verdict: b})
+		}
+	}
+
+	kindOrder := make(map[diffKind]int, len(diffKinds))
+	for i, k := range diffKinds {
+		kindOrder[k] = i
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		switch {
+		case a.kind != b.kind:
+			return kindOrder[a.kind] < kindOrder[b.kind]
+		case a.verdict.testID != b.verdict.testID:
+			return a.verdict.testID < b.verdict.testID
+		default:
+			return a.verdict.variantHash < b.verdict.variantHash
+		}
+	})
+	return changes, nil
+}
+
+// fetchVerdicts fetches test results of the invocation and returns verdicts
+// of its test variants keyed by test id and variant hash.
+func (r *diffRun) fetchVerdicts(ctx context.Context, invID string) (map[string]*variantVerdict, error) {
+	req := &pb.QueryTestResultsRequest{
+		Invocations: []string{pbutil.InvocationName(invID)},
+		Predicate:   &pb.TestResultPredicate{TestIdRegexp: r.testID},
+		PageSize:    1000,
+		ReadMask: &field_mask.FieldMask{
+			Paths: []string{"test_id", "variant", "variant_hash", "expected"},
+		},
+	}
+
+	msgC := make(chan proto.Message)
+	errC := make(chan error, 1)
+	go func() {
+		defer close(msgC)
+		errC <- pbutil.Query(ctx, msgC, r.resultdb, req)
+	}()
+
+	ret := map[string]*variantVerdict{}
+	for m := range msgC {
+		tr := m.(*pb.TestResult)
+		key := tr.TestId + "\n" + tr.VariantHash
+		v := ret[key]
+		if v == nil {
+			v = &variantVerdict{
+				testID:      tr.TestId,
+				variant:     tr.Variant,
+				variantHash: tr.VariantHash,
+			}
+			ret[key] = v
+		}
+		v.expected = v.expected || tr.Expected
+	}
+	if err := <-errC; err != nil {
+		return nil, errors.Annotate(err, "failed to query test results of %s", invID).Err()
+	}
+	return ret, nil
+}
+
+// printChanges prints the changes to stdout, in JSON or text format.
+func (r *diffRun) printChanges(changes []*variantChange) {
+	if r.json {
+		enc := json.NewEncoder(os.Stdout)
+		for _, c := range changes {
+			obj := map[string]interface{}{
+				"change":      c.kind,
+				"testId":      c.verdict.testID,
+				"variantHash": c.verdict.variantHash,
+			}
+			if c.verdict.variant != nil {
+				obj["variant"] = json.RawMessage(msgToJSON(c.verdict.variant))
+			}
+			if c.kind == diffAdded {
+				obj["expected"] = c.verdict.expected
+			}
+			enc.Encode(obj) // prints \n in the end
+		}
+		return
+	}
+
+	headers := map[diffKind]string{
+		diffNewlyFailing: "Newly failing",
+		diffNewlyPassing: "Newly passing",
+		diffDisappeared:  "Disappeared",
+		diffAdded:        "Added",
+	}
+	for i := 0; i < len(changes); {
+		kind := changes[i].kind
+		j := i
+		for j < len(changes) && changes[j].kind == kind {
+			j++
+		}
+
+		fmt.Printf("%s (%d):\n", headers[kind], j-i)
+		for _, c := range changes[i:j] {
+			line := "  " + c.verdict.testID
+			if pairs := pbutil.VariantToStrings(c.verdict.variant); len(pairs) > 0 {
+				line += " {" + strings.Join(pairs, ", ") + "}"
+			}
+			if kind == diffAdded && !c.verdict.expected {
+				line += " (unexpected)"
+			}
+			fmt.Println(line)
+		}
+		fmt.Println()
+		i = j
+	}
+}
@@ -47,10 +47,15 @@ func cmdQuery(p Params) *subcommands.Command {
 			If no invocation ids are specified on the command line, read them from
 			stdin separated by newline. Example:
 			  bb chromium/ci/linux-rel -status failure -inv -10 | rdb query
+
+			Results can be filtered and ranked by flakiness of their test variants,
+			computed from the history of the realm. Example:
+			  rdb query -u -realm chromium:ci -rank-by-flake-rate <INVOCATION_ID>
 		`),
 		CommandRun: func() subcommands.CommandRun {
 			r := &queryRun{}
 			r.queryRunBase.registerFlags(p)
+			r.queryRunBase.registerFlakinessFlags()
 			return r
 		},
 	}
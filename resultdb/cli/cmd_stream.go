@@ -21,6 +21,7 @@ import (
 	"os"
 	"os/exec"
 	"os/user"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
@@ -45,10 +46,19 @@ import (
 	"go.chromium.org/luci/resultdb/pbutil"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
 	"go.chromium.org/luci/resultdb/sink"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
 )
 
 var matchInvalidInvocationIDChars = regexp.MustCompile(`[^a-z0-9_\-:.]`)
 
+// resultFileFormats maps the values of the -format flag to the result file
+// formats.
+var resultFileFormats = map[string]sinkpb.TestResultFile_Format{
+	"junit":        sinkpb.TestResultFile_JUNIT_XML,
+	"tap":          sinkpb.TestResultFile_TAP,
+	"go-test-json": sinkpb.TestResultFile_GO_TEST_JSON,
+}
+
 func cmdStream(p Params) *subcommands.Command {
 	return &subcommands.Command{
 		UsageLine: `stream [flags] TEST_CMD [TEST_ARG]...`,
@@ -59,6 +69,12 @@ func cmdStream(p Params) *subcommands.Command {
 			upload them to ResultDB. Either use the current invocation from
 			LUCI_CONTEXT or create/finalize a new one. Example:
 				rdb stream -new -realm chromium:public ./out/chrome/test/browser_tests
+
+			Test commands that do not use the ResultSink protocol can write their
+			results to a file in a supported format instead. Example:
+				rdb stream -new -realm chromium:public \
+				  -result-file=results.json -format=go-test-json \
+				  sh -c 'go test -json ./... > results.json'
 		`),
 		CommandRun: func() subcommands.CommandRun {
 			r := &streamRun{vars: make(stringmapflag.Value)}
@@ -88,6 +104,21 @@ func cmdStream(p Params) *subcommands.Command {
 				sink.DefaultTestResultChannelMaxLeases, text.Doc(`
 				The maximum number of goroutines uploading test results.
 			`))
+			r.Flags.StringVar(&r.resultFile, "result-file", "", text.Doc(`
+				Path to a file that the test command writes test results to, in the
+				format given by -format. The file is watched while the test command
+				runs, and the results appended to it are uploaded.
+			`))
+			r.Flags.StringVar(&r.resultFormat, "format", "", text.Doc(`
+				Format of the -result-file. One of "junit" (JUnit XML), "tap" (TAP
+				version 13) or "go-test-json" (the output of "go test -json").
+			`))
+			r.Flags.StringVar(&r.locationTagsFile, "location-tags-file", "", text.Doc(`
+				Path to a JSON file with test metadata keyed by source location,
+				e.g. owners and bug components of directories in the repository.
+				Test results that have a test location but no metadata get
+				metadata derived from this file.
+			`))
 
 			return r
 		},
@@ -104,6 +135,9 @@ type streamRun struct {
 	vars                stringmapflag.Value
 	artChannelMaxLeases uint
 	trChannelMaxLeases  uint
+	resultFile          string
+	resultFormat        string
+	locationTagsFile    string
 
 	// TODO(ddoman): add flags
 	// - tag (invocation-tag)
@@ -124,9 +158,30 @@ func (r *streamRun) validate(ctx context.Context, args []string) (err error) {
 			return errors.Annotate(err, "invalid realm").Err()
 		}
 	}
+	switch _, ok := resultFileFormats[r.resultFormat]; {
+	case r.resultFile == "" && r.resultFormat != "":
+		return errors.Reason("-format requires -result-file").Err()
+	case r.resultFile != "" && !ok:
+		return errors.Reason("invalid -format %q", r.resultFormat).Err()
+	}
 	return nil
 }
 
+// resultFiles returns the result files to watch.
+func (r *streamRun) resultFiles() ([]*sinkpb.TestResultFile, error) {
+	if r.resultFile == "" {
+		return nil, nil
+	}
+	path, err := filepath.Abs(r.resultFile)
+	if err != nil {
+		return nil, errors.Annotate(err, "-result-file").Err()
+	}
+	return []*sinkpb.TestResultFile{{
+		Path:   path,
+		Format: resultFileFormats[r.resultFormat],
+	}}, nil
+}
+
 func (r *streamRun) Run(a subcommands.Application, args []string, env subcommands.Env) (ret int) {
 	ctx := cli.GetContext(a, r, env)
 
@@ -207,15 +262,27 @@ func (r *streamRun) runTestCmd(ctx context.Context, args []string) error {
 
 	// TODO(ddoman): send the logs of SinkServer to --log-file
 
+	resultFiles, err := r.resultFiles()
+	if err != nil {
+		return err
+	}
+	var locationTags *sinkpb.LocationTags
+	if r.locationTagsFile != "" {
+		if locationTags, err = sink.LoadLocationTags(r.locationTagsFile); err != nil {
+			return errors.Annotate(err, "-location-tags-file").Err()
+		}
+	}
 	cfg := sink.ServerConfig{
 		Recorder:                   r.recorder,
 		Invocation:                 r.invocation.Name,
 		UpdateToken:                r.invocation.UpdateToken,
 		TestIDPrefix:               r.testIDPrefix,
 		BaseVariant:                &pb.Variant{Def: r.vars},
-		ArtifactUploader:           &sink.ArtifactUploader{Client: r.http, Host: r.host},
+		ArtifactUploader:           &sink.ArtifactUploader{Client: r.http, Host: r.host, Insecure: r.prpcClient.Options.Insecure},
 		ArtChannelMaxLeases:        r.artChannelMaxLeases,
 		TestResultChannelMaxLeases: r.trChannelMaxLeases,
+		ResultFiles:                resultFiles,
+		LocationTags:               locationTags,
 	}
 	return sink.Run(ctx, cfg, func(ctx context.Context, cfg sink.ServerConfig) error {
 		exported, err := lucictx.Export(ctx)
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
@@ -41,6 +42,14 @@ type queryRunBase struct {
 	testID     string
 	merge      bool
 
+	owner        string
+	bugComponent string
+
+	// Flakiness flags, registered by registerFlakinessFlags.
+	realm           string
+	minFlakeRate    float64
+	rankByFlakeRate bool
+
 	// TODO(crbug.com/1021849): add flag -artifact-dir
 	// TODO(crbug.com/1021849): add flag -artifact-name
 }
@@ -54,6 +63,8 @@ func (r *queryRunBase) registerFlags(p Params) {
 		  testResult: luci.resultdb.v1.TestResult message.
 			testExoneration: luci.resultdb.v1.TestExoneration message.
 			invocation: luci.resultdb.v1.Invocation message.
+		If -realm is specified, test results and exonerations also have flakeRate
+		property.
 	`))
 
 	r.Flags.IntVar(&r.limit, "n", 0, text.Doc(`
@@ -80,6 +91,37 @@ func (r *queryRunBase) registerFlags(p Params) {
 		Useful when the invocations are a part of one computation, e.g. shards
 		of a test.
 	`))
+
+	r.Flags.StringVar(&r.owner, "owner", "", text.Doc(`
+		Print only test results of tests owned by this owner, according to
+		their test metadata. Test exonerations are not printed.
+	`))
+
+	r.Flags.StringVar(&r.bugComponent, "bug-component", "", text.Doc(`
+		Print only test results of tests in this bug component, according to
+		their test metadata. Test exonerations are not printed.
+	`))
+}
+
+// registerFlakinessFlags registers flags to filter and rank results by the
+// flakiness of their test variants.
+func (r *queryRunBase) registerFlakinessFlags() {
+	r.Flags.StringVar(&r.realm, "realm", "", text.Doc(`
+		The realm of the invocation history to compute test flakiness from,
+		e.g. "chromium:ci". Required by -min-flake-rate and -rank-by-flake-rate.
+		When specified, each test result and exoneration is printed with the
+		flake rate of its test variant.
+	`))
+
+	r.Flags.Float64Var(&r.minFlakeRate, "min-flake-rate", 0, text.Doc(`
+		Print only test results and exonerations of test variants with at least
+		this flake rate in the history, between 0 and 1.
+	`))
+
+	r.Flags.BoolVar(&r.rankByFlakeRate, "rank-by-flake-rate", false, text.Doc(`
+		Print test results and exonerations ordered by decreasing flake rate of
+		their test variants. Results are printed after all of them are fetched.
+	`))
 }
 
 func (r *queryRunBase) validate() error {
@@ -87,6 +129,13 @@ func (r *queryRunBase) validate() error {
 		return errors.Reason("-n must be non-negative").Err()
 	}
 
+	if r.minFlakeRate < 0 || r.minFlakeRate > 1 {
+		return errors.Reason("-min-flake-rate must be between 0 and 1").Err()
+	}
+	if (r.minFlakeRate > 0 || r.rankByFlakeRate) && r.realm == "" {
+		return errors.Reason("-realm is required by -min-flake-rate and -rank-by-flake-rate").Err()
+	}
+
 	// TODO(crbug.com/1021849): improve validation.
 	return nil
 }
@@ -94,10 +143,19 @@ func (r *queryRunBase) validate() error {
 type resultItem struct {
 	invocationID string
 	result       proto.Message
+	flakeRate    float32
 }
 
 // queryAndPrint queries results and prints them.
 func (r *queryRunBase) queryAndPrint(ctx context.Context, invIDs []string) error {
+	var flakeRates map[testVariantKey]float32
+	if r.realm != "" {
+		var err error
+		if flakeRates, err = r.fetchFlakeRates(ctx); err != nil {
+			return err
+		}
+	}
+
 	eg, ctx := errgroup.WithContext(ctx)
 	resultC := make(chan resultItem)
 
@@ -131,10 +189,80 @@ func (r *queryRunBase) queryAndPrint(ctx context.Context, invIDs []string) error
 		errC <- err
 	}()
 
-	r.printProto(resultC, r.json)
+	if flakeRates == nil {
+		r.printProto(resultC, r.json)
+	} else {
+		r.printProto(r.applyFlakeRates(resultC, flakeRates), r.json)
+	}
 	return <-errC
 }
 
+// testVariantKey identifies a test variant.
+type testVariantKey struct {
+	testID      string
+	variantHash string
+}
+
+// fetchFlakeRates fetches flake rates of the test variants in r.realm.
+// Test variants without flakiness in the history are omitted.
+func (r *queryRunBase) fetchFlakeRates(ctx context.Context) (map[testVariantKey]float32, error) {
+	res, err := r.resultdb.QueryTestFlakiness(ctx, &pb.QueryTestFlakinessRequest{
+		Realm:        r.realm,
+		TestIdRegexp: r.testID,
+		MinFlakeRate: float32(r.minFlakeRate),
+		PageSize:     1000,
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to query test flakiness").Err()
+	}
+
+	ret := make(map[testVariantKey]float32, len(res.TestFlakiness))
+	for _, f := range res.TestFlakiness {
+		ret[testVariantKey{testID: f.TestId, variantHash: f.VariantHash}] = f.FlakeRate
+	}
+	return ret, nil
+}
+
+// applyFlakeRates sets flake rates of the test results and exonerations in
+// resultC, filters them by r.minFlakeRate and ranks them if requested.
+func (r *queryRunBase) applyFlakeRates(resultC <-chan resultItem, flakeRates map[testVariantKey]float32) <-chan resultItem {
+	ret := make(chan resultItem)
+	go func() {
+		defer close(ret)
+
+		var ranked []resultItem
+		for item := range resultC {
+			var key testVariantKey
+			switch res := item.result.(type) {
+			case *pb.TestResult:
+				key = testVariantKey{testID: res.TestId, variantHash: res.VariantHash}
+			case *pb.TestExoneration:
+				key = testVariantKey{testID: res.TestId, variantHash: res.VariantHash}
+			default:
+				ret <- item
+				continue
+			}
+
+			item.flakeRate = flakeRates[key]
+			switch {
+			case float64(item.flakeRate) < r.minFlakeRate:
+			case r.rankByFlakeRate:
+				ranked = append(ranked, item)
+			default:
+				ret <- item
+			}
+		}
+
+		sort.SliceStable(ranked, func(i, j int) bool {
+			return ranked[i].flakeRate > ranked[j].flakeRate
+		})
+		for _, item := range ranked {
+			ret <- item
+		}
+	}()
+	return ret
+}
+
 // fetchInvocation fetches an invocation.
 func (r *queryRunBase) fetchInvocation(ctx context.Context, invID string, dest chan<- resultItem) error {
 	res, err := r.resultdb.GetInvocation(ctx, &pb.GetInvocationRequest{Name: pbutil.InvocationName(invID)})
@@ -171,6 +299,17 @@ func (r *queryRunBase) fetchItems(ctx context.Context, invIDs []string, resultIt
 		PageSize: int32(r.limit),
 	}
 
+	// Test exonerations do not have test metadata, so they cannot be filtered
+	// by it.
+	reqs := []proto.Message{trReq, teReq}
+	if r.owner != "" || r.bugComponent != "" {
+		trReq.Predicate.TestMetadata = &pb.TestMetadataPredicate{
+			Owner:        r.owner,
+			BugComponent: r.bugComponent,
+		}
+		reqs = reqs[:1]
+	}
+
 	// Query for results.
 	msgC := make(chan proto.Message)
 	errC := make(chan error, 1)
@@ -178,7 +317,7 @@ func (r *queryRunBase) fetchItems(ctx context.Context, invIDs []string, resultIt
 	defer cancelQuery()
 	go func() {
 		defer close(msgC)
-		errC <- pbutil.Query(queryCtx, msgC, r.resultdb, trReq, teReq)
+		errC <- pbutil.Query(queryCtx, msgC, r.resultdb, reqs...)
 	}()
 
 	// Send findings to the destination channel.
@@ -240,12 +379,18 @@ func (r *queryRunBase) printProto(resultC <-chan resultItem, printJSON bool) {
 			if !r.merge {
 				obj["invocationId"] = res.invocationID
 			}
+			if r.realm != "" && key != "invocation" {
+				obj["flakeRate"] = res.flakeRate
+			}
 			enc.Encode(obj) // prints \n in the end
 		} else {
 			fmt.Fprintf(ind, "%s:\n", key)
 			ind.Level++
 			proto.MarshalText(ind, res.result)
 			ind.Level--
+			if r.realm != "" && key != "invocation" {
+				fmt.Fprintf(ind, "flakeRate: %g\n", res.flakeRate)
+			}
 			fmt.Fprintln(ind)
 		}
 	}
@@ -0,0 +1,40 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"go.chromium.org/luci/server"
+
+	"go.chromium.org/luci/resultdb/internal"
+	"go.chromium.org/luci/resultdb/internal/services/fileexporter"
+)
+
+func main() {
+	opts := fileexporter.DefaultOptions()
+	flag.IntVar(&opts.TaskWorkers, "task-workers", opts.TaskWorkers,
+		"Number of invocations to export concurrently")
+	flag.StringVar(&opts.Destination, "destination", opts.Destination,
+		`Where to write files to: a local directory or a "gs://bucket/prefix" path`)
+	flag.StringVar(&opts.Format, "format", opts.Format,
+		fmt.Sprintf("File format, one of: %s", strings.Join(fileexporter.FormatNames(), ", ")))
+
+	internal.Main(func(srv *server.Server) error {
+		return fileexporter.InitServer(srv, opts)
+	})
+}
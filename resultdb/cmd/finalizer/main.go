@@ -15,14 +15,18 @@
 package main
 
 import (
+	"go.chromium.org/luci/config/server/cfgmodule"
 	"go.chromium.org/luci/server"
+	"go.chromium.org/luci/server/module"
 
 	"go.chromium.org/luci/resultdb/internal"
 	"go.chromium.org/luci/resultdb/internal/services/finalizer"
 )
 
 func main() {
-	internal.Main(func(srv *server.Server) error {
+	// The finalizer reads project configs to apply exoneration rules.
+	modules := []module.Module{cfgmodule.NewModuleFromFlags()}
+	internal.MainWithModules(modules, func(srv *server.Server) error {
 		finalizer.InitServer(srv, finalizer.DefaultOptions())
 		return nil
 	})
@@ -0,0 +1,36 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"time"
+
+	"go.chromium.org/luci/server"
+
+	"go.chromium.org/luci/resultdb/internal"
+	"go.chromium.org/luci/resultdb/internal/services/flakinessanalyzer"
+)
+
+func main() {
+	var opts flakinessanalyzer.Options
+	flag.DurationVar(&opts.UpdateInterval, "update-interval", time.Hour, "How often to recompute test flakiness.")
+	flag.DurationVar(&opts.Window, "window", 7*24*time.Hour, "How far back to look in the history of a realm.")
+	flag.IntVar(&opts.MaxInvocations, "max-invocations", 1000, "Maximum number of the most recent invocations to analyze in a realm.")
+	internal.Main(func(srv *server.Server) error {
+		flakinessanalyzer.InitServer(srv, opts)
+		return nil
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command local runs Recorder and ResultDB services with in-memory storage,
+// for development and end-to-end testing on a developer machine. It does not
+// need Cloud Spanner or any other cloud service.
+//
+// Example:
+//
+//	go run go.chromium.org/luci/resultdb/cmd/local -http-addr localhost:8800
+//	rdb stream -host localhost:8800 -new -realm chromium:public -- ./test.sh
+package main
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"os"
+
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/logging/gologger"
+
+	"go.chromium.org/luci/resultdb/internal/services/local"
+)
+
+func main() {
+	opts := local.Options{}
+	addr := flag.String(
+		"http-addr",
+		"127.0.0.1:8800",
+		"Address to listen on",
+	)
+	flag.BoolVar(
+		&opts.InsecureSelfURLs,
+		"insecure-self-urls",
+		true,
+		"Use http:// (not https://) for URLs pointing back to the server",
+	)
+	flag.Parse()
+
+	ctx := gologger.StdConfig.Use(context.Background())
+	r, err := local.NewRouter(ctx, opts)
+	if err != nil {
+		logging.Errorf(ctx, "%s", err)
+		os.Exit(1)
+	}
+
+	logging.Infof(ctx, "Serving ResultDB on http://%s", *addr)
+	if err := http.ListenAndServe(*addr, r); err != nil {
+		logging.Errorf(ctx, "%s", err)
+		os.Exit(1)
+	}
+}
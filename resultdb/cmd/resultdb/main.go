@@ -18,10 +18,14 @@ import (
 	"flag"
 
 	"go.chromium.org/luci/common/flag/stringmapflag"
+	"go.chromium.org/luci/config/server/cfgmodule"
 	"go.chromium.org/luci/server"
+	"go.chromium.org/luci/server/module"
 
 	"go.chromium.org/luci/resultdb/internal"
 	"go.chromium.org/luci/resultdb/internal/artifactcontent"
+	// Registers the project config validation rules.
+	_ "go.chromium.org/luci/resultdb/internal/config"
 	"go.chromium.org/luci/resultdb/internal/services/resultdb"
 )
 
@@ -46,7 +50,10 @@ func main() {
 
 	artifactcontent.RegisterRBEInstanceFlag(flag.CommandLine, &opts.ArtifactRBEInstance)
 
-	internal.Main(func(srv *server.Server) error {
+	// LUCI Config validates configs against the main host, which is served
+	// by the frontend.
+	modules := []module.Module{cfgmodule.NewModuleFromFlags()}
+	internal.MainWithModules(modules, func(srv *server.Server) error {
 		return resultdb.InitServer(srv, opts)
 	})
 }
@@ -35,12 +35,9 @@ import (
 	"go.chromium.org/luci/grpc/grpcutil"
 	"go.chromium.org/luci/server/auth"
 	"go.chromium.org/luci/server/router"
-	"go.chromium.org/luci/server/span"
 	"go.chromium.org/luci/server/tokens"
 
-	"go.chromium.org/luci/resultdb/internal/artifacts"
-	"go.chromium.org/luci/resultdb/internal/invocations"
-	"go.chromium.org/luci/resultdb/internal/spanutil"
+	"go.chromium.org/luci/resultdb/internal/storage"
 	"go.chromium.org/luci/resultdb/pbutil"
 )
 
@@ -73,6 +70,9 @@ type Server struct {
 	// e.g. "projects/luci-resultdb/instances/artifacts".
 	RBECASInstanceName string
 
+	// Reads the artifacts.
+	Store storage.Artifacts
+
 	// used for isolate client
 	anonClient, authClient *http.Client
 
@@ -151,10 +151,6 @@ type contentRequest struct {
 
 	artifactName string
 
-	invID      invocations.ID
-	parentID   string
-	artifactID string
-
 	contentType spanner.NullString
 	size        spanner.NullInt64
 }
@@ -172,34 +168,26 @@ func (r *contentRequest) handle(c *router.Context) {
 		return
 	}
 
-	// Read the state from database.
-	var isolateURL spanner.NullString
-	var rbeCASHash spanner.NullString
-	key := r.invID.Key(r.parentID, r.artifactID)
-	err := spanutil.ReadRow(span.Single(c.Context), "Artifacts", key, map[string]interface{}{
-		"ContentType": &r.contentType,
-		"Size":        &r.size,
-		"IsolateURL":  &isolateURL,
-		"RBECASHash":  &rbeCASHash,
-	})
+	// Read the state from the store.
+	content, err := r.Store.ReadArtifactContent(c.Context, r.artifactName)
+	if err == nil {
+		r.contentType = content.ContentType
+		r.size = content.Size
+	}
 
 	// Check the error and write content to the response body.
 	switch {
-	case spanner.ErrCode(err) == codes.NotFound:
-		err = appstatus.Attachf(err, codes.NotFound, "%s not found", r.artifactName)
-		r.sendError(c.Context, err)
-
 	case err != nil:
 		r.sendError(c.Context, err)
 
-	case rbeCASHash.Valid:
-		r.handleRBECASContent(c, rbeCASHash.StringVal)
+	case content.RBECASHash.Valid:
+		r.handleRBECASContent(c, content.RBECASHash.StringVal)
 
-	case isolateURL.Valid:
-		r.handleIsolateContent(c.Context, isolateURL.StringVal)
+	case content.IsolateURL.Valid:
+		r.handleIsolateContent(c.Context, content.IsolateURL.StringVal)
 
 	default:
-		r.sendError(c.Context, errors.Reason("neither RBECASHash nor IsolateURL is initialized in %q", key).Err())
+		r.sendError(c.Context, errors.Reason("neither RBECASHash nor IsolateURL is initialized in %q", r.artifactName).Err())
 	}
 }
 
@@ -208,13 +196,9 @@ func (r *contentRequest) parseRequest(ctx context.Context, req *http.Request) er
 	// of test IDs.
 	r.artifactName = strings.Trim(req.URL.EscapedPath(), "/")
 
-	invID, testID, resultID, artifactID, err := pbutil.ParseArtifactName(r.artifactName)
-	if err != nil {
+	if err := pbutil.ValidateArtifactName(r.artifactName); err != nil {
 		return errors.Annotate(err, "invalid artifact name %q", r.artifactName).Err()
 	}
-	r.invID = invocations.ID(invID)
-	r.parentID = artifacts.ParentID(testID, resultID)
-	r.artifactID = artifactID
 	return nil
 }
 
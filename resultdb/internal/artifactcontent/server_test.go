@@ -37,6 +37,7 @@ import (
 	"go.chromium.org/luci/server/router"
 	"go.chromium.org/luci/server/secrets/testsecrets"
 
+	"go.chromium.org/luci/resultdb/internal/storage"
 	"go.chromium.org/luci/resultdb/internal/testutil"
 	"go.chromium.org/luci/resultdb/internal/testutil/insert"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
@@ -120,6 +121,7 @@ func TestServeContent(t *testing.T) {
 				return "example.com"
 			},
 			RBECASInstanceName: "projects/example/instances/artifacts",
+			Store:              storage.Spanner{},
 			ReadCASBlob: func(ctx context.Context, req *bytestream.ReadRequest) (bytestream.ByteStream_ReadClient, error) {
 				return casReader, casReadErr
 			},
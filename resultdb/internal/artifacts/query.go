@@ -127,7 +127,7 @@ func (q *Query) Fetch(ctx context.Context) (arts []*pb.Artifact, nextPageToken s
 		return
 	}
 
-	st.Params["ParentIdRegexp"] = q.parentIDRegexp()
+	st.Params["ParentIdRegexp"] = q.EffectiveParentIDRegexp()
 
 	// TODO(cbrug.com/1090197): remove these default values by refactoring artifacts/query.go.
 	st.Params["variantHashEquals"] = spanner.NullString{}
@@ -177,9 +177,10 @@ func (q *Query) Fetch(ctx context.Context) (arts []*pb.Artifact, nextPageToken s
 	return
 }
 
-// parentIDRegexp returns a regular expression for ParentId column.
-// Uses q.FollowEdges and q.TestResultPredicate.TestIdRegexp to compute it.
-func (q *Query) parentIDRegexp() string {
+// EffectiveParentIDRegexp returns a regular expression for ParentId column.
+// Uses q.FollowEdges and q.TestResultPredicate.TestIdRegexp to compute it,
+// unless q.ParentIDRegexp is specified.
+func (q *Query) EffectiveParentIDRegexp() string {
 	// If it is explicitly specified, use it.
 	if q.ParentIDRegexp != "" {
 		if q.TestResultPredicate != nil || q.FollowEdges != nil {
@@ -0,0 +1,193 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package config implements loading and validation of ResultDB project
+// configs.
+package config
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/proto"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/config"
+	"go.chromium.org/luci/config/cfgclient"
+	"go.chromium.org/luci/config/validation"
+	"go.chromium.org/luci/server/caching"
+
+	configpb "go.chromium.org/luci/resultdb/proto/config"
+)
+
+// projectConfigFile is the path of the ResultDB config in a project config set.
+const projectConfigFile = "${appid}.cfg"
+
+// MaxKnownFlakyInvocations is the maximum value of
+// KnownFlaky.max_invocations, as well as its default.
+const MaxKnownFlakyInvocations = 1000
+
+var ruleNameRe = regexp.MustCompile(`^[a-z0-9\-_]{1,64}$`)
+
+// projectCache caches project configs in the process memory.
+var projectCache = caching.RegisterLRUCache(256)
+
+// projectCacheExpiration is how long a project config is cached for.
+const projectCacheExpiration = 5 * time.Minute
+
+func init() {
+	validation.Rules.Add("regex:projects/.*", projectConfigFile, validateProjectConfigFile)
+}
+
+// Project returns the ResultDB config of the LUCI project.
+// If the project does not have one, or the LUCI Config client is not
+// configured, returns an empty config.
+//
+// The config is cached in the process memory for a few minutes.
+func Project(ctx context.Context, project string) (*configpb.ProjectConfig, error) {
+	lru := projectCache.LRU(ctx)
+	if lru == nil {
+		// The process cache is not installed, e.g. in tests.
+		return fetchProject(ctx, project)
+	}
+	v, err := lru.GetOrCreate(ctx, project, func() (interface{}, time.Duration, error) {
+		cfg, err := fetchProject(ctx, project)
+		return cfg, projectCacheExpiration, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*configpb.ProjectConfig), nil
+}
+
+// fetchProject fetches the ResultDB config of the project from LUCI Config.
+//
+// Fetch errors are transient, but parse errors are not: retrying does not fix
+// a malformed config.
+func fetchProject(ctx context.Context, project string) (*configpb.ProjectConfig, error) {
+	cfg := &configpb.ProjectConfig{}
+	var content string
+	switch err := cfgclient.Get(ctx, config.ProjectSet(project), projectConfigFile, cfgclient.String(&content), nil); {
+	case err == config.ErrNoConfig:
+		return cfg, nil
+	case err == cfgclient.ErrNotConfigured:
+		// The server does not use a LUCI Config service, e.g. it runs locally.
+		// Treat it as if no project has a config.
+		logging.Debugf(ctx, "LUCI Config is not configured; using an empty config of project %q", project)
+		return cfg, nil
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to fetch the config of project %q", project).Tag(transient.Tag).Err()
+	}
+
+	if err := proto.UnmarshalTextML(content, cfg); err != nil {
+		return nil, errors.Annotate(err, "failed to parse the config of project %q", project).Err()
+	}
+	return cfg, nil
+}
+
+// validateProjectConfigFile validates the contents of a ResultDB project
+// config file.
+func validateProjectConfigFile(ctx *validation.Context, configSet, path string, content []byte) error {
+	cfg := &configpb.ProjectConfig{}
+	if err := proto.UnmarshalTextML(string(content), cfg); err != nil {
+		ctx.Error(err)
+		return nil
+	}
+	ValidateProjectConfig(ctx, cfg)
+	return nil
+}
+
+// ValidateProjectConfig validates a ResultDB project config.
+// Errors are reported to ctx.
+func ValidateProjectConfig(ctx *validation.Context, cfg *configpb.ProjectConfig) {
+	names := make(map[string]struct{}, len(cfg.ExonerationRules))
+	for i, r := range cfg.ExonerationRules {
+		ctx.Enter("exoneration_rules #%d (%s)", i, r.Name)
+		if _, dup := names[r.Name]; dup {
+			ctx.Errorf("duplicate rule name")
+		}
+		names[r.Name] = struct{}{}
+		validateExonerationRule(ctx, r)
+		ctx.Exit()
+	}
+}
+
+// ValidateExonerationRule returns an error if the rule is invalid.
+func ValidateExonerationRule(ctx context.Context, r *configpb.ExonerationRule) error {
+	vctx := &validation.Context{Context: ctx}
+	validateExonerationRule(vctx, r)
+	return vctx.Finalize()
+}
+
+func validateExonerationRule(ctx *validation.Context, r *configpb.ExonerationRule) {
+	if !ruleNameRe.MatchString(r.Name) {
+		ctx.Errorf("name: does not match %s", ruleNameRe)
+	}
+	if _, err := CompileTestIDRegexp(r.TestIdRegexp); err != nil {
+		ctx.Errorf("test_id_regexp: %s", err)
+	}
+
+	switch c := r.Condition.(type) {
+	case *configpb.ExonerationRule_KnownFlaky:
+		ctx.Enter("known_flaky")
+		validateKnownFlaky(ctx, c.KnownFlaky)
+		ctx.Exit()
+
+	case *configpb.ExonerationRule_FailsOnBase:
+		if c.FailsOnBase.GetInvocationTagKey() == "" {
+			ctx.Errorf("fails_on_base: invocation_tag_key: unspecified")
+		}
+
+	case *configpb.ExonerationRule_TrackedBug:
+		if c.TrackedBug.GetBug() == "" {
+			ctx.Errorf("tracked_bug: bug: unspecified")
+		}
+		if r.TestIdRegexp == "" {
+			ctx.Errorf("tracked_bug: test_id_regexp is required")
+		}
+
+	default:
+		ctx.Errorf("condition: unspecified")
+	}
+}
+
+func validateKnownFlaky(ctx *validation.Context, kf *configpb.KnownFlaky) {
+	if kf.MinFlakeRate <= 0 || kf.MinFlakeRate > 1 {
+		ctx.Errorf("min_flake_rate: must be in (0, 1]")
+	}
+	if kf.Window != nil {
+		switch d, err := ptypes.Duration(kf.Window); {
+		case err != nil:
+			ctx.Errorf("window: %s", err)
+		case d <= 0:
+			ctx.Errorf("window: must be positive")
+		}
+	}
+	if kf.MaxInvocations < 0 || kf.MaxInvocations > MaxKnownFlakyInvocations {
+		ctx.Errorf("max_invocations: must be in [0, %d]", MaxKnownFlakyInvocations)
+	}
+}
+
+// CompileTestIDRegexp compiles ExonerationRule.test_id_regexp.
+// An empty expression matches all test ids.
+func CompileTestIDRegexp(re string) (*regexp.Regexp, error) {
+	if re == "" {
+		re = ".*"
+	}
+	return regexp.Compile("^(?:" + re + ")$")
+}
@@ -0,0 +1,160 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/config"
+	"go.chromium.org/luci/config/cfgclient"
+	"go.chromium.org/luci/config/impl/erroring"
+	"go.chromium.org/luci/config/impl/memory"
+	"go.chromium.org/luci/config/validation"
+
+	configpb "go.chromium.org/luci/resultdb/proto/config"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestValidateProjectConfig(t *testing.T) {
+	t.Parallel()
+
+	Convey(`ValidateProjectConfig`, t, func() {
+		cfg := &configpb.ProjectConfig{
+			ExonerationRules: []*configpb.ExonerationRule{
+				{
+					Name: "known-flaky",
+					Condition: &configpb.ExonerationRule_KnownFlaky{KnownFlaky: &configpb.KnownFlaky{
+						MinFlakeRate: 0.1,
+						Window:       ptypes.DurationProto(24 * time.Hour),
+					}},
+				},
+				{
+					Name: "fails-on-base",
+					Condition: &configpb.ExonerationRule_FailsOnBase{FailsOnBase: &configpb.FailsOnBase{
+						InvocationTagKey: "base_invocation",
+					}},
+				},
+				{
+					Name:         "crbug-123",
+					TestIdRegexp: "ninja://foo/.+",
+					Condition: &configpb.ExonerationRule_TrackedBug{TrackedBug: &configpb.TrackedBug{
+						Bug: "crbug.com/123",
+					}},
+				},
+			},
+		}
+		validate := func() error {
+			ctx := &validation.Context{Context: context.Background()}
+			ValidateProjectConfig(ctx, cfg)
+			return ctx.Finalize()
+		}
+
+		Convey(`Valid`, func() {
+			So(validate(), ShouldBeNil)
+		})
+
+		Convey(`Invalid name`, func() {
+			cfg.ExonerationRules[0].Name = "Known flaky"
+			So(validate(), ShouldErrLike, "name: does not match")
+		})
+
+		Convey(`Duplicate name`, func() {
+			cfg.ExonerationRules[1].Name = "known-flaky"
+			So(validate(), ShouldErrLike, "duplicate rule name")
+		})
+
+		Convey(`Invalid test id regexp`, func() {
+			cfg.ExonerationRules[0].TestIdRegexp = "("
+			So(validate(), ShouldErrLike, "test_id_regexp")
+		})
+
+		Convey(`No condition`, func() {
+			cfg.ExonerationRules[0].Condition = nil
+			So(validate(), ShouldErrLike, "condition: unspecified")
+		})
+
+		Convey(`Invalid min flake rate`, func() {
+			cfg.ExonerationRules[0].GetKnownFlaky().MinFlakeRate = 0
+			So(validate(), ShouldErrLike, "min_flake_rate: must be in (0, 1]")
+		})
+
+		Convey(`Too many invocations`, func() {
+			cfg.ExonerationRules[0].GetKnownFlaky().MaxInvocations = 1001
+			So(validate(), ShouldErrLike, "max_invocations: must be in [0, 1000]")
+		})
+
+		Convey(`Tracked bug without test id regexp`, func() {
+			cfg.ExonerationRules[2].TestIdRegexp = ""
+			So(validate(), ShouldErrLike, "tracked_bug: test_id_regexp is required")
+		})
+	})
+
+	Convey(`validateProjectConfigFile`, t, func() {
+		ctx := &validation.Context{Context: context.Background()}
+		content := `
+			exoneration_rules {
+				name: "crbug-123"
+				test_id_regexp: "ninja://foo/.+"
+				tracked_bug { bug: "crbug.com/123" }
+			}
+		`
+		So(validateProjectConfigFile(ctx, "projects/x", "resultdb.cfg", []byte(content)), ShouldBeNil)
+		So(ctx.Finalize(), ShouldBeNil)
+	})
+}
+
+func TestProject(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Project`, t, func() {
+		ctx := context.Background()
+
+		Convey(`LUCI Config is not configured`, func() {
+			client, err := cfgclient.New(cfgclient.Options{})
+			So(err, ShouldBeNil)
+			ctx = cfgclient.Use(ctx, client)
+
+			cfg, err := Project(ctx, "chromium")
+			So(err, ShouldBeNil)
+			So(cfg, ShouldResembleProto, &configpb.ProjectConfig{})
+		})
+
+		Convey(`Parse failure is not transient`, func() {
+			ctx = cfgclient.Use(ctx, memory.New(map[config.Set]memory.Files{
+				"projects/chromium": {projectConfigFile: "exoneration_rules { bad"},
+			}))
+
+			_, err := Project(ctx, "chromium")
+			So(err, ShouldErrLike, "failed to parse")
+			So(transient.Tag.In(err), ShouldBeFalse)
+		})
+
+		Convey(`Fetch failure is transient`, func() {
+			ctx = cfgclient.Use(ctx, erroring.New(errors.New("boom")))
+
+			_, err := Project(ctx, "chromium")
+			So(err, ShouldErrLike, "boom")
+			So(transient.Tag.In(err), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,182 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flakiness computes the flakiness of test variants from their results
+// over a history of invocations.
+package flakiness
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/resultdb/internal/invocations"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// Invocation is an invocation whose test results are analyzed.
+type Invocation struct {
+	ID         invocations.ID
+	CreateTime time.Time
+
+	// OrdinalDomain and Ordinal identify the commit that the invocation ran
+	// against, if known. See HistoryOptions.commit.
+	OrdinalDomain string
+	Ordinal       int64
+}
+
+// outcome is a bit set of the kinds of results that a test variant had in an
+// invocation.
+type outcome uint8
+
+const (
+	outcomeExpected outcome = 1 << iota
+	outcomeUnexpected
+
+	outcomeFlaky = outcomeExpected | outcomeUnexpected
+)
+
+type testVariantKey struct {
+	testID      string
+	variantHash string
+}
+
+// testVariantHistory is the history of a test variant.
+type testVariantHistory struct {
+	variant *pb.Variant
+	// outcomes maps invocation indexes to the outcome of the test variant in
+	// the invocation.
+	outcomes map[int]outcome
+}
+
+// Analyzer computes the flakiness of test variants from their results in a
+// set of invocations.
+//
+// It is safe for concurrent use.
+type Analyzer struct {
+	mu    sync.Mutex
+	invs  []Invocation
+	tests map[testVariantKey]*testVariantHistory
+}
+
+// AddInvocation adds an invocation to analyze, and returns its index to use
+// with AddResult.
+func (a *Analyzer) AddInvocation(inv Invocation) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.invs = append(a.invs, inv)
+	return len(a.invs) - 1
+}
+
+// AddResult adds a test result that belongs to the invocation with index i.
+//
+// Test results of invocations included by the analyzed invocation should be
+// added to it. Skipped test results are ignored.
+func (a *Analyzer) AddResult(i int, tr *pb.TestResult) {
+	if tr.Status == pb.TestStatus_SKIP {
+		return
+	}
+	o := outcomeUnexpected
+	if tr.Expected {
+		o = outcomeExpected
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.tests == nil {
+		a.tests = make(map[testVariantKey]*testVariantHistory)
+	}
+	key := testVariantKey{testID: tr.TestId, variantHash: tr.VariantHash}
+	h := a.tests[key]
+	if h == nil {
+		h = &testVariantHistory{variant: tr.Variant, outcomes: make(map[int]outcome)}
+		a.tests[key] = h
+	}
+	h.outcomes[i] |= o
+}
+
+// Flakiness returns the flakiness of each test variant that has results,
+// ordered by decreasing flake rate, then by test id and variant hash.
+func (a *Analyzer) Flakiness() []*pb.TestFlakiness {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ret := make([]*pb.TestFlakiness, 0, len(a.tests))
+	for key, h := range a.tests {
+		f := a.flakiness(h)
+		f.TestId = key.testID
+		f.VariantHash = key.variantHash
+		ret = append(ret, f)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		switch {
+		case ret[i].FlakeRate != ret[j].FlakeRate:
+			return ret[i].FlakeRate > ret[j].FlakeRate
+		case ret[i].TestId != ret[j].TestId:
+			return ret[i].TestId < ret[j].TestId
+		default:
+			return ret[i].VariantHash < ret[j].VariantHash
+		}
+	})
+	return ret
+}
+
+func (a *Analyzer) flakiness(h *testVariantHistory) *pb.TestFlakiness {
+	// Order the invocations by commit if all of them have one in the same
+	// domain, or by creation time otherwise.
+	idxs := make([]int, 0, len(h.outcomes))
+	byCommit := true
+	for i := range h.outcomes {
+		idxs = append(idxs, i)
+		if d := a.invs[i].OrdinalDomain; d == "" || d != a.invs[idxs[0]].OrdinalDomain {
+			byCommit = false
+		}
+	}
+	sort.Slice(idxs, func(i, j int) bool {
+		x, y := a.invs[idxs[i]], a.invs[idxs[j]]
+		if byCommit && x.Ordinal != y.Ordinal {
+			return x.Ordinal < y.Ordinal
+		}
+		if !x.CreateTime.Equal(y.CreateTime) {
+			return x.CreateTime.Before(y.CreateTime)
+		}
+		return x.ID < y.ID
+	})
+
+	f := &pb.TestFlakiness{
+		Variant:         h.variant,
+		InvocationCount: int32(len(idxs)),
+	}
+	var prev outcome
+	for _, i := range idxs {
+		switch o := h.outcomes[i]; {
+		case o == outcomeFlaky:
+			f.FlakyInvocationCount++
+		case prev != 0 && o != prev:
+			f.FlipCount++
+			prev = o
+		default:
+			prev = o
+		}
+	}
+
+	// An invocation may be flaky, and a pair of consecutive invocations may
+	// flip.
+	if opportunities := 2*len(idxs) - 1; opportunities > 0 {
+		f.FlakeRate = float32(f.FlakyInvocationCount+f.FlipCount) / float32(opportunities)
+	}
+	return f
+}
@@ -0,0 +1,126 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flakiness
+
+import (
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestAnalyzer(t *testing.T) {
+	Convey(`Analyzer`, t, func() {
+		a := &Analyzer{}
+		t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		variant := pbutil.Variant("k", "v")
+		variantHash := pbutil.VariantHash(variant)
+
+		// addInvs adds an invocation per element of outcomes, in the given order,
+		// each with one result per character: 'P' for expected, 'F' for unexpected
+		// and 'S' for skipped.
+		addInvs := func(testID string, outcomes ...string) {
+			for i, o := range outcomes {
+				idx := a.AddInvocation(Invocation{
+					ID:         "inv",
+					CreateTime: t0.Add(time.Duration(i) * time.Hour),
+				})
+				for _, c := range o {
+					tr := &pb.TestResult{
+						TestId:      testID,
+						Variant:     variant,
+						VariantHash: variantHash,
+						Status:      pb.TestStatus_PASS,
+						Expected:    c == 'P',
+					}
+					switch c {
+					case 'F':
+						tr.Status = pb.TestStatus_FAIL
+					case 'S':
+						tr.Status = pb.TestStatus_SKIP
+					}
+					a.AddResult(idx, tr)
+				}
+			}
+		}
+
+		Convey(`Stable`, func() {
+			addInvs("test", "P", "P", "PP")
+			So(a.Flakiness(), ShouldResembleProto, []*pb.TestFlakiness{{
+				TestId:          "test",
+				Variant:         variant,
+				VariantHash:     variantHash,
+				InvocationCount: 3,
+			}})
+		})
+
+		Convey(`Flaky invocations`, func() {
+			addInvs("test", "P", "FP", "F")
+			f := a.Flakiness()
+			So(f, ShouldHaveLength, 1)
+			So(f[0].InvocationCount, ShouldEqual, 3)
+			So(f[0].FlakyInvocationCount, ShouldEqual, 1)
+			So(f[0].FlipCount, ShouldEqual, 1)
+			So(f[0].FlakeRate, ShouldEqual, float32(2.0/5.0))
+		})
+
+		Convey(`Flips`, func() {
+			addInvs("test", "P", "F", "P", "F")
+			f := a.Flakiness()
+			So(f[0].FlipCount, ShouldEqual, 3)
+			So(f[0].FlakeRate, ShouldEqual, float32(3.0/7.0))
+		})
+
+		Convey(`Skipped results are ignored`, func() {
+			addInvs("test", "P", "S", "PS")
+			f := a.Flakiness()
+			So(f[0].InvocationCount, ShouldEqual, 2)
+			So(f[0].FlakeRate, ShouldEqual, 0)
+		})
+
+		Convey(`Ordered by commit`, func() {
+			// By creation time, the verdicts are P, F, P.
+			// By commit, they are P, P, F.
+			for i, c := range []struct {
+				ordinal  int64
+				expected bool
+			}{{1, true}, {3, false}, {2, true}} {
+				idx := a.AddInvocation(Invocation{
+					ID:            "inv",
+					CreateTime:    t0.Add(time.Duration(i) * time.Hour),
+					OrdinalDomain: "gitiles://host/project/refs/heads/main",
+					Ordinal:       c.ordinal,
+				})
+				a.AddResult(idx, &pb.TestResult{TestId: "test", Status: pb.TestStatus_PASS, Expected: c.expected})
+			}
+			So(a.Flakiness()[0].FlipCount, ShouldEqual, 1)
+		})
+
+		Convey(`Sorted`, func() {
+			addInvs("b", "P", "FP")
+			addInvs("c", "P", "P")
+			addInvs("a", "P", "FP")
+			f := a.Flakiness()
+			So(f, ShouldHaveLength, 3)
+			So(f[0].TestId, ShouldEqual, "a")
+			So(f[1].TestId, ShouldEqual, "b")
+			So(f[2].TestId, ShouldEqual, "c")
+		})
+	})
+}
@@ -0,0 +1,165 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flakiness
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/proto/mask"
+	"go.chromium.org/luci/common/sync/parallel"
+	"go.chromium.org/luci/common/trace"
+
+	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/spanutil"
+	"go.chromium.org/luci/resultdb/internal/testresults"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// resultMask is the mask of test result fields needed for the analysis.
+var resultMask = mask.MustFromReadMask(&pb.TestResult{},
+	"test_id",
+	"variant",
+	"variant_hash",
+	"expected",
+	"status",
+)
+
+const (
+	// DefaultWindow is how far back to look in the history of a realm by
+	// default.
+	DefaultWindow = 7 * 24 * time.Hour
+
+	// DefaultMaxInvocations is the maximum number of the most recent invocations
+	// to analyze in a realm by default.
+	DefaultMaxInvocations = 1000
+)
+
+// Query specifies the history to analyze.
+type Query struct {
+	// Realm is the realm of the invocations to analyze.
+	Realm string
+	// Earliest and Latest bound the history time of the invocations to
+	// analyze. Earliest is inclusive and Latest is exclusive.
+	Earliest time.Time
+	Latest   time.Time
+	// Predicate filters the test results to analyze.
+	// Its Expectancy must be ALL.
+	Predicate *pb.TestResultPredicate
+	// MaxInvocations is the maximum number of the most recent invocations to
+	// analyze. Must be positive.
+	MaxInvocations int
+}
+
+// Run computes the flakiness of the test variants in the history specified
+// by the query.
+//
+// Must be called in a read-only transaction.
+func (q *Query) Run(ctx context.Context) (ret []*pb.TestFlakiness, err error) {
+	ctx, ts := trace.StartSpan(ctx, "flakiness.Query.Run")
+	defer func() { ts.End(err) }()
+
+	if q.MaxInvocations <= 0 {
+		panic("MaxInvocations <= 0")
+	}
+
+	invs, err := q.fetchInvocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ts.Attribute("cr.dev/invocations", len(invs))
+
+	a := &Analyzer{}
+	err = parallel.WorkPool(16, func(work chan<- func() error) {
+		for _, inv := range invs {
+			inv := inv
+			i := a.AddInvocation(inv)
+			work <- func() error {
+				return q.fetchResults(ctx, inv.ID, func(tr *pb.TestResult) error {
+					a.AddResult(i, tr)
+					return nil
+				})
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a.Flakiness(), nil
+}
+
+// fetchInvocations returns the most recent finalized invocations in the realm
+// and the time range.
+func (q *Query) fetchInvocations(ctx context.Context) ([]Invocation, error) {
+	st := spanner.NewStatement(`
+		SELECT InvocationId, CreateTime, OrdinalDomain, Ordinal
+		FROM Invocations@{FORCE_INDEX=InvocationsByTimestamp}
+		WHERE Realm = @realm
+			AND HistoryTime >= @earliest
+			AND HistoryTime < @latest
+			AND State = @finalized
+		ORDER BY HistoryTime DESC
+		LIMIT @limit
+	`)
+	st.Params = spanutil.ToSpannerMap(map[string]interface{}{
+		"realm":     q.Realm,
+		"earliest":  q.Earliest,
+		"latest":    q.Latest,
+		"finalized": pb.Invocation_FINALIZED,
+		"limit":     q.MaxInvocations,
+	})
+
+	var ret []Invocation
+	var b spanutil.Buffer
+	err := spanutil.Query(ctx, st, func(row *spanner.Row) error {
+		var inv Invocation
+		var domain spanner.NullString
+		var ordinal spanner.NullInt64
+		if err := b.FromSpanner(row, &inv.ID, &inv.CreateTime, &domain, &ordinal); err != nil {
+			return err
+		}
+		if domain.Valid && ordinal.Valid {
+			inv.OrdinalDomain = domain.StringVal
+			inv.Ordinal = ordinal.Int64
+		}
+		ret = append(ret, inv)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch invocations").Err()
+	}
+	return ret, nil
+}
+
+// fetchResults calls f for each test result in the invocation, including
+// results of included invocations.
+func (q *Query) fetchResults(ctx context.Context, id invocations.ID, f func(*pb.TestResult) error) error {
+	invIDs, err := invocations.Reachable(ctx, invocations.NewIDSet(id))
+	if err != nil {
+		return err
+	}
+	tq := &testresults.Query{
+		InvocationIDs: invIDs,
+		Predicate:     q.Predicate,
+		Mask:          resultMask,
+	}
+	if err := tq.Run(ctx, f); err != nil {
+		return errors.Annotate(err, "failed to fetch test results of %s", id.Name()).Err()
+	}
+	return nil
+}
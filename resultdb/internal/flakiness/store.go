@@ -0,0 +1,252 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flakiness
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/trace"
+	"go.chromium.org/luci/server/span"
+
+	"go.chromium.org/luci/resultdb/internal/spanutil"
+	"go.chromium.org/luci/resultdb/internal/testresults"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// writeBatchSize is the maximum number of rows to write or delete in one
+// Spanner commit.
+// Cloud Spanner limitation is 20k mutations per commit, where an insertion
+// counts as one mutation per column.
+const writeBatchSize = 1000
+
+// Write replaces the stored flakiness of test variants in the realm with fs,
+// computed at computeTime. Test variants without flakiness are not stored.
+//
+// Rows are written in batches, so readers may see a mix of the previous and the
+// new flakiness of the realm while Write runs.
+//
+// Must not be called in a transaction.
+func Write(ctx context.Context, realm string, computeTime time.Time, fs []*pb.TestFlakiness) (err error) {
+	ctx, ts := trace.StartSpan(ctx, "flakiness.Write")
+	defer func() { ts.End(err) }()
+
+	b := &batch{ctx: ctx}
+	for _, f := range fs {
+		if f.FlakeRate <= 0 {
+			continue
+		}
+		err := b.add(spanutil.InsertOrUpdateMap("TestFlakiness", map[string]interface{}{
+			"Realm":                realm,
+			"TestId":               f.TestId,
+			"VariantHash":          f.VariantHash,
+			"Variant":              f.Variant,
+			"FlakeRate":            float64(f.FlakeRate),
+			"InvocationCount":      int64(f.InvocationCount),
+			"FlakyInvocationCount": int64(f.FlakyInvocationCount),
+			"FlipCount":            int64(f.FlipCount),
+			"ComputeTime":          computeTime,
+		}))
+		if err != nil {
+			return errors.Annotate(err, "failed to write flakiness").Err()
+		}
+	}
+	if err := b.flush(); err != nil {
+		return errors.Annotate(err, "failed to write flakiness").Err()
+	}
+
+	// Delete the test variants that are not flaky anymore.
+	st := spanner.NewStatement(`
+		SELECT Realm, TestId, VariantHash
+		FROM TestFlakiness
+		WHERE Realm = @realm AND ComputeTime < @computeTime
+	`)
+	st.Params = spanutil.ToSpannerMap(map[string]interface{}{
+		"realm":       realm,
+		"computeTime": computeTime,
+	})
+	return deleteRows(ctx, st)
+}
+
+// DeleteInactive deletes the stored flakiness of the realms not in
+// activeRealms that was computed before computeTime.
+//
+// Write replaces the flakiness only in the realms it is called for, so the
+// flakiness of a realm without recent invocations must be deleted separately.
+//
+// Must not be called in a transaction.
+func DeleteInactive(ctx context.Context, activeRealms []string, computeTime time.Time) (err error) {
+	ctx, ts := trace.StartSpan(ctx, "flakiness.DeleteInactive")
+	defer func() { ts.End(err) }()
+
+	st := spanner.NewStatement(`
+		SELECT Realm, TestId, VariantHash
+		FROM TestFlakiness
+		WHERE Realm NOT IN UNNEST(@activeRealms) AND ComputeTime < @computeTime
+	`)
+	st.Params = spanutil.ToSpannerMap(map[string]interface{}{
+		"activeRealms": activeRealms,
+		"computeTime":  computeTime,
+	})
+	return deleteRows(ctx, st)
+}
+
+// deleteRows deletes the TestFlakiness rows whose keys are returned by st,
+// in batches.
+func deleteRows(ctx context.Context, st spanner.Statement) error {
+	b := &batch{ctx: ctx}
+	err := spanutil.Query(span.Single(ctx), st, func(row *spanner.Row) error {
+		var realm, testID, variantHash string
+		if err := spanutil.FromSpanner(row, &realm, &testID, &variantHash); err != nil {
+			return err
+		}
+		return b.add(spanner.Delete("TestFlakiness", spanner.Key{realm, testID, variantHash}))
+	})
+	if err == nil {
+		err = b.flush()
+	}
+	if err != nil {
+		return errors.Annotate(err, "failed to delete stale flakiness").Err()
+	}
+	return nil
+}
+
+// batch applies mutations in batches of writeBatchSize.
+type batch struct {
+	ctx context.Context
+	ms  []*spanner.Mutation
+}
+
+// add buffers the mutation, and applies the buffered ones if there are enough.
+func (b *batch) add(m *spanner.Mutation) error {
+	b.ms = append(b.ms, m)
+	if len(b.ms) < writeBatchSize {
+		return nil
+	}
+	return b.flush()
+}
+
+// flush applies the buffered mutations.
+func (b *batch) flush() error {
+	if len(b.ms) == 0 {
+		return nil
+	}
+	if _, err := span.Apply(b.ctx, b.ms); err != nil {
+		return err
+	}
+	b.ms = b.ms[:0]
+	return nil
+}
+
+// StoredQuery specifies the stored flakiness to read.
+// See also Write.
+type StoredQuery struct {
+	// Realm is the realm of the analyzed invocations.
+	Realm string
+	// Predicate filters the test variants by test id and variant.
+	Predicate *pb.TestResultPredicate
+	// MinFlakeRate is the minimum flake rate of the test variants to read.
+	MinFlakeRate float32
+	// Limit is the maximum number of test variants to read. Must be positive.
+	Limit int
+}
+
+// Fetch returns the stored flakiness of the test variants matching the query,
+// ordered by decreasing flake rate, then by test id and variant hash.
+func (q *StoredQuery) Fetch(ctx context.Context) (ret []*pb.TestFlakiness, err error) {
+	ctx, ts := trace.StartSpan(ctx, "flakiness.StoredQuery.Fetch")
+	defer func() { ts.End(err) }()
+
+	if q.Limit <= 0 {
+		panic("Limit <= 0")
+	}
+
+	params := map[string]interface{}{
+		"realm":        q.Realm,
+		"minFlakeRate": float64(q.MinFlakeRate),
+		"limit":        q.Limit,
+	}
+	if re := q.Predicate.GetTestIdRegexp(); re != "" && re != ".*" {
+		params["testIdRegexp"] = fmt.Sprintf("^%s$", re)
+	}
+	testresults.PopulateVariantParams(params, q.Predicate.GetVariant())
+
+	var sql bytes.Buffer
+	if err := storedQueryTmpl.Execute(&sql, params); err != nil {
+		return nil, err
+	}
+	st := spanner.NewStatement(sql.String())
+	st.Params = spanutil.ToSpannerMap(params)
+
+	var b spanutil.Buffer
+	err = spanutil.Query(ctx, st, func(row *spanner.Row) error {
+		f := &pb.TestFlakiness{}
+		var flakeRate float64
+		var invCount, flakyInvCount, flipCount int64
+		err := b.FromSpanner(row,
+			&f.TestId,
+			&f.VariantHash,
+			&f.Variant,
+			&flakeRate,
+			&invCount,
+			&flakyInvCount,
+			&flipCount,
+		)
+		if err != nil {
+			return err
+		}
+		f.FlakeRate = float32(flakeRate)
+		f.InvocationCount = int32(invCount)
+		f.FlakyInvocationCount = int32(flakyInvCount)
+		f.FlipCount = int32(flipCount)
+		ret = append(ret, f)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read flakiness").Err()
+	}
+	return ret, nil
+}
+
+var storedQueryTmpl = template.Must(template.New("").Parse(`
+	SELECT
+		TestId,
+		VariantHash,
+		Variant,
+		FlakeRate,
+		InvocationCount,
+		FlakyInvocationCount,
+		FlipCount
+	FROM TestFlakiness
+	WHERE Realm = @realm
+		AND FlakeRate >= @minFlakeRate
+		{{if .testIdRegexp}}
+			AND REGEXP_CONTAINS(TestId, @testIdRegexp)
+		{{end}}
+		{{if .variantHashEquals}}
+			AND VariantHash = @variantHashEquals
+		{{end}}
+		{{if .variantContains}}
+			AND (SELECT LOGICAL_AND(kv IN UNNEST(Variant)) FROM UNNEST(@variantContains) kv)
+		{{end}}
+	ORDER BY FlakeRate DESC, TestId, VariantHash
+	LIMIT @limit
+`))
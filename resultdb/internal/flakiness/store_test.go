@@ -0,0 +1,97 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flakiness
+
+import (
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/server/span"
+
+	"go.chromium.org/luci/resultdb/internal/testutil"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestStore(t *testing.T) {
+	Convey(`Store`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		flaky := func(testID string, v *pb.Variant, rate float32) *pb.TestFlakiness {
+			return &pb.TestFlakiness{
+				TestId:               testID,
+				Variant:              v,
+				VariantHash:          pbutil.VariantHash(v),
+				FlakeRate:            rate,
+				InvocationCount:      10,
+				FlakyInvocationCount: 2,
+				FlipCount:            1,
+			}
+		}
+		linux := pbutil.Variant("os", "linux")
+		mac := pbutil.Variant("os", "mac")
+		a := flaky("a", linux, 0.5)
+		b := flaky("b", mac, 0.25)
+		c := flaky("c", linux, 0)
+		So(Write(ctx, "testproject:testrealm", now, []*pb.TestFlakiness{a, b, c}), ShouldBeNil)
+		So(Write(ctx, "testproject:otherrealm", now, []*pb.TestFlakiness{b}), ShouldBeNil)
+
+		fetch := func(q *StoredQuery) []*pb.TestFlakiness {
+			q.Realm = "testproject:testrealm"
+			if q.Limit == 0 {
+				q.Limit = 100
+			}
+			fs, err := q.Fetch(span.Single(ctx))
+			So(err, ShouldBeNil)
+			return fs
+		}
+
+		Convey(`Flaky test variants by decreasing flake rate`, func() {
+			So(fetch(&StoredQuery{}), ShouldResembleProto, []*pb.TestFlakiness{a, b})
+		})
+
+		Convey(`Min flake rate`, func() {
+			So(fetch(&StoredQuery{MinFlakeRate: 0.3}), ShouldResembleProto, []*pb.TestFlakiness{a})
+		})
+
+		Convey(`Limit`, func() {
+			So(fetch(&StoredQuery{Limit: 1}), ShouldResembleProto, []*pb.TestFlakiness{a})
+		})
+
+		Convey(`Test id regexp`, func() {
+			q := &StoredQuery{Predicate: &pb.TestResultPredicate{TestIdRegexp: "b"}}
+			So(fetch(q), ShouldResembleProto, []*pb.TestFlakiness{b})
+		})
+
+		Convey(`Variant predicate`, func() {
+			q := &StoredQuery{Predicate: &pb.TestResultPredicate{
+				Variant: &pb.VariantPredicate{
+					Predicate: &pb.VariantPredicate_Contains{Contains: mac},
+				},
+			}}
+			So(fetch(q), ShouldResembleProto, []*pb.TestFlakiness{b})
+		})
+
+		Convey(`Write replaces the previous flakiness`, func() {
+			a.FlakeRate = 0.125
+			So(Write(ctx, "testproject:testrealm", now.Add(time.Hour), []*pb.TestFlakiness{a}), ShouldBeNil)
+			So(fetch(&StoredQuery{}), ShouldResembleProto, []*pb.TestFlakiness{a})
+		})
+	})
+}
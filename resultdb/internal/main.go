@@ -26,6 +26,12 @@ import (
 
 // Main registers all dependencies and runs a service.
 func Main(init func(srv *server.Server) error) {
+	MainWithModules(nil, init)
+}
+
+// MainWithModules is like Main, but also registers extra modules, e.g.
+// the LUCI Config module for services that read project configs.
+func MainWithModules(extra []module.Module, init func(srv *server.Server) error) {
 	modules := []module.Module{
 		limiter.NewModuleFromFlags(),
 		secrets.NewModuleFromFlags(),
@@ -33,5 +39,5 @@ func Main(init func(srv *server.Server) error) {
 		span.NewModuleFromFlags(),
 		tq.NewModuleFromFlags(),
 	}
-	server.Main(nil, modules, init)
+	server.Main(nil, append(modules, extra...), init)
 }
@@ -0,0 +1,172 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memstore
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/grpc/appstatus"
+
+	"go.chromium.org/luci/resultdb/internal/artifacts"
+	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/storage"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// readArtifact returns the artifact or a NotFound error.
+// Must be called with s.mu held.
+func (s *Store) readArtifact(name string) (*artifact, error) {
+	invID, testID, resultID, artifactID := artifacts.MustParseName(name)
+	if inv, ok := s.invs[invID]; ok {
+		if a, ok := inv.artifacts[artifactKey{artifacts.ParentID(testID, resultID), artifactID}]; ok {
+			return a, nil
+		}
+	}
+	return nil, appstatus.Errorf(codes.NotFound, "%s not found", name)
+}
+
+// ReadArtifact implements storage.Store.
+func (s *Store) ReadArtifact(ctx context.Context, name string) (*pb.Artifact, error) {
+	defer s.rlock(ctx)()
+	a, err := s.readArtifact(name)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(a.Artifact).(*pb.Artifact), nil
+}
+
+// ReadArtifactContent implements storage.Store.
+func (s *Store) ReadArtifactContent(ctx context.Context, name string) (*storage.ArtifactContent, error) {
+	defer s.rlock(ctx)()
+	a, err := s.readArtifact(name)
+	if err != nil {
+		return nil, err
+	}
+	return &storage.ArtifactContent{
+		ContentType: spanner.NullString{StringVal: a.ContentType, Valid: true},
+		Size:        spanner.NullInt64{Int64: a.SizeBytes, Valid: true},
+		RBECASHash:  spanner.NullString{StringVal: a.rbeCASHash, Valid: true},
+	}, nil
+}
+
+// QueryArtifacts implements storage.Store.
+func (s *Store) QueryArtifacts(ctx context.Context, q *artifacts.Query) ([]*pb.Artifact, string, error) {
+	if q.PageSize <= 0 {
+		panic("PageSize <= 0")
+	}
+
+	parentIDRegexp, err := regexp.Compile(q.EffectiveParentIDRegexp())
+	if err != nil {
+		return nil, "", appstatus.Attachf(err, codes.InvalidArgument, "invalid parent id regexp")
+	}
+
+	defer s.rlock(ctx)()
+
+	// If we need to filter artifacts by attributes of test results, then
+	// collect the parents that match.
+	var parents map[artifactParent]struct{}
+	if q.FollowEdges == nil || q.FollowEdges.TestResults {
+		variant := q.TestResultPredicate.GetVariant()
+		interesting := q.TestResultPredicate.GetExpectancy() == pb.TestResultPredicate_VARIANTS_WITH_UNEXPECTED_RESULTS
+		if variant != nil || interesting {
+			parents = s.artifactParents(q.InvocationIDs, variant, interesting)
+		}
+	}
+
+	var items []item
+	for _, id := range s.sortedInvocations(q.InvocationIDs) {
+		for k, a := range s.invs[id].artifacts {
+			if !parentIDRegexp.MatchString(k.parentID) {
+				continue
+			}
+			if parents != nil && k.parentID != "" {
+				if _, ok := parents[artifactParent{id, k.parentID}]; !ok {
+					continue
+				}
+			}
+			items = append(items, item{key: [3]string{id.RowID(), k.parentID, k.artifactID}, msg: a.Artifact})
+		}
+	}
+
+	items, nextPageToken, err := page(items, q.PageToken, q.PageSize)
+	if err != nil {
+		return nil, "", err
+	}
+	arts := make([]*pb.Artifact, len(items))
+	for i, it := range items {
+		arts[i] = proto.Clone(it.msg).(*pb.Artifact)
+	}
+	return arts, nextPageToken, nil
+}
+
+// artifactParent identifies a test result that may have artifacts.
+type artifactParent struct {
+	invID    invocations.ID
+	parentID string
+}
+
+// artifactParents returns the test results in the invocations that match the
+// variant predicate. If interesting is true, returns only the results of test
+// variants with unexpected results.
+// Must be called with s.mu held.
+func (s *Store) artifactParents(ids invocations.IDSet, variant *pb.VariantPredicate, interesting bool) map[artifactParent]struct{} {
+	var testVariants map[testVariant]struct{}
+	if interesting {
+		testVariants = s.variantsWithUnexpectedResults(ids, &testFilter{}, false)
+	}
+
+	ret := map[artifactParent]struct{}{}
+	for id := range ids {
+		inv, ok := s.invs[id]
+		if !ok {
+			continue
+		}
+		for k, tr := range inv.testResults {
+			if interesting && !hasTestVariant(testVariants, testVariant{tr.TestId, tr.VariantHash}) {
+				continue
+			}
+			if matchVariant(variant, tr.Variant) {
+				ret[artifactParent{id, artifacts.ParentID(k.testID, k.id)}] = struct{}{}
+			}
+		}
+	}
+	return ret
+}
+
+// InsertArtifact implements storage.Store.
+func (s *Store) InsertArtifact(ctx context.Context, a *pb.Artifact, rbeCASHash string) {
+	invID, testID, resultID, artifactID := artifacts.MustParseName(a.Name)
+	key := artifactKey{artifacts.ParentID(testID, resultID), artifactID}
+	a = proto.Clone(a).(*pb.Artifact)
+	check := func() error {
+		inv, err := s.get(invID)
+		if err != nil {
+			return err
+		}
+		if _, ok := inv.artifacts[key]; ok {
+			return appstatus.Errorf(codes.AlreadyExists, "%s already exists", a.Name)
+		}
+		return nil
+	}
+	s.write(ctx, check, func(time.Time) {
+		s.invs[invID].artifacts[key] = &artifact{Artifact: a, rbeCASHash: rbeCASHash}
+	})
+}
@@ -0,0 +1,168 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/genproto/googleapis/bytestream"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// casChunkSize is the maximum size of a chunk returned by CAS.Read.
+const casChunkSize = 1 << 20
+
+// CAS is an in-memory content-addressable storage of blobs, accessed like
+// RBE-CAS through the ByteStream API.
+//
+// A zero CAS is ready to use, and it is safe for concurrent use.
+type CAS struct {
+	mu    sync.RWMutex
+	blobs map[string][]byte // keyed by hex-encoded SHA256 hash
+}
+
+// NewWriter starts writing a blob. The resource name of the first request
+// must be in "{instance}/uploads/{uuid}/blobs/{hash}/{size}" format.
+func (c *CAS) NewWriter(ctx context.Context) (bytestream.ByteStream_WriteClient, error) {
+	return &casWriter{ctx: ctx, cas: c}, nil
+}
+
+// Read starts reading a blob. The resource name must be in
+// "{instance}/blobs/{hash}/{size}" format.
+func (c *CAS) Read(ctx context.Context, req *bytestream.ReadRequest) (bytestream.ByteStream_ReadClient, error) {
+	hash, _, err := parseBlobResourceName(req.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	blob, ok := c.blobs[hash]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "blob %s not found", hash)
+	}
+	return &casReader{ctx: ctx, r: bytes.NewReader(blob)}, nil
+}
+
+// parseBlobResourceName returns the hash and the size of the blob referenced
+// by a ByteStream resource name.
+func parseBlobResourceName(name string) (hash string, size int64, err error) {
+	i := strings.LastIndex(name, "/blobs/")
+	if i < 0 {
+		return "", 0, status.Errorf(codes.InvalidArgument, "invalid resource name %q", name)
+	}
+	parts := strings.Split(name[i+len("/blobs/"):], "/")
+	if len(parts) != 2 {
+		return "", 0, status.Errorf(codes.InvalidArgument, "invalid resource name %q", name)
+	}
+	if size, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+		return "", 0, status.Errorf(codes.InvalidArgument, "invalid blob size in %q", name)
+	}
+	return parts[0], size, nil
+}
+
+// casWriter implements bytestream.ByteStream_WriteClient.
+type casWriter struct {
+	grpc.ClientStream
+
+	ctx context.Context
+	cas *CAS
+	buf bytes.Buffer
+
+	hash string
+	size int64
+}
+
+// Send implements bytestream.ByteStream_WriteClient.
+func (w *casWriter) Send(req *bytestream.WriteRequest) error {
+	if w.buf.Len() == 0 && w.hash == "" {
+		var err error
+		if w.hash, w.size, err = parseBlobResourceName(req.ResourceName); err != nil {
+			return err
+		}
+	}
+	if req.WriteOffset != int64(w.buf.Len()) {
+		return status.Errorf(codes.InvalidArgument, "expected write offset %d, got %d", w.buf.Len(), req.WriteOffset)
+	}
+	w.buf.Write(req.Data)
+	return nil
+}
+
+// CloseAndRecv implements bytestream.ByteStream_WriteClient.
+// It stores the blob if its hash and size match the resource name.
+func (w *casWriter) CloseAndRecv() (*bytestream.WriteResponse, error) {
+	blob := w.buf.Bytes()
+	if int64(len(blob)) != w.size {
+		return nil, status.Errorf(codes.InvalidArgument, "expected %d bytes, got %d", w.size, len(blob))
+	}
+	h := sha256.Sum256(blob)
+	if hash := hex.EncodeToString(h[:]); hash != w.hash {
+		return nil, status.Errorf(codes.InvalidArgument, "expected hash %s, got %s", w.hash, hash)
+	}
+
+	w.cas.mu.Lock()
+	defer w.cas.mu.Unlock()
+	if w.cas.blobs == nil {
+		w.cas.blobs = map[string][]byte{}
+	}
+	w.cas.blobs[w.hash] = append([]byte(nil), blob...)
+	return &bytestream.WriteResponse{CommittedSize: w.size}, nil
+}
+
+// CloseSend implements grpc.ClientStream.
+func (w *casWriter) CloseSend() error {
+	return nil
+}
+
+// Context implements grpc.ClientStream.
+func (w *casWriter) Context() context.Context {
+	return w.ctx
+}
+
+// casReader implements bytestream.ByteStream_ReadClient.
+type casReader struct {
+	grpc.ClientStream
+
+	ctx context.Context
+	r   *bytes.Reader
+}
+
+// Recv implements bytestream.ByteStream_ReadClient.
+func (r *casReader) Recv() (*bytestream.ReadResponse, error) {
+	chunk := make([]byte, casChunkSize)
+	n, err := r.r.Read(chunk)
+	if err != nil {
+		return nil, err
+	}
+	return &bytestream.ReadResponse{Data: chunk[:n]}, nil
+}
+
+// CloseSend implements grpc.ClientStream.
+func (r *casReader) CloseSend() error {
+	return nil
+}
+
+// Context implements grpc.ClientStream.
+func (r *casReader) Context() context.Context {
+	return r.ctx
+}
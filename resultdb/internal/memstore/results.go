@@ -0,0 +1,419 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memstore
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/proto/mask"
+	"go.chromium.org/luci/grpc/appstatus"
+
+	"go.chromium.org/luci/resultdb/internal/exonerations"
+	"go.chromium.org/luci/resultdb/internal/flakiness"
+	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/pagination"
+	"go.chromium.org/luci/resultdb/internal/testresults"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// testVariant identifies a variant of a test.
+type testVariant struct {
+	testID      string
+	variantHash string
+}
+
+// testFilter filters test results and exonerations by test id and variant.
+type testFilter struct {
+	testIDRegexp *regexp.Regexp
+	variant      *pb.VariantPredicate
+}
+
+// newTestFilter returns a filter of test results and exonerations like
+// testresults.Query does.
+func newTestFilter(p *pb.TestResultPredicate) (*testFilter, error) {
+	f := &testFilter{variant: p.GetVariant()}
+	if re := p.GetTestIdRegexp(); re != "" && re != ".*" {
+		var err error
+		if f.testIDRegexp, err = regexp.Compile(fmt.Sprintf("^%s$", re)); err != nil {
+			return nil, appstatus.Attachf(err, codes.InvalidArgument, "invalid test id regexp")
+		}
+	}
+	return f, nil
+}
+
+func (f *testFilter) match(testID string, variant *pb.Variant) bool {
+	if f.testIDRegexp != nil && !f.testIDRegexp.MatchString(testID) {
+		return false
+	}
+	return matchVariant(f.variant, variant)
+}
+
+// matchVariant returns true if the variant matches the predicate.
+func matchVariant(p *pb.VariantPredicate, variant *pb.Variant) bool {
+	switch p := p.GetPredicate().(type) {
+	case *pb.VariantPredicate_Equals:
+		return pbutil.VariantHash(p.Equals) == pbutil.VariantHash(variant)
+	case *pb.VariantPredicate_Contains:
+		pairs := stringset.NewFromSlice(pbutil.VariantToStrings(variant)...)
+		return pairs.HasAll(pbutil.VariantToStrings(p.Contains)...)
+	case nil:
+		return true
+	default:
+		panic(errors.Reason("unexpected variant predicate %q", p).Err())
+	}
+}
+
+// matchTestMetadata returns true if the test metadata matches the predicate.
+func matchTestMetadata(p *pb.TestMetadataPredicate, md *pb.TestMetadata) bool {
+	if p.GetOwner() != "" && !stringset.NewFromSlice(md.GetOwners()...).Has(p.Owner) {
+		return false
+	}
+	return p.GetBugComponent() == "" || p.BugComponent == md.GetBugComponent()
+}
+
+// variantsWithUnexpectedResults returns the test variants that have
+// unexpected results in the invocations and match the filter.
+// If excludeExonerated is true, exonerated test variants are excluded.
+// Must be called with s.mu held.
+func (s *Store) variantsWithUnexpectedResults(ids invocations.IDSet, f *testFilter, excludeExonerated bool) map[testVariant]struct{} {
+	ret := map[testVariant]struct{}{}
+	for id := range ids {
+		inv, ok := s.invs[id]
+		if !ok {
+			continue
+		}
+		for _, tr := range inv.testResults {
+			if !tr.Expected && f.match(tr.TestId, tr.Variant) {
+				ret[testVariant{tr.TestId, tr.VariantHash}] = struct{}{}
+			}
+		}
+	}
+	if excludeExonerated {
+		for id := range ids {
+			inv, ok := s.invs[id]
+			if !ok {
+				continue
+			}
+			for _, te := range inv.exonerations {
+				if f.match(te.TestId, te.Variant) {
+					delete(ret, testVariant{te.TestId, te.VariantHash})
+				}
+			}
+		}
+	}
+	return ret
+}
+
+// ReadTestResult implements storage.Store.
+func (s *Store) ReadTestResult(ctx context.Context, name string) (*pb.TestResult, error) {
+	invID, testID, resultID := testresults.MustParseName(name)
+	defer s.rlock(ctx)()
+	if inv, ok := s.invs[invID]; ok {
+		if tr, ok := inv.testResults[resultKey{testID, resultID}]; ok {
+			return proto.Clone(tr).(*pb.TestResult), nil
+		}
+	}
+	return nil, appstatus.Errorf(codes.NotFound, "%s not found", name)
+}
+
+// QueryTestResults implements storage.Store.
+func (s *Store) QueryTestResults(ctx context.Context, q *testresults.Query) ([]*pb.TestResult, string, error) {
+	switch {
+	case q.PageSize <= 0:
+		panic("PageSize <= 0")
+	case q.Predicate.GetExcludeExonerated() && q.Predicate.GetExpectancy() == pb.TestResultPredicate_ALL:
+		panic("ExcludeExonerated and Expectancy=ALL are mutually exclusive")
+	}
+
+	f, err := newTestFilter(q.Predicate)
+	if err != nil {
+		return nil, "", err
+	}
+	readMask := q.Mask
+	if readMask.IsEmpty() {
+		readMask, _ = testresults.ListMask(nil)
+	}
+
+	defer s.rlock(ctx)()
+
+	// Filter by expectancy.
+	var testVariants map[testVariant]struct{}
+	switch q.Predicate.GetExpectancy() {
+	case
+		pb.TestResultPredicate_VARIANTS_WITH_UNEXPECTED_RESULTS,
+		pb.TestResultPredicate_VARIANTS_WITH_ONLY_UNEXPECTED_RESULTS:
+
+		testVariants = s.variantsWithUnexpectedResults(q.InvocationIDs, f, q.Predicate.GetExcludeExonerated())
+		if len(testVariants) == 0 {
+			// No test variant to match.
+			return nil, "", nil
+		}
+	}
+
+	var items []item
+	expected := map[testVariant]bool{}
+	for _, id := range s.sortedInvocations(q.InvocationIDs) {
+		for k, tr := range s.invs[id].testResults {
+			tv := testVariant{tr.TestId, tr.VariantHash}
+			switch {
+			case testVariants != nil && !hasTestVariant(testVariants, tv):
+				continue
+			case testVariants == nil && !f.match(tr.TestId, tr.Variant):
+				continue
+			case !matchTestMetadata(q.Predicate.GetTestMetadata(), tr.TestMetadata):
+				continue
+			}
+			expected[tv] = expected[tv] || tr.Expected
+			items = append(items, item{key: [3]string{id.RowID(), k.testID, k.id}, msg: tr})
+		}
+	}
+	if q.Predicate.GetExpectancy() == pb.TestResultPredicate_VARIANTS_WITH_ONLY_UNEXPECTED_RESULTS {
+		filtered := items[:0]
+		for _, it := range items {
+			tr := it.msg.(*pb.TestResult)
+			if !expected[testVariant{tr.TestId, tr.VariantHash}] {
+				filtered = append(filtered, it)
+			}
+		}
+		items = filtered
+	}
+
+	items, nextPageToken, err := page(items, q.PageToken, q.PageSize)
+	if err != nil {
+		return nil, "", err
+	}
+	trs := make([]*pb.TestResult, len(items))
+	for i, it := range items {
+		tr := proto.Clone(it.msg).(*pb.TestResult)
+		if err := trimTestResult(readMask, tr); err != nil {
+			return nil, "", err
+		}
+		trs[i] = tr
+	}
+	return trs, nextPageToken, nil
+}
+
+func hasTestVariant(tvs map[testVariant]struct{}, tv testVariant) bool {
+	_, ok := tvs[tv]
+	return ok
+}
+
+// trimTestResult trims the test result to the fields in the mask, except the
+// name, which is always present.
+func trimTestResult(m mask.Mask, tr *pb.TestResult) error {
+	name := tr.Name
+	if err := m.Trim(tr); err != nil {
+		return errors.Annotate(err, "failed to trim test result").Err()
+	}
+	tr.Name = name
+	return nil
+}
+
+// InsertTestResults implements storage.Store.
+func (s *Store) InsertTestResults(ctx context.Context, invID invocations.ID, trs []*pb.TestResult) error {
+	clones := make([]*pb.TestResult, len(trs))
+	for i, tr := range trs {
+		tr = proto.Clone(tr).(*pb.TestResult)
+		tr.VariantHash = pbutil.VariantHash(tr.Variant)
+		clones[i] = tr
+	}
+	s.write(ctx, s.checkExists(invID), func(time.Time) {
+		inv := s.invs[invID]
+		for _, tr := range clones {
+			inv.testResults[resultKey{tr.TestId, tr.ResultId}] = tr
+		}
+	})
+	return nil
+}
+
+// ReadTestExoneration implements storage.Store.
+func (s *Store) ReadTestExoneration(ctx context.Context, name string) (*pb.TestExoneration, error) {
+	invID, testID, exonerationID, err := pbutil.ParseTestExonerationName(name)
+	if err != nil {
+		return nil, err
+	}
+	defer s.rlock(ctx)()
+	if inv, ok := s.invs[invocations.ID(invID)]; ok {
+		if te, ok := inv.exonerations[resultKey{testID, exonerationID}]; ok {
+			return proto.Clone(te).(*pb.TestExoneration), nil
+		}
+	}
+	return nil, appstatus.Errorf(codes.NotFound, "%s not found", name)
+}
+
+// QueryTestExonerations implements storage.Store.
+func (s *Store) QueryTestExonerations(ctx context.Context, q *exonerations.Query) ([]*pb.TestExoneration, string, error) {
+	if q.PageSize <= 0 {
+		panic("PageSize <= 0")
+	}
+
+	defer s.rlock(ctx)()
+
+	// TODO(nodir): filter by q.Predicate, like exonerations.Query should.
+	var items []item
+	for _, id := range s.sortedInvocations(q.InvocationIDs) {
+		for k, te := range s.invs[id].exonerations {
+			items = append(items, item{key: [3]string{id.RowID(), k.testID, k.id}, msg: te})
+		}
+	}
+
+	items, nextPageToken, err := page(items, q.PageToken, q.PageSize)
+	if err != nil {
+		return nil, "", err
+	}
+	tes := make([]*pb.TestExoneration, len(items))
+	for i, it := range items {
+		tes[i] = proto.Clone(it.msg).(*pb.TestExoneration)
+	}
+	return tes, nextPageToken, nil
+}
+
+// InsertTestExonerations implements storage.Store.
+func (s *Store) InsertTestExonerations(ctx context.Context, invID invocations.ID, tes []*pb.TestExoneration, replace bool) {
+	clones := make([]*pb.TestExoneration, len(tes))
+	for i, te := range tes {
+		te = proto.Clone(te).(*pb.TestExoneration)
+		te.VariantHash = pbutil.VariantHash(te.Variant)
+		clones[i] = te
+	}
+	check := func() error {
+		inv, err := s.get(invID)
+		if err != nil || replace {
+			return err
+		}
+		for _, te := range clones {
+			if _, ok := inv.exonerations[resultKey{te.TestId, te.ExonerationId}]; ok {
+				return appstatus.Errorf(codes.AlreadyExists, "%s already exists", te.Name)
+			}
+		}
+		return nil
+	}
+	s.write(ctx, check, func(time.Time) {
+		inv := s.invs[invID]
+		for _, te := range clones {
+			inv.exonerations[resultKey{te.TestId, te.ExonerationId}] = te
+		}
+	})
+}
+
+// QueryTestFlakiness implements storage.Store.
+//
+// Unlike storage.Spanner, it analyzes the flakiness on each call, with the
+// same window and invocation limit as the flakiness analyzer.
+func (s *Store) QueryTestFlakiness(ctx context.Context, q *flakiness.StoredQuery) ([]*pb.TestFlakiness, error) {
+	if q.Limit <= 0 {
+		panic("Limit <= 0")
+	}
+
+	f, err := newTestFilter(q.Predicate)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := clock.Now(ctx).UTC()
+	earliest := latest.Add(-flakiness.DefaultWindow)
+
+	defer s.rlock(ctx)()
+
+	// Select the latest finalized invocations of the realm.
+	var invs []*invocation
+	for _, inv := range s.invs {
+		if inv.inv.Realm == q.Realm && inv.inv.State == pb.Invocation_FINALIZED && !inv.historyTime.IsZero() &&
+			!inv.historyTime.Before(earliest) && inv.historyTime.Before(latest) {
+			invs = append(invs, inv)
+		}
+	}
+	sort.Slice(invs, func(i, j int) bool { return invs[i].historyTime.After(invs[j].historyTime) })
+	if len(invs) > flakiness.DefaultMaxInvocations {
+		invs = invs[:flakiness.DefaultMaxInvocations]
+	}
+
+	a := &flakiness.Analyzer{}
+	for _, inv := range invs {
+		id := invocations.MustParseName(inv.inv.Name)
+		i := a.AddInvocation(flakiness.Invocation{
+			ID:            id,
+			CreateTime:    inv.inv.CreateTime.AsTime(),
+			OrdinalDomain: inv.ordinalDomain,
+			Ordinal:       inv.ordinal,
+		})
+		for reachableID := range s.reachable(invocations.NewIDSet(id)) {
+			if r, ok := s.invs[reachableID]; ok {
+				for _, tr := range r.testResults {
+					a.AddResult(i, tr)
+				}
+			}
+		}
+	}
+
+	var ret []*pb.TestFlakiness
+	for _, tf := range a.Flakiness() {
+		if len(ret) == q.Limit {
+			break
+		}
+		if tf.FlakeRate > 0 && tf.FlakeRate >= q.MinFlakeRate && f.match(tf.TestId, tf.Variant) {
+			ret = append(ret, tf)
+		}
+	}
+	return ret, nil
+}
+
+// item is a query result, keyed like in Spanner: by invocation row id, then
+// by two more keys.
+type item struct {
+	key [3]string
+	msg proto.Message
+}
+
+// page sorts the items and returns at most pageSize of them after the page
+// token, as well as the next page token.
+func page(items []item, pageToken string, pageSize int) ([]item, string, error) {
+	m := map[string]interface{}{}
+	if err := invocations.TokenToMap(pageToken, m, "invocationID", "key2", "key3"); err != nil {
+		return nil, "", err
+	}
+	after := [3]string{m["invocationID"].(invocations.ID).RowID(), m["key2"].(string), m["key3"].(string)}
+
+	sort.Slice(items, func(i, j int) bool { return lessKey(items[i].key, items[j].key) })
+	start := sort.Search(len(items), func(i int) bool { return lessKey(after, items[i].key) })
+	items = items[start:]
+	if len(items) < pageSize {
+		return items, "", nil
+	}
+
+	items = items[:pageSize]
+	last := items[pageSize-1].key
+	return items, pagination.Token(string(invocations.IDFromRowID(last[0])), last[1], last[2]), nil
+}
+
+func lessKey(a, b [3]string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
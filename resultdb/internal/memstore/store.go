@@ -0,0 +1,469 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package memstore implements storage.Store in memory.
+//
+// It lets the Recorder and ResultDB services run on a developer machine
+// without Cloud Spanner. Data does not survive process restarts.
+package memstore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/grpc/appstatus"
+
+	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/storage"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// Store is a storage.Store that keeps data in memory.
+//
+// Read-only transactions read a consistent snapshot, and read-write
+// transactions are serialized. Invocations are finalized as soon as the
+// transaction that makes them ready for finalization commits.
+//
+// A zero Store is ready to use, and it is safe for concurrent use.
+type Store struct {
+	// mu protects invs. Read-only transactions hold it for reading until they
+	// end.
+	mu   sync.RWMutex
+	invs map[invocations.ID]*invocation
+
+	// rwMu serializes read-write transactions.
+	rwMu sync.Mutex
+}
+
+var _ storage.Store = (*Store)(nil)
+
+// invocation is an invocation with its contents.
+type invocation struct {
+	// inv is the invocation without IncludedInvocations.
+	inv             *pb.Invocation
+	createRequestID string
+	included        invocations.IDSet
+
+	// historyTime is the time the invocation is indexed at for history
+	// queries. Zero if the invocation is not indexed.
+	historyTime   time.Time
+	ordinalDomain string
+	ordinal       int64
+
+	testResults  map[resultKey]*pb.TestResult
+	exonerations map[resultKey]*pb.TestExoneration
+	artifacts    map[artifactKey]*artifact
+}
+
+// resultKey identifies a test result or a test exoneration in an invocation.
+type resultKey struct {
+	testID string
+	id     string
+}
+
+// artifactKey identifies an artifact in an invocation.
+type artifactKey struct {
+	parentID   string
+	artifactID string
+}
+
+// artifact is an artifact with the hash of its content in RBE-CAS.
+type artifact struct {
+	*pb.Artifact
+	rbeCASHash string
+}
+
+// roTxn is a read-only transaction of a store.
+type roTxn struct {
+	s *Store
+}
+
+// rwTxn is a read-write transaction of a store.
+type rwTxn struct {
+	s *Store
+
+	// mu protects the fields below. The transaction may be used concurrently.
+	mu     sync.Mutex
+	writes []func(now time.Time)
+	err    error
+}
+
+var txnKey = "memstore transaction"
+
+// ReadOnlyTransaction implements storage.Store.
+// The reads in the transaction are never stale.
+//
+// The transaction blocks commits of read-write transactions until it ends.
+func (s *Store) ReadOnlyTransaction(ctx context.Context, maxStaleness time.Duration) (context.Context, context.CancelFunc) {
+	s.checkNoTxn(ctx)
+	s.mu.RLock()
+	var once sync.Once
+	return context.WithValue(ctx, &txnKey, &roTxn{s: s}), func() {
+		once.Do(s.mu.RUnlock)
+	}
+}
+
+// ReadWriteTransaction implements storage.Store.
+// f is called exactly once.
+//
+// The reads in the transaction do not see its writes. If f succeeds, the
+// writes are applied in order, at the same time.
+func (s *Store) ReadWriteTransaction(ctx context.Context, f func(ctx context.Context) error) error {
+	s.checkNoTxn(ctx)
+	s.rwMu.Lock()
+	defer s.rwMu.Unlock()
+
+	t := &rwTxn{s: s}
+	if err := f(context.WithValue(ctx, &txnKey, t)); err != nil {
+		return err
+	}
+	if t.err != nil {
+		return t.err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := clock.Now(ctx).UTC()
+	for _, w := range t.writes {
+		w(now)
+	}
+	s.finalizeReady(now)
+	return nil
+}
+
+// checkNoTxn panics if ctx is in a transaction of s.
+func (s *Store) checkNoTxn(ctx context.Context) {
+	switch t := ctx.Value(&txnKey).(type) {
+	case *roTxn:
+		if t.s == s {
+			panic("nested transactions are not allowed")
+		}
+	case *rwTxn:
+		if t.s == s {
+			panic("nested transactions are not allowed")
+		}
+	}
+}
+
+// rlock locks s.mu for reading, unless ctx is in a read-only transaction of s
+// that holds the lock already. Returns a function that unlocks it.
+func (s *Store) rlock(ctx context.Context) (unlock func()) {
+	if t, ok := ctx.Value(&txnKey).(*roTxn); ok && t.s == s {
+		return func() {}
+	}
+	s.mu.RLock()
+	return s.mu.RUnlock
+}
+
+// write buffers a write in the read-write transaction of ctx.
+//
+// check is called immediately to validate the write against the committed
+// data. If it fails, the transaction fails. Since read-write transactions are
+// serialized, the committed data does not change until the write is applied.
+func (s *Store) write(ctx context.Context, check func() error, apply func(now time.Time)) {
+	t, ok := ctx.Value(&txnKey).(*rwTxn)
+	if !ok || t.s != s {
+		panic("writes must be done in a read-write transaction")
+	}
+
+	var err error
+	if check != nil {
+		unlock := s.rlock(ctx)
+		err = check()
+		unlock()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err != nil {
+		if t.err == nil {
+			t.err = err
+		}
+		return
+	}
+	t.writes = append(t.writes, apply)
+}
+
+// finalizeReady finalizes the invocations that are finalizing and do not
+// reach active invocations.
+// Must be called with s.mu held.
+func (s *Store) finalizeReady(now time.Time) {
+	nowTS, _ := ptypes.TimestampProto(now)
+	for _, inv := range s.invs {
+		if inv.inv.State != pb.Invocation_FINALIZING {
+			continue
+		}
+		ready := true
+		for id := range s.reachable(invocations.NewIDSet(invocations.MustParseName(inv.inv.Name))) {
+			if r, ok := s.invs[id]; ok && r.inv.State == pb.Invocation_ACTIVE {
+				ready = false
+				break
+			}
+		}
+		if ready {
+			inv.inv.State = pb.Invocation_FINALIZED
+			inv.inv.FinalizeTime = nowTS
+		}
+	}
+}
+
+func invocationNotFound(id invocations.ID) error {
+	return appstatus.Errorf(codes.NotFound, "%s not found", id.Name())
+}
+
+// get returns the invocation or a NotFound error.
+// Must be called with s.mu held.
+func (s *Store) get(id invocations.ID) (*invocation, error) {
+	if inv, ok := s.invs[id]; ok {
+		return inv, nil
+	}
+	return nil, invocationNotFound(id)
+}
+
+// checkExists returns a function that checks that the invocation exists.
+func (s *Store) checkExists(id invocations.ID) func() error {
+	return func() error {
+		_, err := s.get(id)
+		return err
+	}
+}
+
+// reachable returns the invocations reachable from the roots, including the
+// roots.
+// Must be called with s.mu held.
+func (s *Store) reachable(roots invocations.IDSet) invocations.IDSet {
+	ret := make(invocations.IDSet, len(roots))
+	var visit func(id invocations.ID)
+	visit = func(id invocations.ID) {
+		if ret.Has(id) {
+			return
+		}
+		ret.Add(id)
+		if inv, ok := s.invs[id]; ok {
+			for included := range inv.included {
+				visit(included)
+			}
+		}
+	}
+	for id := range roots {
+		visit(id)
+	}
+	return ret
+}
+
+// sortedInvocations returns the existing invocations among ids, ordered like
+// in Spanner.
+// Must be called with s.mu held.
+func (s *Store) sortedInvocations(ids invocations.IDSet) []invocations.ID {
+	ret := make([]invocations.ID, 0, len(ids))
+	for _, id := range ids.SortByRowID() {
+		if _, ok := s.invs[id]; ok {
+			ret = append(ret, id)
+		}
+	}
+	return ret
+}
+
+// ReadInvocation implements storage.Store.
+func (s *Store) ReadInvocation(ctx context.Context, id invocations.ID) (*pb.Invocation, error) {
+	defer s.rlock(ctx)()
+	inv, err := s.get(id)
+	if err != nil {
+		return nil, err
+	}
+	ret := proto.Clone(inv.inv).(*pb.Invocation)
+	ret.IncludedInvocations = inv.included.Names()
+	return ret, nil
+}
+
+// ReadInvocations implements storage.Store.
+func (s *Store) ReadInvocations(ctx context.Context, ids invocations.IDSet) (map[invocations.ID]*pb.Invocation, error) {
+	ret := make(map[invocations.ID]*pb.Invocation, len(ids))
+	for id := range ids {
+		inv, err := s.ReadInvocation(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		ret[id] = inv
+	}
+	return ret, nil
+}
+
+// ReadInvocationState implements storage.Store.
+func (s *Store) ReadInvocationState(ctx context.Context, id invocations.ID) (pb.Invocation_State, error) {
+	defer s.rlock(ctx)()
+	inv, err := s.get(id)
+	if err != nil {
+		return 0, err
+	}
+	return inv.inv.State, nil
+}
+
+// ReadInvocationStates implements storage.Store.
+func (s *Store) ReadInvocationStates(ctx context.Context, ids invocations.IDSet) (map[invocations.ID]pb.Invocation_State, error) {
+	defer s.rlock(ctx)()
+	ret := make(map[invocations.ID]pb.Invocation_State, len(ids))
+	for id := range ids {
+		if inv, ok := s.invs[id]; ok {
+			ret[id] = inv.inv.State
+		}
+	}
+	return ret, nil
+}
+
+// ReadRealm implements storage.Store.
+func (s *Store) ReadRealm(ctx context.Context, id invocations.ID) (string, error) {
+	defer s.rlock(ctx)()
+	inv, err := s.get(id)
+	if err != nil {
+		return "", err
+	}
+	return inv.inv.Realm, nil
+}
+
+// ReadRealms implements storage.Store.
+func (s *Store) ReadRealms(ctx context.Context, ids invocations.IDSet) (map[invocations.ID]string, error) {
+	defer s.rlock(ctx)()
+	ret := make(map[invocations.ID]string, len(ids))
+	for id := range ids {
+		inv, err := s.get(id)
+		if err != nil {
+			return nil, err
+		}
+		ret[id] = inv.inv.Realm
+	}
+	return ret, nil
+}
+
+// ReadCreateRequests implements storage.Store.
+func (s *Store) ReadCreateRequests(ctx context.Context, ids invocations.IDSet) (map[invocations.ID]storage.CreateRequest, error) {
+	defer s.rlock(ctx)()
+	ret := make(map[invocations.ID]storage.CreateRequest, len(ids))
+	for id := range ids {
+		if inv, ok := s.invs[id]; ok {
+			ret[id] = storage.CreateRequest{
+				RequestID: inv.createRequestID,
+				CreatedBy: inv.inv.CreatedBy,
+			}
+		}
+	}
+	return ret, nil
+}
+
+// ReadTestResultCount implements storage.Store.
+func (s *Store) ReadTestResultCount(ctx context.Context, ids invocations.IDSet) (int64, error) {
+	defer s.rlock(ctx)()
+	var count int64
+	for id := range ids {
+		if inv, ok := s.invs[id]; ok {
+			count += int64(len(inv.testResults))
+		}
+	}
+	return count, nil
+}
+
+// Reachable implements storage.Store.
+func (s *Store) Reachable(ctx context.Context, roots invocations.IDSet) (invocations.IDSet, error) {
+	defer s.rlock(ctx)()
+	return s.reachable(roots), nil
+}
+
+// InsertInvocation implements storage.Store.
+func (s *Store) InsertInvocation(ctx context.Context, inv *pb.Invocation, createRequestID string, expectedResultsExpiration time.Duration) {
+	id := invocations.MustParseName(inv.Name)
+	inv = proto.Clone(inv).(*pb.Invocation)
+	check := func() error {
+		if _, ok := s.invs[id]; ok {
+			return appstatus.Errorf(codes.AlreadyExists, "%s already exists", inv.Name)
+		}
+		return nil
+	}
+	s.write(ctx, check, func(now time.Time) {
+		rec := &invocation{
+			createRequestID: createRequestID,
+			included:        invocations.MustParseNames(inv.IncludedInvocations),
+			testResults:     map[resultKey]*pb.TestResult{},
+			exonerations:    map[resultKey]*pb.TestExoneration{},
+			artifacts:       map[artifactKey]*artifact{},
+		}
+
+		nowTS, _ := ptypes.TimestampProto(now)
+		inv.CreateTime = nowTS
+		if inv.State == pb.Invocation_FINALIZED {
+			inv.FinalizeTime = nowTS
+		}
+		if hOpts := inv.HistoryOptions; hOpts != nil {
+			if hOpts.UseInvocationTimestamp {
+				rec.historyTime = now
+			}
+			if cp := hOpts.Commit; cp != nil {
+				rec.ordinalDomain = storage.OrdinalDomain(cp)
+				rec.ordinal = cp.Position
+			}
+		}
+		inv.HistoryOptions = nil
+		inv.IncludedInvocations = nil
+		rec.inv = inv
+
+		if s.invs == nil {
+			s.invs = map[invocations.ID]*invocation{}
+		}
+		s.invs[id] = rec
+	})
+}
+
+// UpdateInvocationDeadline implements storage.Store.
+func (s *Store) UpdateInvocationDeadline(ctx context.Context, id invocations.ID, deadline *tspb.Timestamp) {
+	s.write(ctx, s.checkExists(id), func(time.Time) {
+		s.invs[id].inv.Deadline = deadline
+	})
+}
+
+// StartInvocationFinalization implements storage.Store.
+func (s *Store) StartInvocationFinalization(ctx context.Context, id invocations.ID) {
+	s.write(ctx, s.checkExists(id), func(time.Time) {
+		s.invs[id].inv.State = pb.Invocation_FINALIZING
+	})
+}
+
+// AddInclusions implements storage.Store.
+func (s *Store) AddInclusions(ctx context.Context, including invocations.ID, included invocations.IDSet) {
+	included = copyIDSet(included)
+	s.write(ctx, s.checkExists(including), func(time.Time) {
+		s.invs[including].included.Union(included)
+	})
+}
+
+// RemoveInclusions implements storage.Store.
+func (s *Store) RemoveInclusions(ctx context.Context, including invocations.ID, included invocations.IDSet) {
+	included = copyIDSet(included)
+	s.write(ctx, s.checkExists(including), func(time.Time) {
+		for id := range included {
+			s.invs[including].included.Remove(id)
+		}
+	})
+}
+
+func copyIDSet(ids invocations.IDSet) invocations.IDSet {
+	ret := make(invocations.IDSet, len(ids))
+	ret.Union(ids)
+	return ret
+}
@@ -0,0 +1,393 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/bytestream"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/resultdb/internal/artifacts"
+	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/testresults"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+	Convey(`Store`, t, func() {
+		ctx, _ := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		s := &Store{}
+		write := func(f func(ctx context.Context)) {
+			err := s.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+				f(ctx)
+				return nil
+			})
+			So(err, ShouldBeNil)
+		}
+		create := func(id invocations.ID, included ...invocations.ID) {
+			write(func(ctx context.Context) {
+				s.InsertInvocation(ctx, &pb.Invocation{
+					Name:                id.Name(),
+					State:               pb.Invocation_ACTIVE,
+					Realm:               "testproject:testrealm",
+					IncludedInvocations: invocations.NewIDSet(included...).Names(),
+				}, "", time.Hour)
+			})
+		}
+
+		Convey(`InsertInvocation`, func() {
+			create("inv", "other")
+			inv, err := s.ReadInvocation(ctx, "inv")
+			So(err, ShouldBeNil)
+			So(inv.Realm, ShouldEqual, "testproject:testrealm")
+			So(inv.CreateTime.AsTime(), ShouldEqual, testclock.TestRecentTimeUTC)
+			So(inv.IncludedInvocations, ShouldResemble, []string{"invocations/other"})
+
+			err = s.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+				s.InsertInvocation(ctx, &pb.Invocation{Name: "invocations/inv"}, "", time.Hour)
+				return nil
+			})
+			So(err, ShouldHaveAppStatus, codes.AlreadyExists)
+
+			_, err = s.ReadInvocation(ctx, "missing")
+			So(err, ShouldHaveAppStatus, codes.NotFound)
+		})
+
+		Convey(`Transactions`, func() {
+			create("inv")
+
+			Convey(`Writes are applied on commit`, func() {
+				err := s.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+					s.StartInvocationFinalization(ctx, "inv")
+					state, err := s.ReadInvocationState(ctx, "inv")
+					So(err, ShouldBeNil)
+					So(state, ShouldEqual, pb.Invocation_ACTIVE)
+					return nil
+				})
+				So(err, ShouldBeNil)
+
+				state, err := s.ReadInvocationState(ctx, "inv")
+				So(err, ShouldBeNil)
+				So(state, ShouldEqual, pb.Invocation_FINALIZED)
+			})
+
+			Convey(`Writes are discarded on failure`, func() {
+				err := s.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+					s.StartInvocationFinalization(ctx, "inv")
+					return errors.New("failed")
+				})
+				So(err, ShouldErrLike, "failed")
+
+				state, err := s.ReadInvocationState(ctx, "inv")
+				So(err, ShouldBeNil)
+				So(state, ShouldEqual, pb.Invocation_ACTIVE)
+			})
+
+			Convey(`Writes to missing invocations fail`, func() {
+				err := s.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+					s.StartInvocationFinalization(ctx, "inv")
+					s.StartInvocationFinalization(ctx, "missing")
+					return nil
+				})
+				So(err, ShouldHaveAppStatus, codes.NotFound)
+
+				state, err := s.ReadInvocationState(ctx, "inv")
+				So(err, ShouldBeNil)
+				So(state, ShouldEqual, pb.Invocation_ACTIVE)
+			})
+
+			Convey(`Writes outside of transactions panic`, func() {
+				So(func() { s.StartInvocationFinalization(ctx, "inv") }, ShouldPanic)
+			})
+		})
+
+		Convey(`Finalization waits for included invocations`, func() {
+			create("b")
+			create("a", "b")
+			write(func(ctx context.Context) { s.StartInvocationFinalization(ctx, "a") })
+
+			state, err := s.ReadInvocationState(ctx, "a")
+			So(err, ShouldBeNil)
+			So(state, ShouldEqual, pb.Invocation_FINALIZING)
+
+			write(func(ctx context.Context) { s.StartInvocationFinalization(ctx, "b") })
+			states, err := s.ReadInvocationStates(ctx, invocations.NewIDSet("a", "b", "missing"))
+			So(err, ShouldBeNil)
+			So(states, ShouldResemble, map[invocations.ID]pb.Invocation_State{
+				"a": pb.Invocation_FINALIZED,
+				"b": pb.Invocation_FINALIZED,
+			})
+		})
+
+		Convey(`Reachable`, func() {
+			create("c")
+			create("b", "c")
+			create("a", "b", "missing")
+
+			ids, err := s.Reachable(ctx, invocations.NewIDSet("a"))
+			So(err, ShouldBeNil)
+			So(ids, ShouldResemble, invocations.NewIDSet("a", "b", "c", "missing"))
+
+			write(func(ctx context.Context) { s.RemoveInclusions(ctx, "a", invocations.NewIDSet("b")) })
+			ids, err = s.Reachable(ctx, invocations.NewIDSet("a"))
+			So(err, ShouldBeNil)
+			So(ids, ShouldResemble, invocations.NewIDSet("a", "missing"))
+		})
+
+		Convey(`TestResults`, func() {
+			create("a")
+			create("b")
+			tr := func(invID, testID, resultID string, expected bool) *pb.TestResult {
+				return &pb.TestResult{
+					Name:        pbutil.TestResultName(invID, testID, resultID),
+					TestId:      testID,
+					ResultId:    resultID,
+					Variant:     pbutil.Variant("k", "v"),
+					Expected:    expected,
+					Status:      pb.TestStatus_PASS,
+					SummaryHtml: "summary",
+				}
+			}
+			write(func(ctx context.Context) {
+				So(s.InsertTestResults(ctx, "b", []*pb.TestResult{tr("b", "t1", "0", true)}), ShouldBeNil)
+				So(s.InsertTestResults(ctx, "a", []*pb.TestResult{
+					tr("a", "t2", "0", false),
+					tr("a", "t1", "1", false),
+					tr("a", "t1", "0", true),
+				}), ShouldBeNil)
+			})
+			bRowFirst := invocations.ID("b").RowID() < invocations.ID("a").RowID()
+
+			query := func(q *testresults.Query) (names []string, token string) {
+				q.InvocationIDs = invocations.NewIDSet("a", "b")
+				if q.PageSize == 0 {
+					q.PageSize = 100
+				}
+				trs, token, err := s.QueryTestResults(ctx, q)
+				So(err, ShouldBeNil)
+				for _, tr := range trs {
+					So(tr.SummaryHtml, ShouldEqual, "")
+					names = append(names, tr.Name)
+				}
+				return names, token
+			}
+
+			Convey(`All`, func() {
+				names, token := query(&testresults.Query{})
+				aNames := []string{
+					"invocations/a/tests/t1/results/0",
+					"invocations/a/tests/t1/results/1",
+					"invocations/a/tests/t2/results/0",
+				}
+				bNames := []string{"invocations/b/tests/t1/results/0"}
+				if bRowFirst {
+					So(names, ShouldResemble, append(bNames, aNames...))
+				} else {
+					So(names, ShouldResemble, append(aNames, bNames...))
+				}
+				So(token, ShouldEqual, "")
+
+				count, err := s.ReadTestResultCount(ctx, invocations.NewIDSet("a", "b"))
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 4)
+			})
+
+			Convey(`Pages`, func() {
+				var all []string
+				token := ""
+				for {
+					var names []string
+					names, token = query(&testresults.Query{PageSize: 3, PageToken: token})
+					all = append(all, names...)
+					if token == "" {
+						break
+					}
+				}
+				So(all, ShouldHaveLength, 4)
+			})
+
+			Convey(`Test id regexp`, func() {
+				names, _ := query(&testresults.Query{
+					Predicate: &pb.TestResultPredicate{TestIdRegexp: "t2"},
+				})
+				So(names, ShouldResemble, []string{"invocations/a/tests/t2/results/0"})
+			})
+
+			Convey(`Variants with only unexpected results`, func() {
+				names, _ := query(&testresults.Query{
+					Predicate: &pb.TestResultPredicate{
+						Expectancy: pb.TestResultPredicate_VARIANTS_WITH_ONLY_UNEXPECTED_RESULTS,
+					},
+				})
+				So(names, ShouldResemble, []string{"invocations/a/tests/t2/results/0"})
+			})
+
+			Convey(`Exonerated`, func() {
+				te := &pb.TestExoneration{
+					Name:          pbutil.TestExonerationName("a", "t2", "0"),
+					TestId:        "t2",
+					Variant:       pbutil.Variant("k", "v"),
+					ExonerationId: "0",
+				}
+				write(func(ctx context.Context) {
+					s.InsertTestExonerations(ctx, "a", []*pb.TestExoneration{te}, false)
+				})
+
+				err := s.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+					s.InsertTestExonerations(ctx, "a", []*pb.TestExoneration{te}, false)
+					return nil
+				})
+				So(err, ShouldHaveAppStatus, codes.AlreadyExists)
+				write(func(ctx context.Context) {
+					s.InsertTestExonerations(ctx, "a", []*pb.TestExoneration{te}, true)
+				})
+
+				names, _ := query(&testresults.Query{
+					Predicate: &pb.TestResultPredicate{
+						Expectancy:        pb.TestResultPredicate_VARIANTS_WITH_UNEXPECTED_RESULTS,
+						ExcludeExonerated: true,
+					},
+				})
+				So(names, ShouldHaveLength, 3)
+				for _, name := range names {
+					So(name, ShouldContainSubstring, "/tests/t1/")
+				}
+			})
+		})
+
+		Convey(`Artifacts`, func() {
+			create("inv")
+			write(func(ctx context.Context) {
+				So(s.InsertTestResults(ctx, "inv", []*pb.TestResult{
+					{Name: pbutil.TestResultName("inv", "t1", "0"), TestId: "t1", ResultId: "0", Expected: true},
+					{Name: pbutil.TestResultName("inv", "t2", "0"), TestId: "t2", ResultId: "0"},
+				}), ShouldBeNil)
+				s.InsertArtifact(ctx, &pb.Artifact{Name: pbutil.InvocationArtifactName("inv", "a"), ArtifactId: "a", SizeBytes: 1}, "sha256:1")
+				s.InsertArtifact(ctx, &pb.Artifact{Name: pbutil.TestResultArtifactName("inv", "t1", "0", "a"), ArtifactId: "a"}, "sha256:2")
+				s.InsertArtifact(ctx, &pb.Artifact{Name: pbutil.TestResultArtifactName("inv", "t2", "0", "a"), ArtifactId: "a"}, "sha256:3")
+			})
+
+			content, err := s.ReadArtifactContent(ctx, "invocations/inv/artifacts/a")
+			So(err, ShouldBeNil)
+			So(content.RBECASHash.StringVal, ShouldEqual, "sha256:1")
+			So(content.Size.Int64, ShouldEqual, 1)
+
+			err = s.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+				s.InsertArtifact(ctx, &pb.Artifact{Name: "invocations/inv/artifacts/a", ArtifactId: "a"}, "sha256:4")
+				return nil
+			})
+			So(err, ShouldHaveAppStatus, codes.AlreadyExists)
+
+			query := func(q *artifacts.Query) []string {
+				q.InvocationIDs = invocations.NewIDSet("inv")
+				q.PageSize = 100
+				arts, _, err := s.QueryArtifacts(ctx, q)
+				So(err, ShouldBeNil)
+				names := make([]string, len(arts))
+				for i, a := range arts {
+					names[i] = a.Name
+				}
+				return names
+			}
+
+			So(query(&artifacts.Query{}), ShouldResemble, []string{
+				"invocations/inv/artifacts/a",
+				"invocations/inv/tests/t1/results/0/artifacts/a",
+				"invocations/inv/tests/t2/results/0/artifacts/a",
+			})
+			So(query(&artifacts.Query{
+				TestResultPredicate: &pb.TestResultPredicate{
+					Expectancy: pb.TestResultPredicate_VARIANTS_WITH_UNEXPECTED_RESULTS,
+				},
+			}), ShouldResemble, []string{
+				"invocations/inv/artifacts/a",
+				"invocations/inv/tests/t2/results/0/artifacts/a",
+			})
+			So(query(&artifacts.Query{ParentIDRegexp: "^$"}), ShouldResemble, []string{
+				"invocations/inv/artifacts/a",
+			})
+		})
+	})
+}
+
+func TestCAS(t *testing.T) {
+	t.Parallel()
+	Convey(`CAS`, t, func() {
+		ctx := context.Background()
+		c := &CAS{}
+		h := sha256.Sum256([]byte("content"))
+		hash := hex.EncodeToString(h[:])
+
+		upload := func(hash string, data string) error {
+			w, err := c.NewWriter(ctx)
+			So(err, ShouldBeNil)
+			defer w.CloseSend()
+			err = w.Send(&bytestream.WriteRequest{
+				ResourceName: fmt.Sprintf("instance/uploads/uuid/blobs/%s/%d", hash, len(data)),
+				Data:         []byte(data),
+				FinishWrite:  true,
+			})
+			So(err, ShouldBeNil)
+			_, err = w.CloseAndRecv()
+			return err
+		}
+		download := func(hash string) (string, error) {
+			r, err := c.Read(ctx, &bytestream.ReadRequest{
+				ResourceName: fmt.Sprintf("instance/blobs/%s/7", hash),
+			})
+			if err != nil {
+				return "", err
+			}
+			var data []byte
+			for {
+				res, err := r.Recv()
+				switch {
+				case err == io.EOF:
+					return string(data), nil
+				case err != nil:
+					return "", err
+				}
+				data = append(data, res.Data...)
+			}
+		}
+
+		So(upload(hash, "content"), ShouldBeNil)
+		data, err := download(hash)
+		So(err, ShouldBeNil)
+		So(data, ShouldEqual, "content")
+
+		err = upload(hash, "tnetnoc")
+		So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+
+		_, err = download("missing")
+		So(status.Code(err), ShouldEqual, codes.NotFound)
+	})
+}
@@ -127,6 +127,9 @@ type TestResultRow struct {
 
 	// TestLocation is the location of the test definition.
 	TestLocation *TestLocation `bigquery:"test_location"`
+
+	// TestMetadata is information about the test, e.g. its owners.
+	TestMetadata *TestMetadata `bigquery:"test_metadata"`
 }
 
 // TestLocation is a location of a test definition, e.g. the file name.
@@ -136,6 +139,14 @@ type TestLocation struct {
 	Line     int    `bigquery:"line"`
 }
 
+// TestMetadata is information about a test, e.g. its owners and bug component.
+// For field description, see the comments in the TestMetadata protobuf message.
+type TestMetadata struct {
+	Owners       []string      `bigquery:"owners"`
+	Location     *TestLocation `bigquery:"location"`
+	BugComponent string        `bigquery:"bug_component"`
+}
+
 // Name returns test result name.
 func (tr *TestResultRow) Name() string {
 	return pbutil.TestResultName(tr.ParentInvocation.ID, tr.TestID, tr.ResultID)
@@ -234,9 +245,37 @@ func (i *rowInput) row() *TestResultRow {
 		}
 	}
 
+	if md := tr.TestMetadata; md != nil {
+		ret.TestMetadata = &TestMetadata{
+			Owners:       md.Owners,
+			BugComponent: md.BugComponent,
+		}
+		if md.Location != nil {
+			ret.TestMetadata.Location = &TestLocation{
+				FileName: md.Location.FileName,
+				Line:     int(md.Location.Line),
+			}
+		}
+	}
+
 	return ret
 }
 
+// NewTestResultRow returns a row for the test result tr.
+// exported is the invocation being exported and parent is the immediate
+// parent invocation of tr. They may be the same.
+//
+// Used by exporters of other destinations to produce rows with the same schema.
+func NewTestResultRow(exported, parent *pb.Invocation, tr *pb.TestResult, exonerated bool) *TestResultRow {
+	input := &rowInput{
+		exported:   exported,
+		parent:     parent,
+		tr:         tr,
+		exonerated: exonerated,
+	}
+	return input.row()
+}
+
 // generateBQRow returns a *bigquery.StructSaver to be inserted into BQ.
 func (b *bqExporter) generateBQRow(input *rowInput) *bigquery.StructSaver {
 	ret := &bigquery.StructSaver{Struct: input.row()}
@@ -53,5 +53,24 @@ func TestGenerateBQRow(t *testing.T) {
 				Line:     54,
 			})
 		})
+		Convey("TestMetadata", func() {
+			input.tr.TestMetadata = &pb.TestMetadata{
+				Owners: []string{"someone@example.com"},
+				Location: &pb.TestLocation{
+					FileName: "//a_test.go",
+					Line:     54,
+				},
+				BugComponent: "Infra>Test",
+			}
+			actual := input.row()
+			So(actual.TestMetadata, ShouldResemble, &TestMetadata{
+				Owners: []string{"someone@example.com"},
+				Location: &TestLocation{
+					FileName: "//a_test.go",
+					Line:     54,
+				},
+				BugComponent: "Infra>Test",
+			})
+		})
 	})
 }
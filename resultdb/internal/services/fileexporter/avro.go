@@ -0,0 +1,375 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileexporter
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math"
+	"reflect"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/resultdb/internal/services/bqexporter"
+)
+
+// This file implements writing of Avro Object Container Files.
+// Spec: https://avro.apache.org/docs/1.10.0/spec.html#Object+Container+Files
+//
+// The Avro schema of the file is derived from the BigQuery schema of
+// bqexporter.TestResultRow, so that exported files have the same schema as the
+// BigQuery tables.
+
+// avroMagic is the first 4 bytes of an Avro Object Container File.
+const avroMagic = "Obj\x01"
+
+// avroBlockSize is the approximate maximum size of an uncompressed block.
+const avroBlockSize = 1 << 20 // 1 MiB
+
+// avroFormat implements Format.
+type avroFormat struct {
+	schema bigquery.Schema
+}
+
+// Extension implements Format.
+func (f *avroFormat) Extension() string {
+	return ".avro"
+}
+
+// NewRowWriter implements Format.
+func (f *avroFormat) NewRowWriter(w io.Writer) (RowWriter, error) {
+	return newAvroWriter(w, "TestResultRow", f.schema)
+}
+
+// avroWriter writes rows to an Avro Object Container File.
+// Data blocks are compressed with the "deflate" codec.
+type avroWriter struct {
+	w      io.Writer
+	schema bigquery.Schema
+	sync   [16]byte
+
+	// block contains encoded rows of the current block.
+	block avroEncoder
+	// count is the number of rows in the current block.
+	count int
+}
+
+func newAvroWriter(w io.Writer, name string, schema bigquery.Schema) (*avroWriter, error) {
+	aw := &avroWriter{w: w, schema: schema}
+	if _, err := rand.Read(aw.sync[:]); err != nil {
+		return nil, err
+	}
+
+	schemaJSON, err := json.Marshal(avroRecordSchema(name, schema))
+	if err != nil {
+		return nil, err
+	}
+
+	var h avroEncoder
+	h.buf.WriteString(avroMagic)
+	// File metadata is an Avro map<bytes>.
+	h.long(2)
+	h.string("avro.codec")
+	h.bytes([]byte("deflate"))
+	h.string("avro.schema")
+	h.bytes(schemaJSON)
+	h.long(0)
+	h.buf.Write(aw.sync[:])
+	if _, err := w.Write(h.buf.Bytes()); err != nil {
+		return nil, err
+	}
+	return aw, nil
+}
+
+// Write implements RowWriter.
+func (w *avroWriter) Write(row *bqexporter.TestResultRow) error {
+	saver := &bigquery.StructSaver{Struct: row, Schema: w.schema}
+	values, _, err := saver.Save()
+	if err != nil {
+		return err
+	}
+	if err := w.block.record(w.schema, values); err != nil {
+		return errors.Annotate(err, "failed to encode %s", row.Name()).Err()
+	}
+	w.count++
+
+	if w.block.buf.Len() >= avroBlockSize {
+		return w.flush()
+	}
+	return nil
+}
+
+// Close implements RowWriter.
+func (w *avroWriter) Close() error {
+	return w.flush()
+}
+
+// flush writes the current block, if any.
+func (w *avroWriter) flush() error {
+	if w.count == 0 {
+		return nil
+	}
+
+	var data bytes.Buffer
+	fw, err := flate.NewWriter(&data, flate.DefaultCompression)
+	if err != nil {
+		return err
+	}
+	if _, err := fw.Write(w.block.buf.Bytes()); err != nil {
+		return err
+	}
+	if err := fw.Close(); err != nil {
+		return err
+	}
+
+	var h avroEncoder
+	h.long(int64(w.count))
+	h.long(int64(data.Len()))
+	for _, b := range [][]byte{h.buf.Bytes(), data.Bytes(), w.sync[:]} {
+		if _, err := w.w.Write(b); err != nil {
+			return err
+		}
+	}
+
+	w.block.buf.Reset()
+	w.count = 0
+	return nil
+}
+
+// avroField is a field of an Avro record schema.
+type avroField struct {
+	Name string      `json:"name"`
+	Type interface{} `json:"type"`
+}
+
+// avroRecord is an Avro record schema.
+type avroRecord struct {
+	Type   string      `json:"type"`
+	Name   string      `json:"name"`
+	Fields []avroField `json:"fields"`
+}
+
+// avroRecordSchema returns an Avro record schema equivalent to the BigQuery
+// schema.
+//
+// Avro requires record names to be unique within a schema, so names of nested
+// records are prefixed with the name of the enclosing record.
+func avroRecordSchema(name string, schema bigquery.Schema) *avroRecord {
+	ret := &avroRecord{
+		Type:   "record",
+		Name:   name,
+		Fields: make([]avroField, len(schema)),
+	}
+	for i, f := range schema {
+		var typ interface{}
+		switch f.Type {
+		case bigquery.StringFieldType:
+			typ = "string"
+		case bigquery.BooleanFieldType:
+			typ = "boolean"
+		case bigquery.IntegerFieldType:
+			typ = "long"
+		case bigquery.FloatFieldType:
+			typ = "double"
+		case bigquery.TimestampFieldType:
+			typ = map[string]string{"type": "long", "logicalType": "timestamp-micros"}
+		case bigquery.RecordFieldType:
+			typ = avroRecordSchema(name+"_"+f.Name, f.Schema)
+		default:
+			panic(errors.Reason("field %q: unsupported type %s", f.Name, f.Type).Err())
+		}
+
+		switch {
+		case f.Repeated:
+			typ = map[string]interface{}{"type": "array", "items": typ}
+		case avroNullable(f):
+			typ = []interface{}{"null", typ}
+		}
+		ret.Fields[i] = avroField{Name: f.Name, Type: typ}
+	}
+	return ret
+}
+
+// avroNullable returns true if the non-repeated field is a union with null.
+//
+// Nested records are always nullable because bigquery.InferSchema marks
+// pointers to structs as required.
+func avroNullable(f *bigquery.FieldSchema) bool {
+	return !f.Required || f.Type == bigquery.RecordFieldType
+}
+
+// avroEncoder encodes values in Avro binary encoding.
+type avroEncoder struct {
+	buf     bytes.Buffer
+	scratch [binary.MaxVarintLen64]byte
+}
+
+func (e *avroEncoder) long(v int64) {
+	// binary.PutVarint uses zig-zag encoding, same as Avro.
+	n := binary.PutVarint(e.scratch[:], v)
+	e.buf.Write(e.scratch[:n])
+}
+
+func (e *avroEncoder) bytes(v []byte) {
+	e.long(int64(len(v)))
+	e.buf.Write(v)
+}
+
+func (e *avroEncoder) string(v string) {
+	e.long(int64(len(v)))
+	e.buf.WriteString(v)
+}
+
+func (e *avroEncoder) boolean(v bool) {
+	if v {
+		e.buf.WriteByte(1)
+	} else {
+		e.buf.WriteByte(0)
+	}
+}
+
+func (e *avroEncoder) double(v float64) {
+	binary.LittleEndian.PutUint64(e.scratch[:8], math.Float64bits(v))
+	e.buf.Write(e.scratch[:8])
+}
+
+// record encodes values produced by bigquery.StructSaver.
+func (e *avroEncoder) record(schema bigquery.Schema, values map[string]bigquery.Value) error {
+	for _, f := range schema {
+		if err := e.field(f, values[f.Name]); err != nil {
+			return errors.Annotate(err, "%s", f.Name).Err()
+		}
+	}
+	return nil
+}
+
+func (e *avroEncoder) field(f *bigquery.FieldSchema, v bigquery.Value) error {
+	v, null := unwrapNull(v)
+
+	switch {
+	case f.Repeated:
+		// Write all items in one block.
+		if !null {
+			items := reflect.ValueOf(v)
+			if items.Kind() != reflect.Slice {
+				return errors.Reason("unexpected value of type %T for a repeated field", v).Err()
+			}
+			if n := items.Len(); n > 0 {
+				e.long(int64(n))
+				for i := 0; i < n; i++ {
+					if err := e.value(f, items.Index(i).Interface()); err != nil {
+						return errors.Annotate(err, "item %d", i).Err()
+					}
+				}
+			}
+		}
+		e.long(0)
+		return nil
+
+	case !avroNullable(f) && null:
+		return errors.Reason("required value is missing").Err()
+
+	case !avroNullable(f):
+		return e.value(f, v)
+
+	case null:
+		// The index of "null" in the union.
+		e.long(0)
+		return nil
+
+	default:
+		e.long(1)
+		return e.value(f, v)
+	}
+}
+
+func (e *avroEncoder) value(f *bigquery.FieldSchema, v bigquery.Value) error {
+	ok := false
+	switch f.Type {
+	case bigquery.StringFieldType:
+		var s string
+		if s, ok = v.(string); ok {
+			e.string(s)
+		}
+
+	case bigquery.BooleanFieldType:
+		var b bool
+		if b, ok = v.(bool); ok {
+			e.boolean(b)
+		}
+
+	case bigquery.IntegerFieldType:
+		rv := reflect.ValueOf(v)
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			e.long(rv.Int())
+			ok = true
+		}
+
+	case bigquery.FloatFieldType:
+		var d float64
+		if d, ok = v.(float64); ok {
+			e.double(d)
+		}
+
+	case bigquery.TimestampFieldType:
+		var t time.Time
+		if t, ok = v.(time.Time); ok {
+			e.long(t.UnixNano() / int64(time.Microsecond))
+		}
+
+	case bigquery.RecordFieldType:
+		var m map[string]bigquery.Value
+		if m, ok = v.(map[string]bigquery.Value); ok {
+			return e.record(f.Schema, m)
+		}
+
+	default:
+		return errors.Reason("unsupported type %s", f.Type).Err()
+	}
+
+	if !ok {
+		return errors.Reason("unexpected value of type %T for a %s field", v, f.Type).Err()
+	}
+	return nil
+}
+
+// unwrapNull unwraps bigquery.Null* values.
+// Returns true if the value is null.
+func unwrapNull(v bigquery.Value) (bigquery.Value, bool) {
+	switch v := v.(type) {
+	case nil:
+		return nil, true
+	case bigquery.NullString:
+		return v.StringVal, !v.Valid
+	case bigquery.NullBool:
+		return v.Bool, !v.Valid
+	case bigquery.NullInt64:
+		return v.Int64, !v.Valid
+	case bigquery.NullFloat64:
+		return v.Float64, !v.Valid
+	case bigquery.NullTimestamp:
+		return v.Timestamp, !v.Valid
+	default:
+		return v, false
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileexporter
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	"go.chromium.org/luci/resultdb/internal/services/bqexporter"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// avroDecoder decodes Avro binary encoding.
+// It is the inverse of avroEncoder.
+type avroDecoder struct {
+	r *bufio.Reader
+}
+
+func (d *avroDecoder) long() int64 {
+	v, err := binary.ReadVarint(d.r)
+	So(err, ShouldBeNil)
+	return v
+}
+
+func (d *avroDecoder) bytes() []byte {
+	buf := make([]byte, d.long())
+	_, err := io.ReadFull(d.r, buf)
+	So(err, ShouldBeNil)
+	return buf
+}
+
+func (d *avroDecoder) record(schema bigquery.Schema) map[string]interface{} {
+	ret := map[string]interface{}{}
+	for _, f := range schema {
+		switch {
+		case f.Repeated:
+			var items []interface{}
+			for n := d.long(); n != 0; n = d.long() {
+				for i := int64(0); i < n; i++ {
+					items = append(items, d.value(f))
+				}
+			}
+			ret[f.Name] = items
+		case avroNullable(f) && d.long() == 0:
+			ret[f.Name] = nil
+		default:
+			ret[f.Name] = d.value(f)
+		}
+	}
+	return ret
+}
+
+func (d *avroDecoder) value(f *bigquery.FieldSchema) interface{} {
+	switch f.Type {
+	case bigquery.StringFieldType:
+		return string(d.bytes())
+	case bigquery.BooleanFieldType:
+		b, err := d.r.ReadByte()
+		So(err, ShouldBeNil)
+		return b == 1
+	case bigquery.IntegerFieldType, bigquery.TimestampFieldType:
+		return d.long()
+	case bigquery.FloatFieldType:
+		var buf [8]byte
+		_, err := io.ReadFull(d.r, buf[:])
+		So(err, ShouldBeNil)
+		return math.Float64frombits(binary.LittleEndian.Uint64(buf[:]))
+	case bigquery.RecordFieldType:
+		return d.record(f.Schema)
+	default:
+		panic("impossible")
+	}
+}
+
+// readAvroFile decodes an Avro Object Container File written by avroWriter.
+func readAvroFile(r io.Reader, schema bigquery.Schema) (metadata map[string]string, rows []map[string]interface{}) {
+	d := &avroDecoder{r: bufio.NewReader(r)}
+
+	magic := make([]byte, 4)
+	_, err := io.ReadFull(d.r, magic)
+	So(err, ShouldBeNil)
+	So(string(magic), ShouldEqual, avroMagic)
+
+	metadata = map[string]string{}
+	for n := d.long(); n != 0; n = d.long() {
+		for i := int64(0); i < n; i++ {
+			key := string(d.bytes())
+			metadata[key] = string(d.bytes())
+		}
+	}
+	sync := make([]byte, 16)
+	_, err = io.ReadFull(d.r, sync)
+	So(err, ShouldBeNil)
+
+	for {
+		if _, err := d.r.Peek(1); err == io.EOF {
+			return
+		}
+		count := d.long()
+		compressed := d.bytes()
+		blockSync := make([]byte, 16)
+		_, err := io.ReadFull(d.r, blockSync)
+		So(err, ShouldBeNil)
+		So(blockSync, ShouldResemble, sync)
+
+		data, err := ioutil.ReadAll(flate.NewReader(bytes.NewReader(compressed)))
+		So(err, ShouldBeNil)
+		bd := &avroDecoder{r: bufio.NewReader(bytes.NewReader(data))}
+		for i := int64(0); i < count; i++ {
+			rows = append(rows, bd.record(schema))
+		}
+		_, err = bd.r.ReadByte()
+		So(err, ShouldEqual, io.EOF)
+	}
+}
+
+func TestAvro(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Avro`, t, func() {
+		schema, err := bigquery.InferSchema(&bqexporter.TestResultRow{})
+		So(err, ShouldBeNil)
+		now := testclock.TestRecentTimeUTC
+
+		row := &bqexporter.TestResultRow{
+			ExportedInvocation: bqexporter.Invocation{
+				ID:    "inv",
+				Tags:  []bqexporter.StringPair{{Key: "k", Value: "v"}},
+				Realm: "testproject:testrealm",
+			},
+			ParentInvocation: bqexporter.Invocation{ID: "inv"},
+			TestID:           "ninja://chrome/test:foo_tests/BarTest.DoBaz",
+			ResultID:         "result1",
+			Variant:          []bqexporter.StringPair{{Key: "a", Value: "b"}},
+			VariantHash:      "deadbeef",
+			Status:           "FAIL",
+			StartTime:        bigquery.NullTimestamp{Timestamp: now, Valid: true},
+			Duration:         bigquery.NullFloat64{Float64: 1.5, Valid: true},
+			Exonerated:       true,
+			PartitionTime:    now,
+			TestMetadata: &bqexporter.TestMetadata{
+				Owners:   []string{"someone@example.com"},
+				Location: &bqexporter.TestLocation{FileName: "//a_test.go", Line: 54},
+			},
+		}
+		expected := map[string]interface{}{
+			"exported": map[string]interface{}{
+				"id":    "inv",
+				"tags":  []interface{}{map[string]interface{}{"key": "k", "value": "v"}},
+				"realm": "testproject:testrealm",
+			},
+			"parent": map[string]interface{}{
+				"id":    "inv",
+				"tags":  []interface{}(nil),
+				"realm": "",
+			},
+			"test_id":        "ninja://chrome/test:foo_tests/BarTest.DoBaz",
+			"result_id":      "result1",
+			"variant":        []interface{}{map[string]interface{}{"key": "a", "value": "b"}},
+			"variant_hash":   "deadbeef",
+			"expected":       false,
+			"status":         "FAIL",
+			"summary_html":   "",
+			"start_time":     now.UnixNano() / int64(time.Microsecond),
+			"duration":       1.5,
+			"tags":           []interface{}(nil),
+			"exonerated":     true,
+			"partition_time": now.UnixNano() / int64(time.Microsecond),
+			"test_location":  nil,
+			"test_metadata": map[string]interface{}{
+				"owners": []interface{}{"someone@example.com"},
+				"location": map[string]interface{}{
+					"file_name": "//a_test.go",
+					"line":      int64(54),
+				},
+				"bug_component": "",
+			},
+		}
+
+		var buf bytes.Buffer
+		w, err := newAvroWriter(&buf, "TestResultRow", schema)
+		So(err, ShouldBeNil)
+
+		Convey(`Round trip`, func() {
+			So(w.Write(row), ShouldBeNil)
+			So(w.Close(), ShouldBeNil)
+
+			metadata, rows := readAvroFile(&buf, schema)
+			So(metadata["avro.codec"], ShouldEqual, "deflate")
+			So(rows, ShouldResemble, []map[string]interface{}{expected})
+
+			var parsed map[string]interface{}
+			So(json.Unmarshal([]byte(metadata["avro.schema"]), &parsed), ShouldBeNil)
+			So(parsed["name"], ShouldEqual, "TestResultRow")
+			So(parsed["fields"], ShouldHaveLength, len(schema))
+		})
+
+		Convey(`Multiple blocks`, func() {
+			row.SummaryHTML = string(make([]byte, avroBlockSize/2))
+			for i := 0; i < 5; i++ {
+				So(w.Write(row), ShouldBeNil)
+			}
+			So(w.Close(), ShouldBeNil)
+
+			_, rows := readAvroFile(&buf, schema)
+			So(rows, ShouldHaveLength, 5)
+		})
+
+		Convey(`No rows`, func() {
+			So(w.Close(), ShouldBeNil)
+			_, rows := readAvroFile(&buf, schema)
+			So(rows, ShouldBeEmpty)
+		})
+	})
+
+	Convey(`avroRecordSchema`, t, func() {
+		type child struct {
+			Name string `bigquery:"name"`
+		}
+		type parent struct {
+			Count    int                    `bigquery:"count"`
+			Score    bigquery.NullFloat64   `bigquery:"score"`
+			Labels   []string               `bigquery:"labels"`
+			Child    *child                 `bigquery:"child"`
+			Children []child                `bigquery:"children"`
+			Time     bigquery.NullTimestamp `bigquery:"time"`
+		}
+		schema, err := bigquery.InferSchema(&parent{})
+		So(err, ShouldBeNil)
+
+		actual, err := json.Marshal(avroRecordSchema("Parent", schema))
+		So(err, ShouldBeNil)
+		So(string(actual), ShouldEqual, `{"type":"record","name":"Parent","fields":[`+
+			`{"name":"count","type":"long"},`+
+			`{"name":"score","type":["null","double"]},`+
+			`{"name":"labels","type":{"items":"string","type":"array"}},`+
+			`{"name":"child","type":["null",{"type":"record","name":"Parent_child","fields":[{"name":"name","type":"string"}]}]},`+
+			`{"name":"children","type":{"items":{"type":"record","name":"Parent_children","fields":[{"name":"name","type":"string"}]},"type":"array"}},`+
+			`{"name":"time","type":["null",{"logicalType":"timestamp-micros","type":"long"}]}`+
+			`]}`)
+	})
+}
@@ -0,0 +1,163 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileexporter
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/gcloud/gs"
+	"go.chromium.org/luci/server/auth"
+)
+
+// Bucket is a blob store for exported files.
+type Bucket interface {
+	// NewWriter returns a writer of a file.
+	// The path is slash-separated and relative to the bucket root.
+	//
+	// The file must not be observable until the writer is closed successfully.
+	// If the file already exists, it is overwritten.
+	NewWriter(ctx context.Context, path string) (FileWriter, error)
+}
+
+// FileWriter writes a file to a Bucket.
+type FileWriter interface {
+	io.Writer
+
+	// Close commits the file.
+	Close() error
+
+	// Abort discards the file.
+	Abort()
+}
+
+// NewBucket returns a Bucket for the destination, which is either
+// a "gs://bucket/prefix" Google Storage path or a local directory.
+func NewBucket(dest string) (Bucket, error) {
+	if strings.HasPrefix(dest, "gs://") {
+		root := gs.Path(dest)
+		if root.Bucket() == "" {
+			return nil, errors.Reason("%q: bucket is unspecified", dest).Err()
+		}
+		return &gsBucket{root: root}, nil
+	}
+
+	if dest == "" {
+		return nil, errors.Reason("destination is unspecified").Err()
+	}
+	dir, err := filepath.Abs(dest)
+	if err != nil {
+		return nil, err
+	}
+	return DirBucket(dir), nil
+}
+
+// DirBucket is a Bucket backed by a local directory.
+type DirBucket string
+
+// NewWriter implements Bucket.
+//
+// The file is written to a temporary file next to the destination and
+// then renamed.
+func (b DirBucket) NewWriter(ctx context.Context, path string) (FileWriter, error) {
+	name := filepath.Join(string(b), filepath.FromSlash(path))
+	dir := filepath.Dir(name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	f, err := ioutil.TempFile(dir, "."+filepath.Base(name)+".tmp")
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Chmod(0644); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	return &dirFileWriter{File: f, name: name}, nil
+}
+
+type dirFileWriter struct {
+	*os.File
+	name string
+}
+
+// Close implements FileWriter.
+func (w *dirFileWriter) Close() error {
+	if err := w.File.Close(); err != nil {
+		os.Remove(w.File.Name())
+		return err
+	}
+	return os.Rename(w.File.Name(), w.name)
+}
+
+// Abort implements FileWriter.
+func (w *dirFileWriter) Abort() {
+	w.File.Close()
+	os.Remove(w.File.Name())
+}
+
+// gsBucket is a Bucket backed by Google Storage.
+type gsBucket struct {
+	root gs.Path
+}
+
+// NewWriter implements Bucket.
+func (b *gsBucket) NewWriter(ctx context.Context, path string) (FileWriter, error) {
+	tr, err := auth.GetRPCTransport(ctx, auth.AsSelf, auth.WithScopes(gs.ReadWriteScopes...))
+	if err != nil {
+		return nil, err
+	}
+
+	// Canceling the context aborts the upload.
+	ctx, cancel := context.WithCancel(ctx)
+	client, err := gs.NewProdClient(ctx, tr)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	w, err := client.NewWriter(b.root.Concat(path))
+	if err != nil {
+		client.Close()
+		cancel()
+		return nil, err
+	}
+	return &gsFileWriter{Writer: w, client: client, cancel: cancel}, nil
+}
+
+type gsFileWriter struct {
+	gs.Writer
+	client gs.Client
+	cancel context.CancelFunc
+}
+
+// Close implements FileWriter.
+func (w *gsFileWriter) Close() error {
+	defer w.cancel()
+	defer w.client.Close()
+	return w.Writer.Close()
+}
+
+// Abort implements FileWriter.
+func (w *gsFileWriter) Abort() {
+	w.cancel()
+	w.client.Close()
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileexporter
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestBucket(t *testing.T) {
+	t.Parallel()
+
+	Convey(`NewBucket`, t, func() {
+		Convey(`gs`, func() {
+			b, err := NewBucket("gs://bucket/prefix")
+			So(err, ShouldBeNil)
+			So(b, ShouldResemble, &gsBucket{root: "gs://bucket/prefix"})
+		})
+
+		Convey(`gs without bucket`, func() {
+			_, err := NewBucket("gs://")
+			So(err, ShouldErrLike, "bucket is unspecified")
+		})
+
+		Convey(`dir`, func() {
+			b, err := NewBucket("/tmp/export")
+			So(err, ShouldBeNil)
+			So(b, ShouldEqual, DirBucket("/tmp/export"))
+		})
+
+		Convey(`empty`, func() {
+			_, err := NewBucket("")
+			So(err, ShouldErrLike, "destination is unspecified")
+		})
+	})
+
+	Convey(`DirBucket`, t, func() {
+		ctx := context.Background()
+		dir, err := ioutil.TempDir("", "fileexporter")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		b := DirBucket(dir)
+		name := filepath.Join(dir, "a", "b.txt")
+
+		w, err := b.NewWriter(ctx, "a/b.txt")
+		So(err, ShouldBeNil)
+		_, err = w.Write([]byte("hello"))
+		So(err, ShouldBeNil)
+
+		Convey(`Close`, func() {
+			// The file is not observable until closed.
+			_, err := os.Stat(name)
+			So(os.IsNotExist(err), ShouldBeTrue)
+
+			So(w.Close(), ShouldBeNil)
+			contents, err := ioutil.ReadFile(name)
+			So(err, ShouldBeNil)
+			So(string(contents), ShouldEqual, "hello")
+
+			files, err := ioutil.ReadDir(filepath.Dir(name))
+			So(err, ShouldBeNil)
+			So(files, ShouldHaveLength, 1)
+		})
+
+		Convey(`Abort`, func() {
+			w.Abort()
+			files, err := ioutil.ReadDir(filepath.Dir(name))
+			So(err, ShouldBeNil)
+			So(files, ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,249 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fileexporter exports test results of finalized invocations to files.
+//
+// It is an alternative to bqexporter for deployments without BigQuery.
+// Rows have the same schema as bqexporter.TestResultRow. Files are written to
+// a Bucket, e.g. a local directory or a Google Storage bucket, and are
+// partitioned by realm and day of the invocation creation, e.g.
+// "realm=chromium:ci/day=2020-08-14/build-1234.avro".
+//
+// File formats are pluggable, see Format.
+package fileexporter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server"
+	"go.chromium.org/luci/server/span"
+
+	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/services/bqexporter"
+	"go.chromium.org/luci/resultdb/internal/spanutil"
+	"go.chromium.org/luci/resultdb/internal/tasks"
+	"go.chromium.org/luci/resultdb/internal/testresults"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// Format is a file format of exported test results.
+type Format interface {
+	// Extension returns the file name extension, e.g. ".avro".
+	Extension() string
+
+	// NewRowWriter returns a RowWriter that writes a file to w.
+	NewRowWriter(w io.Writer) (RowWriter, error)
+}
+
+// RowWriter writes test result rows to a file.
+type RowWriter interface {
+	// Write writes a row.
+	Write(row *bqexporter.TestResultRow) error
+
+	// Close flushes buffered rows.
+	// It does not close the underlying io.Writer.
+	Close() error
+}
+
+// Formats are supported file formats, keyed by name.
+var Formats = map[string]Format{}
+
+func init() {
+	schema, err := bigquery.InferSchema(&bqexporter.TestResultRow{})
+	if err != nil {
+		panic(err)
+	}
+	Formats["avro"] = &avroFormat{schema: schema}
+}
+
+// FormatNames returns sorted names of supported file formats.
+func FormatNames() []string {
+	names := make([]string, 0, len(Formats))
+	for name := range Formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Options is fileexporter configuration.
+type Options struct {
+	// How often to query for tasks.
+	TaskQueryInterval time.Duration
+
+	// How long to lease a task for.
+	TaskLeaseDuration time.Duration
+
+	// Number of invocations to export concurrently.
+	TaskWorkers int
+
+	// Destination is where to write files to.
+	// Either a "gs://bucket/prefix" Google Storage path or a local directory.
+	Destination string
+
+	// Format is the name of the file format, see Formats.
+	Format string
+}
+
+// DefaultOptions returns Options with default values.
+func DefaultOptions() Options {
+	return Options{
+		TaskLeaseDuration: 10 * time.Minute,
+		TaskQueryInterval: 5 * time.Second,
+		TaskWorkers:       10,
+		Format:            "avro",
+	}
+}
+
+type fileExporter struct {
+	bucket Bucket
+	format Format
+}
+
+// InitServer initializes a fileexporter server.
+func InitServer(srv *server.Server, opts Options) error {
+	format, ok := Formats[opts.Format]
+	if !ok {
+		return errors.Reason("unknown format %q; supported formats: %s", opts.Format, strings.Join(FormatNames(), ", ")).Err()
+	}
+	bucket, err := NewBucket(opts.Destination)
+	if err != nil {
+		return errors.Annotate(err, "invalid destination").Err()
+	}
+
+	e := &fileExporter{bucket: bucket, format: format}
+	d := tasks.Dispatcher{
+		Workers:       opts.TaskWorkers,
+		LeaseDuration: opts.TaskLeaseDuration,
+		QueryInterval: opts.TaskQueryInterval,
+	}
+	srv.RunInBackground("fileexport", func(ctx context.Context) {
+		d.Run(ctx, tasks.FileExport, e.exportResultsToFile)
+	})
+	return nil
+}
+
+// filePath returns the path of the file for the invocation, relative to the
+// bucket root.
+func filePath(inv *pb.Invocation, ext string) string {
+	day := pbutil.MustTimestamp(inv.CreateTime).UTC().Format("2006-01-02")
+	return fmt.Sprintf("realm=%s/day=%s/%s%s", inv.Realm, day, invocations.MustParseName(inv.Name), ext)
+}
+
+// exportResultsToFile exports test results of an invocation to a file.
+//
+// Only results directly in the invocation are exported, not the results of
+// included invocations, because the latter get exported on their own.
+func (e *fileExporter) exportResultsToFile(ctx context.Context, invID invocations.ID, payload []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	defer cancel()
+
+	ctx, cancelTxn := span.ReadOnlyTransaction(ctx)
+	defer cancelTxn()
+
+	inv, err := invocations.Read(ctx, invID)
+	if err != nil {
+		return err
+	}
+	if inv.State != pb.Invocation_FINALIZED {
+		return errors.Reason("%s is not finalized yet", invID.Name()).Err()
+	}
+
+	exonerated, err := queryExoneratedTestVariants(ctx, invID)
+	if err != nil {
+		return err
+	}
+
+	path := filePath(inv, e.format.Extension())
+	fw, err := e.bucket.NewWriter(ctx, path)
+	if err != nil {
+		return errors.Annotate(err, "failed to create %q", path).Err()
+	}
+
+	count, err := e.writeTestResults(ctx, fw, inv, exonerated)
+	if err != nil || count == 0 {
+		// Do not create empty files.
+		fw.Abort()
+		return err
+	}
+	if err := fw.Close(); err != nil {
+		return errors.Annotate(err, "failed to write %q", path).Err()
+	}
+	logging.Infof(ctx, "exported %d rows to %q", count, path)
+	return nil
+}
+
+// writeTestResults writes test results of the invocation to w.
+// Returns the number of written rows.
+func (e *fileExporter) writeTestResults(ctx context.Context, w io.Writer, inv *pb.Invocation, exonerated map[testVariantKey]struct{}) (count int, err error) {
+	rw, err := e.format.NewRowWriter(w)
+	if err != nil {
+		return 0, err
+	}
+
+	q := testresults.Query{
+		InvocationIDs: invocations.NewIDSet(invocations.MustParseName(inv.Name)),
+		Mask:          testresults.AllFields,
+	}
+	err = q.Run(ctx, func(tr *pb.TestResult) error {
+		_, ok := exonerated[testVariantKey{testID: tr.TestId, variantHash: tr.VariantHash}]
+		count++
+		return rw.Write(bqexporter.NewTestResultRow(inv, inv, tr, ok))
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, rw.Close()
+}
+
+type testVariantKey struct {
+	testID      string
+	variantHash string
+}
+
+// queryExoneratedTestVariants reads test variants exonerated in the
+// invocation.
+func queryExoneratedTestVariants(ctx context.Context, invID invocations.ID) (map[testVariantKey]struct{}, error) {
+	st := spanner.NewStatement(`
+		SELECT DISTINCT TestId, VariantHash,
+		FROM TestExonerations
+		WHERE InvocationId = @invID
+	`)
+	st.Params["invID"] = invID
+	tvs := map[testVariantKey]struct{}{}
+	var b spanutil.Buffer
+	err := spanutil.Query(ctx, st, func(row *spanner.Row) error {
+		var key testVariantKey
+		if err := b.FromSpanner(row, &key.testID, &key.variantHash); err != nil {
+			return err
+		}
+		tvs[key] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tvs, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileexporter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/resultdb/internal/testutil"
+	"go.chromium.org/luci/resultdb/internal/testutil/insert"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestFilePath(t *testing.T) {
+	t.Parallel()
+
+	Convey(`filePath`, t, func() {
+		inv := &pb.Invocation{
+			Name:       "invocations/build-1234",
+			Realm:      "chromium:ci",
+			CreateTime: pbutil.MustTimestampProto(time.Date(2020, 8, 14, 23, 59, 0, 0, time.UTC)),
+		}
+		So(filePath(inv, ".avro"), ShouldEqual, "realm=chromium:ci/day=2020-08-14/build-1234.avro")
+	})
+}
+
+func TestExportResultsToFile(t *testing.T) {
+	Convey(`exportResultsToFile`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		testutil.MustApply(ctx,
+			insert.Invocation("a", pb.Invocation_FINALIZED, map[string]interface{}{"Realm": "testproject:testrealm"}),
+			insert.Invocation("b", pb.Invocation_FINALIZED, map[string]interface{}{"Realm": "testproject:testrealm"}),
+			insert.Invocation("c", pb.Invocation_FINALIZED, map[string]interface{}{"Realm": "testproject:testrealm"}),
+			insert.Invocation("active", pb.Invocation_ACTIVE, nil),
+			insert.Inclusion("a", "b"))
+		testutil.MustApply(ctx, testutil.CombineMutations(
+			insert.TestResults("a", "A", pbutil.Variant("k", "v"), pb.TestStatus_FAIL, pb.TestStatus_PASS),
+			insert.TestExonerations("a", "A", pbutil.Variant("k", "v"), 1),
+			insert.TestResults("a", "C", nil, pb.TestStatus_PASS),
+			// Results of included invocations are not exported.
+			insert.TestResults("b", "B", pbutil.Variant("k", "v"), pb.TestStatus_CRASH),
+		)...)
+
+		dir, err := ioutil.TempDir("", "fileexporter")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		e := &fileExporter{bucket: DirBucket(dir), format: Formats["avro"]}
+		schema := Formats["avro"].(*avroFormat).schema
+
+		Convey(`success`, func() {
+			So(e.exportResultsToFile(ctx, "a", nil), ShouldBeNil)
+
+			files, err := filepath.Glob(filepath.Join(dir, "realm=testproject:testrealm", "day=*", "a.avro"))
+			So(err, ShouldBeNil)
+			So(files, ShouldHaveLength, 1)
+
+			f, err := os.Open(files[0])
+			So(err, ShouldBeNil)
+			defer f.Close()
+			_, rows := readAvroFile(f, schema)
+			So(rows, ShouldHaveLength, 3)
+			for _, r := range rows {
+				So(r["test_id"], ShouldBeIn, []string{"A", "C"})
+				So(r["exonerated"], ShouldEqual, r["test_id"] == "A")
+				So(r["exported"].(map[string]interface{})["id"], ShouldEqual, "a")
+				So(r["parent"].(map[string]interface{})["realm"], ShouldEqual, "testproject:testrealm")
+			}
+		})
+
+		Convey(`no results`, func() {
+			So(e.exportResultsToFile(ctx, "c", nil), ShouldBeNil)
+			files, err := filepath.Glob(filepath.Join(dir, "*", "*", "*"))
+			So(err, ShouldBeNil)
+			So(files, ShouldBeEmpty)
+		})
+
+		Convey(`not finalized`, func() {
+			So(e.exportResultsToFile(ctx, "active", nil), ShouldErrLike, "is not finalized yet")
+		})
+	})
+}
@@ -0,0 +1,430 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package finalizer
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/proto/mask"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/common/trace"
+	"go.chromium.org/luci/grpc/appstatus"
+	"go.chromium.org/luci/server/auth/realms"
+	"go.chromium.org/luci/server/experiments"
+	"go.chromium.org/luci/server/span"
+
+	"go.chromium.org/luci/resultdb/internal/config"
+	"go.chromium.org/luci/resultdb/internal/flakiness"
+	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/spanutil"
+	"go.chromium.org/luci/resultdb/internal/testresults"
+	"go.chromium.org/luci/resultdb/pbutil"
+	configpb "go.chromium.org/luci/resultdb/proto/config"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// UseExonerationRules experiment enables automatic exoneration of unexpected
+// test results by the rules in project configs, when invocations are
+// finalized.
+var UseExonerationRules = experiments.Register("rdb-exoneration-rules")
+
+// defaultKnownFlakyWindow is the default value of KnownFlaky.window.
+const defaultKnownFlakyWindow = 7 * 24 * time.Hour
+
+// maxKnownFlakyTests is the maximum number of tests to analyze for
+// a KnownFlaky rule. If more tests have unexpected results, they are unlikely
+// to be caused by flakiness, so the rule is not applied.
+const maxKnownFlakyTests = 100
+
+// baseResultMask is the mask of test result fields needed to find test
+// variants that fail on the base revision.
+var baseResultMask = mask.MustFromReadMask(&pb.TestResult{},
+	"test_id",
+	"variant_hash",
+	"expected",
+)
+
+// testVariant is a test variant that has unexpected results.
+type testVariant struct {
+	TestID      string
+	VariantHash string
+	Variant     *pb.Variant
+}
+
+type testVariantKey struct {
+	TestID      string
+	VariantHash string
+}
+
+// exonerateByRules applies the exoneration rules of the invocation's project
+// to the test variants that have unexpected results directly in the invocation
+// and are not exonerated yet. The matched test variants are exonerated.
+//
+// Must be called before the invocation is finalized. Idempotent.
+func exonerateByRules(ctx context.Context, invID invocations.ID) (err error) {
+	ctx, ts := trace.StartSpan(ctx, "resultdb.exonerateByRules")
+	defer func() { ts.End(err) }()
+
+	inv, err := invocations.Read(span.Single(ctx), invID)
+	if err != nil {
+		return err
+	}
+	if inv.Realm == "" {
+		return nil
+	}
+	project, _ := realms.Split(inv.Realm)
+	cfg, err := config.Project(ctx, project)
+	switch {
+	case transient.Tag.In(err):
+		return err
+	case err != nil:
+		// A malformed config must not block finalization.
+		logging.Errorf(ctx, "skipping exoneration rules of %s: %s", invID.Name(), err)
+		return nil
+	}
+	if len(cfg.ExonerationRules) == 0 {
+		return nil
+	}
+
+	ms, err := evaluateExonerationRules(ctx, inv, cfg.ExonerationRules)
+	if err != nil || len(ms) == 0 {
+		return err
+	}
+
+	_, err = span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		// Finalized invocations are immutable.
+		if err := ensureFinalizing(ctx, invID); err != nil {
+			return err
+		}
+		span.BufferWrite(ctx, ms...)
+		return nil
+	})
+	switch {
+	case err == errAlreadyFinalized:
+		return nil
+	case err != nil:
+		return errors.Annotate(err, "failed to insert test exonerations").Err()
+	default:
+		logging.Infof(ctx, "exonerated %d test variants of %s by rules", len(ms), invID.Name())
+		return nil
+	}
+}
+
+// evaluateExonerationRules returns mutations that insert exonerations for
+// the test variants matched by the rules.
+func evaluateExonerationRules(ctx context.Context, inv *pb.Invocation, rules []*configpb.ExonerationRule) ([]*spanner.Mutation, error) {
+	ctx, cancel := span.ReadOnlyTransaction(ctx)
+	defer cancel()
+
+	invID := invocations.MustParseName(inv.Name)
+	tvs, err := unexoneratedTestVariants(ctx, invID)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &ruleEvaluator{inv: inv}
+	var ms []*spanner.Mutation
+	for _, rule := range rules {
+		if len(tvs) == 0 {
+			break
+		}
+
+		// LUCI Config validates configs only if the validation endpoint is
+		// reachable, so do not trust them.
+		if err := config.ValidateExonerationRule(ctx, rule); err != nil {
+			logging.Errorf(ctx, "skipping invalid exoneration rule %q: %s", rule.Name, err)
+			continue
+		}
+
+		var matched map[*testVariant]string
+		if matched, err = e.match(ctx, rule, tvs); err != nil {
+			return nil, errors.Annotate(err, "rule %q", rule.Name).Err()
+		}
+
+		// The first matching rule wins, so exclude the matched test variants
+		// from further evaluation.
+		rest := tvs[:0]
+		for _, tv := range tvs {
+			if detail, ok := matched[tv]; ok {
+				ms = append(ms, insertRuleExoneration(invID, rule, tv, detail))
+			} else {
+				rest = append(rest, tv)
+			}
+		}
+		tvs = rest
+	}
+	return ms, nil
+}
+
+// unexoneratedTestVariants returns test variants that have unexpected results
+// directly in the invocation and are not exonerated in it.
+func unexoneratedTestVariants(ctx context.Context, invID invocations.ID) ([]*testVariant, error) {
+	st := spanner.NewStatement(`
+		SELECT tr.TestId, tr.VariantHash, tr.Variant
+		FROM TestResults@{FORCE_INDEX=UnexpectedTestResults} tr
+		WHERE tr.InvocationId = @invID AND tr.IsUnexpected
+			AND NOT EXISTS (
+				SELECT 1
+				FROM TestExonerations te
+				WHERE te.InvocationId = @invID
+					AND te.TestId = tr.TestId
+					AND te.VariantHash = tr.VariantHash
+			)
+	`)
+	st.Params = spanutil.ToSpannerMap(map[string]interface{}{
+		"invID": invID,
+	})
+
+	var ret []*testVariant
+	seen := map[testVariantKey]struct{}{}
+	var b spanutil.Buffer
+	err := span.Query(ctx, st).Do(func(row *spanner.Row) error {
+		tv := &testVariant{}
+		if err := b.FromSpanner(row, &tv.TestID, &tv.VariantHash, &tv.Variant); err != nil {
+			return err
+		}
+		key := testVariantKey{tv.TestID, tv.VariantHash}
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			ret = append(ret, tv)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read unexpected test variants").Err()
+	}
+	return ret, nil
+}
+
+// insertRuleExoneration returns a mutation that inserts an exoneration of the
+// test variant matched by the rule.
+//
+// The exoneration id is deterministic, so that retries do not create
+// duplicates.
+func insertRuleExoneration(invID invocations.ID, rule *configpb.ExonerationRule, tv *testVariant, detail string) *spanner.Mutation {
+	explanation := fmt.Sprintf("Exonerated by rule %q: %s.", rule.Name, detail)
+	return spanutil.InsertOrUpdateMap("TestExonerations", map[string]interface{}{
+		"InvocationId":    invID,
+		"TestId":          tv.TestID,
+		"ExonerationId":   fmt.Sprintf("%s:rule:%s", tv.VariantHash, rule.Name),
+		"Variant":         tv.Variant,
+		"VariantHash":     tv.VariantHash,
+		"ExplanationHTML": spanutil.Compressed(html.EscapeString(explanation)),
+	})
+}
+
+// ruleEvaluator evaluates exoneration rules for an invocation.
+type ruleEvaluator struct {
+	inv *pb.Invocation
+
+	// baseFailures caches failing test variants of base invocations.
+	baseFailures map[invocations.ID]map[testVariantKey]bool
+}
+
+// match returns the test variants that match the rule, mapped to
+// human-readable details of the match.
+func (e *ruleEvaluator) match(ctx context.Context, rule *configpb.ExonerationRule, tvs []*testVariant) (map[*testVariant]string, error) {
+	re, err := config.CompileTestIDRegexp(rule.TestIdRegexp)
+	if err != nil {
+		// evaluateExonerationRules skips invalid rules.
+		return nil, errors.Annotate(err, "invalid test_id_regexp").Err()
+	}
+	var candidates []*testVariant
+	for _, tv := range tvs {
+		if re.MatchString(tv.TestID) {
+			candidates = append(candidates, tv)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	ret := map[*testVariant]string{}
+	switch c := rule.Condition.(type) {
+	case *configpb.ExonerationRule_TrackedBug:
+		for _, tv := range candidates {
+			ret[tv] = fmt.Sprintf("the test is affected by %s", c.TrackedBug.Bug)
+		}
+
+	case *configpb.ExonerationRule_KnownFlaky:
+		rates, err := e.flakeRates(ctx, c.KnownFlaky, candidates)
+		if err != nil {
+			return nil, err
+		}
+		for _, tv := range candidates {
+			if rate, ok := rates[testVariantKey{tv.TestID, tv.VariantHash}]; ok && rate >= c.KnownFlaky.MinFlakeRate {
+				ret[tv] = fmt.Sprintf("the test variant is known to be flaky, with flake rate %.2f", rate)
+			}
+		}
+
+	case *configpb.ExonerationRule_FailsOnBase:
+		baseID, failing, err := e.failingOnBase(ctx, c.FailsOnBase.InvocationTagKey)
+		if err != nil {
+			return nil, err
+		}
+		for _, tv := range candidates {
+			if failing[testVariantKey{tv.TestID, tv.VariantHash}] {
+				ret[tv] = fmt.Sprintf("the test variant also has unexpected results in the base invocation %s", baseID)
+			}
+		}
+	}
+	return ret, nil
+}
+
+// flakeRates returns the flake rates of the test variants in the recent
+// history of the invocation's realm.
+func (e *ruleEvaluator) flakeRates(ctx context.Context, kf *configpb.KnownFlaky, tvs []*testVariant) (map[testVariantKey]float32, error) {
+	testIDs := stringset.New(len(tvs))
+	for _, tv := range tvs {
+		testIDs.Add(tv.TestID)
+	}
+	if testIDs.Len() > maxKnownFlakyTests {
+		logging.Warningf(ctx, "%d tests have unexpected results; not checking their flakiness", testIDs.Len())
+		return nil, nil
+	}
+
+	window := defaultKnownFlakyWindow
+	if kf.Window != nil {
+		window = pbutil.MustDuration(kf.Window)
+	}
+	maxInvocations := int(kf.MaxInvocations)
+	if maxInvocations == 0 {
+		maxInvocations = config.MaxKnownFlakyInvocations
+	}
+
+	now := clock.Now(ctx).UTC()
+	q := &flakiness.Query{
+		Realm:          e.inv.Realm,
+		Earliest:       now.Add(-window),
+		Latest:         now,
+		Predicate:      &pb.TestResultPredicate{TestIdRegexp: testIDsRegexp(testIDs.ToSlice())},
+		MaxInvocations: maxInvocations,
+	}
+	fs, err := q.Run(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to compute flakiness").Err()
+	}
+
+	ret := make(map[testVariantKey]float32, len(fs))
+	for _, f := range fs {
+		ret[testVariantKey{f.TestId, f.VariantHash}] = f.FlakeRate
+	}
+	return ret, nil
+}
+
+// testIDsRegexp returns a regular expression that matches exactly the given
+// test ids.
+func testIDsRegexp(testIDs []string) string {
+	sort.Strings(testIDs)
+	quoted := make([]string, len(testIDs))
+	for i, id := range testIDs {
+		quoted[i] = regexp.QuoteMeta(id)
+	}
+	return "(?:" + strings.Join(quoted, "|") + ")"
+}
+
+// failingOnBase returns the ID of the base invocation, specified in the tag
+// of the invocation, and the test variants that have unexpected results in it.
+//
+// Returns an empty ID if the invocation does not have a valid base invocation.
+func (e *ruleEvaluator) failingOnBase(ctx context.Context, tagKey string) (baseID invocations.ID, failing map[testVariantKey]bool, err error) {
+	for _, t := range e.inv.Tags {
+		if t.Key == tagKey {
+			baseID = invocations.ID(t.Value)
+			break
+		}
+	}
+	switch {
+	case baseID == "":
+		return "", nil, nil
+	case pbutil.ValidateInvocationID(string(baseID)) != nil:
+		logging.Warningf(ctx, "invalid base invocation id %q in tag %q", baseID, tagKey)
+		return "", nil, nil
+	}
+
+	if failing, ok := e.baseFailures[baseID]; ok {
+		return baseID, failing, nil
+	}
+
+	switch ok, err := e.sameProject(ctx, baseID); {
+	case err != nil:
+		return "", nil, err
+	case !ok:
+		return "", nil, nil
+	}
+
+	reach, err := invocations.Reachable(ctx, invocations.NewIDSet(baseID))
+	if err != nil {
+		return "", nil, err
+	}
+	failing = map[testVariantKey]bool{}
+	q := &testresults.Query{
+		InvocationIDs: reach,
+		Predicate: &pb.TestResultPredicate{
+			Expectancy: pb.TestResultPredicate_VARIANTS_WITH_UNEXPECTED_RESULTS,
+		},
+		Mask: baseResultMask,
+	}
+	err = q.Run(ctx, func(tr *pb.TestResult) error {
+		if !tr.Expected {
+			failing[testVariantKey{tr.TestId, tr.VariantHash}] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return "", nil, errors.Annotate(err, "failed to read test results of %s", baseID.Name()).Err()
+	}
+
+	if e.baseFailures == nil {
+		e.baseFailures = map[invocations.ID]map[testVariantKey]bool{}
+	}
+	e.baseFailures[baseID] = failing
+	return baseID, failing, nil
+}
+
+// sameProject returns true if the invocation exists and is in the same LUCI
+// project as e.inv.
+// Test results of other projects must not affect exonerations.
+func (e *ruleEvaluator) sameProject(ctx context.Context, id invocations.ID) (bool, error) {
+	var realm spanner.NullString
+	err := invocations.ReadColumns(ctx, id, map[string]interface{}{"Realm": &realm})
+	if s, ok := appstatus.Get(err); ok && s.Code() == codes.NotFound {
+		logging.Warningf(ctx, "base invocation %s not found", id.Name())
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	project, _ := realms.Split(e.inv.Realm)
+	if !realm.Valid || !strings.HasPrefix(realm.StringVal, project+":") {
+		logging.Warningf(ctx, "base invocation %s is not in project %q", id.Name(), project)
+		return false, nil
+	}
+	return true, nil
+}
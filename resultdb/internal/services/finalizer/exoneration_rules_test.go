@@ -0,0 +1,161 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package finalizer
+
+import (
+	"regexp"
+	"testing"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/server/span"
+
+	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/spanutil"
+	"go.chromium.org/luci/resultdb/internal/testutil"
+	"go.chromium.org/luci/resultdb/internal/testutil/insert"
+	"go.chromium.org/luci/resultdb/pbutil"
+	configpb "go.chromium.org/luci/resultdb/proto/config"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTestIDsRegexp(t *testing.T) {
+	t.Parallel()
+
+	Convey(`testIDsRegexp`, t, func() {
+		re := regexp.MustCompile("^" + testIDsRegexp([]string{"a.b", "c|d"}) + "$")
+		So(re.MatchString("a.b"), ShouldBeTrue)
+		So(re.MatchString("c|d"), ShouldBeTrue)
+		So(re.MatchString("axb"), ShouldBeFalse)
+		So(re.MatchString("c"), ShouldBeFalse)
+	})
+}
+
+func TestEvaluateExonerationRules(t *testing.T) {
+	Convey(`evaluateExonerationRules`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+
+		testutil.MustApply(ctx, testutil.CombineMutations(
+			[]*spanner.Mutation{
+				insert.Invocation("inv", pb.Invocation_FINALIZING, map[string]interface{}{
+					"Realm": "testproject:ci",
+					"Tags":  pbutil.StringPairs("base_invocation", "base"),
+				}),
+				insert.Invocation("base", pb.Invocation_FINALIZED, map[string]interface{}{
+					"Realm": "testproject:ci",
+				}),
+				insert.Invocation("other-project", pb.Invocation_FINALIZED, map[string]interface{}{
+					"Realm": "otherproject:ci",
+				}),
+			},
+			insert.TestResults("inv", "ninja://bug/T1", nil, pb.TestStatus_FAIL),
+			insert.TestResults("inv", "T2", nil, pb.TestStatus_FAIL),
+			insert.TestResults("inv", "T3", nil, pb.TestStatus_FAIL),
+			insert.TestResults("inv", "T4", nil, pb.TestStatus_PASS),
+			insert.TestResults("inv", "T5", nil, pb.TestStatus_FAIL),
+			insert.TestExonerations("inv", "T3", nil, 1),
+			insert.TestResults("base", "T2", nil, pb.TestStatus_FAIL),
+			insert.TestResults("base", "T3", nil, pb.TestStatus_FAIL),
+		)...)
+
+		inv, err := invocations.Read(span.Single(ctx), "inv")
+		So(err, ShouldBeNil)
+
+		rules := []*configpb.ExonerationRule{
+			{
+				Name:         "crbug-123",
+				TestIdRegexp: "ninja://bug/.+",
+				Condition: &configpb.ExonerationRule_TrackedBug{TrackedBug: &configpb.TrackedBug{
+					Bug: "crbug.com/123",
+				}},
+			},
+			{
+				Name: "fails-on-base",
+				Condition: &configpb.ExonerationRule_FailsOnBase{FailsOnBase: &configpb.FailsOnBase{
+					InvocationTagKey: "base_invocation",
+				}},
+			},
+		}
+
+		// exonerationIDs applies the mutations and returns IDs of the
+		// exonerations created by rules.
+		exonerationIDs := func() map[string]string {
+			ms, err := evaluateExonerationRules(ctx, inv, rules)
+			So(err, ShouldBeNil)
+			testutil.MustApply(ctx, ms...)
+
+			st := spanner.NewStatement(`
+				SELECT TestId, ExonerationId
+				FROM TestExonerations
+				WHERE InvocationId = @invID AND ExonerationId LIKE '%:rule:%'
+			`)
+			st.Params = spanutil.ToSpannerMap(map[string]interface{}{"invID": invocations.ID("inv")})
+			ret := map[string]string{}
+			var b spanutil.Buffer
+			err = span.Query(span.Single(ctx), st).Do(func(row *spanner.Row) error {
+				var testID, exonerationID string
+				if err := b.FromSpanner(row, &testID, &exonerationID); err != nil {
+					return err
+				}
+				ret[testID] = exonerationID
+				return nil
+			})
+			So(err, ShouldBeNil)
+			return ret
+		}
+
+		vh := pbutil.VariantHash(nil)
+
+		Convey(`Works`, func() {
+			So(exonerationIDs(), ShouldResemble, map[string]string{
+				"ninja://bug/T1": vh + ":rule:crbug-123",
+				"T2":             vh + ":rule:fails-on-base",
+			})
+		})
+
+		Convey(`Is idempotent`, func() {
+			exonerationIDs()
+			ms, err := evaluateExonerationRules(ctx, inv, rules)
+			So(err, ShouldBeNil)
+			So(ms, ShouldBeEmpty)
+		})
+
+		Convey(`Skips invalid rules`, func() {
+			rules = append([]*configpb.ExonerationRule{
+				{Name: "no-condition"},
+				{
+					Name:         "bad-regexp",
+					TestIdRegexp: "(",
+					Condition: &configpb.ExonerationRule_TrackedBug{TrackedBug: &configpb.TrackedBug{
+						Bug: "crbug.com/456",
+					}},
+				},
+			}, rules...)
+			So(exonerationIDs(), ShouldResemble, map[string]string{
+				"ninja://bug/T1": vh + ":rule:crbug-123",
+				"T2":             vh + ":rule:fails-on-base",
+			})
+		})
+
+		Convey(`Ignores base invocations of other projects`, func() {
+			inv.Tags = pbutil.StringPairs("base_invocation", "other-project")
+			So(exonerationIDs(), ShouldResemble, map[string]string{
+				"ninja://bug/T1": vh + ":rule:crbug-123",
+			})
+		})
+	})
+}
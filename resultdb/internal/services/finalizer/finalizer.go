@@ -25,6 +25,7 @@ import (
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/protobuf/proto"
 
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/sync/parallel"
@@ -87,7 +88,8 @@ func init() {
 // enqueued to try to transition it from FINALIZING to FINALIZED.
 // Then the task tries to finalize the invocation:
 // 1. Check if the invocation is ready to be finalized.
-// 2. Finalize the invocation.
+// 2. Exonerate unexpected test results by project rules, if enabled.
+// 3. Finalize the invocation.
 //
 // The invocation is ready to be finalized iff it is in FINALIZING state and it
 // does not include, directly or indirectly, an active invocation.
@@ -99,11 +101,16 @@ func init() {
 // If the invocation is not ready to finalize, the task is dropped.
 // This check is implemented in readyToFinalize() function.
 //
+// Before the invocation is finalized, the exoneration rules of its project are
+// applied to its unexpected test results, in a separate read-write transaction.
+// Exonerations have deterministic IDs, so this is safe to retry.
+// This is implemented in exonerateByRules() function.
+//
 // The second part is actual finalization. It is done in a separate read-write
 // transaction. First the task checks again if the invocation is still
 // FINALIZING. If so, the task changes state to FINALIZED, enqueues BQExport
-// tasks and tasks to try to finalize invocations that directly include the
-// current one (more about this below).
+// and FileExport tasks and tasks to try to finalize invocations that directly
+// include the current one (more about this below).
 // The finalization is implemented in finalizeInvocation() function.
 //
 // If we have a chain of inclusions A includes B, B includes C, where A and B
@@ -142,6 +149,11 @@ func tryFinalizeInvocation(ctx context.Context, invID invocations.ID) error {
 
 	default:
 		logging.Infof(ctx, "decided to finalize %s...", invID.Name())
+		if UseExonerationRules.Enabled(ctx) {
+			if err := exonerateByRules(ctx, invID); err != nil {
+				return errors.Annotate(err, "failed to apply exoneration rules").Err()
+			}
+		}
 		return finalizeInvocation(ctx, invID)
 	}
 }
@@ -264,7 +276,7 @@ func ensureFinalizing(ctx context.Context, invID invocations.ID) error {
 }
 
 // finalizeInvocation updates the invocation state to FINALIZED.
-// Enqueues BigQuery export tasks.
+// Enqueues BigQuery and file export tasks.
 // For each FINALIZING invocation that includes the given one, enqueues
 // a finalization task.
 func finalizeInvocation(ctx context.Context, invID invocations.ID) error {
@@ -304,6 +316,10 @@ func finalizeInvocation(ctx context.Context, invID invocations.ID) error {
 						return err
 					}
 				}
+				// Enqueue a task to export the invocation to files.
+				if tasks.UseFileExport.Enabled(ctx) {
+					span.BufferWrite(ctx, tasks.EnqueueFileExport(invID, clock.Now(ctx).UTC()))
+				}
 				// Enqueue tasks to export the invocation to BigQuery.
 				// Note: this cannot be done in parallel with insertNextFinalizationTasks
 				// because a Spanner session can process only one DML query at a time.
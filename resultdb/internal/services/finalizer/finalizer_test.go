@@ -202,5 +202,29 @@ func TestFinalizeInvocation(t *testing.T) {
 			So(err, ShouldBeNil)
 			So(payloads, ShouldResemble, []string{"bq_export1", "bq_export2"})
 		})
+
+		Convey(`Enqueues a file_export task`, func() {
+			ctx = experiments.Enable(ctx, tasks.UseFileExport)
+			testutil.MustApply(ctx, insert.Invocation("x", pb.Invocation_FINALIZING, nil))
+
+			err := finalizeInvocation(ctx, "x")
+			So(err, ShouldBeNil)
+
+			st := spanner.NewStatement(`
+				SELECT TaskID
+				FROM InvocationTasks
+				WHERE TaskType = @taskType
+			`)
+			st.Params["taskType"] = string(tasks.FileExport)
+			var taskIDs []string
+			err = span.Query(span.Single(ctx), st).Do(func(r *spanner.Row) error {
+				var taskID string
+				So(spanutil.FromSpanner(r, &taskID), ShouldBeNil)
+				taskIDs = append(taskIDs, taskID)
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(taskIDs, ShouldResemble, []string{invocations.ID("x").RowID()})
+		})
 	})
 }
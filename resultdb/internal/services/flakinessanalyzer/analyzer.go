@@ -0,0 +1,134 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flakinessanalyzer periodically computes the flakiness of test
+// variants in each realm and stores it for QueryTestFlakiness RPC.
+// There must be a single replica of flakinessanalyzer server.
+package flakinessanalyzer
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server"
+	"go.chromium.org/luci/server/span"
+
+	"go.chromium.org/luci/resultdb/internal/cron"
+	"go.chromium.org/luci/resultdb/internal/flakiness"
+	"go.chromium.org/luci/resultdb/internal/spanutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// Options is flakiness analyzer server configuration.
+type Options struct {
+	// UpdateInterval is how often to recompute the flakiness.
+	UpdateInterval time.Duration
+
+	// Window is how far back to look in the history of a realm.
+	Window time.Duration
+
+	// MaxInvocations is the maximum number of the most recent invocations to
+	// analyze in a realm.
+	MaxInvocations int
+}
+
+// InitServer initializes a flakiness analyzer server.
+func InitServer(srv *server.Server, opts Options) {
+	if opts.UpdateInterval == 0 {
+		opts.UpdateInterval = time.Hour
+	}
+	if opts.Window == 0 {
+		opts.Window = flakiness.DefaultWindow
+	}
+	if opts.MaxInvocations == 0 {
+		opts.MaxInvocations = flakiness.DefaultMaxInvocations
+	}
+
+	srv.RunInBackground("resultdb.flakiness", func(ctx context.Context) {
+		cron.Run(ctx, opts.UpdateInterval, func(ctx context.Context) error {
+			return analyzeAll(ctx, opts)
+		})
+	})
+}
+
+// analyzeAll recomputes the flakiness in each realm that has invocations in
+// the window, and deletes the flakiness of other realms.
+func analyzeAll(ctx context.Context, opts Options) error {
+	now := clock.Now(ctx).UTC()
+	realms, err := activeRealms(ctx, now.Add(-opts.Window))
+	if err != nil {
+		return err
+	}
+
+	for _, realm := range realms {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := analyzeRealm(ctx, realm, now, opts); err != nil {
+			logging.Errorf(ctx, "failed to analyze flakiness in realm %s: %s", realm, err)
+		}
+	}
+
+	// The flakiness of realms without invocations in the window is stale.
+	return flakiness.DeleteInactive(ctx, realms, now)
+}
+
+// activeRealms returns the realms of the invocations indexed by creation time
+// since earliest.
+func activeRealms(ctx context.Context, earliest time.Time) ([]string, error) {
+	st := spanner.NewStatement(`
+		SELECT DISTINCT Realm
+		FROM Invocations@{FORCE_INDEX=InvocationsByTimestamp}
+		WHERE HistoryTime >= @earliest
+	`)
+	st.Params["earliest"] = earliest
+
+	var ret []string
+	err := spanutil.Query(span.Single(ctx), st, func(row *spanner.Row) error {
+		var realm string
+		if err := spanutil.FromSpanner(row, &realm); err != nil {
+			return err
+		}
+		ret = append(ret, realm)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch active realms").Err()
+	}
+	return ret, nil
+}
+
+// analyzeRealm computes the flakiness of the test variants in the realm as of
+// now, and stores it.
+func analyzeRealm(ctx context.Context, realm string, now time.Time, opts Options) error {
+	q := &flakiness.Query{
+		Realm:          realm,
+		Earliest:       now.Add(-opts.Window),
+		Latest:         now,
+		Predicate:      &pb.TestResultPredicate{},
+		MaxInvocations: opts.MaxInvocations,
+	}
+	txn, cancel := span.ReadOnlyTransaction(ctx)
+	fs, err := q.Run(txn)
+	cancel()
+	if err != nil {
+		return err
+	}
+	return flakiness.Write(ctx, realm, now, fs)
+}
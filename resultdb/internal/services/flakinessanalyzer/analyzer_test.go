@@ -0,0 +1,82 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flakinessanalyzer
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/server/span"
+
+	"go.chromium.org/luci/resultdb/internal/flakiness"
+	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/testutil"
+	"go.chromium.org/luci/resultdb/internal/testutil/insert"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAnalyzeAll(t *testing.T) {
+	Convey(`AnalyzeAll`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		opts := Options{Window: 24 * time.Hour, MaxInvocations: 100}
+		v := pbutil.Variant("os", "linux")
+
+		insertInv := func(id invocations.ID, realm string, statuses ...pb.TestStatus) {
+			testutil.MustApply(ctx, testutil.CombineMutations(
+				[]*spanner.Mutation{insert.Invocation(id, pb.Invocation_FINALIZED, map[string]interface{}{
+					"Realm":       realm,
+					"HistoryTime": clock.Now(ctx).UTC().Add(-time.Hour),
+				})},
+				insert.TestResults(string(id), "flaky", v, statuses...),
+				insert.TestResults(string(id), "stable", v, pb.TestStatus_PASS),
+			)...)
+		}
+		insertInv("a", "testproject:testrealm", pb.TestStatus_FAIL, pb.TestStatus_PASS)
+		insertInv("b", "testproject:testrealm", pb.TestStatus_PASS)
+		insertInv("c", "testproject:otherrealm", pb.TestStatus_PASS)
+
+		So(analyzeAll(ctx, opts), ShouldBeNil)
+
+		fetch := func(realm string) []*pb.TestFlakiness {
+			q := &flakiness.StoredQuery{Realm: realm, Limit: 100}
+			fs, err := q.Fetch(span.Single(ctx))
+			So(err, ShouldBeNil)
+			return fs
+		}
+
+		fs := fetch("testproject:testrealm")
+		So(fs, ShouldHaveLength, 1)
+		So(fs[0].TestId, ShouldEqual, "flaky")
+		So(fs[0].InvocationCount, ShouldEqual, 2)
+		So(fs[0].FlakyInvocationCount, ShouldEqual, 1)
+
+		So(fetch("testproject:otherrealm"), ShouldBeEmpty)
+
+		Convey(`Deletes the flakiness of inactive realms`, func() {
+			So(flakiness.Write(ctx, "testproject:inactive", clock.Now(ctx).UTC().Add(-time.Hour), fs), ShouldBeNil)
+			So(fetch("testproject:inactive"), ShouldHaveLength, 1)
+
+			So(analyzeAll(ctx, opts), ShouldBeNil)
+			So(fetch("testproject:inactive"), ShouldBeEmpty)
+			So(fetch("testproject:testrealm"), ShouldHaveLength, 1)
+		})
+	})
+}
@@ -0,0 +1,138 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package local runs the Recorder and ResultDB services on top of in-memory
+// storage, so that they can run as a single binary on a developer machine
+// without Cloud Spanner, RBE-CAS or LUCI auth.
+//
+// All requests are authenticated as Identity, and all permissions are
+// granted. Invocations are not exported to BigQuery, and they are finalized
+// as soon as they are ready.
+package local
+
+import (
+	"context"
+	"crypto/rand"
+	"net/http"
+	"time"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authdb"
+	"go.chromium.org/luci/server/router"
+	"go.chromium.org/luci/server/secrets"
+
+	"go.chromium.org/luci/resultdb/internal/artifactcontent"
+	"go.chromium.org/luci/resultdb/internal/memstore"
+	"go.chromium.org/luci/resultdb/internal/services/recorder"
+	"go.chromium.org/luci/resultdb/internal/services/resultdb"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// Identity is the identity of all requests to the local server.
+const Identity identity.Identity = "user:local@localhost"
+
+// rbeInstance is the name of the in-memory RBE-CAS instance.
+const rbeInstance = "local"
+
+// expectedResultsExpiration is the duration since invocation creation after
+// which to delete expected test results. The in-memory storage does not delete
+// them though.
+const expectedResultsExpiration = 60 * 24 * time.Hour
+
+// Options is local server configuration.
+type Options struct {
+	// InsecureSelfURLs is set to true to use http:// (not https://) for URLs
+	// pointing back to the server.
+	InsecureSelfURLs bool
+}
+
+// NewRouter returns a router that serves the Recorder and ResultDB services,
+// as well as artifact uploads and downloads. The services share in-memory
+// storage.
+//
+// ctx becomes the root context of the requests. It does not need LUCI server
+// framework, which requires cloud credentials.
+func NewRouter(ctx context.Context, opts Options) (*router.Router, error) {
+	ctx, err := withAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r := router.NewWithRootContext(ctx)
+
+	store := &memstore.Store{}
+	cas := &memstore.CAS{}
+
+	contentServer := &artifactcontent.Server{
+		InsecureURLs: opts.InsecureSelfURLs,
+		HostnameProvider: func(requestHost string) string {
+			return requestHost
+		},
+		ReadCASBlob:        cas.Read,
+		RBECASInstanceName: rbeInstance,
+		Store:              store,
+	}
+	if err := contentServer.Init(ctx); err != nil {
+		return nil, errors.Annotate(err, "failed to initialize the artifact content server").Err()
+	}
+	contentServer.InstallHandlers(r)
+	recorder.InstallArtifactCreationHandler(r, store, rbeInstance, cas.NewWriter)
+
+	srv := &prpc.Server{
+		Authenticator: &auth.Authenticator{Methods: []auth.Method{localAuthMethod{}}},
+		AccessControl: prpc.AllowOriginAll,
+		// TODO(crbug/1082369): Remove this workaround once field masks can be decoded.
+		HackFixFieldMasksForJSON: true,
+	}
+	pb.RegisterRecorderServer(srv, recorder.NewServer(store, recorder.Options{
+		ExpectedResultsExpiration: expectedResultsExpiration,
+		ArtifactRBEInstance:       rbeInstance,
+	}))
+	pb.RegisterResultDBServer(srv, resultdb.NewServer(store, contentServer))
+	srv.InstallHandlers(r, router.MiddlewareChain{})
+	return r, nil
+}
+
+// withAuth returns a context with auth configuration that grants all
+// permissions, and with random secrets to sign update tokens and artifact
+// URLs.
+func withAuth(ctx context.Context) (context.Context, error) {
+	root := make([]byte, 32)
+	if _, err := rand.Read(root); err != nil {
+		return nil, errors.Annotate(err, "failed to generate the root secret").Err()
+	}
+	ctx = secrets.Set(ctx, secrets.NewDerivedStore(secrets.Secret{Current: root}))
+
+	ctx = auth.ModifyConfig(ctx, func(cfg auth.Config) auth.Config {
+		cfg.DBProvider = func(context.Context) (authdb.DB, error) {
+			return authdb.DevServerDB{}, nil
+		}
+		cfg.AnonymousTransport = func(context.Context) http.RoundTripper {
+			return http.DefaultTransport
+		}
+		return cfg
+	})
+	return ctx, nil
+}
+
+// localAuthMethod is an auth.Method that authenticates all requests as
+// Identity.
+type localAuthMethod struct{}
+
+// Authenticate implements auth.Method.
+func (localAuthMethod) Authenticate(context.Context, *http.Request) (*auth.User, error) {
+	return &auth.User{Identity: Identity}, nil
+}
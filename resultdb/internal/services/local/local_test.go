@@ -0,0 +1,272 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/grpc/prpc"
+
+	"go.chromium.org/luci/resultdb/internal/services/recorder"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLocalServer(t *testing.T) {
+	t.Parallel()
+	Convey(`LocalServer`, t, func() {
+		ctx := context.Background()
+		r, err := NewRouter(ctx, Options{InsecureSelfURLs: true})
+		So(err, ShouldBeNil)
+		ts := httptest.NewServer(r)
+		defer ts.Close()
+		tsURL, err := url.Parse(ts.URL)
+		So(err, ShouldBeNil)
+
+		client := &prpc.Client{Host: tsURL.Host, Options: &prpc.Options{Insecure: true}}
+		rec := pb.NewRecorderPRPCClient(client)
+		rdb := pb.NewResultDBPRPCClient(client)
+
+		createInvocation := func(id string) (ctx context.Context, token string) {
+			md := metadata.MD{}
+			inv, err := rec.CreateInvocation(context.Background(), &pb.CreateInvocationRequest{
+				InvocationId: id,
+				Invocation: &pb.Invocation{
+					Realm:          "testproject:testrealm",
+					HistoryOptions: &pb.HistoryOptions{UseInvocationTimestamp: true},
+				},
+			}, prpc.Header(&md))
+			So(err, ShouldBeNil)
+			So(inv.Name, ShouldEqual, "invocations/"+id)
+			So(inv.State, ShouldEqual, pb.Invocation_ACTIVE)
+			So(inv.CreatedBy, ShouldEqual, string(Identity))
+			token = md.Get(recorder.UpdateTokenMetadataKey)[0]
+			return metadata.AppendToOutgoingContext(context.Background(), recorder.UpdateTokenMetadataKey, token), token
+		}
+		ctx, token := createInvocation("inv")
+
+		tr := func(testID, resultID string, expected bool) *pb.CreateTestResultRequest {
+			status := pb.TestStatus_PASS
+			if !expected {
+				status = pb.TestStatus_FAIL
+			}
+			return &pb.CreateTestResultRequest{TestResult: &pb.TestResult{
+				TestId:   testID,
+				ResultId: resultID,
+				Variant:  pbutil.Variant("k", "v"),
+				Expected: expected,
+				Status:   status,
+			}}
+		}
+		_, err = rec.BatchCreateTestResults(ctx, &pb.BatchCreateTestResultsRequest{
+			Invocation: "invocations/inv",
+			Requests: []*pb.CreateTestResultRequest{
+				tr("a", "0", true),
+				tr("b", "0", false),
+				tr("b", "1", true),
+				tr("c", "0", false),
+			},
+		})
+		So(err, ShouldBeNil)
+
+		Convey(`Update token is required`, func() {
+			_, err := rec.FinalizeInvocation(context.Background(), &pb.FinalizeInvocationRequest{Name: "invocations/inv"})
+			So(status.Code(err), ShouldEqual, codes.Unauthenticated)
+		})
+
+		Convey(`QueryTestResults`, func() {
+			query := func(pred *pb.TestResultPredicate) []string {
+				res, err := rdb.QueryTestResults(ctx, &pb.QueryTestResultsRequest{
+					Invocations: []string{"invocations/inv"},
+					Predicate:   pred,
+				})
+				So(err, ShouldBeNil)
+				names := make([]string, len(res.TestResults))
+				for i, tr := range res.TestResults {
+					names[i] = tr.TestId + "/" + tr.ResultId
+				}
+				return names
+			}
+
+			So(query(nil), ShouldResemble, []string{"a/0", "b/0", "b/1", "c/0"})
+			So(query(&pb.TestResultPredicate{TestIdRegexp: "a|c"}), ShouldResemble, []string{"a/0", "c/0"})
+			So(query(&pb.TestResultPredicate{
+				Expectancy: pb.TestResultPredicate_VARIANTS_WITH_UNEXPECTED_RESULTS,
+			}), ShouldResemble, []string{"b/0", "b/1", "c/0"})
+			So(query(&pb.TestResultPredicate{
+				Expectancy: pb.TestResultPredicate_VARIANTS_WITH_ONLY_UNEXPECTED_RESULTS,
+			}), ShouldResemble, []string{"c/0"})
+
+			_, err := rec.CreateTestExoneration(ctx, &pb.CreateTestExonerationRequest{
+				Invocation:      "invocations/inv",
+				TestExoneration: &pb.TestExoneration{TestId: "c", Variant: pbutil.Variant("k", "v")},
+			})
+			So(err, ShouldBeNil)
+			So(query(&pb.TestResultPredicate{
+				Expectancy:        pb.TestResultPredicate_VARIANTS_WITH_UNEXPECTED_RESULTS,
+				ExcludeExonerated: true,
+			}), ShouldResemble, []string{"b/0", "b/1"})
+
+			Convey(`Test metadata`, func() {
+				req := tr("d", "0", false)
+				req.TestResult.TestMetadata = &pb.TestMetadata{
+					Owners:       []string{"someone@example.com"},
+					BugComponent: "Infra",
+				}
+				_, err := rec.BatchCreateTestResults(ctx, &pb.BatchCreateTestResultsRequest{
+					Invocation: "invocations/inv",
+					Requests:   []*pb.CreateTestResultRequest{req},
+				})
+				So(err, ShouldBeNil)
+
+				So(query(&pb.TestResultPredicate{
+					TestMetadata: &pb.TestMetadataPredicate{Owner: "someone@example.com"},
+				}), ShouldResemble, []string{"d/0"})
+				So(query(&pb.TestResultPredicate{
+					TestMetadata: &pb.TestMetadataPredicate{BugComponent: "Other"},
+				}), ShouldBeEmpty)
+			})
+		})
+
+		Convey(`Pagination`, func() {
+			req := &pb.ListTestResultsRequest{Invocation: "invocations/inv", PageSize: 3}
+			res, err := rdb.ListTestResults(ctx, req)
+			So(err, ShouldBeNil)
+			So(res.TestResults, ShouldHaveLength, 3)
+			So(res.NextPageToken, ShouldNotEqual, "")
+
+			req.PageToken = res.NextPageToken
+			res, err = rdb.ListTestResults(ctx, req)
+			So(err, ShouldBeNil)
+			So(res.TestResults, ShouldHaveLength, 1)
+			So(res.NextPageToken, ShouldEqual, "")
+		})
+
+		Convey(`Included invocations`, func() {
+			childCtx, _ := createInvocation("child")
+			_, err := rec.CreateTestResult(childCtx, &pb.CreateTestResultRequest{
+				Invocation: "invocations/child",
+				TestResult: tr("d", "0", true).TestResult,
+			})
+			So(err, ShouldBeNil)
+
+			_, err = rec.UpdateIncludedInvocations(ctx, &pb.UpdateIncludedInvocationsRequest{
+				IncludingInvocation: "invocations/inv",
+				AddInvocations:      []string{"invocations/child"},
+			})
+			So(err, ShouldBeNil)
+
+			res, err := rdb.QueryTestResultStatistics(ctx, &pb.QueryTestResultStatisticsRequest{
+				Invocations: []string{"invocations/inv"},
+			})
+			So(err, ShouldBeNil)
+			So(res.TotalTestResults, ShouldEqual, 5)
+
+			Convey(`Finalization waits for included invocations`, func() {
+				inv, err := rec.FinalizeInvocation(ctx, &pb.FinalizeInvocationRequest{Name: "invocations/inv"})
+				So(err, ShouldBeNil)
+				So(inv.State, ShouldEqual, pb.Invocation_FINALIZING)
+
+				_, err = rec.FinalizeInvocation(childCtx, &pb.FinalizeInvocationRequest{Name: "invocations/child"})
+				So(err, ShouldBeNil)
+
+				inv, err = rdb.GetInvocation(ctx, &pb.GetInvocationRequest{Name: "invocations/inv"})
+				So(err, ShouldBeNil)
+				So(inv.State, ShouldEqual, pb.Invocation_FINALIZED)
+			})
+		})
+
+		Convey(`Artifacts`, func() {
+			const name = "invocations/inv/tests/b/results/0/artifacts/log"
+			put := func(content, hash string) int {
+				req, err := http.NewRequest("PUT", ts.URL+"/"+name, strings.NewReader(content))
+				So(err, ShouldBeNil)
+				req.Header.Set("Content-Hash", hash)
+				req.Header.Set("Content-Type", "text/plain")
+				req.Header.Set("Update-Token", token)
+				res, err := http.DefaultClient.Do(req)
+				So(err, ShouldBeNil)
+				res.Body.Close()
+				return res.StatusCode
+			}
+
+			// The hash of "hello".
+			hash := "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+			So(put("hello", hash), ShouldEqual, http.StatusNoContent)
+			So(put("hello!", hash), ShouldEqual, http.StatusConflict)
+
+			res, err := rdb.QueryArtifacts(ctx, &pb.QueryArtifactsRequest{
+				Invocations:         []string{"invocations/inv"},
+				TestResultPredicate: &pb.TestResultPredicate{TestIdRegexp: "b"},
+			})
+			So(err, ShouldBeNil)
+			So(res.Artifacts, ShouldHaveLength, 1)
+			So(res.Artifacts[0].SizeBytes, ShouldEqual, 5)
+			So(res.Artifacts[0].FetchUrl, ShouldStartWith, ts.URL+"/"+name+"?token=")
+
+			res, err = rdb.QueryArtifacts(ctx, &pb.QueryArtifactsRequest{
+				Invocations:         []string{"invocations/inv"},
+				TestResultPredicate: &pb.TestResultPredicate{TestIdRegexp: "a"},
+			})
+			So(err, ShouldBeNil)
+			So(res.Artifacts, ShouldHaveLength, 0)
+
+			art, err := rdb.GetArtifact(ctx, &pb.GetArtifactRequest{Name: name})
+			So(err, ShouldBeNil)
+			fetched, err := http.Get(art.FetchUrl)
+			So(err, ShouldBeNil)
+			defer fetched.Body.Close()
+			content, err := ioutil.ReadAll(fetched.Body)
+			So(err, ShouldBeNil)
+			So(fetched.StatusCode, ShouldEqual, http.StatusOK)
+			So(string(content), ShouldEqual, "hello")
+			So(fetched.Header.Get("Content-Type"), ShouldEqual, "text/plain")
+		})
+
+		Convey(`FinalizeInvocation`, func() {
+			inv, err := rec.FinalizeInvocation(ctx, &pb.FinalizeInvocationRequest{Name: "invocations/inv"})
+			So(err, ShouldBeNil)
+			So(inv.State, ShouldEqual, pb.Invocation_FINALIZING)
+
+			inv, err = rdb.GetInvocation(ctx, &pb.GetInvocationRequest{Name: "invocations/inv"})
+			So(err, ShouldBeNil)
+			So(inv.State, ShouldEqual, pb.Invocation_FINALIZED)
+
+			res, err := rdb.QueryTestFlakiness(ctx, &pb.QueryTestFlakinessRequest{Realm: "testproject:testrealm"})
+			So(err, ShouldBeNil)
+			So(res.TestFlakiness, ShouldHaveLength, 1)
+			So(res.TestFlakiness[0].TestId, ShouldEqual, "b")
+			So(res.TestFlakiness[0].FlakyInvocationCount, ShouldEqual, 1)
+
+			_, err = rec.CreateTestResult(ctx, &pb.CreateTestResultRequest{
+				Invocation: "invocations/inv",
+				TestResult: tr("e", "0", true).TestResult,
+			})
+			So(status.Code(err), ShouldEqual, codes.FailedPrecondition)
+		})
+	})
+}
@@ -18,14 +18,12 @@ import (
 	"context"
 	"time"
 
-	"cloud.google.com/go/spanner"
 	"google.golang.org/grpc/codes"
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
 	"go.chromium.org/luci/server/auth"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
 	"go.chromium.org/luci/resultdb/internal/spanutil"
@@ -92,17 +90,19 @@ func (s *recorderServer) BatchCreateInvocations(ctx context.Context, in *pb.Batc
 // createInvocations is a shared implementation for CreateInvocation and BatchCreateInvocations RPCs.
 func (s *recorderServer) createInvocations(ctx context.Context, reqs []*pb.CreateInvocationRequest, requestID string, now time.Time, idSet invocations.IDSet) ([]*pb.Invocation, []string, error) {
 	createdBy := string(auth.CurrentIdentity(ctx))
-	ms := s.createInvocationsRequestsToMutations(ctx, now, reqs, requestID, createdBy)
+	invs := createInvocationsRequestsToInvocations(now, reqs, createdBy)
 
 	var err error
 	deduped := false
-	_, err = span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
-		deduped, err = deduplicateCreateInvocations(ctx, idSet, requestID, createdBy)
+	err = s.store.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		deduped, err = s.deduplicateCreateInvocations(ctx, idSet, requestID, createdBy)
 		if err != nil {
 			return err
 		}
 		if !deduped {
-			span.BufferWrite(ctx, ms...)
+			for _, inv := range invs {
+				s.store.InsertInvocation(ctx, inv, requestID, s.ExpectedResultsExpiration)
+			}
 		}
 		return nil
 	})
@@ -115,18 +115,16 @@ func (s *recorderServer) createInvocations(ctx context.Context, reqs []*pb.Creat
 		}
 	}
 
-	return getCreatedInvocationsAndUpdateTokens(ctx, idSet, reqs)
+	return s.getCreatedInvocationsAndUpdateTokens(ctx, idSet, reqs)
 }
 
-// createInvocationsRequestsToMutations computes a database mutation for
-// inserting a row for each invocation creation requested.
-func (s *recorderServer) createInvocationsRequestsToMutations(ctx context.Context, now time.Time, reqs []*pb.CreateInvocationRequest, requestID, createdBy string) []*spanner.Mutation {
-
-	ms := make([]*spanner.Mutation, len(reqs))
-	// Compute mutations
+// createInvocationsRequestsToInvocations computes an invocation to store for
+// each invocation creation requested.
+func createInvocationsRequestsToInvocations(now time.Time, reqs []*pb.CreateInvocationRequest, createdBy string) []*pb.Invocation {
+	invs := make([]*pb.Invocation, len(reqs))
 	for i, req := range reqs {
 
-		// Prepare the invocation we will save to spanner.
+		// Prepare the invocation we will store.
 		inv := &pb.Invocation{
 			Name:             invocations.ID(req.InvocationId).Name(),
 			State:            pb.Invocation_ACTIVE,
@@ -136,6 +134,7 @@ func (s *recorderServer) createInvocationsRequestsToMutations(ctx context.Contex
 			CreatedBy:        createdBy,
 			ProducerResource: req.Invocation.GetProducerResource(),
 			Realm:            req.Invocation.GetRealm(),
+			HistoryOptions:   req.Invocation.GetHistoryOptions(),
 		}
 
 		// Ensure the invocation has a deadline.
@@ -144,20 +143,19 @@ func (s *recorderServer) createInvocationsRequestsToMutations(ctx context.Contex
 		}
 
 		pbutil.NormalizeInvocation(inv)
-		// Create a mutation to create the invocation.
-		ms[i] = spanutil.InsertMap("Invocations", s.rowOfInvocation(ctx, inv, requestID))
+		invs[i] = inv
 	}
-	return ms
+	return invs
 }
 
 // getCreatedInvocationsAndUpdateTokens reads the full details of the
 // invocations just created in a separate read-only transaction, and
 // generates an update token for each.
-func getCreatedInvocationsAndUpdateTokens(ctx context.Context, idSet invocations.IDSet, reqs []*pb.CreateInvocationRequest) ([]*pb.Invocation, []string, error) {
-	ctx, cancel := span.ReadOnlyTransaction(ctx)
+func (s *recorderServer) getCreatedInvocationsAndUpdateTokens(ctx context.Context, idSet invocations.IDSet, reqs []*pb.CreateInvocationRequest) ([]*pb.Invocation, []string, error) {
+	ctx, cancel := s.store.ReadOnlyTransaction(ctx, 0)
 	defer cancel()
 
-	invMap, err := invocations.ReadBatch(ctx, idSet)
+	invMap, err := s.store.ReadInvocations(ctx, idSet)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -179,32 +177,22 @@ func getCreatedInvocationsAndUpdateTokens(ctx context.Context, idSet invocations
 // deduplicateCreateInvocations checks if the invocations have already been
 // created with the given requestID and current requester.
 // Returns a true if they have.
-func deduplicateCreateInvocations(ctx context.Context, idSet invocations.IDSet, requestID, createdBy string) (bool, error) {
-	invCount := 0
-	columns := []string{"InvocationId", "CreateRequestId", "CreatedBy"}
-	err := span.Read(ctx, "Invocations", idSet.Keys(), columns).Do(func(r *spanner.Row) error {
-		var invID invocations.ID
-		var rowRequestID spanner.NullString
-		var rowCreatedBy spanner.NullString
-		switch err := spanutil.FromSpanner(r, &invID, &rowRequestID, &rowCreatedBy); {
-		case err != nil:
-			return err
-		case !rowRequestID.Valid || rowRequestID.StringVal != requestID:
-			return invocationAlreadyExists(invID)
-		case rowCreatedBy.StringVal != createdBy:
-			return invocationAlreadyExists(invID)
-		default:
-			invCount++
-			return nil
-		}
-	})
-	switch {
-	case err != nil:
+func (s *recorderServer) deduplicateCreateInvocations(ctx context.Context, idSet invocations.IDSet, requestID, createdBy string) (bool, error) {
+	createReqs, err := s.store.ReadCreateRequests(ctx, idSet)
+	if err != nil {
 		return false, err
-	case invCount == len(idSet):
+	}
+	for invID, cr := range createReqs {
+		if cr.RequestID == "" || cr.RequestID != requestID || cr.CreatedBy != createdBy {
+			return false, invocationAlreadyExists(invID)
+		}
+	}
+
+	switch len(createReqs) {
+	case len(idSet):
 		// All invocations were previously created with this request id.
 		return true, nil
-	case invCount == 0:
+	case 0:
 		// None of the invocations exist already.
 		return false, nil
 	default:
@@ -33,6 +33,7 @@ import (
 	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/storage"
 	"go.chromium.org/luci/resultdb/internal/testutil"
 	"go.chromium.org/luci/resultdb/pbutil"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
@@ -248,7 +249,7 @@ func TestBatchCreateInvocations(t *testing.T) {
 			})
 			So(err, ShouldBeNil)
 			So(expectedResultsExpirationTime, ShouldHappenWithin, time.Second, start.Add(expectedResultExpiration))
-			So(invExpirationTime, ShouldHappenWithin, time.Second, start.Add(invocationExpirationDuration))
+			So(invExpirationTime, ShouldHappenWithin, time.Second, start.Add(storage.InvocationExpirationDuration))
 		})
 	})
 }
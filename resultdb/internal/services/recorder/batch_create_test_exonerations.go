@@ -17,11 +17,8 @@ package recorder
 import (
 	"context"
 
-	"cloud.google.com/go/spanner"
-
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
 	"go.chromium.org/luci/resultdb/pbutil"
@@ -66,12 +63,12 @@ func (s *recorderServer) BatchCreateTestExonerations(ctx context.Context, in *pb
 	ret := &pb.BatchCreateTestExonerationsResponse{
 		TestExonerations: make([]*pb.TestExoneration, len(in.Requests)),
 	}
-	ms := make([]*spanner.Mutation, len(in.Requests))
 	for i, sub := range in.Requests {
-		ret.TestExonerations[i], ms[i] = insertTestExoneration(ctx, invID, in.RequestId, i, sub.TestExoneration)
+		ret.TestExonerations[i] = testExoneration(ctx, invID, in.RequestId, i, sub.TestExoneration)
 	}
-	err = mutateInvocation(ctx, invID, func(ctx context.Context) error {
-		span.BufferWrite(ctx, ms...)
+	err = s.mutateInvocation(ctx, invID, func(ctx context.Context) error {
+		// Exonerations with deterministic IDs are replaced on retries.
+		s.store.InsertTestExonerations(ctx, invID, ret.TestExonerations, in.RequestId != "")
 		return nil
 	})
 	if err != nil {
@@ -18,14 +18,12 @@ import (
 	"context"
 	"time"
 
-	"cloud.google.com/go/spanner"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
 	"go.chromium.org/luci/resultdb/internal/spanutil"
@@ -95,20 +93,18 @@ func (s *recorderServer) BatchCreateTestResults(ctx context.Context, in *pb.Batc
 	ret := &pb.BatchCreateTestResultsResponse{
 		TestResults: make([]*pb.TestResult, len(in.Requests)),
 	}
-	ms := make([]*spanner.Mutation, len(in.Requests))
 	for i, r := range in.Requests {
-		ret.TestResults[i], ms[i] = insertTestResult(ctx, invID, in.RequestId, r.TestResult)
+		ret.TestResults[i] = testResult(invID, r.TestResult)
 	}
 	var realm string
-	err := mutateInvocation(ctx, invID, func(ctx context.Context) error {
-		span.BufferWrite(ctx, ms...)
+	err := s.mutateInvocation(ctx, invID, func(ctx context.Context) error {
 		eg, ctx := errgroup.WithContext(ctx)
 		eg.Go(func() (err error) {
-			realm, err = invocations.ReadRealm(ctx, invID)
+			realm, err = s.store.ReadRealm(ctx, invID)
 			return
 		})
 		eg.Go(func() error {
-			return invocations.IncrementTestResultCount(ctx, invID, int64(len(in.Requests)))
+			return s.store.InsertTestResults(ctx, invID, ret.TestResults)
 		})
 		return eg.Wait()
 	})
@@ -119,38 +115,12 @@ func (s *recorderServer) BatchCreateTestResults(ctx context.Context, in *pb.Batc
 	return ret, nil
 }
 
-func insertTestResult(ctx context.Context, invID invocations.ID, requestID string, body *pb.TestResult) (*pb.TestResult, *spanner.Mutation) {
+// testResult returns the test result to store, with the OUTPUT_ONLY fields
+// populated.
+func testResult(invID invocations.ID, body *pb.TestResult) *pb.TestResult {
 	// create a copy of the input message with the OUTPUT_ONLY field(s) to be used in
 	// the response
 	ret := proto.Clone(body).(*pb.TestResult)
 	ret.Name = pbutil.TestResultName(string(invID), ret.TestId, ret.ResultId)
-
-	// handle values for nullable columns
-	var runDuration spanner.NullInt64
-	if ret.Duration != nil {
-		runDuration.Int64 = pbutil.MustDuration(ret.Duration).Microseconds()
-		runDuration.Valid = true
-	}
-
-	row := map[string]interface{}{
-		"InvocationId":    invID,
-		"TestId":          ret.TestId,
-		"ResultId":        ret.ResultId,
-		"Variant":         ret.Variant,
-		"VariantHash":     pbutil.VariantHash(ret.Variant),
-		"CommitTimestamp": spanner.CommitTimestamp,
-		"IsUnexpected":    spanner.NullBool{Bool: true, Valid: !body.Expected},
-		"Status":          ret.Status,
-		"SummaryHTML":     spanutil.Compressed(ret.SummaryHtml),
-		"StartTime":       ret.StartTime,
-		"RunDurationUsec": runDuration,
-		"Tags":            ret.Tags,
-	}
-	if ret.TestLocation != nil {
-		row["TestLocationFileName"] = ret.TestLocation.FileName
-		// Spanner client does not support int32
-		row["TestLocationLine"] = int(ret.TestLocation.Line)
-	}
-	mutation := spanner.InsertOrUpdateMap("TestResults", spanutil.ToSpannerMap(row))
-	return ret, mutation
+	return ret
 }
@@ -27,7 +27,6 @@ import (
 	"strconv"
 	"strings"
 
-	"cloud.google.com/go/spanner"
 	"github.com/google/uuid"
 	"google.golang.org/genproto/googleapis/bytestream"
 	"google.golang.org/grpc/codes"
@@ -41,11 +40,10 @@ import (
 	"go.chromium.org/luci/grpc/appstatus"
 	"go.chromium.org/luci/grpc/grpcutil"
 	"go.chromium.org/luci/server/router"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/artifacts"
 	"go.chromium.org/luci/resultdb/internal/invocations"
-	"go.chromium.org/luci/resultdb/internal/spanutil"
+	"go.chromium.org/luci/resultdb/internal/storage"
 	"go.chromium.org/luci/resultdb/pbutil"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
 )
@@ -78,6 +76,8 @@ type artifactCreationHandler struct {
 	RBEInstance  string
 	NewCASWriter func(context.Context) (bytestream.ByteStream_WriteClient, error)
 	bufSize      int
+
+	store storage.Store
 }
 
 // Handle implements router.Handler.
@@ -152,8 +152,8 @@ func (ac *artifactCreator) handle(c *router.Context) error {
 		return err
 	}
 
-	// Record the artifact in Spanner.
-	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+	// Record the artifact in the store.
+	return ac.store.ReadWriteTransaction(ctx, func(ctx context.Context) error {
 		// Verify the state again.
 		switch sameExists, err := ac.verifyState(ctx); {
 		case err != nil:
@@ -162,17 +162,14 @@ func (ac *artifactCreator) handle(c *router.Context) error {
 			return nil
 		}
 
-		span.BufferWrite(ctx, spanutil.InsertMap("Artifacts", map[string]interface{}{
-			"InvocationId": ac.invID,
-			"ParentId":     ac.localParentID,
-			"ArtifactId":   ac.artifactID,
-			"ContentType":  ac.contentType,
-			"Size":         ac.size,
-			"RBECASHash":   ac.hash,
-		}))
+		ac.store.InsertArtifact(ctx, &pb.Artifact{
+			Name:        ac.artifactName,
+			ArtifactId:  ac.artifactID,
+			ContentType: ac.contentType,
+			SizeBytes:   ac.size,
+		}, ac.hash)
 		return nil
 	})
-	return err
 }
 
 // writeToCAS writes contents in r to RBE-CAS.
@@ -323,39 +320,34 @@ func (ac *artifactCreator) parseRequest(c *router.Context) error {
 	return nil
 }
 
-// verifyStateBeforeWriting checks Spanner state in a read-only transaction,
+// verifyStateBeforeWriting checks the stored state in a read-only transaction,
 // see verifyState comment.
 func (ac *artifactCreator) verifyStateBeforeWriting(ctx context.Context) (sameAlreadyExists bool, err error) {
-	ctx, cancel := span.ReadOnlyTransaction(ctx)
+	ctx, cancel := ac.store.ReadOnlyTransaction(ctx, 0)
 	defer cancel()
 	return ac.verifyState(ctx)
 }
 
-// verifyState checks if the Spanner state is compatible with creation of the
+// verifyState checks if the stored state is compatible with creation of the
 // artifact. If an identical artifact already exists, sameAlreadyExists is true.
 func (ac *artifactCreator) verifyState(ctx context.Context) (sameAlreadyExists bool, err error) {
 	var (
 		invState       pb.Invocation_State
-		hash           spanner.NullString
-		size           spanner.NullInt64
+		content        *storage.ArtifactContent
 		artifactExists bool
 	)
 
 	// Read the state concurrently.
 	err = parallel.FanOutIn(func(work chan<- func() error) {
 		work <- func() (err error) {
-			invState, err = invocations.ReadState(ctx, ac.invID)
+			invState, err = ac.store.ReadInvocationState(ctx, ac.invID)
 			return
 		}
 
-		work <- func() error {
-			key := ac.invID.Key(ac.localParentID, ac.artifactID)
-			err := spanutil.ReadRow(ctx, "Artifacts", key, map[string]interface{}{
-				"RBECASHash": &hash,
-				"Size":       &size,
-			})
+		work <- func() (err error) {
+			content, err = ac.store.ReadArtifactContent(ctx, ac.artifactName)
 			artifactExists = err == nil
-			if spanner.ErrCode(err) == codes.NotFound {
+			if st, ok := appstatus.Get(err); ok && st.Code() == codes.NotFound {
 				// This is expected.
 				return nil
 			}
@@ -371,7 +363,7 @@ func (ac *artifactCreator) verifyState(ctx context.Context) (sameAlreadyExists b
 	case invState != pb.Invocation_ACTIVE:
 		return false, appstatus.Errorf(codes.FailedPrecondition, "%s is not active", ac.invID.Name())
 
-	case hash.Valid && hash.StringVal == ac.hash && size.Valid && size.Int64 == ac.size:
+	case artifactExists && content.RBECASHash.StringVal == ac.hash && content.Size.Valid && content.Size.Int64 == ac.size:
 		// The same artifact already exists.
 		return true, nil
 
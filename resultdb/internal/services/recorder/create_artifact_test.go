@@ -35,6 +35,7 @@ import (
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
 	"go.chromium.org/luci/resultdb/internal/spanutil"
+	"go.chromium.org/luci/resultdb/internal/storage"
 	"go.chromium.org/luci/resultdb/internal/testutil"
 	"go.chromium.org/luci/resultdb/internal/testutil/insert"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
@@ -89,6 +90,7 @@ func TestCreateArtifact(t *testing.T) {
 				writerCreated = true
 				return w, nil
 			},
+			store: storage.Spanner{},
 		}
 
 		art := "invocations/inv/artifacts/a"
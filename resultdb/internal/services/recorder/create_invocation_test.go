@@ -35,6 +35,7 @@ import (
 	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/storage"
 	"go.chromium.org/luci/resultdb/internal/testutil"
 	"go.chromium.org/luci/resultdb/internal/testutil/insert"
 	"go.chromium.org/luci/resultdb/pbutil"
@@ -454,7 +455,7 @@ func TestCreateInvocation(t *testing.T) {
 			})
 			So(err, ShouldBeNil)
 			So(expectedResultsExpirationTime, ShouldHappenWithin, time.Second, start.Add(expectedResultExpiration))
-			So(invExpirationTime, ShouldHappenWithin, time.Second, start.Add(invocationExpirationDuration))
+			So(invExpirationTime, ShouldHappenWithin, time.Second, start.Add(storage.InvocationExpirationDuration))
 		})
 	})
 }
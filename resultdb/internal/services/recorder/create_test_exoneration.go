@@ -20,16 +20,13 @@ import (
 	"encoding/hex"
 	"fmt"
 
-	"cloud.google.com/go/spanner"
 	"github.com/google/uuid"
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
 	"go.chromium.org/luci/server/auth"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
-	"go.chromium.org/luci/resultdb/internal/spanutil"
 	"go.chromium.org/luci/resultdb/pbutil"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
 )
@@ -63,9 +60,10 @@ func (s *recorderServer) CreateTestExoneration(ctx context.Context, in *pb.Creat
 	}
 	invID := invocations.MustParseName(in.Invocation)
 
-	ret, mutation := insertTestExoneration(ctx, invID, in.RequestId, 0, in.TestExoneration)
-	err := mutateInvocation(ctx, invID, func(ctx context.Context) error {
-		span.BufferWrite(ctx, mutation)
+	ret := testExoneration(ctx, invID, in.RequestId, 0, in.TestExoneration)
+	err := s.mutateInvocation(ctx, invID, func(ctx context.Context) error {
+		// Exonerations with deterministic IDs are replaced on retries.
+		s.store.InsertTestExonerations(ctx, invID, []*pb.TestExoneration{ret}, in.RequestId != "")
 		return nil
 	})
 	if err != nil {
@@ -74,36 +72,27 @@ func (s *recorderServer) CreateTestExoneration(ctx context.Context, in *pb.Creat
 	return ret, nil
 }
 
-func insertTestExoneration(ctx context.Context, invID invocations.ID, requestID string, ordinal int, body *pb.TestExoneration) (ret *pb.TestExoneration, mutation *spanner.Mutation) {
-	// Compute exoneration ID and choose Insert vs InsertOrUpdate.
+// testExoneration returns the test exoneration to store, with the
+// OUTPUT_ONLY fields populated.
+func testExoneration(ctx context.Context, invID invocations.ID, requestID string, ordinal int, body *pb.TestExoneration) *pb.TestExoneration {
+	// Compute exoneration ID.
 	var exonerationIDSuffix string
-	mutFn := spanner.InsertMap
 	if requestID == "" {
 		// Use a random id.
 		exonerationIDSuffix = "r:" + uuid.New().String()
 	} else {
 		// Use a deterministic id.
 		exonerationIDSuffix = "d:" + deterministicExonerationIDSuffix(ctx, requestID, ordinal)
-		mutFn = spanner.InsertOrUpdateMap
 	}
 
 	exonerationID := fmt.Sprintf("%s:%s", pbutil.VariantHash(body.Variant), exonerationIDSuffix)
-	ret = &pb.TestExoneration{
+	return &pb.TestExoneration{
 		Name:            pbutil.TestExonerationName(string(invID), body.TestId, exonerationID),
 		TestId:          body.TestId,
 		Variant:         body.Variant,
 		ExonerationId:   exonerationID,
 		ExplanationHtml: body.ExplanationHtml,
 	}
-	mutation = mutFn("TestExonerations", spanutil.ToSpannerMap(map[string]interface{}{
-		"InvocationId":    invID,
-		"TestId":          ret.TestId,
-		"ExonerationId":   exonerationID,
-		"Variant":         ret.Variant,
-		"VariantHash":     pbutil.VariantHash(ret.Variant),
-		"ExplanationHTML": spanutil.Compressed(ret.ExplanationHtml),
-	}))
-	return
 }
 
 func deterministicExonerationIDSuffix(ctx context.Context, requestID string, ordinal int) string {
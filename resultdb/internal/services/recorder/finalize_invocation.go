@@ -21,10 +21,8 @@ import (
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
-	"go.chromium.org/luci/resultdb/internal/tasks"
 	"go.chromium.org/luci/resultdb/pbutil"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
 )
@@ -56,8 +54,8 @@ func (s *recorderServer) FinalizeInvocation(ctx context.Context, in *pb.Finalize
 	}
 
 	var ret *pb.Invocation
-	_, err = span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
-		inv, err := invocations.Read(ctx, invID)
+	err = s.store.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		inv, err := s.store.ReadInvocation(ctx, invID)
 		if err != nil {
 			return err
 		}
@@ -70,7 +68,7 @@ func (s *recorderServer) FinalizeInvocation(ctx context.Context, in *pb.Finalize
 
 		// Finalize as requested.
 		ret.State = pb.Invocation_FINALIZING
-		tasks.StartInvocationFinalization(ctx, invID)
+		s.store.StartInvocationFinalization(ctx, invID)
 		return nil
 	})
 
@@ -16,18 +16,12 @@ package recorder
 
 import (
 	"context"
-	"fmt"
 	"time"
 
-	"cloud.google.com/go/spanner"
-	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 
-	"go.chromium.org/luci/common/clock"
-	"go.chromium.org/luci/common/data/rand/mathrand"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 	"go.chromium.org/luci/server/tokens"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
@@ -37,9 +31,6 @@ import (
 const (
 	day = 24 * time.Hour
 
-	// Delete Invocations row after this duration since invocation creation.
-	invocationExpirationDuration = 2 * 365 * day // 2 y
-
 	// By default, finalize the invocation 1h after creation if it is still
 	// incomplete.
 	defaultInvocationDeadlineDuration = time.Hour
@@ -80,7 +71,7 @@ func validateInvocationToken(ctx context.Context, token string, invID invocation
 // mutateInvocation checks if the invocation can be mutated and also
 // finalizes the invocation if it's deadline is exceeded.
 // If the invocation is active, continue with the other mutation(s) in f.
-func mutateInvocation(ctx context.Context, id invocations.ID, f func(context.Context) error) error {
+func (s *recorderServer) mutateInvocation(ctx context.Context, id invocations.ID, f func(context.Context) error) error {
 	var retErr error
 
 	token, err := extractUpdateToken(ctx)
@@ -90,8 +81,8 @@ func mutateInvocation(ctx context.Context, id invocations.ID, f func(context.Con
 	if err := validateInvocationToken(ctx, token, id); err != nil {
 		return appstatus.Errorf(codes.PermissionDenied, "invalid update token")
 	}
-	_, err = span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
-		state, err := invocations.ReadState(ctx, id)
+	err = s.store.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		state, err := s.store.ReadInvocationState(ctx, id)
 		switch {
 		case err != nil:
 			return err
@@ -123,54 +114,3 @@ func extractUpdateToken(ctx context.Context) (string, error) {
 		return token[0], nil
 	}
 }
-
-// rowOfInvocation returns Invocation row values to be inserted to create the
-// invocation.
-// inv.CreateTime is ignored in favor of spanner.CommitTime.
-func (s *recorderServer) rowOfInvocation(ctx context.Context, inv *pb.Invocation, createRequestID string) map[string]interface{} {
-	now := clock.Now(ctx).UTC()
-	row := map[string]interface{}{
-		"InvocationId": invocations.MustParseName(inv.Name),
-		"ShardId":      mathrand.Intn(ctx, invocations.Shards),
-		"State":        inv.State,
-		"Realm":        inv.Realm,
-		"CreatedBy":    inv.CreatedBy,
-
-		"InvocationExpirationTime":          now.Add(invocationExpirationDuration),
-		"ExpectedTestResultsExpirationTime": now.Add(s.ExpectedResultsExpiration),
-
-		"CreateTime": spanner.CommitTimestamp,
-		"Deadline":   inv.Deadline,
-
-		"Tags":             inv.Tags,
-		"ProducerResource": inv.ProducerResource,
-		"TestResultCount":  0,
-	}
-
-	if inv.State == pb.Invocation_FINALIZED {
-		// We are ignoring the provided inv.FinalizeTime because it would not
-		// make sense to have an invocation finalized before it was created,
-		// yet attempting to set this in the future would fail the sql schema
-		// restriction for columns that allow commit timestamp.
-		// Note this function is only used for setting FinalizeTime by derive
-		// invocation, which is planned to be superseded by other mechanisms.
-		row["FinalizeTime"] = spanner.CommitTimestamp
-	}
-
-	if createRequestID != "" {
-		row["CreateRequestId"] = createRequestID
-	}
-
-	if len(inv.BigqueryExports) != 0 {
-		bqExports := make([][]byte, len(inv.BigqueryExports))
-		for i, msg := range inv.BigqueryExports {
-			var err error
-			if bqExports[i], err = proto.Marshal(msg); err != nil {
-				panic(fmt.Sprintf("failed to marshal BigQueryExport to bytes: %s", err))
-			}
-		}
-		row["BigQueryExports"] = bqExports
-	}
-
-	return row
-}
@@ -40,7 +40,7 @@ func TestMutateInvocation(t *testing.T) {
 		ctx := testutil.SpannerTestContext(t)
 
 		mayMutate := func(id invocations.ID) error {
-			return mutateInvocation(ctx, id, func(ctx context.Context) error {
+			return newTestRecorderServer().mutateInvocation(ctx, id, func(ctx context.Context) error {
 				return nil
 			})
 		}
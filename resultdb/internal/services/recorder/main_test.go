@@ -17,6 +17,7 @@ package recorder
 import (
 	"testing"
 
+	"go.chromium.org/luci/resultdb/internal/storage"
 	"go.chromium.org/luci/resultdb/internal/testutil"
 )
 
@@ -29,5 +30,6 @@ func TestMain(m *testing.M) {
 func newTestRecorderServer() *recorderServer {
 	return &recorderServer{
 		Options: &Options{ExpectedResultsExpiration: expectedResultExpiration},
+		store:   storage.Spanner{},
 	}
 }
@@ -26,6 +26,7 @@ import (
 
 	"go.chromium.org/luci/resultdb/internal"
 	"go.chromium.org/luci/resultdb/internal/artifactcontent"
+	"go.chromium.org/luci/resultdb/internal/storage"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
 )
 
@@ -35,6 +36,8 @@ import (
 // internal.CommonPostlude.
 type recorderServer struct {
 	*Options
+
+	store storage.Store
 }
 
 // Options is recorder server configuration.
@@ -48,12 +51,17 @@ type Options struct {
 	ArtifactRBEInstance string
 }
 
+// NewServer returns a recorder server that stores data in store.
+func NewServer(store storage.Store, opt Options) pb.RecorderServer {
+	return &pb.DecoratedRecorder{
+		Service:  &recorderServer{Options: &opt, store: store},
+		Postlude: internal.CommonPostlude,
+	}
+}
+
 // InitServer initializes a recorder server.
 func InitServer(srv *server.Server, opt Options) error {
-	pb.RegisterRecorderServer(srv.PRPC, &pb.DecoratedRecorder{
-		Service:  &recorderServer{Options: &opt},
-		Postlude: internal.CommonPostlude,
-	})
+	pb.RegisterRecorderServer(srv.PRPC, NewServer(storage.Spanner{}, opt))
 
 	return installArtifactCreationHandler(srv, &opt)
 }
@@ -70,16 +78,24 @@ func installArtifactCreationHandler(srv *server.Server, opt *Options) error {
 	}
 
 	bs := bytestream.NewByteStreamClient(conn)
+	InstallArtifactCreationHandler(srv.Routes, storage.Spanner{}, opt.ArtifactRBEInstance, func(ctx context.Context) (bytestream.ByteStream_WriteClient, error) {
+		return bs.Write(ctx)
+	})
+	return nil
+}
+
+// InstallArtifactCreationHandler installs a handler of artifact creation
+// requests that stores the artifacts in store and their contents in the
+// RBE-CAS instance.
+func InstallArtifactCreationHandler(r *router.Router, store storage.Store, rbeInstance string, newCASWriter func(context.Context) (bytestream.ByteStream_WriteClient, error)) {
 	ach := &artifactCreationHandler{
-		RBEInstance: opt.ArtifactRBEInstance,
-		NewCASWriter: func(ctx context.Context) (bytestream.ByteStream_WriteClient, error) {
-			return bs.Write(ctx)
-		},
+		RBEInstance:  rbeInstance,
+		NewCASWriter: newCASWriter,
+		store:        store,
 	}
 
 	// Ideally we define more specific routes, but
 	// "github.com/julienschmidt/httprouter" does not support routes over
 	// unescaped paths: https://github.com/julienschmidt/httprouter/issues/208
-	srv.Routes.PUT("invocations/*rest", router.MiddlewareChain{}, ach.Handle)
-	return nil
+	r.PUT("invocations/*rest", router.MiddlewareChain{}, ach.Handle)
 }
@@ -17,17 +17,14 @@ package recorder
 import (
 	"context"
 
-	"cloud.google.com/go/spanner"
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
 
 	"go.chromium.org/luci/common/data/stringset"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
-	"go.chromium.org/luci/resultdb/internal/spanutil"
 	"go.chromium.org/luci/resultdb/pbutil"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
 )
@@ -69,29 +66,17 @@ func (s *recorderServer) UpdateIncludedInvocations(ctx context.Context, in *pb.U
 	add := invocations.MustParseNames(in.AddInvocations)
 	remove := invocations.MustParseNames(in.RemoveInvocations)
 
-	err := mutateInvocation(ctx, including, func(ctx context.Context) error {
-		// Accumulate keys to remove in a single KeySet.
-		ks := spanner.KeySets()
-		for rInv := range remove {
-			ks = spanner.KeySets(invocations.InclusionKey(including, rInv), ks)
-		}
-		ms := make([]*spanner.Mutation, 1, 1+len(add))
-		ms[0] = spanner.Delete("IncludedInvocations", ks)
+	err := s.mutateInvocation(ctx, including, func(ctx context.Context) error {
+		s.store.RemoveInclusions(ctx, including, remove)
 
-		switch states, err := invocations.ReadStateBatch(ctx, add); {
+		switch states, err := s.store.ReadInvocationStates(ctx, add); {
 		case err != nil:
 			return err
 		// Ensure every included invocation exists.
 		case len(states) != len(add):
 			return appstatus.Errorf(codes.NotFound, "at least one of the included invocations does not exist")
 		}
-		for aInv := range add {
-			ms = append(ms, spanutil.InsertOrUpdateMap("IncludedInvocations", map[string]interface{}{
-				"InvocationId":         including,
-				"IncludedInvocationId": aInv,
-			}))
-		}
-		span.BufferWrite(ctx, ms...)
+		s.store.AddInclusions(ctx, including, add)
 		return nil
 	})
 
@@ -21,10 +21,8 @@ import (
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
-	"go.chromium.org/luci/resultdb/internal/spanutil"
 	"go.chromium.org/luci/resultdb/pbutil"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
 )
@@ -66,31 +64,25 @@ func (s *recorderServer) UpdateInvocation(ctx context.Context, in *pb.UpdateInvo
 	invID := invocations.MustParseName(in.Invocation.Name)
 
 	var ret *pb.Invocation
-	err := mutateInvocation(ctx, invID, func(ctx context.Context) error {
+	err := s.mutateInvocation(ctx, invID, func(ctx context.Context) error {
 		var err error
-		if ret, err = invocations.Read(ctx, invID); err != nil {
+		if ret, err = s.store.ReadInvocation(ctx, invID); err != nil {
 			return err
 		}
 
-		values := map[string]interface{}{
-			"InvocationId": invID,
-		}
-
 		for _, path := range in.UpdateMask.Paths {
 			switch path {
 			// The cases in this switch statement must be synchronized with a
 			// similar switch statement in validateUpdateInvocationRequest.
 
 			case "deadline":
-				values["Deadline"] = in.Invocation.Deadline
+				s.store.UpdateInvocationDeadline(ctx, invID, in.Invocation.Deadline)
 				ret.Deadline = in.Invocation.Deadline
 
 			default:
 				panic("impossible")
 			}
 		}
-
-		span.BufferWrite(ctx, spanutil.UpdateMap("Invocations", values))
 		return nil
 	})
 	if err != nil {
@@ -21,21 +21,19 @@ import (
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
-	"go.chromium.org/luci/resultdb/internal/artifacts"
 	"go.chromium.org/luci/resultdb/internal/invocations"
 	"go.chromium.org/luci/resultdb/pbutil"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
 )
 
-func verifyReadArtifactPermission(ctx context.Context, name string) error {
+func (s *resultDBServer) verifyReadArtifactPermission(ctx context.Context, name string) error {
 	invIDStr, _, _, _, inputErr := pbutil.ParseArtifactName(name)
 	if inputErr != nil {
 		return appstatus.BadRequest(inputErr)
 	}
 
-	return verifyPermission(ctx, permGetArtifact, invocations.ID(invIDStr))
+	return s.verifyPermission(ctx, permGetArtifact, invocations.ID(invIDStr))
 }
 
 func validateGetArtifactRequest(req *pb.GetArtifactRequest) error {
@@ -48,7 +46,7 @@ func validateGetArtifactRequest(req *pb.GetArtifactRequest) error {
 
 // GetArtifact implements pb.ResultDBServer.
 func (s *resultDBServer) GetArtifact(ctx context.Context, in *pb.GetArtifactRequest) (*pb.Artifact, error) {
-	if err := verifyReadArtifactPermission(ctx, in.Name); err != nil {
+	if err := s.verifyReadArtifactPermission(ctx, in.Name); err != nil {
 		return nil, err
 	}
 
@@ -56,10 +54,7 @@ func (s *resultDBServer) GetArtifact(ctx context.Context, in *pb.GetArtifactRequ
 		return nil, appstatus.BadRequest(err)
 	}
 
-	ctx, cancel := span.ReadOnlyTransaction(ctx)
-	defer cancel()
-
-	art, err := artifacts.Read(ctx, in.Name)
+	art, err := s.store.ReadArtifact(ctx, in.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -19,7 +19,6 @@ import (
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
 	"go.chromium.org/luci/resultdb/pbutil"
@@ -41,7 +40,7 @@ func validateGetInvocationRequest(req *pb.GetInvocationRequest) error {
 
 // GetInvocation implements pb.ResultDBServer.
 func (s *resultDBServer) GetInvocation(ctx context.Context, in *pb.GetInvocationRequest) (*pb.Invocation, error) {
-	if err := verifyPermissionInvNames(ctx, permGetInvocation, in.Name); err != nil {
+	if err := s.verifyPermissionInvNames(ctx, permGetInvocation, in.Name); err != nil {
 		return nil, err
 	}
 
@@ -49,7 +48,5 @@ func (s *resultDBServer) GetInvocation(ctx context.Context, in *pb.GetInvocation
 		return nil, appstatus.BadRequest(err)
 	}
 
-	ctx, cancel := span.ReadOnlyTransaction(ctx)
-	defer cancel()
-	return invocations.Read(ctx, invocations.MustParseName(in.Name))
+	return s.store.ReadInvocation(ctx, invocations.MustParseName(in.Name))
 }
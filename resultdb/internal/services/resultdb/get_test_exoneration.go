@@ -19,9 +19,7 @@ import (
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
-	"go.chromium.org/luci/resultdb/internal/exonerations"
 	"go.chromium.org/luci/resultdb/pbutil"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
 )
@@ -39,7 +37,5 @@ func (s *resultDBServer) GetTestExoneration(ctx context.Context, in *pb.GetTestE
 		return nil, appstatus.BadRequest(err)
 	}
 
-	ctx, cancel := span.ReadOnlyTransaction(ctx)
-	defer cancel()
-	return exonerations.Read(ctx, in.Name)
+	return s.store.ReadTestExoneration(ctx, in.Name)
 }
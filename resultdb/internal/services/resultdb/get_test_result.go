@@ -19,9 +19,7 @@ import (
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
-	"go.chromium.org/luci/resultdb/internal/testresults"
 	"go.chromium.org/luci/resultdb/pbutil"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
 )
@@ -39,9 +37,7 @@ func (s *resultDBServer) GetTestResult(ctx context.Context, in *pb.GetTestResult
 		return nil, appstatus.BadRequest(err)
 	}
 
-	ctx, cancel := span.ReadOnlyTransaction(ctx)
-	defer cancel()
-	tr, err := testresults.Read(ctx, in.Name)
+	tr, err := s.store.ReadTestResult(ctx, in.Name)
 	if err != nil {
 		return nil, err
 	}
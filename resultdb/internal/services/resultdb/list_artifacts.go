@@ -20,7 +20,6 @@ import (
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/artifacts"
 	"go.chromium.org/luci/resultdb/internal/invocations"
@@ -65,7 +64,7 @@ func (s *resultDBServer) ListArtifacts(ctx context.Context, in *pb.ListArtifacts
 		return nil, err
 	}
 
-	if err := verifyPermission(ctx, permListArtifacts, invID); err != nil {
+	if err := s.verifyPermission(ctx, permListArtifacts, invID); err != nil {
 		return nil, err
 	}
 
@@ -82,7 +81,7 @@ func (s *resultDBServer) ListArtifacts(ctx context.Context, in *pb.ListArtifacts
 	}
 
 	// Read artifacts.
-	arts, token, err := q.Fetch(span.Single(ctx))
+	arts, token, err := s.store.QueryArtifacts(ctx, &q)
 	if err != nil {
 		return nil, err
 	}
@@ -19,7 +19,6 @@ import (
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/exonerations"
 	"go.chromium.org/luci/resultdb/internal/invocations"
@@ -42,7 +41,7 @@ func validateListTestExonerationsRequest(req *pb.ListTestExonerationsRequest) er
 
 // ListTestExonerations implements pb.ResultDBServer.
 func (s *resultDBServer) ListTestExonerations(ctx context.Context, in *pb.ListTestExonerationsRequest) (*pb.ListTestExonerationsResponse, error) {
-	if err := verifyPermissionInvNames(ctx, permListTestExonerations, in.Invocation); err != nil {
+	if err := s.verifyPermissionInvNames(ctx, permListTestExonerations, in.Invocation); err != nil {
 		return nil, err
 	}
 
@@ -56,9 +55,7 @@ func (s *resultDBServer) ListTestExonerations(ctx context.Context, in *pb.ListTe
 		PageToken:     in.GetPageToken(),
 	}
 
-	ctx, cancel := span.ReadOnlyTransaction(ctx)
-	defer cancel()
-	tes, tok, err := q.Fetch(ctx)
+	tes, tok, err := s.store.QueryTestExonerations(ctx, &q)
 	if err != nil {
 		return nil, err
 	}
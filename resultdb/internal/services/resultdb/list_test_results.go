@@ -19,7 +19,6 @@ import (
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
 	"go.chromium.org/luci/resultdb/internal/pagination"
@@ -42,7 +41,7 @@ func validateListTestResultsRequest(req *pb.ListTestResultsRequest) error {
 
 // ListTestResults implements pb.ResultDBServer.
 func (s *resultDBServer) ListTestResults(ctx context.Context, in *pb.ListTestResultsRequest) (*pb.ListTestResultsResponse, error) {
-	if err := verifyPermissionInvNames(ctx, permListTestResults, in.Invocation); err != nil {
+	if err := s.verifyPermissionInvNames(ctx, permListTestResults, in.Invocation); err != nil {
 		return nil, err
 	}
 
@@ -55,16 +54,13 @@ func (s *resultDBServer) ListTestResults(ctx context.Context, in *pb.ListTestRes
 		return nil, appstatus.BadRequest(err)
 	}
 
-	ctx, cancel := span.ReadOnlyTransaction(ctx)
-	defer cancel()
-
 	q := testresults.Query{
 		PageSize:      pagination.AdjustPageSize(in.PageSize),
 		PageToken:     in.PageToken,
 		InvocationIDs: invocations.NewIDSet(invocations.MustParseName(in.Invocation)),
 		Mask:          readMask,
 	}
-	trs, tok, err := q.Fetch(ctx)
+	trs, tok, err := s.store.QueryTestResults(ctx, &q)
 	if err != nil {
 		return nil, err
 	}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/resultdb/internal/storage"
 	"go.chromium.org/luci/resultdb/internal/testutil"
 )
 
@@ -29,6 +30,7 @@ func TestMain(m *testing.M) {
 
 func newTestResultDBService() *resultDBServer {
 	return &resultDBServer{
+		store: storage.Spanner{},
 		generateArtifactURL: func(ctx context.Context, requestHost, artifactName string) (u string, expiration time.Time, err error) {
 			u = "https://signed-url.example.com/" + artifactName
 			expiration = clock.Now(ctx).UTC().Add(time.Hour)
@@ -24,7 +24,6 @@ import (
 	"go.chromium.org/luci/grpc/appstatus"
 	"go.chromium.org/luci/server/auth"
 	"go.chromium.org/luci/server/auth/realms"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
 )
@@ -42,17 +41,17 @@ var (
 
 // verifyPermission checks if the caller has the specified permission on the
 // realm that the invocation with the specified id belongs to.
-func verifyPermission(ctx context.Context, permission realms.Permission, id invocations.ID) error {
-	return verifyPermissionBatch(ctx, permission, invocations.NewIDSet(id))
+func (s *resultDBServer) verifyPermission(ctx context.Context, permission realms.Permission, id invocations.ID) error {
+	return s.verifyPermissionBatch(ctx, permission, invocations.NewIDSet(id))
 }
 
 // verifyPermissionBatch is like verifyPermission, but checks multiple
 // invocations.
-func verifyPermissionBatch(ctx context.Context, permission realms.Permission, ids invocations.IDSet) (err error) {
+func (s *resultDBServer) verifyPermissionBatch(ctx context.Context, permission realms.Permission, ids invocations.IDSet) (err error) {
 	ctx, ts := trace.StartSpan(ctx, "resultdb.resultdb.verifyPermissionBatch")
 	defer func() { ts.End(err) }()
 
-	realms, err := invocations.ReadRealms(span.Single(ctx), ids)
+	realms, err := s.store.ReadRealms(ctx, ids)
 	if err != nil {
 		return err
 	}
@@ -75,10 +74,10 @@ func verifyPermissionBatch(ctx context.Context, permission realms.Permission, id
 
 // verifyPermissionInvNames does the same as verifyPermission but accepts
 // invocation names (variadic)  instead of a single  invocations.ID.
-func verifyPermissionInvNames(ctx context.Context, permission realms.Permission, invNames ...string) error {
+func (s *resultDBServer) verifyPermissionInvNames(ctx context.Context, permission realms.Permission, invNames ...string) error {
 	ids, err := invocations.ParseNames(invNames)
 	if err != nil {
 		return appstatus.BadRequest(err)
 	}
-	return verifyPermissionBatch(ctx, permission, ids)
+	return s.verifyPermissionBatch(ctx, permission, ids)
 }
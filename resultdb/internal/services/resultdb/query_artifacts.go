@@ -17,12 +17,8 @@ package resultdb
 import (
 	"context"
 
-	"cloud.google.com/go/spanner"
-	"github.com/golang/protobuf/ptypes"
-
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/artifacts"
 	"go.chromium.org/luci/resultdb/internal/invocations"
@@ -42,7 +38,7 @@ func validateQueryArtifactsRequest(req *pb.QueryArtifactsRequest) error {
 
 // QueryArtifacts implements pb.ResultDBServer.
 func (s *resultDBServer) QueryArtifacts(ctx context.Context, in *pb.QueryArtifactsRequest) (*pb.QueryArtifactsResponse, error) {
-	if err := verifyPermissionInvNames(ctx, permListArtifacts, in.Invocations...); err != nil {
+	if err := s.verifyPermissionInvNames(ctx, permListArtifacts, in.Invocations...); err != nil {
 		return nil, err
 	}
 
@@ -51,15 +47,11 @@ func (s *resultDBServer) QueryArtifacts(ctx context.Context, in *pb.QueryArtifac
 	}
 
 	// Open a transaction.
-	ctx, cancel := span.ReadOnlyTransaction(ctx)
+	ctx, cancel := s.readOnlyTransaction(ctx, in)
 	defer cancel()
-	if in.MaxStaleness != nil {
-		st, _ := ptypes.Duration(in.MaxStaleness)
-		span.RO(ctx).WithTimestampBound(spanner.MaxStaleness(st))
-	}
 
 	// Get the transitive closure.
-	invs, err := invocations.Reachable(ctx, invocations.MustParseNames(in.Invocations))
+	invs, err := s.store.Reachable(ctx, invocations.MustParseNames(in.Invocations))
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +64,7 @@ func (s *resultDBServer) QueryArtifacts(ctx context.Context, in *pb.QueryArtifac
 		PageToken:           in.PageToken,
 		FollowEdges:         in.FollowEdges,
 	}
-	arts, token, err := q.Fetch(ctx)
+	arts, token, err := s.store.QueryArtifacts(ctx, &q)
 	if err != nil {
 		return nil, err
 	}
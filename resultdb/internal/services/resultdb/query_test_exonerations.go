@@ -17,12 +17,8 @@ package resultdb
 import (
 	"context"
 
-	"cloud.google.com/go/spanner"
-	"github.com/golang/protobuf/ptypes"
-
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/exonerations"
 	"go.chromium.org/luci/resultdb/internal/invocations"
@@ -43,7 +39,7 @@ func validateQueryTestExonerationsRequest(req *pb.QueryTestExonerationsRequest)
 
 // QueryTestExonerations implements pb.ResultDBServer.
 func (s *resultDBServer) QueryTestExonerations(ctx context.Context, in *pb.QueryTestExonerationsRequest) (*pb.QueryTestExonerationsResponse, error) {
-	if err := verifyPermissionInvNames(ctx, permListTestExonerations, in.Invocations...); err != nil {
+	if err := s.verifyPermissionInvNames(ctx, permListTestExonerations, in.Invocations...); err != nil {
 		return nil, err
 	}
 
@@ -52,15 +48,11 @@ func (s *resultDBServer) QueryTestExonerations(ctx context.Context, in *pb.Query
 	}
 
 	// Open a transaction.
-	ctx, cancel := span.ReadOnlyTransaction(ctx)
+	ctx, cancel := s.readOnlyTransaction(ctx, in)
 	defer cancel()
-	if in.MaxStaleness != nil {
-		st, _ := ptypes.Duration(in.MaxStaleness)
-		span.RO(ctx).WithTimestampBound(spanner.MaxStaleness(st))
-	}
 
 	// Get the transitive closure.
-	invs, err := invocations.Reachable(ctx, invocations.MustParseNames(in.Invocations))
+	invs, err := s.store.Reachable(ctx, invocations.MustParseNames(in.Invocations))
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +64,7 @@ func (s *resultDBServer) QueryTestExonerations(ctx context.Context, in *pb.Query
 		PageToken:     in.PageToken,
 		InvocationIDs: invs,
 	}
-	tes, token, err := q.Fetch(ctx)
+	tes, token, err := s.store.QueryTestExonerations(ctx, &q)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,90 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resultdb
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/grpc/appstatus"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/realms"
+
+	"go.chromium.org/luci/resultdb/internal/flakiness"
+	"go.chromium.org/luci/resultdb/internal/pagination"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// validateQueryTestFlakinessRequest returns a non-nil error if req is
+// determined to be invalid.
+func validateQueryTestFlakinessRequest(req *pb.QueryTestFlakinessRequest) error {
+	if req.Realm == "" {
+		return errors.Reason("realm: unspecified").Err()
+	}
+	if err := realms.ValidateRealmName(req.Realm, realms.GlobalScope); err != nil {
+		return errors.Annotate(err, "realm").Err()
+	}
+
+	if err := pbutil.ValidateTestResultPredicate(flakinessPredicate(req)); err != nil {
+		return err
+	}
+
+	if req.MinFlakeRate < 0 || req.MinFlakeRate > 1 {
+		return errors.Reason("min_flake_rate: must be between 0 and 1, inclusive").Err()
+	}
+
+	if err := pagination.ValidatePageSize(req.PageSize); err != nil {
+		return errors.Annotate(err, "page_size").Err()
+	}
+	return nil
+}
+
+// flakinessPredicate returns the predicate of the requested test variants.
+func flakinessPredicate(req *pb.QueryTestFlakinessRequest) *pb.TestResultPredicate {
+	return &pb.TestResultPredicate{
+		TestIdRegexp: req.TestIdRegexp,
+		Variant:      req.VariantPredicate,
+	}
+}
+
+// QueryTestFlakiness implements pb.ResultDBServer.
+func (s *resultDBServer) QueryTestFlakiness(ctx context.Context, in *pb.QueryTestFlakinessRequest) (*pb.QueryTestFlakinessResponse, error) {
+	if err := validateQueryTestFlakinessRequest(in); err != nil {
+		return nil, appstatus.BadRequest(err)
+	}
+
+	// Note: HasPermission does not make RPCs.
+	switch allowed, err := auth.HasPermission(ctx, permListTestResults, in.Realm); {
+	case err != nil:
+		return nil, err
+	case !allowed:
+		return nil, appstatus.Errorf(codes.PermissionDenied, `caller does not have permission %s in realm %s`, permListTestResults, in.Realm)
+	}
+
+	q := &flakiness.StoredQuery{
+		Realm:        in.Realm,
+		Predicate:    flakinessPredicate(in),
+		MinFlakeRate: in.MinFlakeRate,
+		Limit:        pagination.AdjustPageSize(in.PageSize),
+	}
+	fs, err := s.store.QueryTestFlakiness(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.QueryTestFlakinessResponse{TestFlakiness: fs}, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resultdb
+
+import (
+	"testing"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestValidateQueryTestFlakinessRequest(t *testing.T) {
+	t.Parallel()
+	Convey(`ValidateQueryTestFlakinessRequest`, t, func() {
+		req := &pb.QueryTestFlakinessRequest{
+			Realm:        "testproject:testrealm",
+			TestIdRegexp: "ninja://chrome/.*",
+			PageSize:     50,
+		}
+
+		Convey(`Valid`, func() {
+			So(validateQueryTestFlakinessRequest(req), ShouldBeNil)
+		})
+
+		Convey(`No realm`, func() {
+			req.Realm = ""
+			So(validateQueryTestFlakinessRequest(req), ShouldErrLike, `realm: unspecified`)
+		})
+
+		Convey(`Invalid realm`, func() {
+			req.Realm = "testproject"
+			So(validateQueryTestFlakinessRequest(req), ShouldErrLike, `realm: bad global realm name`)
+		})
+
+		Convey(`Invalid test id regexp`, func() {
+			req.TestIdRegexp = "^a"
+			So(validateQueryTestFlakinessRequest(req), ShouldErrLike, `test_id_regexp: must not start with ^`)
+		})
+
+		Convey(`Invalid min flake rate`, func() {
+			req.MinFlakeRate = 2
+			So(validateQueryTestFlakinessRequest(req), ShouldErrLike, `min_flake_rate: must be between 0 and 1`)
+		})
+
+		Convey(`Invalid page size`, func() {
+			req.PageSize = -1
+			So(validateQueryTestFlakinessRequest(req), ShouldErrLike, `page_size: negative`)
+		})
+	})
+}
@@ -17,11 +17,7 @@ package resultdb
 import (
 	"context"
 
-	"cloud.google.com/go/spanner"
-	"github.com/golang/protobuf/ptypes"
-
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
@@ -29,7 +25,7 @@ import (
 
 // QueryTestResultStatistics implements pb.ResultDBServer.
 func (s *resultDBServer) QueryTestResultStatistics(ctx context.Context, in *pb.QueryTestResultStatisticsRequest) (*pb.QueryTestResultStatisticsResponse, error) {
-	if err := verifyPermissionInvNames(ctx, permListTestResults, in.Invocations...); err != nil {
+	if err := s.verifyPermissionInvNames(ctx, permListTestResults, in.Invocations...); err != nil {
 		return nil, err
 	}
 
@@ -38,20 +34,16 @@ func (s *resultDBServer) QueryTestResultStatistics(ctx context.Context, in *pb.Q
 	}
 
 	// Open a transaction.
-	ctx, cancel := span.ReadOnlyTransaction(ctx)
+	ctx, cancel := s.readOnlyTransaction(ctx, in)
 	defer cancel()
-	if in.MaxStaleness != nil {
-		st, _ := ptypes.Duration(in.MaxStaleness)
-		span.RO(ctx).WithTimestampBound(spanner.MaxStaleness(st))
-	}
 
 	// Get the transitive closure.
-	invs, err := invocations.Reachable(ctx, invocations.MustParseNames(in.Invocations))
+	invs, err := s.store.Reachable(ctx, invocations.MustParseNames(in.Invocations))
 	if err != nil {
 		return nil, err
 	}
 
-	totalNum, err := invocations.ReadTestResultCount(ctx, invs)
+	totalNum, err := s.store.ReadTestResultCount(ctx, invs)
 	if err != nil {
 		return nil, err
 	}
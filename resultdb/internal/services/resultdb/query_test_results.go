@@ -16,14 +16,13 @@ package resultdb
 
 import (
 	"context"
+	"time"
 
-	"cloud.google.com/go/spanner"
 	"github.com/golang/protobuf/ptypes"
 	durpb "github.com/golang/protobuf/ptypes/duration"
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/grpc/appstatus"
-	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/resultdb/internal/invocations"
 	"go.chromium.org/luci/resultdb/internal/pagination"
@@ -46,6 +45,15 @@ type queryRequestWithPaging interface {
 	GetPageSize() int32
 }
 
+// readOnlyTransaction opens a read-only transaction to serve req.
+func (s *resultDBServer) readOnlyTransaction(ctx context.Context, req queryRequest) (context.Context, context.CancelFunc) {
+	var maxStaleness time.Duration
+	if req.GetMaxStaleness() != nil {
+		maxStaleness, _ = ptypes.Duration(req.GetMaxStaleness())
+	}
+	return s.store.ReadOnlyTransaction(ctx, maxStaleness)
+}
+
 // validateQueryRequest returns a non-nil error if req is determined to be
 // invalid.
 func validateQueryRequest(req queryRequest) error {
@@ -85,7 +93,7 @@ func validateQueryTestResultsRequest(req *pb.QueryTestResultsRequest) error {
 
 // QueryTestResults implements pb.ResultDBServer.
 func (s *resultDBServer) QueryTestResults(ctx context.Context, in *pb.QueryTestResultsRequest) (*pb.QueryTestResultsResponse, error) {
-	if err := verifyPermissionInvNames(ctx, permListTestResults, in.Invocations...); err != nil {
+	if err := s.verifyPermissionInvNames(ctx, permListTestResults, in.Invocations...); err != nil {
 		return nil, err
 	}
 
@@ -98,15 +106,11 @@ func (s *resultDBServer) QueryTestResults(ctx context.Context, in *pb.QueryTestR
 	}
 
 	// Open a transaction.
-	ctx, cancel := span.ReadOnlyTransaction(ctx)
+	ctx, cancel := s.readOnlyTransaction(ctx, in)
 	defer cancel()
-	if in.MaxStaleness != nil {
-		st, _ := ptypes.Duration(in.MaxStaleness)
-		span.RO(ctx).WithTimestampBound(spanner.MaxStaleness(st))
-	}
 
 	// Get the transitive closure.
-	invs, err := invocations.Reachable(ctx, invocations.MustParseNames(in.Invocations))
+	invs, err := s.store.Reachable(ctx, invocations.MustParseNames(in.Invocations))
 	if err != nil {
 		return nil, errors.Annotate(err, "failed to read the reach").Err()
 	}
@@ -119,7 +123,7 @@ func (s *resultDBServer) QueryTestResults(ctx context.Context, in *pb.QueryTestR
 		InvocationIDs: invs,
 		Mask:          readMask,
 	}
-	trs, token, err := q.Fetch(ctx)
+	trs, token, err := s.store.QueryTestResults(ctx, &q)
 	if err != nil {
 		return nil, errors.Annotate(err, "failed to read test results").Err()
 	}
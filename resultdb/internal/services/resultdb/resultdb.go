@@ -27,6 +27,7 @@ import (
 
 	"go.chromium.org/luci/resultdb/internal"
 	"go.chromium.org/luci/resultdb/internal/artifactcontent"
+	"go.chromium.org/luci/resultdb/internal/storage"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
 )
 
@@ -35,6 +36,7 @@ import (
 // It does not return gRPC-native errors; use DecoratedResultDB with
 // internal.CommonPostlude.
 type resultDBServer struct {
+	store               storage.Store
 	generateArtifactURL func(ctx context.Context, requestHost, artifactName string) (url string, expiration time.Time, err error)
 }
 
@@ -55,6 +57,18 @@ type Options struct {
 	ArtifactRBEInstance string
 }
 
+// NewServer returns a resultdb server that reads data from store.
+// contentServer serves the contents of the artifacts.
+func NewServer(store storage.Store, contentServer *artifactcontent.Server) pb.ResultDBServer {
+	return &pb.DecoratedResultDB{
+		Service: &resultDBServer{
+			store:               store,
+			generateArtifactURL: contentServer.GenerateSignedURL,
+		},
+		Postlude: internal.CommonPostlude,
+	}
+}
+
 // InitServer initializes a resultdb server.
 func InitServer(srv *server.Server, opts Options) error {
 	contentServer, err := newArtifactContentServer(srv.Context, opts)
@@ -71,12 +85,7 @@ func InitServer(srv *server.Server, opts Options) error {
 		contentServer.InstallHandlers(srv.VirtualHost(host))
 	}
 
-	pb.RegisterResultDBServer(srv.PRPC, &pb.DecoratedResultDB{
-		Service: &resultDBServer{
-			generateArtifactURL: contentServer.GenerateSignedURL,
-		},
-		Postlude: internal.CommonPostlude,
-	})
+	pb.RegisterResultDBServer(srv.PRPC, NewServer(storage.Spanner{}, contentServer))
 
 	// Register an empty Recorder server only to make the discovery service
 	// list it.
@@ -118,6 +127,7 @@ func newArtifactContentServer(ctx context.Context, opts Options) (*artifactconte
 			return bs.Read(ctx, req)
 		},
 		RBECASInstanceName: opts.ArtifactRBEInstance,
+		Store:              storage.Spanner{},
 	}
 
 	if err := contentServer.Init(ctx); err != nil {
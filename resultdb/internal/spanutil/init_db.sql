@@ -189,6 +189,22 @@ CREATE TABLE TestResults (
   -- See also TestResult.test_location.line field.
   TestLocationLine INT64,
 
+  -- Owners of the test.
+  -- See also TestResult.test_metadata.owners field.
+  TestMetadataOwners ARRAY<STRING(MAX)>,
+
+  -- Name of the file where the test is defined.
+  -- See also TestResult.test_metadata.location.file_name field.
+  TestMetadataFileName STRING(MAX),
+
+  -- Line number in the test file.
+  -- See also TestResult.test_metadata.location.line field.
+  TestMetadataLine INT64,
+
+  -- Bug tracker component of the test.
+  -- See also TestResult.test_metadata.bug_component field.
+  TestMetadataBugComponent STRING(MAX),
+
 ) PRIMARY KEY (InvocationId, TestId, ResultId),
   INTERLEAVE IN PARENT Invocations ON DELETE CASCADE;
 
@@ -269,6 +285,43 @@ CREATE TABLE TestExonerations (
 ) PRIMARY KEY (InvocationId, TestId, ExonerationId),
   INTERLEAVE IN PARENT Invocations ON DELETE CASCADE;
 
+-- Stores the flakiness of test variants in a realm.
+-- Periodically recomputed from the recent history of the realm by the
+-- flakinessanalyzer server, and read by QueryTestFlakiness RPC.
+-- Only test variants with a positive flake rate are stored.
+CREATE TABLE TestFlakiness (
+  -- Security realm of the analyzed invocations.
+  Realm STRING(64) NOT NULL,
+
+  -- Unique identifier of the test,
+  -- see also TestResult.test_id in test_result.proto.
+  TestId STRING(MAX) NOT NULL,
+
+  -- A hex-encoded sha256 of concatenated "<key>:<value>\n" variant pairs.
+  VariantHash STRING(64) NOT NULL,
+
+  -- key:value pairs in the test variant.
+  -- See also TestFlakiness.variant in test_result.proto.
+  Variant ARRAY<STRING(MAX)>,
+
+  -- Flakiness score in (0, 1], see TestFlakiness.flake_rate.
+  FlakeRate FLOAT64 NOT NULL,
+
+  -- See the TestFlakiness message in test_result.proto.
+  InvocationCount INT64 NOT NULL,
+  FlakyInvocationCount INT64 NOT NULL,
+  FlipCount INT64 NOT NULL,
+
+  -- When the flakiness was computed.
+  -- Rows computed before the latest analysis of the realm are deleted, as
+  -- well as rows of realms without recent invocations.
+  ComputeTime TIMESTAMP NOT NULL,
+) PRIMARY KEY (Realm, TestId, VariantHash);
+
+-- Used by QueryTestFlakiness to return the flakiest test variants first.
+CREATE INDEX TestFlakinessByFlakeRate
+  ON TestFlakiness (Realm, FlakeRate DESC);
+
 -- Stores tasks to perform on invocations.
 -- E.g. to export an invocation to a BigQuery table.
 CREATE TABLE InvocationTasks (
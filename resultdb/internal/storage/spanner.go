@@ -0,0 +1,404 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/golang/protobuf/proto"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/rand/mathrand"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/grpc/appstatus"
+	"go.chromium.org/luci/server/span"
+
+	"go.chromium.org/luci/resultdb/internal/artifacts"
+	"go.chromium.org/luci/resultdb/internal/exonerations"
+	"go.chromium.org/luci/resultdb/internal/flakiness"
+	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/spanutil"
+	"go.chromium.org/luci/resultdb/internal/tasks"
+	"go.chromium.org/luci/resultdb/internal/testresults"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// InvocationExpirationDuration is the duration since invocation creation after
+// which the Invocations row is deleted.
+const InvocationExpirationDuration = 2 * 365 * 24 * time.Hour // 2 y
+
+// Spanner is a Store on top of Cloud Spanner.
+// The context must have a Spanner client, see span.UseClient.
+type Spanner struct{}
+
+var _ Store = Spanner{}
+
+// single returns a context for a single read. Reuses the transaction in ctx,
+// if any.
+func single(ctx context.Context) context.Context {
+	if span.Txn(ctx) != nil {
+		return ctx
+	}
+	return span.Single(ctx)
+}
+
+// readOnly returns a context for multiple reads. Reuses the transaction in
+// ctx, if any.
+func readOnly(ctx context.Context) (context.Context, context.CancelFunc) {
+	if span.Txn(ctx) != nil {
+		return ctx, func() {}
+	}
+	return span.ReadOnlyTransaction(ctx)
+}
+
+// ReadOnlyTransaction implements Store.
+func (Spanner) ReadOnlyTransaction(ctx context.Context, maxStaleness time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := span.ReadOnlyTransaction(ctx)
+	if maxStaleness > 0 {
+		span.RO(ctx).WithTimestampBound(spanner.MaxStaleness(maxStaleness))
+	}
+	return ctx, cancel
+}
+
+// ReadWriteTransaction implements Store.
+func (Spanner) ReadWriteTransaction(ctx context.Context, f func(ctx context.Context) error) error {
+	_, err := span.ReadWriteTransaction(ctx, f)
+	return err
+}
+
+// ReadInvocation implements Invocations.
+func (Spanner) ReadInvocation(ctx context.Context, id invocations.ID) (*pb.Invocation, error) {
+	return invocations.Read(single(ctx), id)
+}
+
+// ReadInvocations implements Invocations.
+func (Spanner) ReadInvocations(ctx context.Context, ids invocations.IDSet) (map[invocations.ID]*pb.Invocation, error) {
+	return invocations.ReadBatch(single(ctx), ids)
+}
+
+// ReadInvocationState implements Invocations.
+func (Spanner) ReadInvocationState(ctx context.Context, id invocations.ID) (pb.Invocation_State, error) {
+	return invocations.ReadState(single(ctx), id)
+}
+
+// ReadInvocationStates implements Invocations.
+func (Spanner) ReadInvocationStates(ctx context.Context, ids invocations.IDSet) (map[invocations.ID]pb.Invocation_State, error) {
+	return invocations.ReadStateBatch(single(ctx), ids)
+}
+
+// ReadRealm implements Invocations.
+func (Spanner) ReadRealm(ctx context.Context, id invocations.ID) (string, error) {
+	return invocations.ReadRealm(single(ctx), id)
+}
+
+// ReadRealms implements Invocations.
+func (Spanner) ReadRealms(ctx context.Context, ids invocations.IDSet) (map[invocations.ID]string, error) {
+	return invocations.ReadRealms(single(ctx), ids)
+}
+
+// ReadCreateRequests implements Invocations.
+func (Spanner) ReadCreateRequests(ctx context.Context, ids invocations.IDSet) (map[invocations.ID]CreateRequest, error) {
+	ret := make(map[invocations.ID]CreateRequest, len(ids))
+	columns := []string{"InvocationId", "CreateRequestId", "CreatedBy"}
+	err := span.Read(single(ctx), "Invocations", ids.Keys(), columns).Do(func(r *spanner.Row) error {
+		var invID invocations.ID
+		var requestID spanner.NullString
+		var createdBy spanner.NullString
+		if err := spanutil.FromSpanner(r, &invID, &requestID, &createdBy); err != nil {
+			return err
+		}
+		ret[invID] = CreateRequest{RequestID: requestID.StringVal, CreatedBy: createdBy.StringVal}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ReadTestResultCount implements Invocations.
+func (Spanner) ReadTestResultCount(ctx context.Context, ids invocations.IDSet) (int64, error) {
+	return invocations.ReadTestResultCount(single(ctx), ids)
+}
+
+// Reachable implements Invocations.
+func (Spanner) Reachable(ctx context.Context, roots invocations.IDSet) (invocations.IDSet, error) {
+	ctx, cancel := readOnly(ctx)
+	defer cancel()
+	return invocations.Reachable(ctx, roots)
+}
+
+// InsertInvocation implements Invocations.
+func (Spanner) InsertInvocation(ctx context.Context, inv *pb.Invocation, createRequestID string, expectedResultsExpiration time.Duration) {
+	row := rowOfInvocation(ctx, inv, createRequestID, expectedResultsExpiration)
+	span.BufferWrite(ctx, spanutil.InsertMap("Invocations", row))
+}
+
+// rowOfInvocation returns Invocation row values to be inserted to create the
+// invocation.
+// inv.CreateTime is ignored in favor of spanner.CommitTime.
+func rowOfInvocation(ctx context.Context, inv *pb.Invocation, createRequestID string, expectedResultsExpiration time.Duration) map[string]interface{} {
+	now := clock.Now(ctx).UTC()
+	row := map[string]interface{}{
+		"InvocationId": invocations.MustParseName(inv.Name),
+		"ShardId":      mathrand.Intn(ctx, invocations.Shards),
+		"State":        inv.State,
+		"Realm":        inv.Realm,
+		"CreatedBy":    inv.CreatedBy,
+
+		"InvocationExpirationTime":          now.Add(InvocationExpirationDuration),
+		"ExpectedTestResultsExpirationTime": now.Add(expectedResultsExpiration),
+
+		"CreateTime": spanner.CommitTimestamp,
+		"Deadline":   inv.Deadline,
+
+		"Tags":             inv.Tags,
+		"ProducerResource": inv.ProducerResource,
+		"TestResultCount":  0,
+	}
+
+	if inv.State == pb.Invocation_FINALIZED {
+		// We are ignoring the provided inv.FinalizeTime because it would not
+		// make sense to have an invocation finalized before it was created,
+		// yet attempting to set this in the future would fail the sql schema
+		// restriction for columns that allow commit timestamp.
+		// Note this function is only used for setting FinalizeTime by derive
+		// invocation, which is planned to be superseded by other mechanisms.
+		row["FinalizeTime"] = spanner.CommitTimestamp
+	}
+
+	if createRequestID != "" {
+		row["CreateRequestId"] = createRequestID
+	}
+
+	// Index the invocation for history queries, if requested.
+	if hist := inv.HistoryOptions; hist != nil {
+		if hist.UseInvocationTimestamp {
+			row["HistoryTime"] = spanner.CommitTimestamp
+		}
+		if cp := hist.Commit; cp != nil {
+			row["Ordinal"] = cp.Position
+			row["OrdinalDomain"] = OrdinalDomain(cp)
+		}
+	}
+
+	if len(inv.BigqueryExports) != 0 {
+		bqExports := make([][]byte, len(inv.BigqueryExports))
+		for i, msg := range inv.BigqueryExports {
+			var err error
+			if bqExports[i], err = proto.Marshal(msg); err != nil {
+				panic(fmt.Sprintf("failed to marshal BigQueryExport to bytes: %s", err))
+			}
+		}
+		row["BigQueryExports"] = bqExports
+	}
+
+	return row
+}
+
+// OrdinalDomain returns the domain of the commit position, used to index
+// invocations for history queries.
+func OrdinalDomain(cp *pb.CommitPosition) string {
+	return fmt.Sprintf("gitiles://%s/%s/%s", cp.Host, cp.Project, cp.Ref)
+}
+
+// UpdateInvocationDeadline implements Invocations.
+func (Spanner) UpdateInvocationDeadline(ctx context.Context, id invocations.ID, deadline *tspb.Timestamp) {
+	span.BufferWrite(ctx, spanutil.UpdateMap("Invocations", map[string]interface{}{
+		"InvocationId": id,
+		"Deadline":     deadline,
+	}))
+}
+
+// StartInvocationFinalization implements Invocations.
+func (Spanner) StartInvocationFinalization(ctx context.Context, id invocations.ID) {
+	tasks.StartInvocationFinalization(ctx, id)
+}
+
+// AddInclusions implements Invocations.
+func (Spanner) AddInclusions(ctx context.Context, including invocations.ID, included invocations.IDSet) {
+	ms := make([]*spanner.Mutation, 0, len(included))
+	for id := range included {
+		ms = append(ms, spanutil.InsertOrUpdateMap("IncludedInvocations", map[string]interface{}{
+			"InvocationId":         including,
+			"IncludedInvocationId": id,
+		}))
+	}
+	span.BufferWrite(ctx, ms...)
+}
+
+// RemoveInclusions implements Invocations.
+func (Spanner) RemoveInclusions(ctx context.Context, including invocations.ID, included invocations.IDSet) {
+	// Accumulate keys to remove in a single KeySet.
+	ks := spanner.KeySets()
+	for id := range included {
+		ks = spanner.KeySets(invocations.InclusionKey(including, id), ks)
+	}
+	span.BufferWrite(ctx, spanner.Delete("IncludedInvocations", ks))
+}
+
+// ReadTestResult implements TestResults.
+func (Spanner) ReadTestResult(ctx context.Context, name string) (*pb.TestResult, error) {
+	return testresults.Read(single(ctx), name)
+}
+
+// QueryTestResults implements TestResults.
+func (Spanner) QueryTestResults(ctx context.Context, q *testresults.Query) ([]*pb.TestResult, string, error) {
+	ctx, cancel := readOnly(ctx)
+	defer cancel()
+	return q.Fetch(ctx)
+}
+
+// InsertTestResults implements TestResults.
+func (Spanner) InsertTestResults(ctx context.Context, invID invocations.ID, trs []*pb.TestResult) error {
+	ms := make([]*spanner.Mutation, len(trs))
+	for i, tr := range trs {
+		ms[i] = spanner.InsertOrUpdateMap("TestResults", spanutil.ToSpannerMap(rowOfTestResult(invID, tr)))
+	}
+	span.BufferWrite(ctx, ms...)
+	return invocations.IncrementTestResultCount(ctx, invID, int64(len(trs)))
+}
+
+// rowOfTestResult returns TestResults row values to be inserted to create the
+// test result.
+func rowOfTestResult(invID invocations.ID, tr *pb.TestResult) map[string]interface{} {
+	// handle values for nullable columns
+	var runDuration spanner.NullInt64
+	if tr.Duration != nil {
+		runDuration.Int64 = pbutil.MustDuration(tr.Duration).Microseconds()
+		runDuration.Valid = true
+	}
+
+	row := map[string]interface{}{
+		"InvocationId":    invID,
+		"TestId":          tr.TestId,
+		"ResultId":        tr.ResultId,
+		"Variant":         tr.Variant,
+		"VariantHash":     pbutil.VariantHash(tr.Variant),
+		"CommitTimestamp": spanner.CommitTimestamp,
+		"IsUnexpected":    spanner.NullBool{Bool: true, Valid: !tr.Expected},
+		"Status":          tr.Status,
+		"SummaryHTML":     spanutil.Compressed(tr.SummaryHtml),
+		"StartTime":       tr.StartTime,
+		"RunDurationUsec": runDuration,
+		"Tags":            tr.Tags,
+	}
+	if tr.TestLocation != nil {
+		row["TestLocationFileName"] = tr.TestLocation.FileName
+		// Spanner client does not support int32
+		row["TestLocationLine"] = int(tr.TestLocation.Line)
+	}
+	if md := tr.TestMetadata; md != nil {
+		row["TestMetadataOwners"] = md.Owners
+		row["TestMetadataBugComponent"] = md.BugComponent
+		if md.Location != nil {
+			row["TestMetadataFileName"] = md.Location.FileName
+			row["TestMetadataLine"] = int(md.Location.Line)
+		}
+	}
+	return row
+}
+
+// QueryTestFlakiness implements TestResults.
+func (Spanner) QueryTestFlakiness(ctx context.Context, q *flakiness.StoredQuery) ([]*pb.TestFlakiness, error) {
+	return q.Fetch(single(ctx))
+}
+
+// ReadTestExoneration implements TestExonerations.
+func (Spanner) ReadTestExoneration(ctx context.Context, name string) (*pb.TestExoneration, error) {
+	return exonerations.Read(single(ctx), name)
+}
+
+// QueryTestExonerations implements TestExonerations.
+func (Spanner) QueryTestExonerations(ctx context.Context, q *exonerations.Query) ([]*pb.TestExoneration, string, error) {
+	ctx, cancel := readOnly(ctx)
+	defer cancel()
+	return q.Fetch(ctx)
+}
+
+// InsertTestExonerations implements TestExonerations.
+func (Spanner) InsertTestExonerations(ctx context.Context, invID invocations.ID, tes []*pb.TestExoneration, replace bool) {
+	mutFn := spanner.InsertMap
+	if replace {
+		mutFn = spanner.InsertOrUpdateMap
+	}
+	ms := make([]*spanner.Mutation, len(tes))
+	for i, te := range tes {
+		ms[i] = mutFn("TestExonerations", spanutil.ToSpannerMap(map[string]interface{}{
+			"InvocationId":    invID,
+			"TestId":          te.TestId,
+			"ExonerationId":   te.ExonerationId,
+			"Variant":         te.Variant,
+			"VariantHash":     pbutil.VariantHash(te.Variant),
+			"ExplanationHTML": spanutil.Compressed(te.ExplanationHtml),
+		}))
+	}
+	span.BufferWrite(ctx, ms...)
+}
+
+// ReadArtifact implements Artifacts.
+func (Spanner) ReadArtifact(ctx context.Context, name string) (*pb.Artifact, error) {
+	return artifacts.Read(single(ctx), name)
+}
+
+// ReadArtifactContent implements Artifacts.
+func (Spanner) ReadArtifactContent(ctx context.Context, name string) (*ArtifactContent, error) {
+	invID, testID, resultID, artifactID := artifacts.MustParseName(name)
+	key := invID.Key(artifacts.ParentID(testID, resultID), artifactID)
+
+	ret := &ArtifactContent{}
+	err := spanutil.ReadRow(single(ctx), "Artifacts", key, map[string]interface{}{
+		"ContentType": &ret.ContentType,
+		"Size":        &ret.Size,
+		"IsolateURL":  &ret.IsolateURL,
+		"RBECASHash":  &ret.RBECASHash,
+	})
+	switch {
+	case spanner.ErrCode(err) == codes.NotFound:
+		return nil, appstatus.Attachf(err, codes.NotFound, "%s not found", name)
+
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to fetch %q", name).Err()
+
+	default:
+		return ret, nil
+	}
+}
+
+// QueryArtifacts implements Artifacts.
+func (Spanner) QueryArtifacts(ctx context.Context, q *artifacts.Query) ([]*pb.Artifact, string, error) {
+	ctx, cancel := readOnly(ctx)
+	defer cancel()
+	return q.Fetch(ctx)
+}
+
+// InsertArtifact implements Artifacts.
+func (Spanner) InsertArtifact(ctx context.Context, a *pb.Artifact, rbeCASHash string) {
+	invID, testID, resultID, artifactID := artifacts.MustParseName(a.Name)
+	span.BufferWrite(ctx, spanutil.InsertMap("Artifacts", map[string]interface{}{
+		"InvocationId": invID,
+		"ParentId":     artifacts.ParentID(testID, resultID),
+		"ArtifactId":   artifactID,
+		"ContentType":  a.ContentType,
+		"Size":         a.SizeBytes,
+		"RBECASHash":   rbeCASHash,
+	}))
+}
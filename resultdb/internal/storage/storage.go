@@ -0,0 +1,196 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storage defines the storage of invocations, test results, test
+// exonerations and artifacts used by the Recorder and ResultDB services.
+//
+// Spanner implements it on top of Cloud Spanner, and is used in production.
+// Package memstore implements it in memory.
+package storage
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+
+	"go.chromium.org/luci/resultdb/internal/artifacts"
+	"go.chromium.org/luci/resultdb/internal/exonerations"
+	"go.chromium.org/luci/resultdb/internal/flakiness"
+	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/testresults"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// Store stores invocations, test results, test exonerations and artifacts.
+//
+// Methods that write must be called in a transaction started by
+// ReadWriteTransaction; the writes are applied when the transaction commits.
+// Methods that read may be called in a transaction started by either
+// ReadOnlyTransaction or ReadWriteTransaction. Outside of a transaction they
+// read the latest data.
+//
+// Errors of reads that refer to missing entities are annotated with NotFound
+// appstatus.
+type Store interface {
+	Invocations
+	TestResults
+	TestExonerations
+	Artifacts
+
+	// ReadOnlyTransaction starts a read-only transaction.
+	// Returns a context to read in and a function to end the transaction.
+	//
+	// If maxStaleness is positive, the reads may return data that is stale by
+	// at most maxStaleness.
+	ReadOnlyTransaction(ctx context.Context, maxStaleness time.Duration) (context.Context, context.CancelFunc)
+
+	// ReadWriteTransaction calls f in a read-write transaction and commits it
+	// if f succeeds. f may be called multiple times.
+	ReadWriteTransaction(ctx context.Context, f func(ctx context.Context) error) error
+}
+
+// CreateRequest identifies the request that created an invocation.
+type CreateRequest struct {
+	// RequestID is the request id of the creation request, if any.
+	RequestID string
+	// CreatedBy is the identity that created the invocation.
+	CreatedBy string
+}
+
+// Invocations stores invocations and the inclusions between them.
+type Invocations interface {
+	// ReadInvocation reads an invocation.
+	ReadInvocation(ctx context.Context, id invocations.ID) (*pb.Invocation, error)
+
+	// ReadInvocations reads multiple invocations.
+	// Returns an error if any of them does not exist.
+	ReadInvocations(ctx context.Context, ids invocations.IDSet) (map[invocations.ID]*pb.Invocation, error)
+
+	// ReadInvocationState reads the state of an invocation.
+	ReadInvocationState(ctx context.Context, id invocations.ID) (pb.Invocation_State, error)
+
+	// ReadInvocationStates reads the states of multiple invocations.
+	// Missing invocations are omitted from the returned map.
+	ReadInvocationStates(ctx context.Context, ids invocations.IDSet) (map[invocations.ID]pb.Invocation_State, error)
+
+	// ReadRealm reads the realm of an invocation.
+	ReadRealm(ctx context.Context, id invocations.ID) (string, error)
+
+	// ReadRealms reads the realms of multiple invocations.
+	// Returns an error if any of them does not exist.
+	ReadRealms(ctx context.Context, ids invocations.IDSet) (map[invocations.ID]string, error)
+
+	// ReadCreateRequests reads how the invocations were created.
+	// Missing invocations are omitted from the returned map.
+	ReadCreateRequests(ctx context.Context, ids invocations.IDSet) (map[invocations.ID]CreateRequest, error)
+
+	// ReadTestResultCount returns the total number of test results of the
+	// invocations.
+	ReadTestResultCount(ctx context.Context, ids invocations.IDSet) (int64, error)
+
+	// Reachable returns the invocations reachable from the roots, including the
+	// roots.
+	Reachable(ctx context.Context, roots invocations.IDSet) (invocations.IDSet, error)
+
+	// InsertInvocation inserts a new invocation. inv.Name must be set.
+	//
+	// inv.CreateTime is ignored in favor of the commit time, and so is
+	// inv.FinalizeTime of a finalized invocation.
+	// createRequestID is the id of the request that creates the invocation, if
+	// any. Expected test results of the invocation may be deleted after
+	// expectedResultsExpiration since its creation.
+	InsertInvocation(ctx context.Context, inv *pb.Invocation, createRequestID string, expectedResultsExpiration time.Duration)
+
+	// UpdateInvocationDeadline updates the deadline of an invocation.
+	UpdateInvocationDeadline(ctx context.Context, id invocations.ID, deadline *tspb.Timestamp)
+
+	// StartInvocationFinalization changes the state of an invocation to
+	// FINALIZING. The invocation becomes FINALIZED asynchronously, when it does
+	// not include any active invocations.
+	StartInvocationFinalization(ctx context.Context, id invocations.ID)
+
+	// AddInclusions makes the including invocation include the others.
+	AddInclusions(ctx context.Context, including invocations.ID, included invocations.IDSet)
+
+	// RemoveInclusions makes the including invocation not include the others.
+	RemoveInclusions(ctx context.Context, including invocations.ID, included invocations.IDSet)
+}
+
+// TestResults stores test results and the test flakiness computed from them.
+type TestResults interface {
+	// ReadTestResult reads a test result.
+	ReadTestResult(ctx context.Context, name string) (*pb.TestResult, error)
+
+	// QueryTestResults returns a page of test results matching q.
+	// See also testresults.Query.Fetch.
+	QueryTestResults(ctx context.Context, q *testresults.Query) (trs []*pb.TestResult, nextPageToken string, err error)
+
+	// InsertTestResults inserts test results of an invocation and adds them to
+	// the test result count of the invocation. Their names must be set.
+	// Existing test results with the same names are replaced.
+	InsertTestResults(ctx context.Context, invID invocations.ID, trs []*pb.TestResult) error
+
+	// QueryTestFlakiness returns the flakiness of the test variants matching q.
+	// See also flakiness.StoredQuery.Fetch.
+	QueryTestFlakiness(ctx context.Context, q *flakiness.StoredQuery) ([]*pb.TestFlakiness, error)
+}
+
+// TestExonerations stores test exonerations.
+type TestExonerations interface {
+	// ReadTestExoneration reads a test exoneration.
+	ReadTestExoneration(ctx context.Context, name string) (*pb.TestExoneration, error)
+
+	// QueryTestExonerations returns a page of test exonerations matching q.
+	// See also exonerations.Query.Fetch.
+	QueryTestExonerations(ctx context.Context, q *exonerations.Query) (tes []*pb.TestExoneration, nextPageToken string, err error)
+
+	// InsertTestExonerations inserts test exonerations of an invocation.
+	// Their names must be set. If replace is true, existing test exonerations
+	// with the same names are replaced, otherwise the transaction fails with
+	// AlreadyExists.
+	InsertTestExonerations(ctx context.Context, invID invocations.ID, tes []*pb.TestExoneration, replace bool)
+}
+
+// ArtifactContent describes the content of an artifact and where it is
+// stored.
+type ArtifactContent struct {
+	ContentType spanner.NullString
+	Size        spanner.NullInt64
+
+	// RBECASHash is the hash of the content stored in RBE-CAS, in
+	// "sha256:{hash}" format.
+	RBECASHash spanner.NullString
+	// IsolateURL is the URL of the content stored in isolate.
+	IsolateURL spanner.NullString
+}
+
+// Artifacts stores artifacts. Their contents are stored elsewhere, e.g. in
+// RBE-CAS.
+type Artifacts interface {
+	// ReadArtifact reads an artifact, without its content or its location.
+	ReadArtifact(ctx context.Context, name string) (*pb.Artifact, error)
+
+	// ReadArtifactContent reads where the content of the artifact is stored.
+	ReadArtifactContent(ctx context.Context, name string) (*ArtifactContent, error)
+
+	// QueryArtifacts returns a page of artifacts matching q.
+	// See also artifacts.Query.Fetch.
+	QueryArtifacts(ctx context.Context, q *artifacts.Query) (arts []*pb.Artifact, nextPageToken string, err error)
+
+	// InsertArtifact inserts an artifact with the content stored in RBE-CAS.
+	// a.Name must be set.
+	InsertArtifact(ctx context.Context, a *pb.Artifact, rbeCASHash string)
+}
@@ -22,6 +22,7 @@ import (
 
 	"cloud.google.com/go/spanner"
 
+	"go.chromium.org/luci/server/experiments"
 	"go.chromium.org/luci/server/span"
 
 	"go.chromium.org/luci/common/clock"
@@ -49,10 +50,18 @@ const (
 	// TryFinalizeInvocation is a type of task that tries to finalize an
 	// invocation. No payload.
 	TryFinalizeInvocation Type = "finalize"
+
+	// FileExport is a type of task that exports test results of an invocation
+	// to files. No payload.
+	FileExport Type = "file_export"
 )
 
 // AllTypes is a slice of all known types of tasks.
-var AllTypes = []Type{BQExport, TryFinalizeInvocation}
+var AllTypes = []Type{BQExport, TryFinalizeInvocation, FileExport}
+
+// UseFileExport experiment enables enqueuing FileExport tasks when
+// invocations are finalized.
+var UseFileExport = experiments.Register("rdb-file-export")
 
 // Enqueue inserts one row to InvocationTasks.
 func Enqueue(typ Type, taskID string, invID invocations.ID, payload interface{}, processAfter time.Time) *spanner.Mutation {
@@ -72,6 +81,11 @@ func EnqueueBQExport(invID invocations.ID, payload *pb.BigQueryExport, processAf
 	return Enqueue(BQExport, fmt.Sprintf("%s:0", invID.RowID()), invID, payload, processAfter)
 }
 
+// EnqueueFileExport inserts one row to InvocationTasks for a file export task.
+func EnqueueFileExport(invID invocations.ID, processAfter time.Time) *spanner.Mutation {
+	return Enqueue(FileExport, invID.RowID(), invID, nil, processAfter)
+}
+
 // Peek calls f on available tasks of a given type.
 func Peek(ctx context.Context, typ Type, f func(id string) error) error {
 	st := spanner.NewStatement(`
@@ -53,6 +53,7 @@ var defaultListMask = mask.MustFromReadMask(&pb.TestResult{},
 	"start_time",
 	"duration",
 	"test_location",
+	"test_metadata",
 )
 
 // ListMask returns mask.Mask converted from field_mask.FieldMask.
@@ -89,6 +90,7 @@ func (q *Query) run(ctx context.Context, f func(*pb.TestResult) error) (err erro
 	columns, parser := q.selectClause()
 	params := q.baseParams()
 	params["limit"] = q.PageSize
+	populateTestMetadataParams(params, q.Predicate.GetTestMetadata())
 
 	// Filter by expectancy.
 	switch q.Predicate.GetExpectancy() {
@@ -163,12 +165,17 @@ func (q *Query) selectClause() (columns []string, parser func(*spanner.Row) (*pb
 	}
 	selectIfIncluded("SummaryHtml", "summary_html")
 	selectIfIncluded("Tags", "tags")
+	selectIfIncluded("TestMetadataOwners", "test_metadata")
+	selectIfIncluded("TestMetadataFileName", "test_metadata")
+	selectIfIncluded("TestMetadataLine", "test_metadata")
+	selectIfIncluded("TestMetadataBugComponent", "test_metadata")
 
 	// Build a parser function.
 	var b spanutil.Buffer
 	var summaryHTML spanutil.Compressed
 	parser = func(row *spanner.Row) (*pb.TestResult, error) {
 		var invID invocations.ID
+		var md testMetadataColumns
 		var maybeUnexpected spanner.NullBool
 		var micros spanner.NullInt64
 		var testLocationFileName spanner.NullString
@@ -195,6 +202,14 @@ func (q *Query) selectClause() (columns []string, parser func(*spanner.Row) (*pb
 				ptrs = append(ptrs, &summaryHTML)
 			case "Tags":
 				ptrs = append(ptrs, &tr.Tags)
+			case "TestMetadataOwners":
+				ptrs = append(ptrs, &md.owners)
+			case "TestMetadataFileName":
+				ptrs = append(ptrs, &md.fileName)
+			case "TestMetadataLine":
+				ptrs = append(ptrs, &md.line)
+			case "TestMetadataBugComponent":
+				ptrs = append(ptrs, &md.bugComponent)
 			default:
 				panic("impossible")
 			}
@@ -212,6 +227,7 @@ func (q *Query) selectClause() (columns []string, parser func(*spanner.Row) (*pb
 		populateExpectedField(tr, maybeUnexpected)
 		populateDurationField(tr, micros)
 		populateTestLocation(tr, testLocationFileName, testLocationLine)
+		md.populate(tr)
 		if err := q.Mask.Trim(tr); err != nil {
 			return nil, errors.Annotate(err, "error trimming fields for %s", tr.Name).Err()
 		}
@@ -379,6 +395,17 @@ func PopulateVariantParams(params map[string]interface{}, variantPredicate *pb.V
 	}
 }
 
+// populateTestMetadataParams populates metadataOwner and metadataBugComponent
+// parameters based on the predicate.
+func populateTestMetadataParams(params map[string]interface{}, p *pb.TestMetadataPredicate) {
+	if p.GetOwner() != "" {
+		params["metadataOwner"] = p.Owner
+	}
+	if p.GetBugComponent() != "" {
+		params["metadataBugComponent"] = p.BugComponent
+	}
+}
+
 // queryTmpl is a set of templates that generate the SQL statements used
 // by Query type.
 // Two main templates are "testResults" and "variantsWithUnexpectedResults"
@@ -440,6 +467,14 @@ var queryTmpl = template.Must(template.New("").Parse(`
 			*/}}
 			{{template "testIDAndVariantFilter" .}}
 		{{end}}
+
+		{{/* Filter by TestMetadata */}}
+		{{if .params.metadataOwner}}
+			AND @metadataOwner IN UNNEST(TestMetadataOwners)
+		{{end}}
+		{{if .params.metadataBugComponent}}
+			AND TestMetadataBugComponent = @metadataBugComponent
+		{{end}}
 	{{end}}
 
 	{{define "variantsWithUnexpectedResults"}}
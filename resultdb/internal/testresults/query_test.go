@@ -103,6 +103,44 @@ func TestQueryTestResults(t *testing.T) {
 			So(actual, ShouldResembleProto, expected)
 		})
 
+		Convey(`Test metadata`, func() {
+			expected := insert.MakeTestResults("inv1", "DoBaz", nil, pb.TestStatus_PASS)
+			expected[0].TestMetadata = &pb.TestMetadata{
+				Owners: []string{"a@example.com", "b@example.com"},
+				Location: &pb.TestLocation{
+					FileName: "//a_test.go",
+					Line:     54,
+				},
+				BugComponent: "Infra>Test",
+			}
+			testutil.MustApply(ctx, insert.TestResultMessages(expected)...)
+
+			actual, _ := mustFetch(q)
+			So(actual, ShouldResembleProto, expected)
+		})
+
+		Convey(`Test metadata filter`, func() {
+			trs := insert.MakeTestResults("inv1", "T", nil, pb.TestStatus_PASS, pb.TestStatus_FAIL, pb.TestStatus_PASS)
+			trs[0].TestMetadata = &pb.TestMetadata{Owners: []string{"a@example.com"}, BugComponent: "A"}
+			trs[1].TestMetadata = &pb.TestMetadata{Owners: []string{"a@example.com", "b@example.com"}, BugComponent: "B"}
+			testutil.MustApply(ctx, insert.TestResultMessages(trs)...)
+
+			Convey(`Owner`, func() {
+				q.Predicate.TestMetadata = &pb.TestMetadataPredicate{Owner: "a@example.com"}
+				So(mustFetchNames(q), ShouldResemble, []string{
+					"invocations/inv1/tests/T/results/0",
+					"invocations/inv1/tests/T/results/1",
+				})
+			})
+
+			Convey(`Bug component`, func() {
+				q.Predicate.TestMetadata = &pb.TestMetadataPredicate{Owner: "a@example.com", BugComponent: "B"}
+				So(mustFetchNames(q), ShouldResemble, []string{
+					"invocations/inv1/tests/T/results/1",
+				})
+			})
+		})
+
 		Convey(`Expectancy filter`, func() {
 			testutil.MustApply(ctx, insert.Invocation("inv0", pb.Invocation_ACTIVE, nil))
 			q.InvocationIDs = invocations.NewIDSet("inv0", "inv1")
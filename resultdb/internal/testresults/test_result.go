@@ -74,6 +74,7 @@ func Read(ctx context.Context, name string) (*pb.TestResult, error) {
 	var summaryHTML spanutil.Compressed
 	var testLocationFileName spanner.NullString
 	var testLocationLine spanner.NullInt64
+	var md testMetadataColumns
 	err := spanutil.ReadRow(ctx, "TestResults", invID.Key(testID, resultID), map[string]interface{}{
 		"Variant":              &tr.Variant,
 		"VariantHash":          &tr.VariantHash,
@@ -85,6 +86,11 @@ func Read(ctx context.Context, name string) (*pb.TestResult, error) {
 		"Tags":                 &tr.Tags,
 		"TestLocationFileName": &testLocationFileName,
 		"TestLocationLine":     &testLocationLine,
+
+		"TestMetadataOwners":       &md.owners,
+		"TestMetadataFileName":     &md.fileName,
+		"TestMetadataLine":         &md.line,
+		"TestMetadataBugComponent": &md.bugComponent,
 	})
 	switch {
 	case spanner.ErrCode(err) == codes.NotFound:
@@ -98,6 +104,7 @@ func Read(ctx context.Context, name string) (*pb.TestResult, error) {
 	populateExpectedField(tr, maybeUnexpected)
 	populateDurationField(tr, micros)
 	populateTestLocation(tr, testLocationFileName, testLocationLine)
+	md.populate(tr)
 	return tr, nil
 }
 
@@ -120,3 +127,30 @@ func populateTestLocation(tr *pb.TestResult, fileName spanner.NullString, line s
 		}
 	}
 }
+
+// testMetadataColumns holds values of TestMetadata* columns of a TestResults
+// row.
+type testMetadataColumns struct {
+	owners       []string
+	fileName     spanner.NullString
+	line         spanner.NullInt64
+	bugComponent string
+}
+
+// populate sets tr.TestMetadata if any of the columns has a value.
+func (c *testMetadataColumns) populate(tr *pb.TestResult) {
+	if len(c.owners) == 0 && !c.fileName.Valid && c.bugComponent == "" {
+		return
+	}
+
+	tr.TestMetadata = &pb.TestMetadata{
+		Owners:       c.owners,
+		BugComponent: c.bugComponent,
+	}
+	if c.fileName.Valid {
+		tr.TestMetadata.Location = &pb.TestLocation{
+			FileName: c.fileName.StringVal,
+			Line:     int32(c.line.Int64),
+		}
+	}
+}
@@ -109,6 +109,14 @@ func TestResultMessages(trs []*pb.TestResult) []*spanner.Mutation {
 			mutMap["TestLocationFileName"] = tr.TestLocation.FileName
 			mutMap["TestLocationLine"] = int(tr.TestLocation.Line)
 		}
+		if md := tr.TestMetadata; md != nil {
+			mutMap["TestMetadataOwners"] = md.Owners
+			mutMap["TestMetadataBugComponent"] = md.BugComponent
+			if md.Location != nil {
+				mutMap["TestMetadataFileName"] = md.Location.FileName
+				mutMap["TestMetadataLine"] = int(md.Location.Line)
+			}
+		}
 
 		ms[i] = spanutil.InsertMap("TestResults", mutMap)
 	}
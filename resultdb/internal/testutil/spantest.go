@@ -180,6 +180,7 @@ func spannerTestMain(m *testing.M) (exitCode int, err error) {
 func cleanupDatabase(ctx context.Context, client *spanner.Client) error {
 	_, err := client.Apply(ctx, []*spanner.Mutation{
 		spanner.Delete("InvocationTasks", spanner.AllKeys()),
+		spanner.Delete("TestFlakiness", spanner.AllKeys()),
 		// All other tables are interleaved in Invocations table.
 		spanner.Delete("Invocations", spanner.AllKeys()),
 	})
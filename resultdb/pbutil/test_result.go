@@ -31,8 +31,11 @@ import (
 )
 
 const (
-	resultIDPattern   = `[a-z0-9\-_.]{1,32}`
-	maxLenSummaryHTML = 4 * 1024
+	resultIDPattern       = `[a-z0-9\-_.]{1,32}`
+	maxLenSummaryHTML     = 4 * 1024
+	maxTestOwners         = 16
+	maxTestOwnerLength    = 256
+	maxBugComponentLength = 256
 )
 
 var (
@@ -123,6 +126,7 @@ func ValidateTestResult(now time.Time, msg *pb.TestResult) (err error) {
 	case ec.isErr(ValidateStartTimeWithDuration(now, msg.StartTime, msg.Duration), ""):
 	case ec.isErr(ValidateStringPairs(msg.Tags), "tags"):
 	case msg.TestLocation != nil && ec.isErr(ValidateTestLocation(msg.TestLocation), "test_location"):
+	case msg.TestMetadata != nil && ec.isErr(ValidateTestMetadata(msg.TestMetadata), "test_metadata"):
 	}
 	return err
 }
@@ -141,6 +145,32 @@ func ValidateTestLocation(loc *pb.TestLocation) error {
 	return nil
 }
 
+// ValidateTestMetadata returns a non-nil error if md is invalid.
+func ValidateTestMetadata(md *pb.TestMetadata) error {
+	if len(md.Owners) > maxTestOwners {
+		return errors.Reason("owners: more than %d owners", maxTestOwners).Err()
+	}
+	for i, o := range md.Owners {
+		switch {
+		case o == "":
+			return errors.Reason("owners[%d]: unspecified", i).Err()
+		case len(o) > maxTestOwnerLength:
+			return errors.Reason("owners[%d]: length exceeds %d", i, maxTestOwnerLength).Err()
+		}
+	}
+
+	if md.Location != nil {
+		if err := ValidateTestLocation(md.Location); err != nil {
+			return errors.Annotate(err, "location").Err()
+		}
+	}
+
+	if len(md.BugComponent) > maxBugComponentLength {
+		return errors.Reason("bug_component: length exceeds %d", maxBugComponentLength).Err()
+	}
+	return nil
+}
+
 // ParseTestResultName extracts the invocation ID, unescaped test id, and
 // result ID.
 func ParseTestResultName(name string) (invID, testID, resultID string, err error) {
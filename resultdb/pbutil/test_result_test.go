@@ -48,6 +48,10 @@ func validTestResult(now time.Time) *pb.TestResult {
 			FileName: "//a_test.go",
 			Line:     54,
 		},
+		TestMetadata: &pb.TestMetadata{
+			Owners:       []string{"someone@example.com"},
+			BugComponent: "Infra>Test",
+		},
 		Tags: StringPairs("k1", "v1"),
 	}
 }
@@ -250,5 +254,24 @@ func TestValidateTestResult(t *testing.T) {
 				So(validate(msg), ShouldErrLike, "test_location: line: must not be negative")
 			})
 		})
+
+		Convey("Test metadata", func() {
+			Convey("empty owner", func() {
+				msg.TestMetadata.Owners = append(msg.TestMetadata.Owners, "")
+				So(validate(msg), ShouldErrLike, "test_metadata: owners[1]: unspecified")
+			})
+			Convey("too many owners", func() {
+				msg.TestMetadata.Owners = make([]string, 17)
+				So(validate(msg), ShouldErrLike, "test_metadata: owners: more than 16 owners")
+			})
+			Convey("invalid location", func() {
+				msg.TestMetadata.Location = &pb.TestLocation{Line: 1}
+				So(validate(msg), ShouldErrLike, "test_metadata: location: file_name: unspecified")
+			})
+			Convey("bug component too long", func() {
+				msg.TestMetadata.BugComponent = strings.Repeat("a", 257)
+				So(validate(msg), ShouldErrLike, "test_metadata: bug_component: length exceeds 256")
+			})
+		})
 	})
 }
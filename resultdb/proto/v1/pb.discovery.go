@@ -12,3432 +12,1982 @@ func init() {
 			"luci.resultdb.v1.Deriver", "luci.resultdb.v1.Recorder", "luci.resultdb.v1.ResultDB",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 253, 123, 120, 28, 199,
-			117, 32, 138, 163, 31, 51, 24, 20, 94, 131, 38, 37, 130, 67,
-			74, 44, 14, 73, 1, 32, 7, 3, 16, 164, 40, 17, 212, 99,
-			65, 96, 72, 13, 5, 2, 200, 0, 176, 44, 41, 50, 212, 152,
-			169, 25, 180, 216, 211, 61, 238, 238, 1, 56, 146, 149, 56, 113,
-			18, 219, 113, 226, 141, 226, 71, 172, 252, 108, 175, 243, 240, 79,
-			137, 243, 112, 30, 206, 205, 221, 248, 238, 38, 217, 196, 251, 93,
-			103, 147, 220, 205, 110, 214, 119, 115, 237, 236, 230, 115, 172, 220,
-			100, 157, 245, 38, 246, 126, 142, 227, 141, 115, 239, 119, 78, 61,
-			186, 7, 0, 37, 218, 177, 178, 127, 92, 227, 179, 204, 57, 213,
-			245, 60, 117, 234, 212, 57, 167, 78, 157, 34, 255, 126, 138, 28,
-			107, 248, 126, 195, 101, 83, 173, 192, 143, 252, 205, 118, 125, 42,
-			114, 154, 44, 140, 236, 102, 171, 136, 73, 214, 48, 207, 80, 148,
-			25, 242, 151, 72, 223, 154, 204, 99, 141, 146, 222, 144, 85, 125,
-			175, 22, 142, 106, 84, 27, 55, 42, 18, 180, 14, 146, 148, 103,
-			123, 126, 56, 170, 83, 109, 60, 85, 225, 192, 229, 239, 32, 7,
-			170, 126, 179, 184, 171, 206, 203, 67, 170, 198, 21, 72, 90, 209,
-			158, 56, 211, 112, 162, 173, 246, 102, 177, 234, 55, 167, 26, 190,
-			107, 123, 141, 184, 139, 173, 168, 211, 98, 97, 220, 211, 175, 104,
-			218, 143, 235, 198, 213, 149, 203, 31, 209, 239, 190, 202, 107, 94,
-			17, 121, 139, 143, 49, 215, 125, 212, 243, 119, 188, 53, 40, 115,
-			237, 143, 38, 73, 175, 149, 186, 187, 231, 237, 154, 70, 126, 119,
-			128, 104, 3, 150, 113, 119, 143, 53, 243, 155, 3, 20, 75, 84,
-			125, 151, 94, 110, 215, 235, 44, 8, 233, 36, 229, 117, 141, 133,
-			180, 102, 71, 54, 117, 188, 136, 5, 213, 45, 219, 107, 48, 90,
-			247, 131, 166, 29, 17, 58, 239, 183, 58, 129, 211, 216, 138, 232,
-			204, 244, 244, 253, 162, 0, 45, 123, 213, 34, 165, 115, 174, 75,
-			241, 91, 72, 3, 22, 178, 96, 155, 213, 138, 132, 110, 69, 81,
-			43, 156, 157, 154, 170, 177, 109, 230, 250, 45, 22, 132, 18, 25,
-			48, 210, 150, 232, 196, 228, 38, 239, 196, 20, 33, 180, 194, 106,
-			78, 24, 5, 206, 102, 59, 114, 124, 143, 218, 94, 141, 182, 67,
-			70, 29, 143, 134, 126, 59, 168, 50, 76, 217, 116, 60, 59, 232,
-			96, 191, 194, 2, 221, 113, 162, 45, 234, 7, 248, 175, 223, 142,
-			8, 109, 250, 53, 167, 238, 84, 109, 168, 161, 64, 237, 128, 209,
-			22, 11, 154, 78, 20, 177, 26, 109, 5, 254, 182, 83, 99, 53,
-			26, 109, 217, 17, 141, 182, 96, 116, 174, 235, 239, 56, 94, 131,
-			194, 84, 58, 80, 40, 132, 66, 132, 54, 89, 52, 75, 8, 133,
-			191, 211, 187, 58, 22, 82, 191, 46, 123, 84, 245, 107, 140, 54,
-			219, 97, 68, 3, 22, 217, 142, 135, 181, 218, 155, 254, 54, 124,
-			18, 24, 35, 212, 243, 35, 167, 202, 10, 52, 218, 114, 66, 234,
-			58, 97, 4, 53, 36, 91, 244, 106, 187, 186, 83, 115, 194, 170,
-			107, 59, 77, 22, 20, 111, 213, 9, 199, 75, 226, 66, 118, 162,
-			21, 248, 181, 118, 149, 197, 253, 32, 113, 71, 254, 65, 253, 32,
-			84, 140, 174, 230, 87, 219, 77, 230, 69, 182, 156, 164, 41, 63,
-			160, 126, 180, 197, 2, 218, 180, 35, 22, 56, 182, 27, 198, 168,
-			198, 9, 138, 182, 24, 161, 201, 222, 171, 65, 45, 49, 7, 75,
-			66, 197, 158, 221, 100, 208, 161, 36, 109, 121, 126, 252, 13, 241,
-			238, 68, 33, 140, 200, 227, 85, 249, 65, 72, 155, 118, 135, 110,
-			50, 160, 148, 26, 141, 124, 202, 188, 154, 31, 132, 12, 136, 162,
-			21, 248, 77, 63, 98, 148, 227, 36, 10, 105, 141, 5, 206, 54,
-			171, 209, 122, 224, 55, 9, 199, 66, 232, 215, 163, 29, 32, 19,
-			65, 65, 52, 108, 177, 42, 80, 16, 109, 5, 14, 16, 86, 0,
-			180, 227, 113, 42, 10, 67, 236, 59, 161, 107, 143, 148, 87, 233,
-			234, 242, 149, 181, 199, 230, 42, 37, 90, 94, 165, 43, 149, 229,
-			215, 149, 23, 74, 11, 244, 242, 227, 116, 237, 145, 18, 157, 95,
-			94, 121, 188, 82, 190, 250, 200, 26, 125, 100, 121, 113, 161, 84,
-			89, 165, 115, 75, 11, 116, 126, 121, 105, 173, 82, 190, 188, 190,
-			182, 92, 89, 37, 52, 63, 183, 74, 203, 171, 121, 252, 50, 183,
-			244, 56, 45, 189, 126, 165, 82, 90, 93, 165, 203, 21, 90, 190,
-			190, 178, 88, 46, 45, 208, 199, 230, 42, 149, 185, 165, 181, 114,
-			105, 181, 64, 203, 75, 243, 139, 235, 11, 229, 165, 171, 5, 122,
-			121, 125, 141, 46, 45, 175, 17, 186, 88, 190, 94, 94, 43, 45,
-			208, 181, 229, 2, 54, 187, 183, 28, 93, 190, 66, 175, 151, 42,
-			243, 143, 204, 45, 173, 205, 93, 46, 47, 150, 215, 30, 199, 6,
-			175, 148, 215, 150, 160, 177, 43, 203, 21, 66, 231, 232, 202, 92,
-			101, 173, 60, 191, 190, 56, 87, 161, 43, 235, 149, 149, 229, 213,
-			18, 133, 145, 45, 148, 87, 231, 23, 231, 202, 215, 75, 11, 69,
-			90, 94, 162, 75, 203, 180, 244, 186, 210, 210, 26, 93, 125, 100,
-			110, 113, 177, 123, 160, 132, 46, 63, 182, 84, 170, 64, 239, 147,
-			195, 164, 151, 75, 116, 177, 60, 119, 121, 177, 4, 77, 225, 56,
-			23, 202, 149, 210, 252, 26, 12, 40, 254, 53, 95, 94, 40, 45,
-			173, 205, 45, 22, 8, 93, 93, 41, 205, 151, 231, 22, 11, 180,
-			244, 250, 210, 245, 149, 197, 185, 202, 227, 5, 81, 233, 106, 233,
-			219, 214, 75, 75, 107, 229, 185, 69, 186, 48, 119, 125, 238, 106,
-			105, 149, 142, 191, 26, 86, 86, 42, 203, 243, 235, 149, 210, 117,
-			232, 245, 242, 21, 186, 186, 126, 121, 117, 173, 188, 182, 190, 86,
-			162, 87, 151, 151, 23, 16, 217, 171, 165, 202, 235, 202, 243, 165,
-			213, 75, 116, 113, 121, 21, 17, 182, 190, 90, 42, 16, 186, 48,
-			183, 54, 135, 77, 175, 84, 150, 175, 148, 215, 86, 47, 193, 239,
-			203, 235, 171, 101, 68, 92, 121, 105, 173, 84, 169, 172, 175, 172,
-			149, 151, 151, 38, 232, 35, 203, 143, 149, 94, 87, 170, 208, 249,
-			185, 245, 213, 210, 2, 98, 120, 121, 9, 70, 11, 180, 82, 90,
-			174, 60, 14, 213, 2, 30, 112, 6, 10, 244, 177, 71, 74, 107,
-			143, 148, 42, 128, 84, 196, 214, 28, 160, 97, 117, 173, 82, 158,
-			95, 75, 102, 91, 174, 208, 181, 229, 202, 26, 73, 140, 147, 46,
-			149, 174, 46, 150, 175, 150, 150, 230, 75, 240, 121, 25, 170, 121,
-			172, 188, 90, 154, 160, 115, 149, 242, 42, 100, 40, 99, 195, 244,
-			177, 185, 199, 233, 242, 58, 142, 26, 38, 106, 125, 181, 68, 248,
-			239, 4, 233, 22, 112, 62, 105, 249, 10, 157, 91, 120, 93, 25,
-			122, 46, 114, 175, 44, 175, 174, 150, 5, 185, 32, 218, 230, 31,
-			17, 56, 47, 18, 146, 33, 154, 110, 25, 180, 103, 20, 126, 101,
-			44, 35, 223, 115, 137, 244, 17, 61, 115, 138, 255, 228, 137, 39,
-			122, 142, 97, 226, 49, 254, 147, 39, 158, 236, 185, 140, 137, 253,
-			252, 39, 79, 60, 213, 83, 192, 68, 141, 255, 228, 137, 247, 244,
-			76, 97, 162, 248, 201, 19, 199, 122, 242, 152, 72, 248, 79, 158,
-			56, 222, 115, 28, 19, 79, 242, 159, 95, 58, 66, 116, 179, 199,
-			74, 125, 7, 236, 124, 185, 151, 143, 208, 57, 170, 182, 92, 228,
-			143, 44, 100, 94, 20, 82, 155, 182, 124, 199, 139, 144, 171, 57,
-			77, 216, 101, 106, 172, 197, 188, 26, 243, 144, 43, 218, 94, 135,
-			167, 63, 235, 123, 200, 76, 92, 191, 106, 187, 132, 86, 109, 151,
-			121, 53, 59, 40, 80, 230, 1, 243, 175, 81, 27, 234, 170, 250,
-			109, 94, 78, 8, 5, 200, 74, 235, 129, 93, 141, 55, 12, 249,
-			1, 246, 3, 144, 16, 16, 134, 13, 211, 119, 57, 79, 164, 107,
-			91, 76, 84, 228, 192, 78, 234, 218, 145, 179, 205, 128, 167, 217,
-			30, 101, 45, 191, 186, 69, 237, 136, 174, 175, 205, 211, 166, 83,
-			243, 144, 161, 251, 30, 161, 215, 108, 175, 13, 187, 192, 217, 2,
-			61, 123, 241, 190, 233, 130, 228, 211, 173, 192, 119, 89, 43, 114,
-			170, 244, 106, 192, 26, 126, 224, 216, 158, 234, 61, 221, 217, 114,
-			170, 91, 148, 221, 140, 24, 244, 9, 249, 243, 62, 185, 54, 237,
-			234, 141, 29, 59, 128, 28, 62, 237, 48, 59, 160, 190, 199, 128,
-			255, 193, 142, 223, 116, 188, 118, 196, 112, 187, 164, 23, 166, 213,
-			248, 92, 223, 107, 20, 233, 34, 179, 91, 241, 144, 3, 70, 243,
-			97, 147, 217, 1, 171, 229, 105, 232, 243, 253, 215, 243, 169, 203,
-			236, 22, 17, 217, 104, 100, 111, 186, 12, 70, 238, 49, 6, 120,
-			173, 251, 1, 151, 68, 90, 176, 181, 242, 253, 188, 29, 194, 166,
-			100, 211, 39, 103, 206, 79, 110, 249, 237, 128, 186, 142, 199, 236,
-			128, 80, 172, 253, 169, 241, 87, 150, 57, 96, 62, 167, 48, 231,
-			4, 50, 241, 45, 70, 3, 20, 114, 156, 16, 183, 4, 58, 61,
-			61, 125, 118, 18, 255, 183, 54, 61, 61, 139, 255, 123, 2, 134,
-			126, 241, 226, 197, 139, 147, 103, 103, 38, 207, 157, 93, 155, 57,
-			55, 123, 239, 197, 217, 123, 47, 22, 47, 202, 191, 39, 138, 244,
-			114, 135, 192, 68, 70, 129, 83, 141, 160, 131, 145, 24, 34, 214,
-			94, 160, 59, 140, 50, 47, 108, 7, 140, 167, 238, 48, 90, 5,
-			44, 251, 222, 54, 11, 34, 62, 191, 124, 79, 162, 79, 86, 174,
-			204, 19, 122, 238, 220, 185, 139, 241, 88, 118, 118, 118, 138, 14,
-			139, 234, 69, 63, 104, 76, 5, 245, 42, 252, 7, 57, 138, 209,
-			205, 104, 2, 4, 54, 70, 161, 101, 175, 17, 194, 160, 78, 208,
-			210, 77, 187, 217, 114, 89, 72, 136, 252, 73, 207, 206, 210, 121,
-			191, 217, 106, 71, 44, 177, 22, 176, 193, 149, 229, 213, 242, 235,
-			233, 211, 128, 153, 241, 137, 167, 139, 66, 226, 137, 51, 41, 201,
-			243, 18, 255, 18, 203, 204, 33, 139, 54, 196, 4, 143, 99, 241,
-			165, 245, 197, 197, 137, 137, 125, 243, 33, 189, 143, 79, 79, 92,
-			74, 244, 105, 230, 213, 250, 212, 96, 17, 212, 226, 215, 107, 118,
-			39, 209, 183, 48, 10, 218, 213, 8, 27, 216, 182, 93, 26, 109,
-			139, 22, 187, 178, 223, 19, 109, 23, 40, 118, 232, 210, 55, 58,
-			164, 237, 98, 180, 13, 208, 43, 141, 136, 103, 106, 135, 172, 74,
-			79, 211, 179, 211, 211, 221, 35, 60, 119, 203, 17, 62, 230, 120,
-			231, 102, 232, 211, 87, 89, 180, 218, 9, 35, 214, 132, 207, 115,
-			225, 21, 199, 101, 107, 221, 19, 113, 165, 188, 88, 90, 43, 95,
-			47, 209, 122, 36, 186, 113, 171, 50, 247, 212, 35, 217, 211, 245,
-			242, 210, 218, 133, 243, 52, 114, 170, 55, 66, 250, 32, 29, 31,
-			31, 231, 41, 19, 245, 168, 88, 219, 121, 196, 105, 108, 45, 216,
-			17, 150, 154, 160, 15, 60, 64, 207, 205, 76, 208, 55, 81, 252,
-			182, 232, 239, 200, 79, 18, 111, 83, 83, 116, 14, 250, 91, 243,
-			119, 66, 172, 18, 22, 203, 217, 233, 233, 4, 15, 11, 139, 42,
-			3, 231, 82, 103, 47, 236, 93, 70, 170, 54, 40, 126, 246, 194,
-			249, 243, 231, 239, 59, 119, 97, 58, 102, 27, 155, 172, 238, 7,
-			140, 174, 123, 206, 77, 89, 203, 197, 251, 166, 119, 215, 82, 252,
-			198, 38, 115, 156, 143, 159, 142, 143, 115, 164, 76, 225, 100, 193,
-			223, 4, 157, 76, 118, 231, 85, 40, 24, 234, 1, 116, 201, 122,
-			78, 37, 234, 65, 2, 152, 232, 34, 128, 243, 183, 36, 128, 107,
-			246, 182, 77, 159, 230, 19, 89, 172, 182, 131, 128, 121, 17, 100,
-			185, 238, 184, 174, 19, 38, 8, 0, 184, 41, 109, 98, 42, 125,
-			144, 222, 186, 192, 43, 144, 57, 125, 48, 78, 45, 122, 108, 231,
-			114, 219, 113, 107, 44, 24, 159, 128, 129, 173, 10, 12, 137, 38,
-			56, 98, 38, 120, 93, 240, 7, 121, 150, 248, 216, 29, 47, 130,
-			145, 139, 156, 124, 232, 98, 216, 136, 129, 137, 226, 38, 212, 140,
-			125, 137, 113, 112, 239, 45, 113, 32, 70, 33, 119, 95, 186, 210,
-			137, 182, 184, 116, 221, 133, 254, 100, 247, 199, 39, 118, 207, 205,
-			85, 22, 205, 199, 216, 24, 159, 64, 14, 120, 109, 117, 121, 137,
-			94, 183, 91, 45, 199, 107, 16, 66, 203, 30, 79, 225, 170, 108,
-			1, 55, 199, 4, 158, 58, 45, 220, 0, 186, 182, 115, 206, 80,
-			197, 78, 74, 144, 45, 127, 93, 92, 153, 55, 5, 59, 186, 13,
-			155, 121, 129, 87, 195, 83, 161, 177, 252, 115, 176, 155, 62, 63,
-			249, 92, 211, 247, 162, 173, 231, 39, 159, 171, 217, 157, 231, 215,
-			158, 131, 45, 237, 249, 217, 231, 154, 142, 247, 252, 236, 115, 33,
-			171, 62, 255, 100, 241, 57, 16, 34, 128, 144, 159, 127, 234, 137,
-			60, 161, 59, 91, 44, 96, 148, 151, 134, 138, 108, 119, 199, 238,
-			132, 148, 221, 4, 185, 6, 52, 32, 190, 67, 214, 97, 111, 172,
-			57, 13, 39, 10, 97, 171, 119, 25, 21, 45, 21, 40, 54, 85,
-			32, 148, 55, 86, 160, 216, 90, 1, 183, 32, 108, 18, 119, 235,
-			103, 89, 224, 79, 182, 236, 90, 141, 235, 84, 209, 142, 47, 107,
-			99, 118, 117, 139, 75, 42, 82, 186, 1, 169, 72, 44, 180, 130,
-			144, 43, 96, 123, 107, 248, 180, 221, 194, 205, 83, 22, 29, 119,
-			138, 172, 40, 18, 207, 238, 47, 3, 77, 20, 8, 182, 239, 183,
-			120, 205, 188, 165, 252, 19, 121, 26, 182, 235, 117, 231, 38, 72,
-			105, 160, 220, 51, 148, 89, 144, 14, 80, 62, 27, 207, 175, 175,
-			205, 231, 39, 46, 117, 165, 18, 46, 70, 189, 177, 237, 4, 172,
-			86, 164, 115, 20, 109, 14, 231, 56, 49, 132, 168, 168, 58, 207,
-			178, 128, 134, 91, 126, 219, 173, 73, 84, 182, 67, 134, 50, 214,
-			184, 29, 170, 214, 106, 116, 179, 67, 160, 27, 19, 48, 1, 30,
-			168, 134, 30, 223, 232, 247, 146, 18, 32, 210, 238, 106, 170, 101,
-			7, 97, 220, 204, 38, 35, 20, 37, 29, 216, 247, 171, 85, 214,
-			138, 232, 166, 31, 109, 97, 155, 80, 150, 107, 210, 114, 12, 225,
-			158, 126, 128, 48, 232, 215, 235, 33, 139, 80, 136, 185, 226, 7,
-			148, 241, 181, 86, 160, 249, 153, 233, 179, 247, 1, 207, 60, 123,
-			239, 218, 244, 217, 217, 115, 211, 179, 103, 239, 45, 78, 159, 125,
-			34, 47, 168, 59, 164, 8, 43, 166, 219, 178, 195, 136, 80, 204,
-			137, 237, 251, 94, 44, 77, 222, 91, 160, 80, 91, 81, 44, 32,
-			123, 219, 94, 173, 6, 78, 43, 42, 128, 12, 216, 37, 192, 216,
-			20, 54, 13, 234, 111, 62, 195, 170, 17, 151, 125, 64, 160, 226,
-			196, 206, 233, 17, 201, 63, 140, 108, 144, 42, 107, 132, 62, 25,
-			249, 229, 213, 229, 85, 92, 100, 227, 19, 251, 136, 109, 197, 166,
-			255, 172, 227, 186, 54, 174, 46, 230, 77, 174, 175, 78, 213, 252,
-			106, 56, 245, 24, 219, 156, 138, 187, 50, 85, 97, 117, 22, 48,
-			175, 202, 166, 174, 186, 254, 166, 237, 110, 44, 99, 31, 194, 41,
-			232, 208, 84, 162, 145, 9, 52, 232, 108, 249, 181, 34, 12, 134,
-			115, 154, 2, 174, 115, 222, 37, 250, 52, 200, 81, 128, 244, 162,
-			252, 241, 180, 28, 16, 12, 117, 147, 201, 209, 178, 26, 217, 119,
-			136, 132, 62, 249, 116, 24, 5, 117, 44, 154, 24, 145, 95, 13,
-			139, 45, 206, 217, 96, 44, 51, 83, 174, 179, 25, 216, 65, 7,
-			133, 209, 226, 86, 212, 116, 79, 224, 47, 89, 118, 2, 13, 17,
-			68, 17, 178, 108, 36, 108, 177, 42, 29, 59, 245, 248, 228, 169,
-			230, 228, 169, 218, 218, 169, 71, 102, 79, 93, 159, 61, 181, 90,
-			60, 85, 127, 98, 172, 72, 23, 157, 27, 108, 199, 9, 25, 10,
-			255, 128, 160, 120, 150, 218, 33, 227, 181, 93, 243, 107, 54, 18,
-			235, 88, 72, 159, 124, 186, 188, 186, 44, 183, 250, 43, 156, 89,
-			213, 4, 56, 62, 241, 244, 83, 227, 220, 124, 39, 248, 220, 51,
-			126, 141, 207, 4, 252, 152, 68, 41, 218, 110, 57, 56, 33, 50,
-			149, 203, 214, 188, 175, 83, 123, 235, 198, 113, 202, 6, 78, 205,
-			44, 156, 154, 89, 32, 116, 2, 16, 233, 111, 162, 217, 204, 22,
-			227, 140, 88, 64, 171, 118, 11, 23, 136, 95, 167, 13, 230, 177,
-			192, 230, 75, 77, 46, 179, 144, 179, 101, 133, 255, 34, 193, 63,
-			195, 236, 209, 44, 227, 59, 50, 35, 228, 3, 26, 49, 205, 30,
-			189, 199, 50, 191, 91, 211, 15, 230, 222, 169, 209, 74, 172, 246,
-			73, 210, 247, 235, 72, 241, 136, 226, 208, 241, 170, 73, 209, 131,
-			236, 47, 123, 208, 235, 237, 48, 2, 82, 120, 37, 93, 129, 236,
-			167, 44, 60, 65, 29, 175, 234, 182, 67, 103, 27, 180, 167, 65,
-			146, 130, 238, 165, 176, 127, 189, 18, 212, 0, 204, 12, 75, 208,
-			0, 208, 58, 64, 94, 230, 131, 209, 44, 243, 109, 154, 110, 229,
-			62, 165, 209, 37, 223, 155, 244, 88, 131, 43, 135, 93, 42, 166,
-			45, 85, 41, 208, 174, 246, 87, 49, 151, 68, 65, 165, 117, 109,
-			219, 110, 155, 133, 220, 76, 23, 87, 134, 198, 196, 48, 114, 92,
-			151, 110, 217, 219, 140, 122, 201, 54, 177, 106, 81, 144, 112, 149,
-			134, 107, 173, 117, 63, 0, 109, 81, 170, 212, 187, 17, 38, 52,
-			169, 130, 248, 143, 236, 131, 20, 45, 133, 227, 148, 72, 209, 112,
-			216, 153, 65, 9, 26, 0, 102, 71, 54, 211, 156, 189, 146, 23,
-			14, 145, 123, 27, 126, 177, 186, 21, 248, 77, 167, 221, 68, 26,
-			117, 219, 85, 103, 42, 96, 97, 219, 141, 106, 155, 220, 204, 60,
-			181, 125, 118, 202, 14, 34, 167, 110, 87, 35, 97, 235, 207, 66,
-			182, 162, 204, 86, 220, 62, 155, 123, 181, 227, 129, 252, 95, 106,
-			36, 51, 39, 106, 177, 44, 98, 122, 118, 147, 225, 81, 64, 95,
-			5, 127, 91, 199, 72, 191, 108, 101, 195, 169, 225, 105, 64, 95,
-			133, 200, 164, 114, 205, 58, 66, 250, 234, 44, 170, 110, 109, 180,
-			3, 119, 212, 192, 207, 25, 76, 88, 15, 92, 107, 145, 28, 84,
-			31, 55, 216, 205, 150, 19, 160, 206, 59, 106, 82, 109, 188, 127,
-			38, 183, 251, 32, 161, 168, 182, 157, 138, 37, 235, 40, 169, 82,
-			214, 113, 50, 80, 245, 189, 136, 121, 209, 6, 236, 74, 163, 41,
-			108, 173, 95, 164, 173, 117, 90, 204, 186, 139, 144, 208, 121, 150,
-			109, 108, 118, 34, 22, 142, 166, 241, 76, 163, 15, 82, 46, 67,
-			194, 229, 179, 79, 76, 221, 30, 106, 47, 241, 148, 214, 230, 181,
-			175, 30, 32, 105, 203, 28, 234, 185, 172, 145, 223, 54, 241, 196,
-			97, 168, 199, 154, 249, 231, 102, 215, 225, 193, 204, 52, 238, 233,
-			139, 235, 243, 101, 58, 215, 142, 182, 252, 0, 245, 214, 69, 167,
-			202, 60, 20, 96, 188, 154, 176, 7, 207, 181, 236, 42, 228, 228,
-			95, 10, 244, 117, 44, 8, 29, 223, 163, 51, 197, 105, 58, 14,
-			25, 242, 226, 83, 30, 100, 246, 142, 223, 70, 83, 176, 231, 71,
-			184, 135, 115, 14, 1, 162, 15, 187, 137, 187, 172, 3, 59, 86,
-			179, 229, 58, 54, 172, 118, 105, 151, 150, 213, 23, 9, 125, 92,
-			212, 160, 88, 82, 213, 111, 117, 96, 93, 37, 178, 81, 59, 18,
-			2, 106, 146, 65, 218, 216, 83, 142, 35, 158, 47, 156, 90, 44,
-			207, 151, 150, 86, 75, 147, 51, 197, 105, 66, 232, 186, 231, 178,
-			48, 150, 70, 112, 31, 111, 181, 92, 167, 138, 156, 206, 181, 119,
-			168, 31, 80, 187, 17, 48, 46, 112, 57, 30, 26, 158, 29, 175,
-			81, 80, 22, 234, 132, 5, 189, 11, 77, 178, 103, 78, 216, 149,
-			1, 109, 243, 202, 198, 124, 121, 110, 181, 188, 90, 32, 244, 177,
-			242, 218, 35, 203, 235, 107, 93, 6, 98, 180, 173, 46, 148, 215,
-			202, 203, 75, 104, 253, 156, 91, 122, 156, 62, 90, 94, 90, 40,
-			80, 97, 156, 23, 210, 37, 116, 209, 1, 4, 226, 241, 206, 42,
-			99, 93, 205, 215, 133, 165, 94, 217, 207, 93, 219, 107, 180, 237,
-			6, 163, 13, 127, 155, 5, 30, 240, 240, 216, 136, 142, 6, 50,
-			66, 93, 167, 233, 112, 219, 78, 184, 119, 68, 202, 210, 152, 237,
-			57, 76, 250, 136, 110, 244, 88, 134, 213, 51, 33, 204, 126, 7,
-			123, 174, 72, 83, 34, 252, 252, 121, 29, 205, 126, 230, 209, 158,
-			203, 90, 238, 199, 116, 58, 199, 231, 94, 28, 135, 212, 104, 173,
-			29, 112, 211, 17, 42, 41, 83, 17, 11, 65, 19, 232, 180, 156,
-			170, 237, 186, 29, 106, 83, 72, 161, 138, 75, 112, 235, 80, 203,
-			70, 13, 5, 24, 40, 158, 246, 128, 154, 192, 81, 98, 211, 53,
-			22, 70, 21, 164, 124, 156, 58, 143, 150, 189, 109, 159, 159, 59,
-			161, 133, 204, 163, 142, 74, 152, 116, 65, 176, 81, 181, 211, 38,
-			174, 133, 77, 198, 141, 124, 66, 198, 102, 97, 20, 22, 16, 199,
-			50, 131, 231, 71, 5, 192, 43, 145, 18, 30, 126, 234, 62, 238,
-			8, 35, 80, 155, 113, 80, 52, 140, 88, 139, 186, 126, 35, 228,
-			82, 106, 24, 5, 204, 110, 194, 168, 195, 118, 171, 229, 7, 160,
-			121, 16, 138, 66, 125, 49, 222, 49, 143, 102, 178, 228, 205, 166,
-			216, 49, 141, 49, 221, 202, 125, 209, 160, 243, 92, 230, 145, 141,
-			4, 32, 103, 41, 177, 39, 129, 36, 190, 189, 207, 18, 58, 137,
-			130, 232, 45, 71, 28, 206, 194, 162, 201, 199, 223, 195, 169, 231,
-			202, 75, 175, 91, 158, 159, 3, 170, 219, 40, 47, 60, 175, 24,
-			116, 56, 245, 220, 92, 101, 173, 124, 101, 110, 126, 13, 210, 243,
-			69, 89, 57, 32, 104, 146, 243, 154, 175, 191, 118, 196, 238, 212,
-			115, 235, 149, 197, 141, 210, 234, 252, 220, 74, 105, 97, 99, 173,
-			180, 138, 45, 8, 142, 22, 78, 61, 87, 41, 173, 174, 47, 174,
-			189, 90, 111, 184, 10, 182, 79, 77, 64, 30, 40, 182, 177, 16,
-			54, 0, 202, 194, 170, 221, 18, 71, 96, 241, 113, 40, 63, 226,
-			69, 94, 209, 186, 209, 152, 242, 88, 52, 213, 14, 220, 169, 19,
-			43, 118, 180, 85, 194, 18, 116, 60, 100, 140, 218, 110, 232, 171,
-			66, 182, 211, 42, 214, 216, 246, 212, 217, 153, 25, 84, 145, 188,
-			26, 77, 116, 11, 151, 190, 56, 149, 3, 222, 194, 92, 127, 167,
-			168, 84, 240, 112, 150, 230, 195, 106, 192, 152, 23, 110, 249, 81,
-			177, 229, 53, 242, 5, 154, 143, 2, 187, 202, 194, 41, 27, 244,
-			213, 124, 145, 144, 1, 41, 148, 24, 99, 122, 70, 66, 154, 101,
-			140, 245, 13, 74, 200, 176, 140, 177, 236, 8, 249, 180, 148, 72,
-			140, 73, 253, 112, 238, 247, 52, 58, 199, 109, 227, 212, 169, 49,
-			47, 114, 234, 14, 11, 36, 211, 148, 88, 44, 208, 182, 231, 188,
-			177, 205, 185, 174, 52, 79, 119, 175, 173, 34, 161, 215, 231, 30,
-			231, 210, 70, 232, 218, 225, 22, 11, 11, 116, 179, 29, 209, 235,
-			235, 171, 120, 172, 3, 178, 122, 16, 113, 190, 109, 243, 44, 192,
-			133, 30, 89, 94, 95, 92, 80, 76, 127, 211, 174, 222, 16, 165,
-			139, 132, 86, 88, 131, 221, 156, 165, 111, 120, 242, 201, 217, 29,
-			63, 168, 205, 62, 245, 212, 248, 147, 79, 206, 162, 242, 54, 251,
-			212, 83, 207, 77, 23, 102, 238, 61, 255, 124, 252, 113, 226, 225,
-			147, 18, 15, 90, 10, 70, 39, 241, 160, 193, 88, 251, 14, 74,
-			200, 176, 140, 201, 67, 163, 228, 239, 57, 30, 116, 203, 56, 175,
-			31, 202, 253, 21, 224, 33, 116, 26, 30, 171, 129, 166, 23, 68,
-			147, 46, 30, 53, 174, 87, 22, 97, 225, 224, 22, 141, 227, 22,
-			91, 112, 184, 27, 71, 130, 165, 226, 180, 239, 39, 8, 112, 69,
-			44, 98, 129, 7, 12, 75, 156, 225, 2, 51, 64, 148, 9, 45,
-			33, 239, 132, 62, 112, 148, 217, 169, 169, 231, 182, 252, 48, 122,
-			126, 234, 57, 47, 124, 126, 234, 185, 154, 211, 96, 97, 244, 124,
-			158, 242, 115, 111, 130, 108, 3, 120, 51, 46, 36, 142, 104, 7,
-			45, 19, 74, 205, 17, 231, 14, 143, 172, 173, 173, 172, 226, 40,
-			132, 133, 46, 100, 193, 182, 227, 53, 20, 201, 232, 41, 64, 128,
-			68, 21, 144, 197, 249, 62, 75, 66, 134, 101, 156, 191, 227, 78,
-			178, 136, 152, 50, 44, 227, 62, 253, 222, 220, 195, 244, 49, 224,
-			78, 106, 140, 20, 199, 200, 194, 34, 45, 215, 197, 239, 90, 129,
-			6, 108, 18, 54, 75, 96, 202, 56, 210, 57, 133, 38, 81, 183,
-			145, 134, 234, 142, 72, 72, 179, 140, 251, 142, 78, 75, 8, 154,
-			58, 119, 158, 124, 23, 159, 34, 211, 50, 30, 208, 115, 185, 136,
-			94, 103, 53, 199, 230, 106, 250, 94, 236, 47, 2, 227, 180, 3,
-			150, 216, 21, 242, 17, 187, 25, 77, 181, 92, 219, 241, 242, 184,
-			232, 226, 117, 180, 39, 171, 211, 180, 27, 108, 10, 86, 87, 145,
-			208, 101, 105, 191, 144, 189, 53, 83, 208, 7, 137, 37, 83, 179,
-			140, 7, 250, 238, 144, 144, 97, 25, 15, 140, 30, 38, 47, 240,
-			222, 166, 44, 99, 78, 63, 148, 123, 139, 70, 87, 157, 103, 85,
-			71, 97, 55, 43, 146, 46, 198, 236, 120, 116, 189, 12, 211, 84,
-			99, 85, 167, 198, 232, 38, 139, 118, 24, 243, 64, 10, 104, 185,
-			118, 71, 218, 39, 212, 174, 227, 120, 174, 195, 207, 178, 124, 207,
-			237, 16, 32, 211, 29, 190, 39, 186, 142, 119, 131, 58, 117, 65,
-			2, 145, 239, 83, 215, 14, 26, 76, 245, 62, 133, 125, 234, 149,
-			144, 102, 25, 115, 25, 57, 199, 41, 195, 50, 230, 238, 184, 83,
-			201, 228, 159, 56, 69, 206, 221, 166, 76, 94, 245, 155, 77, 223,
-			251, 198, 37, 242, 29, 210, 251, 58, 59, 112, 108, 47, 178, 206,
-			19, 163, 198, 234, 163, 26, 53, 198, 251, 103, 242, 197, 221, 117,
-			21, 69, 190, 226, 2, 171, 151, 188, 40, 232, 84, 32, 123, 238,
-			2, 201, 200, 4, 43, 75, 140, 27, 172, 35, 4, 122, 248, 105,
-			29, 36, 41, 84, 114, 132, 36, 207, 129, 89, 253, 126, 45, 127,
-			158, 16, 110, 87, 88, 177, 157, 224, 118, 75, 230, 93, 50, 52,
-			239, 55, 155, 78, 180, 226, 135, 232, 112, 1, 90, 4, 172, 82,
-			169, 69, 192, 111, 107, 148, 244, 182, 2, 255, 25, 86, 141, 68,
-			105, 9, 66, 59, 1, 171, 11, 197, 1, 126, 90, 57, 146, 105,
-			137, 186, 80, 79, 48, 42, 10, 206, 255, 160, 70, 14, 116, 55,
-			87, 177, 189, 6, 179, 30, 32, 25, 102, 7, 174, 195, 68, 187,
-			253, 51, 116, 47, 186, 118, 21, 84, 37, 172, 251, 73, 26, 248,
-			75, 200, 59, 119, 59, 101, 69, 254, 252, 14, 119, 166, 226, 157,
-			184, 176, 167, 19, 175, 164, 224, 196, 205, 207, 236, 106, 254, 149,
-			74, 137, 156, 223, 136, 34, 243, 183, 199, 184, 34, 211, 120, 37,
-			69, 230, 236, 197, 111, 41, 50, 223, 82, 100, 190, 233, 138, 204,
-			203, 132, 43, 50, 211, 61, 247, 106, 185, 255, 72, 232, 28, 189,
-			193, 58, 147, 200, 65, 104, 211, 110, 209, 26, 11, 171, 129, 179,
-			9, 189, 240, 61, 70, 183, 57, 99, 227, 230, 31, 212, 97, 170,
-			54, 182, 136, 196, 25, 218, 77, 22, 167, 74, 83, 38, 187, 201,
-			170, 136, 90, 7, 54, 139, 58, 218, 80, 35, 186, 99, 119, 66,
-			84, 55, 148, 182, 225, 123, 36, 241, 125, 121, 181, 64, 175, 174,
-			172, 75, 167, 187, 248, 3, 16, 35, 144, 39, 55, 218, 35, 118,
-			131, 182, 199, 141, 151, 174, 221, 0, 73, 108, 78, 245, 179, 198,
-			234, 142, 135, 156, 129, 86, 237, 86, 212, 14, 88, 152, 28, 7,
-			230, 141, 59, 44, 165, 61, 57, 21, 251, 212, 2, 50, 139, 237,
-			186, 64, 110, 80, 76, 213, 67, 232, 213, 182, 83, 99, 176, 229,
-			161, 165, 112, 191, 178, 53, 6, 18, 27, 170, 47, 107, 176, 202,
-			130, 182, 203, 104, 3, 138, 129, 10, 101, 71, 128, 250, 144, 75,
-			160, 155, 160, 75, 162, 13, 81, 249, 193, 169, 122, 240, 84, 18,
-			164, 63, 175, 129, 171, 180, 197, 170, 128, 221, 64, 104, 135, 48,
-			51, 13, 103, 155, 121, 93, 61, 228, 103, 78, 126, 187, 177, 5,
-			235, 164, 234, 123, 161, 3, 100, 227, 68, 80, 89, 203, 14, 209,
-			110, 62, 222, 242, 65, 88, 116, 80, 196, 168, 187, 246, 13, 199,
-			237, 76, 160, 160, 196, 183, 106, 167, 9, 122, 29, 212, 6, 98,
-			128, 156, 17, 199, 142, 24, 181, 171, 129, 31, 134, 80, 153, 77,
-			171, 44, 64, 78, 80, 115, 154, 204, 67, 214, 51, 206, 138, 141,
-			34, 168, 50, 184, 46, 118, 216, 38, 215, 63, 81, 168, 81, 228,
-			177, 199, 185, 146, 210, 208, 137, 208, 163, 18, 20, 235, 42, 44,
-			37, 46, 112, 226, 209, 13, 96, 5, 81, 19, 48, 133, 51, 27,
-			80, 40, 108, 132, 114, 186, 81, 0, 165, 123, 58, 99, 115, 7,
-			64, 180, 21, 162, 10, 21, 182, 157, 136, 133, 197, 86, 199, 157,
-			64, 12, 47, 67, 213, 220, 202, 109, 35, 134, 96, 38, 146, 107,
-			96, 7, 237, 149, 32, 10, 217, 180, 110, 59, 110, 59, 96, 220,
-			149, 135, 159, 169, 8, 223, 149, 87, 159, 107, 208, 54, 110, 99,
-			190, 133, 135, 172, 248, 28, 175, 210, 144, 69, 148, 133, 161, 154,
-			55, 168, 38, 138, 85, 67, 73, 23, 91, 14, 8, 225, 29, 172,
-			172, 235, 20, 199, 169, 211, 171, 75, 212, 14, 132, 56, 106, 55,
-			125, 175, 161, 198, 24, 55, 211, 178, 157, 32, 20, 72, 183, 107,
-			53, 148, 228, 160, 46, 143, 237, 136, 242, 162, 135, 188, 105, 174,
-			157, 69, 106, 31, 232, 162, 69, 155, 155, 137, 161, 155, 78, 20,
-			198, 93, 195, 179, 159, 121, 177, 117, 22, 146, 157, 192, 190, 225,
-			202, 217, 108, 87, 111, 176, 104, 150, 51, 60, 216, 12, 121, 66,
-			129, 226, 172, 230, 171, 78, 158, 231, 194, 83, 231, 174, 108, 152,
-			34, 243, 185, 142, 215, 190, 57, 25, 48, 23, 179, 199, 4, 48,
-			155, 36, 12, 34, 182, 43, 208, 137, 171, 97, 247, 166, 46, 129,
-			169, 48, 168, 162, 190, 239, 120, 141, 41, 108, 100, 211, 143, 166,
-			118, 81, 84, 108, 255, 152, 206, 12, 147, 239, 148, 230, 143, 243,
-			250, 221, 185, 0, 57, 103, 130, 75, 8, 132, 197, 28, 234, 81,
-			214, 65, 140, 9, 219, 121, 83, 88, 185, 183, 109, 215, 169, 209,
-			88, 48, 20, 104, 82, 25, 11, 52, 228, 91, 141, 19, 160, 11,
-			107, 24, 5, 182, 227, 69, 97, 172, 125, 167, 161, 7, 119, 36,
-			180, 239, 243, 119, 30, 78, 104, 223, 231, 143, 222, 69, 94, 71,
-			64, 123, 48, 239, 239, 121, 88, 203, 93, 3, 166, 195, 79, 170,
-			187, 201, 162, 72, 215, 148, 98, 130, 170, 2, 238, 105, 118, 163,
-			193, 247, 97, 198, 146, 198, 170, 200, 110, 132, 28, 29, 160, 231,
-			222, 159, 177, 200, 163, 196, 52, 53, 64, 199, 37, 125, 36, 247,
-			80, 172, 70, 219, 147, 207, 62, 5, 255, 55, 61, 121, 113, 227,
-			169, 211, 227, 83, 187, 18, 38, 78, 159, 36, 244, 186, 125, 147,
-			186, 204, 107, 68, 91, 179, 244, 194, 121, 49, 52, 13, 13, 11,
-			151, 132, 254, 163, 225, 208, 46, 245, 13, 72, 200, 176, 140, 75,
-			195, 89, 114, 12, 155, 213, 44, 227, 33, 253, 64, 206, 234, 170,
-			105, 230, 222, 11, 170, 42, 208, 205, 31, 82, 85, 65, 159, 31,
-			234, 27, 146, 144, 97, 25, 15, 141, 88, 228, 221, 58, 209, 77,
-			221, 50, 175, 244, 172, 104, 185, 183, 234, 180, 91, 38, 149, 251,
-			137, 88, 25, 94, 187, 201, 2, 192, 22, 149, 194, 179, 156, 242,
-			42, 22, 3, 241, 35, 182, 45, 17, 216, 220, 66, 106, 55, 108,
-			199, 11, 35, 60, 112, 179, 105, 192, 176, 168, 31, 116, 198, 66,
-			89, 202, 245, 27, 69, 122, 29, 85, 102, 177, 125, 113, 197, 29,
-			114, 215, 85, 54, 130, 249, 8, 45, 71, 92, 245, 239, 238, 26,
-			212, 59, 21, 176, 58, 100, 222, 116, 60, 238, 114, 173, 188, 215,
-			69, 67, 170, 215, 236, 166, 19, 70, 33, 161, 142, 87, 128, 253,
-			64, 248, 96, 115, 139, 195, 205, 72, 216, 252, 0, 193, 87, 50,
-			119, 146, 127, 10, 218, 166, 14, 179, 92, 214, 173, 220, 119, 105,
-			252, 76, 95, 57, 126, 215, 29, 230, 214, 66, 105, 211, 233, 224,
-			22, 22, 37, 198, 41, 206, 185, 161, 111, 194, 168, 195, 143, 254,
-			145, 185, 239, 131, 81, 52, 74, 197, 38, 34, 116, 178, 224, 251,
-			37, 31, 133, 152, 94, 29, 41, 165, 44, 166, 87, 71, 74, 41,
-			11, 19, 148, 142, 148, 82, 206, 142, 144, 126, 236, 186, 102, 25,
-			215, 196, 106, 209, 145, 42, 174, 169, 98, 64, 21, 215, 250, 178,
-			18, 50, 44, 227, 218, 129, 131, 162, 152, 110, 25, 143, 234, 35,
-			226, 19, 104, 219, 143, 170, 98, 80, 229, 163, 130, 46, 117, 180,
-			94, 60, 58, 156, 37, 27, 88, 204, 176, 140, 101, 253, 142, 92,
-			5, 241, 244, 170, 36, 35, 54, 5, 215, 111, 40, 121, 18, 180,
-			187, 41, 161, 203, 193, 156, 18, 238, 159, 175, 6, 110, 164, 160,
-			133, 94, 9, 105, 150, 177, 156, 145, 35, 48, 160, 245, 3, 7,
-			201, 15, 1, 93, 27, 150, 249, 88, 207, 83, 90, 238, 45, 58,
-			157, 19, 14, 140, 232, 198, 223, 69, 12, 32, 117, 205, 239, 74,
-			226, 219, 73, 24, 182, 155, 82, 56, 175, 6, 12, 196, 44, 220,
-			115, 165, 146, 5, 95, 184, 234, 84, 36, 116, 201, 143, 132, 215,
-			162, 83, 231, 126, 10, 42, 27, 16, 0, 207, 135, 245, 134, 140,
-			187, 214, 56, 193, 158, 145, 34, 135, 36, 192, 34, 249, 252, 87,
-			109, 183, 72, 232, 186, 100, 76, 156, 228, 209, 94, 17, 136, 253,
-			202, 167, 111, 108, 179, 160, 3, 104, 228, 54, 246, 133, 203, 197,
-			171, 44, 138, 109, 238, 143, 168, 29, 10, 104, 26, 144, 245, 88,
-			230, 8, 185, 76, 76, 211, 0, 146, 126, 92, 191, 59, 119, 47,
-			206, 148, 235, 239, 160, 52, 185, 107, 169, 240, 209, 187, 237, 154,
-			218, 191, 177, 105, 49, 25, 6, 178, 226, 199, 245, 172, 132, 52,
-			203, 120, 124, 228, 176, 132, 12, 203, 120, 252, 232, 93, 100, 13,
-			91, 211, 44, 227, 219, 245, 163, 185, 171, 180, 44, 234, 243, 61,
-			183, 179, 103, 54, 228, 162, 181, 5, 178, 208, 85, 212, 237, 96,
-			247, 128, 60, 108, 126, 164, 173, 218, 215, 210, 80, 173, 108, 31,
-			200, 249, 219, 71, 14, 73, 200, 176, 140, 111, 207, 29, 33, 55,
-			136, 110, 154, 150, 105, 247, 52, 180, 220, 70, 146, 20, 226, 163,
-			242, 111, 46, 154, 77, 205, 50, 236, 204, 8, 121, 128, 152, 166,
-			9, 104, 174, 234, 19, 185, 41, 68, 179, 239, 214, 144, 116, 98,
-			95, 152, 87, 68, 176, 137, 8, 174, 10, 243, 157, 137, 8, 174,
-			30, 61, 41, 33, 195, 50, 170, 99, 227, 164, 140, 237, 104, 150,
-			81, 215, 199, 114, 15, 116, 35, 56, 225, 14, 176, 23, 171, 208,
-			155, 189, 88, 53, 17, 171, 117, 213, 40, 96, 181, 126, 52, 47,
-			33, 195, 50, 234, 167, 238, 81, 118, 172, 183, 124, 143, 65, 232,
-			110, 11, 20, 87, 190, 90, 145, 31, 220, 234, 206, 216, 117, 50,
-			114, 197, 113, 217, 130, 202, 184, 202, 34, 235, 126, 98, 130, 2,
-			47, 204, 83, 39, 247, 24, 45, 186, 75, 224, 253, 172, 10, 150,
-			200, 127, 206, 36, 7, 246, 249, 186, 239, 249, 243, 40, 233, 109,
-			217, 213, 27, 118, 131, 41, 203, 17, 7, 173, 187, 9, 145, 206,
-			237, 213, 206, 168, 65, 141, 241, 190, 74, 34, 197, 58, 67, 70,
-			90, 237, 77, 215, 169, 110, 36, 178, 17, 106, 140, 167, 42, 89,
-			254, 97, 33, 206, 60, 70, 134, 119, 152, 125, 35, 153, 181, 31,
-			179, 14, 65, 114, 34, 227, 60, 25, 104, 178, 48, 180, 27, 140,
-			159, 65, 155, 56, 122, 186, 103, 244, 187, 71, 222, 47, 74, 225,
-			41, 245, 28, 233, 99, 94, 187, 41, 79, 177, 247, 199, 95, 201,
-			107, 55, 119, 215, 146, 129, 98, 162, 138, 94, 52, 90, 87, 217,
-			104, 26, 43, 24, 219, 83, 193, 42, 255, 190, 187, 14, 89, 206,
-			154, 39, 125, 232, 43, 15, 234, 202, 104, 47, 86, 114, 106, 159,
-			89, 100, 110, 109, 119, 21, 113, 57, 235, 2, 233, 21, 42, 242,
-			104, 6, 173, 87, 71, 247, 37, 4, 110, 59, 14, 43, 50, 179,
-			85, 38, 89, 126, 70, 178, 81, 245, 107, 108, 195, 241, 234, 254,
-			104, 31, 86, 112, 108, 239, 64, 48, 227, 188, 95, 99, 101, 175,
-			238, 87, 134, 194, 46, 216, 186, 147, 164, 195, 142, 23, 217, 55,
-			71, 7, 144, 66, 4, 148, 255, 231, 105, 50, 124, 59, 36, 118,
-			137, 164, 80, 48, 24, 213, 191, 30, 28, 240, 50, 221, 72, 76,
-			127, 131, 72, 156, 35, 253, 30, 11, 35, 86, 227, 20, 97, 220,
-			38, 77, 17, 94, 104, 47, 73, 153, 223, 16, 73, 189, 158, 12,
-			171, 46, 109, 32, 99, 19, 180, 57, 245, 106, 61, 41, 150, 100,
-			57, 180, 132, 86, 134, 88, 23, 108, 45, 16, 226, 123, 204, 175,
-			111, 212, 88, 213, 29, 205, 220, 2, 75, 203, 144, 101, 15, 150,
-			124, 158, 90, 117, 173, 139, 49, 169, 245, 222, 130, 82, 174, 243,
-			69, 182, 135, 218, 214, 201, 144, 188, 196, 41, 70, 214, 135, 157,
-			40, 190, 234, 200, 42, 162, 24, 31, 216, 96, 144, 4, 173, 19,
-			68, 37, 108, 32, 89, 17, 228, 66, 3, 50, 113, 201, 110, 178,
-			220, 179, 100, 168, 27, 61, 214, 65, 146, 194, 115, 63, 164, 194,
-			84, 133, 3, 86, 150, 24, 204, 171, 137, 251, 182, 240, 211, 250,
-			39, 241, 128, 13, 28, 240, 61, 123, 103, 180, 171, 230, 221, 227,
-			206, 221, 71, 6, 187, 6, 112, 187, 77, 231, 223, 68, 238, 216,
-			183, 106, 235, 245, 228, 96, 219, 83, 55, 88, 88, 109, 131, 55,
-			53, 250, 127, 247, 222, 130, 230, 214, 147, 185, 121, 45, 149, 3,
-			237, 189, 137, 167, 251, 50, 127, 222, 155, 125, 243, 155, 223, 252,
-			102, 61, 255, 155, 105, 114, 112, 191, 53, 179, 239, 242, 189, 147,
-			164, 189, 118, 115, 147, 5, 136, 164, 84, 69, 64, 214, 28, 73,
-			185, 246, 38, 115, 241, 16, 97, 104, 230, 204, 109, 173, 202, 226,
-			34, 20, 169, 240, 146, 214, 67, 196, 84, 142, 70, 67, 51, 167,
-			111, 175, 6, 88, 75, 21, 44, 103, 29, 33, 125, 240, 47, 167,
-			141, 52, 247, 141, 130, 4, 160, 11, 43, 71, 50, 252, 170, 18,
-			147, 91, 155, 130, 129, 176, 106, 172, 110, 183, 221, 104, 131, 159,
-			185, 244, 98, 134, 1, 145, 248, 58, 72, 179, 142, 145, 126, 190,
-			170, 28, 175, 198, 110, 34, 247, 76, 85, 248, 66, 43, 67, 10,
-			52, 255, 76, 232, 123, 146, 52, 177, 9, 72, 192, 230, 239, 219,
-			205, 184, 239, 218, 127, 120, 123, 214, 210, 24, 25, 230, 210, 196,
-			134, 116, 104, 30, 29, 161, 218, 120, 166, 50, 196, 147, 229, 49,
-			97, 254, 127, 209, 137, 137, 140, 101, 152, 244, 175, 61, 190, 82,
-			218, 88, 88, 94, 191, 188, 88, 202, 106, 214, 16, 33, 152, 112,
-			101, 113, 121, 110, 45, 171, 43, 24, 111, 52, 100, 13, 85, 128,
-			95, 241, 200, 154, 201, 12, 231, 102, 178, 41, 43, 75, 6, 120,
-			5, 229, 215, 151, 22, 46, 156, 207, 166, 187, 83, 206, 205, 100,
-			123, 173, 65, 210, 135, 41, 151, 151, 151, 23, 179, 25, 85, 231,
-			234, 90, 165, 188, 116, 53, 219, 167, 234, 188, 90, 89, 94, 95,
-			201, 18, 85, 195, 245, 210, 234, 234, 220, 213, 82, 182, 95, 229,
-			184, 252, 248, 90, 105, 53, 59, 208, 213, 173, 115, 51, 217, 65,
-			213, 68, 105, 105, 253, 122, 118, 200, 26, 33, 131, 188, 9, 217,
-			137, 225, 93, 73, 23, 206, 103, 179, 113, 71, 120, 45, 35, 93,
-			9, 23, 206, 103, 173, 252, 60, 73, 33, 25, 90, 22, 25, 90,
-			156, 187, 92, 90, 220, 88, 198, 27, 153, 115, 139, 89, 45, 78,
-			171, 148, 190, 109, 189, 92, 41, 45, 100, 245, 100, 218, 74, 105,
-			110, 173, 180, 144, 53, 242, 85, 114, 112, 63, 134, 186, 239, 18,
-			74, 208, 130, 126, 11, 90, 192, 186, 118, 211, 66, 254, 79, 117,
-			114, 96, 159, 77, 101, 223, 70, 30, 142, 207, 15, 129, 83, 76,
-			236, 187, 59, 33, 101, 239, 217, 106, 177, 92, 82, 212, 48, 110,
-			33, 106, 64, 21, 123, 8, 246, 169, 61, 204, 159, 239, 143, 23,
-			110, 103, 127, 196, 180, 175, 111, 19, 72, 237, 179, 9, 92, 34,
-			35, 123, 42, 186, 109, 102, 252, 22, 141, 140, 222, 10, 57, 175,
-			194, 18, 245, 46, 150, 120, 105, 55, 6, 143, 223, 122, 18, 246,
-			204, 245, 75, 26, 185, 115, 127, 145, 114, 223, 62, 60, 68, 210,
-			220, 59, 93, 204, 247, 222, 189, 235, 58, 126, 222, 61, 217, 162,
-			84, 114, 183, 55, 110, 37, 23, 242, 222, 236, 233, 233, 219, 117,
-			114, 199, 190, 149, 239, 219, 209, 187, 8, 113, 188, 86, 91, 248,
-			148, 114, 78, 220, 135, 41, 200, 188, 128, 203, 182, 35, 245, 157,
-			31, 84, 19, 158, 132, 25, 238, 143, 59, 202, 221, 90, 239, 190,
-			197, 72, 247, 16, 230, 52, 201, 86, 93, 135, 121, 209, 134, 242,
-			106, 195, 173, 38, 51, 155, 170, 219, 110, 200, 42, 195, 252, 243,
-			170, 252, 10, 37, 144, 128, 130, 68, 137, 116, 87, 9, 254, 89,
-			149, 200, 191, 208, 71, 250, 19, 2, 184, 117, 156, 12, 60, 99,
-			111, 219, 27, 82, 169, 226, 152, 232, 135, 180, 21, 161, 88, 77,
-			147, 131, 152, 197, 111, 71, 44, 216, 168, 186, 118, 24, 34, 210,
-			50, 152, 213, 130, 111, 203, 240, 105, 94, 126, 177, 238, 37, 7,
-			176, 68, 179, 237, 70, 78, 203, 101, 27, 160, 230, 133, 184, 229,
-			168, 158, 141, 64, 142, 235, 34, 3, 244, 40, 180, 22, 200, 93,
-			88, 76, 56, 196, 179, 13, 246, 198, 182, 237, 134, 27, 182, 87,
-			219, 216, 178, 195, 173, 209, 131, 80, 193, 101, 125, 84, 171, 28,
-			134, 140, 87, 69, 190, 18, 102, 155, 243, 106, 143, 216, 225, 150,
-			53, 75, 238, 196, 90, 184, 209, 120, 163, 186, 197, 170, 55, 54,
-			218, 81, 253, 254, 209, 35, 201, 246, 177, 135, 220, 142, 61, 15,
-			89, 214, 163, 250, 253, 214, 42, 25, 128, 201, 104, 58, 207, 178,
-			141, 186, 31, 224, 30, 58, 180, 15, 107, 74, 96, 176, 184, 44,
-			10, 92, 247, 107, 108, 54, 181, 186, 82, 42, 45, 84, 250, 101,
-			45, 87, 252, 0, 8, 170, 225, 43, 4, 247, 115, 130, 106, 248,
-			18, 189, 247, 146, 3, 213, 42, 31, 179, 83, 221, 16, 202, 88,
-			56, 154, 237, 66, 86, 181, 122, 149, 103, 16, 52, 30, 90, 23,
-			201, 29, 49, 178, 146, 5, 71, 246, 140, 114, 119, 209, 123, 201,
-			129, 86, 103, 111, 65, 171, 171, 197, 86, 103, 119, 177, 251, 200,
-			193, 214, 86, 107, 111, 185, 211, 201, 114, 86, 107, 171, 181, 187,
-			224, 41, 212, 204, 3, 134, 183, 123, 70, 15, 37, 179, 39, 62,
-			88, 69, 146, 173, 86, 55, 152, 103, 111, 186, 108, 195, 14, 152,
-			103, 135, 163, 199, 48, 179, 25, 5, 109, 86, 25, 170, 86, 75,
-			248, 113, 14, 191, 89, 167, 201, 136, 191, 249, 76, 149, 83, 228,
-			70, 43, 96, 117, 231, 230, 232, 73, 68, 239, 48, 124, 64, 122,
-			92, 193, 100, 107, 130, 100, 171, 225, 150, 29, 180, 144, 37, 135,
-			45, 187, 202, 70, 79, 241, 172, 60, 125, 73, 38, 195, 138, 8,
-			119, 156, 122, 36, 107, 28, 227, 43, 2, 211, 68, 109, 227, 36,
-			11, 152, 232, 106, 120, 28, 179, 13, 181, 182, 90, 201, 118, 79,
-			144, 65, 200, 25, 55, 58, 193, 5, 183, 214, 86, 162, 197, 243,
-			228, 78, 200, 212, 100, 145, 93, 179, 35, 59, 145, 187, 128, 185,
-			1, 237, 215, 197, 199, 174, 126, 6, 237, 205, 142, 34, 172, 73,
-			222, 79, 72, 147, 164, 245, 154, 9, 231, 249, 89, 50, 144, 164,
-			123, 171, 143, 112, 202, 207, 106, 32, 4, 205, 47, 47, 128, 248,
-			242, 68, 41, 171, 131, 24, 181, 88, 94, 43, 109, 84, 214, 151,
-			214, 202, 215, 75, 89, 35, 33, 216, 95, 51, 51, 247, 100, 199,
-			242, 127, 160, 147, 161, 110, 77, 205, 122, 128, 28, 146, 102, 149,
-			144, 69, 27, 59, 78, 128, 11, 178, 105, 243, 205, 81, 209, 207,
-			65, 145, 107, 149, 69, 143, 57, 129, 184, 137, 99, 45, 146, 99,
-			158, 191, 33, 239, 61, 109, 196, 6, 173, 13, 187, 90, 101, 97,
-			232, 243, 141, 80, 213, 114, 212, 243, 87, 69, 230, 120, 135, 152,
-			19, 89, 119, 145, 175, 113, 43, 242, 61, 66, 250, 154, 118, 107,
-			131, 121, 81, 208, 65, 249, 60, 83, 201, 52, 237, 22, 119, 188,
-			250, 199, 80, 147, 174, 153, 153, 76, 182, 239, 154, 153, 233, 203,
-			146, 252, 103, 13, 50, 144, 148, 215, 65, 253, 169, 226, 142, 165,
-			33, 79, 59, 241, 138, 210, 125, 113, 30, 182, 178, 217, 52, 23,
-			142, 43, 188, 36, 136, 17, 64, 108, 140, 11, 35, 153, 138, 128,
-			172, 171, 36, 253, 76, 136, 117, 167, 177, 238, 253, 108, 127, 137,
-			186, 175, 173, 98, 229, 125, 215, 86, 55, 150, 150, 43, 215, 231,
-			22, 43, 162, 184, 117, 152, 152, 174, 253, 108, 167, 123, 211, 195,
-			164, 219, 157, 132, 195, 196, 220, 97, 246, 141, 238, 173, 6, 147,
-			94, 195, 197, 48, 69, 82, 136, 47, 139, 16, 129, 177, 108, 143,
-			149, 33, 230, 252, 114, 5, 22, 68, 150, 12, 240, 212, 141, 149,
-			114, 105, 190, 148, 213, 243, 247, 146, 52, 71, 2, 44, 22, 133,
-			134, 108, 143, 0, 69, 29, 154, 252, 186, 126, 253, 114, 169, 146,
-			213, 187, 167, 218, 204, 166, 242, 33, 25, 72, 202, 225, 255, 56,
-			202, 248, 111, 104, 164, 63, 33, 87, 227, 141, 32, 215, 245, 119,
-			54, 108, 215, 177, 67, 65, 26, 4, 147, 230, 32, 229, 118, 167,
-			238, 31, 105, 137, 164, 178, 233, 252, 7, 53, 146, 221, 45, 216,
-			238, 234, 166, 246, 63, 179, 155, 249, 247, 107, 100, 168, 91, 154,
-			221, 213, 189, 227, 255, 83, 187, 247, 39, 58, 25, 236, 146, 97,
-			111, 183, 119, 111, 36, 35, 78, 141, 53, 209, 225, 167, 218, 217,
-			64, 199, 236, 209, 60, 50, 141, 189, 70, 197, 174, 22, 138, 229,
-			184, 220, 34, 20, 155, 61, 80, 94, 40, 93, 95, 89, 94, 43,
-			45, 205, 63, 190, 177, 190, 244, 232, 210, 242, 99, 75, 149, 172,
-			179, 43, 219, 107, 184, 236, 87, 72, 118, 119, 167, 172, 67, 100,
-			191, 110, 101, 123, 172, 3, 100, 120, 105, 121, 99, 181, 188, 80,
-			218, 40, 93, 185, 82, 154, 95, 91, 229, 118, 15, 149, 123, 173,
-			107, 129, 231, 127, 212, 32, 7, 246, 233, 137, 53, 39, 52, 22,
-			174, 68, 77, 222, 78, 239, 139, 32, 51, 172, 216, 65, 36, 20,
-			156, 9, 146, 141, 111, 49, 8, 123, 18, 87, 99, 134, 227, 116,
-			110, 82, 42, 16, 139, 159, 238, 109, 179, 13, 199, 147, 198, 39,
-			80, 107, 204, 74, 86, 126, 41, 123, 145, 202, 45, 239, 79, 38,
-			114, 167, 208, 103, 55, 43, 191, 168, 220, 199, 201, 64, 205, 111,
-			131, 172, 199, 243, 193, 222, 161, 85, 250, 121, 154, 202, 34, 164,
-			248, 216, 234, 53, 80, 233, 231, 105, 60, 203, 24, 25, 182, 27,
-			141, 0, 42, 151, 21, 113, 189, 100, 72, 37, 99, 198, 220, 53,
-			146, 145, 120, 128, 173, 26, 48, 177, 209, 226, 202, 182, 62, 222,
-			87, 201, 120, 242, 227, 113, 50, 224, 132, 27, 177, 17, 95, 167,
-			250, 120, 166, 210, 239, 132, 202, 0, 154, 127, 73, 39, 67, 221,
-			135, 16, 214, 2, 201, 184, 194, 121, 66, 156, 128, 141, 191, 202,
-			185, 69, 113, 81, 228, 175, 168, 146, 185, 223, 215, 72, 70, 38,
-			91, 119, 18, 179, 101, 71, 91, 88, 93, 234, 178, 158, 213, 42,
-			8, 67, 122, 216, 178, 61, 36, 1, 145, 14, 48, 204, 171, 203,
-			236, 26, 42, 61, 126, 179, 201, 188, 40, 148, 243, 42, 210, 231,
-			69, 178, 117, 134, 140, 68, 129, 237, 184, 93, 121, 77, 204, 155,
-			149, 31, 84, 230, 89, 114, 88, 214, 91, 99, 145, 93, 221, 98,
-			181, 184, 80, 26, 141, 27, 135, 68, 134, 5, 241, 93, 150, 205,
-			255, 129, 70, 70, 164, 154, 86, 83, 200, 186, 78, 136, 237, 121,
-			126, 148, 68, 215, 94, 82, 222, 83, 174, 56, 167, 10, 85, 18,
-			21, 228, 154, 132, 196, 95, 110, 137, 182, 99, 164, 95, 156, 48,
-			225, 49, 165, 184, 185, 202, 147, 64, 159, 179, 14, 146, 212, 38,
-			107, 56, 158, 176, 27, 115, 64, 154, 95, 76, 101, 126, 185, 252,
-			79, 181, 253, 163, 94, 102, 119, 89, 23, 194, 71, 180, 39, 30,
-			122, 165, 184, 151, 24, 29, 114, 178, 193, 188, 201, 134, 159, 56,
-			117, 189, 20, 255, 76, 132, 194, 204, 237, 14, 133, 89, 97, 117,
-			151, 225, 85, 230, 107, 255, 219, 255, 174, 147, 94, 43, 53, 214,
-			243, 3, 189, 26, 249, 200, 48, 58, 115, 143, 125, 43, 14, 230,
-			183, 226, 96, 126, 43, 14, 230, 183, 226, 96, 126, 43, 14, 230,
-			183, 226, 96, 126, 227, 113, 48, 103, 62, 173, 139, 139, 63, 179,
-			244, 6, 243, 34, 223, 251, 39, 49, 99, 167, 227, 143, 98, 18,
-			125, 157, 29, 212, 236, 9, 66, 233, 101, 59, 228, 183, 89, 252,
-			192, 105, 56, 158, 237, 238, 221, 128, 248, 125, 4, 12, 54, 68,
-			87, 109, 239, 25, 187, 67, 175, 110, 177, 166, 189, 99, 71, 5,
-			122, 141, 213, 235, 116, 129, 217, 94, 33, 142, 19, 20, 202, 139,
-			30, 194, 214, 147, 136, 141, 226, 184, 76, 222, 21, 97, 187, 252,
-			215, 67, 90, 247, 219, 30, 94, 255, 224, 91, 52, 230, 150, 247,
-			52, 92, 167, 150, 76, 150, 151, 70, 162, 192, 246, 66, 126, 91,
-			189, 230, 4, 12, 253, 172, 34, 159, 218, 116, 31, 247, 36, 162,
-			184, 136, 237, 117, 4, 79, 116, 60, 190, 133, 38, 110, 31, 136,
-			60, 1, 23, 144, 208, 243, 156, 95, 104, 8, 39, 212, 149, 153,
-			9, 21, 101, 244, 76, 207, 186, 188, 39, 3, 63, 121, 98, 33,
-			142, 29, 90, 80, 177, 67, 39, 123, 206, 202, 216, 161, 240, 147,
-			39, 22, 123, 238, 147, 65, 74, 225, 39, 79, 156, 138, 99, 135,
-			194, 79, 158, 56, 29, 71, 46, 157, 86, 145, 75, 207, 247, 28,
-			37, 223, 73, 244, 76, 31, 254, 204, 69, 116, 183, 43, 152, 114,
-			3, 151, 246, 109, 229, 117, 135, 87, 178, 171, 54, 108, 225, 129,
-			18, 76, 38, 55, 129, 28, 8, 181, 221, 134, 31, 56, 209, 86,
-			51, 164, 53, 223, 27, 139, 232, 142, 31, 220, 144, 209, 10, 54,
-			125, 63, 10, 163, 128, 71, 50, 43, 18, 242, 12, 191, 26, 116,
-			127, 207, 172, 150, 123, 3, 143, 80, 32, 73, 72, 92, 194, 9,
-			112, 186, 248, 145, 203, 158, 185, 89, 101, 17, 110, 25, 182, 227,
-			201, 203, 161, 188, 239, 132, 19, 0, 117, 34, 30, 172, 42, 76,
-			196, 7, 184, 63, 115, 24, 29, 103, 209, 63, 254, 162, 62, 46,
-			253, 209, 77, 128, 146, 158, 235, 23, 251, 239, 78, 120, 174, 95,
-			60, 118, 34, 225, 185, 126, 241, 158, 49, 50, 197, 61, 215, 31,
-			236, 121, 66, 203, 157, 160, 11, 130, 52, 121, 240, 212, 102, 203,
-			101, 17, 75, 146, 93, 49, 118, 73, 127, 48, 115, 132, 92, 148,
-			46, 233, 15, 233, 71, 242, 5, 78, 152, 176, 23, 22, 186, 226,
-			164, 6, 190, 31, 37, 132, 146, 40, 96, 76, 57, 160, 155, 80,
-			54, 233, 142, 254, 80, 255, 72, 194, 29, 253, 33, 235, 206, 132,
-			59, 250, 67, 135, 115, 228, 180, 116, 71, 127, 88, 191, 59, 127,
-			151, 184, 165, 80, 247, 253, 124, 1, 255, 41, 110, 218, 65, 190,
-			64, 89, 84, 141, 61, 211, 77, 200, 156, 244, 83, 127, 88, 181,
-			1, 3, 121, 216, 58, 156, 240, 83, 127, 248, 232, 93, 228, 60,
-			182, 161, 91, 198, 101, 253, 120, 110, 140, 46, 201, 221, 93, 76,
-			7, 46, 6, 126, 201, 78, 45, 106, 213, 154, 110, 66, 49, 5,
-			165, 44, 227, 178, 106, 13, 186, 125, 217, 58, 42, 33, 195, 50,
-			46, 31, 163, 228, 219, 176, 53, 195, 50, 22, 244, 241, 220, 2,
-			69, 207, 7, 166, 46, 162, 115, 71, 191, 184, 81, 209, 7, 121,
-			249, 69, 186, 245, 113, 121, 41, 233, 186, 172, 233, 134, 9, 117,
-			42, 40, 101, 25, 11, 253, 89, 9, 105, 150, 177, 48, 146, 151,
-			16, 180, 126, 106, 140, 60, 139, 93, 49, 45, 227, 170, 126, 79,
-			174, 185, 187, 43, 59, 204, 190, 113, 123, 29, 225, 49, 40, 132,
-			168, 52, 233, 136, 251, 241, 180, 233, 52, 248, 173, 121, 116, 22,
-			45, 210, 5, 95, 94, 167, 84, 125, 54, 177, 113, 5, 165, 44,
-			227, 170, 234, 179, 169, 89, 198, 213, 17, 42, 33, 195, 50, 174,
-			158, 56, 69, 46, 96, 159, 209, 207, 188, 144, 155, 64, 105, 63,
-			242, 91, 34, 22, 69, 146, 187, 38, 121, 176, 106, 47, 101, 66,
-			65, 5, 165, 45, 227, 90, 127, 78, 66, 154, 101, 92, 59, 50,
-			38, 33, 195, 50, 174, 157, 62, 131, 171, 78, 211, 211, 150, 241,
-			168, 62, 41, 62, 165, 77, 128, 100, 37, 105, 248, 38, 86, 157,
-			166, 167, 53, 203, 120, 244, 216, 184, 132, 12, 203, 120, 244, 76,
-			65, 84, 210, 107, 25, 139, 122, 81, 124, 234, 53, 1, 146, 149,
-			244, 166, 45, 99, 177, 255, 184, 132, 52, 203, 88, 204, 79, 72,
-			200, 176, 140, 197, 194, 164, 168, 36, 99, 25, 215, 85, 37, 25,
-			19, 32, 89, 73, 38, 109, 25, 215, 251, 143, 73, 72, 179, 140,
-			235, 84, 86, 146, 49, 44, 227, 186, 170, 164, 207, 50, 150, 245,
-			19, 226, 83, 159, 9, 144, 172, 164, 47, 109, 25, 203, 253, 114,
-			25, 246, 105, 150, 177, 124, 72, 14, 174, 207, 176, 140, 229, 227,
-			121, 242, 223, 53, 172, 133, 88, 198, 186, 62, 149, 251, 51, 141,
-			223, 229, 66, 255, 66, 201, 218, 66, 21, 61, 176, 107, 207, 177,
-			55, 97, 175, 1, 18, 82, 251, 111, 66, 119, 73, 220, 154, 13,
-			237, 58, 195, 91, 91, 77, 208, 94, 112, 34, 153, 23, 57, 1,
-			19, 205, 200, 109, 107, 203, 14, 48, 184, 138, 184, 235, 72, 104,
-			189, 237, 137, 128, 136, 137, 107, 86, 241, 54, 17, 210, 201, 73,
-			126, 19, 43, 209, 171, 56, 220, 49, 122, 53, 195, 206, 47, 244,
-			196, 38, 198, 198, 244, 125, 55, 84, 36, 68, 76, 24, 182, 130,
-			210, 150, 177, 222, 47, 57, 10, 209, 44, 99, 61, 119, 90, 66,
-			134, 101, 172, 79, 22, 201, 83, 136, 173, 126, 238, 16, 191, 194,
-			175, 132, 162, 199, 167, 90, 244, 9, 134, 203, 63, 243, 80, 49,
-			76, 221, 110, 194, 224, 205, 152, 109, 134, 199, 64, 224, 192, 185,
-			188, 234, 86, 191, 9, 245, 43, 40, 101, 25, 143, 43, 70, 212,
-			175, 89, 198, 227, 214, 168, 132, 12, 203, 120, 252, 200, 93, 100,
-			140, 223, 206, 121, 170, 231, 121, 45, 119, 164, 107, 43, 16, 114,
-			12, 198, 104, 72, 92, 88, 121, 42, 115, 72, 220, 222, 232, 177,
-			140, 55, 8, 15, 110, 29, 153, 250, 27, 244, 228, 205, 145, 55,
-			244, 143, 36, 110, 142, 188, 65, 48, 117, 126, 115, 228, 13, 135,
-			115, 241, 205, 145, 167, 245, 211, 242, 118, 136, 9, 144, 172, 68,
-			75, 91, 198, 211, 130, 146, 249, 61, 146, 167, 233, 169, 196, 61,
-			146, 167, 199, 39, 226, 123, 36, 182, 88, 14, 58, 50, 99, 91,
-			85, 2, 139, 214, 86, 149, 64, 115, 182, 88, 14, 252, 86, 137,
-			45, 150, 3, 2, 85, 253, 140, 188, 229, 97, 2, 164, 238, 131,
-			164, 45, 163, 42, 88, 4, 191, 15, 82, 61, 114, 79, 226, 62,
-			72, 117, 226, 180, 168, 196, 180, 140, 154, 96, 17, 58, 242, 181,
-			154, 170, 196, 76, 91, 70, 77, 176, 8, 29, 249, 90, 77, 176,
-			8, 29, 249, 90, 237, 76, 129, 12, 64, 37, 70, 143, 101, 214,
-			245, 27, 6, 255, 102, 0, 246, 234, 100, 148, 28, 33, 105, 128,
-			0, 237, 13, 243, 174, 252, 0, 119, 194, 23, 49, 219, 134, 72,
-			47, 255, 104, 194, 215, 129, 24, 78, 89, 70, 99, 208, 138, 97,
-			205, 50, 26, 7, 70, 99, 216, 176, 140, 198, 145, 163, 170, 114,
-			205, 50, 182, 204, 35, 249, 1, 90, 186, 185, 183, 114, 152, 158,
-			173, 68, 229, 176, 173, 110, 37, 42, 135, 41, 218, 58, 112, 103,
-			12, 27, 150, 177, 117, 56, 71, 6, 69, 229, 186, 101, 60, 99,
-			78, 169, 207, 128, 172, 103, 18, 213, 193, 84, 61, 51, 152, 143,
-			97, 205, 50, 158, 57, 113, 58, 134, 13, 203, 120, 102, 178, 40,
-			48, 157, 178, 12, 87, 205, 57, 112, 116, 87, 97, 26, 56, 186,
-			43, 150, 163, 142, 28, 221, 205, 201, 57, 7, 142, 238, 170, 57,
-			79, 91, 134, 167, 79, 137, 79, 192, 209, 61, 85, 9, 112, 116,
-			79, 17, 14, 112, 116, 143, 74, 58, 5, 142, 238, 169, 158, 244,
-			90, 70, 75, 151, 228, 0, 28, 189, 165, 42, 1, 142, 222, 82,
-			61, 1, 142, 222, 202, 29, 151, 144, 97, 25, 173, 147, 167, 200,
-			71, 240, 246, 151, 161, 89, 102, 91, 191, 105, 228, 254, 127, 26,
-			173, 200, 219, 35, 210, 116, 69, 35, 187, 65, 185, 55, 84, 88,
-			164, 149, 125, 82, 85, 152, 2, 105, 118, 0, 246, 37, 46, 142,
-			225, 133, 86, 97, 20, 150, 247, 77, 196, 22, 142, 151, 210, 197,
-			66, 79, 84, 44, 50, 53, 237, 14, 26, 138, 168, 191, 205, 2,
-			215, 110, 201, 251, 81, 6, 76, 116, 155, 28, 18, 84, 131, 194,
-			224, 246, 45, 72, 146, 139, 123, 219, 106, 154, 185, 192, 183, 173,
-			168, 134, 139, 124, 219, 138, 36, 185, 208, 183, 173, 72, 18, 197,
-			190, 157, 91, 144, 36, 151, 243, 118, 18, 149, 3, 73, 238, 36,
-			42, 135, 158, 238, 40, 146, 228, 210, 222, 142, 98, 62, 25, 203,
-			232, 232, 5, 49, 31, 176, 141, 118, 212, 204, 193, 54, 218, 233,
-			31, 149, 144, 102, 25, 157, 195, 99, 18, 50, 44, 163, 115, 250,
-			12, 249, 110, 126, 111, 175, 207, 50, 222, 164, 159, 202, 181, 99,
-			252, 241, 221, 9, 13, 68, 50, 14, 239, 222, 249, 81, 247, 250,
-			246, 153, 10, 80, 255, 248, 61, 117, 180, 64, 97, 4, 9, 220,
-			148, 88, 76, 19, 190, 87, 141, 111, 172, 193, 246, 253, 38, 213,
-			249, 190, 148, 101, 188, 73, 177, 95, 216, 190, 223, 100, 73, 74,
-			134, 237, 251, 77, 249, 147, 164, 31, 175, 175, 165, 190, 179, 231,
-			251, 52, 77, 221, 220, 250, 206, 204, 93, 100, 89, 220, 220, 50,
-			191, 75, 211, 103, 115, 115, 50, 250, 26, 198, 205, 141, 124, 12,
-			18, 32, 162, 11, 56, 17, 173, 249, 44, 4, 85, 41, 96, 85,
-			191, 225, 57, 207, 50, 186, 197, 2, 86, 228, 241, 147, 132, 96,
-			58, 200, 175, 106, 153, 88, 163, 2, 211, 0, 246, 223, 45, 65,
-			13, 192, 99, 231, 36, 104, 0, 120, 225, 34, 121, 2, 58, 150,
-			178, 204, 239, 213, 244, 195, 185, 235, 116, 30, 61, 203, 66, 212,
-			172, 80, 204, 99, 180, 218, 14, 35, 191, 25, 247, 201, 139, 137,
-			93, 8, 177, 78, 24, 147, 120, 178, 95, 192, 108, 141, 84, 15,
-			84, 62, 56, 202, 27, 78, 65, 63, 190, 87, 27, 28, 145, 160,
-			14, 224, 29, 163, 228, 28, 94, 240, 74, 191, 93, 235, 249, 130,
-			166, 229, 78, 117, 109, 148, 177, 44, 130, 23, 80, 213, 28, 146,
-			126, 126, 81, 203, 124, 187, 150, 57, 74, 134, 136, 105, 154, 102,
-			143, 149, 254, 126, 77, 255, 105, 205, 192, 6, 76, 80, 235, 204,
-			239, 215, 122, 251, 201, 42, 73, 155, 34, 84, 234, 15, 106, 230,
-			193, 220, 60, 157, 230, 49, 147, 37, 73, 193, 42, 14, 2, 140,
-			108, 66, 151, 3, 140, 79, 16, 210, 29, 230, 4, 252, 27, 191,
-			39, 142, 247, 32, 3, 102, 135, 120, 235, 144, 12, 147, 94, 94,
-			169, 134, 181, 14, 199, 9, 58, 36, 88, 7, 200, 144, 104, 86,
-			179, 204, 119, 106, 230, 1, 149, 65, 227, 9, 67, 113, 130, 14,
-			9, 35, 22, 217, 17, 37, 116, 203, 124, 65, 51, 15, 228, 26,
-			116, 201, 143, 232, 19, 78, 227, 9, 187, 33, 35, 119, 23, 169,
-			138, 110, 170, 24, 84, 100, 223, 96, 244, 236, 52, 197, 24, 151,
-			69, 74, 215, 67, 70, 19, 14, 196, 212, 169, 19, 170, 226, 154,
-			38, 4, 30, 215, 185, 193, 220, 78, 98, 48, 208, 215, 23, 146,
-			93, 227, 93, 25, 177, 212, 96, 12, 203, 252, 97, 205, 60, 168,
-			50, 0, 111, 253, 225, 228, 240, 13, 29, 18, 172, 3, 106, 48,
-			166, 101, 190, 231, 155, 54, 152, 115, 51, 183, 63, 24, 32, 143,
-			247, 36, 7, 3, 194, 216, 123, 146, 131, 73, 89, 230, 123, 53,
-			243, 14, 149, 33, 165, 97, 66, 54, 78, 208, 33, 225, 192, 65,
-			85, 34, 109, 153, 63, 146, 44, 145, 214, 48, 33, 46, 145, 214,
-			33, 33, 81, 162, 215, 50, 223, 167, 153, 150, 202, 208, 171, 97,
-			194, 96, 156, 160, 67, 66, 118, 68, 149, 200, 88, 230, 139, 73,
-			20, 103, 52, 76, 136, 81, 156, 209, 33, 193, 58, 64, 62, 171,
-			137, 34, 125, 150, 249, 65, 160, 236, 63, 208, 232, 154, 221, 152,
-			172, 49, 12, 212, 194, 106, 84, 29, 129, 22, 9, 189, 26, 248,
-			237, 56, 16, 124, 124, 38, 143, 226, 46, 240, 207, 88, 40, 118,
-			60, 17, 218, 187, 72, 31, 241, 119, 216, 54, 11, 10, 220, 140,
-			119, 142, 96, 72, 25, 166, 78, 4, 66, 25, 240, 155, 135, 209,
-			221, 100, 42, 234, 55, 242, 53, 100, 191, 13, 108, 120, 7, 117,
-			11, 30, 192, 13, 131, 201, 68, 1, 179, 35, 241, 81, 240, 107,
-			59, 164, 109, 239, 134, 231, 239, 120, 34, 37, 49, 157, 125, 26,
-			14, 50, 158, 206, 62, 29, 18, 70, 14, 144, 73, 129, 5, 98,
-			153, 255, 76, 51, 239, 204, 223, 69, 23, 241, 66, 253, 254, 120,
-			80, 229, 137, 134, 249, 227, 185, 35, 58, 36, 28, 184, 131, 156,
-			16, 21, 246, 91, 230, 143, 3, 90, 15, 208, 37, 182, 3, 72,
-			217, 86, 33, 141, 226, 106, 250, 53, 204, 21, 247, 171, 95, 135,
-			132, 145, 152, 1, 12, 88, 230, 79, 36, 137, 102, 64, 195, 132,
-			120, 66, 7, 116, 72, 176, 98, 162, 25, 180, 204, 159, 76, 178,
-			140, 65, 13, 19, 98, 162, 25, 212, 33, 33, 27, 147, 242, 144,
-			101, 126, 88, 51, 15, 169, 12, 67, 26, 38, 140, 196, 9, 58,
-			36, 28, 188, 83, 149, 24, 182, 204, 255, 127, 178, 196, 176, 134,
-			9, 113, 137, 97, 29, 18, 14, 222, 73, 198, 68, 137, 172, 101,
-			190, 164, 153, 119, 228, 15, 193, 154, 12, 187, 150, 50, 55, 220,
-			201, 146, 89, 13, 115, 198, 3, 204, 234, 144, 96, 29, 84, 85,
-			141, 88, 230, 79, 221, 86, 85, 35, 26, 230, 140, 171, 26, 209,
-			33, 1, 113, 5, 76, 95, 179, 210, 63, 163, 233, 191, 160, 152,
-			62, 48, 215, 159, 209, 122, 7, 200, 105, 108, 9, 228, 39, 243,
-			231, 52, 243, 80, 46, 119, 75, 166, 47, 27, 67, 113, 9, 50,
-			91, 113, 130, 14, 9, 119, 72, 164, 129, 192, 100, 254, 124, 140,
-			52, 148, 129, 32, 33, 46, 1, 188, 252, 231, 147, 37, 116, 203,
-			252, 104, 178, 4, 84, 241, 209, 100, 9, 158, 227, 142, 59, 113,
-			207, 52, 161, 191, 191, 164, 233, 71, 248, 112, 112, 103, 255, 37,
-			185, 179, 155, 24, 77, 251, 151, 180, 254, 17, 9, 106, 0, 90,
-			119, 74, 208, 0, 240, 112, 78, 212, 164, 89, 230, 47, 107, 250,
-			81, 241, 81, 51, 17, 148, 53, 105, 41, 0, 251, 179, 18, 196,
-			204, 35, 135, 36, 104, 0, 152, 59, 34, 106, 210, 45, 243, 99,
-			113, 159, 128, 163, 127, 44, 174, 9, 120, 226, 199, 226, 154, 160,
-			217, 143, 105, 35, 178, 79, 176, 97, 124, 12, 250, 244, 126, 13,
-			171, 50, 44, 243, 127, 5, 121, 227, 7, 53, 90, 174, 83, 117,
-			23, 10, 166, 70, 92, 173, 23, 182, 2, 41, 209, 133, 44, 42,
-			82, 200, 139, 215, 242, 121, 100, 86, 175, 22, 151, 36, 201, 107,
-			249, 78, 28, 166, 4, 223, 179, 170, 83, 117, 27, 167, 64, 147,
-			119, 121, 64, 112, 143, 239, 250, 20, 229, 88, 12, 19, 187, 167,
-			192, 52, 128, 253, 195, 18, 212, 0, 204, 30, 148, 32, 142, 229,
-			208, 40, 249, 85, 29, 135, 102, 90, 230, 175, 107, 58, 205, 253,
-			148, 142, 198, 58, 169, 227, 67, 111, 153, 215, 110, 98, 151, 67,
-			209, 75, 17, 67, 85, 158, 129, 98, 164, 154, 78, 139, 241, 145,
-			202, 47, 132, 7, 3, 13, 101, 124, 168, 177, 226, 88, 65, 68,
-			68, 170, 183, 93, 183, 51, 249, 198, 182, 237, 58, 117, 7, 247,
-			209, 68, 216, 160, 249, 51, 103, 38, 97, 55, 164, 97, 213, 199,
-			199, 65, 48, 10, 16, 223, 37, 229, 185, 105, 221, 17, 135, 191,
-			184, 31, 240, 55, 43, 234, 78, 16, 114, 219, 17, 191, 70, 202,
-			123, 28, 71, 51, 117, 66, 18, 143, 10, 145, 110, 7, 213, 45,
-			86, 19, 209, 121, 246, 68, 61, 45, 80, 223, 19, 15, 97, 224,
-			205, 120, 223, 199, 71, 193, 132, 251, 242, 132, 194, 186, 201, 49,
-			167, 192, 20, 128, 138, 200, 97, 27, 255, 117, 205, 146, 212, 103,
-			26, 0, 222, 125, 140, 124, 7, 34, 61, 101, 153, 191, 165, 233,
-			199, 114, 45, 17, 92, 72, 138, 167, 175, 140, 103, 186, 201, 28,
-			175, 33, 30, 6, 67, 244, 149, 121, 216, 96, 12, 34, 191, 205,
-			119, 192, 88, 115, 176, 61, 143, 5, 176, 57, 41, 170, 83, 125,
-			79, 153, 216, 1, 5, 98, 127, 84, 223, 65, 160, 248, 45, 205,
-			202, 73, 208, 0, 240, 174, 187, 201, 47, 114, 138, 73, 91, 230,
-			239, 104, 250, 201, 220, 143, 115, 138, 17, 225, 54, 36, 161, 40,
-			67, 95, 151, 53, 47, 98, 55, 163, 248, 129, 55, 187, 59, 116,
-			143, 219, 102, 194, 84, 188, 233, 251, 46, 179, 1, 15, 249, 40,
-			104, 179, 60, 16, 124, 30, 221, 241, 242, 34, 135, 120, 77, 107,
-			87, 59, 226, 62, 33, 111, 70, 5, 113, 29, 135, 197, 40, 3,
-			253, 130, 36, 238, 117, 232, 142, 221, 153, 144, 141, 129, 168, 182,
-			171, 162, 121, 149, 159, 119, 139, 251, 141, 96, 78, 250, 208, 131,
-			244, 236, 204, 253, 60, 10, 23, 207, 84, 36, 116, 109, 121, 97,
-			121, 156, 31, 48, 78, 204, 242, 115, 196, 201, 11, 231, 133, 164,
-			248, 176, 68, 112, 218, 68, 156, 41, 48, 5, 160, 194, 55, 136,
-			99, 191, 163, 89, 199, 36, 104, 0, 152, 63, 65, 190, 135, 51,
-			159, 94, 203, 252, 125, 77, 63, 158, 219, 134, 85, 134, 44, 3,
-			20, 65, 17, 56, 202, 171, 177, 155, 60, 138, 25, 94, 152, 148,
-			20, 144, 60, 73, 234, 180, 216, 88, 72, 227, 139, 203, 132, 27,
-			231, 105, 210, 60, 235, 112, 251, 30, 200, 178, 124, 94, 236, 136,
-			151, 80, 36, 211, 107, 98, 55, 20, 152, 2, 80, 241, 79, 144,
-			15, 127, 95, 27, 145, 108, 187, 215, 0, 240, 24, 37, 95, 229,
-			67, 200, 88, 230, 31, 194, 16, 62, 175, 241, 87, 87, 98, 202,
-			150, 61, 224, 143, 201, 240, 40, 73, 156, 171, 130, 66, 188, 231,
-			4, 173, 40, 56, 13, 1, 150, 16, 208, 45, 155, 103, 181, 105,
-			94, 221, 16, 205, 11, 205, 143, 250, 94, 162, 254, 130, 24, 19,
-			126, 26, 19, 47, 36, 16, 30, 149, 76, 104, 224, 197, 36, 55,
-			114, 162, 49, 144, 54, 121, 124, 114, 30, 246, 108, 75, 24, 153,
-			199, 66, 222, 255, 205, 142, 12, 200, 139, 220, 202, 193, 224, 44,
-			85, 187, 201, 220, 121, 30, 229, 143, 227, 34, 99, 226, 224, 21,
-			152, 2, 80, 205, 61, 136, 201, 127, 24, 243, 137, 140, 1, 224,
-			221, 84, 108, 97, 125, 150, 249, 41, 77, 63, 41, 62, 246, 153,
-			8, 202, 154, 250, 210, 0, 246, 203, 221, 15, 36, 205, 79, 105,
-			163, 146, 138, 250, 12, 0, 243, 39, 200, 47, 247, 97, 85, 196,
-			50, 255, 171, 166, 159, 202, 253, 100, 31, 162, 48, 104, 179, 152,
-			217, 168, 39, 113, 242, 210, 58, 159, 47, 242, 64, 192, 234, 139,
-			34, 19, 153, 163, 128, 209, 240, 162, 192, 174, 222, 8, 69, 52,
-			182, 42, 163, 32, 174, 6, 53, 140, 66, 137, 39, 101, 80, 72,
-			152, 139, 121, 133, 187, 174, 220, 34, 171, 139, 251, 194, 11, 200,
-			205, 112, 147, 225, 11, 91, 120, 144, 205, 169, 59, 242, 9, 198,
-			113, 6, 142, 226, 83, 223, 173, 201, 238, 85, 133, 25, 0, 39,
-			89, 245, 6, 43, 71, 95, 127, 17, 232, 39, 73, 109, 192, 56,
-			197, 154, 9, 41, 151, 222, 241, 121, 169, 124, 216, 241, 162, 45,
-			22, 57, 213, 60, 255, 94, 16, 46, 71, 123, 250, 231, 68, 33,
-			13, 125, 23, 29, 177, 112, 229, 140, 51, 187, 186, 37, 187, 164,
-			134, 200, 11, 53, 100, 252, 54, 104, 72, 53, 193, 91, 152, 40,
-			210, 85, 153, 34, 58, 21, 242, 128, 81, 241, 201, 154, 60, 169,
-			64, 179, 15, 239, 82, 141, 31, 156, 201, 107, 103, 200, 223, 230,
-			86, 202, 251, 85, 166, 228, 139, 160, 198, 2, 208, 44, 234, 17,
-			236, 13, 174, 75, 243, 1, 179, 93, 49, 210, 80, 62, 90, 36,
-			247, 75, 174, 206, 20, 246, 204, 154, 52, 242, 84, 65, 25, 226,
-			13, 135, 172, 105, 123, 48, 34, 238, 147, 87, 32, 226, 229, 22,
-			207, 247, 38, 3, 214, 98, 168, 179, 117, 215, 43, 95, 117, 194,
-			57, 82, 179, 166, 148, 55, 88, 81, 168, 159, 145, 93, 47, 75,
-			169, 166, 106, 44, 178, 29, 23, 40, 77, 6, 75, 132, 207, 200,
-			25, 118, 2, 63, 98, 9, 122, 134, 157, 196, 243, 249, 219, 1,
-			78, 40, 253, 38, 218, 33, 171, 183, 93, 36, 142, 192, 111, 123,
-			181, 201, 40, 112, 240, 60, 63, 113, 254, 206, 15, 96, 16, 45,
-			24, 8, 50, 68, 183, 105, 186, 195, 8, 242, 225, 61, 99, 218,
-			61, 185, 24, 58, 172, 64, 25, 198, 152, 220, 194, 152, 146, 92,
-			26, 130, 185, 147, 161, 90, 249, 187, 69, 187, 240, 176, 38, 150,
-			39, 67, 119, 47, 12, 24, 23, 199, 148, 148, 94, 11, 81, 108,
-			140, 171, 218, 222, 24, 172, 73, 230, 186, 212, 169, 43, 59, 148,
-			147, 60, 187, 225, 207, 33, 128, 100, 87, 160, 161, 143, 111, 22,
-			0, 105, 136, 153, 144, 243, 73, 118, 15, 2, 8, 3, 85, 233,
-			153, 93, 116, 29, 74, 42, 4, 54, 204, 215, 136, 107, 55, 10,
-			201, 238, 117, 168, 237, 6, 204, 174, 117, 212, 52, 146, 184, 18,
-			20, 18, 159, 238, 190, 29, 254, 180, 226, 157, 196, 68, 174, 165,
-			192, 20, 128, 74, 178, 5, 85, 248, 191, 106, 89, 201, 241, 136,
-			1, 96, 254, 36, 201, 19, 144, 198, 210, 127, 173, 245, 252, 119,
-			77, 203, 29, 236, 50, 205, 201, 209, 244, 19, 195, 4, 57, 231,
-			175, 181, 204, 81, 100, 182, 41, 208, 97, 190, 40, 245, 133, 20,
-			234, 48, 95, 148, 77, 167, 80, 135, 249, 162, 100, 219, 41, 212,
-			97, 190, 40, 117, 152, 20, 234, 48, 95, 148, 58, 76, 10, 148,
-			137, 47, 73, 182, 157, 66, 29, 230, 75, 113, 77, 90, 26, 64,
-			193, 182, 83, 168, 195, 124, 73, 178, 237, 20, 234, 48, 95, 2,
-			182, 61, 70, 116, 51, 109, 165, 255, 70, 235, 121, 65, 215, 114,
-			135, 147, 131, 240, 98, 201, 92, 140, 4, 36, 136, 191, 209, 50,
-			92, 243, 73, 195, 72, 190, 34, 71, 146, 198, 145, 124, 69, 182,
-			159, 198, 145, 124, 69, 142, 36, 141, 35, 249, 138, 28, 73, 26,
-			71, 242, 21, 57, 146, 52, 140, 228, 171, 154, 94, 20, 31, 97,
-			36, 95, 141, 107, 130, 145, 124, 85, 235, 63, 33, 65, 204, 124,
-			114, 66, 130, 6, 128, 133, 73, 81, 147, 110, 153, 127, 167, 233,
-			50, 47, 168, 29, 127, 23, 215, 4, 50, 229, 223, 105, 253, 178,
-			19, 208, 236, 223, 105, 135, 238, 150, 160, 1, 224, 241, 60, 249,
-			40, 8, 160, 105, 163, 199, 74, 127, 143, 174, 191, 85, 55, 114,
-			31, 212, 247, 57, 26, 145, 2, 41, 183, 178, 37, 14, 49, 100,
-			72, 200, 125, 14, 70, 152, 23, 5, 206, 174, 83, 16, 64, 242,
-			190, 71, 32, 187, 78, 64, 18, 113, 216, 228, 134, 90, 115, 194,
-			200, 241, 170, 17, 151, 28, 94, 49, 22, 11, 111, 210, 142, 112,
-			59, 133, 210, 242, 192, 132, 134, 109, 12, 217, 135, 159, 144, 97,
-			217, 173, 86, 224, 183, 2, 199, 142, 248, 25, 184, 140, 129, 10,
-			61, 22, 167, 224, 142, 23, 157, 155, 33, 180, 230, 55, 109, 199,
-			19, 203, 40, 109, 192, 28, 127, 143, 78, 142, 144, 163, 36, 13,
-			32, 16, 200, 247, 234, 123, 206, 103, 64, 183, 79, 243, 35, 67,
-			248, 60, 16, 39, 164, 32, 97, 208, 138, 19, 52, 72, 56, 48,
-			26, 39, 24, 144, 112, 228, 168, 106, 65, 179, 204, 239, 211, 241,
-			144, 102, 191, 22, 128, 144, 190, 47, 217, 2, 40, 246, 223, 151,
-			108, 65, 195, 10, 14, 220, 25, 39, 24, 144, 112, 56, 199, 79,
-			199, 210, 64, 19, 63, 160, 235, 211, 251, 159, 142, 221, 146, 4,
-			186, 63, 236, 38, 5, 2, 50, 29, 174, 45, 241, 125, 55, 57,
-			80, 144, 159, 109, 241, 230, 195, 173, 72, 131, 36, 104, 131, 19,
-			48, 40, 232, 63, 160, 43, 106, 7, 5, 253, 7, 244, 254, 163,
-			18, 212, 0, 188, 235, 140, 4, 113, 100, 197, 41, 226, 226, 56,
-			77, 203, 252, 33, 93, 63, 153, 123, 67, 220, 92, 220, 195, 91,
-			158, 40, 5, 140, 75, 180, 251, 30, 26, 145, 253, 78, 141, 120,
-			227, 38, 111, 78, 129, 41, 0, 21, 175, 0, 165, 246, 135, 116,
-			161, 168, 164, 81, 169, 253, 33, 61, 127, 130, 156, 37, 186, 217,
-			107, 165, 223, 173, 247, 188, 168, 239, 246, 31, 227, 253, 148, 103,
-			33, 156, 117, 9, 174, 5, 74, 195, 187, 245, 12, 23, 118, 123,
-			129, 40, 223, 163, 11, 174, 213, 139, 68, 248, 30, 217, 147, 94,
-			36, 193, 247, 200, 158, 244, 34, 1, 190, 71, 23, 92, 171, 23,
-			201, 239, 61, 186, 224, 90, 189, 64, 124, 239, 213, 133, 13, 169,
-			23, 137, 237, 189, 113, 77, 64, 106, 239, 213, 133, 230, 210, 139,
-			132, 246, 94, 93, 216, 144, 122, 145, 204, 222, 171, 11, 27, 82,
-			47, 112, 173, 247, 233, 250, 184, 248, 8, 147, 241, 190, 184, 38,
-			224, 90, 239, 211, 251, 101, 143, 161, 217, 247, 233, 71, 79, 72,
-			208, 0, 240, 158, 49, 114, 146, 232, 102, 198, 74, 191, 95, 239,
-			249, 49, 93, 203, 221, 217, 133, 29, 113, 25, 93, 32, 4, 148,
-			129, 247, 235, 153, 187, 177, 241, 12, 32, 228, 3, 18, 33, 25,
-			68, 200, 7, 100, 227, 25, 68, 200, 7, 36, 66, 50, 136, 144,
-			15, 72, 132, 100, 16, 33, 31, 144, 8, 201, 64, 207, 62, 168,
-			235, 5, 241, 17, 16, 242, 193, 184, 38, 96, 227, 31, 212, 251,
-			169, 4, 49, 243, 241, 49, 9, 26, 0, 158, 62, 35, 106, 210,
-			45, 243, 67, 186, 126, 143, 248, 8, 8, 249, 80, 92, 19, 32,
-			228, 67, 122, 255, 97, 9, 106, 0, 230, 142, 75, 208, 0, 240,
-			228, 41, 50, 77, 116, 179, 207, 74, 255, 164, 222, 243, 75, 186,
-			150, 203, 239, 242, 49, 137, 182, 252, 154, 124, 48, 47, 137, 28,
-			208, 111, 126, 82, 207, 220, 133, 29, 233, 3, 228, 124, 88, 34,
-			167, 15, 145, 243, 97, 217, 145, 62, 68, 206, 135, 37, 114, 250,
-			16, 57, 31, 150, 200, 233, 67, 228, 124, 24, 144, 243, 35, 26,
-			86, 165, 89, 230, 79, 235, 250, 241, 220, 219, 52, 90, 246, 208,
-			55, 211, 171, 73, 55, 77, 180, 187, 224, 42, 67, 133, 153, 133,
-			220, 136, 180, 175, 197, 101, 199, 238, 80, 59, 36, 116, 223, 112,
-			74, 202, 8, 195, 31, 116, 17, 55, 33, 196, 211, 77, 123, 124,
-			107, 120, 71, 97, 162, 126, 58, 30, 21, 80, 238, 79, 199, 163,
-			210, 176, 223, 214, 81, 9, 26, 0, 30, 163, 2, 63, 186, 101,
-			126, 68, 215, 243, 226, 35, 76, 212, 71, 226, 154, 244, 20, 128,
-			170, 38, 192, 192, 71, 116, 235, 46, 9, 26, 0, 210, 227, 162,
-			38, 195, 50, 127, 86, 215, 79, 137, 143, 192, 203, 126, 54, 174,
-			9, 120, 217, 207, 234, 253, 163, 18, 212, 0, 60, 76, 37, 136,
-			101, 79, 156, 36, 37, 172, 201, 180, 204, 143, 234, 250, 125, 185,
-			251, 104, 57, 142, 53, 235, 212, 133, 246, 38, 222, 196, 2, 101,
-			133, 71, 215, 144, 233, 210, 127, 90, 182, 105, 242, 122, 20, 152,
-			2, 80, 72, 133, 125, 200, 164, 62, 170, 103, 101, 23, 128, 73,
-			125, 20, 186, 32, 192, 12, 128, 167, 46, 72, 176, 23, 192, 233,
-			123, 69, 7, 83, 150, 249, 139, 251, 117, 144, 71, 35, 217, 219,
-			65, 145, 190, 187, 131, 41, 19, 235, 81, 32, 86, 171, 58, 8,
-			98, 231, 47, 198, 29, 76, 25, 0, 170, 14, 166, 50, 0, 170,
-			14, 166, 122, 1, 156, 190, 151, 124, 116, 136, 232, 38, 177, 210,
-			255, 65, 239, 249, 183, 134, 54, 179, 68, 31, 252, 135, 255, 201,
-			183, 112, 66, 50, 243, 111, 7, 105, 9, 148, 87, 229, 25, 23,
-			123, 76, 242, 155, 66, 234, 53, 163, 188, 12, 79, 67, 237, 136,
-			223, 154, 75, 174, 12, 66, 159, 65, 125, 66, 221, 107, 11, 19,
-			155, 19, 87, 9, 240, 58, 82, 228, 195, 38, 36, 85, 215, 26,
-			13, 93, 167, 177, 21, 185, 157, 56, 80, 62, 236, 83, 160, 138,
-			218, 29, 105, 205, 162, 91, 14, 232, 248, 117, 212, 196, 106, 66,
-			228, 106, 218, 158, 211, 106, 99, 172, 219, 216, 92, 36, 39, 4,
-			164, 51, 233, 33, 0, 21, 237, 239, 33, 96, 135, 123, 60, 4,
-			24, 61, 45, 80, 147, 168, 75, 140, 50, 145, 87, 238, 184, 29,
-			134, 187, 174, 176, 34, 112, 71, 109, 249, 46, 42, 234, 89, 221,
-			90, 26, 10, 9, 252, 113, 56, 101, 1, 149, 194, 70, 179, 72,
-			105, 217, 11, 35, 102, 215, 184, 2, 236, 139, 176, 252, 77, 30,
-			205, 90, 232, 116, 94, 87, 39, 99, 11, 183, 120, 110, 97, 191,
-			171, 195, 197, 164, 193, 15, 249, 15, 206, 167, 98, 95, 226, 92,
-			2, 159, 38, 64, 211, 192, 94, 20, 208, 199, 24, 183, 154, 39,
-			222, 62, 65, 19, 143, 79, 91, 62, 159, 5, 110, 157, 75, 224,
-			8, 159, 187, 219, 145, 207, 224, 217, 9, 83, 70, 1, 80, 197,
-			169, 163, 229, 59, 94, 132, 141, 226, 28, 134, 188, 107, 155, 140,
-			121, 132, 227, 141, 159, 247, 134, 62, 230, 73, 212, 14, 204, 24,
-			209, 94, 196, 119, 205, 165, 83, 147, 60, 150, 71, 90, 233, 158,
-			109, 113, 21, 171, 186, 229, 135, 12, 77, 62, 252, 34, 89, 56,
-			75, 232, 105, 212, 242, 101, 70, 222, 51, 180, 16, 74, 87, 27,
-			212, 23, 148, 95, 135, 136, 145, 45, 158, 88, 225, 54, 199, 128,
-			80, 74, 253, 160, 97, 123, 206, 179, 226, 126, 159, 31, 136, 135,
-			59, 90, 44, 112, 240, 8, 218, 149, 109, 20, 16, 145, 194, 57,
-			72, 116, 249, 222, 233, 233, 233, 105, 168, 37, 218, 10, 208, 90,
-			112, 17, 254, 164, 177, 95, 156, 76, 116, 252, 54, 191, 71, 22,
-			182, 3, 177, 16, 32, 169, 230, 39, 94, 166, 193, 135, 28, 248,
-			204, 98, 213, 216, 11, 197, 186, 68, 15, 138, 175, 48, 234, 77,
-			225, 119, 30, 110, 9, 244, 227, 240, 185, 43, 58, 250, 195, 170,
-			218, 160, 37, 199, 147, 238, 223, 17, 106, 35, 145, 3, 146, 41,
-			155, 108, 218, 142, 152, 213, 205, 118, 125, 178, 129, 111, 65, 79,
-			170, 25, 156, 12, 88, 195, 9, 163, 160, 147, 184, 158, 131, 131,
-			247, 165, 132, 154, 112, 85, 83, 14, 111, 171, 78, 179, 229, 118,
-			84, 16, 245, 142, 223, 198, 139, 116, 248, 36, 52, 138, 186, 120,
-			137, 5, 223, 165, 192, 119, 162, 157, 109, 54, 57, 79, 91, 110,
-			187, 225, 120, 19, 56, 148, 174, 34, 59, 108, 19, 223, 206, 24,
-			119, 234, 212, 222, 182, 29, 215, 222, 116, 217, 132, 112, 9, 14,
-			216, 88, 72, 61, 159, 191, 222, 32, 174, 239, 221, 196, 103, 199,
-			232, 150, 191, 131, 104, 135, 181, 230, 225, 23, 129, 249, 102, 145,
-			174, 135, 109, 180, 216, 192, 119, 36, 31, 44, 140, 47, 159, 211,
-			61, 67, 42, 162, 131, 51, 247, 97, 2, 5, 131, 237, 153, 39,
-			113, 137, 20, 42, 242, 189, 4, 78, 176, 91, 124, 122, 55, 59,
-			180, 213, 142, 228, 211, 231, 130, 93, 132, 237, 205, 201, 46, 95,
-			39, 60, 229, 224, 43, 66, 46, 239, 144, 223, 122, 161, 126, 157,
-			147, 29, 94, 159, 12, 147, 175, 205, 240, 71, 28, 191, 206, 203,
-			178, 248, 6, 53, 166, 158, 240, 37, 155, 18, 39, 133, 78, 72,
-			163, 118, 0, 204, 182, 29, 137, 173, 128, 243, 15, 224, 9, 248,
-			226, 137, 144, 253, 20, 33, 134, 44, 162, 237, 150, 160, 12, 187,
-			29, 249, 77, 59, 146, 207, 131, 134, 120, 49, 75, 88, 253, 37,
-			141, 112, 161, 145, 104, 150, 249, 31, 244, 204, 1, 238, 155, 78,
-			64, 106, 252, 180, 174, 159, 200, 189, 172, 209, 85, 249, 238, 199,
-			53, 123, 219, 166, 34, 26, 145, 120, 64, 18, 35, 36, 177, 48,
-			177, 63, 137, 35, 0, 39, 148, 86, 65, 209, 49, 66, 91, 174,
-			93, 197, 61, 240, 114, 71, 158, 67, 21, 18, 238, 219, 178, 98,
-			7, 95, 175, 175, 113, 233, 79, 218, 9, 252, 122, 4, 28, 206,
-			241, 18, 170, 189, 50, 157, 117, 149, 87, 118, 54, 207, 15, 154,
-			56, 236, 196, 147, 139, 155, 118, 245, 198, 142, 29, 212, 66, 169,
-			249, 11, 137, 149, 11, 32, 4, 165, 227, 79, 75, 121, 132, 160,
-			116, 252, 105, 41, 253, 17, 148, 142, 63, 173, 91, 119, 75, 208,
-			0, 240, 120, 158, 252, 186, 142, 40, 211, 44, 243, 79, 117, 253,
-			76, 238, 23, 116, 117, 254, 4, 60, 24, 79, 153, 4, 154, 212,
-			249, 72, 242, 106, 26, 222, 87, 13, 236, 150, 60, 124, 19, 236,
-			146, 80, 140, 199, 198, 203, 198, 15, 100, 197, 106, 170, 60, 152,
-			66, 182, 202, 3, 161, 227, 33, 58, 138, 209, 103, 9, 29, 103,
-			111, 108, 59, 219, 182, 203, 93, 240, 249, 49, 163, 91, 163, 249,
-			201, 73, 223, 99, 27, 24, 91, 12, 163, 115, 11, 130, 224, 203,
-			93, 216, 46, 199, 113, 122, 9, 181, 101, 79, 99, 11, 52, 191,
-			63, 23, 114, 105, 92, 190, 126, 0, 125, 228, 51, 38, 31, 254,
-			218, 225, 239, 249, 16, 228, 1, 78, 213, 1, 145, 165, 186, 229,
-			251, 194, 18, 203, 71, 5, 195, 152, 80, 216, 7, 41, 254, 79,
-			99, 236, 131, 20, 255, 167, 49, 246, 53, 196, 175, 117, 143, 4,
-			13, 0, 39, 78, 147, 159, 48, 16, 251, 186, 101, 126, 94, 215,
-			47, 229, 222, 109, 8, 236, 171, 227, 18, 254, 156, 15, 167, 94,
-			20, 135, 4, 177, 226, 69, 115, 215, 77, 28, 11, 208, 144, 181,
-			108, 252, 89, 4, 244, 240, 11, 101, 124, 121, 131, 200, 23, 223,
-			147, 17, 140, 162, 32, 236, 195, 184, 227, 138, 117, 200, 37, 39,
-			37, 120, 36, 175, 166, 225, 132, 181, 249, 107, 55, 33, 83, 199,
-			239, 32, 64, 120, 126, 116, 26, 86, 175, 56, 153, 119, 240, 29,
-			37, 62, 101, 49, 17, 240, 211, 117, 116, 128, 221, 47, 94, 95,
-			145, 198, 222, 92, 187, 74, 242, 86, 164, 15, 23, 73, 44, 87,
-			254, 106, 147, 186, 236, 14, 221, 28, 131, 229, 28, 197, 106, 217,
-			248, 132, 84, 54, 237, 144, 238, 48, 215, 197, 227, 31, 175, 67,
-			18, 8, 73, 8, 26, 187, 16, 32, 174, 15, 241, 73, 3, 197,
-			230, 243, 241, 12, 131, 26, 241, 121, 41, 239, 19, 212, 174, 62,
-			175, 103, 79, 72, 208, 0, 240, 158, 113, 9, 102, 0, 156, 152,
-			149, 96, 47, 128, 231, 47, 146, 9, 156, 126, 195, 50, 191, 160,
-			235, 165, 220, 17, 190, 38, 4, 143, 171, 249, 12, 143, 15, 182,
-			184, 215, 17, 47, 9, 138, 217, 23, 226, 78, 24, 41, 0, 85,
-			39, 64, 49, 251, 130, 158, 157, 148, 32, 86, 60, 61, 35, 193,
-			12, 128, 231, 22, 208, 255, 7, 64, 252, 124, 126, 158, 252, 25,
-			231, 1, 166, 101, 126, 89, 215, 31, 202, 253, 159, 250, 43, 80,
-			225, 204, 43, 147, 161, 18, 217, 9, 74, 54, 59, 220, 144, 141,
-			175, 230, 193, 152, 64, 76, 196, 83, 31, 27, 36, 232, 136, 53,
-			91, 40, 238, 52, 109, 174, 44, 8, 38, 111, 227, 121, 210, 250,
-			218, 149, 201, 251, 9, 30, 203, 211, 144, 189, 177, 141, 135, 125,
-			124, 225, 242, 23, 119, 248, 33, 31, 161, 34, 58, 91, 226, 106,
-			39, 239, 84, 205, 87, 98, 111, 145, 196, 228, 101, 39, 92, 133,
-			133, 84, 198, 137, 203, 174, 66, 55, 195, 184, 241, 238, 182, 67,
-			121, 172, 36, 230, 103, 203, 134, 172, 148, 213, 235, 32, 93, 136,
-			193, 197, 194, 35, 127, 125, 4, 4, 14, 113, 61, 73, 205, 161,
-			201, 17, 173, 192, 20, 128, 106, 14, 65, 179, 253, 178, 158, 149,
-			172, 2, 52, 219, 47, 235, 19, 103, 36, 152, 1, 176, 240, 160,
-			4, 123, 1, 188, 239, 1, 124, 223, 149, 152, 61, 86, 250, 171,
-			186, 254, 255, 232, 70, 238, 1, 170, 2, 148, 40, 6, 46, 142,
-			195, 246, 187, 29, 43, 149, 173, 208, 121, 86, 245, 19, 157, 148,
-			191, 170, 247, 30, 32, 11, 64, 47, 220, 73, 249, 127, 232, 230,
-			80, 254, 188, 170, 60, 190, 56, 138, 197, 161, 66, 161, 21, 21,
-			128, 169, 56, 182, 43, 5, 100, 110, 22, 38, 194, 43, 249, 127,
-			232, 102, 95, 156, 160, 67, 194, 192, 32, 185, 42, 218, 209, 44,
-			243, 107, 186, 105, 229, 210, 252, 110, 103, 126, 10, 29, 109, 227,
-			144, 34, 203, 45, 228, 224, 202, 219, 83, 240, 36, 190, 208, 165,
-			95, 38, 17, 238, 204, 95, 211, 133, 111, 34, 17, 238, 204, 95,
-			211, 179, 35, 170, 41, 221, 50, 255, 94, 55, 239, 200, 223, 151,
-			28, 82, 13, 116, 0, 160, 48, 65, 89, 139, 78, 196, 84, 68,
-			138, 93, 83, 42, 107, 134, 78, 255, 189, 46, 60, 54, 137, 112,
-			79, 254, 123, 253, 192, 65, 180, 175, 16, 224, 22, 111, 54, 244,
-			75, 98, 222, 82, 38, 130, 146, 4, 82, 105, 0, 197, 105, 17,
-			65, 219, 193, 155, 141, 209, 147, 18, 52, 0, 28, 147, 188, 36,
-			149, 1, 80, 241, 146, 84, 47, 128, 231, 47, 146, 207, 242, 85,
-			156, 182, 204, 183, 26, 122, 62, 247, 239, 245, 88, 248, 185, 234,
-			239, 18, 125, 194, 40, 192, 48, 20, 95, 143, 232, 83, 174, 83,
-			159, 135, 47, 41, 236, 169, 84, 200, 111, 201, 168, 22, 221, 225,
-			59, 80, 180, 156, 68, 247, 143, 77, 59, 100, 73, 167, 39, 37,
-			59, 225, 169, 39, 109, 217, 209, 22, 190, 192, 38, 3, 119, 20,
-			121, 209, 132, 219, 70, 178, 84, 24, 217, 17, 39, 130, 174, 77,
-			11, 89, 183, 168, 6, 207, 79, 246, 175, 101, 119, 95, 186, 10,
-			203, 107, 134, 138, 91, 168, 21, 156, 54, 17, 201, 10, 76, 1,
-			168, 54, 251, 180, 6, 160, 48, 217, 17, 244, 244, 121, 171, 113,
-			236, 56, 249, 67, 19, 39, 168, 215, 50, 223, 107, 232, 151, 114,
-			159, 48, 233, 42, 247, 79, 22, 65, 75, 229, 54, 28, 118, 27,
-			77, 28, 143, 239, 224, 242, 17, 204, 135, 41, 205, 139, 32, 166,
-			121, 85, 132, 187, 26, 226, 17, 173, 140, 26, 130, 175, 71, 119,
-			96, 61, 70, 78, 21, 164, 110, 90, 89, 153, 167, 97, 39, 140,
-			208, 252, 176, 134, 103, 182, 65, 178, 37, 188, 228, 204, 8, 69,
-			225, 178, 155, 205, 135, 123, 186, 65, 199, 85, 132, 129, 26, 143,
-			218, 98, 187, 66, 249, 10, 39, 138, 132, 94, 221, 61, 168, 29,
-			38, 44, 33, 168, 223, 220, 112, 60, 52, 0, 75, 209, 67, 180,
-			132, 111, 131, 41, 95, 111, 60, 180, 179, 3, 183, 35, 189, 155,
-			209, 112, 179, 59, 20, 19, 217, 167, 49, 142, 141, 29, 245, 170,
-			35, 171, 37, 189, 202, 29, 143, 214, 237, 109, 31, 189, 160, 248,
-			74, 23, 29, 39, 92, 203, 238, 222, 206, 146, 24, 229, 26, 229,
-			173, 81, 26, 224, 19, 221, 5, 34, 88, 146, 114, 89, 243, 41,
-			58, 184, 21, 41, 93, 198, 123, 178, 53, 38, 116, 112, 174, 78,
-			135, 176, 127, 236, 33, 3, 238, 190, 222, 37, 133, 242, 3, 120,
-			214, 228, 158, 4, 237, 120, 83, 233, 53, 145, 172, 20, 152, 2,
-			80, 109, 42, 189, 26, 128, 74, 58, 233, 53, 0, 84, 210, 73,
-			111, 6, 64, 197, 81, 122, 145, 66, 207, 95, 20, 156, 43, 99,
-			153, 63, 98, 232, 114, 199, 201, 152, 8, 202, 118, 50, 41, 0,
-			85, 59, 25, 13, 192, 236, 41, 9, 26, 0, 142, 159, 150, 32,
-			86, 117, 230, 1, 9, 246, 2, 120, 225, 146, 104, 167, 207, 50,
-			223, 23, 115, 200, 62, 19, 65, 217, 78, 95, 10, 64, 213, 78,
-			159, 6, 160, 26, 79, 159, 1, 160, 26, 79, 95, 6, 64, 53,
-			158, 190, 94, 0, 213, 120, 136, 101, 190, 104, 232, 178, 19, 196,
-			68, 80, 182, 67, 82, 0, 170, 118, 64, 179, 124, 209, 200, 74,
-			78, 76, 12, 0, 199, 38, 36, 152, 1, 240, 180, 236, 50, 233,
-			5, 240, 222, 89, 242, 37, 174, 134, 246, 91, 230, 63, 51, 244,
-			153, 220, 231, 52, 90, 14, 187, 98, 130, 72, 66, 124, 152, 80,
-			254, 90, 15, 127, 75, 150, 251, 128, 218, 65, 131, 69, 192, 117,
-			163, 186, 31, 52, 133, 207, 20, 236, 221, 172, 233, 68, 144, 63,
-			190, 27, 161, 204, 175, 132, 11, 250, 219, 44, 232, 160, 188, 152,
-			20, 96, 209, 54, 229, 68, 138, 73, 203, 45, 219, 237, 80, 167,
-			225, 249, 1, 171, 93, 146, 217, 161, 60, 161, 46, 179, 195, 40,
-			233, 55, 134, 55, 35, 228, 78, 142, 45, 201, 33, 112, 49, 204,
-			77, 104, 161, 253, 38, 142, 90, 129, 41, 0, 21, 62, 251, 53,
-			0, 179, 57, 9, 26, 0, 222, 117, 76, 130, 25, 0, 233, 89,
-			9, 246, 2, 120, 102, 26, 31, 135, 36, 120, 85, 193, 208, 239,
-			203, 189, 145, 242, 64, 203, 161, 244, 3, 194, 115, 36, 140, 186,
-			172, 94, 122, 19, 241, 64, 246, 11, 201, 82, 236, 82, 60, 137,
-			120, 203, 202, 79, 176, 65, 165, 240, 250, 1, 157, 63, 115, 70,
-			13, 109, 192, 196, 30, 40, 48, 5, 160, 26, 26, 222, 156, 48,
-			132, 193, 159, 232, 3, 6, 128, 39, 228, 74, 24, 200, 0, 120,
-			207, 5, 9, 246, 2, 120, 246, 94, 242, 195, 156, 84, 6, 45,
-			243, 167, 12, 125, 2, 159, 156, 151, 155, 182, 47, 141, 90, 180,
-			42, 20, 33, 30, 204, 89, 48, 14, 7, 97, 180, 197, 161, 38,
-			4, 234, 122, 162, 8, 217, 111, 64, 187, 246, 248, 34, 231, 88,
-			220, 77, 73, 242, 42, 53, 220, 65, 19, 59, 165, 192, 20, 128,
-			106, 147, 27, 212, 0, 180, 228, 10, 28, 52, 0, 188, 135, 191,
-			24, 70, 244, 33, 203, 252, 25, 67, 31, 207, 93, 162, 42, 36,
-			52, 162, 115, 79, 159, 46, 201, 102, 67, 169, 230, 139, 141, 93,
-			117, 99, 200, 196, 186, 20, 152, 2, 80, 117, 99, 72, 3, 208,
-			202, 75, 208, 0, 240, 212, 24, 121, 129, 11, 67, 195, 150, 249,
-			139, 134, 126, 50, 247, 221, 122, 194, 112, 67, 87, 119, 156, 122,
-			148, 220, 219, 112, 105, 224, 229, 174, 189, 38, 29, 144, 251, 230,
-			165, 191, 39, 250, 111, 4, 12, 68, 34, 32, 253, 177, 226, 24,
-			151, 245, 241, 193, 233, 176, 234, 7, 76, 197, 138, 227, 174, 34,
-			190, 156, 52, 233, 222, 29, 78, 133, 157, 230, 166, 239, 134, 68,
-			42, 155, 194, 9, 51, 138, 85, 139, 144, 79, 46, 23, 127, 10,
-			220, 187, 10, 187, 168, 12, 242, 194, 133, 150, 159, 32, 144, 87,
-			106, 70, 181, 34, 17, 56, 108, 34, 78, 20, 152, 2, 80, 225,
-			115, 88, 3, 80, 153, 137, 134, 13, 0, 143, 159, 64, 143, 118,
-			162, 103, 45, 243, 87, 96, 90, 91, 49, 149, 182, 182, 90, 183,
-			75, 157, 144, 117, 15, 1, 144, 125, 168, 114, 65, 204, 147, 19,
-			82, 208, 16, 59, 170, 239, 89, 19, 59, 160, 192, 20, 128, 170,
-			239, 89, 13, 64, 69, 11, 89, 3, 192, 83, 99, 228, 95, 242,
-			53, 54, 98, 153, 191, 102, 232, 167, 114, 31, 213, 80, 157, 72,
-			224, 27, 13, 9, 60, 200, 160, 138, 240, 134, 36, 235, 215, 247,
-			239, 180, 234, 34, 137, 251, 184, 103, 22, 213, 167, 110, 129, 21,
-			197, 205, 164, 71, 50, 44, 12, 32, 134, 136, 5, 205, 56, 44,
-			144, 234, 132, 26, 252, 136, 137, 3, 80, 96, 10, 64, 53, 248,
-			17, 13, 64, 75, 178, 210, 17, 3, 192, 252, 73, 242, 59, 124,
-			240, 150, 101, 254, 186, 161, 23, 115, 31, 255, 7, 12, 94, 134,
-			128, 87, 88, 32, 123, 103, 234, 85, 177, 16, 155, 14, 147, 136,
-			32, 241, 35, 185, 175, 142, 8, 203, 196, 193, 40, 48, 5, 160,
-			66, 132, 165, 1, 104, 73, 89, 192, 50, 0, 60, 51, 73, 254,
-			21, 71, 196, 1, 203, 252, 109, 224, 8, 191, 252, 106, 136, 144,
-			243, 229, 215, 105, 208, 222, 236, 252, 3, 136, 64, 56, 78, 126,
-			67, 100, 128, 77, 239, 102, 137, 7, 76, 28, 132, 2, 83, 0,
-			42, 4, 28, 208, 0, 84, 75, 248, 128, 1, 224, 241, 19, 228,
-			77, 56, 254, 131, 150, 249, 73, 67, 159, 205, 121, 223, 208, 5,
-			239, 248, 173, 254, 238, 216, 139, 114, 223, 205, 47, 203, 99, 99,
-			121, 220, 144, 188, 13, 78, 244, 131, 38, 54, 175, 192, 52, 128,
-			253, 178, 171, 7, 53, 0, 197, 109, 112, 162, 31, 52, 0, 188,
-			112, 145, 188, 85, 35, 134, 9, 146, 217, 239, 25, 250, 225, 220,
-			179, 255, 224, 235, 224, 223, 248, 40, 80, 130, 76, 245, 64, 79,
-			196, 221, 113, 130, 119, 199, 127, 207, 16, 119, 199, 9, 222, 29,
-			255, 61, 227, 142, 81, 126, 50, 209, 103, 153, 255, 135, 161, 15,
-			241, 130, 125, 61, 0, 245, 15, 242, 156, 125, 80, 48, 9, 234,
-			2, 28, 32, 186, 217, 111, 165, 255, 157, 209, 243, 195, 166, 134,
-			213, 128, 216, 244, 239, 140, 204, 157, 228, 63, 166, 136, 105, 246,
-			235, 61, 150, 249, 178, 161, 63, 148, 251, 100, 10, 24, 49, 42,
-			2, 137, 115, 169, 216, 101, 254, 172, 180, 96, 64, 174, 228, 37,
-			215, 122, 215, 133, 36, 105, 216, 74, 236, 58, 152, 67, 157, 56,
-			76, 130, 204, 104, 71, 206, 166, 131, 129, 117, 148, 121, 107, 87,
-			237, 68, 84, 95, 164, 120, 210, 37, 110, 223, 198, 103, 149, 14,
-			151, 169, 226, 136, 116, 252, 202, 250, 44, 165, 229, 104, 44, 164,
-			46, 11, 67, 66, 89, 189, 238, 84, 29, 188, 162, 181, 5, 18,
-			29, 219, 97, 1, 173, 51, 27, 95, 174, 231, 230, 106, 152, 74,
-			216, 106, 81, 146, 69, 95, 227, 218, 174, 40, 124, 202, 21, 94,
-			26, 116, 217, 77, 27, 227, 229, 117, 157, 4, 83, 149, 253, 138,
-			239, 211, 231, 120, 36, 80, 177, 110, 111, 241, 192, 3, 125, 16,
-			177, 125, 137, 231, 77, 208, 216, 121, 152, 128, 166, 125, 19, 191,
-			60, 223, 237, 46, 202, 18, 7, 247, 32, 179, 243, 115, 111, 64,
-			131, 236, 30, 247, 178, 190, 148, 64, 104, 40, 156, 248, 48, 107,
-			114, 170, 8, 94, 86, 234, 166, 110, 212, 74, 209, 137, 73, 142,
-			27, 148, 90, 84, 80, 165, 12, 124, 137, 135, 79, 67, 89, 66,
-			58, 39, 108, 10, 87, 210, 144, 159, 5, 132, 168, 41, 236, 118,
-			227, 199, 38, 47, 43, 71, 111, 161, 19, 200, 147, 184, 66, 34,
-			204, 108, 180, 131, 199, 185, 248, 4, 172, 58, 182, 199, 219, 89,
-			94, 221, 15, 170, 66, 159, 143, 246, 139, 153, 39, 24, 68, 63,
-			30, 98, 189, 44, 25, 68, 63, 30, 98, 189, 44, 101, 236, 126,
-			52, 29, 190, 108, 100, 199, 36, 104, 0, 120, 250, 140, 4, 51,
-			0, 10, 219, 104, 191, 222, 211, 11, 224, 125, 15, 144, 207, 107,
-			184, 104, 52, 203, 252, 47, 134, 126, 37, 247, 25, 141, 46, 56,
-			97, 172, 65, 36, 44, 15, 194, 10, 36, 223, 230, 160, 249, 90,
-			226, 32, 33, 79, 229, 251, 28, 210, 21, 179, 106, 123, 24, 1,
-			182, 238, 58, 213, 72, 222, 132, 228, 22, 101, 89, 147, 116, 185,
-			144, 231, 93, 200, 133, 248, 233, 18, 109, 130, 192, 169, 226, 164,
-			9, 65, 72, 172, 91, 102, 135, 14, 11, 46, 81, 143, 237, 8,
-			35, 4, 95, 76, 246, 182, 239, 72, 114, 17, 39, 42, 137, 78,
-			230, 21, 30, 53, 19, 71, 171, 192, 20, 128, 10, 143, 26, 226,
-			34, 59, 37, 65, 3, 192, 153, 115, 18, 204, 0, 120, 190, 36,
-			193, 94, 0, 31, 94, 32, 95, 224, 120, 212, 45, 243, 175, 12,
-			253, 108, 238, 63, 199, 106, 173, 36, 239, 215, 76, 179, 77, 172,
-			161, 175, 83, 157, 21, 218, 44, 185, 109, 117, 54, 65, 246, 124,
-			252, 186, 137, 3, 86, 96, 10, 64, 133, 74, 32, 171, 191, 146,
-			26, 109, 63, 158, 251, 252, 149, 113, 215, 221, 18, 204, 0, 120,
-			108, 90, 130, 189, 0, 158, 158, 34, 31, 74, 35, 42, 13, 203,
-			124, 171, 169, 223, 157, 123, 33, 13, 130, 131, 186, 219, 34, 209,
-			201, 23, 90, 247, 57, 120, 66, 28, 179, 91, 232, 209, 222, 225,
-			43, 95, 160, 137, 64, 186, 188, 0, 37, 47, 250, 168, 20, 206,
-			245, 40, 164, 60, 240, 40, 235, 172, 117, 90, 172, 64, 49, 4,
-			61, 252, 124, 8, 210, 55, 56, 1, 63, 72, 207, 94, 34, 177,
-			140, 80, 75, 94, 78, 114, 125, 255, 70, 136, 65, 45, 100, 117,
-			162, 195, 215, 237, 22, 186, 69, 226, 179, 50, 146, 161, 38, 153,
-			170, 124, 130, 166, 155, 141, 198, 57, 108, 151, 138, 110, 209, 27,
-			172, 35, 58, 177, 39, 139, 234, 176, 208, 131, 30, 164, 51, 34,
-			219, 243, 252, 31, 197, 195, 186, 59, 180, 107, 116, 132, 150, 119,
-			197, 139, 64, 55, 52, 126, 206, 11, 124, 43, 97, 21, 224, 243,
-			34, 187, 255, 32, 238, 184, 138, 32, 55, 219, 17, 10, 176, 212,
-			166, 30, 143, 253, 1, 115, 227, 116, 81, 189, 50, 155, 70, 62,
-			221, 130, 13, 26, 190, 221, 96, 29, 126, 101, 92, 184, 176, 115,
-			132, 39, 206, 177, 230, 86, 202, 40, 204, 224, 45, 129, 61, 209,
-			45, 240, 212, 74, 186, 171, 96, 212, 82, 59, 36, 212, 169, 199,
-			183, 24, 57, 193, 239, 127, 51, 11, 47, 53, 44, 175, 149, 102,
-			101, 108, 70, 97, 91, 84, 146, 235, 174, 216, 180, 116, 142, 31,
-			163, 75, 41, 3, 169, 138, 199, 178, 35, 82, 21, 229, 23, 60,
-			69, 5, 130, 101, 73, 183, 42, 167, 217, 101, 197, 228, 198, 102,
-			161, 16, 200, 125, 64, 248, 129, 197, 251, 129, 97, 226, 18, 81,
-			96, 10, 64, 181, 248, 12, 13, 192, 236, 97, 9, 226, 122, 58,
-			122, 23, 57, 9, 50, 83, 159, 101, 190, 221, 212, 7, 243, 135,
-			240, 192, 217, 117, 34, 216, 204, 197, 145, 212, 166, 203, 184, 48,
-			215, 15, 50, 217, 219, 205, 254, 1, 94, 5, 202, 100, 73, 80,
-			23, 224, 49, 81, 227, 247, 67, 141, 22, 214, 232, 217, 158, 191,
-			97, 135, 27, 80, 179, 172, 76, 131, 28, 170, 180, 182, 11, 212,
-			5, 184, 140, 139, 223, 180, 204, 119, 154, 223, 188, 80, 75, 253,
-			120, 174, 248, 206, 24, 87, 102, 26, 192, 126, 201, 138, 76, 13,
-			192, 99, 146, 201, 155, 6, 128, 34, 212, 82, 127, 202, 50, 95,
-			48, 95, 155, 80, 75, 253, 32, 46, 191, 96, 10, 113, 185, 31,
-			197, 229, 23, 76, 33, 46, 247, 163, 184, 252, 130, 121, 199, 40,
-			74, 189, 3, 86, 250, 221, 102, 207, 127, 19, 82, 239, 128, 102,
-			153, 239, 54, 51, 7, 201, 79, 232, 196, 52, 7, 64, 234, 125,
-			209, 212, 139, 185, 119, 235, 136, 49, 124, 246, 41, 38, 86, 121,
-			164, 133, 174, 79, 103, 206, 236, 62, 166, 22, 226, 177, 29, 187,
-			158, 146, 91, 92, 85, 23, 206, 142, 91, 182, 7, 232, 87, 158,
-			56, 59, 64, 204, 69, 170, 84, 7, 121, 42, 64, 20, 98, 54,
-			153, 235, 239, 200, 141, 190, 91, 253, 235, 176, 40, 94, 190, 177,
-			127, 129, 223, 98, 242, 69, 1, 232, 13, 108, 85, 140, 78, 78,
-			210, 208, 15, 130, 78, 129, 238, 176, 49, 215, 165, 200, 225, 19,
-			111, 249, 71, 194, 69, 180, 13, 18, 177, 60, 24, 57, 206, 103,
-			125, 0, 5, 168, 23, 37, 17, 12, 96, 188, 173, 23, 77, 113,
-			15, 98, 0, 5, 168, 23, 77, 17, 1, 99, 0, 5, 168, 23,
-			205, 195, 57, 9, 102, 0, 60, 50, 41, 193, 94, 0, 239, 41,
-			96, 56, 147, 1, 179, 199, 74, 255, 168, 169, 255, 152, 201, 195,
-			153, 12, 224, 241, 240, 143, 154, 189, 176, 54, 210, 0, 194, 252,
-			188, 223, 52, 135, 115, 195, 202, 44, 208, 196, 184, 158, 120, 36,
-			58, 32, 78, 126, 223, 111, 154, 137, 4, 29, 18, 6, 135, 208,
-			35, 97, 128, 159, 252, 126, 208, 20, 231, 181, 3, 226, 0, 247,
-			131, 166, 153, 137, 19, 116, 72, 232, 31, 80, 37, 116, 203, 252,
-			144, 41, 2, 216, 12, 136, 115, 216, 15, 153, 226, 28, 118, 64,
-			156, 195, 126, 200, 60, 112, 144, 252, 95, 156, 138, 52, 203, 124,
-			201, 212, 143, 228, 254, 141, 46, 214, 29, 222, 28, 22, 211, 37,
-			142, 200, 249, 51, 127, 92, 9, 82, 204, 179, 21, 56, 77, 124,
-			82, 70, 138, 95, 232, 154, 137, 172, 132, 218, 92, 33, 81, 186,
-			203, 30, 210, 226, 243, 13, 138, 68, 145, 86, 108, 177, 211, 219,
-			158, 170, 29, 68, 253, 157, 192, 145, 126, 125, 24, 227, 79, 198,
-			18, 137, 157, 120, 24, 39, 160, 66, 242, 50, 152, 29, 4, 118,
-			7, 45, 16, 60, 110, 21, 238, 1, 202, 185, 201, 221, 29, 109,
-			104, 211, 245, 55, 139, 180, 44, 239, 104, 23, 56, 123, 150, 39,
-			76, 192, 153, 35, 30, 9, 28, 253, 159, 240, 208, 138, 139, 90,
-			92, 242, 20, 167, 101, 28, 105, 137, 152, 56, 156, 98, 64, 234,
-			124, 41, 38, 62, 144, 58, 95, 146, 220, 122, 0, 39, 243, 37,
-			51, 43, 137, 15, 164, 206, 151, 128, 248, 126, 52, 133, 19, 163,
-			91, 230, 199, 76, 253, 92, 238, 29, 41, 156, 24, 254, 234, 154,
-			114, 134, 17, 118, 17, 22, 123, 243, 173, 162, 56, 194, 49, 164,
-			172, 71, 194, 203, 218, 23, 241, 3, 196, 61, 240, 228, 174, 4,
-			74, 174, 122, 135, 3, 7, 15, 229, 46, 156, 167, 155, 184, 178,
-			34, 214, 8, 108, 23, 113, 95, 119, 110, 202, 64, 35, 132, 142,
-			59, 94, 116, 225, 124, 129, 182, 197, 191, 161, 248, 23, 51, 97,
-			130, 248, 53, 81, 164, 116, 46, 17, 28, 78, 14, 68, 61, 157,
-			70, 120, 172, 31, 65, 31, 56, 97, 201, 241, 112, 231, 22, 169,
-			98, 32, 214, 67, 234, 250, 252, 174, 61, 136, 169, 14, 58, 174,
-			112, 31, 27, 160, 215, 45, 187, 5, 108, 100, 135, 223, 228, 119,
-			65, 220, 136, 67, 44, 136, 216, 5, 194, 8, 75, 235, 174, 207,
-			165, 92, 238, 138, 29, 55, 91, 36, 116, 21, 25, 90, 7, 190,
-			170, 119, 221, 148, 240, 45, 6, 129, 90, 96, 151, 218, 196, 106,
-			201, 206, 11, 175, 127, 162, 132, 132, 196, 183, 60, 119, 218, 204,
-			203, 104, 50, 232, 11, 192, 182, 236, 109, 199, 15, 18, 55, 18,
-			144, 113, 240, 185, 34, 84, 61, 63, 135, 151, 38, 187, 228, 31,
-			21, 135, 58, 226, 186, 127, 23, 207, 85, 183, 103, 125, 49, 217,
-			201, 67, 106, 238, 187, 198, 29, 82, 237, 26, 154, 216, 185, 35,
-			113, 195, 247, 27, 197, 166, 29, 109, 21, 203, 64, 7, 74, 12,
-			25, 224, 113, 133, 98, 194, 198, 184, 66, 166, 176, 184, 13, 240,
-			184, 66, 166, 53, 42, 65, 3, 192, 35, 71, 37, 152, 1, 240,
-			174, 25, 9, 246, 2, 56, 126, 86, 112, 85, 205, 74, 255, 138,
-			169, 255, 75, 197, 85, 97, 145, 252, 138, 217, 59, 136, 225, 168,
-			6, 120, 144, 168, 95, 53, 77, 43, 119, 72, 216, 43, 19, 135,
-			202, 252, 22, 17, 103, 116, 60, 66, 212, 175, 198, 204, 147, 71,
-			136, 250, 85, 51, 59, 66, 38, 68, 85, 154, 101, 254, 26, 84,
-			117, 24, 171, 218, 67, 115, 97, 162, 50, 141, 231, 141, 43, 3,
-			198, 251, 107, 201, 202, 116, 203, 252, 23, 251, 86, 22, 251, 230,
-			202, 178, 208, 240, 191, 72, 86, 198, 11, 103, 71, 200, 127, 27,
-			192, 165, 111, 88, 230, 103, 76, 253, 76, 238, 79, 6, 164, 75,
-			68, 226, 218, 193, 166, 82, 65, 92, 251, 89, 199, 237, 60, 76,
-			233, 162, 253, 108, 71, 185, 142, 202, 3, 60, 33, 129, 76, 2,
-			90, 100, 16, 84, 238, 80, 15, 26, 183, 136, 26, 177, 35, 125,
-			217, 184, 131, 97, 66, 211, 194, 155, 48, 184, 211, 243, 214, 10,
-			156, 131, 56, 24, 95, 71, 228, 27, 11, 227, 184, 51, 200, 20,
-			197, 69, 76, 209, 191, 205, 118, 36, 5, 97, 46, 200, 113, 231,
-			13, 206, 151, 185, 113, 76, 208, 125, 87, 173, 130, 191, 86, 35,
-			238, 75, 30, 215, 135, 157, 21, 65, 149, 48, 254, 17, 55, 64,
-			72, 107, 151, 240, 46, 134, 225, 219, 120, 107, 6, 70, 187, 75,
-			71, 176, 3, 70, 235, 1, 99, 220, 184, 141, 154, 141, 138, 162,
-			128, 18, 17, 161, 204, 110, 176, 0, 148, 106, 23, 176, 42, 47,
-			181, 116, 199, 248, 80, 23, 88, 148, 188, 39, 111, 155, 40, 191,
-			58, 162, 246, 142, 46, 155, 58, 40, 74, 97, 187, 209, 96, 161,
-			12, 220, 209, 101, 0, 178, 241, 245, 14, 16, 157, 28, 198, 195,
-			221, 216, 168, 75, 65, 61, 93, 253, 233, 138, 243, 130, 49, 48,
-			253, 64, 88, 33, 19, 75, 123, 211, 247, 111, 220, 96, 140, 71,
-			150, 242, 183, 89, 176, 5, 115, 17, 117, 90, 66, 123, 22, 145,
-			190, 187, 156, 199, 156, 61, 12, 68, 122, 94, 82, 155, 251, 246,
-			69, 137, 216, 252, 94, 196, 130, 186, 56, 30, 177, 189, 174, 99,
-			1, 191, 6, 10, 173, 237, 186, 210, 229, 20, 131, 152, 160, 221,
-			146, 6, 172, 105, 39, 110, 17, 22, 41, 189, 210, 14, 96, 26,
-			64, 110, 0, 82, 11, 152, 93, 155, 12, 237, 58, 83, 129, 203,
-			73, 162, 49, 39, 217, 159, 196, 115, 4, 188, 195, 151, 208, 205,
-			37, 146, 14, 112, 178, 49, 168, 13, 153, 49, 140, 157, 91, 151,
-			212, 133, 3, 222, 32, 146, 115, 181, 29, 240, 155, 88, 184, 231,
-			184, 60, 152, 71, 119, 133, 64, 244, 142, 215, 102, 132, 223, 216,
-			192, 104, 22, 148, 201, 184, 188, 130, 44, 139, 164, 235, 150, 252,
-			110, 109, 117, 175, 110, 141, 143, 95, 171, 224, 24, 82, 170, 226,
-			23, 111, 8, 110, 101, 207, 118, 186, 175, 54, 208, 53, 172, 57,
-			44, 224, 144, 128, 44, 202, 97, 153, 175, 91, 231, 89, 86, 27,
-			159, 144, 130, 86, 215, 234, 38, 216, 118, 192, 162, 118, 32, 8,
-			18, 195, 116, 8, 61, 185, 123, 41, 110, 217, 33, 197, 215, 149,
-			112, 9, 116, 245, 44, 97, 37, 247, 24, 12, 216, 14, 58, 202,
-			141, 223, 151, 126, 101, 251, 212, 137, 154, 131, 184, 134, 229, 115,
-			151, 58, 190, 190, 29, 143, 135, 106, 17, 219, 20, 62, 158, 133,
-			254, 229, 128, 152, 2, 240, 121, 102, 43, 137, 176, 213, 14, 90,
-			62, 119, 118, 0, 196, 16, 185, 50, 64, 220, 240, 118, 239, 141,
-			194, 62, 137, 232, 14, 95, 17, 223, 68, 153, 140, 85, 220, 147,
-			72, 68, 234, 119, 162, 36, 198, 165, 229, 62, 225, 252, 148, 152,
-			27, 201, 45, 187, 187, 129, 85, 139, 119, 181, 78, 115, 47, 252,
-			211, 162, 27, 14, 62, 209, 214, 213, 21, 180, 236, 157, 70, 255,
-			224, 211, 228, 149, 178, 117, 243, 38, 201, 207, 120, 236, 151, 46,
-			3, 218, 22, 200, 194, 155, 140, 121, 2, 227, 106, 63, 55, 76,
-			220, 113, 20, 152, 2, 80, 9, 170, 134, 6, 160, 8, 166, 55,
-			128, 102, 133, 207, 152, 135, 228, 246, 110, 100, 0, 60, 124, 90,
-			130, 189, 0, 158, 156, 32, 127, 174, 225, 94, 102, 90, 230, 103,
-			77, 253, 108, 238, 143, 146, 94, 63, 192, 179, 94, 51, 227, 168,
-			52, 75, 135, 223, 152, 105, 84, 68, 207, 187, 45, 79, 31, 17,
-			247, 148, 143, 220, 228, 67, 85, 96, 10, 64, 133, 68, 83, 3,
-			48, 43, 117, 75, 211, 0, 80, 24, 70, 7, 208, 143, 249, 179,
-			166, 48, 140, 14, 160, 31, 243, 103, 205, 211, 83, 228, 97, 196,
-			97, 202, 50, 95, 54, 245, 66, 238, 236, 215, 255, 180, 8, 175,
-			47, 101, 98, 13, 10, 196, 10, 85, 215, 82, 26, 128, 106, 126,
-			83, 6, 128, 135, 14, 75, 48, 3, 96, 238, 140, 4, 123, 1,
-			60, 117, 26, 173, 54, 3, 32, 249, 253, 197, 55, 209, 106, 51,
-			128, 190, 164, 127, 17, 119, 53, 141, 13, 244, 75, 60, 165, 53,
-			0, 133, 213, 102, 0, 125, 73, 255, 66, 90, 109, 6, 82, 150,
-			249, 151, 175, 145, 213, 102, 32, 213, 3, 149, 11, 171, 205, 0,
-			90, 109, 254, 82, 90, 109, 6, 208, 106, 243, 151, 230, 29, 163,
-			228, 40, 244, 163, 207, 50, 191, 96, 234, 131, 249, 97, 241, 100,
-			71, 141, 62, 131, 66, 24, 175, 169, 175, 7, 62, 11, 43, 216,
-			0, 90, 216, 146, 160, 46, 192, 1, 162, 155, 131, 86, 250, 175,
-			205, 158, 191, 17, 246, 159, 65, 205, 50, 255, 218, 204, 28, 68,
-			204, 15, 130, 32, 252, 165, 111, 34, 230, 7, 209, 84, 242, 37,
-			137, 249, 65, 52, 149, 124, 73, 98, 126, 16, 197, 233, 47, 73,
-			204, 15, 162, 169, 228, 75, 18, 243, 131, 41, 203, 252, 242, 107,
-			132, 249, 65, 192, 252, 151, 37, 230, 7, 17, 243, 95, 150, 152,
-			31, 68, 204, 127, 89, 218, 203, 134, 172, 244, 223, 154, 61, 63,
-			144, 226, 248, 26, 210, 44, 243, 111, 205, 204, 1, 242, 52, 49,
-			205, 33, 192, 215, 215, 76, 157, 230, 42, 252, 140, 184, 219, 209,
-			65, 30, 25, 227, 75, 216, 180, 201, 223, 183, 138, 109, 99, 252,
-			141, 1, 124, 11, 41, 138, 111, 81, 16, 25, 198, 17, 251, 50,
-			132, 8, 252, 154, 68, 224, 16, 30, 214, 125, 77, 174, 178, 33,
-			68, 224, 215, 204, 236, 17, 9, 26, 0, 222, 125, 140, 188, 172,
-			97, 247, 52, 203, 124, 75, 74, 63, 155, 251, 84, 204, 39, 69,
-			240, 149, 215, 240, 12, 137, 95, 121, 122, 109, 185, 36, 30, 165,
-			42, 28, 105, 38, 142, 83, 129, 41, 0, 21, 142, 52, 196, 130,
-			96, 146, 67, 104, 18, 121, 75, 74, 48, 201, 33, 60, 136, 123,
-			75, 74, 48, 201, 33, 60, 136, 123, 75, 234, 244, 20, 26, 163,
-			135, 250, 44, 243, 123, 83, 175, 96, 140, 30, 130, 117, 247, 189,
-			41, 177, 208, 134, 112, 221, 37, 65, 93, 128, 203, 56, 27, 186,
-			101, 190, 45, 245, 205, 91, 92, 67, 168, 49, 191, 45, 30, 55,
-			240, 205, 183, 165, 250, 229, 200, 96, 246, 223, 150, 18, 139, 107,
-			8, 53, 230, 183, 165, 196, 226, 26, 74, 89, 230, 59, 82, 175,
-			205, 226, 26, 130, 197, 245, 142, 148, 88, 92, 67, 184, 184, 222,
-			145, 18, 139, 107, 8, 23, 215, 59, 82, 98, 113, 13, 91, 233,
-			119, 166, 122, 126, 84, 44, 174, 97, 205, 50, 223, 153, 202, 140,
-			146, 191, 1, 242, 29, 134, 213, 245, 46, 32, 223, 63, 223, 69,
-			190, 92, 75, 122, 205, 137, 152, 183, 243, 90, 159, 133, 38, 162,
-			36, 137, 137, 29, 198, 69, 255, 46, 57, 177, 195, 184, 232, 223,
-			37, 9, 122, 24, 23, 253, 187, 36, 65, 15, 227, 162, 127, 151,
-			36, 232, 97, 52, 48, 191, 75, 18, 244, 48, 26, 152, 223, 5,
-			4, 189, 140, 40, 213, 44, 243, 189, 223, 68, 26, 28, 230, 49,
-			129, 226, 174, 106, 105, 0, 251, 101, 103, 48, 38, 144, 164, 193,
-			97, 30, 19, 72, 210, 224, 112, 202, 50, 95, 124, 141, 104, 112,
-			24, 104, 240, 69, 73, 131, 195, 72, 131, 47, 74, 26, 28, 70,
-			26, 124, 81, 210, 96, 214, 74, 191, 63, 213, 243, 243, 130, 6,
-			179, 154, 101, 190, 63, 149, 185, 147, 252, 145, 65, 76, 51, 11,
-			52, 248, 82, 74, 159, 73, 158, 196, 199, 55, 57, 95, 67, 2,
-			20, 141, 188, 214, 212, 39, 239, 65, 20, 201, 204, 159, 107, 168,
-			89, 206, 82, 30, 118, 71, 133, 81, 56, 171, 226, 39, 156, 155,
-			145, 33, 123, 226, 24, 234, 234, 45, 105, 37, 58, 86, 86, 230,
-			9, 165, 180, 30, 216, 77, 182, 227, 7, 55, 138, 148, 62, 198,
-			168, 221, 242, 93, 191, 1, 148, 132, 79, 106, 248, 118, 80, 19,
-			90, 87, 152, 120, 251, 193, 167, 126, 59, 8, 153, 187, 205, 66,
-			113, 228, 75, 233, 14, 227, 215, 90, 100, 236, 67, 110, 184, 192,
-			11, 26, 24, 213, 116, 19, 175, 131, 64, 182, 26, 171, 58, 194,
-			244, 32, 15, 122, 228, 227, 163, 80, 145, 120, 127, 84, 144, 110,
-			22, 87, 217, 75, 146, 116, 179, 184, 202, 94, 146, 171, 44, 139,
-			171, 236, 37, 185, 202, 178, 184, 202, 94, 74, 9, 55, 250, 44,
-			174, 178, 151, 82, 194, 141, 62, 139, 171, 236, 165, 212, 153, 105,
-			92, 101, 89, 140, 2, 244, 77, 92, 101, 89, 92, 101, 31, 137,
-			187, 10, 171, 236, 35, 114, 149, 101, 113, 149, 125, 68, 174, 178,
-			44, 174, 178, 143, 200, 85, 150, 77, 89, 230, 207, 189, 70, 171,
-			44, 11, 171, 236, 231, 228, 42, 203, 226, 42, 251, 57, 185, 202,
-			178, 184, 202, 126, 78, 174, 178, 17, 43, 253, 11, 169, 158, 223,
-			19, 171, 108, 68, 179, 204, 95, 72, 101, 238, 32, 159, 130, 85,
-			54, 2, 171, 236, 227, 176, 202, 62, 147, 244, 119, 65, 251, 206,
-			107, 236, 238, 2, 109, 188, 246, 222, 46, 226, 206, 228, 255, 215,
-			150, 216, 8, 46, 177, 143, 75, 186, 29, 193, 37, 246, 113, 185,
-			196, 70, 112, 137, 125, 92, 46, 177, 17, 92, 98, 31, 151, 75,
-			108, 4, 151, 216, 199, 229, 18, 27, 193, 37, 246, 113, 88, 98,
-			127, 5, 194, 193, 136, 217, 99, 165, 127, 35, 165, 255, 118, 202,
-			232, 118, 146, 18, 70, 193, 26, 155, 228, 151, 134, 39, 209, 50,
-			59, 238, 7, 220, 84, 231, 120, 244, 145, 181, 181, 21, 88, 147,
-			174, 237, 85, 217, 4, 159, 252, 26, 107, 182, 252, 136, 121, 48,
-			173, 126, 64, 61, 110, 79, 121, 152, 231, 197, 55, 108, 241, 22,
-			218, 110, 211, 75, 108, 110, 187, 90, 90, 3, 226, 216, 228, 247,
-			125, 237, 58, 127, 83, 30, 166, 157, 187, 83, 174, 172, 39, 190,
-			199, 205, 41, 235, 159, 52, 105, 239, 58, 163, 89, 89, 94, 93,
-			147, 200, 196, 195, 224, 223, 72, 245, 30, 194, 115, 217, 17, 126,
-			24, 252, 155, 41, 243, 40, 154, 252, 71, 196, 217, 239, 111, 166,
-			196, 99, 19, 35, 226, 236, 247, 55, 83, 185, 35, 228, 164, 40,
-			161, 89, 230, 191, 74, 153, 163, 249, 131, 220, 83, 132, 133, 137,
-			190, 16, 85, 76, 227, 217, 14, 196, 9, 58, 36, 220, 121, 136,
-			220, 39, 234, 209, 45, 243, 183, 82, 230, 129, 252, 88, 18, 117,
-			60, 28, 155, 140, 110, 133, 1, 10, 248, 28, 132, 113, 213, 208,
-			131, 223, 74, 137, 215, 82, 70, 196, 209, 241, 111, 165, 240, 81,
-			30, 96, 4, 154, 149, 254, 68, 74, 255, 215, 169, 123, 196, 172,
-			3, 227, 251, 68, 76, 64, 192, 248, 62, 145, 18, 129, 2, 71,
-			176, 163, 159, 72, 29, 157, 148, 160, 1, 160, 184, 139, 63, 130,
-			162, 253, 191, 78, 165, 79, 73, 176, 23, 192, 145, 147, 200, 163,
-			71, 160, 221, 79, 126, 19, 121, 244, 8, 74, 227, 159, 140, 187,
-			10, 210, 248, 39, 37, 143, 30, 193, 129, 127, 82, 242, 232, 17,
-			148, 198, 63, 41, 121, 244, 72, 202, 50, 127, 247, 53, 226, 209,
-			35, 192, 163, 127, 87, 242, 232, 17, 228, 209, 191, 43, 121, 244,
-			8, 242, 232, 223, 5, 30, 253, 14, 131, 232, 166, 101, 165, 63,
-			149, 234, 249, 47, 41, 45, 247, 119, 58, 157, 83, 38, 62, 117,
-			144, 10, 92, 193, 86, 26, 110, 140, 54, 101, 204, 87, 88, 18,
-			55, 192, 184, 127, 173, 221, 106, 49, 155, 95, 111, 149, 163, 16,
-			193, 169, 120, 152, 100, 121, 43, 86, 185, 40, 205, 206, 174, 136,
-			16, 95, 174, 29, 242, 59, 16, 42, 6, 160, 239, 187, 50, 104,
-			96, 40, 120, 27, 158, 225, 96, 84, 44, 232, 224, 66, 34, 194,
-			55, 222, 213, 10, 139, 93, 183, 70, 119, 117, 193, 241, 186, 98,
-			130, 243, 18, 226, 237, 110, 110, 207, 230, 253, 139, 171, 157, 157,
-			21, 85, 140, 79, 112, 118, 209, 10, 124, 30, 222, 126, 87, 182,
-			121, 191, 213, 89, 243, 199, 39, 38, 196, 65, 22, 70, 101, 192,
-			197, 177, 158, 140, 42, 166, 66, 143, 201, 184, 101, 60, 226, 142,
-			165, 89, 230, 167, 82, 153, 35, 228, 223, 232, 196, 52, 45, 163,
-			199, 74, 127, 38, 165, 255, 231, 148, 145, 251, 53, 238, 86, 145,
-			188, 84, 221, 21, 166, 44, 62, 56, 194, 72, 116, 34, 148, 131,
-			154, 69, 30, 46, 179, 33, 46, 116, 19, 106, 211, 154, 31, 77,
-			202, 208, 38, 53, 233, 75, 235, 132, 27, 113, 12, 7, 17, 93,
-			158, 58, 245, 122, 162, 116, 178, 74, 47, 17, 171, 140, 142, 215,
-			152, 231, 71, 50, 74, 3, 127, 14, 4, 166, 170, 139, 6, 194,
-			22, 171, 134, 187, 93, 224, 38, 138, 132, 150, 138, 141, 98, 225,
-			57, 250, 164, 120, 135, 26, 29, 36, 158, 42, 208, 39, 243, 155,
-			118, 80, 220, 180, 159, 205, 23, 176, 51, 152, 244, 198, 246, 77,
-			149, 133, 62, 159, 232, 17, 225, 239, 87, 143, 139, 50, 19, 69,
-			200, 41, 22, 171, 133, 129, 124, 63, 147, 34, 252, 1, 37, 139,
-			7, 242, 253, 227, 148, 153, 71, 190, 100, 137, 208, 189, 127, 156,
-			18, 129, 117, 45, 17, 186, 247, 143, 83, 131, 7, 226, 4, 13,
-			18, 14, 222, 21, 39, 24, 144, 64, 143, 171, 58, 53, 203, 252,
-			79, 41, 243, 132, 202, 0, 204, 236, 63, 37, 235, 212, 82, 144,
-			48, 56, 18, 39, 96, 17, 235, 238, 56, 193, 128, 132, 227, 121,
-			92, 203, 22, 244, 242, 79, 82, 58, 15, 47, 105, 97, 31, 255,
-			68, 114, 28, 11, 141, 107, 127, 146, 234, 63, 32, 65, 13, 192,
-			131, 163, 18, 52, 0, 60, 114, 148, 191, 196, 99, 65, 231, 62,
-			151, 210, 199, 114, 63, 168, 37, 30, 140, 120, 5, 106, 42, 192,
-			76, 237, 108, 217, 17, 82, 49, 122, 42, 160, 4, 230, 223, 96,
-			176, 224, 3, 2, 91, 1, 143, 244, 136, 241, 246, 236, 80, 190,
-			239, 174, 14, 81, 74, 226, 130, 128, 120, 155, 135, 47, 94, 225,
-			193, 40, 158, 250, 145, 99, 1, 84, 125, 46, 30, 26, 32, 234,
-			115, 41, 225, 12, 96, 33, 154, 62, 151, 18, 183, 208, 44, 68,
-			210, 231, 82, 167, 238, 17, 72, 210, 45, 243, 229, 148, 62, 33,
-			62, 2, 91, 126, 57, 174, 9, 237, 222, 113, 77, 128, 134, 151,
-			83, 214, 73, 9, 26, 0, 142, 141, 139, 154, 12, 203, 252, 179,
-			148, 8, 90, 107, 225, 129, 198, 159, 197, 53, 25, 41, 0, 133,
-			219, 215, 255, 203, 222, 223, 64, 199, 145, 93, 247, 129, 120, 191,
-			170, 234, 70, 227, 129, 31, 96, 17, 36, 193, 34, 57, 124, 108,
-			126, 1, 96, 163, 1, 130, 31, 51, 4, 137, 153, 105, 2, 77,
-			178, 103, 64, 0, 211, 0, 56, 26, 141, 70, 96, 161, 187, 0,
-			212, 176, 187, 170, 85, 85, 77, 18, 35, 75, 254, 107, 20, 201,
-			209, 135, 19, 125, 196, 86, 98, 69, 138, 172, 248, 239, 56, 182,
-			118, 115, 20, 173, 37, 57, 62, 241, 74, 103, 157, 88, 201, 110,
-			116, 214, 202, 158, 172, 215, 235, 56, 142, 87, 89, 43, 62, 235,
-			15, 69, 187, 113, 54, 242, 234, 236, 121, 247, 125, 212, 171, 238,
-			6, 192, 153, 145, 38, 27, 103, 120, 236, 17, 94, 117, 213, 251,
-			184, 239, 190, 247, 238, 189, 239, 222, 251, 51, 225, 66, 227, 127,
-			79, 239, 59, 41, 138, 240, 237, 153, 179, 188, 38, 195, 52, 254,
-			32, 173, 137, 31, 13, 86, 20, 53, 25, 105, 90, 148, 125, 50,
-			16, 45, 242, 224, 34, 19, 172, 250, 127, 16, 179, 64, 218, 52,
-			190, 147, 214, 196, 208, 211, 6, 20, 69, 77, 105, 248, 85, 246,
-			41, 141, 104, 113, 159, 224, 151, 180, 78, 139, 60, 29, 169, 9,
-			102, 246, 52, 207, 64, 107, 50, 27, 121, 92, 83, 38, 77, 139,
-			178, 79, 96, 35, 79, 155, 39, 68, 81, 167, 197, 83, 167, 241,
-			111, 35, 172, 25, 251, 205, 204, 119, 211, 169, 127, 151, 65, 214,
-			203, 164, 228, 85, 237, 102, 200, 211, 72, 62, 26, 50, 53, 75,
-			162, 20, 248, 13, 225, 143, 131, 219, 16, 253, 23, 88, 178, 12,
-			91, 201, 165, 81, 192, 19, 119, 127, 152, 137, 59, 147, 56, 217,
-			208, 113, 182, 11, 239, 71, 166, 241, 221, 116, 246, 32, 126, 117,
-			31, 54, 140, 253, 144, 74, 56, 163, 157, 176, 190, 215, 79, 138,
-			100, 214, 231, 217, 10, 221, 56, 201, 169, 77, 154, 174, 195, 238,
-			174, 147, 53, 198, 185, 178, 96, 192, 48, 86, 76, 170, 126, 16,
-			56, 97, 211, 247, 106, 60, 99, 150, 114, 247, 23, 231, 14, 149,
-			161, 13, 73, 80, 109, 150, 46, 143, 131, 30, 196, 40, 12, 145,
-			79, 202, 51, 37, 64, 228, 169, 113, 100, 27, 39, 8, 243, 109,
-			145, 88, 113, 108, 46, 79, 80, 229, 54, 220, 186, 29, 96, 9,
-			199, 205, 33, 149, 32, 111, 93, 158, 132, 246, 38, 85, 0, 88,
-			88, 13, 27, 130, 244, 78, 223, 50, 196, 135, 146, 149, 39, 18,
-			242, 125, 233, 106, 254, 30, 76, 102, 29, 136, 70, 242, 253, 123,
-			196, 142, 88, 166, 211, 216, 47, 52, 30, 55, 212, 190, 93, 85,
-			239, 228, 126, 235, 239, 124, 167, 252, 31, 250, 127, 239, 124, 39,
-			253, 209, 230, 63, 174, 86, 225, 127, 106, 14, 33, 107, 132, 172,
-			111, 184, 152, 170, 75, 50, 103, 167, 76, 160, 66, 234, 124, 62,
-			153, 11, 127, 216, 180, 61, 66, 32, 89, 10, 73, 254, 83, 79,
-			25, 66, 94, 180, 243, 238, 48, 33, 47, 146, 139, 121, 50, 158,
-			39, 19, 121, 50, 78, 94, 130, 247, 232, 198, 250, 96, 195, 175,
-			119, 14, 172, 192, 63, 92, 109, 251, 48, 79, 46, 210, 111, 233,
-			135, 117, 123, 213, 169, 147, 33, 49, 250, 97, 246, 73, 53, 95,
-			235, 248, 228, 146, 248, 132, 129, 121, 49, 50, 241, 247, 157, 252,
-			90, 199, 251, 231, 197, 251, 44, 195, 227, 154, 239, 243, 151, 215,
-			243, 27, 29, 47, 95, 144, 47, 179, 228, 136, 67, 231, 135, 69,
-			170, 126, 74, 166, 81, 82, 148, 100, 227, 62, 0, 50, 21, 179,
-			116, 235, 228, 14, 37, 81, 232, 212, 215, 56, 216, 24, 191, 47,
-			135, 52, 97, 68, 101, 122, 6, 196, 196, 83, 131, 186, 209, 176,
-			18, 213, 211, 18, 14, 59, 44, 189, 21, 184, 184, 251, 107, 194,
-			99, 51, 100, 89, 115, 9, 161, 74, 48, 115, 69, 113, 188, 106,
-			221, 15, 147, 105, 243, 120, 168, 15, 147, 96, 10, 36, 201, 228,
-			224, 211, 22, 185, 65, 156, 91, 18, 188, 57, 171, 247, 200, 80,
-			211, 15, 67, 119, 181, 190, 169, 34, 66, 73, 87, 143, 88, 242,
-			81, 242, 253, 50, 177, 15, 242, 61, 178, 88, 52, 238, 50, 33,
-			201, 245, 96, 131, 170, 142, 192, 95, 64, 53, 121, 17, 148, 139,
-			37, 252, 156, 164, 34, 104, 252, 9, 108, 126, 219, 99, 212, 42,
-			208, 105, 184, 45, 250, 34, 153, 56, 214, 197, 100, 152, 20, 109,
-			75, 16, 148, 249, 52, 134, 194, 169, 81, 36, 21, 148, 244, 83,
-			83, 244, 3, 146, 84, 51, 0, 13, 149, 54, 204, 82, 219, 202,
-			225, 67, 250, 34, 158, 215, 155, 52, 252, 16, 140, 10, 254, 234,
-			125, 215, 111, 133, 130, 184, 2, 139, 141, 141, 173, 150, 227, 116,
-			181, 215, 109, 215, 147, 9, 66, 69, 78, 89, 53, 29, 170, 58,
-			13, 73, 32, 129, 176, 234, 55, 157, 60, 115, 219, 5, 39, 5,
-			153, 112, 181, 203, 168, 147, 172, 122, 54, 100, 203, 91, 56, 253,
-			176, 176, 11, 200, 242, 201, 185, 202, 141, 66, 46, 184, 242, 119,
-			25, 175, 176, 30, 113, 118, 81, 198, 227, 56, 57, 230, 174, 148,
-			224, 133, 152, 128, 66, 7, 98, 206, 89, 180, 150, 85, 103, 221,
-			245, 128, 141, 184, 212, 213, 78, 25, 22, 133, 25, 110, 216, 1,
-			83, 45, 218, 18, 246, 10, 39, 30, 150, 104, 20, 190, 129, 65,
-			62, 195, 124, 77, 152, 207, 140, 221, 109, 196, 234, 48, 67, 191,
-			193, 61, 107, 218, 223, 164, 53, 75, 197, 174, 225, 216, 18, 93,
-			131, 85, 65, 149, 38, 199, 171, 217, 93, 22, 17, 201, 1, 170,
-			107, 142, 107, 176, 176, 73, 66, 230, 73, 155, 237, 80, 116, 100,
-			74, 254, 101, 185, 50, 19, 152, 16, 113, 42, 196, 152, 163, 105,
-			69, 241, 129, 233, 50, 72, 43, 145, 142, 129, 251, 19, 211, 74,
-			121, 30, 49, 55, 0, 141, 210, 247, 98, 103, 66, 9, 40, 65,
-			70, 201, 116, 156, 33, 136, 97, 150, 64, 4, 0, 151, 123, 149,
-			101, 196, 37, 212, 102, 224, 175, 218, 171, 204, 121, 176, 230, 132,
-			238, 186, 7, 118, 48, 200, 216, 11, 102, 66, 18, 109, 248, 34,
-			41, 133, 98, 57, 96, 121, 43, 34, 219, 171, 229, 169, 80, 12,
-			78, 236, 204, 61, 214, 95, 83, 90, 169, 178, 60, 68, 132, 65,
-			74, 84, 253, 160, 166, 228, 72, 132, 56, 4, 46, 28, 239, 103,
-			0, 6, 25, 77, 22, 51, 180, 200, 229, 254, 253, 12, 192, 32,
-			51, 112, 84, 20, 117, 90, 60, 78, 192, 192, 178, 159, 106, 145,
-			159, 205, 104, 223, 205, 48, 95, 216, 253, 160, 8, 125, 54, 131,
-			77, 252, 254, 30, 156, 161, 101, 42, 225, 124, 57, 99, 228, 173,
-			127, 151, 81, 115, 183, 243, 236, 210, 118, 16, 9, 118, 221, 74,
-			70, 19, 241, 182, 28, 197, 5, 203, 49, 66, 98, 105, 197, 197,
-			158, 219, 78, 21, 75, 40, 7, 85, 98, 155, 26, 79, 159, 5,
-			30, 91, 54, 219, 37, 169, 144, 200, 162, 91, 3, 223, 143, 186,
-			246, 64, 100, 56, 161, 59, 18, 79, 196, 22, 37, 210, 176, 139,
-			101, 172, 44, 98, 55, 132, 234, 225, 208, 135, 195, 239, 2, 28,
-			126, 143, 195, 73, 137, 227, 189, 121, 146, 109, 193, 117, 167, 32,
-			34, 151, 233, 60, 14, 93, 24, 38, 100, 108, 12, 190, 19, 129,
-			116, 5, 24, 213, 208, 227, 195, 82, 100, 24, 27, 131, 42, 229,
-			11, 244, 232, 29, 26, 86, 100, 138, 177, 49, 114, 62, 246, 126,
-			19, 235, 183, 203, 16, 19, 141, 179, 248, 109, 149, 132, 23, 161,
-			151, 242, 232, 109, 167, 79, 226, 227, 41, 114, 241, 42, 134, 213,
-			210, 222, 6, 171, 178, 163, 242, 137, 100, 229, 221, 240, 25, 136,
-			136, 254, 155, 224, 85, 119, 5, 113, 0, 201, 163, 163, 250, 243,
-			93, 229, 61, 120, 151, 71, 19, 198, 155, 2, 139, 135, 6, 182,
-			136, 81, 28, 229, 209, 234, 199, 168, 93, 220, 156, 81, 94, 163,
-			226, 43, 119, 158, 97, 76, 84, 183, 195, 72, 48, 99, 199, 228,
-			211, 153, 151, 172, 209, 118, 62, 39, 101, 186, 120, 147, 31, 146,
-			73, 249, 228, 230, 142, 197, 114, 97, 226, 156, 216, 175, 32, 208,
-			66, 92, 193, 53, 220, 170, 95, 247, 189, 97, 238, 206, 189, 159,
-			155, 31, 190, 156, 225, 166, 130, 253, 220, 252, 240, 229, 12, 199,
-			245, 217, 207, 205, 15, 95, 206, 236, 63, 20, 63, 208, 233, 3,
-			235, 72, 252, 32, 75, 31, 28, 61, 135, 251, 113, 150, 63, 208,
-			232, 147, 99, 35, 248, 91, 26, 95, 236, 200, 52, 254, 33, 93,
-			236, 95, 215, 68, 232, 225, 6, 36, 241, 103, 138, 122, 180, 17,
-			56, 14, 75, 187, 222, 10, 164, 156, 53, 201, 51, 38, 215, 93,
-			143, 234, 5, 240, 119, 213, 175, 183, 26, 94, 30, 19, 122, 84,
-			211, 31, 98, 193, 53, 175, 56, 110, 218, 97, 216, 106, 56, 53,
-			118, 44, 219, 161, 82, 209, 112, 30, 62, 101, 245, 72, 132, 0,
-			59, 144, 209, 67, 174, 167, 38, 20, 102, 180, 135, 160, 29, 30,
-			15, 84, 221, 44, 16, 197, 53, 150, 214, 201, 248, 143, 85, 41,
-			47, 64, 104, 149, 175, 56, 129, 63, 202, 204, 250, 84, 242, 144,
-			174, 203, 155, 126, 139, 29, 19, 60, 57, 49, 177, 107, 53, 76,
-			206, 67, 20, 18, 221, 184, 248, 61, 72, 205, 13, 155, 117, 123,
-			211, 21, 144, 138, 45, 22, 77, 41, 232, 142, 12, 32, 106, 60,
-			119, 40, 77, 31, 40, 115, 135, 128, 236, 202, 220, 33, 157, 62,
-			80, 230, 14, 101, 233, 3, 101, 238, 16, 157, 187, 127, 72, 231,
-			238, 255, 191, 139, 207, 157, 102, 26, 127, 156, 49, 134, 173, 191,
-			182, 75, 230, 2, 95, 4, 141, 147, 30, 108, 101, 111, 205, 79,
-			154, 252, 100, 26, 81, 133, 99, 69, 170, 97, 27, 146, 42, 109,
-			82, 117, 180, 193, 101, 105, 144, 87, 220, 248, 242, 7, 14, 109,
-			0, 59, 100, 251, 105, 204, 245, 92, 107, 135, 15, 132, 247, 173,
-			64, 180, 16, 12, 175, 30, 237, 24, 147, 34, 100, 46, 229, 71,
-			32, 24, 185, 59, 219, 245, 61, 112, 151, 117, 170, 45, 8, 8,
-			163, 175, 133, 44, 97, 36, 241, 124, 198, 83, 152, 153, 159, 218,
-			190, 98, 199, 176, 124, 159, 93, 234, 69, 220, 210, 12, 200, 148,
-			82, 19, 128, 70, 105, 135, 234, 142, 77, 229, 137, 149, 154, 195,
-			250, 189, 34, 59, 4, 21, 220, 115, 156, 38, 61, 252, 236, 245,
-			192, 110, 110, 64, 183, 229, 11, 192, 110, 172, 3, 88, 16, 107,
-			104, 181, 21, 129, 220, 84, 245, 61, 143, 185, 147, 71, 254, 48,
-			179, 113, 51, 87, 112, 177, 154, 10, 236, 72, 148, 117, 131, 143,
-			191, 176, 196, 174, 110, 178, 156, 55, 237, 131, 241, 99, 138, 197,
-			135, 41, 19, 21, 98, 109, 65, 6, 32, 207, 67, 240, 198, 70,
-			252, 9, 143, 175, 80, 115, 145, 92, 149, 63, 54, 236, 224, 30,
-			93, 39, 204, 2, 62, 54, 54, 204, 212, 170, 16, 0, 29, 29,
-			144, 255, 185, 192, 199, 196, 84, 65, 135, 188, 160, 33, 229, 135,
-			136, 231, 55, 2, 166, 241, 136, 29, 70, 78, 224, 134, 247, 98,
-			152, 88, 89, 93, 231, 142, 9, 42, 29, 100, 193, 164, 140, 225,
-			199, 113, 124, 204, 64, 16, 132, 81, 1, 147, 57, 231, 1, 208,
-			4, 56, 151, 135, 109, 198, 33, 159, 128, 56, 196, 112, 44, 68,
-			214, 253, 196, 193, 2, 25, 57, 164, 29, 1, 206, 222, 105, 62,
-			124, 149, 113, 215, 124, 31, 244, 226, 45, 126, 94, 181, 131, 66,
-			151, 106, 87, 237, 128, 157, 126, 221, 206, 178, 85, 251, 21, 50,
-			69, 46, 92, 221, 182, 218, 87, 68, 171, 69, 143, 75, 230, 148,
-			18, 29, 239, 108, 83, 199, 187, 90, 15, 121, 29, 59, 213, 36,
-			222, 84, 16, 73, 91, 171, 117, 135, 62, 103, 226, 1, 175, 96,
-			134, 47, 12, 201, 39, 12, 60, 38, 88, 87, 224, 53, 41, 195,
-			11, 38, 240, 3, 18, 5, 182, 11, 1, 4, 130, 69, 120, 85,
-			172, 85, 34, 190, 87, 17, 46, 3, 182, 21, 173, 214, 109, 239,
-			30, 99, 122, 177, 26, 120, 192, 36, 147, 0, 161, 26, 170, 81,
-			20, 118, 238, 94, 188, 180, 200, 68, 161, 235, 156, 176, 215, 166,
-			200, 37, 54, 43, 35, 228, 186, 202, 216, 146, 90, 32, 186, 141,
-			176, 132, 232, 48, 108, 50, 203, 199, 42, 216, 59, 228, 175, 8,
-			38, 231, 194, 70, 129, 140, 140, 109, 91, 51, 215, 34, 200, 8,
-			89, 15, 32, 61, 32, 255, 160, 141, 177, 216, 143, 100, 138, 92,
-			150, 179, 194, 253, 19, 72, 173, 109, 248, 161, 114, 28, 105, 6,
-			156, 19, 241, 113, 164, 165, 233, 3, 126, 147, 1, 15, 16, 125,
-			48, 112, 42, 126, 160, 211, 7, 103, 135, 224, 38, 131, 62, 208,
-			77, 227, 79, 50, 198, 136, 124, 65, 55, 224, 65, 92, 167, 158,
-			166, 15, 148, 58, 117, 68, 31, 12, 156, 142, 31, 64, 29, 67,
-			195, 178, 78, 195, 52, 254, 52, 99, 76, 200, 23, 12, 246, 32,
-			174, 211, 72, 211, 7, 74, 157, 6, 162, 15, 6, 70, 227, 7,
-			58, 125, 48, 126, 30, 255, 49, 194, 154, 49, 96, 102, 254, 44,
-			147, 250, 104, 15, 178, 254, 21, 82, 160, 208, 216, 174, 88, 103,
-			106, 214, 134, 219, 36, 171, 78, 244, 192, 113, 188, 182, 176, 34,
-			166, 111, 71, 97, 187, 121, 90, 164, 230, 47, 198, 217, 190, 229,
-			249, 170, 38, 17, 9, 67, 191, 234, 218, 242, 206, 75, 2, 140,
-			200, 86, 176, 106, 239, 142, 47, 203, 69, 118, 125, 144, 48, 33,
-			7, 58, 101, 177, 216, 75, 152, 127, 148, 72, 19, 193, 76, 211,
-			3, 200, 52, 254, 44, 147, 61, 140, 31, 98, 195, 24, 160, 122,
-			219, 127, 204, 104, 167, 173, 151, 73, 209, 35, 69, 233, 238, 34,
-			142, 160, 144, 169, 250, 96, 3, 160, 34, 169, 243, 16, 206, 140,
-			54, 34, 128, 73, 78, 156, 41, 88, 216, 65, 68, 250, 30, 111,
-			61, 1, 229, 203, 20, 208, 1, 16, 86, 255, 163, 208, 71, 7,
-			64, 31, 253, 143, 153, 190, 3, 162, 136, 104, 241, 32, 17, 69,
-			157, 22, 79, 158, 2, 125, 116, 128, 234, 163, 223, 207, 104, 31,
-			233, 97, 250, 232, 0, 232, 163, 223, 207, 224, 1, 252, 87, 17,
-			206, 208, 50, 29, 215, 15, 168, 136, 250, 227, 170, 58, 10, 62,
-			154, 201, 147, 175, 253, 90, 33, 1, 58, 28, 3, 54, 129, 127,
-			49, 179, 162, 195, 177, 44, 140, 68, 118, 187, 244, 84, 16, 214,
-			253, 2, 179, 31, 1, 215, 13, 112, 217, 252, 7, 130, 81, 7,
-			184, 108, 254, 3, 33, 223, 13, 112, 217, 252, 7, 66, 190, 27,
-			224, 178, 249, 15, 132, 124, 55, 192, 101, 243, 31, 8, 249, 110,
-			64, 200, 230, 63, 160, 242, 221, 60, 31, 55, 50, 141, 247, 245,
-			24, 167, 172, 167, 218, 199, 13, 60, 0, 249, 144, 153, 110, 34,
-			176, 59, 186, 142, 95, 233, 55, 149, 75, 223, 215, 163, 244, 155,
-			202, 165, 239, 235, 225, 11, 108, 128, 203, 165, 239, 235, 25, 56,
-			30, 63, 208, 233, 131, 220, 73, 252, 128, 119, 74, 51, 141, 15,
-			244, 24, 199, 172, 245, 246, 78, 129, 36, 207, 78, 239, 181, 208,
-			129, 73, 97, 96, 255, 124, 118, 146, 124, 198, 115, 64, 195, 178,
-			140, 253, 223, 149, 11, 68, 118, 15, 175, 116, 158, 110, 23, 31,
-			80, 59, 79, 119, 177, 15, 244, 40, 68, 167, 4, 251, 64, 15,
-			135, 82, 29, 224, 187, 216, 7, 122, 142, 28, 197, 255, 171, 96,
-			37, 221, 52, 62, 220, 99, 28, 177, 254, 41, 234, 224, 37, 238,
-			86, 246, 40, 157, 231, 1, 148, 219, 116, 30, 106, 97, 121, 90,
-			28, 79, 148, 149, 155, 78, 186, 47, 52, 237, 48, 82, 212, 208,
-			192, 169, 59, 247, 1, 142, 122, 51, 114, 200, 16, 71, 58, 99,
-			169, 5, 132, 10, 9, 107, 118, 10, 170, 28, 101, 242, 210, 176,
-			66, 33, 186, 39, 127, 88, 165, 16, 221, 147, 63, 172, 82, 136,
-			238, 201, 31, 238, 225, 80, 176, 3, 124, 79, 254, 112, 207, 97,
-			11, 255, 252, 97, 124, 156, 165, 252, 30, 179, 155, 238, 24, 44,
-			148, 21, 17, 56, 206, 24, 201, 196, 60, 39, 184, 221, 116, 45,
-			158, 31, 124, 76, 228, 7, 31, 139, 93, 40, 216, 219, 35, 239,
-			198, 187, 65, 241, 191, 206, 43, 49, 31, 195, 214, 141, 114, 105,
-			118, 102, 229, 122, 233, 86, 241, 78, 121, 190, 178, 178, 60, 183,
-			184, 80, 154, 46, 223, 40, 151, 102, 250, 83, 230, 46, 156, 21,
-			176, 213, 253, 136, 150, 42, 165, 231, 150, 203, 149, 210, 76, 191,
-			102, 238, 197, 125, 243, 203, 75, 11, 203, 75, 43, 243, 115, 179,
-			47, 244, 235, 230, 30, 140, 203, 115, 178, 108, 152, 187, 113, 111,
-			249, 246, 237, 229, 165, 226, 245, 217, 82, 127, 122, 242, 46, 222,
-			147, 28, 130, 121, 172, 208, 158, 209, 28, 122, 199, 93, 47, 6,
-			255, 102, 150, 232, 67, 123, 38, 14, 23, 226, 49, 22, 18, 221,
-			175, 236, 94, 83, 139, 215, 155, 120, 79, 213, 111, 40, 175, 95,
-			55, 19, 239, 131, 149, 99, 1, 189, 189, 200, 223, 88, 247, 235,
-			182, 183, 94, 240, 131, 245, 177, 117, 199, 131, 78, 140, 177, 159,
-			236, 166, 27, 2, 209, 21, 15, 197, 171, 202, 223, 159, 215, 140,
-			155, 197, 133, 242, 51, 223, 63, 128, 51, 166, 177, 55, 53, 135,
-			240, 175, 25, 24, 237, 50, 245, 189, 41, 115, 226, 75, 6, 153,
-			246, 155, 155, 129, 187, 190, 17, 145, 137, 241, 243, 87, 184, 183,
-			32, 153, 157, 157, 166, 210, 206, 172, 91, 117, 188, 16, 112, 236,
-			106, 92, 117, 43, 54, 169, 168, 32, 126, 201, 147, 59, 44, 187,
-			9, 153, 40, 140, 147, 33, 176, 67, 243, 159, 114, 195, 87, 177,
-			132, 235, 73, 102, 24, 116, 225, 206, 163, 234, 52, 97, 173, 176,
-			28, 129, 182, 87, 117, 226, 180, 133, 188, 142, 2, 134, 52, 133,
-			0, 152, 187, 10, 231, 28, 85, 68, 155, 34, 254, 86, 188, 70,
-			236, 8, 51, 211, 216, 70, 20, 53, 39, 199, 198, 30, 60, 120,
-			80, 176, 161, 163, 64, 179, 58, 123, 45, 28, 155, 45, 79, 151,
-			230, 22, 75, 163, 19, 133, 113, 140, 201, 178, 7, 1, 138, 50,
-			120, 113, 117, 83, 96, 200, 81, 17, 182, 110, 63, 0, 131, 226,
-			122, 192, 211, 55, 185, 158, 200, 255, 145, 39, 161, 191, 22, 61,
-			0, 205, 166, 230, 82, 57, 112, 181, 21, 37, 168, 36, 58, 198,
-			49, 168, 197, 11, 62, 64, 239, 230, 138, 139, 164, 188, 152, 35,
-			215, 139, 139, 229, 197, 60, 38, 207, 151, 151, 110, 205, 47, 47,
-			145, 231, 139, 149, 74, 113, 110, 169, 92, 90, 36, 243, 21, 50,
-			61, 63, 55, 83, 166, 28, 189, 72, 230, 111, 144, 226, 220, 11,
-			228, 217, 242, 220, 76, 94, 4, 107, 58, 15, 169, 114, 30, 130,
-			255, 33, 56, 227, 213, 148, 164, 153, 162, 121, 233, 14, 46, 242,
-			227, 203, 28, 87, 235, 254, 125, 39, 0, 125, 9, 178, 47, 132,
-			28, 197, 207, 171, 97, 2, 121, 72, 184, 89, 185, 99, 68, 5,
-			140, 113, 22, 35, 205, 212, 247, 165, 246, 227, 94, 172, 233, 41,
-			83, 223, 159, 26, 161, 15, 179, 166, 126, 32, 245, 54, 250, 48,
-			219, 199, 254, 100, 15, 15, 166, 114, 240, 16, 179, 63, 217, 195,
-			67, 169, 11, 240, 144, 255, 201, 30, 14, 166, 206, 194, 67, 196,
-			254, 100, 15, 15, 243, 207, 79, 137, 63, 81, 143, 105, 28, 73,
-			13, 35, 252, 219, 58, 214, 122, 82, 166, 62, 164, 77, 90, 223,
-			212, 73, 145, 27, 214, 21, 27, 158, 28, 184, 72, 84, 192, 147,
-			89, 12, 137, 73, 207, 11, 128, 89, 42, 113, 229, 1, 205, 100,
-			24, 92, 169, 196, 74, 79, 36, 89, 76, 42, 132, 109, 6, 70,
-			242, 226, 144, 178, 254, 147, 59, 200, 48, 153, 34, 98, 71, 122,
-			9, 20, 133, 197, 200, 142, 56, 54, 198, 163, 124, 172, 108, 96,
-			236, 251, 246, 13, 105, 166, 197, 237, 41, 81, 84, 167, 21, 178,
-			245, 176, 67, 173, 241, 46, 216, 189, 210, 37, 183, 225, 132, 145,
-			221, 104, 82, 118, 115, 3, 103, 37, 114, 217, 88, 31, 169, 118,
-			165, 207, 121, 110, 185, 222, 161, 59, 98, 19, 126, 233, 42, 198,
-			24, 235, 61, 41, 205, 212, 143, 244, 156, 100, 127, 27, 116, 162,
-			249, 243, 140, 169, 15, 245, 241, 231, 200, 212, 135, 78, 77, 176,
-			191, 117, 83, 31, 186, 116, 5, 255, 161, 134, 181, 116, 202, 52,
-			206, 167, 230, 144, 245, 47, 53, 8, 191, 246, 106, 110, 21, 242,
-			76, 241, 189, 67, 77, 108, 98, 115, 220, 51, 198, 37, 67, 107,
-			234, 125, 20, 51, 208, 40, 210, 159, 220, 47, 64, 236, 126, 87,
-			203, 9, 35, 22, 215, 203, 234, 176, 67, 193, 82, 144, 108, 130,
-			171, 88, 54, 149, 6, 154, 173, 104, 88, 196, 168, 143, 140, 136,
-			219, 177, 145, 17, 53, 169, 177, 236, 150, 224, 193, 170, 95, 39,
-			28, 202, 143, 223, 129, 95, 165, 170, 44, 243, 141, 100, 142, 114,
-			97, 242, 75, 170, 138, 208, 45, 147, 231, 70, 216, 240, 31, 144,
-			226, 66, 25, 28, 51, 40, 191, 110, 216, 94, 173, 46, 133, 65,
-			145, 102, 14, 28, 203, 151, 100, 144, 212, 200, 72, 195, 222, 28,
-			25, 33, 129, 83, 117, 220, 251, 14, 100, 185, 76, 130, 177, 203,
-			91, 37, 140, 245, 52, 157, 132, 243, 105, 19, 63, 133, 141, 52,
-			149, 185, 245, 11, 218, 9, 107, 130, 76, 251, 222, 125, 42, 215,
-			48, 211, 0, 247, 74, 6, 234, 66, 4, 158, 18, 23, 12, 39,
-			3, 56, 157, 166, 211, 32, 1, 235, 23, 180, 163, 162, 164, 153,
-			250, 133, 227, 4, 255, 44, 130, 218, 145, 169, 63, 161, 237, 181,
-			62, 137, 120, 62, 28, 110, 125, 21, 164, 16, 134, 123, 59, 148,
-			26, 112, 1, 147, 231, 33, 111, 2, 100, 89, 96, 73, 12, 186,
-			145, 215, 14, 28, 18, 219, 157, 153, 103, 44, 187, 81, 230, 251,
-			8, 207, 17, 66, 156, 70, 115, 195, 14, 93, 192, 36, 86, 32,
-			3, 101, 255, 17, 244, 81, 150, 52, 83, 127, 98, 247, 30, 252,
-			53, 214, 127, 205, 212, 167, 180, 189, 214, 223, 167, 90, 102, 71,
-			151, 5, 115, 201, 84, 6, 140, 109, 29, 37, 51, 7, 123, 119,
-			100, 164, 209, 10, 41, 239, 172, 58, 113, 74, 96, 59, 76, 220,
-			177, 113, 238, 204, 51, 75, 246, 154, 237, 214, 1, 165, 212, 39,
-			53, 159, 132, 28, 63, 136, 95, 244, 122, 196, 9, 2, 186, 49,
-			182, 56, 94, 230, 221, 242, 220, 157, 226, 108, 121, 102, 165, 88,
-			185, 185, 124, 187, 52, 183, 116, 119, 88, 14, 143, 78, 193, 148,
-			28, 30, 12, 104, 247, 30, 252, 255, 176, 225, 233, 166, 62, 173,
-			153, 214, 159, 116, 29, 158, 178, 217, 238, 56, 66, 53, 215, 49,
-			44, 181, 176, 233, 211, 83, 60, 207, 179, 184, 84, 235, 45, 17,
-			161, 128, 213, 235, 96, 62, 234, 4, 58, 27, 19, 77, 56, 76,
-			52, 163, 156, 184, 118, 117, 35, 118, 236, 193, 83, 88, 143, 12,
-			23, 45, 166, 137, 205, 50, 52, 134, 144, 246, 72, 201, 64, 119,
-			54, 228, 208, 69, 85, 39, 166, 141, 142, 232, 248, 119, 139, 146,
-			102, 234, 211, 253, 251, 240, 103, 25, 109, 12, 83, 191, 165, 237,
-			179, 62, 214, 149, 54, 176, 63, 188, 78, 210, 136, 93, 136, 95,
-			93, 227, 182, 123, 110, 249, 21, 93, 109, 170, 189, 83, 218, 58,
-			89, 119, 13, 68, 59, 184, 75, 148, 52, 83, 191, 181, 183, 31,
-			255, 109, 214, 249, 180, 169, 223, 214, 250, 173, 159, 234, 222, 249,
-			70, 163, 21, 81, 185, 105, 199, 190, 139, 21, 229, 208, 177, 86,
-			157, 228, 156, 69, 62, 119, 234, 38, 54, 6, 63, 109, 170, 139,
-			198, 214, 14, 158, 204, 135, 109, 151, 53, 102, 242, 131, 91, 5,
-			57, 130, 52, 162, 189, 236, 19, 37, 205, 212, 111, 239, 217, 187,
-			154, 97, 169, 212, 240, 183, 142, 227, 203, 235, 126, 161, 186, 17,
-			248, 13, 183, 213, 96, 194, 97, 171, 234, 142, 177, 217, 173, 173,
-			50, 149, 100, 236, 254, 249, 177, 102, 224, 176, 1, 112, 253, 165,
-			159, 190, 87, 16, 239, 21, 238, 159, 183, 46, 60, 98, 77, 85,
-			191, 209, 240, 61, 86, 77, 238, 247, 53, 188, 127, 201, 9, 163,
-			10, 188, 182, 32, 26, 49, 79, 225, 61, 145, 19, 70, 43, 110,
-			109, 37, 112, 214, 157, 135, 205, 65, 68, 208, 80, 111, 101, 23,
-			125, 90, 174, 85, 224, 153, 121, 13, 247, 220, 183, 3, 215, 246,
-			162, 65, 141, 160, 161, 190, 137, 92, 161, 189, 91, 133, 59, 236,
-			5, 89, 117, 69, 124, 98, 46, 96, 236, 60, 108, 58, 213, 200,
-			246, 170, 155, 131, 58, 65, 67, 123, 38, 198, 59, 43, 232, 210,
-			189, 66, 73, 126, 87, 81, 234, 48, 71, 177, 9, 57, 102, 106,
-			206, 138, 243, 208, 231, 90, 238, 160, 65, 208, 80, 182, 178, 143,
-			255, 82, 146, 63, 228, 214, 48, 142, 43, 50, 123, 176, 94, 156,
-			157, 237, 79, 153, 167, 48, 185, 83, 172, 148, 139, 115, 75, 139,
-			43, 84, 22, 94, 89, 158, 43, 189, 109, 161, 52, 189, 84, 154,
-			89, 169, 148, 22, 151, 103, 151, 22, 251, 145, 57, 140, 79, 39,
-			223, 162, 114, 69, 183, 87, 181, 220, 123, 241, 32, 29, 132, 104,
-			217, 245, 189, 55, 149, 208, 185, 159, 68, 184, 191, 253, 87, 243,
-			2, 206, 56, 239, 106, 217, 245, 16, 26, 236, 155, 56, 188, 101,
-			141, 183, 82, 21, 254, 170, 249, 56, 206, 114, 11, 95, 200, 59,
-			178, 237, 103, 242, 229, 235, 125, 184, 87, 114, 240, 245, 243, 111,
-			31, 123, 52, 94, 189, 202, 158, 52, 87, 159, 249, 220, 97, 170,
-			47, 238, 73, 189, 128, 240, 175, 51, 125, 113, 79, 202, 156, 248,
-			149, 14, 125, 113, 137, 170, 6, 203, 211, 101, 82, 132, 52, 81,
-			225, 127, 150, 90, 227, 91, 106, 227, 155, 160, 54, 50, 173, 177,
-			63, 117, 88, 104, 141, 102, 106, 138, 107, 120, 3, 169, 27, 66,
-			107, 164, 127, 254, 10, 194, 154, 145, 162, 58, 222, 52, 178, 190,
-			128, 72, 69, 189, 135, 94, 107, 121, 44, 241, 114, 188, 77, 145,
-			209, 39, 201, 170, 239, 83, 49, 175, 4, 247, 157, 18, 98, 192,
-			142, 170, 27, 14, 3, 209, 167, 203, 155, 31, 226, 192, 164, 183,
-			253, 48, 34, 85, 30, 47, 197, 242, 63, 137, 107, 123, 122, 52,
-			177, 77, 206, 161, 155, 68, 200, 177, 143, 118, 222, 165, 152, 48,
-			108, 80, 233, 245, 72, 246, 8, 254, 41, 132, 13, 136, 179, 211,
-			31, 211, 142, 90, 63, 129, 72, 81, 237, 133, 2, 253, 111, 179,
-			231, 110, 141, 245, 88, 102, 104, 11, 28, 134, 16, 192, 39, 13,
-			242, 45, 50, 7, 83, 170, 171, 130, 35, 44, 88, 31, 229, 207,
-			34, 7, 35, 79, 226, 44, 96, 159, 97, 129, 188, 19, 132, 131,
-			83, 236, 164, 52, 192, 236, 172, 63, 166, 101, 69, 9, 153, 250,
-			99, 189, 135, 68, 73, 55, 245, 199, 172, 35, 248, 89, 24, 0,
-			50, 245, 19, 218, 113, 235, 201, 109, 250, 207, 119, 62, 18, 218,
-			145, 27, 178, 12, 149, 204, 251, 69, 158, 32, 162, 89, 148, 161,
-			181, 153, 162, 68, 235, 222, 111, 137, 146, 110, 234, 39, 142, 61,
-			134, 111, 209, 102, 41, 7, 156, 210, 46, 235, 214, 36, 185, 225,
-			214, 35, 240, 159, 81, 230, 144, 199, 85, 38, 24, 161, 32, 102,
-			77, 234, 53, 80, 47, 76, 200, 169, 158, 125, 248, 33, 206, 208,
-			18, 157, 145, 179, 198, 46, 203, 5, 156, 134, 68, 165, 172, 255,
-			238, 38, 87, 71, 232, 218, 154, 43, 207, 221, 156, 148, 49, 173,
-			110, 8, 57, 227, 64, 235, 240, 40, 141, 93, 143, 10, 44, 33,
-			36, 144, 99, 2, 42, 64, 180, 2, 177, 235, 118, 4, 158, 37,
-			24, 239, 193, 61, 172, 101, 68, 155, 238, 137, 203, 154, 169, 159,
-			197, 125, 248, 83, 58, 239, 26, 213, 163, 140, 97, 235, 39, 244,
-			110, 196, 94, 117, 32, 158, 22, 188, 70, 224, 71, 65, 118, 16,
-			177, 54, 168, 124, 237, 129, 195, 77, 195, 167, 123, 76, 203, 147,
-			244, 16, 140, 79, 202, 145, 200, 160, 11, 238, 208, 145, 79, 214,
-			156, 168, 186, 193, 28, 44, 149, 15, 64, 97, 168, 219, 247, 92,
-			170, 101, 118, 84, 131, 219, 209, 51, 228, 60, 195, 214, 165, 186,
-			240, 57, 201, 158, 2, 158, 115, 161, 45, 56, 128, 106, 81, 201,
-			215, 88, 139, 116, 189, 47, 20, 23, 23, 161, 55, 27, 160, 29,
-			65, 251, 152, 188, 120, 163, 88, 158, 205, 19, 246, 95, 250, 202,
-			75, 28, 4, 153, 46, 245, 228, 100, 110, 182, 117, 144, 223, 23,
-			99, 158, 49, 81, 229, 91, 97, 206, 87, 185, 86, 204, 20, 130,
-			153, 57, 21, 151, 53, 83, 63, 127, 118, 8, 174, 54, 82, 16,
-			211, 170, 95, 50, 10, 214, 6, 89, 100, 129, 16, 148, 180, 59,
-			237, 23, 2, 132, 62, 73, 35, 158, 239, 45, 129, 66, 210, 101,
-			6, 68, 71, 40, 203, 92, 50, 134, 227, 50, 237, 73, 126, 20,
-			127, 131, 237, 62, 154, 169, 95, 213, 142, 90, 255, 160, 235, 238,
-			3, 219, 77, 219, 74, 125, 164, 133, 181, 221, 246, 73, 143, 136,
-			128, 225, 139, 43, 25, 66, 229, 37, 189, 176, 3, 96, 197, 115,
-			138, 39, 173, 21, 185, 113, 19, 43, 73, 238, 28, 90, 134, 14,
-			101, 151, 40, 33, 83, 191, 186, 91, 108, 88, 84, 235, 188, 106,
-			29, 193, 255, 136, 13, 90, 55, 245, 235, 218, 99, 214, 87, 16,
-			184, 54, 1, 68, 246, 26, 236, 33, 132, 161, 197, 250, 18, 159,
-			92, 161, 59, 93, 239, 183, 91, 60, 197, 103, 156, 58, 17, 54,
-			207, 88, 108, 45, 20, 103, 103, 41, 255, 151, 215, 88, 216, 96,
-			94, 92, 170, 209, 218, 221, 144, 204, 205, 47, 241, 188, 167, 76,
-			135, 231, 185, 249, 228, 36, 11, 242, 83, 225, 36, 80, 149, 154,
-			184, 87, 114, 196, 122, 154, 142, 35, 35, 74, 200, 212, 175, 247,
-			28, 22, 37, 58, 198, 163, 199, 240, 203, 12, 143, 250, 102, 106,
-			14, 89, 239, 220, 230, 168, 84, 132, 225, 71, 63, 47, 157, 248,
-			163, 144, 31, 110, 116, 29, 220, 204, 18, 252, 51, 148, 210, 136,
-			110, 165, 207, 104, 71, 173, 143, 74, 246, 82, 62, 249, 79, 123,
-			194, 33, 56, 225, 158, 225, 39, 28, 36, 223, 213, 159, 225, 39,
-			28, 130, 19, 238, 25, 235, 8, 94, 128, 81, 128, 210, 120, 220,
-			154, 222, 105, 16, 143, 122, 204, 33, 56, 230, 110, 243, 99, 14,
-			114, 245, 234, 183, 249, 49, 135, 224, 152, 187, 125, 236, 49, 124,
-			17, 83, 21, 219, 120, 46, 245, 2, 178, 134, 182, 152, 58, 46,
-			219, 199, 83, 6, 147, 64, 59, 252, 92, 118, 16, 34, 241, 180,
-			108, 202, 52, 42, 218, 219, 116, 168, 93, 203, 210, 113, 86, 178,
-			251, 32, 79, 159, 70, 231, 103, 201, 56, 100, 77, 144, 162, 236,
-			188, 200, 38, 9, 42, 6, 235, 127, 236, 223, 44, 156, 55, 249,
-			64, 52, 45, 149, 161, 53, 244, 137, 18, 50, 245, 165, 93, 166,
-			40, 233, 166, 190, 116, 224, 32, 126, 6, 154, 66, 166, 254, 188,
-			113, 216, 154, 138, 155, 58, 27, 146, 123, 206, 230, 40, 11, 14,
-			109, 218, 110, 16, 178, 198, 133, 211, 2, 115, 181, 19, 208, 159,
-			190, 231, 200, 86, 41, 249, 158, 151, 173, 82, 242, 61, 191, 107,
-			64, 148, 116, 83, 127, 254, 208, 160, 84, 234, 63, 123, 13, 63,
-			254, 136, 170, 184, 235, 221, 151, 183, 242, 91, 104, 245, 59, 93,
-			100, 202, 23, 228, 229, 101, 36, 140, 228, 252, 133, 215, 99, 23,
-			176, 94, 167, 89, 34, 247, 237, 52, 198, 101, 57, 42, 211, 194,
-			134, 103, 55, 28, 166, 211, 94, 207, 252, 94, 81, 255, 189, 98,
-			186, 2, 207, 204, 41, 156, 134, 235, 6, 80, 36, 247, 116, 211,
-			104, 227, 138, 10, 112, 61, 113, 93, 255, 189, 162, 94, 97, 95,
-			153, 211, 184, 143, 153, 102, 224, 18, 0, 148, 252, 190, 9, 171,
-			227, 98, 84, 94, 25, 200, 214, 49, 251, 140, 254, 96, 142, 99,
-			35, 178, 215, 195, 193, 52, 209, 135, 250, 38, 142, 118, 118, 97,
-			17, 238, 84, 22, 108, 55, 168, 192, 155, 230, 12, 222, 189, 230,
-			122, 144, 61, 150, 53, 156, 217, 177, 97, 232, 245, 46, 241, 21,
-			180, 123, 25, 103, 107, 142, 93, 171, 187, 158, 51, 216, 179, 83,
-			5, 21, 249, 174, 121, 25, 15, 8, 115, 217, 74, 204, 60, 225,
-			96, 150, 232, 67, 189, 172, 161, 253, 226, 133, 152, 122, 161, 249,
-			44, 238, 95, 117, 215, 223, 213, 114, 130, 205, 21, 231, 97, 211,
-			15, 162, 112, 176, 23, 198, 76, 58, 199, 124, 221, 93, 127, 142,
-			190, 89, 130, 23, 43, 123, 197, 151, 172, 28, 154, 57, 204, 73,
-			88, 91, 89, 221, 28, 196, 48, 181, 208, 116, 47, 127, 124, 125,
-			211, 60, 135, 247, 241, 60, 2, 193, 138, 176, 155, 13, 246, 129,
-			101, 163, 95, 252, 80, 225, 207, 205, 1, 156, 14, 28, 187, 222,
-			24, 220, 5, 47, 176, 130, 89, 198, 123, 55, 220, 48, 242, 131,
-			205, 21, 30, 99, 63, 184, 27, 72, 213, 165, 203, 183, 216, 139,
-			252, 2, 188, 178, 103, 35, 81, 206, 149, 113, 26, 24, 200, 60,
-			128, 247, 45, 46, 21, 151, 74, 109, 183, 245, 24, 103, 138, 211,
-			75, 229, 59, 165, 126, 100, 238, 193, 248, 70, 121, 174, 56, 91,
-			126, 123, 121, 238, 102, 191, 102, 238, 198, 189, 188, 92, 154, 233,
-			215, 159, 49, 178, 122, 191, 145, 251, 43, 26, 222, 147, 36, 147,
-			121, 12, 247, 52, 3, 255, 101, 167, 26, 113, 110, 215, 127, 175,
-			168, 85, 196, 51, 250, 115, 205, 142, 236, 208, 97, 22, 28, 241,
-			51, 127, 102, 30, 198, 105, 48, 87, 130, 25, 140, 255, 200, 158,
-			152, 243, 24, 108, 65, 66, 243, 227, 156, 158, 223, 105, 222, 20,
-			187, 89, 88, 233, 139, 226, 130, 85, 193, 125, 202, 111, 230, 180,
-			98, 154, 225, 182, 160, 211, 143, 100, 133, 171, 196, 223, 229, 254,
-			18, 194, 123, 146, 147, 96, 62, 129, 7, 91, 161, 163, 176, 233,
-			138, 220, 153, 160, 153, 108, 229, 96, 43, 116, 98, 46, 149, 252,
-			110, 62, 129, 51, 116, 51, 114, 133, 177, 171, 203, 132, 79, 195,
-			239, 11, 126, 8, 167, 68, 133, 191, 255, 122, 44, 75, 127, 48,
-			142, 123, 204, 244, 158, 212, 47, 160, 183, 76, 75, 111, 153, 150,
-			126, 84, 166, 165, 97, 248, 19, 153, 250, 126, 254, 167, 102, 234,
-			3, 169, 41, 248, 83, 55, 245, 3, 169, 167, 165, 31, 130, 180,
-			61, 209, 63, 63, 160, 129, 237, 41, 125, 60, 245, 19, 8, 89,
-			127, 70, 197, 218, 170, 239, 81, 206, 160, 194, 18, 23, 92, 28,
-			184, 52, 142, 117, 106, 113, 247, 193, 46, 52, 196, 193, 67, 135,
-			95, 78, 102, 57, 81, 148, 83, 17, 60, 73, 153, 173, 197, 6,
-			119, 85, 104, 196, 225, 36, 9, 31, 216, 65, 3, 228, 75, 59,
-			188, 151, 199, 44, 63, 205, 106, 171, 122, 207, 137, 216, 223, 121,
-			50, 253, 28, 177, 163, 200, 105, 0, 52, 200, 180, 223, 104, 250,
-			0, 153, 56, 9, 10, 190, 192, 34, 98, 126, 219, 202, 209, 149,
-			39, 161, 195, 125, 222, 67, 41, 7, 129, 66, 15, 46, 157, 181,
-			73, 114, 254, 162, 98, 199, 58, 158, 53, 241, 4, 253, 187, 215,
-			212, 137, 182, 59, 119, 6, 196, 80, 22, 232, 24, 180, 154, 145,
-			83, 203, 147, 106, 176, 218, 90, 47, 84, 253, 198, 216, 249, 241,
-			199, 159, 184, 124, 229, 50, 120, 152, 26, 70, 170, 55, 101, 234,
-			164, 143, 107, 108, 189, 180, 58, 165, 164, 177, 210, 23, 133, 145,
-			204, 24, 210, 206, 25, 214, 231, 17, 153, 78, 218, 39, 100, 68,
-			56, 75, 152, 38, 71, 194, 32, 65, 232, 26, 96, 219, 228, 204,
-			245, 194, 77, 39, 138, 183, 55, 76, 42, 11, 211, 204, 210, 208,
-			176, 163, 73, 149, 6, 99, 239, 46, 207, 221, 153, 159, 46, 82,
-			94, 94, 41, 207, 188, 135, 177, 169, 93, 15, 125, 88, 174, 225,
-			228, 216, 152, 237, 54, 11, 53, 231, 254, 216, 249, 9, 112, 251,
-			158, 87, 239, 45, 21, 3, 218, 80, 194, 128, 54, 212, 187, 91,
-			49, 160, 13, 245, 179, 244, 23, 204, 93, 115, 200, 60, 167, 227,
-			189, 56, 11, 197, 191, 153, 77, 153, 250, 176, 49, 170, 60, 64,
-			166, 62, 98, 156, 3, 137, 30, 76, 95, 163, 218, 53, 93, 49,
-			95, 141, 246, 236, 2, 215, 78, 110, 190, 26, 51, 44, 235, 105,
-			216, 33, 197, 173, 58, 203, 251, 203, 238, 222, 36, 182, 12, 208,
-			208, 149, 144, 153, 204, 60, 227, 51, 100, 157, 54, 171, 212, 152,
-			113, 32, 97, 149, 26, 27, 60, 140, 103, 98, 163, 212, 132, 177,
-			215, 186, 196, 129, 49, 238, 203, 8, 116, 59, 206, 193, 4, 177,
-			26, 85, 186, 80, 66, 112, 22, 232, 52, 84, 32, 168, 6, 39,
-			44, 40, 19, 187, 247, 224, 127, 134, 98, 19, 202, 227, 198, 126,
-			235, 31, 162, 246, 118, 92, 233, 116, 208, 12, 252, 170, 0, 188,
-			8, 108, 143, 157, 70, 224, 220, 239, 69, 62, 145, 114, 3, 27,
-			172, 184, 138, 228, 49, 221, 128, 193, 147, 132, 106, 12, 125, 186,
-			255, 65, 88, 18, 128, 243, 51, 127, 229, 154, 27, 56, 44, 211,
-			76, 128, 225, 30, 147, 23, 149, 139, 83, 201, 74, 100, 213, 169,
-			210, 101, 236, 122, 54, 192, 250, 183, 25, 102, 30, 55, 246, 36,
-			12, 51, 143, 239, 51, 241, 125, 62, 92, 221, 212, 175, 26, 166,
-			181, 222, 109, 180, 114, 71, 161, 100, 245, 124, 200, 163, 231, 4,
-			221, 8, 76, 60, 214, 75, 190, 158, 19, 189, 87, 58, 175, 244,
-			139, 238, 138, 87, 141, 221, 113, 89, 51, 245, 171, 253, 251, 240,
-			147, 194, 216, 91, 212, 158, 182, 206, 147, 105, 30, 105, 196, 248,
-			198, 23, 80, 12, 247, 149, 112, 220, 110, 171, 130, 106, 110, 69,
-			173, 71, 177, 239, 22, 179, 187, 21, 251, 110, 177, 127, 159, 40,
-			101, 77, 189, 104, 62, 197, 215, 0, 98, 139, 162, 56, 48, 197,
-			59, 162, 153, 70, 73, 187, 101, 88, 227, 2, 177, 123, 43, 214,
-			43, 116, 239, 136, 150, 49, 245, 146, 118, 68, 49, 23, 149, 142,
-			158, 85, 204, 69, 37, 142, 74, 155, 2, 248, 159, 82, 254, 150,
-			88, 158, 26, 235, 201, 13, 185, 60, 53, 182, 60, 111, 26, 231,
-			32, 249, 27, 204, 220, 172, 118, 220, 186, 78, 226, 221, 102, 20,
-			12, 155, 194, 37, 172, 77, 235, 45, 96, 82, 4, 180, 76, 110,
-			122, 21, 81, 86, 177, 161, 199, 160, 53, 202, 82, 198, 212, 103,
-			251, 14, 40, 102, 159, 217, 131, 150, 98, 246, 153, 61, 246, 24,
-			254, 15, 108, 219, 52, 76, 99, 73, 91, 190, 104, 253, 12, 218,
-			146, 74, 242, 56, 18, 246, 20, 185, 110, 216, 254, 218, 185, 104,
-			68, 56, 160, 244, 26, 0, 104, 116, 145, 242, 79, 218, 124, 147,
-			44, 171, 156, 122, 109, 243, 49, 113, 155, 76, 77, 145, 27, 236,
-			119, 246, 254, 27, 73, 185, 195, 9, 97, 100, 76, 125, 73, 78,
-			175, 129, 76, 125, 233, 232, 176, 40, 233, 166, 190, 148, 31, 21,
-			165, 172, 169, 47, 103, 46, 240, 201, 52, 216, 236, 46, 103, 207,
-			227, 87, 25, 13, 211, 166, 254, 130, 54, 108, 181, 72, 236, 237,
-			246, 160, 27, 45, 121, 88, 14, 0, 37, 175, 181, 232, 246, 161,
-			158, 244, 252, 212, 226, 233, 28, 184, 69, 106, 185, 89, 179, 35,
-			69, 228, 38, 45, 47, 114, 235, 9, 90, 177, 62, 166, 51, 180,
-			19, 98, 52, 105, 100, 234, 47, 28, 61, 37, 74, 186, 169, 191,
-			112, 118, 8, 191, 154, 129, 238, 102, 76, 227, 158, 86, 191, 104,
-			125, 47, 77, 230, 32, 253, 59, 221, 181, 148, 13, 73, 217, 165,
-			196, 161, 233, 123, 78, 129, 204, 223, 119, 130, 54, 1, 132, 39,
-			76, 79, 204, 163, 77, 150, 231, 202, 243, 115, 138, 128, 67, 186,
-			109, 128, 157, 231, 49, 115, 50, 18, 223, 200, 192, 188, 110, 155,
-			102, 158, 4, 78, 181, 21, 132, 238, 125, 7, 156, 93, 218, 242,
-			63, 92, 111, 151, 117, 212, 46, 82, 209, 91, 2, 45, 42, 195,
-			102, 123, 55, 38, 213, 13, 250, 65, 66, 124, 10, 121, 207, 184,
-			28, 6, 6, 234, 152, 18, 210, 31, 16, 164, 42, 76, 28, 153,
-			130, 74, 224, 186, 200, 196, 169, 12, 192, 178, 21, 56, 137, 111,
-			4, 176, 57, 139, 18, 115, 5, 189, 184, 253, 215, 9, 28, 177,
-			236, 237, 8, 178, 155, 192, 37, 141, 83, 131, 208, 53, 22, 74,
-			36, 220, 15, 233, 102, 209, 54, 157, 28, 112, 54, 220, 176, 155,
-			178, 85, 214, 80, 156, 92, 131, 74, 129, 65, 129, 60, 79, 23,
-			38, 43, 196, 176, 83, 204, 237, 137, 14, 18, 147, 192, 177, 171,
-			27, 112, 170, 168, 13, 64, 80, 60, 169, 110, 86, 235, 60, 30,
-			18, 0, 7, 196, 137, 14, 248, 224, 126, 147, 142, 17, 116, 93,
-			69, 145, 229, 249, 13, 219, 174, 229, 228, 196, 139, 125, 230, 190,
-			67, 170, 117, 63, 100, 84, 195, 201, 177, 97, 64, 38, 171, 176,
-			124, 21, 65, 161, 204, 231, 181, 178, 48, 13, 112, 97, 126, 205,
-			93, 219, 84, 246, 34, 185, 92, 50, 134, 169, 223, 147, 251, 101,
-			38, 109, 234, 247, 250, 196, 177, 146, 65, 166, 126, 207, 60, 35,
-			74, 186, 169, 223, 27, 30, 17, 165, 172, 169, 215, 229, 86, 144,
-			97, 91, 65, 61, 123, 30, 135, 176, 180, 122, 76, 189, 169, 141,
-			89, 107, 164, 221, 118, 163, 120, 51, 61, 160, 84, 22, 154, 63,
-			129, 67, 122, 40, 28, 22, 1, 41, 140, 6, 220, 162, 153, 88,
-			90, 60, 124, 131, 85, 72, 64, 228, 102, 125, 234, 49, 104, 171,
-			178, 148, 49, 245, 102, 159, 48, 242, 247, 32, 83, 111, 90, 162,
-			247, 61, 186, 169, 55, 71, 11, 44, 191, 125, 74, 203, 154, 250,
-			67, 237, 166, 245, 111, 17, 211, 146, 89, 4, 73, 180, 201, 67,
-			111, 115, 173, 208, 9, 38, 175, 57, 13, 219, 173, 63, 153, 27,
-			38, 15, 54, 124, 41, 171, 181, 159, 228, 100, 73, 220, 196, 208,
-			229, 7, 181, 137, 44, 229, 118, 181, 234, 183, 188, 136, 85, 138,
-			121, 173, 213, 96, 84, 209, 71, 158, 182, 155, 205, 176, 233, 71,
-			133, 117, 254, 17, 255, 134, 106, 4, 185, 97, 118, 199, 69, 87,
-			0, 200, 215, 116, 25, 64, 196, 59, 78, 244, 243, 101, 127, 195,
-			171, 249, 206, 211, 124, 23, 96, 159, 110, 37, 98, 100, 211, 116,
-			228, 66, 240, 166, 71, 243, 195, 222, 253, 162, 164, 155, 250, 195,
-			131, 131, 162, 68, 105, 116, 248, 6, 159, 239, 44, 155, 239, 135,
-			71, 102, 240, 231, 52, 32, 97, 175, 169, 255, 184, 70, 172, 79,
-			104, 228, 70, 171, 94, 79, 164, 196, 20, 70, 52, 54, 179, 50,
-			71, 103, 219, 20, 39, 201, 184, 157, 22, 113, 146, 30, 25, 163,
-			162, 214, 81, 64, 238, 144, 132, 143, 21, 63, 76, 70, 201, 162,
-			186, 121, 77, 146, 220, 216, 152, 48, 176, 140, 194, 190, 86, 16,
-			4, 167, 42, 23, 108, 112, 99, 53, 199, 174, 173, 58, 206, 90,
-			142, 126, 223, 177, 127, 178, 58, 18, 147, 150, 168, 3, 158, 135,
-			99, 231, 39, 46, 92, 188, 116, 249, 241, 39, 174, 140, 231, 36,
-			173, 123, 211, 148, 68, 130, 214, 189, 200, 212, 127, 188, 87, 200,
-			34, 189, 186, 169, 255, 248, 177, 227, 248, 43, 140, 31, 177, 105,
-			188, 31, 105, 3, 214, 47, 32, 82, 113, 236, 122, 163, 171, 164,
-			224, 60, 116, 195, 136, 107, 241, 156, 98, 130, 88, 210, 138, 196,
-			12, 179, 140, 80, 208, 63, 215, 91, 11, 108, 102, 88, 162, 255,
-			25, 109, 110, 142, 157, 27, 11, 156, 181, 112, 108, 195, 177, 107,
-			225, 88, 3, 66, 104, 199, 64, 194, 95, 119, 61, 103, 204, 110,
-			69, 27, 43, 156, 27, 185, 9, 10, 108, 156, 225, 74, 213, 247,
-			214, 220, 117, 166, 245, 98, 46, 255, 225, 52, 244, 60, 43, 138,
-			136, 22, 123, 247, 136, 162, 78, 139, 251, 246, 195, 45, 71, 74,
-			235, 51, 141, 15, 34, 237, 140, 117, 77, 120, 31, 59, 33, 113,
-			215, 198, 54, 252, 7, 204, 34, 83, 115, 30, 242, 104, 121, 142,
-			66, 40, 114, 236, 38, 68, 103, 86, 117, 95, 6, 42, 235, 23,
-			69, 68, 139, 251, 136, 40, 234, 180, 120, 242, 52, 254, 49, 184,
-			226, 203, 124, 4, 165, 254, 38, 66, 150, 71, 146, 118, 199, 118,
-			79, 203, 173, 57, 84, 9, 33, 99, 219, 16, 79, 253, 39, 119,
-			51, 166, 9, 173, 41, 146, 26, 143, 21, 69, 200, 52, 62, 130,
-			178, 7, 241, 40, 191, 244, 51, 126, 18, 105, 79, 91, 199, 65,
-			2, 17, 43, 70, 86, 195, 77, 176, 124, 152, 112, 15, 71, 223,
-			207, 138, 34, 162, 197, 222, 126, 81, 212, 105, 113, 255, 128, 40,
-			102, 105, 241, 192, 83, 16, 218, 136, 184, 174, 108, 252, 36, 58,
-			52, 197, 27, 71, 166, 241, 177, 109, 26, 159, 97, 6, 94, 217,
-			56, 74, 195, 251, 162, 113, 4, 159, 203, 198, 145, 78, 139, 178,
-			113, 148, 165, 69, 217, 56, 211, 73, 140, 143, 209, 198, 207, 65,
-			227, 154, 105, 124, 18, 105, 79, 90, 199, 186, 55, 190, 196, 60,
-			96, 121, 109, 90, 26, 222, 22, 77, 211, 158, 127, 82, 240, 21,
-			130, 248, 194, 79, 162, 125, 166, 40, 102, 105, 113, 255, 20, 111,
-			154, 41, 33, 198, 39, 209, 129, 171, 184, 66, 155, 214, 83, 102,
-			230, 167, 145, 246, 51, 72, 183, 174, 43, 55, 243, 221, 121, 32,
-			233, 211, 210, 49, 235, 162, 135, 16, 46, 251, 211, 8, 31, 192,
-			54, 206, 208, 34, 0, 120, 35, 227, 140, 245, 28, 28, 204, 177,
-			79, 0, 4, 221, 195, 129, 167, 214, 12, 109, 117, 86, 15, 190,
-			82, 201, 35, 146, 7, 26, 66, 19, 25, 104, 227, 80, 252, 0,
-			209, 7, 131, 39, 226, 7, 58, 125, 112, 234, 52, 24, 67, 16,
-			165, 211, 167, 145, 118, 156, 211, 73, 207, 64, 113, 183, 40, 34,
-			90, 220, 99, 137, 34, 188, 124, 236, 49, 252, 51, 8, 46, 87,
-			51, 159, 67, 169, 95, 64, 200, 250, 8, 34, 73, 107, 185, 66,
-			49, 88, 186, 27, 73, 105, 50, 30, 20, 203, 127, 89, 203, 19,
-			136, 169, 100, 27, 154, 27, 96, 73, 3, 46, 219, 81, 234, 64,
-			240, 38, 203, 45, 24, 64, 60, 7, 101, 15, 183, 1, 30, 57,
-			60, 229, 135, 27, 137, 240, 107, 64, 193, 166, 75, 234, 5, 126,
-			79, 107, 124, 30, 105, 167, 172, 103, 99, 40, 168, 196, 118, 34,
-			189, 158, 54, 229, 238, 194, 179, 8, 198, 221, 62, 43, 84, 98,
-			48, 252, 243, 73, 214, 96, 249, 125, 30, 105, 25, 81, 68, 180,
-			216, 115, 92, 20, 117, 90, 204, 157, 196, 159, 71, 252, 22, 215,
-			248, 59, 72, 59, 10, 225, 21, 29, 61, 89, 221, 228, 163, 32,
-			77, 126, 19, 0, 54, 206, 179, 33, 105, 53, 69, 36, 43, 116,
-			33, 14, 179, 81, 182, 31, 238, 90, 199, 64, 22, 36, 148, 97,
-			125, 19, 136, 134, 25, 169, 120, 110, 64, 25, 87, 173, 58, 104,
-			200, 17, 161, 12, 116, 178, 95, 20, 161, 207, 251, 14, 137, 162,
-			78, 139, 214, 17, 121, 65, 252, 107, 135, 241, 197, 71, 188, 94,
-			173, 57, 129, 123, 223, 9, 94, 255, 237, 240, 235, 189, 137, 206,
-			253, 38, 194, 199, 103, 160, 245, 105, 254, 121, 172, 54, 86, 152,
-			119, 188, 185, 129, 119, 11, 197, 102, 133, 158, 251, 252, 246, 232,
-			201, 206, 235, 154, 29, 106, 42, 8, 17, 99, 201, 14, 239, 177,
-			203, 175, 93, 161, 242, 200, 154, 193, 187, 212, 87, 204, 227, 56,
-			187, 225, 135, 145, 114, 183, 12, 31, 201, 135, 230, 126, 172, 185,
-			53, 245, 166, 77, 115, 107, 35, 159, 69, 120, 108, 171, 158, 44,
-			4, 78, 213, 247, 24, 44, 236, 13, 22, 153, 178, 180, 217, 116,
-			204, 25, 252, 244, 76, 169, 82, 190, 83, 90, 153, 190, 85, 153,
-			191, 93, 94, 190, 189, 162, 24, 107, 23, 42, 37, 121, 11, 177,
-			114, 163, 88, 158, 93, 174, 148, 86, 150, 94, 88, 104, 191, 96,
-			60, 141, 79, 148, 231, 166, 231, 111, 47, 204, 150, 150, 148, 154,
-			22, 159, 47, 86, 110, 151, 231, 110, 174, 44, 21, 23, 159, 237,
-			71, 19, 15, 113, 15, 235, 95, 96, 54, 240, 224, 86, 93, 53,
-			207, 191, 102, 2, 91, 93, 174, 182, 227, 151, 114, 169, 215, 115,
-			113, 246, 39, 251, 153, 75, 118, 105, 187, 123, 179, 137, 241, 183,
-			238, 205, 222, 186, 55, 251, 161, 220, 155, 21, 101, 76, 239, 141,
-			56, 166, 247, 6, 254, 223, 16, 214, 50, 41, 211, 56, 154, 202,
-			33, 235, 95, 208, 83, 130, 105, 138, 145, 79, 216, 238, 217, 225,
-			106, 189, 52, 63, 51, 63, 73, 42, 144, 206, 38, 78, 247, 211,
-			112, 108, 47, 98, 177, 85, 145, 211, 104, 250, 129, 29, 108, 74,
-			181, 83, 12, 23, 76, 47, 13, 167, 230, 194, 143, 17, 64, 5,
-			187, 34, 103, 24, 0, 202, 64, 139, 234, 209, 205, 76, 41, 9,
-			3, 16, 41, 207, 132, 121, 18, 216, 113, 50, 168, 13, 251, 190,
-			131, 185, 113, 168, 97, 223, 3, 147, 67, 108, 232, 98, 247, 95,
-			153, 20, 50, 245, 163, 217, 189, 248, 91, 8, 27, 25, 184, 118,
-			57, 161, 61, 111, 253, 58, 34, 108, 241, 135, 44, 217, 164, 60,
-			222, 32, 246, 170, 195, 20, 98, 19, 177, 73, 36, 251, 84, 192,
-			164, 34, 236, 39, 27, 98, 32, 170, 165, 235, 106, 87, 41, 46,
-			112, 162, 192, 117, 232, 155, 224, 145, 140, 227, 203, 175, 89, 55,
-			140, 84, 235, 140, 31, 196, 191, 181, 155, 110, 152, 122, 151, 97,
-			55, 63, 39, 50, 143, 137, 146, 102, 234, 39, 142, 63, 41, 74,
-			186, 169, 159, 40, 47, 226, 34, 243, 198, 63, 157, 154, 66, 214,
-			37, 82, 148, 177, 90, 194, 107, 144, 206, 213, 86, 219, 33, 187,
-			131, 147, 183, 137, 167, 179, 103, 65, 144, 78, 233, 112, 223, 119,
-			69, 183, 142, 169, 169, 52, 236, 118, 18, 49, 69, 83, 135, 187,
-			53, 124, 16, 0, 247, 83, 32, 165, 234, 35, 198, 180, 53, 24,
-			107, 202, 27, 96, 87, 91, 19, 159, 193, 213, 6, 75, 173, 162,
-			143, 24, 74, 25, 153, 250, 72, 223, 254, 184, 172, 155, 250, 200,
-			193, 67, 113, 57, 107, 234, 35, 131, 215, 241, 62, 140, 121, 25,
-			44, 6, 35, 214, 211, 248, 125, 105, 222, 56, 50, 245, 39, 140,
-			41, 235, 187, 6, 41, 207, 192, 45, 110, 130, 211, 34, 95, 222,
-			81, 65, 70, 32, 47, 116, 130, 72, 88, 8, 225, 13, 62, 145,
-			44, 199, 176, 189, 14, 201, 194, 69, 68, 82, 51, 112, 214, 220,
-			135, 147, 109, 101, 22, 114, 106, 87, 55, 24, 71, 132, 45, 55,
-			114, 216, 237, 39, 236, 149, 57, 207, 245, 94, 182, 133, 2, 237,
-			140, 209, 151, 38, 35, 72, 111, 19, 5, 155, 43, 235, 205, 214,
-			10, 93, 70, 28, 145, 119, 133, 254, 60, 22, 5, 118, 213, 97,
-			127, 230, 104, 45, 80, 197, 10, 195, 195, 154, 228, 225, 189, 245,
-			250, 230, 232, 187, 90, 118, 157, 101, 37, 129, 55, 4, 98, 150,
-			184, 155, 229, 136, 43, 210, 187, 21, 250, 179, 234, 122, 116, 181,
-			182, 194, 56, 165, 26, 92, 98, 179, 251, 218, 92, 178, 159, 171,
-			129, 255, 32, 116, 2, 232, 74, 8, 93, 97, 246, 9, 214, 9,
-			56, 198, 184, 37, 131, 159, 20, 44, 169, 50, 179, 107, 192, 219,
-			110, 189, 230, 4, 84, 6, 73, 124, 2, 79, 187, 125, 83, 16,
-			228, 6, 50, 78, 130, 83, 39, 199, 174, 200, 209, 231, 57, 58,
-			127, 77, 39, 136, 54, 33, 50, 209, 147, 71, 212, 51, 139, 243,
-			115, 44, 121, 14, 97, 121, 147, 31, 193, 112, 33, 126, 25, 11,
-			131, 234, 216, 57, 97, 167, 160, 205, 184, 222, 58, 51, 187, 172,
-			250, 17, 100, 235, 138, 105, 202, 226, 31, 97, 179, 111, 91, 13,
-			130, 77, 81, 154, 242, 160, 82, 166, 60, 217, 183, 59, 46, 235,
-			166, 254, 68, 255, 190, 184, 156, 53, 245, 39, 204, 107, 146, 173,
-			249, 93, 219, 19, 3, 147, 248, 154, 8, 81, 185, 166, 205, 90,
-			99, 124, 29, 183, 11, 238, 210, 166, 187, 216, 109, 121, 130, 139,
-			232, 53, 109, 143, 114, 17, 126, 109, 239, 81, 229, 34, 252, 218,
-			113, 34, 74, 89, 83, 191, 118, 226, 217, 228, 61, 248, 181, 147,
-			101, 252, 203, 136, 5, 236, 95, 79, 149, 144, 245, 11, 136, 106,
-			159, 53, 98, 139, 124, 4, 65, 179, 90, 232, 34, 47, 22, 238,
-			184, 62, 75, 204, 85, 128, 124, 180, 18, 111, 7, 14, 149, 197,
-			200, 142, 90, 33, 134, 228, 102, 110, 157, 129, 235, 108, 177, 73,
-			193, 117, 143, 18, 46, 11, 210, 139, 77, 186, 52, 9, 119, 100,
-			180, 214, 170, 95, 83, 99, 223, 175, 167, 47, 226, 62, 17, 251,
-			62, 173, 221, 84, 226, 216, 167, 181, 235, 74, 28, 251, 116, 233,
-			6, 127, 17, 153, 250, 140, 54, 164, 4, 140, 207, 104, 39, 149,
-			128, 241, 153, 51, 103, 165, 2, 243, 85, 19, 31, 105, 247, 58,
-			133, 204, 142, 92, 79, 217, 219, 230, 202, 152, 235, 193, 105, 240,
-			236, 190, 126, 31, 239, 87, 18, 205, 136, 223, 175, 99, 248, 85,
-			100, 153, 57, 187, 238, 70, 27, 173, 85, 224, 88, 150, 105, 38,
-			110, 166, 9, 185, 166, 89, 107, 255, 1, 161, 207, 107, 250, 205,
-			133, 235, 191, 168, 61, 198, 242, 196, 20, 22, 132, 243, 228, 243,
-			78, 189, 254, 172, 231, 63, 240, 168, 16, 31, 62, 243, 131, 126,
-			42, 171, 62, 150, 186, 208, 143, 255, 135, 93, 32, 171, 62, 150,
-			50, 39, 190, 190, 75, 194, 189, 9, 172, 55, 50, 26, 3, 212,
-			213, 236, 200, 102, 39, 62, 207, 135, 192, 242, 108, 225, 132, 128,
-			59, 254, 132, 200, 81, 83, 246, 170, 5, 2, 33, 60, 240, 91,
-			40, 97, 239, 10, 88, 46, 205, 154, 115, 223, 169, 211, 229, 28,
-			10, 26, 208, 65, 138, 192, 255, 81, 30, 248, 63, 134, 233, 57,
-			42, 197, 59, 113, 152, 183, 152, 115, 50, 183, 3, 211, 39, 124,
-			107, 163, 253, 18, 201, 68, 253, 64, 36, 212, 199, 236, 194, 194,
-			21, 238, 41, 144, 125, 150, 138, 100, 144, 41, 76, 198, 107, 199,
-			225, 208, 18, 56, 66, 114, 25, 7, 33, 104, 56, 209, 36, 151,
-			131, 71, 218, 58, 22, 182, 35, 131, 128, 67, 117, 224, 112, 127,
-			106, 145, 216, 184, 42, 40, 134, 169, 196, 14, 72, 160, 32, 177,
-			215, 93, 118, 78, 170, 45, 178, 144, 113, 165, 59, 53, 55, 172,
-			214, 109, 183, 225, 176, 4, 149, 221, 58, 225, 122, 42, 45, 68,
-			39, 184, 149, 60, 238, 7, 142, 59, 242, 134, 250, 33, 192, 173,
-			218, 48, 72, 108, 175, 54, 230, 7, 220, 199, 169, 97, 71, 78,
-			224, 218, 117, 37, 52, 94, 104, 33, 138, 108, 47, 192, 51, 232,
-			160, 230, 184, 0, 30, 41, 104, 88, 42, 111, 121, 126, 252, 155,
-			114, 193, 232, 123, 172, 42, 63, 144, 233, 33, 196, 222, 237, 120,
-			53, 63, 8, 29, 142, 234, 213, 240, 35, 135, 223, 28, 68, 161,
-			148, 240, 68, 22, 114, 55, 148, 186, 135, 132, 100, 144, 210, 125,
-			51, 112, 41, 99, 5, 148, 119, 60, 69, 176, 7, 73, 226, 86,
-			121, 145, 44, 206, 223, 88, 122, 190, 88, 41, 145, 242, 34, 89,
-			168, 204, 223, 41, 207, 148, 102, 200, 245, 23, 200, 210, 173, 18,
-			153, 158, 95, 120, 161, 82, 190, 121, 107, 137, 220, 154, 159, 157,
-			41, 85, 22, 73, 113, 110, 134, 106, 33, 75, 149, 242, 245, 229,
-			165, 249, 202, 34, 150, 154, 11, 253, 133, 106, 36, 165, 183, 45,
-			84, 74, 139, 160, 174, 148, 111, 47, 204, 150, 75, 51, 138, 18,
-			147, 39, 229, 185, 233, 217, 229, 153, 242, 220, 205, 60, 185, 190,
-			188, 68, 230, 230, 151, 48, 153, 45, 223, 46, 47, 149, 102, 200,
-			210, 124, 30, 154, 237, 252, 142, 170, 59, 183, 75, 149, 233, 91,
-			197, 185, 165, 226, 245, 242, 108, 121, 233, 5, 104, 240, 70, 121,
-			105, 142, 54, 118, 99, 190, 130, 73, 145, 44, 20, 43, 75, 229,
-			233, 229, 217, 98, 133, 44, 44, 87, 22, 230, 23, 75, 132, 142,
-			108, 166, 188, 56, 61, 91, 44, 223, 46, 205, 20, 72, 121, 142,
-			204, 205, 147, 210, 157, 210, 220, 18, 89, 188, 85, 156, 157, 77,
-			14, 20, 147, 249, 231, 231, 74, 21, 174, 108, 201, 97, 146, 235,
-			37, 50, 91, 46, 94, 159, 45, 209, 166, 96, 156, 51, 229, 74,
-			105, 122, 137, 14, 40, 254, 107, 186, 60, 83, 154, 91, 42, 206,
-			230, 49, 1, 27, 66, 113, 54, 79, 74, 111, 43, 221, 94, 152,
-			45, 86, 94, 200, 243, 74, 23, 75, 207, 45, 151, 230, 150, 202,
-			197, 89, 50, 83, 188, 93, 188, 89, 90, 36, 67, 59, 81, 101,
-			161, 50, 63, 189, 92, 41, 221, 166, 189, 158, 191, 65, 22, 151,
-			175, 47, 46, 149, 151, 150, 151, 74, 228, 230, 252, 252, 12, 16,
-			123, 177, 84, 185, 83, 158, 46, 45, 94, 37, 179, 243, 139, 64,
-			176, 229, 197, 82, 30, 147, 153, 226, 82, 17, 154, 94, 168, 204,
-			223, 40, 47, 45, 94, 165, 127, 95, 95, 94, 44, 3, 225, 202,
-			115, 75, 165, 74, 101, 25, 18, 158, 13, 147, 91, 243, 207, 151,
-			238, 148, 42, 100, 186, 184, 188, 88, 154, 1, 10, 207, 207, 209,
-			209, 82, 94, 41, 205, 87, 94, 160, 213, 82, 58, 192, 12, 228,
-			201, 243, 183, 74, 75, 183, 74, 21, 74, 84, 160, 86, 145, 146,
-			97, 113, 169, 82, 158, 94, 82, 95, 155, 175, 144, 165, 249, 202,
-			18, 86, 198, 73, 230, 74, 55, 103, 203, 55, 75, 115, 211, 37,
-			250, 243, 60, 173, 230, 249, 242, 98, 105, 152, 20, 43, 229, 69,
-			250, 66, 25, 26, 38, 207, 23, 95, 32, 84, 17, 158, 191, 1,
-			19, 181, 188, 88, 194, 236, 111, 133, 117, 243, 48, 159, 164, 124,
-			131, 20, 103, 238, 148, 105, 207, 249, 219, 11, 243, 139, 139, 101,
-			206, 46, 64, 182, 233, 91, 156, 230, 82, 103, 37, 169, 65, 174,
-			157, 230, 82, 87, 65, 59, 61, 205, 254, 100, 15, 79, 166, 158,
-			20, 42, 43, 253, 147, 61, 60, 149, 202, 139, 228, 82, 244, 79,
-			246, 240, 116, 234, 156, 72, 67, 69, 255, 100, 15, 207, 196, 9,
-			171, 206, 200, 132, 85, 103, 83, 39, 68, 26, 42, 250, 39, 123,
-			56, 148, 58, 14, 15, 143, 179, 63, 191, 207, 188, 71, 245, 11,
-			169, 126, 235, 79, 52, 82, 100, 169, 255, 220, 42, 207, 195, 44,
-			52, 38, 56, 2, 54, 253, 22, 24, 143, 3, 103, 20, 12, 42,
-			62, 177, 239, 251, 174, 64, 249, 161, 219, 95, 11, 44, 24, 112,
-			75, 147, 248, 30, 182, 223, 77, 191, 21, 144, 226, 66, 57, 44,
-			144, 162, 136, 164, 75, 128, 165, 248, 236, 252, 2, 21, 91, 73,
-			6, 3, 144, 131, 220, 162, 204, 130, 236, 64, 118, 98, 224, 35,
-			197, 133, 50, 199, 20, 45, 128, 83, 134, 235, 133, 145, 237, 85,
-			29, 113, 26, 9, 245, 92, 65, 106, 34, 36, 104, 86, 201, 117,
-			59, 24, 106, 143, 167, 0, 25, 99, 88, 122, 9, 108, 241, 251,
-			85, 86, 205, 123, 184, 138, 4, 82, 182, 116, 213, 136, 17, 113,
-			239, 194, 219, 119, 233, 200, 24, 45, 224, 69, 150, 231, 145, 220,
-			125, 247, 123, 238, 42, 90, 230, 133, 236, 110, 41, 58, 125, 124,
-			10, 119, 100, 27, 100, 134, 219, 6, 21, 97, 183, 144, 159, 78,
-			224, 94, 200, 199, 119, 219, 14, 239, 153, 3, 56, 221, 180, 163,
-			141, 112, 16, 17, 125, 168, 183, 194, 10, 215, 63, 138, 186, 139,
-			86, 123, 228, 135, 66, 188, 186, 178, 77, 18, 191, 46, 125, 186,
-			26, 255, 249, 26, 4, 174, 159, 189, 138, 123, 204, 244, 99, 169,
-			255, 19, 161, 183, 36, 174, 183, 36, 174, 183, 36, 174, 183, 36,
-			174, 183, 36, 174, 183, 36, 174, 55, 81, 226, 146, 194, 213, 73,
-			41, 92, 157, 74, 141, 9, 225, 138, 254, 41, 36, 46, 41, 92,
-			157, 150, 194, 213, 153, 88, 184, 58, 35, 133, 171, 179, 169, 5,
-			33, 197, 209, 63, 187, 74, 92, 127, 63, 15, 18, 87, 230, 187,
-			136, 30, 125, 214, 223, 206, 147, 187, 242, 0, 190, 219, 14, 90,
-			204, 32, 203, 54, 27, 171, 126, 93, 166, 4, 133, 211, 60, 79,
-			148, 148, 143, 226, 32, 128, 95, 38, 73, 110, 173, 96, 231, 218,
-			159, 172, 22, 106, 57, 140, 201, 45, 39, 112, 200, 221, 181, 182,
-			134, 100, 46, 58, 8, 240, 1, 172, 41, 46, 185, 229, 201, 93,
-			251, 46, 236, 187, 119, 87, 239, 98, 37, 21, 33, 221, 244, 98,
-			123, 122, 11, 44, 199, 180, 98, 150, 209, 237, 110, 237, 174, 172,
-			86, 254, 10, 187, 172, 76, 33, 79, 223, 46, 172, 222, 5, 220,
-			77, 158, 102, 13, 28, 95, 131, 120, 171, 100, 91, 221, 102, 2,
-			191, 141, 247, 32, 233, 200, 129, 19, 200, 213, 54, 89, 167, 239,
-			54, 69, 60, 60, 164, 23, 169, 49, 163, 48, 253, 217, 35, 45,
-			240, 114, 142, 95, 41, 180, 245, 1, 60, 226, 88, 0, 61, 71,
-			35, 7, 225, 205, 241, 170, 62, 152, 219, 134, 66, 199, 129, 220,
-			38, 15, 192, 229, 239, 36, 255, 250, 54, 124, 237, 122, 84, 130,
-			161, 98, 30, 64, 184, 97, 230, 231, 174, 26, 151, 193, 211, 234,
-			97, 196, 242, 120, 54, 229, 187, 121, 150, 180, 143, 201, 184, 130,
-			78, 126, 128, 233, 232, 71, 149, 212, 251, 44, 117, 67, 140, 34,
-			206, 82, 101, 50, 239, 217, 100, 160, 58, 39, 150, 29, 226, 56,
-			31, 164, 156, 60, 42, 77, 118, 100, 53, 17, 191, 196, 225, 43,
-			14, 160, 26, 98, 85, 82, 231, 217, 34, 4, 232, 101, 91, 143,
-			237, 144, 159, 207, 161, 224, 203, 181, 88, 244, 182, 201, 36, 153,
-			152, 16, 165, 213, 248, 7, 66, 106, 100, 82, 164, 105, 165, 255,
-			30, 210, 55, 69, 241, 61, 226, 143, 77, 250, 210, 5, 172, 62,
-			124, 101, 146, 60, 193, 101, 113, 238, 31, 205, 208, 199, 253, 56,
-			108, 95, 158, 153, 60, 33, 40, 93, 59, 156, 54, 15, 243, 155,
-			192, 177, 175, 96, 184, 67, 118, 3, 110, 93, 151, 192, 45, 78,
-			20, 99, 250, 64, 224, 18, 227, 112, 30, 147, 36, 179, 114, 66,
-			178, 114, 204, 211, 19, 14, 79, 226, 215, 63, 244, 247, 196, 250,
-			69, 177, 3, 82, 84, 192, 55, 50, 135, 103, 126, 147, 205, 133,
-			69, 200, 171, 46, 220, 92, 128, 185, 48, 219, 0, 120, 144, 7,
-			79, 2, 98, 19, 185, 223, 8, 117, 132, 87, 43, 220, 205, 225,
-			178, 59, 177, 134, 242, 108, 241, 198, 107, 138, 177, 0, 131, 240,
-			141, 83, 148, 14, 217, 44, 193, 168, 218, 194, 154, 242, 2, 38,
-			27, 54, 93, 175, 142, 23, 243, 227, 112, 50, 55, 143, 45, 147,
-			46, 134, 247, 98, 143, 113, 207, 169, 82, 238, 10, 220, 58, 187,
-			39, 223, 228, 115, 130, 73, 228, 55, 121, 36, 75, 59, 35, 22,
-			72, 217, 35, 85, 59, 100, 170, 34, 169, 148, 22, 151, 186, 14,
-			74, 105, 79, 140, 74, 198, 15, 240, 153, 23, 85, 139, 68, 158,
-			109, 213, 98, 46, 171, 38, 42, 22, 203, 40, 140, 28, 187, 166,
-			210, 11, 238, 197, 92, 175, 230, 222, 119, 107, 45, 187, 46, 186,
-			139, 99, 68, 35, 190, 149, 73, 135, 94, 90, 123, 183, 209, 240,
-			189, 137, 233, 191, 121, 204, 133, 92, 5, 145, 85, 149, 61, 11,
-			116, 31, 226, 232, 128, 137, 193, 114, 78, 199, 164, 90, 119, 236,
-			160, 190, 41, 5, 104, 88, 225, 235, 14, 84, 10, 98, 50, 132,
-			154, 169, 32, 169, 158, 216, 127, 10, 132, 148, 33, 158, 98, 19,
-			250, 200, 8, 224, 176, 108, 190, 254, 22, 195, 26, 19, 127, 132,
-			106, 142, 98, 28, 103, 5, 166, 171, 20, 236, 5, 221, 182, 88,
-			22, 168, 66, 230, 5, 205, 67, 88, 46, 202, 92, 186, 157, 219,
-			124, 40, 185, 78, 224, 59, 114, 190, 101, 23, 102, 152, 95, 22,
-			170, 164, 167, 199, 209, 186, 207, 145, 207, 40, 37, 161, 202, 26,
-			195, 58, 160, 27, 111, 162, 235, 114, 23, 142, 179, 35, 243, 29,
-			71, 205, 194, 26, 2, 18, 92, 183, 253, 152, 69, 164, 212, 29,
-			129, 230, 10, 19, 26, 146, 150, 23, 249, 173, 234, 6, 109, 22,
-			214, 175, 236, 29, 160, 245, 133, 226, 100, 241, 49, 169, 113, 20,
-			24, 248, 154, 247, 149, 119, 33, 47, 207, 10, 150, 70, 54, 108,
-			235, 158, 93, 175, 203, 243, 157, 237, 157, 247, 213, 67, 166, 193,
-			238, 207, 68, 7, 218, 119, 165, 120, 56, 107, 12, 211, 178, 157,
-			246, 121, 53, 33, 51, 195, 9, 94, 117, 0, 70, 12, 130, 143,
-			248, 66, 3, 103, 107, 74, 237, 246, 22, 196, 173, 44, 92, 230,
-			138, 241, 23, 226, 125, 3, 119, 237, 21, 76, 134, 216, 44, 121,
-			29, 157, 187, 36, 185, 11, 187, 228, 221, 246, 109, 178, 237, 216,
-			237, 152, 177, 142, 154, 212, 148, 177, 225, 61, 78, 9, 220, 133,
-			20, 144, 248, 75, 210, 67, 158, 53, 13, 39, 88, 143, 99, 149,
-			20, 106, 40, 61, 193, 91, 209, 162, 29, 191, 156, 121, 149, 40,
-			111, 242, 10, 186, 28, 202, 201, 179, 40, 121, 10, 119, 59, 132,
-			171, 147, 228, 197, 243, 47, 41, 199, 20, 67, 61, 227, 227, 124,
-			13, 237, 140, 119, 173, 122, 66, 169, 26, 40, 229, 118, 144, 214,
-			101, 178, 5, 23, 111, 95, 164, 242, 109, 46, 79, 197, 220, 106,
-			238, 37, 241, 85, 212, 38, 16, 172, 190, 182, 46, 109, 55, 246,
-			60, 153, 72, 12, 31, 220, 160, 156, 216, 232, 64, 183, 93, 110,
-			97, 224, 233, 185, 195, 206, 60, 99, 34, 14, 90, 221, 239, 112,
-			204, 196, 144, 19, 93, 8, 216, 176, 44, 129, 49, 169, 56, 91,
-			99, 225, 229, 244, 180, 150, 7, 230, 89, 17, 68, 221, 46, 172,
-			40, 148, 107, 133, 17, 102, 30, 194, 202, 41, 224, 213, 186, 200,
-			56, 34, 32, 91, 198, 48, 51, 99, 73, 204, 108, 228, 150, 227,
-			85, 169, 200, 216, 217, 159, 88, 28, 240, 213, 205, 42, 47, 41,
-			98, 199, 9, 213, 132, 185, 182, 35, 134, 69, 116, 43, 153, 255,
-			92, 244, 26, 146, 215, 215, 124, 204, 165, 22, 81, 45, 27, 80,
-			40, 119, 193, 26, 147, 209, 229, 130, 78, 48, 80, 66, 226, 241,
-			5, 247, 50, 122, 117, 147, 115, 112, 87, 65, 71, 169, 50, 33,
-			233, 64, 242, 191, 14, 65, 71, 145, 115, 98, 249, 26, 210, 113,
-			131, 158, 87, 221, 112, 26, 54, 113, 238, 251, 245, 150, 216, 39,
-			120, 66, 119, 9, 71, 141, 99, 5, 200, 225, 217, 236, 98, 41,
-			233, 158, 7, 73, 192, 89, 230, 65, 72, 53, 13, 224, 140, 244,
-			167, 53, 183, 94, 231, 91, 11, 86, 141, 235, 10, 118, 91, 204,
-			5, 110, 32, 102, 168, 32, 67, 107, 93, 122, 12, 61, 176, 189,
-			72, 61, 162, 243, 42, 96, 133, 48, 181, 51, 80, 124, 56, 18,
-			169, 254, 196, 250, 8, 114, 34, 224, 173, 114, 165, 14, 39, 136,
-			151, 231, 54, 48, 64, 151, 19, 89, 204, 221, 53, 218, 117, 128,
-			207, 100, 232, 223, 73, 241, 173, 13, 44, 187, 131, 133, 224, 132,
-			143, 143, 196, 176, 203, 153, 24, 139, 92, 73, 7, 177, 154, 67,
-			143, 165, 144, 139, 46, 170, 220, 123, 207, 245, 106, 32, 138, 117,
-			23, 117, 186, 108, 84, 56, 118, 242, 100, 34, 196, 134, 207, 0,
-			22, 98, 237, 13, 4, 156, 147, 100, 218, 247, 66, 183, 38, 101,
-			21, 122, 124, 220, 90, 90, 90, 96, 34, 38, 83, 112, 160, 76,
-			251, 192, 175, 63, 218, 143, 22, 46, 214, 240, 220, 132, 74, 63,
-			228, 218, 231, 179, 188, 80, 92, 154, 190, 37, 165, 83, 127, 141,
-			44, 44, 47, 37, 22, 179, 72, 69, 9, 45, 134, 78, 195, 246,
-			34, 183, 26, 98, 50, 68, 95, 4, 91, 46, 28, 174, 194, 176,
-			9, 26, 85, 171, 94, 231, 61, 10, 185, 94, 12, 106, 115, 73,
-			168, 205, 254, 154, 42, 197, 177, 221, 140, 190, 145, 239, 88, 155,
-			160, 105, 183, 161, 151, 242, 72, 117, 134, 113, 205, 244, 26, 134,
-			212, 169, 194, 135, 2, 236, 107, 195, 46, 176, 134, 66, 102, 179,
-			117, 61, 38, 116, 3, 122, 24, 149, 232, 170, 190, 119, 223, 17,
-			209, 68, 99, 224, 44, 68, 101, 132, 96, 180, 106, 55, 28, 136,
-			170, 227, 38, 119, 142, 5, 17, 114, 38, 4, 180, 123, 126, 190,
-			86, 249, 108, 181, 25, 164, 99, 22, 146, 114, 178, 212, 131, 197,
-			111, 11, 129, 15, 142, 200, 242, 232, 89, 14, 157, 0, 98, 12,
-			25, 236, 51, 127, 186, 176, 65, 245, 202, 38, 252, 151, 193, 75,
-			43, 39, 145, 168, 11, 62, 149, 21, 113, 26, 113, 192, 222, 149,
-			24, 73, 58, 249, 187, 93, 171, 129, 3, 175, 90, 43, 204, 6,
-			83, 101, 237, 118, 41, 230, 46, 239, 241, 93, 88, 220, 117, 223,
-			135, 109, 54, 108, 85, 55, 228, 208, 232, 171, 178, 27, 194, 236,
-			4, 64, 193, 106, 103, 114, 237, 111, 192, 232, 114, 137, 62, 48,
-			142, 144, 241, 20, 130, 37, 164, 193, 138, 177, 5, 236, 236, 162,
-			117, 217, 112, 131, 197, 31, 170, 237, 206, 217, 13, 39, 223, 222,
-			76, 82, 157, 160, 91, 230, 188, 231, 8, 246, 12, 219, 76, 82,
-			0, 165, 171, 156, 66, 62, 125, 53, 36, 47, 211, 69, 96, 199,
-			25, 33, 249, 33, 45, 151, 49, 215, 46, 219, 57, 163, 157, 25,
-			22, 129, 159, 110, 243, 146, 28, 9, 52, 194, 252, 254, 216, 159,
-			138, 156, 146, 132, 241, 185, 120, 53, 254, 101, 177, 181, 42, 106,
-			10, 91, 171, 43, 162, 141, 41, 114, 229, 106, 23, 243, 194, 82,
-			114, 175, 98, 129, 65, 219, 207, 168, 50, 137, 180, 134, 249, 96,
-			251, 215, 149, 78, 40, 95, 197, 167, 32, 31, 229, 102, 83, 220,
-			160, 12, 229, 226, 33, 231, 100, 68, 32, 221, 104, 135, 105, 255,
-			120, 230, 81, 110, 76, 227, 230, 13, 190, 125, 198, 51, 74, 238,
-			56, 129, 188, 100, 227, 227, 108, 147, 208, 96, 251, 220, 84, 174,
-			143, 249, 206, 185, 1, 59, 78, 108, 190, 128, 174, 169, 58, 8,
-			150, 135, 5, 183, 62, 222, 167, 109, 109, 38, 51, 7, 36, 12,
-			181, 44, 148, 159, 42, 191, 160, 13, 116, 194, 148, 196, 199, 29,
-			237, 18, 108, 82, 112, 218, 54, 236, 102, 147, 135, 162, 245, 177,
-			139, 98, 227, 187, 40, 187, 15, 231, 69, 254, 161, 239, 33, 237,
-			168, 245, 24, 11, 183, 87, 172, 163, 108, 46, 4, 105, 120, 66,
-			31, 3, 94, 151, 197, 52, 45, 246, 201, 116, 63, 136, 22, 205,
-			67, 162, 168, 211, 162, 18, 143, 244, 63, 158, 198, 143, 117, 32,
-			224, 113, 248, 166, 173, 110, 164, 39, 113, 86, 32, 60, 153, 131,
-			184, 39, 4, 207, 68, 6, 37, 160, 87, 68, 209, 28, 192, 105,
-			207, 246, 124, 134, 21, 144, 174, 176, 194, 245, 31, 235, 126, 83,
-			189, 91, 84, 40, 46, 170, 71, 118, 246, 3, 20, 221, 124, 13,
-			55, 211, 191, 125, 146, 185, 2, 134, 111, 93, 76, 191, 117, 49,
-			253, 214, 197, 244, 91, 23, 211, 111, 93, 76, 191, 117, 49, 253,
-			102, 94, 76, 203, 251, 98, 250, 167, 184, 152, 46, 138, 155, 229,
-			83, 50, 206, 237, 116, 124, 133, 125, 90, 94, 97, 159, 73, 21,
-			196, 21, 54, 253, 83, 92, 76, 203, 43, 236, 179, 242, 10, 123,
-			40, 190, 194, 166, 127, 126, 182, 159, 133, 77, 173, 165, 66, 100,
-			125, 188, 159, 20, 137, 68, 104, 76, 222, 74, 187, 235, 158, 83,
-			203, 147, 53, 247, 161, 83, 27, 229, 136, 189, 18, 102, 219, 109,
-			56, 170, 168, 142, 153, 10, 199, 82, 178, 208, 221, 159, 157, 251,
-			12, 173, 33, 176, 171, 241, 177, 32, 126, 136, 8, 200, 0, 80,
-			100, 136, 90, 204, 26, 2, 72, 16, 12, 166, 11, 180, 115, 135,
-			85, 200, 244, 240, 186, 227, 213, 108, 134, 35, 200, 147, 95, 210,
-			109, 250, 158, 67, 114, 53, 123, 51, 7, 30, 132, 185, 134, 239,
-			69, 27, 57, 81, 13, 67, 51, 134, 141, 47, 206, 162, 229, 122,
-			241, 9, 39, 16, 201, 171, 50, 235, 17, 38, 209, 3, 245, 109,
-			97, 67, 160, 3, 148, 164, 98, 168, 234, 50, 129, 82, 141, 110,
-			229, 126, 64, 165, 241, 136, 14, 23, 240, 248, 88, 148, 157, 172,
-			168, 64, 42, 32, 49, 176, 155, 213, 192, 127, 232, 210, 227, 160,
-			190, 73, 206, 141, 158, 31, 207, 143, 143, 143, 147, 77, 199, 14,
-			248, 53, 136, 0, 244, 140, 177, 61, 201, 249, 73, 72, 166, 217,
-			138, 156, 184, 27, 44, 0, 70, 237, 46, 3, 16, 12, 157, 86,
-			205, 23, 177, 32, 112, 148, 196, 227, 1, 72, 107, 50, 69, 10,
-			133, 194, 213, 246, 223, 28, 175, 150, 248, 69, 54, 36, 228, 43,
-			241, 43, 251, 89, 10, 135, 98, 90, 1, 197, 89, 150, 70, 89,
-			91, 162, 124, 181, 237, 35, 96, 0, 254, 9, 251, 91, 124, 0,
-			37, 209, 136, 187, 70, 134, 58, 26, 186, 70, 198, 201, 153, 51,
-			237, 117, 61, 73, 198, 135, 99, 237, 164, 227, 163, 115, 170, 142,
-			220, 246, 233, 232, 20, 57, 63, 46, 254, 9, 109, 153, 56, 117,
-			42, 95, 117, 235, 192, 147, 93, 59, 112, 109, 251, 14, 140, 110,
-			211, 129, 115, 221, 58, 160, 76, 255, 68, 60, 253, 241, 124, 193,
-			252, 199, 197, 115, 241, 132, 189, 118, 46, 216, 114, 174, 183, 230,
-			17, 246, 147, 58, 229, 83, 201, 41, 39, 231, 58, 136, 112, 53,
-			254, 72, 48, 128, 50, 233, 234, 7, 29, 92, 16, 127, 147, 164,
-			115, 130, 231, 84, 18, 199, 31, 116, 165, 110, 60, 189, 241, 139,
-			79, 170, 47, 110, 209, 198, 185, 238, 109, 116, 101, 33, 101, 6,
-			47, 108, 181, 128, 107, 118, 228, 64, 102, 12, 250, 159, 154, 83,
-			7, 21, 131, 44, 108, 70, 27, 76, 154, 162, 255, 34, 74, 244,
-			206, 23, 135, 106, 246, 102, 56, 117, 33, 79, 26, 174, 215, 138,
-			156, 112, 234, 252, 248, 112, 114, 153, 145, 41, 217, 218, 80, 219,
-			79, 133, 27, 129, 223, 88, 146, 85, 69, 181, 225, 216, 154, 119,
-			219, 110, 54, 93, 111, 61, 182, 213, 112, 77, 135, 153, 108, 98,
-			80, 95, 170, 56, 119, 88, 242, 152, 225, 66, 141, 107, 166, 42,
-			49, 243, 66, 200, 115, 211, 30, 88, 126, 216, 139, 96, 132, 229,
-			210, 115, 216, 90, 91, 115, 31, 146, 92, 152, 35, 67, 60, 21,
-			10, 92, 141, 49, 210, 15, 179, 248, 113, 134, 108, 81, 117, 106,
-			177, 125, 213, 107, 53, 86, 89, 96, 37, 127, 149, 235, 62, 241,
-			41, 19, 138, 128, 118, 232, 38, 150, 231, 146, 93, 23, 159, 180,
-			249, 201, 92, 144, 167, 21, 212, 52, 158, 168, 75, 113, 74, 18,
-			99, 119, 19, 132, 162, 164, 200, 93, 8, 115, 116, 188, 46, 157,
-			253, 196, 161, 120, 94, 169, 140, 215, 133, 121, 170, 26, 222, 197,
-			110, 181, 21, 4, 119, 157, 167, 245, 210, 122, 218, 106, 197, 164,
-			225, 86, 131, 100, 189, 143, 90, 237, 249, 48, 7, 190, 234, 194,
-			91, 125, 45, 219, 143, 127, 71, 34, 133, 189, 172, 13, 88, 223,
-			68, 100, 17, 164, 2, 217, 40, 183, 132, 171, 98, 65, 129, 220,
-			230, 56, 30, 192, 219, 163, 23, 206, 95, 202, 95, 122, 252, 50,
-			61, 224, 232, 255, 195, 237, 249, 185, 182, 135, 34, 77, 236, 125,
-			126, 215, 11, 17, 179, 161, 67, 86, 253, 22, 12, 45, 16, 233,
-			168, 185, 110, 50, 137, 201, 229, 113, 218, 137, 177, 134, 235, 145,
-			17, 90, 104, 184, 222, 216, 70, 64, 70, 200, 196, 69, 178, 17,
-			140, 213, 236, 77, 50, 66, 46, 92, 190, 84, 152, 184, 68, 232,
-			26, 25, 163, 135, 43, 25, 97, 43, 148, 157, 180, 106, 190, 228,
-			151, 101, 102, 88, 58, 244, 151, 179, 123, 149, 48, 209, 151, 205,
-			253, 248, 253, 186, 72, 66, 27, 104, 166, 245, 127, 105, 130, 16,
-			9, 225, 198, 230, 116, 73, 74, 55, 138, 112, 163, 210, 11, 199,
-			4, 19, 171, 41, 36, 144, 45, 2, 22, 140, 239, 57, 178, 182,
-			32, 33, 107, 137, 40, 208, 113, 76, 238, 242, 121, 184, 43, 240,
-			78, 233, 219, 252, 174, 250, 62, 168, 120, 158, 179, 110, 195, 223,
-			119, 161, 67, 252, 69, 198, 232, 98, 27, 8, 161, 43, 74, 131,
-			28, 168, 43, 79, 108, 226, 249, 222, 232, 43, 78, 224, 243, 155,
-			66, 145, 254, 32, 81, 155, 0, 110, 193, 114, 120, 84, 81, 165,
-			242, 163, 8, 241, 104, 235, 103, 59, 139, 92, 185, 114, 37, 207,
-			255, 159, 177, 135, 242, 64, 97, 13, 153, 201, 55, 77, 103, 65,
-			205, 228, 27, 36, 50, 249, 6, 253, 251, 164, 73, 235, 243, 55,
-			240, 19, 143, 152, 249, 70, 193, 86, 120, 253, 105, 118, 118, 176,
-			159, 253, 104, 64, 90, 114, 255, 196, 192, 56, 206, 162, 176, 45,
-			216, 202, 81, 220, 195, 131, 248, 227, 164, 56, 233, 74, 134, 129,
-			140, 154, 39, 113, 47, 107, 138, 254, 174, 139, 207, 211, 144, 82,
-			135, 253, 80, 174, 153, 79, 196, 24, 164, 198, 14, 208, 159, 172,
-			118, 9, 244, 122, 28, 103, 5, 96, 215, 96, 154, 160, 161, 44,
-			251, 93, 62, 52, 175, 224, 12, 11, 100, 6, 52, 149, 61, 221,
-			128, 88, 232, 56, 89, 8, 53, 239, 58, 251, 192, 60, 131, 119,
-			133, 173, 70, 195, 14, 54, 87, 54, 162, 70, 29, 208, 84, 248,
-			232, 250, 248, 15, 183, 162, 70, 221, 124, 18, 99, 144, 55, 24,
-			104, 75, 246, 81, 64, 91, 210, 149, 94, 248, 4, 16, 91, 38,
-			113, 86, 204, 233, 96, 47, 31, 254, 86, 152, 247, 124, 120, 226,
-			125, 243, 18, 71, 153, 193, 59, 163, 204, 176, 79, 25, 212, 204,
-			52, 222, 13, 115, 38, 238, 64, 1, 63, 165, 111, 226, 177, 238,
-			196, 153, 21, 25, 127, 0, 45, 68, 148, 40, 125, 248, 52, 172,
-			108, 216, 225, 6, 131, 88, 225, 244, 225, 63, 220, 178, 195, 141,
-			92, 9, 239, 82, 107, 49, 143, 224, 222, 53, 183, 238, 192, 189,
-			14, 99, 153, 74, 150, 62, 152, 163, 220, 100, 98, 3, 160, 107,
-			116, 48, 235, 194, 223, 207, 24, 89, 212, 175, 229, 94, 213, 240,
-			222, 54, 120, 175, 109, 249, 242, 80, 27, 95, 74, 150, 188, 16,
-			115, 155, 190, 3, 183, 197, 140, 54, 138, 247, 40, 80, 89, 180,
-			82, 35, 209, 230, 110, 229, 215, 114, 205, 44, 224, 126, 231, 97,
-			179, 110, 123, 236, 117, 224, 159, 116, 76, 159, 189, 202, 143, 192,
-			67, 237, 180, 204, 116, 167, 229, 200, 219, 216, 178, 100, 236, 106,
-			30, 196, 230, 226, 82, 113, 105, 121, 177, 45, 39, 84, 22, 27,
-			11, 197, 197, 197, 126, 68, 255, 186, 81, 44, 207, 246, 107, 102,
-			47, 78, 79, 87, 138, 139, 183, 250, 117, 250, 103, 241, 250, 124,
-			101, 169, 223, 160, 191, 47, 62, 91, 94, 232, 79, 191, 158, 52,
-			77, 127, 245, 26, 195, 55, 249, 231, 111, 225, 155, 188, 149, 167,
-			233, 205, 200, 211, 52, 20, 227, 155, 12, 199, 248, 38, 83, 221,
-			241, 77, 254, 156, 69, 168, 26, 39, 82, 15, 17, 11, 81, 141,
-			179, 96, 199, 176, 115, 54, 199, 255, 171, 218, 192, 18, 243, 107,
-			17, 100, 169, 230, 174, 3, 242, 39, 208, 72, 30, 58, 85, 160,
-			116, 163, 85, 143, 92, 170, 127, 193, 81, 43, 29, 103, 228, 99,
-			158, 200, 40, 143, 219, 42, 130, 60, 44, 201, 87, 101, 3, 240,
-			54, 191, 12, 136, 211, 1, 178, 47, 170, 34, 225, 58, 111, 223,
-			245, 164, 145, 41, 134, 116, 196, 100, 104, 126, 49, 79, 110, 46,
-			44, 231, 101, 178, 154, 181, 186, 189, 30, 230, 137, 19, 85, 135,
-			89, 98, 30, 72, 70, 197, 253, 12, 132, 243, 143, 8, 150, 16,
-			153, 109, 136, 203, 225, 92, 98, 97, 223, 190, 111, 187, 117, 158,
-			78, 59, 17, 192, 1, 70, 47, 167, 177, 234, 128, 99, 81, 92,
-			187, 18, 162, 122, 34, 107, 226, 95, 215, 196, 205, 227, 136, 54,
-			106, 88, 191, 172, 109, 143, 124, 162, 100, 131, 234, 14, 125, 18,
-			75, 38, 109, 208, 39, 152, 228, 182, 1, 63, 1, 129, 44, 28,
-			123, 247, 114, 101, 118, 165, 180, 56, 93, 92, 40, 205, 172, 44,
-			149, 22, 151, 224, 55, 62, 105, 99, 239, 102, 136, 165, 244, 89,
-			174, 128, 185, 106, 217, 229, 11, 194, 59, 186, 226, 214, 136, 19,
-			86, 109, 129, 205, 24, 95, 195, 41, 81, 176, 205, 123, 235, 99,
-			158, 19, 141, 181, 130, 250, 216, 201, 5, 59, 218, 40, 193, 23,
-			175, 27, 140, 101, 36, 1, 198, 50, 146, 0, 99, 25, 73, 128,
-			177, 140, 152, 163, 109, 96, 44, 231, 218, 193, 88, 242, 198, 57,
-			252, 179, 233, 24, 18, 163, 104, 125, 50, 13, 121, 87, 137, 91,
-			163, 18, 123, 203, 115, 223, 213, 114, 120, 214, 237, 53, 55, 78,
-			54, 196, 184, 197, 19, 137, 180, 101, 50, 92, 2, 208, 242, 147,
-			228, 157, 47, 190, 56, 57, 217, 12, 92, 47, 154, 156, 124, 233,
-			165, 119, 159, 207, 79, 92, 186, 252, 158, 83, 204, 169, 46, 122,
-			224, 195, 247, 161, 140, 151, 1, 241, 83, 130, 103, 242, 124, 80,
-			140, 193, 164, 198, 108, 99, 208, 31, 236, 122, 115, 195, 246, 90,
-			13, 8, 56, 175, 110, 216, 96, 32, 13, 192, 144, 176, 41, 253,
-			107, 32, 173, 78, 211, 14, 248, 130, 95, 15, 252, 86, 179, 32,
-			205, 160, 144, 126, 58, 103, 143, 173, 142, 85, 115, 242, 207, 90,
-			252, 167, 51, 249, 80, 45, 108, 138, 194, 90, 142, 175, 35, 6,
-			253, 27, 182, 221, 222, 65, 43, 172, 242, 98, 189, 78, 220, 200,
-			105, 132, 10, 152, 49, 85, 137, 224, 21, 9, 19, 203, 73, 201,
-			71, 207, 71, 157, 179, 199, 160, 189, 231, 221, 104, 67, 152, 150,
-			225, 51, 225, 149, 25, 132, 17, 185, 200, 110, 33, 153, 187, 241,
-			54, 149, 210, 211, 43, 174, 119, 117, 203, 154, 229, 176, 97, 31,
-			146, 227, 222, 174, 21, 90, 117, 215, 222, 211, 79, 115, 2, 148,
-			70, 69, 97, 118, 61, 178, 92, 46, 96, 198, 48, 114, 11, 130,
-			235, 239, 102, 224, 68, 98, 250, 67, 153, 145, 138, 5, 17, 216,
-			155, 9, 93, 173, 40, 217, 31, 80, 87, 122, 251, 85, 212, 149,
-			253, 3, 42, 234, 202, 129, 167, 219, 80, 87, 14, 61, 137, 55,
-			18, 168, 43, 111, 79, 230, 101, 83, 161, 107, 221, 24, 176, 160,
-			91, 246, 59, 216, 40, 37, 175, 219, 163, 175, 140, 143, 94, 121,
-			199, 232, 74, 129, 178, 250, 133, 9, 202, 233, 28, 131, 37, 109,
-			234, 37, 217, 101, 192, 103, 233, 53, 85, 124, 150, 3, 7, 85,
-			124, 150, 67, 157, 248, 44, 231, 218, 241, 89, 70, 240, 3, 142,
-			207, 98, 204, 106, 183, 39, 44, 151, 204, 128, 187, 98, 83, 24,
-			5, 64, 249, 22, 39, 243, 3, 27, 196, 154, 160, 229, 121, 2,
-			233, 129, 14, 32, 143, 217, 238, 170, 248, 98, 178, 12, 100, 121,
-			153, 86, 140, 233, 255, 241, 89, 84, 72, 32, 183, 104, 189, 42,
-			114, 11, 222, 167, 34, 183, 12, 72, 84, 151, 172, 169, 223, 206,
-			156, 231, 35, 208, 217, 144, 110, 103, 199, 240, 223, 214, 57, 176,
-			139, 126, 71, 123, 218, 250, 41, 157, 60, 191, 225, 200, 171, 105,
-			5, 177, 66, 158, 193, 236, 0, 228, 232, 29, 66, 195, 43, 128,
-			61, 209, 150, 137, 33, 100, 14, 194, 233, 217, 60, 185, 114, 229,
-			244, 57, 89, 135, 200, 47, 104, 7, 142, 250, 245, 114, 232, 4,
-			236, 97, 140, 210, 12, 12, 233, 132, 76, 172, 130, 50, 115, 2,
-			237, 132, 25, 103, 110, 220, 29, 63, 144, 19, 83, 74, 199, 215,
-			120, 22, 47, 14, 146, 1, 109, 201, 131, 159, 135, 47, 81, 121,
-			79, 86, 18, 249, 240, 205, 88, 120, 207, 109, 142, 85, 3, 59,
-			220, 96, 129, 35, 240, 213, 134, 29, 120, 84, 58, 163, 167, 188,
-			45, 226, 49, 236, 42, 192, 182, 137, 164, 93, 82, 218, 149, 85,
-			250, 30, 160, 55, 48, 25, 67, 133, 188, 105, 163, 54, 91, 206,
-			77, 59, 112, 67, 176, 21, 115, 100, 153, 52, 157, 165, 140, 130,
-			65, 115, 167, 103, 175, 130, 65, 115, 199, 220, 175, 96, 208, 220,
-			25, 120, 42, 137, 65, 115, 231, 224, 20, 100, 127, 6, 8, 154,
-			183, 107, 51, 214, 44, 185, 109, 87, 55, 92, 207, 25, 13, 28,
-			187, 6, 162, 5, 235, 122, 226, 108, 225, 66, 217, 237, 229, 69,
-			184, 103, 166, 187, 72, 167, 190, 147, 64, 150, 121, 187, 68, 205,
-			78, 35, 83, 127, 251, 238, 253, 10, 178, 204, 219, 15, 10, 12,
-			237, 116, 214, 212, 223, 62, 56, 205, 251, 152, 102, 125, 124, 187,
-			85, 196, 191, 129, 56, 240, 140, 110, 107, 55, 172, 95, 65, 228,
-			86, 171, 97, 123, 113, 31, 21, 181, 77, 113, 27, 6, 57, 197,
-			245, 200, 173, 165, 219, 179, 162, 187, 171, 84, 140, 243, 220, 200,
-			125, 5, 188, 201, 193, 159, 58, 112, 168, 196, 203, 50, 89, 195,
-			183, 60, 37, 160, 72, 154, 8, 104, 250, 194, 226, 197, 12, 12,
-			237, 16, 198, 62, 220, 9, 54, 236, 122, 93, 216, 193, 47, 142,
-			95, 185, 76, 86, 55, 35, 158, 172, 111, 121, 233, 198, 232, 19,
-			5, 21, 47, 196, 150, 59, 79, 6, 153, 186, 221, 123, 64, 193,
-			11, 177, 7, 15, 43, 120, 33, 182, 85, 74, 226, 133, 216, 71,
-			167, 241, 45, 142, 23, 98, 212, 52, 103, 194, 154, 132, 142, 54,
-			125, 151, 69, 251, 193, 173, 173, 132, 16, 138, 25, 30, 140, 27,
-			220, 59, 132, 73, 173, 5, 21, 246, 163, 38, 241, 127, 122, 144,
-			169, 215, 142, 158, 81, 96, 63, 106, 18, 194, 164, 39, 107, 234,
-			142, 220, 56, 122, 88, 151, 156, 236, 24, 231, 164, 172, 105, 184,
-			218, 203, 19, 214, 179, 241, 221, 65, 59, 239, 196, 59, 134, 50,
-			45, 42, 37, 215, 33, 3, 33, 167, 228, 120, 12, 187, 145, 49,
-			117, 87, 179, 20, 216, 13, 247, 200, 73, 5, 118, 195, 61, 115,
-			86, 129, 221, 120, 89, 246, 145, 195, 110, 188, 156, 29, 195, 13,
-			142, 186, 97, 120, 154, 63, 97, 173, 144, 219, 78, 100, 131, 171,
-			23, 83, 155, 146, 82, 46, 147, 183, 27, 160, 48, 43, 145, 88,
-			16, 165, 21, 68, 110, 149, 3, 135, 139, 221, 143, 43, 94, 202,
-			18, 17, 48, 21, 134, 169, 123, 18, 96, 165, 55, 99, 234, 158,
-			4, 215, 234, 69, 166, 238, 29, 84, 1, 45, 188, 99, 199, 69,
-			41, 107, 234, 190, 28, 69, 47, 27, 133, 159, 29, 195, 83, 28,
-			238, 66, 15, 180, 28, 131, 39, 11, 28, 69, 244, 19, 82, 80,
-			141, 139, 233, 76, 56, 169, 51, 231, 33, 217, 43, 156, 161, 223,
-			139, 164, 137, 24, 153, 122, 32, 147, 38, 98, 221, 212, 131, 227,
-			39, 240, 203, 2, 113, 226, 190, 246, 96, 194, 122, 7, 185, 101,
-			135, 27, 98, 58, 101, 78, 116, 178, 225, 60, 28, 10, 55, 236,
-			137, 75, 151, 135, 66, 200, 191, 63, 116, 246, 108, 225, 101, 223,
-			245, 134, 206, 158, 14, 39, 79, 135, 239, 240, 206, 2, 125, 239,
-			229, 201, 125, 202, 158, 226, 75, 16, 195, 134, 134, 233, 63, 217,
-			171, 190, 180, 169, 223, 151, 75, 163, 15, 153, 250, 125, 185, 52,
-			250, 116, 83, 191, 63, 40, 104, 213, 151, 53, 245, 7, 146, 58,
-			125, 140, 58, 15, 178, 99, 120, 12, 208, 42, 210, 239, 6, 0,
-			205, 28, 153, 109, 11, 40, 0, 26, 197, 65, 149, 10, 170, 252,
-			187, 179, 3, 0, 53, 137, 122, 77, 253, 199, 0, 106, 242, 121,
-			135, 79, 255, 3, 155, 7, 61, 212, 0, 199, 201, 167, 170, 7,
-			169, 83, 38, 229, 80, 147, 168, 55, 101, 234, 63, 198, 193, 37,
-			17, 64, 77, 42, 37, 141, 149, 254, 173, 128, 172, 55, 222, 143,
-			180, 67, 214, 111, 163, 4, 136, 3, 76, 209, 131, 173, 230, 146,
-			163, 84, 137, 252, 159, 44, 62, 206, 15, 221, 200, 15, 54, 243,
-			108, 63, 98, 87, 189, 112, 198, 228, 198, 168, 64, 201, 165, 106,
-			134, 190, 226, 55, 154, 190, 231, 120, 81, 56, 214, 180, 55, 27,
-			240, 71, 213, 15, 28, 81, 90, 225, 126, 176, 43, 116, 57, 172,
-			180, 34, 183, 190, 210, 242, 220, 136, 246, 162, 80, 165, 194, 248,
-			109, 251, 33, 97, 222, 33, 147, 228, 210, 249, 137, 2, 38, 139,
-			183, 230, 151, 103, 103, 164, 165, 103, 213, 174, 222, 11, 235, 118,
-			184, 225, 132, 9, 228, 141, 247, 39, 145, 55, 222, 143, 122, 77,
-			5, 121, 227, 253, 232, 192, 65, 252, 180, 0, 214, 248, 32, 210,
-			246, 89, 19, 100, 222, 115, 70, 87, 109, 42, 153, 214, 93, 79,
-			222, 249, 109, 67, 27, 5, 107, 227, 131, 72, 235, 81, 176, 54,
-			62, 136, 178, 187, 20, 172, 141, 15, 162, 189, 253, 248, 10, 228,
-			250, 204, 124, 24, 165, 254, 46, 66, 214, 185, 237, 14, 61, 59,
-			185, 33, 224, 62, 150, 111, 211, 248, 48, 74, 239, 197, 255, 63,
-			196, 51, 110, 26, 127, 5, 105, 135, 173, 128, 167, 252, 4, 212,
-			187, 88, 135, 103, 118, 154, 57, 63, 18, 49, 167, 92, 226, 230,
-			178, 129, 42, 4, 93, 133, 168, 27, 39, 100, 215, 171, 201, 224,
-			45, 25, 126, 232, 133, 78, 36, 225, 168, 118, 139, 52, 159, 180,
-			11, 3, 162, 168, 209, 226, 161, 65, 60, 204, 19, 125, 26, 31,
-			71, 90, 159, 117, 36, 22, 86, 96, 27, 222, 176, 69, 188, 169,
-			172, 8, 177, 119, 51, 162, 168, 209, 98, 47, 198, 63, 203, 70,
-			170, 153, 198, 79, 211, 154, 62, 129, 186, 84, 69, 69, 35, 102,
-			146, 106, 173, 175, 131, 14, 41, 157, 111, 192, 59, 148, 27, 150,
-			248, 212, 185, 94, 213, 15, 2, 184, 39, 134, 32, 108, 120, 8,
-			74, 119, 211, 15, 67, 119, 181, 238, 224, 248, 251, 142, 143, 192,
-			6, 198, 62, 33, 107, 117, 251, 158, 35, 71, 160, 1, 162, 136,
-			28, 1, 235, 114, 47, 198, 255, 53, 27, 1, 195, 242, 216, 101,
-			253, 173, 110, 35, 0, 145, 206, 169, 145, 90, 139, 93, 87, 43,
-			199, 20, 125, 217, 111, 69, 12, 106, 147, 117, 68, 220, 87, 77,
-			118, 29, 34, 219, 55, 252, 128, 255, 193, 60, 221, 49, 73, 12,
-			90, 12, 237, 28, 124, 172, 142, 79, 14, 135, 193, 139, 48, 142,
-			78, 3, 84, 166, 241, 105, 132, 251, 240, 58, 140, 198, 48, 141,
-			191, 69, 71, 243, 66, 151, 193, 240, 195, 158, 181, 68, 57, 210,
-			94, 101, 224, 40, 92, 236, 161, 27, 96, 184, 1, 1, 178, 128,
-			49, 12, 186, 98, 224, 216, 161, 239, 77, 130, 4, 225, 183, 226,
-			110, 24, 8, 90, 18, 221, 48, 52, 90, 196, 125, 248, 243, 26,
-			244, 35, 109, 26, 191, 64, 249, 226, 147, 90, 91, 71, 106, 110,
-			13, 134, 46, 197, 141, 164, 166, 191, 180, 161, 170, 15, 82, 206,
-			173, 215, 89, 6, 141, 132, 158, 17, 230, 33, 66, 69, 36, 108,
-			103, 185, 158, 153, 227, 111, 62, 49, 190, 102, 224, 52, 236, 168,
-			21, 56, 117, 150, 10, 34, 209, 0, 100, 68, 81, 164, 252, 7,
-			118, 72, 235, 161, 18, 125, 19, 152, 119, 180, 155, 68, 239, 173,
-			185, 235, 66, 154, 137, 227, 134, 147, 236, 9, 227, 229, 210, 76,
-			162, 74, 78, 179, 52, 2, 34, 9, 198, 76, 107, 180, 216, 139,
-			241, 255, 196, 129, 98, 254, 30, 74, 253, 115, 132, 172, 127, 132,
-			72, 89, 193, 210, 145, 22, 71, 150, 217, 97, 136, 107, 134, 211,
-			179, 195, 192, 252, 171, 161, 95, 23, 142, 188, 100, 181, 110, 55,
-			32, 68, 133, 69, 108, 119, 40, 63, 241, 118, 22, 159, 221, 9,
-			71, 8, 217, 28, 255, 29, 150, 116, 72, 86, 253, 104, 131, 27,
-			122, 188, 154, 244, 15, 166, 138, 92, 232, 243, 85, 232, 249, 17,
-			38, 211, 179, 103, 233, 46, 32, 55, 74, 128, 152, 249, 123, 40,
-			123, 8, 255, 43, 141, 99, 204, 100, 190, 138, 180, 95, 69, 134,
-			245, 205, 71, 177, 53, 42, 119, 55, 210, 224, 136, 59, 44, 142,
-			202, 197, 83, 187, 213, 241, 245, 26, 29, 149, 134, 195, 177, 119,
-			151, 222, 54, 63, 87, 170, 200, 47, 169, 110, 186, 141, 221, 81,
-			144, 246, 77, 54, 66, 198, 72, 59, 95, 21, 199, 45, 67, 218,
-			249, 42, 234, 221, 173, 32, 237, 124, 21, 245, 239, 195, 123, 89,
-			49, 75, 167, 195, 252, 85, 164, 3, 228, 147, 38, 160, 174, 126,
-			5, 25, 163, 202, 19, 100, 26, 255, 0, 25, 231, 240, 19, 2,
-			157, 231, 215, 144, 118, 192, 26, 17, 166, 72, 97, 125, 100, 176,
-			222, 177, 9, 88, 16, 32, 198, 204, 73, 195, 167, 89, 5, 51,
-			231, 215, 4, 14, 22, 195, 204, 249, 53, 180, 127, 0, 255, 107,
-			134, 2, 164, 153, 198, 127, 135, 180, 131, 214, 183, 80, 187, 9,
-			69, 145, 61, 85, 145, 142, 181, 207, 47, 8, 193, 93, 169, 0,
-			215, 61, 238, 189, 68, 175, 156, 135, 81, 96, 139, 73, 90, 1,
-			112, 220, 188, 196, 40, 149, 106, 157, 205, 130, 31, 99, 41, 17,
-			183, 181, 204, 161, 108, 37, 243, 211, 253, 115, 149, 138, 34, 108,
-			157, 196, 169, 189, 11, 84, 140, 89, 73, 112, 134, 32, 136, 150,
-			129, 49, 246, 138, 34, 162, 69, 188, 79, 20, 117, 90, 28, 56,
-			64, 119, 122, 131, 150, 50, 191, 129, 180, 127, 138, 12, 235, 249,
-			132, 97, 204, 83, 87, 201, 35, 89, 198, 152, 191, 44, 200, 25,
-			193, 40, 164, 7, 181, 99, 68, 46, 77, 211, 211, 166, 241, 27,
-			241, 52, 209, 243, 231, 55, 80, 175, 128, 54, 210, 117, 90, 180,
-			142, 112, 22, 210, 179, 180, 91, 71, 255, 169, 100, 33, 102, 71,
-			50, 190, 17, 179, 144, 206, 88, 232, 159, 80, 22, 242, 96, 40,
-			134, 105, 124, 19, 105, 207, 88, 119, 73, 5, 78, 27, 122, 212,
-			174, 58, 27, 46, 191, 102, 73, 172, 250, 55, 166, 190, 179, 62,
-			27, 105, 104, 80, 140, 136, 30, 101, 223, 68, 189, 135, 69, 81,
-			167, 197, 163, 199, 68, 49, 75, 139, 143, 149, 121, 239, 153, 161,
-			196, 248, 38, 34, 55, 65, 25, 2, 72, 181, 111, 33, 237, 198,
-			143, 88, 25, 98, 157, 73, 179, 198, 68, 207, 233, 9, 242, 45,
-			212, 123, 64, 20, 117, 90, 28, 20, 3, 73, 103, 105, 209, 42,
-			241, 158, 51, 243, 137, 241, 45, 116, 116, 90, 58, 201, 124, 202,
-			193, 151, 30, 209, 237, 36, 16, 144, 168, 175, 219, 67, 102, 187,
-			132, 241, 214, 142, 41, 81, 95, 55, 142, 149, 245, 186, 221, 128,
-			114, 159, 67, 248, 208, 52, 152, 30, 58, 145, 175, 134, 240, 238,
-			184, 165, 21, 183, 166, 130, 80, 237, 138, 127, 41, 215, 204, 107,
-			24, 199, 101, 240, 113, 232, 234, 1, 162, 52, 161, 188, 111, 30,
-			195, 88, 40, 98, 194, 51, 167, 210, 203, 159, 148, 107, 185, 15,
-			32, 124, 236, 186, 29, 85, 55, 218, 251, 25, 138, 142, 150, 112,
-			150, 191, 206, 242, 197, 246, 77, 12, 119, 54, 190, 197, 40, 43,
-			242, 211, 182, 126, 104, 93, 250, 241, 216, 86, 253, 96, 185, 149,
-			204, 39, 113, 159, 114, 254, 242, 190, 108, 79, 8, 245, 3, 243,
-			36, 222, 205, 130, 207, 87, 34, 255, 158, 227, 133, 131, 26, 100,
-			191, 221, 197, 30, 46, 193, 179, 220, 223, 64, 248, 80, 59, 198,
-			181, 160, 68, 49, 49, 17, 104, 231, 137, 96, 179, 169, 206, 198,
-			85, 220, 199, 251, 64, 57, 147, 79, 102, 167, 19, 145, 140, 47,
-			173, 96, 246, 58, 253, 59, 119, 17, 31, 230, 152, 227, 93, 58,
-			119, 40, 225, 34, 3, 13, 195, 131, 220, 223, 71, 88, 162, 118,
-			179, 240, 211, 46, 147, 124, 25, 15, 176, 224, 84, 215, 91, 95,
-			105, 27, 36, 175, 109, 191, 124, 65, 193, 17, 59, 139, 247, 218,
-			181, 218, 138, 58, 47, 140, 170, 123, 236, 154, 218, 142, 57, 138,
-			205, 0, 224, 146, 18, 239, 234, 240, 238, 62, 246, 139, 242, 122,
-			238, 103, 229, 202, 137, 143, 90, 209, 215, 147, 29, 211, 208, 219,
-			73, 232, 235, 184, 79, 89, 143, 91, 175, 154, 184, 122, 94, 71,
-			20, 123, 195, 237, 176, 116, 126, 49, 185, 116, 20, 48, 162, 215,
-			212, 83, 117, 125, 105, 219, 175, 175, 14, 90, 108, 185, 190, 58,
-			58, 107, 39, 150, 87, 162, 175, 124, 121, 61, 133, 119, 41, 20,
-			219, 102, 125, 41, 189, 232, 139, 169, 21, 230, 126, 25, 225, 163,
-			113, 245, 138, 20, 253, 154, 200, 241, 28, 238, 135, 110, 40, 167,
-			54, 159, 189, 19, 221, 187, 162, 52, 196, 106, 219, 27, 181, 121,
-			143, 237, 64, 154, 255, 6, 225, 92, 27, 109, 148, 175, 95, 219,
-			100, 62, 211, 49, 153, 133, 237, 38, 179, 147, 72, 143, 62, 163,
-			45, 124, 114, 219, 94, 243, 105, 157, 195, 251, 218, 233, 41, 230,
-			118, 103, 130, 86, 250, 219, 104, 25, 78, 252, 105, 15, 206, 10,
-			120, 115, 243, 37, 220, 223, 190, 95, 155, 143, 126, 58, 236, 4,
-			41, 104, 190, 7, 31, 236, 126, 38, 152, 99, 157, 95, 110, 123,
-			138, 89, 227, 143, 254, 1, 35, 92, 46, 69, 71, 215, 126, 20,
-			116, 27, 221, 22, 199, 197, 142, 163, 179, 177, 217, 185, 157, 155,
-			231, 58, 191, 218, 114, 211, 223, 177, 9, 23, 31, 222, 114, 235,
-			55, 39, 182, 30, 202, 86, 231, 132, 117, 176, 227, 168, 130, 44,
-			246, 140, 88, 237, 123, 203, 214, 172, 208, 177, 145, 117, 27, 73,
-			252, 82, 7, 43, 40, 251, 215, 14, 172, 208, 185, 43, 239, 192,
-			10, 93, 182, 198, 92, 202, 172, 227, 3, 93, 215, 153, 249, 26,
-			151, 183, 181, 243, 154, 203, 165, 204, 143, 34, 124, 100, 155, 181,
-			109, 94, 220, 113, 4, 93, 54, 48, 235, 210, 107, 252, 74, 12,
-			254, 245, 184, 140, 254, 251, 89, 230, 50, 250, 33, 237, 45, 151,
-			209, 183, 92, 70, 223, 4, 151, 209, 211, 177, 203, 232, 72, 236,
-			50, 10, 49, 209, 186, 97, 234, 7, 83, 215, 185, 247, 232, 96,
-			236, 61, 74, 255, 252, 55, 26, 195, 254, 204, 165, 158, 67, 214,
-			111, 105, 42, 246, 39, 211, 88, 59, 176, 63, 219, 79, 140, 56,
-			175, 44, 79, 239, 18, 18, 155, 52, 196, 53, 240, 61, 103, 147,
-			228, 152, 12, 63, 10, 106, 71, 142, 89, 76, 132, 245, 129, 167,
-			231, 228, 105, 114, 121, 178, 135, 86, 36, 173, 143, 138, 145, 186,
-			125, 127, 42, 96, 50, 79, 223, 127, 224, 134, 78, 103, 202, 182,
-			53, 219, 173, 51, 206, 94, 158, 43, 46, 47, 221, 42, 205, 45,
-			149, 167, 139, 75, 165, 25, 158, 77, 70, 4, 119, 2, 76, 40,
-			24, 158, 161, 89, 167, 13, 2, 148, 39, 166, 185, 81, 44, 207,
-			150, 102, 18, 96, 197, 74, 61, 60, 1, 163, 106, 31, 101, 121,
-			168, 217, 185, 85, 83, 208, 71, 115, 217, 126, 252, 239, 53, 129,
-			62, 90, 208, 110, 90, 127, 67, 227, 35, 163, 116, 243, 156, 7,
-			74, 37, 5, 145, 181, 153, 39, 212, 145, 201, 89, 163, 36, 154,
-			159, 91, 227, 97, 213, 33, 75, 157, 237, 113, 148, 86, 21, 19,
-			102, 195, 177, 41, 79, 201, 137, 225, 169, 159, 195, 182, 217, 137,
-			151, 37, 203, 88, 22, 181, 232, 106, 227, 73, 203, 242, 68, 234,
-			64, 98, 112, 188, 159, 224, 235, 168, 244, 72, 110, 41, 204, 118,
-			86, 158, 33, 118, 61, 112, 236, 218, 38, 203, 217, 25, 230, 37,
-			136, 76, 113, 182, 82, 42, 206, 188, 176, 82, 122, 91, 121, 113,
-			105, 17, 39, 102, 103, 226, 54, 153, 154, 82, 108, 127, 33, 153,
-			122, 3, 255, 84, 132, 212, 66, 230, 160, 130, 144, 90, 56, 84,
-			80, 16, 82, 11, 87, 166, 241, 199, 216, 20, 33, 211, 184, 164,
-			93, 62, 111, 189, 47, 158, 35, 233, 210, 172, 34, 212, 186, 138,
-			47, 117, 208, 172, 62, 58, 241, 109, 86, 223, 40, 220, 72, 214,
-			218, 230, 2, 119, 155, 140, 29, 231, 130, 93, 183, 128, 54, 31,
-			167, 46, 22, 38, 204, 216, 239, 154, 37, 178, 179, 67, 210, 85,
-			12, 20, 168, 226, 66, 24, 151, 240, 178, 8, 153, 250, 165, 204,
-			81, 81, 210, 76, 253, 210, 177, 73, 81, 210, 77, 253, 242, 222,
-			81, 124, 13, 104, 167, 153, 250, 21, 237, 166, 53, 198, 149, 111,
-			110, 90, 229, 9, 91, 61, 223, 27, 149, 203, 35, 193, 241, 188,
-			46, 13, 153, 250, 21, 57, 73, 80, 153, 156, 36, 77, 55, 245,
-			43, 87, 166, 241, 77, 104, 71, 55, 245, 107, 218, 179, 214, 36,
-			89, 10, 108, 47, 228, 249, 102, 98, 214, 75, 162, 213, 179, 216,
-			89, 186, 204, 111, 148, 231, 138, 179, 229, 183, 11, 191, 170, 12,
-			115, 238, 187, 150, 25, 20, 37, 205, 212, 175, 29, 158, 16, 37,
-			218, 202, 212, 77, 124, 29, 154, 52, 76, 227, 41, 237, 233, 33,
-			235, 162, 28, 27, 143, 181, 19, 105, 9, 237, 157, 7, 104, 32,
-			83, 127, 42, 115, 92, 148, 52, 83, 127, 138, 60, 45, 74, 186,
-			169, 63, 189, 247, 20, 254, 4, 131, 41, 6, 183, 202, 155, 214,
-			101, 82, 108, 178, 180, 139, 73, 239, 77, 72, 72, 183, 117, 123,
-			108, 17, 45, 169, 158, 129, 63, 132, 69, 148, 70, 166, 94, 146,
-			243, 147, 214, 76, 189, 36, 231, 39, 173, 155, 122, 233, 202, 52,
-			126, 1, 122, 159, 49, 141, 155, 218, 173, 243, 214, 179, 164, 24,
-			249, 13, 238, 120, 104, 203, 145, 172, 82, 246, 235, 240, 93, 220,
-			97, 76, 188, 161, 12, 50, 245, 155, 146, 25, 51, 154, 169, 223,
-			148, 204, 152, 209, 77, 253, 214, 222, 81, 92, 134, 78, 244, 152,
-			198, 179, 218, 108, 206, 186, 218, 78, 67, 213, 216, 255, 104, 141,
-			246, 32, 83, 127, 54, 115, 68, 148, 52, 83, 127, 246, 232, 19,
-			162, 164, 155, 250, 236, 222, 199, 240, 10, 52, 154, 53, 141, 121,
-			109, 225, 178, 245, 220, 163, 140, 92, 213, 79, 187, 244, 4, 119,
-			235, 74, 22, 153, 250, 124, 230, 132, 40, 105, 166, 62, 159, 155,
-			17, 37, 221, 212, 23, 246, 78, 224, 15, 34, 22, 30, 114, 39,
-			229, 32, 107, 120, 11, 176, 231, 246, 229, 95, 192, 19, 243, 63,
-			204, 173, 151, 242, 141, 8, 148, 184, 147, 61, 142, 255, 80, 70,
-			71, 191, 67, 187, 97, 253, 14, 82, 26, 74, 220, 128, 173, 58,
-			85, 31, 66, 79, 164, 131, 123, 242, 228, 43, 48, 7, 44, 38,
-			65, 135, 155, 33, 184, 161, 223, 46, 190, 32, 32, 119, 213, 61,
-			154, 5, 181, 187, 85, 167, 190, 201, 99, 184, 169, 104, 8, 56,
-			226, 92, 194, 192, 36, 7, 254, 192, 163, 231, 39, 46, 92, 188,
-			116, 249, 241, 39, 174, 140, 231, 10, 224, 223, 206, 228, 18, 150,
-			170, 53, 100, 178, 11, 149, 171, 111, 46, 151, 103, 224, 164, 85,
-			157, 163, 95, 226, 30, 210, 43, 239, 24, 125, 105, 228, 84, 34,
-			156, 225, 29, 137, 112, 134, 119, 244, 30, 84, 194, 25, 222, 113,
-			216, 82, 32, 117, 223, 113, 164, 148, 140, 102, 120, 199, 177, 105,
-			60, 44, 98, 23, 86, 180, 163, 214, 81, 149, 102, 112, 168, 128,
-			172, 204, 193, 169, 185, 103, 120, 134, 190, 187, 75, 241, 33, 95,
-			217, 125, 72, 241, 33, 95, 177, 142, 224, 175, 32, 238, 37, 174,
-			215, 180, 65, 235, 239, 34, 82, 236, 18, 8, 33, 157, 249, 56,
-			243, 20, 72, 197, 161, 226, 181, 112, 226, 189, 112, 153, 20, 23,
-			167, 203, 229, 56, 72, 33, 4, 39, 136, 192, 246, 106, 126, 131,
-			44, 47, 179, 251, 92, 42, 81, 54, 26, 144, 208, 92, 56, 205,
-			11, 110, 20, 201, 200, 221, 154, 211, 104, 250, 17, 160, 44, 64,
-			234, 241, 216, 4, 116, 151, 101, 47, 96, 233, 191, 10, 170, 211,
-			121, 45, 225, 116, 94, 235, 221, 175, 56, 157, 215, 14, 30, 194,
-			147, 224, 192, 102, 108, 164, 34, 100, 21, 182, 88, 2, 221, 173,
-			33, 177, 47, 219, 70, 246, 52, 222, 228, 222, 102, 122, 67, 27,
-			183, 234, 162, 154, 240, 69, 247, 165, 66, 220, 205, 216, 1, 147,
-			107, 12, 0, 110, 231, 7, 177, 67, 166, 242, 174, 235, 225, 246,
-			128, 34, 178, 220, 164, 47, 93, 26, 31, 39, 201, 211, 151, 54,
-			109, 208, 182, 101, 41, 99, 234, 141, 190, 156, 40, 33, 83, 111,
-			156, 60, 39, 74, 186, 169, 55, 10, 99, 248, 143, 17, 247, 4,
-			211, 67, 109, 208, 250, 189, 255, 92, 166, 55, 246, 132, 192, 36,
-			140, 2, 223, 91, 175, 111, 170, 245, 3, 42, 187, 223, 108, 213,
-			25, 176, 182, 240, 243, 150, 132, 66, 105, 58, 224, 172, 40, 209,
-			225, 115, 174, 0, 175, 53, 61, 60, 120, 8, 80, 240, 53, 51,
-			253, 48, 245, 126, 132, 172, 139, 44, 110, 46, 137, 212, 178, 53,
-			95, 40, 40, 248, 148, 182, 15, 179, 103, 240, 73, 238, 145, 161,
-			191, 162, 157, 177, 14, 38, 182, 78, 182, 27, 137, 222, 105, 48,
-			141, 175, 104, 178, 148, 49, 245, 87, 184, 255, 42, 184, 24, 232,
-			175, 28, 60, 33, 74, 186, 169, 191, 114, 234, 52, 254, 154, 38,
-			156, 5, 94, 69, 218, 41, 235, 139, 26, 153, 247, 184, 148, 71,
-			53, 73, 150, 190, 151, 55, 211, 161, 64, 132, 204, 21, 95, 65,
-			66, 80, 101, 83, 37, 199, 177, 29, 74, 17, 53, 47, 195, 95,
-			234, 118, 176, 238, 96, 118, 90, 49, 73, 39, 112, 108, 174, 113,
-			169, 158, 220, 109, 194, 110, 72, 86, 157, 77, 72, 194, 192, 225,
-			5, 64, 191, 229, 186, 46, 171, 153, 135, 11, 240, 76, 84, 213,
-			96, 181, 181, 14, 25, 28, 207, 143, 95, 190, 120, 241, 202, 101,
-			161, 118, 240, 235, 170, 23, 221, 151, 152, 179, 23, 128, 122, 135,
-			108, 187, 147, 52, 166, 107, 16, 147, 145, 25, 31, 140, 30, 117,
-			127, 157, 39, 194, 96, 57, 168, 70, 98, 167, 9, 3, 72, 168,
-			250, 80, 188, 42, 242, 124, 50, 31, 138, 87, 145, 121, 92, 241,
-			161, 120, 21, 229, 78, 226, 171, 152, 202, 100, 153, 159, 64, 169,
-			159, 68, 200, 26, 221, 98, 11, 105, 55, 132, 114, 38, 233, 195,
-			186, 161, 35, 211, 248, 9, 148, 61, 142, 79, 99, 195, 208, 181,
-			148, 105, 124, 8, 105, 55, 173, 67, 234, 38, 30, 249, 124, 192,
-			188, 179, 58, 229, 12, 250, 222, 46, 81, 68, 180, 184, 251, 144,
-			40, 234, 180, 104, 29, 17, 197, 44, 45, 30, 189, 1, 215, 213,
-			186, 240, 61, 249, 16, 122, 108, 6, 159, 135, 70, 145, 105, 124,
-			20, 105, 121, 235, 36, 104, 8, 34, 73, 163, 72, 151, 158, 64,
-			239, 16, 29, 64, 25, 248, 70, 52, 129, 160, 138, 163, 103, 69,
-			81, 167, 197, 145, 115, 120, 10, 107, 134, 97, 102, 62, 134, 82,
-			159, 68, 200, 26, 219, 130, 60, 157, 102, 92, 133, 64, 116, 127,
-			254, 24, 202, 158, 192, 23, 176, 97, 24, 148, 64, 159, 64, 218,
-			148, 117, 58, 225, 208, 155, 148, 229, 133, 108, 196, 123, 107, 128,
-			179, 206, 39, 196, 237, 190, 1, 228, 250, 132, 112, 214, 49, 128,
-			92, 159, 64, 60, 104, 208, 0, 114, 125, 2, 153, 215, 128, 92,
-			134, 32, 215, 39, 208, 192, 36, 158, 193, 154, 145, 54, 51, 63,
-			141, 82, 127, 7, 33, 42, 118, 111, 59, 219, 29, 182, 98, 101,
-			84, 105, 240, 157, 204, 14, 177, 128, 139, 52, 29, 214, 167, 145,
-			118, 219, 250, 42, 218, 102, 92, 118, 173, 54, 198, 110, 4, 85,
-			181, 130, 231, 225, 206, 99, 112, 203, 41, 119, 136, 64, 9, 191,
-			51, 79, 169, 28, 68, 26, 22, 239, 196, 254, 78, 52, 167, 192,
-			132, 113, 243, 13, 68, 243, 62, 176, 3, 72, 241, 29, 49, 164,
-			24, 69, 242, 164, 212, 75, 3, 169, 63, 45, 72, 157, 6, 82,
-			127, 26, 245, 30, 19, 69, 112, 10, 37, 39, 68, 49, 75, 139,
-			185, 89, 32, 117, 90, 144, 250, 211, 232, 212, 51, 248, 191, 98,
-			116, 65, 166, 241, 57, 164, 157, 177, 62, 203, 232, 18, 118, 18,
-			134, 175, 119, 232, 97, 126, 11, 18, 148, 89, 182, 59, 246, 109,
-			152, 252, 154, 110, 128, 194, 4, 33, 210, 16, 243, 64, 74, 137,
-			100, 229, 214, 29, 47, 170, 111, 98, 14, 0, 195, 180, 110, 121,
-			98, 85, 55, 229, 232, 233, 38, 242, 57, 177, 137, 164, 97, 19,
-			249, 156, 216, 68, 210, 176, 74, 62, 135, 76, 49, 122, 186, 74,
-			62, 135, 78, 157, 198, 255, 136, 141, 85, 51, 141, 159, 71, 218,
-			176, 245, 229, 182, 177, 10, 36, 177, 122, 220, 193, 246, 241, 51,
-			174, 128, 225, 227, 215, 49, 126, 22, 130, 200, 171, 230, 196, 120,
-			221, 52, 160, 74, 240, 207, 199, 52, 208, 210, 180, 40, 105, 64,
-			103, 244, 231, 145, 121, 74, 20, 117, 90, 60, 59, 4, 27, 105,
-			198, 204, 252, 34, 74, 125, 125, 155, 141, 180, 221, 210, 167, 172,
-			168, 12, 50, 141, 95, 164, 27, 233, 52, 54, 140, 12, 93, 80,
-			95, 64, 218, 180, 117, 41, 177, 158, 154, 118, 192, 96, 186, 196,
-			248, 183, 224, 24, 232, 93, 6, 152, 249, 11, 130, 153, 51, 192,
-			204, 95, 64, 189, 251, 69, 81, 167, 197, 131, 135, 68, 49, 75,
-			139, 131, 215, 129, 153, 51, 130, 153, 191, 128, 172, 167, 241, 135,
-			53, 232, 19, 50, 141, 47, 33, 237, 150, 245, 125, 197, 35, 58,
-			86, 213, 217, 33, 77, 15, 102, 30, 1, 204, 210, 95, 179, 56,
-			204, 90, 2, 222, 175, 230, 212, 90, 77, 105, 254, 11, 153, 139,
-			28, 102, 130, 147, 90, 171, 52, 182, 197, 1, 245, 157, 245, 38,
-			204, 111, 92, 238, 116, 18, 36, 82, 64, 97, 88, 131, 84, 47,
-			170, 58, 14, 195, 99, 242, 155, 77, 95, 122, 150, 50, 211, 29,
-			243, 192, 76, 26, 240, 152, 253, 78, 146, 150, 30, 32, 95, 18,
-			39, 88, 6, 150, 198, 151, 208, 238, 65, 81, 212, 105, 241, 200,
-			81, 81, 204, 210, 226, 177, 155, 156, 180, 44, 146, 213, 248, 18,
-			58, 94, 194, 255, 183, 1, 164, 213, 76, 227, 107, 72, 27, 180,
-			254, 208, 248, 11, 47, 199, 146, 114, 163, 9, 32, 125, 224, 14,
-			175, 6, 50, 10, 246, 224, 137, 189, 100, 94, 44, 207, 9, 98,
-			164, 55, 54, 237, 121, 1, 242, 1, 235, 155, 165, 91, 35, 81,
-			171, 89, 119, 200, 80, 220, 227, 60, 228, 12, 125, 184, 226, 175,
-			137, 152, 149, 225, 28, 79, 54, 135, 201, 104, 66, 93, 161, 170,
-			56, 167, 214, 77, 160, 214, 154, 10, 49, 35, 115, 202, 181, 66,
-			39, 160, 159, 182, 215, 75, 43, 24, 167, 18, 104, 183, 21, 14,
-			160, 53, 240, 69, 44, 82, 114, 128, 115, 66, 191, 217, 246, 86,
-			83, 250, 42, 130, 81, 133, 185, 45, 47, 205, 207, 204, 15, 189,
-			92, 221, 112, 189, 186, 227, 12, 79, 242, 237, 147, 195, 181, 8,
-			234, 178, 176, 62, 230, 119, 42, 97, 47, 24, 196, 20, 191, 34,
-			96, 204, 73, 55, 184, 175, 197, 155, 4, 93, 229, 95, 139, 55,
-			9, 186, 193, 125, 141, 110, 18, 159, 64, 88, 51, 122, 204, 204,
-			63, 70, 169, 255, 5, 33, 235, 194, 206, 218, 166, 50, 22, 214,
-			111, 102, 122, 249, 97, 25, 236, 166, 166, 216, 198, 217, 131, 76,
-			227, 31, 163, 236, 105, 216, 56, 123, 52, 240, 41, 125, 99, 27,
-			103, 15, 108, 156, 223, 16, 52, 233, 129, 141, 243, 27, 130, 38,
-			61, 176, 113, 126, 67, 108, 156, 61, 176, 113, 126, 67, 108, 156,
-			61, 98, 227, 252, 6, 221, 56, 191, 135, 160, 79, 200, 52, 126,
-			19, 105, 227, 214, 183, 17, 169, 136, 45, 72, 238, 152, 109, 247,
-			80, 9, 229, 91, 17, 104, 118, 84, 190, 213, 107, 19, 134, 158,
-			128, 99, 229, 251, 77, 85, 233, 25, 89, 168, 44, 241, 155, 226,
-			28, 237, 129, 13, 243, 55, 81, 95, 78, 20, 129, 38, 39, 207,
-			137, 162, 78, 139, 133, 49, 252, 167, 140, 98, 154, 105, 252, 22,
-			221, 15, 255, 245, 95, 124, 189, 158, 83, 128, 46, 195, 223, 138,
-			89, 142, 242, 204, 111, 197, 44, 71, 151, 225, 111, 81, 150, 187,
-			142, 53, 35, 107, 102, 126, 7, 165, 254, 213, 35, 42, 247, 29,
-			203, 16, 86, 77, 22, 153, 198, 239, 160, 236, 25, 208, 219, 178,
-			116, 213, 252, 46, 210, 206, 90, 135, 146, 203, 51, 214, 239, 105,
-			55, 178, 0, 38, 241, 187, 98, 78, 179, 160, 198, 253, 46, 234,
-			59, 32, 138, 136, 22, 15, 230, 68, 81, 167, 197, 211, 103, 240,
-			211, 88, 51, 122, 205, 204, 239, 163, 212, 31, 35, 100, 77, 236,
-			40, 27, 117, 196, 105, 64, 143, 123, 145, 105, 252, 62, 202, 158,
-			130, 117, 222, 75, 123, 252, 237, 55, 184, 206, 123, 97, 157, 127,
-			91, 16, 189, 23, 6, 240, 109, 65, 244, 94, 24, 192, 183, 197,
-			58, 239, 133, 117, 254, 109, 177, 206, 123, 197, 58, 255, 54, 93,
-			231, 227, 208, 37, 100, 102, 254, 0, 105, 223, 65, 231, 45, 2,
-			2, 82, 251, 88, 20, 33, 137, 87, 73, 23, 197, 31, 32, 109,
-			159, 40, 34, 90, 52, 115, 162, 168, 211, 226, 233, 51, 162, 152,
-			53, 141, 239, 160, 204, 56, 111, 159, 75, 17, 223, 65, 217, 2,
-			254, 42, 130, 14, 104, 166, 241, 71, 232, 63, 51, 99, 39, 27,
-			27, 229, 254, 63, 138, 39, 130, 114, 255, 31, 197, 19, 65, 185,
-			255, 143, 232, 68, 220, 192, 154, 129, 1, 65, 251, 253, 26, 178,
-			158, 120, 180, 51, 72, 117, 126, 81, 248, 9, 51, 84, 149, 17,
-			224, 39, 204, 81, 85, 222, 16, 63, 97, 142, 174, 194, 135, 129,
-			57, 186, 10, 31, 6, 230, 232, 42, 156, 159, 48, 240, 211, 247,
-			4, 63, 97, 193, 79, 223, 163, 252, 244, 103, 8, 250, 132, 76,
-			227, 207, 145, 118, 201, 250, 78, 215, 115, 163, 125, 108, 127, 97,
-			206, 14, 12, 103, 199, 159, 139, 125, 6, 195, 50, 249, 115, 212,
-			119, 86, 20, 129, 46, 67, 227, 162, 168, 211, 226, 133, 139, 248,
-			79, 24, 213, 52, 211, 120, 85, 251, 47, 195, 38, 204, 8, 64,
-			23, 207, 171, 154, 228, 58, 48, 166, 106, 146, 235, 232, 226, 121,
-			85, 59, 120, 8, 223, 196, 154, 209, 103, 102, 62, 168, 165, 62,
-			164, 33, 235, 202, 35, 30, 29, 91, 172, 158, 62, 100, 26, 31,
-			212, 178, 231, 240, 8, 54, 140, 62, 186, 122, 254, 178, 166, 157,
-			183, 142, 178, 243, 35, 113, 231, 151, 60, 68, 250, 224, 16, 249,
-			203, 154, 38, 139, 25, 90, 236, 179, 68, 17, 209, 226, 145, 188,
-			40, 234, 180, 56, 54, 46, 35, 83, 254, 218, 151, 209, 107, 8,
-			77, 225, 30, 113, 63, 178, 228, 173, 59, 71, 167, 236, 152, 222,
-			245, 81, 7, 99, 7, 145, 187, 102, 87, 163, 55, 144, 21, 246,
-			245, 135, 202, 92, 126, 196, 15, 155, 129, 195, 34, 82, 223, 112,
-			136, 205, 24, 30, 184, 233, 68, 175, 33, 28, 130, 125, 208, 25,
-			85, 176, 93, 252, 196, 193, 89, 55, 140, 94, 175, 127, 255, 17,
-			220, 219, 180, 215, 157, 149, 208, 125, 197, 225, 40, 86, 89, 250,
-			96, 209, 125, 197, 49, 143, 97, 12, 63, 130, 137, 95, 248, 120,
-			211, 39, 16, 142, 98, 62, 142, 123, 3, 199, 102, 140, 194, 83,
-			230, 110, 23, 44, 146, 165, 47, 67, 168, 200, 171, 8, 31, 234,
-			232, 244, 15, 201, 209, 223, 60, 131, 247, 122, 206, 195, 104, 69,
-			233, 57, 139, 231, 217, 77, 31, 47, 136, 222, 231, 198, 240, 225,
-			206, 144, 90, 65, 59, 83, 165, 55, 39, 245, 123, 241, 17, 209,
-			233, 215, 237, 129, 255, 6, 200, 157, 251, 105, 132, 143, 118, 239,
-			192, 143, 198, 153, 254, 145, 41, 249, 203, 26, 62, 244, 92, 203,
-			9, 54, 187, 48, 33, 233, 12, 139, 234, 77, 6, 62, 77, 227,
-			94, 185, 224, 120, 44, 197, 233, 237, 102, 123, 65, 188, 92, 137,
-			191, 51, 159, 196, 187, 27, 246, 195, 149, 48, 178, 235, 142, 231,
-			132, 161, 204, 169, 187, 85, 10, 227, 202, 174, 134, 253, 112, 81,
-			188, 158, 156, 23, 99, 219, 121, 73, 111, 187, 12, 50, 175, 97,
-			25, 188, 31, 225, 193, 78, 186, 189, 217, 235, 224, 35, 26, 62,
-			42, 123, 209, 141, 177, 119, 158, 194, 219, 157, 83, 56, 178, 35,
-			195, 201, 121, 100, 171, 228, 255, 27, 147, 153, 251, 20, 194, 199,
-			182, 160, 198, 127, 226, 85, 246, 151, 16, 38, 109, 220, 178, 24,
-			217, 145, 27, 70, 110, 245, 53, 204, 85, 7, 113, 181, 215, 68,
-			220, 220, 115, 248, 196, 54, 189, 224, 52, 202, 99, 51, 242, 35,
-			187, 190, 210, 198, 194, 104, 72, 175, 244, 195, 47, 10, 203, 231,
-			70, 177, 121, 211, 137, 138, 92, 52, 216, 241, 200, 243, 241, 0,
-			221, 6, 197, 251, 114, 236, 71, 112, 134, 105, 58, 234, 39, 252,
-			209, 27, 218, 120, 55, 241, 129, 182, 6, 249, 48, 159, 192, 189,
-			66, 160, 17, 44, 96, 117, 178, 128, 28, 88, 252, 242, 35, 79,
-			250, 63, 211, 241, 1, 32, 119, 199, 104, 119, 158, 233, 59, 120,
-			23, 75, 9, 186, 226, 212, 214, 29, 49, 209, 23, 58, 59, 216,
-			181, 129, 66, 169, 182, 238, 44, 109, 54, 157, 69, 39, 170, 244,
-			177, 138, 232, 163, 208, 124, 1, 31, 80, 166, 117, 37, 94, 249,
-			250, 107, 217, 188, 247, 71, 157, 15, 59, 153, 211, 120, 3, 43,
-			63, 189, 237, 44, 103, 218, 102, 217, 170, 226, 62, 101, 200, 230,
-			121, 17, 115, 234, 36, 3, 72, 41, 111, 101, 69, 184, 105, 50,
-			100, 233, 68, 219, 150, 173, 193, 171, 137, 40, 196, 87, 240, 193,
-			118, 106, 191, 105, 188, 244, 93, 13, 31, 73, 8, 151, 183, 220,
-			48, 242, 131, 77, 193, 81, 3, 56, 29, 56, 118, 189, 193, 133,
-			30, 86, 48, 79, 225, 61, 60, 181, 197, 74, 224, 172, 59, 15,
-			155, 188, 242, 93, 44, 143, 61, 248, 214, 53, 205, 121, 188, 79,
-			38, 153, 104, 227, 135, 220, 150, 121, 237, 99, 102, 232, 191, 223,
-			246, 196, 188, 142, 179, 213, 230, 74, 96, 123, 235, 14, 103, 130,
-			46, 124, 53, 237, 55, 26, 110, 180, 224, 51, 191, 98, 128, 33,
-			187, 149, 170, 244, 84, 155, 240, 167, 121, 13, 99, 170, 167, 240,
-			90, 210, 80, 203, 145, 46, 220, 233, 54, 28, 241, 109, 111, 36,
-			10, 73, 94, 202, 192, 214, 181, 21, 47, 57, 109, 188, 116, 189,
-			7, 167, 161, 209, 220, 159, 106, 248, 104, 119, 154, 203, 211, 164,
-			199, 241, 162, 192, 117, 196, 164, 119, 137, 158, 218, 174, 130, 66,
-			201, 139, 130, 205, 138, 168, 228, 81, 153, 193, 250, 159, 17, 78,
-			195, 167, 102, 25, 239, 173, 2, 29, 87, 154, 156, 144, 60, 120,
-			156, 236, 72, 240, 61, 213, 68, 217, 188, 77, 215, 140, 204, 26,
-			32, 117, 196, 45, 3, 201, 37, 26, 5, 93, 79, 226, 59, 249,
-			208, 188, 136, 51, 60, 64, 90, 223, 57, 64, 186, 194, 223, 157,
-			248, 24, 198, 89, 145, 52, 199, 124, 30, 239, 78, 40, 96, 230,
-			153, 174, 228, 125, 237, 177, 139, 172, 98, 37, 154, 176, 123, 197,
-			175, 61, 148, 112, 3, 239, 109, 83, 141, 204, 161, 206, 79, 186,
-			171, 124, 86, 151, 144, 198, 45, 244, 172, 92, 202, 92, 131, 99,
-			183, 61, 100, 176, 75, 132, 231, 150, 106, 210, 163, 197, 11, 62,
-			96, 231, 117, 71, 156, 224, 232, 214, 157, 237, 22, 32, 216, 37,
-			150, 113, 59, 109, 40, 151, 50, 239, 225, 254, 118, 241, 186, 91,
-			208, 231, 22, 170, 139, 213, 69, 132, 221, 74, 90, 207, 165, 204,
-			87, 248, 65, 221, 49, 204, 46, 253, 222, 78, 220, 182, 186, 68,
-			140, 110, 43, 144, 230, 82, 230, 7, 17, 62, 188, 165, 80, 214,
-			45, 146, 118, 39, 57, 210, 218, 74, 74, 216, 78, 234, 203, 165,
-			204, 231, 112, 159, 34, 201, 153, 167, 186, 242, 82, 155, 160, 103,
-			109, 115, 204, 229, 82, 230, 42, 222, 157, 16, 190, 186, 45, 180,
-			110, 226, 160, 117, 118, 199, 247, 100, 183, 29, 188, 39, 121, 42,
-			155, 93, 62, 238, 42, 37, 89, 93, 214, 102, 247, 3, 158, 45,
-			132, 110, 91, 121, 183, 133, 176, 205, 57, 221, 109, 33, 108, 119,
-			66, 188, 190, 16, 217, 63, 252, 40, 98, 49, 178, 255, 82, 127,
-			43, 70, 246, 173, 24, 217, 55, 23, 86, 69, 137, 145, 29, 142,
-			99, 100, 159, 130, 63, 211, 166, 126, 40, 53, 5, 127, 102, 76,
-			125, 144, 7, 209, 246, 152, 250, 225, 212, 211, 240, 103, 214, 212,
-			45, 25, 79, 123, 52, 142, 167, 165, 127, 158, 99, 225, 180, 199,
-			83, 43, 200, 58, 158, 140, 166, 181, 59, 98, 105, 69, 80, 232,
-			241, 108, 63, 62, 43, 98, 66, 79, 104, 69, 203, 34, 21, 135,
-			74, 91, 247, 89, 216, 92, 151, 208, 31, 128, 28, 201, 236, 87,
-			130, 24, 79, 12, 12, 41, 65, 140, 39, 46, 76, 225, 251, 60,
-			134, 81, 63, 211, 94, 101, 50, 7, 235, 143, 38, 66, 12, 209,
-			134, 101, 15, 233, 188, 156, 145, 61, 68, 186, 169, 159, 185, 48,
-			133, 255, 14, 226, 161, 130, 70, 94, 27, 29, 177, 254, 58, 82,
-			58, 153, 136, 7, 99, 65, 117, 29, 215, 140, 116, 39, 96, 176,
-			135, 242, 142, 70, 130, 45, 240, 24, 203, 100, 61, 254, 90, 87,
-			231, 74, 150, 30, 191, 221, 248, 17, 10, 223, 248, 68, 84, 98,
-			62, 115, 64, 137, 74, 204, 31, 204, 43, 81, 137, 163, 123, 207,
-			224, 81, 30, 149, 104, 140, 107, 231, 117, 235, 120, 39, 217, 21,
-			179, 78, 34, 246, 112, 60, 17, 123, 56, 158, 136, 61, 28, 159,
-			154, 197, 95, 68, 34, 248, 240, 113, 237, 137, 49, 235, 243, 29,
-			196, 74, 92, 39, 189, 201, 20, 75, 220, 127, 181, 147, 205, 64,
-			166, 254, 120, 198, 82, 98, 29, 31, 63, 114, 89, 137, 117, 124,
-			98, 239, 57, 252, 65, 17, 235, 104, 76, 105, 79, 158, 179, 30,
-			110, 201, 9, 0, 14, 237, 37, 238, 154, 3, 167, 218, 10, 66,
-			247, 190, 83, 223, 132, 108, 185, 205, 166, 31, 192, 236, 41, 215,
-			181, 194, 145, 90, 125, 193, 174, 221, 167, 155, 123, 141, 238, 251,
-			17, 164, 188, 43, 168, 241, 141, 83, 137, 248, 198, 169, 68, 124,
-			227, 147, 123, 207, 226, 247, 138, 248, 198, 105, 109, 102, 220, 106,
-			110, 63, 29, 29, 221, 254, 97, 245, 52, 131, 76, 125, 90, 198,
-			35, 102, 52, 83, 159, 150, 241, 136, 25, 221, 212, 103, 246, 230,
-			241, 23, 144, 136, 130, 44, 107, 207, 92, 180, 62, 147, 96, 157,
-			54, 31, 213, 80, 138, 93, 12, 62, 60, 217, 229, 233, 86, 16,
-			128, 135, 48, 9, 69, 223, 192, 218, 166, 192, 200, 38, 166, 43,
-			198, 132, 105, 115, 55, 165, 199, 83, 224, 84, 35, 158, 83, 214,
-			245, 68, 49, 17, 104, 89, 150, 17, 178, 61, 154, 169, 151, 101,
-			132, 108, 143, 110, 234, 207, 236, 29, 199, 33, 15, 180, 212, 231,
-			181, 171, 214, 96, 114, 215, 148, 55, 129, 120, 98, 150, 110, 112,
-			82, 96, 122, 163, 238, 116, 106, 240, 229, 62, 53, 248, 210, 60,
-			165, 4, 95, 206, 143, 61, 142, 255, 25, 163, 123, 175, 169, 47,
-			107, 179, 214, 127, 171, 146, 93, 90, 87, 182, 90, 169, 99, 145,
-			146, 233, 64, 44, 90, 55, 233, 111, 17, 182, 175, 132, 68, 32,
-			78, 251, 194, 198, 74, 163, 91, 45, 235, 120, 85, 199, 228, 106,
-			95, 206, 189, 200, 212, 151, 229, 206, 222, 171, 153, 250, 178, 220,
-			217, 123, 117, 83, 95, 190, 112, 11, 127, 157, 141, 28, 155, 250,
-			139, 218, 188, 245, 165, 45, 70, 190, 227, 90, 158, 182, 33, 5,
-			3, 124, 153, 236, 125, 130, 203, 148, 161, 180, 241, 153, 194, 102,
-			204, 187, 84, 114, 217, 142, 171, 143, 143, 8, 35, 83, 127, 49,
-			51, 32, 74, 154, 169, 191, 120, 96, 68, 148, 116, 83, 127, 241,
-			210, 179, 120, 23, 12, 182, 207, 52, 94, 210, 222, 41, 120, 160,
-			15, 153, 250, 75, 114, 211, 235, 211, 76, 253, 165, 163, 143, 139,
-			146, 110, 234, 239, 52, 8, 190, 204, 98, 115, 87, 83, 14, 178,
-			70, 182, 112, 211, 73, 24, 15, 148, 184, 51, 42, 1, 172, 102,
-			143, 226, 103, 68, 40, 109, 77, 155, 178, 166, 64, 88, 246, 182,
-			140, 45, 225, 13, 108, 229, 158, 19, 135, 170, 214, 18, 161, 170,
-			181, 4, 242, 86, 173, 127, 159, 18, 170, 90, 51, 175, 37, 67,
-			85, 107, 3, 147, 48, 48, 136, 184, 188, 183, 237, 192, 58, 92,
-			252, 227, 104, 203, 163, 248, 89, 17, 109, 249, 178, 54, 101, 61,
-			217, 49, 176, 54, 191, 250, 196, 200, 148, 36, 33, 202, 200, 16,
-			7, 44, 206, 42, 17, 147, 47, 243, 145, 177, 136, 201, 151, 249,
-			200, 16, 140, 236, 101, 62, 50, 36, 70, 246, 242, 192, 36, 190,
-			194, 162, 6, 189, 212, 7, 17, 178, 242, 91, 12, 173, 205, 36,
-			210, 22, 45, 232, 101, 31, 195, 79, 137, 104, 193, 166, 54, 109,
-			77, 108, 17, 13, 196, 147, 52, 39, 177, 222, 220, 218, 123, 114,
-			113, 36, 97, 154, 214, 144, 85, 98, 7, 155, 60, 206, 145, 197,
-			14, 54, 57, 22, 12, 36, 39, 214, 155, 131, 215, 97, 64, 34,
-			51, 177, 222, 180, 158, 102, 174, 164, 32, 217, 60, 208, 14, 90,
-			191, 207, 130, 22, 26, 246, 67, 183, 209, 106, 108, 181, 171, 203,
-			32, 0, 9, 233, 194, 5, 93, 170, 15, 241, 148, 41, 107, 206,
-			3, 129, 56, 2, 186, 21, 68, 226, 177, 40, 149, 150, 39, 115,
-			126, 231, 137, 29, 145, 134, 31, 70, 228, 252, 248, 120, 178, 17,
-			17, 147, 194, 234, 227, 254, 67, 113, 215, 88, 18, 127, 55, 4,
-			96, 234, 171, 60, 208, 143, 216, 171, 254, 125, 7, 30, 201, 239,
-			171, 190, 19, 84, 153, 158, 68, 159, 75, 218, 161, 52, 29, 114,
-			143, 40, 81, 2, 100, 247, 137, 146, 110, 234, 15, 6, 14, 224,
-			127, 47, 146, 39, 235, 239, 213, 6, 173, 239, 32, 82, 36, 77,
-			0, 4, 244, 239, 57, 108, 179, 114, 92, 153, 46, 220, 150, 49,
-			60, 228, 110, 27, 7, 220, 37, 85, 187, 94, 47, 96, 178, 192,
-			156, 153, 120, 82, 110, 63, 222, 220, 24, 24, 206, 106, 72, 185,
-			201, 139, 160, 21, 74, 220, 231, 55, 28, 143, 22, 92, 207, 102,
-			234, 93, 156, 38, 167, 105, 7, 118, 195, 137, 156, 32, 118, 145,
-			162, 53, 118, 54, 125, 123, 121, 113, 9, 147, 6, 120, 198, 67,
-			62, 120, 90, 7, 68, 126, 197, 223, 193, 161, 34, 6, 38, 73,
-			164, 165, 233, 192, 5, 123, 81, 30, 121, 175, 100, 47, 42, 239,
-			190, 247, 224, 33, 252, 219, 136, 231, 83, 54, 62, 128, 180, 17,
-			235, 191, 71, 228, 134, 12, 41, 228, 251, 114, 123, 204, 41, 227,
-			2, 0, 116, 112, 56, 46, 190, 128, 102, 104, 64, 192, 25, 15,
-			114, 96, 248, 20, 42, 56, 49, 75, 189, 108, 175, 135, 2, 72,
-			138, 237, 251, 34, 156, 134, 111, 7, 30, 196, 85, 193, 252, 219,
-			245, 7, 246, 38, 21, 67, 226, 51, 194, 129, 180, 32, 107, 36,
-			7, 119, 125, 34, 223, 180, 76, 49, 192, 2, 99, 192, 53, 73,
-			166, 112, 206, 192, 224, 142, 40, 41, 156, 63, 128, 142, 158, 86,
-			82, 56, 127, 0, 13, 13, 139, 40, 209, 15, 161, 212, 39, 100,
-			112, 83, 23, 207, 177, 238, 155, 131, 136, 18, 253, 16, 202, 30,
-			135, 221, 1, 162, 68, 63, 130, 180, 179, 214, 249, 246, 56, 34,
-			126, 114, 42, 234, 115, 123, 170, 10, 30, 18, 106, 64, 13, 106,
-			56, 233, 71, 132, 31, 50, 11, 39, 253, 136, 240, 67, 102, 225,
-			164, 31, 65, 167, 207, 224, 63, 66, 34, 92, 244, 227, 72, 59,
-			102, 253, 46, 101, 122, 206, 239, 16, 77, 34, 128, 238, 66, 202,
-			167, 118, 72, 238, 198, 55, 1, 119, 59, 88, 218, 115, 30, 10,
-			102, 6, 56, 196, 68, 36, 139, 223, 112, 163, 136, 199, 225, 5,
-			14, 121, 224, 64, 144, 90, 251, 50, 8, 137, 64, 5, 112, 27,
-			12, 141, 79, 184, 36, 242, 42, 157, 182, 188, 75, 116, 58, 101,
-			226, 140, 60, 32, 178, 75, 194, 209, 13, 202, 110, 54, 29, 59,
-			144, 104, 51, 60, 152, 53, 13, 227, 205, 42, 161, 174, 31, 71,
-			189, 131, 74, 168, 235, 199, 209, 145, 163, 34, 212, 245, 167, 80,
-			234, 175, 111, 19, 234, 186, 85, 38, 125, 25, 234, 250, 83, 40,
-			123, 2, 223, 17, 161, 174, 159, 66, 154, 105, 221, 234, 126, 194,
-			181, 37, 40, 233, 56, 230, 148, 54, 84, 39, 91, 22, 13, 251,
-			169, 100, 52, 236, 167, 146, 209, 176, 159, 66, 253, 251, 240, 83,
-			44, 216, 245, 211, 40, 245, 5, 132, 172, 243, 59, 28, 104, 91,
-			184, 57, 166, 33, 254, 51, 123, 18, 112, 103, 32, 204, 245, 51,
-			232, 117, 158, 107, 113, 124, 233, 103, 146, 241, 165, 159, 17, 30,
-			194, 44, 190, 244, 51, 194, 67, 152, 197, 151, 126, 70, 120, 8,
-			203, 248, 210, 207, 32, 235, 105, 252, 125, 25, 95, 250, 115, 72,
-			59, 104, 253, 31, 219, 158, 110, 237, 41, 88, 126, 116, 71, 92,
-			162, 165, 31, 221, 57, 23, 135, 167, 254, 156, 192, 240, 97, 225,
-			169, 63, 135, 178, 251, 148, 240, 212, 159, 67, 3, 7, 240, 251,
-			52, 17, 158, 250, 75, 72, 27, 180, 254, 221, 107, 63, 235, 84,
-			230, 248, 79, 113, 224, 37, 218, 231, 9, 238, 95, 219, 169, 23,
-			199, 178, 254, 82, 204, 124, 148, 123, 126, 41, 102, 62, 122, 210,
-			253, 18, 101, 190, 167, 89, 44, 235, 223, 67, 169, 175, 202, 120,
-			141, 109, 182, 251, 45, 150, 78, 134, 65, 122, 156, 194, 51, 34,
-			160, 245, 139, 72, 59, 111, 93, 142, 247, 252, 78, 59, 194, 14,
-			27, 127, 6, 54, 254, 47, 138, 141, 63, 3, 27, 255, 23, 17,
-			247, 29, 102, 1, 174, 95, 68, 220, 119, 152, 5, 184, 126, 17,
-			141, 141, 179, 141, 31, 226, 87, 191, 242, 95, 204, 198, 159, 129,
-			229, 241, 149, 56, 176, 15, 193, 240, 123, 213, 16, 213, 175, 208,
-			141, 255, 42, 139, 235, 251, 85, 148, 250, 253, 109, 34, 151, 59,
-			44, 136, 241, 68, 247, 32, 211, 248, 85, 122, 184, 255, 27, 36,
-			34, 240, 190, 142, 180, 156, 245, 47, 98, 221, 120, 91, 165, 54,
-			25, 208, 157, 223, 66, 175, 197, 100, 232, 190, 107, 171, 170, 93,
-			55, 31, 19, 128, 201, 94, 100, 128, 216, 174, 183, 190, 69, 82,
-			187, 144, 56, 239, 106, 185, 247, 237, 186, 195, 176, 218, 222, 69,
-			7, 71, 95, 247, 189, 164, 138, 13, 171, 74, 102, 127, 132, 190,
-			198, 49, 130, 6, 140, 83, 13, 25, 252, 186, 136, 19, 103, 33,
-			131, 95, 71, 230, 49, 37, 100, 240, 235, 136, 156, 0, 165, 22,
-			2, 2, 127, 29, 105, 167, 172, 107, 148, 17, 147, 120, 181, 9,
-			67, 7, 3, 107, 179, 255, 95, 246, 174, 62, 54, 174, 226, 136,
-			223, 206, 174, 159, 207, 107, 251, 62, 246, 236, 212, 60, 219, 225,
-			197, 132, 198, 212, 31, 129, 182, 148, 143, 134, 86, 124, 180, 20,
-			33, 10, 84, 252, 87, 169, 205, 217, 126, 117, 78, 216, 62, 235,
-			238, 12, 181, 80, 255, 105, 36, 254, 64, 162, 106, 248, 144, 172,
-			42, 105, 101, 36, 20, 129, 132, 64, 66, 20, 168, 139, 144, 210,
-			8, 72, 21, 170, 208, 210, 32, 81, 35, 129, 90, 172, 80, 68,
-			37, 104, 171, 208, 32, 85, 213, 204, 238, 188, 247, 238, 124, 103,
-			146, 72, 1, 210, 240, 231, 172, 207, 111, 119, 103, 103, 103, 126,
-			179, 187, 51, 83, 43, 85, 169, 190, 55, 197, 12, 240, 3, 235,
-			100, 96, 221, 65, 1, 189, 137, 192, 186, 131, 98, 211, 249, 137,
-			192, 186, 131, 98, 232, 2, 189, 15, 56, 176, 238, 176, 128, 49,
-			255, 103, 182, 254, 210, 29, 238, 42, 137, 139, 86, 82, 6, 57,
-			218, 138, 118, 99, 212, 31, 187, 84, 138, 119, 86, 109, 241, 45,
-			155, 249, 132, 114, 107, 232, 128, 222, 28, 5, 11, 243, 81, 77,
-			158, 232, 145, 124, 67, 246, 6, 186, 107, 154, 45, 254, 56, 136,
-			94, 41, 97, 199, 151, 206, 54, 28, 239, 196, 117, 176, 162, 243,
-			29, 22, 157, 133, 249, 153, 114, 17, 69, 231, 171, 179, 65, 113,
-			186, 60, 174, 131, 107, 119, 149, 203, 85, 92, 187, 162, 77, 232,
-			82, 137, 122, 119, 6, 109, 106, 97, 50, 206, 52, 137, 59, 132,
-			130, 253, 131, 155, 92, 1, 151, 29, 87, 125, 229, 226, 217, 136,
-			153, 224, 17, 127, 252, 68, 20, 222, 97, 209, 63, 156, 136, 194,
-			59, 44, 70, 70, 245, 251, 86, 210, 165, 81, 127, 18, 231, 138,
-			111, 105, 89, 32, 219, 104, 206, 237, 76, 10, 36, 211, 44, 245,
-			146, 56, 210, 211, 171, 255, 99, 25, 164, 140, 122, 157, 194, 218,
-			79, 222, 230, 54, 42, 154, 79, 210, 222, 174, 239, 251, 116, 60,
-			76, 203, 10, 213, 70, 115, 231, 120, 78, 196, 198, 175, 199, 241,
-			156, 74, 34, 201, 78, 102, 59, 26, 230, 183, 254, 63, 157, 204,
-			118, 104, 243, 104, 114, 253, 76, 10, 36, 157, 147, 217, 78, 181,
-			105, 222, 66, 39, 115, 135, 141, 108, 125, 219, 70, 137, 142, 183,
-			66, 29, 27, 24, 162, 180, 48, 234, 109, 145, 14, 244, 15, 56,
-			166, 245, 24, 122, 153, 183, 4, 55, 81, 138, 167, 198, 91, 202,
-			224, 230, 138, 173, 50, 63, 177, 184, 254, 144, 58, 184, 225, 186,
-			81, 251, 251, 27, 174, 75, 102, 156, 184, 225, 186, 186, 96, 216,
-			99, 245, 193, 176, 199, 234, 131, 97, 143, 213, 7, 195, 30, 67,
-			39, 244, 17, 65, 131, 163, 0, 71, 24, 244, 127, 241, 25, 198,
-			34, 110, 232, 34, 25, 155, 153, 38, 3, 243, 30, 227, 136, 52,
-			25, 152, 247, 16, 71, 184, 40, 223, 247, 69, 234, 62, 104, 29,
-			229, 219, 252, 94, 173, 62, 202, 247, 125, 68, 141, 127, 23, 28,
-			230, 123, 28, 193, 196, 95, 26, 193, 68, 88, 127, 47, 119, 178,
-			136, 66, 39, 32, 197, 103, 11, 81, 116, 144, 64, 29, 103, 129,
-			178, 193, 201, 199, 25, 81, 216, 224, 228, 227, 140, 40, 108, 112,
-			242, 113, 68, 20, 183, 114, 236, 241, 9, 1, 31, 137, 75, 252,
-			171, 25, 83, 52, 148, 251, 58, 69, 96, 97, 131, 147, 79, 176,
-			45, 180, 193, 201, 39, 68, 255, 214, 68, 112, 242, 9, 177, 109,
-			56, 17, 156, 252, 209, 186, 224, 228, 143, 68, 122, 220, 34, 15,
-			10, 78, 222, 13, 159, 35, 143, 230, 200, 163, 131, 144, 199, 110,
-			136, 184, 141, 10, 98, 55, 244, 15, 39, 34, 160, 119, 195, 200,
-			168, 245, 251, 59, 144, 188, 7, 206, 77, 191, 191, 131, 48, 200,
-			61, 224, 48, 72, 7, 97, 144, 123, 32, 205, 251, 68, 18, 111,
-			122, 122, 245, 110, 43, 119, 202, 168, 61, 0, 125, 254, 191, 78,
-			3, 131, 124, 90, 142, 127, 243, 1, 156, 182, 231, 223, 65, 104,
-			100, 15, 68, 241, 245, 136, 70, 246, 64, 20, 95, 143, 104, 100,
-			15, 184, 204, 145, 218, 120, 15, 66, 234, 151, 192, 57, 94, 54,
-			178, 193, 27, 132, 214, 63, 8, 233, 11, 245, 93, 28, 90, 191,
-			4, 112, 137, 63, 219, 194, 245, 167, 9, 81, 82, 54, 155, 45,
-			140, 245, 208, 169, 25, 232, 164, 186, 139, 172, 180, 38, 165, 186,
-			4, 81, 40, 57, 90, 233, 37, 142, 54, 182, 17, 250, 75, 28,
-			109, 108, 35, 244, 151, 96, 251, 197, 214, 74, 83, 0, 254, 62,
-			56, 27, 172, 180, 38, 43, 189, 47, 14, 2, 23, 52, 116, 103,
-			165, 109, 140, 252, 62, 232, 31, 160, 20, 128, 157, 198, 91, 134,
-			212, 19, 208, 58, 5, 96, 203, 7, 171, 13, 17, 224, 203, 144,
-			30, 214, 31, 8, 14, 1, 223, 15, 48, 228, 255, 53, 97, 169,
-			235, 159, 92, 36, 61, 221, 186, 231, 19, 13, 246, 186, 213, 213,
-			246, 25, 180, 215, 142, 9, 167, 102, 177, 109, 40, 251, 254, 68,
-			40, 123, 27, 146, 206, 98, 219, 80, 246, 253, 224, 44, 182, 13,
-			101, 223, 15, 193, 22, 107, 16, 59, 169, 228, 237, 231, 6, 177,
-			133, 65, 236, 36, 248, 241, 56, 27, 196, 78, 18, 232, 199, 217,
-			32, 118, 146, 64, 63, 142, 6, 241, 91, 26, 84, 151, 241, 158,
-			132, 212, 175, 65, 248, 151, 157, 164, 219, 208, 84, 162, 187, 132,
-			81, 79, 66, 250, 34, 61, 166, 149, 234, 66, 129, 126, 10, 224,
-			124, 255, 252, 224, 182, 13, 30, 2, 185, 241, 118, 209, 226, 63,
-			197, 86, 169, 139, 22, 255, 41, 72, 251, 76, 74, 36, 7, 55,
-			211, 3, 130, 110, 227, 61, 11, 169, 223, 130, 240, 135, 91, 223,
-			179, 240, 51, 149, 196, 0, 187, 133, 81, 207, 226, 55, 175, 215,
-			74, 117, 227, 0, 87, 0, 174, 242, 175, 88, 119, 193, 194, 15,
-			74, 214, 93, 172, 240, 71, 147, 55, 42, 221, 52, 244, 21, 214,
-			29, 221, 52, 244, 21, 112, 55, 42, 221, 52, 244, 21, 112, 249,
-			69, 187, 233, 82, 98, 5, 92, 126, 209, 110, 190, 148, 88, 129,
-			158, 43, 245, 229, 26, 84, 198, 120, 207, 67, 234, 21, 104, 253,
-			60, 162, 238, 41, 121, 98, 118, 25, 97, 212, 243, 144, 30, 208,
-			11, 90, 169, 12, 206, 238, 0, 192, 55, 253, 233, 38, 9, 89,
-			220, 61, 75, 125, 69, 147, 225, 38, 79, 63, 46, 34, 200, 95,
-			159, 85, 112, 184, 201, 67, 10, 174, 164, 154, 33, 94, 28, 96,
-			94, 100, 136, 23, 7, 160, 35, 203, 164, 68, 210, 20, 152, 76,
-			35, 217, 243, 13, 226, 69, 134, 121, 113, 0, 54, 237, 208, 239,
-			10, 154, 133, 48, 234, 16, 2, 181, 213, 86, 64, 45, 126, 251,
-			115, 166, 0, 90, 220, 195, 153, 5, 102, 25, 178, 65, 135, 120,
-			11, 100, 104, 203, 30, 98, 96, 150, 161, 45, 123, 8, 129, 217,
-			63, 45, 107, 192, 168, 35, 8, 204, 254, 118, 106, 23, 50, 145,
-			232, 124, 210, 55, 49, 137, 142, 79, 247, 88, 40, 67, 87, 48,
-			71, 98, 241, 66, 249, 56, 194, 64, 44, 67, 48, 255, 8, 2,
-			177, 43, 52, 168, 172, 241, 94, 133, 212, 27, 32, 252, 145, 141,
-			174, 96, 154, 109, 165, 172, 48, 234, 85, 72, 15, 82, 41, 238,
-			44, 110, 165, 163, 104, 154, 191, 68, 203, 221, 240, 76, 173, 254,
-			206, 197, 238, 47, 55, 220, 44, 25, 184, 163, 108, 224, 178, 132,
-			158, 142, 66, 103, 129, 73, 129, 100, 207, 32, 147, 18, 201, 96,
-			139, 189, 111, 201, 226, 220, 86, 225, 92, 185, 111, 201, 146, 244,
-			175, 242, 210, 102, 73, 250, 87, 25, 129, 101, 73, 250, 87, 17,
-			129, 225, 210, 230, 140, 247, 38, 164, 30, 148, 188, 180, 45, 16,
-			88, 179, 165, 205, 9, 163, 222, 196, 165, 165, 107, 205, 28, 174,
-			237, 26, 174, 237, 7, 9, 216, 85, 76, 188, 112, 60, 99, 87,
-			45, 4, 186, 241, 119, 14, 25, 52, 156, 175, 125, 98, 231, 38,
-			57, 18, 210, 53, 22, 210, 28, 105, 240, 53, 70, 97, 57, 18,
-			210, 53, 70, 97, 57, 18, 210, 53, 20, 210, 29, 200, 62, 153,
-			50, 222, 59, 0, 255, 0, 73, 79, 222, 170, 33, 165, 14, 143,
-			103, 190, 173, 26, 148, 23, 106, 211, 101, 28, 82, 56, 133, 187,
-			121, 113, 62, 100, 155, 159, 147, 248, 237, 119, 64, 247, 234, 91,
-			181, 135, 36, 174, 198, 187, 160, 134, 252, 171, 105, 167, 81, 240,
-			120, 80, 9, 231, 43, 33, 138, 186, 245, 100, 62, 142, 173, 156,
-			219, 41, 171, 219, 237, 39, 219, 232, 155, 233, 184, 65, 96, 67,
-			199, 96, 220, 32, 177, 33, 216, 162, 191, 231, 70, 33, 140, 122,
-			15, 148, 239, 95, 67, 163, 176, 91, 122, 108, 114, 87, 105, 102,
-			42, 168, 132, 51, 182, 159, 93, 165, 249, 96, 34, 172, 221, 25,
-			134, 115, 201, 92, 237, 184, 168, 201, 74, 100, 81, 39, 116, 10,
-			152, 28, 6, 157, 3, 66, 71, 111, 220, 32, 177, 161, 239, 60,
-			125, 216, 74, 166, 48, 234, 67, 196, 79, 143, 128, 147, 131, 82,
-			88, 117, 251, 223, 50, 166, 86, 14, 108, 112, 188, 77, 85, 234,
-			118, 61, 178, 154, 37, 113, 123, 82, 40, 227, 12, 207, 44, 222,
-			13, 160, 86, 7, 99, 117, 218, 195, 38, 225, 138, 230, 230, 114,
-			200, 70, 255, 61, 26, 44, 84, 67, 29, 4, 193, 93, 205, 214,
-			225, 202, 160, 86, 89, 8, 127, 50, 222, 244, 163, 40, 232, 99,
-			86, 208, 155, 126, 53, 184, 43, 25, 205, 30, 125, 74, 7, 215,
-			44, 242, 89, 253, 168, 155, 59, 65, 120, 203, 144, 113, 61, 100,
-			248, 207, 196, 157, 171, 209, 188, 57, 185, 69, 56, 252, 33, 64,
-			55, 147, 196, 221, 140, 207, 164, 68, 114, 112, 179, 189, 131, 205,
-			161, 109, 253, 47, 192, 197, 254, 81, 97, 147, 103, 71, 192, 207,
-			57, 94, 174, 18, 79, 188, 210, 137, 44, 201, 73, 172, 148, 60,
-			39, 212, 235, 14, 10, 221, 219, 237, 250, 102, 251, 58, 251, 187,
-			55, 223, 70, 129, 93, 139, 245, 233, 229, 26, 185, 133, 8, 164,
-			204, 23, 17, 216, 251, 108, 108, 148, 216, 226, 89, 142, 38, 243,
-			49, 68, 76, 1, 143, 230, 217, 203, 164, 64, 114, 211, 8, 147,
-			18, 201, 241, 237, 250, 87, 86, 32, 165, 81, 119, 75, 24, 243,
-			127, 126, 118, 57, 92, 177, 54, 63, 179, 46, 87, 142, 158, 3,
-			222, 45, 129, 133, 74, 10, 36, 157, 203, 149, 163, 131, 181, 187,
-			229, 200, 168, 133, 182, 57, 80, 70, 221, 43, 207, 13, 104, 155,
-			163, 3, 180, 123, 165, 131, 182, 57, 58, 64, 187, 87, 166, 217,
-			198, 40, 137, 100, 79, 175, 254, 183, 101, 77, 155, 81, 15, 72,
-			232, 243, 215, 78, 241, 204, 241, 83, 193, 182, 141, 61, 159, 46,
-			184, 205, 65, 155, 157, 119, 154, 73, 129, 164, 3, 183, 57, 186,
-			233, 123, 64, 186, 170, 69, 121, 227, 45, 201, 212, 67, 146, 159,
-			154, 183, 114, 217, 155, 65, 160, 188, 48, 106, 73, 166, 55, 235,
-			135, 144, 219, 121, 52, 186, 123, 37, 12, 249, 247, 139, 232, 166,
-			175, 206, 68, 148, 42, 213, 218, 134, 118, 192, 170, 190, 214, 58,
-			93, 219, 162, 130, 39, 123, 18, 25, 121, 223, 209, 49, 100, 158,
-			48, 202, 94, 9, 17, 233, 33, 233, 128, 116, 158, 76, 250, 94,
-			233, 128, 116, 158, 12, 250, 94, 25, 108, 177, 199, 144, 121, 84,
-			107, 203, 242, 108, 56, 134, 204, 19, 76, 88, 102, 17, 200, 147,
-			153, 90, 150, 14, 4, 231, 201, 76, 45, 203, 254, 1, 122, 156,
-			105, 140, 247, 176, 76, 29, 148, 173, 31, 103, 54, 139, 205, 78,
-			200, 129, 17, 70, 61, 44, 211, 23, 232, 63, 35, 155, 12, 202,
-			193, 163, 18, 10, 254, 11, 54, 93, 106, 169, 18, 78, 141, 219,
-			204, 156, 115, 148, 236, 191, 86, 78, 72, 53, 165, 104, 177, 98,
-			77, 55, 232, 197, 90, 49, 152, 8, 81, 149, 34, 6, 69, 87,
-			200, 86, 20, 64, 220, 129, 12, 27, 15, 199, 73, 161, 23, 19,
-			161, 25, 84, 230, 53, 46, 223, 182, 203, 142, 112, 52, 40, 213,
-			226, 30, 73, 167, 56, 128, 172, 131, 154, 43, 229, 120, 71, 56,
-			179, 72, 177, 207, 115, 77, 114, 175, 218, 177, 57, 150, 26, 2,
-			128, 143, 50, 75, 13, 201, 202, 163, 178, 35, 195, 164, 68, 50,
-			111, 244, 183, 137, 7, 194, 168, 199, 36, 12, 248, 151, 51, 246,
-			142, 21, 69, 45, 58, 186, 42, 77, 85, 19, 39, 67, 60, 110,
-			100, 121, 212, 41, 174, 227, 99, 113, 167, 130, 190, 219, 241, 5,
-			38, 37, 146, 126, 63, 133, 178, 24, 68, 27, 79, 72, 184, 200,
-			191, 106, 163, 78, 93, 158, 154, 143, 237, 25, 109, 250, 19, 18,
-			12, 147, 2, 201, 194, 86, 38, 37, 146, 219, 134, 245, 253, 104,
-			211, 77, 58, 101, 188, 167, 37, 252, 70, 74, 255, 167, 16, 245,
-			78, 169, 252, 167, 233, 204, 200, 166, 89, 9, 56, 63, 11, 197,
-			110, 39, 254, 28, 37, 90, 169, 114, 18, 215, 82, 181, 190, 56,
-			105, 45, 97, 173, 106, 229, 224, 246, 57, 130, 171, 184, 247, 108,
-			70, 127, 118, 90, 156, 242, 37, 77, 91, 107, 124, 213, 94, 172,
-			132, 246, 231, 86, 133, 52, 12, 10, 149, 198, 118, 236, 138, 7,
-			67, 153, 96, 167, 80, 85, 207, 146, 95, 85, 156, 156, 44, 87,
-			168, 32, 106, 29, 152, 26, 119, 236, 251, 97, 121, 222, 133, 174,
-			90, 38, 165, 81, 68, 158, 150, 233, 110, 221, 69, 203, 35, 141,
-			122, 70, 170, 11, 29, 7, 209, 200, 63, 35, 21, 47, 37, 26,
-			249, 103, 100, 95, 192, 36, 253, 248, 130, 173, 238, 95, 149, 81,
-			207, 74, 53, 232, 254, 168, 60, 34, 187, 153, 20, 72, 102, 250,
-			152, 148, 72, 146, 135, 139, 255, 218, 102, 212, 115, 8, 15, 70,
-			18, 232, 159, 212, 80, 132, 15, 152, 59, 243, 100, 156, 166, 195,
-			72, 4, 218, 236, 255, 182, 51, 41, 144, 116, 198, 214, 144, 29,
-			121, 14, 141, 237, 27, 118, 219, 123, 70, 253, 78, 130, 239, 255,
-			65, 4, 183, 172, 207, 146, 139, 44, 155, 14, 107, 235, 53, 153,
-			139, 56, 44, 87, 74, 104, 44, 103, 34, 27, 164, 19, 14, 109,
-			49, 86, 154, 108, 234, 18, 170, 138, 197, 35, 254, 77, 92, 49,
-			204, 30, 21, 56, 148, 101, 179, 15, 79, 218, 172, 200, 84, 79,
-			57, 234, 21, 253, 92, 151, 151, 33, 74, 76, 156, 204, 238, 107,
-			192, 107, 163, 249, 241, 78, 244, 4, 146, 206, 168, 26, 240, 36,
-			146, 155, 206, 163, 231, 23, 5, 227, 189, 40, 83, 111, 200, 13,
-			30, 237, 126, 140, 74, 45, 8, 163, 94, 148, 233, 173, 58, 163,
-			149, 42, 160, 119, 252, 146, 132, 63, 74, 73, 189, 21, 200, 223,
-			125, 73, 234, 172, 254, 190, 246, 144, 68, 149, 251, 123, 169, 182,
-			249, 55, 6, 215, 54, 200, 116, 147, 40, 61, 212, 181, 81, 129,
-			224, 152, 79, 163, 148, 29, 249, 142, 98, 105, 166, 56, 49, 19,
-			58, 151, 147, 62, 238, 209, 215, 77, 220, 32, 176, 161, 48, 20,
-			55, 72, 108, 184, 240, 139, 250, 70, 55, 30, 97, 212, 203, 82,
-			93, 230, 127, 221, 166, 147, 167, 110, 75, 205, 99, 6, 91, 140,
-			38, 209, 63, 186, 92, 47, 163, 244, 71, 13, 244, 249, 205, 95,
-			142, 27, 36, 54, 92, 250, 53, 189, 211, 245, 15, 70, 189, 34,
-			149, 239, 223, 194, 101, 146, 233, 85, 103, 173, 26, 206, 252, 104,
-			60, 184, 169, 88, 189, 61, 46, 37, 178, 211, 22, 40, 219, 233,
-			196, 20, 141, 233, 206, 235, 195, 166, 121, 75, 118, 38, 6, 133,
-			226, 254, 138, 84, 153, 184, 65, 96, 67, 182, 55, 110, 144, 216,
-			208, 119, 158, 53, 139, 5, 92, 163, 215, 16, 61, 188, 96, 113,
-			122, 113, 178, 182, 80, 228, 82, 42, 22, 252, 184, 154, 201, 132,
-			129, 147, 22, 146, 58, 15, 167, 172, 186, 180, 168, 33, 58, 198,
-			113, 89, 179, 172, 149, 140, 53, 101, 113, 54, 108, 84, 111, 113,
-			198, 227, 184, 36, 147, 182, 111, 202, 106, 97, 101, 142, 138, 199,
-			38, 240, 213, 109, 148, 28, 109, 212, 190, 85, 67, 96, 54, 177,
-			24, 184, 172, 103, 223, 41, 86, 119, 185, 125, 81, 32, 68, 245,
-			26, 35, 170, 2, 9, 203, 107, 178, 51, 199, 164, 64, 50, 223,
-			199, 164, 68, 178, 127, 64, 47, 91, 158, 8, 163, 86, 145, 39,
-			247, 9, 171, 245, 237, 26, 68, 197, 162, 194, 154, 83, 15, 21,
-			91, 97, 176, 238, 0, 48, 225, 204, 160, 243, 138, 202, 101, 157,
-			22, 176, 149, 6, 171, 161, 157, 131, 101, 11, 171, 141, 226, 116,
-			177, 52, 55, 26, 76, 44, 68, 7, 90, 46, 227, 122, 18, 76,
-			23, 236, 113, 34, 239, 251, 130, 61, 78, 100, 36, 85, 176, 199,
-			137, 178, 127, 128, 243, 98, 255, 47, 0, 0, 255, 255, 245, 164,
-			252, 185, 18, 43, 2, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 107, 116, 92, 201,
+			121, 24, 216, 247, 209, 141, 238, 2, 8, 52, 138, 47, 240, 242,
+			85, 236, 33, 135, 32, 8, 116, 147, 224, 99, 72, 144, 156, 153,
+			6, 208, 36, 155, 131, 151, 26, 192, 80, 243, 114, 235, 162, 187,
+			208, 184, 195, 238, 123, 91, 247, 222, 6, 136, 25, 201, 150, 71,
+			137, 37, 89, 242, 70, 150, 98, 249, 216, 242, 177, 101, 121, 143,
+			157, 117, 156, 156, 196, 143, 56, 222, 115, 226, 163, 156, 181, 189,
+			250, 35, 111, 28, 239, 217, 85, 18, 199, 118, 108, 229, 72, 113,
+			86, 177, 188, 115, 142, 227, 56, 94, 159, 61, 95, 61, 238, 189,
+			253, 192, 99, 56, 150, 188, 246, 14, 127, 128, 93, 117, 235, 241,
+			213, 87, 95, 125, 245, 213, 87, 223, 247, 21, 250, 175, 10, 58,
+			93, 115, 156, 90, 157, 230, 154, 174, 227, 59, 107, 173, 245, 156,
+			111, 53, 168, 231, 155, 141, 102, 150, 101, 225, 33, 94, 32, 43,
+			11, 100, 110, 161, 212, 138, 44, 131, 71, 80, 159, 71, 43, 142,
+			93, 245, 70, 20, 162, 140, 106, 37, 153, 196, 135, 80, 220, 54,
+			109, 199, 27, 81, 137, 50, 26, 47, 241, 196, 244, 119, 163, 131,
+			21, 167, 145, 237, 104, 115, 122, 48, 104, 113, 9, 178, 150, 148,
+			151, 47, 214, 44, 127, 163, 181, 150, 173, 56, 141, 92, 205, 169,
+			155, 118, 45, 4, 177, 233, 111, 55, 169, 23, 66, 250, 103, 138,
+			242, 69, 85, 187, 183, 52, 253, 179, 234, 169, 123, 188, 229, 37,
+			209, 114, 246, 33, 173, 215, 95, 176, 157, 45, 123, 5, 234, 172,
+			37, 88, 35, 87, 208, 15, 30, 69, 215, 106, 78, 182, 178, 225,
+			58, 13, 171, 213, 200, 58, 110, 45, 87, 111, 85, 172, 156, 75,
+			189, 86, 221, 175, 174, 241, 222, 114, 155, 151, 115, 166, 235, 91,
+			235, 102, 197, 23, 248, 72, 67, 177, 172, 44, 150, 221, 188, 108,
+			236, 133, 194, 204, 255, 165, 160, 100, 94, 180, 130, 49, 210, 109,
+			179, 65, 25, 186, 82, 37, 246, 27, 159, 70, 253, 178, 151, 178,
+			85, 101, 24, 75, 149, 144, 204, 42, 86, 241, 113, 148, 90, 167,
+			126, 101, 163, 220, 114, 235, 35, 26, 251, 156, 100, 25, 171, 110,
+			29, 207, 161, 67, 193, 199, 50, 125, 220, 180, 92, 211, 183, 28,
+			123, 68, 39, 202, 104, 255, 164, 209, 137, 236, 108, 128, 235, 18,
+			150, 109, 20, 130, 90, 248, 12, 26, 168, 56, 182, 79, 109, 191,
+			12, 104, 30, 137, 179, 222, 250, 69, 30, 96, 17, 159, 68, 200,
+			179, 222, 160, 229, 181, 109, 159, 122, 35, 9, 54, 239, 41, 200,
+			153, 134, 140, 233, 203, 47, 231, 246, 135, 218, 91, 28, 139, 205,
+			181, 7, 127, 126, 16, 37, 176, 62, 24, 155, 86, 208, 175, 233,
+			72, 25, 192, 218, 96, 12, 79, 254, 138, 78, 102, 156, 230, 182,
+			107, 213, 54, 124, 50, 121, 105, 242, 18, 89, 217, 160, 100, 110,
+			117, 166, 72, 242, 45, 127, 195, 113, 189, 44, 66, 100, 206, 170,
+			80, 219, 163, 85, 210, 178, 171, 212, 37, 254, 6, 37, 249, 166,
+			89, 129, 146, 252, 203, 56, 121, 145, 186, 158, 229, 216, 100, 50,
+			123, 137, 140, 66, 129, 140, 248, 148, 185, 112, 11, 145, 109, 167,
+			69, 26, 230, 54, 177, 29, 159, 180, 60, 74, 252, 13, 203, 35,
+			235, 86, 157, 18, 250, 184, 66, 155, 62, 177, 108, 82, 113, 26,
+			205, 186, 101, 218, 21, 74, 182, 44, 127, 131, 248, 97, 243, 89,
+			68, 94, 18, 45, 56, 107, 190, 105, 217, 196, 36, 21, 167, 185,
+			77, 156, 245, 104, 49, 98, 250, 8, 17, 246, 111, 195, 247, 155,
+			83, 185, 220, 214, 214, 86, 214, 100, 144, 114, 242, 227, 229, 188,
+			220, 92, 113, 166, 176, 176, 92, 152, 152, 204, 94, 66, 136, 172,
+			218, 117, 234, 121, 196, 165, 31, 108, 89, 46, 173, 146, 181, 109,
+			98, 54, 155, 117, 171, 98, 174, 213, 41, 169, 155, 91, 196, 113,
+			137, 89, 115, 41, 173, 18, 223, 1, 88, 183, 92, 203, 183, 236,
+			218, 56, 241, 156, 117, 127, 203, 116, 41, 34, 85, 203, 243, 93,
+			107, 173, 229, 183, 161, 73, 66, 102, 121, 109, 5, 28, 155, 152,
+			54, 201, 228, 151, 73, 113, 57, 67, 166, 243, 203, 197, 229, 113,
+			68, 30, 22, 87, 238, 47, 174, 174, 144, 135, 249, 82, 41, 191,
+			176, 82, 44, 44, 147, 197, 18, 153, 89, 92, 152, 45, 174, 20,
+			23, 23, 150, 201, 226, 93, 146, 95, 120, 137, 188, 80, 92, 152,
+			29, 39, 212, 242, 55, 168, 75, 232, 227, 166, 11, 208, 59, 46,
+			177, 0, 129, 180, 154, 69, 100, 153, 210, 182, 238, 215, 29, 62,
+			107, 94, 147, 86, 172, 117, 171, 66, 96, 181, 183, 204, 26, 37,
+			53, 103, 147, 186, 182, 101, 215, 72, 147, 186, 13, 203, 131, 73,
+			244, 136, 105, 87, 17, 169, 91, 13, 203, 103, 20, 235, 117, 143,
+			40, 139, 80, 18, 41, 42, 214, 210, 177, 99, 40, 133, 84, 45,
+			134, 53, 28, 187, 0, 153, 73, 172, 29, 138, 221, 133, 204, 100,
+			63, 255, 249, 143, 85, 164, 234, 49, 172, 159, 136, 77, 43, 198,
+			79, 170, 36, 207, 231, 190, 233, 58, 213, 86, 133, 86, 73, 181,
+			229, 2, 4, 38, 89, 107, 89, 245, 106, 206, 167, 158, 63, 78,
+			252, 237, 166, 85, 49, 235, 245, 109, 98, 18, 200, 33, 114, 177,
+			102, 17, 35, 210, 166, 233, 82, 219, 39, 46, 245, 156, 150, 91,
+			97, 40, 22, 40, 49, 201, 10, 245, 252, 18, 163, 124, 54, 117,
+			54, 41, 218, 155, 78, 133, 141, 5, 232, 57, 111, 19, 43, 200,
+			152, 168, 211, 77, 90, 15, 90, 39, 13, 182, 22, 214, 40, 113,
+			105, 221, 132, 217, 244, 29, 214, 191, 55, 14, 109, 89, 178, 128,
+			237, 248, 227, 100, 221, 113, 17, 161, 143, 205, 70, 179, 78, 217,
+			39, 115, 155, 172, 81, 32, 113, 86, 205, 243, 29, 151, 242, 65,
+			17, 207, 167, 77, 82, 119, 106, 30, 217, 218, 160, 54, 241, 124,
+			151, 154, 13, 24, 181, 215, 106, 54, 29, 215, 39, 150, 135, 136,
+			89, 173, 194, 252, 33, 132, 52, 61, 166, 96, 237, 68, 50, 141,
+			62, 162, 35, 93, 143, 169, 49, 172, 157, 87, 177, 241, 127, 107,
+			100, 198, 180, 163, 157, 184, 116, 29, 166, 198, 225, 139, 42, 130,
+			164, 187, 142, 219, 48, 253, 41, 68, 38, 200, 93, 199, 221, 121,
+			196, 222, 20, 44, 154, 76, 248, 221, 203, 189, 89, 92, 120, 113,
+			113, 38, 15, 84, 87, 46, 206, 126, 56, 96, 208, 94, 238, 205,
+			124, 105, 165, 120, 55, 63, 179, 2, 249, 153, 172, 108, 28, 16,
+			52, 193, 121, 205, 59, 111, 29, 42, 123, 185, 55, 87, 75, 115,
+			229, 194, 242, 76, 126, 169, 48, 91, 94, 41, 44, 179, 30, 4,
+			71, 243, 114, 111, 150, 10, 203, 171, 115, 43, 123, 65, 179, 181,
+			65, 93, 74, 122, 180, 4, 228, 1, 43, 0, 250, 42, 91, 85,
+			66, 189, 138, 217, 164, 85, 198, 106, 16, 99, 23, 222, 84, 78,
+			108, 131, 140, 87, 52, 31, 213, 114, 54, 245, 115, 45, 183, 158,
+			123, 106, 201, 244, 55, 10, 172, 6, 25, 245, 40, 37, 102, 221,
+			115, 130, 74, 166, 213, 204, 86, 233, 102, 238, 242, 228, 228, 133,
+			113, 4, 107, 135, 68, 192, 130, 142, 171, 78, 165, 213, 160, 54,
+			16, 211, 26, 173, 59, 91, 89, 68, 10, 156, 104, 188, 41, 146,
+			241, 42, 46, 165, 182, 183, 225, 248, 217, 166, 93, 203, 140, 147,
+			140, 239, 154, 21, 234, 229, 204, 172, 255, 216, 207, 100, 17, 26,
+			64, 113, 160, 128, 56, 144, 64, 82, 166, 20, 172, 157, 79, 29,
+			144, 41, 13, 107, 231, 211, 195, 232, 223, 41, 140, 88, 20, 172,
+			77, 168, 199, 140, 175, 40, 36, 79, 234, 78, 197, 172, 19, 171,
+			74, 109, 223, 90, 183, 168, 43, 153, 166, 196, 226, 56, 105, 217,
+			214, 7, 91, 156, 235, 90, 54, 241, 187, 215, 86, 22, 145, 249,
+			252, 75, 100, 195, 220, 164, 196, 171, 155, 222, 6, 245, 198, 201,
+			90, 203, 39, 243, 171, 203, 43, 100, 97, 113, 133, 120, 190, 233,
+			250, 172, 5, 98, 242, 34, 192, 133, 238, 47, 174, 206, 205, 6,
+			76, 127, 205, 172, 60, 18, 181, 179, 136, 148, 104, 141, 62, 158,
+			34, 223, 245, 202, 43, 83, 91, 142, 91, 157, 122, 237, 181, 209,
+			87, 94, 153, 106, 186, 150, 237, 79, 189, 246, 218, 155, 151, 198,
+			39, 175, 93, 253, 112, 248, 241, 194, 115, 103, 37, 30, 148, 56,
+			140, 78, 226, 65, 129, 177, 166, 14, 201, 148, 134, 181, 137, 163,
+			35, 232, 47, 57, 30, 84, 172, 93, 85, 143, 26, 223, 2, 60,
+			120, 86, 205, 166, 85, 226, 109, 56, 174, 63, 81, 183, 54, 105,
+			21, 232, 4, 22, 14, 219, 162, 217, 184, 197, 22, 236, 117, 226,
+			72, 176, 84, 54, 237, 189, 4, 1, 96, 42, 69, 219, 167, 174,
+			13, 12, 107, 156, 47, 69, 216, 240, 24, 202, 214, 217, 66, 36,
+			25, 203, 115, 128, 163, 76, 229, 114, 111, 110, 56, 158, 255, 225,
+			220, 155, 182, 247, 225, 220, 155, 85, 171, 70, 61, 255, 195, 25,
+			98, 250, 0, 4, 98, 108, 3, 120, 51, 91, 72, 28, 209, 22,
+			240, 7, 82, 113, 236, 77, 234, 10, 158, 100, 218, 228, 254, 202,
+			202, 210, 50, 27, 197, 26, 93, 7, 86, 227, 81, 119, 211, 178,
+			107, 1, 201, 168, 113, 64, 128, 68, 149, 170, 96, 237, 106, 10,
+			203, 148, 134, 181, 171, 135, 143, 160, 57, 134, 41, 13, 107, 207,
+			168, 215, 140, 231, 200, 67, 224, 78, 193, 24, 9, 27, 35, 245,
+			178, 164, 184, 46, 126, 87, 199, 137, 75, 39, 96, 179, 4, 166,
+			204, 70, 42, 37, 175, 160, 95, 45, 1, 205, 29, 151, 41, 5,
+			107, 207, 156, 184, 36, 83, 208, 213, 149, 171, 232, 123, 249, 20,
+			233, 88, 187, 173, 26, 134, 79, 230, 105, 213, 50, 129, 235, 211,
+			30, 216, 159, 3, 198, 105, 186, 52, 178, 43, 100, 124, 250, 216,
+			207, 53, 235, 166, 101, 103, 216, 162, 11, 215, 81, 87, 81, 171,
+			97, 214, 104, 14, 86, 87, 22, 145, 197, 38, 76, 153, 89, 15,
+			160, 213, 227, 0, 131, 196, 146, 174, 96, 237, 118, 234, 176, 76,
+			105, 88, 187, 61, 114, 12, 253, 32, 135, 54, 142, 181, 188, 122,
+			212, 248, 168, 66, 150, 173, 55, 2, 64, 97, 55, 203, 162, 54,
+			198, 108, 217, 100, 181, 8, 196, 85, 165, 21, 171, 74, 201, 26,
+			245, 183, 40, 181, 65, 10, 104, 214, 205, 109, 96, 252, 209, 33,
+			18, 203, 174, 91, 54, 133, 29, 198, 177, 235, 219, 8, 200, 116,
+			11, 10, 153, 164, 110, 217, 143, 136, 181, 46, 72, 192, 119, 28,
+			82, 55, 221, 26, 13, 160, 143, 51, 152, 250, 100, 74, 193, 90,
+			62, 41, 231, 56, 174, 97, 45, 127, 248, 72, 32, 147, 255, 250,
+			57, 116, 101, 159, 50, 121, 197, 105, 52, 28, 251, 201, 37, 242,
+			45, 212, 247, 162, 233, 90, 166, 237, 227, 171, 72, 171, 210, 245,
+			17, 133, 104, 163, 253, 147, 153, 108, 103, 91, 89, 81, 46, 59,
+			75, 215, 11, 182, 239, 110, 151, 160, 184, 113, 29, 37, 101, 6,
+			78, 35, 237, 17, 221, 22, 2, 61, 252, 132, 179, 207, 166, 89,
+			111, 81, 33, 201, 243, 196, 148, 122, 67, 201, 92, 69, 104, 217,
+			7, 121, 98, 201, 180, 220, 253, 214, 204, 212, 209, 224, 140, 211,
+			104, 88, 254, 146, 227, 89, 64, 31, 112, 138, 128, 85, 42, 170,
+			178, 223, 112, 22, 107, 186, 206, 235, 180, 226, 139, 218, 50, 9,
+			253, 184, 116, 93, 28, 28, 52, 151, 174, 99, 3, 37, 155, 162,
+			45, 118, 78, 208, 74, 65, 58, 243, 3, 10, 58, 216, 222, 93,
+			201, 180, 107, 20, 223, 70, 73, 106, 186, 117, 139, 138, 126, 251,
+			39, 73, 55, 186, 58, 42, 6, 53, 240, 13, 148, 0, 254, 226,
+			113, 224, 246, 83, 87, 148, 207, 108, 241, 3, 39, 7, 226, 122,
+			23, 16, 187, 29, 112, 194, 238, 39, 59, 186, 223, 173, 150, 40,
+			249, 36, 7, 153, 255, 118, 154, 31, 100, 106, 187, 29, 100, 46,
+			223, 124, 239, 32, 243, 222, 65, 230, 175, 252, 32, 243, 31, 17,
+			63, 200, 92, 138, 93, 83, 140, 175, 34, 146, 39, 143, 232, 246,
+			4, 99, 39, 164, 97, 54, 73, 149, 122, 21, 215, 90, 3, 40,
+			28, 155, 146, 77, 206, 216, 96, 155, 16, 103, 152, 138, 201, 122,
+			100, 196, 233, 153, 13, 26, 230, 146, 10, 223, 61, 232, 99, 90,
+			97, 184, 183, 96, 179, 88, 95, 167, 236, 148, 179, 101, 110, 123,
+			236, 184, 17, 156, 54, 28, 27, 69, 190, 47, 46, 143, 147, 123,
+			75, 171, 222, 56, 39, 185, 240, 3, 16, 35, 144, 167, 195, 54,
+			61, 118, 76, 116, 91, 54, 48, 107, 178, 94, 55, 107, 94, 22,
+			6, 33, 225, 172, 210, 117, 203, 102, 156, 129, 84, 204, 166, 223,
+			114, 169, 23, 29, 7, 43, 27, 2, 44, 165, 61, 57, 21, 61,
+			90, 1, 153, 197, 172, 215, 65, 96, 129, 106, 65, 59, 136, 220,
+			107, 89, 85, 10, 91, 158, 199, 70, 213, 163, 110, 149, 130, 196,
+			198, 142, 47, 43, 32, 100, 184, 173, 58, 37, 53, 168, 6, 71,
+			40, 211, 7, 212, 123, 92, 2, 93, 131, 179, 36, 245, 96, 188,
+			66, 128, 13, 219, 201, 194, 66, 2, 233, 207, 174, 49, 117, 67,
+			147, 86, 0, 187, 174, 56, 29, 194, 204, 212, 172, 77, 106, 183,
+			65, 8, 100, 78, 109, 167, 85, 219, 128, 45, 188, 226, 216, 158,
+			5, 252, 194, 242, 161, 177, 166, 233, 65, 107, 100, 180, 233, 128,
+			14, 199, 98, 210, 200, 122, 221, 124, 100, 213, 183, 47, 48, 65,
+			137, 111, 213, 86, 3, 206, 117, 208, 154, 239, 132, 51, 98, 153,
+			62, 37, 102, 197, 117, 60, 15, 26, 51, 73, 133, 186, 140, 19,
+			84, 173, 6, 181, 129, 106, 201, 40, 205, 214, 178, 100, 107, 131,
+			178, 117, 177, 69, 215, 24, 108, 92, 168, 9, 200, 131, 97, 223,
+			113, 217, 124, 59, 45, 6, 152, 103, 249, 20, 142, 241, 164, 233,
+			58, 21, 234, 121, 132, 11, 156, 150, 99, 95, 0, 145, 20, 198,
+			200, 142, 71, 18, 103, 38, 160, 144, 248, 27, 166, 31, 78, 55,
+			19, 64, 73, 23, 48, 38, 1, 45, 26, 89, 119, 157, 6, 3,
+			166, 236, 181, 44, 159, 122, 217, 230, 118, 253, 2, 195, 240, 34,
+			192, 186, 101, 1, 7, 53, 25, 134, 128, 187, 68, 215, 192, 150,
+			85, 175, 147, 13, 171, 10, 221, 174, 155, 86, 189, 229, 50, 241,
+			201, 180, 29, 168, 9, 100, 150, 69, 251, 153, 235, 133, 197, 125,
+			205, 119, 101, 3, 246, 50, 249, 57, 92, 165, 30, 245, 9, 245,
+			188, 96, 222, 160, 25, 63, 60, 26, 74, 186, 216, 176, 64, 8,
+			223, 102, 141, 221, 13, 87, 221, 56, 8, 98, 247, 22, 136, 233,
+			10, 113, 212, 108, 56, 118, 45, 24, 99, 216, 77, 211, 180, 92,
+			79, 32, 221, 172, 86, 129, 92, 76, 104, 203, 166, 91, 162, 190,
+			128, 144, 119, 205, 79, 103, 126, 176, 15, 180, 209, 34, 200, 182,
+			2, 76, 203, 247, 66, 208, 224, 200, 65, 102, 196, 214, 57, 30,
+			5, 130, 193, 198, 86, 206, 90, 171, 242, 136, 250, 83, 172, 81,
+			166, 213, 227, 25, 227, 132, 145, 88, 166, 98, 101, 120, 41, 171,
+			94, 165, 110, 91, 49, 150, 35, 203, 213, 45, 187, 245, 120, 194,
+			165, 117, 86, 60, 36, 128, 169, 40, 97, 32, 177, 93, 193, 65,
+			186, 226, 181, 111, 234, 50, 145, 243, 220, 10, 211, 239, 88, 118,
+			45, 199, 186, 93, 115, 252, 92, 7, 69, 133, 250, 143, 75, 201,
+			33, 244, 61, 82, 253, 113, 85, 61, 101, 184, 100, 165, 109, 182,
+			37, 194, 196, 224, 179, 136, 188, 64, 183, 217, 105, 128, 205, 131,
+			71, 26, 45, 207, 7, 90, 223, 52, 235, 86, 149, 132, 130, 161,
+			64, 83, 80, 112, 156, 192, 185, 222, 223, 160, 150, 139, 224, 156,
+			229, 249, 174, 105, 217, 190, 23, 136, 217, 177, 4, 64, 32, 143,
+			5, 0, 220, 213, 35, 199, 100, 10, 142, 82, 39, 78, 162, 23,
+			145, 170, 43, 88, 191, 17, 123, 78, 49, 30, 192, 145, 147, 117,
+			23, 161, 62, 32, 139, 44, 89, 9, 14, 38, 236, 168, 0, 252,
+			207, 55, 107, 53, 190, 15, 83, 26, 85, 86, 249, 102, 205, 227,
+			232, 128, 115, 238, 141, 36, 70, 47, 32, 93, 87, 0, 29, 183,
+			212, 97, 227, 217, 240, 24, 109, 78, 188, 241, 218, 43, 230, 196,
+			27, 151, 38, 110, 150, 95, 27, 27, 205, 117, 100, 92, 24, 59,
+			139, 200, 188, 249, 152, 212, 169, 93, 243, 55, 166, 200, 245, 171,
+			98, 104, 10, 83, 44, 220, 18, 231, 31, 133, 41, 22, 110, 165,
+			6, 100, 74, 195, 218, 173, 161, 52, 58, 205, 186, 85, 176, 246,
+			172, 122, 208, 192, 109, 45, 77, 94, 187, 30, 52, 5, 103, 243,
+			103, 131, 166, 0, 230, 103, 83, 131, 50, 165, 97, 237, 217, 97,
+			140, 254, 62, 104, 3, 85, 172, 223, 141, 45, 41, 198, 199, 84,
+			210, 46, 147, 202, 253, 68, 172, 12, 187, 213, 160, 46, 96, 139,
+			72, 225, 89, 78, 57, 28, 80, 44, 31, 196, 143, 80, 183, 132,
+			136, 219, 178, 61, 98, 214, 76, 203, 6, 29, 34, 19, 176, 92,
+			202, 170, 58, 238, 246, 121, 79, 214, 170, 59, 181, 44, 153, 119,
+			220, 80, 146, 128, 249, 0, 222, 229, 210, 245, 160, 24, 2, 133,
+			93, 22, 145, 162, 207, 53, 62, 237, 160, 65, 187, 57, 151, 174,
+			67, 225, 53, 203, 102, 19, 198, 185, 105, 4, 188, 0, 106, 250,
+			216, 242, 124, 15, 17, 203, 30, 135, 61, 165, 233, 58, 155, 192,
+			12, 153, 198, 225, 177, 47, 116, 126, 112, 66, 191, 155, 60, 130,
+			254, 30, 156, 54, 85, 152, 229, 162, 138, 141, 239, 85, 24, 213,
+			175, 59, 245, 58, 63, 20, 174, 91, 180, 94, 245, 164, 78, 7,
+			20, 164, 53, 203, 143, 140, 147, 45, 0, 54, 20, 169, 212, 217,
+			218, 176, 42, 76, 18, 69, 189, 48, 202, 148, 82, 178, 57, 24,
+			158, 41, 247, 75, 62, 10, 49, 189, 42, 163, 148, 162, 152, 94,
+			149, 81, 74, 81, 168, 160, 84, 70, 41, 197, 244, 48, 234, 103,
+			160, 43, 88, 123, 32, 86, 139, 202, 168, 226, 65, 80, 13, 168,
+			226, 65, 42, 45, 83, 26, 214, 30, 28, 60, 36, 170, 169, 88,
+			123, 65, 29, 22, 159, 212, 56, 164, 100, 53, 192, 205, 11, 130,
+			46, 85, 166, 189, 120, 97, 40, 141, 202, 172, 154, 134, 181, 69,
+			245, 176, 81, 34, 43, 251, 33, 25, 177, 41, 212, 157, 90, 32,
+			79, 194, 233, 46, 39, 206, 114, 48, 167, 136, 152, 107, 206, 166,
+			60, 100, 171, 170, 22, 135, 30, 250, 100, 74, 193, 218, 98, 82,
+			142, 64, 131, 222, 15, 30, 66, 159, 6, 186, 214, 176, 254, 48,
+			246, 154, 98, 124, 20, 180, 220, 46, 112, 120, 160, 215, 14, 98,
+			0, 169, 107, 166, 35, 11, 88, 54, 49, 61, 175, 213, 144, 194,
+			121, 197, 165, 32, 102, 1, 107, 37, 242, 144, 5, 95, 248, 209,
+			41, 139, 200, 130, 227, 3, 203, 50, 125, 216, 149, 214, 28, 127,
+			35, 44, 6, 4, 192, 203, 177, 118, 61, 96, 249, 140, 185, 117,
+			141, 148, 113, 72, 4, 44, 146, 207, 127, 5, 20, 35, 100, 85,
+			50, 38, 78, 242, 219, 64, 117, 174, 216, 175, 28, 242, 193, 22,
+			117, 183, 97, 111, 229, 58, 246, 217, 233, 236, 61, 234, 135, 58,
+			247, 251, 193, 14, 5, 52, 173, 41, 88, 123, 152, 60, 142, 166,
+			145, 174, 107, 64, 210, 47, 169, 167, 140, 107, 108, 166, 234, 206,
+			22, 0, 219, 129, 29, 49, 250, 122, 171, 26, 236, 223, 172, 107,
+			49, 25, 26, 99, 197, 47, 169, 105, 153, 82, 176, 246, 210, 240,
+			49, 153, 210, 176, 246, 210, 137, 147, 104, 133, 245, 166, 96, 237,
+			85, 245, 132, 113, 143, 20, 69, 123, 160, 92, 233, 154, 13, 185,
+			104, 77, 129, 44, 223, 181, 42, 126, 125, 155, 129, 7, 199, 13,
+			19, 232, 197, 146, 91, 129, 166, 42, 9, 104, 86, 246, 15, 228,
+			252, 234, 240, 81, 153, 210, 176, 246, 170, 113, 28, 61, 66, 170,
+			174, 99, 221, 140, 213, 20, 163, 28, 37, 133, 64, 79, 226, 253,
+			213, 162, 25, 212, 86, 102, 114, 24, 221, 70, 186, 174, 3, 154,
+			43, 234, 5, 35, 199, 208, 236, 212, 171, 128, 230, 160, 231, 61,
+			16, 172, 51, 4, 87, 132, 250, 78, 103, 203, 188, 114, 226, 172,
+			76, 105, 88, 171, 156, 31, 69, 69, 214, 143, 130, 181, 117, 245,
+			188, 113, 187, 29, 193, 225, 24, 123, 96, 21, 160, 233, 198, 170,
+			206, 176, 186, 30, 116, 10, 88, 93, 63, 145, 145, 41, 13, 107,
+			235, 231, 158, 14, 244, 88, 255, 203, 45, 68, 58, 21, 80, 252,
+			236, 213, 244, 29, 119, 167, 107, 245, 121, 52, 124, 215, 170, 211,
+			217, 160, 224, 50, 245, 241, 13, 164, 131, 250, 78, 104, 167, 206,
+			118, 233, 44, 218, 107, 44, 65, 91, 37, 86, 35, 243, 53, 29,
+			29, 236, 241, 181, 231, 245, 51, 40, 142, 204, 202, 35, 179, 38,
+			213, 78, 50, 137, 79, 33, 84, 165, 77, 106, 87, 169, 93, 217,
+			30, 209, 136, 54, 154, 42, 69, 114, 240, 69, 52, 220, 108, 173,
+			213, 173, 74, 57, 82, 12, 17, 109, 52, 94, 74, 243, 15, 179,
+			97, 225, 243, 104, 104, 139, 154, 143, 162, 69, 251, 89, 209, 65,
+			200, 142, 20, 156, 65, 3, 13, 234, 121, 102, 141, 242, 43, 104,
+			157, 141, 158, 116, 141, 190, 115, 228, 253, 162, 22, 187, 164, 206,
+			163, 20, 181, 91, 13, 121, 137, 221, 27, 127, 5, 187, 213, 232,
+			108, 37, 9, 213, 68, 19, 125, 76, 103, 93, 161, 35, 9, 6,
+			194, 249, 174, 6, 150, 249, 247, 206, 54, 100, 61, 60, 131, 82,
+			244, 177, 207, 143, 78, 35, 125, 172, 145, 115, 93, 141, 220, 133,
+			29, 179, 179, 137, 176, 30, 190, 142, 250, 196, 9, 121, 36, 201,
+			148, 87, 39, 122, 52, 81, 167, 92, 117, 236, 149, 100, 97, 92,
+			68, 105, 126, 69, 82, 174, 56, 85, 90, 182, 236, 117, 103, 36,
+			197, 26, 56, 221, 213, 192, 50, 43, 56, 227, 84, 105, 209, 94,
+			119, 74, 131, 94, 91, 26, 31, 65, 9, 111, 219, 246, 205, 199,
+			35, 3, 140, 112, 68, 42, 243, 43, 9, 52, 180, 31, 18, 187,
+			133, 226, 76, 46, 24, 81, 223, 9, 14, 120, 157, 118, 36, 38,
+			158, 16, 137, 121, 212, 111, 83, 207, 167, 85, 78, 17, 218, 62,
+			105, 10, 241, 74, 221, 36, 165, 63, 17, 73, 189, 31, 13, 5,
+			32, 149, 25, 51, 21, 180, 153, 219, 11, 146, 108, 65, 214, 99,
+			138, 208, 210, 96, 208, 14, 75, 227, 89, 132, 28, 155, 58, 235,
+			229, 42, 173, 212, 71, 146, 59, 96, 105, 17, 138, 116, 130, 151,
+			98, 21, 103, 105, 165, 142, 111, 134, 164, 214, 183, 3, 165, 204,
+			243, 69, 214, 69, 109, 171, 104, 16, 14, 130, 238, 38, 173, 138,
+			145, 165, 24, 16, 217, 61, 71, 86, 18, 213, 216, 64, 74, 7,
+			100, 43, 44, 137, 159, 66, 65, 70, 25, 184, 21, 99, 47, 169,
+			210, 128, 204, 92, 48, 27, 212, 120, 3, 13, 182, 163, 7, 20,
+			233, 236, 218, 143, 49, 186, 120, 137, 39, 64, 17, 78, 237, 170,
+			48, 73, 210, 168, 93, 197, 207, 135, 3, 214, 216, 128, 159, 238,
+			2, 183, 189, 229, 206, 113, 27, 207, 160, 3, 109, 3, 216, 111,
+			215, 153, 15, 161, 195, 61, 155, 198, 239, 71, 135, 90, 182, 5,
+			55, 118, 77, 151, 2, 197, 114, 8, 71, 190, 222, 183, 3, 205,
+			173, 70, 75, 243, 86, 74, 7, 219, 154, 224, 153, 99, 169, 228,
+			55, 250, 210, 31, 249, 200, 71, 62, 162, 102, 254, 101, 2, 29,
+			234, 181, 102, 122, 46, 223, 35, 40, 97, 183, 26, 107, 212, 101,
+			119, 8, 241, 146, 72, 225, 60, 138, 215, 205, 53, 90, 103, 119,
+			8, 131, 147, 23, 247, 181, 42, 179, 115, 80, 165, 196, 107, 226,
+			103, 145, 30, 216, 25, 13, 78, 142, 237, 175, 5, 88, 142, 37,
+			86, 15, 76, 163, 224, 127, 78, 27, 9, 6, 115, 18, 50, 128,
+			46, 224, 154, 131, 45, 147, 42, 149, 91, 91, 144, 6, 194, 170,
+			210, 117, 179, 85, 247, 203, 236, 56, 206, 8, 62, 85, 26, 16,
+			153, 47, 66, 30, 88, 102, 177, 197, 81, 182, 236, 42, 125, 204,
+			184, 103, 188, 196, 23, 90, 17, 114, 160, 251, 215, 61, 199, 150,
+			164, 9, 45, 36, 33, 131, 117, 255, 76, 72, 92, 156, 113, 159,
+			236, 61, 188, 78, 154, 194, 231, 209, 16, 43, 113, 69, 76, 189,
+			89, 31, 25, 38, 202, 104, 178, 52, 200, 179, 229, 45, 97, 230,
+			159, 169, 72, 7, 100, 224, 33, 212, 191, 242, 210, 82, 161, 60,
+			187, 184, 58, 61, 87, 72, 43, 120, 16, 33, 150, 113, 119, 110,
+			49, 191, 146, 86, 131, 116, 113, 97, 229, 250, 213, 180, 22, 84,
+			88, 229, 25, 122, 180, 192, 149, 201, 116, 28, 167, 209, 0, 75,
+			223, 45, 190, 191, 48, 123, 253, 106, 58, 209, 158, 115, 101, 50,
+			221, 135, 15, 160, 20, 203, 153, 94, 92, 156, 75, 39, 131, 54,
+			151, 87, 74, 197, 133, 123, 233, 84, 208, 230, 189, 210, 226, 234,
+			82, 26, 5, 45, 204, 23, 150, 151, 243, 247, 10, 233, 254, 160,
+			196, 244, 75, 43, 133, 229, 244, 64, 27, 88, 87, 38, 211, 7,
+			130, 46, 10, 11, 171, 243, 233, 65, 60, 140, 14, 176, 228, 178,
+			4, 98, 168, 35, 235, 250, 213, 116, 58, 4, 132, 183, 50, 220,
+			150, 113, 253, 106, 26, 103, 102, 80, 156, 145, 33, 198, 104, 112,
+			46, 63, 93, 152, 43, 47, 46, 129, 69, 73, 126, 46, 173, 132,
+			121, 165, 194, 251, 86, 139, 165, 194, 108, 90, 141, 230, 45, 21,
+			242, 43, 133, 217, 180, 150, 169, 160, 67, 189, 24, 106, 207, 37,
+			20, 161, 5, 117, 7, 90, 96, 109, 117, 210, 66, 230, 15, 85,
+			116, 176, 199, 166, 210, 179, 147, 231, 194, 235, 67, 224, 20, 23,
+			186, 186, 128, 134, 24, 101, 119, 180, 38, 110, 26, 163, 162, 134,
+			182, 131, 168, 1, 77, 116, 17, 236, 107, 93, 204, 159, 239, 143,
+			215, 123, 86, 239, 232, 156, 229, 189, 179, 77, 32, 222, 99, 19,
+			184, 133, 134, 187, 26, 218, 55, 51, 254, 168, 130, 70, 118, 66,
+			206, 30, 44, 81, 109, 99, 137, 183, 58, 49, 120, 166, 39, 10,
+			88, 63, 93, 115, 253, 51, 10, 58, 210, 91, 164, 236, 9, 195,
+			179, 40, 209, 160, 254, 134, 83, 21, 243, 221, 189, 119, 205, 179,
+			207, 29, 109, 149, 68, 173, 232, 110, 175, 237, 176, 219, 11, 104,
+			186, 32, 253, 132, 138, 14, 247, 108, 188, 39, 160, 39, 17, 178,
+			236, 102, 75, 152, 148, 170, 204, 164, 52, 197, 114, 24, 243, 2,
+			46, 219, 242, 131, 239, 26, 251, 142, 120, 22, 43, 112, 35, 4,
+			84, 103, 128, 158, 218, 97, 164, 157, 112, 226, 75, 40, 93, 169,
+			91, 96, 205, 26, 24, 181, 177, 173, 38, 57, 21, 95, 55, 235,
+			30, 45, 13, 241, 207, 203, 242, 43, 212, 96, 4, 228, 70, 106,
+			36, 218, 106, 240, 207, 65, 141, 204, 15, 166, 80, 127, 68, 0,
+			7, 19, 218, 215, 205, 77, 179, 44, 15, 85, 10, 59, 84, 245,
+			67, 222, 146, 56, 88, 93, 66, 135, 32, 89, 118, 90, 62, 117,
+			203, 149, 186, 233, 121, 128, 40, 38, 223, 167, 74, 24, 190, 45,
+			194, 167, 25, 249, 5, 95, 67, 7, 33, 183, 220, 104, 213, 125,
+			171, 89, 167, 101, 56, 230, 121, 35, 40, 10, 217, 48, 148, 152,
+			23, 5, 0, 34, 15, 207, 162, 147, 144, 89, 174, 81, 155, 186,
+			166, 79, 203, 244, 131, 45, 179, 238, 149, 77, 187, 90, 222, 48,
+			189, 141, 145, 67, 208, 192, 180, 58, 162, 148, 142, 65, 193, 123,
+			162, 92, 129, 21, 203, 219, 213, 251, 166, 183, 129, 167, 208, 17,
+			248, 8, 24, 177, 236, 90, 185, 178, 65, 43, 143, 202, 45, 127,
+			253, 198, 200, 241, 104, 255, 12, 66, 174, 198, 158, 129, 34, 171,
+			254, 250, 13, 188, 140, 6, 96, 238, 26, 96, 49, 188, 238, 184,
+			108, 15, 29, 156, 188, 176, 219, 17, 38, 187, 40, 42, 204, 59,
+			85, 58, 21, 95, 94, 42, 20, 102, 75, 253, 178, 149, 187, 142,
+			11, 38, 200, 53, 39, 64, 112, 63, 195, 90, 170, 230, 72, 244,
+			94, 67, 7, 43, 21, 62, 102, 171, 82, 22, 135, 49, 111, 36,
+			221, 134, 172, 74, 133, 13, 214, 170, 8, 26, 247, 240, 77, 116,
+			56, 68, 86, 180, 226, 112, 180, 226, 193, 0, 79, 145, 170, 215,
+			208, 193, 230, 118, 119, 69, 220, 214, 99, 115, 187, 179, 218, 51,
+			232, 80, 115, 163, 217, 93, 111, 44, 90, 15, 55, 55, 154, 157,
+			21, 207, 177, 147, 185, 75, 43, 96, 5, 58, 114, 52, 90, 60,
+			242, 1, 103, 81, 186, 82, 41, 83, 27, 140, 133, 203, 96, 55,
+			103, 122, 35, 167, 89, 97, 221, 119, 91, 180, 52, 88, 169, 20,
+			216, 199, 60, 251, 134, 199, 208, 176, 179, 246, 122, 133, 83, 100,
+			185, 233, 210, 117, 235, 241, 200, 89, 134, 222, 33, 248, 192, 232,
+			113, 137, 101, 227, 11, 40, 93, 241, 54, 76, 183, 201, 88, 178,
+			215, 52, 43, 116, 228, 28, 47, 202, 243, 23, 100, 54, 172, 8,
+			111, 203, 90, 247, 101, 139, 231, 89, 177, 126, 150, 39, 90, 27,
+			69, 105, 192, 68, 91, 199, 163, 172, 216, 96, 115, 163, 25, 237,
+			247, 41, 116, 160, 185, 17, 237, 244, 2, 43, 54, 208, 220, 136,
+			244, 120, 21, 29, 129, 66, 13, 234, 155, 85, 211, 55, 35, 165,
+			199, 89, 105, 64, 251, 188, 248, 216, 6, 167, 219, 90, 219, 14,
+			8, 107, 130, 149, 237, 135, 60, 73, 90, 223, 54, 225, 60, 51,
+			133, 6, 162, 116, 143, 83, 136, 83, 126, 90, 1, 33, 104, 102,
+			113, 182, 80, 94, 46, 190, 92, 72, 171, 32, 70, 205, 21, 87,
+			10, 229, 210, 234, 194, 74, 113, 190, 144, 214, 34, 130, 253, 3,
+			61, 249, 116, 250, 124, 230, 183, 84, 52, 216, 126, 82, 195, 183,
+			209, 81, 169, 86, 241, 168, 95, 222, 178, 92, 182, 32, 27, 38,
+			223, 28, 3, 250, 57, 36, 74, 45, 83, 255, 161, 229, 82, 110,
+			169, 139, 231, 208, 105, 219, 41, 123, 190, 105, 87, 77, 183, 90,
+			14, 21, 90, 101, 179, 2, 247, 185, 142, 59, 162, 70, 91, 57,
+			97, 59, 203, 162, 112, 184, 67, 228, 69, 209, 14, 242, 213, 118,
+			34, 223, 227, 40, 213, 48, 155, 101, 10, 118, 86, 76, 62, 79,
+			150, 146, 13, 179, 201, 12, 177, 190, 35, 199, 164, 7, 122, 50,
+			153, 78, 61, 208, 147, 169, 52, 202, 252, 129, 134, 6, 162, 242,
+			58, 206, 163, 120, 133, 237, 88, 128, 191, 193, 201, 167, 118, 149,
+			238, 179, 51, 176, 149, 77, 37, 184, 112, 92, 226, 53, 65, 140,
+			0, 98, 163, 176, 133, 131, 128, 47, 82, 248, 30, 74, 188, 238,
+			65, 9, 182, 247, 12, 78, 158, 221, 189, 237, 7, 203, 172, 241,
+			212, 131, 229, 242, 194, 98, 105, 62, 63, 87, 18, 213, 241, 49,
+			164, 215, 205, 55, 182, 219, 55, 61, 150, 181, 223, 73, 56, 134,
+			116, 80, 208, 181, 111, 53, 44, 235, 219, 184, 24, 114, 40, 206,
+			240, 133, 17, 18, 24, 75, 199, 112, 18, 233, 51, 139, 165, 217,
+			180, 2, 43, 128, 231, 150, 151, 138, 133, 153, 66, 90, 205, 92,
+			67, 9, 142, 4, 88, 44, 1, 26, 210, 49, 145, 20, 109, 40,
+			242, 235, 234, 252, 116, 161, 148, 86, 219, 167, 90, 79, 199, 51,
+			30, 26, 136, 202, 225, 223, 17, 42, 203, 124, 73, 65, 253, 17,
+			185, 26, 142, 157, 38, 220, 167, 149, 205, 186, 101, 122, 130, 52,
+			16, 203, 202, 67, 206, 126, 167, 238, 59, 1, 252, 3, 61, 25,
+			79, 39, 50, 63, 174, 160, 116, 167, 96, 219, 1, 166, 242, 215,
+			9, 102, 230, 199, 20, 52, 40, 182, 207, 222, 224, 157, 249, 107,
+			5, 239, 247, 85, 116, 160, 77, 134, 221, 47, 116, 31, 68, 195,
+			86, 149, 54, 152, 189, 79, 101, 187, 204, 236, 178, 71, 50, 140,
+			105, 228, 118, 151, 146, 179, 197, 176, 222, 28, 84, 155, 58, 88,
+			156, 45, 204, 47, 45, 174, 20, 22, 102, 94, 42, 175, 46, 188,
+			176, 176, 248, 112, 161, 148, 142, 52, 207, 138, 125, 251, 16, 146,
+			89, 66, 233, 78, 160, 240, 81, 212, 11, 172, 116, 12, 31, 68,
+			67, 11, 139, 229, 229, 226, 108, 161, 92, 184, 123, 183, 48, 179,
+			178, 204, 245, 30, 65, 233, 149, 182, 5, 158, 249, 81, 13, 29,
+			236, 1, 9, 206, 139, 19, 11, 63, 68, 77, 236, 7, 250, 44,
+			200, 12, 75, 166, 235, 139, 3, 206, 5, 148, 14, 110, 168, 93,
+			161, 79, 210, 152, 62, 105, 40, 204, 103, 75, 3, 143, 35, 204,
+			47, 247, 54, 65, 37, 47, 149, 79, 112, 172, 209, 75, 105, 249,
+			165, 104, 251, 65, 105, 155, 214, 204, 142, 210, 192, 204, 181, 82,
+			90, 126, 9, 74, 159, 65, 3, 85, 167, 5, 178, 30, 111, 21,
+			246, 14, 165, 212, 207, 243, 130, 34, 66, 138, 15, 181, 94, 3,
+			165, 126, 158, 199, 139, 156, 71, 67, 102, 173, 230, 66, 227, 178,
+			33, 126, 46, 25, 12, 178, 89, 65, 227, 1, 74, 74, 60, 128,
+			34, 12, 48, 81, 110, 242, 195, 182, 10, 138, 48, 91, 126, 60,
+			131, 6, 44, 175, 28, 104, 172, 71, 84, 162, 142, 38, 75, 253,
+			150, 23, 40, 64, 51, 63, 163, 162, 193, 246, 75, 8, 60, 139,
+			146, 117, 97, 59, 33, 110, 192, 70, 247, 184, 183, 200, 206, 137,
+			242, 165, 160, 166, 241, 155, 10, 74, 202, 108, 124, 4, 233, 77,
+			211, 223, 96, 205, 197, 167, 213, 180, 82, 98, 105, 200, 247, 154,
+			166, 61, 162, 134, 249, 144, 6, 25, 183, 78, 77, 48, 145, 42,
+			195, 197, 44, 184, 95, 8, 51, 234, 33, 145, 63, 35, 178, 225,
+			46, 12, 204, 114, 234, 109, 101, 117, 86, 54, 45, 63, 4, 133,
+			167, 208, 49, 217, 110, 149, 250, 96, 58, 91, 13, 43, 193, 21,
+			71, 170, 116, 84, 20, 152, 21, 223, 101, 221, 204, 111, 41, 104,
+			88, 30, 211, 170, 1, 178, 230, 17, 50, 109, 219, 241, 163, 232,
+			234, 38, 229, 174, 122, 217, 124, 80, 169, 20, 105, 192, 104, 32,
+			20, 126, 217, 17, 109, 167, 81, 191, 184, 97, 130, 211, 168, 80,
+			177, 34, 158, 117, 215, 170, 51, 245, 203, 26, 173, 89, 182, 208,
+			27, 243, 132, 84, 191, 232, 129, 250, 101, 250, 239, 41, 189, 29,
+			131, 211, 29, 218, 5, 239, 190, 242, 242, 179, 187, 185, 6, 67,
+			197, 202, 68, 141, 218, 19, 53, 39, 114, 235, 122, 43, 252, 25,
+			241, 22, 54, 58, 189, 133, 75, 116, 189, 78, 43, 48, 100, 244,
+			211, 199, 2, 223, 104, 179, 105, 229, 216, 77, 84, 121, 141, 110,
+			152, 155, 86, 112, 137, 139, 4, 184, 102, 211, 50, 246, 188, 242,
+			29, 123, 19, 29, 96, 210, 227, 180, 104, 4, 159, 66, 198, 221,
+			98, 97, 110, 182, 60, 93, 184, 159, 127, 177, 184, 88, 42, 175,
+			46, 44, 47, 21, 102, 138, 119, 139, 133, 217, 116, 12, 15, 160,
+			100, 68, 41, 57, 128, 146, 17, 117, 228, 16, 234, 95, 92, 93,
+			89, 90, 93, 41, 47, 46, 204, 189, 148, 214, 24, 223, 91, 8,
+			210, 58, 200, 57, 197, 249, 249, 213, 149, 60, 168, 131, 227, 83,
+			31, 64, 131, 237, 67, 192, 187, 235, 162, 71, 126, 12, 174, 144,
+			6, 39, 143, 201, 82, 102, 211, 202, 182, 129, 95, 58, 176, 30,
+			77, 78, 55, 209, 96, 100, 6, 205, 166, 53, 141, 219, 202, 75,
+			207, 238, 188, 40, 17, 113, 103, 171, 81, 155, 161, 40, 199, 63,
+			153, 77, 203, 99, 72, 15, 233, 209, 187, 21, 249, 253, 69, 85,
+			191, 151, 95, 42, 62, 248, 239, 135, 193, 216, 126, 40, 182, 160,
+			160, 95, 229, 94, 195, 67, 49, 60, 249, 11, 93, 198, 246, 124,
+			150, 201, 220, 220, 204, 223, 72, 51, 251, 247, 172, 236, 191, 253,
+			86, 246, 194, 204, 126, 56, 118, 80, 154, 217, 31, 140, 141, 9,
+			51, 251, 195, 177, 247, 75, 51, 123, 248, 201, 51, 143, 196, 50,
+			44, 19, 241, 159, 60, 243, 104, 236, 10, 203, 20, 63, 121, 230,
+			72, 236, 60, 203, 84, 248, 79, 158, 121, 76, 84, 63, 43, 127,
+			42, 125, 88, 63, 30, 187, 160, 160, 127, 171, 33, 181, 47, 134,
+			181, 81, 117, 202, 248, 77, 141, 228, 193, 108, 223, 170, 9, 3,
+			62, 176, 73, 14, 7, 206, 214, 31, 145, 11, 154, 140, 202, 73,
+			31, 39, 92, 117, 202, 236, 93, 198, 9, 245, 43, 217, 11, 96,
+			219, 71, 228, 74, 39, 226, 132, 239, 101, 81, 196, 217, 147, 145,
+			27, 76, 188, 93, 227, 118, 179, 119, 200, 101, 242, 202, 104, 184,
+			160, 179, 237, 28, 228, 2, 185, 67, 36, 71, 122, 237, 22, 84,
+			94, 246, 193, 126, 220, 99, 127, 247, 81, 57, 194, 192, 120, 253,
+			78, 134, 52, 219, 114, 133, 225, 162, 95, 39, 119, 200, 101, 40,
+			67, 246, 106, 181, 184, 176, 123, 163, 129, 159, 143, 112, 25, 44,
+			131, 65, 208, 190, 91, 143, 192, 60, 206, 43, 144, 61, 192, 145,
+			76, 248, 181, 91, 204, 140, 178, 47, 166, 98, 237, 120, 223, 83,
+			252, 183, 14, 19, 45, 242, 19, 88, 27, 237, 23, 249, 10, 214,
+			70, 207, 78, 242, 223, 26, 214, 70, 175, 221, 68, 127, 164, 34,
+			53, 30, 195, 250, 229, 216, 130, 98, 252, 123, 149, 251, 137, 87,
+			173, 138, 233, 59, 129, 219, 172, 236, 56, 234, 58, 192, 64, 34,
+			163, 17, 247, 140, 113, 97, 248, 196, 63, 33, 98, 69, 248, 133,
+			101, 19, 225, 61, 201, 93, 202, 121, 27, 166, 39, 73, 138, 249,
+			123, 214, 108, 7, 60, 120, 76, 143, 48, 29, 62, 24, 217, 51,
+			203, 248, 177, 177, 170, 67, 61, 96, 140, 99, 99, 194, 132, 188,
+			29, 44, 73, 131, 21, 167, 78, 214, 90, 224, 6, 226, 17, 203,
+			247, 104, 125, 253, 22, 184, 16, 2, 189, 34, 82, 165, 182, 227,
+			83, 175, 189, 38, 216, 12, 130, 103, 146, 185, 190, 78, 43, 62,
+			217, 112, 182, 72, 126, 9, 124, 24, 29, 144, 171, 200, 134, 105,
+			87, 235, 162, 14, 27, 21, 80, 54, 152, 32, 78, 113, 200, 192,
+			110, 131, 140, 141, 53, 204, 237, 177, 49, 226, 210, 10, 181, 54,
+			41, 179, 119, 23, 150, 216, 194, 210, 108, 189, 5, 142, 6, 220,
+			104, 45, 14, 166, 101, 151, 227, 24, 61, 135, 244, 56, 115, 113,
+			191, 162, 158, 49, 38, 201, 140, 99, 111, 130, 68, 15, 247, 175,
+			68, 92, 22, 51, 166, 4, 125, 120, 89, 50, 235, 180, 237, 12,
+			220, 110, 13, 26, 80, 176, 118, 69, 61, 33, 83, 42, 214, 174,
+			156, 38, 232, 39, 21, 214, 58, 216, 79, 171, 67, 198, 103, 21,
+			178, 44, 86, 55, 115, 0, 144, 168, 16, 83, 197, 230, 65, 92,
+			253, 102, 17, 121, 184, 97, 213, 193, 221, 187, 30, 216, 220, 246,
+			64, 47, 152, 183, 201, 58, 17, 223, 223, 181, 128, 129, 210, 42,
+			226, 224, 55, 154, 27, 166, 103, 121, 96, 175, 105, 54, 155, 174,
+			211, 116, 193, 29, 36, 128, 31, 140, 222, 110, 168, 65, 74, 197,
+			218, 141, 3, 131, 232, 75, 28, 126, 21, 107, 119, 212, 33, 227,
+			231, 21, 50, 219, 13, 178, 36, 46, 73, 38, 130, 108, 169, 48,
+			192, 11, 230, 12, 38, 168, 229, 249, 99, 99, 96, 240, 41, 108,
+			144, 89, 3, 112, 166, 144, 36, 46, 168, 83, 248, 178, 75, 103,
+			13, 112, 98, 113, 136, 231, 112, 79, 142, 138, 9, 238, 111, 166,
+			77, 168, 235, 2, 99, 108, 121, 45, 134, 206, 15, 20, 23, 94,
+			204, 207, 21, 103, 203, 249, 210, 189, 213, 249, 194, 194, 202, 7,
+			46, 4, 195, 3, 11, 222, 59, 193, 240, 216, 128, 14, 12, 162,
+			255, 135, 15, 79, 195, 218, 140, 138, 141, 63, 238, 57, 188, 8,
+			179, 221, 115, 132, 150, 23, 14, 140, 45, 53, 175, 233, 216, 158,
+			244, 88, 231, 118, 143, 194, 253, 22, 201, 42, 32, 32, 136, 81,
+			19, 185, 190, 54, 160, 16, 243, 0, 132, 203, 13, 234, 18, 142,
+			57, 177, 52, 97, 45, 49, 231, 50, 150, 11, 229, 199, 136, 191,
+			225, 58, 91, 33, 78, 76, 24, 66, 232, 103, 20, 64, 120, 222,
+			19, 222, 43, 21, 26, 226, 6, 172, 100, 103, 212, 3, 50, 165,
+			98, 109, 38, 61, 140, 126, 156, 227, 70, 199, 218, 125, 117, 216,
+			248, 116, 79, 220, 88, 246, 147, 163, 70, 114, 33, 88, 250, 12,
+			31, 21, 199, 229, 8, 171, 6, 118, 230, 80, 11, 86, 155, 176,
+			24, 101, 72, 229, 243, 17, 0, 15, 182, 167, 247, 213, 1, 153,
+			82, 177, 118, 127, 40, 141, 126, 138, 3, 31, 199, 218, 188, 154,
+			54, 126, 168, 55, 240, 141, 70, 203, 7, 185, 105, 79, 216, 229,
+			138, 162, 48, 214, 10, 109, 159, 51, 112, 210, 114, 41, 236, 137,
+			38, 130, 25, 103, 167, 35, 62, 225, 82, 140, 92, 163, 130, 93,
+			178, 145, 186, 212, 92, 247, 169, 27, 140, 32, 174, 0, 148, 253,
+			50, 165, 98, 109, 126, 112, 40, 48, 55, 253, 119, 103, 209, 245,
+			125, 186, 77, 55, 93, 202, 7, 176, 163, 231, 244, 147, 56, 96,
+			103, 254, 137, 134, 14, 134, 150, 191, 75, 178, 19, 124, 22, 13,
+			138, 136, 22, 101, 151, 214, 232, 227, 166, 184, 9, 29, 128, 220,
+			98, 149, 121, 135, 52, 241, 109, 212, 39, 28, 100, 132, 107, 238,
+			206, 78, 216, 65, 211, 37, 89, 5, 47, 33, 196, 221, 230, 76,
+			176, 39, 133, 83, 230, 224, 228, 165, 238, 6, 122, 128, 151, 45,
+			4, 245, 74, 145, 54, 240, 4, 194, 244, 49, 163, 166, 50, 125,
+			236, 136, 227, 50, 59, 171, 38, 75, 195, 226, 75, 33, 248, 128,
+			231, 208, 1, 24, 78, 112, 211, 196, 20, 237, 253, 147, 231, 123,
+			195, 32, 175, 156, 194, 145, 12, 248, 145, 236, 204, 58, 66, 33,
+			88, 184, 15, 105, 249, 185, 185, 116, 12, 159, 69, 228, 197, 124,
+			169, 152, 95, 88, 89, 46, 131, 255, 106, 121, 117, 161, 240, 254,
+			165, 194, 204, 74, 97, 182, 204, 99, 143, 128, 234, 235, 2, 58,
+			215, 94, 10, 142, 130, 189, 138, 170, 153, 18, 58, 220, 19, 28,
+			56, 176, 59, 91, 54, 117, 197, 84, 241, 4, 126, 10, 29, 88,
+			107, 49, 181, 70, 211, 177, 169, 152, 169, 84, 105, 96, 173, 85,
+			155, 145, 121, 153, 239, 70, 35, 208, 166, 196, 141, 229, 216, 223,
+			81, 82, 0, 191, 245, 116, 231, 87, 124, 5, 37, 248, 141, 55,
+			27, 80, 255, 228, 177, 29, 91, 188, 31, 43, 137, 162, 248, 25,
+			148, 4, 215, 23, 240, 211, 25, 81, 247, 174, 22, 20, 158, 238,
+			71, 169, 96, 141, 61, 137, 251, 248, 199, 78, 114, 247, 241, 245,
+			247, 220, 199, 223, 115, 31, 255, 118, 185, 143, 223, 233, 237, 62,
+			254, 43, 10, 119, 31, 63, 30, 187, 171, 24, 63, 167, 144, 18,
+			21, 110, 172, 108, 79, 108, 217, 76, 45, 22, 13, 94, 53, 241,
+			44, 89, 115, 28, 112, 200, 41, 52, 154, 254, 182, 60, 84, 146,
+			134, 233, 87, 54, 64, 2, 172, 215, 163, 110, 171, 140, 72, 231,
+			29, 112, 169, 97, 22, 55, 30, 217, 114, 90, 245, 42, 217, 2,
+			7, 92, 136, 71, 5, 206, 175, 140, 247, 129, 13, 25, 235, 194,
+			35, 190, 179, 55, 231, 19, 62, 38, 32, 174, 31, 79, 30, 71,
+			63, 164, 72, 159, 204, 83, 234, 9, 227, 99, 138, 116, 11, 23,
+			194, 14, 147, 136, 88, 228, 27, 225, 245, 110, 193, 193, 194, 175,
+			48, 137, 138, 145, 188, 75, 107, 173, 186, 25, 76, 26, 243, 220,
+			3, 129, 223, 165, 245, 237, 113, 98, 101, 105, 150, 225, 56, 252,
+			44, 252, 169, 235, 86, 197, 2, 207, 156, 45, 215, 108, 202, 232,
+			77, 228, 187, 152, 152, 122, 86, 56, 146, 240, 56, 73, 167, 132,
+			219, 24, 63, 19, 156, 74, 29, 149, 41, 13, 107, 167, 140, 227,
+			204, 139, 50, 6, 242, 232, 25, 245, 180, 241, 236, 46, 240, 11,
+			206, 71, 60, 211, 183, 188, 117, 17, 183, 133, 137, 152, 130, 7,
+			5, 221, 130, 255, 202, 25, 85, 70, 94, 1, 81, 254, 204, 65,
+			67, 166, 52, 172, 157, 57, 121, 10, 221, 135, 110, 129, 2, 206,
+			170, 215, 53, 99, 138, 220, 181, 234, 62, 28, 208, 34, 189, 123,
+			100, 205, 4, 22, 211, 70, 8, 217, 192, 111, 93, 158, 188, 88,
+			187, 44, 70, 216, 217, 190, 97, 244, 24, 37, 160, 93, 152, 145,
+			243, 250, 128, 97, 145, 124, 7, 97, 8, 248, 173, 109, 113, 96,
+			2, 165, 209, 66, 113, 225, 222, 20, 105, 121, 193, 152, 192, 223,
+			30, 86, 133, 105, 3, 142, 165, 3, 154, 23, 136, 208, 196, 131,
+			152, 55, 210, 181, 204, 174, 108, 103, 17, 26, 68, 125, 188, 103,
+			8, 71, 165, 247, 133, 105, 21, 107, 231, 81, 63, 250, 97, 77,
+			128, 6, 39, 61, 253, 130, 241, 49, 173, 23, 178, 193, 1, 17,
+			160, 112, 100, 156, 4, 137, 118, 38, 4, 110, 192, 9, 128, 7,
+			199, 105, 56, 160, 60, 107, 217, 1, 62, 196, 248, 178, 224, 163,
+			89, 105, 15, 140, 198, 130, 24, 113, 191, 184, 72, 5, 0, 95,
+			56, 235, 163, 206, 112, 0, 158, 60, 210, 134, 194, 103, 48, 207,
+			64, 129, 204, 239, 24, 218, 240, 187, 189, 180, 217, 165, 96, 22,
+			117, 121, 139, 119, 12, 136, 247, 8, 235, 125, 41, 191, 188, 204,
+			40, 23, 134, 39, 250, 71, 228, 149, 187, 249, 226, 220, 56, 225,
+			127, 161, 200, 107, 210, 139, 188, 94, 239, 152, 204, 237, 14, 0,
+			215, 40, 59, 148, 161, 168, 67, 180, 156, 119, 234, 181, 23, 142,
+			204, 28, 80, 234, 101, 253, 108, 152, 86, 177, 118, 249, 252, 40,
+			218, 18, 19, 167, 98, 237, 154, 158, 53, 54, 200, 178, 213, 176,
+			96, 209, 250, 206, 158, 252, 130, 139, 222, 157, 56, 66, 68, 158,
+			149, 196, 234, 178, 183, 123, 205, 128, 4, 4, 150, 231, 53, 253,
+			66, 152, 6, 72, 198, 39, 208, 151, 131, 216, 94, 183, 212, 19,
+			198, 255, 220, 147, 251, 48, 118, 211, 177, 82, 247, 181, 176, 118,
+			99, 159, 160, 251, 116, 173, 106, 176, 109, 211, 122, 85, 34, 93,
+			6, 33, 0, 91, 119, 20, 137, 60, 197, 62, 194, 108, 179, 152,
+			77, 237, 43, 41, 224, 28, 106, 2, 107, 183, 212, 129, 72, 148,
+			174, 91, 7, 36, 195, 2, 63, 215, 91, 198, 113, 244, 235, 138,
+			12, 211, 53, 173, 158, 50, 126, 89, 33, 197, 117, 226, 187, 45,
+			58, 14, 226, 131, 15, 1, 20, 90, 62, 9, 133, 233, 54, 188,
+			195, 122, 159, 111, 249, 252, 128, 206, 196, 107, 15, 116, 51, 140,
+			121, 134, 162, 112, 54, 63, 55, 7, 244, 95, 92, 39, 204, 124,
+			96, 92, 28, 190, 128, 67, 1, 241, 67, 224, 5, 166, 231, 22,
+			90, 6, 112, 59, 229, 33, 14, 218, 209, 15, 74, 119, 55, 122,
+			236, 10, 161, 10, 70, 12, 238, 180, 211, 106, 66, 166, 20, 172,
+			77, 247, 73, 103, 122, 112, 167, 157, 62, 113, 18, 45, 202, 240,
+			96, 5, 117, 204, 152, 238, 53, 201, 140, 136, 88, 223, 242, 100,
+			176, 15, 54, 173, 39, 160, 69, 137, 94, 56, 185, 22, 70, 206,
+			201, 148, 134, 181, 194, 232, 5, 180, 206, 253, 248, 31, 128, 131,
+			250, 203, 187, 236, 210, 82, 182, 143, 236, 211, 192, 120, 3, 173,
+			15, 4, 18, 168, 10, 31, 83, 193, 231, 4, 132, 86, 16, 230,
+			17, 150, 223, 131, 228, 73, 52, 47, 253, 250, 231, 212, 131, 198,
+			243, 76, 252, 108, 31, 26, 107, 160, 110, 201, 152, 107, 236, 212,
+			64, 172, 118, 64, 178, 44, 87, 250, 84, 114, 207, 254, 57, 177,
+			21, 114, 207, 254, 185, 192, 29, 31, 252, 181, 231, 134, 49, 90,
+			150, 158, 253, 139, 234, 113, 227, 238, 78, 29, 51, 92, 211, 199,
+			102, 5, 182, 6, 134, 218, 182, 126, 219, 142, 46, 65, 247, 224,
+			247, 189, 24, 116, 15, 35, 93, 76, 29, 145, 41, 240, 154, 62,
+			102, 160, 215, 17, 40, 12, 244, 149, 216, 43, 138, 241, 93, 187,
+			224, 58, 114, 230, 217, 191, 88, 36, 73, 15, 102, 64, 224, 27,
+			70, 186, 146, 36, 232, 71, 3, 23, 251, 135, 234, 9, 227, 83,
+			1, 23, 137, 84, 137, 140, 252, 175, 65, 144, 225, 222, 246, 15,
+			5, 250, 184, 183, 253, 67, 33, 200, 112, 111, 251, 135, 198, 113,
+			180, 36, 189, 237, 95, 86, 79, 27, 51, 123, 13, 66, 48, 132,
+			61, 151, 137, 202, 188, 113, 95, 22, 210, 140, 202, 166, 238, 101,
+			33, 205, 168, 108, 234, 94, 62, 121, 10, 93, 229, 254, 238, 223,
+			21, 91, 87, 140, 209, 29, 166, 78, 28, 225, 194, 41, 11, 124,
+			194, 191, 43, 57, 130, 6, 192, 75, 59, 25, 195, 122, 89, 165,
+			26, 107, 93, 75, 194, 56, 203, 201, 97, 244, 156, 244, 23, 55,
+			245, 163, 198, 100, 36, 74, 145, 92, 73, 236, 36, 201, 167, 33,
+			18, 16, 68, 208, 168, 24, 8, 119, 22, 55, 245, 254, 136, 179,
+			184, 57, 128, 35, 206, 226, 230, 225, 35, 232, 129, 116, 22, 175,
+			234, 199, 140, 59, 97, 87, 231, 189, 142, 216, 29, 98, 25, 139,
+			195, 40, 241, 55, 28, 79, 184, 80, 91, 158, 8, 97, 35, 220,
+			192, 19, 208, 152, 236, 21, 208, 87, 29, 56, 36, 83, 26, 214,
+			170, 71, 71, 2, 237, 210, 167, 111, 163, 103, 246, 169, 19, 10,
+			35, 93, 236, 25, 152, 111, 167, 27, 245, 160, 192, 78, 145, 251,
+			158, 72, 65, 101, 60, 161, 126, 44, 243, 181, 56, 66, 97, 188,
+			19, 108, 68, 61, 27, 166, 19, 191, 159, 215, 126, 63, 31, 23,
+			6, 64, 119, 152, 159, 137, 15, 158, 55, 160, 130, 234, 161, 184,
+			136, 4, 78, 97, 247, 100, 211, 218, 239, 231, 53, 230, 152, 232,
+			83, 60, 131, 250, 65, 160, 245, 249, 109, 212, 136, 190, 87, 140,
+			186, 160, 119, 196, 171, 173, 88, 13, 138, 47, 33, 29, 66, 178,
+			8, 143, 210, 19, 221, 32, 132, 17, 102, 74, 172, 36, 158, 69,
+			7, 214, 45, 219, 172, 131, 109, 62, 235, 56, 177, 103, 199, 12,
+			234, 1, 89, 11, 242, 241, 117, 148, 172, 82, 179, 10, 1, 179,
+			70, 250, 246, 106, 160, 20, 148, 197, 215, 209, 33, 169, 183, 45,
+			135, 196, 3, 190, 114, 218, 104, 138, 163, 231, 160, 44, 16, 98,
+			207, 195, 47, 160, 244, 154, 85, 99, 113, 30, 32, 222, 185, 227,
+			250, 222, 72, 74, 248, 243, 118, 141, 121, 218, 170, 189, 15, 74,
+			22, 88, 193, 210, 144, 172, 201, 211, 30, 206, 32, 129, 194, 106,
+			121, 109, 155, 217, 184, 138, 174, 83, 34, 123, 122, 27, 76, 123,
+			68, 56, 104, 183, 44, 21, 184, 35, 253, 80, 180, 148, 150, 31,
+			74, 34, 31, 52, 104, 46, 53, 235, 13, 225, 41, 205, 19, 184,
+			136, 134, 68, 108, 36, 97, 44, 231, 141, 28, 216, 41, 14, 162,
+			136, 157, 32, 44, 49, 74, 131, 27, 109, 233, 76, 17, 197, 25,
+			1, 225, 195, 104, 120, 121, 37, 191, 82, 232, 48, 27, 65, 40,
+			145, 159, 89, 41, 190, 40, 188, 0, 239, 22, 23, 242, 115, 197,
+			151, 193, 130, 86, 5, 171, 16, 145, 6, 7, 182, 7, 122, 82,
+			75, 235, 153, 255, 65, 69, 131, 237, 104, 194, 39, 195, 88, 146,
+			138, 68, 137, 90, 146, 121, 240, 25, 118, 86, 143, 10, 77, 160,
+			248, 44, 242, 240, 49, 20, 103, 122, 243, 17, 45, 172, 203, 115,
+			240, 34, 98, 42, 63, 121, 192, 23, 148, 62, 190, 215, 188, 69,
+			20, 184, 94, 169, 223, 15, 19, 70, 9, 245, 71, 190, 129, 175,
+			120, 176, 138, 133, 202, 239, 92, 119, 227, 97, 141, 80, 143, 24,
+			214, 203, 252, 29, 5, 13, 182, 79, 2, 190, 129, 70, 90, 30,
+			141, 144, 105, 57, 224, 76, 172, 155, 100, 233, 72, 203, 163, 33,
+			149, 6, 244, 14, 129, 47, 129, 25, 89, 82, 167, 185, 143, 192,
+			151, 188, 252, 147, 40, 16, 255, 205, 37, 212, 135, 227, 131, 177,
+			127, 160, 188, 167, 65, 124, 79, 131, 248, 237, 210, 32, 94, 96,
+			63, 21, 172, 29, 20, 63, 85, 80, 33, 222, 97, 63, 53, 176,
+			146, 121, 94, 168, 24, 143, 134, 42, 70, 248, 249, 119, 33, 8,
+			81, 12, 199, 79, 199, 62, 166, 40, 198, 127, 5, 177, 182, 2,
+			247, 101, 77, 56, 254, 73, 193, 5, 142, 139, 235, 226, 40, 5,
+			161, 157, 229, 37, 28, 191, 89, 147, 27, 15, 12, 191, 8, 39,
+			174, 80, 174, 139, 232, 32, 156, 117, 226, 57, 13, 22, 33, 170,
+			217, 226, 131, 187, 37, 21, 31, 222, 20, 241, 182, 76, 23, 252,
+			250, 136, 111, 122, 143, 198, 17, 15, 40, 199, 131, 207, 241, 223,
+			227, 100, 230, 125, 196, 244, 125, 218, 104, 250, 89, 68, 216, 29,
+			135, 7, 64, 76, 177, 72, 152, 98, 83, 34, 60, 58, 96, 100,
+			235, 226, 129, 218, 216, 103, 47, 144, 131, 224, 220, 186, 64, 31,
+			251, 196, 170, 78, 145, 203, 87, 133, 148, 9, 194, 222, 233, 36,
+			70, 147, 240, 59, 133, 53, 162, 30, 200, 60, 205, 20, 170, 132,
+			89, 40, 187, 173, 166, 79, 171, 227, 164, 226, 174, 181, 106, 236,
+			25, 148, 203, 151, 158, 185, 113, 253, 38, 132, 51, 131, 112, 84,
+			177, 84, 12, 107, 164, 95, 28, 204, 83, 208, 28, 164, 254, 169,
+			212, 126, 234, 163, 234, 69, 221, 248, 162, 178, 123, 68, 254, 16,
+			118, 17, 77, 175, 35, 160, 79, 200, 208, 16, 41, 45, 205, 132,
+			129, 251, 201, 46, 49, 243, 35, 241, 200, 123, 132, 161, 7, 116,
+			44, 70, 175, 204, 197, 249, 22, 14, 20, 163, 226, 64, 193, 53,
+			163, 163, 34, 124, 23, 215, 140, 142, 166, 135, 209, 1, 158, 74,
+			98, 125, 20, 95, 212, 208, 16, 74, 178, 228, 143, 37, 99, 88,
+			187, 160, 79, 68, 50, 20, 172, 141, 233, 23, 153, 12, 207, 116,
+			154, 19, 234, 109, 45, 162, 151, 156, 232, 27, 64, 139, 66, 135,
+			20, 195, 90, 78, 55, 196, 177, 86, 26, 116, 48, 187, 144, 44,
+			191, 246, 101, 191, 97, 109, 51, 28, 90, 235, 108, 129, 48, 185,
+			13, 50, 157, 134, 229, 251, 180, 26, 209, 13, 65, 7, 57, 253,
+			112, 152, 86, 177, 150, 27, 57, 134, 102, 69, 135, 10, 214, 38,
+			245, 33, 17, 102, 42, 196, 35, 217, 50, 61, 113, 83, 92, 101,
+			135, 45, 112, 172, 106, 250, 30, 179, 83, 233, 214, 64, 129, 220,
+			62, 169, 71, 210, 42, 214, 38, 15, 12, 162, 175, 40, 162, 27,
+			21, 107, 207, 232, 7, 141, 127, 161, 116, 246, 99, 5, 246, 46,
+			50, 22, 39, 48, 88, 215, 180, 249, 254, 3, 11, 195, 178, 125,
+			135, 4, 146, 2, 68, 208, 247, 131, 91, 112, 102, 98, 177, 1,
+			71, 67, 155, 152, 45, 223, 105, 152, 190, 80, 40, 121, 14, 112,
+			60, 184, 187, 102, 171, 209, 129, 184, 224, 192, 17, 93, 90, 129,
+			243, 36, 188, 71, 97, 217, 65, 82, 138, 118, 81, 82, 2, 149,
+			21, 44, 92, 203, 54, 43, 96, 217, 30, 25, 47, 28, 129, 158,
+			209, 7, 195, 52, 140, 111, 24, 163, 77, 49, 92, 208, 69, 233,
+			216, 168, 245, 26, 109, 192, 67, 0, 173, 182, 67, 64, 161, 75,
+			221, 94, 8, 38, 54, 135, 82, 172, 224, 54, 232, 35, 192, 71,
+			224, 2, 227, 136, 91, 250, 129, 48, 13, 186, 191, 244, 48, 122,
+			86, 106, 241, 243, 234, 243, 198, 101, 50, 211, 114, 33, 194, 170,
+			176, 142, 19, 59, 90, 8, 230, 78, 171, 66, 73, 68, 3, 168,
+			43, 44, 128, 186, 92, 21, 112, 212, 205, 167, 135, 101, 42, 137,
+			181, 60, 126, 78, 172, 1, 133, 47, 138, 252, 161, 59, 2, 16,
+			21, 235, 5, 245, 190, 110, 92, 34, 15, 69, 176, 213, 29, 72,
+			47, 219, 27, 16, 208, 3, 22, 68, 4, 44, 174, 7, 44, 156,
+			56, 47, 83, 160, 154, 26, 187, 40, 150, 167, 154, 196, 122, 97,
+			252, 190, 92, 158, 42, 135, 228, 110, 176, 60, 85, 190, 60, 239,
+			233, 23, 133, 26, 13, 116, 61, 234, 105, 99, 154, 20, 59, 95,
+			1, 233, 25, 163, 18, 52, 134, 121, 200, 147, 58, 117, 151, 54,
+			5, 228, 2, 30, 77, 135, 22, 37, 228, 16, 239, 127, 174, 255,
+			112, 36, 222, 255, 220, 17, 121, 247, 1, 250, 188, 185, 147, 167,
+			208, 159, 201, 120, 255, 250, 138, 186, 122, 213, 248, 81, 101, 71,
+			44, 5, 27, 144, 212, 160, 4, 235, 134, 243, 215, 238, 69, 83,
+			92, 143, 234, 100, 45, 15, 110, 186, 198, 197, 46, 238, 133, 202,
+			252, 72, 63, 86, 164, 155, 46, 194, 152, 156, 39, 119, 238, 144,
+			187, 252, 59, 67, 22, 185, 243, 228, 255, 36, 146, 64, 243, 184,
+			18, 76, 47, 104, 30, 87, 78, 92, 144, 41, 13, 107, 43, 227,
+			19, 50, 149, 196, 218, 106, 226, 138, 152, 76, 157, 207, 238, 106,
+			242, 50, 122, 43, 120, 133, 224, 37, 245, 130, 209, 34, 129, 8,
+			76, 182, 122, 225, 18, 184, 200, 26, 147, 89, 42, 116, 189, 5,
+			236, 35, 50, 102, 185, 107, 137, 104, 45, 66, 7, 181, 218, 172,
+			154, 126, 68, 200, 38, 45, 219, 183, 234, 109, 184, 18, 79, 11,
+			176, 32, 124, 114, 52, 96, 63, 243, 146, 136, 17, 199, 159, 29,
+			120, 233, 252, 40, 122, 43, 193, 192, 77, 96, 253, 145, 90, 191,
+			106, 188, 29, 39, 224, 203, 194, 120, 97, 8, 166, 23, 229, 82,
+			114, 211, 4, 93, 10, 89, 220, 164, 110, 135, 200, 1, 179, 140,
+			162, 99, 180, 64, 219, 180, 186, 80, 92, 92, 136, 136, 52, 164,
+			23, 3, 236, 222, 143, 185, 125, 155, 172, 195, 226, 44, 114, 106,
+			12, 234, 200, 146, 30, 188, 121, 81, 105, 185, 160, 55, 175, 111,
+			119, 222, 242, 152, 100, 186, 83, 186, 137, 212, 101, 170, 112, 209,
+			102, 180, 73, 128, 215, 130, 136, 160, 149, 13, 171, 94, 109, 23,
+			152, 60, 1, 153, 144, 188, 136, 211, 134, 9, 41, 172, 115, 57,
+			10, 20, 142, 21, 179, 233, 181, 234, 166, 47, 159, 149, 176, 196,
+			91, 36, 16, 129, 182, 85, 241, 69, 172, 229, 160, 142, 140, 64,
+			94, 115, 205, 230, 6, 108, 176, 28, 95, 66, 177, 79, 93, 42,
+			151, 189, 233, 147, 6, 220, 135, 56, 54, 37, 180, 90, 11, 159,
+			177, 144, 150, 175, 16, 208, 182, 99, 58, 197, 243, 76, 222, 134,
+			25, 190, 228, 193, 59, 146, 150, 117, 164, 1, 114, 159, 155, 37,
+			15, 225, 150, 141, 39, 60, 0, 3, 132, 21, 86, 30, 162, 52,
+			59, 235, 96, 202, 101, 86, 54, 216, 174, 18, 237, 128, 172, 108,
+			180, 60, 82, 217, 174, 128, 57, 42, 24, 95, 50, 175, 77, 113,
+			183, 7, 105, 219, 113, 154, 48, 70, 166, 149, 136, 28, 93, 137,
+			75, 253, 150, 107, 119, 220, 183, 6, 19, 47, 249, 204, 38, 37,
+			149, 186, 227, 113, 172, 69, 169, 13, 100, 3, 8, 141, 73, 74,
+			180, 226, 184, 85, 234, 102, 101, 216, 195, 210, 210, 12, 112, 166,
+			134, 83, 133, 120, 172, 33, 47, 10, 150, 75, 66, 199, 218, 163,
+			128, 95, 38, 226, 88, 123, 212, 47, 183, 149, 132, 130, 181, 71,
+			248, 105, 153, 210, 176, 246, 232, 194, 152, 76, 37, 177, 86, 15,
+			88, 65, 130, 179, 130, 122, 242, 50, 242, 216, 210, 234, 195, 90,
+			83, 205, 25, 235, 164, 83, 91, 19, 49, 164, 99, 193, 210, 229,
+			89, 159, 48, 29, 193, 168, 119, 129, 115, 69, 137, 3, 203, 238,
+			94, 90, 222, 6, 187, 253, 226, 13, 18, 38, 100, 115, 152, 250,
+			116, 232, 53, 72, 37, 176, 214, 236, 151, 183, 55, 125, 10, 214,
+			154, 134, 132, 190, 79, 195, 90, 115, 34, 11, 175, 32, 2, 180,
+			73, 172, 61, 86, 239, 25, 255, 73, 225, 231, 226, 32, 234, 245,
+			40, 147, 139, 51, 45, 143, 186, 83, 183, 105, 195, 180, 234, 207,
+			102, 46, 144, 173, 13, 105, 213, 87, 237, 224, 108, 64, 98, 242,
+			138, 13, 150, 31, 107, 77, 4, 118, 0, 137, 195, 105, 217, 62,
+			143, 162, 142, 68, 171, 21, 119, 34, 114, 2, 121, 222, 108, 54,
+			189, 166, 227, 103, 107, 162, 146, 168, 3, 103, 128, 204, 5, 126,
+			121, 9, 43, 128, 201, 215, 107, 160, 56, 135, 70, 144, 136, 133,
+			206, 91, 124, 221, 217, 176, 171, 14, 125, 94, 112, 1, 94, 117,
+			39, 17, 35, 25, 135, 145, 75, 193, 59, 169, 96, 237, 113, 234,
+			160, 76, 105, 88, 123, 124, 100, 68, 166, 0, 71, 199, 238, 138,
+			249, 78, 242, 249, 126, 124, 124, 22, 125, 65, 101, 19, 158, 194,
+			218, 247, 168, 196, 248, 140, 74, 238, 182, 234, 117, 238, 68, 32,
+			22, 153, 84, 155, 241, 153, 21, 74, 179, 106, 231, 20, 183, 163,
+			113, 183, 83, 196, 83, 176, 101, 76, 200, 86, 39, 160, 43, 47,
+			64, 124, 120, 212, 131, 136, 224, 203, 81, 230, 53, 69, 50, 185,
+			32, 224, 247, 4, 227, 107, 89, 137, 112, 56, 100, 65, 25, 47,
+			7, 42, 202, 53, 74, 215, 89, 68, 241, 46, 254, 201, 219, 104,
+			155, 180, 182, 54, 88, 190, 151, 187, 60, 121, 229, 234, 181, 235,
+			207, 220, 184, 121, 41, 124, 38, 43, 21, 7, 20, 73, 92, 167,
+			20, 172, 125, 79, 74, 202, 34, 41, 13, 107, 223, 115, 242, 52,
+			250, 101, 78, 143, 8, 235, 31, 85, 212, 67, 198, 63, 0, 83,
+			28, 179, 222, 232, 41, 41, 240, 192, 205, 252, 220, 46, 48, 38,
+			145, 21, 232, 141, 184, 46, 151, 163, 159, 193, 103, 217, 235, 174,
+			201, 117, 224, 240, 103, 162, 185, 157, 187, 8, 209, 118, 189, 220,
+			6, 53, 171, 94, 174, 97, 122, 62, 117, 115, 76, 194, 175, 89,
+			54, 205, 153, 45, 127, 67, 198, 38, 17, 74, 39, 166, 213, 244,
+			202, 21, 199, 94, 183, 106, 252, 156, 139, 132, 252, 135, 226, 12,
+			242, 164, 76, 42, 144, 76, 13, 202, 164, 6, 201, 225, 131, 236,
+			94, 35, 166, 246, 99, 253, 251, 20, 245, 105, 227, 182, 52, 124,
+			7, 99, 252, 245, 28, 216, 247, 51, 29, 76, 149, 62, 22, 209,
+			145, 163, 143, 95, 117, 80, 138, 104, 186, 63, 193, 26, 75, 203,
+			164, 2, 201, 97, 34, 147, 26, 36, 159, 58, 135, 62, 196, 46,
+			80, 19, 159, 84, 98, 63, 166, 40, 134, 77, 218, 53, 141, 157,
+			70, 190, 59, 83, 168, 228, 65, 76, 76, 129, 170, 96, 73, 239,
+			59, 65, 123, 226, 36, 36, 164, 19, 9, 106, 63, 210, 116, 69,
+			193, 250, 39, 149, 228, 17, 52, 33, 238, 85, 245, 31, 80, 212,
+			231, 141, 211, 100, 33, 178, 98, 130, 102, 132, 210, 85, 12, 83,
+			129, 155, 55, 40, 159, 148, 73, 5, 235, 63, 160, 164, 210, 50,
+			169, 65, 242, 224, 33, 153, 76, 66, 242, 240, 115, 40, 141, 146,
+			44, 9, 107, 86, 255, 1, 229, 232, 29, 209, 185, 130, 245, 79,
+			239, 210, 249, 44, 87, 233, 6, 157, 43, 113, 86, 94, 118, 14,
+			35, 249, 116, 216, 185, 162, 65, 50, 232, 92, 73, 66, 50, 232,
+			156, 159, 73, 244, 79, 67, 231, 23, 89, 231, 42, 214, 63, 171,
+			168, 207, 26, 39, 123, 119, 190, 2, 219, 65, 208, 181, 26, 103,
+			165, 101, 215, 170, 2, 73, 65, 87, 10, 156, 65, 244, 207, 42,
+			195, 88, 38, 147, 240, 245, 224, 29, 209, 181, 202, 187, 254, 172,
+			114, 248, 22, 42, 65, 215, 90, 12, 39, 62, 167, 168, 63, 170,
+			104, 198, 116, 196, 228, 162, 55, 13, 180, 237, 200, 221, 179, 46,
+			33, 212, 98, 10, 214, 63, 167, 160, 195, 200, 68, 9, 72, 194,
+			204, 254, 136, 162, 63, 109, 188, 143, 109, 204, 129, 130, 57, 140,
+			159, 220, 214, 50, 91, 224, 221, 205, 51, 35, 184, 0, 39, 194,
+			32, 29, 13, 161, 62, 222, 69, 130, 245, 113, 52, 204, 80, 32,
+			99, 228, 76, 152, 161, 65, 198, 89, 176, 35, 0, 156, 107, 88,
+			255, 188, 162, 158, 22, 120, 210, 18, 44, 121, 64, 38, 21, 72,
+			14, 26, 50, 201, 10, 159, 60, 5, 23, 210, 112, 19, 158, 248,
+			130, 2, 170, 101, 227, 147, 10, 105, 215, 143, 71, 48, 182, 225,
+			108, 117, 112, 169, 40, 206, 88, 28, 69, 208, 107, 121, 78, 192,
+			208, 44, 23, 5, 56, 16, 178, 29, 96, 7, 108, 20, 64, 182,
+			36, 102, 123, 128, 106, 56, 137, 7, 97, 203, 61, 177, 164, 128,
+			26, 190, 0, 75, 234, 37, 113, 115, 174, 127, 81, 81, 207, 26,
+			47, 144, 101, 42, 108, 18, 218, 216, 137, 236, 110, 109, 59, 224,
+			46, 166, 197, 92, 7, 67, 176, 207, 203, 35, 49, 83, 245, 139,
+			73, 102, 23, 223, 208, 118, 66, 38, 21, 172, 127, 81, 233, 59,
+			45, 147, 26, 36, 51, 79, 161, 47, 42, 226, 242, 91, 255, 25,
+			69, 61, 193, 60, 123, 186, 32, 89, 235, 10, 247, 205, 180, 154,
+			231, 61, 210, 106, 2, 141, 48, 208, 92, 26, 245, 240, 10, 193,
+			147, 54, 147, 108, 112, 240, 40, 132, 229, 1, 135, 4, 77, 197,
+			38, 200, 3, 0, 180, 120, 203, 133, 248, 109, 47, 250, 136, 11,
+			228, 96, 68, 74, 130, 1, 153, 150, 73, 6, 243, 240, 81, 153,
+			212, 32, 105, 28, 15, 174, 132, 127, 245, 24, 186, 186, 207, 11,
+			213, 42, 117, 173, 77, 234, 62, 249, 125, 240, 147, 222, 61, 131,
+			147, 252, 233, 89, 214, 187, 124, 132, 36, 60, 54, 150, 184, 51,
+			13, 222, 64, 7, 228, 193, 166, 12, 251, 190, 184, 47, 122, 182,
+			251, 130, 102, 143, 150, 178, 82, 196, 88, 49, 189, 71, 252, 186,
+			107, 64, 182, 12, 89, 198, 44, 26, 136, 22, 193, 167, 81, 18,
+			66, 221, 71, 110, 147, 89, 165, 32, 19, 31, 68, 170, 124, 8,
+			154, 183, 167, 90, 213, 177, 31, 87, 80, 110, 39, 72, 150, 92,
+			26, 216, 243, 220, 229, 78, 81, 44, 124, 206, 44, 122, 126, 182,
+			80, 42, 190, 88, 40, 207, 220, 47, 45, 206, 23, 87, 231, 203,
+			17, 101, 237, 82, 169, 16, 220, 59, 148, 193, 172, 111, 181, 84,
+			40, 179, 64, 153, 237, 87, 138, 231, 208, 153, 226, 194, 204, 226,
+			252, 210, 92, 97, 37, 210, 210, 242, 195, 124, 105, 190, 184, 112,
+			175, 188, 146, 95, 126, 33, 173, 76, 62, 70, 125, 28, 62, 23,
+			55, 208, 200, 78, 160, 226, 203, 239, 24, 193, 70, 143, 203, 236,
+			176, 80, 38, 246, 36, 87, 101, 127, 44, 222, 156, 46, 188, 247,
+			230, 244, 123, 111, 78, 127, 251, 222, 156, 14, 110, 202, 242, 226,
+			78, 236, 112, 120, 39, 6, 63, 255, 80, 65, 106, 130, 61, 63,
+			157, 81, 140, 255, 3, 118, 9, 118, 232, 3, 238, 206, 185, 103,
+			155, 140, 0, 103, 184, 149, 197, 217, 197, 41, 82, 162, 13, 103,
+			83, 222, 80, 128, 83, 39, 5, 75, 38, 230, 214, 7, 215, 86,
+			142, 107, 186, 219, 68, 200, 236, 193, 112, 153, 234, 165, 1, 111,
+			153, 194, 71, 223, 172, 81, 68, 130, 7, 88, 182, 168, 236, 49,
+			228, 166, 158, 80, 165, 72, 110, 198, 110, 204, 72, 113, 22, 116,
+			78, 38, 67, 55, 123, 43, 1, 204, 208, 144, 80, 14, 53, 204,
+			71, 76, 229, 16, 170, 206, 249, 141, 87, 2, 174, 69, 78, 36,
+			135, 208, 191, 86, 144, 158, 128, 43, 42, 237, 140, 250, 208, 248,
+			53, 112, 189, 131, 94, 189, 246, 215, 114, 152, 190, 164, 77, 60,
+			98, 170, 16, 51, 120, 84, 170, 93, 41, 197, 30, 237, 21, 250,
+			147, 13, 57, 144, 168, 166, 235, 86, 187, 172, 37, 36, 18, 151,
+			250, 174, 69, 55, 129, 68, 193, 212, 28, 133, 151, 95, 115, 150,
+			23, 121, 206, 130, 197, 36, 8, 190, 117, 170, 110, 248, 241, 14,
+			198, 4, 134, 245, 137, 83, 50, 165, 98, 237, 204, 233, 103, 101,
+			10, 204, 236, 139, 203, 40, 207, 238, 64, 245, 115, 177, 59, 138,
+			113, 141, 228, 3, 55, 65, 105, 39, 8, 115, 181, 19, 59, 4,
+			188, 70, 238, 15, 207, 37, 207, 51, 65, 58, 166, 177, 251, 190,
+			155, 154, 113, 146, 20, 101, 184, 33, 160, 132, 14, 20, 1, 36,
+			122, 12, 196, 84, 109, 20, 29, 65, 231, 225, 230, 4, 4, 68,
+			184, 43, 155, 49, 70, 194, 147, 50, 236, 67, 76, 226, 224, 213,
+			216, 213, 6, 20, 140, 67, 201, 72, 26, 110, 217, 250, 15, 134,
+			105, 13, 107, 99, 71, 142, 134, 233, 36, 214, 198, 70, 166, 209,
+			48, 66, 34, 13, 82, 184, 54, 102, 60, 143, 190, 55, 46, 58,
+			7, 175, 98, 253, 142, 241, 39, 58, 41, 206, 66, 151, 109, 32,
+			139, 199, 136, 32, 104, 32, 163, 7, 203, 246, 168, 235, 75, 13,
+			33, 204, 187, 156, 72, 160, 64, 2, 54, 77, 240, 162, 183, 116,
+			53, 227, 97, 36, 167, 58, 210, 108, 53, 80, 19, 158, 28, 2,
+			138, 96, 79, 123, 241, 219, 79, 198, 43, 51, 182, 101, 191, 110,
+			202, 3, 52, 205, 65, 229, 41, 159, 214, 105, 131, 250, 238, 118,
+			185, 214, 108, 65, 32, 38, 90, 115, 133, 25, 8, 245, 252, 28,
+			123, 19, 155, 255, 132, 103, 199, 9, 107, 162, 236, 131, 89, 181,
+			120, 223, 12, 212, 21, 219, 19, 96, 20, 8, 115, 83, 229, 37,
+			8, 47, 17, 220, 205, 202, 247, 16, 165, 217, 50, 131, 7, 94,
+			111, 114, 197, 107, 92, 226, 218, 14, 6, 46, 238, 107, 51, 237,
+			112, 174, 185, 206, 150, 71, 93, 6, 138, 199, 64, 217, 225, 145,
+			53, 185, 83, 136, 199, 180, 153, 202, 130, 149, 102, 79, 171, 129,
+			96, 210, 86, 133, 229, 246, 170, 147, 149, 232, 14, 158, 92, 227,
+			183, 165, 206, 58, 201, 64, 126, 134, 128, 39, 57, 117, 125, 80,
+			119, 19, 211, 14, 182, 168, 7, 203, 139, 11, 108, 199, 131, 35,
+			53, 146, 91, 212, 238, 138, 11, 249, 133, 61, 212, 118, 81, 234,
+			41, 186, 94, 108, 99, 173, 5, 56, 5, 249, 153, 163, 180, 115,
+			53, 72, 50, 5, 75, 224, 27, 17, 178, 134, 91, 182, 27, 253,
+			226, 70, 15, 210, 26, 214, 110, 164, 135, 195, 116, 18, 107, 55,
+			240, 237, 128, 172, 197, 93, 219, 141, 67, 83, 236, 129, 27, 182,
+			160, 110, 171, 115, 70, 78, 172, 227, 78, 193, 61, 208, 233, 46,
+			119, 0, 36, 46, 187, 19, 88, 187, 173, 14, 202, 148, 130, 181,
+			219, 67, 39, 100, 10, 94, 124, 62, 77, 100, 42, 137, 181, 219,
+			103, 94, 104, 191, 7, 191, 253, 84, 17, 253, 146, 194, 99, 69,
+			76, 199, 10, 10, 40, 144, 216, 83, 62, 166, 12, 133, 225, 54,
+			43, 217, 30, 242, 98, 246, 69, 75, 188, 200, 152, 133, 192, 147,
+			66, 31, 13, 21, 129, 149, 128, 201, 89, 203, 131, 232, 12, 190,
+			105, 213, 33, 174, 204, 142, 76, 138, 93, 247, 68, 60, 181, 197,
+			91, 156, 61, 186, 132, 61, 136, 181, 90, 113, 170, 209, 176, 11,
+			211, 241, 171, 168, 95, 134, 93, 152, 81, 239, 69, 66, 40, 204,
+			168, 211, 50, 5, 126, 232, 133, 187, 162, 160, 130, 181, 89, 117,
+			84, 124, 130, 25, 156, 85, 159, 146, 41, 21, 107, 179, 79, 159,
+			15, 14, 48, 191, 172, 160, 227, 157, 118, 166, 96, 233, 177, 45,
+			206, 41, 67, 29, 198, 139, 153, 62, 20, 47, 192, 247, 233, 205,
+			222, 81, 170, 16, 251, 42, 3, 28, 157, 223, 45, 62, 21, 96,
+			214, 227, 189, 69, 2, 81, 157, 234, 12, 68, 245, 144, 214, 235,
+			47, 216, 206, 150, 13, 66, 188, 23, 64, 254, 77, 165, 251, 105,
+			33, 126, 110, 106, 0, 5, 237, 0, 254, 25, 148, 186, 11, 133,
+			230, 77, 239, 17, 62, 132, 226, 16, 186, 203, 99, 177, 188, 82,
+			37, 158, 152, 254, 212, 14, 241, 183, 6, 131, 138, 114, 116, 55,
+			119, 9, 223, 212, 3, 166, 91, 225, 207, 39, 24, 239, 219, 10,
+			58, 213, 57, 222, 170, 8, 10, 179, 211, 104, 167, 80, 82, 198,
+			141, 129, 39, 177, 61, 70, 116, 48, 92, 8, 153, 39, 147, 128,
+			6, 219, 180, 29, 79, 196, 128, 231, 137, 233, 15, 245, 198, 194,
+			1, 217, 160, 68, 194, 216, 222, 83, 44, 193, 124, 130, 81, 255,
+			163, 87, 208, 141, 125, 158, 123, 35, 182, 148, 79, 126, 200, 222,
+			3, 197, 198, 233, 206, 239, 129, 233, 227, 187, 48, 202, 206, 124,
+			54, 142, 80, 40, 67, 237, 106, 92, 125, 2, 245, 137, 45, 60,
+			60, 18, 199, 75, 9, 200, 43, 86, 241, 83, 40, 197, 165, 58,
+			248, 174, 201, 234, 113, 56, 53, 39, 249, 135, 98, 21, 223, 8,
+			93, 203, 185, 201, 233, 206, 142, 224, 188, 117, 89, 28, 206, 234,
+			210, 15, 139, 249, 246, 39, 249, 247, 32, 19, 223, 68, 9, 206,
+			198, 68, 168, 222, 30, 103, 85, 24, 39, 103, 160, 2, 116, 94,
+			1, 174, 213, 188, 86, 163, 97, 186, 219, 229, 13, 191, 81, 31,
+			233, 11, 71, 215, 47, 62, 220, 247, 27, 117, 252, 44, 66, 236,
+			21, 3, 166, 137, 26, 73, 238, 101, 99, 205, 59, 73, 177, 42,
+			112, 11, 143, 167, 80, 82, 206, 169, 120, 1, 234, 88, 87, 109,
+			73, 227, 98, 120, 178, 60, 190, 38, 172, 202, 209, 222, 86, 229,
+			188, 42, 43, 142, 103, 68, 92, 132, 32, 130, 99, 191, 8, 220,
+			223, 19, 57, 65, 220, 198, 1, 63, 146, 2, 252, 136, 105, 224,
+			193, 234, 7, 34, 248, 17, 31, 88, 124, 250, 251, 157, 65, 24,
+			14, 236, 214, 153, 116, 12, 226, 224, 182, 7, 96, 40, 160, 129,
+			40, 60, 16, 214, 18, 132, 148, 178, 8, 16, 10, 38, 223, 73,
+			200, 0, 125, 53, 60, 117, 192, 140, 222, 129, 232, 226, 37, 246,
+			251, 129, 158, 84, 210, 106, 230, 99, 10, 26, 136, 118, 5, 113,
+			158, 185, 251, 147, 224, 187, 34, 5, 51, 19, 96, 72, 221, 23,
+			134, 130, 242, 221, 81, 25, 180, 30, 81, 25, 222, 82, 209, 80,
+			135, 139, 210, 174, 107, 237, 104, 199, 90, 11, 150, 217, 149, 112,
+			5, 105, 123, 172, 160, 112, 241, 76, 160, 193, 136, 187, 15, 52,
+			170, 183, 245, 121, 32, 242, 181, 200, 194, 213, 211, 199, 205, 186,
+			105, 179, 12, 190, 38, 226, 225, 154, 24, 138, 124, 100, 235, 162,
+			147, 62, 18, 189, 233, 35, 243, 19, 42, 58, 0, 72, 184, 11,
+			190, 181, 54, 245, 188, 232, 48, 149, 157, 134, 169, 238, 123, 152,
+			103, 58, 224, 0, 4, 165, 218, 73, 244, 36, 66, 224, 217, 75,
+			203, 16, 54, 132, 153, 189, 171, 165, 20, 203, 41, 129, 45, 255,
+			5, 148, 14, 37, 196, 50, 187, 240, 101, 220, 38, 94, 26, 10,
+			243, 103, 32, 27, 95, 69, 71, 160, 222, 118, 212, 24, 157, 87,
+			72, 176, 10, 135, 216, 215, 80, 30, 227, 181, 88, 255, 86, 83,
+			148, 236, 99, 37, 83, 235, 117, 171, 201, 62, 143, 189, 159, 51,
+			99, 206, 164, 240, 17, 132, 151, 87, 242, 43, 171, 203, 29, 174,
+			5, 73, 164, 131, 219, 111, 90, 129, 80, 220, 160, 47, 76, 171,
+			16, 166, 126, 166, 148, 95, 190, 159, 214, 224, 103, 126, 122, 177,
+			180, 146, 214, 225, 251, 242, 11, 197, 165, 116, 252, 73, 84, 115,
+			255, 227, 60, 183, 98, 255, 126, 245, 61, 43, 246, 247, 172, 216,
+			191, 3, 86, 236, 163, 161, 21, 251, 133, 208, 138, 253, 78, 111,
+			43, 246, 191, 224, 86, 236, 250, 153, 216, 135, 21, 227, 143, 85,
+			146, 143, 88, 62, 133, 206, 133, 166, 240, 242, 172, 152, 208, 63,
+			89, 92, 247, 193, 79, 94, 62, 93, 28, 124, 2, 100, 7, 207,
+			230, 203, 183, 93, 8, 108, 243, 94, 224, 127, 31, 100, 11, 229,
+			213, 56, 234, 104, 136, 157, 189, 219, 139, 6, 29, 120, 34, 82,
+			90, 251, 21, 16, 175, 33, 46, 219, 130, 254, 217, 67, 255, 16,
+			149, 15, 204, 186, 4, 243, 242, 16, 25, 93, 92, 30, 39, 247,
+			150, 86, 199, 3, 5, 197, 122, 221, 172, 121, 44, 178, 228, 5,
+			174, 140, 97, 10, 72, 174, 191, 10, 12, 169, 196, 179, 177, 161,
+			19, 182, 37, 140, 246, 133, 202, 6, 108, 87, 54, 77, 171, 46,
+			76, 168, 88, 57, 217, 8, 187, 26, 164, 141, 53, 90, 245, 68,
+			204, 58, 254, 33, 162, 252, 58, 147, 196, 232, 215, 84, 105, 237,
+			62, 166, 78, 232, 198, 47, 169, 187, 91, 187, 71, 52, 128, 189,
+			205, 221, 67, 121, 180, 195, 220, 29, 145, 204, 46, 6, 239, 76,
+			71, 228, 229, 222, 92, 45, 205, 149, 11, 203, 51, 249, 165, 194,
+			108, 121, 165, 176, 188, 2, 198, 240, 130, 207, 121, 185, 55, 121,
+			248, 1, 200, 3, 197, 204, 214, 6, 117, 41, 233, 81, 131, 8,
+			64, 203, 86, 149, 80, 175, 98, 74, 15, 92, 20, 40, 72, 34,
+			71, 175, 230, 163, 90, 206, 166, 126, 174, 229, 214, 115, 79, 45,
+			153, 254, 70, 129, 213, 216, 213, 116, 102, 39, 59, 32, 48, 192,
+			31, 11, 108, 83, 224, 24, 62, 214, 102, 128, 63, 214, 102, 128,
+			63, 134, 39, 58, 12, 240, 47, 118, 26, 224, 143, 235, 23, 209,
+			79, 198, 67, 51, 232, 188, 241, 217, 56, 187, 107, 39, 86, 21,
+			44, 163, 90, 182, 245, 193, 150, 124, 218, 25, 2, 164, 75, 22,
+			9, 195, 135, 169, 17, 198, 83, 129, 1, 68, 248, 160, 252, 43,
+			83, 83, 77, 215, 178, 253, 169, 169, 215, 94, 123, 243, 242, 248,
+			228, 181, 235, 31, 62, 203, 195, 3, 248, 91, 14, 163, 54, 79,
+			186, 21, 195, 13, 171, 99, 7, 46, 210, 66, 7, 200, 9, 204,
+			174, 130, 57, 187, 191, 65, 76, 68, 108, 199, 158, 48, 235, 205,
+			13, 83, 60, 209, 77, 42, 27, 166, 107, 86, 124, 234, 178, 56,
+			2, 112, 85, 107, 123, 86, 149, 2, 211, 53, 131, 144, 132, 38,
+			169, 185, 78, 171, 153, 141, 132, 81, 37, 19, 36, 99, 230, 214,
+			114, 21, 102, 61, 196, 126, 86, 195, 159, 116, 234, 113, 52, 177,
+			45, 19, 96, 107, 180, 18, 184, 11, 139, 144, 24, 225, 171, 234,
+			172, 23, 222, 56, 120, 241, 91, 62, 109, 120, 145, 200, 36, 142,
+			13, 6, 147, 78, 171, 25, 196, 124, 16, 168, 20, 163, 23, 163,
+			206, 152, 57, 214, 223, 67, 254, 240, 58, 67, 2, 171, 38, 227,
+			41, 184, 158, 79, 174, 130, 130, 180, 1, 44, 166, 181, 54, 177,
+			75, 163, 176, 123, 133, 237, 174, 237, 216, 114, 48, 108, 198, 135,
+			130, 113, 239, 214, 11, 52, 221, 19, 122, 64, 89, 70, 58, 34,
+			8, 246, 37, 53, 163, 171, 197, 44, 226, 4, 19, 176, 160, 32,
+			110, 191, 156, 126, 47, 208, 66, 178, 253, 104, 203, 12, 201, 31,
+			180, 128, 249, 128, 252, 65, 131, 148, 79, 165, 163, 150, 246, 7,
+			15, 201, 20, 88, 218, 31, 126, 190, 195, 210, 254, 232, 179, 104,
+			163, 205, 210, 254, 229, 118, 93, 124, 132, 249, 16, 43, 52, 82,
+			237, 117, 227, 97, 85, 163, 180, 110, 78, 188, 113, 105, 226, 230,
+			171, 19, 229, 44, 144, 250, 149, 73, 160, 116, 97, 119, 31, 7,
+			155, 124, 9, 50, 44, 178, 66, 74, 198, 248, 129, 216, 28, 133,
+			195, 71, 196, 138, 101, 54, 249, 71, 187, 109, 242, 47, 70, 50,
+			152, 77, 254, 24, 218, 18, 54, 249, 250, 156, 58, 63, 105, 88,
+			68, 70, 96, 23, 241, 135, 29, 59, 178, 51, 111, 65, 60, 141,
+			117, 226, 182, 108, 91, 90, 247, 194, 48, 199, 17, 231, 174, 145,
+			88, 197, 92, 13, 61, 30, 168, 146, 129, 20, 4, 78, 216, 94,
+			20, 76, 3, 179, 214, 87, 83, 50, 165, 96, 109, 14, 73, 91,
+			84, 102, 173, 127, 40, 176, 228, 79, 98, 109, 62, 113, 89, 140,
+			64, 227, 67, 154, 79, 230, 208, 79, 105, 50, 58, 199, 139, 234,
+			243, 198, 15, 105, 228, 225, 6, 21, 119, 85, 114, 15, 5, 168,
+			195, 61, 152, 111, 128, 194, 98, 91, 158, 235, 97, 175, 130, 105,
+			18, 241, 82, 194, 123, 167, 153, 185, 113, 114, 243, 230, 185, 139,
+			65, 27, 130, 195, 179, 247, 217, 35, 181, 87, 61, 25, 127, 53,
+			12, 185, 194, 8, 146, 189, 40, 204, 120, 175, 220, 246, 122, 196,
+			12, 66, 8, 34, 252, 118, 125, 32, 103, 238, 68, 0, 23, 225,
+			79, 179, 194, 48, 154, 245, 37, 63, 122, 34, 202, 47, 196, 36,
+			10, 26, 129, 200, 67, 166, 85, 207, 121, 143, 172, 102, 174, 226,
+			154, 222, 70, 54, 12, 170, 177, 97, 186, 112, 54, 130, 229, 13,
+			42, 126, 104, 128, 18, 179, 194, 156, 243, 164, 162, 54, 144, 118,
+			131, 38, 29, 155, 89, 236, 194, 132, 182, 187, 57, 116, 96, 155,
+			47, 231, 166, 233, 90, 30, 24, 201, 138, 73, 212, 227, 48, 75,
+			50, 216, 10, 248, 29, 188, 216, 55, 36, 83, 26, 214, 94, 196,
+			210, 20, 85, 79, 98, 237, 197, 67, 207, 181, 251, 29, 188, 120,
+			228, 14, 179, 248, 137, 169, 113, 136, 50, 49, 107, 204, 145, 121,
+			19, 98, 121, 209, 9, 151, 154, 85, 38, 90, 112, 208, 37, 12,
+			1, 242, 32, 238, 204, 234, 242, 10, 11, 29, 179, 70, 73, 247,
+			121, 39, 128, 49, 206, 194, 77, 200, 16, 56, 224, 77, 240, 242,
+			1, 9, 21, 120, 19, 188, 124, 228, 168, 76, 37, 177, 246, 242,
+			200, 140, 128, 49, 206, 73, 242, 101, 35, 143, 254, 87, 133, 145,
+			100, 2, 107, 166, 122, 215, 248, 21, 133, 220, 111, 53, 76, 59,
+			132, 49, 114, 176, 149, 128, 74, 57, 197, 178, 201, 253, 149, 249,
+			57, 9, 46, 132, 25, 53, 109, 203, 7, 223, 16, 178, 70, 215,
+			33, 220, 171, 11, 47, 218, 50, 79, 25, 65, 79, 226, 26, 72,
+			94, 148, 177, 208, 88, 162, 89, 161, 86, 234, 12, 84, 225, 128,
+			76, 238, 53, 204, 122, 93, 222, 233, 94, 189, 116, 243, 58, 89,
+			219, 134, 104, 167, 112, 65, 179, 186, 114, 119, 226, 70, 128, 18,
+			176, 17, 55, 3, 206, 3, 54, 226, 102, 74, 174, 203, 132, 134,
+			53, 115, 68, 218, 92, 39, 146, 88, 51, 141, 66, 187, 141, 184,
+			121, 98, 134, 135, 27, 83, 251, 176, 94, 85, 233, 164, 1, 113,
+			154, 41, 105, 58, 150, 13, 193, 92, 153, 0, 28, 186, 141, 132,
+			4, 207, 84, 90, 156, 142, 133, 212, 26, 128, 212, 7, 81, 45,
+			2, 15, 22, 48, 245, 174, 158, 144, 102, 235, 96, 234, 93, 13,
+			204, 214, 251, 146, 88, 163, 1, 227, 232, 227, 32, 209, 100, 78,
+			80, 82, 18, 235, 150, 250, 250, 164, 241, 2, 145, 42, 177, 46,
+			218, 9, 57, 70, 100, 90, 162, 152, 172, 177, 91, 39, 129, 201,
+			75, 1, 140, 201, 4, 214, 44, 85, 26, 252, 130, 140, 100, 29,
+			127, 74, 166, 52, 172, 89, 79, 75, 151, 170, 100, 18, 107, 175,
+			39, 46, 183, 155, 90, 191, 158, 204, 161, 134, 176, 180, 214, 109,
+			213, 153, 52, 202, 68, 106, 152, 196, 177, 169, 93, 202, 5, 30,
+			230, 147, 6, 59, 48, 243, 23, 48, 214, 196, 121, 22, 228, 23,
+			171, 34, 194, 195, 72, 238, 231, 184, 237, 195, 12, 224, 78, 233,
+			88, 179, 3, 163, 250, 84, 2, 107, 118, 224, 80, 5, 70, 204,
+			246, 145, 168, 17, 179, 125, 242, 180, 76, 37, 177, 230, 4, 163,
+			72, 241, 81, 56, 201, 28, 186, 35, 76, 156, 53, 87, 205, 112,
+			151, 52, 55, 188, 246, 140, 68, 51, 19, 98, 186, 8, 246, 68,
+			153, 73, 121, 0, 21, 74, 96, 205, 13, 46, 202, 144, 130, 53,
+			55, 184, 40, 67, 26, 214, 220, 211, 103, 208, 235, 210, 202, 120,
+			83, 221, 154, 52, 94, 37, 160, 46, 148, 211, 41, 78, 56, 89,
+			68, 54, 232, 227, 81, 111, 195, 156, 188, 118, 125, 212, 99, 134,
+			188, 163, 231, 207, 103, 95, 119, 44, 123, 244, 252, 57, 111, 234,
+			156, 247, 170, 125, 158, 225, 247, 209, 56, 217, 4, 242, 148, 53,
+			153, 24, 54, 122, 1, 254, 5, 80, 245, 199, 177, 182, 25, 44,
+			141, 126, 5, 107, 155, 193, 210, 232, 215, 176, 182, 57, 34, 113,
+			213, 159, 196, 218, 86, 128, 157, 126, 142, 157, 173, 100, 14, 125,
+			63, 231, 22, 3, 88, 127, 83, 253, 208, 164, 241, 33, 2, 47,
+			188, 192, 57, 4, 38, 201, 92, 115, 34, 225, 203, 228, 57, 198,
+			23, 65, 151, 248, 169, 113, 173, 85, 35, 129, 90, 48, 139, 200,
+			138, 107, 177, 83, 149, 136, 150, 94, 49, 109, 184, 79, 134, 48,
+			209, 190, 67, 92, 120, 132, 84, 110, 37, 94, 187, 75, 103, 48,
+			170, 129, 4, 214, 222, 12, 112, 61, 160, 96, 237, 205, 0, 215,
+			3, 26, 214, 222, 60, 125, 70, 166, 146, 88, 251, 80, 48, 170,
+			1, 62, 170, 15, 37, 115, 232, 18, 183, 187, 254, 136, 18, 251,
+			148, 162, 24, 25, 34, 53, 154, 109, 171, 43, 12, 150, 19, 177,
+			149, 254, 136, 146, 60, 132, 174, 64, 34, 133, 245, 239, 85, 152,
+			167, 244, 67, 42, 232, 90, 6, 64, 51, 171, 224, 78, 208, 116,
+			224, 76, 197, 98, 0, 242, 0, 202, 186, 174, 164, 98, 80, 171,
+			127, 128, 201, 66, 74, 42, 166, 136, 228, 127, 82, 164, 249, 245,
+			199, 21, 245, 168, 241, 111, 149, 54, 43, 100, 70, 111, 91, 59,
+			17, 166, 112, 179, 146, 23, 216, 96, 163, 74, 153, 17, 167, 227,
+			110, 143, 115, 230, 202, 120, 21, 63, 94, 100, 114, 32, 29, 139,
+			35, 2, 119, 31, 144, 179, 227, 229, 154, 230, 54, 123, 247, 39,
+			87, 113, 92, 42, 83, 101, 97, 22, 82, 134, 181, 93, 110, 249,
+			86, 189, 220, 178, 193, 209, 216, 243, 179, 21, 56, 89, 204, 155,
+			143, 73, 157, 218, 53, 127, 99, 138, 92, 187, 60, 9, 198, 255,
+			247, 23, 87, 231, 102, 3, 181, 213, 154, 89, 121, 228, 213, 77,
+			111, 131, 122, 194, 210, 147, 155, 142, 127, 60, 52, 161, 6, 76,
+			124, 92, 73, 73, 155, 233, 152, 6, 201, 195, 71, 208, 243, 210,
+			50, 252, 147, 138, 58, 108, 76, 146, 69, 155, 78, 240, 248, 116,
+			160, 226, 38, 252, 221, 227, 221, 112, 35, 26, 4, 99, 241, 79,
+			42, 106, 159, 76, 114, 179, 119, 49, 15, 32, 84, 235, 159, 84,
+			134, 210, 232, 49, 183, 45, 254, 52, 55, 200, 127, 189, 7, 181,
+			155, 130, 214, 67, 65, 74, 26, 248, 4, 119, 233, 194, 189, 36,
+			50, 11, 66, 8, 101, 37, 76, 34, 181, 230, 19, 112, 59, 193,
+			212, 121, 17, 203, 225, 79, 3, 129, 181, 164, 229, 240, 103, 21,
+			245, 164, 81, 35, 139, 124, 61, 57, 235, 157, 139, 141, 57, 1,
+			17, 179, 90, 5, 93, 22, 101, 166, 65, 252, 0, 3, 220, 9,
+			188, 83, 97, 110, 46, 95, 23, 11, 114, 156, 219, 154, 72, 111,
+			181, 201, 107, 114, 87, 133, 179, 155, 64, 149, 170, 198, 116, 214,
+			111, 144, 100, 182, 238, 253, 195, 50, 201, 108, 221, 241, 136, 76,
+			50, 91, 247, 227, 39, 208, 152, 180, 49, 254, 28, 216, 24, 159,
+			32, 15, 247, 152, 18, 22, 58, 75, 255, 156, 162, 14, 202, 36,
+			179, 88, 31, 138, 218, 250, 126, 78, 49, 142, 163, 239, 102, 13,
+			171, 96, 253, 173, 30, 55, 154, 36, 184, 79, 144, 18, 43, 112,
+			24, 176, 122, 121, 20, 16, 194, 90, 171, 230, 117, 108, 66, 161,
+			162, 7, 240, 93, 149, 199, 130, 204, 116, 221, 178, 31, 61, 59,
+			103, 110, 59, 45, 63, 147, 109, 167, 229, 201, 107, 215, 3, 96,
+			193, 226, 255, 243, 146, 92, 85, 102, 76, 253, 121, 37, 117, 68,
+			38, 153, 113, 250, 49, 3, 221, 100, 198, 14, 137, 159, 80, 98,
+			191, 170, 40, 198, 197, 221, 36, 192, 182, 99, 24, 39, 128, 56,
+			172, 130, 159, 80, 226, 67, 232, 35, 138, 48, 57, 208, 127, 74,
+			81, 143, 25, 174, 176, 121, 96, 110, 191, 161, 66, 139, 43, 45,
+			23, 28, 198, 59, 35, 199, 79, 33, 40, 71, 218, 247, 110, 49,
+			3, 60, 240, 3, 132, 3, 96, 91, 104, 1, 134, 41, 211, 134,
+			112, 161, 96, 196, 45, 253, 241, 14, 72, 59, 7, 0, 225, 144,
+			76, 170, 88, 255, 41, 229, 232, 8, 186, 32, 44, 29, 244, 159,
+			86, 212, 126, 227, 120, 40, 185, 195, 102, 205, 244, 127, 77, 211,
+			243, 228, 108, 67, 81, 94, 54, 33, 147, 42, 36, 83, 72, 190,
+			58, 161, 98, 253, 31, 66, 75, 159, 81, 122, 52, 5, 27, 2,
+			52, 69, 150, 91, 181, 26, 83, 168, 4, 14, 72, 96, 173, 33,
+			181, 172, 130, 206, 44, 155, 69, 231, 175, 248, 226, 37, 3, 150,
+			201, 156, 183, 154, 142, 231, 89, 107, 117, 138, 2, 123, 255, 238,
+			74, 176, 132, 44, 150, 101, 18, 184, 189, 16, 78, 31, 240, 16,
+			131, 194, 96, 148, 35, 128, 195, 245, 63, 84, 82, 8, 253, 99,
+			249, 48, 131, 254, 79, 20, 117, 192, 248, 137, 94, 35, 96, 231,
+			27, 90, 37, 213, 22, 147, 141, 3, 57, 71, 56, 129, 58, 45,
+			159, 199, 26, 0, 6, 14, 113, 72, 192, 229, 127, 147, 78, 245,
+			28, 34, 223, 107, 28, 87, 108, 58, 64, 14, 107, 242, 73, 2,
+			57, 104, 57, 180, 139, 12, 63, 209, 241, 5, 195, 209, 20, 6,
+			112, 159, 76, 170, 144, 68, 253, 168, 38, 158, 82, 208, 127, 17,
+			70, 243, 82, 143, 193, 8, 201, 151, 247, 4, 20, 105, 174, 49,
+			73, 69, 158, 1, 96, 219, 244, 32, 246, 31, 243, 142, 23, 138,
+			19, 151, 154, 158, 99, 79, 49, 113, 218, 105, 133, 96, 64, 0,
+			201, 95, 12, 193, 128, 24, 135, 191, 168, 160, 126, 244, 69, 149,
+			193, 17, 199, 250, 191, 0, 186, 248, 172, 218, 1, 72, 213, 130,
+			240, 9, 190, 16, 25, 105, 54, 216, 211, 184, 102, 10, 10, 7,
+			88, 150, 140, 179, 226, 212, 197, 155, 106, 109, 135, 110, 111, 156,
+			120, 45, 224, 139, 82, 55, 130, 2, 91, 183, 241, 182, 241, 53,
+			93, 218, 48, 193, 75, 184, 190, 205, 100, 155, 182, 14, 60, 167,
+			17, 129, 207, 131, 138, 208, 14, 28, 111, 155, 140, 120, 39, 122,
+			29, 111, 193, 103, 77, 138, 246, 193, 162, 238, 32, 79, 54, 94,
+			33, 218, 183, 53, 41, 112, 22, 87, 24, 146, 36, 97, 198, 85,
+			72, 166, 16, 250, 223, 193, 83, 70, 195, 137, 95, 83, 98, 223,
+			80, 20, 227, 215, 21, 82, 140, 56, 19, 137, 198, 189, 214, 26,
+			196, 209, 18, 62, 155, 38, 153, 153, 187, 0, 100, 104, 174, 121,
+			78, 61, 216, 213, 214, 234, 102, 131, 34, 193, 40, 122, 104, 2,
+			66, 118, 22, 10, 178, 109, 174, 223, 65, 119, 226, 59, 147, 241,
+			60, 30, 116, 152, 137, 37, 128, 78, 248, 1, 2, 37, 104, 53,
+			60, 71, 172, 66, 219, 241, 17, 153, 153, 59, 15, 92, 32, 96,
+			148, 58, 16, 239, 175, 41, 201, 163, 232, 247, 84, 17, 254, 48,
+			241, 21, 69, 253, 87, 138, 110, 252, 230, 126, 20, 239, 145, 171,
+			94, 177, 145, 194, 185, 178, 83, 253, 30, 13, 165, 217, 161, 130,
+			127, 82, 13, 124, 164, 99, 47, 247, 102, 225, 253, 139, 11, 133,
+			82, 80, 51, 139, 118, 85, 194, 75, 212, 126, 135, 53, 242, 176,
+			195, 105, 76, 92, 251, 138, 220, 255, 88, 124, 72, 253, 43, 74,
+			234, 128, 76, 106, 88, 255, 138, 146, 30, 70, 67, 188, 112, 18,
+			39, 190, 162, 224, 127, 165, 104, 204, 231, 77, 19, 106, 121, 253,
+			55, 21, 125, 34, 146, 163, 96, 253, 127, 83, 244, 139, 232, 134,
+			8, 43, 169, 255, 182, 162, 30, 54, 198, 164, 94, 94, 40, 44,
+			93, 30, 201, 40, 188, 15, 145, 8, 8, 96, 3, 217, 238, 183,
+			67, 216, 192, 17, 240, 183, 165, 35, 32, 11, 35, 169, 255, 182,
+			114, 240, 16, 250, 15, 32, 99, 107, 192, 182, 191, 170, 168, 71,
+			140, 127, 173, 116, 234, 19, 163, 241, 160, 69, 18, 186, 226, 253,
+			139, 139, 118, 136, 89, 12, 204, 102, 213, 174, 91, 143, 218, 160,
+			162, 143, 125, 215, 44, 139, 250, 101, 112, 248, 247, 196, 35, 71,
+			142, 77, 3, 29, 7, 220, 202, 213, 235, 145, 64, 156, 168, 163,
+			103, 17, 203, 35, 32, 126, 56, 60, 173, 129, 220, 196, 197, 247,
+			240, 46, 61, 11, 98, 112, 185, 141, 50, 36, 66, 212, 4, 27,
+			99, 74, 38, 21, 72, 162, 97, 153, 212, 32, 121, 232, 48, 112,
+			122, 93, 83, 53, 156, 248, 29, 69, 253, 61, 69, 55, 30, 182,
+			105, 137, 237, 182, 232, 168, 251, 81, 19, 23, 25, 204, 76, 206,
+			112, 225, 221, 203, 32, 152, 49, 239, 87, 139, 99, 253, 119, 194,
+			105, 130, 37, 252, 59, 74, 234, 168, 76, 106, 144, 52, 142, 11,
+			18, 210, 146, 0, 214, 137, 223, 11, 72, 136, 43, 85, 245, 127,
+			31, 146, 144, 198, 73, 232, 119, 129, 132, 108, 54, 20, 29, 235,
+			127, 168, 168, 15, 140, 15, 128, 155, 178, 231, 48, 69, 240, 26,
+			221, 176, 196, 157, 99, 100, 60, 239, 86, 151, 197, 97, 214, 227,
+			172, 67, 57, 34, 216, 202, 254, 80, 73, 29, 147, 73, 13, 146,
+			39, 78, 202, 100, 18, 146, 167, 138, 2, 122, 174, 53, 212, 255,
+			80, 33, 247, 152, 102, 64, 3, 9, 243, 235, 138, 122, 247, 219,
+			172, 25, 224, 192, 196, 121, 103, 18, 114, 216, 65, 190, 174, 164,
+			14, 203, 164, 6, 201, 17, 57, 144, 120, 18, 146, 70, 65, 64,
+			206, 117, 137, 250, 215, 149, 19, 51, 168, 132, 84, 93, 199, 137,
+			255, 172, 196, 190, 95, 85, 140, 89, 18, 88, 181, 68, 36, 92,
+			1, 199, 184, 8, 129, 38, 93, 41, 1, 161, 34, 156, 36, 47,
+			204, 252, 184, 5, 127, 7, 84, 254, 103, 37, 121, 24, 93, 66,
+			186, 174, 131, 28, 252, 77, 224, 13, 25, 201, 27, 246, 224, 9,
+			58, 227, 87, 223, 148, 3, 212, 25, 191, 250, 166, 228, 9, 58,
+			59, 181, 124, 19, 120, 66, 145, 53, 175, 96, 253, 91, 192, 18,
+			110, 189, 11, 142, 32, 90, 134, 67, 205, 183, 228, 210, 211, 153,
+			212, 251, 45, 185, 244, 116, 198, 139, 190, 165, 28, 58, 140, 242,
+			172, 95, 21, 235, 111, 43, 170, 97, 92, 233, 53, 227, 93, 67,
+			20, 249, 204, 188, 40, 232, 15, 168, 230, 237, 112, 156, 192, 69,
+			223, 150, 19, 169, 179, 165, 254, 182, 50, 114, 12, 125, 12, 182,
+			73, 29, 146, 127, 14, 250, 133, 63, 83, 34, 51, 229, 193, 97,
+			31, 72, 252, 149, 75, 227, 228, 242, 107, 227, 226, 16, 117, 137,
+			249, 241, 112, 30, 4, 130, 240, 54, 132, 243, 55, 235, 117, 208,
+			228, 242, 149, 238, 111, 4, 7, 112, 103, 93, 148, 137, 108, 140,
+			76, 68, 2, 179, 30, 111, 156, 84, 45, 254, 208, 216, 218, 118,
+			123, 45, 68, 156, 38, 184, 22, 131, 66, 1, 184, 142, 48, 184,
+			104, 200, 136, 70, 109, 61, 152, 182, 89, 223, 126, 163, 35, 74,
+			87, 179, 222, 98, 144, 160, 72, 65, 198, 124, 97, 254, 224, 158,
+			148, 137, 104, 155, 20, 194, 14, 75, 237, 131, 206, 120, 209, 159,
+			11, 169, 83, 215, 225, 110, 71, 255, 115, 37, 137, 101, 146, 225,
+			233, 240, 17, 244, 26, 195, 154, 142, 245, 191, 132, 115, 248, 34,
+			89, 216, 29, 22, 198, 161, 225, 242, 86, 72, 104, 242, 240, 21,
+			165, 29, 36, 231, 55, 128, 5, 184, 200, 95, 134, 176, 0, 233,
+			255, 165, 146, 28, 145, 73, 13, 146, 199, 79, 128, 223, 51, 0,
+			19, 199, 250, 91, 170, 250, 20, 248, 61, 135, 208, 68, 129, 16,
+			79, 65, 108, 210, 158, 79, 58, 116, 72, 110, 18, 52, 212, 177,
+			84, 133, 48, 40, 52, 113, 226, 242, 87, 152, 248, 128, 176, 92,
+			161, 158, 183, 222, 130, 64, 59, 190, 43, 133, 4, 157, 113, 149,
+			183, 212, 96, 36, 192, 85, 222, 82, 147, 167, 100, 82, 131, 228,
+			153, 12, 250, 41, 78, 140, 9, 172, 127, 66, 85, 143, 26, 127,
+			95, 141, 140, 4, 142, 7, 145, 227, 93, 20, 42, 178, 5, 135,
+			126, 38, 143, 130, 104, 210, 245, 36, 2, 115, 78, 103, 95, 186,
+			4, 84, 166, 24, 217, 180, 42, 148, 108, 82, 215, 51, 199, 131,
+			248, 60, 81, 2, 137, 32, 145, 209, 120, 4, 167, 112, 155, 197,
+			2, 217, 112, 20, 116, 120, 74, 179, 103, 10, 121, 144, 57, 160,
+			93, 142, 124, 199, 166, 108, 5, 163, 14, 247, 244, 44, 175, 204,
+			94, 180, 4, 167, 107, 208, 206, 67, 27, 48, 112, 126, 21, 188,
+			101, 121, 33, 63, 73, 196, 25, 150, 36, 74, 19, 10, 36, 3,
+			66, 77, 104, 144, 60, 124, 36, 176, 215, 254, 97, 138, 174, 237,
+			211, 2, 218, 149, 177, 121, 158, 216, 88, 123, 55, 207, 133, 238,
+			71, 168, 67, 235, 251, 119, 233, 80, 109, 60, 177, 69, 122, 230,
+			11, 10, 58, 58, 227, 210, 182, 200, 93, 194, 175, 23, 143, 162,
+			3, 97, 79, 129, 49, 166, 112, 161, 14, 191, 20, 171, 248, 54,
+			66, 97, 90, 132, 210, 237, 97, 140, 28, 233, 34, 82, 30, 159,
+			68, 72, 42, 84, 165, 145, 120, 41, 37, 114, 138, 213, 204, 223,
+			85, 208, 201, 105, 136, 207, 223, 9, 167, 39, 1, 45, 160, 164,
+			40, 238, 137, 199, 213, 47, 116, 119, 190, 195, 40, 75, 65, 213,
+			14, 56, 212, 30, 112, 156, 218, 9, 14, 112, 167, 241, 40, 126,
+			22, 245, 135, 227, 146, 176, 236, 142, 136, 104, 5, 48, 26, 110,
+			177, 48, 106, 101, 223, 121, 68, 217, 3, 103, 240, 218, 252, 0,
+			207, 92, 97, 121, 153, 31, 81, 208, 209, 206, 96, 107, 18, 19,
+			249, 182, 137, 80, 246, 158, 8, 62, 155, 209, 217, 184, 133, 250,
+			5, 12, 64, 153, 35, 234, 14, 246, 236, 129, 239, 73, 9, 241,
+			226, 243, 166, 247, 40, 115, 21, 29, 19, 193, 239, 122, 0, 119,
+			180, 205, 178, 153, 117, 204, 50, 50, 63, 175, 32, 34, 71, 212,
+			21, 81, 92, 214, 14, 2, 146, 195, 187, 252, 29, 131, 20, 173,
+			137, 128, 228, 150, 93, 11, 235, 227, 243, 104, 200, 172, 182, 199,
+			48, 87, 153, 149, 247, 160, 89, 109, 139, 92, 62, 129, 176, 203,
+			252, 118, 219, 202, 106, 172, 236, 48, 255, 18, 41, 158, 249, 201,
+			96, 229, 132, 18, 137, 132, 245, 41, 132, 122, 67, 24, 69, 244,
+			52, 234, 143, 172, 199, 157, 87, 77, 216, 188, 104, 35, 140, 176,
+			189, 215, 210, 249, 217, 246, 165, 19, 182, 228, 189, 35, 72, 163,
+			235, 75, 221, 125, 125, 117, 225, 98, 199, 245, 213, 5, 172, 137,
+			78, 237, 4, 171, 88, 94, 207, 117, 196, 39, 223, 113, 125, 69,
+			160, 136, 198, 35, 207, 252, 146, 130, 78, 132, 205, 71, 180, 25,
+			239, 8, 29, 239, 67, 105, 104, 52, 120, 129, 50, 228, 121, 103,
+			122, 131, 18, 233, 136, 35, 119, 200, 111, 207, 221, 11, 53, 191,
+			168, 160, 76, 7, 110, 34, 181, 223, 217, 100, 62, 232, 154, 204,
+			108, 55, 212, 187, 33, 105, 255, 51, 218, 66, 79, 237, 10, 181,
+			152, 214, 5, 52, 220, 137, 79, 57, 183, 123, 35, 180, 148, 238,
+			192, 165, 55, 249, 173, 62, 148, 148, 113, 246, 240, 107, 40, 221,
+			201, 175, 241, 254, 119, 135, 189, 98, 91, 224, 15, 163, 35, 145,
+			33, 134, 159, 60, 156, 235, 174, 217, 187, 164, 236, 234, 210, 254,
+			43, 112, 196, 101, 98, 48, 186, 206, 173, 160, 215, 232, 58, 203,
+			236, 119, 116, 38, 194, 221, 236, 28, 95, 236, 174, 181, 35, 211,
+			223, 179, 11, 11, 29, 219, 145, 245, 227, 201, 157, 135, 178, 211,
+			62, 97, 28, 233, 218, 170, 152, 3, 40, 71, 86, 72, 137, 130,
+			129, 238, 72, 10, 17, 22, 178, 243, 72, 194, 66, 93, 164, 16,
+			126, 218, 139, 20, 34, 37, 247, 71, 10, 109, 21, 2, 82, 168,
+			163, 195, 225, 231, 200, 122, 192, 239, 112, 121, 27, 123, 175, 185,
+			76, 12, 127, 74, 65, 199, 59, 64, 138, 20, 240, 240, 213, 61,
+			71, 16, 45, 46, 187, 190, 246, 14, 107, 201, 193, 63, 137, 31,
+			203, 159, 206, 113, 63, 150, 79, 188, 231, 199, 242, 158, 31, 203,
+			119, 194, 143, 229, 92, 232, 199, 50, 22, 250, 177, 228, 217, 79,
+			29, 107, 71, 98, 211, 194, 165, 101, 36, 12, 66, 3, 63, 255,
+			163, 202, 131, 208, 100, 98, 239, 83, 140, 175, 170, 209, 32, 52,
+			252, 196, 26, 189, 54, 7, 133, 14, 233, 220, 49, 194, 215, 3,
+			69, 52, 97, 184, 214, 147, 238, 152, 44, 88, 119, 134, 203, 240,
+			19, 236, 216, 1, 183, 254, 69, 63, 208, 2, 243, 107, 107, 232,
+			14, 2, 9, 179, 3, 57, 105, 136, 135, 27, 192, 17, 35, 114,
+			89, 24, 174, 83, 161, 161, 67, 100, 81, 30, 224, 133, 221, 36,
+			91, 232, 60, 192, 52, 232, 82, 68, 236, 232, 133, 252, 234, 202,
+			253, 194, 194, 74, 113, 38, 191, 82, 152, 21, 207, 224, 139, 184,
+			3, 172, 15, 174, 0, 97, 221, 210, 142, 88, 52, 60, 20, 2,
+			123, 248, 178, 48, 219, 22, 53, 43, 210, 14, 104, 36, 152, 78,
+			44, 82, 177, 35, 146, 183, 12, 131, 147, 73, 166, 209, 159, 170,
+			50, 12, 78, 86, 189, 103, 252, 136, 42, 80, 10, 120, 131, 128,
+			255, 97, 35, 226, 186, 90, 142, 75, 94, 88, 122, 157, 97, 37,
+			44, 30, 99, 216, 242, 189, 32, 90, 166, 52, 0, 13, 38, 7,
+			2, 124, 82, 55, 156, 24, 17, 248, 206, 235, 152, 157, 112, 89,
+			130, 110, 112, 189, 5, 215, 175, 136, 240, 34, 222, 184, 152, 98,
+			203, 174, 117, 6, 183, 4, 7, 140, 8, 68, 1, 75, 225, 166,
+			238, 197, 89, 98, 214, 193, 228, 21, 30, 122, 132, 184, 165, 227,
+			65, 204, 227, 252, 92, 169, 144, 159, 125, 169, 92, 120, 127, 113,
+			121, 101, 25, 181, 205, 14, 15, 120, 30, 217, 134, 223, 77, 188,
+			243, 59, 209, 80, 61, 217, 196, 145, 72, 168, 158, 236, 209, 172,
+			76, 105, 88, 203, 222, 156, 65, 159, 230, 83, 164, 96, 253, 154,
+			122, 253, 178, 241, 189, 225, 28, 5, 126, 86, 225, 104, 133, 25,
+			152, 240, 203, 130, 24, 27, 251, 70, 62, 188, 159, 88, 247, 173,
+			9, 102, 25, 82, 237, 152, 11, 212, 107, 50, 246, 156, 11, 214,
+			51, 155, 76, 79, 134, 90, 71, 193, 85, 82, 24, 15, 144, 41,
+			163, 224, 14, 62, 178, 7, 134, 152, 22, 123, 102, 86, 144, 94,
+			24, 231, 8, 188, 35, 174, 37, 78, 200, 20, 188, 125, 122, 114,
+			74, 166, 52, 172, 93, 31, 154, 128, 80, 40, 9, 48, 49, 209,
+			110, 170, 247, 140, 156, 56, 124, 139, 43, 46, 139, 69, 108, 97,
+			46, 55, 2, 234, 54, 165, 110, 208, 15, 184, 52, 220, 12, 38,
+			137, 53, 22, 76, 146, 170, 97, 237, 230, 205, 25, 116, 143, 245,
+			3, 113, 81, 212, 23, 192, 140, 56, 136, 199, 239, 69, 72, 47,
+			108, 91, 198, 85, 4, 155, 32, 26, 70, 235, 15, 186, 4, 70,
+			122, 59, 49, 34, 83, 42, 214, 110, 31, 155, 148, 41, 232, 229,
+			206, 61, 52, 205, 186, 212, 177, 254, 156, 250, 252, 168, 113, 53,
+			24, 91, 228, 217, 8, 152, 42, 115, 239, 1, 130, 193, 251, 115,
+			137, 211, 50, 165, 98, 237, 57, 242, 188, 76, 105, 88, 123, 126,
+			232, 44, 250, 12, 143, 151, 197, 124, 61, 238, 25, 215, 73, 30,
+			226, 242, 86, 59, 93, 74, 124, 103, 215, 254, 248, 34, 90, 137,
+			112, 241, 119, 253, 106, 64, 130, 219, 194, 23, 130, 249, 137, 171,
+			88, 43, 4, 243, 3, 150, 241, 133, 155, 51, 96, 84, 157, 0,
+			203, 119, 253, 158, 122, 255, 178, 241, 2, 201, 251, 78, 67, 24,
+			241, 153, 193, 72, 214, 128, 252, 186, 28, 42, 246, 24, 147, 232,
+			8, 172, 207, 239, 5, 196, 152, 80, 177, 118, 47, 32, 70, 176,
+			69, 191, 63, 52, 129, 138, 12, 136, 62, 172, 191, 160, 206, 101,
+			140, 91, 157, 56, 140, 28, 11, 247, 66, 164, 104, 24, 236, 203,
+			95, 72, 28, 151, 41, 21, 107, 47, 156, 184, 33, 83, 26, 214,
+			230, 134, 78, 161, 50, 235, 52, 137, 245, 69, 117, 233, 186, 241,
+			190, 253, 140, 60, 122, 62, 237, 1, 9, 234, 5, 10, 152, 145,
+			47, 38, 206, 200, 148, 138, 181, 197, 204, 172, 76, 105, 88, 91,
+			26, 154, 68, 223, 39, 30, 119, 127, 49, 70, 21, 227, 194, 14,
+			81, 199, 58, 151, 127, 22, 77, 46, 254, 85, 178, 222, 59, 119,
+			66, 239, 205, 23, 147, 167, 209, 31, 5, 47, 181, 191, 170, 222,
+			53, 126, 71, 137, 116, 212, 102, 137, 192, 223, 132, 241, 34, 94,
+			119, 237, 59, 95, 22, 52, 124, 210, 247, 203, 219, 246, 224, 234,
+			149, 204, 231, 95, 146, 177, 159, 186, 111, 139, 172, 10, 133, 247,
+			38, 152, 149, 41, 200, 142, 197, 217, 80, 194, 64, 36, 195, 156,
+			148, 38, 162, 241, 187, 217, 155, 229, 92, 46, 145, 207, 47, 51,
+			217, 5, 172, 168, 239, 173, 22, 103, 129, 53, 182, 121, 108, 189,
+			38, 220, 182, 202, 175, 78, 188, 54, 118, 54, 48, 163, 6, 31,
+			203, 87, 3, 227, 112, 64, 196, 171, 226, 209, 89, 190, 235, 188,
+			122, 76, 26, 135, 199, 146, 88, 123, 245, 120, 161, 221, 197, 242,
+			213, 147, 51, 96, 4, 8, 94, 103, 88, 43, 51, 147, 207, 8,
+			206, 152, 168, 229, 59, 97, 148, 52, 225, 174, 150, 128, 178, 210,
+			125, 5, 88, 119, 57, 120, 193, 25, 158, 144, 41, 27, 199, 101,
+			204, 113, 21, 92, 40, 70, 140, 255, 9, 94, 230, 234, 246, 206,
+			12, 140, 59, 5, 241, 100, 33, 90, 158, 239, 90, 210, 179, 232,
+			202, 117, 146, 95, 158, 41, 22, 67, 207, 73, 48, 134, 205, 19,
+			215, 180, 171, 78, 131, 172, 174, 114, 187, 26, 144, 40, 27, 13,
+			184, 230, 175, 74, 79, 62, 73, 141, 242, 153, 5, 171, 10, 33,
+			12, 65, 132, 1, 161, 202, 36, 31, 16, 5, 202, 86, 245, 3,
+			208, 68, 211, 117, 216, 213, 102, 48, 70, 112, 255, 169, 6, 120,
+			5, 236, 84, 131, 24, 246, 176, 81, 84, 143, 28, 69, 83, 204,
+			254, 92, 223, 136, 249, 138, 145, 221, 97, 9, 244, 220, 6, 189,
+			240, 49, 228, 141, 228, 57, 180, 45, 172, 198, 181, 134, 122, 201,
+			168, 203, 102, 188, 87, 172, 215, 228, 70, 9, 134, 66, 82, 180,
+			149, 39, 6, 80, 16, 16, 199, 13, 189, 68, 34, 101, 193, 14,
+			170, 195, 203, 153, 172, 54, 161, 208, 181, 75, 151, 130, 14, 196,
+			96, 21, 176, 21, 214, 26, 194, 27, 67, 129, 0, 208, 90, 163,
+			63, 35, 83, 10, 214, 26, 79, 93, 148, 41, 13, 107, 141, 108,
+			14, 253, 23, 69, 88, 116, 107, 158, 58, 98, 252, 254, 223, 148,
+			233, 13, 45, 210, 16, 60, 22, 226, 216, 181, 250, 118, 180, 125,
+			128, 170, 233, 52, 225, 113, 145, 224, 120, 203, 237, 104, 197, 51,
+			206, 113, 172, 121, 130, 42, 88, 172, 114, 205, 19, 84, 161, 48,
+			49, 197, 59, 114, 148, 133, 99, 84, 113, 252, 113, 236, 163, 138,
+			98, 92, 37, 249, 80, 92, 219, 155, 46, 34, 225, 24, 129, 228,
+			30, 39, 159, 70, 79, 9, 35, 114, 237, 13, 245, 105, 227, 72,
+			27, 235, 12, 222, 87, 18, 47, 21, 195, 52, 190, 33, 166, 81,
+			101, 211, 248, 134, 112, 170, 97, 6, 223, 218, 27, 71, 206, 200,
+			148, 134, 181, 55, 206, 158, 67, 95, 82, 165, 189, 247, 91, 16,
+			220, 250, 159, 170, 96, 153, 207, 165, 60, 56, 73, 114, 67, 115,
+			249, 42, 69, 132, 63, 194, 42, 163, 16, 114, 194, 13, 142, 90,
+			66, 8, 12, 6, 107, 217, 158, 79, 205, 42, 48, 87, 211, 11,
+			68, 212, 241, 192, 39, 151, 61, 17, 143, 200, 154, 120, 196, 218,
+			178, 161, 27, 113, 226, 138, 186, 151, 5, 13, 114, 97, 23, 124,
+			148, 183, 29, 240, 245, 20, 119, 217, 236, 124, 43, 206, 186, 188,
+			229, 192, 241, 132, 217, 118, 69, 159, 187, 187, 126, 245, 234, 205,
+			235, 136, 180, 93, 87, 189, 98, 189, 198, 141, 110, 161, 155, 170,
+			136, 165, 29, 224, 24, 214, 32, 34, 99, 179, 14, 83, 122, 212,
+			29, 120, 65, 26, 6, 206, 4, 107, 111, 76, 92, 240, 170, 170,
+			162, 99, 253, 173, 208, 218, 30, 108, 217, 222, 10, 173, 237, 193,
+			126, 228, 45, 5, 203, 144, 222, 96, 63, 242, 150, 146, 121, 10,
+			221, 226, 150, 157, 31, 83, 98, 63, 160, 40, 198, 196, 14, 44,
+			68, 106, 15, 59, 98, 118, 74, 3, 202, 143, 41, 201, 211, 232,
+			156, 176, 159, 212, 63, 161, 168, 247, 140, 163, 209, 141, 207, 119,
+			196, 128, 5, 176, 236, 145, 104, 40, 55, 32, 147, 112, 27, 173,
+			28, 144, 38, 92, 96, 85, 243, 9, 48, 217, 23, 201, 36, 36,
+			79, 220, 109, 183, 1, 252, 132, 114, 106, 22, 93, 150, 22, 127,
+			159, 82, 212, 113, 227, 41, 160, 9, 254, 100, 59, 216, 145, 192,
+			85, 155, 39, 45, 218, 155, 213, 54, 27, 50, 48, 175, 249, 148,
+			162, 202, 46, 0, 61, 159, 82, 78, 156, 151, 73, 13, 146, 99,
+			23, 209, 29, 110, 150, 244, 105, 37, 246, 89, 69, 49, 114, 59,
+			160, 167, 91, 141, 27, 65, 16, 240, 231, 79, 43, 201, 51, 232,
+			138, 180, 64, 250, 140, 162, 222, 49, 206, 181, 57, 230, 132, 243,
+			13, 240, 74, 217, 72, 64, 203, 141, 144, 62, 211, 110, 132, 244,
+			25, 105, 52, 201, 141, 144, 62, 3, 70, 147, 34, 153, 132, 36,
+			190, 205, 208, 165, 75, 116, 125, 70, 57, 52, 133, 102, 145, 170,
+			199, 113, 226, 115, 74, 236, 103, 20, 197, 184, 190, 195, 112, 118,
+			212, 21, 71, 70, 5, 38, 25, 159, 83, 146, 163, 220, 11, 52,
+			14, 195, 250, 188, 162, 206, 27, 255, 92, 217, 101, 92, 102, 181,
+			154, 227, 55, 130, 209, 215, 232, 124, 39, 7, 182, 24, 227, 8,
+			172, 28, 72, 177, 75, 4, 106, 179, 255, 141, 190, 191, 178, 182,
+			219, 35, 80, 209, 135, 7, 132, 250, 134, 133, 24, 137, 134, 167,
+			140, 148, 23, 168, 142, 171, 177, 168, 127, 70, 28, 152, 150, 254,
+			121, 37, 117, 82, 38, 153, 127, 6, 57, 35, 147, 73, 72, 102,
+			230, 24, 170, 227, 18, 213, 159, 87, 206, 62, 64, 255, 136, 227,
+			133, 133, 240, 87, 159, 54, 126, 92, 9, 159, 226, 106, 71, 140,
+			88, 239, 12, 194, 241, 29, 80, 80, 92, 143, 132, 25, 245, 218,
+			107, 131, 73, 137, 84, 65, 136, 102, 170, 34, 186, 131, 56, 36,
+			19, 207, 170, 179, 40, 250, 136, 88, 53, 219, 145, 167, 238, 96,
+			199, 170, 72, 195, 155, 56, 99, 34, 95, 144, 76, 36, 206, 152,
+			200, 23, 36, 19, 137, 195, 110, 163, 127, 65, 193, 114, 244, 138,
+			6, 95, 207, 158, 67, 191, 206, 199, 202, 60, 49, 212, 11, 198,
+			63, 235, 24, 107, 211, 165, 155, 150, 211, 242, 118, 122, 31, 145,
+			153, 86, 3, 85, 8, 59, 151, 119, 62, 126, 224, 140, 65, 211,
+			2, 25, 79, 140, 3, 112, 89, 248, 233, 16, 7, 96, 24, 247,
+			211, 33, 14, 128, 217, 252, 180, 130, 207, 202, 164, 6, 201, 243,
+			163, 140, 145, 38, 112, 226, 103, 149, 216, 191, 220, 133, 145, 118,
+			106, 250, 34, 43, 10, 44, 114, 126, 22, 24, 233, 12, 210, 245,
+			4, 44, 168, 159, 83, 212, 25, 227, 90, 219, 122, 18, 97, 106,
+			195, 241, 239, 64, 49, 12, 186, 4, 35, 230, 159, 147, 196, 156,
+			96, 196, 252, 115, 74, 234, 160, 76, 106, 208, 199, 145, 163, 50,
+			153, 132, 228, 200, 52, 35, 230, 132, 36, 230, 159, 83, 140, 231,
+			209, 247, 195, 38, 157, 128, 161, 255, 130, 162, 222, 55, 254, 123,
+			196, 51, 37, 60, 170, 195, 238, 233, 195, 108, 173, 136, 176, 36,
+			225, 99, 111, 44, 229, 134, 150, 244, 85, 90, 109, 53, 3, 245,
+			31, 104, 223, 178, 80, 209, 10, 76, 61, 69, 181, 64, 217, 22,
+			70, 249, 233, 110, 183, 77, 253, 38, 228, 206, 40, 137, 48, 66,
+			144, 162, 1, 19, 218, 60, 56, 23, 85, 40, 4, 226, 49, 61,
+			102, 56, 40, 224, 146, 170, 59, 232, 23, 117, 40, 240, 184, 254,
+			46, 64, 45, 108, 32, 191, 32, 119, 176, 4, 91, 26, 191, 160,
+			28, 24, 145, 73, 13, 190, 30, 63, 33, 147, 73, 72, 158, 188,
+			39, 80, 203, 131, 235, 234, 191, 160, 156, 46, 160, 255, 166, 51,
+			212, 170, 88, 255, 146, 162, 142, 24, 127, 164, 255, 173, 151, 99,
+			73, 177, 209, 172, 131, 48, 67, 167, 34, 31, 130, 183, 104, 69,
+			204, 232, 150, 12, 112, 220, 52, 109, 155, 186, 226, 241, 204, 96,
+			218, 199, 121, 128, 119, 8, 222, 80, 175, 195, 131, 124, 160, 172,
+			246, 91, 16, 38, 106, 52, 132, 24, 84, 137, 85, 250, 184, 236,
+			172, 75, 223, 211, 11, 25, 97, 150, 10, 190, 61, 97, 65, 232,
+			221, 148, 216, 186, 199, 176, 181, 14, 32, 6, 195, 149, 6, 167,
+			240, 20, 25, 84, 237, 108, 23, 26, 184, 4, 106, 200, 94, 43,
+			156, 217, 232, 177, 26, 114, 29, 139, 90, 224, 132, 99, 217, 81,
+			73, 60, 172, 40, 111, 247, 2, 155, 113, 40, 36, 220, 71, 86,
+			22, 103, 23, 71, 95, 135, 152, 16, 117, 74, 47, 76, 9, 246,
+			41, 30, 115, 146, 216, 133, 113, 218, 194, 249, 165, 226, 216, 155,
+			20, 76, 189, 249, 187, 140, 226, 138, 128, 19, 39, 48, 184, 47,
+			133, 76, 2, 86, 249, 151, 66, 38, 1, 166, 190, 95, 82, 142,
+			28, 69, 159, 81, 144, 170, 247, 225, 196, 111, 40, 177, 127, 163,
+			40, 198, 149, 29, 56, 92, 239, 177, 112, 184, 39, 23, 255, 42,
+			21, 118, 160, 122, 1, 9, 180, 79, 193, 250, 111, 40, 201, 115,
+			140, 113, 246, 1, 227, 252, 242, 187, 100, 156, 125, 140, 113, 126,
+			89, 226, 164, 143, 49, 206, 47, 75, 156, 244, 49, 198, 249, 101,
+			201, 56, 251, 152, 20, 240, 101, 201, 56, 251, 36, 227, 252, 50,
+			48, 206, 183, 97, 103, 236, 3, 148, 254, 150, 162, 94, 50, 190,
+			166, 16, 49, 171, 94, 200, 49, 163, 28, 15, 78, 159, 2, 171,
+			236, 240, 29, 194, 187, 247, 225, 59, 82, 86, 60, 236, 133, 228,
+			204, 116, 180, 250, 237, 62, 210, 115, 180, 128, 44, 241, 91, 114,
+			31, 237, 99, 94, 186, 191, 165, 244, 103, 100, 146, 225, 228, 169,
+			139, 50, 169, 65, 50, 155, 67, 223, 226, 24, 227, 206, 53, 35,
+			198, 127, 248, 219, 127, 174, 23, 24, 128, 101, 248, 213, 144, 228,
+			128, 102, 190, 26, 146, 28, 44, 195, 175, 2, 201, 77, 35, 112,
+			0, 73, 252, 142, 18, 251, 189, 125, 30, 238, 187, 150, 33, 19,
+			55, 146, 204, 107, 38, 249, 52, 58, 135, 116, 61, 9, 171, 230,
+			119, 21, 245, 188, 113, 180, 125, 121, 134, 231, 123, 0, 35, 9,
+			106, 26, 253, 119, 229, 156, 38, 217, 49, 238, 119, 149, 254, 195,
+			50, 201, 188, 104, 142, 100, 100, 82, 131, 228, 185, 167, 209, 243,
+			72, 213, 83, 56, 241, 7, 74, 236, 191, 40, 138, 49, 185, 167,
+			108, 20, 177, 86, 136, 64, 156, 82, 176, 254, 7, 74, 242, 44,
+			91, 231, 41, 128, 248, 107, 239, 114, 157, 167, 216, 58, 255, 154,
+			68, 122, 138, 13, 224, 107, 18, 233, 41, 182, 206, 191, 38, 215,
+			121, 138, 173, 243, 175, 201, 117, 158, 146, 235, 252, 107, 176, 206,
+			193, 187, 36, 165, 42, 56, 241, 117, 69, 253, 134, 114, 217, 32,
+			76, 64, 234, 28, 75, 68, 72, 18, 77, 194, 162, 248, 186, 162,
+			14, 203, 36, 115, 159, 193, 25, 153, 100, 238, 51, 231, 158, 150,
+			201, 36, 214, 191, 161, 36, 46, 137, 254, 133, 20, 241, 13, 37,
+			153, 69, 255, 28, 86, 77, 10, 92, 210, 190, 169, 252, 13, 83,
+			118, 242, 177, 169, 81, 55, 155, 20, 211, 7, 125, 51, 156, 8,
+			160, 254, 111, 194, 68, 220, 69, 170, 142, 112, 226, 79, 148, 216,
+			71, 85, 197, 184, 177, 191, 61, 40, 50, 5, 209, 21, 128, 20,
+			172, 255, 137, 146, 28, 99, 244, 132, 128, 158, 222, 126, 151, 244,
+			132, 212, 88, 212, 139, 6, 49, 122, 122, 91, 14, 3, 49, 122,
+			122, 91, 210, 19, 98, 244, 244, 182, 164, 39, 36, 233, 233, 109,
+			160, 39, 246, 126, 43, 2, 44, 252, 133, 162, 94, 51, 190, 209,
+			115, 223, 232, 28, 219, 223, 154, 189, 3, 177, 115, 232, 95, 72,
+			62, 131, 216, 222, 241, 23, 74, 255, 121, 153, 100, 120, 25, 189,
+			36, 147, 26, 36, 175, 92, 69, 127, 204, 177, 166, 130, 123, 200,
+			255, 63, 116, 194, 28, 1, 194, 151, 71, 82, 29, 144, 205, 91,
+			106, 64, 117, 176, 120, 222, 82, 143, 28, 69, 247, 144, 170, 247,
+			227, 196, 247, 169, 177, 79, 168, 138, 113, 115, 159, 91, 199, 14,
+			171, 167, 95, 193, 250, 247, 169, 201, 139, 44, 94, 71, 63, 172,
+			158, 143, 171, 234, 101, 227, 4, 89, 233, 186, 243, 107, 223, 68,
+			250, 217, 38, 242, 113, 85, 76, 110, 63, 219, 68, 62, 174, 246,
+			27, 50, 9, 1, 92, 212, 227, 227, 50, 9, 1, 92, 212, 220,
+			165, 192, 51, 229, 87, 126, 67, 121, 7, 174, 41, 194, 34, 238,
+			219, 246, 142, 192, 222, 222, 41, 167, 59, 75, 116, 190, 52, 176,
+			223, 193, 152, 174, 111, 173, 155, 21, 225, 130, 242, 68, 15, 20,
+			60, 185, 171, 204, 245, 125, 86, 12, 222, 3, 125, 215, 46, 54,
+			57, 116, 232, 30, 245, 67, 70, 43, 24, 224, 206, 238, 16, 188,
+			66, 40, 237, 236, 89, 225, 231, 21, 116, 164, 227, 73, 172, 119,
+			100, 18, 126, 28, 165, 154, 102, 141, 150, 225, 174, 128, 249, 33,
+			196, 75, 73, 200, 88, 182, 222, 160, 96, 227, 13, 191, 185, 138,
+			95, 218, 120, 67, 206, 10, 100, 224, 103, 224, 129, 7, 147, 187,
+			49, 141, 232, 123, 58, 139, 36, 161, 48, 252, 202, 188, 165, 160,
+			163, 93, 64, 255, 21, 25, 250, 227, 167, 209, 144, 77, 31, 251,
+			229, 8, 228, 42, 131, 252, 0, 100, 47, 73, 232, 51, 57, 116,
+			172, 59, 180, 129, 196, 29, 142, 226, 91, 160, 250, 187, 209, 113,
+			9, 116, 148, 159, 124, 167, 208, 157, 249, 156, 130, 78, 244, 6,
+			224, 219, 99, 76, 191, 111, 76, 254, 146, 138, 142, 118, 62, 189,
+			38, 177, 66, 186, 221, 162, 82, 237, 142, 79, 51, 40, 21, 44,
+			56, 225, 75, 113, 110, 183, 217, 94, 146, 133, 75, 97, 61, 252,
+			44, 58, 208, 48, 31, 151, 61, 223, 172, 83, 240, 227, 13, 158,
+			66, 232, 36, 71, 25, 58, 176, 52, 208, 48, 31, 47, 203, 226,
+			237, 243, 162, 239, 58, 47, 241, 93, 151, 65, 226, 29, 44, 131,
+			143, 42, 104, 164, 27, 111, 223, 233, 117, 240, 73, 21, 157, 8,
+			160, 232, 69, 216, 123, 79, 225, 124, 247, 20, 142, 245, 6, 52,
+			210, 126, 48, 143, 220, 79, 229, 255, 27, 147, 153, 249, 97, 5,
+			157, 220, 1, 27, 127, 205, 171, 236, 239, 40, 136, 4, 144, 241,
+			73, 134, 56, 88, 96, 178, 87, 121, 7, 115, 213, 133, 92, 245,
+			29, 33, 55, 243, 62, 116, 102, 23, 40, 4, 142, 198, 17, 246,
+			29, 223, 172, 151, 59, 72, 24, 158, 122, 74, 179, 47, 97, 117,
+			47, 51, 129, 240, 61, 234, 231, 133, 104, 176, 231, 150, 231, 160,
+			67, 192, 6, 101, 249, 96, 236, 199, 81, 130, 159, 116, 162, 85,
+			68, 214, 187, 98, 188, 219, 232, 112, 71, 135, 98, 152, 55, 80,
+			74, 10, 52, 146, 4, 140, 110, 18, 144, 245, 74, 97, 225, 125,
+			79, 250, 87, 52, 116, 152, 161, 59, 210, 249, 126, 103, 250, 69,
+			52, 192, 93, 213, 203, 180, 90, 163, 114, 162, 175, 116, 3, 216,
+			179, 131, 108, 161, 90, 163, 43, 219, 77, 186, 76, 253, 82, 63,
+			111, 8, 178, 60, 252, 18, 58, 28, 153, 214, 114, 184, 242, 181,
+			119, 194, 188, 15, 250, 221, 153, 221, 43, 95, 127, 23, 43, 63,
+			190, 235, 202, 79, 116, 172, 124, 163, 130, 250, 35, 67, 198, 151,
+			165, 207, 41, 109, 119, 32, 85, 224, 169, 169, 210, 65, 171, 251,
+			102, 25, 159, 233, 96, 217, 128, 240, 100, 27, 83, 206, 188, 129,
+			142, 116, 98, 251, 59, 70, 75, 127, 162, 162, 227, 109, 194, 165,
+			240, 197, 151, 20, 117, 8, 197, 89, 212, 17, 33, 244, 240, 4,
+			62, 139, 6, 69, 56, 145, 178, 11, 118, 116, 77, 209, 56, 27,
+			106, 177, 202, 108, 235, 154, 120, 17, 13, 139, 192, 4, 93, 244,
+			144, 233, 30, 133, 8, 22, 18, 18, 67, 122, 179, 35, 7, 79,
+			163, 100, 165, 89, 102, 239, 203, 143, 232, 59, 209, 213, 12, 139,
+			30, 176, 36, 34, 15, 148, 160, 240, 253, 88, 169, 175, 210, 100,
+			63, 193, 53, 29, 206, 41, 162, 149, 56, 107, 229, 120, 119, 43,
+			240, 18, 151, 172, 155, 242, 101, 162, 157, 150, 128, 90, 180, 29,
+			105, 137, 118, 208, 210, 116, 31, 138, 179, 78, 51, 223, 82, 209,
+			137, 222, 56, 23, 211, 190, 128, 250, 168, 13, 111, 221, 202, 73,
+			239, 225, 61, 181, 91, 3, 217, 130, 237, 187, 219, 37, 217, 200,
+			126, 137, 193, 248, 63, 21, 20, 103, 85, 113, 17, 13, 193, 241,
+			202, 242, 203, 50, 132, 3, 35, 128, 254, 73, 178, 39, 194, 7,
+			43, 109, 105, 60, 143, 14, 133, 75, 165, 28, 156, 17, 71, 212,
+			29, 132, 162, 224, 97, 180, 210, 193, 176, 94, 144, 137, 175, 162,
+			132, 112, 144, 214, 246, 118, 144, 46, 137, 178, 153, 63, 85, 208,
+			177, 96, 135, 10, 2, 187, 72, 34, 63, 214, 70, 228, 124, 143,
+			248, 235, 165, 244, 179, 104, 176, 97, 217, 229, 200, 35, 84, 113,
+			246, 8, 213, 64, 195, 178, 239, 6, 239, 80, 117, 81, 99, 132,
+			179, 61, 208, 147, 122, 58, 158, 169, 34, 163, 215, 184, 5, 161,
+			221, 21, 163, 91, 151, 95, 4, 189, 157, 238, 141, 212, 176, 129,
+			3, 126, 52, 57, 249, 209, 126, 240, 176, 133, 194, 179, 211, 248,
+			33, 58, 208, 118, 190, 197, 79, 119, 183, 214, 235, 0, 188, 167,
+			107, 40, 111, 56, 156, 221, 29, 26, 142, 76, 255, 206, 13, 135,
+			133, 50, 49, 188, 129, 134, 228, 25, 74, 240, 100, 60, 218, 93,
+			165, 163, 136, 132, 250, 194, 62, 74, 6, 78, 153, 235, 76, 170,
+			233, 144, 255, 240, 197, 29, 199, 17, 41, 37, 251, 219, 91, 158,
+			204, 196, 240, 22, 23, 135, 58, 62, 120, 120, 98, 103, 96, 163,
+			229, 100, 95, 217, 253, 22, 15, 6, 248, 8, 165, 3, 122, 19,
+			195, 239, 229, 128, 220, 89, 70, 118, 56, 182, 159, 162, 65, 103,
+			111, 8, 57, 168, 19, 30, 156, 221, 165, 153, 118, 192, 121, 183,
+			185, 125, 151, 15, 250, 254, 190, 40, 71, 233, 148, 121, 241, 228,
+			46, 13, 118, 11, 200, 28, 136, 43, 239, 168, 78, 0, 200, 251,
+			80, 127, 68, 80, 198, 103, 187, 91, 233, 150, 163, 141, 93, 164,
+			136, 76, 12, 175, 161, 3, 109, 178, 109, 175, 133, 214, 33, 252,
+			242, 33, 156, 223, 179, 92, 0, 54, 69, 131, 237, 66, 15, 238,
+			81, 185, 83, 44, 226, 189, 140, 238, 93, 48, 232, 102, 11, 29,
+			234, 181, 83, 226, 137, 29, 151, 92, 91, 185, 93, 22, 66, 239,
+			226, 65, 199, 31, 68, 56, 152, 189, 128, 81, 226, 139, 187, 204,
+			113, 80, 74, 118, 58, 158, 173, 183, 42, 86, 214, 165, 94, 171,
+			254, 255, 178, 247, 253, 177, 113, 85, 217, 253, 243, 238, 125, 30,
+			207, 60, 199, 246, 248, 121, 156, 175, 243, 236, 144, 23, 19, 18,
+			39, 158, 56, 14, 44, 132, 47, 196, 129, 144, 116, 151, 240, 123,
+			23, 104, 255, 160, 64, 38, 241, 75, 50, 194, 158, 113, 103, 198,
+			9, 6, 173, 68, 182, 65, 228, 31, 186, 81, 1, 209, 54, 93,
+			34, 44, 45, 46, 202, 138, 85, 84, 40, 10, 17, 162, 129, 13,
+			104, 203, 15, 169, 75, 203, 74, 176, 172, 160, 221, 102, 43, 72,
+			181, 105, 82, 148, 45, 252, 83, 125, 206, 189, 231, 189, 251, 102,
+			60, 206, 143, 109, 96, 41, 252, 19, 249, 190, 188, 185, 239, 222,
+			115, 207, 61, 231, 220, 115, 207, 231, 156, 89, 94, 254, 93, 64,
+			207, 47, 254, 165, 165, 80, 207, 187, 237, 175, 171, 247, 125, 93,
+			189, 239, 243, 173, 222, 103, 160, 158, 151, 70, 168, 231, 171, 232,
+			207, 38, 84, 242, 27, 166, 63, 147, 128, 61, 43, 88, 116, 179,
+			43, 231, 37, 174, 166, 63, 83, 174, 244, 66, 132, 116, 111, 132,
+			144, 198, 159, 3, 10, 32, 189, 32, 81, 176, 188, 5, 113, 124,
+			116, 190, 14, 29, 205, 48, 223, 5, 169, 140, 179, 132, 81, 190,
+			11, 197, 90, 207, 243, 191, 19, 192, 8, 223, 30, 84, 226, 8,
+			227, 16, 204, 133, 159, 45, 76, 118, 114, 75, 184, 114, 97, 182,
+			159, 91, 210, 149, 11, 47, 25, 118, 182, 107, 84, 170, 92, 92,
+			219, 165, 57, 144, 243, 133, 249, 3, 140, 98, 113, 56, 66, 160,
+			209, 23, 135, 35, 4, 168, 98, 241, 37, 195, 206, 180, 165, 193,
+			159, 118, 78, 44, 95, 230, 253, 133, 101, 12, 210, 164, 21, 221,
+			64, 231, 235, 47, 142, 113, 227, 121, 19, 5, 189, 133, 183, 110,
+			97, 77, 47, 141, 154, 141, 209, 28, 55, 208, 124, 82, 54, 186,
+			193, 93, 228, 237, 149, 160, 214, 157, 5, 248, 2, 161, 29, 66,
+			170, 131, 152, 185, 100, 23, 183, 132, 43, 115, 115, 115, 220, 146,
+			174, 92, 222, 190, 216, 89, 174, 113, 166, 246, 144, 88, 41, 189,
+			5, 245, 100, 55, 28, 117, 97, 199, 64, 147, 14, 197, 208, 164,
+			67, 49, 52, 233, 208, 240, 13, 206, 1, 139, 225, 164, 171, 196,
+			229, 43, 188, 169, 58, 98, 153, 30, 192, 207, 155, 98, 166, 169,
+			82, 71, 54, 160, 87, 87, 37, 61, 3, 189, 186, 170, 231, 50,
+			110, 73, 87, 94, 222, 62, 0, 228, 33, 102, 215, 228, 218, 195,
+			98, 205, 128, 119, 111, 237, 228, 120, 60, 8, 107, 143, 239, 9,
+			160, 195, 55, 79, 148, 145, 147, 29, 57, 145, 253, 91, 39, 40,
+			107, 101, 197, 120, 197, 8, 141, 55, 95, 200, 143, 108, 135, 112,
+			31, 129, 220, 175, 6, 101, 74, 138, 174, 135, 5, 196, 234, 112,
+			12, 177, 58, 28, 67, 172, 174, 105, 95, 130, 226, 7, 128, 142,
+			186, 246, 58, 177, 126, 200, 27, 159, 125, 57, 234, 134, 253, 191,
+			53, 82, 192, 90, 215, 133, 8, 83, 192, 90, 215, 133, 8, 83,
+			192, 90, 215, 183, 231, 156, 31, 89, 140, 107, 221, 32, 174, 251,
+			134, 247, 131, 24, 235, 212, 68, 29, 87, 66, 75, 15, 107, 95,
+			59, 228, 117, 19, 101, 236, 193, 209, 73, 191, 194, 99, 35, 255,
+			169, 145, 224, 51, 182, 92, 81, 233, 193, 154, 0, 98, 36, 229,
+			64, 202, 123, 157, 173, 189, 80, 228, 102, 56, 49, 64, 103, 55,
+			132, 152, 231, 102, 225, 202, 13, 33, 230, 25, 133, 154, 174, 107,
+			31, 114, 42, 26, 58, 43, 111, 22, 87, 122, 221, 198, 172, 242,
+			69, 159, 61, 82, 131, 206, 197, 55, 64, 192, 133, 54, 218, 239,
+			26, 32, 169, 33, 179, 4, 167, 237, 48, 225, 180, 238, 34, 110,
+			73, 87, 222, 188, 98, 149, 243, 166, 162, 123, 218, 149, 183, 139,
+			27, 188, 151, 76, 178, 135, 254, 178, 70, 59, 117, 69, 228, 167,
+			139, 54, 109, 33, 30, 65, 83, 163, 29, 114, 58, 14, 182, 193,
+			198, 118, 140, 143, 54, 218, 214, 209, 174, 142, 200, 85, 187, 157,
+			81, 201, 233, 246, 80, 178, 167, 133, 43, 111, 15, 37, 123, 90,
+			186, 242, 246, 75, 174, 117, 14, 171, 153, 59, 174, 188, 67, 220,
+			236, 253, 109, 131, 153, 159, 118, 47, 35, 91, 124, 89, 255, 50,
+			62, 250, 24, 151, 25, 83, 169, 225, 51, 131, 205, 74, 229, 24,
+			151, 157, 118, 247, 233, 25, 161, 94, 212, 29, 201, 44, 183, 132,
+			43, 239, 232, 90, 198, 45, 233, 202, 59, 46, 189, 222, 153, 67,
+			147, 109, 113, 237, 59, 197, 93, 204, 3, 168, 232, 116, 103, 40,
+			244, 90, 132, 43, 239, 236, 93, 197, 45, 233, 202, 187, 108, 223,
+			249, 7, 149, 56, 98, 142, 107, 111, 21, 219, 150, 123, 135, 4,
+			200, 68, 209, 242, 152, 69, 232, 26, 9, 231, 171, 157, 53, 149,
+			40, 73, 115, 57, 216, 28, 103, 27, 66, 136, 228, 17, 181, 164,
+			18, 54, 251, 183, 197, 122, 66, 156, 88, 80, 46, 148, 70, 52,
+			192, 124, 156, 2, 207, 198, 201, 70, 84, 162, 85, 215, 159, 89,
+			57, 52, 52, 164, 254, 82, 159, 48, 33, 229, 33, 10, 101, 52,
+			95, 169, 250, 171, 252, 145, 252, 100, 133, 227, 109, 96, 17, 3,
+			26, 2, 31, 248, 160, 227, 223, 140, 152, 157, 248, 146, 128, 224,
+			35, 193, 189, 193, 72, 125, 138, 85, 224, 78, 56, 121, 175, 6,
+			174, 212, 36, 104, 157, 168, 4, 119, 207, 228, 211, 11, 151, 11,
+			37, 167, 182, 134, 154, 116, 142, 112, 229, 214, 80, 147, 162, 0,
+			213, 182, 246, 165, 206, 101, 10, 228, 62, 154, 40, 89, 222, 178,
+			6, 241, 110, 49, 55, 145, 142, 209, 97, 80, 250, 104, 170, 215,
+			185, 142, 49, 233, 69, 49, 236, 13, 19, 149, 77, 28, 85, 52,
+			70, 35, 104, 171, 81, 156, 155, 70, 114, 55, 161, 51, 19, 243,
+			93, 140, 213, 213, 45, 102, 184, 190, 37, 48, 223, 69, 119, 117,
+			28, 243, 93, 204, 94, 65, 19, 179, 92, 187, 156, 152, 152, 117,
+			98, 145, 137, 99, 76, 12, 246, 90, 57, 213, 235, 92, 207, 176,
+			229, 170, 24, 246, 214, 212, 77, 204, 216, 119, 117, 51, 139, 250,
+			53, 103, 70, 229, 164, 100, 53, 196, 215, 98, 102, 85, 61, 51,
+			5, 61, 174, 234, 153, 89, 8, 223, 147, 85, 61, 51, 139, 103,
+			86, 205, 94, 129, 218, 61, 10, 126, 187, 199, 178, 188, 92, 131,
+			169, 177, 131, 136, 205, 183, 104, 114, 48, 220, 238, 77, 93, 224,
+			92, 21, 193, 110, 215, 121, 23, 251, 55, 205, 184, 98, 58, 195,
+			114, 188, 146, 115, 97, 228, 187, 125, 122, 66, 84, 118, 73, 222,
+			39, 82, 38, 8, 55, 221, 105, 130, 112, 117, 165, 71, 65, 19,
+			186, 175, 251, 26, 154, 144, 224, 9, 221, 231, 93, 237, 252, 39,
+			226, 234, 40, 38, 115, 39, 178, 155, 255, 139, 130, 255, 140, 229,
+			239, 45, 140, 77, 140, 53, 210, 166, 33, 156, 134, 55, 118, 69,
+			31, 48, 176, 235, 116, 242, 161, 45, 193, 14, 46, 40, 72, 103,
+			90, 194, 180, 34, 141, 210, 22, 212, 16, 226, 42, 38, 57, 115,
+			163, 199, 63, 194, 232, 46, 213, 159, 142, 196, 139, 134, 70, 253,
+			225, 244, 8, 9, 113, 165, 134, 204, 162, 10, 203, 246, 0, 125,
+			13, 133, 191, 223, 92, 10, 202, 48, 89, 170, 37, 122, 174, 67,
+			213, 20, 138, 118, 39, 167, 212, 86, 165, 165, 118, 90, 169, 16,
+			84, 43, 209, 204, 118, 169, 112, 77, 129, 240, 219, 221, 8, 191,
+			253, 119, 4, 30, 226, 70, 67, 225, 152, 201, 232, 11, 162, 210,
+			94, 249, 16, 17, 231, 111, 172, 97, 131, 141, 62, 68, 221, 160,
+			227, 223, 162, 2, 100, 117, 169, 145, 82, 164, 88, 176, 97, 43,
+			19, 155, 42, 96, 169, 98, 213, 31, 215, 177, 148, 127, 4, 172,
+			199, 120, 126, 107, 161, 152, 71, 26, 154, 156, 145, 116, 106, 60,
+			95, 206, 143, 5, 8, 230, 13, 3, 14, 177, 27, 234, 63, 141,
+			120, 78, 199, 31, 67, 240, 44, 113, 25, 134, 130, 197, 49, 80,
+			48, 120, 28, 77, 44, 36, 147, 48, 107, 176, 169, 162, 86, 187,
+			57, 236, 85, 21, 181, 218, 141, 176, 215, 119, 21, 153, 164, 107,
+			127, 223, 18, 203, 188, 215, 45, 255, 155, 33, 70, 87, 171, 69,
+			214, 138, 108, 24, 40, 102, 128, 97, 80, 65, 169, 93, 252, 23,
+			215, 156, 26, 203, 87, 238, 9, 81, 67, 132, 233, 225, 66, 160,
+			119, 111, 171, 142, 141, 146, 169, 70, 133, 209, 116, 185, 88, 234,
+			127, 132, 241, 105, 90, 44, 96, 239, 107, 54, 202, 143, 238, 200,
+			79, 2, 220, 29, 89, 27, 1, 229, 217, 217, 226, 247, 225, 181,
+			62, 46, 164, 17, 230, 236, 64, 72, 43, 152, 173, 114, 79, 72,
+			9, 153, 164, 201, 245, 112, 211, 66, 179, 247, 34, 110, 210, 212,
+			251, 151, 50, 236, 250, 17, 85, 30, 110, 121, 163, 80, 204, 153,
+			133, 4, 195, 174, 31, 1, 90, 240, 42, 134, 93, 63, 102, 137,
+			37, 222, 202, 90, 96, 158, 54, 92, 12, 239, 69, 204, 136, 210,
+			227, 150, 20, 147, 249, 24, 7, 220, 42, 124, 246, 99, 28, 216,
+			175, 240, 217, 143, 113, 96, 191, 194, 103, 63, 102, 93, 180, 216,
+			249, 15, 139, 241, 215, 123, 145, 215, 254, 151, 224, 123, 205, 242,
+			4, 207, 226, 106, 252, 21, 232, 253, 124, 197, 223, 24, 93, 173,
+			109, 172, 227, 106, 220, 189, 105, 126, 198, 154, 71, 113, 174, 160,
+			123, 105, 172, 80, 165, 82, 81, 96, 233, 192, 223, 129, 127, 138,
+			165, 218, 157, 80, 241, 57, 221, 59, 52, 52, 170, 2, 104, 209,
+			203, 93, 198, 148, 157, 94, 206, 48, 19, 77, 206, 31, 67, 1,
+			3, 38, 28, 228, 84, 126, 124, 60, 200, 151, 195, 210, 139, 26,
+			29, 110, 66, 132, 21, 118, 124, 175, 149, 238, 54, 176, 227, 123,
+			173, 158, 94, 198, 142, 239, 179, 18, 83, 179, 96, 199, 181, 138,
+			51, 206, 164, 198, 42, 3, 59, 190, 15, 216, 241, 63, 100, 236,
+			248, 147, 150, 112, 189, 107, 103, 214, 116, 198, 33, 110, 70, 117,
+			103, 124, 131, 117, 158, 70, 140, 55, 81, 199, 38, 188, 252, 201,
+			56, 188, 252, 73, 192, 203, 175, 82, 232, 241, 31, 90, 137, 191,
+			179, 44, 111, 229, 105, 20, 155, 241, 53, 147, 113, 155, 44, 215,
+			254, 161, 149, 186, 208, 185, 154, 113, 227, 211, 214, 57, 234, 55,
+			141, 193, 110, 114, 237, 105, 30, 188, 2, 108, 79, 179, 236, 81,
+			128, 237, 105, 14, 185, 111, 162, 144, 251, 105, 14, 185, 15, 1,
+			219, 211, 8, 185, 255, 44, 4, 108, 63, 3, 37, 119, 108, 86,
+			37, 23, 59, 47, 159, 87, 77, 23, 251, 210, 249, 85, 119, 10,
+			239, 253, 12, 171, 59, 133, 247, 126, 134, 213, 157, 194, 123, 63,
+			131, 162, 35, 59, 5, 227, 189, 159, 131, 186, 59, 113, 246, 234,
+			206, 100, 142, 47, 66, 231, 197, 190, 175, 43, 247, 156, 189, 226,
+			83, 224, 240, 231, 34, 230, 131, 226, 123, 46, 98, 62, 40, 190,
+			231, 192, 124, 0, 64, 37, 221, 228, 65, 43, 241, 114, 8, 128,
+			154, 69, 220, 55, 216, 58, 64, 136, 31, 4, 0, 106, 61, 35,
+			196, 15, 89, 98, 165, 119, 89, 36, 243, 99, 204, 114, 38, 130,
+			63, 73, 130, 255, 16, 11, 254, 36, 9, 254, 67, 150, 14, 198,
+			87, 136, 241, 67, 150, 14, 198, 79, 210, 110, 58, 100, 173, 24,
+			82, 130, 159, 0, 225, 135, 191, 50, 130, 63, 73, 219, 227, 48,
+			175, 182, 194, 124, 31, 102, 193, 159, 164, 237, 113, 24, 130, 31,
+			202, 189, 217, 77, 30, 177, 18, 199, 66, 229, 94, 47, 39, 235,
+			28, 184, 209, 66, 3, 209, 122, 4, 202, 253, 223, 44, 134, 180,
+			190, 110, 137, 62, 239, 157, 200, 53, 17, 215, 241, 161, 193, 82,
+			40, 214, 103, 72, 200, 53, 112, 43, 56, 126, 255, 246, 66, 222,
+			60, 226, 205, 20, 180, 181, 20, 214, 229, 173, 196, 65, 147, 184,
+			231, 152, 57, 75, 100, 5, 128, 209, 194, 118, 132, 158, 81, 218,
+			190, 63, 193, 228, 240, 122, 169, 104, 190, 169, 235, 101, 234, 239,
+			144, 231, 32, 44, 81, 210, 76, 172, 248, 58, 179, 162, 194, 224,
+			190, 206, 137, 23, 20, 6, 247, 117, 203, 157, 207, 77, 137, 166,
+			191, 144, 14, 183, 132, 176, 125, 11, 249, 131, 86, 251, 107, 77,
+			210, 212, 154, 147, 170, 124, 90, 5, 94, 195, 45, 147, 186, 106,
+			56, 71, 147, 132, 227, 176, 146, 174, 253, 150, 37, 186, 244, 56,
+			176, 204, 111, 89, 115, 23, 112, 83, 162, 217, 119, 161, 243, 3,
+			193, 72, 213, 119, 44, 177, 220, 251, 51, 85, 88, 114, 187, 190,
+			201, 211, 154, 12, 30, 202, 64, 201, 65, 181, 49, 98, 163, 25,
+			41, 231, 119, 16, 245, 56, 149, 16, 37, 171, 113, 148, 71, 194,
+			159, 24, 15, 139, 13, 134, 168, 147, 154, 116, 40, 72, 84, 0,
+			241, 239, 135, 97, 127, 80, 81, 151, 142, 213, 120, 215, 162, 2,
+			159, 161, 123, 141, 89, 103, 98, 124, 180, 148, 135, 120, 252, 198,
+			152, 159, 223, 90, 26, 116, 252, 117, 219, 74, 37, 100, 252, 244,
+			243, 42, 67, 82, 57, 252, 186, 86, 104, 35, 19, 155, 67, 4,
+			59, 237, 16, 202, 158, 225, 223, 168, 43, 211, 173, 30, 190, 100,
+			104, 44, 36, 38, 124, 206, 239, 88, 194, 227, 166, 133, 102, 79,
+			63, 55, 37, 154, 3, 57, 117, 196, 108, 134, 192, 124, 255, 43,
+			117, 196, 108, 166, 10, 82, 239, 179, 206, 109, 198, 245, 159, 253,
+			62, 235, 220, 102, 58, 49, 188, 15, 157, 251, 223, 138, 64, 54,
+			161, 94, 187, 189, 143, 206, 92, 231, 214, 10, 154, 207, 83, 223,
+			214, 127, 251, 92, 15, 153, 205, 176, 61, 35, 172, 110, 179, 176,
+			77, 172, 110, 51, 238, 102, 236, 95, 133, 135, 204, 102, 40, 230,
+			143, 255, 111, 30, 50, 155, 69, 83, 146, 38, 215, 195, 77, 170,
+			113, 167, 15, 153, 205, 184, 242, 177, 63, 182, 250, 151, 58, 171,
+			21, 84, 252, 55, 86, 226, 183, 22, 167, 7, 156, 193, 234, 168,
+			93, 35, 195, 226, 0, 72, 252, 55, 86, 202, 119, 238, 98, 144,
+			248, 9, 156, 50, 111, 241, 111, 132, 173, 20, 140, 196, 118, 10,
+			60, 168, 81, 105, 171, 186, 59, 2, 127, 195, 250, 156, 122, 127,
+			195, 122, 51, 133, 203, 134, 245, 122, 94, 10, 93, 126, 130, 21,
+			128, 66, 151, 159, 136, 163, 203, 79, 240, 33, 84, 161, 203, 79,
+			224, 16, 250, 52, 22, 60, 5, 13, 112, 10, 182, 200, 95, 253,
+			30, 219, 34, 122, 232, 176, 35, 78, 49, 39, 167, 200, 142, 56,
+			197, 118, 68, 138, 236, 136, 83, 176, 35, 52, 108, 254, 51, 43,
+			241, 215, 162, 49, 108, 62, 92, 190, 6, 86, 35, 96, 243, 159,
+			193, 106, 164, 212, 164, 132, 155, 223, 41, 68, 159, 247, 94, 173,
+			49, 17, 51, 30, 207, 220, 162, 112, 12, 147, 226, 247, 203, 162,
+			72, 19, 67, 237, 100, 164, 169, 66, 251, 239, 20, 218, 162, 80,
+			104, 255, 157, 66, 91, 20, 10, 237, 191, 83, 248, 11, 157, 111,
+			51, 152, 127, 151, 16, 15, 138, 149, 222, 90, 127, 109, 29, 133,
+			206, 193, 176, 72, 19, 140, 121, 151, 208, 186, 80, 161, 253, 119,
+			137, 158, 69, 6, 218, 127, 151, 88, 210, 111, 160, 253, 31, 20,
+			181, 104, 255, 7, 69, 106, 80, 89, 30, 105, 184, 27, 31, 22,
+			95, 91, 30, 51, 91, 30, 105, 178, 60, 30, 142, 168, 13, 1,
+			241, 176, 208, 150, 135, 74, 41, 240, 176, 24, 200, 169, 115, 127,
+			26, 205, 71, 197, 87, 243, 220, 159, 38, 27, 228, 81, 46, 14,
+			152, 38, 27, 228, 81, 161, 109, 144, 52, 217, 32, 143, 138, 108,
+			151, 243, 167, 138, 239, 108, 215, 222, 43, 68, 183, 247, 95, 231,
+			96, 131, 124, 81, 7, 255, 153, 7, 112, 206, 39, 255, 52, 89,
+			35, 123, 25, 115, 159, 38, 107, 100, 47, 99, 238, 211, 100, 141,
+			236, 21, 58, 21, 171, 227, 38, 247, 137, 196, 223, 8, 78, 154,
+			52, 155, 14, 110, 32, 196, 145, 171, 98, 159, 72, 93, 228, 220,
+			207, 185, 42, 166, 128, 182, 31, 107, 112, 244, 39, 243, 10, 194,
+			82, 167, 223, 99, 57, 116, 118, 10, 218, 232, 50, 210, 210, 14,
+			9, 213, 41, 22, 170, 14, 105, 233, 41, 134, 239, 171, 148, 23,
+			83, 12, 223, 87, 41, 47, 166, 196, 138, 33, 231, 233, 48, 163,
+			197, 180, 248, 50, 104, 105, 135, 78, 251, 211, 81, 86, 5, 72,
+			235, 105, 161, 181, 180, 67, 90, 122, 90, 244, 244, 82, 78, 205,
+			22, 55, 185, 95, 36, 94, 16, 141, 115, 106, 54, 12, 81, 54,
+			22, 185, 197, 114, 237, 253, 34, 213, 239, 156, 176, 56, 167, 194,
+			1, 104, 234, 127, 53, 52, 117, 60, 226, 197, 48, 191, 226, 209,
+			43, 53, 250, 186, 81, 100, 193, 121, 212, 215, 154, 8, 103, 167,
+			177, 85, 110, 136, 3, 204, 92, 45, 164, 177, 15, 176, 198, 86,
+			185, 33, 14, 176, 198, 110, 33, 230, 58, 0, 141, 77, 10, 177,
+			5, 204, 117, 240, 107, 133, 216, 64, 33, 182, 144, 249, 113, 144,
+			21, 98, 11, 153, 31, 7, 89, 33, 182, 16, 67, 31, 132, 66,
+			252, 3, 71, 216, 115, 220, 228, 139, 34, 241, 247, 194, 242, 86,
+			157, 225, 177, 97, 70, 142, 158, 99, 185, 246, 139, 34, 181, 20,
+			129, 134, 246, 28, 136, 173, 151, 132, 88, 224, 45, 240, 111, 155,
+			37, 14, 75, 143, 119, 14, 45, 254, 75, 172, 149, 230, 208, 226,
+			191, 36, 82, 30, 55, 37, 122, 155, 127, 1, 5, 18, 180, 186,
+			201, 87, 68, 226, 85, 97, 121, 253, 13, 54, 160, 17, 221, 111,
+			12, 176, 213, 114, 237, 87, 208, 231, 183, 28, 219, 110, 197, 0,
+			143, 8, 49, 236, 253, 255, 186, 11, 22, 142, 231, 169, 187, 88,
+			225, 78, 205, 27, 149, 86, 26, 250, 17, 150, 29, 173, 52, 244,
+			35, 66, 223, 168, 180, 18, 223, 30, 17, 58, 97, 111, 43, 93,
+			74, 28, 17, 58, 97, 111, 43, 39, 236, 61, 34, 178, 87, 56,
+			151, 59, 194, 110, 115, 147, 63, 21, 137, 247, 68, 227, 48, 137,
+			24, 120, 192, 152, 93, 155, 229, 218, 63, 21, 169, 94, 103, 194,
+			177, 237, 54, 204, 238, 13, 33, 174, 242, 182, 198, 238, 90, 148,
+			30, 208, 247, 44, 177, 0, 39, 191, 127, 134, 16, 144, 165, 100,
+			242, 199, 162, 114, 213, 123, 17, 43, 168, 247, 52, 45, 218, 136,
+			22, 111, 48, 45, 218, 136, 22, 111, 136, 116, 59, 55, 37, 70,
+			229, 118, 114, 51, 133, 102, 118, 13, 209, 162, 141, 105, 241, 134,
+			152, 187, 218, 249, 24, 82, 177, 13, 155, 252, 109, 24, 106, 191,
+			104, 100, 168, 69, 161, 87, 231, 203, 64, 139, 190, 112, 126, 13,
+			179, 54, 210, 65, 111, 243, 22, 104, 163, 45, 251, 54, 27, 102,
+			109, 180, 101, 223, 134, 97, 70, 105, 6, 219, 112, 32, 120, 23,
+			134, 217, 175, 206, 220, 48, 139, 177, 206, 231, 105, 144, 213, 124,
+			248, 92, 221, 66, 109, 116, 5, 243, 110, 196, 94, 224, 143, 119,
+			217, 16, 107, 19, 176, 235, 223, 133, 33, 134, 160, 156, 118, 55,
+			249, 75, 145, 248, 181, 176, 188, 129, 70, 82, 173, 209, 86, 106,
+			71, 106, 59, 145, 154, 239, 92, 238, 216, 118, 59, 182, 210, 7,
+			80, 205, 203, 104, 185, 35, 126, 152, 225, 206, 69, 237, 47, 61,
+			220, 118, 82, 112, 31, 176, 130, 107, 39, 235, 233, 3, 209, 210,
+			201, 77, 11, 29, 103, 231, 115, 83, 162, 233, 47, 84, 247, 45,
+			237, 152, 219, 81, 241, 85, 185, 111, 105, 39, 238, 63, 202, 75,
+			219, 78, 220, 127, 148, 45, 176, 118, 226, 254, 163, 176, 192, 176,
+			180, 25, 55, 249, 145, 72, 236, 147, 188, 180, 245, 66, 178, 38,
+			80, 52, 90, 218, 140, 229, 218, 31, 97, 105, 233, 90, 51, 131,
+			181, 61, 142, 181, 61, 97, 152, 93, 209, 10, 159, 185, 99, 228,
+			172, 175, 90, 200, 43, 134, 247, 180, 101, 80, 227, 95, 251, 220,
+			252, 38, 25, 98, 210, 227, 204, 164, 25, 146, 224, 199, 217, 10,
+			203, 144, 4, 63, 206, 86, 88, 134, 36, 248, 113, 88, 97, 171,
+			65, 62, 153, 112, 147, 39, 133, 248, 173, 144, 20, 250, 86, 9,
+			170, 144, 201, 209, 204, 151, 84, 252, 210, 68, 117, 107, 9, 51,
+			64, 110, 5, 191, 58, 57, 30, 112, 226, 183, 140, 68, 223, 39,
+			133, 211, 229, 124, 219, 73, 162, 51, 172, 198, 39, 194, 238, 243,
+			214, 210, 78, 195, 47, 144, 103, 13, 165, 10, 131, 34, 2, 65,
+			55, 77, 158, 150, 172, 156, 44, 173, 221, 105, 86, 93, 54, 81,
+			159, 169, 232, 129, 133, 7, 233, 249, 209, 3, 137, 7, 254, 66,
+			231, 59, 122, 20, 240, 208, 9, 219, 243, 174, 161, 81, 168, 45,
+			189, 124, 243, 54, 228, 156, 47, 7, 163, 234, 59, 219, 10, 227,
+			254, 166, 160, 186, 35, 8, 138, 198, 144, 104, 81, 13, 221, 24,
+			13, 3, 220, 125, 202, 28, 6, 248, 251, 148, 72, 119, 69, 15,
+			224, 9, 20, 221, 243, 156, 55, 20, 103, 34, 175, 155, 20, 11,
+			188, 167, 133, 230, 3, 84, 204, 83, 251, 95, 17, 6, 181, 3,
+			40, 219, 132, 202, 253, 171, 119, 61, 72, 205, 156, 184, 194, 100,
+			74, 166, 86, 164, 208, 106, 140, 90, 100, 58, 54, 165, 135, 202,
+			237, 24, 206, 77, 39, 101, 14, 127, 157, 131, 155, 28, 16, 178,
+			251, 103, 90, 135, 43, 252, 106, 121, 34, 248, 238, 224, 140, 157,
+			194, 156, 88, 174, 24, 125, 198, 94, 253, 251, 205, 244, 16, 97,
+			87, 142, 127, 205, 36, 251, 234, 115, 122, 238, 228, 211, 82, 4,
+			25, 116, 250, 92, 254, 111, 162, 206, 90, 168, 55, 205, 183, 48,
+			135, 191, 39, 69, 43, 55, 137, 186, 109, 30, 55, 145, 53, 79,
+			206, 191, 64, 221, 193, 102, 160, 91, 119, 75, 49, 132, 59, 88,
+			202, 70, 31, 134, 232, 235, 131, 151, 46, 109, 21, 173, 180, 145,
+			118, 220, 180, 149, 76, 63, 161, 83, 231, 40, 212, 161, 243, 241,
+			199, 10, 39, 116, 211, 205, 183, 17, 174, 110, 50, 158, 175, 177,
+			150, 90, 176, 64, 74, 124, 17, 129, 175, 143, 69, 74, 137, 53,
+			158, 162, 168, 153, 224, 36, 36, 10, 156, 102, 187, 165, 190, 251,
+			204, 168, 72, 62, 57, 119, 128, 155, 72, 198, 39, 7, 87, 56,
+			251, 20, 67, 74, 215, 222, 35, 197, 114, 111, 207, 151, 235, 192,
+			21, 18, 235, 60, 31, 185, 50, 20, 14, 184, 71, 234, 35, 87,
+			134, 28, 107, 123, 164, 62, 114, 101, 200, 177, 182, 71, 14, 228,
+			148, 105, 155, 65, 225, 129, 199, 229, 87, 195, 180, 205, 144, 3,
+			237, 113, 169, 77, 219, 12, 57, 208, 30, 151, 218, 180, 205, 144,
+			3, 237, 113, 153, 237, 114, 62, 81, 164, 105, 114, 237, 39, 164,
+			232, 246, 142, 158, 165, 207, 241, 11, 177, 109, 107, 191, 124, 174,
+			198, 109, 70, 52, 169, 121, 167, 184, 105, 161, 169, 141, 219, 12,
+			221, 244, 61, 33, 117, 25, 176, 14, 55, 57, 37, 19, 63, 146,
+			28, 114, 62, 131, 113, 27, 31, 151, 97, 221, 118, 88, 174, 61,
+			37, 83, 23, 56, 83, 160, 118, 7, 148, 238, 83, 82, 244, 121,
+			143, 88, 225, 77, 95, 200, 24, 216, 134, 133, 114, 165, 58, 139,
+			12, 98, 209, 215, 88, 166, 59, 126, 233, 108, 60, 145, 252, 187,
+			200, 13, 217, 65, 54, 202, 83, 82, 132, 205, 36, 198, 172, 13,
+			233, 14, 82, 233, 79, 73, 109, 72, 119, 144, 66, 127, 74, 250,
+			11, 149, 27, 178, 3, 98, 109, 191, 252, 50, 184, 33, 59, 200,
+			8, 222, 207, 44, 208, 65, 70, 240, 126, 169, 141, 224, 14, 50,
+			17, 246, 203, 158, 94, 10, 206, 116, 221, 228, 143, 101, 226, 45,
+			217, 56, 56, 51, 6, 168, 208, 112, 21, 131, 15, 92, 203, 181,
+			127, 44, 83, 23, 58, 255, 12, 50, 185, 224, 131, 103, 165, 232,
+			244, 94, 133, 41, 172, 138, 90, 15, 170, 84, 183, 69, 170, 158,
+			81, 45, 25, 92, 77, 40, 30, 197, 214, 96, 114, 170, 220, 183,
+			41, 128, 40, 133, 13, 90, 8, 70, 116, 137, 14, 216, 29, 32,
+			216, 96, 48, 72, 30, 180, 60, 171, 70, 82, 0, 124, 237, 77,
+			93, 110, 83, 35, 204, 33, 57, 110, 248, 197, 77, 65, 100, 32,
+			59, 126, 85, 215, 70, 5, 8, 11, 110, 237, 124, 113, 134, 100,
+			198, 33, 194, 8, 52, 116, 201, 0, 124, 150, 73, 234, 18, 175,
+			60, 43, 211, 109, 220, 148, 152, 117, 135, 235, 124, 147, 104, 96,
+			185, 246, 243, 82, 244, 122, 151, 107, 155, 107, 50, 18, 20, 213,
+			208, 117, 85, 24, 209, 178, 68, 145, 92, 143, 27, 155, 46, 252,
+			40, 214, 241, 249, 232, 163, 88, 199, 231, 101, 250, 255, 113, 83,
+			162, 233, 245, 16, 164, 197, 133, 181, 241, 130, 20, 75, 189, 225,
+			217, 62, 26, 34, 172, 78, 243, 101, 232, 244, 23, 164, 112, 185,
+			105, 161, 217, 185, 136, 155, 18, 205, 37, 253, 206, 35, 208, 233,
+			110, 42, 225, 38, 15, 75, 241, 19, 41, 189, 239, 177, 153, 57,
+			169, 106, 99, 108, 5, 131, 162, 56, 199, 88, 161, 234, 115, 194,
+			163, 10, 60, 202, 198, 127, 135, 40, 167, 10, 103, 69, 46, 84,
+			226, 213, 126, 171, 134, 182, 170, 150, 252, 123, 138, 165, 29, 122,
+			239, 17, 214, 42, 60, 180, 104, 225, 75, 146, 182, 198, 138, 162,
+			112, 139, 24, 52, 43, 62, 40, 8, 141, 21, 165, 114, 52, 24,
+			42, 83, 50, 2, 81, 61, 86, 40, 66, 154, 109, 70, 5, 112,
+			240, 102, 204, 152, 26, 212, 228, 187, 187, 164, 33, 92, 154, 132,
+			41, 176, 200, 97, 153, 2, 16, 8, 60, 33, 93, 251, 101, 105,
+			95, 164, 41, 8, 37, 255, 178, 180, 121, 41, 161, 228, 95, 150,
+			221, 62, 55, 233, 229, 11, 23, 233, 159, 218, 174, 253, 138, 180,
+			231, 235, 159, 218, 73, 106, 182, 114, 19, 238, 72, 217, 214, 205,
+			77, 137, 38, 157, 112, 241, 211, 38, 215, 126, 13, 230, 193, 128,
+			127, 107, 172, 94, 118, 100, 31, 48, 117, 80, 56, 79, 235, 47,
+			213, 19, 244, 200, 107, 172, 108, 93, 212, 184, 181, 95, 99, 101,
+			235, 146, 30, 121, 13, 202, 246, 125, 181, 237, 147, 174, 253, 166,
+			20, 158, 247, 150, 229, 223, 82, 95, 194, 5, 11, 180, 53, 168,
+			214, 75, 50, 13, 248, 44, 149, 11, 112, 4, 141, 134, 58, 200,
+			137, 246, 171, 159, 143, 132, 38, 171, 58, 67, 84, 49, 123, 68,
+			239, 104, 65, 192, 19, 27, 211, 86, 150, 74, 106, 191, 89, 149,
+			168, 160, 2, 229, 225, 87, 75, 197, 48, 45, 70, 152, 233, 155,
+			79, 128, 106, 186, 201, 38, 154, 31, 239, 68, 132, 213, 190, 201,
+			74, 213, 21, 73, 137, 230, 220, 121, 20, 126, 209, 233, 38, 255,
+			81, 38, 126, 45, 103, 9, 218, 61, 141, 72, 237, 68, 157, 11,
+			153, 90, 228, 180, 57, 182, 221, 137, 211, 241, 207, 164, 248, 133,
+			148, 244, 241, 78, 58, 239, 254, 76, 58, 237, 206, 29, 78, 18,
+			255, 13, 145, 251, 79, 210, 94, 226, 93, 239, 175, 171, 225, 105,
+			77, 10, 67, 186, 145, 172, 213, 180, 173, 24, 116, 202, 33, 156,
+			40, 191, 61, 95, 24, 205, 111, 2, 178, 145, 14, 148, 212, 121,
+			146, 122, 119, 163, 7, 22, 30, 116, 246, 69, 15, 36, 30, 92,
+			180, 216, 185, 94, 143, 199, 114, 237, 159, 75, 123, 149, 119, 165,
+			191, 46, 6, 127, 60, 243, 209, 24, 223, 199, 145, 235, 231, 224,
+			254, 240, 1, 117, 127, 193, 197, 209, 3, 137, 7, 151, 94, 230,
+			108, 212, 223, 23, 174, 253, 158, 180, 61, 239, 22, 174, 59, 14,
+			77, 81, 168, 86, 130, 209, 45, 131, 254, 141, 249, 202, 61, 81,
+			109, 158, 141, 180, 204, 149, 141, 154, 77, 161, 76, 55, 126, 43,
+			152, 49, 83, 205, 70, 99, 80, 16, 143, 239, 73, 187, 45, 122,
+			96, 225, 65, 123, 87, 244, 64, 226, 65, 247, 60, 165, 22, 59,
+			177, 70, 31, 194, 122, 120, 85, 217, 233, 249, 205, 85, 36, 188,
+			215, 60, 154, 211, 155, 0, 169, 94, 200, 6, 54, 53, 36, 125,
+			60, 24, 81, 226, 18, 74, 112, 75, 228, 198, 209, 105, 232, 148,
+			150, 140, 36, 37, 92, 245, 53, 50, 119, 69, 36, 215, 194, 26,
+			103, 14, 109, 139, 98, 53, 40, 23, 9, 44, 107, 216, 87, 56,
+			147, 110, 24, 201, 65, 40, 162, 203, 34, 232, 165, 147, 171, 93,
+			155, 175, 108, 211, 251, 162, 147, 44, 170, 15, 217, 162, 234, 36,
+			139, 234, 67, 217, 146, 225, 166, 133, 89, 119, 116, 115, 83, 162,
+			217, 211, 235, 60, 169, 104, 2, 223, 28, 104, 242, 231, 160, 73,
+			40, 42, 248, 16, 0, 181, 165, 196, 67, 25, 119, 41, 65, 220,
+			1, 104, 28, 102, 112, 120, 133, 112, 169, 147, 2, 48, 39, 128,
+			73, 85, 115, 80, 100, 97, 177, 145, 223, 154, 47, 20, 115, 254,
+			166, 137, 208, 161, 165, 75, 24, 152, 198, 116, 39, 105, 224, 163,
+			188, 239, 59, 201, 146, 58, 202, 150, 84, 39, 113, 222, 81, 169,
+			113, 59, 89, 55, 249, 145, 76, 236, 180, 27, 227, 118, 234, 83,
+			12, 25, 155, 62, 11, 151, 162, 76, 45, 36, 193, 157, 5, 191,
+			28, 147, 98, 141, 55, 160, 121, 152, 236, 164, 18, 163, 135, 25,
+			226, 194, 25, 137, 174, 216, 92, 96, 116, 75, 150, 76, 149, 99,
+			60, 230, 44, 45, 194, 49, 54, 85, 178, 180, 91, 143, 201, 14,
+			151, 155, 41, 124, 168, 115, 152, 46, 79, 178, 124, 121, 114, 76,
+			118, 93, 233, 252, 49, 13, 4, 142, 59, 216, 50, 55, 205, 102,
+			86, 104, 91, 70, 143, 14, 76, 138, 204, 66, 155, 11, 240, 106,
+			238, 40, 195, 81, 59, 162, 46, 59, 239, 34, 142, 90, 20, 142,
+			21, 244, 61, 30, 141, 21, 244, 61, 206, 22, 78, 150, 232, 123,
+			92, 122, 61, 132, 88, 203, 194, 194, 57, 9, 11, 103, 229, 153,
+			90, 56, 225, 120, 116, 127, 216, 182, 39, 217, 170, 201, 210, 166,
+			61, 201, 86, 77, 150, 182, 236, 73, 185, 164, 223, 153, 135, 229,
+			72, 187, 246, 39, 82, 180, 246, 205, 241, 163, 164, 150, 192, 199,
+			218, 118, 54, 13, 7, 163, 108, 153, 163, 122, 77, 131, 192, 212,
+			124, 2, 108, 157, 69, 55, 159, 74, 209, 227, 125, 223, 210, 112,
+			247, 200, 93, 21, 141, 142, 168, 145, 175, 250, 163, 1, 48, 230,
+			96, 61, 66, 177, 7, 62, 82, 18, 114, 44, 104, 52, 23, 72,
+			109, 174, 90, 136, 247, 38, 85, 253, 59, 221, 141, 127, 95, 80,
+			46, 25, 191, 207, 209, 126, 41, 6, 219, 81, 120, 44, 58, 140,
+			235, 121, 34, 114, 231, 83, 86, 236, 89, 114, 48, 124, 42, 83,
+			115, 185, 73, 195, 159, 231, 57, 63, 81, 179, 177, 93, 251, 1,
+			91, 204, 245, 158, 107, 228, 96, 168, 163, 122, 228, 100, 152, 205,
+			107, 80, 75, 142, 243, 233, 57, 200, 146, 231, 224, 1, 59, 156,
+			51, 60, 7, 15, 216, 218, 152, 201, 146, 231, 224, 1, 59, 219,
+			229, 172, 113, 132, 221, 229, 38, 119, 217, 137, 221, 182, 229, 13,
+			205, 122, 40, 110, 180, 145, 187, 44, 215, 222, 101, 167, 250, 156,
+			7, 64, 192, 46, 236, 228, 135, 108, 145, 243, 202, 53, 169, 10,
+			180, 54, 28, 51, 195, 98, 153, 30, 57, 83, 22, 143, 4, 200,
+			34, 64, 65, 110, 180, 196, 142, 94, 99, 150, 203, 122, 7, 210,
+			206, 210, 29, 248, 219, 34, 33, 221, 69, 66, 250, 33, 91, 11,
+			233, 46, 18, 210, 15, 217, 45, 221, 220, 180, 48, 192, 121, 75,
+			184, 41, 209, 92, 54, 176, 41, 57, 94, 46, 85, 75, 151, 252,
+			207, 0, 141, 210, 164, 130, 30, 77, 1, 0},
 	)
 }
 
@@ -309,6 +309,26 @@ func (mr *MockResultDBClientMockRecorder) GetTestResultHistory(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestResultHistory", reflect.TypeOf((*MockResultDBClient)(nil).GetTestResultHistory), varargs...)
 }
 
+// QueryTestFlakiness mocks base method.
+func (m *MockResultDBClient) QueryTestFlakiness(ctx context.Context, in *QueryTestFlakinessRequest, opts ...grpc.CallOption) (*QueryTestFlakinessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QueryTestFlakiness", varargs...)
+	ret0, _ := ret[0].(*QueryTestFlakinessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTestFlakiness indicates an expected call of QueryTestFlakiness.
+func (mr *MockResultDBClientMockRecorder) QueryTestFlakiness(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTestFlakiness", reflect.TypeOf((*MockResultDBClient)(nil).QueryTestFlakiness), varargs...)
+}
+
 // MockResultDBServer is a mock of ResultDBServer interface.
 type MockResultDBServer struct {
 	ctrl     *gomock.Controller
@@ -511,3 +531,18 @@ func (mr *MockResultDBServerMockRecorder) GetTestResultHistory(arg0, arg1 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestResultHistory", reflect.TypeOf((*MockResultDBServer)(nil).GetTestResultHistory), arg0, arg1)
 }
+
+// QueryTestFlakiness mocks base method.
+func (m *MockResultDBServer) QueryTestFlakiness(arg0 context.Context, arg1 *QueryTestFlakinessRequest) (*QueryTestFlakinessResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTestFlakiness", arg0, arg1)
+	ret0, _ := ret[0].(*QueryTestFlakinessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTestFlakiness indicates an expected call of QueryTestFlakiness.
+func (mr *MockResultDBServerMockRecorder) QueryTestFlakiness(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTestFlakiness", reflect.TypeOf((*MockResultDBServer)(nil).QueryTestFlakiness), arg0, arg1)
+}
@@ -226,3 +226,20 @@ func (s *DecoratedResultDB) GetTestResultHistory(ctx context.Context, req *GetTe
 	}
 	return
 }
+
+func (s *DecoratedResultDB) QueryTestFlakiness(ctx context.Context, req *QueryTestFlakinessRequest) (rsp *QueryTestFlakinessResponse, err error) {
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "QueryTestFlakiness", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		rsp, err = s.Service.QueryTestFlakiness(ctx, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "QueryTestFlakiness", rsp, err)
+	}
+	return
+}
@@ -39,6 +39,9 @@ type ArtifactUploader struct {
 	Client *http.Client
 	// Host is the host of a ResultDB instance to upload artifacts to.
 	Host string
+	// Insecure is set to true to use http:// (not https://), e.g. for a local
+	// ResultDB instance.
+	Insecure bool
 
 	// MaxInMemoryFileSize is the maximum size of an artifact file that can be loaded into
 	// memory.
@@ -53,7 +56,11 @@ type ArtifactUploader struct {
 }
 
 func (u *ArtifactUploader) newRequest(ctx context.Context, name, contentType string, input io.ReadSeeker, updateToken string) (*http.Request, error) {
-	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("https://%s/%s", u.Host, name), input)
+	scheme := "https"
+	if u.Insecure {
+		scheme = "http"
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s://%s/%s", scheme, u.Host, name), input)
 	if err != nil {
 		return nil, errors.Annotate(err, "failed to create a request").Err()
 	}
@@ -90,5 +90,14 @@ func TestArtifactUploader(t *testing.T) {
 			So(sent.Header.Get("Content-Type"), ShouldEqual, contentType)
 			So(sent.Header.Get("Update-Token"), ShouldEqual, token)
 		})
+
+		Convey("Upload uses http if insecure", func() {
+			uploader.Insecure = true
+			err := uploader.Upload(ctx, name, contentType, []byte(content), token)
+			So(err, ShouldBeNil)
+
+			sent := <-reqCh
+			So(sent.URL.String(), ShouldEqual, fmt.Sprintf("http://example.org/%s", name))
+		})
 	})
 }
@@ -0,0 +1,172 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package formats converts test results in foreign formats to ResultSink
+// TestResults.
+package formats
+
+import (
+	"bytes"
+	"html"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	durationpb "github.com/golang/protobuf/ptypes/duration"
+
+	"go.chromium.org/luci/common/errors"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+const (
+	// OriginalFormatTagKey is a key of the tag indicating the format of the
+	// source data.
+	OriginalFormatTagKey = "orig_format"
+
+	// maxLenSummaryHTML is the maximum length of a generated summary_html.
+	// It must not exceed the limit enforced by ResultDB.
+	maxLenSummaryHTML = 4 * 1024
+)
+
+// Converter incrementally converts a stream of test results in a foreign
+// format to sinkpb.TestResults.
+type Converter interface {
+	// Feed consumes the next chunk of the input, and returns the test results
+	// that are complete so far.
+	Feed(data []byte) ([]*sinkpb.TestResult, error)
+
+	// Close marks the end of the input, and returns the remaining test results.
+	Close() ([]*sinkpb.TestResult, error)
+}
+
+// NewConverter returns a Converter for a format.
+func NewConverter(format sinkpb.TestResultFile_Format) (Converter, error) {
+	switch format {
+	case sinkpb.TestResultFile_JUNIT_XML:
+		return &junitConverter{}, nil
+	case sinkpb.TestResultFile_TAP:
+		return &lineConverter{parser: &tapParser{}}, nil
+	case sinkpb.TestResultFile_GO_TEST_JSON:
+		return &lineConverter{parser: newGoTestParser()}, nil
+	default:
+		return nil, errors.Reason("unsupported format %s", format).Err()
+	}
+}
+
+// Convert converts an entire input in a foreign format.
+func Convert(format sinkpb.TestResultFile_Format, data []byte) ([]*sinkpb.TestResult, error) {
+	c, err := NewConverter(format)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := c.Feed(data)
+	if err != nil {
+		return nil, err
+	}
+	rest, err := c.Close()
+	if err != nil {
+		return nil, err
+	}
+	return append(ret, rest...), nil
+}
+
+// lineParser parses a line-oriented format.
+type lineParser interface {
+	// parseLine parses a line without its trailing newline.
+	parseLine(line []byte) ([]*sinkpb.TestResult, error)
+	// close returns the test results that are still pending at the end of the
+	// input.
+	close() ([]*sinkpb.TestResult, error)
+}
+
+// lineConverter is a Converter for line-oriented formats.
+type lineConverter struct {
+	parser lineParser
+	// partial is an incomplete line from the previous Feed call.
+	partial []byte
+}
+
+func (c *lineConverter) Feed(data []byte) (ret []*sinkpb.TestResult, err error) {
+	c.partial = append(c.partial, data...)
+	for {
+		i := bytes.IndexByte(c.partial, '\n')
+		if i < 0 {
+			return ret, nil
+		}
+		line := bytes.TrimSuffix(c.partial[:i], []byte("\r"))
+		c.partial = c.partial[i+1:]
+
+		trs, err := c.parser.parseLine(line)
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, trs...)
+	}
+}
+
+func (c *lineConverter) Close() (ret []*sinkpb.TestResult, err error) {
+	if len(c.partial) > 0 {
+		if ret, err = c.parser.parseLine(c.partial); err != nil {
+			return
+		}
+		c.partial = nil
+	}
+	rest, err := c.parser.close()
+	return append(ret, rest...), err
+}
+
+// textArtifact returns an artifact with text contents.
+func textArtifact(text string) *sinkpb.Artifact {
+	return &sinkpb.Artifact{
+		Body:        &sinkpb.Artifact_Contents{Contents: []byte(text)},
+		ContentType: "text/plain",
+	}
+}
+
+// preSummary returns a summary_html showing text preformatted, truncated to
+// fit the summary_html size limit.
+func preSummary(text string) string {
+	const prefix, suffix, ellipsis = "<pre>", "</pre>", "..."
+	escaped := html.EscapeString(text)
+	if max := maxLenSummaryHTML - len(prefix) - len(suffix); len(escaped) > max {
+		// Truncate the original text, so that no rune or escape sequence gets
+		// cut.
+		n := 0
+		for i, r := range text {
+			l := len(html.EscapeString(string(r)))
+			if n+l > max-len(ellipsis) {
+				text = text[:i]
+				break
+			}
+			n += l
+		}
+		escaped = html.EscapeString(text) + ellipsis
+	}
+	return prefix + escaped + suffix
+}
+
+// durationFromSeconds converts a duration in seconds to a Duration proto.
+// It returns nil if secs is not positive.
+func durationFromSeconds(secs float64) *durationpb.Duration {
+	if secs <= 0 {
+		return nil
+	}
+	return ptypes.DurationProto(time.Duration(secs * float64(time.Second)))
+}
+
+// formatTag returns a tag indicating the original format.
+func formatTag(format sinkpb.TestResultFile_Format) *pb.StringPair {
+	return &pb.StringPair{Key: OriginalFormatTagKey, Value: format.String()}
+}
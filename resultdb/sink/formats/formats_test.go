@@ -0,0 +1,76 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"strings"
+	"testing"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestConverter(t *testing.T) {
+	t.Parallel()
+
+	Convey(`NewConverter`, t, func() {
+		Convey(`rejects unsupported formats`, func() {
+			_, err := NewConverter(sinkpb.TestResultFile_GOOGLE_TEST)
+			So(err, ShouldErrLike, "unsupported format GOOGLE_TEST")
+		})
+
+		Convey(`converts line-oriented input fed in arbitrary chunks`, func() {
+			c, err := NewConverter(sinkpb.TestResultFile_TAP)
+			So(err, ShouldBeNil)
+
+			trs, err := c.Feed([]byte("TAP version 13\nok 1 - fo"))
+			So(err, ShouldBeNil)
+			So(trs, ShouldBeEmpty)
+
+			trs, err = c.Feed([]byte("o\r\nnot ok 2 - bar\n"))
+			So(err, ShouldBeNil)
+			So(trs, ShouldHaveLength, 1)
+			So(trs[0].TestId, ShouldEqual, "foo")
+
+			trs, err = c.Close()
+			So(err, ShouldBeNil)
+			So(trs, ShouldHaveLength, 1)
+			So(trs[0].TestId, ShouldEqual, "bar")
+			So(trs[0].Status, ShouldEqual, pb.TestStatus_FAIL)
+		})
+
+		Convey(`converts the last line without a newline`, func() {
+			trs, err := Convert(sinkpb.TestResultFile_TAP, []byte("ok 1 - foo"))
+			So(err, ShouldBeNil)
+			So(trs, ShouldHaveLength, 1)
+			So(trs[0].TestId, ShouldEqual, "foo")
+		})
+	})
+
+	Convey(`preSummary`, t, func() {
+		Convey(`escapes the text`, func() {
+			So(preSummary("a < b"), ShouldEqual, "<pre>a &lt; b</pre>")
+		})
+
+		Convey(`truncates long text`, func() {
+			s := preSummary(strings.Repeat("<", maxLenSummaryHTML))
+			So(len(s), ShouldBeLessThanOrEqualTo, maxLenSummaryHTML)
+			So(s, ShouldEndWith, "&lt;...</pre>")
+		})
+	})
+}
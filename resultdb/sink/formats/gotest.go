@@ -0,0 +1,138 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"go.chromium.org/luci/common/errors"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+// goTestEvent is an event emitted by `go test -json`.
+//
+// See https://golang.org/cmd/test2json/ for details.
+type goTestEvent struct {
+	Time    time.Time
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// goTestState is the state of a test that has not finished yet.
+type goTestState struct {
+	start  time.Time
+	output strings.Builder
+}
+
+// goTestParser is a lineParser for the output of `go test -json`.
+type goTestParser struct {
+	// tests maps test IDs to the state of running tests.
+	tests map[string]*goTestState
+}
+
+func newGoTestParser() *goTestParser {
+	return &goTestParser{tests: make(map[string]*goTestState)}
+}
+
+func (p *goTestParser) parseLine(line []byte) ([]*sinkpb.TestResult, error) {
+	// `go test` may interleave non-JSON output, e.g. build errors.
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 || line[0] != '{' {
+		return nil, nil
+	}
+
+	var ev goTestEvent
+	if err := json.Unmarshal(line, &ev); err != nil {
+		return nil, errors.Annotate(err, "invalid go test event %q", line).Err()
+	}
+	if ev.Test == "" {
+		// A package-level event.
+		return nil, nil
+	}
+
+	testID := ev.Package + "." + ev.Test
+	st := p.tests[testID]
+	if st == nil {
+		st = &goTestState{start: ev.Time}
+		p.tests[testID] = st
+	}
+
+	var status pb.TestStatus
+	var expected bool
+	switch ev.Action {
+	case "output":
+		st.output.WriteString(ev.Output)
+		return nil, nil
+	case "pass":
+		status, expected = pb.TestStatus_PASS, true
+	case "fail":
+		status, expected = pb.TestStatus_FAIL, false
+	case "skip":
+		status, expected = pb.TestStatus_SKIP, true
+	default:
+		// "run", "pause", "cont" and "bench" don't affect the result.
+		return nil, nil
+	}
+
+	delete(p.tests, testID)
+	tr := st.toProto(testID, status, expected)
+	tr.Duration = durationFromSeconds(ev.Elapsed)
+	return []*sinkpb.TestResult{tr}, nil
+}
+
+func (p *goTestParser) close() ([]*sinkpb.TestResult, error) {
+	// Tests that never finished were aborted, e.g. by a timeout.
+	ids := make([]string, 0, len(p.tests))
+	for id := range p.tests {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	ret := make([]*sinkpb.TestResult, len(ids))
+	for i, id := range ids {
+		ret[i] = p.tests[id].toProto(id, pb.TestStatus_ABORT, false)
+	}
+	p.tests = make(map[string]*goTestState)
+	return ret, nil
+}
+
+func (st *goTestState) toProto(testID string, status pb.TestStatus, expected bool) *sinkpb.TestResult {
+	tr := &sinkpb.TestResult{
+		TestId:   testID,
+		Expected: expected,
+		Status:   status,
+		Tags:     []*pb.StringPair{formatTag(sinkpb.TestResultFile_GO_TEST_JSON)},
+	}
+	if !st.start.IsZero() {
+		tr.StartTime, _ = ptypes.TimestampProto(st.start)
+	}
+	if st.output.Len() > 0 {
+		tr.Artifacts = map[string]*sinkpb.Artifact{
+			"stdout": textArtifact(st.output.String()),
+		}
+	}
+	return tr
+}
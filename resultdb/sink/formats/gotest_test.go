@@ -0,0 +1,86 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestGoTestConversions(t *testing.T) {
+	t.Parallel()
+
+	tag := []*pb.StringPair{{Key: OriginalFormatTagKey, Value: "GO_TEST_JSON"}}
+
+	Convey(`go test -json`, t, func() {
+		input := strings.Join([]string{
+			`{"Time":"2020-06-01T10:00:00Z","Action":"run","Package":"example.com/foo","Test":"TestPass"}`,
+			`{"Time":"2020-06-01T10:00:00Z","Action":"output","Package":"example.com/foo","Test":"TestPass","Output":"=== RUN   TestPass\n"}`,
+			`{"Time":"2020-06-01T10:00:01Z","Action":"pass","Package":"example.com/foo","Test":"TestPass","Elapsed":1.5}`,
+			`{"Time":"2020-06-01T10:00:01Z","Action":"run","Package":"example.com/foo","Test":"TestFail/sub"}`,
+			`{"Time":"2020-06-01T10:00:02Z","Action":"fail","Package":"example.com/foo","Test":"TestFail/sub","Elapsed":0}`,
+			`{"Time":"2020-06-01T10:00:02Z","Action":"run","Package":"example.com/foo","Test":"TestSkip"}`,
+			`{"Time":"2020-06-01T10:00:02Z","Action":"skip","Package":"example.com/foo","Test":"TestSkip","Elapsed":0.01}`,
+			`# example.com/bar`,
+			`{"Time":"2020-06-01T10:00:03Z","Action":"run","Package":"example.com/foo","Test":"TestHang"}`,
+			`{"Time":"2020-06-01T10:00:03Z","Action":"fail","Package":"example.com/foo","Elapsed":3}`,
+		}, "\n")
+
+		trs, err := Convert(sinkpb.TestResultFile_GO_TEST_JSON, []byte(input))
+		So(err, ShouldBeNil)
+
+		start, _ := ptypes.TimestampProto(time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC))
+		So(trs, ShouldHaveLength, 4)
+		So(trs[0], ShouldResembleProto, &sinkpb.TestResult{
+			TestId:    "example.com/foo.TestPass",
+			Expected:  true,
+			Status:    pb.TestStatus_PASS,
+			StartTime: start,
+			Duration:  ptypes.DurationProto(1500 * time.Millisecond),
+			Tags:      tag,
+			Artifacts: map[string]*sinkpb.Artifact{
+				"stdout": {
+					Body:        &sinkpb.Artifact_Contents{Contents: []byte("=== RUN   TestPass\n")},
+					ContentType: "text/plain",
+				},
+			},
+		})
+		So(trs[1].TestId, ShouldEqual, "example.com/foo.TestFail/sub")
+		So(trs[1].Status, ShouldEqual, pb.TestStatus_FAIL)
+		So(trs[1].Expected, ShouldBeFalse)
+		So(trs[1].Duration, ShouldBeNil)
+		So(trs[1].Artifacts, ShouldBeNil)
+		So(trs[2].TestId, ShouldEqual, "example.com/foo.TestSkip")
+		So(trs[2].Status, ShouldEqual, pb.TestStatus_SKIP)
+		So(trs[2].Expected, ShouldBeTrue)
+		So(trs[3].TestId, ShouldEqual, "example.com/foo.TestHang")
+		So(trs[3].Status, ShouldEqual, pb.TestStatus_ABORT)
+		So(trs[3].Expected, ShouldBeFalse)
+	})
+
+	Convey(`invalid events are rejected`, t, func() {
+		_, err := Convert(sinkpb.TestResultFile_GO_TEST_JSON, []byte(`{"Action":`))
+		So(err, ShouldErrLike, "invalid go test event")
+	})
+}
@@ -0,0 +1,158 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strconv"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+// junitSuite is a <testsuite> or a <testsuites> element of a JUnit XML file.
+//
+// Suites may be nested.
+type junitSuite struct {
+	Name   string       `xml:"name,attr"`
+	Suites []junitSuite `xml:"testsuite"`
+	Cases  []junitCase  `xml:"testcase"`
+}
+
+// junitCase is a <testcase> element of a JUnit XML file.
+type junitCase struct {
+	Name      string         `xml:"name,attr"`
+	Classname string         `xml:"classname,attr"`
+	Time      string         `xml:"time,attr"`
+	Failures  []junitProblem `xml:"failure"`
+	Errors    []junitProblem `xml:"error"`
+	Skipped   *junitProblem  `xml:"skipped"`
+	SystemOut string         `xml:"system-out"`
+	SystemErr string         `xml:"system-err"`
+}
+
+// junitProblem is a <failure>, <error> or <skipped> element of a JUnit XML
+// file.
+type junitProblem struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// junitConverter is a Converter for JUnit XML.
+//
+// XML documents can only be converted once complete, so all the test results
+// are returned by Close.
+type junitConverter struct {
+	buf bytes.Buffer
+}
+
+func (c *junitConverter) Feed(data []byte) ([]*sinkpb.TestResult, error) {
+	c.buf.Write(data)
+	return nil, nil
+}
+
+func (c *junitConverter) Close() ([]*sinkpb.TestResult, error) {
+	if len(bytes.TrimSpace(c.buf.Bytes())) == 0 {
+		return nil, nil
+	}
+
+	var root junitSuite
+	if err := xml.Unmarshal(c.buf.Bytes(), &root); err != nil {
+		return nil, errors.Annotate(err, "invalid JUnit XML").Err()
+	}
+	c.buf.Reset()
+
+	var ret []*sinkpb.TestResult
+	root.toProtos(&ret)
+	return ret, nil
+}
+
+func (s *junitSuite) toProtos(dest *[]*sinkpb.TestResult) {
+	for i := range s.Suites {
+		s.Suites[i].toProtos(dest)
+	}
+	for _, tc := range s.Cases {
+		*dest = append(*dest, tc.toProto(s.Name))
+	}
+}
+
+func (tc *junitCase) toProto(suiteName string) *sinkpb.TestResult {
+	testID := tc.Name
+	switch {
+	case tc.Classname != "":
+		testID = tc.Classname + "." + tc.Name
+	case suiteName != "":
+		testID = suiteName + "." + tc.Name
+	}
+
+	tr := &sinkpb.TestResult{
+		TestId:   testID,
+		Expected: true,
+		Status:   pb.TestStatus_PASS,
+		Tags:     []*pb.StringPair{formatTag(sinkpb.TestResultFile_JUNIT_XML)},
+	}
+	if secs, err := strconv.ParseFloat(tc.Time, 64); err == nil {
+		tr.Duration = durationFromSeconds(secs)
+	}
+
+	artifacts := map[string]*sinkpb.Artifact{}
+	var problems []junitProblem
+	switch {
+	case len(tc.Errors) > 0:
+		tr.Status, tr.Expected = pb.TestStatus_CRASH, false
+		problems = tc.Errors
+	case len(tc.Failures) > 0:
+		tr.Status, tr.Expected = pb.TestStatus_FAIL, false
+		problems = tc.Failures
+	case tc.Skipped != nil:
+		tr.Status = pb.TestStatus_SKIP
+		if msg := tc.Skipped.Message; msg != "" {
+			tr.SummaryHtml = preSummary(msg)
+		}
+	}
+	if len(problems) > 0 {
+		var msgs, texts []string
+		for _, p := range problems {
+			if p.Message != "" {
+				msgs = append(msgs, p.Message)
+			}
+			if t := strings.TrimSpace(p.Text); t != "" {
+				texts = append(texts, t)
+			}
+		}
+		if len(msgs) > 0 {
+			tr.SummaryHtml = preSummary(strings.Join(msgs, "\n"))
+		}
+		if len(texts) > 0 {
+			artifacts["failure"] = textArtifact(strings.Join(texts, "\n\n"))
+		}
+	}
+
+	if out := strings.TrimSpace(tc.SystemOut); out != "" {
+		artifacts["stdout"] = textArtifact(tc.SystemOut)
+	}
+	if out := strings.TrimSpace(tc.SystemErr); out != "" {
+		artifacts["stderr"] = textArtifact(tc.SystemErr)
+	}
+	if len(artifacts) > 0 {
+		tr.Artifacts = artifacts
+	}
+	return tr
+}
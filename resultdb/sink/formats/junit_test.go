@@ -0,0 +1,118 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestJUnitConversions(t *testing.T) {
+	t.Parallel()
+
+	tag := []*pb.StringPair{{Key: OriginalFormatTagKey, Value: "JUNIT_XML"}}
+
+	Convey(`JUnit XML`, t, func() {
+		input := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="suite">
+    <testcase classname="com.example.Foo" name="testPass" time="0.25">
+      <system-out>hello</system-out>
+    </testcase>
+    <testcase name="testFail" time="1">
+      <failure message="expected 1 &lt; 2" type="AssertionError">stack trace</failure>
+      <system-err>oops</system-err>
+    </testcase>
+    <testcase classname="com.example.Foo" name="testError">
+      <error message="boom"/>
+    </testcase>
+    <testcase classname="com.example.Foo" name="testSkip">
+      <skipped/>
+    </testcase>
+  </testsuite>
+  <testsuite name="other">
+    <testsuite name="nested">
+      <testcase name="testNested"/>
+    </testsuite>
+  </testsuite>
+</testsuites>`
+
+		c, err := NewConverter(sinkpb.TestResultFile_JUNIT_XML)
+		So(err, ShouldBeNil)
+		trs, err := c.Feed([]byte(input))
+		So(err, ShouldBeNil)
+		So(trs, ShouldBeEmpty)
+		trs, err = c.Close()
+		So(err, ShouldBeNil)
+		So(trs, ShouldHaveLength, 5)
+
+		So(trs[0], ShouldResembleProto, &sinkpb.TestResult{
+			TestId:   "com.example.Foo.testPass",
+			Expected: true,
+			Status:   pb.TestStatus_PASS,
+			Duration: ptypes.DurationProto(250 * time.Millisecond),
+			Tags:     tag,
+			Artifacts: map[string]*sinkpb.Artifact{
+				"stdout": {
+					Body:        &sinkpb.Artifact_Contents{Contents: []byte("hello")},
+					ContentType: "text/plain",
+				},
+			},
+		})
+		So(trs[1], ShouldResembleProto, &sinkpb.TestResult{
+			TestId:      "suite.testFail",
+			Status:      pb.TestStatus_FAIL,
+			SummaryHtml: "<pre>expected 1 &lt; 2</pre>",
+			Duration:    ptypes.DurationProto(time.Second),
+			Tags:        tag,
+			Artifacts: map[string]*sinkpb.Artifact{
+				"failure": {
+					Body:        &sinkpb.Artifact_Contents{Contents: []byte("stack trace")},
+					ContentType: "text/plain",
+				},
+				"stderr": {
+					Body:        &sinkpb.Artifact_Contents{Contents: []byte("oops")},
+					ContentType: "text/plain",
+				},
+			},
+		})
+		So(trs[2].Status, ShouldEqual, pb.TestStatus_CRASH)
+		So(trs[2].Expected, ShouldBeFalse)
+		So(trs[3].Status, ShouldEqual, pb.TestStatus_SKIP)
+		So(trs[3].Expected, ShouldBeTrue)
+		So(trs[4].TestId, ShouldEqual, "nested.testNested")
+	})
+
+	Convey(`A single testsuite root`, t, func() {
+		trs, err := Convert(sinkpb.TestResultFile_JUNIT_XML,
+			[]byte(`<testsuite name="s"><testcase name="t"/></testsuite>`))
+		So(err, ShouldBeNil)
+		So(trs, ShouldHaveLength, 1)
+		So(trs[0].TestId, ShouldEqual, "s.t")
+	})
+
+	Convey(`Invalid XML`, t, func() {
+		_, err := Convert(sinkpb.TestResultFile_JUNIT_XML, []byte(`<testsuite>`))
+		So(err, ShouldErrLike, "invalid JUnit XML")
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+var (
+	// tapTestLineRe matches a TAP test line, e.g. "not ok 2 - desc # TODO x".
+	tapTestLineRe = regexp.MustCompile(`^(not )?ok\b\s*(\d+)?\s*(?:-\s*)?([^#]*?)\s*(?:#\s*(.*))?$`)
+	// tapDurationRe matches the commonly used duration_ms YAML diagnostic.
+	tapDurationRe = regexp.MustCompile(`^\s*duration_ms:\s*([0-9.]+)\s*$`)
+)
+
+// tapParser is a lineParser for the Test Anything Protocol, version 13.
+//
+// See https://testanything.org/tap-version-13-specification.html.
+type tapParser struct {
+	// count is the number of test lines seen so far.
+	count int
+	// pending is the last test result, which may still be followed by a YAML
+	// diagnostic block.
+	pending *sinkpb.TestResult
+	// yaml is the YAML diagnostic block of pending, if one was started.
+	yaml *strings.Builder
+}
+
+func (p *tapParser) parseLine(line []byte) ([]*sinkpb.TestResult, error) {
+	s := string(line)
+
+	// Handle a YAML diagnostic block following a test line.
+	if p.yaml != nil {
+		if strings.TrimSpace(s) == "..." {
+			return p.flush(), nil
+		}
+		p.yaml.WriteString(s)
+		p.yaml.WriteString("\n")
+		return nil, nil
+	}
+	if p.pending != nil && strings.TrimSpace(s) == "---" {
+		p.yaml = &strings.Builder{}
+		return nil, nil
+	}
+
+	// Any other line completes the pending test result.
+	ret := p.flush()
+	m := tapTestLineRe.FindStringSubmatch(s)
+	if m == nil {
+		// A plan, a comment, a version line or an unknown line.
+		return ret, nil
+	}
+	p.count++
+
+	testID := m[3]
+	if testID == "" {
+		testID = m[2]
+	}
+	if testID == "" {
+		testID = strconv.Itoa(p.count)
+	}
+
+	tr := &sinkpb.TestResult{
+		TestId:   testID,
+		Expected: m[1] == "",
+		Status:   pb.TestStatus_PASS,
+		Tags:     []*pb.StringPair{formatTag(sinkpb.TestResultFile_TAP)},
+	}
+	if m[1] != "" {
+		tr.Status = pb.TestStatus_FAIL
+	}
+	switch directive := strings.ToUpper(m[4]); {
+	case strings.HasPrefix(directive, "SKIP"):
+		tr.Status, tr.Expected = pb.TestStatus_SKIP, true
+	case strings.HasPrefix(directive, "TODO"):
+		// Failures of TODO tests are expected.
+		tr.Expected = true
+	}
+	p.pending = tr
+	return ret, nil
+}
+
+func (p *tapParser) close() ([]*sinkpb.TestResult, error) {
+	return p.flush(), nil
+}
+
+// flush returns the pending test result, if any, with its YAML diagnostics.
+func (p *tapParser) flush() []*sinkpb.TestResult {
+	tr := p.pending
+	if tr == nil {
+		return nil
+	}
+	if p.yaml != nil && p.yaml.Len() > 0 {
+		diag := p.yaml.String()
+		tr.Artifacts = map[string]*sinkpb.Artifact{
+			"diagnostics": textArtifact(diag),
+		}
+		if !tr.Expected {
+			tr.SummaryHtml = preSummary(diag)
+		}
+		for _, l := range strings.Split(diag, "\n") {
+			if m := tapDurationRe.FindStringSubmatch(l); m != nil {
+				if ms, err := strconv.ParseFloat(m[1], 64); err == nil && ms > 0 {
+					tr.Duration = ptypes.DurationProto(time.Duration(ms * float64(time.Millisecond)))
+				}
+			}
+		}
+	}
+	p.pending, p.yaml = nil, nil
+	return []*sinkpb.TestResult{tr}
+}
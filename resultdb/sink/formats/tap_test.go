@@ -0,0 +1,91 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestTAPConversions(t *testing.T) {
+	t.Parallel()
+
+	tag := []*pb.StringPair{{Key: OriginalFormatTagKey, Value: "TAP"}}
+
+	Convey(`TAP`, t, func() {
+		input := strings.Join([]string{
+			`TAP version 13`,
+			`1..6`,
+			`ok 1 - Input file opened`,
+			`not ok 2 - First line of the input valid`,
+			`  ---`,
+			`  message: 'First line invalid'`,
+			`  duration_ms: 12.5`,
+			`  ...`,
+			`ok 3 - Read the rest of the file # SKIP no file`,
+			`not ok 4 - Summarized correctly # TODO Not written yet`,
+			`# a comment`,
+			`ok 5`,
+			`ok`,
+			`Bail out! Out of disk space.`,
+		}, "\n")
+
+		trs, err := Convert(sinkpb.TestResultFile_TAP, []byte(input))
+		So(err, ShouldBeNil)
+		So(trs, ShouldHaveLength, 6)
+
+		So(trs[0], ShouldResembleProto, &sinkpb.TestResult{
+			TestId:   "Input file opened",
+			Expected: true,
+			Status:   pb.TestStatus_PASS,
+			Tags:     tag,
+		})
+
+		diag := "  message: 'First line invalid'\n  duration_ms: 12.5\n"
+		So(trs[1], ShouldResembleProto, &sinkpb.TestResult{
+			TestId:      "First line of the input valid",
+			Status:      pb.TestStatus_FAIL,
+			SummaryHtml: "<pre>  message: &#39;First line invalid&#39;\n  duration_ms: 12.5\n</pre>",
+			Duration:    ptypes.DurationProto(12500 * time.Microsecond),
+			Tags:        tag,
+			Artifacts: map[string]*sinkpb.Artifact{
+				"diagnostics": {
+					Body:        &sinkpb.Artifact_Contents{Contents: []byte(diag)},
+					ContentType: "text/plain",
+				},
+			},
+		})
+
+		So(trs[2].TestId, ShouldEqual, "Read the rest of the file")
+		So(trs[2].Status, ShouldEqual, pb.TestStatus_SKIP)
+		So(trs[2].Expected, ShouldBeTrue)
+
+		So(trs[3].TestId, ShouldEqual, "Summarized correctly")
+		So(trs[3].Status, ShouldEqual, pb.TestStatus_FAIL)
+		So(trs[3].Expected, ShouldBeTrue)
+
+		So(trs[4].TestId, ShouldEqual, "5")
+		So(trs[5].TestId, ShouldEqual, "6")
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"os"
+	"path"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+// LoadLocationTags reads a LocationTags JSON file.
+func LoadLocationTags(filename string) (*sinkpb.LocationTags, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	ret := &sinkpb.LocationTags{}
+	if err := jsonpb.Unmarshal(f, ret); err != nil {
+		return nil, errors.Annotate(err, "failed to parse %q", filename).Err()
+	}
+	if err := validateLocationTags(ret); err != nil {
+		return nil, errors.Annotate(err, "invalid %q", filename).Err()
+	}
+	return ret, nil
+}
+
+// validateLocationTags returns a non-nil error if lt is invalid.
+func validateLocationTags(lt *sinkpb.LocationTags) error {
+	validate := func(p string, tags *sinkpb.LocationTags_Tags) error {
+		if !strings.HasPrefix(p, "//") {
+			return errors.Reason("%q: must start with //", p).Err()
+		}
+		md := &pb.TestMetadata{Owners: tags.GetOwners(), BugComponent: tags.GetBugComponent()}
+		return errors.Annotate(pbutil.ValidateTestMetadata(md), "%q", p).Err()
+	}
+
+	for p, tags := range lt.GetDirs() {
+		if err := validate(p, tags); err != nil {
+			return errors.Annotate(err, "dirs").Err()
+		}
+	}
+	for p, tags := range lt.GetFiles() {
+		if err := validate(p, tags); err != nil {
+			return errors.Annotate(err, "files").Err()
+		}
+	}
+	return nil
+}
+
+// testMetadata derives the metadata of a test defined at loc from lt.
+// Returns nil if lt has no tags for loc.
+func testMetadata(lt *sinkpb.LocationTags, loc *pb.TestLocation) *pb.TestMetadata {
+	// Only files in the repository can have tags.
+	if !strings.HasPrefix(loc.GetFileName(), "//") {
+		return nil
+	}
+
+	md := &pb.TestMetadata{}
+	apply := func(tags *sinkpb.LocationTags_Tags) {
+		if len(md.Owners) == 0 {
+			md.Owners = tags.GetOwners()
+		}
+		if md.BugComponent == "" {
+			md.BugComponent = tags.GetBugComponent()
+		}
+	}
+
+	// Walk from the file up to the repository root.
+	apply(lt.GetFiles()[loc.FileName])
+	for dir := path.Dir(strings.TrimPrefix(loc.FileName, "//")); dir != "."; dir = path.Dir(dir) {
+		apply(lt.GetDirs()["//"+dir])
+	}
+	apply(lt.GetDirs()["//"])
+
+	if len(md.Owners) == 0 && md.BugComponent == "" {
+		return nil
+	}
+	md.Location = loc
+	return md
+}
@@ -0,0 +1,103 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestLocationTags(t *testing.T) {
+	t.Parallel()
+
+	Convey("LoadLocationTags", t, func() {
+		dir, err := ioutil.TempDir("", "location-tags")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		filename := filepath.Join(dir, "location_tags.json")
+
+		Convey("works", func() {
+			err := ioutil.WriteFile(filename, []byte(`{
+				"dirs": {"//foo": {"owners": ["foo@example.com"], "bug_component": "Foo"}},
+				"files": {"//foo/a_test.go": {"bug_component": "Foo>A"}}
+			}`), 0600)
+			So(err, ShouldBeNil)
+
+			lt, err := LoadLocationTags(filename)
+			So(err, ShouldBeNil)
+			So(lt, ShouldResembleProto, &sinkpb.LocationTags{
+				Dirs: map[string]*sinkpb.LocationTags_Tags{
+					"//foo": {Owners: []string{"foo@example.com"}, BugComponent: "Foo"},
+				},
+				Files: map[string]*sinkpb.LocationTags_Tags{
+					"//foo/a_test.go": {BugComponent: "Foo>A"},
+				},
+			})
+		})
+
+		Convey("rejects paths without //", func() {
+			err := ioutil.WriteFile(filename, []byte(`{"dirs": {"foo": {}}}`), 0600)
+			So(err, ShouldBeNil)
+
+			_, err = LoadLocationTags(filename)
+			So(err, ShouldErrLike, `dirs: "foo": must start with //`)
+		})
+	})
+
+	Convey("testMetadata", t, func() {
+		lt := &sinkpb.LocationTags{
+			Dirs: map[string]*sinkpb.LocationTags_Tags{
+				"//":        {Owners: []string{"root@example.com"}, BugComponent: "Root"},
+				"//foo":     {Owners: []string{"foo@example.com"}},
+				"//foo/bar": {BugComponent: "Foo>Bar"},
+			},
+			Files: map[string]*sinkpb.LocationTags_Tags{
+				"//foo/bar/special_test.go": {Owners: []string{"special@example.com"}},
+			},
+		}
+		md := func(fileName string) *pb.TestMetadata {
+			return testMetadata(lt, &pb.TestLocation{FileName: fileName})
+		}
+
+		Convey("nearest tags win", func() {
+			So(md("//foo/bar/a_test.go"), ShouldResembleProto, &pb.TestMetadata{
+				Owners:       []string{"foo@example.com"},
+				Location:     &pb.TestLocation{FileName: "//foo/bar/a_test.go"},
+				BugComponent: "Foo>Bar",
+			})
+			So(md("//foo/bar/special_test.go").Owners, ShouldResemble, []string{"special@example.com"})
+		})
+
+		Convey("falls back to the root", func() {
+			So(md("//a_test.go"), ShouldResembleProto, &pb.TestMetadata{
+				Owners:       []string{"root@example.com"},
+				Location:     &pb.TestLocation{FileName: "//a_test.go"},
+				BugComponent: "Root",
+			})
+		})
+
+		Convey("ignores files outside of the repository", func() {
+			So(md("/tmp/a_test.go"), ShouldBeNil)
+		})
+	})
+}
@@ -12,2160 +12,997 @@ func init() {
 			"luci.resultsink.v1.Sink",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 253, 123, 120, 28, 199,
-			117, 32, 138, 163, 95, 131, 65, 225, 53, 104, 80, 18, 56, 148,
-			200, 226, 144, 20, 0, 114, 48, 0, 65, 138, 50, 65, 61, 22,
-			4, 134, 20, 100, 16, 96, 240, 176, 44, 249, 179, 160, 198, 76,
-			205, 160, 197, 158, 238, 113, 119, 15, 192, 145, 173, 196, 191, 56,
-			15, 59, 78, 252, 139, 227, 71, 172, 92, 219, 235, 77, 226, 171,
-			196, 121, 56, 15, 103, 115, 55, 190, 187, 73, 54, 201, 126, 215,
-			217, 36, 55, 217, 205, 250, 110, 18, 103, 55, 159, 99, 229, 177,
-			206, 122, 99, 107, 175, 227, 245, 198, 185, 247, 59, 167, 30, 221,
-			51, 0, 101, 202, 177, 146, 63, 174, 248, 89, 198, 156, 234, 170,
-			83, 85, 167, 78, 157, 58, 117, 234, 212, 41, 242, 187, 167, 200,
-			209, 122, 16, 212, 61, 54, 221, 12, 131, 56, 216, 110, 213, 166,
-			171, 173, 208, 137, 221, 192, 47, 97, 138, 61, 204, 191, 151, 228,
-			247, 194, 28, 201, 46, 138, 44, 246, 24, 233, 141, 88, 37, 240,
-			171, 209, 152, 70, 181, 9, 99, 77, 130, 246, 33, 98, 249, 142,
-			31, 68, 99, 58, 213, 38, 172, 53, 14, 92, 126, 11, 25, 173,
-			4, 141, 82, 23, 202, 203, 131, 18, 225, 117, 72, 185, 174, 61,
-			113, 186, 238, 198, 59, 173, 237, 82, 37, 104, 76, 215, 3, 207,
-			241, 235, 73, 251, 154, 113, 187, 201, 34, 213, 204, 175, 104, 218,
-			15, 235, 198, 213, 235, 151, 63, 166, 31, 189, 202, 241, 94, 23,
-			89, 75, 143, 49, 207, 123, 173, 31, 236, 249, 27, 80, 228, 209,
-			63, 60, 65, 50, 182, 121, 180, 39, 210, 200, 111, 13, 16, 109,
-			192, 54, 142, 246, 216, 179, 191, 58, 64, 177, 64, 37, 240, 232,
-			229, 86, 173, 198, 194, 136, 78, 81, 142, 106, 60, 162, 85, 39,
-			118, 168, 235, 199, 44, 172, 236, 56, 126, 157, 209, 90, 16, 54,
-			156, 152, 208, 133, 160, 217, 14, 221, 250, 78, 76, 103, 103, 102,
-			94, 35, 10, 208, 37, 191, 82, 162, 116, 222, 243, 40, 126, 139,
-			104, 200, 34, 22, 238, 178, 106, 137, 208, 157, 56, 110, 70, 115,
-			211, 211, 85, 182, 203, 188, 160, 201, 194, 72, 82, 2, 250, 217,
-			20, 141, 152, 218, 230, 141, 152, 38, 132, 174, 177, 170, 27, 197,
-			161, 187, 221, 130, 174, 82, 199, 175, 210, 86, 196, 168, 235, 211,
-			40, 104, 133, 21, 134, 41, 219, 174, 239, 132, 109, 108, 87, 84,
-			164, 123, 110, 188, 67, 131, 16, 255, 6, 173, 152, 208, 70, 80,
-			117, 107, 110, 5, 137, 85, 164, 78, 200, 104, 147, 133, 13, 55,
-			142, 89, 149, 54, 195, 96, 215, 173, 178, 42, 141, 119, 156, 152,
-			198, 59, 208, 59, 207, 11, 246, 92, 191, 78, 97, 28, 93, 40,
-			20, 65, 33, 66, 27, 44, 158, 35, 132, 194, 191, 211, 93, 13,
-			139, 104, 80, 147, 45, 170, 4, 85, 70, 27, 173, 40, 166, 33,
-			139, 29, 215, 71, 172, 206, 118, 176, 11, 159, 4, 197, 8, 245,
-			131, 216, 173, 176, 34, 141, 119, 220, 136, 122, 110, 20, 3, 134,
-			116, 141, 126, 181, 171, 57, 85, 55, 170, 120, 142, 219, 96, 97,
-			233, 86, 141, 112, 253, 52, 45, 100, 35, 154, 97, 80, 109, 85,
-			88, 210, 14, 146, 52, 228, 239, 213, 14, 66, 69, 239, 170, 65,
-			165, 213, 96, 126, 236, 200, 65, 154, 14, 66, 26, 196, 59, 44,
-			164, 13, 39, 102, 161, 235, 120, 81, 66, 106, 28, 160, 120, 135,
-			17, 154, 110, 189, 234, 212, 10, 115, 177, 36, 32, 246, 157, 6,
-			131, 6, 165, 121, 203, 15, 146, 111, 72, 119, 55, 142, 160, 71,
-			62, 71, 21, 132, 17, 109, 56, 109, 186, 205, 128, 83, 170, 52,
-			14, 40, 243, 171, 65, 24, 49, 96, 138, 102, 24, 52, 130, 152,
-			81, 78, 147, 56, 162, 85, 22, 186, 187, 172, 74, 107, 97, 208,
-			32, 156, 10, 81, 80, 139, 247, 128, 77, 4, 7, 209, 168, 201,
-			42, 192, 65, 180, 25, 186, 192, 88, 33, 240, 142, 207, 185, 40,
-			138, 176, 237, 132, 110, 60, 178, 180, 78, 215, 87, 175, 108, 60,
-			54, 191, 86, 166, 75, 235, 244, 250, 218, 234, 235, 150, 22, 203,
-			139, 244, 242, 227, 116, 227, 145, 50, 93, 88, 189, 254, 248, 218,
-			210, 213, 71, 54, 232, 35, 171, 203, 139, 229, 181, 117, 58, 191,
-			178, 72, 23, 86, 87, 54, 214, 150, 46, 111, 110, 172, 174, 173,
-			19, 90, 152, 95, 167, 75, 235, 5, 252, 50, 191, 242, 56, 45,
-			191, 254, 250, 90, 121, 125, 157, 174, 174, 209, 165, 107, 215, 151,
-			151, 202, 139, 244, 177, 249, 181, 181, 249, 149, 141, 165, 242, 122,
-			145, 46, 173, 44, 44, 111, 46, 46, 173, 92, 45, 210, 203, 155,
-			27, 116, 101, 117, 131, 208, 229, 165, 107, 75, 27, 229, 69, 186,
-			177, 90, 196, 106, 247, 151, 163, 171, 87, 232, 181, 242, 218, 194,
-			35, 243, 43, 27, 243, 151, 151, 150, 151, 54, 30, 199, 10, 175,
-			44, 109, 172, 64, 101, 87, 86, 215, 8, 157, 167, 215, 231, 215,
-			54, 150, 22, 54, 151, 231, 215, 232, 245, 205, 181, 235, 171, 235,
-			101, 10, 61, 91, 92, 90, 95, 88, 158, 95, 186, 86, 94, 44,
-			209, 165, 21, 186, 178, 74, 203, 175, 43, 175, 108, 208, 245, 71,
-			230, 151, 151, 59, 59, 74, 232, 234, 99, 43, 229, 53, 104, 125,
-			186, 155, 244, 114, 153, 46, 47, 205, 95, 94, 46, 67, 85, 216,
-			207, 197, 165, 181, 242, 194, 6, 116, 40, 249, 181, 176, 180, 88,
-			94, 217, 152, 95, 46, 18, 186, 126, 189, 188, 176, 52, 191, 92,
-			164, 229, 215, 151, 175, 93, 95, 158, 95, 123, 188, 40, 144, 174,
-			151, 191, 101, 179, 188, 178, 177, 52, 191, 76, 23, 231, 175, 205,
-			95, 45, 175, 211, 137, 175, 71, 149, 235, 107, 171, 11, 155, 107,
-			229, 107, 208, 234, 213, 43, 116, 125, 243, 242, 250, 198, 210, 198,
-			230, 70, 153, 94, 93, 93, 93, 68, 98, 175, 151, 215, 94, 183,
-			180, 80, 94, 191, 68, 151, 87, 215, 145, 96, 155, 235, 229, 34,
-			161, 139, 243, 27, 243, 88, 245, 245, 181, 213, 43, 75, 27, 235,
-			151, 224, 247, 229, 205, 245, 37, 36, 220, 210, 202, 70, 121, 109,
-			109, 243, 250, 198, 210, 234, 202, 36, 125, 100, 245, 177, 242, 235,
-			202, 107, 116, 97, 126, 115, 189, 188, 136, 20, 94, 93, 129, 222,
-			2, 175, 148, 87, 215, 30, 7, 180, 64, 7, 28, 129, 34, 125,
-			236, 145, 242, 198, 35, 229, 53, 32, 42, 82, 107, 30, 200, 176,
-			190, 177, 182, 180, 176, 145, 206, 182, 186, 70, 55, 86, 215, 54,
-			72, 170, 159, 116, 165, 124, 117, 121, 233, 106, 121, 101, 161, 12,
-			159, 87, 1, 205, 99, 75, 235, 229, 73, 58, 191, 182, 180, 14,
-			25, 150, 176, 98, 250, 216, 252, 227, 116, 117, 19, 123, 13, 3,
-			181, 185, 94, 38, 252, 119, 138, 117, 139, 56, 158, 116, 233, 10,
-			157, 95, 124, 221, 18, 180, 92, 228, 190, 190, 186, 190, 190, 36,
-			216, 5, 201, 182, 240, 136, 160, 121, 137, 144, 44, 209, 116, 219,
-			160, 61, 99, 240, 43, 107, 27, 133, 158, 75, 164, 143, 232, 217,
-			83, 252, 39, 79, 60, 209, 115, 12, 19, 143, 241, 159, 60, 241,
-			100, 207, 60, 38, 246, 243, 159, 60, 241, 84, 79, 17, 19, 53,
-			254, 147, 39, 222, 219, 83, 194, 68, 241, 147, 39, 142, 247, 20,
-			48, 145, 240, 159, 60, 113, 162, 231, 56, 38, 158, 228, 63, 63,
-			156, 35, 186, 217, 99, 155, 181, 158, 72, 203, 191, 59, 71, 231,
-			169, 92, 110, 81, 58, 178, 136, 249, 113, 68, 29, 26, 185, 117,
-			159, 85, 139, 180, 230, 222, 100, 213, 41, 143, 249, 245, 120, 135,
-			70, 77, 199, 7, 41, 19, 187, 13, 150, 100, 103, 85, 66, 29,
-			40, 83, 9, 90, 62, 202, 76, 177, 238, 163, 192, 172, 133, 78,
-			37, 89, 22, 228, 135, 152, 162, 14, 128, 32, 129, 101, 49, 240,
-			184, 228, 163, 75, 49, 117, 65, 122, 87, 89, 147, 249, 85, 198,
-			17, 58, 126, 155, 86, 28, 143, 249, 85, 39, 68, 172, 149, 192,
-			175, 176, 102, 12, 98, 250, 6, 163, 133, 170, 211, 46, 16, 144,
-			105, 133, 70, 224, 199, 59, 5, 137, 38, 100, 158, 19, 115, 193,
-			183, 225, 54, 88, 20, 59, 141, 38, 23, 212, 98, 133, 171, 186,
-			176, 188, 50, 191, 194, 232, 54, 139, 247, 24, 243, 9, 141, 247,
-			210, 185, 119, 29, 175, 197, 34, 64, 230, 36, 164, 130, 38, 184,
-			49, 173, 56, 62, 200, 86, 167, 10, 162, 60, 8, 105, 212, 218,
-			142, 161, 187, 64, 17, 16, 162, 212, 73, 16, 149, 232, 26, 106,
-			12, 128, 168, 217, 12, 131, 155, 46, 44, 7, 94, 155, 158, 153,
-			58, 59, 83, 156, 153, 153, 161, 109, 230, 132, 17, 136, 207, 19,
-			180, 124, 211, 105, 52, 61, 22, 17, 34, 127, 210, 179, 115, 116,
-			33, 104, 52, 91, 49, 75, 154, 129, 117, 116, 52, 23, 215, 189,
-			102, 196, 90, 213, 0, 23, 223, 146, 88, 164, 147, 254, 68, 177,
-			19, 198, 244, 65, 90, 42, 149, 46, 117, 127, 99, 126, 181, 227,
-			139, 170, 72, 234, 87, 242, 43, 255, 172, 148, 67, 57, 172, 15,
-			2, 6, 5, 77, 241, 186, 36, 124, 169, 171, 16, 50, 128, 40,
-			194, 127, 203, 2, 8, 201, 74, 220, 26, 157, 216, 87, 209, 3,
-			116, 134, 222, 123, 111, 55, 174, 135, 232, 204, 36, 125, 51, 47,
-			118, 64, 235, 206, 60, 72, 207, 94, 218, 247, 85, 84, 253, 32,
-			61, 59, 35, 255, 137, 76, 207, 82, 230, 129, 126, 117, 80, 3,
-			30, 58, 176, 1, 15, 188, 116, 3, 166, 94, 162, 1, 103, 14,
-			106, 64, 106, 248, 103, 147, 225, 79, 198, 11, 199, 63, 1, 207,
-			36, 3, 246, 242, 185, 224, 150, 99, 125, 107, 30, 225, 159, 210,
-			67, 254, 96, 231, 144, 211, 51, 251, 136, 112, 41, 41, 36, 25,
-			32, 53, 232, 233, 2, 251, 184, 32, 41, 211, 73, 231, 14, 158,
-			75, 147, 56, 41, 112, 32, 117, 147, 225, 77, 50, 62, 148, 206,
-			120, 139, 58, 206, 28, 92, 199, 129, 44, 148, 26, 193, 115, 183,
-			154, 192, 85, 39, 102, 32, 81, 75, 240, 127, 85, 230, 225, 22,
-			131, 94, 111, 199, 59, 92, 155, 130, 127, 49, 16, 125, 127, 198,
-			137, 170, 211, 142, 30, 60, 87, 164, 13, 215, 111, 197, 44, 122,
-			240, 236, 204, 100, 231, 52, 163, 15, 170, 218, 38, 186, 62, 149,
-			174, 132, 65, 99, 67, 161, 138, 171, 147, 40, 123, 30, 93, 95,
-			93, 161, 215, 156, 102, 211, 245, 235, 132, 208, 37, 159, 167, 240,
-			157, 78, 17, 165, 166, 106, 63, 236, 190, 64, 162, 49, 31, 216,
-			172, 202, 151, 1, 208, 99, 253, 58, 13, 29, 161, 186, 58, 32,
-			47, 9, 13, 182, 159, 102, 149, 184, 72, 247, 118, 88, 200, 21,
-			112, 145, 145, 1, 81, 133, 246, 28, 181, 106, 53, 247, 38, 45,
-			68, 5, 58, 225, 250, 85, 220, 169, 248, 117, 185, 110, 76, 130,
-			224, 37, 80, 97, 51, 100, 21, 6, 53, 110, 183, 185, 2, 220,
-			106, 108, 179, 48, 181, 196, 136, 189, 79, 178, 202, 68, 148, 221,
-			132, 5, 43, 194, 102, 18, 181, 46, 57, 158, 44, 82, 162, 87,
-			130, 144, 50, 62, 92, 69, 122, 78, 173, 86, 136, 105, 166, 3,
-			87, 180, 19, 180, 188, 42, 221, 102, 68, 245, 221, 237, 32, 20,
-			144, 162, 112, 46, 42, 64, 127, 93, 24, 253, 142, 69, 241, 108,
-			10, 153, 192, 69, 96, 37, 73, 154, 120, 16, 182, 146, 228, 174,
-			179, 128, 23, 240, 116, 97, 37, 180, 225, 86, 194, 78, 188, 183,
-			139, 246, 108, 84, 40, 17, 252, 103, 152, 61, 154, 109, 212, 178,
-			57, 242, 25, 141, 152, 102, 143, 222, 99, 27, 79, 235, 135, 242,
-			191, 163, 209, 117, 212, 10, 84, 165, 160, 10, 192, 160, 165, 212,
-			130, 18, 189, 6, 59, 173, 109, 198, 121, 123, 234, 220, 217, 251,
-			138, 247, 221, 127, 1, 22, 56, 248, 143, 192, 82, 124, 166, 43,
-			145, 186, 126, 197, 107, 69, 238, 46, 43, 209, 149, 32, 102, 115,
-			128, 53, 98, 116, 59, 104, 97, 215, 66, 216, 45, 226, 204, 225,
-			123, 147, 57, 66, 47, 204, 64, 35, 166, 27, 174, 79, 79, 3,
-			208, 112, 253, 233, 157, 144, 158, 166, 179, 231, 233, 78, 56, 93,
-			117, 218, 244, 52, 61, 119, 225, 190, 210, 236, 125, 20, 230, 200,
-			52, 44, 174, 244, 52, 159, 161, 124, 165, 37, 100, 128, 88, 208,
-			59, 11, 186, 215, 43, 33, 205, 54, 158, 206, 14, 75, 200, 176,
-			141, 167, 237, 81, 242, 54, 3, 9, 161, 217, 70, 168, 219, 249,
-			255, 91, 151, 132, 232, 80, 110, 28, 65, 151, 78, 237, 38, 165,
-			220, 164, 233, 69, 18, 130, 201, 217, 20, 81, 143, 69, 17, 159,
-			48, 129, 207, 20, 182, 176, 67, 215, 226, 220, 232, 208, 25, 66,
-			159, 18, 227, 240, 20, 173, 185, 204, 171, 34, 15, 56, 180, 25,
-			68, 110, 236, 238, 226, 22, 207, 103, 117, 7, 127, 63, 133, 13,
-			18, 25, 57, 163, 75, 49, 16, 97, 83, 82, 21, 6, 33, 109,
-			4, 33, 43, 82, 135, 250, 129, 63, 245, 12, 11, 3, 174, 5,
-			1, 215, 96, 7, 58, 176, 241, 173, 53, 204, 4, 217, 61, 216,
-			168, 130, 254, 8, 236, 133, 217, 59, 219, 217, 205, 34, 23, 47,
-			94, 44, 138, 255, 56, 123, 164, 18, 82, 172, 33, 199, 75, 179,
-			96, 20, 228, 120, 105, 48, 38, 217, 65, 9, 25, 182, 17, 230,
-			70, 182, 51, 104, 63, 57, 71, 254, 253, 52, 57, 214, 109, 210,
-			138, 149, 70, 118, 11, 155, 214, 37, 210, 167, 150, 187, 151, 109,
-			212, 250, 214, 131, 141, 90, 67, 10, 163, 180, 106, 157, 249, 250,
-			86, 45, 213, 210, 151, 97, 214, 250, 131, 41, 210, 107, 91, 71,
-			123, 222, 161, 189, 106, 215, 122, 213, 174, 245, 170, 93, 235, 85,
-			187, 214, 171, 118, 173, 87, 237, 90, 255, 232, 118, 173, 203, 137,
-			93, 235, 242, 75, 219, 181, 166, 19, 187, 214, 244, 203, 176, 107,
-			189, 120, 4, 237, 90, 214, 183, 194, 202, 151, 127, 225, 8, 157,
-			79, 237, 89, 59, 44, 91, 205, 192, 245, 99, 148, 106, 110, 131,
-			29, 100, 104, 194, 244, 103, 64, 33, 10, 66, 234, 5, 21, 199,
-			35, 202, 248, 84, 236, 220, 234, 188, 28, 139, 23, 57, 88, 41,
-			44, 209, 141, 29, 38, 16, 73, 139, 21, 104, 108, 113, 64, 29,
-			159, 178, 102, 80, 217, 1, 133, 114, 115, 99, 129, 54, 220, 170,
-			143, 2, 61, 240, 9, 125, 212, 241, 91, 176, 10, 156, 45, 210,
-			179, 23, 239, 159, 41, 74, 57, 221, 12, 3, 143, 53, 99, 183,
-			66, 175, 134, 172, 30, 132, 174, 227, 39, 166, 179, 189, 29, 183,
-			178, 67, 217, 205, 24, 247, 92, 40, 159, 15, 200, 181, 237, 84,
-			110, 236, 57, 33, 228, 8, 80, 87, 6, 237, 16, 228, 31, 172,
-			248, 98, 167, 137, 107, 44, 215, 194, 177, 127, 94, 224, 215, 75,
-			116, 153, 57, 205, 164, 203, 33, 163, 133, 168, 193, 156, 144, 85,
-			11, 52, 10, 248, 250, 235, 7, 212, 99, 78, 147, 72, 109, 51,
-			118, 182, 61, 220, 67, 250, 12, 55, 116, 160, 99, 162, 38, 210,
-			132, 165, 149, 175, 231, 173, 8, 22, 37, 135, 190, 97, 246, 252,
-			212, 78, 208, 10, 169, 231, 250, 204, 9, 9, 69, 236, 111, 156,
-			120, 105, 157, 3, 198, 115, 26, 115, 78, 162, 16, 223, 97, 52,
-			148, 166, 56, 212, 64, 97, 247, 51, 133, 255, 219, 152, 153, 153,
-			195, 255, 61, 1, 93, 191, 120, 241, 226, 197, 169, 179, 179, 83,
-			231, 206, 110, 204, 158, 155, 187, 239, 226, 220, 125, 23, 75, 23,
-			229, 191, 39, 74, 244, 114, 27, 77, 151, 113, 232, 86, 112, 151,
-			26, 139, 46, 34, 246, 34, 221, 99, 148, 249, 81, 11, 55, 187,
-			78, 12, 96, 5, 168, 28, 248, 187, 44, 140, 249, 248, 242, 53,
-			137, 190, 97, 237, 202, 2, 161, 231, 206, 157, 187, 152, 244, 101,
-			111, 111, 175, 228, 178, 184, 86, 10, 194, 250, 116, 88, 171, 192,
-			127, 144, 163, 20, 223, 140, 39, 209, 20, 32, 246, 207, 183, 101,
-			50, 236, 178, 25, 93, 95, 93, 95, 122, 61, 125, 10, 40, 51,
-			49, 249, 212, 126, 243, 144, 210, 60, 133, 29, 35, 209, 153, 35,
-			22, 111, 137, 1, 158, 192, 226, 43, 155, 203, 203, 147, 147, 7,
-			230, 67, 126, 159, 152, 153, 188, 116, 91, 118, 44, 209, 166, 58,
-			139, 1, 75, 80, 171, 58, 237, 84, 219, 162, 56, 108, 85, 98,
-			172, 96, 215, 241, 104, 188, 43, 106, 236, 200, 126, 111, 188, 91,
-			164, 216, 160, 75, 223, 104, 151, 118, 75, 241, 46, 64, 47, 213,
-			35, 158, 169, 21, 177, 138, 216, 81, 118, 244, 240, 220, 45, 123,
-			248, 152, 235, 159, 155, 165, 79, 93, 101, 241, 122, 59, 138, 25,
-			218, 94, 230, 163, 43, 174, 199, 54, 58, 7, 226, 202, 210, 114,
-			121, 99, 233, 90, 153, 214, 98, 209, 140, 91, 149, 185, 183, 22,
-			203, 150, 110, 46, 173, 108, 92, 56, 79, 99, 183, 114, 35, 162,
-			15, 210, 137, 137, 9, 158, 50, 89, 139, 75, 213, 189, 71, 220,
-			250, 206, 162, 19, 99, 169, 73, 250, 192, 3, 244, 220, 236, 36,
-			125, 11, 197, 111, 203, 193, 158, 252, 36, 233, 54, 61, 77, 231,
-			161, 189, 213, 96, 47, 66, 148, 48, 89, 206, 206, 116, 24, 65,
-			74, 42, 3, 151, 82, 103, 47, 236, 159, 70, 10, 27, 20, 63,
-			123, 225, 252, 249, 243, 247, 159, 187, 48, 147, 136, 141, 109, 86,
-			11, 66, 70, 55, 125, 247, 166, 196, 114, 241, 254, 153, 110, 44,
-			165, 111, 108, 48, 39, 120, 255, 233, 196, 4, 39, 202, 180, 50,
-			208, 77, 210, 169, 116, 115, 190, 14, 7, 3, 30, 32, 151, 196,
-			115, 42, 133, 7, 25, 96, 178, 131, 1, 206, 223, 146, 1, 30,
-			117, 118, 29, 250, 20, 31, 200, 82, 165, 21, 134, 204, 143, 33,
-			203, 53, 215, 243, 220, 40, 197, 0, 32, 77, 105, 3, 83, 233,
-			131, 244, 214, 5, 94, 130, 205, 233, 131, 169, 179, 7, 159, 237,
-			93, 110, 185, 94, 149, 133, 19, 147, 208, 177, 117, 65, 33, 81,
-			5, 39, 204, 164, 180, 105, 82, 10, 121, 86, 120, 223, 93, 63,
-			134, 158, 139, 156, 188, 235, 147, 210, 146, 2, 93, 47, 109, 3,
-			102, 108, 75, 66, 131, 251, 110, 73, 3, 209, 11, 185, 250, 118,
-			219, 57, 15, 106, 190, 52, 92, 38, 99, 115, 149, 197, 11, 9,
-			53, 38, 110, 219, 112, 153, 162, 211, 75, 88, 46, 249, 74, 74,
-			80, 44, 191, 44, 169, 204, 171, 130, 21, 221, 129, 197, 188, 200,
-			209, 8, 91, 155, 27, 209, 194, 155, 97, 53, 125, 118, 234, 205,
-			120, 74, 245, 236, 212, 155, 171, 78, 251, 217, 141, 55, 195, 146,
-			246, 236, 220, 155, 27, 174, 255, 236, 220, 155, 35, 86, 121, 246,
-			13, 165, 55, 131, 18, 1, 140, 252, 236, 27, 159, 40, 16, 97,
-			51, 229, 165, 241, 4, 201, 219, 115, 218, 105, 139, 38, 95, 33,
-			107, 176, 54, 86, 221, 186, 27, 71, 194, 236, 40, 106, 42, 82,
-			172, 170, 72, 40, 175, 172, 72, 177, 54, 110, 68, 196, 42, 113,
-			181, 126, 134, 133, 193, 84, 147, 31, 103, 193, 98, 182, 23, 72,
-			108, 204, 169, 236, 112, 77, 37, 177, 155, 146, 148, 173, 21, 245,
-			10, 88, 222, 234, 1, 109, 53, 113, 241, 148, 69, 39, 220, 18,
-			43, 137, 196, 179, 7, 235, 64, 147, 69, 130, 245, 7, 77, 142,
-			153, 215, 84, 120, 162, 32, 237, 193, 194, 20, 204, 184, 61, 9,
-			248, 0, 245, 179, 137, 194, 230, 198, 66, 97, 242, 82, 71, 42,
-			225, 106, 212, 155, 90, 110, 200, 170, 37, 58, 79, 185, 45, 136,
-			51, 67, 132, 27, 85, 247, 25, 22, 74, 243, 168, 32, 101, 43,
-			98, 168, 99, 77, 56, 145, 170, 173, 74, 183, 219, 4, 154, 49,
-			9, 3, 224, 195, 214, 208, 231, 11, 253, 126, 86, 18, 182, 183,
-			84, 85, 77, 39, 140, 146, 106, 182, 25, 161, 168, 233, 192, 186,
-			95, 169, 176, 102, 76, 183, 131, 120, 7, 235, 132, 178, 124, 39,
-			45, 251, 16, 237, 107, 7, 69, 19, 107, 45, 98, 49, 42, 49,
-			29, 150, 234, 194, 236, 204, 217, 251, 65, 102, 158, 189, 111, 99,
-			230, 236, 220, 185, 153, 185, 179, 247, 149, 102, 206, 62, 81, 16,
-			220, 29, 81, 132, 149, 208, 109, 58, 81, 76, 40, 230, 196, 250,
-			3, 63, 209, 38, 239, 43, 82, 192, 86, 18, 19, 200, 217, 117,
-			214, 43, 161, 219, 140, 139, 104, 33, 76, 43, 48, 14, 133, 69,
-			67, 152, 245, 185, 238, 3, 10, 21, 103, 118, 206, 143, 200, 254,
-			81, 236, 128, 86, 89, 37, 244, 13, 113, 176, 180, 190, 186, 142,
-			147, 108, 98, 242, 0, 181, 173, 212, 8, 158, 113, 61, 207, 193,
-			217, 197, 252, 169, 205, 245, 233, 106, 80, 137, 166, 31, 99, 219,
-			211, 73, 83, 166, 215, 152, 56, 179, 157, 190, 234, 5, 219, 142,
-			183, 181, 138, 109, 136, 166, 161, 65, 211, 169, 74, 38, 209, 160,
-			179, 19, 84, 75, 208, 25, 46, 105, 138, 56, 207, 121, 147, 232,
-			83, 234, 72, 69, 254, 120, 74, 118, 72, 156, 237, 138, 222, 178,
-			42, 57, 176, 139, 132, 190, 225, 169, 40, 14, 107, 88, 52, 213,
-			163, 160, 18, 149, 154, 92, 178, 65, 95, 102, 167, 61, 119, 59,
-			116, 194, 54, 42, 163, 165, 157, 184, 225, 157, 192, 95, 178, 236,
-			36, 26, 34, 136, 98, 100, 89, 73, 212, 100, 21, 58, 126, 234,
-			241, 169, 83, 141, 169, 83, 213, 141, 83, 143, 204, 157, 186, 54,
-			119, 106, 189, 116, 170, 246, 196, 120, 137, 46, 187, 55, 216, 158,
-			27, 49, 84, 254, 129, 64, 201, 40, 181, 34, 198, 177, 61, 26,
-			84, 249, 41, 244, 120, 68, 223, 240, 212, 210, 250, 170, 92, 234,
-			175, 112, 97, 85, 21, 224, 196, 228, 83, 111, 156, 224, 230, 59,
-			33, 231, 158, 14, 170, 124, 36, 224, 199, 20, 106, 209, 78, 211,
-			197, 1, 145, 169, 92, 183, 230, 109, 157, 222, 143, 27, 251, 41,
-			43, 56, 53, 187, 120, 106, 118, 145, 208, 73, 32, 100, 176, 141,
-			102, 51, 71, 244, 51, 102, 33, 173, 56, 77, 156, 32, 65, 141,
-			214, 153, 207, 66, 126, 242, 19, 119, 156, 108, 167, 232, 223, 113,
-			88, 241, 173, 217, 17, 242, 33, 121, 88, 97, 126, 187, 166, 31,
-			202, 191, 75, 163, 107, 201, 182, 47, 117, 98, 1, 28, 143, 36,
-			142, 92, 191, 146, 86, 61, 200, 193, 186, 71, 167, 181, 250, 22,
-			123, 5, 114, 208, 102, 225, 137, 14, 235, 245, 160, 60, 109, 128,
-			246, 245, 74, 80, 3, 48, 59, 44, 65, 3, 64, 123, 148, 188,
-			160, 137, 3, 7, 243, 237, 154, 110, 231, 63, 173, 209, 149, 192,
-			159, 82, 230, 252, 151, 119, 238, 80, 162, 43, 162, 160, 218, 117,
-			9, 175, 6, 52, 211, 37, 200, 208, 152, 24, 197, 174, 231, 209,
-			29, 103, 151, 161, 249, 95, 213, 201, 79, 57, 121, 65, 194, 183,
-			52, 124, 215, 90, 11, 66, 216, 45, 202, 45, 117, 55, 193, 196,
-			78, 42, 49, 241, 239, 39, 138, 102, 97, 63, 37, 81, 52, 236,
-			118, 118, 80, 130, 6, 128, 41, 171, 254, 159, 223, 65, 206, 213,
-			131, 82, 101, 39, 12, 26, 110, 171, 129, 60, 234, 181, 42, 238,
-			116, 200, 162, 150, 23, 87, 183, 185, 153, 121, 122, 247, 236, 116,
-			37, 104, 52, 148, 247, 106, 14, 50, 149, 100, 166, 210, 238, 217,
-			194, 30, 233, 125, 157, 3, 155, 223, 216, 62, 79, 140, 42, 171,
-			141, 105, 212, 152, 232, 159, 45, 148, 186, 179, 150, 68, 190, 210,
-			34, 171, 149, 253, 56, 108, 175, 65, 246, 252, 5, 146, 149, 9,
-			118, 142, 24, 55, 88, 27, 143, 9, 250, 214, 224, 167, 125, 136,
-			88, 72, 47, 60, 34, 232, 91, 227, 192, 156, 254, 26, 173, 112,
-			158, 16, 46, 162, 174, 59, 110, 120, 187, 37, 47, 159, 125, 98,
-			250, 246, 250, 125, 137, 167, 52, 183, 31, 253, 249, 81, 146, 177,
-			205, 161, 158, 7, 53, 242, 235, 38, 30, 7, 12, 245, 216, 179,
-			255, 194, 236, 176, 236, 159, 189, 136, 11, 238, 242, 230, 194, 18,
-			157, 111, 197, 59, 1, 247, 67, 89, 118, 43, 204, 71, 237, 194,
-			175, 10, 99, 237, 124, 211, 169, 64, 78, 254, 165, 72, 95, 199,
-			194, 200, 13, 124, 58, 91, 154, 161, 19, 144, 161, 32, 62, 21,
-			64, 161, 110, 7, 45, 180, 211, 250, 65, 140, 11, 44, 159, 190,
-			160, 151, 176, 155, 184, 4, 186, 62, 158, 236, 121, 174, 3, 83,
-			81, 26, 141, 37, 250, 18, 161, 143, 11, 12, 74, 94, 84, 130,
-			102, 91, 30, 52, 137, 108, 212, 137, 133, 246, 152, 150, 94, 14,
-			182, 148, 211, 136, 231, 139, 166, 151, 151, 22, 202, 43, 235, 229,
-			169, 217, 210, 12, 33, 116, 211, 199, 243, 54, 169, 42, 224, 34,
-			219, 108, 122, 110, 5, 197, 144, 231, 236, 209, 32, 164, 78, 61,
-			100, 92, 27, 114, 125, 180, 10, 187, 126, 189, 168, 204, 199, 41,
-			243, 118, 7, 153, 100, 203, 220, 168, 35, 3, 26, 206, 149, 1,
-			248, 242, 252, 250, 210, 122, 145, 208, 199, 150, 54, 30, 89, 221,
-			220, 232, 176, 222, 162, 225, 115, 113, 105, 99, 105, 117, 5, 77,
-			147, 243, 43, 143, 211, 215, 46, 173, 44, 22, 169, 176, 156, 11,
-			213, 15, 154, 232, 2, 1, 241, 236, 101, 157, 177, 142, 234, 229,
-			121, 157, 50, 110, 123, 142, 95, 111, 57, 117, 70, 235, 193, 46,
-			11, 125, 16, 176, 137, 133, 59, 226, 167, 235, 158, 219, 112, 99,
-			113, 32, 185, 175, 71, 202, 12, 152, 235, 57, 44, 12, 113, 118,
-			207, 21, 105, 220, 131, 159, 47, 16, 238, 96, 86, 234, 57, 167,
-			229, 255, 35, 161, 243, 244, 6, 107, 79, 241, 243, 195, 134, 211,
-			164, 85, 22, 85, 66, 119, 27, 170, 134, 181, 106, 151, 79, 43,
-			46, 199, 98, 22, 193, 194, 139, 213, 32, 71, 226, 81, 162, 74,
-			149, 107, 50, 187, 201, 42, 72, 79, 215, 87, 14, 92, 49, 5,
-			53, 174, 136, 93, 22, 138, 17, 154, 201, 146, 239, 171, 235, 69,
-			122, 245, 250, 166, 60, 61, 74, 62, 0, 7, 2, 79, 114, 237,
-			19, 73, 26, 182, 124, 190, 10, 123, 78, 61, 42, 65, 39, 100,
-			59, 171, 172, 230, 250, 120, 110, 2, 11, 86, 220, 10, 89, 148,
-			238, 7, 230, 77, 26, 44, 206, 106, 21, 253, 15, 192, 226, 70,
-			180, 226, 120, 30, 240, 24, 20, 83, 120, 8, 189, 218, 114, 171,
-			204, 115, 65, 27, 132, 94, 29, 80, 182, 202, 34, 183, 238, 207,
-			17, 58, 69, 55, 96, 106, 133, 45, 143, 209, 58, 20, 3, 253,
-			223, 137, 129, 244, 17, 189, 182, 185, 190, 1, 100, 19, 139, 161,
-			58, 208, 81, 120, 112, 123, 61, 15, 235, 97, 29, 167, 102, 147,
-			85, 128, 186, 92, 134, 240, 145, 169, 187, 187, 204, 239, 104, 33,
-			223, 60, 5, 173, 250, 14, 76, 142, 74, 224, 71, 46, 240, 138,
-			27, 3, 178, 166, 19, 161, 2, 56, 209, 12, 98, 230, 199, 174,
-			227, 121, 109, 32, 230, 13, 215, 107, 79, 150, 232, 82, 141, 186,
-			220, 205, 175, 209, 12, 194, 24, 176, 197, 65, 50, 34, 46, 232,
-			149, 78, 37, 12, 162, 8, 144, 57, 180, 194, 66, 156, 254, 85,
-			183, 193, 124, 148, 55, 19, 172, 84, 47, 129, 86, 142, 147, 97,
-			143, 109, 99, 219, 184, 205, 81, 177, 199, 190, 83, 66, 74, 35,
-			55, 198, 163, 65, 208, 212, 43, 48, 127, 220, 40, 240, 28, 190,
-			7, 1, 170, 32, 105, 66, 166, 104, 230, 0, 9, 197, 98, 39,
-			135, 27, 181, 90, 186, 175, 49, 14, 63, 201, 226, 62, 121, 44,
-			138, 183, 162, 150, 27, 51, 80, 3, 189, 73, 164, 240, 42, 160,
-			230, 234, 154, 131, 20, 130, 145, 72, 207, 129, 61, 92, 120, 221,
-			42, 84, 91, 115, 92, 175, 21, 50, 110, 147, 230, 155, 3, 97,
-			132, 253, 250, 99, 189, 178, 122, 91, 227, 45, 142, 122, 197, 231,
-			100, 150, 70, 44, 166, 176, 171, 148, 227, 6, 104, 98, 177, 243,
-			98, 120, 218, 136, 124, 177, 227, 70, 113, 16, 182, 17, 89, 199,
-			118, 196, 173, 209, 171, 43, 212, 9, 235, 124, 56, 156, 70, 224,
-			215, 85, 31, 147, 106, 154, 142, 27, 70, 130, 232, 78, 181, 138,
-			22, 94, 192, 229, 179, 61, 81, 94, 180, 144, 87, 237, 86, 161,
-			65, 177, 18, 254, 29, 188, 232, 112, 125, 7, 154, 9, 91, 78,
-			213, 52, 220, 196, 44, 136, 245, 178, 152, 110, 4, 182, 13, 103,
-			206, 118, 171, 114, 131, 197, 115, 92, 202, 193, 10, 200, 19, 138,
-			20, 71, 181, 80, 113, 11, 60, 23, 154, 79, 58, 178, 97, 138,
-			204, 231, 185, 126, 235, 230, 84, 200, 60, 204, 158, 48, 192, 92,
-			154, 49, 136, 88, 163, 96, 143, 80, 137, 58, 87, 114, 9, 76,
-			71, 97, 101, 26, 202, 187, 126, 125, 26, 43, 217, 14, 226, 233,
-			46, 142, 74, 84, 223, 82, 118, 152, 124, 155, 116, 211, 153, 213,
-			143, 230, 67, 148, 156, 41, 41, 33, 8, 150, 72, 168, 215, 178,
-			54, 82, 76, 40, 129, 194, 127, 3, 64, 183, 74, 19, 181, 68,
-			144, 73, 101, 44, 210, 136, 175, 47, 110, 136, 103, 177, 81, 28,
-			58, 174, 31, 71, 202, 51, 163, 39, 3, 45, 184, 35, 229, 73,
-			51, 123, 231, 225, 148, 39, 205, 236, 221, 247, 144, 215, 17, 221,
-			212, 108, 243, 66, 207, 131, 90, 254, 81, 16, 58, 220, 228, 210,
-			201, 22, 37, 186, 209, 110, 186, 21, 228, 62, 60, 224, 197, 133,
-			204, 169, 215, 249, 226, 203, 24, 93, 242, 119, 3, 126, 218, 95,
-			138, 157, 122, 196, 201, 161, 105, 182, 113, 33, 107, 147, 215, 18,
-			211, 212, 128, 28, 23, 245, 145, 252, 67, 116, 141, 213, 217, 205,
-			57, 250, 228, 27, 156, 169, 103, 222, 8, 255, 55, 51, 117, 113,
-			235, 141, 167, 39, 166, 187, 18, 38, 79, 159, 36, 244, 154, 115,
-			147, 114, 111, 231, 57, 122, 225, 188, 232, 154, 134, 78, 66, 23,
-			245, 172, 132, 52, 219, 184, 216, 55, 32, 33, 195, 54, 46, 14,
-			231, 200, 49, 172, 86, 179, 141, 7, 244, 209, 188, 221, 129, 105,
-			246, 190, 11, 10, 149, 102, 65, 14, 137, 10, 218, 252, 64, 223,
-			144, 132, 12, 219, 120, 96, 196, 86, 154, 238, 219, 190, 195, 32,
-			116, 223, 157, 44, 92, 65, 155, 113, 16, 222, 202, 131, 229, 26,
-			25, 185, 226, 122, 108, 81, 101, 92, 103, 177, 253, 26, 98, 130,
-			234, 37, 52, 220, 147, 221, 46, 42, 165, 206, 18, 232, 45, 178,
-			134, 37, 10, 159, 51, 201, 232, 1, 95, 109, 155, 152, 192, 217,
-			66, 111, 197, 223, 246, 24, 233, 109, 58, 149, 27, 78, 93, 170,
-			174, 18, 180, 143, 18, 34, 143, 218, 42, 237, 49, 131, 26, 19,
-			125, 107, 169, 20, 251, 12, 25, 105, 182, 182, 61, 183, 178, 149,
-			202, 70, 168, 49, 97, 173, 229, 248, 135, 197, 36, 243, 56, 25,
-			222, 99, 206, 141, 116, 214, 126, 204, 58, 4, 201, 169, 140, 11,
-			100, 160, 193, 162, 200, 169, 179, 173, 184, 221, 100, 99, 38, 246,
-			158, 238, 235, 125, 119, 207, 251, 69, 169, 141, 118, 147, 217, 243,
-			164, 143, 249, 173, 6, 199, 96, 221, 130, 126, 101, 191, 213, 232,
-			198, 146, 133, 98, 2, 69, 111, 196, 194, 93, 183, 194, 198, 50,
-			136, 96, 124, 31, 130, 117, 254, 189, 27, 135, 44, 103, 47, 144,
-			62, 60, 185, 131, 53, 103, 172, 23, 145, 156, 58, 96, 20, 153,
-			87, 237, 70, 145, 148, 179, 47, 144, 94, 161, 231, 140, 101, 169,
-			54, 209, 63, 123, 247, 129, 140, 176, 202, 243, 172, 201, 204, 246,
-			18, 201, 113, 223, 151, 173, 74, 80, 101, 91, 174, 95, 11, 198,
-			250, 16, 193, 177, 253, 29, 193, 140, 11, 65, 149, 45, 249, 181,
-			96, 109, 40, 234, 128, 237, 59, 73, 38, 106, 251, 177, 115, 115,
-			108, 0, 57, 68, 64, 133, 127, 145, 33, 195, 183, 195, 98, 151,
-			136, 133, 126, 100, 99, 250, 203, 161, 1, 47, 211, 73, 196, 204,
-			55, 72, 196, 121, 210, 239, 179, 40, 102, 85, 206, 17, 198, 109,
-			242, 20, 225, 133, 246, 179, 148, 249, 13, 177, 212, 235, 201, 176,
-			106, 210, 22, 158, 57, 10, 222, 156, 254, 122, 45, 41, 149, 101,
-			57, 188, 147, 176, 54, 196, 58, 96, 123, 145, 144, 192, 103, 65,
-			109, 171, 202, 42, 222, 88, 246, 22, 84, 90, 133, 44, 251, 168,
-			20, 240, 212, 138, 103, 95, 76, 88, 173, 247, 22, 156, 114, 141,
-			79, 178, 125, 220, 182, 73, 134, 164, 75, 153, 232, 89, 31, 54,
-			162, 244, 117, 123, 182, 38, 138, 241, 142, 13, 134, 105, 208, 62,
-			65, 84, 194, 22, 178, 21, 65, 41, 52, 32, 19, 87, 156, 6,
-			203, 63, 67, 134, 58, 201, 3, 155, 113, 244, 81, 71, 46, 180,
-			214, 56, 0, 155, 118, 230, 87, 133, 247, 31, 252, 180, 255, 73,
-			210, 97, 3, 59, 124, 239, 254, 17, 237, 192, 220, 221, 239, 252,
-			253, 100, 176, 163, 3, 183, 91, 117, 225, 45, 228, 142, 3, 81,
-			219, 175, 39, 135, 90, 190, 58, 79, 103, 213, 45, 94, 213, 216,
-			159, 247, 222, 130, 231, 54, 211, 185, 57, 150, 181, 209, 214, 254,
-			196, 211, 125, 217, 191, 232, 205, 189, 245, 173, 111, 125, 171, 94,
-			248, 213, 12, 57, 116, 208, 156, 57, 112, 250, 222, 73, 50, 220,
-			91, 27, 137, 100, 173, 9, 200, 158, 39, 150, 231, 108, 51, 111,
-			204, 164, 218, 196, 208, 236, 153, 219, 154, 149, 165, 101, 40, 178,
-			198, 75, 218, 15, 17, 83, 136, 104, 192, 112, 250, 246, 48, 192,
-			92, 90, 195, 114, 246, 17, 210, 7, 127, 57, 111, 100, 176, 205,
-			89, 72, 0, 190, 176, 243, 36, 203, 29, 39, 152, 92, 218, 20,
-			12, 140, 85, 101, 53, 167, 229, 197, 91, 220, 108, 211, 139, 25,
-			6, 68, 226, 235, 32, 205, 62, 70, 250, 249, 172, 114, 253, 42,
-			187, 137, 210, 211, 90, 227, 19, 109, 9, 82, 160, 250, 167, 163,
-			192, 151, 172, 137, 85, 64, 2, 86, 127, 127, 183, 224, 190, 231,
-			224, 238, 237, 155, 75, 227, 100, 152, 107, 19, 91, 242, 120, 101,
-			108, 132, 106, 19, 217, 181, 33, 158, 188, 42, 82, 11, 255, 92,
-			39, 38, 10, 150, 97, 210, 191, 241, 248, 245, 242, 214, 226, 234,
-			230, 229, 229, 114, 78, 179, 135, 8, 193, 132, 43, 203, 171, 243,
-			27, 57, 93, 193, 120, 190, 154, 51, 84, 1, 126, 224, 156, 51,
-			211, 25, 206, 205, 230, 44, 59, 71, 6, 56, 130, 165, 215, 151,
-			23, 47, 156, 207, 101, 58, 83, 206, 205, 230, 122, 237, 65, 210,
-			135, 41, 151, 87, 87, 151, 115, 89, 133, 115, 125, 99, 109, 105,
-			229, 106, 174, 79, 225, 188, 186, 182, 186, 121, 61, 71, 20, 134,
-			107, 229, 245, 245, 249, 171, 229, 92, 191, 202, 113, 249, 241, 141,
-			242, 122, 110, 160, 163, 89, 231, 102, 115, 131, 170, 138, 242, 202,
-			230, 181, 220, 144, 61, 66, 6, 121, 21, 178, 17, 195, 93, 73,
-			23, 206, 231, 114, 73, 67, 56, 150, 145, 142, 132, 11, 231, 115,
-			118, 97, 129, 88, 200, 134, 182, 77, 134, 150, 231, 47, 151, 151,
-			183, 86, 209, 63, 108, 126, 57, 167, 37, 105, 107, 229, 111, 217,
-			92, 90, 43, 47, 230, 244, 116, 218, 245, 242, 252, 70, 121, 49,
-			103, 20, 42, 228, 208, 65, 2, 245, 192, 41, 148, 226, 5, 253,
-			22, 188, 128, 184, 186, 121, 161, 240, 167, 58, 25, 61, 96, 81,
-			57, 176, 146, 135, 19, 19, 36, 72, 138, 201, 3, 87, 39, 228,
-			236, 125, 75, 45, 150, 75, 171, 26, 198, 45, 84, 13, 64, 177,
-			143, 97, 223, 184, 79, 248, 243, 245, 241, 194, 237, 172, 143, 152,
-			246, 242, 22, 1, 235, 128, 69, 224, 18, 25, 217, 135, 232, 182,
-			133, 241, 219, 52, 50, 118, 43, 226, 124, 29, 145, 168, 119, 136,
-			196, 75, 221, 20, 60, 126, 235, 65, 216, 55, 214, 207, 107, 228,
-			206, 131, 85, 202, 3, 219, 240, 16, 201, 240, 179, 50, 49, 222,
-			251, 215, 174, 107, 248, 185, 123, 176, 69, 169, 244, 106, 111, 220,
-			74, 47, 228, 173, 217, 215, 210, 119, 232, 228, 142, 3, 145, 31,
-			216, 208, 123, 8, 113, 253, 102, 43, 230, 170, 19, 151, 196, 125,
-			152, 130, 194, 11, 164, 108, 43, 86, 223, 13, 252, 78, 120, 18,
-			102, 120, 77, 210, 80, 19, 27, 122, 244, 22, 61, 221, 199, 152,
-			51, 36, 87, 241, 92, 230, 199, 91, 81, 28, 50, 167, 225, 250,
-			117, 92, 106, 178, 115, 86, 205, 241, 34, 182, 54, 204, 63, 175,
-			203, 175, 80, 2, 25, 40, 76, 149, 200, 116, 148, 224, 159, 85,
-			137, 194, 187, 251, 72, 127, 74, 1, 183, 143, 147, 129, 167, 157,
-			93, 103, 75, 110, 170, 56, 37, 250, 33, 237, 186, 216, 88, 205,
-			144, 67, 152, 37, 104, 197, 44, 220, 170, 120, 78, 20, 33, 209,
-			178, 152, 213, 134, 111, 171, 240, 105, 65, 126, 177, 239, 35, 163,
-			88, 162, 209, 242, 98, 183, 233, 177, 45, 216, 230, 69, 184, 228,
-			168, 150, 141, 64, 142, 107, 34, 3, 180, 40, 178, 23, 201, 61,
-			88, 76, 28, 207, 177, 45, 246, 166, 150, 227, 69, 91, 142, 95,
-			221, 218, 113, 162, 157, 177, 67, 128, 224, 178, 62, 166, 173, 29,
-			134, 140, 87, 69, 190, 50, 102, 155, 247, 171, 143, 56, 209, 142,
-			61, 71, 238, 68, 44, 124, 231, 191, 85, 217, 97, 149, 27, 91,
-			173, 184, 246, 154, 177, 35, 233, 250, 177, 133, 220, 24, 177, 0,
-			89, 54, 227, 218, 107, 236, 117, 50, 0, 131, 209, 112, 159, 97,
-			91, 181, 32, 196, 53, 116, 232, 0, 209, 148, 162, 96, 105, 85,
-			20, 184, 22, 84, 217, 156, 181, 126, 189, 92, 94, 92, 235, 151,
-			88, 174, 4, 33, 48, 84, 61, 80, 4, 238, 231, 12, 85, 15,
-			36, 121, 239, 35, 163, 149, 10, 239, 179, 91, 217, 18, 155, 177,
-			104, 44, 215, 65, 172, 74, 229, 42, 207, 32, 120, 60, 178, 47,
-			146, 59, 18, 98, 165, 11, 142, 236, 235, 101, 119, 209, 251, 200,
-			104, 179, 189, 191, 160, 221, 81, 99, 179, 221, 93, 236, 126, 114,
-			168, 185, 211, 220, 95, 238, 116, 186, 156, 221, 220, 105, 118, 23,
-			60, 133, 59, 243, 144, 161, 175, 193, 216, 93, 233, 236, 169, 15,
-			118, 137, 228, 42, 149, 45, 230, 59, 219, 30, 219, 114, 66, 230,
-			59, 209, 216, 49, 204, 108, 198, 97, 139, 173, 13, 85, 42, 101,
-			252, 56, 143, 223, 236, 211, 100, 36, 216, 126, 186, 194, 57, 114,
-			171, 25, 178, 154, 123, 115, 236, 36, 146, 119, 24, 62, 32, 63,
-			94, 199, 100, 123, 146, 228, 42, 209, 142, 19, 54, 81, 36, 71,
-			77, 167, 194, 198, 78, 241, 172, 60, 125, 69, 38, 195, 140, 136,
-			246, 220, 90, 44, 49, 142, 243, 25, 129, 105, 2, 219, 4, 201,
-			1, 37, 58, 42, 158, 192, 108, 67, 205, 157, 102, 186, 222, 19,
-			100, 16, 114, 38, 149, 78, 114, 197, 173, 185, 147, 170, 241, 60,
-			185, 19, 50, 53, 88, 236, 84, 157, 216, 73, 229, 46, 98, 110,
-			32, 251, 53, 241, 177, 163, 157, 97, 107, 187, 173, 24, 107, 138,
-			183, 19, 210, 36, 107, 189, 98, 202, 121, 97, 142, 12, 164, 249,
-			222, 238, 35, 156, 243, 115, 26, 40, 65, 11, 171, 139, 160, 190,
-			60, 81, 206, 233, 160, 70, 45, 47, 109, 148, 183, 214, 54, 87,
-			54, 150, 174, 149, 115, 70, 74, 177, 127, 212, 204, 222, 155, 27,
-			47, 252, 158, 78, 134, 58, 119, 106, 246, 3, 228, 46, 105, 86,
-			137, 88, 188, 181, 231, 134, 56, 33, 27, 14, 95, 28, 21, 255,
-			28, 18, 185, 214, 89, 252, 152, 27, 10, 191, 0, 123, 153, 28,
-			243, 131, 45, 233, 133, 177, 149, 24, 180, 182, 156, 74, 133, 69,
-			81, 192, 23, 66, 133, 229, 110, 63, 88, 23, 153, 147, 21, 98,
-			94, 100, 237, 98, 95, 227, 86, 236, 123, 132, 244, 53, 156, 230,
-			22, 243, 227, 176, 141, 250, 121, 118, 45, 219, 112, 154, 252, 236,
-			246, 31, 98, 155, 244, 168, 153, 205, 230, 250, 30, 53, 179, 125,
-			57, 82, 248, 172, 65, 6, 210, 250, 58, 108, 127, 42, 184, 98,
-			105, 40, 211, 78, 188, 164, 118, 95, 90, 128, 165, 108, 46, 195,
-			149, 227, 53, 94, 18, 212, 8, 96, 54, 198, 149, 145, 236, 154,
-			128, 236, 171, 36, 243, 116, 132, 184, 51, 136, 251, 32, 219, 95,
-			10, 247, 163, 235, 136, 188, 239, 209, 245, 173, 149, 213, 181, 107,
-			243, 203, 107, 162, 184, 125, 152, 152, 158, 243, 76, 187, 115, 209,
-			195, 164, 219, 29, 132, 195, 196, 220, 99, 206, 141, 206, 165, 6,
-			147, 94, 193, 201, 48, 77, 44, 164, 151, 77, 136, 160, 88, 174,
-			199, 206, 18, 115, 97, 117, 13, 38, 68, 142, 12, 240, 212, 173,
-			235, 75, 229, 133, 114, 78, 47, 220, 71, 50, 156, 8, 48, 89,
-			20, 25, 114, 61, 2, 20, 56, 52, 249, 117, 243, 218, 229, 242,
-			90, 78, 239, 28, 106, 51, 103, 21, 34, 50, 144, 214, 195, 255,
-			97, 54, 227, 191, 162, 145, 254, 148, 94, 13, 10, 145, 227, 121,
-			193, 222, 150, 227, 185, 78, 36, 88, 131, 96, 210, 60, 164, 220,
-			238, 208, 253, 3, 77, 17, 43, 151, 41, 124, 88, 35, 185, 110,
-			197, 182, 171, 153, 218, 63, 102, 51, 11, 31, 212, 200, 80, 167,
-			54, 219, 213, 188, 227, 255, 168, 205, 251, 19, 157, 12, 118, 232,
-			176, 183, 219, 186, 55, 145, 17, 183, 202, 26, 120, 106, 91, 105,
-			111, 121, 108, 151, 121, 99, 5, 20, 26, 251, 141, 138, 29, 53,
-			148, 150, 146, 114, 203, 80, 108, 110, 116, 105, 177, 124, 237, 250,
-			234, 70, 121, 101, 225, 241, 173, 205, 149, 215, 174, 172, 62, 182,
-			178, 150, 115, 187, 178, 189, 130, 211, 254, 58, 201, 117, 55, 202,
-			190, 139, 28, 212, 172, 92, 143, 61, 74, 134, 87, 86, 183, 214,
-			151, 22, 203, 91, 229, 43, 87, 202, 11, 27, 235, 220, 238, 161,
-			114, 111, 116, 76, 240, 194, 15, 25, 100, 244, 128, 150, 216, 243,
-			98, 199, 194, 55, 81, 83, 183, 211, 250, 18, 232, 12, 215, 157,
-			48, 22, 27, 156, 73, 146, 227, 135, 157, 53, 151, 133, 194, 158,
-			196, 183, 49, 195, 73, 58, 55, 41, 21, 137, 45, 175, 123, 111,
-			185, 190, 52, 62, 193, 182, 198, 92, 203, 201, 47, 75, 126, 172,
-			114, 75, 111, 174, 84, 110, 11, 175, 53, 231, 228, 23, 149, 251,
-			56, 25, 168, 6, 45, 208, 245, 120, 62, 88, 59, 180, 181, 126,
-			158, 166, 178, 8, 45, 62, 177, 122, 13, 172, 245, 243, 52, 158,
-			101, 156, 12, 59, 245, 122, 8, 200, 37, 34, 190, 47, 25, 82,
-			201, 152, 49, 255, 40, 201, 74, 58, 192, 82, 13, 148, 216, 106,
-			242, 205, 182, 62, 209, 183, 150, 245, 229, 199, 227, 100, 192, 141,
-			182, 18, 35, 190, 78, 245, 137, 236, 90, 191, 27, 41, 3, 104,
-			225, 121, 157, 12, 117, 30, 66, 216, 139, 36, 235, 137, 19, 67,
-			113, 2, 54, 241, 117, 206, 45, 74, 203, 34, 255, 154, 42, 153,
-			255, 29, 141, 100, 101, 178, 125, 39, 49, 155, 78, 188, 131, 232,
-			172, 203, 122, 78, 91, 67, 24, 210, 163, 166, 227, 35, 11, 136,
-			116, 128, 97, 92, 61, 230, 84, 113, 211, 19, 52, 26, 204, 143,
-			35, 57, 174, 34, 125, 65, 36, 219, 103, 200, 72, 28, 58, 174,
-			215, 145, 215, 196, 188, 57, 249, 65, 101, 158, 35, 135, 37, 222,
-			42, 139, 157, 202, 14, 171, 38, 133, 50, 104, 220, 184, 75, 100,
-			88, 20, 223, 101, 217, 194, 239, 105, 100, 68, 110, 211, 170, 138,
-			88, 215, 8, 113, 124, 63, 136, 211, 228, 218, 207, 202, 251, 202,
-			149, 230, 85, 161, 181, 20, 130, 124, 131, 144, 228, 203, 45, 201,
-			118, 140, 244, 139, 19, 38, 60, 166, 228, 27, 123, 194, 147, 96,
-			63, 103, 31, 34, 214, 54, 171, 187, 190, 176, 27, 115, 64, 154,
-			95, 76, 101, 126, 185, 252, 255, 215, 14, 190, 131, 159, 235, 178,
-			46, 68, 143, 104, 79, 60, 244, 82, 183, 240, 241, 174, 250, 84,
-			157, 249, 83, 245, 32, 117, 234, 122, 41, 249, 153, 186, 152, 159,
-			239, 190, 152, 191, 198, 106, 30, 67, 199, 202, 71, 255, 247, 255,
-			67, 39, 189, 182, 53, 222, 243, 189, 189, 26, 249, 216, 48, 186,
-			225, 141, 191, 122, 43, 255, 213, 91, 249, 175, 222, 202, 127, 245,
-			86, 254, 171, 183, 242, 95, 189, 149, 255, 141, 223, 202, 159, 253,
-			35, 93, 184, 108, 207, 209, 27, 204, 143, 3, 255, 159, 36, 130,
-			157, 78, 188, 22, 147, 232, 235, 156, 176, 234, 76, 18, 74, 47,
-			59, 17, 247, 67, 14, 66, 183, 238, 250, 142, 183, 127, 1, 226,
-			78, 165, 120, 245, 137, 174, 59, 254, 211, 78, 155, 94, 221, 97,
-			13, 103, 207, 137, 139, 244, 81, 86, 171, 209, 69, 230, 248, 197,
-			228, 214, 82, 36, 189, 117, 133, 173, 39, 117, 83, 195, 245, 152,
-			116, 248, 101, 93, 78, 136, 17, 173, 5, 45, 31, 125, 120, 249,
-			18, 141, 185, 165, 179, 173, 231, 86, 211, 201, 210, 243, 55, 14,
-			29, 63, 226, 33, 29, 171, 110, 200, 42, 177, 215, 198, 11, 85,
-			244, 0, 247, 36, 162, 164, 136, 227, 183, 133, 76, 116, 125, 190,
-			132, 166, 92, 72, 69, 158, 144, 43, 72, 232, 62, 200, 189, 82,
-			163, 73, 229, 236, 60, 169, 98, 30, 156, 233, 217, 148, 206, 206,
-			240, 147, 39, 22, 147, 72, 6, 69, 21, 201, 96, 170, 231, 172,
-			140, 100, 0, 63, 121, 98, 169, 231, 126, 25, 50, 1, 126, 242,
-			196, 233, 36, 146, 1, 252, 228, 137, 51, 73, 28, 133, 25, 21,
-			71, 225, 124, 207, 221, 228, 219, 136, 158, 237, 195, 159, 249, 152,
-			118, 187, 130, 41, 95, 62, 105, 223, 230, 174, 115, 81, 147, 177,
-			42, 221, 102, 21, 7, 150, 240, 80, 41, 38, 83, 219, 192, 14,
-			132, 58, 94, 61, 8, 221, 120, 167, 17, 209, 106, 224, 143, 199,
-			116, 47, 8, 111, 208, 106, 11, 93, 243, 182, 131, 32, 142, 226,
-			144, 223, 171, 44, 17, 242, 52, 247, 239, 126, 77, 207, 156, 150,
-			127, 18, 199, 93, 170, 15, 210, 147, 58, 196, 225, 226, 71, 46,
-			251, 198, 102, 157, 197, 184, 100, 56, 174, 47, 175, 210, 241, 182,
-			19, 206, 0, 212, 141, 249, 213, 185, 168, 148, 56, 57, 190, 38,
-			123, 152, 244, 75, 39, 199, 139, 250, 132, 116, 42, 52, 1, 74,
-			187, 31, 94, 236, 63, 154, 114, 63, 188, 120, 236, 68, 202, 253,
-			240, 226, 189, 227, 100, 154, 187, 31, 62, 216, 243, 132, 150, 63,
-			65, 23, 5, 107, 242, 80, 14, 141, 166, 199, 98, 150, 102, 187,
-			82, 226, 87, 248, 96, 246, 8, 185, 40, 253, 10, 31, 210, 143,
-			20, 138, 156, 49, 97, 45, 44, 118, 68, 109, 8, 131, 32, 78,
-			41, 37, 113, 200, 152, 242, 34, 52, 161, 108, 218, 167, 240, 161,
-			254, 145, 148, 79, 225, 67, 246, 157, 41, 159, 194, 135, 14, 231,
-			201, 105, 233, 83, 248, 176, 126, 180, 112, 143, 112, 53, 173, 5,
-			65, 161, 136, 127, 74, 219, 78, 88, 40, 82, 22, 87, 18, 247,
-			66, 19, 50, 167, 157, 13, 31, 86, 117, 64, 71, 30, 182, 15,
-			167, 156, 13, 31, 190, 251, 30, 114, 30, 235, 208, 109, 227, 178,
-			126, 60, 63, 78, 87, 228, 234, 46, 134, 3, 39, 131, 140, 180,
-			39, 38, 181, 170, 77, 55, 161, 152, 130, 44, 219, 184, 172, 106,
-			131, 102, 95, 182, 239, 150, 144, 97, 27, 151, 143, 81, 242, 45,
-			88, 155, 97, 27, 139, 250, 68, 126, 145, 162, 231, 3, 83, 161,
-			228, 184, 163, 95, 82, 169, 104, 131, 244, 96, 150, 110, 125, 92,
-			95, 66, 149, 74, 53, 197, 48, 1, 167, 130, 44, 219, 88, 236,
-			207, 73, 72, 179, 141, 197, 145, 130, 132, 160, 246, 83, 227, 228,
-			25, 108, 138, 105, 27, 87, 245, 123, 243, 141, 238, 166, 236, 49,
-			231, 198, 237, 53, 164, 196, 239, 99, 114, 85, 105, 10, 21, 118,
-			144, 172, 13, 183, 46, 194, 36, 6, 190, 215, 46, 209, 197, 64,
-			94, 132, 81, 109, 54, 177, 114, 5, 89, 182, 113, 85, 181, 217,
-			212, 108, 227, 234, 8, 149, 144, 97, 27, 87, 79, 156, 34, 23,
-			176, 205, 150, 109, 60, 170, 23, 243, 147, 168, 237, 199, 65, 115,
-			10, 45, 53, 29, 210, 53, 45, 131, 85, 125, 150, 9, 5, 21,
-			148, 177, 141, 71, 251, 243, 18, 210, 108, 227, 209, 35, 227, 18,
-			50, 108, 227, 209, 211, 103, 112, 214, 105, 122, 198, 54, 94, 171,
-			79, 137, 79, 25, 19, 32, 137, 36, 3, 223, 196, 172, 211, 244,
-			140, 102, 27, 175, 61, 54, 33, 33, 195, 54, 94, 123, 166, 40,
-			144, 244, 218, 198, 178, 94, 18, 159, 122, 77, 128, 36, 146, 222,
-			140, 109, 44, 247, 31, 151, 144, 102, 27, 203, 133, 73, 9, 25,
-			182, 177, 92, 156, 18, 72, 178, 182, 113, 77, 33, 201, 154, 0,
-			73, 36, 217, 140, 109, 92, 235, 63, 38, 33, 205, 54, 174, 81,
-			137, 36, 107, 216, 198, 53, 133, 164, 207, 54, 86, 245, 19, 226,
-			83, 159, 9, 144, 68, 210, 151, 177, 141, 213, 126, 57, 13, 251,
-			52, 219, 88, 189, 75, 118, 174, 207, 176, 141, 213, 227, 5, 242,
-			223, 53, 196, 66, 108, 99, 83, 159, 206, 255, 153, 198, 29, 242,
-			121, 48, 60, 33, 218, 34, 117, 151, 185, 99, 205, 113, 182, 97,
-			173, 1, 22, 82, 235, 111, 106, 239, 146, 186, 239, 20, 57, 53,
-			134, 174, 247, 13, 216, 189, 224, 64, 50, 63, 118, 67, 38, 170,
-			145, 203, 214, 142, 19, 54, 48, 216, 38, 191, 176, 66, 104, 173,
-			229, 139, 235, 217, 41, 95, 249, 100, 153, 136, 232, 212, 20, 119,
-			167, 79, 181, 42, 9, 190, 2, 140, 138, 43, 191, 216, 39, 54,
-			240, 166, 126, 16, 120, 145, 98, 33, 98, 66, 183, 21, 148, 177,
-			141, 205, 126, 41, 81, 136, 102, 27, 155, 249, 211, 18, 50, 108,
-			99, 115, 170, 68, 222, 136, 212, 234, 183, 141, 199, 245, 163, 249,
-			235, 252, 94, 15, 122, 124, 170, 73, 159, 18, 184, 252, 115, 171,
-			41, 166, 157, 112, 81, 199, 80, 50, 152, 109, 182, 128, 138, 7,
-			7, 206, 21, 84, 179, 250, 77, 192, 175, 32, 203, 54, 30, 87,
-			130, 168, 95, 179, 141, 199, 237, 49, 9, 25, 182, 241, 248, 145,
-			123, 200, 56, 209, 77, 221, 54, 223, 216, 243, 172, 150, 63, 210,
-			177, 20, 8, 61, 6, 47, 118, 139, 37, 0, 100, 217, 27, 179,
-			119, 33, 255, 232, 176, 4, 60, 169, 31, 65, 124, 58, 10, 245,
-			39, 69, 205, 58, 10, 245, 39, 69, 205, 58, 10, 245, 39, 133,
-			80, 215, 81, 168, 63, 121, 56, 47, 144, 104, 182, 241, 148, 126,
-			90, 124, 2, 169, 253, 148, 66, 162, 101, 108, 227, 41, 193, 201,
-			58, 74, 237, 167, 232, 41, 9, 25, 182, 241, 212, 196, 164, 64,
-			162, 219, 134, 35, 166, 131, 142, 194, 216, 81, 72, 96, 210, 58,
-			10, 9, 84, 231, 136, 233, 160, 163, 48, 118, 196, 116, 64, 160,
-			162, 159, 17, 159, 64, 140, 86, 20, 18, 35, 99, 27, 21, 33,
-			34, 116, 20, 163, 149, 35, 247, 74, 8, 202, 77, 158, 22, 72,
-			76, 219, 168, 10, 17, 161, 163, 92, 171, 42, 36, 102, 198, 54,
-			170, 66, 68, 232, 40, 215, 170, 66, 68, 232, 40, 215, 170, 103,
-			138, 100, 0, 144, 24, 61, 182, 89, 211, 111, 24, 252, 155, 129,
-			97, 72, 201, 24, 57, 66, 50, 0, 1, 217, 235, 230, 61, 133,
-			1, 216, 141, 170, 27, 164, 67, 164, 151, 127, 52, 225, 235, 64,
-			2, 91, 182, 81, 31, 180, 19, 88, 179, 141, 250, 232, 88, 2,
-			27, 182, 81, 63, 114, 183, 66, 174, 217, 198, 142, 121, 164, 48,
-			64, 203, 55, 247, 35, 135, 225, 217, 73, 33, 135, 101, 117, 39,
-			133, 28, 134, 104, 103, 244, 206, 4, 54, 108, 99, 231, 112, 158,
-			12, 10, 228, 186, 109, 60, 109, 78, 171, 207, 64, 172, 167, 83,
-			232, 96, 168, 158, 30, 44, 36, 176, 102, 27, 79, 159, 56, 157,
-			192, 134, 109, 60, 61, 85, 18, 148, 182, 108, 195, 83, 99, 14,
-			18, 221, 83, 148, 6, 137, 238, 137, 233, 168, 163, 68, 247, 242,
-			114, 204, 65, 162, 123, 106, 204, 51, 182, 225, 235, 211, 226, 19,
-			72, 116, 95, 33, 1, 137, 238, 43, 198, 1, 137, 238, 83, 201,
-			167, 32, 209, 125, 213, 146, 94, 219, 104, 234, 146, 29, 64, 162,
-			55, 21, 18, 144, 232, 77, 213, 18, 144, 232, 205, 252, 113, 9,
-			25, 182, 209, 60, 121, 138, 124, 76, 195, 65, 215, 108, 179, 165,
-			223, 52, 242, 255, 139, 38, 66, 164, 7, 53, 101, 186, 162, 177,
-			83, 23, 193, 125, 163, 18, 93, 59, 32, 85, 93, 48, 149, 102,
-			7, 16, 95, 40, 36, 241, 38, 159, 178, 19, 243, 168, 76, 73,
-			160, 97, 167, 161, 54, 44, 41, 196, 34, 83, 195, 105, 163, 161,
-			136, 6, 187, 44, 244, 156, 166, 16, 51, 186, 1, 3, 221, 34,
-			119, 9, 174, 65, 101, 112, 247, 22, 44, 201, 213, 189, 93, 53,
-			204, 92, 225, 219, 85, 92, 195, 85, 190, 93, 197, 146, 92, 233,
-			219, 85, 44, 137, 106, 223, 222, 45, 88, 146, 235, 121, 123, 41,
-			228, 192, 146, 123, 41, 228, 208, 210, 61, 197, 146, 92, 219, 219,
-			83, 194, 39, 107, 27, 109, 189, 40, 198, 3, 150, 209, 182, 26,
-			57, 88, 70, 219, 253, 99, 18, 210, 108, 163, 125, 120, 92, 66,
-			134, 109, 180, 79, 159, 33, 223, 142, 35, 7, 235, 232, 91, 244,
-			83, 249, 86, 66, 63, 190, 58, 161, 129, 72, 70, 5, 217, 63,
-			62, 114, 120, 14, 26, 10, 216, 254, 241, 203, 134, 104, 129, 194,
-			187, 191, 184, 40, 177, 132, 39, 2, 191, 34, 85, 26, 29, 151,
-			239, 183, 168, 198, 247, 89, 182, 241, 22, 37, 126, 97, 249, 126,
-			139, 45, 57, 25, 150, 239, 183, 20, 78, 146, 126, 2, 82, 199,
-			250, 182, 158, 239, 210, 52, 20, 238, 32, 214, 190, 45, 123, 15,
-			89, 37, 166, 105, 232, 61, 182, 249, 255, 211, 244, 185, 252, 60,
-			223, 219, 136, 40, 30, 113, 128, 55, 61, 197, 21, 81, 55, 166,
-			213, 128, 69, 176, 85, 10, 89, 37, 168, 251, 238, 51, 140, 238,
-			176, 144, 149, 240, 14, 174, 84, 76, 7, 137, 5, 8, 77, 196,
-			168, 192, 12, 128, 253, 71, 37, 168, 1, 120, 236, 156, 4, 13,
-			0, 47, 92, 36, 79, 64, 195, 44, 219, 252, 78, 77, 63, 156,
-			191, 70, 23, 208, 179, 44, 194, 157, 21, 170, 121, 140, 86, 90,
-			81, 28, 52, 146, 54, 249, 9, 179, 11, 37, 214, 141, 18, 22,
-			79, 183, 11, 132, 173, 97, 245, 0, 242, 193, 49, 94, 177, 5,
-			237, 248, 78, 109, 112, 68, 130, 58, 128, 119, 140, 145, 115, 4,
-			164, 121, 230, 29, 90, 207, 23, 52, 45, 127, 170, 99, 161, 76,
-			116, 17, 188, 155, 173, 198, 144, 244, 19, 195, 132, 125, 214, 59,
-			180, 236, 221, 100, 136, 152, 166, 105, 246, 216, 153, 239, 209, 244,
-			31, 215, 12, 172, 192, 132, 109, 157, 249, 61, 90, 111, 63, 89,
-			39, 25, 83, 4, 110, 248, 62, 205, 60, 148, 95, 160, 51, 60,
-			130, 139, 100, 41, 152, 197, 97, 136, 119, 210, 233, 106, 136, 151,
-			76, 35, 186, 199, 220, 144, 127, 227, 151, 253, 220, 138, 227, 193,
-			38, 62, 10, 124, 208, 85, 134, 73, 47, 71, 170, 33, 214, 225,
-			36, 65, 135, 4, 123, 148, 12, 137, 106, 53, 219, 124, 151, 102,
-			142, 170, 12, 26, 79, 24, 74, 18, 116, 72, 24, 177, 201, 158,
-			40, 161, 219, 230, 187, 53, 115, 52, 95, 167, 43, 65, 76, 159,
-			112, 235, 79, 56, 117, 25, 71, 168, 68, 85, 172, 5, 37, 160,
-			98, 231, 6, 163, 103, 103, 232, 118, 59, 102, 81, 137, 210, 205,
-			136, 209, 148, 3, 49, 117, 107, 36, 9, 212, 156, 82, 120, 60,
-			247, 6, 243, 218, 169, 206, 64, 91, 223, 157, 110, 26, 111, 202,
-			136, 173, 58, 99, 216, 230, 15, 104, 230, 33, 149, 1, 100, 235,
-			15, 164, 187, 111, 232, 144, 96, 143, 170, 206, 152, 182, 249, 190,
-			111, 90, 103, 206, 205, 222, 126, 103, 128, 61, 222, 151, 238, 12,
-			40, 99, 239, 75, 119, 198, 178, 205, 247, 107, 230, 29, 42, 131,
-			165, 97, 66, 46, 73, 208, 33, 97, 244, 144, 42, 145, 177, 205,
-			31, 76, 151, 200, 104, 152, 144, 148, 200, 232, 144, 144, 42, 209,
-			107, 155, 31, 208, 76, 91, 101, 232, 213, 48, 97, 48, 73, 208,
-			33, 33, 55, 162, 74, 100, 109, 243, 185, 52, 137, 179, 26, 38,
-			36, 36, 206, 234, 144, 96, 143, 146, 207, 106, 162, 72, 159, 109,
-			126, 24, 56, 251, 247, 52, 186, 225, 212, 167, 170, 12, 175, 216,
-			179, 42, 85, 71, 160, 37, 66, 175, 134, 65, 43, 9, 75, 149,
-			156, 201, 163, 186, 11, 242, 51, 81, 138, 93, 95, 4, 26, 42,
-			209, 71, 130, 61, 182, 203, 194, 34, 55, 227, 157, 35, 24, 12,
-			128, 169, 19, 1, 21, 168, 158, 7, 245, 216, 102, 42, 6, 17,
-			202, 53, 20, 191, 117, 172, 120, 15, 247, 22, 34, 42, 188, 95,
-			37, 52, 14, 153, 19, 139, 143, 66, 94, 59, 17, 109, 249, 55,
-			252, 96, 207, 23, 41, 169, 225, 236, 211, 176, 147, 201, 112, 246,
-			233, 144, 48, 50, 74, 166, 4, 21, 136, 109, 254, 83, 205, 188,
-			179, 112, 15, 93, 198, 91, 145, 7, 211, 65, 149, 39, 26, 230,
-			79, 198, 142, 232, 144, 48, 122, 7, 57, 33, 16, 246, 219, 230,
-			15, 3, 89, 71, 233, 10, 219, 3, 162, 236, 170, 96, 20, 9,
-			154, 126, 13, 115, 37, 237, 234, 215, 33, 97, 36, 17, 0, 3,
-			182, 249, 35, 105, 166, 25, 208, 48, 33, 25, 208, 1, 29, 18,
-			236, 132, 105, 6, 109, 243, 71, 211, 34, 99, 80, 195, 132, 132,
-			105, 6, 117, 72, 200, 37, 172, 60, 100, 155, 31, 213, 204, 187,
-			84, 134, 33, 13, 19, 70, 146, 4, 29, 18, 14, 221, 169, 74,
-			12, 219, 230, 255, 154, 46, 49, 172, 97, 66, 82, 98, 88, 135,
-			132, 67, 119, 146, 113, 81, 34, 103, 155, 207, 107, 230, 29, 133,
-			187, 96, 78, 70, 29, 83, 153, 27, 238, 100, 201, 156, 134, 57,
-			147, 14, 230, 116, 72, 176, 15, 41, 84, 35, 182, 249, 99, 183,
-			133, 106, 68, 195, 156, 9, 170, 17, 29, 18, 144, 86, 32, 244,
-			53, 59, 243, 19, 154, 254, 51, 74, 232, 131, 112, 253, 9, 173,
-			119, 128, 156, 198, 154, 64, 127, 50, 127, 74, 51, 239, 202, 231,
-			111, 41, 244, 101, 101, 168, 46, 65, 102, 59, 73, 208, 33, 225,
-			14, 73, 52, 80, 152, 204, 159, 78, 136, 134, 58, 16, 36, 36,
-			37, 64, 150, 255, 116, 186, 132, 110, 155, 31, 79, 151, 0, 20,
-			31, 79, 151, 224, 57, 238, 184, 19, 215, 76, 19, 218, 251, 115,
-			154, 126, 132, 119, 7, 87, 246, 159, 147, 43, 187, 137, 177, 125,
-			126, 78, 235, 31, 145, 160, 6, 160, 125, 167, 4, 13, 0, 15,
-			231, 5, 38, 205, 54, 127, 94, 211, 239, 22, 31, 53, 19, 65,
-			137, 73, 179, 0, 236, 207, 73, 16, 51, 143, 220, 37, 65, 3,
-			192, 252, 17, 129, 73, 183, 205, 79, 36, 109, 2, 137, 254, 137,
-			4, 19, 200, 196, 79, 36, 152, 160, 218, 79, 104, 35, 178, 77,
-			176, 96, 124, 2, 218, 244, 65, 13, 81, 25, 182, 249, 191, 129,
-			190, 241, 125, 26, 93, 170, 81, 117, 23, 10, 134, 38, 98, 177,
-			56, 171, 244, 25, 171, 74, 141, 46, 98, 113, 137, 66, 94, 12,
-			102, 134, 159, 241, 220, 82, 150, 228, 113, 221, 146, 178, 202, 62,
-			237, 163, 154, 175, 110, 227, 20, 105, 250, 46, 15, 40, 238, 201,
-			93, 159, 146, 236, 139, 97, 98, 243, 20, 152, 1, 176, 127, 88,
-			130, 26, 128, 185, 67, 18, 196, 190, 220, 53, 70, 126, 81, 199,
-			174, 153, 182, 249, 203, 154, 78, 243, 63, 166, 163, 177, 78, 238,
-			241, 161, 181, 204, 111, 53, 176, 201, 145, 104, 165, 27, 117, 156,
-			129, 98, 184, 129, 118, 147, 241, 158, 202, 47, 132, 63, 92, 19,
-			201, 32, 31, 227, 165, 241, 162, 8, 107, 81, 107, 121, 94, 123,
-			234, 77, 45, 199, 115, 107, 46, 174, 163, 169, 216, 15, 11, 103,
-			206, 76, 225, 171, 85, 81, 37, 192, 80, 133, 24, 202, 129, 175,
-			146, 242, 220, 180, 230, 138, 195, 95, 92, 15, 120, 4, 189, 154,
-			27, 70, 220, 118, 196, 175, 145, 242, 22, 75, 237, 11, 218, 77,
-			146, 94, 33, 209, 157, 176, 178, 195, 170, 34, 196, 130, 202, 135,
-			58, 45, 243, 49, 162, 155, 8, 203, 7, 137, 97, 16, 96, 136,
-			98, 225, 190, 60, 169, 168, 110, 114, 202, 41, 208, 2, 80, 49,
-			57, 44, 227, 191, 172, 217, 146, 251, 76, 3, 192, 163, 199, 200,
-			183, 34, 209, 45, 219, 252, 53, 77, 63, 150, 111, 138, 8, 17,
-			82, 61, 125, 105, 58, 211, 109, 134, 239, 194, 240, 219, 117, 64,
-			62, 124, 205, 75, 188, 19, 182, 203, 87, 192, 100, 231, 224, 248,
-			62, 11, 241, 173, 9, 201, 117, 170, 237, 150, 137, 13, 80, 32,
-			182, 71, 181, 29, 20, 138, 95, 211, 236, 188, 4, 13, 0, 239,
-			57, 74, 126, 150, 115, 76, 198, 54, 127, 83, 211, 79, 230, 127,
-			152, 115, 140, 223, 106, 176, 208, 173, 72, 70, 81, 134, 190, 14,
-			107, 94, 204, 110, 198, 73, 184, 105, 167, 51, 254, 130, 215, 98,
-			194, 84, 188, 29, 4, 30, 115, 128, 14, 133, 56, 108, 177, 2,
-			62, 93, 134, 238, 120, 5, 145, 67, 196, 246, 237, 170, 71, 220,
-			39, 228, 213, 192, 23, 220, 19, 76, 192, 100, 100, 81, 197, 105,
-			114, 210, 56, 126, 155, 238, 57, 237, 73, 89, 25, 168, 106, 93,
-			136, 22, 84, 126, 222, 44, 238, 55, 130, 57, 241, 13, 164, 217,
-			215, 240, 80, 42, 60, 83, 137, 208, 141, 213, 197, 213, 9, 126,
-			192, 56, 57, 199, 207, 17, 167, 46, 156, 23, 154, 226, 195, 146,
-			192, 25, 19, 105, 166, 64, 11, 64, 69, 111, 80, 199, 126, 83,
-			179, 143, 73, 208, 0, 176, 112, 130, 124, 7, 23, 62, 189, 182,
-			249, 59, 154, 126, 60, 191, 11, 179, 12, 69, 6, 108, 4, 69,
-			244, 15, 191, 202, 110, 242, 80, 52, 120, 97, 82, 114, 64, 250,
-			36, 169, 221, 100, 227, 17, 77, 46, 46, 19, 110, 156, 167, 105,
-			243, 172, 203, 237, 123, 242, 177, 32, 244, 61, 193, 18, 138, 101,
-			122, 77, 108, 134, 2, 45, 0, 149, 252, 4, 253, 240, 119, 180,
-			17, 41, 182, 123, 13, 0, 143, 81, 242, 85, 222, 133, 172, 109,
-			254, 62, 116, 225, 243, 26, 143, 1, 153, 112, 182, 108, 1, 15,
-			109, 201, 67, 93, 112, 169, 10, 27, 226, 125, 39, 104, 37, 33,
-			105, 8, 136, 132, 144, 238, 56, 60, 171, 67, 11, 234, 134, 104,
-			65, 236, 252, 96, 26, 39, 248, 139, 162, 79, 248, 105, 92, 196,
-			107, 35, 60, 180, 140, 216, 129, 151, 210, 210, 200, 141, 199, 65,
-			219, 172, 182, 42, 194, 21, 131, 251, 156, 0, 170, 241, 136, 183,
-			127, 187, 45, 163, 32, 162, 180, 114, 49, 88, 79, 197, 105, 48,
-			111, 129, 135, 106, 226, 180, 200, 154, 216, 121, 5, 90, 0, 170,
-			177, 7, 53, 249, 247, 19, 57, 145, 53, 0, 60, 74, 197, 18,
-			214, 103, 155, 159, 214, 244, 147, 226, 99, 159, 137, 160, 196, 212,
-			151, 1, 176, 95, 174, 126, 160, 105, 126, 90, 27, 147, 92, 212,
-			103, 0, 88, 56, 65, 126, 190, 15, 81, 17, 219, 252, 175, 154,
-			126, 42, 255, 163, 125, 72, 194, 176, 197, 18, 97, 163, 2, 116,
-			22, 164, 117, 190, 80, 162, 143, 97, 0, 26, 249, 69, 177, 137,
-			204, 81, 196, 144, 70, 113, 232, 84, 110, 68, 34, 164, 78, 133,
-			81, 80, 87, 195, 42, 198, 15, 195, 147, 50, 40, 36, 204, 197,
-			28, 97, 215, 149, 91, 20, 117, 73, 91, 58, 30, 206, 161, 219,
-			12, 227, 253, 226, 65, 54, 231, 238, 56, 32, 248, 130, 14, 72,
-			148, 128, 6, 94, 85, 54, 175, 34, 204, 0, 56, 200, 170, 53,
-			136, 28, 125, 253, 69, 200, 175, 52, 183, 129, 224, 20, 115, 38,
-			162, 92, 123, 199, 96, 183, 133, 168, 237, 199, 59, 44, 118, 43,
-			5, 254, 189, 40, 92, 142, 246, 181, 207, 141, 35, 26, 5, 30,
-			58, 98, 225, 204, 153, 96, 78, 101, 71, 54, 73, 117, 145, 23,
-			170, 203, 32, 60, 80, 145, 170, 130, 215, 48, 89, 162, 235, 50,
-			69, 52, 42, 162, 236, 166, 27, 165, 130, 20, 201, 147, 10, 52,
-			251, 240, 38, 85, 249, 193, 153, 188, 118, 134, 242, 109, 254, 250,
-			210, 65, 200, 148, 126, 17, 86, 89, 8, 59, 139, 90, 12, 107,
-			131, 231, 209, 66, 200, 28, 79, 244, 52, 146, 33, 84, 229, 122,
-			201, 183, 51, 197, 125, 163, 38, 141, 60, 21, 216, 12, 241, 138,
-			35, 214, 112, 124, 232, 17, 247, 201, 43, 18, 17, 71, 210, 15,
-			252, 169, 144, 53, 25, 238, 217, 58, 241, 202, 24, 179, 56, 70,
-			106, 212, 212, 230, 13, 102, 20, 238, 207, 72, 87, 156, 91, 85,
-			85, 149, 197, 142, 235, 1, 167, 201, 136, 87, 240, 25, 37, 195,
-			94, 24, 196, 44, 197, 207, 248, 234, 83, 16, 227, 65, 138, 27,
-			73, 191, 137, 86, 196, 106, 45, 15, 153, 35, 12, 90, 126, 117,
-			42, 14, 93, 60, 207, 79, 157, 191, 243, 3, 24, 36, 11, 70,
-			243, 138, 208, 109, 154, 238, 49, 130, 114, 120, 95, 159, 186, 7,
-			151, 58, 94, 20, 20, 41, 195, 64, 97, 59, 24, 24, 140, 107,
-			67, 48, 118, 50, 200, 30, 143, 162, 218, 69, 135, 13, 49, 61,
-			25, 186, 123, 97, 212, 159, 36, 48, 152, 244, 90, 136, 19, 99,
-			92, 197, 241, 199, 97, 78, 50, 207, 163, 110, 77, 217, 161, 220,
-			244, 217, 77, 16, 226, 243, 9, 126, 171, 81, 164, 81, 0, 45,
-			65, 214, 16, 35, 33, 199, 147, 116, 119, 2, 24, 3, 183, 210,
-			179, 93, 124, 29, 73, 46, 4, 49, 204, 231, 136, 231, 212, 139,
-			233, 230, 181, 169, 227, 133, 204, 169, 182, 213, 48, 146, 4, 9,
-			42, 137, 79, 117, 222, 14, 127, 74, 201, 78, 98, 162, 212, 82,
-			160, 5, 160, 210, 108, 97, 43, 252, 95, 181, 156, 148, 120, 196,
-			0, 176, 112, 146, 20, 8, 104, 99, 153, 47, 106, 61, 255, 93,
-			211, 242, 135, 58, 76, 115, 178, 55, 253, 196, 48, 65, 207, 249,
-			162, 150, 189, 27, 133, 173, 5, 123, 152, 47, 201, 253, 130, 133,
-			123, 152, 47, 201, 170, 45, 220, 195, 124, 73, 138, 109, 11, 247,
-			48, 95, 146, 123, 24, 11, 247, 48, 95, 146, 123, 24, 11, 54,
-			19, 47, 74, 177, 109, 225, 30, 230, 197, 4, 147, 150, 1, 80,
-			136, 109, 11, 247, 48, 47, 74, 177, 109, 225, 30, 230, 69, 16,
-			219, 227, 68, 55, 51, 118, 230, 111, 180, 158, 119, 235, 90, 254,
-			112, 186, 19, 126, 162, 153, 139, 158, 128, 6, 241, 55, 90, 150,
-			239, 124, 50, 208, 147, 175, 200, 158, 100, 176, 39, 95, 145, 245,
-			103, 176, 39, 95, 145, 61, 201, 96, 79, 190, 34, 123, 146, 193,
-			158, 124, 69, 246, 36, 3, 61, 249, 170, 166, 151, 196, 71, 232,
-			201, 87, 19, 76, 208, 147, 175, 106, 253, 39, 36, 136, 153, 79,
-			78, 74, 208, 0, 176, 56, 37, 48, 233, 182, 249, 183, 154, 46,
-			243, 194, 182, 227, 111, 19, 76, 160, 83, 254, 173, 214, 47, 27,
-			1, 213, 254, 173, 118, 215, 81, 9, 26, 0, 30, 47, 144, 143,
-			131, 2, 154, 49, 122, 236, 204, 119, 232, 250, 119, 235, 70, 254,
-			195, 250, 1, 71, 35, 82, 33, 229, 86, 182, 212, 33, 134, 140,
-			235, 117, 192, 193, 8, 243, 227, 208, 237, 58, 5, 1, 34, 31,
-			120, 4, 210, 117, 2, 130, 111, 243, 73, 119, 93, 62, 99, 171,
-			110, 20, 187, 126, 37, 230, 154, 195, 75, 198, 98, 81, 207, 238,
-			194, 114, 138, 47, 252, 138, 51, 13, 26, 181, 42, 59, 242, 19,
-			10, 44, 124, 31, 183, 25, 186, 252, 125, 92, 165, 77, 99, 139,
-			197, 41, 184, 235, 199, 231, 102, 9, 173, 6, 13, 199, 245, 197,
-			52, 202, 24, 48, 198, 223, 161, 147, 35, 228, 110, 146, 1, 16,
-			24, 228, 59, 245, 125, 231, 51, 176, 183, 207, 240, 35, 67, 248,
-			60, 144, 36, 88, 144, 48, 104, 39, 9, 26, 36, 140, 142, 37,
-			9, 6, 36, 28, 185, 91, 213, 160, 217, 230, 119, 233, 120, 72,
-			115, 80, 13, 192, 72, 223, 149, 174, 1, 54, 246, 223, 149, 174,
-			65, 67, 4, 163, 119, 38, 9, 6, 36, 28, 206, 243, 211, 177,
-			12, 240, 196, 247, 234, 250, 204, 193, 167, 99, 183, 100, 129, 206,
-			15, 221, 172, 64, 64, 167, 195, 185, 37, 95, 55, 238, 98, 7,
-			10, 250, 179, 35, 158, 9, 189, 21, 107, 144, 20, 111, 112, 6,
-			134, 13, 250, 247, 234, 138, 219, 97, 131, 254, 189, 122, 255, 221,
-			18, 212, 0, 188, 231, 140, 4, 177, 103, 165, 105, 226, 97, 63,
-			77, 219, 252, 126, 93, 63, 153, 127, 50, 169, 46, 105, 225, 45,
-			79, 148, 66, 198, 53, 218, 3, 15, 141, 200, 65, 167, 70, 188,
-			114, 147, 87, 167, 64, 11, 64, 37, 43, 96, 83, 251, 253, 186,
-			216, 168, 100, 112, 83, 251, 253, 122, 225, 4, 57, 75, 116, 179,
-			215, 206, 188, 87, 239, 121, 78, 239, 246, 31, 227, 237, 148, 103,
-			33, 92, 116, 9, 169, 5, 155, 134, 247, 234, 89, 174, 236, 246,
-			2, 83, 190, 79, 23, 82, 171, 23, 153, 240, 125, 178, 37, 189,
-			200, 130, 239, 147, 45, 233, 69, 6, 124, 159, 46, 164, 86, 47,
-			178, 223, 251, 116, 33, 181, 122, 129, 249, 222, 175, 11, 27, 82,
-			47, 50, 219, 251, 19, 76, 192, 106, 239, 215, 197, 206, 165, 23,
-			25, 237, 253, 186, 176, 33, 245, 34, 155, 189, 95, 23, 54, 164,
-			94, 144, 90, 31, 208, 245, 9, 241, 17, 6, 227, 3, 9, 38,
-			144, 90, 31, 208, 251, 101, 139, 161, 218, 15, 232, 119, 159, 144,
-			160, 1, 224, 189, 227, 228, 36, 209, 205, 172, 157, 249, 160, 222,
-			243, 207, 116, 45, 127, 103, 7, 117, 196, 101, 116, 65, 16, 216,
-			12, 124, 80, 207, 30, 197, 202, 179, 64, 144, 15, 73, 130, 100,
-			145, 32, 31, 146, 149, 103, 145, 32, 31, 146, 4, 201, 34, 65,
-			62, 36, 9, 146, 69, 130, 124, 72, 18, 36, 11, 45, 251, 176,
-			174, 23, 197, 71, 32, 200, 135, 19, 76, 32, 198, 63, 172, 247,
-			83, 9, 98, 230, 227, 227, 18, 52, 0, 60, 125, 70, 96, 210,
-			109, 243, 35, 186, 126, 175, 248, 8, 4, 249, 72, 130, 9, 8,
-			242, 17, 189, 255, 176, 4, 53, 0, 243, 199, 37, 104, 0, 120,
-			242, 20, 153, 33, 186, 217, 103, 103, 126, 84, 239, 249, 57, 93,
-			203, 23, 186, 124, 76, 226, 157, 160, 42, 195, 119, 167, 137, 3,
-			251, 155, 31, 213, 179, 247, 96, 67, 250, 128, 56, 31, 149, 196,
-			233, 67, 226, 124, 84, 54, 164, 15, 137, 243, 81, 73, 156, 62,
-			36, 206, 71, 37, 113, 250, 144, 56, 31, 5, 226, 252, 160, 134,
-			168, 52, 219, 252, 113, 93, 63, 158, 127, 187, 70, 151, 124, 244,
-			205, 244, 171, 210, 77, 19, 237, 46, 56, 203, 112, 195, 204, 34,
-			38, 30, 30, 61, 192, 226, 178, 231, 180, 241, 9, 221, 3, 195,
-			41, 41, 35, 76, 145, 110, 183, 98, 121, 19, 162, 6, 58, 107,
-			176, 223, 183, 134, 55, 20, 6, 234, 199, 147, 94, 1, 231, 254,
-			120, 210, 43, 13, 219, 109, 223, 45, 65, 3, 192, 99, 84, 208,
-			71, 183, 205, 143, 233, 122, 65, 124, 132, 129, 250, 88, 130, 73,
-			183, 0, 84, 152, 128, 2, 31, 211, 237, 123, 36, 104, 0, 72,
-			143, 11, 76, 134, 109, 254, 164, 174, 159, 18, 31, 65, 150, 253,
-			100, 130, 9, 100, 217, 79, 234, 253, 99, 18, 212, 0, 60, 76,
-			37, 136, 101, 79, 156, 36, 101, 196, 100, 218, 230, 199, 117, 253,
-			254, 252, 253, 116, 73, 94, 216, 139, 64, 83, 229, 187, 55, 202,
-			99, 129, 192, 102, 133, 71, 215, 144, 233, 210, 127, 90, 214, 105,
-			114, 60, 10, 180, 0, 20, 90, 97, 31, 10, 169, 143, 235, 57,
-			217, 4, 16, 82, 31, 135, 38, 8, 48, 11, 224, 169, 11, 18,
-			236, 5, 112, 230, 62, 209, 64, 203, 54, 127, 246, 160, 6, 242,
-			104, 36, 251, 27, 40, 210, 187, 27, 104, 153, 136, 71, 129, 136,
-			86, 53, 16, 212, 206, 159, 77, 26, 104, 25, 0, 170, 6, 90,
-			89, 0, 85, 3, 173, 94, 0, 103, 238, 35, 31, 31, 34, 186,
-			73, 236, 204, 127, 208, 123, 126, 215, 208, 102, 87, 232, 131, 127,
-			255, 127, 132, 138, 91, 170, 100, 246, 119, 7, 105, 25, 54, 175,
-			202, 51, 46, 241, 152, 228, 55, 133, 96, 241, 192, 224, 247, 5,
-			25, 158, 134, 58, 49, 191, 53, 151, 158, 25, 132, 62, 141, 251,
-			9, 117, 175, 45, 74, 45, 78, 124, 75, 128, 215, 145, 226, 0,
-			22, 33, 185, 117, 173, 210, 200, 115, 235, 59, 177, 215, 78, 162,
-			29, 195, 58, 5, 91, 81, 167, 45, 173, 89, 116, 199, 133, 61,
-			126, 13, 119, 98, 85, 161, 114, 53, 28, 223, 109, 182, 60, 220,
-			28, 42, 115, 145, 28, 16, 208, 206, 164, 135, 0, 32, 58, 216,
-			67, 192, 137, 246, 121, 8, 48, 122, 90, 144, 38, 133, 75, 244,
-			50, 149, 87, 174, 184, 109, 134, 171, 174, 176, 34, 112, 71, 109,
-			249, 74, 67, 145, 63, 241, 150, 222, 165, 161, 146, 128, 174, 19,
-			137, 5, 84, 42, 27, 141, 18, 165, 75, 126, 20, 51, 167, 202,
-			55, 192, 129, 136, 173, 220, 160, 252, 97, 8, 190, 167, 243, 59,
-			26, 153, 88, 184, 69, 204, 236, 131, 174, 14, 151, 210, 6, 63,
-			148, 63, 56, 158, 74, 124, 137, 115, 9, 140, 47, 141, 166, 129,
-			253, 36, 160, 143, 49, 110, 53, 79, 69, 173, 71, 19, 79, 64,
-			155, 1, 31, 5, 110, 157, 75, 209, 8, 159, 136, 217, 99, 60,
-			46, 48, 117, 82, 166, 140, 34, 144, 138, 115, 7, 127, 124, 16,
-			42, 197, 49, 140, 120, 211, 182, 25, 243, 9, 167, 27, 63, 239,
-			141, 2, 204, 147, 194, 14, 194, 24, 201, 94, 194, 87, 150, 164,
-			83, 147, 60, 150, 71, 94, 233, 28, 109, 113, 21, 171, 178, 19,
-			68, 12, 77, 62, 252, 34, 89, 52, 71, 232, 105, 220, 229, 203,
-			140, 188, 101, 104, 33, 148, 174, 54, 184, 95, 80, 126, 29, 34,
-			48, 184, 8, 142, 207, 109, 142, 33, 161, 148, 6, 97, 221, 241,
-			221, 103, 196, 253, 190, 32, 20, 209, 215, 155, 44, 116, 241, 8,
-			218, 147, 117, 20, 145, 144, 194, 57, 72, 52, 249, 190, 25, 124,
-			15, 156, 210, 120, 39, 68, 107, 1, 62, 171, 39, 141, 253, 226,
-			100, 162, 29, 180, 248, 61, 178, 228, 29, 61, 72, 170, 6, 169,
-			55, 5, 48, 26, 55, 31, 89, 254, 10, 60, 180, 66, 137, 46,
-			209, 130, 210, 75, 244, 122, 91, 248, 157, 71, 59, 130, 252, 216,
-			125, 238, 138, 142, 254, 176, 10, 27, 212, 148, 126, 49, 18, 67,
-			82, 187, 160, 153, 178, 169, 134, 227, 138, 81, 221, 110, 213, 166,
-			234, 248, 50, 205, 148, 26, 193, 169, 144, 213, 221, 40, 14, 219,
-			169, 235, 57, 216, 249, 64, 106, 168, 41, 87, 53, 229, 240, 182,
-			238, 54, 154, 94, 91, 94, 224, 131, 174, 227, 69, 58, 124, 160,
-			6, 85, 93, 188, 196, 130, 193, 197, 241, 213, 26, 119, 151, 77,
-			45, 208, 166, 215, 170, 187, 62, 62, 150, 223, 89, 100, 143, 109,
-			99, 0, 244, 9, 183, 70, 157, 93, 199, 245, 156, 109, 143, 77,
-			10, 151, 224, 144, 141, 71, 212, 15, 120, 8, 110, 113, 125, 239,
-			102, 211, 67, 121, 20, 236, 33, 217, 97, 174, 249, 248, 69, 80,
-			190, 81, 162, 155, 81, 11, 45, 54, 240, 29, 217, 7, 11, 227,
-			59, 76, 116, 95, 151, 74, 232, 224, 204, 125, 152, 96, 131, 193,
-			246, 141, 147, 184, 68, 10, 136, 2, 63, 69, 19, 108, 22, 31,
-			222, 237, 54, 109, 182, 98, 249, 16, 147, 16, 23, 81, 107, 123,
-			170, 195, 215, 9, 79, 57, 248, 140, 144, 211, 59, 226, 183, 94,
-			104, 80, 227, 108, 135, 215, 39, 163, 244, 147, 1, 48, 57, 232,
-			203, 189, 44, 139, 47, 226, 96, 234, 137, 64, 138, 41, 113, 82,
-			232, 70, 52, 110, 133, 32, 108, 91, 177, 88, 10, 184, 252, 0,
-			153, 128, 97, 235, 133, 238, 167, 24, 49, 98, 49, 109, 53, 5,
-			103, 56, 173, 56, 104, 56, 177, 136, 132, 237, 68, 120, 49, 75,
-			88, 253, 37, 143, 112, 165, 145, 104, 182, 249, 31, 244, 236, 40,
-			247, 77, 39, 160, 53, 254, 145, 174, 159, 200, 191, 160, 209, 117,
-			25, 188, 29, 95, 159, 19, 209, 136, 196, 139, 98, 24, 33, 137,
-			69, 169, 245, 73, 28, 1, 184, 145, 180, 10, 138, 134, 17, 218,
-			244, 156, 10, 174, 129, 151, 219, 242, 28, 170, 152, 114, 223, 150,
-			136, 93, 124, 75, 171, 202, 181, 63, 105, 39, 8, 106, 49, 72,
-			56, 215, 79, 109, 237, 149, 233, 172, 163, 188, 178, 179, 249, 65,
-			216, 192, 110, 227, 41, 43, 103, 11, 245, 48, 169, 220, 249, 11,
-			141, 149, 43, 32, 4, 181, 227, 63, 146, 250, 8, 65, 237, 248,
-			143, 164, 246, 71, 80, 59, 254, 35, 221, 62, 42, 65, 3, 192,
-			227, 5, 242, 203, 58, 146, 76, 179, 205, 63, 213, 245, 51, 249,
-			159, 209, 213, 249, 19, 200, 96, 60, 101, 18, 100, 82, 231, 35,
-			233, 171, 105, 120, 95, 53, 116, 154, 242, 240, 77, 136, 75, 66,
-			49, 30, 27, 47, 155, 60, 109, 146, 108, 83, 229, 193, 20, 138,
-			85, 134, 111, 173, 226, 33, 58, 170, 209, 103, 9, 157, 96, 111,
-			106, 185, 187, 142, 199, 93, 240, 249, 49, 163, 87, 165, 133, 169,
-			169, 192, 103, 91, 24, 91, 12, 163, 115, 11, 134, 224, 211, 93,
-			216, 46, 39, 112, 120, 9, 117, 100, 75, 19, 11, 52, 191, 63,
-			23, 113, 109, 92, 72, 118, 108, 35, 31, 49, 249, 100, 203, 30,
-			127, 148, 129, 160, 12, 112, 43, 46, 168, 44, 149, 157, 32, 16,
-			150, 88, 222, 43, 232, 198, 164, 162, 62, 104, 241, 127, 154, 80,
-			31, 180, 248, 63, 77, 168, 175, 33, 125, 237, 123, 37, 104, 0,
-			56, 121, 154, 252, 136, 129, 212, 215, 109, 243, 243, 186, 126, 41,
-			255, 94, 67, 80, 95, 29, 151, 240, 55, 25, 56, 247, 162, 58,
-			36, 152, 21, 47, 154, 123, 94, 234, 88, 128, 70, 172, 233, 224,
-			207, 18, 144, 135, 95, 40, 227, 211, 27, 84, 190, 228, 158, 140,
-			16, 20, 69, 97, 31, 198, 21, 87, 204, 67, 174, 57, 41, 197,
-			35, 125, 53, 13, 7, 172, 197, 159, 44, 136, 152, 58, 126, 7,
-			5, 194, 15, 226, 211, 48, 123, 197, 201, 188, 139, 143, 97, 240,
-			33, 75, 152, 128, 159, 174, 163, 3, 236, 65, 241, 250, 74, 52,
-			241, 230, 234, 42, 201, 107, 145, 62, 92, 36, 53, 93, 249, 211,
-			27, 234, 178, 59, 52, 115, 28, 166, 115, 156, 108, 203, 38, 38,
-			229, 102, 211, 137, 232, 30, 243, 60, 60, 254, 241, 219, 36, 69,
-			144, 148, 162, 209, 69, 0, 113, 125, 136, 15, 26, 108, 108, 62,
-			159, 140, 48, 108, 35, 62, 47, 245, 125, 130, 187, 171, 207, 235,
-			185, 19, 18, 52, 0, 188, 119, 66, 130, 89, 0, 39, 231, 36,
-			216, 11, 224, 249, 139, 100, 18, 135, 223, 176, 205, 47, 232, 122,
-			57, 127, 132, 207, 9, 33, 227, 170, 1, 195, 227, 131, 29, 238,
-			117, 196, 75, 194, 198, 236, 11, 73, 35, 12, 11, 64, 213, 8,
-			216, 152, 125, 65, 207, 77, 73, 16, 17, 207, 204, 74, 48, 11,
-			224, 185, 69, 244, 255, 1, 16, 63, 159, 95, 32, 127, 198, 101,
-			128, 105, 155, 95, 214, 245, 135, 242, 255, 151, 254, 18, 92, 56,
-			251, 210, 108, 168, 84, 118, 130, 154, 205, 30, 55, 100, 227, 123,
-			71, 208, 39, 80, 19, 241, 212, 199, 1, 13, 58, 102, 141, 38,
-			170, 59, 13, 135, 111, 22, 132, 144, 119, 240, 60, 105, 115, 227,
-			202, 212, 107, 8, 30, 203, 211, 136, 189, 169, 133, 135, 125, 124,
-			226, 242, 103, 19, 248, 33, 31, 161, 34, 58, 91, 234, 106, 39,
-			111, 84, 53, 80, 106, 111, 137, 36, 236, 229, 164, 92, 133, 133,
-			86, 198, 153, 139, 191, 76, 24, 37, 149, 119, 214, 29, 201, 99,
-			37, 49, 62, 59, 14, 100, 165, 172, 86, 3, 237, 66, 116, 46,
-			81, 30, 177, 110, 15, 20, 14, 113, 61, 73, 141, 161, 201, 9,
-			173, 64, 11, 64, 53, 134, 176, 179, 253, 178, 158, 147, 162, 2,
-			118, 182, 95, 214, 39, 207, 72, 48, 11, 96, 241, 65, 9, 246,
-			2, 120, 255, 3, 100, 25, 70, 208, 236, 177, 51, 95, 213, 245,
-			255, 71, 55, 242, 15, 80, 21, 160, 68, 9, 112, 113, 28, 118,
-			208, 237, 88, 185, 217, 138, 220, 103, 84, 59, 209, 73, 249, 171,
-			122, 239, 40, 89, 4, 126, 225, 78, 202, 255, 83, 55, 135, 10,
-			231, 21, 242, 228, 226, 40, 22, 7, 132, 98, 87, 84, 84, 175,
-			77, 114, 5, 153, 155, 133, 137, 240, 74, 254, 159, 186, 217, 151,
-			36, 232, 144, 48, 48, 72, 174, 138, 122, 52, 219, 252, 154, 110,
-			218, 249, 12, 191, 219, 89, 152, 70, 71, 219, 36, 164, 200, 106,
-			19, 37, 184, 242, 246, 20, 50, 137, 79, 116, 233, 151, 73, 132,
-			59, 243, 215, 116, 225, 155, 72, 132, 59, 243, 215, 244, 220, 136,
-			170, 74, 183, 205, 191, 211, 205, 59, 10, 247, 167, 187, 84, 101,
-			226, 9, 71, 193, 89, 203, 110, 204, 84, 68, 138, 174, 33, 149,
-			152, 161, 209, 127, 167, 11, 143, 77, 34, 220, 147, 255, 78, 31,
-			61, 132, 246, 21, 2, 210, 226, 173, 134, 126, 73, 140, 155, 101,
-			34, 40, 89, 192, 202, 0, 40, 78, 139, 8, 218, 14, 222, 106,
-			140, 157, 148, 160, 1, 224, 184, 148, 37, 86, 22, 64, 37, 75,
-			172, 94, 0, 207, 95, 36, 159, 229, 179, 56, 99, 155, 223, 109,
-			232, 133, 252, 191, 215, 19, 229, 231, 106, 208, 165, 250, 240, 7,
-			165, 95, 158, 234, 179, 84, 163, 1, 15, 95, 82, 220, 135, 84,
-			232, 111, 233, 168, 22, 157, 225, 59, 80, 181, 156, 66, 247, 143,
-			109, 39, 98, 105, 167, 39, 165, 59, 225, 169, 39, 109, 58, 241,
-			14, 62, 163, 35, 3, 119, 148, 120, 209, 148, 219, 70, 186, 84,
-			20, 59, 49, 103, 130, 142, 69, 11, 69, 183, 64, 131, 231, 39,
-			7, 99, 233, 110, 75, 71, 97, 121, 205, 80, 73, 11, 53, 131,
-			51, 38, 18, 89, 129, 22, 128, 106, 177, 207, 104, 0, 10, 147,
-			29, 65, 79, 159, 239, 54, 142, 29, 39, 191, 111, 226, 0, 245,
-			218, 230, 251, 13, 253, 82, 254, 55, 76, 186, 206, 253, 147, 69,
-			208, 82, 185, 12, 71, 157, 70, 19, 215, 231, 43, 184, 124, 190,
-			236, 97, 74, 11, 34, 136, 105, 65, 21, 225, 174, 134, 120, 68,
-			43, 163, 134, 224, 27, 235, 109, 152, 143, 177, 91, 1, 173, 155,
-			174, 93, 95, 160, 17, 127, 78, 25, 141, 57, 109, 44, 148, 212,
-			132, 151, 156, 25, 161, 168, 92, 118, 138, 249, 104, 95, 51, 232,
-			132, 138, 48, 80, 229, 81, 91, 28, 79, 108, 190, 162, 201, 18,
-			161, 87, 187, 59, 181, 199, 132, 37, 4, 247, 55, 55, 92, 31,
-			13, 192, 82, 245, 144, 175, 94, 6, 126, 202, 215, 27, 15, 237,
-			156, 208, 107, 75, 239, 102, 52, 220, 116, 135, 98, 34, 7, 84,
-			198, 169, 177, 167, 158, 230, 98, 213, 180, 87, 185, 235, 211, 154,
-			179, 27, 160, 23, 20, 159, 233, 162, 225, 226, 105, 199, 206, 229,
-			44, 77, 81, 190, 163, 188, 53, 73, 67, 124, 210, 187, 72, 132,
-			72, 82, 46, 107, 1, 69, 7, 183, 18, 165, 171, 120, 79, 182,
-			202, 196, 30, 156, 111, 167, 35, 88, 63, 246, 177, 1, 119, 95,
-			239, 208, 66, 249, 1, 60, 107, 112, 79, 130, 86, 178, 168, 244,
-			154, 200, 86, 10, 180, 0, 84, 139, 74, 175, 6, 160, 210, 78,
-			122, 13, 0, 149, 118, 210, 155, 5, 80, 73, 148, 94, 228, 208,
-			243, 23, 133, 228, 202, 218, 230, 15, 26, 186, 92, 113, 178, 38,
-			130, 178, 158, 172, 5, 160, 170, 39, 171, 1, 152, 59, 37, 65,
-			3, 192, 137, 211, 18, 68, 84, 103, 30, 144, 96, 47, 128, 23,
-			46, 137, 122, 250, 108, 243, 3, 137, 132, 236, 51, 17, 148, 245,
-			244, 89, 0, 170, 122, 250, 52, 0, 85, 127, 250, 12, 0, 85,
-			127, 250, 178, 0, 170, 254, 244, 245, 2, 168, 250, 67, 108, 243,
-			57, 67, 151, 141, 32, 38, 130, 178, 30, 98, 1, 168, 234, 129,
-			157, 229, 115, 70, 78, 74, 98, 98, 0, 56, 62, 41, 193, 44,
-			128, 167, 101, 147, 73, 47, 128, 247, 205, 145, 23, 249, 54, 180,
-			223, 54, 255, 169, 161, 207, 230, 63, 167, 209, 165, 168, 35, 38,
-			136, 100, 196, 135, 9, 229, 175, 245, 240, 7, 1, 185, 15, 168,
-			19, 214, 89, 12, 82, 55, 174, 5, 97, 67, 248, 76, 193, 218,
-			205, 26, 110, 12, 249, 147, 187, 17, 202, 252, 74, 184, 162, 191,
-			203, 194, 54, 234, 139, 105, 5, 22, 109, 83, 110, 172, 132, 180,
-			92, 178, 189, 54, 117, 235, 126, 16, 178, 234, 37, 153, 29, 202,
-			19, 234, 49, 39, 138, 211, 126, 99, 120, 51, 66, 174, 228, 88,
-			147, 236, 2, 87, 195, 188, 212, 46, 180, 223, 196, 94, 43, 208,
-			2, 80, 209, 179, 95, 3, 48, 151, 151, 160, 1, 224, 61, 199,
-			36, 152, 5, 144, 158, 149, 96, 47, 128, 103, 102, 240, 133, 47,
-			130, 87, 21, 12, 253, 254, 252, 155, 40, 15, 180, 28, 73, 63,
-			32, 60, 71, 194, 168, 203, 234, 205, 71, 17, 15, 228, 160, 144,
-			44, 165, 142, 141, 39, 225, 210, 40, 14, 82, 98, 80, 109, 120,
-			131, 144, 46, 156, 57, 163, 186, 54, 96, 98, 11, 20, 104, 1,
-			168, 186, 134, 55, 39, 12, 97, 240, 39, 250, 128, 1, 224, 9,
-			57, 19, 6, 178, 0, 222, 123, 65, 130, 189, 0, 158, 189, 143,
-			252, 0, 103, 149, 65, 219, 252, 49, 67, 159, 204, 191, 45, 101,
-			177, 8, 164, 81, 139, 86, 196, 70, 136, 7, 115, 22, 130, 195,
-			69, 24, 109, 113, 184, 19, 130, 237, 122, 170, 8, 57, 168, 67,
-			93, 107, 124, 137, 75, 44, 238, 166, 36, 101, 149, 234, 238, 160,
-			137, 141, 82, 160, 5, 160, 90, 228, 6, 53, 0, 109, 57, 3,
-			7, 13, 0, 239, 157, 32, 75, 216, 159, 33, 219, 252, 9, 67,
-			159, 200, 95, 162, 42, 36, 52, 146, 115, 95, 155, 46, 201, 106,
-			35, 185, 205, 23, 11, 187, 106, 198, 144, 137, 184, 20, 104, 1,
-			168, 154, 49, 164, 1, 104, 23, 36, 104, 0, 120, 106, 156, 188,
-			155, 43, 67, 195, 182, 249, 179, 134, 126, 50, 255, 237, 122, 202,
-			112, 67, 215, 247, 220, 90, 156, 94, 219, 112, 106, 224, 229, 174,
-			253, 38, 29, 208, 251, 22, 164, 191, 39, 250, 111, 132, 12, 84,
-			34, 96, 253, 241, 210, 56, 215, 245, 241, 169, 208, 168, 18, 132,
-			76, 197, 138, 227, 174, 34, 129, 28, 52, 233, 222, 29, 77, 71,
-			237, 198, 118, 224, 69, 68, 110, 54, 133, 19, 102, 156, 108, 45,
-			34, 62, 184, 92, 253, 41, 114, 239, 42, 108, 162, 50, 200, 11,
-			23, 90, 126, 130, 64, 94, 170, 26, 85, 139, 36, 224, 176, 137,
-			52, 81, 160, 5, 160, 162, 231, 176, 6, 160, 50, 19, 13, 27,
-			0, 30, 63, 129, 30, 237, 68, 207, 217, 230, 47, 192, 176, 54,
-			19, 46, 109, 238, 52, 111, 151, 59, 33, 235, 62, 6, 32, 7,
-			112, 229, 162, 24, 39, 55, 162, 176, 67, 108, 171, 182, 231, 76,
-			108, 128, 2, 45, 0, 85, 219, 115, 26, 128, 138, 23, 114, 6,
-			128, 167, 198, 201, 191, 226, 115, 108, 196, 54, 127, 201, 208, 79,
-			229, 63, 174, 225, 118, 34, 69, 111, 52, 36, 240, 32, 131, 42,
-			194, 27, 178, 108, 80, 59, 184, 209, 170, 137, 36, 105, 227, 190,
-			81, 84, 159, 58, 21, 86, 84, 55, 211, 30, 201, 48, 49, 128,
-			25, 98, 22, 54, 146, 176, 64, 170, 17, 170, 243, 35, 38, 118,
-			64, 129, 22, 128, 170, 243, 35, 26, 128, 182, 20, 165, 35, 6,
-			128, 133, 147, 228, 55, 121, 231, 109, 219, 252, 101, 67, 47, 229,
-			63, 249, 247, 232, 188, 12, 1, 175, 168, 64, 246, 143, 212, 215,
-			165, 66, 98, 58, 76, 19, 130, 36, 47, 29, 126, 125, 66, 216,
-			38, 118, 70, 129, 22, 128, 138, 16, 182, 6, 160, 45, 117, 1,
-			219, 0, 240, 204, 20, 249, 215, 156, 16, 163, 182, 249, 235, 32,
-			17, 126, 254, 235, 17, 66, 142, 87, 80, 163, 97, 107, 187, 253,
-			247, 96, 2, 225, 56, 249, 13, 177, 1, 86, 221, 45, 18, 71,
-			77, 236, 132, 2, 45, 0, 21, 1, 70, 53, 0, 213, 20, 30,
-			53, 0, 60, 126, 130, 188, 5, 251, 127, 200, 54, 63, 101, 232,
-			115, 121, 255, 27, 186, 224, 157, 188, 178, 220, 25, 123, 81, 174,
-			187, 133, 85, 121, 108, 44, 143, 27, 210, 183, 193, 137, 126, 200,
-			196, 234, 21, 152, 1, 176, 95, 54, 245, 144, 6, 160, 184, 13,
-			78, 244, 67, 6, 128, 23, 46, 146, 239, 214, 136, 97, 130, 102,
-			246, 219, 134, 126, 56, 255, 204, 223, 251, 58, 248, 55, 222, 11,
-			212, 32, 173, 30, 104, 137, 184, 59, 78, 240, 238, 248, 111, 27,
-			226, 238, 56, 193, 187, 227, 191, 109, 220, 49, 198, 79, 38, 250,
-			108, 243, 255, 52, 244, 33, 94, 176, 175, 7, 160, 126, 254, 180,
-			59, 233, 131, 130, 105, 80, 23, 224, 0, 209, 205, 126, 59, 243,
-			239, 140, 158, 31, 48, 53, 68, 3, 106, 211, 191, 51, 178, 119,
-			146, 255, 104, 17, 211, 236, 215, 123, 108, 243, 5, 67, 127, 40,
-			255, 41, 11, 4, 49, 110, 4, 82, 231, 82, 137, 203, 252, 89,
-			105, 193, 128, 92, 233, 75, 174, 181, 142, 11, 73, 210, 176, 149,
-			90, 117, 48, 135, 58, 113, 152, 2, 157, 209, 137, 221, 109, 23,
-			3, 235, 40, 243, 86, 23, 118, 34, 208, 151, 40, 158, 116, 137,
-			219, 183, 157, 239, 159, 163, 107, 178, 140, 72, 199, 175, 172, 207,
-			81, 186, 20, 143, 71, 212, 99, 81, 68, 40, 171, 213, 220, 138,
-			139, 87, 180, 118, 64, 163, 99, 123, 44, 164, 53, 230, 224, 243,
-			195, 220, 92, 13, 67, 9, 75, 45, 127, 61, 29, 149, 225, 174,
-			40, 124, 202, 21, 94, 26, 116, 217, 77, 7, 227, 229, 117, 156,
-			4, 83, 149, 253, 74, 16, 208, 55, 243, 72, 160, 98, 222, 222,
-			226, 129, 7, 250, 32, 82, 251, 18, 207, 155, 226, 177, 243, 48,
-			0, 13, 231, 38, 126, 121, 182, 211, 93, 148, 165, 14, 238, 65,
-			103, 231, 231, 222, 64, 6, 217, 60, 238, 101, 125, 41, 69, 208,
-			72, 56, 241, 97, 214, 244, 80, 17, 188, 172, 212, 201, 221, 184,
-			43, 69, 39, 38, 217, 111, 216, 212, 226, 6, 85, 234, 192, 151,
-			120, 248, 52, 212, 37, 164, 115, 194, 182, 112, 37, 141, 248, 89,
-			64, 132, 59, 133, 110, 55, 126, 172, 242, 178, 114, 244, 22, 123,
-			2, 121, 18, 87, 76, 133, 153, 141, 247, 240, 56, 55, 14, 221,
-			138, 10, 95, 139, 163, 207, 252, 90, 16, 86, 196, 126, 62, 62,
-			40, 102, 158, 16, 16, 253, 120, 136, 245, 130, 20, 16, 253, 120,
-			136, 245, 130, 212, 177, 251, 209, 116, 248, 130, 145, 27, 151, 160,
-			1, 224, 233, 51, 18, 204, 2, 40, 108, 163, 253, 122, 79, 47,
-			128, 247, 63, 64, 62, 175, 225, 164, 209, 108, 243, 191, 24, 250,
-			149, 252, 103, 52, 186, 232, 70, 201, 14, 34, 101, 121, 16, 86,
-			32, 249, 54, 7, 45, 84, 83, 7, 9, 5, 42, 223, 231, 144,
-			174, 152, 21, 199, 199, 8, 176, 53, 207, 173, 196, 242, 38, 36,
-			183, 40, 75, 76, 210, 229, 66, 158, 119, 161, 20, 18, 79, 126,
-			55, 64, 225, 84, 113, 210, 132, 34, 36, 230, 45, 115, 34, 151,
-			133, 151, 240, 61, 104, 110, 132, 224, 147, 201, 217, 13, 92, 201,
-			46, 226, 68, 37, 213, 200, 130, 162, 163, 102, 98, 111, 21, 104,
-			1, 168, 232, 168, 33, 45, 114, 211, 18, 52, 0, 156, 61, 39,
-			193, 44, 128, 231, 203, 18, 236, 5, 240, 225, 69, 242, 5, 78,
-			71, 221, 54, 255, 218, 208, 207, 230, 255, 115, 178, 173, 149, 236,
-			253, 138, 237, 108, 83, 115, 232, 101, 110, 103, 197, 110, 150, 220,
-			246, 118, 54, 197, 246, 188, 255, 186, 137, 29, 86, 160, 5, 160,
-			34, 37, 176, 213, 95, 203, 29, 109, 63, 158, 251, 252, 181, 113,
-			207, 81, 9, 102, 1, 60, 54, 35, 193, 94, 0, 79, 79, 147,
-			143, 100, 144, 148, 134, 109, 126, 183, 169, 31, 205, 191, 59, 3,
-			138, 131, 186, 219, 34, 201, 201, 39, 90, 231, 57, 120, 74, 29,
-			115, 154, 232, 209, 222, 230, 51, 95, 144, 137, 64, 186, 188, 0,
-			37, 47, 250, 168, 20, 46, 245, 40, 164, 60, 240, 90, 214, 222,
-			104, 55, 89, 145, 98, 8, 122, 248, 249, 16, 164, 111, 113, 6,
-			126, 144, 158, 189, 68, 18, 29, 161, 154, 190, 156, 228, 5, 193,
-			141, 8, 131, 90, 72, 116, 162, 193, 215, 156, 38, 186, 69, 226,
-			179, 50, 82, 160, 166, 133, 170, 124, 130, 166, 83, 140, 38, 57,
-			28, 143, 138, 102, 225, 211, 243, 188, 17, 251, 178, 168, 6, 139,
-			125, 208, 131, 116, 86, 100, 123, 150, 255, 81, 50, 172, 179, 65,
-			93, 189, 35, 116, 169, 43, 94, 4, 186, 161, 241, 115, 94, 144,
-			91, 41, 171, 0, 31, 23, 217, 252, 7, 113, 197, 85, 12, 185,
-			221, 138, 81, 129, 197, 199, 206, 113, 251, 13, 99, 227, 118, 112,
-			189, 50, 155, 198, 1, 221, 129, 5, 90, 60, 59, 159, 126, 99,
-			92, 4, 128, 75, 157, 99, 205, 95, 95, 66, 101, 6, 111, 9,
-			236, 139, 110, 129, 167, 86, 210, 93, 5, 163, 150, 58, 17, 161,
-			110, 45, 185, 197, 200, 25, 254, 224, 155, 89, 120, 169, 97, 117,
-			163, 60, 39, 99, 51, 10, 219, 162, 210, 92, 187, 98, 211, 210,
-			121, 126, 140, 46, 181, 12, 228, 42, 30, 203, 142, 200, 173, 40,
-			191, 224, 41, 16, 8, 145, 37, 221, 170, 220, 70, 135, 21, 147,
-			27, 155, 197, 134, 64, 174, 3, 194, 15, 44, 89, 15, 12, 19,
-			167, 136, 2, 45, 0, 213, 228, 51, 52, 0, 115, 135, 37, 136,
-			243, 233, 238, 123, 200, 73, 208, 153, 250, 108, 243, 29, 166, 62,
-			88, 184, 11, 15, 156, 61, 55, 134, 197, 92, 28, 73, 109, 123,
-			140, 43, 115, 253, 160, 147, 189, 195, 236, 31, 224, 40, 80, 39,
-			75, 131, 186, 0, 143, 9, 140, 223, 3, 24, 109, 196, 232, 59,
-			126, 176, 229, 68, 91, 128, 89, 34, 211, 32, 135, 42, 173, 117,
-			129, 186, 0, 87, 113, 242, 155, 182, 249, 46, 243, 155, 23, 106,
-			169, 31, 207, 21, 223, 149, 208, 202, 204, 0, 216, 47, 69, 145,
-			169, 1, 120, 76, 10, 121, 211, 0, 80, 132, 90, 234, 183, 108,
-			243, 221, 230, 43, 19, 106, 169, 31, 212, 229, 119, 155, 66, 93,
-			238, 71, 117, 249, 221, 166, 80, 151, 251, 81, 93, 126, 183, 121,
-			199, 24, 106, 189, 3, 118, 230, 189, 102, 207, 127, 19, 90, 239,
-			128, 102, 155, 239, 53, 179, 135, 200, 143, 232, 196, 52, 7, 64,
-			235, 125, 206, 212, 75, 249, 247, 234, 72, 49, 124, 246, 41, 97,
-			86, 121, 164, 133, 174, 79, 103, 206, 116, 31, 83, 11, 245, 216,
-			73, 92, 79, 201, 45, 174, 170, 11, 103, 199, 29, 199, 7, 242,
-			43, 79, 156, 61, 96, 230, 18, 85, 91, 7, 121, 42, 64, 20,
-			97, 182, 153, 23, 236, 201, 133, 190, 115, 251, 215, 102, 113, 50,
-			125, 19, 255, 130, 160, 201, 228, 139, 2, 208, 26, 88, 170, 24,
-			157, 154, 162, 81, 16, 134, 237, 34, 221, 99, 227, 158, 71, 81,
-			194, 7, 252, 202, 80, 149, 225, 181, 66, 116, 17, 109, 129, 70,
-			44, 15, 70, 142, 243, 81, 31, 64, 5, 234, 57, 201, 4, 3,
-			24, 111, 235, 57, 83, 220, 131, 24, 64, 5, 234, 57, 83, 68,
-			192, 24, 64, 5, 234, 57, 243, 112, 94, 130, 89, 0, 143, 76,
-			73, 176, 23, 192, 123, 139, 24, 206, 100, 192, 236, 177, 51, 63,
-			100, 234, 255, 204, 228, 225, 76, 6, 240, 120, 248, 135, 204, 94,
-			152, 27, 25, 0, 97, 124, 62, 104, 154, 195, 249, 97, 101, 22,
-			104, 96, 92, 79, 60, 18, 29, 16, 39, 191, 31, 52, 205, 84,
-			130, 14, 9, 131, 67, 232, 145, 48, 192, 79, 126, 63, 108, 138,
-			243, 218, 1, 113, 128, 251, 97, 211, 204, 38, 9, 58, 36, 244,
-			15, 168, 18, 186, 109, 126, 196, 20, 1, 108, 6, 196, 57, 236,
-			71, 76, 113, 14, 59, 32, 206, 97, 63, 98, 142, 30, 34, 127,
-			200, 185, 72, 179, 205, 231, 77, 253, 72, 254, 223, 234, 98, 222,
-			225, 205, 97, 49, 92, 226, 136, 156, 63, 243, 199, 55, 65, 74,
-			120, 54, 67, 183, 129, 79, 202, 72, 245, 11, 93, 51, 81, 148,
-			80, 135, 111, 72, 212, 222, 101, 31, 107, 241, 241, 134, 141, 68,
-			137, 174, 57, 98, 165, 119, 124, 133, 29, 84, 253, 189, 208, 149,
-			126, 125, 24, 227, 79, 198, 18, 73, 156, 120, 24, 103, 160, 98,
-			250, 50, 152, 19, 134, 78, 27, 45, 16, 60, 110, 21, 174, 1,
-			202, 185, 201, 235, 142, 54, 180, 237, 5, 219, 37, 186, 36, 239,
-			104, 23, 185, 120, 150, 39, 76, 32, 153, 99, 30, 9, 28, 253,
-			159, 240, 208, 138, 171, 90, 92, 243, 20, 167, 101, 156, 104, 169,
-			152, 56, 156, 99, 64, 235, 124, 62, 97, 62, 208, 58, 159, 151,
-			210, 122, 0, 7, 243, 121, 51, 39, 153, 15, 180, 206, 231, 129,
-			249, 126, 200, 194, 129, 209, 109, 243, 19, 166, 126, 46, 255, 78,
-			11, 7, 134, 191, 186, 166, 156, 97, 132, 93, 132, 37, 222, 124,
-			235, 168, 142, 112, 10, 41, 235, 145, 240, 178, 14, 68, 252, 0,
-			113, 15, 60, 189, 42, 193, 38, 87, 189, 195, 129, 157, 135, 114,
-			23, 206, 211, 109, 156, 89, 49, 171, 135, 142, 135, 180, 175, 185,
-			55, 101, 160, 17, 66, 39, 92, 63, 190, 112, 190, 72, 91, 226,
-			111, 36, 254, 98, 38, 76, 16, 191, 38, 75, 148, 206, 167, 130,
-			195, 201, 142, 168, 167, 211, 8, 143, 245, 35, 248, 3, 7, 44,
-			221, 31, 238, 220, 34, 183, 24, 72, 245, 136, 122, 1, 191, 107,
-			15, 106, 170, 139, 142, 43, 220, 199, 6, 248, 117, 199, 105, 130,
-			24, 217, 227, 55, 249, 61, 80, 55, 146, 16, 11, 34, 118, 129,
-			48, 194, 210, 154, 23, 112, 45, 151, 187, 98, 39, 213, 150, 8,
-			93, 71, 129, 214, 134, 175, 234, 93, 55, 165, 124, 139, 78, 224,
-			46, 176, 99, 219, 196, 170, 233, 198, 11, 175, 127, 162, 148, 132,
-			212, 183, 2, 119, 218, 44, 200, 104, 50, 232, 11, 192, 118, 156,
-			93, 55, 8, 83, 55, 18, 80, 112, 240, 177, 34, 84, 61, 63,
-			135, 151, 38, 59, 244, 31, 21, 135, 58, 230, 123, 255, 14, 153,
-			171, 110, 207, 6, 98, 176, 211, 135, 212, 220, 119, 141, 59, 164,
-			58, 85, 52, 177, 115, 71, 226, 122, 16, 212, 75, 13, 39, 222,
-			41, 45, 1, 31, 40, 53, 100, 128, 199, 21, 74, 24, 27, 227,
-			10, 153, 194, 226, 54, 192, 227, 10, 153, 246, 152, 4, 13, 0,
-			143, 220, 45, 193, 44, 128, 247, 204, 74, 176, 23, 192, 137, 179,
-			66, 170, 106, 118, 230, 23, 76, 253, 95, 41, 169, 10, 147, 228,
-			23, 204, 222, 65, 12, 71, 53, 192, 131, 68, 253, 162, 105, 218,
-			249, 187, 132, 189, 50, 117, 168, 204, 111, 17, 113, 65, 199, 35,
-			68, 253, 98, 34, 60, 121, 132, 168, 95, 52, 115, 35, 100, 82,
-			160, 210, 108, 243, 151, 0, 213, 97, 68, 181, 143, 231, 162, 20,
-			50, 141, 231, 77, 144, 129, 224, 253, 165, 52, 50, 221, 54, 255,
-			229, 129, 200, 18, 223, 92, 89, 22, 42, 254, 151, 105, 100, 188,
-			112, 110, 132, 252, 183, 1, 156, 250, 134, 109, 126, 198, 212, 207,
-			228, 255, 100, 64, 186, 68, 164, 174, 29, 108, 171, 45, 136, 231,
-			60, 227, 122, 237, 135, 41, 93, 118, 158, 105, 43, 215, 81, 121,
-			128, 39, 52, 144, 41, 32, 139, 12, 130, 202, 29, 234, 97, 199,
-			45, 162, 70, 236, 73, 95, 54, 238, 96, 152, 218, 105, 225, 77,
-			24, 92, 233, 121, 109, 69, 46, 65, 92, 140, 175, 35, 242, 141,
-			71, 73, 220, 25, 20, 138, 226, 34, 166, 104, 223, 118, 43, 150,
-			138, 48, 87, 228, 184, 243, 6, 151, 203, 220, 56, 38, 248, 190,
-			3, 171, 144, 175, 149, 152, 251, 146, 39, 248, 176, 177, 34, 168,
-			18, 198, 63, 226, 6, 8, 105, 237, 18, 222, 197, 208, 125, 7,
-			111, 205, 64, 111, 187, 246, 8, 78, 200, 104, 45, 100, 140, 27,
-			183, 113, 103, 163, 162, 40, 160, 70, 68, 40, 115, 234, 44, 132,
-			77, 181, 7, 84, 149, 151, 90, 58, 99, 124, 168, 11, 44, 74,
-			223, 147, 183, 77, 148, 95, 29, 81, 107, 71, 135, 77, 29, 54,
-			74, 81, 171, 94, 103, 145, 12, 220, 209, 97, 0, 114, 240, 245,
-			14, 80, 157, 92, 198, 195, 221, 56, 184, 151, 2, 60, 29, 237,
-			233, 136, 243, 130, 49, 48, 131, 80, 88, 33, 83, 83, 123, 59,
-			8, 110, 220, 96, 140, 71, 150, 10, 118, 89, 184, 3, 99, 17,
-			183, 155, 98, 247, 44, 34, 125, 119, 56, 143, 185, 251, 4, 136,
-			244, 188, 164, 14, 247, 237, 139, 83, 177, 249, 253, 152, 133, 53,
-			113, 60, 226, 248, 29, 199, 2, 65, 21, 54, 180, 142, 231, 73,
-			151, 83, 12, 98, 130, 118, 75, 26, 178, 134, 147, 186, 69, 88,
-			162, 244, 74, 43, 132, 97, 0, 189, 1, 88, 45, 100, 78, 117,
-			42, 114, 106, 76, 5, 46, 39, 169, 202, 220, 116, 123, 82, 207,
-			17, 240, 6, 95, 66, 55, 151, 88, 58, 192, 201, 202, 0, 27,
-			10, 99, 232, 59, 183, 46, 169, 11, 7, 188, 66, 100, 231, 74,
-			43, 228, 55, 177, 112, 205, 241, 120, 48, 143, 78, 132, 192, 244,
-			174, 223, 98, 132, 223, 216, 192, 104, 22, 148, 201, 184, 188, 130,
-			45, 75, 164, 227, 150, 124, 247, 110, 117, 255, 222, 26, 31, 191,
-			86, 193, 49, 164, 86, 197, 47, 222, 16, 92, 202, 158, 105, 119,
-			94, 109, 160, 27, 136, 57, 42, 98, 151, 128, 45, 150, 162, 37,
-			62, 111, 221, 103, 88, 117, 98, 82, 42, 90, 29, 179, 155, 96,
-			221, 33, 139, 91, 161, 96, 72, 12, 211, 33, 246, 201, 157, 83,
-			113, 199, 137, 40, 190, 174, 132, 83, 160, 163, 101, 41, 43, 185,
-			207, 160, 195, 78, 216, 86, 110, 252, 129, 244, 43, 59, 0, 39,
-			238, 28, 196, 53, 172, 128, 187, 212, 241, 249, 237, 250, 60, 84,
-			139, 88, 166, 240, 241, 44, 244, 47, 7, 194, 20, 65, 206, 51,
-			71, 105, 132, 205, 86, 216, 12, 184, 179, 3, 16, 134, 200, 153,
-			1, 234, 134, 223, 189, 54, 10, 251, 36, 146, 59, 122, 73, 122,
-			19, 101, 50, 86, 113, 79, 98, 17, 169, 223, 141, 211, 20, 151,
-			150, 251, 148, 243, 83, 106, 108, 164, 180, 236, 108, 6, 162, 22,
-			239, 106, 157, 230, 94, 248, 167, 69, 51, 92, 124, 162, 173, 163,
-			41, 104, 217, 59, 141, 254, 193, 167, 201, 75, 101, 235, 148, 77,
-			82, 158, 241, 216, 47, 29, 6, 180, 29, 208, 133, 183, 25, 243,
-			5, 197, 213, 122, 110, 152, 184, 226, 40, 208, 2, 80, 41, 170,
-			134, 6, 160, 8, 166, 55, 128, 102, 133, 207, 152, 119, 201, 229,
-			221, 200, 2, 120, 248, 180, 4, 123, 1, 60, 57, 73, 254, 66,
-			195, 181, 204, 180, 205, 207, 154, 250, 217, 252, 31, 164, 189, 126,
-			64, 102, 189, 98, 198, 81, 105, 150, 142, 190, 49, 211, 168, 136,
-			158, 119, 91, 158, 62, 34, 238, 41, 239, 185, 201, 187, 170, 64,
-			11, 64, 69, 68, 83, 3, 48, 39, 247, 150, 166, 1, 160, 48,
-			140, 14, 160, 31, 243, 103, 77, 97, 24, 29, 64, 63, 230, 207,
-			154, 167, 167, 201, 195, 72, 67, 203, 54, 95, 48, 245, 98, 254,
-			236, 203, 127, 90, 132, 227, 179, 76, 196, 160, 64, 68, 168, 154,
-			102, 105, 0, 170, 241, 181, 12, 0, 239, 58, 44, 193, 44, 128,
-			249, 51, 18, 236, 5, 240, 212, 105, 180, 218, 12, 128, 230, 247,
-			151, 223, 68, 171, 205, 0, 250, 146, 254, 101, 210, 212, 12, 86,
-			208, 47, 233, 148, 209, 0, 20, 86, 155, 1, 244, 37, 253, 75,
-			105, 181, 25, 176, 108, 243, 175, 94, 33, 171, 205, 128, 213, 3,
-			200, 133, 213, 102, 0, 173, 54, 127, 37, 173, 54, 3, 104, 181,
-			249, 43, 243, 142, 49, 114, 55, 180, 163, 207, 54, 191, 96, 234,
-			131, 133, 97, 241, 100, 71, 149, 62, 141, 74, 24, 199, 212, 215,
-			3, 159, 133, 21, 108, 0, 45, 108, 105, 80, 23, 224, 0, 209,
-			205, 65, 59, 243, 69, 179, 231, 111, 132, 253, 103, 80, 179, 205,
-			47, 154, 217, 67, 72, 249, 65, 80, 132, 95, 252, 38, 82, 126,
-			16, 77, 37, 47, 74, 202, 15, 162, 169, 228, 69, 73, 249, 65,
-			84, 167, 95, 148, 148, 31, 68, 83, 201, 139, 146, 242, 131, 150,
-			109, 126, 249, 21, 162, 252, 32, 80, 254, 203, 146, 242, 131, 72,
-			249, 47, 75, 202, 15, 34, 229, 191, 44, 237, 101, 67, 118, 230,
-			127, 152, 61, 223, 107, 113, 122, 13, 105, 182, 249, 63, 204, 236,
-			40, 121, 138, 152, 230, 16, 208, 235, 107, 166, 78, 243, 107, 252,
-			140, 184, 211, 209, 65, 30, 25, 227, 75, 216, 180, 193, 223, 183,
-			74, 108, 99, 252, 141, 1, 124, 11, 41, 78, 110, 81, 16, 25,
-			198, 17, 219, 50, 132, 4, 252, 154, 36, 224, 16, 30, 214, 125,
-			77, 206, 178, 33, 36, 224, 215, 204, 220, 17, 9, 26, 0, 30,
-			61, 70, 94, 208, 176, 121, 154, 109, 190, 205, 210, 207, 230, 63,
-			157, 200, 73, 17, 124, 229, 21, 60, 67, 226, 87, 158, 94, 89,
-			41, 137, 71, 169, 138, 70, 154, 137, 253, 84, 160, 5, 160, 162,
-			145, 134, 84, 16, 66, 114, 8, 77, 34, 111, 179, 132, 144, 28,
-			194, 131, 184, 183, 89, 66, 72, 14, 225, 65, 220, 219, 172, 211,
-			211, 104, 140, 30, 234, 179, 205, 239, 180, 94, 194, 24, 61, 4,
-			243, 238, 59, 45, 49, 209, 134, 112, 222, 165, 65, 93, 128, 171,
-			56, 26, 186, 109, 190, 221, 250, 230, 77, 174, 33, 220, 49, 191,
-			61, 233, 55, 200, 205, 183, 91, 253, 178, 103, 48, 250, 111, 183,
-			196, 228, 26, 194, 29, 243, 219, 45, 49, 185, 134, 44, 219, 124,
-			167, 245, 202, 76, 174, 33, 152, 92, 239, 180, 196, 228, 26, 194,
-			201, 245, 78, 75, 76, 174, 33, 156, 92, 239, 180, 196, 228, 26,
-			182, 51, 239, 178, 122, 126, 72, 76, 174, 97, 205, 54, 223, 101,
-			101, 199, 200, 223, 0, 251, 14, 195, 236, 122, 15, 176, 239, 95,
-			116, 177, 47, 223, 37, 189, 226, 76, 204, 235, 121, 165, 207, 66,
-			83, 81, 146, 196, 192, 14, 227, 164, 127, 143, 28, 216, 97, 156,
-			244, 239, 145, 12, 61, 140, 147, 254, 61, 146, 161, 135, 113, 210,
-			191, 71, 50, 244, 48, 26, 152, 223, 35, 25, 122, 24, 13, 204,
-			239, 1, 134, 94, 69, 146, 106, 182, 249, 254, 111, 34, 15, 14,
-			243, 152, 64, 73, 83, 181, 12, 128, 253, 178, 49, 24, 19, 72,
-			242, 224, 48, 143, 9, 36, 121, 112, 216, 178, 205, 231, 94, 33,
-			30, 28, 6, 30, 124, 78, 242, 224, 48, 242, 224, 115, 146, 7,
-			135, 145, 7, 159, 147, 60, 152, 179, 51, 31, 180, 122, 126, 90,
-			240, 96, 78, 179, 205, 15, 90, 217, 59, 201, 31, 24, 196, 52,
-			115, 192, 131, 207, 91, 250, 108, 250, 36, 62, 185, 201, 249, 10,
-			50, 160, 168, 228, 149, 230, 62, 121, 15, 162, 68, 102, 255, 66,
-			195, 157, 229, 28, 229, 97, 119, 84, 24, 133, 179, 42, 126, 194,
-			185, 89, 25, 178, 39, 137, 161, 174, 222, 146, 86, 170, 227, 218,
-			245, 5, 66, 41, 173, 133, 78, 131, 237, 5, 225, 141, 18, 165,
-			143, 49, 234, 52, 3, 47, 168, 3, 39, 225, 147, 26, 129, 19,
-			86, 197, 174, 43, 74, 189, 253, 16, 208, 160, 21, 70, 204, 219,
-			101, 145, 56, 242, 165, 116, 143, 241, 107, 45, 50, 246, 33, 55,
-			92, 224, 5, 13, 140, 106, 186, 141, 215, 65, 32, 91, 149, 85,
-			92, 97, 122, 144, 7, 61, 242, 241, 81, 64, 36, 222, 31, 21,
-			172, 155, 195, 89, 246, 188, 100, 221, 28, 206, 178, 231, 229, 44,
-			203, 225, 44, 123, 94, 206, 178, 28, 206, 178, 231, 45, 225, 70,
-			159, 195, 89, 246, 188, 37, 220, 232, 115, 56, 203, 158, 183, 206,
-			204, 224, 44, 203, 97, 20, 160, 111, 226, 44, 203, 225, 44, 251,
-			88, 210, 84, 152, 101, 31, 147, 179, 44, 135, 179, 236, 99, 114,
-			150, 229, 112, 150, 125, 76, 206, 178, 156, 101, 155, 63, 245, 10,
-			205, 178, 28, 204, 178, 159, 146, 179, 44, 135, 179, 236, 167, 228,
-			44, 203, 225, 44, 251, 41, 57, 203, 70, 236, 204, 207, 88, 61,
-			191, 45, 102, 217, 136, 102, 155, 63, 99, 101, 239, 32, 159, 134,
-			89, 54, 2, 179, 236, 147, 48, 203, 62, 147, 246, 119, 65, 251,
-			206, 43, 236, 238, 2, 117, 188, 242, 222, 46, 226, 206, 228, 255,
-			215, 166, 216, 8, 78, 177, 79, 74, 190, 29, 193, 41, 246, 73,
-			57, 197, 70, 112, 138, 125, 82, 78, 177, 17, 156, 98, 159, 148,
-			83, 108, 4, 167, 216, 39, 229, 20, 27, 193, 41, 246, 73, 152,
-			98, 127, 13, 202, 193, 136, 217, 99, 103, 126, 197, 210, 127, 221,
-			50, 58, 157, 164, 132, 81, 176, 202, 166, 248, 165, 225, 41, 180,
-			204, 78, 4, 33, 55, 213, 185, 62, 125, 100, 99, 227, 58, 204,
-			73, 207, 241, 43, 108, 146, 15, 126, 149, 53, 154, 65, 204, 124,
-			24, 214, 32, 164, 62, 183, 167, 60, 204, 243, 226, 27, 182, 120,
-			11, 173, 219, 244, 146, 152, 219, 174, 150, 55, 128, 57, 182, 249,
-			125, 95, 167, 198, 223, 148, 135, 97, 231, 238, 148, 215, 55, 83,
-			223, 147, 234, 148, 245, 79, 154, 180, 187, 206, 104, 174, 175, 174,
-			111, 72, 98, 226, 97, 240, 175, 88, 189, 119, 225, 185, 236, 8,
-			63, 12, 254, 85, 203, 188, 27, 77, 254, 35, 226, 236, 247, 87,
-			45, 241, 216, 196, 136, 56, 251, 253, 85, 43, 127, 132, 156, 20,
-			37, 52, 219, 252, 215, 150, 57, 86, 56, 196, 61, 69, 88, 148,
-			106, 11, 81, 197, 52, 158, 109, 52, 73, 208, 33, 225, 206, 187,
-			200, 253, 2, 143, 110, 155, 191, 102, 153, 163, 133, 241, 52, 233,
-			120, 56, 54, 25, 221, 10, 3, 20, 240, 49, 136, 18, 212, 208,
-			130, 95, 179, 196, 107, 41, 35, 226, 232, 248, 215, 44, 124, 148,
-			7, 4, 129, 102, 103, 126, 195, 210, 255, 141, 117, 175, 24, 117,
-			16, 124, 191, 145, 48, 16, 8, 190, 223, 176, 68, 160, 192, 17,
-			108, 232, 111, 88, 119, 79, 73, 208, 0, 80, 220, 197, 31, 65,
-			213, 254, 223, 88, 153, 83, 18, 236, 5, 112, 228, 36, 202, 232,
-			17, 168, 247, 83, 223, 68, 25, 61, 130, 218, 248, 167, 146, 166,
-			130, 54, 254, 41, 41, 163, 71, 176, 227, 159, 146, 50, 122, 4,
-			181, 241, 79, 73, 25, 61, 98, 217, 230, 111, 189, 66, 50, 122,
-			4, 100, 244, 111, 73, 25, 61, 130, 50, 250, 183, 164, 140, 30,
-			65, 25, 253, 91, 32, 163, 223, 105, 16, 221, 180, 237, 204, 167,
-			173, 158, 255, 98, 105, 249, 191, 213, 233, 188, 50, 241, 169, 131,
-			84, 144, 10, 142, 218, 225, 38, 100, 83, 198, 124, 69, 37, 113,
-			3, 140, 251, 215, 58, 205, 38, 115, 248, 245, 86, 217, 11, 17,
-			156, 138, 135, 73, 150, 183, 98, 149, 139, 210, 220, 220, 117, 17,
-			226, 203, 115, 34, 126, 7, 66, 197, 0, 12, 2, 79, 6, 13,
-			140, 132, 108, 195, 51, 28, 140, 138, 5, 13, 92, 76, 69, 248,
-			198, 187, 90, 81, 169, 227, 214, 104, 87, 19, 92, 191, 35, 38,
-			56, 47, 33, 222, 238, 230, 246, 108, 222, 190, 4, 237, 220, 156,
-			64, 49, 49, 201, 197, 69, 51, 12, 120, 120, 251, 174, 108, 11,
-			65, 179, 189, 17, 76, 76, 78, 138, 131, 44, 140, 202, 128, 147,
-			99, 51, 29, 85, 76, 133, 30, 147, 113, 203, 120, 196, 29, 91,
-			179, 205, 79, 91, 217, 35, 228, 223, 234, 196, 52, 109, 163, 199,
-			206, 124, 198, 210, 255, 179, 101, 228, 127, 137, 187, 85, 164, 47,
-			85, 119, 132, 41, 75, 14, 142, 48, 18, 157, 8, 229, 160, 70,
-			145, 135, 203, 172, 139, 11, 221, 132, 58, 180, 26, 196, 83, 50,
-			180, 73, 85, 250, 210, 186, 209, 86, 18, 195, 65, 68, 151, 167,
-			110, 173, 150, 42, 157, 70, 233, 167, 98, 149, 209, 137, 42, 243,
-			131, 88, 70, 105, 224, 207, 129, 192, 80, 117, 240, 64, 212, 100,
-			149, 168, 219, 5, 110, 178, 68, 104, 185, 84, 47, 21, 223, 76,
-			223, 32, 222, 161, 70, 7, 137, 55, 22, 233, 27, 10, 219, 78,
-			88, 218, 118, 158, 41, 20, 177, 49, 152, 244, 166, 214, 77, 149,
-			133, 62, 155, 106, 17, 225, 239, 87, 79, 136, 50, 147, 37, 200,
-			41, 38, 171, 141, 129, 124, 63, 99, 17, 254, 128, 146, 205, 3,
-			249, 254, 177, 101, 22, 80, 46, 217, 34, 116, 239, 31, 91, 34,
-			176, 174, 45, 66, 247, 254, 177, 53, 56, 154, 36, 104, 144, 112,
-			232, 158, 36, 193, 128, 4, 122, 92, 225, 212, 108, 243, 63, 89,
-			230, 9, 149, 1, 132, 217, 127, 74, 227, 212, 44, 72, 24, 28,
-			73, 18, 176, 136, 125, 52, 73, 48, 32, 225, 120, 1, 231, 178,
-			13, 173, 252, 19, 75, 231, 225, 37, 109, 108, 227, 159, 72, 137,
-			99, 163, 113, 237, 79, 172, 254, 81, 9, 106, 0, 30, 26, 147,
-			160, 1, 224, 145, 187, 249, 75, 60, 54, 52, 238, 115, 150, 62,
-			158, 255, 62, 45, 245, 96, 196, 75, 112, 83, 17, 70, 106, 111,
-			199, 137, 145, 139, 209, 83, 1, 53, 176, 224, 6, 131, 9, 31,
-			18, 88, 10, 120, 164, 71, 140, 183, 231, 68, 242, 125, 119, 117,
-			136, 82, 22, 23, 4, 196, 219, 60, 124, 242, 10, 15, 70, 241,
-			212, 143, 236, 11, 144, 234, 115, 73, 215, 128, 80, 159, 179, 132,
-			51, 128, 141, 100, 250, 156, 37, 110, 161, 217, 72, 164, 207, 89,
-			167, 238, 21, 68, 210, 109, 243, 5, 75, 159, 20, 31, 65, 44,
-			191, 144, 96, 66, 187, 119, 130, 9, 200, 240, 130, 101, 159, 148,
-			160, 1, 224, 248, 132, 192, 100, 216, 230, 159, 89, 34, 104, 173,
-			141, 7, 26, 127, 150, 96, 50, 44, 0, 133, 219, 151, 141, 7,
-			26, 127, 102, 141, 156, 144, 32, 150, 189, 119, 92, 96, 50, 109,
-			243, 207, 45, 93, 126, 52, 57, 40, 49, 153, 22, 128, 170, 77,
-			166, 6, 160, 184, 92, 100, 163, 85, 255, 207, 19, 22, 176, 108,
-			243, 47, 44, 93, 118, 221, 50, 17, 148, 152, 44, 252, 170, 218,
-			100, 105, 0, 142, 72, 126, 177, 12, 0, 69, 56, 82, 27, 205,
-			236, 150, 136, 64, 107, 115, 27, 121, 130, 41, 99, 1, 168, 218,
-			132, 54, 114, 203, 62, 46, 65, 3, 192, 147, 167, 200, 31, 106,
-			68, 55, 71, 237, 204, 23, 173, 158, 47, 101, 180, 252, 211, 180,
-			236, 87, 156, 102, 36, 194, 72, 222, 222, 203, 212, 60, 136, 82,
-			24, 52, 164, 63, 14, 233, 122, 209, 255, 58, 15, 150, 225, 164,
-			98, 105, 148, 200, 236, 83, 223, 204, 192, 157, 157, 239, 100, 99,
-			195, 185, 20, 30, 213, 108, 243, 139, 86, 246, 78, 242, 237, 35,
-			196, 52, 71, 49, 148, 112, 70, 63, 158, 127, 49, 71, 231, 233,
-			114, 32, 162, 21, 186, 73, 144, 83, 135, 54, 93, 198, 207, 174,
-			59, 49, 38, 177, 178, 176, 195, 216, 87, 66, 43, 65, 24, 178,
-			168, 25, 248, 85, 17, 49, 43, 117, 246, 151, 196, 14, 85, 87,
-			27, 58, 31, 213, 230, 225, 242, 196, 163, 7, 201, 43, 12, 113,
-			64, 151, 22, 203, 248, 34, 79, 85, 188, 108, 195, 194, 168, 216,
-			117, 19, 43, 185, 155, 43, 2, 84, 185, 13, 215, 115, 66, 162,
-			158, 227, 22, 79, 42, 97, 220, 186, 34, 141, 156, 54, 108, 0,
-			248, 181, 26, 222, 5, 229, 157, 126, 203, 43, 62, 64, 86, 17,
-			72, 40, 8, 148, 171, 249, 179, 132, 46, 51, 188, 141, 20, 4,
-			55, 168, 19, 243, 72, 167, 137, 95, 104, 210, 111, 196, 254, 82,
-			168, 158, 20, 126, 235, 79, 62, 169, 254, 192, 255, 158, 124, 18,
-			62, 58, 226, 227, 118, 5, 255, 84, 25, 165, 53, 74, 235, 59,
-			46, 129, 237, 146, 138, 217, 169, 2, 168, 80, 79, 140, 39, 119,
-			225, 143, 154, 142, 79, 41, 6, 75, 161, 157, 255, 210, 171, 12,
-			165, 111, 112, 138, 238, 36, 165, 111, 160, 231, 139, 116, 166, 72,
-			103, 139, 116, 134, 190, 17, 243, 129, 96, 221, 219, 9, 188, 253,
-			29, 43, 137, 130, 219, 93, 5, 139, 244, 60, 148, 133, 130, 158,
-			179, 205, 60, 58, 33, 123, 63, 201, 139, 84, 138, 213, 125, 69,
-			238, 147, 69, 248, 99, 94, 156, 76, 34, 63, 43, 214, 246, 229,
-			63, 43, 243, 243, 8, 143, 181, 32, 16, 153, 235, 197, 157, 125,
-			153, 207, 169, 204, 60, 56, 226, 196, 217, 73, 25, 170, 31, 200,
-			52, 69, 231, 21, 217, 132, 15, 128, 10, 197, 172, 220, 58, 133,
-			67, 73, 28, 49, 175, 38, 30, 27, 19, 231, 229, 24, 38, 140,
-			166, 153, 158, 63, 196, 36, 66, 131, 186, 241, 100, 234, 86, 79,
-			75, 58, 236, 240, 240, 86, 232, 226, 30, 212, 164, 199, 102, 196,
-			163, 230, 82, 10, 155, 96, 238, 138, 194, 252, 138, 23, 68, 157,
-			97, 243, 196, 85, 31, 174, 193, 148, 104, 39, 147, 163, 79, 91,
-			236, 134, 73, 108, 73, 244, 230, 172, 220, 160, 19, 205, 32, 138,
-			220, 109, 175, 157, 126, 17, 74, 185, 122, 36, 154, 79, 42, 222,
-			47, 87, 251, 48, 222, 35, 191, 139, 38, 92, 38, 20, 185, 246,
-			118, 96, 235, 136, 252, 133, 84, 83, 7, 65, 133, 68, 195, 47,
-			40, 42, 226, 142, 191, 227, 109, 126, 199, 231, 212, 42, 193, 48,
-			92, 147, 109, 81, 76, 156, 236, 197, 212, 53, 41, 168, 75, 18,
-			148, 251, 52, 70, 210, 169, 81, 6, 21, 84, 244, 75, 135, 232,
-			199, 151, 164, 154, 33, 238, 80, 161, 98, 30, 218, 86, 117, 31,
-			195, 23, 137, 184, 222, 180, 17, 68, 104, 84, 8, 182, 119, 221,
-			160, 21, 73, 226, 202, 183, 216, 120, 223, 170, 5, 65, 87, 167,
-			238, 184, 190, 10, 16, 42, 99, 202, 166, 195, 161, 166, 135, 161,
-			243, 33, 129, 168, 18, 52, 89, 145, 187, 237, 162, 147, 130, 10,
-			184, 122, 64, 175, 59, 89, 117, 60, 226, 211, 91, 58, 253, 240,
-			107, 23, 24, 229, 83, 112, 149, 27, 71, 66, 113, 21, 121, 57,
-			175, 240, 22, 9, 118, 73, 245, 135, 177, 2, 119, 87, 234, 224,
-			133, 132, 128, 114, 15, 196, 157, 179, 0, 203, 54, 171, 187, 62,
-			178, 145, 208, 186, 186, 41, 195, 111, 97, 70, 59, 78, 200, 183,
-			22, 93, 1, 123, 165, 19, 15, 15, 52, 138, 101, 176, 147, 143,
-			114, 95, 19, 238, 51, 227, 28, 212, 227, 116, 55, 163, 160, 33,
-			60, 107, 186, 115, 2, 102, 181, 177, 107, 48, 71, 189, 174, 193,
-			81, 192, 166, 137, 249, 85, 231, 128, 73, 68, 11, 248, 170, 107,
-			65, 236, 96, 81, 72, 98, 228, 73, 135, 75, 40, 232, 89, 42,
-			254, 178, 154, 153, 29, 111, 66, 36, 161, 16, 19, 142, 6, 68,
-			201, 130, 233, 242, 39, 173, 100, 56, 6, 225, 79, 12, 72, 69,
-			28, 49, 55, 196, 29, 101, 224, 39, 206, 132, 234, 65, 9, 58,
-			69, 23, 146, 8, 65, 252, 205, 18, 188, 1, 32, 244, 222, 212,
-			52, 18, 26, 106, 51, 12, 182, 157, 109, 238, 60, 88, 101, 145,
-			91, 247, 209, 14, 134, 17, 123, 209, 76, 72, 227, 157, 64, 6,
-			165, 72, 89, 14, 120, 220, 138, 216, 241, 171, 69, 80, 138, 209,
-			137, 157, 187, 199, 6, 181, 84, 45, 21, 30, 135, 136, 242, 39,
-			37, 42, 65, 88, 77, 197, 72, 196, 123, 8, 66, 57, 30, 229,
-			15, 24, 100, 116, 5, 102, 0, 20, 122, 255, 40, 127, 192, 32,
-			115, 232, 110, 9, 26, 0, 30, 163, 104, 96, 25, 133, 93, 228,
-			135, 51, 250, 23, 51, 220, 23, 118, 20, 55, 66, 31, 206, 16,
-			155, 188, 173, 151, 100, 0, 6, 13, 231, 159, 103, 204, 98, 254,
-			75, 153, 116, 236, 118, 17, 93, 218, 9, 99, 201, 174, 183, 210,
-			209, 228, 125, 91, 241, 138, 11, 81, 125, 196, 192, 210, 41, 23,
-			123, 97, 59, 77, 89, 66, 197, 163, 74, 92, 168, 137, 240, 89,
-			232, 177, 229, 112, 41, 9, 74, 34, 191, 221, 26, 6, 65, 124,
-			96, 11, 100, 132, 19, 144, 72, 34, 16, 91, 220, 17, 134, 93,
-			78, 227, 212, 36, 118, 35, 68, 143, 139, 62, 46, 126, 231, 112,
-			241, 187, 31, 87, 74, 146, 200, 230, 57, 46, 130, 61, 86, 146,
-			55, 151, 97, 28, 39, 206, 77, 82, 58, 61, 141, 229, 228, 69,
-			186, 18, 246, 106, 226, 254, 73, 165, 50, 76, 79, 35, 74, 149,
-			1, 150, 222, 137, 201, 255, 151, 189, 175, 139, 141, 35, 57, 19,
-			155, 234, 234, 25, 206, 20, 37, 145, 42, 141, 36, 170, 165, 149,
-			74, 212, 74, 34, 169, 225, 140, 68, 173, 156, 21, 181, 178, 61,
-			34, 41, 113, 214, 252, 209, 14, 73, 237, 90, 235, 53, 221, 51,
-			211, 34, 123, 57, 211, 61, 219, 221, 35, 138, 187, 56, 39, 155,
-			96, 145, 100, 115, 64, 114, 113, 46, 9, 114, 135, 4, 62, 39,
-			65, 16, 4, 121, 136, 115, 139, 248, 225, 14, 137, 99, 35, 176,
-			31, 238, 225, 112, 79, 14, 46, 231, 188, 38, 192, 57, 111, 7,
-			216, 201, 34, 168, 175, 126, 186, 102, 200, 213, 207, 94, 114, 65,
-			128, 125, 25, 204, 87, 221, 93, 245, 85, 213, 87, 85, 223, 95,
-			125, 159, 193, 83, 84, 42, 236, 90, 234, 253, 166, 214, 239, 1,
-			93, 236, 107, 92, 220, 223, 54, 135, 240, 21, 192, 82, 31, 189,
-			131, 227, 211, 247, 241, 109, 246, 202, 45, 2, 171, 101, 176, 13,
-			81, 229, 190, 202, 103, 250, 43, 63, 40, 63, 3, 83, 183, 255,
-			102, 100, 213, 7, 38, 113, 0, 206, 99, 95, 245, 215, 14, 228,
-			247, 224, 93, 121, 155, 48, 221, 20, 196, 125, 104, 32, 139, 52,
-			139, 163, 62, 90, 195, 52, 107, 151, 84, 103, 212, 30, 113, 246,
-			85, 58, 207, 8, 34, 106, 187, 113, 162, 136, 113, 223, 228, 243,
-			153, 215, 164, 49, 112, 62, 247, 243, 116, 233, 38, 63, 161, 131,
-			242, 233, 205, 157, 168, 229, 34, 216, 57, 181, 95, 193, 69, 11,
-			101, 130, 235, 248, 205, 176, 29, 6, 147, 210, 157, 251, 152, 84,
-			63, 124, 146, 147, 170, 130, 99, 82, 253, 240, 73, 78, 230, 245,
-			57, 38, 213, 15, 159, 228, 142, 157, 76, 11, 48, 47, 112, 78,
-			167, 5, 121, 94, 112, 230, 10, 25, 37, 121, 89, 96, 241, 146,
-			151, 166, 200, 31, 90, 114, 177, 35, 106, 255, 30, 95, 236, 255,
-			193, 82, 87, 15, 183, 33, 136, 191, 16, 212, 147, 237, 200, 243,
-			68, 216, 245, 94, 164, 249, 172, 89, 25, 49, 185, 237, 7, 92,
-			46, 128, 255, 205, 176, 221, 235, 4, 37, 194, 248, 81, 205, 31,
-			164, 140, 107, 201, 112, 220, 116, 227, 184, 215, 241, 90, 226, 88,
-			118, 99, 163, 162, 201, 18, 124, 42, 234, 209, 25, 2, 220, 72,
-			223, 30, 242, 3, 51, 160, 176, 24, 123, 184, 180, 35, 239, 3,
-			53, 247, 202, 204, 112, 141, 229, 117, 10, 250, 19, 85, 106, 3,
-			8, 175, 242, 125, 47, 10, 167, 133, 90, 159, 115, 30, 218, 117,
-			121, 47, 236, 137, 99, 66, 6, 39, 102, 110, 171, 69, 216, 53,
-			184, 133, 196, 55, 46, 105, 7, 105, 249, 113, 183, 237, 238, 249,
-			42, 165, 98, 79, 220, 166, 84, 227, 142, 108, 24, 212, 116, 238,
-			80, 150, 23, 24, 115, 135, 96, 216, 141, 185, 67, 152, 23, 24,
-			115, 135, 242, 188, 192, 152, 59, 196, 231, 238, 247, 248, 220, 253,
-			179, 67, 114, 238, 44, 106, 255, 105, 206, 158, 116, 254, 222, 33,
-			29, 11, 124, 13, 36, 78, 126, 176, 213, 130, 71, 97, 191, 202,
-			79, 135, 17, 53, 40, 86, 133, 26, 118, 33, 168, 210, 30, 23,
-			71, 59, 146, 151, 6, 126, 197, 79, 141, 63, 112, 104, 67, 178,
-			67, 177, 159, 166, 84, 47, 165, 118, 248, 64, 121, 223, 170, 140,
-			22, 138, 224, 205, 163, 157, 16, 86, 133, 200, 165, 242, 8, 4,
-			37, 247, 254, 118, 195, 0, 220, 101, 189, 102, 15, 46, 132, 241,
-			215, 98, 17, 48, 146, 5, 161, 160, 41, 34, 212, 79, 3, 95,
-			137, 99, 88, 191, 47, 140, 122, 137, 212, 52, 67, 102, 74, 45,
-			9, 64, 163, 28, 161, 182, 231, 114, 126, 98, 179, 229, 9, 188,
-			55, 53, 66, 80, 193, 142, 231, 117, 249, 225, 231, 110, 69, 110,
-			119, 27, 208, 214, 47, 0, 185, 9, 4, 136, 26, 172, 137, 70,
-			47, 1, 190, 169, 25, 6, 129, 112, 39, 79, 194, 73, 161, 227,
-			22, 174, 224, 106, 53, 149, 197, 145, 168, 235, 6, 31, 127, 165,
-			137, 109, 236, 137, 152, 55, 131, 157, 9, 211, 17, 75, 15, 83,
-			193, 42, 164, 210, 130, 190, 128, 188, 10, 151, 55, 182, 211, 79,
-			228, 253, 10, 51, 22, 201, 45, 253, 176, 227, 70, 59, 124, 157,
-			8, 13, 120, 165, 50, 41, 196, 170, 24, 18, 58, 122, 192, 255,
-			75, 134, 79, 176, 169, 106, 28, 74, 106, 12, 57, 61, 36, 50,
-			190, 17, 16, 77, 192, 220, 56, 241, 34, 63, 222, 73, 211, 196,
-			234, 234, 246, 239, 152, 32, 210, 65, 20, 76, 78, 24, 97, 122,
-			143, 79, 40, 8, 162, 56, 41, 19, 182, 226, 237, 194, 152, 0,
-			229, 202, 107, 155, 233, 149, 79, 200, 56, 36, 242, 88, 168, 168,
-			251, 125, 7, 11, 68, 228, 208, 122, 4, 56, 123, 231, 100, 247,
-			77, 194, 125, 20, 134, 32, 23, 127, 198, 227, 134, 27, 149, 15,
-			168, 182, 225, 70, 226, 244, 59, 232, 44, 107, 184, 239, 179, 219,
-			236, 250, 173, 167, 86, 251, 190, 106, 181, 26, 72, 206, 156, 143,
-			196, 190, 119, 158, 82, 199, 123, 189, 39, 178, 142, 103, 213, 164,
-			222, 52, 50, 146, 246, 26, 109, 143, 151, 11, 246, 64, 86, 48,
-			47, 23, 134, 166, 19, 145, 60, 38, 218, 50, 210, 107, 114, 130,
-			87, 68, 16, 70, 44, 137, 92, 31, 46, 16, 40, 18, 145, 85,
-			137, 86, 153, 250, 222, 204, 112, 25, 137, 173, 168, 209, 118, 131,
-			29, 65, 244, 106, 53, 200, 11, 147, 130, 3, 132, 106, 184, 68,
-			81, 126, 54, 122, 233, 210, 98, 51, 229, 3, 231, 68, 188, 118,
-			155, 221, 16, 179, 50, 197, 238, 152, 132, 173, 71, 11, 88, 183,
-			41, 17, 16, 29, 186, 205, 150, 100, 95, 21, 121, 199, 242, 21,
-			69, 228, 146, 217, 40, 179, 169, 202, 83, 107, 150, 82, 4, 155,
-			98, 91, 17, 132, 7, 148, 31, 12, 16, 150, 120, 200, 110, 179,
-			47, 233, 89, 145, 254, 9, 172, 53, 208, 253, 216, 56, 142, 44,
-			27, 206, 137, 244, 56, 178, 178, 188, 64, 90, 50, 160, 0, 241,
-			130, 226, 203, 105, 1, 230, 5, 151, 39, 192, 146, 193, 11, 48,
-			181, 127, 145, 179, 167, 244, 11, 216, 134, 130, 180, 78, 156, 229,
-			5, 70, 157, 24, 241, 130, 226, 197, 180, 0, 234, 152, 152, 212,
-			117, 218, 212, 254, 31, 57, 123, 70, 191, 96, 139, 130, 180, 78,
-			59, 203, 11, 140, 58, 109, 196, 11, 138, 211, 105, 1, 230, 5,
-			87, 175, 145, 63, 69, 196, 178, 139, 52, 247, 103, 185, 204, 175,
-			15, 33, 231, 79, 144, 145, 10, 77, 236, 138, 109, 33, 102, 109,
-			251, 93, 214, 240, 146, 93, 207, 11, 6, 174, 21, 9, 121, 59,
-			137, 7, 213, 211, 42, 52, 127, 53, 141, 246, 173, 207, 87, 51,
-			136, 72, 28, 135, 77, 223, 213, 54, 47, 157, 96, 68, 183, 66,
-			76, 125, 119, 106, 44, 87, 209, 245, 129, 195, 132, 24, 232, 156,
-			196, 82, 47, 97, 249, 81, 95, 152, 8, 161, 154, 46, 34, 106,
-			255, 89, 46, 127, 138, 60, 33, 182, 93, 228, 114, 219, 47, 115,
-			214, 69, 231, 93, 86, 13, 88, 85, 187, 187, 168, 35, 40, 22,
-			162, 62, 232, 0, 56, 75, 234, 61, 129, 51, 99, 96, 16, 64,
-			37, 167, 206, 20, 162, 244, 32, 42, 124, 79, 176, 213, 151, 202,
-			87, 8, 160, 69, 96, 86, 127, 169, 228, 209, 34, 200, 163, 191,
-			204, 13, 31, 87, 32, 226, 224, 9, 166, 64, 204, 193, 11, 47,
-			131, 60, 90, 228, 242, 232, 175, 114, 214, 223, 26, 18, 242, 104,
-			17, 228, 209, 95, 229, 72, 145, 252, 109, 68, 114, 28, 230, 253,
-			250, 148, 179, 168, 127, 217, 20, 71, 193, 71, 179, 255, 228, 27,
-			52, 43, 244, 37, 29, 78, 19, 54, 129, 127, 177, 208, 162, 195,
-			177, 172, 148, 68, 238, 32, 247, 84, 86, 218, 253, 178, 208, 31,
-			1, 213, 21, 37, 111, 254, 169, 34, 212, 162, 228, 205, 63, 85,
-			252, 93, 81, 242, 230, 159, 42, 254, 174, 40, 121, 243, 79, 21,
-			127, 87, 148, 188, 249, 167, 138, 191, 43, 42, 222, 252, 83, 206,
-			223, 173, 202, 126, 35, 106, 127, 56, 100, 191, 236, 124, 101, 176,
-			223, 64, 3, 16, 15, 89, 200, 38, 42, 119, 199, 129, 253, 55,
-			240, 230, 124, 233, 135, 67, 6, 222, 156, 47, 253, 112, 72, 46,
-			176, 162, 228, 75, 63, 28, 42, 158, 75, 11, 48, 47, 24, 191,
-			64, 118, 37, 82, 22, 181, 63, 26, 178, 95, 114, 182, 6, 145,
-			2, 78, 94, 156, 222, 143, 98, 15, 38, 69, 36, 251, 151, 179,
-			211, 79, 103, 50, 6, 52, 44, 203, 212, 255, 221, 48, 32, 10,
-			59, 188, 129, 60, 223, 46, 62, 50, 145, 231, 187, 216, 71, 67,
-			198, 160, 243, 1, 251, 104, 72, 166, 82, 45, 202, 93, 236, 163,
-			161, 211, 103, 200, 127, 86, 164, 132, 169, 253, 241, 144, 125, 218,
-			249, 41, 218, 71, 75, 210, 173, 236, 121, 144, 151, 23, 40, 159,
-			130, 60, 212, 34, 226, 180, 120, 129, 130, 13, 75, 39, 223, 23,
-			186, 110, 156, 24, 98, 104, 228, 181, 189, 199, 144, 142, 122, 47,
-			241, 216, 132, 204, 116, 38, 66, 11, 40, 17, 18, 214, 236, 109,
-			168, 114, 90, 240, 75, 147, 198, 8, 241, 61, 249, 99, 115, 132,
-			248, 158, 252, 177, 57, 66, 124, 79, 254, 120, 72, 166, 130, 45,
-			202, 61, 249, 227, 161, 83, 14, 249, 231, 167, 200, 57, 17, 242,
-			187, 226, 118, 253, 10, 44, 148, 77, 117, 113, 92, 16, 18, 37,
-			50, 38, 184, 219, 245, 29, 25, 31, 188, 162, 226, 131, 87, 82,
-			23, 10, 241, 246, 212, 7, 228, 48, 8, 254, 119, 100, 37, 244,
-			44, 113, 238, 214, 22, 150, 230, 55, 239, 44, 44, 86, 31, 212,
-			86, 235, 155, 27, 43, 107, 247, 23, 230, 106, 119, 107, 11, 243,
-			163, 25, 122, 136, 228, 85, 218, 234, 81, 196, 161, 250, 194, 27,
-			27, 181, 250, 194, 252, 168, 69, 71, 200, 240, 234, 198, 250, 253,
-			141, 245, 205, 213, 149, 165, 175, 143, 98, 122, 132, 144, 218, 138,
-			134, 109, 122, 152, 20, 106, 203, 203, 27, 235, 213, 59, 75, 11,
-			163, 217, 217, 111, 145, 35, 253, 93, 160, 47, 149, 7, 35, 154,
-			3, 118, 210, 245, 98, 236, 183, 243, 12, 79, 28, 153, 57, 85,
-			78, 251, 88, 238, 67, 191, 126, 248, 145, 9, 222, 233, 146, 35,
-			205, 176, 99, 188, 126, 135, 246, 189, 15, 90, 142, 251, 232, 97,
-			85, 190, 177, 21, 182, 221, 96, 171, 28, 70, 91, 149, 45, 47,
-			0, 36, 42, 226, 145, 219, 245, 99, 24, 116, 195, 67, 241, 150,
-			241, 255, 119, 44, 251, 94, 245, 126, 237, 245, 95, 29, 39, 57,
-			106, 143, 100, 86, 16, 249, 125, 155, 160, 67, 20, 143, 100, 232,
-			204, 247, 109, 54, 23, 118, 247, 34, 127, 107, 59, 97, 51, 87,
-			175, 221, 148, 222, 130, 108, 105, 105, 142, 115, 59, 75, 126, 211,
-			11, 98, 200, 99, 215, 146, 162, 91, 181, 203, 89, 5, 245, 164,
-			196, 30, 136, 232, 38, 108, 166, 124, 149, 77, 128, 30, 90, 62,
-			26, 159, 188, 69, 116, 186, 158, 254, 8, 131, 62, 216, 60, 154,
-			94, 23, 214, 138, 136, 17, 232, 6, 77, 47, 13, 91, 40, 235,
-			40, 19, 8, 83, 8, 9, 115, 27, 112, 206, 113, 65, 180, 171,
-			238, 223, 170, 215, 152, 155, 16, 161, 26, 219, 78, 146, 238, 108,
-			165, 178, 187, 187, 91, 118, 1, 81, 24, 179, 182, 120, 45, 174,
-			44, 213, 230, 22, 86, 214, 22, 166, 103, 202, 87, 9, 97, 27,
-			1, 92, 80, 212, 151, 23, 27, 123, 42, 135, 28, 103, 97, 219,
-			238, 46, 40, 20, 183, 34, 25, 190, 201, 15, 84, 252, 143, 18,
-			139, 195, 71, 201, 46, 72, 54, 45, 159, 243, 129, 141, 94, 210,
-			55, 74, 10, 49, 153, 131, 90, 189, 16, 66, 234, 221, 241, 234,
-			26, 171, 173, 141, 179, 59, 213, 181, 218, 90, 137, 176, 55, 107,
-			235, 139, 171, 27, 235, 236, 205, 106, 189, 94, 93, 89, 175, 45,
-			172, 177, 213, 58, 155, 91, 93, 153, 175, 113, 138, 94, 99, 171,
-			119, 89, 117, 229, 235, 236, 107, 181, 149, 249, 146, 186, 172, 233,
-			61, 225, 194, 121, 12, 254, 135, 224, 140, 215, 50, 130, 102, 170,
-			230, 181, 59, 184, 138, 143, 175, 99, 92, 109, 133, 143, 189, 8,
-			228, 37, 136, 190, 16, 203, 44, 126, 65, 139, 48, 136, 67, 34,
-			213, 202, 251, 122, 84, 38, 132, 228, 9, 178, 40, 62, 154, 57,
-			70, 10, 196, 194, 25, 138, 143, 101, 166, 120, 97, 158, 226, 227,
-			153, 183, 120, 97, 126, 88, 252, 21, 133, 39, 50, 227, 80, 72,
-			196, 95, 81, 120, 50, 115, 29, 10, 229, 95, 81, 56, 150, 185,
-			12, 133, 72, 252, 21, 133, 167, 228, 231, 47, 171, 191, 104, 136,
-			218, 167, 51, 147, 136, 252, 12, 19, 107, 40, 67, 241, 132, 53,
-			235, 252, 1, 102, 85, 169, 88, 55, 116, 120, 186, 227, 42, 80,
-			129, 12, 102, 49, 161, 38, 189, 164, 18, 204, 114, 142, 171, 4,
-			217, 76, 38, 193, 149, 74, 173, 244, 190, 32, 139, 253, 2, 225,
-			128, 130, 145, 189, 61, 97, 172, 255, 254, 29, 100, 146, 221, 102,
-			106, 71, 122, 7, 4, 133, 181, 196, 77, 100, 110, 140, 231, 249,
-			216, 216, 192, 196, 247, 131, 27, 210, 124, 79, 234, 83, 146, 164,
-			205, 43, 20, 235, 225, 25, 181, 166, 187, 224, 193, 149, 174, 251,
-			29, 47, 78, 220, 78, 151, 147, 155, 31, 121, 155, 137, 47, 250,
-			250, 92, 181, 27, 56, 151, 164, 230, 250, 25, 232, 168, 77, 248,
-			157, 91, 132, 16, 130, 135, 50, 22, 197, 167, 135, 46, 136, 255,
-			54, 159, 104, 89, 158, 163, 120, 98, 88, 150, 35, 138, 39, 94,
-			158, 17, 255, 49, 197, 19, 55, 110, 146, 255, 102, 17, 43, 155,
-			161, 246, 181, 204, 10, 114, 254, 216, 130, 235, 215, 65, 203, 111,
-			66, 156, 41, 185, 119, 152, 129, 77, 92, 153, 247, 76, 80, 201,
-			196, 35, 211, 30, 37, 20, 52, 6, 247, 167, 247, 11, 96, 187,
-			223, 235, 121, 113, 34, 238, 245, 138, 58, 220, 88, 145, 20, 4,
-			155, 144, 34, 150, 203, 185, 129, 110, 47, 153, 84, 119, 212, 167,
-			166, 148, 117, 108, 106, 202, 12, 106, 172, 209, 82, 52, 216, 12,
-			219, 76, 166, 242, 147, 54, 240, 91, 92, 148, 21, 190, 145, 194,
-			81, 46, 238, 255, 146, 139, 34, 124, 203, 148, 177, 17, 182, 195,
-			93, 86, 189, 95, 3, 199, 12, 78, 175, 219, 110, 208, 106, 107,
-			102, 80, 133, 153, 3, 199, 242, 117, 125, 73, 106, 106, 170, 227,
-			238, 77, 77, 177, 200, 107, 122, 254, 99, 15, 162, 92, 246, 39,
-			99, 215, 86, 37, 66, 112, 150, 79, 194, 181, 44, 37, 95, 33,
-			118, 150, 243, 220, 248, 186, 117, 222, 153, 97, 115, 97, 240, 152,
-			243, 53, 66, 53, 32, 189, 146, 97, 116, 225, 6, 158, 113, 47,
-			24, 78, 6, 112, 58, 205, 102, 129, 3, 198, 215, 173, 51, 10,
-			178, 40, 190, 126, 142, 145, 239, 34, 168, 29, 81, 252, 170, 53,
-			226, 124, 7, 201, 120, 56, 82, 251, 170, 134, 66, 41, 238, 221,
-			88, 75, 192, 101, 194, 222, 132, 184, 9, 16, 101, 65, 4, 49,
-			56, 104, 120, 221, 200, 99, 169, 222, 89, 120, 198, 10, 139, 178,
-			220, 71, 100, 140, 16, 230, 117, 186, 219, 110, 236, 67, 78, 98,
-			35, 101, 160, 198, 31, 1, 142, 26, 178, 40, 126, 245, 240, 17,
-			242, 239, 5, 254, 22, 197, 183, 173, 17, 231, 223, 112, 41, 115,
-			31, 202, 138, 184, 116, 40, 3, 65, 182, 158, 17, 153, 67, 188,
-			59, 53, 213, 233, 197, 156, 118, 26, 94, 26, 18, 216, 141, 251,
-			108, 108, 146, 58, 75, 66, 147, 253, 200, 245, 219, 144, 165, 52,
-			100, 173, 144, 197, 50, 127, 144, 52, 244, 6, 204, 139, 34, 190,
-			49, 246, 100, 190, 204, 111, 213, 86, 30, 84, 151, 106, 243, 155,
-			213, 250, 189, 141, 229, 133, 149, 245, 111, 77, 234, 238, 241, 41,
-			184, 173, 187, 7, 29, 58, 124, 132, 252, 47, 209, 61, 76, 241,
-			156, 69, 157, 95, 28, 216, 61, 99, 179, 125, 102, 15, 205, 88,
-			199, 176, 212, 226, 110, 200, 79, 241, 146, 140, 226, 210, 108, 247,
-			212, 13, 5, 98, 154, 131, 101, 175, 251, 178, 179, 9, 214, 68,
-			166, 137, 22, 35, 167, 204, 174, 126, 34, 142, 61, 40, 133, 245,
-			40, 242, 162, 165, 99, 226, 138, 8, 141, 49, 132, 61, 50, 34,
-			208, 93, 142, 101, 234, 162, 166, 151, 142, 13, 70, 188, 255, 135,
-			21, 100, 81, 60, 55, 122, 148, 252, 99, 49, 54, 54, 197, 139,
-			214, 81, 231, 239, 28, 56, 54, 176, 63, 124, 206, 161, 81, 187,
-			144, 52, 93, 147, 1, 59, 183, 254, 138, 175, 54, 83, 223, 169,
-			117, 157, 2, 93, 27, 113, 4, 15, 41, 200, 162, 120, 113, 100,
-			148, 124, 79, 32, 159, 165, 120, 217, 26, 117, 126, 243, 96, 228,
-			59, 157, 94, 194, 249, 166, 103, 226, 174, 86, 148, 199, 251, 218,
-			244, 250, 231, 44, 9, 165, 83, 55, 115, 9, 248, 105, 115, 89,
-			52, 213, 118, 200, 96, 62, 98, 187, 108, 9, 149, 31, 88, 21,
-			116, 15, 178, 136, 99, 57, 172, 32, 139, 226, 229, 35, 35, 141,
-			156, 8, 165, 70, 254, 248, 14, 121, 117, 43, 44, 55, 183, 163,
-			176, 227, 247, 58, 130, 57, 236, 53, 253, 138, 152, 221, 86, 67,
-			136, 36, 149, 199, 215, 42, 137, 23, 39, 155, 162, 88, 74, 48,
-			163, 252, 205, 178, 122, 179, 252, 248, 154, 243, 44, 161, 199, 57,
-			187, 79, 208, 145, 167, 180, 124, 126, 110, 240, 121, 162, 14, 92,
-			249, 194, 245, 231, 196, 182, 25, 118, 58, 170, 214, 241, 239, 218,
-			132, 172, 123, 113, 82, 135, 215, 168, 67, 108, 206, 162, 140, 33,
-			134, 38, 10, 119, 114, 63, 175, 226, 159, 87, 179, 117, 40, 163,
-			103, 200, 16, 116, 212, 111, 141, 89, 240, 24, 158, 229, 120, 89,
-			173, 69, 47, 144, 130, 104, 138, 63, 199, 234, 243, 236, 207, 171,
-			86, 61, 47, 30, 212, 90, 244, 85, 50, 244, 216, 141, 124, 55,
-			72, 198, 108, 134, 38, 134, 103, 78, 149, 7, 7, 170, 252, 64,
-			188, 32, 106, 87, 175, 211, 115, 36, 239, 61, 233, 130, 125, 99,
-			44, 203, 208, 68, 94, 60, 215, 133, 244, 38, 201, 113, 6, 169,
-			23, 143, 229, 24, 154, 56, 50, 115, 102, 127, 205, 188, 159, 107,
-			240, 142, 68, 93, 124, 64, 47, 145, 67, 113, 175, 211, 113, 163,
-			189, 205, 237, 164, 211, 30, 27, 74, 123, 55, 44, 31, 44, 38,
-			157, 54, 253, 50, 33, 160, 123, 0, 206, 102, 44, 15, 29, 112,
-			246, 73, 123, 154, 15, 18, 53, 20, 224, 19, 94, 72, 103, 73,
-			94, 205, 233, 88, 65, 118, 255, 179, 88, 51, 217, 61, 245, 62,
-			189, 65, 236, 196, 221, 138, 199, 8, 195, 19, 195, 7, 117, 110,
-			13, 24, 204, 251, 174, 31, 137, 79, 225, 117, 58, 71, 14, 195,
-			156, 41, 107, 245, 216, 48, 180, 123, 246, 224, 193, 81, 106, 168,
-			250, 161, 196, 128, 198, 231, 201, 33, 243, 41, 117, 72, 158, 139,
-			101, 64, 40, 64, 9, 117, 13, 83, 74, 236, 182, 31, 120, 64,
-			1, 217, 58, 252, 127, 221, 206, 163, 81, 107, 252, 103, 136, 140,
-			240, 106, 22, 158, 132, 42, 66, 247, 83, 201, 237, 228, 0, 185,
-			105, 74, 187, 158, 18, 17, 126, 6, 17, 165, 244, 51, 77, 142,
-			120, 105, 195, 188, 82, 187, 175, 205, 195, 198, 211, 90, 139, 150,
-			201, 40, 36, 140, 22, 242, 129, 32, 139, 108, 74, 22, 35, 198,
-			67, 78, 26, 83, 111, 137, 85, 36, 168, 139, 158, 32, 116, 109,
-			189, 186, 190, 177, 54, 160, 120, 200, 19, 251, 126, 117, 109, 109,
-			20, 241, 127, 119, 171, 181, 165, 81, 139, 22, 72, 118, 174, 94,
-			93, 91, 28, 197, 252, 111, 245, 206, 106, 125, 125, 212, 230, 207,
-			215, 190, 86, 187, 63, 154, 189, 115, 237, 97, 229, 249, 214, 245,
-			45, 81, 210, 109, 188, 254, 91, 55, 201, 16, 205, 30, 201, 252,
-			20, 33, 242, 35, 33, 192, 31, 201, 208, 153, 31, 236, 19, 224,
-			215, 185, 172, 182, 49, 87, 99, 85, 136, 219, 21, 255, 127, 41,
-			198, 127, 33, 199, 255, 5, 200, 241, 66, 140, 31, 205, 156, 82,
-			98, 60, 205, 76, 194, 95, 196, 37, 250, 9, 248, 107, 81, 92,
-			148, 165, 152, 139, 244, 183, 181, 244, 126, 87, 201, 249, 252, 239,
-			255, 180, 136, 101, 103, 168, 125, 62, 19, 35, 231, 23, 22, 171,
-			26, 236, 146, 203, 30, 245, 130, 166, 10, 236, 200, 15, 249, 166,
-			11, 36, 177, 250, 40, 49, 92, 71, 211, 71, 16, 41, 245, 137,
-			215, 132, 145, 78, 195, 161, 241, 45, 24, 248, 27, 8, 0, 166,
-			138, 69, 59, 113, 137, 12, 84, 20, 247, 252, 196, 235, 127, 85,
-			55, 16, 75, 134, 88, 43, 239, 141, 47, 84, 120, 89, 213, 190,
-			31, 24, 198, 20, 185, 241, 196, 132, 77, 172, 174, 149, 216, 189,
-			251, 27, 37, 117, 233, 140, 61, 106, 187, 91, 49, 40, 16, 38,
-			117, 176, 58, 29, 116, 95, 249, 65, 138, 249, 219, 147, 234, 216,
-			56, 97, 62, 159, 237, 154, 169, 220, 213, 121, 239, 149, 156, 165,
-			42, 1, 54, 202, 235, 52, 188, 86, 60, 144, 43, 133, 75, 96,
-			54, 23, 153, 206, 231, 41, 249, 145, 69, 108, 113, 185, 115, 202,
-			154, 182, 157, 223, 181, 216, 156, 232, 18, 184, 63, 195, 197, 91,
-			233, 97, 45, 210, 191, 123, 160, 61, 142, 33, 91, 58, 216, 236,
-			253, 128, 9, 198, 97, 254, 78, 249, 158, 151, 164, 140, 4, 97,
-			245, 251, 115, 101, 240, 235, 239, 184, 201, 44, 97, 227, 126, 240,
-			88, 57, 32, 86, 62, 168, 173, 60, 88, 157, 171, 114, 66, 223,
-			172, 205, 255, 26, 240, 79, 113, 229, 131, 141, 250, 210, 230, 194,
-			218, 92, 245, 254, 194, 252, 230, 250, 194, 218, 58, 60, 147, 147,
-			86, 249, 160, 190, 176, 182, 177, 4, 101, 227, 101, 34, 253, 237,
-			14, 248, 130, 73, 68, 55, 253, 22, 243, 226, 166, 219, 149, 102,
-			50, 162, 83, 225, 27, 10, 210, 238, 206, 86, 37, 240, 146, 74,
-			47, 106, 87, 46, 220, 119, 147, 237, 5, 248, 66, 92, 109, 108,
-			199, 161, 254, 198, 245, 187, 229, 150, 247, 184, 114, 109, 6, 44,
-			187, 171, 166, 104, 194, 249, 71, 27, 76, 51, 120, 202, 202, 43,
-			8, 81, 60, 85, 56, 172, 32, 76, 241, 212, 168, 184, 225, 34,
-			44, 50, 83, 116, 26, 147, 17, 146, 7, 240, 183, 243, 25, 138,
-			175, 216, 211, 70, 1, 162, 184, 100, 95, 33, 223, 205, 194, 12,
-			33, 138, 171, 86, 213, 249, 78, 150, 173, 11, 74, 40, 49, 151,
-			245, 2, 255, 189, 158, 97, 13, 136, 82, 229, 125, 44, 163, 74,
-			195, 230, 222, 141, 66, 97, 225, 96, 117, 111, 203, 123, 50, 203,
-			190, 249, 246, 219, 179, 179, 221, 200, 15, 146, 217, 217, 119, 222,
-			249, 224, 90, 105, 230, 198, 151, 126, 237, 101, 66, 192, 183, 103,
-			55, 132, 239, 99, 117, 253, 66, 112, 139, 138, 2, 211, 196, 100,
-			156, 192, 2, 25, 224, 143, 51, 224, 65, 24, 76, 187, 237, 238,
-			182, 27, 244, 58, 144, 247, 178, 185, 237, 70, 110, 51, 145, 41,
-			192, 247, 204, 220, 157, 174, 150, 60, 93, 6, 254, 187, 101, 67,
-			91, 198, 166, 217, 184, 91, 105, 84, 154, 227, 250, 111, 43, 253,
-			235, 205, 62, 49, 129, 61, 5, 60, 26, 151, 235, 72, 58, 2,
-			15, 92, 183, 128, 86, 228, 37, 130, 118, 155, 249, 137, 215, 17,
-			17, 190, 133, 99, 21, 88, 79, 249, 43, 105, 4, 192, 71, 218,
-			143, 37, 12, 84, 175, 199, 221, 10, 180, 247, 166, 184, 50, 32,
-			146, 139, 242, 207, 74, 169, 199, 8, 123, 69, 249, 145, 198, 189,
-			198, 244, 83, 42, 133, 203, 8, 186, 222, 198, 103, 214, 172, 187,
-			13, 251, 144, 238, 247, 211, 90, 81, 158, 218, 251, 176, 231, 159,
-			142, 43, 169, 171, 105, 172, 117, 63, 96, 27, 181, 50, 17, 4,
-			163, 183, 160, 212, 69, 89, 78, 63, 168, 66, 210, 132, 63, 187,
-			110, 74, 254, 40, 203, 105, 84, 145, 63, 226, 20, 91, 24, 85,
-			16, 166, 184, 10, 185, 147, 1, 202, 83, 92, 61, 254, 85, 73,
-			236, 72, 80, 127, 245, 228, 151, 201, 54, 208, 186, 69, 237, 5,
-			107, 209, 118, 30, 154, 134, 51, 215, 220, 124, 4, 109, 11, 13,
-			90, 186, 173, 136, 109, 90, 111, 148, 154, 214, 221, 233, 247, 175,
-			78, 223, 252, 198, 244, 102, 153, 147, 250, 245, 25, 78, 233, 2,
-			17, 46, 165, 46, 104, 148, 249, 34, 91, 40, 80, 5, 97, 138,
-			23, 142, 159, 144, 43, 214, 202, 83, 123, 225, 228, 162, 90, 177,
-			150, 192, 249, 174, 125, 197, 40, 64, 20, 223, 179, 167, 200, 46,
-			116, 2, 83, 123, 201, 90, 158, 113, 124, 237, 238, 42, 213, 204,
-			156, 210, 244, 201, 188, 235, 238, 137, 132, 100, 129, 206, 16, 150,
-			8, 29, 12, 236, 174, 134, 74, 186, 209, 107, 238, 120, 112, 239,
-			221, 111, 183, 188, 72, 250, 181, 167, 103, 145, 158, 6, 156, 163,
-			120, 201, 42, 40, 8, 81, 188, 68, 142, 42, 8, 83, 188, 84,
-			60, 174, 160, 60, 197, 203, 185, 107, 178, 7, 88, 116, 105, 57,
-			95, 33, 223, 195, 208, 5, 155, 226, 7, 214, 87, 157, 223, 196,
-			125, 201, 76, 12, 213, 134, 62, 131, 197, 1, 168, 178, 195, 73,
-			129, 140, 159, 85, 129, 112, 195, 135, 43, 30, 115, 146, 131, 101,
-			115, 75, 37, 118, 243, 230, 197, 43, 186, 14, 185, 195, 131, 70,
-			205, 248, 122, 35, 86, 106, 182, 212, 107, 18, 8, 210, 139, 117,
-			172, 111, 121, 236, 245, 2, 245, 157, 170, 12, 60, 255, 130, 253,
-			15, 216, 249, 219, 6, 226, 82, 203, 165, 18, 103, 66, 91, 250,
-			224, 151, 202, 92, 206, 239, 233, 74, 32, 241, 175, 223, 174, 196,
-			59, 126, 183, 210, 140, 220, 120, 91, 152, 94, 225, 171, 109, 55,
-			10, 56, 119, 6, 217, 181, 34, 185, 5, 137, 56, 192, 76, 72,
-			154, 36, 229, 118, 117, 149, 97, 224, 77, 196, 147, 146, 199, 48,
-			35, 226, 14, 140, 182, 188, 179, 238, 70, 126, 12, 142, 141, 98,
-			18, 237, 44, 159, 165, 156, 130, 16, 197, 15, 134, 70, 20, 132,
-			41, 126, 64, 143, 41, 40, 79, 241, 131, 226, 87, 228, 116, 219,
-			98, 186, 31, 156, 184, 77, 190, 14, 179, 157, 165, 248, 161, 53,
-			239, 44, 177, 101, 183, 185, 237, 7, 222, 116, 228, 185, 45, 96,
-			45, 4, 234, 125, 103, 139, 100, 202, 150, 55, 214, 214, 217, 202,
-			234, 58, 223, 69, 246, 203, 59, 26, 199, 108, 142, 215, 125, 72,
-			65, 136, 226, 135, 135, 21, 86, 89, 76, 241, 195, 19, 39, 21,
-			148, 167, 248, 225, 216, 156, 196, 49, 43, 112, 124, 232, 84, 201,
-			127, 66, 128, 100, 142, 98, 215, 186, 235, 252, 0, 177, 197, 94,
-			199, 13, 82, 28, 13, 113, 44, 213, 103, 10, 62, 5, 194, 112,
-			44, 47, 41, 116, 27, 156, 141, 11, 252, 4, 50, 230, 75, 23,
-			202, 200, 227, 28, 175, 145, 103, 183, 17, 133, 187, 194, 209, 22,
-			102, 55, 246, 223, 215, 123, 183, 212, 7, 232, 48, 171, 222, 123,
-			124, 122, 249, 17, 18, 177, 184, 227, 182, 219, 202, 133, 240, 149,
-			171, 55, 191, 164, 77, 251, 132, 109, 172, 223, 157, 126, 85, 15,
-			73, 46, 203, 123, 162, 118, 158, 28, 162, 216, 45, 168, 117, 153,
-			195, 20, 187, 99, 167, 20, 148, 167, 216, 117, 22, 228, 144, 228,
-			196, 144, 184, 103, 230, 200, 34, 140, 200, 16, 181, 91, 150, 55,
-			227, 204, 138, 208, 18, 16, 6, 158, 119, 194, 239, 120, 105, 112,
-			236, 148, 224, 65, 23, 33, 232, 88, 114, 173, 26, 165, 161, 28,
-			197, 45, 235, 180, 130, 16, 197, 173, 51, 151, 20, 132, 41, 110,
-			77, 78, 41, 40, 79, 177, 167, 55, 142, 33, 129, 146, 151, 175,
-			72, 74, 202, 83, 219, 183, 222, 157, 113, 190, 198, 180, 153, 105,
-			144, 118, 210, 29, 195, 152, 22, 115, 36, 183, 64, 175, 39, 71,
-			242, 170, 198, 49, 159, 163, 216, 183, 28, 5, 33, 138, 253, 211,
-			23, 20, 132, 41, 246, 225, 18, 52, 64, 121, 138, 223, 213, 56,
-			230, 5, 142, 239, 230, 43, 164, 3, 56, 22, 168, 29, 88, 225,
-			140, 179, 201, 150, 85, 22, 76, 33, 54, 245, 115, 185, 130, 223,
-			238, 128, 192, 220, 146, 94, 94, 202, 83, 95, 223, 34, 76, 119,
-			63, 41, 120, 25, 75, 68, 98, 83, 176, 41, 14, 44, 13, 229,
-			40, 14, 134, 213, 116, 23, 16, 197, 193, 9, 213, 167, 2, 166,
-			56, 120, 233, 156, 130, 242, 20, 135, 186, 23, 5, 209, 139, 48,
-			95, 33, 175, 65, 47, 8, 197, 145, 53, 238, 84, 248, 6, 45,
-			47, 159, 136, 243, 79, 223, 140, 41, 233, 52, 113, 76, 105, 104,
-			52, 82, 36, 199, 63, 63, 162, 32, 68, 113, 52, 114, 70, 65,
-			152, 226, 232, 220, 121, 82, 33, 124, 79, 201, 62, 206, 252, 85,
-			132, 156, 241, 244, 18, 178, 57, 161, 230, 77, 83, 144, 46, 248,
-			217, 255, 56, 95, 36, 51, 252, 127, 129, 226, 93, 235, 240, 248,
-			37, 246, 166, 39, 7, 210, 112, 120, 103, 145, 215, 13, 57, 19,
-			207, 218, 174, 80, 204, 14, 19, 219, 70, 133, 12, 197, 187, 195,
-			98, 195, 64, 5, 206, 73, 27, 144, 37, 32, 8, 207, 139, 172,
-			12, 197, 223, 182, 78, 56, 63, 67, 144, 153, 56, 213, 184, 183,
-			205, 27, 57, 3, 131, 34, 196, 18, 225, 219, 164, 52, 202, 221,
-			48, 246, 147, 48, 218, 43, 137, 133, 45, 46, 11, 192, 102, 61,
-			94, 225, 156, 153, 100, 79, 103, 57, 152, 94, 244, 170, 116, 221,
-			61, 240, 129, 172, 52, 195, 200, 83, 208, 166, 84, 80, 111, 114,
-			186, 218, 236, 37, 126, 123, 179, 23, 248, 9, 199, 162, 220, 228,
-			92, 237, 178, 251, 68, 186, 219, 204, 178, 27, 215, 102, 184, 12,
-			191, 184, 186, 177, 52, 175, 85, 38, 13, 183, 185, 19, 183, 221,
-			120, 219, 147, 102, 46, 222, 211, 44, 239, 106, 94, 65, 136, 226,
-			111, 23, 142, 42, 8, 83, 252, 237, 226, 113, 242, 85, 24, 19,
-			68, 237, 15, 145, 117, 212, 153, 97, 171, 129, 39, 239, 30, 128,
-			51, 174, 188, 250, 242, 148, 129, 1, 118, 7, 9, 111, 45, 100,
-			13, 41, 16, 106, 204, 31, 82, 32, 230, 224, 200, 40, 185, 9,
-			134, 211, 220, 71, 40, 243, 79, 17, 114, 174, 60, 237, 232, 112,
-			251, 151, 21, 25, 22, 118, 64, 251, 35, 148, 29, 33, 127, 5,
-			73, 75, 160, 253, 55, 145, 117, 202, 137, 152, 80, 163, 193, 21,
-			175, 84, 18, 22, 218, 142, 21, 113, 153, 215, 224, 91, 229, 9,
-			107, 178, 18, 183, 132, 245, 38, 22, 214, 24, 101, 75, 20, 113,
-			39, 68, 224, 107, 194, 122, 65, 236, 37, 218, 166, 121, 88, 153,
-			18, 57, 10, 69, 5, 90, 28, 60, 57, 70, 38, 165, 45, 209,
-			254, 117, 100, 13, 59, 167, 211, 35, 31, 54, 179, 109, 176, 164,
-			197, 58, 32, 181, 176, 233, 241, 119, 115, 10, 180, 56, 88, 32,
-			202, 42, 105, 81, 251, 55, 120, 77, 127, 23, 29, 80, 21, 103,
-			48, 132, 98, 103, 95, 156, 125, 112, 9, 147, 234, 25, 57, 117,
-			126, 0, 214, 155, 166, 140, 137, 36, 175, 76, 114, 209, 85, 222,
-			34, 86, 14, 112, 230, 124, 235, 143, 100, 56, 50, 113, 59, 174,
-			237, 238, 120, 186, 7, 188, 183, 191, 145, 246, 64, 160, 92, 32,
-			228, 95, 43, 195, 157, 253, 247, 145, 117, 200, 249, 39, 7, 245,
-			0, 24, 35, 175, 165, 130, 114, 152, 155, 253, 186, 240, 153, 111,
-			134, 29, 79, 33, 18, 200, 184, 243, 179, 7, 118, 81, 236, 25,
-			97, 36, 255, 8, 163, 142, 52, 89, 169, 78, 171, 174, 93, 17,
-			65, 8, 140, 254, 233, 238, 96, 4, 8, 15, 41, 208, 226, 32,
-			25, 38, 91, 210, 212, 102, 255, 35, 222, 155, 175, 31, 208, 25,
-			121, 100, 138, 150, 56, 69, 186, 141, 16, 206, 80, 201, 60, 240,
-			205, 47, 222, 134, 180, 57, 172, 170, 36, 46, 149, 9, 149, 159,
-			195, 97, 47, 69, 195, 70, 208, 146, 66, 195, 182, 56, 72, 134,
-			201, 239, 88, 210, 106, 102, 127, 143, 211, 197, 119, 172, 1, 68,
-			90, 190, 72, 188, 170, 15, 237, 126, 121, 25, 188, 255, 210, 99,
-			72, 113, 139, 109, 149, 119, 206, 228, 214, 227, 146, 72, 63, 231,
-			42, 161, 10, 46, 130, 250, 129, 11, 41, 170, 140, 254, 117, 35,
-			175, 3, 233, 14, 218, 123, 192, 157, 246, 53, 0, 14, 190, 6,
-			175, 188, 235, 130, 71, 58, 231, 139, 187, 64, 188, 211, 7, 241,
-			197, 193, 35, 127, 75, 241, 4, 122, 81, 15, 144, 39, 244, 87,
-			242, 4, 125, 85, 202, 49, 203, 34, 24, 36, 69, 152, 89, 139,
-			131, 5, 66, 254, 8, 17, 62, 158, 185, 127, 137, 50, 63, 69,
-			200, 249, 49, 98, 53, 195, 170, 168, 245, 118, 224, 236, 41, 111,
-			156, 184, 108, 110, 105, 18, 136, 191, 1, 65, 158, 228, 21, 145,
-			70, 27, 130, 35, 139, 141, 226, 0, 17, 34, 221, 206, 164, 142,
-			175, 60, 16, 191, 66, 55, 39, 159, 195, 146, 142, 197, 189, 100,
-			161, 46, 9, 210, 155, 245, 92, 28, 138, 67, 185, 10, 33, 143,
-			235, 220, 210, 101, 190, 11, 232, 141, 210, 134, 32, 143, 40, 127,
-			146, 252, 137, 69, 108, 219, 178, 50, 52, 247, 125, 100, 125, 130,
-			108, 231, 15, 158, 71, 99, 103, 88, 54, 180, 218, 142, 236, 211,
-			219, 25, 102, 153, 65, 221, 221, 231, 85, 221, 25, 13, 199, 149,
-			15, 22, 222, 90, 93, 89, 168, 235, 47, 185, 132, 247, 20, 237,
-			157, 26, 218, 191, 96, 85, 30, 63, 225, 44, 240, 178, 254, 62,
-			178, 242, 10, 68, 28, 44, 28, 86, 32, 230, 224, 232, 81, 50,
-			34, 192, 60, 159, 14, 250, 9, 194, 224, 97, 109, 73, 125, 158,
-			253, 111, 145, 61, 109, 148, 32, 106, 255, 46, 178, 175, 144, 87,
-			97, 10, 17, 181, 127, 128, 172, 227, 206, 148, 82, 232, 41, 29,
-			94, 137, 197, 158, 199, 82, 69, 170, 26, 0, 141, 27, 63, 155,
-			127, 144, 226, 134, 160, 166, 194, 168, 2, 49, 7, 143, 21, 201,
-			127, 69, 208, 142, 69, 237, 223, 71, 214, 9, 231, 15, 209, 160,
-			34, 2, 98, 76, 74, 250, 52, 216, 57, 209, 190, 52, 159, 201,
-			156, 68, 108, 35, 104, 251, 59, 125, 88, 121, 79, 146, 200, 85,
-			147, 180, 217, 117, 253, 40, 150, 78, 48, 97, 144, 166, 45, 118,
-			217, 163, 94, 187, 109, 112, 136, 100, 160, 229, 18, 131, 8, 29,
-			154, 248, 249, 254, 217, 128, 43, 221, 176, 78, 106, 154, 240, 202,
-			156, 141, 217, 236, 163, 12, 53, 32, 86, 14, 250, 88, 80, 32,
-			226, 32, 57, 170, 64, 204, 193, 226, 113, 190, 211, 219, 28, 202,
-			253, 16, 89, 63, 70, 182, 243, 102, 159, 122, 41, 48, 87, 201,
-			115, 233, 151, 106, 128, 179, 240, 18, 153, 54, 130, 5, 201, 118,
-			113, 150, 218, 63, 76, 167, 137, 159, 63, 63, 68, 133, 147, 10,
-			196, 28, 4, 39, 125, 0, 243, 28, 173, 51, 63, 214, 36, 36,
-			180, 49, 246, 127, 76, 73, 8, 11, 18, 250, 17, 39, 161, 0,
-			186, 98, 83, 251, 39, 200, 122, 221, 249, 22, 171, 195, 105, 35,
-			46, 80, 110, 251, 210, 88, 209, 183, 234, 255, 124, 66, 176, 192,
-			217, 206, 66, 131, 170, 71, 252, 40, 251, 9, 42, 156, 82, 32,
-			230, 224, 153, 151, 20, 152, 231, 224, 217, 154, 196, 94, 168, 27,
-			236, 159, 32, 118, 79, 251, 87, 252, 187, 139, 228, 246, 211, 45,
-			155, 177, 31, 236, 60, 205, 201, 130, 26, 102, 95, 254, 110, 249,
-			241, 181, 255, 55, 94, 20, 206, 231, 118, 20, 25, 255, 73, 191,
-			255, 133, 97, 244, 70, 125, 70, 239, 211, 166, 123, 133, 52, 186,
-			107, 183, 10, 199, 112, 142, 192, 12, 77, 228, 13, 191, 136, 87,
-			180, 95, 132, 253, 108, 191, 8, 237, 18, 113, 126, 192, 37, 2,
-			108, 223, 253, 222, 16, 55, 251, 188, 33, 114, 207, 242, 134, 48,
-			29, 33, 110, 24, 142, 16, 67, 207, 112, 132, 48, 124, 32, 174,
-			74, 31, 136, 252, 179, 125, 32, 164, 251, 195, 215, 72, 129, 11,
-			234, 143, 220, 102, 18, 143, 21, 224, 179, 233, 242, 126, 170, 41,
-			27, 59, 91, 85, 189, 15, 73, 134, 235, 233, 247, 251, 125, 41,
-			200, 139, 251, 82, 56, 15, 201, 145, 254, 22, 232, 40, 193, 59,
-			222, 158, 156, 110, 254, 151, 206, 144, 44, 200, 42, 48, 207, 3,
-			29, 85, 24, 171, 74, 234, 226, 213, 89, 235, 85, 52, 30, 145,
-			188, 42, 166, 47, 145, 2, 23, 112, 55, 187, 110, 178, 45, 234,
-			94, 204, 8, 55, 13, 126, 54, 210, 51, 36, 175, 82, 159, 65,
-			43, 135, 248, 83, 85, 194, 103, 95, 254, 135, 224, 13, 194, 157,
-			167, 62, 44, 203, 214, 247, 186, 222, 157, 28, 177, 27, 97, 107,
-			111, 252, 95, 33, 114, 36, 29, 189, 187, 126, 27, 92, 64, 210,
-			86, 235, 240, 159, 86, 73, 78, 92, 132, 130, 214, 142, 204, 76,
-			62, 125, 22, 120, 61, 101, 193, 127, 212, 229, 135, 227, 115, 36,
-			39, 74, 104, 158, 216, 75, 27, 115, 181, 209, 12, 61, 75, 156,
-			185, 197, 250, 234, 114, 109, 99, 121, 243, 245, 181, 213, 21, 193,
-			70, 8, 75, 225, 218, 40, 162, 35, 100, 248, 222, 234, 234, 189,
-			165, 5, 120, 48, 106, 221, 121, 229, 225, 204, 11, 236, 57, 183,
-			56, 212, 109, 188, 254, 209, 25, 146, 163, 246, 145, 76, 231, 11,
-			127, 138, 47, 252, 41, 254, 143, 250, 83, 156, 78, 253, 41, 38,
-			82, 127, 138, 201, 212, 159, 226, 118, 234, 79, 113, 71, 251, 83,
-			212, 82, 127, 138, 26, 249, 107, 72, 248, 83, 156, 201, 220, 70,
-			206, 174, 12, 60, 213, 102, 124, 66, 30, 187, 109, 79, 176, 119,
-			253, 91, 84, 186, 208, 210, 164, 106, 126, 192, 202, 229, 74, 185,
-			124, 224, 49, 37, 135, 197, 79, 226, 52, 24, 129, 136, 61, 158,
-			112, 209, 198, 112, 43, 56, 147, 167, 160, 134, 2, 199, 238, 179,
-			214, 113, 231, 58, 91, 120, 10, 38, 253, 123, 239, 58, 156, 117,
-			125, 22, 245, 179, 125, 22, 245, 179, 218, 164, 152, 193, 20, 159,
-			61, 86, 36, 137, 178, 143, 95, 176, 78, 58, 91, 47, 208, 150,
-			62, 77, 203, 194, 240, 29, 138, 28, 178, 37, 230, 178, 200, 13,
-			90, 97, 167, 164, 12, 236, 66, 42, 73, 89, 188, 62, 147, 231,
-			133, 62, 147, 231, 5, 109, 63, 68, 152, 226, 11, 199, 79, 144,
-			59, 210, 166, 137, 47, 89, 69, 231, 198, 11, 224, 151, 90, 194,
-			12, 107, 229, 37, 109, 234, 225, 93, 190, 164, 77, 61, 22, 166,
-			248, 18, 61, 38, 71, 30, 83, 60, 105, 77, 190, 208, 200, 139,
-			99, 191, 207, 138, 56, 105, 189, 100, 88, 17, 39, 207, 190, 108,
-			88, 17, 39, 47, 79, 144, 187, 202, 74, 88, 178, 28, 231, 230,
-			139, 180, 101, 48, 22, 125, 134, 172, 146, 30, 75, 27, 81, 92,
-			210, 22, 17, 27, 83, 92, 26, 59, 69, 230, 149, 165, 170, 98,
-			93, 113, 254, 210, 139, 245, 78, 242, 41, 125, 70, 169, 138, 54,
-			119, 100, 17, 197, 21, 109, 238, 200, 98, 138, 43, 147, 83, 114,
-			238, 114, 20, 207, 88, 19, 47, 52, 119, 154, 231, 212, 246, 30,
-			168, 196, 49, 236, 61, 51, 218, 112, 145, 195, 20, 207, 92, 186,
-			76, 190, 44, 13, 58, 248, 134, 117, 213, 185, 246, 2, 173, 113,
-			78, 39, 53, 227, 216, 188, 2, 211, 168, 115, 99, 248, 146, 97,
-			212, 185, 113, 249, 138, 97, 212, 185, 81, 174, 128, 96, 148, 177,
-			242, 20, 223, 180, 46, 57, 15, 153, 230, 78, 32, 163, 104, 183,
-			29, 186, 34, 200, 153, 190, 117, 174, 78, 152, 125, 166, 18, 136,
-			200, 231, 118, 217, 142, 183, 39, 147, 246, 42, 222, 9, 36, 38,
-			195, 134, 115, 211, 58, 105, 216, 112, 110, 142, 157, 55, 108, 56,
-			55, 95, 190, 72, 238, 73, 35, 13, 126, 205, 186, 238, 204, 190,
-			200, 80, 152, 252, 89, 217, 52, 184, 188, 102, 157, 51, 12, 46,
-			175, 177, 105, 195, 224, 242, 218, 213, 25, 242, 223, 17, 152, 58,
-			236, 249, 76, 29, 57, 255, 5, 61, 215, 54, 170, 134, 234, 128,
-			77, 52, 234, 54, 249, 70, 170, 6, 224, 57, 119, 81, 54, 175,
-			60, 47, 164, 243, 141, 136, 187, 29, 233, 129, 220, 244, 91, 102,
-			12, 139, 61, 153, 193, 33, 77, 62, 45, 162, 155, 19, 83, 84,
-			215, 12, 172, 154, 28, 195, 76, 51, 159, 31, 133, 8, 189, 136,
-			75, 103, 11, 214, 10, 22, 6, 133, 60, 223, 107, 23, 242, 135,
-			32, 245, 24, 24, 89, 22, 237, 83, 206, 26, 171, 54, 226, 176,
-			221, 75, 188, 190, 203, 224, 122, 146, 129, 39, 9, 141, 160, 137,
-			29, 97, 15, 96, 110, 44, 144, 18, 8, 173, 249, 193, 142, 148,
-			155, 251, 140, 27, 139, 54, 49, 140, 27, 139, 195, 69, 195, 184,
-			177, 120, 114, 140, 252, 67, 36, 173, 27, 120, 217, 62, 233, 124,
-			140, 216, 156, 202, 221, 43, 57, 25, 61, 216, 108, 67, 4, 167,
-			5, 3, 104, 44, 239, 91, 187, 70, 240, 95, 55, 245, 211, 35,
-			10, 75, 78, 192, 171, 243, 171, 19, 65, 216, 242, 163, 18, 139,
-			221, 119, 223, 117, 91, 157, 201, 89, 153, 83, 77, 85, 163, 19,
-			6, 115, 174, 107, 187, 23, 236, 164, 22, 26, 126, 28, 44, 219,
-			5, 5, 113, 68, 9, 85, 16, 166, 120, 249, 248, 9, 216, 194,
-			16, 63, 14, 222, 176, 156, 103, 111, 97, 138, 192, 202, 38, 55,
-			174, 219, 227, 59, 225, 27, 218, 34, 196, 7, 230, 13, 185, 101,
-			34, 56, 16, 222, 24, 59, 69, 46, 131, 82, 212, 222, 200, 116,
-			144, 115, 26, 18, 153, 183, 213, 2, 54, 236, 37, 146, 34, 120,
-			21, 27, 249, 19, 196, 151, 90, 70, 252, 150, 69, 157, 111, 164,
-			179, 190, 186, 54, 29, 184, 16, 33, 201, 156, 127, 229, 190, 241,
-			185, 167, 31, 244, 109, 248, 45, 217, 19, 80, 183, 225, 183, 164,
-			235, 28, 104, 219, 240, 91, 163, 71, 201, 69, 142, 20, 231, 115,
-			222, 182, 124, 236, 156, 76, 221, 70, 197, 180, 130, 100, 160, 42,
-			4, 78, 228, 237, 161, 195, 100, 135, 228, 56, 196, 251, 242, 77,
-			251, 176, 243, 13, 216, 163, 224, 3, 17, 155, 210, 123, 175, 231,
-			5, 34, 130, 178, 193, 19, 113, 73, 34, 13, 207, 15, 65, 59,
-			69, 89, 53, 138, 220, 189, 73, 185, 213, 25, 87, 212, 68, 211,
-			71, 200, 144, 104, 12, 241, 214, 242, 41, 108, 81, 252, 205, 225,
-			67, 228, 31, 32, 137, 13, 162, 184, 97, 95, 112, 254, 58, 210,
-			78, 49, 151, 99, 209, 0, 40, 3, 235, 106, 64, 161, 226, 84,
-			217, 169, 165, 22, 33, 42, 139, 251, 46, 229, 102, 216, 209, 79,
-			42, 113, 212, 172, 92, 169, 116, 32, 74, 76, 165, 21, 54, 99,
-			96, 229, 252, 96, 171, 242, 110, 28, 6, 155, 6, 95, 23, 111,
-			74, 188, 59, 173, 20, 115, 4, 152, 157, 77, 97, 139, 226, 198,
-			249, 241, 20, 115, 139, 226, 109, 187, 200, 49, 191, 7, 168, 170,
-			190, 131, 89, 110, 215, 107, 183, 117, 224, 103, 225, 0, 212, 151,
-			196, 100, 214, 232, 74, 220, 47, 131, 245, 117, 160, 225, 198, 30,
-			224, 93, 105, 187, 189, 160, 185, 237, 69, 149, 62, 196, 147, 200,
-			109, 238, 120, 81, 185, 217, 76, 49, 231, 99, 186, 109, 143, 164,
-			48, 199, 148, 30, 35, 227, 82, 219, 138, 219, 86, 209, 57, 46,
-			85, 218, 166, 153, 88, 211, 32, 202, 241, 151, 20, 13, 242, 145,
-			104, 23, 70, 20, 132, 41, 110, 211, 99, 90, 117, 245, 163, 17,
-			242, 165, 23, 81, 93, 129, 128, 251, 217, 58, 171, 63, 159, 26,
-			108, 252, 29, 50, 86, 247, 186, 97, 100, 184, 223, 198, 117, 97,
-			130, 166, 85, 114, 200, 28, 187, 49, 4, 58, 144, 179, 79, 151,
-			190, 235, 195, 73, 90, 211, 248, 61, 114, 234, 128, 234, 197, 253,
-			58, 58, 69, 142, 26, 245, 111, 66, 142, 73, 104, 164, 80, 31,
-			73, 43, 89, 225, 197, 51, 239, 19, 155, 47, 126, 26, 145, 163,
-			251, 42, 164, 165, 131, 80, 250, 172, 110, 57, 7, 42, 113, 62,
-			19, 203, 241, 204, 231, 148, 251, 255, 104, 88, 200, 253, 229, 47,
-			228, 254, 47, 228, 254, 255, 91, 114, 255, 61, 41, 214, 23, 83,
-			177, 158, 255, 253, 23, 152, 88, 185, 12, 181, 207, 101, 46, 32,
-			231, 183, 48, 91, 147, 169, 0, 193, 198, 199, 9, 125, 240, 4,
-			55, 34, 82, 54, 101, 106, 159, 64, 142, 190, 219, 18, 54, 1,
-			183, 7, 199, 246, 14, 216, 53, 160, 4, 178, 78, 109, 123, 110,
-			75, 248, 133, 8, 123, 199, 14, 4, 19, 229, 143, 235, 247, 231,
-			210, 196, 63, 102, 158, 192, 141, 128, 215, 229, 5, 137, 47, 114,
-			158, 137, 203, 175, 105, 52, 50, 145, 204, 166, 76, 192, 190, 83,
-			146, 235, 66, 102, 45, 155, 53, 57, 129, 215, 120, 61, 211, 128,
-			211, 151, 165, 187, 157, 137, 101, 108, 220, 113, 120, 236, 171, 155,
-			181, 173, 134, 185, 244, 249, 251, 155, 226, 125, 190, 8, 55, 231,
-			86, 87, 214, 23, 222, 90, 87, 233, 109, 193, 54, 11, 161, 144,
-			141, 148, 6, 37, 246, 216, 143, 125, 227, 112, 221, 242, 147, 237,
-			94, 3, 78, 83, 216, 24, 248, 207, 116, 119, 175, 210, 104, 135,
-			13, 117, 160, 138, 97, 173, 152, 141, 192, 241, 73, 8, 206, 241,
-			67, 255, 92, 254, 16, 103, 83, 114, 224, 202, 114, 222, 26, 47,
-			57, 39, 153, 216, 148, 226, 193, 201, 58, 68, 178, 57, 193, 41,
-			156, 207, 157, 84, 144, 69, 241, 249, 177, 171, 10, 194, 20, 143,
-			143, 76, 16, 34, 180, 59, 23, 51, 19, 72, 43, 89, 46, 230,
-			25, 185, 164, 148, 44, 151, 173, 203, 206, 41, 193, 57, 40, 86,
-			76, 211, 72, 170, 74, 177, 249, 139, 26, 202, 81, 124, 89, 123,
-			160, 241, 26, 47, 159, 24, 55, 20, 43, 151, 47, 94, 130, 118,
-			17, 181, 167, 248, 230, 167, 196, 133, 169, 252, 121, 242, 55, 180,
-			227, 213, 180, 53, 225, 124, 192, 150, 252, 24, 14, 212, 125, 183,
-			17, 164, 223, 74, 179, 223, 62, 221, 230, 147, 46, 56, 199, 88,
-			243, 142, 144, 218, 72, 122, 77, 121, 45, 152, 232, 125, 188, 176,
-			178, 87, 43, 50, 76, 101, 7, 155, 163, 98, 74, 18, 211, 195,
-			71, 13, 73, 98, 154, 94, 48, 36, 137, 233, 75, 151, 213, 49,
-			254, 191, 3, 0, 0, 255, 255, 90, 140, 250, 244, 37, 64, 1,
-			0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 109, 112, 28, 201,
+			117, 32, 216, 85, 89, 221, 232, 78, 128, 64, 35, 9, 146, 96,
+			145, 67, 38, 155, 51, 36, 136, 1, 186, 73, 144, 28, 13, 193,
+			153, 145, 26, 64, 147, 108, 14, 190, 174, 1, 12, 231, 83, 173,
+			234, 238, 236, 238, 26, 118, 87, 181, 170, 170, 1, 98, 70, 146,
+			101, 57, 46, 228, 143, 187, 179, 206, 146, 237, 179, 173, 176, 194,
+			30, 159, 125, 113, 142, 187, 184, 184, 245, 110, 132, 127, 216, 225,
+			221, 149, 99, 127, 73, 107, 175, 28, 27, 14, 135, 63, 100, 107,
+			55, 228, 93, 135, 194, 138, 208, 143, 245, 122, 119, 29, 27, 47,
+			63, 170, 170, 27, 224, 199, 140, 61, 114, 108, 132, 230, 7, 7,
+			47, 43, 243, 229, 203, 151, 47, 95, 190, 124, 249, 242, 53, 254,
+			158, 134, 207, 180, 92, 183, 213, 97, 133, 158, 231, 6, 110, 173,
+			223, 44, 52, 250, 158, 21, 216, 174, 147, 231, 37, 100, 66, 124,
+			207, 171, 239, 185, 69, 156, 94, 145, 85, 200, 52, 30, 241, 89,
+			221, 117, 26, 254, 180, 70, 181, 25, 84, 81, 32, 153, 194, 73,
+			199, 114, 92, 127, 90, 167, 218, 76, 178, 34, 128, 165, 79, 225,
+			163, 117, 183, 155, 31, 66, 185, 116, 68, 33, 220, 132, 146, 77,
+			237, 245, 217, 150, 29, 180, 251, 181, 124, 221, 237, 22, 90, 110,
+			199, 114, 90, 17, 125, 189, 96, 191, 199, 252, 144, 204, 191, 209,
+			180, 95, 214, 209, 237, 205, 165, 255, 91, 63, 115, 91, 224, 221,
+			148, 120, 243, 247, 88, 167, 243, 178, 227, 238, 57, 219, 208, 164,
+			150, 226, 56, 174, 226, 255, 164, 225, 179, 195, 163, 14, 236, 46,
+			243, 3, 171, 219, 123, 216, 176, 111, 226, 204, 182, 170, 243, 190,
+			199, 253, 153, 195, 199, 61, 30, 98, 84, 3, 127, 246, 241, 3,
+			15, 41, 253, 0, 35, 255, 221, 103, 240, 213, 150, 155, 175, 183,
+			61, 183, 107, 247, 187, 121, 215, 107, 21, 58, 253, 186, 93, 240,
+			152, 223, 239, 4, 141, 154, 96, 72, 97, 247, 74, 161, 238, 118,
+			187, 161, 16, 100, 161, 82, 94, 85, 202, 239, 94, 49, 31, 199,
+			192, 220, 30, 30, 121, 197, 242, 108, 203, 9, 200, 53, 140, 26,
+			172, 57, 173, 81, 52, 51, 186, 144, 203, 15, 227, 202, 203, 122,
+			249, 21, 214, 44, 57, 129, 183, 95, 129, 234, 230, 115, 56, 173,
+			10, 72, 22, 163, 251, 108, 159, 203, 88, 166, 2, 127, 2, 159,
+			119, 173, 78, 159, 113, 249, 202, 84, 4, 176, 168, 63, 175, 229,
+			174, 97, 188, 21, 120, 182, 211, 218, 180, 108, 239, 73, 91, 230,
+			58, 120, 124, 217, 237, 118, 237, 96, 211, 245, 109, 16, 69, 66,
+			176, 209, 118, 253, 64, 54, 229, 127, 195, 188, 247, 60, 247, 109,
+			86, 15, 100, 107, 5, 66, 63, 30, 107, 78, 35, 94, 138, 60,
+			214, 36, 38, 78, 247, 36, 174, 105, 131, 47, 142, 16, 206, 253,
+			175, 26, 62, 58, 216, 93, 197, 114, 90, 140, 188, 128, 211, 204,
+			242, 58, 54, 147, 253, 142, 46, 208, 131, 236, 26, 106, 24, 182,
+			32, 207, 227, 84, 199, 10, 160, 173, 254, 132, 109, 101, 253, 220,
+			158, 16, 110, 65, 196, 115, 7, 136, 48, 135, 37, 55, 31, 10,
+			110, 172, 251, 133, 161, 238, 31, 213, 74, 214, 92, 186, 242, 122,
+			225, 201, 228, 241, 166, 224, 64, 175, 118, 247, 63, 159, 197, 41,
+			98, 140, 39, 90, 26, 254, 170, 129, 181, 49, 130, 198, 19, 100,
+			225, 55, 13, 186, 236, 246, 246, 61, 187, 213, 14, 232, 194, 229,
+			43, 55, 232, 118, 155, 209, 213, 157, 229, 50, 45, 246, 131, 182,
+			235, 249, 121, 140, 233, 170, 93, 103, 142, 207, 26, 180, 239, 52,
+			152, 71, 131, 54, 163, 197, 158, 85, 135, 154, 226, 203, 28, 125,
+			133, 121, 190, 237, 58, 116, 33, 127, 153, 206, 64, 133, 156, 252,
+			148, 187, 116, 19, 211, 125, 183, 79, 187, 214, 62, 117, 220, 128,
+			246, 125, 70, 131, 182, 237, 211, 166, 221, 97, 148, 61, 168, 179,
+			94, 64, 109, 135, 214, 221, 110, 175, 99, 91, 78, 157, 209, 61,
+			59, 104, 211, 32, 66, 159, 199, 244, 53, 137, 193, 173, 5, 150,
+			237, 80, 139, 214, 221, 222, 62, 117, 155, 241, 106, 212, 10, 48,
+			166, 252, 191, 118, 16, 244, 22, 11, 133, 189, 189, 189, 188, 197,
+			41, 21, 107, 86, 212, 243, 11, 171, 229, 229, 210, 250, 86, 105,
+			126, 33, 127, 25, 99, 186, 227, 116, 152, 239, 83, 143, 125, 178,
+			111, 123, 172, 65, 107, 251, 212, 234, 245, 58, 118, 221, 170, 117,
+			24, 237, 88, 123, 212, 245, 168, 213, 242, 24, 107, 208, 192, 5,
+			90, 247, 60, 59, 176, 157, 214, 28, 245, 221, 102, 176, 103, 121,
+			12, 211, 134, 237, 7, 158, 93, 235, 7, 3, 108, 82, 148, 217,
+			254, 64, 5, 215, 161, 150, 67, 115, 197, 45, 90, 222, 202, 209,
+			165, 226, 86, 121, 107, 14, 211, 123, 229, 237, 59, 27, 59, 219,
+			244, 94, 177, 82, 41, 174, 111, 151, 75, 91, 116, 163, 66, 151,
+			55, 214, 87, 202, 219, 229, 141, 245, 45, 186, 113, 139, 22, 215,
+			95, 163, 47, 151, 215, 87, 230, 40, 179, 131, 54, 243, 40, 123,
+			208, 243, 128, 122, 215, 163, 54, 48, 144, 53, 242, 152, 110, 49,
+			54, 208, 125, 211, 21, 179, 230, 247, 88, 221, 110, 218, 117, 10,
+			123, 67, 223, 106, 49, 218, 114, 119, 153, 231, 216, 78, 139, 246,
+			152, 215, 181, 125, 152, 68, 159, 90, 78, 3, 211, 142, 221, 181,
+			3, 190, 89, 248, 7, 71, 148, 199, 56, 141, 53, 157, 160, 108,
+			226, 36, 206, 96, 29, 37, 8, 34, 137, 75, 80, 152, 38, 104,
+			42, 113, 11, 10, 211, 163, 226, 207, 127, 143, 177, 110, 36, 136,
+			113, 57, 113, 93, 51, 255, 16, 211, 34, 189, 207, 246, 231, 185,
+			58, 161, 93, 171, 71, 27, 204, 175, 123, 118, 13, 168, 112, 29,
+			70, 119, 133, 98, 131, 217, 181, 40, 172, 12, 90, 183, 120, 143,
+			92, 56, 125, 171, 203, 162, 82, 90, 183, 28, 90, 3, 57, 98,
+			117, 206, 90, 219, 161, 13, 187, 217, 100, 30, 115, 2, 186, 103,
+			237, 251, 115, 124, 244, 236, 129, 213, 237, 117, 24, 117, 29, 28,
+			251, 190, 177, 53, 71, 111, 111, 238, 248, 115, 66, 228, 162, 15,
+			32, 140, 32, 158, 110, 79, 140, 223, 245, 168, 215, 119, 96, 15,
+			161, 205, 142, 213, 242, 243, 48, 8, 69, 103, 131, 53, 109, 135,
+			107, 6, 90, 183, 122, 65, 223, 99, 126, 124, 28, 188, 110, 68,
+			48, 239, 201, 138, 166, 226, 16, 44, 182, 79, 235, 86, 167, 3,
+			226, 6, 205, 66, 60, 152, 222, 238, 219, 13, 214, 177, 29, 230,
+			243, 81, 29, 210, 182, 193, 124, 187, 229, 44, 98, 58, 79, 183,
+			97, 149, 121, 253, 14, 163, 45, 104, 230, 211, 189, 182, 21, 0,
+			235, 125, 186, 182, 179, 181, 13, 108, 3, 217, 129, 241, 218, 14,
+			159, 220, 8, 79, 30, 22, 82, 145, 250, 182, 211, 226, 171, 180,
+			199, 234, 192, 93, 161, 78, 196, 204, 180, 236, 93, 230, 12, 80,
+			72, 109, 159, 50, 199, 237, 183, 218, 176, 78, 234, 174, 227, 219,
+			160, 47, 236, 0, 144, 245, 44, 31, 176, 209, 153, 158, 27, 48,
+			39, 176, 173, 78, 103, 31, 152, 121, 223, 238, 236, 95, 202, 211,
+			114, 147, 218, 28, 129, 221, 237, 185, 94, 0, 216, 2, 55, 154,
+			17, 219, 10, 24, 181, 234, 158, 235, 251, 128, 204, 162, 117, 230,
+			113, 77, 208, 176, 187, 204, 1, 169, 165, 51, 44, 223, 202, 211,
+			189, 54, 227, 235, 98, 143, 213, 56, 109, 62, 181, 188, 152, 120,
+			112, 238, 187, 30, 159, 111, 183, 207, 9, 243, 237, 128, 129, 244,
+			211, 158, 231, 214, 97, 41, 217, 190, 219, 225, 114, 127, 105, 14,
+			184, 194, 89, 227, 177, 144, 103, 22, 176, 144, 6, 192, 203, 112,
+			186, 131, 54, 3, 84, 195, 196, 88, 212, 1, 105, 109, 122, 110,
+			151, 19, 83, 245, 251, 118, 192, 252, 124, 111, 191, 115, 137, 115,
+			120, 3, 80, 239, 217, 160, 65, 45, 206, 33, 208, 46, 241, 53,
+			176, 103, 119, 58, 180, 109, 55, 24, 181, 104, 211, 178, 59, 125,
+			143, 113, 238, 59, 46, 180, 4, 49, 203, 227, 39, 153, 235, 245,
+			141, 39, 154, 239, 122, 27, 246, 50, 245, 57, 90, 165, 62, 11,
+			40, 243, 253, 112, 222, 0, 77, 224, 3, 99, 248, 184, 148, 92,
+			180, 109, 63, 112, 189, 125, 142, 236, 86, 180, 234, 230, 168, 221,
+			164, 183, 215, 169, 229, 181, 196, 116, 88, 93, 215, 105, 133, 99,
+			140, 186, 233, 89, 182, 231, 75, 166, 91, 141, 6, 136, 139, 5,
+			184, 28, 182, 39, 219, 75, 10, 69, 215, 118, 3, 8, 10, 194,
+			125, 96, 64, 22, 45, 167, 161, 200, 180, 3, 63, 34, 13, 211,
+			178, 67, 151, 229, 214, 57, 23, 39, 130, 211, 198, 87, 78, 173,
+			95, 191, 207, 130, 69, 142, 148, 111, 134, 162, 96, 142, 114, 17,
+			203, 213, 237, 156, 168, 101, 119, 26, 204, 27, 168, 198, 75, 84,
+			189, 142, 237, 244, 31, 204, 123, 172, 195, 171, 71, 2, 176, 24,
+			23, 12, 44, 183, 43, 127, 177, 80, 168, 251, 131, 155, 186, 2,
+			10, 190, 87, 47, 64, 123, 219, 105, 21, 120, 183, 53, 55, 40,
+			12, 73, 20, 198, 24, 35, 35, 161, 17, 116, 57, 61, 129, 127,
+			8, 27, 70, 66, 79, 16, 116, 77, 63, 99, 122, 116, 123, 96,
+			182, 21, 195, 228, 224, 243, 152, 190, 204, 246, 65, 235, 83, 62,
+			15, 62, 237, 246, 253, 0, 228, 101, 215, 234, 216, 13, 26, 25,
+			134, 146, 77, 97, 197, 57, 234, 139, 173, 198, 246, 48, 95, 240,
+			129, 103, 217, 78, 0, 86, 195, 24, 78, 2, 1, 41, 160, 224,
+			152, 130, 52, 130, 174, 29, 63, 169, 32, 68, 208, 181, 211, 79,
+			225, 87, 176, 110, 104, 196, 120, 62, 241, 81, 205, 188, 75, 139,
+			20, 54, 82, 167, 21, 147, 62, 16, 139, 60, 221, 222, 239, 217,
+			160, 22, 247, 193, 132, 104, 112, 253, 23, 88, 173, 150, 216, 135,
+			25, 163, 101, 103, 215, 173, 243, 117, 155, 15, 172, 150, 47, 216,
+			161, 105, 4, 61, 159, 38, 248, 101, 108, 24, 26, 176, 227, 166,
+			62, 105, 190, 68, 43, 172, 197, 30, 44, 210, 143, 191, 97, 205,
+			191, 243, 22, 252, 115, 121, 254, 70, 245, 173, 217, 153, 194, 80,
+			193, 165, 217, 167, 49, 93, 179, 30, 208, 14, 115, 90, 65, 123,
+			145, 62, 119, 77, 14, 77, 211, 19, 73, 192, 150, 86, 144, 70,
+			208, 205, 204, 152, 130, 16, 65, 55, 39, 178, 248, 44, 239, 86,
+			35, 232, 37, 253, 168, 73, 6, 48, 45, 92, 127, 46, 68, 165,
+			37, 161, 134, 66, 5, 52, 191, 148, 25, 87, 16, 34, 232, 165,
+			73, 130, 191, 164, 99, 221, 208, 137, 113, 43, 177, 169, 153, 159,
+			215, 233, 160, 77, 170, 246, 19, 185, 50, 156, 126, 151, 121, 192,
+			45, 170, 140, 103, 53, 229, 112, 64, 177, 3, 48, 63, 236, 144,
+			95, 24, 54, 55, 159, 90, 45, 203, 118, 252, 96, 14, 150, 191,
+			69, 61, 198, 155, 186, 222, 254, 69, 95, 181, 234, 184, 173, 60,
+			93, 115, 189, 200, 146, 128, 249, 0, 221, 229, 177, 102, 88, 13,
+			243, 122, 152, 150, 3, 106, 117, 124, 119, 136, 52, 192, 91, 240,
+			88, 19, 42, 215, 108, 135, 19, 32, 180, 105, 140, 188, 144, 106,
+			246, 192, 246, 3, 31, 83, 219, 153, 131, 61, 165, 231, 185, 187,
+			160, 12, 235, 174, 19, 176, 7, 176, 37, 194, 36, 235, 26, 65,
+			183, 210, 199, 241, 79, 106, 216, 48, 116, 152, 229, 178, 78, 204,
+			31, 214, 184, 212, 55, 221, 78, 199, 221, 3, 85, 210, 180, 89,
+			167, 225, 75, 173, 209, 220, 231, 91, 88, 16, 27, 39, 95, 0,
+			124, 40, 124, 123, 0, 59, 175, 109, 215, 185, 37, 138, 15, 227,
+			104, 141, 117, 220, 61, 133, 206, 102, 62, 181, 212, 126, 41, 70,
+			33, 167, 87, 231, 146, 82, 150, 211, 171, 115, 73, 41, 103, 142,
+			40, 8, 17, 84, 206, 78, 226, 81, 78, 186, 70, 208, 93, 185,
+			90, 116, 46, 21, 119, 195, 102, 32, 21, 119, 51, 89, 5, 33,
+			130, 238, 30, 157, 146, 205, 116, 130, 94, 214, 39, 229, 39, 61,
+			9, 144, 106, 6, 188, 121, 89, 202, 165, 174, 235, 136, 160, 151,
+			39, 178, 184, 202, 155, 33, 130, 54, 244, 99, 102, 133, 110, 63,
+			137, 200, 200, 77, 161, 227, 182, 66, 123, 18, 78, 119, 5, 121,
+			150, 131, 57, 197, 212, 170, 185, 187, 44, 28, 56, 74, 66, 15,
+			35, 10, 210, 8, 218, 72, 171, 17, 32, 232, 253, 232, 20, 254,
+			2, 200, 53, 34, 198, 189, 196, 91, 154, 249, 35, 58, 45, 82,
+			15, 52, 60, 200, 235, 144, 48, 128, 213, 181, 60, 84, 4, 42,
+			155, 90, 190, 223, 239, 42, 227, 188, 238, 49, 48, 179, 64, 181,
+			82, 117, 200, 130, 47, 226, 232, 148, 199, 116, 221, 13, 64, 101,
+			89, 1, 236, 74, 53, 55, 104, 71, 213, 64, 0, 68, 61, 142,
+			215, 7, 149, 207, 149, 219, 129, 145, 114, 13, 137, 65, 69, 138,
+			249, 175, 91, 157, 60, 166, 59, 74, 49, 9, 145, 223, 7, 169,
+			243, 228, 126, 229, 210, 79, 246, 153, 183, 15, 139, 171, 194, 119,
+			203, 149, 165, 252, 109, 22, 108, 51, 63, 16, 240, 157, 112, 135,
+			2, 153, 70, 26, 65, 247, 210, 167, 240, 18, 54, 12, 4, 34,
+			253, 154, 126, 198, 188, 206, 103, 170, 227, 238, 1, 177, 67, 220,
+			145, 163, 239, 244, 27, 225, 254, 205, 187, 150, 147, 129, 184, 42,
+			126, 77, 207, 42, 72, 35, 232, 181, 201, 147, 10, 66, 4, 189,
+			118, 250, 41, 188, 205, 123, 211, 8, 122, 83, 63, 109, 222, 166,
+			101, 137, 207, 117, 58, 251, 7, 102, 67, 45, 90, 75, 50, 43,
+			240, 236, 122, 208, 217, 231, 228, 193, 113, 195, 2, 121, 177, 213,
+			86, 128, 116, 45, 5, 104, 85, 255, 32, 206, 111, 78, 158, 80,
+			16, 34, 232, 77, 243, 20, 190, 143, 117, 195, 32, 134, 149, 104,
+			105, 102, 53, 46, 10, 161, 159, 196, 255, 135, 101, 179, 161, 17,
+			100, 165, 39, 241, 11, 216, 48, 12, 96, 115, 93, 191, 100, 22,
+			56, 155, 221, 78, 3, 216, 28, 246, 252, 24, 6, 27, 156, 193,
+			117, 253, 148, 130, 52, 130, 234, 167, 159, 86, 16, 34, 168, 126,
+			113, 6, 151, 121, 63, 26, 65, 77, 253, 162, 249, 194, 32, 131,
+			163, 49, 30, 194, 85, 160, 230, 32, 87, 13, 206, 213, 102, 216,
+			41, 112, 181, 121, 58, 167, 32, 68, 80, 243, 153, 11, 161, 31,
+			235, 95, 222, 196, 116, 216, 1, 37, 206, 94, 189, 192, 245, 30,
+			230, 194, 91, 195, 147, 183, 236, 14, 91, 9, 43, 110, 177, 128,
+			60, 143, 13, 56, 191, 75, 239, 212, 211, 7, 124, 22, 131, 45,
+			184, 159, 174, 194, 91, 228, 254, 157, 129, 143, 30, 242, 21, 28,
+			71, 96, 44, 43, 199, 17, 252, 205, 29, 71, 86, 253, 190, 213,
+			82, 110, 39, 5, 146, 51, 24, 55, 88, 143, 57, 13, 230, 212,
+			247, 167, 17, 69, 51, 153, 74, 172, 132, 60, 139, 39, 123, 253,
+			90, 199, 174, 87, 99, 213, 48, 69, 51, 201, 74, 86, 124, 88,
+			137, 42, 95, 196, 19, 123, 204, 186, 31, 175, 58, 202, 171, 142,
+			67, 113, 172, 226, 50, 30, 235, 50, 223, 183, 90, 172, 10, 94,
+			197, 105, 131, 143, 158, 30, 24, 253, 240, 200, 71, 101, 171, 237,
+			253, 30, 35, 69, 156, 97, 78, 191, 43, 48, 36, 31, 194, 191,
+			146, 211, 239, 14, 99, 73, 67, 51, 137, 98, 196, 103, 222, 174,
+			93, 103, 211, 41, 142, 224, 226, 1, 4, 91, 226, 251, 48, 14,
+			213, 142, 44, 227, 12, 123, 16, 136, 163, 211, 244, 8, 71, 242,
+			204, 1, 36, 183, 96, 199, 28, 70, 17, 181, 35, 207, 225, 17,
+			121, 66, 158, 78, 115, 231, 213, 233, 67, 80, 116, 216, 134, 168,
+			83, 81, 149, 73, 25, 103, 125, 183, 239, 213, 89, 181, 238, 54,
+			88, 213, 118, 154, 238, 116, 134, 35, 56, 123, 0, 193, 22, 175,
+			184, 236, 54, 88, 217, 105, 186, 149, 113, 127, 0, 38, 199, 113,
+			202, 223, 119, 2, 235, 193, 244, 24, 23, 28, 9, 229, 126, 51,
+			133, 39, 158, 68, 196, 110, 226, 36, 183, 11, 166, 245, 247, 195,
+			3, 209, 102, 144, 137, 169, 15, 200, 196, 34, 30, 117, 152, 31,
+			176, 134, 144, 8, 244, 132, 50, 133, 69, 163, 131, 34, 101, 124,
+			32, 145, 122, 21, 79, 132, 36, 85, 185, 50, 149, 178, 89, 120,
+			28, 37, 249, 146, 106, 199, 29, 161, 149, 241, 16, 15, 135, 201,
+			10, 198, 174, 195, 220, 102, 181, 193, 234, 157, 233, 244, 67, 184,
+			180, 1, 85, 134, 201, 203, 240, 134, 43, 172, 222, 33, 55, 34,
+			81, 27, 121, 136, 164, 172, 137, 69, 118, 64, 218, 118, 240, 56,
+			28, 4, 189, 93, 214, 144, 35, 203, 112, 34, 242, 143, 29, 89,
+			69, 54, 227, 3, 169, 28, 81, 88, 56, 72, 206, 227, 176, 160,
+			10, 218, 138, 171, 151, 76, 101, 76, 21, 174, 91, 93, 102, 190,
+			131, 199, 7, 217, 3, 142, 116, 63, 176, 60, 225, 169, 78, 86,
+			4, 0, 142, 112, 230, 52, 228, 245, 7, 98, 78, 131, 124, 44,
+			26, 48, 226, 3, 190, 112, 128, 220, 65, 204, 195, 227, 54, 63,
+			130, 143, 12, 12, 224, 73, 187, 206, 125, 10, 31, 59, 20, 53,
+			121, 21, 79, 245, 29, 219, 9, 152, 215, 243, 24, 72, 172, 160,
+			112, 250, 219, 35, 15, 145, 185, 157, 120, 109, 129, 165, 114, 116,
+			0, 133, 40, 156, 205, 164, 255, 114, 36, 251, 217, 207, 126, 246,
+			179, 122, 238, 159, 167, 240, 212, 97, 107, 230, 208, 229, 123, 28,
+			167, 156, 126, 183, 198, 60, 126, 135, 144, 172, 72, 136, 20, 113,
+			178, 99, 213, 88, 135, 223, 33, 140, 47, 60, 251, 68, 171, 50,
+			191, 10, 77, 42, 162, 37, 121, 9, 27, 82, 69, 107, 51, 227,
+			11, 179, 79, 134, 1, 150, 99, 133, 183, 35, 167, 112, 6, 254,
+			47, 100, 35, 197, 105, 78, 67, 1, 200, 5, 92, 115, 240, 101,
+			210, 96, 106, 107, 11, 97, 16, 172, 6, 107, 90, 253, 78, 80,
+			229, 199, 113, 46, 240, 153, 202, 152, 44, 124, 5, 202, 200, 89,
+			60, 202, 23, 71, 213, 118, 26, 236, 1, 215, 158, 201, 138, 88,
+			104, 101, 40, 129, 238, 223, 246, 93, 71, 137, 38, 96, 72, 67,
+			1, 239, 254, 35, 145, 112, 9, 197, 253, 212, 225, 195, 27, 150,
+			41, 114, 17, 79, 240, 26, 87, 229, 212, 91, 157, 233, 73, 170,
+			205, 164, 43, 227, 162, 120, 67, 150, 230, 254, 153, 142, 13, 96,
+			6, 153, 192, 163, 219, 175, 109, 150, 170, 43, 27, 59, 75, 171,
+			165, 172, 70, 198, 49, 230, 5, 183, 86, 55, 138, 219, 89, 61,
+			132, 203, 235, 219, 207, 93, 203, 162, 176, 193, 142, 40, 48, 226,
+			21, 174, 46, 100, 147, 36, 139, 199, 56, 124, 171, 252, 106, 105,
+			229, 185, 107, 217, 212, 96, 201, 213, 133, 236, 8, 57, 130, 51,
+			188, 100, 105, 99, 99, 53, 155, 14, 113, 110, 109, 87, 202, 235,
+			183, 179, 153, 16, 231, 237, 202, 198, 206, 102, 22, 135, 24, 214,
+			74, 91, 91, 197, 219, 165, 236, 104, 88, 99, 233, 181, 237, 210,
+			86, 118, 108, 128, 172, 171, 11, 217, 35, 97, 23, 165, 245, 157,
+			181, 236, 56, 153, 196, 71, 56, 184, 165, 136, 152, 24, 42, 122,
+			238, 90, 54, 27, 17, 34, 176, 76, 14, 20, 60, 119, 45, 75,
+			114, 203, 56, 201, 197, 144, 16, 60, 190, 90, 92, 42, 173, 86,
+			55, 54, 193, 253, 95, 92, 205, 106, 81, 89, 165, 244, 63, 237,
+			148, 43, 165, 149, 172, 30, 47, 219, 44, 21, 183, 75, 43, 89,
+			148, 171, 227, 169, 195, 20, 234, 161, 75, 40, 38, 11, 250, 67,
+			100, 129, 227, 26, 150, 133, 220, 183, 116, 124, 244, 144, 77, 229,
+			208, 78, 62, 26, 93, 31, 130, 166, 184, 116, 160, 11, 64, 196,
+			37, 123, 8, 155, 188, 105, 140, 155, 26, 232, 33, 166, 6, 160,
+			56, 32, 176, 111, 29, 80, 254, 98, 127, 124, 238, 208, 230, 67,
+			157, 243, 178, 247, 183, 9, 36, 15, 217, 4, 110, 226, 201, 3,
+			136, 158, 88, 25, 255, 136, 134, 167, 31, 198, 156, 199, 168, 68,
+			125, 64, 37, 222, 28, 230, 224, 185, 67, 89, 192, 251, 57, 48,
+			215, 191, 166, 225, 227, 135, 155, 148, 135, 210, 240, 18, 78, 117,
+			89, 208, 118, 27, 114, 190, 15, 238, 93, 107, 252, 243, 16, 174,
+			138, 108, 21, 223, 237, 209, 67, 118, 123, 73, 205, 1, 74, 127,
+			76, 199, 199, 14, 69, 126, 40, 161, 79, 97, 108, 59, 189, 126,
+			32, 76, 39, 96, 88, 166, 146, 225, 37, 92, 121, 129, 150, 237,
+			7, 225, 119, 196, 191, 99, 81, 196, 43, 60, 31, 17, 106, 112,
+			66, 207, 60, 100, 164, 195, 116, 146, 203, 56, 91, 239, 216, 204,
+			9, 170, 126, 224, 49, 171, 107, 59, 45, 190, 213, 164, 23, 147,
+			77, 171, 227, 179, 202, 132, 248, 188, 165, 190, 66, 11, 46, 64,
+			94, 172, 69, 106, 160, 133, 248, 28, 182, 200, 253, 239, 25, 60,
+			26, 51, 192, 201, 57, 60, 246, 182, 181, 107, 85, 229, 41, 74,
+			114, 98, 20, 202, 54, 229, 193, 234, 50, 158, 2, 176, 234, 246,
+			3, 230, 85, 235, 29, 203, 247, 129, 81, 220, 190, 207, 84, 8,
+			124, 219, 128, 79, 203, 234, 11, 185, 142, 143, 66, 105, 181, 219,
+			239, 4, 118, 175, 195, 170, 112, 204, 243, 167, 113, 156, 178, 73,
+			168, 177, 38, 43, 0, 69, 62, 89, 193, 79, 65, 97, 181, 197,
+			28, 230, 89, 1, 171, 178, 79, 246, 173, 142, 95, 181, 156, 70,
+			181, 109, 249, 237, 233, 41, 64, 176, 164, 79, 107, 149, 147, 80,
+			241, 182, 172, 87, 226, 213, 138, 78, 227, 142, 229, 183, 201, 34,
+			62, 14, 31, 129, 35, 182, 211, 170, 214, 219, 172, 126, 191, 218,
+			15, 154, 207, 79, 159, 138, 247, 207, 41, 20, 110, 236, 101, 168,
+			178, 19, 52, 159, 39, 91, 120, 12, 230, 174, 107, 191, 195, 170,
+			77, 215, 227, 123, 232, 248, 194, 165, 71, 29, 97, 242, 27, 178,
+			193, 154, 219, 96, 139, 201, 173, 205, 82, 105, 165, 50, 170, 176,
+			220, 114, 61, 242, 20, 198, 45, 55, 100, 240, 40, 231, 90, 166,
+			229, 42, 246, 94, 199, 71, 235, 117, 49, 102, 187, 94, 149, 135,
+			49, 127, 58, 59, 192, 172, 122, 157, 15, 214, 174, 75, 25, 247,
+			201, 13, 124, 44, 98, 86, 188, 225, 100, 188, 225, 209, 144, 79,
+			177, 166, 215, 241, 209, 222, 254, 193, 134, 100, 160, 199, 222, 254,
+			112, 179, 143, 224, 169, 94, 187, 119, 176, 221, 108, 188, 29, 233,
+			181, 123, 195, 13, 159, 225, 39, 115, 143, 213, 173, 128, 53, 166,
+			79, 196, 171, 199, 62, 144, 60, 206, 214, 235, 85, 230, 192, 29,
+			123, 213, 242, 152, 99, 249, 211, 103, 121, 101, 35, 240, 250, 172,
+			50, 94, 175, 151, 248, 199, 34, 255, 70, 102, 241, 164, 91, 123,
+			187, 46, 36, 178, 218, 243, 88, 211, 126, 48, 253, 52, 103, 239,
+			4, 124, 224, 242, 184, 201, 139, 201, 37, 156, 173, 251, 109, 203,
+			235, 113, 149, 236, 247, 172, 58, 155, 126, 70, 84, 21, 229, 235,
+			170, 24, 86, 132, 191, 103, 55, 3, 133, 241, 34, 175, 54, 202,
+			203, 36, 182, 25, 156, 5, 78, 12, 116, 60, 195, 171, 141, 247,
+			218, 189, 120, 191, 231, 241, 145, 94, 59, 222, 233, 37, 94, 109,
+			172, 215, 142, 245, 120, 13, 31, 135, 74, 93, 22, 88, 13, 43,
+			176, 98, 181, 231, 120, 109, 96, 251, 154, 252, 56, 64, 167, 215,
+			175, 237, 135, 130, 53, 207, 235, 142, 66, 153, 18, 173, 15, 205,
+			56, 207, 45, 226, 177, 184, 220, 147, 12, 22, 146, 159, 213, 192,
+			8, 90, 222, 88, 41, 85, 183, 202, 175, 151, 178, 58, 152, 81,
+			171, 229, 237, 82, 181, 178, 179, 190, 93, 94, 43, 101, 81, 204,
+			176, 191, 107, 164, 47, 100, 47, 230, 126, 95, 199, 227, 131, 39,
+			53, 242, 2, 62, 161, 220, 42, 62, 11, 170, 123, 182, 199, 23,
+			100, 215, 18, 155, 99, 40, 63, 83, 178, 214, 22, 11, 238, 217,
+			30, 187, 229, 122, 93, 43, 32, 171, 248, 172, 227, 86, 253, 192,
+			114, 26, 150, 215, 168, 70, 14, 173, 170, 85, 135, 251, 92, 215,
+			155, 214, 227, 88, 78, 59, 238, 150, 172, 28, 237, 16, 69, 89,
+			117, 72, 124, 209, 195, 196, 247, 20, 206, 116, 173, 94, 149, 65,
+			156, 21, 183, 207, 211, 149, 116, 215, 234, 241, 64, 172, 239, 203,
+			49, 233, 174, 145, 78, 103, 51, 119, 141, 116, 38, 139, 115, 127,
+			129, 240, 88, 220, 94, 39, 69, 156, 172, 243, 29, 11, 248, 55,
+			190, 112, 254, 145, 214, 125, 126, 25, 182, 178, 197, 148, 48, 142,
+			43, 162, 37, 152, 17, 32, 108, 12, 182, 112, 48, 240, 37, 68,
+			110, 227, 212, 219, 62, 212, 224, 123, 207, 248, 194, 211, 143, 198,
+			125, 119, 139, 35, 207, 220, 221, 170, 174, 111, 84, 214, 138, 171,
+			21, 217, 156, 156, 196, 70, 199, 122, 103, 127, 112, 211, 227, 69,
+			79, 58, 9, 39, 177, 1, 14, 186, 193, 173, 134, 23, 125, 136,
+			139, 161, 128, 147, 156, 95, 4, 99, 201, 177, 108, 130, 164, 177,
+			177, 188, 81, 89, 201, 106, 176, 2, 68, 105, 117, 179, 92, 90,
+			46, 101, 245, 220, 117, 156, 18, 76, 128, 197, 18, 178, 33, 155,
+			144, 160, 196, 161, 169, 175, 59, 107, 75, 165, 74, 86, 31, 156,
+			106, 35, 155, 204, 249, 120, 44, 110, 135, 127, 95, 164, 44, 247,
+			59, 26, 30, 141, 217, 213, 112, 236, 180, 224, 62, 173, 106, 117,
+			108, 203, 151, 162, 129, 121, 81, 17, 74, 158, 116, 234, 190, 31,
+			196, 223, 53, 210, 201, 108, 42, 247, 139, 26, 206, 14, 27, 182,
+			67, 100, 106, 255, 152, 100, 230, 190, 172, 225, 113, 185, 125, 30,
+			78, 222, 185, 127, 84, 242, 190, 169, 227, 35, 3, 54, 236, 147,
+			82, 247, 73, 60, 105, 55, 88, 151, 199, 251, 212, 247, 171, 29,
+			182, 203, 58, 211, 57, 174, 52, 10, 143, 182, 146, 243, 229, 168,
+			221, 42, 52, 91, 60, 90, 94, 41, 173, 109, 110, 108, 151, 214,
+			151, 95, 171, 238, 172, 191, 188, 190, 113, 111, 189, 146, 141, 161,
+			231, 213, 62, 60, 134, 228, 54, 113, 118, 152, 40, 114, 2, 31,
+			70, 86, 54, 65, 142, 226, 137, 245, 141, 234, 86, 121, 165, 84,
+			45, 221, 186, 85, 90, 222, 222, 18, 126, 143, 176, 246, 246, 192,
+			2, 207, 253, 60, 194, 71, 15, 161, 132, 20, 229, 137, 69, 28,
+			162, 230, 159, 132, 250, 60, 216, 12, 155, 150, 23, 200, 3, 206,
+			37, 156, 13, 111, 168, 61, 233, 79, 66, 220, 159, 52, 17, 149,
+			243, 165, 65, 230, 48, 17, 151, 123, 187, 224, 146, 87, 206, 39,
+			56, 214, 24, 149, 172, 250, 82, 118, 130, 176, 182, 195, 90, 214,
+			80, 109, 80, 230, 168, 146, 85, 95, 194, 218, 231, 240, 88, 195,
+			237, 131, 173, 39, 176, 194, 222, 161, 85, 70, 69, 89, 88, 69,
+			90, 241, 145, 215, 107, 172, 50, 42, 202, 68, 149, 139, 120, 194,
+			106, 181, 60, 64, 174, 16, 137, 115, 201, 120, 88, 204, 43, 154,
+			119, 113, 90, 241, 1, 28, 97, 192, 137, 106, 79, 28, 182, 117,
+			112, 132, 57, 234, 227, 57, 60, 102, 251, 213, 208, 99, 61, 173,
+			83, 125, 38, 93, 25, 181, 253, 208, 1, 154, 251, 53, 29, 143,
+			15, 94, 66, 144, 21, 156, 238, 200, 216, 9, 121, 3, 54, 243,
+			152, 123, 139, 252, 170, 172, 95, 9, 91, 154, 95, 215, 112, 90,
+			21, 147, 227, 216, 232, 89, 65, 155, 163, 75, 46, 233, 89, 173,
+			194, 97, 40, 247, 123, 150, 51, 173, 71, 229, 0, 131, 141, 219,
+			97, 22, 132, 72, 85, 225, 98, 150, 57, 129, 47, 195, 168, 39,
+			100, 249, 178, 44, 134, 187, 48, 8, 203, 233, 12, 212, 53, 120,
+			221, 172, 250, 16, 86, 94, 196, 39, 21, 222, 6, 11, 32, 116,
+			182, 17, 53, 130, 43, 142, 76, 229, 132, 172, 176, 34, 191, 171,
+			182, 185, 223, 215, 240, 164, 58, 166, 53, 66, 102, 173, 97, 108,
+			57, 142, 27, 196, 217, 117, 80, 148, 15, 180, 203, 23, 195, 70,
+			149, 24, 2, 179, 139, 113, 244, 229, 161, 108, 59, 139, 71, 229,
+			13, 19, 156, 70, 229, 193, 30, 139, 162, 91, 118, 135, 187, 95,
+			106, 172, 101, 59, 210, 111, 44, 0, 229, 126, 49, 66, 247, 203,
+			210, 79, 106, 135, 63, 66, 200, 14, 121, 23, 252, 59, 218, 235,
+			47, 61, 234, 25, 2, 52, 172, 207, 183, 152, 51, 223, 114, 99,
+			183, 174, 55, 163, 63, 99, 47, 19, 204, 225, 151, 9, 21, 214,
+			236, 176, 58, 12, 25, 255, 234, 201, 240, 29, 134, 213, 179, 11,
+			252, 38, 170, 90, 99, 109, 107, 215, 14, 47, 113, 177, 36, 215,
+			234, 217, 230, 99, 175, 124, 103, 223, 197, 71, 184, 245, 184, 36,
+			145, 144, 51, 216, 188, 85, 46, 173, 174, 84, 151, 74, 119, 138,
+			175, 148, 55, 42, 213, 157, 245, 173, 205, 210, 114, 249, 86, 185,
+			180, 146, 77, 144, 49, 156, 142, 57, 37, 199, 112, 58, 230, 142,
+			156, 192, 163, 27, 59, 219, 155, 59, 219, 213, 141, 245, 213, 215,
+			178, 136, 235, 189, 245, 16, 54, 192, 206, 41, 175, 173, 237, 108,
+			23, 193, 29, 156, 92, 252, 4, 30, 31, 28, 2, 121, 180, 47,
+			122, 250, 203, 112, 133, 52, 190, 112, 82, 213, 178, 122, 118, 126,
+			128, 252, 202, 145, 102, 28, 92, 234, 225, 241, 216, 12, 90, 61,
+			123, 137, 12, 212, 87, 175, 72, 138, 178, 134, 152, 58, 30, 49,
+			222, 98, 14, 103, 81, 65, 124, 178, 122, 182, 207, 153, 30, 201,
+			163, 127, 51, 246, 247, 47, 235, 198, 237, 226, 102, 249, 238, 127,
+			57, 6, 193, 246, 19, 137, 117, 13, 255, 182, 8, 182, 159, 72,
+			144, 133, 127, 114, 32, 216, 94, 204, 50, 93, 93, 93, 254, 31,
+			50, 204, 254, 7, 81, 246, 31, 126, 148, 189, 12, 179, 159, 76,
+			28, 85, 97, 246, 71, 19, 179, 50, 204, 254, 88, 226, 85, 21,
+			102, 15, 127, 138, 194, 227, 137, 28, 47, 196, 226, 79, 81, 120,
+			34, 113, 149, 23, 202, 63, 69, 225, 116, 226, 34, 47, 212, 196,
+			159, 162, 240, 164, 108, 254, 180, 250, 83, 27, 33, 198, 169, 196,
+			37, 13, 255, 17, 194, 250, 72, 130, 160, 25, 125, 209, 252, 58,
+			162, 69, 8, 219, 183, 91, 50, 128, 15, 98, 146, 163, 129, 243,
+			245, 71, 213, 130, 166, 51, 106, 210, 231, 168, 112, 157, 242, 120,
+			151, 57, 202, 130, 122, 254, 18, 196, 246, 81, 181, 210, 169, 60,
+			225, 251, 176, 30, 74, 34, 122, 216, 95, 228, 226, 6, 19, 239,
+			180, 68, 220, 236, 139, 244, 10, 125, 99, 38, 90, 208, 249, 65,
+			13, 114, 137, 190, 72, 149, 70, 122, 235, 38, 52, 222, 10, 32,
+			126, 220, 231, 255, 62, 65, 227, 152, 2, 19, 237, 135, 21, 146,
+			122, 121, 71, 131, 160, 67, 95, 164, 87, 160, 14, 125, 28, 214,
+			242, 250, 163, 145, 134, 239, 124, 32, 222, 30, 124, 32, 16, 16,
+			244, 196, 216, 99, 52, 207, 137, 6, 244, 49, 228, 40, 37, 252,
+			214, 77, 30, 70, 57, 146, 208, 9, 58, 53, 114, 94, 252, 109,
+			192, 68, 203, 242, 20, 65, 51, 163, 178, 92, 35, 104, 230, 233,
+			5, 241, 55, 34, 104, 230, 250, 13, 252, 31, 117, 172, 39, 19,
+			196, 184, 146, 88, 215, 204, 63, 209, 105, 17, 98, 75, 27, 118,
+			221, 10, 92, 79, 233, 14, 213, 113, 252, 233, 0, 39, 137, 206,
+			196, 158, 103, 204, 201, 192, 39, 241, 9, 83, 59, 166, 47, 108,
+			135, 191, 208, 129, 144, 254, 57, 234, 122, 18, 135, 229, 43, 145,
+			170, 245, 3, 106, 183, 28, 23, 94, 240, 88, 62, 229, 62, 124,
+			8, 178, 231, 145, 241, 179, 179, 13, 151, 249, 160, 24, 103, 103,
+			101, 8, 249, 32, 89, 74, 6, 235, 110, 135, 214, 250, 240, 12,
+			196, 167, 118, 224, 179, 78, 243, 38, 188, 75, 0, 121, 197, 180,
+			193, 28, 55, 96, 254, 96, 75, 136, 25, 132, 183, 77, 86, 179,
+			201, 234, 1, 109, 187, 123, 180, 184, 89, 166, 129, 235, 130, 93,
+			69, 219, 150, 211, 232, 200, 54, 124, 84, 32, 217, 16, 130, 184,
+			40, 222, 103, 64, 220, 6, 157, 157, 237, 90, 251, 179, 179, 212,
+			99, 117, 102, 239, 50, 30, 239, 46, 35, 177, 101, 164, 89, 179,
+			15, 239, 74, 68, 208, 90, 18, 34, 72, 175, 36, 9, 254, 40,
+			54, 146, 60, 198, 251, 170, 126, 206, 92, 160, 203, 174, 179, 11,
+			22, 61, 220, 191, 82, 121, 89, 204, 149, 18, 244, 225, 231, 233,
+			138, 59, 176, 51, 136, 184, 53, 64, 160, 17, 116, 85, 63, 173,
+			32, 157, 160, 171, 103, 41, 254, 37, 141, 99, 135, 248, 105, 125,
+			194, 252, 162, 70, 183, 228, 234, 230, 33, 216, 138, 21, 114, 170,
+			248, 60, 200, 171, 223, 60, 166, 247, 218, 118, 135, 81, 171, 211,
+			9, 99, 110, 15, 97, 47, 132, 183, 169, 54, 48, 241, 182, 207,
+			183, 158, 90, 168, 64, 89, 3, 11, 242, 187, 189, 182, 229, 195,
+			211, 144, 38, 60, 204, 242, 220, 158, 7, 207, 65, 66, 250, 33,
+			232, 237, 121, 61, 132, 116, 130, 158, 63, 50, 142, 127, 71, 208,
+			175, 19, 244, 162, 62, 97, 254, 255, 26, 93, 57, 72, 178, 18,
+			46, 37, 38, 82, 108, 153, 12, 192, 11, 231, 12, 38, 168, 239,
+			7, 179, 179, 16, 240, 41, 99, 144, 57, 2, 56, 83, 40, 17,
+			151, 210, 57, 135, 65, 177, 135, 143, 53, 224, 17, 139, 75, 125,
+			87, 188, 228, 168, 91, 240, 252, 205, 114, 40, 243, 60, 80, 140,
+			125, 191, 207, 217, 249, 137, 242, 250, 43, 197, 213, 242, 74, 181,
+			88, 185, 189, 179, 86, 90, 223, 254, 196, 165, 112, 120, 16, 193,
+			251, 98, 56, 60, 62, 160, 35, 227, 248, 191, 137, 225, 33, 130,
+			150, 117, 98, 254, 245, 161, 195, 139, 41, 219, 199, 142, 208, 246,
+			163, 129, 241, 165, 230, 247, 92, 199, 103, 254, 156, 88, 88, 60,
+			78, 17, 228, 57, 104, 51, 172, 154, 128, 129, 32, 71, 77, 213,
+			250, 106, 67, 37, 254, 2, 16, 46, 55, 152, 71, 5, 231, 228,
+			210, 132, 181, 196, 31, 151, 241, 82, 168, 63, 75, 131, 182, 231,
+			238, 69, 60, 177, 96, 8, 209, 59, 163, 144, 194, 139, 190, 124,
+			189, 82, 103, 17, 111, 32, 74, 118, 89, 63, 162, 32, 157, 160,
+			229, 236, 36, 254, 69, 193, 27, 131, 160, 59, 250, 164, 249, 133,
+			67, 121, 99, 59, 31, 156, 53, 74, 11, 193, 60, 115, 126, 212,
+			93, 79, 48, 172, 17, 198, 153, 67, 43, 88, 109, 50, 98, 148,
+			51, 85, 204, 71, 72, 60, 196, 158, 222, 209, 199, 20, 164, 19,
+			116, 103, 34, 139, 223, 19, 196, 39, 9, 90, 211, 179, 230, 79,
+			31, 78, 124, 183, 219, 15, 192, 110, 122, 44, 237, 106, 69, 49,
+			24, 107, 157, 13, 206, 25, 60, 210, 242, 24, 236, 137, 22, 134,
+			25, 231, 167, 35, 49, 225, 202, 140, 172, 49, 169, 46, 249, 72,
+			61, 102, 53, 3, 230, 133, 35, 72, 106, 64, 229, 168, 130, 116,
+			130, 214, 198, 39, 194, 112, 211, 255, 231, 13, 252, 252, 19, 62,
+			155, 230, 79, 89, 196, 164, 63, 246, 237, 244, 195, 14, 61, 230,
+			99, 222, 228, 155, 103, 135, 191, 7, 106, 195, 149, 21, 62, 200,
+			35, 239, 220, 23, 147, 24, 71, 209, 197, 196, 140, 95, 50, 47,
+			165, 190, 89, 68, 223, 44, 38, 165, 47, 230, 52, 30, 225, 3,
+			181, 133, 79, 61, 179, 196, 191, 165, 160, 172, 220, 32, 231, 113,
+			70, 116, 85, 181, 27, 252, 84, 202, 155, 39, 191, 89, 212, 43,
+			105, 241, 161, 220, 32, 207, 227, 17, 249, 104, 71, 222, 55, 159,
+			124, 232, 195, 112, 129, 93, 85, 39, 103, 33, 226, 72, 60, 227,
+			19, 46, 119, 241, 61, 44, 36, 55, 112, 10, 12, 164, 190, 47,
+			29, 251, 167, 15, 98, 134, 113, 130, 41, 213, 247, 37, 233, 162,
+			1, 185, 128, 199, 252, 126, 183, 107, 121, 251, 213, 118, 208, 237,
+			76, 143, 68, 163, 27, 149, 31, 238, 4, 221, 14, 121, 9, 99,
+			30, 243, 80, 5, 206, 79, 167, 31, 247, 222, 89, 96, 200, 240,
+			38, 96, 28, 145, 69, 156, 86, 115, 42, 227, 69, 79, 30, 104,
+			173, 76, 51, 57, 60, 85, 159, 92, 199, 6, 60, 11, 226, 81,
+			122, 163, 135, 13, 46, 122, 229, 36, 154, 242, 234, 100, 25, 31,
+			129, 249, 169, 134, 254, 158, 81, 121, 205, 127, 40, 115, 66, 47,
+			207, 88, 16, 131, 128, 63, 114, 26, 196, 213, 246, 88, 140, 63,
+			242, 3, 191, 205, 190, 35, 59, 83, 151, 131, 211, 71, 30, 213,
+			153, 186, 37, 20, 228, 142, 5, 177, 162, 92, 9, 143, 197, 233,
+			1, 39, 24, 28, 4, 171, 210, 157, 8, 190, 159, 52, 20, 128,
+			151, 12, 2, 35, 224, 77, 169, 116, 133, 240, 191, 239, 26, 105,
+			45, 171, 231, 62, 175, 225, 177, 120, 87, 112, 43, 228, 238, 57,
+			204, 131, 12, 14, 224, 11, 146, 16, 204, 76, 200, 33, 253, 137,
+			56, 20, 214, 39, 231, 241, 145, 90, 159, 187, 166, 122, 174, 195,
+			156, 64, 250, 177, 198, 106, 253, 214, 178, 42, 203, 125, 78, 199,
+			19, 64, 73, 233, 129, 203, 29, 69, 208, 240, 81, 107, 237, 196,
+			208, 90, 11, 151, 217, 213, 104, 5, 161, 199, 172, 160, 104, 241,
+			204, 227, 113, 22, 117, 12, 72, 141, 129, 62, 143, 196, 190, 150,
+			249, 229, 54, 123, 208, 235, 88, 226, 112, 36, 214, 68, 50, 90,
+			19, 19, 177, 143, 124, 93, 12, 203, 71, 234, 112, 249, 200, 125,
+			69, 199, 71, 128, 9, 183, 58, 214, 125, 120, 4, 236, 199, 135,
+			169, 61, 108, 152, 250, 19, 15, 243, 220, 16, 29, 192, 160, 204,
+			160, 136, 62, 133, 49, 60, 218, 101, 85, 112, 214, 113, 29, 164,
+			87, 50, 188, 164, 98, 5, 12, 220, 146, 209, 187, 178, 106, 221,
+			237, 59, 1, 215, 54, 201, 202, 68, 84, 190, 12, 197, 228, 26,
+			62, 14, 237, 246, 171, 7, 26, 164, 120, 131, 41, 254, 53, 122,
+			213, 39, 90, 241, 254, 237, 158, 172, 57, 194, 107, 102, 154, 29,
+			187, 199, 63, 207, 190, 42, 148, 177, 80, 82, 228, 56, 38, 91,
+			219, 197, 237, 157, 173, 33, 255, 85, 26, 27, 155, 197, 45, 112,
+			202, 167, 177, 113, 171, 88, 94, 205, 234, 112, 169, 189, 92, 41,
+			110, 221, 201, 34, 248, 179, 184, 180, 81, 217, 206, 26, 112, 177,
+			183, 245, 114, 121, 51, 155, 252, 32, 57, 23, 126, 101, 13, 143,
+			144, 228, 120, 226, 199, 245, 31, 36, 93, 248, 65, 210, 133, 15,
+			47, 233, 2, 56, 134, 52, 130, 142, 38, 102, 120, 169, 78, 208,
+			148, 44, 69, 224, 25, 122, 49, 116, 2, 133, 89, 25, 78, 36,
+			110, 225, 255, 170, 139, 172, 12, 231, 18, 159, 214, 204, 191, 230,
+			15, 239, 66, 171, 219, 162, 205, 190, 83, 151, 71, 201, 48, 119,
+			65, 30, 211, 141, 102, 192, 156, 232, 161, 99, 148, 214, 192, 246,
+			163, 71, 246, 42, 18, 140, 194, 54, 207, 7, 70, 219, 150, 31,
+			21, 139, 141, 204, 159, 195, 67, 136, 248, 91, 230, 193, 170, 97,
+			7, 190, 60, 87, 5, 3, 41, 32, 68, 139, 71, 230, 128, 144,
+			202, 203, 199, 116, 70, 166, 123, 152, 11, 211, 59, 240, 92, 14,
+			220, 15, 5, 71, 10, 97, 74, 75, 15, 84, 120, 160, 145, 243,
+			183, 31, 189, 48, 183, 97, 182, 203, 1, 245, 219, 110, 191, 211,
+			128, 35, 161, 181, 107, 217, 29, 176, 199, 213, 195, 48, 133, 132,
+			91, 227, 172, 91, 99, 13, 95, 158, 112, 197, 7, 249, 250, 12,
+			14, 242, 231, 210, 4, 127, 85, 151, 175, 181, 141, 89, 125, 222,
+			48, 255, 169, 78, 151, 197, 144, 248, 75, 231, 192, 133, 55, 169,
+			32, 10, 174, 64, 18, 123, 114, 47, 159, 154, 63, 244, 181, 27,
+			166, 149, 205, 229, 60, 166, 34, 86, 102, 17, 211, 92, 164, 109,
+			253, 194, 187, 229, 245, 87, 54, 150, 139, 224, 247, 172, 150, 87,
+			62, 205, 95, 148, 251, 133, 119, 119, 42, 171, 213, 210, 214, 114,
+			113, 179, 180, 82, 221, 46, 109, 109, 243, 111, 114, 210, 10, 239,
+			86, 74, 91, 59, 171, 188, 44, 151, 199, 116, 175, 205, 60, 70,
+			15, 105, 65, 37, 161, 85, 187, 65, 153, 95, 183, 122, 50, 251,
+			2, 14, 95, 187, 199, 252, 236, 189, 251, 173, 130, 195, 130, 66,
+			223, 235, 20, 206, 111, 90, 65, 187, 196, 91, 112, 23, 43, 127,
+			67, 172, 218, 88, 118, 47, 223, 96, 187, 133, 43, 11, 11, 48,
+			99, 27, 241, 19, 174, 122, 86, 158, 36, 104, 86, 190, 127, 21,
+			206, 141, 89, 249, 218, 54, 193, 159, 225, 205, 102, 39, 241, 17,
+			1, 165, 137, 49, 75, 230, 17, 158, 192, 105, 14, 126, 57, 157,
+			32, 232, 89, 99, 62, 86, 160, 17, 52, 103, 60, 139, 127, 41,
+			201, 103, 72, 35, 168, 168, 23, 205, 47, 38, 41, 236, 50, 212,
+			110, 192, 219, 231, 190, 99, 127, 178, 175, 30, 130, 194, 117, 170,
+			82, 145, 48, 124, 144, 8, 75, 40, 119, 249, 124, 52, 143, 163,
+			231, 231, 111, 44, 46, 246, 60, 219, 9, 22, 23, 223, 122, 235,
+			221, 43, 115, 11, 215, 159, 251, 244, 211, 24, 211, 114, 147, 6,
+			123, 174, 204, 95, 209, 182, 118, 33, 251, 131, 56, 116, 40, 9,
+			132, 179, 112, 211, 126, 32, 5, 204, 105, 248, 156, 183, 212, 194,
+			212, 113, 157, 121, 171, 211, 107, 91, 242, 65, 47, 156, 223, 60,
+			171, 30, 48, 15, 156, 44, 108, 63, 204, 203, 1, 126, 178, 208,
+			129, 97, 209, 150, 231, 246, 123, 249, 152, 211, 149, 206, 211, 156,
+			85, 168, 21, 234, 60, 123, 1, 255, 179, 17, 253, 201, 22, 31,
+			196, 129, 125, 5, 52, 115, 114, 29, 241, 108, 3, 202, 241, 21,
+			190, 193, 230, 189, 8, 228, 197, 78, 135, 218, 1, 235, 250, 180,
+			198, 58, 144, 15, 34, 112, 169, 235, 48, 65, 8, 173, 49, 225,
+			43, 145, 172, 148, 163, 151, 163, 206, 89, 5, 222, 223, 61, 241,
+			76, 155, 51, 129, 55, 155, 147, 71, 95, 207, 15, 232, 53, 112,
+			30, 117, 65, 197, 244, 107, 243, 143, 64, 10, 187, 87, 132, 183,
+			246, 80, 204, 225, 176, 185, 66, 11, 199, 253, 168, 94, 0, 245,
+			161, 212, 3, 203, 114, 234, 240, 46, 213, 23, 95, 235, 182, 67,
+			119, 202, 121, 44, 4, 38, 84, 65, 225, 45, 191, 154, 126, 56,
+			241, 83, 203, 217, 167, 34, 13, 200, 158, 21, 137, 63, 188, 26,
+			47, 134, 226, 15, 190, 177, 162, 124, 53, 158, 224, 15, 66, 139,
+			71, 167, 20, 148, 38, 168, 120, 236, 99, 82, 216, 53, 33, 253,
+			197, 19, 47, 225, 54, 151, 117, 157, 24, 37, 253, 142, 97, 190,
+			78, 203, 74, 178, 125, 149, 144, 71, 250, 103, 108, 39, 116, 230,
+			70, 106, 133, 179, 71, 210, 25, 151, 117, 145, 84, 225, 205, 249,
+			106, 30, 68, 253, 234, 2, 72, 186, 32, 68, 79, 18, 84, 10,
+			73, 6, 127, 87, 41, 67, 20, 132, 8, 42, 29, 59, 46, 87,
+			172, 158, 38, 70, 233, 196, 29, 181, 98, 117, 65, 243, 45, 227,
+			217, 88, 129, 70, 208, 109, 99, 22, 239, 241, 65, 32, 98, 172,
+			234, 107, 11, 166, 77, 213, 125, 173, 188, 173, 112, 157, 216, 206,
+			188, 7, 87, 94, 77, 200, 130, 224, 72, 31, 23, 103, 244, 28,
+			166, 50, 141, 75, 88, 83, 37, 2, 145, 153, 62, 100, 202, 128,
+			104, 47, 10, 167, 1, 165, 8, 90, 213, 51, 10, 210, 8, 90,
+			197, 147, 10, 66, 4, 173, 78, 169, 164, 24, 40, 77, 208, 90,
+			234, 138, 28, 1, 18, 67, 90, 75, 23, 240, 123, 136, 15, 193,
+			32, 232, 21, 253, 99, 230, 79, 35, 122, 79, 230, 182, 1, 145,
+			138, 121, 200, 194, 61, 88, 100, 185, 145, 25, 132, 212, 185, 30,
+			246, 42, 152, 166, 64, 164, 207, 8, 83, 159, 208, 229, 213, 57,
+			122, 227, 198, 51, 207, 134, 56, 164, 134, 151, 9, 115, 194, 214,
+			59, 190, 242, 214, 74, 20, 29, 120, 93, 29, 48, 143, 191, 63,
+			228, 222, 69, 181, 237, 245, 29, 213, 78, 210, 199, 45, 217, 162,
+			115, 240, 3, 61, 247, 98, 140, 112, 233, 44, 205, 131, 33, 12,
+			239, 249, 61, 169, 59, 225, 163, 116, 105, 113, 123, 47, 68, 18,
+			184, 220, 193, 90, 240, 239, 219, 189, 66, 221, 179, 252, 54, 111,
+			43, 90, 181, 45, 15, 206, 70, 176, 188, 123, 150, 204, 206, 67,
+			173, 122, 208, 183, 58, 84, 56, 44, 112, 100, 237, 134, 40, 93,
+			135, 205, 248, 151, 164, 141, 97, 251, 145, 23, 111, 136, 219, 98,
+			57, 247, 44, 207, 246, 33, 109, 142, 156, 68, 35, 9, 179, 148,
+			82, 144, 70, 208, 43, 35, 19, 10, 66, 4, 189, 66, 142, 42,
+			40, 77, 208, 43, 83, 31, 149, 211, 109, 136, 233, 126, 229, 248,
+			139, 248, 53, 62, 219, 73, 130, 94, 215, 87, 204, 85, 186, 102,
+			213, 219, 182, 195, 230, 61, 102, 53, 184, 105, 33, 72, 87, 52,
+			132, 252, 201, 227, 129, 12, 63, 7, 207, 59, 33, 141, 201, 20,
+			224, 30, 83, 144, 70, 208, 235, 71, 20, 85, 73, 68, 208, 235,
+			199, 79, 40, 40, 77, 208, 235, 211, 203, 146, 198, 164, 160, 241,
+			117, 179, 136, 255, 149, 198, 137, 76, 17, 100, 233, 183, 204, 223,
+			212, 232, 157, 126, 215, 114, 34, 26, 99, 7, 91, 69, 168, 178,
+			83, 108, 135, 222, 217, 94, 91, 85, 228, 130, 83, 210, 114, 236,
+			192, 126, 7, 238, 130, 89, 19, 156, 195, 30, 188, 127, 3, 39,
+			140, 146, 167, 154, 231, 238, 249, 220, 223, 200, 103, 215, 183, 223,
+			9, 117, 183, 116, 43, 133, 153, 106, 248, 235, 1, 176, 144, 32,
+			45, 64, 23, 178, 103, 201, 215, 242, 215, 46, 223, 120, 142, 214,
+			246, 193, 55, 106, 59, 152, 238, 108, 223, 154, 127, 62, 100, 73,
+			42, 9, 35, 81, 154, 39, 5, 201, 0, 50, 106, 93, 166, 16,
+			65, 214, 180, 74, 86, 147, 74, 19, 100, 153, 37, 201, 146, 148,
+			96, 137, 117, 122, 25, 223, 225, 28, 25, 33, 70, 67, 103, 11,
+			38, 220, 234, 48, 218, 115, 109, 153, 100, 9, 110, 240, 246, 100,
+			14, 169, 104, 206, 64, 12, 61, 41, 199, 210, 106, 13, 73, 26,
+			73, 17, 212, 144, 15, 250, 19, 250, 136, 70, 80, 227, 244, 5,
+			5, 33, 130, 26, 151, 102, 21, 148, 38, 136, 133, 138, 99, 68,
+			144, 196, 210, 5, 41, 73, 105, 98, 216, 250, 219, 11, 230, 203,
+			52, 188, 173, 116, 155, 67, 116, 132, 26, 35, 54, 45, 113, 78,
+			182, 184, 123, 88, 114, 242, 114, 72, 99, 58, 69, 144, 173, 155,
+			10, 210, 8, 178, 79, 157, 87, 16, 34, 200, 190, 112, 81, 65,
+			105, 130, 222, 14, 105, 76, 11, 26, 223, 78, 23, 112, 151, 211,
+			152, 33, 134, 163, 187, 11, 102, 149, 42, 15, 147, 60, 54, 13,
+			90, 185, 160, 195, 2, 218, 229, 89, 10, 101, 178, 56, 121, 158,
+			5, 251, 197, 174, 247, 59, 150, 39, 205, 127, 46, 121, 222, 224,
+			48, 67, 186, 51, 6, 65, 142, 30, 66, 41, 130, 156, 81, 53,
+			221, 25, 141, 32, 231, 184, 26, 83, 6, 17, 228, 60, 117, 86,
+			65, 105, 130, 220, 112, 20, 25, 49, 10, 55, 93, 192, 47, 242,
+			81, 96, 130, 60, 61, 103, 94, 6, 5, 237, 177, 168, 239, 208,
+			10, 106, 72, 51, 29, 168, 2, 175, 27, 191, 197, 14, 169, 194,
+			41, 104, 63, 174, 32, 141, 32, 111, 226, 180, 130, 16, 65, 222,
+			217, 115, 248, 109, 222, 209, 40, 49, 118, 245, 189, 5, 243, 77,
+			10, 238, 66, 181, 20, 228, 9, 39, 143, 105, 155, 61, 152, 241,
+			219, 214, 194, 245, 231, 102, 124, 23, 4, 108, 230, 226, 197, 252,
+			219, 174, 237, 204, 92, 124, 198, 95, 124, 198, 127, 211, 185, 200,
+			249, 123, 127, 142, 238, 130, 120, 170, 150, 220, 12, 155, 185, 4,
+			255, 133, 84, 141, 38, 9, 218, 13, 151, 198, 168, 70, 208, 110,
+			184, 52, 70, 17, 65, 187, 211, 138, 87, 163, 105, 130, 246, 66,
+			238, 140, 10, 238, 236, 165, 11, 248, 199, 133, 182, 24, 35, 198,
+			187, 250, 167, 22, 204, 79, 81, 136, 7, 131, 115, 8, 76, 146,
+			85, 115, 251, 65, 200, 43, 117, 142, 9, 124, 42, 60, 138, 92,
+			17, 215, 250, 45, 26, 186, 5, 243, 152, 110, 123, 54, 63, 85,
+			201, 187, 213, 186, 229, 240, 219, 76, 155, 231, 138, 243, 224, 201,
+			146, 186, 119, 243, 169, 213, 15, 92, 232, 138, 111, 88, 225, 168,
+			198, 82, 4, 189, 27, 242, 122, 76, 35, 232, 221, 144, 215, 99,
+			136, 160, 119, 207, 158, 83, 80, 154, 160, 79, 133, 163, 26, 19,
+			163, 250, 84, 186, 128, 47, 243, 116, 85, 169, 207, 106, 137, 255,
+			69, 211, 204, 28, 85, 30, 77, 53, 29, 48, 156, 129, 204, 106,
+			120, 20, 35, 67, 211, 136, 241, 89, 45, 61, 133, 175, 2, 144,
+			33, 198, 15, 107, 250, 145, 220, 5, 122, 143, 73, 185, 222, 147,
+			41, 239, 172, 6, 236, 145, 61, 23, 206, 84, 60, 25, 13, 168,
+			191, 49, 200, 35, 149, 73, 64, 171, 209, 49, 110, 11, 105, 153,
+			132, 38, 193, 255, 160, 201, 228, 86, 198, 143, 106, 250, 9, 243,
+			143, 52, 10, 94, 93, 69, 14, 151, 183, 189, 135, 9, 166, 56,
+			26, 114, 183, 144, 63, 156, 247, 105, 78, 40, 87, 174, 171, 196,
+			134, 153, 43, 20, 114, 97, 92, 198, 34, 205, 21, 10, 225, 236,
+			248, 133, 158, 181, 207, 163, 4, 11, 117, 215, 99, 10, 170, 202,
+			187, 166, 42, 172, 237, 106, 63, 176, 59, 213, 190, 99, 7, 64,
+			69, 190, 94, 207, 13, 38, 215, 186, 126, 101, 1, 194, 106, 238,
+			108, 236, 172, 174, 132, 110, 171, 154, 85, 191, 239, 119, 44, 191,
+			205, 252, 60, 22, 67, 135, 35, 30, 140, 53, 173, 64, 141, 24,
+			63, 170, 101, 136, 2, 17, 128, 199, 142, 227, 143, 201, 236, 91,
+			198, 79, 104, 250, 164, 185, 64, 55, 28, 54, 95, 179, 192, 204,
+			6, 23, 55, 21, 175, 36, 31, 197, 27, 137, 80, 75, 114, 20,
+			35, 10, 212, 0, 76, 203, 121, 0, 163, 218, 248, 9, 109, 34,
+			139, 31, 96, 184, 196, 75, 125, 65, 75, 124, 89, 211, 204, 183,
+			15, 145, 118, 75, 202, 122, 100, 72, 193, 126, 183, 11, 217, 108,
+			32, 93, 17, 204, 143, 8, 81, 140, 207, 130, 52, 66, 121, 66,
+			35, 139, 42, 175, 249, 60, 220, 78, 240, 121, 147, 2, 6, 227,
+			252, 2, 8, 88, 95, 38, 193, 50, 190, 168, 233, 79, 153, 45,
+			186, 33, 214, 147, 219, 28, 94, 108, 172, 107, 217, 29, 106, 53,
+			26, 224, 203, 98, 60, 184, 73, 28, 96, 64, 59, 65, 158, 29,
+			152, 155, 43, 207, 201, 5, 57, 71, 153, 85, 111, 83, 43, 160,
+			93, 215, 15, 232, 194, 117, 181, 171, 194, 217, 77, 178, 74, 215,
+			19, 6, 239, 55, 4, 147, 0, 142, 78, 42, 80, 3, 144, 76,
+			43, 16, 1, 120, 234, 52, 158, 149, 201, 175, 140, 159, 209, 244,
+			211, 230, 105, 122, 239, 49, 83, 162, 67, 218, 27, 168, 60, 174,
+			64, 141, 24, 63, 163, 77, 156, 80, 32, 2, 208, 60, 133, 63,
+			35, 211, 99, 25, 191, 160, 233, 167, 204, 30, 13, 239, 19, 66,
+			11, 163, 223, 162, 129, 7, 47, 91, 148, 32, 212, 250, 45, 127,
+			104, 19, 138, 28, 61, 192, 239, 134, 154, 145, 220, 82, 199, 118,
+			238, 191, 180, 106, 237, 187, 253, 32, 151, 31, 148, 101, 145, 222,
+			77, 144, 163, 39, 57, 1, 105, 5, 106, 0, 102, 142, 43, 16,
+			1, 120, 210, 196, 55, 120, 24, 77, 234, 43, 90, 226, 183, 53,
+			205, 124, 246, 81, 22, 224, 192, 49, 76, 8, 0, 68, 133, 24,
+			95, 209, 146, 19, 248, 179, 154, 140, 11, 49, 222, 211, 244, 147,
+			166, 71, 133, 55, 156, 238, 89, 113, 135, 150, 112, 90, 174, 187,
+			92, 233, 196, 142, 159, 210, 80, 142, 225, 247, 111, 138, 187, 124,
+			31, 34, 30, 172, 48, 178, 132, 7, 168, 112, 78, 89, 14, 166,
+			125, 7, 114, 68, 170, 8, 151, 35, 42, 176, 4, 72, 152, 82,
+			160, 78, 140, 247, 180, 19, 211, 248, 146, 140, 44, 49, 126, 85,
+			211, 71, 205, 83, 145, 229, 206, 109, 18, 240, 255, 65, 42, 76,
+			53, 219, 80, 85, 212, 77, 41, 80, 7, 48, 131, 85, 140, 138,
+			78, 140, 95, 7, 76, 63, 165, 29, 130, 10, 54, 4, 64, 69,
+			183, 250, 173, 22, 119, 168, 132, 87, 228, 144, 124, 70, 121, 89,
+			165, 156, 217, 14, 191, 203, 175, 7, 50, 238, 129, 23, 114, 15,
+			84, 207, 245, 125, 187, 214, 97, 56, 186, 98, 63, 208, 8, 150,
+			144, 200, 98, 106, 81, 184, 189, 96, 225, 8, 96, 180, 191, 30,
+			141, 0, 68, 242, 215, 181, 12, 198, 255, 175, 10, 227, 48, 254,
+			63, 77, 31, 51, 191, 114, 216, 8, 248, 249, 134, 53, 104, 163,
+			207, 109, 227, 208, 206, 129, 13, 17, 242, 84, 245, 131, 186, 219,
+			101, 138, 16, 136, 55, 240, 237, 93, 182, 120, 232, 16, 197, 94,
+			227, 122, 114, 211, 1, 113, 168, 169, 0, 6, 53, 104, 53, 180,
+			103, 57, 127, 226, 227, 11, 135, 131, 52, 78, 240, 136, 2, 117,
+			0, 241, 40, 110, 201, 192, 11, 227, 55, 96, 52, 175, 29, 50,
+			24, 105, 249, 138, 158, 64, 34, 173, 26, 183, 84, 212, 25, 160,
+			105, 59, 182, 15, 65, 36, 60, 109, 174, 116, 156, 64, 74, 55,
+			215, 89, 228, 254, 100, 183, 31, 145, 1, 105, 35, 127, 35, 34,
+			195, 208, 1, 196, 163, 248, 151, 117, 25, 67, 97, 252, 22, 200,
+			197, 23, 245, 33, 66, 26, 118, 131, 175, 132, 208, 246, 30, 116,
+			123, 65, 229, 144, 203, 74, 113, 214, 221, 142, 140, 192, 30, 56,
+			116, 251, 115, 212, 239, 131, 94, 84, 190, 17, 76, 41, 133, 188,
+			134, 222, 254, 220, 192, 248, 122, 30, 235, 90, 16, 196, 213, 17,
+			153, 54, 7, 58, 240, 221, 110, 140, 62, 31, 26, 2, 30, 56,
+			222, 246, 184, 240, 206, 31, 118, 188, 117, 154, 118, 75, 153, 246,
+			225, 162, 30, 18, 79, 62, 94, 105, 218, 15, 160, 148, 60, 75,
+			106, 196, 248, 173, 72, 48, 147, 58, 128, 25, 140, 255, 64, 227,
+			105, 249, 82, 95, 213, 18, 127, 169, 105, 230, 239, 106, 180, 28,
+			139, 49, 145, 200, 253, 126, 13, 18, 226, 133, 217, 110, 151, 87,
+			47, 129, 24, 90, 53, 223, 237, 132, 187, 90, 173, 99, 117, 33,
+			102, 136, 43, 138, 67, 60, 1, 145, 58, 139, 12, 217, 129, 228,
+			177, 97, 119, 242, 59, 183, 241, 124, 145, 186, 143, 155, 37, 192,
+			78, 153, 208, 151, 123, 53, 124, 87, 174, 66, 199, 13, 48, 93,
+			94, 189, 8, 90, 32, 84, 148, 6, 8, 239, 87, 181, 244, 9,
+			252, 103, 186, 76, 174, 151, 250, 154, 166, 255, 158, 102, 152, 95,
+			127, 18, 199, 123, 236, 170, 87, 110, 164, 112, 174, 28, 118, 191,
+			199, 238, 169, 135, 93, 240, 31, 212, 3, 31, 235, 216, 47, 188,
+			91, 122, 117, 99, 189, 84, 9, 91, 230, 241, 35, 157, 240, 138,
+			181, 223, 103, 143, 60, 236, 112, 136, 155, 107, 95, 83, 251, 31,
+			79, 61, 104, 124, 77, 203, 28, 81, 32, 34, 198, 215, 180, 236,
+			36, 158, 16, 96, 154, 164, 190, 166, 145, 223, 211, 16, 206, 226,
+			52, 47, 0, 211, 219, 248, 186, 102, 204, 199, 74, 52, 98, 252,
+			107, 205, 120, 22, 63, 47, 51, 22, 26, 223, 208, 244, 99, 230,
+			172, 242, 203, 75, 135, 165, 39, 82, 195, 70, 247, 33, 138, 1,
+			33, 109, 96, 219, 125, 35, 162, 13, 204, 244, 111, 104, 153, 172,
+			2, 17, 128, 71, 167, 240, 159, 131, 141, 141, 64, 109, 255, 161,
+			166, 31, 55, 255, 141, 54, 236, 79, 140, 29, 196, 20, 8, 93,
+			137, 254, 229, 69, 59, 133, 103, 179, 48, 81, 78, 199, 190, 63,
+			64, 21, 123, 16, 120, 86, 85, 182, 175, 134, 105, 144, 109, 145,
+			72, 92, 249, 56, 224, 86, 174, 211, 137, 157, 45, 240, 80, 207,
+			115, 212, 206, 179, 124, 36, 252, 50, 199, 170, 156, 231, 120, 134,
+			92, 48, 131, 171, 3, 146, 161, 24, 162, 167, 248, 24, 51, 10,
+			212, 0, 196, 147, 10, 68, 0, 78, 29, 3, 77, 111, 32, 29,
+			145, 212, 31, 107, 250, 159, 105, 134, 121, 111, 192, 75, 236, 196,
+			87, 201, 19, 185, 137, 203, 156, 102, 110, 103, 120, 243, 42, 39,
+			68, 52, 77, 40, 73, 140, 63, 142, 166, 9, 150, 240, 31, 107,
+			153, 19, 10, 68, 0, 154, 167, 164, 8, 161, 52, 144, 117, 250,
+			207, 66, 17, 18, 78, 85, 227, 79, 34, 17, 66, 66, 132, 254,
+			20, 68, 200, 225, 67, 49, 136, 241, 45, 77, 191, 107, 126, 130,
+			86, 248, 110, 3, 91, 109, 141, 181, 109, 121, 231, 24, 27, 207,
+			223, 215, 151, 37, 104, 54, 146, 188, 67, 53, 34, 216, 202, 190,
+			165, 101, 78, 42, 16, 1, 120, 250, 41, 5, 166, 1, 60, 83,
+			150, 212, 11, 175, 161, 241, 45, 141, 222, 230, 158, 1, 4, 91,
+			221, 183, 53, 253, 214, 135, 236, 25, 16, 196, 36, 69, 103, 138,
+			114, 216, 65, 190, 173, 101, 142, 41, 16, 1, 56, 173, 6, 146,
+			76, 3, 104, 150, 36, 229, 194, 151, 104, 124, 91, 59, 189, 140,
+			43, 60, 217, 103, 234, 175, 52, 8, 144, 48, 87, 104, 24, 213,
+			18, 179, 112, 37, 29, 226, 202, 150, 223, 243, 195, 83, 10, 62,
+			56, 153, 209, 91, 84, 246, 152, 213, 233, 74, 253, 14, 172, 252,
+			43, 45, 125, 12, 95, 230, 201, 54, 19, 196, 248, 14, 232, 134,
+			156, 210, 13, 143, 209, 9, 6, 215, 87, 223, 81, 3, 52, 184,
+			190, 250, 142, 210, 9, 6, 215, 87, 223, 209, 142, 78, 133, 185,
+			60, 141, 239, 130, 74, 184, 249, 247, 208, 8, 18, 51, 28, 106,
+			190, 171, 150, 158, 193, 173, 222, 239, 170, 165, 103, 112, 93, 244,
+			93, 109, 234, 24, 46, 242, 126, 117, 98, 124, 79, 211, 77, 243,
+			234, 97, 51, 126, 96, 136, 178, 156, 135, 23, 133, 253, 129, 212,
+			124, 47, 26, 39, 12, 229, 123, 106, 34, 33, 95, 47, 128, 211,
+			39, 241, 231, 97, 155, 52, 0, 252, 91, 240, 47, 252, 141, 22,
+			155, 41, 31, 14, 251, 32, 226, 111, 92, 158, 163, 87, 222, 154,
+			147, 135, 168, 203, 180, 203, 44, 71, 28, 55, 192, 16, 222, 135,
+			115, 163, 213, 233, 128, 39, 87, 172, 116, 153, 165, 186, 38, 110,
+			75, 69, 157, 216, 198, 200, 181, 3, 132, 245, 248, 115, 180, 97,
+			139, 176, 228, 218, 254, 96, 43, 76, 221, 30, 252, 86, 0, 56,
+			20, 108, 249, 139, 8, 65, 155, 117, 165, 22, 28, 236, 193, 114,
+			172, 206, 62, 172, 207, 120, 39, 189, 78, 95, 230, 238, 143, 42,
+			114, 229, 11, 127, 192, 61, 41, 55, 209, 118, 225, 103, 35, 66,
+			239, 131, 193, 117, 209, 223, 74, 171, 211, 48, 224, 110, 199, 248,
+			91, 45, 77, 20, 200, 249, 116, 236, 56, 126, 139, 115, 205, 32,
+			198, 223, 193, 57, 124, 131, 174, 63, 154, 22, 216, 137, 249, 229,
+			173, 180, 208, 212, 225, 43, 46, 59, 88, 205, 111, 72, 11, 104,
+			145, 191, 139, 104, 1, 209, 255, 59, 45, 61, 173, 64, 4, 224,
+			169, 211, 248, 231, 97, 251, 50, 96, 190, 63, 167, 235, 231, 205,
+			159, 208, 98, 212, 196, 137, 224, 182, 35, 191, 112, 230, 214, 85,
+			204, 88, 131, 249, 24, 178, 220, 20, 105, 120, 104, 169, 74, 99,
+			80, 122, 226, 228, 229, 175, 12, 241, 1, 99, 185, 206, 124, 191,
+			217, 239, 80, 143, 5, 158, 50, 18, 12, 174, 85, 62, 167, 135,
+			35, 1, 173, 242, 57, 61, 125, 70, 129, 8, 192, 115, 57, 252,
+			158, 16, 198, 20, 49, 126, 76, 215, 79, 152, 95, 210, 99, 35,
+			129, 227, 65, 236, 120, 23, 167, 138, 238, 193, 161, 159, 219, 163,
+			96, 154, 28, 28, 74, 224, 98, 113, 149, 117, 192, 64, 229, 142,
+			145, 93, 187, 206, 232, 46, 243, 124, 107, 142, 214, 88, 176, 199,
+			152, 51, 32, 32, 49, 38, 114, 25, 143, 241, 20, 110, 206, 92,
+			175, 193, 100, 148, 211, 112, 218, 102, 187, 201, 31, 74, 0, 249,
+			109, 214, 21, 204, 119, 29, 198, 87, 48, 166, 50, 91, 177, 124,
+			251, 152, 23, 141, 249, 251, 151, 218, 190, 8, 222, 6, 28, 48,
+			112, 113, 21, 188, 103, 251, 145, 62, 73, 37, 57, 151, 20, 75,
+			83, 26, 128, 161, 160, 166, 16, 128, 199, 142, 135, 241, 218, 127,
+			254, 60, 126, 241, 209, 33, 110, 190, 237, 220, 127, 84, 208, 54,
+			137, 133, 24, 66, 93, 248, 201, 171, 127, 148, 168, 108, 243, 3,
+			7, 158, 231, 254, 183, 193, 120, 238, 135, 6, 88, 158, 138, 135,
+			107, 203, 136, 218, 48, 76, 219, 140, 5, 91, 67, 16, 101, 58,
+			22, 103, 125, 45, 140, 179, 54, 30, 31, 103, 29, 134, 88, 159,
+			27, 10, 177, 134, 160, 202, 204, 96, 116, 245, 141, 129, 232, 234,
+			212, 227, 162, 171, 227, 129, 213, 215, 99, 129, 213, 35, 143, 9,
+			172, 142, 197, 84, 95, 150, 49, 213, 233, 199, 199, 84, 203, 112,
+			234, 151, 113, 198, 242, 2, 187, 105, 213, 3, 127, 58, 35, 223,
+			130, 31, 148, 154, 124, 108, 235, 42, 170, 250, 60, 185, 78, 37,
+			106, 127, 48, 54, 27, 127, 128, 216, 236, 229, 225, 152, 235, 209,
+			39, 137, 185, 30, 12, 183, 54, 95, 199, 227, 131, 100, 30, 242,
+			11, 106, 11, 241, 95, 80, 27, 226, 150, 26, 182, 66, 34, 179,
+			30, 242, 95, 102, 243, 112, 90, 21, 147, 167, 100, 24, 183, 124,
+			243, 174, 205, 100, 238, 36, 42, 105, 40, 130, 35, 26, 57, 141,
+			211, 252, 151, 17, 224, 173, 62, 244, 50, 6, 95, 85, 9, 228,
+			186, 146, 127, 199, 179, 217, 141, 202, 178, 237, 253, 30, 91, 74,
+			97, 163, 230, 54, 246, 115, 255, 86, 195, 227, 209, 20, 240, 7,
+			243, 36, 124, 105, 15, 173, 248, 223, 164, 136, 83, 50, 79, 147,
+			46, 115, 167, 61, 114, 42, 1, 79, 254, 22, 111, 80, 145, 13,
+			115, 93, 156, 146, 121, 156, 210, 216, 128, 168, 151, 108, 2, 158,
+			159, 47, 223, 169, 108, 172, 149, 119, 214, 170, 119, 183, 54, 214,
+			197, 105, 86, 196, 157, 65, 208, 238, 4, 30, 189, 189, 177, 113,
+			123, 181, 196, 63, 100, 117, 158, 58, 103, 103, 189, 188, 93, 125,
+			117, 109, 53, 139, 200, 8, 70, 219, 197, 205, 172, 1, 201, 120,
+			110, 111, 240, 74, 28, 79, 54, 153, 251, 89, 132, 199, 212, 212,
+			111, 67, 124, 255, 75, 216, 104, 216, 50, 156, 125, 116, 97, 246,
+			176, 1, 196, 235, 231, 87, 108, 79, 10, 34, 111, 7, 153, 150,
+			128, 253, 190, 76, 116, 248, 236, 99, 17, 0, 19, 36, 6, 209,
+			210, 92, 198, 6, 124, 121, 104, 108, 253, 129, 248, 120, 253, 96,
+			124, 188, 249, 113, 156, 9, 73, 59, 68, 248, 110, 14, 10, 223,
+			51, 143, 37, 19, 254, 137, 73, 161, 89, 197, 56, 162, 252, 67,
+			232, 96, 233, 218, 235, 11, 239, 99, 243, 185, 9, 80, 175, 118,
+			247, 255, 124, 86, 132, 88, 255, 138, 246, 131, 16, 235, 31, 132,
+			88, 255, 131, 134, 88, 159, 138, 66, 172, 103, 162, 16, 235, 75,
+			81, 136, 245, 139, 81, 136, 245, 82, 24, 98, 93, 142, 66, 172,
+			203, 248, 71, 52, 17, 98, 125, 58, 177, 172, 153, 123, 180, 200,
+			111, 239, 58, 20, 38, 100, 215, 234, 128, 69, 234, 54, 105, 108,
+			161, 52, 106, 49, 109, 169, 98, 133, 193, 97, 72, 243, 249, 66,
+			62, 127, 168, 193, 34, 217, 98, 7, 226, 231, 119, 64, 211, 243,
+			147, 124, 131, 5, 224, 38, 141, 69, 26, 159, 78, 19, 252, 49,
+			245, 179, 80, 103, 244, 99, 230, 85, 90, 122, 4, 37, 3, 170,
+			155, 255, 89, 110, 132, 247, 232, 137, 36, 65, 103, 194, 232, 0,
+			8, 178, 61, 19, 70, 25, 66, 144, 237, 153, 163, 83, 56, 80,
+			33, 179, 231, 245, 19, 102, 235, 125, 244, 21, 218, 85, 220, 148,
+			110, 82, 183, 11, 55, 198, 60, 214, 214, 179, 156, 134, 219, 157,
+			83, 49, 183, 194, 195, 25, 185, 139, 66, 250, 32, 10, 242, 124,
+			72, 31, 68, 65, 158, 15, 67, 10, 33, 10, 242, 252, 177, 227,
+			252, 167, 85, 224, 242, 8, 93, 208, 167, 204, 235, 239, 131, 62,
+			101, 202, 133, 189, 65, 0, 227, 133, 48, 250, 11, 134, 124, 33,
+			140, 254, 210, 17, 65, 23, 200, 81, 201, 121, 68, 208, 37, 253,
+			210, 251, 226, 188, 48, 0, 195, 190, 32, 176, 240, 146, 254, 148,
+			130, 52, 130, 46, 157, 121, 90, 65, 128, 254, 226, 12, 190, 165,
+			2, 7, 231, 116, 211, 188, 241, 126, 250, 138, 153, 152, 97, 143,
+			16, 219, 54, 23, 242, 18, 94, 173, 206, 133, 145, 32, 6, 34,
+			104, 110, 250, 36, 94, 81, 193, 107, 5, 253, 89, 243, 35, 239,
+			111, 116, 210, 98, 13, 251, 131, 56, 181, 66, 24, 1, 5, 111,
+			76, 11, 97, 4, 20, 196, 169, 21, 46, 205, 202, 185, 75, 17,
+			180, 160, 207, 188, 175, 185, 83, 182, 107, 216, 91, 138, 35, 81,
+			177, 44, 16, 2, 182, 16, 198, 50, 65, 8, 216, 194, 133, 139,
+			248, 37, 25, 227, 133, 174, 235, 151, 205, 43, 239, 163, 55, 176,
+			121, 195, 158, 70, 12, 130, 174, 135, 209, 71, 16, 231, 117, 125,
+			52, 140, 236, 210, 8, 186, 126, 241, 89, 5, 33, 130, 174, 231,
+			11, 220, 201, 154, 208, 211, 4, 221, 208, 47, 152, 175, 83, 101,
+			6, 194, 105, 149, 246, 123, 29, 215, 106, 112, 63, 137, 229, 251,
+			110, 221, 182, 130, 112, 135, 81, 87, 21, 82, 79, 136, 123, 66,
+			248, 165, 77, 248, 229, 66, 184, 160, 113, 168, 178, 162, 121, 144,
+			174, 236, 22, 194, 186, 110, 232, 42, 8, 48, 173, 17, 116, 99,
+			90, 133, 195, 164, 17, 65, 55, 158, 126, 6, 223, 150, 113, 91,
+			232, 5, 253, 170, 185, 248, 126, 88, 17, 183, 212, 195, 46, 51,
+			41, 130, 94, 208, 195, 56, 43, 141, 160, 23, 232, 188, 130, 16,
+			65, 47, 92, 94, 192, 255, 66, 83, 81, 86, 75, 250, 85, 243,
+			55, 180, 247, 219, 169, 178, 236, 7, 181, 73, 244, 154, 196, 118,
+			238, 83, 59, 186, 79, 83, 222, 242, 195, 98, 45, 230, 176, 124,
+			222, 33, 48, 66, 179, 193, 8, 142, 129, 65, 134, 47, 46, 195,
+			225, 66, 168, 215, 82, 56, 92, 172, 17, 180, 20, 14, 23, 35,
+			130, 150, 46, 47, 224, 191, 130, 77, 67, 35, 198, 221, 196, 171,
+			154, 249, 167, 218, 19, 237, 26, 74, 50, 14, 217, 51, 188, 94,
+			29, 246, 13, 53, 223, 79, 184, 105, 208, 21, 21, 123, 46, 159,
+			31, 64, 76, 8, 108, 198, 10, 15, 92, 34, 169, 64, 119, 254,
+			172, 0, 220, 28, 30, 147, 63, 48, 25, 122, 235, 112, 220, 9,
+			169, 218, 250, 74, 22, 229, 230, 4, 234, 249, 46, 255, 97, 48,
+			195, 208, 224, 174, 231, 101, 125, 27, 113, 174, 104, 105, 216, 90,
+			94, 78, 143, 225, 79, 168, 31, 240, 91, 55, 78, 154, 91, 180,
+			8, 87, 140, 125, 248, 225, 78, 11, 36, 30, 238, 231, 88, 36,
+			211, 192, 120, 72, 255, 19, 62, 22, 234, 138, 80, 10, 184, 165,
+			229, 68, 9, 241, 216, 130, 153, 23, 87, 14, 114, 134, 196, 175,
+			250, 173, 27, 33, 164, 17, 180, 62, 58, 165, 32, 68, 208, 250,
+			137, 105, 252, 127, 104, 50, 176, 8, 109, 25, 39, 204, 31, 215,
+			32, 243, 70, 192, 156, 200, 87, 167, 8, 201, 211, 29, 159, 129,
+			171, 139, 135, 128, 250, 76, 228, 7, 128, 135, 252, 240, 248, 8,
+			156, 81, 86, 244, 82, 9, 43, 42, 225, 94, 127, 99, 101, 99,
+			198, 113, 27, 54, 92, 96, 89, 111, 191, 109, 53, 186, 151, 22,
+			193, 79, 228, 238, 133, 104, 228, 185, 13, 174, 231, 105, 189, 221,
+			119, 238, 43, 77, 163, 241, 221, 111, 203, 200, 40, 8, 8, 197,
+			68, 65, 136, 160, 173, 99, 199, 185, 198, 214, 96, 247, 187, 167,
+			155, 143, 215, 216, 74, 192, 242, 178, 215, 170, 116, 92, 11, 156,
+			160, 248, 239, 201, 29, 66, 131, 43, 35, 116, 79, 238, 16, 26,
+			184, 145, 209, 189, 233, 147, 248, 34, 143, 142, 50, 222, 72, 252,
+			144, 102, 158, 162, 69, 25, 151, 6, 11, 45, 30, 106, 34, 37,
+			2, 80, 188, 145, 62, 142, 109, 25, 203, 132, 62, 174, 19, 243,
+			205, 104, 214, 55, 182, 230, 29, 158, 122, 112, 96, 254, 37, 142,
+			15, 62, 253, 60, 96, 9, 125, 92, 142, 132, 199, 43, 161, 143,
+			15, 252, 84, 223, 199, 179, 147, 248, 25, 32, 202, 72, 16, 195,
+			210, 223, 69, 230, 137, 232, 225, 28, 239, 87, 156, 107, 21, 66,
+			110, 120, 89, 35, 71, 240, 125, 156, 2, 8, 198, 210, 48, 142,
+			152, 111, 114, 149, 204, 27, 128, 62, 166, 62, 132, 201, 65, 82,
+			46, 183, 25, 91, 49, 20, 78, 173, 212, 229, 151, 236, 62, 157,
+			129, 229, 104, 137, 178, 162, 231, 89, 251, 151, 100, 4, 72, 44,
+			215, 139, 232, 122, 28, 143, 136, 206, 32, 122, 216, 72, 71, 176,
+			78, 80, 99, 116, 12, 255, 172, 38, 169, 209, 8, 106, 27, 231,
+			205, 207, 107, 225, 179, 128, 139, 190, 232, 0, 136, 144, 124, 242,
+			37, 226, 232, 158, 56, 60, 165, 9, 31, 145, 136, 89, 3, 231,
+			228, 224, 47, 150, 62, 91, 232, 90, 126, 192, 188, 66, 195, 173,
+			251, 225, 239, 151, 194, 143, 144, 84, 99, 102, 172, 47, 115, 61,
+			231, 187, 141, 136, 114, 80, 9, 109, 227, 76, 4, 235, 4, 181,
+			207, 229, 34, 202, 117, 130, 28, 99, 10, 40, 191, 205, 73, 85,
+			99, 135, 159, 247, 163, 123, 12, 174, 73, 221, 122, 31, 52, 27,
+			216, 105, 180, 12, 209, 29, 0, 113, 195, 127, 17, 63, 217, 79,
+			174, 194, 45, 41, 167, 187, 208, 177, 250, 78, 189, 205, 188, 184,
+			253, 237, 87, 101, 228, 88, 190, 94, 143, 40, 7, 209, 117, 140,
+			137, 8, 6, 74, 201, 81, 252, 63, 43, 202, 33, 162, 215, 32,
+			230, 46, 189, 187, 227, 216, 1, 125, 117, 109, 85, 242, 119, 14,
+			212, 83, 207, 115, 27, 253, 186, 208, 159, 93, 120, 225, 3, 29,
+			242, 151, 41, 12, 126, 140, 61, 164, 59, 150, 106, 112, 207, 118,
+			26, 251, 158, 107, 53, 10, 28, 227, 252, 86, 189, 205, 186, 86,
+			161, 214, 113, 107, 106, 2, 248, 135, 252, 3, 63, 198, 97, 4,
+			113, 198, 198, 145, 8, 214, 9, 242, 178, 147, 184, 39, 201, 52,
+			8, 218, 53, 198, 76, 139, 139, 35, 45, 58, 251, 1, 68, 229,
+			208, 77, 153, 198, 103, 142, 123, 195, 225, 104, 124, 229, 106, 140,
+			44, 160, 214, 146, 117, 249, 217, 52, 176, 122, 243, 178, 230, 252,
+			149, 171, 243, 234, 164, 198, 167, 33, 15, 15, 227, 35, 138, 192,
+			178, 220, 53, 70, 34, 88, 39, 104, 23, 143, 226, 138, 164, 40,
+			73, 208, 59, 198, 49, 115, 89, 69, 61, 241, 56, 131, 38, 253,
+			68, 75, 188, 148, 163, 243, 32, 89, 159, 200, 31, 26, 206, 80,
+			239, 54, 248, 212, 45, 64, 157, 66, 212, 39, 88, 151, 239, 24,
+			217, 8, 214, 9, 122, 231, 232, 20, 206, 169, 159, 227, 252, 140,
+			62, 101, 30, 147, 177, 27, 74, 189, 203, 168, 75, 249, 75, 156,
+			41, 168, 164, 52, 6, 200, 237, 103, 50, 19, 10, 66, 4, 125,
+			134, 28, 197, 127, 97, 136, 96, 154, 159, 210, 18, 191, 162, 105,
+			230, 55, 12, 193, 212, 208, 140, 184, 207, 246, 197, 140, 203, 232,
+			79, 101, 65, 204, 81, 149, 32, 136, 255, 176, 1, 191, 189, 10,
+			117, 157, 10, 16, 197, 32, 54, 82, 45, 0, 101, 34, 40, 133,
+			231, 76, 123, 23, 254, 161, 52, 7, 158, 170, 220, 162, 2, 41,
+			4, 238, 2, 152, 27, 112, 43, 229, 22, 105, 174, 236, 52, 61,
+			43, 247, 105, 149, 64, 12, 42, 54, 93, 151, 215, 21, 206, 168,
+			220, 34, 125, 35, 215, 116, 221, 249, 128, 89, 221, 143, 201, 16,
+			29, 144, 194, 220, 91, 115, 244, 33, 248, 94, 186, 229, 186, 185,
+			79, 11, 148, 10, 117, 14, 40, 29, 166, 169, 233, 186, 133, 154,
+			229, 113, 229, 144, 111, 29, 232, 22, 130, 164, 92, 135, 13, 246,
+			170, 240, 194, 255, 62, 45, 223, 137, 132, 124, 141, 238, 145, 135,
+			13, 53, 56, 46, 199, 157, 194, 113, 91, 13, 18, 152, 241, 196,
+			238, 161, 127, 1, 62, 210, 192, 186, 207, 96, 125, 178, 58, 131,
+			223, 8, 100, 226, 38, 26, 42, 170, 203, 71, 64, 218, 176, 33,
+			80, 206, 245, 236, 240, 197, 178, 21, 150, 237, 63, 4, 7, 180,
+			3, 7, 17, 92, 245, 192, 26, 223, 24, 136, 128, 143, 56, 202,
+			31, 70, 121, 76, 6, 87, 193, 15, 63, 137, 95, 38, 12, 58,
+			251, 177, 208, 166, 159, 130, 32, 96, 184, 35, 70, 40, 65, 82,
+			95, 210, 244, 159, 215, 144, 121, 37, 122, 96, 17, 158, 22, 66,
+			59, 151, 15, 207, 245, 226, 148, 202, 59, 36, 132, 224, 242, 251,
+			75, 26, 30, 199, 37, 156, 50, 16, 2, 223, 129, 241, 51, 154,
+			113, 246, 201, 14, 93, 170, 211, 188, 152, 198, 60, 198, 19, 120,
+			68, 160, 49, 32, 68, 215, 24, 139, 10, 146, 80, 112, 228, 104,
+			84, 192, 99, 122, 167, 204, 168, 128, 71, 245, 62, 117, 6, 175,
+			74, 82, 52, 98, 252, 156, 102, 60, 101, 190, 240, 190, 72, 25,
+			96, 105, 140, 34, 136, 4, 250, 57, 205, 136, 21, 112, 252, 163,
+			211, 81, 1, 130, 130, 83, 167, 241, 3, 25, 55, 102, 124, 5,
+			174, 118, 223, 166, 224, 203, 4, 229, 160, 216, 103, 195, 109, 49,
+			125, 89, 254, 164, 56, 183, 81, 100, 228, 35, 168, 208, 48, 148,
+			94, 94, 149, 70, 146, 15, 65, 196, 176, 54, 163, 103, 103, 106,
+			145, 83, 207, 117, 213, 173, 47, 255, 41, 87, 232, 250, 168, 2,
+			121, 228, 239, 212, 180, 2, 17, 128, 167, 78, 227, 55, 85, 108,
+			212, 123, 154, 126, 198, 92, 15, 201, 132, 249, 254, 96, 4, 134,
+			75, 51, 36, 5, 98, 22, 222, 139, 72, 129, 152, 133, 247, 180,
+			41, 21, 253, 1, 49, 11, 239, 105, 167, 159, 10, 239, 24, 191,
+			58, 129, 159, 123, 31, 110, 94, 14, 61, 226, 114, 241, 239, 119,
+			95, 153, 123, 11, 79, 87, 24, 132, 16, 68, 38, 151, 95, 1,
+			75, 204, 15, 72, 17, 143, 197, 247, 122, 121, 65, 112, 38, 127,
+			144, 138, 216, 129, 179, 50, 26, 68, 152, 114, 183, 241, 201, 67,
+			208, 67, 158, 48, 159, 145, 89, 60, 25, 195, 207, 85, 143, 114,
+			252, 79, 68, 72, 224, 37, 135, 191, 240, 14, 54, 192, 88, 37,
+			30, 158, 60, 128, 144, 204, 29, 70, 210, 33, 253, 242, 97, 153,
+			243, 79, 88, 91, 80, 153, 75, 124, 64, 191, 252, 31, 140, 66,
+			6, 220, 241, 68, 254, 7, 110, 249, 31, 184, 229, 63, 44, 183,
+			252, 109, 233, 117, 159, 138, 188, 238, 240, 231, 255, 133, 176, 158,
+			74, 16, 227, 108, 226, 188, 102, 254, 2, 162, 242, 183, 21, 192,
+			75, 0, 10, 213, 11, 134, 79, 156, 177, 159, 4, 23, 191, 185,
+			229, 83, 71, 114, 31, 94, 99, 193, 80, 172, 62, 28, 84, 221,
+			251, 204, 81, 241, 124, 119, 182, 183, 55, 105, 155, 89, 32, 162,
+			123, 109, 25, 218, 120, 223, 118, 90, 194, 69, 84, 217, 92, 230,
+			186, 150, 203, 158, 252, 245, 36, 30, 183, 177, 227, 0, 46, 136,
+			79, 173, 91, 48, 57, 34, 235, 33, 207, 14, 89, 3, 141, 31,
+			244, 61, 241, 46, 11, 130, 152, 230, 228, 186, 176, 223, 225, 156,
+			89, 140, 159, 92, 95, 0, 60, 243, 156, 166, 151, 164, 225, 19,
+			167, 210, 143, 101, 37, 217, 181, 85, 74, 197, 70, 45, 190, 244,
+			161, 126, 149, 99, 224, 139, 176, 186, 188, 177, 190, 93, 122, 117,
+			27, 194, 140, 58, 125, 150, 7, 179, 151, 118, 69, 128, 87, 248,
+			148, 105, 142, 238, 218, 190, 29, 28, 122, 18, 225, 138, 1, 254,
+			153, 239, 237, 15, 156, 63, 4, 91, 11, 241, 78, 248, 113, 15,
+			99, 148, 130, 19, 242, 217, 244, 24, 28, 171, 83, 220, 188, 56,
+			167, 231, 230, 204, 19, 84, 168, 176, 1, 79, 166, 244, 112, 64,
+			53, 200, 156, 146, 58, 161, 32, 157, 160, 115, 211, 151, 21, 132,
+			8, 202, 77, 204, 96, 44, 46, 95, 158, 73, 204, 104, 225, 29,
+			200, 51, 105, 138, 47, 168, 59, 144, 139, 250, 69, 243, 36, 221,
+			142, 225, 143, 100, 68, 218, 247, 220, 80, 65, 23, 67, 175, 45,
+			252, 198, 247, 197, 240, 205, 40, 144, 126, 241, 120, 78, 65, 136,
+			160, 139, 207, 92, 224, 253, 106, 196, 152, 5, 229, 167, 220, 91,
+			179, 233, 115, 248, 71, 213, 59, 61, 52, 175, 207, 152, 239, 210,
+			85, 219, 231, 230, 202, 129, 252, 33, 190, 148, 197, 193, 80, 116,
+			120, 108, 36, 61, 29, 126, 232, 235, 192, 32, 82, 242, 141, 29,
+			107, 240, 137, 142, 233, 119, 97, 253, 168, 208, 116, 37, 134, 114,
+			104, 26, 31, 218, 188, 28, 154, 240, 124, 205, 143, 78, 198, 60,
+			95, 243, 228, 124, 204, 243, 53, 127, 225, 98, 45, 213, 243, 220,
+			192, 189, 250, 223, 7, 0, 109, 56, 14, 133, 2, 145, 0, 0,
+		},
 	)
 }
 
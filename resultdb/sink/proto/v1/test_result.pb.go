@@ -57,6 +57,15 @@ const (
 	// Implementation:
 	// https://cs.chromium.org/chromium/src/base/test/launcher/test_results_tracker.cc
 	TestResultFile_GOOGLE_TEST TestResultFile_Format = 2
+	// JUnit XML format, as produced by many test runners.
+	// https://github.com/windyroad/JUnit-Schema/blob/master/JUnit.xsd
+	TestResultFile_JUNIT_XML TestResultFile_Format = 3
+	// Test Anything Protocol, version 13.
+	// https://testanything.org/tap-version-13-specification.html
+	TestResultFile_TAP TestResultFile_Format = 4
+	// The output of `go test -json`.
+	// https://golang.org/cmd/test2json/
+	TestResultFile_GO_TEST_JSON TestResultFile_Format = 5
 )
 
 // Enum value maps for TestResultFile_Format.
@@ -65,11 +74,17 @@ var (
 		0: "LUCI",
 		1: "CHROMIUM_JSON_TEST_RESULTS",
 		2: "GOOGLE_TEST",
+		3: "JUNIT_XML",
+		4: "TAP",
+		5: "GO_TEST_JSON",
 	}
 	TestResultFile_Format_value = map[string]int32{
 		"LUCI":                       0,
 		"CHROMIUM_JSON_TEST_RESULTS": 1,
 		"GOOGLE_TEST":                2,
+		"JUNIT_XML":                  3,
+		"TAP":                        4,
+		"GO_TEST_JSON":               5,
 	}
 )
 
@@ -131,6 +146,11 @@ type TestResult struct {
 	Artifacts map[string]*Artifact `protobuf:"bytes,9,rep,name=artifacts,proto3" json:"artifacts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Equivalent of luci.resultdb.v1.TestResult.test_location.
 	TestLocation *v1.TestLocation `protobuf:"bytes,10,opt,name=test_location,json=testLocation,proto3" json:"test_location,omitempty"`
+	// Equivalent of luci.resultdb.v1.TestResult.test_metadata.
+	//
+	// If omitted and the sink is configured with a location-tags file,
+	// the metadata is derived from test_location.file_name.
+	TestMetadata *v1.TestMetadata `protobuf:"bytes,11,opt,name=test_metadata,json=testMetadata,proto3" json:"test_metadata,omitempty"`
 }
 
 func (x *TestResult) Reset() {
@@ -235,6 +255,13 @@ func (x *TestResult) GetTestLocation() *v1.TestLocation {
 	return nil
 }
 
+func (x *TestResult) GetTestMetadata() *v1.TestMetadata {
+	if x != nil {
+		return x.TestMetadata
+	}
+	return nil
+}
+
 // A local equivalent of luci.resultdb.Artifact message
 // in ../../rpc/v1/artifact.proto.
 // See its comments for details.
@@ -393,6 +420,141 @@ func (x *TestResultFile) GetFormat() TestResultFile_Format {
 	return TestResultFile_LUCI
 }
 
+// Test metadata keyed by source location, usually checked into the repository
+// as a JSON file, e.g.
+//
+//   {
+//     "dirs": {
+//       "//": {"bug_component": "Infra"},
+//       "//foo": {"owners": ["foo-team@example.com"], "bug_component": "Infra>Foo"}
+//     },
+//     "files": {
+//       "//foo/bar_test.go": {"owners": ["someone@example.com"]}
+//     }
+//   }
+//
+// The metadata of a test is derived from its TestLocation.file_name.
+// An entry for the file takes precedence over entries for its directories,
+// and a directory takes precedence over its ancestors.
+// Owners and bug_component are resolved independently.
+type LocationTags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Tags of directories.
+	// Keys are paths starting with "//", e.g. "//foo/bar".
+	// "//" is the repository root.
+	Dirs map[string]*LocationTags_Tags `protobuf:"bytes,1,rep,name=dirs,proto3" json:"dirs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Tags of files.
+	// Keys are paths starting with "//", e.g. "//foo/bar_test.go".
+	Files map[string]*LocationTags_Tags `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *LocationTags) Reset() {
+	*x = LocationTags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocationTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationTags) ProtoMessage() {}
+
+func (x *LocationTags) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationTags.ProtoReflect.Descriptor instead.
+func (*LocationTags) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LocationTags) GetDirs() map[string]*LocationTags_Tags {
+	if x != nil {
+		return x.Dirs
+	}
+	return nil
+}
+
+func (x *LocationTags) GetFiles() map[string]*LocationTags_Tags {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// Metadata associated with a file or a directory.
+type LocationTags_Tags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Equivalent of luci.resultdb.v1.TestMetadata.owners.
+	Owners []string `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
+	// Equivalent of luci.resultdb.v1.TestMetadata.bug_component.
+	BugComponent string `protobuf:"bytes,2,opt,name=bug_component,json=bugComponent,proto3" json:"bug_component,omitempty"`
+}
+
+func (x *LocationTags_Tags) Reset() {
+	*x = LocationTags_Tags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocationTags_Tags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationTags_Tags) ProtoMessage() {}
+
+func (x *LocationTags_Tags) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationTags_Tags.ProtoReflect.Descriptor instead.
+func (*LocationTags_Tags) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *LocationTags_Tags) GetOwners() []string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *LocationTags_Tags) GetBugComponent() string {
+	if x != nil {
+		return x.BugComponent
+	}
+	return ""
+}
+
 var File_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_rawDesc = []byte{
@@ -412,7 +574,7 @@ var file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_rawDesc =
 	0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f,
 	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76,
 	0x31, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xc9, 0x04, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x05, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75,
 	0x6c, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72,
 	0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
@@ -443,34 +605,67 @@ var file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_rawDesc =
 	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x72, 0x65, 0x73,
 	0x75, 0x6c, 0x74, 0x64, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x63,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x1a, 0x5a, 0x0a, 0x0e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x69, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69,
-	0x66, 0x61, 0x63, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x72, 0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x66,
-	0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x08, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x62,
-	0x6f, 0x64, 0x79, 0x22, 0xac, 0x01, 0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x41, 0x0a, 0x06, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6c, 0x75, 0x63,
-	0x69, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x69, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x46,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x43, 0x0a,
-	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x55, 0x43, 0x49, 0x10,
-	0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x48, 0x52, 0x4f, 0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x4a, 0x53,
-	0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x53, 0x10,
-	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54,
-	0x10, 0x02, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75,
-	0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x64, 0x62, 0x2f, 0x73, 0x69, 0x6e, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76,
-	0x31, 0x3b, 0x73, 0x69, 0x6e, 0x6b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x75, 0x63,
+	0x69, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x64, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x5a, 0x0a, 0x0e, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x75,
+	0x63, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x69, 0x6e, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x12, 0x1d, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x1c, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x41, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x29, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x69, 0x6e,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x22, 0x6d, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x08, 0x0a, 0x04,
+	0x4c, 0x55, 0x43, 0x49, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x48, 0x52, 0x4f, 0x4d, 0x49,
+	0x55, 0x4d, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53,
+	0x55, 0x4c, 0x54, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45,
+	0x5f, 0x54, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4a, 0x55, 0x4e, 0x49, 0x54,
+	0x5f, 0x58, 0x4d, 0x4c, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x50, 0x10, 0x04, 0x12,
+	0x10, 0x0a, 0x0c, 0x47, 0x4f, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10,
+	0x05, 0x22, 0x97, 0x03, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61,
+	0x67, 0x73, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x69,
+	0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61,
+	0x67, 0x73, 0x2e, 0x44, 0x69, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x69,
+	0x72, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x69, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x61, 0x67, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x43, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x67, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75,
+	0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x1a, 0x5e, 0x0a, 0x09, 0x44, 0x69,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x69, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x73, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5f, 0x0a, 0x0a, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x75, 0x63, 0x69,
+	0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x69, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x73, 0x2e, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x34, 0x5a, 0x32, 0x67,
+	0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c,
+	0x75, 0x63, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x64, 0x62, 0x2f, 0x73, 0x69, 0x6e,
+	0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x69, 0x6e, 0x6b, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -486,33 +681,43 @@ func file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_rawDescG
 }
 
 var file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_goTypes = []interface{}{
 	(TestResultFile_Format)(0),  // 0: luci.resultsink.v1.TestResultFile.Format
 	(*TestResult)(nil),          // 1: luci.resultsink.v1.TestResult
 	(*Artifact)(nil),            // 2: luci.resultsink.v1.Artifact
 	(*TestResultFile)(nil),      // 3: luci.resultsink.v1.TestResultFile
-	nil,                         // 4: luci.resultsink.v1.TestResult.ArtifactsEntry
-	(v1.TestStatus)(0),          // 5: luci.resultdb.v1.TestStatus
-	(*timestamp.Timestamp)(nil), // 6: google.protobuf.Timestamp
-	(*duration.Duration)(nil),   // 7: google.protobuf.Duration
-	(*v1.StringPair)(nil),       // 8: luci.resultdb.v1.StringPair
-	(*v1.TestLocation)(nil),     // 9: luci.resultdb.v1.TestLocation
+	(*LocationTags)(nil),        // 4: luci.resultsink.v1.LocationTags
+	nil,                         // 5: luci.resultsink.v1.TestResult.ArtifactsEntry
+	(*LocationTags_Tags)(nil),   // 6: luci.resultsink.v1.LocationTags.Tags
+	nil,                         // 7: luci.resultsink.v1.LocationTags.DirsEntry
+	nil,                         // 8: luci.resultsink.v1.LocationTags.FilesEntry
+	(v1.TestStatus)(0),          // 9: luci.resultdb.v1.TestStatus
+	(*timestamp.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*duration.Duration)(nil),   // 11: google.protobuf.Duration
+	(*v1.StringPair)(nil),       // 12: luci.resultdb.v1.StringPair
+	(*v1.TestLocation)(nil),     // 13: luci.resultdb.v1.TestLocation
+	(*v1.TestMetadata)(nil),     // 14: luci.resultdb.v1.TestMetadata
 }
 var file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_depIdxs = []int32{
-	5, // 0: luci.resultsink.v1.TestResult.status:type_name -> luci.resultdb.v1.TestStatus
-	6, // 1: luci.resultsink.v1.TestResult.start_time:type_name -> google.protobuf.Timestamp
-	7, // 2: luci.resultsink.v1.TestResult.duration:type_name -> google.protobuf.Duration
-	8, // 3: luci.resultsink.v1.TestResult.tags:type_name -> luci.resultdb.v1.StringPair
-	4, // 4: luci.resultsink.v1.TestResult.artifacts:type_name -> luci.resultsink.v1.TestResult.ArtifactsEntry
-	9, // 5: luci.resultsink.v1.TestResult.test_location:type_name -> luci.resultdb.v1.TestLocation
-	0, // 6: luci.resultsink.v1.TestResultFile.format:type_name -> luci.resultsink.v1.TestResultFile.Format
-	2, // 7: luci.resultsink.v1.TestResult.ArtifactsEntry.value:type_name -> luci.resultsink.v1.Artifact
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	9,  // 0: luci.resultsink.v1.TestResult.status:type_name -> luci.resultdb.v1.TestStatus
+	10, // 1: luci.resultsink.v1.TestResult.start_time:type_name -> google.protobuf.Timestamp
+	11, // 2: luci.resultsink.v1.TestResult.duration:type_name -> google.protobuf.Duration
+	12, // 3: luci.resultsink.v1.TestResult.tags:type_name -> luci.resultdb.v1.StringPair
+	5,  // 4: luci.resultsink.v1.TestResult.artifacts:type_name -> luci.resultsink.v1.TestResult.ArtifactsEntry
+	13, // 5: luci.resultsink.v1.TestResult.test_location:type_name -> luci.resultdb.v1.TestLocation
+	14, // 6: luci.resultsink.v1.TestResult.test_metadata:type_name -> luci.resultdb.v1.TestMetadata
+	0,  // 7: luci.resultsink.v1.TestResultFile.format:type_name -> luci.resultsink.v1.TestResultFile.Format
+	7,  // 8: luci.resultsink.v1.LocationTags.dirs:type_name -> luci.resultsink.v1.LocationTags.DirsEntry
+	8,  // 9: luci.resultsink.v1.LocationTags.files:type_name -> luci.resultsink.v1.LocationTags.FilesEntry
+	2,  // 10: luci.resultsink.v1.TestResult.ArtifactsEntry.value:type_name -> luci.resultsink.v1.Artifact
+	6,  // 11: luci.resultsink.v1.LocationTags.DirsEntry.value:type_name -> luci.resultsink.v1.LocationTags.Tags
+	6,  // 12: luci.resultsink.v1.LocationTags.FilesEntry.value:type_name -> luci.resultsink.v1.LocationTags.Tags
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_init() }
@@ -557,6 +762,30 @@ func file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_init() {
 				return nil
 			}
 		}
+		file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationTags_Tags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*Artifact_FilePath)(nil),
@@ -568,7 +797,7 @@ func file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_resultdb_sink_proto_v1_test_result_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,133 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/resultdb/sink/formats"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+// resultFilePollInterval is how often a result file is checked for new data.
+const resultFilePollInterval = time.Second
+
+// validateResultFile returns a non-nil error if f is invalid.
+func validateResultFile(f *sinkpb.TestResultFile) error {
+	if f.GetPath() == "" {
+		return errors.Reason("path: unspecified").Err()
+	}
+	if !filepath.IsAbs(f.Path) {
+		return errors.Reason("path: %q is not absolute", f.Path).Err()
+	}
+	if _, err := formats.NewConverter(f.Format); err != nil {
+		return errors.Annotate(err, "format").Err()
+	}
+	return nil
+}
+
+// resultFileWatcher watches a file with test results in a foreign format and
+// reports them, as they get appended to the file.
+type resultFileWatcher struct {
+	file   *sinkpb.TestResultFile
+	conv   formats.Converter
+	report func(context.Context, []*sinkpb.TestResult) error
+
+	// offset is the size of the file that has been consumed so far.
+	offset int64
+}
+
+func newResultFileWatcher(f *sinkpb.TestResultFile, report func(context.Context, []*sinkpb.TestResult) error) (*resultFileWatcher, error) {
+	conv, err := formats.NewConverter(f.Format)
+	if err != nil {
+		return nil, err
+	}
+	return &resultFileWatcher{file: f, conv: conv, report: report}, nil
+}
+
+// run polls the file until stopC is closed, then reports the remaining test
+// results and returns.
+//
+// If ctx is cancelled, run returns without reading the rest of the file.
+func (w *resultFileWatcher) run(ctx context.Context, stopC <-chan struct{}) error {
+	for {
+		if err := w.poll(ctx); err != nil {
+			return err
+		}
+
+		select {
+		case <-stopC:
+			if err := w.poll(ctx); err != nil {
+				return err
+			}
+			trs, err := w.conv.Close()
+			if err != nil {
+				return errors.Annotate(err, "converting %q", w.file.Path).Err()
+			}
+			return w.reportAll(ctx, trs)
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clock.After(ctx, resultFilePollInterval):
+		}
+	}
+}
+
+// poll reads and converts the data appended to the file since the last poll.
+func (w *resultFileWatcher) poll(ctx context.Context) error {
+	f, err := os.Open(w.file.Path)
+	switch {
+	case os.IsNotExist(err):
+		// The test command may not have created it yet.
+		return nil
+	case err != nil:
+		return err
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(w.offset, io.SeekStart); err != nil {
+		return err
+	}
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	w.offset += int64(len(data))
+
+	trs, err := w.conv.Feed(data)
+	if err != nil {
+		return errors.Annotate(err, "converting %q", w.file.Path).Err()
+	}
+	return w.reportAll(ctx, trs)
+}
+
+func (w *resultFileWatcher) reportAll(ctx context.Context, trs []*sinkpb.TestResult) error {
+	if len(trs) == 0 {
+		return nil
+	}
+	logging.Debugf(ctx, "SinkServer: reporting %d test results from %q", len(trs), w.file.Path)
+	return w.report(ctx, trs)
+}
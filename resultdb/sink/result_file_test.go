@@ -0,0 +1,112 @@
+// Copyright 2020 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestResultFile(t *testing.T) {
+	t.Parallel()
+
+	Convey("validateResultFile", t, func() {
+		f := &sinkpb.TestResultFile{Format: sinkpb.TestResultFile_TAP}
+
+		f.Path = ""
+		So(validateResultFile(f), ShouldErrLike, "path: unspecified")
+		f.Path = "relative/path"
+		So(validateResultFile(f), ShouldErrLike, "is not absolute")
+		f.Path, _ = filepath.Abs("results.tap")
+		So(validateResultFile(f), ShouldBeNil)
+		f.Format = sinkpb.TestResultFile_CHROMIUM_JSON_TEST_RESULTS
+		So(validateResultFile(f), ShouldErrLike, "unsupported format")
+	})
+
+	Convey("resultFileWatcher", t, func() {
+		ctx := context.Background()
+
+		dir, err := ioutil.TempDir("", "result_file_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "results.tap")
+
+		var mu sync.Mutex
+		var reported []*sinkpb.TestResult
+		w, err := newResultFileWatcher(
+			&sinkpb.TestResultFile{Path: path, Format: sinkpb.TestResultFile_TAP},
+			func(ctx context.Context, trs []*sinkpb.TestResult) error {
+				mu.Lock()
+				defer mu.Unlock()
+				reported = append(reported, trs...)
+				return nil
+			})
+		So(err, ShouldBeNil)
+
+		appendFile := func(data string) {
+			f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+			So(err, ShouldBeNil)
+			defer f.Close()
+			_, err = f.WriteString(data)
+			So(err, ShouldBeNil)
+		}
+
+		Convey("ignores a missing file", func() {
+			So(w.poll(ctx), ShouldBeNil)
+			So(reported, ShouldBeEmpty)
+		})
+
+		Convey("reports test results as they are appended", func() {
+			appendFile("TAP version 13\nok 1 - foo\nnot ok 2 - b")
+			So(w.poll(ctx), ShouldBeNil)
+			So(reported, ShouldHaveLength, 0)
+
+			appendFile("ar\nok 3 - baz\n")
+			So(w.poll(ctx), ShouldBeNil)
+			So(reported, ShouldHaveLength, 2)
+			So(reported[0].TestId, ShouldEqual, "foo")
+			So(reported[1].TestId, ShouldEqual, "bar")
+			So(reported[1].Status, ShouldEqual, pb.TestStatus_FAIL)
+
+			Convey("and the rest once stopped", func() {
+				appendFile("ok 4 - qux\n")
+				stopC := make(chan struct{})
+				close(stopC)
+				So(w.run(ctx, stopC), ShouldBeNil)
+				So(reported, ShouldHaveLength, 4)
+				So(reported[2].TestId, ShouldEqual, "baz")
+				So(reported[3].TestId, ShouldEqual, "qux")
+			})
+		})
+
+		Convey("stops without reporting the rest if cancelled", func() {
+			appendFile("ok 1 - foo\n")
+			ctx, cancel := context.WithCancel(ctx)
+			cancel()
+			So(w.run(ctx, nil), ShouldEqual, context.Canceled)
+			So(reported, ShouldBeEmpty)
+		})
+	})
+}
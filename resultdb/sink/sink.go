@@ -109,6 +109,15 @@ type ServerConfig struct {
 
 	// TestResultChannelMaxLeases specifies that the max lease of the TestResult upload channel.
 	TestResultChannelMaxLeases uint
+
+	// ResultFiles are files with test results in foreign formats, that the server
+	// watches while running. Test results appended to them are converted and
+	// reported as if they were sent to the server.
+	ResultFiles []*sinkpb.TestResultFile
+
+	// LocationTags is used to derive the metadata of test results that have
+	// a test location but no metadata. Optional.
+	LocationTags *sinkpb.LocationTags
 }
 
 // Validate validates all the config fields.
@@ -133,6 +142,16 @@ func (c *ServerConfig) Validate() error {
 	if err := pbutil.ValidateVariant(c.BaseVariant); err != nil {
 		return errors.Annotate(err, "BaseVariant").Err()
 	}
+	for i, f := range c.ResultFiles {
+		if err := validateResultFile(f); err != nil {
+			return errors.Annotate(err, "ResultFiles[%d]", i).Err()
+		}
+	}
+	if c.LocationTags != nil {
+		if err := validateLocationTags(c.LocationTags); err != nil {
+			return errors.Annotate(err, "LocationTags").Err()
+		}
+	}
 
 	return nil
 }
@@ -151,6 +170,13 @@ type Server struct {
 
 	mu  sync.Mutex // protects err
 	err error
+
+	// watchersStopC is closed to make the result file watchers report the rest
+	// of their files and stop.
+	watchersStopC  chan struct{}
+	stopWatchers   sync.Once
+	cancelWatchers context.CancelFunc
+	watchers       sync.WaitGroup
 }
 
 // NewServer creates a Server value and populates optional values with defaults.
@@ -182,8 +208,9 @@ func NewServer(ctx context.Context, cfg ServerConfig) (*Server, error) {
 	cfg.invocationID, _ = pbutil.ParseInvocationName(cfg.Invocation)
 
 	s := &Server{
-		cfg:   cfg,
-		doneC: make(chan struct{}),
+		cfg:           cfg,
+		doneC:         make(chan struct{}),
+		watchersStopC: make(chan struct{}),
 	}
 	return s, nil
 }
@@ -283,6 +310,10 @@ func (s *Server) Start(ctx context.Context) error {
 	prpc.InstallHandlers(routes, router.MiddlewareChain{})
 	sinkpb.RegisterSinkServer(prpc, ss)
 
+	if err := s.startResultFileWatchers(ctx, ss); err != nil {
+		return err
+	}
+
 	go func() {
 		defer func() {
 			// close SinkServer to complete all the outgoing requests before closing doneC
@@ -334,6 +365,12 @@ func (s *Server) Shutdown(ctx context.Context) (err error) {
 		// hasn't been started
 		return ErrCloseBeforeStart
 	}
+
+	// Report the rest of the result files before the server stops accepting
+	// test results.
+	s.stopWatchers.Do(func() { close(s.watchersStopC) })
+	s.watchers.Wait()
+
 	if err = s.httpSrv.Shutdown(ctx); err != nil {
 		return
 	}
@@ -363,9 +400,47 @@ func (s *Server) Close(ctx context.Context) (err error) {
 		err = ErrCloseBeforeStart
 		return
 	}
+	if s.cancelWatchers != nil {
+		s.cancelWatchers()
+	}
 	return s.httpSrv.Close()
 }
 
+// startResultFileWatchers launches a goroutine watching each of the result
+// files, and reporting their test results to ss.
+func (s *Server) startResultFileWatchers(ctx context.Context, ss sinkpb.SinkServer) error {
+	service := ss.(*sinkpb.DecoratedSink).Service
+	report := func(ctx context.Context, trs []*sinkpb.TestResult) error {
+		// Report the results one by one, so that an invalid one doesn't prevent
+		// the others from being uploaded.
+		for _, tr := range trs {
+			req := &sinkpb.ReportTestResultsRequest{TestResults: []*sinkpb.TestResult{tr}}
+			if _, err := service.ReportTestResults(ctx, req); err != nil {
+				logging.Errorf(ctx, "SinkServer: dropping test result %q: %s", tr.TestId, err)
+			}
+		}
+		return nil
+	}
+
+	ctx, s.cancelWatchers = context.WithCancel(ctx)
+	for _, f := range s.cfg.ResultFiles {
+		w, err := newResultFileWatcher(f, report)
+		if err != nil {
+			s.cancelWatchers()
+			return err
+		}
+
+		s.watchers.Add(1)
+		go func() {
+			defer s.watchers.Done()
+			if err := w.run(ctx, s.watchersStopC); err != nil && err != context.Canceled {
+				logging.Errorf(ctx, "SinkServer: failed to watch result file %q: %s", w.file.Path, err)
+			}
+		}()
+	}
+	return nil
+}
+
 // Export exports lucictx.ResultSink derived from the server configuration into
 // the context.
 func (s *Server) Export(ctx context.Context) context.Context {
@@ -69,6 +69,7 @@ func TestReportTestResults(t *testing.T) {
 			TestId:       tr.TestId,
 			ResultId:     tr.ResultId,
 			Expected:     tr.Expected,
+			Status:       tr.Status,
 			SummaryHtml:  tr.SummaryHtml,
 			StartTime:    tr.StartTime,
 			Duration:     tr.Duration,
@@ -91,6 +92,20 @@ func TestReportTestResults(t *testing.T) {
 				expected.Variant = pbutil.Variant(base...)
 				check(ctx, cfg, tr, expected)
 			})
+
+			Convey("with ServerConfig.LocationTags", func() {
+				cfg.LocationTags = &sinkpb.LocationTags{
+					Dirs: map[string]*sinkpb.LocationTags_Tags{
+						"//": {Owners: []string{"someone@example.com"}, BugComponent: "Infra"},
+					},
+				}
+				expected.TestMetadata = &pb.TestMetadata{
+					Owners:       []string{"someone@example.com"},
+					Location:     tr.TestLocation,
+					BugComponent: "Infra",
+				}
+				check(ctx, cfg, tr, expected)
+			})
 		})
 
 		Convey("generates a random ResultID, if omitted", func() {
@@ -97,19 +97,26 @@ func (c *testResultChannel) report(ctx context.Context, b *buffer.Batch) error {
 		reqs := make([]*pb.CreateTestResultRequest, len(b.Data))
 		for i, d := range b.Data {
 			tr := d.(*sinkpb.TestResult)
+			// Forward the status too, not only Expected: the flakiness analysis
+			// ignores skipped results.
 			reqs[i] = &pb.CreateTestResultRequest{
 				TestResult: &pb.TestResult{
 					TestId:       tr.GetTestId(),
 					ResultId:     tr.GetResultId(),
 					Variant:      c.cfg.BaseVariant,
 					Expected:     tr.GetExpected(),
+					Status:       tr.GetStatus(),
 					SummaryHtml:  tr.GetSummaryHtml(),
 					StartTime:    tr.GetStartTime(),
 					Duration:     tr.GetDuration(),
 					Tags:         tr.GetTags(),
 					TestLocation: tr.GetTestLocation(),
+					TestMetadata: tr.GetTestMetadata(),
 				},
 			}
+			if tr.GetTestMetadata() == nil && c.cfg.LocationTags != nil {
+				reqs[i].TestResult.TestMetadata = testMetadata(c.cfg.LocationTags, tr.GetTestLocation())
+			}
 		}
 		b.Meta = &pb.BatchCreateTestResultsRequest{
 			Invocation: c.cfg.Invocation,